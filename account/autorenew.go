@@ -0,0 +1,169 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// autoRenewConfTarget is the confirmation target used to estimate the
+	// fee rate of automatic account renewals.
+	autoRenewConfTarget = 6
+)
+
+// UpdateAutoRenew updates the automatic renewal settings of the account
+// associated with the given trader key. If enabled, the account is renewed
+// once the best height comes within renewalWindow blocks of its expiry.
+func (m *manager) UpdateAutoRenew(traderKey *btcec.PublicKey, autoRenew bool,
+	renewalWindow uint32) (*Account, error) {
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch account.State {
+	case StatePendingClosed, StateClosed, StateCanceledAfterRecovery:
+		return nil, fmt.Errorf("cannot update automatic renewal of "+
+			"account in state %v", account.State)
+	}
+
+	// The renewal window is only relevant if automatic renewal is enabled,
+	// so we don't keep a stale one around otherwise.
+	if autoRenew {
+		err := validateRenewalWindow(
+			renewalWindow, m.cfg.AutoRenewExpiryBlocks,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		renewalWindow = 0
+	}
+
+	modifier := AutoRenewModifier(autoRenew, renewalWindow)
+	if err := m.cfg.Store.UpdateAccount(account, modifier); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// validateRenewalWindow makes sure an account renewed with the given number of
+// expiry blocks doesn't immediately fall into the renewal window again.
+func validateRenewalWindow(renewalWindow, expiryBlocks uint32) error {
+	if renewalWindow == 0 {
+		return errors.New("renewal window must be greater than zero")
+	}
+	if renewalWindow >= expiryBlocks {
+		return fmt.Errorf("renewal window must be smaller than the "+
+			"automatic renewal expiry of %d blocks", expiryBlocks)
+	}
+
+	return nil
+}
+
+// autoRenewAccounts renews all accounts that have automatic renewal enabled
+// and are within their renewal window at the given height. Accounts that are
+// currently involved in a batch are skipped and picked up again with one of
+// the next blocks once the batch is resolved.
+func (m *manager) autoRenewAccounts(bestHeight uint32) {
+	accounts, err := m.cfg.Store.Accounts()
+	if err != nil {
+		log.Errorf("Unable to retrieve accounts for automatic "+
+			"renewal: %v", err)
+		return
+	}
+
+	var dueAccounts []*Account
+	for _, account := range accounts {
+		if renewalDue(account, bestHeight) {
+			dueAccounts = append(dueAccounts, account)
+		}
+	}
+	if len(dueAccounts) == 0 {
+		return
+	}
+
+	// A pending batch spends the accounts that participated in it, so
+	// renewing any account now could conflict with the batch transaction.
+	// We wait until the batch is resolved before renewing.
+	pending, err := m.hasPendingBatch()
+	if err != nil {
+		log.Errorf("Unable to determine pending batch for automatic "+
+			"renewal: %v", err)
+		return
+	}
+	if pending {
+		log.Infof("Deferring automatic renewal of %d account(s) until "+
+			"pending batch is resolved", len(dueAccounts))
+		return
+	}
+
+	ctx := context.Background()
+	feeRate, err := m.autoRenewFeeRate(ctx)
+	if err != nil {
+		log.Errorf("Unable to determine fee rate for automatic "+
+			"renewal: %v", err)
+		return
+	}
+
+	newExpiry := bestHeight + m.cfg.AutoRenewExpiryBlocks
+	for _, account := range dueAccounts {
+		traderKey := account.TraderKey.PubKey
+
+		log.Infof("Automatically renewing account %x expiring at "+
+			"height %d to new expiry %d with fee rate %v",
+			traderKey.SerializeCompressed(), account.Expiry,
+			newExpiry, feeRate)
+
+		_, _, _, err := m.RenewAccount(
+			ctx, traderKey, newExpiry, feeRate, bestHeight, false,
+		)
+		if err != nil {
+			log.Errorf("Unable to automatically renew account %x: "+
+				"%v", traderKey.SerializeCompressed(), err)
+		}
+	}
+}
+
+// autoRenewFeeRate returns the fee rate to use for automatic renewals, capped
+// at the configured maximum.
+func (m *manager) autoRenewFeeRate(
+	ctx context.Context) (chainfee.SatPerKWeight, error) {
+
+	feeRate, err := m.cfg.Wallet.EstimateFeeRate(ctx, autoRenewConfTarget)
+	if err != nil {
+		return 0, err
+	}
+
+	if m.cfg.AutoRenewMaxFeeRate > 0 && feeRate > m.cfg.AutoRenewMaxFeeRate {
+		log.Debugf("Capping automatic renewal fee rate of %v at %v",
+			feeRate, m.cfg.AutoRenewMaxFeeRate)
+		feeRate = m.cfg.AutoRenewMaxFeeRate
+	}
+
+	return feeRate, nil
+}
+
+// renewalDue returns true if the account has automatic renewal enabled, is in
+// a state that allows it to be renewed and the given height is within its
+// renewal window. Accounts in a pending state, for example because they're
+// part of a pending batch, are never due.
+func renewalDue(account *Account, bestHeight uint32) bool {
+	if !account.AutoRenew {
+		return false
+	}
+
+	switch account.State {
+	case StateOpen, StateExpired:
+	default:
+		return false
+	}
+
+	return bestHeight+account.RenewalWindow >= account.Expiry
+}
@@ -0,0 +1,297 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// CoinSelection controls which of the backing lnd node's coins are used to
+// fund a new account or an account deposit.
+type CoinSelection struct {
+	// Inputs is the list of wallet UTXOs that must be used to fund the
+	// transaction. No other inputs are added. If this is empty, lnd
+	// selects the inputs.
+	Inputs []wire.OutPoint
+
+	// ChangeType is the address type of the change output, if one is
+	// needed. Only p2wkh and p2tr are supported. If this is unknown, lnd
+	// decides on the type of the change address.
+	ChangeType walletrpc.AddressType
+}
+
+// Validate makes sure the coin selection doesn't contain any duplicate inputs
+// and uses a supported change address type.
+func (c *CoinSelection) Validate() error {
+	seen := make(map[wire.OutPoint]struct{}, len(c.Inputs))
+	for _, op := range c.Inputs {
+		if _, ok := seen[op]; ok {
+			return fmt.Errorf("input %v selected more than once",
+				op)
+		}
+		seen[op] = struct{}{}
+	}
+
+	switch c.ChangeType {
+	case walletrpc.AddressType_UNKNOWN,
+		walletrpc.AddressType_WITNESS_PUBKEY_HASH,
+		walletrpc.AddressType_TAPROOT_PUBKEY:
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported change address type %v",
+			c.ChangeType)
+	}
+}
+
+// validateSelectedInputs makes sure all selected inputs are confirmed and
+// unspent outputs of the backing lnd node's wallet and that their total value
+// covers the given amount plus the fee of a transaction with the outputs that
+// were already added to the weight estimator, the selected inputs and a change
+// output.
+func (m *manager) validateSelectedInputs(ctx context.Context,
+	inputs []wire.OutPoint, amount btcutil.Amount,
+	feeRate chainfee.SatPerKWeight,
+	weightEstimator input.TxWeightEstimator) error {
+
+	utxos, err := m.cfg.Wallet.ListUnspent(ctx, 1, math.MaxInt32)
+	if err != nil {
+		return fmt.Errorf("unable to list wallet UTXOs: %v", err)
+	}
+	utxoIndex := make(map[wire.OutPoint]*lnwallet.Utxo, len(utxos))
+	for _, utxo := range utxos {
+		utxoIndex[utxo.OutPoint] = utxo
+	}
+
+	var (
+		total   btcutil.Amount
+		missing []string
+	)
+	for _, op := range inputs {
+		utxo, ok := utxoIndex[op]
+		if !ok {
+			missing = append(missing, op.String())
+			continue
+		}
+
+		switch utxo.AddressType {
+		case lnwallet.WitnessPubKey:
+			weightEstimator.AddP2WKHInput()
+
+		case lnwallet.NestedWitnessPubKey:
+			weightEstimator.AddNestedP2WKHInput()
+
+		case lnwallet.TaprootPubkey:
+			weightEstimator.AddTaprootKeySpendInput(
+				txscript.SigHashDefault,
+			)
+
+		default:
+			return fmt.Errorf("input %v has unsupported address "+
+				"type %v", op, utxo.AddressType)
+		}
+
+		total += utxo.Value
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("selected inputs %s are not confirmed "+
+			"unspent outputs of the wallet",
+			strings.Join(missing, ", "))
+	}
+
+	// We always assume a change output of the larger p2tr type to be on
+	// the safe side.
+	weightEstimator.AddP2TROutput()
+	fee := feeRate.FeeForWeight(int64(weightEstimator.Weight()))
+
+	if total < amount+fee {
+		return fmt.Errorf("selected inputs of total value %v don't "+
+			"cover %v plus an estimated fee of %v, shortfall is %v",
+			total, amount, fee, amount+fee-total)
+	}
+
+	return nil
+}
+
+// fundPsbt creates a PSBT with the given outputs and lets the backing lnd
+// node's wallet fund it at the given fee rate, adding a change output if
+// needed. If a coin selection is given, only the selected inputs are used and
+// the change output is of the selected type. The inputs used are locked until
+// the returned closure is called.
+func (m *manager) fundPsbt(ctx context.Context, outputs []*wire.TxOut,
+	coins *CoinSelection, feeRate chainfee.SatPerKWeight) (*psbt.Packet,
+	int32, func(), error) {
+
+	var txIns []*wire.OutPoint
+	if coins != nil && len(coins.Inputs) > 0 {
+		var (
+			weightEstimator input.TxWeightEstimator
+			outputTotal     btcutil.Amount
+		)
+		for _, out := range outputs {
+			weightEstimator.AddTxOutput(out)
+			outputTotal += btcutil.Amount(out.Value)
+		}
+
+		err := m.validateSelectedInputs(
+			ctx, coins.Inputs, outputTotal, feeRate,
+			weightEstimator,
+		)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		for idx := range coins.Inputs {
+			txIns = append(txIns, &coins.Inputs[idx])
+		}
+	}
+
+	sequences := make([]uint32, len(txIns))
+	tplPacket, err := psbt.New(txIns, outputs, 2, 0, sequences)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("error creating template "+
+			"PSBT: %v", err)
+	}
+
+	var tplBytes bytes.Buffer
+	if err := tplPacket.Serialize(&tplBytes); err != nil {
+		return nil, 0, nil, fmt.Errorf("error serializing template "+
+			"PSBT: %v", err)
+	}
+
+	packet, changeOutputIdx, lockedCoins, err := m.cfg.Wallet.FundPsbt(
+		ctx, &walletrpc.FundPsbtRequest{
+			Template: &walletrpc.FundPsbtRequest_Psbt{
+				Psbt: tplBytes.Bytes(),
+			},
+			MinConfs: 1,
+			Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
+				SatPerVbyte: uint64(
+					feeRate.FeePerKVByte() / 1000,
+				),
+			},
+		},
+	)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("error funding PSBT: %v", err)
+	}
+
+	releaseInputs := func() {
+		for _, coin := range lockedCoins {
+			var lockID wtxmgr.LockID
+			copy(lockID[:], coin.Id)
+
+			hash, _ := chainhash.NewHash(coin.Outpoint.TxidBytes)
+			op := wire.OutPoint{
+				Hash:  *hash,
+				Index: coin.Outpoint.OutputIndex,
+			}
+			_ = m.cfg.Wallet.ReleaseOutput(ctx, lockID, op)
+		}
+	}
+
+	if coins != nil && coins.ChangeType != walletrpc.AddressType_UNKNOWN &&
+		changeOutputIdx >= 0 {
+
+		err := m.replaceChangeOutput(
+			ctx, packet, changeOutputIdx, coins.ChangeType, feeRate,
+		)
+		if err != nil {
+			releaseInputs()
+			return nil, 0, nil, err
+		}
+	}
+
+	return packet, changeOutputIdx, releaseInputs, nil
+}
+
+// replaceChangeOutput replaces the change output lnd added to a funded PSBT
+// with one that pays to a new change address of the given type. The change
+// value is adjusted for the different size of the output, so the transaction
+// still pays the given fee rate.
+func (m *manager) replaceChangeOutput(ctx context.Context, packet *psbt.Packet,
+	changeOutputIdx int32, changeType walletrpc.AddressType,
+	feeRate chainfee.SatPerKWeight) error {
+
+	addr, err := m.cfg.Wallet.NextAddr(ctx, "", changeType, true)
+	if err != nil {
+		return fmt.Errorf("unable to derive change address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	oldChange := packet.UnsignedTx.TxOut[changeOutputIdx]
+	newChange := wire.NewTxOut(oldChange.Value, pkScript)
+
+	// Outputs don't have a witness, so every byte counts four times.
+	sizeDiff := newChange.SerializeSize() - oldChange.SerializeSize()
+	weightDiff := int64(sizeDiff * blockchain.WitnessScaleFactor)
+	newChange.Value -= int64(feeRate.FeeForWeight(weightDiff))
+
+	dustLimit := lnwallet.DustLimitForSize(len(pkScript))
+	if btcutil.Amount(newChange.Value) < dustLimit {
+		return fmt.Errorf("change output of %v would be dust with "+
+			"address type %v", btcutil.Amount(newChange.Value),
+			changeType)
+	}
+
+	// The derivation info lnd added belongs to the old change address, so
+	// we remove it together with the output.
+	packet.UnsignedTx.TxOut[changeOutputIdx] = newChange
+	packet.Outputs[changeOutputIdx] = psbt.POutput{}
+
+	return nil
+}
+
+// fundAccountWithCoins creates, signs and publishes the transaction funding
+// the given account output according to the given coin selection, which may be
+// nil to let lnd select the coins. The transaction isn't signed if its fee
+// exceeds the given limit.
+func (m *manager) fundAccountWithCoins(ctx context.Context,
+	accountOutput *wire.TxOut, coins *CoinSelection,
+	feeRate chainfee.SatPerKWeight, limit *FeeLimit,
+	label string) (*wire.MsgTx, error) {
+
+	packet, _, releaseInputs, err := m.fundPsbt(
+		ctx, []*wire.TxOut{accountOutput}, coins, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := limit.checkWalletPacket(packet); err != nil {
+		releaseInputs()
+		return nil, err
+	}
+
+	_, tx, err := m.cfg.Wallet.FinalizePsbt(ctx, packet, "")
+	if err != nil {
+		releaseInputs()
+		return nil, fmt.Errorf("error signing funding transaction: %v",
+			err)
+	}
+
+	if err := m.cfg.Wallet.PublishTransaction(ctx, tx, label); err != nil {
+		releaseInputs()
+		return nil, err
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,242 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// DryRunLeaseTime is the time the wallet inputs selected by a deposit
+	// dry run are leased for. A deposit of the same amount at the same fee
+	// rate into the same account within this time reuses them.
+	DryRunLeaseTime = 2 * time.Minute
+)
+
+// DryRun is the unsigned transaction of an account modification that was only
+// simulated, along with a breakdown of its fee. Nothing was signed, broadcast
+// or persisted for it.
+type DryRun struct {
+	// Tx is the unsigned transaction.
+	Tx *wire.MsgTx
+
+	// InputTotal is the total value of all inputs of the transaction,
+	// including the account input.
+	InputTotal btcutil.Amount
+
+	// OutputTotal is the total value of all outputs of the transaction,
+	// including the new account output and any change output.
+	OutputTotal btcutil.Amount
+
+	// Weight is the estimated weight of the transaction once all its
+	// inputs are signed.
+	Weight int64
+
+	// WalletInputs are the inputs of the backing lnd node's wallet that
+	// fund a deposit. They are leased until LeaseExpiry.
+	WalletInputs []wire.OutPoint
+
+	// LeaseExpiry is the time the lease of the wallet inputs expires. It
+	// is zero if the transaction doesn't have any wallet inputs.
+	LeaseExpiry time.Time
+}
+
+// Fee returns the fee the transaction pays.
+func (d *DryRun) Fee() btcutil.Amount {
+	return d.InputTotal - d.OutputTotal
+}
+
+// VSize returns the estimated virtual size of the transaction once all its
+// inputs are signed.
+func (d *DryRun) VSize() int64 {
+	return (d.Weight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+}
+
+// FeeRate returns the fee rate the transaction pays.
+func (d *DryRun) FeeRate() chainfee.SatPerKWeight {
+	if d.Weight == 0 {
+		return 0
+	}
+
+	return chainfee.SatPerKWeight(d.Fee() * 1000 / btcutil.Amount(d.Weight))
+}
+
+// newDryRun sanity checks the unsigned spending transaction of an account
+// exactly like it would be checked before signing it and returns it along
+// with its fee breakdown.
+func newDryRun(account *Account, packet *psbt.Packet, witnessType witnessType,
+	isClose bool, bestHeight uint32, limit *FeeLimit) (*DryRun, error) {
+
+	lockTime, err := spendLockTime(witnessType, isClose, bestHeight)
+	if err != nil {
+		return nil, err
+	}
+	packet.UnsignedTx.LockTime = lockTime
+
+	err = sanityCheckAccountSpendTx(account, packet, witnessType, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	inputTotal, outputTotal, weight, err := accountSpendFee(
+		account, packet, witnessType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DryRun{
+		Tx:          packet.UnsignedTx,
+		InputTotal:  inputTotal,
+		OutputTotal: outputTotal,
+		Weight:      weight,
+	}, nil
+}
+
+// dryRunSelection is the coin selection of the latest deposit dry run of an
+// account.
+type dryRunSelection struct {
+	// depositAmount is the amount of the simulated deposit.
+	depositAmount btcutil.Amount
+
+	// feeRate is the fee rate of the simulated deposit.
+	feeRate chainfee.SatPerKWeight
+
+	// coins are the wallet inputs and the change type that were used.
+	coins CoinSelection
+
+	// expiry is the time the lease of the inputs expires.
+	expiry time.Time
+}
+
+// reusableFor returns the coin selection of the dry run if a deposit of the
+// given amount at the given fee rate can reuse it. A deposit can only reuse
+// it if it doesn't select its own inputs and requests the same change type.
+func (s *dryRunSelection) reusableFor(depositAmount btcutil.Amount,
+	feeRate chainfee.SatPerKWeight, coins *CoinSelection) *CoinSelection {
+
+	changeType := walletrpc.AddressType_UNKNOWN
+	if coins != nil {
+		if len(coins.Inputs) > 0 {
+			return nil
+		}
+		changeType = coins.ChangeType
+	}
+
+	switch {
+	case s == nil, !time.Now().Before(s.expiry):
+		return nil
+
+	case depositAmount != s.depositAmount, feeRate != s.feeRate,
+		changeType != s.coins.ChangeType:
+
+		return nil
+	}
+
+	return &CoinSelection{
+		Inputs:     s.coins.Inputs,
+		ChangeType: s.coins.ChangeType,
+	}
+}
+
+// holdDryRunInputs leases the wallet inputs of a deposit dry run, so a
+// following deposit can reuse them, and remembers them as the latest
+// selection of the account. The inputs must no longer be locked by lnd.
+func (m *manager) holdDryRunInputs(ctx context.Context, account *Account,
+	dryRun *DryRun, depositAmount btcutil.Amount,
+	feeRate chainfee.SatPerKWeight, coins *CoinSelection) error {
+
+	lockID, err := m.cfg.Store.LockID()
+	if err != nil {
+		return err
+	}
+
+	for _, txIn := range dryRun.Tx.TxIn {
+		op := txIn.PreviousOutPoint
+		if op == account.OutPoint {
+			continue
+		}
+
+		expiry, err := m.cfg.Wallet.LeaseOutput(
+			ctx, lockID, op, DryRunLeaseTime,
+		)
+		if err != nil {
+			m.releaseDryRunInputs(ctx, dryRun.WalletInputs)
+			return fmt.Errorf("unable to lease input %v: %v", op,
+				err)
+		}
+
+		dryRun.WalletInputs = append(dryRun.WalletInputs, op)
+		dryRun.LeaseExpiry = expiry
+	}
+
+	selection := &dryRunSelection{
+		depositAmount: depositAmount,
+		feeRate:       feeRate,
+		coins: CoinSelection{
+			Inputs: dryRun.WalletInputs,
+		},
+		expiry: dryRun.LeaseExpiry,
+	}
+	if coins != nil {
+		selection.coins.ChangeType = coins.ChangeType
+	}
+
+	m.dryRunSelectionsMtx.Lock()
+	m.dryRunSelections[accountIndex(account)] = selection
+	m.dryRunSelectionsMtx.Unlock()
+
+	return nil
+}
+
+// takeDryRunSelection removes the selection of the latest deposit dry run of
+// the account associated with the given trader key and releases its inputs,
+// so lnd can select them again. Nil is returned if there is no selection.
+func (m *manager) takeDryRunSelection(ctx context.Context,
+	traderKey *btcec.PublicKey) *dryRunSelection {
+
+	var key [33]byte
+	copy(key[:], traderKey.SerializeCompressed())
+
+	m.dryRunSelectionsMtx.Lock()
+	selection, ok := m.dryRunSelections[key]
+	delete(m.dryRunSelections, key)
+	m.dryRunSelectionsMtx.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	m.releaseDryRunInputs(ctx, selection.coins.Inputs)
+
+	return selection
+}
+
+// releaseDryRunInputs releases the leases of the given dry run inputs. Leases
+// that already expired are ignored.
+func (m *manager) releaseDryRunInputs(ctx context.Context,
+	inputs []wire.OutPoint) {
+
+	lockID, err := m.cfg.Store.LockID()
+	if err != nil {
+		log.Errorf("Unable to release dry run inputs: %v", err)
+		return
+	}
+
+	for _, op := range inputs {
+		err := m.cfg.Wallet.ReleaseOutput(ctx, lockID, op)
+		if err != nil {
+			log.Debugf("Unable to release dry run input %v: %v",
+				op, err)
+		}
+	}
+}
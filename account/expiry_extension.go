@@ -0,0 +1,62 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/pool/terms"
+)
+
+const (
+	// expiryExtensionPadding is the number of blocks we allow an extended
+	// expiry to exceed the auctioneer's maximum extension by. This avoids
+	// rejecting batches because of discrepancies in block propagation
+	// between us and the auctioneer.
+	expiryExtensionPadding = 3
+)
+
+var (
+	// ErrInvalidExpiryExtension is returned if the auctioneer proposes a
+	// new account expiry as part of a batch that we don't accept.
+	ErrInvalidExpiryExtension = errors.New("invalid account expiry " +
+		"extension")
+)
+
+// ValidateExpiryExtension makes sure the new expiry the auctioneer proposes
+// for an account as part of a batch is acceptable. The new expiry must not be
+// below the current one and must not exceed the auctioneer's advertised
+// account extension relative to the current height. If the expiry is
+// extended, it must be extended by at least the configured minimum.
+func (m *manager) ValidateExpiryExtension(account *Account, newExpiry,
+	bestHeight uint32, terms *terms.AuctioneerTerms) error {
+
+	switch {
+	case newExpiry < account.Expiry:
+		return fmt.Errorf("%w: new expiry %d is below current expiry "+
+			"%d", ErrInvalidExpiryExtension, newExpiry,
+			account.Expiry)
+
+	// The expiry isn't changed, there's nothing more to check.
+	case newExpiry == account.Expiry:
+		return nil
+	}
+
+	maxExpiry := bestHeight + terms.AutoRenewExtensionBlocks +
+		expiryExtensionPadding
+	if newExpiry > maxExpiry {
+		return fmt.Errorf("%w: new expiry %d exceeds maximum of %d "+
+			"allowed by the auctioneer's extension of %d blocks",
+			ErrInvalidExpiryExtension, newExpiry, maxExpiry,
+			terms.AutoRenewExtensionBlocks)
+	}
+
+	extension := newExpiry - account.Expiry
+	if extension < m.cfg.MinAcceptableExpiryExtension {
+		return fmt.Errorf("%w: expiry extended by %d blocks, minimum "+
+			"acceptable extension is %d blocks",
+			ErrInvalidExpiryExtension, extension,
+			m.cfg.MinAcceptableExpiryExtension)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrFeeExceedsMax is returned if the fee of an account transaction
+	// exceeds one of the ceilings the user set.
+	ErrFeeExceedsMax = errors.New("fee exceeds maximum")
+)
+
+// FeeLimit is a ceiling for the fee of an account transaction. It protects
+// against absurd fee estimates, for example during a fee spike. A zero value
+// for either field means there is no ceiling of that kind.
+type FeeLimit struct {
+	// MaxFee is the maximum absolute fee the transaction may pay.
+	MaxFee btcutil.Amount
+
+	// MaxFeeRate is the maximum fee rate the transaction may pay.
+	MaxFeeRate chainfee.SatPerKWeight
+}
+
+// checkFeeRate returns an error wrapping ErrFeeExceedsMax if the given fee
+// rate exceeds the maximum fee rate. A nil limit is never exceeded.
+func (l *FeeLimit) checkFeeRate(feeRate chainfee.SatPerKWeight) error {
+	if l == nil || l.MaxFeeRate == 0 || feeRate <= l.MaxFeeRate {
+		return nil
+	}
+
+	return fmt.Errorf("%w: fee rate of %v exceeds maximum of %v",
+		ErrFeeExceedsMax, feeRate, l.MaxFeeRate)
+}
+
+// checkFee returns an error wrapping ErrFeeExceedsMax if the given fee of a
+// transaction with the given weight exceeds either ceiling. A nil limit is
+// never exceeded.
+func (l *FeeLimit) checkFee(fee btcutil.Amount, weight int64) error {
+	if l == nil {
+		return nil
+	}
+
+	if l.MaxFee != 0 && fee > l.MaxFee {
+		return fmt.Errorf("%w: fee of %v exceeds maximum of %v",
+			ErrFeeExceedsMax, fee, l.MaxFee)
+	}
+
+	feeRate := chainfee.SatPerKWeight(fee * 1000 / btcutil.Amount(weight))
+	if err := l.checkFeeRate(feeRate); err != nil {
+		return fmt.Errorf("%w (fee of %v)", err, fee)
+	}
+
+	return nil
+}
+
+// checkWalletPacket returns an error wrapping ErrFeeExceedsMax if the fee of
+// the given funded but unsigned PSBT exceeds either ceiling. All inputs of the
+// packet must belong to the backing lnd node's wallet. A nil limit is never
+// exceeded.
+func (l *FeeLimit) checkWalletPacket(packet *psbt.Packet) error {
+	if l == nil {
+		return nil
+	}
+
+	var (
+		inputTotal, outputTotal btcutil.Amount
+		witnessSize             int64
+	)
+	for _, pIn := range packet.Inputs {
+		if pIn.WitnessUtxo == nil {
+			return errors.New("funded PSBT is missing witness UTXO")
+		}
+		inputTotal += btcutil.Amount(pIn.WitnessUtxo.Value)
+
+		inputWitnessSize, err := walletInputWitnessSize(pIn)
+		if err != nil {
+			return err
+		}
+		witnessSize += inputWitnessSize
+	}
+	for _, output := range packet.UnsignedTx.TxOut {
+		outputTotal += btcutil.Amount(output.Value)
+	}
+
+	// Just like when sanity checking an account spend, we add the witness
+	// weight along with the marker and flag bytes to the weight of the
+	// unsigned transaction.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(
+		packet.UnsignedTx,
+	)) + 2 + witnessSize
+
+	return l.checkFee(inputTotal-outputTotal, weight)
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/wtxmgr"
@@ -18,12 +21,22 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/subscribe"
 )
 
 var (
 	// ErrNoPendingBatch is an error returned when we attempt to retrieve
 	// the ID of a pending batch, but one does not exist.
 	ErrNoPendingBatch = errors.New("no pending batch found")
+
+	// ErrNoScheduledWithdrawal is an error returned when we attempt to
+	// access the scheduled withdrawal of an account, but one does not
+	// exist.
+	ErrNoScheduledWithdrawal = errors.New("no scheduled withdrawal found")
+
+	// ErrNoPendingDeposit is an error returned when we attempt to finalize
+	// an externally funded deposit of an account, but none was initiated.
+	ErrNoPendingDeposit = errors.New("no pending deposit found")
 )
 
 // Reservation contains information about the different keys required for to
@@ -136,6 +149,58 @@ func (s State) IsActive() bool {
 	}
 }
 
+// Version represents the version of an account.
+type Version uint8
+
+// NOTE: We avoid the use of iota as these can be persisted to disk.
+const (
+	// VersionInitialNoVersion is the version of all accounts created before
+	// accounts were versioned. These accounts use a P2WSH output.
+	VersionInitialNoVersion Version = 0
+
+	// VersionTaprootEnabled is the version of accounts that use a P2TR
+	// output with a MuSig2 key spend path for cooperative spends.
+	VersionTaprootEnabled Version = 1
+)
+
+// String returns a human-readable description of an account's version.
+func (v Version) String() string {
+	switch v {
+	case VersionInitialNoVersion:
+		return "account_p2wsh"
+
+	case VersionTaprootEnabled:
+		return "account_p2tr"
+
+	default:
+		return fmt.Sprintf("unknown <%d>", v)
+	}
+}
+
+// ScriptVersion returns the version of the output script an account of this
+// version uses.
+func (v Version) ScriptVersion() poolscript.Version {
+	switch v {
+	case VersionTaprootEnabled:
+		return poolscript.VersionTaprootMuSig2
+
+	default:
+		return poolscript.VersionWitnessScript
+	}
+}
+
+// versionFromScript returns the account version that uses the given output
+// script version.
+func versionFromScript(scriptVersion poolscript.Version) Version {
+	switch scriptVersion {
+	case poolscript.VersionTaprootMuSig2:
+		return VersionTaprootEnabled
+
+	default:
+		return VersionInitialNoVersion
+	}
+}
+
 // Account encapsulates all of the details of a CLM account on-chain from
 // the trader's perspective.
 type Account struct {
@@ -192,6 +257,25 @@ type Account struct {
 	// NOTE: This is only nil within the StateInitiated phase. There are no
 	// guarantees as to whether the transaction has its witness populated.
 	LatestTx *wire.MsgTx
+
+	// AutoRenew indicates whether the account should automatically be
+	// renewed once the best height comes within RenewalWindow blocks of
+	// its expiry.
+	AutoRenew bool
+
+	// RenewalWindow is the number of blocks before the account's expiry at
+	// which an automatic renewal is attempted. This is only used if
+	// AutoRenew is set.
+	RenewalWindow uint32
+
+	// Reserve is the part of the account's value that orders may not
+	// spend. It ensures the account can always pay for a sweep through
+	// its expiry path.
+	Reserve btcutil.Amount
+
+	// Version is the version of the account. It determines the type of
+	// the account's output script and how it is spent.
+	Version Version
 }
 
 const (
@@ -205,8 +289,8 @@ const (
 // Output returns the current on-chain output associated with the account.
 func (a *Account) Output() (*wire.TxOut, error) {
 	script, err := poolscript.AccountScript(
-		a.Expiry, a.TraderKey.PubKey, a.AuctioneerKey, a.BatchKey,
-		a.Secret,
+		a.Version.ScriptVersion(), a.Expiry, a.TraderKey.PubKey,
+		a.AuctioneerKey, a.BatchKey, a.Secret,
 	)
 	if err != nil {
 		return nil, err
@@ -224,8 +308,8 @@ func (a *Account) Output() (*wire.TxOut, error) {
 func (a *Account) NextOutputScript() ([]byte, error) {
 	nextBatchKey := poolscript.IncrementKey(a.BatchKey)
 	return poolscript.AccountScript(
-		a.Expiry, a.TraderKey.PubKey, a.AuctioneerKey, nextBatchKey,
-		a.Secret,
+		a.Version.ScriptVersion(), a.Expiry, a.TraderKey.PubKey,
+		a.AuctioneerKey, nextBatchKey, a.Secret,
 	)
 }
 
@@ -250,6 +334,10 @@ func (a *Account) Copy(modifiers ...Modifier) *Account {
 		State:         a.State,
 		HeightHint:    a.HeightHint,
 		OutPoint:      a.OutPoint,
+		AutoRenew:     a.AutoRenew,
+		RenewalWindow: a.RenewalWindow,
+		Reserve:       a.Reserve,
+		Version:       a.Version,
 	}
 	if a.State != StateInitiated {
 		accountCopy.LatestTx = a.LatestTx.Copy()
@@ -318,6 +406,63 @@ func LatestTxModifier(tx *wire.MsgTx) Modifier {
 	}
 }
 
+// AutoRenewModifier is a functional option that modifies the automatic renewal
+// settings of an account.
+func AutoRenewModifier(autoRenew bool, renewalWindow uint32) Modifier {
+	return func(account *Account) {
+		account.AutoRenew = autoRenew
+		account.RenewalWindow = renewalWindow
+	}
+}
+
+// ScheduledWithdrawal is a withdrawal from an account that is executed
+// automatically once the estimated fee rate drops to or below a maximum.
+type ScheduledWithdrawal struct {
+	// TraderKey identifies the account to withdraw from. There can only be
+	// one scheduled withdrawal per account.
+	TraderKey *btcec.PublicKey
+
+	// Outputs are the outputs the funds are withdrawn to.
+	Outputs []*wire.TxOut
+
+	// MaxFeeRate is the highest fee rate at which the withdrawal is
+	// executed.
+	MaxFeeRate chainfee.SatPerKWeight
+
+	// Expiry is the time after which the withdrawal is no longer attempted
+	// and removed.
+	Expiry time.Time
+}
+
+// PendingDeposit is a deposit into an account that is funded by an external
+// wallet through a PSBT. It is created when the deposit is initiated and
+// removed once the signed PSBT is finalized.
+type PendingDeposit struct {
+	// TraderKey identifies the account the funds are deposited into. There
+	// can only be one pending deposit per account.
+	TraderKey *btcec.PublicKey
+
+	// OutPoint is the account outpoint the deposit transaction spends. If
+	// the account is modified in the meantime, the deposit can no longer
+	// be finalized.
+	OutPoint wire.OutPoint
+
+	// Value is the value of the account after the deposit.
+	Value btcutil.Amount
+
+	// Expiry is the new expiration height of the account. A value of zero
+	// means the expiry is not changed by the deposit.
+	Expiry uint32
+}
+
+// ReserveModifier is a functional option that modifies the reserve of an
+// account.
+func ReserveModifier(reserve btcutil.Amount) Modifier {
+	return func(account *Account) {
+		account.Reserve = reserve
+	}
+}
+
 // Store is responsible for storing and retrieving account information reliably.
 type Store interface {
 	// AddAccount adds a record for the account to the database.
@@ -338,13 +483,56 @@ type Store interface {
 	// If a batch doesn't exist, ErrNoPendingBatch is returned.
 	PendingBatch() error
 
-	// MarkBatchComplete marks the batch with the given ID as complete,
-	// indicating that the staged account updates can be applied to disk.
-	MarkBatchComplete() error
+	// MarkBatchComplete marks the pending batch with the given batch
+	// transaction hash as complete, indicating that the staged account
+	// updates can be applied to disk. If no pending batch with that
+	// transaction exists, ErrNoPendingBatch is returned.
+	MarkBatchComplete(batchTxHash chainhash.Hash) error
 
 	// LockID retrieves the global lock ID we'll use to lock any outputs
 	// when performing coin selection.
 	LockID() (wtxmgr.LockID, error)
+
+	// StoreScheduledWithdrawal stores the given scheduled withdrawal,
+	// replacing any existing one of the same account.
+	StoreScheduledWithdrawal(*ScheduledWithdrawal) error
+
+	// ScheduledWithdrawals returns all scheduled withdrawals.
+	ScheduledWithdrawals() ([]*ScheduledWithdrawal, error)
+
+	// DeleteScheduledWithdrawal removes the scheduled withdrawal of the
+	// account with the given trader key. If there is none,
+	// ErrNoScheduledWithdrawal is returned.
+	DeleteScheduledWithdrawal(*btcec.PublicKey) error
+
+	// CompleteScheduledWithdrawal updates an account according to the
+	// given modifiers and removes its scheduled withdrawal atomically. If
+	// the account has no scheduled withdrawal, ErrNoScheduledWithdrawal
+	// is returned and the account is left untouched.
+	CompleteScheduledWithdrawal(*Account, ...Modifier) error
+
+	// StorePendingDeposit stores the given pending deposit, replacing any
+	// existing one of the same account.
+	StorePendingDeposit(*PendingDeposit) error
+
+	// PendingDeposit returns the pending deposit of the account with the
+	// given trader key. If there is none, ErrNoPendingDeposit is returned.
+	PendingDeposit(*btcec.PublicKey) (*PendingDeposit, error)
+
+	// DeletePendingDeposit removes the pending deposit of the account with
+	// the given trader key. If there is none, ErrNoPendingDeposit is
+	// returned.
+	DeletePendingDeposit(*btcec.PublicKey) error
+
+	// RecordFeeBump records a fee bump of the latest transaction of the
+	// account with the given trader key at the given fee rate in the
+	// account's event log.
+	RecordFeeBump(*btcec.PublicKey, chainfee.SatPerKWeight) error
+
+	// SubscribeAccountUpdates registers a callback that is invoked with
+	// the current list of all accounts every time an account is added or
+	// updated.
+	SubscribeAccountUpdates(cb UpdateCallback)
 }
 
 // Auctioneer provides us with the different ways we are able to communicate
@@ -357,8 +545,10 @@ type Auctioneer interface {
 	// construction. To address an edge case in the account recovery where
 	// the trader crashes before confirming the account with the auctioneer,
 	// we also send the trader key and expiry along with the reservation.
+	// The version of the account must be one the auctioneer supports
+	// according to its terms.
 	ReserveAccount(context.Context, btcutil.Amount, uint32,
-		*btcec.PublicKey) (*Reservation, error)
+		*btcec.PublicKey, Version) (*Reservation, error)
 
 	// InitAccount initializes an account with the auctioneer such that it
 	// can be used once fully confirmed.
@@ -370,9 +560,13 @@ type Auctioneer interface {
 	// spending from the account allowing our modifications to take place.
 	// The inputs and outputs provided should exclude the account input
 	// being spent and the account output potentially being recreated, since
-	// the auctioneer can construct those themselves.
+	// the auctioneer can construct those themselves. For taproot accounts
+	// the trader's MuSig2 nonces and the outputs spent by all inputs of the
+	// transaction must be provided, the auctioneer then returns its partial
+	// signature along with its own MuSig2 nonces.
 	ModifyAccount(context.Context, *Account, []*wire.TxIn,
-		[]*wire.TxOut, []Modifier) ([]byte, error)
+		[]*wire.TxOut, []Modifier, []byte, []*wire.TxOut) ([]byte,
+		[]byte, error)
 
 	// StartAccountSubscription opens a stream to the server and subscribes
 	// to all updates that concern the given account, including all orders
@@ -416,9 +610,10 @@ type TxFeeEstimator interface {
 // terms of a transaction's resulting outputs.
 type FeeExpr interface {
 	// CloseOutputs is the list of outputs that should be used for the
-	// closing transaction of an account based on the concrete fee
-	// expression implementation.
-	CloseOutputs(btcutil.Amount, witnessType) ([]*wire.TxOut, error)
+	// closing transaction of an account of the given version based on the
+	// concrete fee expression implementation.
+	CloseOutputs(btcutil.Amount, witnessType, Version) ([]*wire.TxOut,
+		error)
 }
 
 // OutputWithFee signals that a single transaction output along with a fee rate
@@ -434,23 +629,20 @@ type OutputWithFee struct {
 }
 
 func (o *OutputWithFee) CloseOutputs(accountValue btcutil.Amount,
-	witnessType witnessType) ([]*wire.TxOut, error) {
+	witnessType witnessType, version Version) ([]*wire.TxOut, error) {
 
 	// Calculate the transaction's weight to determine its fee according to
-	// the provided fee rate. The transaction will contain one P2WSH input
-	// (the account input) and one output.
+	// the provided fee rate. The transaction will contain one input (the
+	// account input) and one output.
 	var weightEstimator input.TxWeightEstimator
 
 	// Determine the appropriate witness size based on the input and output
 	// type.
-	switch witnessType {
-	case expiryWitness:
-		weightEstimator.AddWitnessInput(poolscript.ExpiryWitnessSize)
-	case multiSigWitness:
-		weightEstimator.AddWitnessInput(poolscript.MultiSigWitnessSize)
-	default:
+	witnessSize, err := witnessType.witnessSize(version)
+	if err != nil {
 		return nil, fmt.Errorf("unhandled witness type %v", witnessType)
 	}
+	weightEstimator.AddWitnessInput(witnessSize)
 
 	pkScript, err := txscript.ParsePkScript(o.PkScript)
 	if err != nil {
@@ -508,11 +700,97 @@ func (o OutputsWithImplicitFee) Outputs() []*wire.TxOut {
 // Outputs is the list of outputs that should be used for the closing
 // transaction of an account using an implicit fee expression.
 func (o OutputsWithImplicitFee) CloseOutputs(accountValue btcutil.Amount,
-	witnessType witnessType) ([]*wire.TxOut, error) {
+	witnessType witnessType, version Version) ([]*wire.TxOut, error) {
 
 	return o, nil
 }
 
+// OutputsWithChange signals that the account is closed into the given outputs
+// and that the remaining balance, minus the fee determined by the fee rate, is
+// sent to a change output.
+type OutputsWithChange struct {
+	// Outputs are the outputs the account funds are withdrawn to.
+	Outputs []*wire.TxOut
+
+	// ChangePkScript is the output script of the change output that
+	// receives the remaining balance of the account.
+	ChangePkScript []byte
+
+	// FeeRate is the fee rate to use to determine the transaction fee.
+	FeeRate chainfee.SatPerKWeight
+}
+
+// CloseOutputs is the list of outputs that should be used for the closing
+// transaction of an account when withdrawing to outputs and sending the
+// remainder to a change output.
+func (o *OutputsWithChange) CloseOutputs(accountValue btcutil.Amount,
+	witnessType witnessType, version Version) ([]*wire.TxOut, error) {
+
+	var weightEstimator input.TxWeightEstimator
+	witnessSize, err := witnessType.witnessSize(version)
+	if err != nil {
+		return nil, err
+	}
+	weightEstimator.AddWitnessInput(witnessSize)
+
+	var outputTotal btcutil.Amount
+	for _, out := range o.Outputs {
+		if _, err := addOutputWeight(&weightEstimator, out); err != nil {
+			return nil, err
+		}
+		outputTotal += btcutil.Amount(out.Value)
+	}
+
+	change := &wire.TxOut{PkScript: o.ChangePkScript}
+	dustLimit, err := addOutputWeight(&weightEstimator, change)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := o.FeeRate.FeeForWeight(int64(weightEstimator.Weight()))
+	changeValue := accountValue - outputTotal - fee
+	if changeValue < dustLimit {
+		return nil, fmt.Errorf("remaining account balance of %v after "+
+			"withdrawing %v with %v results in dust", accountValue,
+			outputTotal, o.FeeRate)
+	}
+	change.Value = int64(changeValue)
+
+	outputs := make([]*wire.TxOut, 0, len(o.Outputs)+1)
+	outputs = append(outputs, o.Outputs...)
+	return append(outputs, change), nil
+}
+
+// addOutputWeight adds the weight of the given output to the weight estimator
+// and returns the dust limit of the output's script type.
+func addOutputWeight(weightEstimator *input.TxWeightEstimator,
+	out *wire.TxOut) (btcutil.Amount, error) {
+
+	pkScript, err := txscript.ParsePkScript(out.PkScript)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse output script %x: %v",
+			out.PkScript, err)
+	}
+
+	switch pkScript.Class() {
+	case txscript.WitnessV0PubKeyHashTy:
+		weightEstimator.AddP2WKHOutput()
+		return lnwallet.DustLimitForSize(input.P2WPKHSize), nil
+
+	case txscript.ScriptHashTy:
+		weightEstimator.AddP2SHOutput()
+		return lnwallet.DustLimitForSize(input.P2SHSize), nil
+
+	case txscript.WitnessV0ScriptHashTy:
+		weightEstimator.AddP2WSHOutput()
+		return lnwallet.DustLimitForSize(input.P2WSHSize), nil
+
+	default:
+		return 0, fmt.Errorf("unsupported output script %x",
+			out.PkScript)
+	}
+}
+
 // Manager is the interface a manager implements to deal with the accounts.
 type Manager interface {
 	// Start resumes all account on-chain operation after a restart.
@@ -527,10 +805,13 @@ type Manager interface {
 		confTarget uint32) (chainfee.SatPerKWeight, btcutil.Amount, error)
 
 	// InitAccount handles a request to create a new account with the provided
-	// parameters.
+	// parameters. If a coin selection is given, the account is funded
+	// according to it, otherwise lnd selects the coins. If a fee limit is
+	// given, the account isn't funded if its funding transaction would
+	// exceed it.
 	InitAccount(ctx context.Context, value btcutil.Amount,
-		feeRate chainfee.SatPerKWeight, expiry,
-		bestHeight uint32) (*Account, error)
+		feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32,
+		coins *CoinSelection, limit *FeeLimit) (*Account, error)
 
 	// WatchMatchedAccounts resumes accounts that were just matched in a batch and
 	// are expecting the batch transaction to confirm as their next account output.
@@ -560,26 +841,93 @@ type Manager interface {
 	HandleAccountExpiry(traderKey *btcec.PublicKey,
 		height uint32) error
 
+	// HandleNewBlock triggers the automatic renewal of all accounts that
+	// have it enabled and are within their renewal window at the given
+	// height.
+	HandleNewBlock(bestHeight uint32)
+
 	// DepositAccount attempts to deposit funds into the account associated with the
 	// given trader key such that the new account value is met using inputs sourced
 	// from the backing lnd node's wallet. If needed, a change output that does back
-	// to lnd may be added to the deposit transaction.
+	// to lnd may be added to the deposit transaction. If a coin selection is
+	// given, only the selected inputs are used. If a fee limit is given,
+	// the deposit is aborted if its transaction would exceed it. If dryRun
+	// is set, the transaction is only created and returned unsigned.
 	DepositAccount(ctx context.Context, traderKey *btcec.PublicKey,
 		depositAmount btcutil.Amount, feeRate chainfee.SatPerKWeight,
-		bestHeight, expiryHeight uint32) (*Account, *wire.MsgTx, error)
+		bestHeight, expiryHeight uint32, coins *CoinSelection,
+		limit *FeeLimit, dryRun bool) (*Account, *wire.MsgTx, *DryRun,
+		error)
+
+	// DepositAccountPsbt initiates a deposit into the account associated
+	// with the given trader key that is funded by an external wallet. The
+	// returned PSBT spends the current account output into the new account
+	// output and needs to be funded, signed and passed to FinalizeDeposit.
+	DepositAccountPsbt(ctx context.Context, traderKey *btcec.PublicKey,
+		depositAmount btcutil.Amount, bestHeight,
+		expiryHeight uint32) (*psbt.Packet, error)
+
+	// FinalizeDeposit completes the pending deposit of the account
+	// associated with the given trader key using the PSBT funded and
+	// signed by the external wallet.
+	FinalizeDeposit(ctx context.Context, traderKey *btcec.PublicKey,
+		packet *psbt.Packet, bestHeight uint32) (*Account, *wire.MsgTx,
+		error)
 
 	// WithdrawAccount attempts to withdraw funds from the account associated with
-	// the given trader key into the provided outputs.
+	// the given trader key into the provided outputs. If a fee limit is
+	// given, the withdrawal is aborted if its transaction would exceed it.
+	// If dryRun is set, the transaction is only created and returned
+	// unsigned.
 	WithdrawAccount(ctx context.Context, traderKey *btcec.PublicKey,
 		outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight,
-		bestHeight, expiryHeight uint32) (*Account, *wire.MsgTx, error)
+		bestHeight, expiryHeight uint32, limit *FeeLimit,
+		dryRun bool) (*Account, *wire.MsgTx, *DryRun, error)
 
 	// RenewAccount updates the expiration of an open/expired account. This will
 	// always require a signature from the auctioneer, even after the account has
 	// expired, to ensure the auctioneer is aware the account is being renewed.
+	// If dryRun is set, the transaction is only created and returned
+	// unsigned.
 	RenewAccount(ctx context.Context, traderKey *btcec.PublicKey,
 		newExpiry uint32, feeRate chainfee.SatPerKWeight,
-		bestHeight uint32) (*Account, *wire.MsgTx, error)
+		bestHeight uint32, dryRun bool) (*Account, *wire.MsgTx,
+		*DryRun, error)
+
+	// UpdateAutoRenew updates the automatic renewal settings of the account
+	// associated with the given trader key. If enabled, the account is
+	// renewed once the best height comes within renewalWindow blocks of
+	// its expiry.
+	UpdateAutoRenew(traderKey *btcec.PublicKey, autoRenew bool,
+		renewalWindow uint32) (*Account, error)
+
+	// SubscribeUpdates returns a new subscription for account update
+	// events along with the current state of all accounts. The client
+	// receives an *UpdateEvent every time the state, value or outpoint of
+	// an account changes.
+	SubscribeUpdates() (*subscribe.Client, []*UpdateEvent, error)
+
+	// UpdateReserve updates the reserve of the account associated with
+	// the given trader key. Orders may not spend into the reserve.
+	UpdateReserve(traderKey *btcec.PublicKey,
+		reserve btcutil.Amount) (*Account, error)
+
+	// ScheduleWithdraw schedules a withdrawal from the account associated
+	// with the given trader key into the provided outputs. The withdrawal
+	// is executed once the estimated fee rate is at or below maxFeeRate,
+	// unless the expiry time is reached first. Any previously scheduled
+	// withdrawal of the account is replaced.
+	ScheduleWithdraw(traderKey *btcec.PublicKey, outputs []*wire.TxOut,
+		maxFeeRate chainfee.SatPerKWeight,
+		expiry time.Time) (*ScheduledWithdrawal, error)
+
+	// ScheduledWithdrawals returns all withdrawals that are scheduled but
+	// weren't executed yet.
+	ScheduledWithdrawals() ([]*ScheduledWithdrawal, error)
+
+	// CancelScheduledWithdraw cancels the scheduled withdrawal of the
+	// account associated with the given trader key.
+	CancelScheduledWithdraw(traderKey *btcec.PublicKey) error
 
 	// BumpAccountFee attempts to bump the fee of an account's most recent
 	// transaction. This is done by locating an eligible output for lnd to CPFP,
@@ -592,11 +940,36 @@ type Manager interface {
 	// CloseAccount attempts to close the account associated with the given trader
 	// key. Closing the account requires a signature of the auctioneer if the
 	// account has not yet expired. The account funds are swept according to the
-	// provided fee expression.
+	// provided fee expression. If a fee limit is given, the account isn't
+	// closed if the closing transaction would exceed it. If dryRun is set,
+	// the transaction is only created and returned unsigned.
 	CloseAccount(ctx context.Context, traderKey *btcec.PublicKey,
-		feeExpr FeeExpr, bestHeight uint32) (*wire.MsgTx, error)
+		feeExpr FeeExpr, bestHeight uint32, limit *FeeLimit,
+		dryRun bool) (*wire.MsgTx, *DryRun, error)
+
+	// WithdrawAndClose closes the account associated with the given trader
+	// key by withdrawing to the given outputs and sending the remaining
+	// balance, minus fees, to the backing lnd node's wallet. The account
+	// must not have any active orders and no batch must be pending.
+	WithdrawAndClose(ctx context.Context, traderKey *btcec.PublicKey,
+		outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight,
+		bestHeight uint32) (*wire.MsgTx, error)
+
+	// SweepExpired sweeps the full value of an expired account to the
+	// given address, or the backing lnd node's wallet if none is provided,
+	// through the account's expiry path, without requiring the
+	// auctioneer's cooperation.
+	SweepExpired(ctx context.Context, traderKey *btcec.PublicKey,
+		destAddr btcutil.Address, feeRate chainfee.SatPerKWeight,
+		bestHeight uint32) (*wire.MsgTx, error)
 
 	// RecoverAccount re-introduces a recovered account into the database and starts
 	// all watchers necessary depending on the account's state.
 	RecoverAccount(ctx context.Context, account *Account) error
+
+	// ValidateExpiryExtension makes sure the new expiry the auctioneer
+	// proposes for an account as part of a batch is acceptable. An error
+	// wrapping ErrInvalidExpiryExtension is returned if it isn't.
+	ValidateExpiryExtension(account *Account, newExpiry,
+		bestHeight uint32, terms *terms.AuctioneerTerms) error
 }
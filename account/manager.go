@@ -25,6 +25,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account/watcher"
+	"github.com/lightninglabs/pool/metrics"
 	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
@@ -34,6 +35,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/subscribe"
 )
 
 const (
@@ -72,18 +74,24 @@ const (
 	multiSigWitness
 )
 
-// witnessSize returns the estimated weight units for an account input witness.
-func (wt witnessType) witnessSize() (int, error) {
+// witnessSize returns the estimated weight units for the input witness of an
+// account of the given version.
+func (wt witnessType) witnessSize(version Version) (int, error) {
+	scriptVersion := version.ScriptVersion()
 	switch wt {
 	case expiryWitness:
-		return poolscript.ExpiryWitnessSize, nil
+		return scriptVersion.ExpiryWitnessSize(), nil
 	case multiSigWitness:
-		return poolscript.MultiSigWitnessSize, nil
+		return scriptVersion.MultiSigWitnessSize(), nil
 	default:
 		return 0, fmt.Errorf("unknown witness type %v", wt)
 	}
 }
 
+// accountUpdater persists the modifications of an account that are made as
+// part of an account spend.
+type accountUpdater func(*Account, ...Modifier) error
+
 // spendPackage tracks useful information regarding an account spend.
 type spendPackage struct {
 	// tx is the spending transaction of the account.
@@ -98,8 +106,14 @@ type spendPackage struct {
 
 	// ourSig is our signature of the spending transaction above. If the
 	// spend is taking the multi-sig path, then the auctioneer's signature
-	// will be required as well for a valid spend.
+	// will be required as well for a valid spend. This is not set for
+	// taproot accounts.
 	ourSig []byte
+
+	// prevOutputs are the outputs spent by all inputs of the spending
+	// transaction, in the order of the inputs. These are only set for
+	// taproot accounts as they are required for the signature hash.
+	prevOutputs []*wire.TxOut
 }
 
 // ManagerConfig contains all of the required dependencies for the Manager to
@@ -144,6 +158,38 @@ type ManagerConfig struct {
 
 	// LndVersion is the version of the connected lnd node.
 	LndVersion *verrpc.Version
+
+	// AutoRenewMaxFeeRate is the maximum fee rate used for automatic
+	// account renewals. If the estimated fee rate is higher, it is capped
+	// to this value.
+	AutoRenewMaxFeeRate chainfee.SatPerKWeight
+
+	// AutoRenewExpiryBlocks is the number of blocks, relative to the
+	// current best height, an account's new expiry is set to when it is
+	// renewed automatically.
+	AutoRenewExpiryBlocks uint32
+
+	// MinAcceptableExpiryExtension is the minimum number of blocks the
+	// auctioneer must extend an account's expiry by when it does so as
+	// part of a batch. Batches with shorter extensions are rejected. A
+	// value of 0 accepts any extension.
+	MinAcceptableExpiryExtension uint32
+
+	// HasActiveOrders returns true if the account with the given trader
+	// key has any orders that are still active in the auctioneer's order
+	// book.
+	HasActiveOrders func(ctx context.Context,
+		traderKey *btcec.PublicKey) (bool, error)
+
+	// Metrics is used to report the balances of all open accounts. If
+	// this is nil, nothing is reported.
+	Metrics metrics.Recorder
+
+	// CompactFilterWatch watches accounts in the mode suited for lnd nodes
+	// with a neutrino backend. The height hints of accounts watched for a
+	// spend are advanced and persisted as blocks are mined and all
+	// notifications are re-armed after a reorg.
+	CompactFilterWatch bool
 }
 
 // Manager is responsible for the management of accounts on-chain.
@@ -166,6 +212,26 @@ type manager struct {
 	// time to fund it.
 	reservationMtx sync.Mutex
 
+	// updateServer is the event bus that notifies subscribers about
+	// changes of an account's state, value or outpoint.
+	updateServer *subscribe.Server
+
+	// knownAccounts is the last known state of all accounts, indexed by
+	// their serialized trader key. It's used to determine what changed
+	// when the store notifies us about an update.
+	knownAccounts    map[[33]byte]*Account
+	knownAccountsMtx sync.Mutex
+
+	// newBlocks is used to hand new block heights from the watcher over to
+	// the goroutine that renews accounts and executes scheduled
+	// withdrawals. Only the latest unprocessed height is kept.
+	newBlocks chan uint32
+
+	// dryRunSelections are the coin selections of the latest deposit dry
+	// run of each account, indexed by the serialized trader key.
+	dryRunSelections    map[[33]byte]*dryRunSelection
+	dryRunSelectionsMtx sync.Mutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -176,14 +242,21 @@ var _ Manager = (*manager)(nil)
 // NewManager instantiates a new Manager backed by the given config.
 func NewManager(cfg *ManagerConfig) *manager { // nolint:golint
 	m := &manager{
-		cfg:  *cfg,
-		quit: make(chan struct{}),
+		cfg:              *cfg,
+		updateServer:     subscribe.NewServer(),
+		knownAccounts:    make(map[[33]byte]*Account),
+		newBlocks:        make(chan uint32, 1),
+		dryRunSelections: make(map[[33]byte]*dryRunSelection),
+		quit:             make(chan struct{}),
 	}
+	m.cfg.Metrics = metrics.OrDisabled(cfg.Metrics)
 
 	m.watcherCtrl = watcher.NewController(&watcher.CtrlConfig{
 		ChainNotifier: cfg.ChainNotifier,
 		// The manager implements the EventHandler interface
-		Handlers: m,
+		Handlers:         m,
+		CompactFilters:   cfg.CompactFilterWatch,
+		UpdateHeightHint: m.updateWatchHeightHint,
 	})
 
 	return m
@@ -202,6 +275,12 @@ func (m *manager) Start() error {
 func (m *manager) start() error {
 	ctx := context.Background()
 
+	// Start tracking account changes first, so subscribers are notified
+	// about everything that happens while resuming the accounts.
+	if err := m.startUpdates(); err != nil {
+		return fmt.Errorf("unable to start account updates: %v", err)
+	}
+
 	// We'll start by resuming all of our accounts. This requires the
 	// watcher to be started first.
 	if err := m.watcherCtrl.Start(); err != nil {
@@ -242,13 +321,18 @@ func (m *manager) start() error {
 		// part so we properly abandon the account if it fails before
 		// publishing the TX instead of trying to re-fund on startup.
 		if err := m.resumeAccount(
-			ctx, account, true, false, feeRate,
+			ctx, account, true, false, feeRate, nil, nil,
 		); err != nil {
 			return fmt.Errorf("unable to resume account %x: %v",
 				acctKey, err)
 		}
 	}
 
+	// Now that all accounts are resumed, we can start renewing them and
+	// executing scheduled withdrawals if requested.
+	m.wg.Add(1)
+	go m.newBlockHandler()
+
 	return nil
 }
 
@@ -257,6 +341,11 @@ func (m *manager) Stop() {
 	m.stopped.Do(func() {
 		m.watcherCtrl.Stop()
 
+		if err := m.updateServer.Stop(); err != nil {
+			log.Errorf("Unable to stop account update server: %v",
+				err)
+		}
+
 		close(m.quit)
 		m.wg.Wait()
 	})
@@ -301,10 +390,16 @@ func (m *manager) QuoteAccount(ctx context.Context, value btcutil.Amount,
 }
 
 // InitAccount handles a request to create a new account with the provided
-// parameters.
+// parameters. If a coin selection is given, the account is funded according
+// to it, otherwise lnd selects the coins. If a fee limit is given, the account
+// isn't funded if its funding transaction would exceed it.
 func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
-	feeRate chainfee.SatPerKWeight, expiry,
-	bestHeight uint32) (*Account, error) {
+	feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32,
+	coins *CoinSelection, limit *FeeLimit) (*Account, error) {
+
+	if err := limit.checkFeeRate(feeRate); err != nil {
+		return nil, err
+	}
 
 	// We'll make sure to acquire the reservation lock throughout the
 	// account funding process to ensure we use the same reservation, as
@@ -326,6 +421,26 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 	if err != nil {
 		return nil, err
 	}
+	if coins != nil {
+		if err := coins.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// If the user selected the inputs to fund the account with, we make
+	// sure they're sufficient before persisting anything. We don't know
+	// the account script yet, so we assume the larger p2tr output.
+	if coins != nil && len(coins.Inputs) > 0 {
+		var weightEstimator input.TxWeightEstimator
+		weightEstimator.AddP2TROutput()
+
+		err := m.validateSelectedInputs(
+			ctx, coins.Inputs, value, feeRate, weightEstimator,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// We'll start by deriving a key for ourselves that we'll use in our
 	// 2-of-2 multi-sig construction.
@@ -338,9 +453,10 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 
 	// With our key obtained, we'll reserve an account with our auctioneer,
 	// who will provide us with their base key and our initial per-batch
-	// key.
+	// key. We use the newest account version both of us support.
+	version := m.negotiateVersion(terms)
 	reservation, err := m.cfg.Auctioneer.ReserveAccount(
-		ctx, value, expiry, keyDesc.PubKey,
+		ctx, value, expiry, keyDesc.PubKey, version,
 	)
 	if err != nil {
 		return nil, err
@@ -358,7 +474,8 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 
 	// With all of the details gathered, we'll persist our intent to create
 	// an account to disk and proceed to fund it and wait for its
-	// confirmation.
+	// confirmation. The account's reserve defaults to what we'd need to
+	// sweep it at the current fee estimate.
 	account := &Account{
 		Value:         value,
 		Expiry:        expiry,
@@ -368,15 +485,19 @@ func (m *manager) InitAccount(ctx context.Context, value btcutil.Amount,
 		Secret:        secret,
 		State:         StateInitiated,
 		HeightHint:    bestHeight,
+		Reserve:       DefaultReserve(feeRate),
+		Version:       version,
 	}
 	if err := m.cfg.Store.AddAccount(account); err != nil {
 		return nil, err
 	}
 
-	log.Infof("Creating new account %x of %v that expires at height %v",
-		keyDesc.PubKey.SerializeCompressed(), value, expiry)
+	log.Infof("Creating new %v account %x of %v that expires at height %v",
+		version, keyDesc.PubKey.SerializeCompressed(), value, expiry)
 
-	err = m.resumeAccount(ctx, account, false, false, feeRate)
+	err = m.resumeAccount(
+		ctx, account, false, false, feeRate, coins, limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -417,7 +538,7 @@ func (m *manager) WatchMatchedAccounts(ctx context.Context,
 		// the appropriate watchers again.
 		// We set feerate to 0 because we know that we won't need to
 		// create a new transaction for resuming the account.
-		err = m.resumeAccount(ctx, acct, false, false, 0)
+		err = m.resumeAccount(ctx, acct, false, false, 0, nil, nil)
 		if err != nil {
 			return fmt.Errorf("error resuming account %x: %v",
 				matchedAccount.SerializeCompressed(), err)
@@ -470,7 +591,8 @@ func (m *manager) verifyAccountSigner(ctx context.Context,
 // This method serves as a way to consolidate the logic of resuming accounts on
 // startup and during normal operation.
 func (m *manager) resumeAccount(ctx context.Context, account *Account, // nolint
-	onRestart bool, onRecovery bool, feeRate chainfee.SatPerKWeight) error {
+	onRestart bool, onRecovery bool, feeRate chainfee.SatPerKWeight,
+	coins *CoinSelection, limit *FeeLimit) error {
 
 	accountOutput, err := account.Output()
 	if err != nil {
@@ -547,11 +669,24 @@ func (m *manager) resumeAccount(ctx context.Context, account *Account, // nolint
 				"AccountCreation(acct_key=%x)", acctKey)
 			label := makeTxnLabel(m.cfg.TxLabelPrefix, contextLabel)
 
+			// Unless the user selected the coins to use or limited
+			// the fee, we let lnd fund the account output. We
+			// can't inspect the fee before lnd publishes the
+			// transaction in that case.
+			//
 			// TODO(wilmer): Expose manual controls to bump fees.
-			tx, err := m.cfg.Wallet.SendOutputs(
-				ctx, []*wire.TxOut{accountOutput}, feeRate,
-				label,
-			)
+			var tx *wire.MsgTx
+			if coins != nil || limit != nil {
+				tx, err = m.fundAccountWithCoins(
+					ctx, accountOutput, coins, feeRate,
+					limit, label,
+				)
+			} else {
+				tx, err = m.cfg.Wallet.SendOutputs(
+					ctx, []*wire.TxOut{accountOutput},
+					feeRate, label,
+				)
+			}
 			if err != nil {
 				return err
 			}
@@ -933,6 +1068,24 @@ func (m *manager) HandleAccountConf(traderKey *btcec.PublicKey,
 	return m.handleStateOpen(context.Background(), account)
 }
 
+// updateWatchHeightHint persists the height hint of an account output that is
+// watched for a spend. The hint is only updated if the account still uses
+// the output, as it might have been replaced while the hint was determined.
+func (m *manager) updateWatchHeightHint(traderKey *btcec.PublicKey,
+	outPoint wire.OutPoint, heightHint uint32) error {
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return err
+	}
+
+	return m.cfg.Store.UpdateAccount(account, func(a *Account) {
+		if a.OutPoint == outPoint {
+			a.HeightHint = heightHint
+		}
+	})
+}
+
 // HandleAccountSpend handles the different spend paths of an account. If an
 // account is spent by the expiration path, it'll always be marked as closed
 // thereafter. If it is spent by the cooperative path with the auctioneer, then
@@ -950,22 +1103,40 @@ func (m *manager) HandleAccountSpend(traderKey *btcec.PublicKey,
 		return err
 	}
 
-	// We'll need to perform different operations based on the witness of
-	// the spending input of the account.
+	// We'll need to perform different operations based on how the account
+	// was spent. If the account was already updated to the output the
+	// spending transaction creates, the spent output was derived from the
+	// previous batch key.
 	spendTx := spendDetails.SpendingTx
-	spendWitness := spendTx.TxIn[spendDetails.SpenderInputIndex].Witness
+	spentOutPoint := spendTx.TxIn[spendDetails.SpenderInputIndex].
+		PreviousOutPoint
+	batchKey := account.BatchKey
+	if account.OutPoint != spentOutPoint {
+		batchKey = poolscript.DecrementKey(batchKey)
+	}
+	spend, err := poolscript.ClassifySpend(
+		spendTx, &poolscript.AccountParams{
+			OutPoint:      spentOutPoint,
+			Expiry:        account.Expiry,
+			TraderKey:     account.TraderKey.PubKey,
+			AuctioneerKey: account.AuctioneerKey,
+		}, batchKey, account.Secret,
+	)
+	if err != nil {
+		return err
+	}
 
-	switch {
-	// If the witness is for a spend of the account expiration path, then
-	// we'll mark the account as closed as the account has expired and all
-	// the funds have been withdrawn.
-	case poolscript.IsExpirySpend(spendWitness):
+	switch spend.Path {
+	// If the account was spent through its expiration path, then we'll
+	// mark the account as closed as the account has expired and all the
+	// funds have been withdrawn.
+	case poolscript.SpendPathExpiry:
 		break
 
-	// If the witness is for a multi-sig spend, then either an order by the
-	// trader was matched, or the account was closed. If it was closed, then
-	// the account output shouldn't have been recreated.
-	case poolscript.IsMultiSigSpend(spendWitness):
+	// If the account was spent through its multi-sig path, then either an
+	// order by the trader was matched, or the account was closed. If it
+	// was closed, then the account output wasn't recreated.
+	case poolscript.SpendPathMultiSig:
 		// If there's a pending batch which has yet to be completed,
 		// we'll mark it as so now. This can happen if the trader is not
 		// connected to the auctioneer when the auctioneer sends them
@@ -983,16 +1154,21 @@ func (m *manager) HandleAccountSpend(traderKey *btcec.PublicKey,
 		case ErrNoPendingBatch:
 			break
 
-		// If there is, we'll commit it and refresh the account state.
+		// If there is, we'll commit the one that spent the account and
+		// refresh the account state. If none of our pending batches
+		// spent the account, we can proceed as normal as well.
 		case nil:
-			if err := m.cfg.Store.MarkBatchComplete(); err != nil {
+			err := m.cfg.Store.MarkBatchComplete(spendTx.TxHash())
+			if err != nil && err != ErrNoPendingBatch {
 				m.pendingBatchMtx.Unlock()
 				return err
 			}
-			account, err = m.cfg.Store.Account(traderKey)
-			if err != nil {
-				m.pendingBatchMtx.Unlock()
-				return err
+			if err == nil {
+				account, err = m.cfg.Store.Account(traderKey)
+				if err != nil {
+					m.pendingBatchMtx.Unlock()
+					return err
+				}
 			}
 
 		default:
@@ -1004,26 +1180,21 @@ func (m *manager) HandleAccountSpend(traderKey *btcec.PublicKey,
 		// An account cannot be spent without our knowledge, so we'll
 		// assume we always persist account updates before a broadcast
 		// of the spending transaction. Therefore, since we should
-		// already have the updates applied, we can just look for our
-		// current output in the transaction.
-		accountOutput, err := account.Output()
-		if err != nil {
-			return err
-		}
-		_, ok := poolscript.LocateOutputScript(
-			spendTx, accountOutput.PkScript,
-		)
-		if ok {
+		// already have the updates applied, we can just proceed if the
+		// account was recreated.
+		if spend.Recreated {
 			// Proceed with the rest of the flow. We won't send to
 			// the account output again, so we don't need to set
 			// a valid feeRate.
 			return m.resumeAccount(
 				context.Background(), account, false, false, 0,
+				nil, nil,
 			)
 		}
 
 	default:
-		return fmt.Errorf("unknown spend witness %x", spendWitness)
+		return fmt.Errorf("account not spent by transaction %v",
+			spendTx.TxHash())
 	}
 
 	log.Infof("Account %x has been closed on-chain with transaction %v",
@@ -1074,45 +1245,115 @@ func (m *manager) HandleAccountExpiry(traderKey *btcec.PublicKey,
 	return m.cfg.Store.UpdateAccount(account, StateModifier(expiredState))
 }
 
+// HandleNewBlock triggers the automatic renewal of accounts and the execution
+// of scheduled withdrawals at the given height. The actual work happens in the
+// background as this is called from the watcher's block loop.
+func (m *manager) HandleNewBlock(bestHeight uint32) {
+	// If the previous height wasn't picked up yet, replace it with the new
+	// one as there's no point in acting on an outdated height.
+	select {
+	case <-m.newBlocks:
+	default:
+	}
+
+	select {
+	case m.newBlocks <- bestHeight:
+	default:
+	}
+}
+
+// newBlockHandler renews accounts and executes scheduled withdrawals for every
+// new block handed over by the watcher.
+//
+// NOTE: This must be run as a goroutine.
+func (m *manager) newBlockHandler() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case bestHeight := <-m.newBlocks:
+			m.autoRenewAccounts(bestHeight)
+			m.executeScheduledWithdrawals(bestHeight)
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// hasPendingBatch returns true if there currently is a pending batch. Accounts
+// that participated in it must not be spent until it is resolved.
+func (m *manager) hasPendingBatch() (bool, error) {
+	err := m.cfg.Store.PendingBatch()
+	switch {
+	case err == nil:
+		return true, nil
+
+	case errors.Is(err, ErrNoPendingBatch):
+		return false, nil
+
+	default:
+		return false, err
+	}
+}
+
 // DepositAccount attempts to deposit funds into the account associated with the
 // given trader key such that the new account value is met using inputs sourced
 // from the backing lnd node's wallet. If needed, a change output that does back
-// to lnd may be added to the deposit transaction.
+// to lnd may be added to the deposit transaction. If a coin selection is given,
+// only the selected inputs are used. If a fee limit is given, the deposit is
+// aborted if its transaction would exceed it.
+//
+// If dryRun is set, the deposit transaction is only created and returned
+// unsigned along with the account as it would be after the deposit. The
+// wallet inputs it uses are leased for DryRunLeaseTime and reused by the next
+// deposit of the same amount at the same fee rate into the account if they're
+// still unspent by then.
 func (m *manager) DepositAccount(ctx context.Context,
 	traderKey *btcec.PublicKey, depositAmount btcutil.Amount,
-	feeRate chainfee.SatPerKWeight, bestHeight,
-	expiryHeight uint32) (*Account, *wire.MsgTx, error) {
+	feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32,
+	coins *CoinSelection, limit *FeeLimit, dryRun bool) (*Account,
+	*wire.MsgTx, *DryRun, error) {
 
 	// The account can only be modified in `StateOpen` and its new value
 	// should not exceed the maximum allowed.
 	account, err := m.cfg.Store.Account(traderKey)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if account.State != StateOpen {
-		return nil, nil, fmt.Errorf("account must be in %v to be "+
+		return nil, nil, nil, fmt.Errorf("account must be in %v to be "+
 			"modified", StateOpen)
 	}
 
 	// The auctioneer defines the maximum account size.
 	terms, err := m.cfg.Auctioneer.Terms(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not query auctioneer "+
+		return nil, nil, nil, fmt.Errorf("could not query auctioneer "+
 			"terms: %v", err)
 	}
 
 	newAccountValue := account.Value + depositAmount
 	if newAccountValue > terms.MaxAccountValue {
-		return nil, nil, fmt.Errorf("new account value is above "+
+		return nil, nil, nil, fmt.Errorf("new account value is above "+
 			"accepted maximum of %v", terms.MaxAccountValue)
 	}
 
+	if coins != nil {
+		if err := coins.Validate(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if err := limit.checkFeeRate(feeRate); err != nil {
+		return nil, nil, nil, err
+	}
+
 	var newExpiry *uint32
 	if expiryHeight != 0 {
 		// Validate the new expiry.
 		err := validateAccountExpiry(expiryHeight, bestHeight)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		newExpiry = &expiryHeight
 	}
@@ -1123,23 +1364,64 @@ func (m *manager) DepositAccount(ctx context.Context,
 		account, newAccountValue, newExpiry,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// To start, we'll need to perform coin selection in order to meet the
 	// required new value of the account as part of the deposit. The
 	// selected inputs, along with a change output if needed, will then be
-	// included in the deposit transaction we'll broadcast.
+	// included in the deposit transaction we'll broadcast. If a previous
+	// dry run of the same deposit still holds its inputs, we use them
+	// again so the transaction matches the one that was reviewed.
+	dryRunSelection := m.takeDryRunSelection(ctx, traderKey)
+	selectedCoins := coins
+	reusedCoins := dryRunSelection.reusableFor(depositAmount, feeRate, coins)
+	if reusedCoins != nil {
+		selectedCoins = reusedCoins
+	}
 	packet, releaseInputs, err := m.inputsForDeposit(
 		ctx, account, newAccountOutput, depositAmount, multiSigWitness,
-		feeRate,
+		feeRate, selectedCoins,
 	)
+	if err != nil && reusedCoins != nil {
+		log.Infof("Unable to reuse inputs of deposit dry run for "+
+			"account %x, selecting new inputs: %v",
+			traderKey.SerializeCompressed(), err)
+
+		packet, releaseInputs, err = m.inputsForDeposit(
+			ctx, account, newAccountOutput, depositAmount,
+			multiSigWitness, feeRate, coins,
+		)
+	}
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	log.Tracef("Got funded PSBT packet %s", spew.Sdump(packet))
 
+	if dryRun {
+		// The inputs are leased by lnd for much longer than we want to
+		// hold them, so we release them right away and lease them
+		// again for just a short time.
+		releaseInputs()
+
+		result, err := newDryRun(
+			account, packet, multiSigWitness, false, bestHeight,
+			limit,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		err = m.holdDryRunInputs(
+			ctx, account, result, depositAmount, feeRate, coins,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return account.Copy(modifiers...), result.Tx, result, nil
+	}
+
 	// We'll tack on the change output if it was needed and an additional
 	// `StatePendingUpdate` modifier to our account and proceed with the
 	// rest of the flow. This should request a signature from the auctioneer
@@ -1147,30 +1429,52 @@ func (m *manager) DepositAccount(ctx context.Context,
 	modifiers = append(modifiers, StateModifier(StatePendingUpdate))
 	modifiedAccount, spendPkg, err := m.spendAccount(
 		ctx, account, packet, multiSigWitness, modifiers, false,
-		bestHeight,
+		bestHeight, limit,
 	)
 	if err != nil {
 		releaseInputs()
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return modifiedAccount, spendPkg.tx, nil
+	return modifiedAccount, spendPkg.tx, nil, nil
 }
 
 // WithdrawAccount attempts to withdraw funds from the account associated with
-// the given trader key into the provided outputs.
+// the given trader key into the provided outputs. If a fee limit is given, the
+// withdrawal is aborted if its transaction would exceed it. If dryRun is set,
+// the withdrawal transaction is only created and returned unsigned along with
+// the account as it would be after the withdrawal.
 func (m *manager) WithdrawAccount(ctx context.Context,
 	traderKey *btcec.PublicKey, outputs []*wire.TxOut,
-	feeRate chainfee.SatPerKWeight,
-	bestHeight, expiryHeight uint32) (*Account, *wire.MsgTx, error) {
+	feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32,
+	limit *FeeLimit, dryRun bool) (*Account, *wire.MsgTx, *DryRun, error) {
+
+	return m.withdrawAccount(
+		ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight,
+		limit, dryRun, m.cfg.Store.UpdateAccount,
+	)
+}
+
+// withdrawAccount withdraws funds from an account like WithdrawAccount but
+// persists the modified account with the given updater before the withdrawal
+// transaction is broadcast.
+func (m *manager) withdrawAccount(ctx context.Context,
+	traderKey *btcec.PublicKey, outputs []*wire.TxOut,
+	feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32,
+	limit *FeeLimit, dryRun bool, updateAccount accountUpdater) (*Account,
+	*wire.MsgTx, *DryRun, error) {
+
+	if err := limit.checkFeeRate(feeRate); err != nil {
+		return nil, nil, nil, err
+	}
 
 	// The account can only be modified in `StateOpen`.
 	account, err := m.cfg.Store.Account(traderKey)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if account.State != StateOpen {
-		return nil, nil, fmt.Errorf("account must be in %v to be "+
+		return nil, nil, nil, fmt.Errorf("account must be in %v to be "+
 			"modified", StateOpen)
 	}
 
@@ -1179,7 +1483,7 @@ func (m *manager) WithdrawAccount(ctx context.Context,
 		// Validate the new expiry.
 		err := validateAccountExpiry(expiryHeight, bestHeight)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		newExpiry = &expiryHeight
 	}
@@ -1193,20 +1497,32 @@ func (m *manager) WithdrawAccount(ctx context.Context,
 		account, outputs, multiSigWitness, feeRate,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	newAccountOutput, modifiers, err := createNewAccountOutput(
 		account, newAccountValue, newExpiry,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	allOutputs := []*wire.TxOut{newAccountOutput}
 	allOutputs = append(allOutputs, outputs...)
 	packet, err := m.createSpendTx(account, allOutputs)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	if dryRun {
+		result, err := newDryRun(
+			account, packet, multiSigWitness, false, bestHeight,
+			limit,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return account.Copy(modifiers...), result.Tx, result, nil
 	}
 
 	// With the output created, we'll tack on an additional
@@ -1214,42 +1530,43 @@ func (m *manager) WithdrawAccount(ctx context.Context,
 	// rest of the flow. This should request a signature from the auctioneer
 	// and assuming it's valid, broadcast the withdrawal transaction.
 	modifiers = append(modifiers, StateModifier(StatePendingUpdate))
-	modifiedAccount, spendPkg, err := m.spendAccount(
+	modifiedAccount, spendPkg, err := m.spendAccountWith(
 		ctx, account, packet, multiSigWitness, modifiers, false,
-		bestHeight,
+		bestHeight, limit, updateAccount,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return modifiedAccount, spendPkg.tx, nil
+	return modifiedAccount, spendPkg.tx, nil, nil
 }
 
 // RenewAccount updates the expiration of an open/expired account. This will
 // always require a signature from the auctioneer, even after the account has
-// expired, to ensure the auctioneer is aware the account is being renewed.
+// expired, to ensure the auctioneer is aware the account is being renewed. If
+// dryRun is set, the renewal transaction is only created and returned unsigned
+// along with the account as it would be after the renewal.
 func (m *manager) RenewAccount(ctx context.Context,
 	traderKey *btcec.PublicKey, newExpiry uint32,
-	feeRate chainfee.SatPerKWeight, bestHeight uint32) (*Account,
-	*wire.MsgTx, error) {
+	feeRate chainfee.SatPerKWeight, bestHeight uint32,
+	dryRun bool) (*Account, *wire.MsgTx, *DryRun, error) {
 
 	// The account can only have its expiry updated if it has confirmed
 	// and/or has expired.
 	account, err := m.cfg.Store.Account(traderKey)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	switch account.State {
 	case StateOpen, StateExpired:
 	default:
-		return nil, nil, fmt.Errorf("account must be in either of %v "+
-			"to be renewed",
-			[]State{StateOpen, StateExpired})
+		return nil, nil, nil, fmt.Errorf("account must be in either "+
+			"of %v to be renewed", []State{StateOpen, StateExpired})
 	}
 
 	// Validate the new expiry.
 	if err := validateAccountExpiry(newExpiry, bestHeight); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Determine the new account output after attempting the expiry update.
@@ -1257,18 +1574,30 @@ func (m *manager) RenewAccount(ctx context.Context,
 		account, nil, multiSigWitness, feeRate,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	newAccountOutput, modifiers, err := createNewAccountOutput(
 		account, newAccountValue, &newExpiry,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	packet, err := m.createSpendTx(account, []*wire.TxOut{newAccountOutput})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	if dryRun {
+		result, err := newDryRun(
+			account, packet, multiSigWitness, false, bestHeight,
+			nil,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return account.Copy(modifiers...), result.Tx, result, nil
 	}
 
 	// With the output created, we'll tack on an additional
@@ -1278,17 +1607,17 @@ func (m *manager) RenewAccount(ctx context.Context,
 	modifiers = append(modifiers, StateModifier(StatePendingUpdate))
 	modifiedAccount, spendPkg, err := m.spendAccount(
 		ctx, account, packet, multiSigWitness, modifiers, false,
-		bestHeight,
+		bestHeight, nil,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Begin to track the new account expiration, which will overwrite the
 	// existing expiration request.
 	m.watcherCtrl.WatchAccountExpiration(traderKey, modifiedAccount.Expiry)
 
-	return modifiedAccount, spendPkg.tx, nil
+	return modifiedAccount, spendPkg.tx, nil, nil
 }
 
 // BumpAccountFee attempts to bump the fee of an account's most recent
@@ -1305,9 +1634,13 @@ func (m *manager) BumpAccountFee(ctx context.Context,
 	}
 
 	// Only accounts in pending states can have their transaction fees
-	// bumped.
+	// bumped. The transaction of an account that participated in a batch
+	// is the batch transaction, which is under the auctioneer's control.
 	switch account.State {
 	case StatePendingOpen, StatePendingUpdate, StatePendingClosed:
+	case StatePendingBatch:
+		return errors.New("cannot bump fee for account that is part " +
+			"of a pending batch")
 	default:
 		return fmt.Errorf("cannot bump fee for account in state %v",
 			account.State)
@@ -1339,9 +1672,16 @@ func (m *manager) BumpAccountFee(ctx context.Context,
 			return err
 		}
 
-		// Once we've found an eligible output, we can return.
+		// Once we've found an eligible output, we record the attempt
+		// and return.
 		log.Infof("Found eligible output %v for CPFP of account %x",
 			op, traderKey.SerializeCompressed())
+
+		err = m.cfg.Store.RecordFeeBump(traderKey, newFeeRate)
+		if err != nil {
+			return fmt.Errorf("unable to record fee bump: %v", err)
+		}
+
 		return nil
 	}
 
@@ -1353,19 +1693,22 @@ func (m *manager) BumpAccountFee(ctx context.Context,
 // CloseAccount attempts to close the account associated with the given trader
 // key. Closing the account requires a signature of the auctioneer if the
 // account has not yet expired. The account funds are swept according to the
-// provided fee expression.
+// provided fee expression. If a fee limit is given, the account isn't closed if
+// the closing transaction would exceed it. If dryRun is set, the closing
+// transaction is only created and returned unsigned.
 func (m *manager) CloseAccount(ctx context.Context, traderKey *btcec.PublicKey,
-	feeExpr FeeExpr, bestHeight uint32) (*wire.MsgTx, error) {
+	feeExpr FeeExpr, bestHeight uint32, limit *FeeLimit,
+	dryRun bool) (*wire.MsgTx, *DryRun, error) {
 
 	account, err := m.cfg.Store.Account(traderKey)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Make sure the account hasn't already been closed, or is in the
 	// process of doing so.
 	if account.State == StatePendingClosed || account.State == StateClosed {
-		return nil, errors.New("account has already been closed")
+		return nil, nil, errors.New("account has already been closed")
 	}
 
 	// Determine the appropriate witness type for the account input based on
@@ -1382,21 +1725,34 @@ func (m *manager) CloseAccount(ctx context.Context, traderKey *btcec.PublicKey,
 		changeType := walletrpc.AddressType_WITNESS_PUBKEY_HASH
 		addr, err := m.cfg.Wallet.NextAddr(ctx, "", changeType, false)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		feeExpr.PkScript, err = txscript.PayToAddrScript(addr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	closeOutputs, err := feeExpr.CloseOutputs(account.Value, witnessType)
+	closeOutputs, err := feeExpr.CloseOutputs(
+		account.Value, witnessType, account.Version,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	packet, err := m.createSpendTx(account, closeOutputs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if dryRun {
+		result, err := newDryRun(
+			account, packet, witnessType, true, bestHeight, limit,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return result.Tx, result, nil
 	}
 
 	// Proceed to create the closing transaction and perform any operations
@@ -1406,13 +1762,80 @@ func (m *manager) CloseAccount(ctx context.Context, traderKey *btcec.PublicKey,
 	}
 	_, spendPkg, err := m.spendAccount(
 		ctx, account, packet, witnessType, modifiers, true,
-		bestHeight,
+		bestHeight, limit,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return spendPkg.tx, nil, nil
+}
+
+// WithdrawAndClose closes the account associated with the given trader key by
+// withdrawing to the given outputs and sending the remaining balance, minus
+// fees, to the backing lnd node's wallet. This is done in a single closing
+// transaction. The account must not have any active orders and no batch must
+// be pending.
+func (m *manager) WithdrawAndClose(ctx context.Context,
+	traderKey *btcec.PublicKey, outputs []*wire.TxOut,
+	feeRate chainfee.SatPerKWeight, bestHeight uint32) (*wire.MsgTx, error) {
+
+	if len(outputs) == 0 {
+		return nil, errors.New("missing outputs for withdrawal")
+	}
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+	switch account.State {
+	case StatePendingClosed, StateClosed:
+		return nil, errors.New("account has already been closed")
+
+	case StatePendingBatch:
+		return nil, errors.New("cannot close account that is part of " +
+			"a pending batch")
+	}
+
+	// A pending batch might still spend the account, so we can't close it
+	// before the batch is resolved.
+	pending, err := m.hasPendingBatch()
 	if err != nil {
 		return nil, err
 	}
+	if pending {
+		return nil, errors.New("cannot close account while a batch is " +
+			"pending")
+	}
 
-	return spendPkg.tx, nil
+	// Orders of a closed account would dangle in the auctioneer's order
+	// book, so they need to be canceled first.
+	hasActiveOrders, err := m.cfg.HasActiveOrders(ctx, traderKey)
+	if err != nil {
+		return nil, err
+	}
+	if hasActiveOrders {
+		return nil, fmt.Errorf("account %x has active orders, cancel "+
+			"them before closing", traderKey.SerializeCompressed())
+	}
+
+	changeType := walletrpc.AddressType_WITNESS_PUBKEY_HASH
+	addr, err := m.cfg.Wallet.NextAddr(ctx, "", changeType, false)
+	if err != nil {
+		return nil, err
+	}
+	changePkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	closeTx, _, err := m.CloseAccount(ctx, traderKey, &OutputsWithChange{
+		Outputs:        outputs,
+		ChangePkScript: changePkScript,
+		FeeRate:        feeRate,
+	}, bestHeight, nil, false)
+
+	return closeTx, err
 }
 
 // spendAccount houses most of the logic required to properly spend an account
@@ -1424,30 +1847,31 @@ func (m *manager) CloseAccount(ctx context.Context, traderKey *btcec.PublicKey,
 // shutdown mid-process.
 func (m *manager) spendAccount(ctx context.Context, account *Account,
 	packet *psbt.Packet, witnessType witnessType, modifiers []Modifier,
-	isClose bool, bestHeight uint32) (*Account, *spendPackage, error) {
-
-	var lockTime uint32
-	switch witnessType {
-	case expiryWitness:
-		if !isClose {
-			return nil, nil, errors.New("modifications for " +
-				"expired accounts are not currently supported")
-		}
+	isClose bool, bestHeight uint32, limit *FeeLimit) (*Account,
+	*spendPackage, error) {
 
-		lockTime = bestHeight
+	return m.spendAccountWith(
+		ctx, account, packet, witnessType, modifiers, isClose,
+		bestHeight, limit, m.cfg.Store.UpdateAccount,
+	)
+}
 
-	case multiSigWitness:
-		lockTime = 0
+// spendAccountWith spends an account like spendAccount but persists the
+// modified account with the given updater.
+func (m *manager) spendAccountWith(ctx context.Context, account *Account,
+	packet *psbt.Packet, witnessType witnessType, modifiers []Modifier,
+	isClose bool, bestHeight uint32, limit *FeeLimit,
+	updateAccount accountUpdater) (*Account, *spendPackage, error) {
 
-	default:
-		return nil, nil, fmt.Errorf("unhandled witness type: %v",
-			witnessType)
+	lockTime, err := spendLockTime(witnessType, isClose, bestHeight)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Create the spending transaction of an account based on the provided
 	// witness type.
 	spendPkg, err := m.signSpendTx(
-		ctx, account, packet, lockTime, witnessType,
+		ctx, account, packet, lockTime, witnessType, limit,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1494,7 +1918,7 @@ func (m *manager) spendAccount(ctx context.Context, account *Account,
 	}
 
 	prevAccountState := account.Copy()
-	if err := m.cfg.Store.UpdateAccount(account, modifiers...); err != nil {
+	if err := updateAccount(account, modifiers...); err != nil {
 		return nil, nil, err
 	}
 
@@ -1515,6 +1939,29 @@ func (m *manager) spendAccount(ctx context.Context, account *Account,
 	return account, spendPkg, nil
 }
 
+// spendLockTime returns the lock time of an account's spending transaction
+// that uses the given witness type. Only closing transactions can take the
+// expiry path, which requires the best height as the lock time.
+func spendLockTime(witnessType witnessType, isClose bool,
+	bestHeight uint32) (uint32, error) {
+
+	switch witnessType {
+	case expiryWitness:
+		if !isClose {
+			return 0, errors.New("modifications for expired " +
+				"accounts are not currently supported")
+		}
+
+		return bestHeight, nil
+
+	case multiSigWitness:
+		return 0, nil
+
+	default:
+		return 0, fmt.Errorf("unhandled witness type: %v", witnessType)
+	}
+}
+
 // RecoverAccount re-introduces a recovered account into the database and starts
 // all watchers necessary depending on the account's state.
 func (m *manager) RecoverAccount(ctx context.Context, account *Account) error {
@@ -1544,7 +1991,7 @@ func (m *manager) RecoverAccount(ctx context.Context, account *Account) error {
 	// the opening transaction in some cases which we don't want. Instead we
 	// set the `onRecovery` flag to true. We won't send to the account
 	// output again, so we don't need to set a valid funding freeRate.
-	return m.resumeAccount(ctx, account, false, true, 0)
+	return m.resumeAccount(ctx, account, false, true, 0, nil, nil)
 }
 
 // determineWitnessType determines the appropriate witness type to use for the
@@ -1563,18 +2010,18 @@ func (m *manager) constructMultiSigWitness(ctx context.Context,
 	account *Account, spendPkg *spendPackage, modifiers []Modifier,
 	isClose bool) (wire.TxWitness, error) {
 
-	var (
-		auctioneerSig []byte
-		err           error
-	)
+	modifyAccount := func(traderNonces []byte,
+		prevOutputs []*wire.TxOut) ([]byte, []byte, error) {
 
-	if isClose {
 		// If the account is being closed, we shouldn't provide any
 		// modifiers.
-		auctioneerSig, err = m.cfg.Auctioneer.ModifyAccount(
-			ctx, account, nil, spendPkg.tx.TxOut, nil,
-		)
-	} else {
+		if isClose {
+			return m.cfg.Auctioneer.ModifyAccount(
+				ctx, account, nil, spendPkg.tx.TxOut, nil,
+				traderNonces, prevOutputs,
+			)
+		}
+
 		// Otherwise, the account output is being re-created due to a
 		// modification, so we need to filter out its spent input and
 		// re-created output from the spending transaction as the
@@ -1589,10 +2036,21 @@ func (m *manager) constructMultiSigWitness(ctx context.Context,
 		outputs = append(outputs, spendPkg.tx.TxOut[:outputIdx]...)
 		outputs = append(outputs, spendPkg.tx.TxOut[outputIdx+1:]...)
 
-		auctioneerSig, err = m.cfg.Auctioneer.ModifyAccount(
-			ctx, account, inputs, outputs, modifiers,
+		return m.cfg.Auctioneer.ModifyAccount(
+			ctx, account, inputs, outputs, modifiers, traderNonces,
+			prevOutputs,
 		)
 	}
+
+	// Taproot accounts are spent with a MuSig2 signature that requires an
+	// additional round of nonce exchange.
+	if account.Version == VersionTaprootEnabled {
+		return m.signTaprootMultiSig(
+			ctx, account, spendPkg, modifyAccount,
+		)
+	}
+
+	auctioneerSig, _, err := modifyAccount(nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1633,8 +2091,8 @@ func (m *manager) createSpendTx(account *Account,
 // the lock time of the transaction, otherwise it is 0. The transaction has its
 // inputs and outputs sorted according to BIP-69.
 func (m *manager) signSpendTx(ctx context.Context, account *Account,
-	packet *psbt.Packet, lockTime uint32,
-	witnessType witnessType) (*spendPackage, error) {
+	packet *psbt.Packet, lockTime uint32, witnessType witnessType,
+	limit *FeeLimit) (*spendPackage, error) {
 
 	tx := packet.UnsignedTx
 
@@ -1645,7 +2103,7 @@ func (m *manager) signSpendTx(ctx context.Context, account *Account,
 
 	// Ensure the transaction crafted passes some basic sanity checks before
 	// we attempt to sign it.
-	err := sanityCheckAccountSpendTx(account, packet, witnessType)
+	err := sanityCheckAccountSpendTx(account, packet, witnessType, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1664,36 +2122,55 @@ func (m *manager) signSpendTx(ctx context.Context, account *Account,
 		return nil, err
 	}
 
-	// Let the wallet sign each input. This will add a partial signature for
-	// the account input which we'll later turn into the correct witness
-	// depending on the spend path.
-	signedPacket, err := m.cfg.Wallet.SignPsbt(ctx, packet)
-	if err != nil {
-		return nil, err
-	}
+	var (
+		signedPacket  *psbt.Packet
+		witnessScript []byte
+		ourSig        []byte
+		prevOutputs   []*wire.TxOut
+		witness       wire.TxWitness
+	)
+	if account.Version == VersionTaprootEnabled {
+		signedPacket, prevOutputs, witness, err = m.signTaprootSpendTx(
+			ctx, account, packet, accountInputIdx, witnessType,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Let the wallet sign each input. This will add a partial
+		// signature for the account input which we'll later turn into
+		// the correct witness depending on the spend path.
+		signedPacket, err = m.cfg.Wallet.SignPsbt(ctx, packet)
+		if err != nil {
+			return nil, err
+		}
 
-	pIn := &signedPacket.Inputs[accountInputIdx]
-	witnessScript := pIn.WitnessScript
+		pIn := &signedPacket.Inputs[accountInputIdx]
+		witnessScript = pIn.WitnessScript
 
-	if len(pIn.PartialSigs) != 1 {
-		return nil, fmt.Errorf("unexpected number of signatures in "+
-			"signed PSBT, got %d wanted 1", len(pIn.PartialSigs))
-	}
+		if len(pIn.PartialSigs) != 1 {
+			return nil, fmt.Errorf("unexpected number of "+
+				"signatures in signed PSBT, got %d wanted 1",
+				len(pIn.PartialSigs))
+		}
 
-	ourSig := pIn.PartialSigs[0].Signature
+		ourSig = pIn.PartialSigs[0].Signature
 
-	// We temporarily set the final witness to the partial sig to allow the
-	// extraction of the final TX. Unless we're using the expiry path in
-	// which case we _can_ create the full and final witness.
-	switch witnessType {
-	case expiryWitness:
-		pIn.FinalScriptWitness, err = serializeWitness(
-			poolscript.SpendExpiry(witnessScript, ourSig),
-		)
+		// We temporarily set the final witness to the partial sig to
+		// allow the extraction of the final TX. Unless we're using the
+		// expiry path in which case we _can_ create the full and final
+		// witness.
+		switch witnessType {
+		case expiryWitness:
+			witness = poolscript.SpendExpiry(witnessScript, ourSig)
 
-	default:
-		pIn.FinalScriptWitness, err = serializeWitness([][]byte{ourSig})
+		default:
+			witness = wire.TxWitness{ourSig}
+		}
 	}
+
+	pIn := &signedPacket.Inputs[accountInputIdx]
+	pIn.FinalScriptWitness, err = serializeWitness(witness)
 	if err != nil {
 		return nil, fmt.Errorf("error serializing witness: %v",
 			err)
@@ -1733,23 +2210,78 @@ func (m *manager) signSpendTx(ctx context.Context, account *Account,
 		accountInputIdx: accountInputIdx,
 		witnessScript:   witnessScript,
 		ourSig:          ourSig,
+		prevOutputs:     prevOutputs,
 	}, nil
 }
 
+// signTaprootSpendTx signs the spending transaction of a taproot account. The
+// wallet can't sign for the account input, so it only signs any additional
+// wallet inputs and we sign the account input ourselves. If the spend takes
+// the expiry path, the full witness is returned. Otherwise a placeholder
+// witness of the correct size is returned that needs to be replaced with the
+// MuSig2 signature.
+func (m *manager) signTaprootSpendTx(ctx context.Context, account *Account,
+	packet *psbt.Packet, accountInputIdx int,
+	witnessType witnessType) (*psbt.Packet, []*wire.TxOut, wire.TxWitness,
+	error) {
+
+	prevOutputs := make([]*wire.TxOut, len(packet.Inputs))
+	for idx, pIn := range packet.Inputs {
+		if pIn.WitnessUtxo == nil {
+			return nil, nil, nil, fmt.Errorf("input %d is missing "+
+				"witness UTXO", idx)
+		}
+		prevOutputs[idx] = pIn.WitnessUtxo
+	}
+
+	signedPacket := packet
+	if len(packet.Inputs) > 1 {
+		var err error
+		signedPacket, err = m.cfg.Wallet.SignPsbt(ctx, packet)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	switch witnessType {
+	case expiryWitness:
+		witness, err := m.signTaprootExpiry(
+			ctx, account, packet.UnsignedTx, prevOutputs,
+			accountInputIdx,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return signedPacket, prevOutputs, witness, nil
+
+	default:
+		placeholderSig := make([]byte, schnorr.SignatureSize)
+		return signedPacket, prevOutputs, wire.TxWitness{
+			placeholderSig,
+		}, nil
+	}
+}
+
 // addBaseAccountModificationWeight adds the estimated weight units for a
-// transaction that modifies an account by spending the current account input
-// and creating the new account output according to the provided `witnessType`.
+// transaction that modifies an account of the given version by spending the
+// current account input and creating the new account output according to the
+// provided `witnessType`.
 func addBaseAccountModificationWeight(weightEstimator *input.TxWeightEstimator,
-	witnessType witnessType) error {
+	witnessType witnessType, version Version) error {
 
-	witnessSize, err := witnessType.witnessSize()
+	witnessSize, err := witnessType.witnessSize(version)
 	if err != nil {
 		return err
 	}
 
 	weightEstimator.AddWitnessInput(witnessSize)
 
-	weightEstimator.AddP2WSHOutput()
+	if version == VersionTaprootEnabled {
+		weightEstimator.AddP2TROutput()
+	} else {
+		weightEstimator.AddP2WSHOutput()
+	}
 
 	return nil
 }
@@ -1768,7 +2300,9 @@ func valueAfterAccountUpdate(account *Account, outputs []*wire.TxOut,
 	// account output that we're spending, and the new account output being
 	// created.
 	var weightEstimator input.TxWeightEstimator
-	err := addBaseAccountModificationWeight(&weightEstimator, witnessType)
+	err := addBaseAccountModificationWeight(
+		&weightEstimator, witnessType, account.Version,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -1817,20 +2351,21 @@ func valueAfterAccountUpdate(account *Account, outputs []*wire.TxOut,
 // wallet which we can use to satisfy an account deposit. A closure to release
 // the inputs is also provided to use when coming across an unexpected failure.
 // If needed, a change output from the backing lnd node's wallet may be returned
-// as well.
+// as well. If a coin selection is given, only the selected inputs are used.
 func (m *manager) inputsForDeposit(ctx context.Context, account *Account,
 	newAccountOutput *wire.TxOut, depositAmount btcutil.Amount,
-	witnessType witnessType, feeRate chainfee.SatPerKWeight) (*psbt.Packet,
-	func(), error) {
+	witnessType witnessType, feeRate chainfee.SatPerKWeight,
+	coins *CoinSelection) (*psbt.Packet, func(), error) {
 
 	// Unfortunately the FundPsbt call doesn't allow us to specify _any_
 	// inputs, otherwise it won't perform coin selection at all. So what we
 	// do instead is to fund our account output just for the funding amount
 	// plus whatever we need to pay for the additional input (which we know
 	// exactly how big it will be). Then we add the account input and its
-	// value to the account output.
+	// value to the account output. If the user selected the inputs to use,
+	// we add those to the template, so lnd only adds a change output.
 	var acctInputEstimator input.TxWeightEstimator
-	witnessSize, err := witnessType.witnessSize()
+	witnessSize, err := witnessType.witnessSize(account.Version)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1842,47 +2377,11 @@ func (m *manager) inputsForDeposit(ctx context.Context, account *Account,
 		Value:    int64(depositAmount + acctInputFee),
 		PkScript: newAccountOutput.PkScript,
 	}
-	tplPacket, err := psbt.New(nil, []*wire.TxOut{outputToFund}, 2, 0, nil)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error creating template PSBT: %v",
-			err)
-	}
-
-	var tplBytes bytes.Buffer
-	if err := tplPacket.Serialize(&tplBytes); err != nil {
-		return nil, nil, fmt.Errorf("error serializing template PSBT: "+
-			"%v", err)
-	}
-
-	packet, changeOutputIdx, lockedCoins, err := m.cfg.Wallet.FundPsbt(
-		ctx, &walletrpc.FundPsbtRequest{
-			Template: &walletrpc.FundPsbtRequest_Psbt{
-				Psbt: tplBytes.Bytes(),
-			},
-			MinConfs: 1,
-			Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
-				SatPerVbyte: uint64(
-					feeRate.FeePerKVByte() / 1000,
-				),
-			},
-		},
+	packet, changeOutputIdx, releaseInputs, err := m.fundPsbt(
+		ctx, []*wire.TxOut{outputToFund}, coins, feeRate,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error funding PSBT: %v", err)
-	}
-
-	releaseInputs := func() {
-		for _, coin := range lockedCoins {
-			var lockID wtxmgr.LockID
-			copy(lockID[:], coin.Id)
-
-			hash, _ := chainhash.NewHash(coin.Outpoint.TxidBytes)
-			op := wire.OutPoint{
-				Hash:  *hash,
-				Index: coin.Outpoint.OutputIndex,
-			}
-			_ = m.cfg.Wallet.ReleaseOutput(ctx, lockID, op)
-		}
+		return nil, nil, err
 	}
 
 	// Due to a bug in lnd 0.14.2 up to 0.15.0 we can't use SignPsbt for
@@ -1985,9 +2484,9 @@ func createNewAccountOutput(account *Account, newAccountValue btcutil.Amount,
 
 // sanityCheckAccountSpendTx ensures that the spending transaction of an account
 // is well-formed by performing various sanity checks on its inputs and outputs.
-// It returns the total amount of fees in satoshis paid by the transaction.
+// If a fee limit is given, the fee paid by the transaction must not exceed it.
 func sanityCheckAccountSpendTx(account *Account, packet *psbt.Packet,
-	witnessType witnessType) error {
+	witnessType witnessType, limit *FeeLimit) error {
 
 	tx := packet.UnsignedTx
 	err := blockchain.CheckTransactionSanity(btcutil.NewTx(tx))
@@ -2004,63 +2503,66 @@ func sanityCheckAccountSpendTx(account *Account, packet *psbt.Packet,
 
 	// CheckTransactionSanity doesn't have enough context to attempt fee
 	// calculation, but we do.
+	inputTotal, outputTotal, fullWeight, err := accountSpendFee(
+		account, packet, witnessType,
+	)
+	if err != nil {
+		return err
+	}
+
+	if inputTotal < outputTotal {
+		return fmt.Errorf("output value of %v exceeds input value "+
+			"of %v", outputTotal, inputTotal)
+	}
+
+	feesPaid := inputTotal - outputTotal
+	minRelayFee := chainfee.FeePerKwFloor.FeeForWeight(fullWeight)
+	if feesPaid < minRelayFee {
+		return fmt.Errorf("signed transaction only pays %d sats "+
+			"in fees while %d are required for relay", feesPaid,
+			minRelayFee)
+	}
+
+	return limit.checkFee(feesPaid, fullWeight)
+}
+
+// accountSpendFee returns the total input and output value of the given
+// spending transaction of an account along with its estimated weight once all
+// inputs are signed.
+func accountSpendFee(account *Account, packet *psbt.Packet,
+	witnessType witnessType) (btcutil.Amount, btcutil.Amount, int64,
+	error) {
+
 	var (
 		inputTotal, outputTotal btcutil.Amount
 		witnessSize             int64
 	)
-	for idx, inp := range tx.TxIn {
+	for idx, inp := range packet.UnsignedTx.TxIn {
 		pIn := packet.Inputs[idx]
 		if inp.PreviousOutPoint == account.OutPoint {
 			inputTotal += account.Value
 
-			acctWitnessSize, err := witnessType.witnessSize()
+			acctWitnessSize, err := witnessType.witnessSize(
+				account.Version,
+			)
 			if err != nil {
-				return err
+				return 0, 0, 0, err
 			}
 			witnessSize += int64(acctWitnessSize)
 		} else {
-			utxo := pIn.WitnessUtxo
-			inputTotal += btcutil.Amount(utxo.Value)
+			inputTotal += btcutil.Amount(pIn.WitnessUtxo.Value)
 
-			pkScript, err := txscript.ParsePkScript(utxo.PkScript)
+			inputWitnessSize, err := walletInputWitnessSize(pIn)
 			if err != nil {
-				return err
-			}
-
-			switch pkScript.Class() {
-			case txscript.WitnessV0PubKeyHashTy:
-				witnessSize += input.P2WKHWitnessSize
-
-			case txscript.ScriptHashTy:
-				witnessSize += input.P2WKHWitnessSize
-
-				// The redeem script counts 4 times because
-				// that's not include in the witness.
-				witnessSize += int64(
-					blockchain.WitnessScaleFactor *
-						len(pIn.RedeemScript),
-				)
-
-			case txscript.WitnessV1TaprootTy:
-				witnessSize += 1 + 1 + schnorr.SignatureSize
-
-			default:
-				return fmt.Errorf("unsupported deposit input "+
-					"of class <%s>", pkScript.Class())
+				return 0, 0, 0, err
 			}
+			witnessSize += inputWitnessSize
 		}
 	}
-	for _, output := range tx.TxOut {
+	for _, output := range packet.UnsignedTx.TxOut {
 		outputTotal += btcutil.Amount(output.Value)
 	}
 
-	if inputTotal < outputTotal {
-		return fmt.Errorf("output value of %v exceeds input value "+
-			"of %v", outputTotal, inputTotal)
-	}
-
-	feesPaid := inputTotal - outputTotal
-
 	// The unsigned TX within the package doesn't have any witness set.
 	// We'll add the witness weight manually in the next step. Fortunately
 	// with the PSBT funding
@@ -2073,14 +2575,37 @@ func sanityCheckAccountSpendTx(account *Account, packet *psbt.Packet,
 	// flag fields that weren't counted above because the unsigned TX has no
 	// witness.
 	fullWeight := txWeightNoWitness + 2 + witnessSize
-	minRelayFee := chainfee.FeePerKwFloor.FeeForWeight(fullWeight)
-	if feesPaid < minRelayFee {
-		return fmt.Errorf("signed transaction only pays %d sats "+
-			"in fees while %d are required for relay", feesPaid,
-			minRelayFee)
+
+	return inputTotal, outputTotal, fullWeight, nil
+}
+
+// walletInputWitnessSize returns the estimated size of the witness that spends
+// the given input of the backing lnd node's wallet. The redeem script of a
+// nested SegWit input is added with its full weight.
+func walletInputWitnessSize(pIn psbt.PInput) (int64, error) {
+	pkScript, err := txscript.ParsePkScript(pIn.WitnessUtxo.PkScript)
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	switch pkScript.Class() {
+	case txscript.WitnessV0PubKeyHashTy:
+		return input.P2WKHWitnessSize, nil
+
+	case txscript.ScriptHashTy:
+		// The redeem script counts 4 times because that's not include
+		// in the witness.
+		return input.P2WKHWitnessSize + int64(
+			blockchain.WitnessScaleFactor*len(pIn.RedeemScript),
+		), nil
+
+	case txscript.WitnessV1TaprootTy:
+		return 1 + 1 + schnorr.SignatureSize, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported deposit input of class <%s>",
+			pkScript.Class())
+	}
 }
 
 // locateAccountInput locates the index of the account input in the provided
@@ -2100,6 +2625,21 @@ func locateAccountInput(tx *wire.MsgTx, account *Account) (int, error) {
 func (m *manager) decorateAccountInput(account *Account, packet *psbt.Packet,
 	idx int) error {
 
+	accountOutput, err := account.Output()
+	if err != nil {
+		return err
+	}
+
+	pIn := &packet.Inputs[idx]
+	pIn.WitnessUtxo = accountOutput
+	pIn.SighashType = sigHashForScript(accountOutput.PkScript)
+
+	// The wallet can't sign for taproot accounts, so we don't add any
+	// information that would make it try.
+	if account.Version == VersionTaprootEnabled {
+		return nil
+	}
+
 	traderKeyTweak := poolscript.TraderKeyTweak(
 		account.BatchKey, account.Secret, account.TraderKey.PubKey,
 	)
@@ -2111,14 +2651,6 @@ func (m *manager) decorateAccountInput(account *Account, packet *psbt.Packet,
 		return err
 	}
 
-	accountOutput, err := account.Output()
-	if err != nil {
-		return err
-	}
-
-	pIn := &packet.Inputs[idx]
-	pIn.WitnessUtxo = accountOutput
-	pIn.SighashType = sigHashForScript(accountOutput.PkScript)
 	pIn.WitnessScript = witnessScript
 	pIn.Bip32Derivation = []*psbt.Bip32Derivation{{
 		Bip32Path: []uint32{
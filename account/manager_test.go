@@ -18,8 +18,12 @@ import (
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -51,6 +55,9 @@ type testHarness struct {
 	wallet     *mockWallet
 	auctioneer *mockAuctioneer
 	manager    Manager
+
+	// hasActiveOrders is returned by the manager's active order check.
+	hasActiveOrders bool
 }
 
 func newTestHarness(t *testing.T) *testHarness {
@@ -59,28 +66,37 @@ func newTestHarness(t *testing.T) *testHarness {
 	notifier := newMockChainNotifier()
 	auctioneer := newMockAuctioneer()
 
-	return &testHarness{
+	h := &testHarness{
 		t:          t,
 		store:      store,
 		wallet:     wallet,
 		notifier:   notifier,
 		auctioneer: auctioneer,
-		manager: NewManager(&ManagerConfig{
-			Store:          store,
-			Auctioneer:     auctioneer,
-			Wallet:         wallet,
-			Signer:         wallet,
-			ChainNotifier:  notifier,
-			TxSource:       wallet,
-			TxFeeEstimator: wallet,
-			ChainParams:    &chaincfg.TestNet3Params,
-			LndVersion: &verrpc.Version{
-				AppMajor: 0,
-				AppMinor: 15,
-				AppPatch: 1,
-			},
-		}),
 	}
+	h.manager = NewManager(&ManagerConfig{
+		Store:          store,
+		Auctioneer:     auctioneer,
+		Wallet:         wallet,
+		Signer:         wallet,
+		ChainNotifier:  notifier,
+		TxSource:       wallet,
+		TxFeeEstimator: wallet,
+		ChainParams:    &chaincfg.TestNet3Params,
+		LndVersion: &verrpc.Version{
+			AppMajor: 0,
+			AppMinor: 15,
+			AppPatch: 1,
+		},
+		AutoRenewMaxFeeRate:   chainfee.FeePerKwFloor,
+		AutoRenewExpiryBlocks: minAccountExpiry * 2,
+		HasActiveOrders: func(context.Context,
+			*btcec.PublicKey) (bool, error) {
+
+			return h.hasActiveOrders, nil
+		},
+	})
+
+	return h
 }
 
 func (h *testHarness) start() {
@@ -150,7 +166,8 @@ func (h *testHarness) openAccount(value btcutil.Amount, expiry uint32, // nolint
 	// Create a new account. Its initial state should be StatePendingOpen.
 	ctx := context.Background()
 	account, err := h.manager.InitAccount(
-		ctx, value, chainfee.FeePerKwFloor, expiry, bestHeight,
+		ctx, value, chainfee.FeePerKwFloor, expiry, bestHeight, nil,
+		nil,
 	)
 	if err != nil {
 		h.t.Fatalf("unable to create new account: %v", err)
@@ -197,9 +214,9 @@ func (h *testHarness) closeAccount(account *Account, feeExpr FeeExpr,
 
 	// Close the account with the auctioneer.
 	go func() {
-		_, err := h.manager.CloseAccount(
+		_, _, err := h.manager.CloseAccount(
 			context.Background(), account.TraderKey.PubKey, feeExpr,
-			bestHeight,
+			bestHeight, nil, false,
 		)
 		if err != nil {
 			h.t.Logf("unable to close account: %v", err)
@@ -473,6 +490,8 @@ func (h *testHarness) restartManager() {
 		TxSource:      mgr.cfg.TxSource,
 		ChainParams:   mgr.cfg.ChainParams,
 		LndVersion:    mgr.cfg.LndVersion,
+
+		HasActiveOrders: mgr.cfg.HasActiveOrders,
 	})
 
 	if err := h.manager.Start(); err != nil {
@@ -539,7 +558,7 @@ func TestResumeAccountAfterRestart(t *testing.T) {
 	go func() {
 		_, _ = h.manager.InitAccount(
 			context.Background(), value, chainfee.FeePerKwFloor, expiry,
-			bestHeight,
+			bestHeight, nil, nil,
 		)
 	}()
 
@@ -559,6 +578,7 @@ func TestResumeAccountAfterRestart(t *testing.T) {
 		Secret:        sharedSecret,
 		HeightHint:    bestHeight,
 		State:         StateInitiated,
+		Reserve:       DefaultReserve(chainfee.FeePerKwFloor),
 	}
 	h.assertAccountExists(account)
 
@@ -712,9 +732,9 @@ func TestAccountClose(t *testing.T) {
 
 			// We'll immediately attempt to close the account with
 			// the test's fee expression.
-			_, err := h.manager.CloseAccount(
+			_, _, err := h.manager.CloseAccount(
 				context.Background(), account.TraderKey.PubKey,
-				testCase.feeExpr, bestHeight,
+				testCase.feeExpr, bestHeight, nil, false,
 			)
 
 			// If the test's fee expression is not valid, we should
@@ -792,6 +812,288 @@ func TestAccountExpiration(t *testing.T) {
 	h.expireAccount(account)
 }
 
+// TestAccountAutoRenew ensures that an account with automatic renewal enabled
+// is renewed once the best height comes within its renewal window, but only
+// after any pending batch was resolved.
+func TestAccountAutoRenew(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bestHeight    = 100
+		expiry        = bestHeight + minAccountExpiry
+		renewalWindow = 10
+	)
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(maxAccountValue, expiry, bestHeight)
+	traderKey := account.TraderKey.PubKey
+
+	// A renewal window that isn't smaller than the automatic renewal
+	// expiry would cause the account to be renewed over and over again.
+	_, err := h.manager.UpdateAutoRenew(
+		traderKey, true, minAccountExpiry*2,
+	)
+	require.ErrorContains(t, err, "renewal window must be smaller")
+
+	_, err = h.manager.UpdateAutoRenew(traderKey, true, renewalWindow)
+	require.NoError(t, err)
+
+	AutoRenewModifier(true, renewalWindow)(account)
+	h.assertAccountExists(account)
+
+	// While a batch is pending, the account must not be renewed even if it
+	// is within its renewal window.
+	windowHeight := uint32(expiry - renewalWindow)
+	h.store.setPendingBatch(func() error { return nil })
+	h.manager.(*manager).autoRenewAccounts(windowHeight)
+	h.assertAccountExists(account)
+
+	// Once the batch is resolved, the next block within the window should
+	// trigger the renewal.
+	h.store.setPendingBatch(nil)
+	h.notifier.blockChan <- int32(windowHeight)
+
+	select {
+	case <-h.wallet.publishChan:
+	case <-time.After(timeout):
+		t.Fatal("expected renewal transaction to be broadcast")
+	}
+
+	newExpiry := windowHeight + minAccountExpiry*2
+	err = wait.NoError(func() error {
+		renewed, err := h.store.Account(traderKey)
+		if err != nil {
+			return err
+		}
+
+		if renewed.State != StatePendingUpdate ||
+			renewed.Expiry != newExpiry {
+
+			return fmt.Errorf("account not renewed, state=%v "+
+				"expiry=%d", renewed.State, renewed.Expiry)
+		}
+
+		return nil
+	}, timeout)
+	require.NoError(t, err)
+}
+
+// TestScheduledWithdrawal ensures that a scheduled withdrawal is only executed
+// once the estimated fee rate drops to its maximum fee rate and no batch is
+// pending.
+func TestScheduledWithdrawal(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bestHeight = 100
+		maxFeeRate = chainfee.FeePerKwFloor * 2
+	)
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+	outputs := []*wire.TxOut{{
+		Value:    int64(account.Value / 2),
+		PkScript: p2wpkh,
+	}}
+
+	// Withdrawals without outputs or an expiry in the past are rejected.
+	expiry := time.Now().Add(time.Hour)
+	_, err := h.manager.ScheduleWithdraw(traderKey, nil, maxFeeRate, expiry)
+	require.ErrorContains(t, err, "missing outputs")
+	_, err = h.manager.ScheduleWithdraw(
+		traderKey, outputs, maxFeeRate, time.Now().Add(-time.Hour),
+	)
+	require.ErrorContains(t, err, "expiry must be in the future")
+
+	_, err = h.manager.ScheduleWithdraw(
+		traderKey, outputs, maxFeeRate, expiry,
+	)
+	require.NoError(t, err)
+
+	assertScheduled := func(expected int) {
+		t.Helper()
+
+		withdrawals, err := h.manager.ScheduledWithdrawals()
+		require.NoError(t, err)
+		require.Len(t, withdrawals, expected)
+	}
+	assertNotBroadcast := func() {
+		t.Helper()
+
+		select {
+		case tx := <-h.wallet.publishChan:
+			t.Fatalf("unexpected transaction broadcast: %v",
+				tx.TxHash())
+		default:
+		}
+	}
+
+	// As long as the fee estimate is above the maximum, nothing happens.
+	mgr := h.manager.(*manager)
+	h.wallet.setFeeRate(maxFeeRate + 1)
+	mgr.executeScheduledWithdrawals(bestHeight)
+	assertNotBroadcast()
+	assertScheduled(1)
+
+	// A pending batch defers the withdrawal even if the fee rate is low
+	// enough, but it must not be removed.
+	h.wallet.setFeeRate(maxFeeRate)
+	h.store.setPendingBatch(func() error { return nil })
+	mgr.executeScheduledWithdrawals(bestHeight)
+	assertNotBroadcast()
+	assertScheduled(1)
+
+	// Once the batch is resolved, the next block executes the withdrawal
+	// at the estimated fee rate.
+	h.store.setPendingBatch(nil)
+	h.notifier.blockChan <- bestHeight
+
+	// The withdrawal happens in the background, so we wait for it to be
+	// processed before we inspect it.
+	err = wait.NoError(func() error {
+		found, err := h.store.Account(traderKey)
+		if err != nil {
+			return err
+		}
+		if found.State != StatePendingUpdate {
+			return fmt.Errorf("unexpected state %v", found.State)
+		}
+		return nil
+	}, timeout)
+	require.NoError(t, err)
+
+	valueAfterWithdrawal, err := valueAfterAccountUpdate(
+		account, outputs, multiSigWitness, maxFeeRate,
+	)
+	require.NoError(t, err)
+	h.assertAccountModification(
+		account, nil, outputs, valueAfterWithdrawal, 0, 0, bestHeight,
+	)
+
+	err = wait.NoError(func() error {
+		withdrawals, err := h.manager.ScheduledWithdrawals()
+		if err != nil {
+			return err
+		}
+		if len(withdrawals) != 0 {
+			return errors.New("withdrawal not removed")
+		}
+		return nil
+	}, timeout)
+	require.NoError(t, err)
+
+	err = h.manager.CancelScheduledWithdraw(traderKey)
+	require.ErrorIs(t, err, ErrNoScheduledWithdrawal)
+
+	// A withdrawal from an account that can't be used anymore after a
+	// recovery is removed instead of being retried forever.
+	found, err := h.store.Account(traderKey)
+	require.NoError(t, err)
+	outputs[0].Value = int64(found.Value / 2)
+	_, err = h.manager.ScheduleWithdraw(
+		traderKey, outputs, maxFeeRate, expiry,
+	)
+	require.NoError(t, err)
+	assertScheduled(1)
+
+	err = h.store.UpdateAccount(
+		found, StateModifier(StateCanceledAfterRecovery),
+	)
+	require.NoError(t, err)
+
+	mgr.executeScheduledWithdrawals(bestHeight)
+	assertNotBroadcast()
+	assertScheduled(0)
+}
+
+// TestScheduledWithdrawalExecutedOnce makes sure a scheduled withdrawal is
+// removed in the same store update that marks its account as pending. If the
+// removal fails, nothing is broadcast, and once it succeeds, the withdrawal is
+// never executed a second time.
+func TestScheduledWithdrawalExecutedOnce(t *testing.T) {
+	t.Parallel()
+
+	const bestHeight = 100
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+	outputs := []*wire.TxOut{{
+		Value:    int64(account.Value / 2),
+		PkScript: p2wpkh,
+	}}
+	_, err := h.manager.ScheduleWithdraw(
+		traderKey, outputs, chainfee.FeePerKwFloor,
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(t, err)
+
+	assertState := func(expected State, numScheduled int) {
+		t.Helper()
+
+		found, err := h.store.Account(traderKey)
+		require.NoError(t, err)
+		require.Equal(t, expected, found.State)
+
+		withdrawals, err := h.manager.ScheduledWithdrawals()
+		require.NoError(t, err)
+		require.Len(t, withdrawals, numScheduled)
+	}
+	assertNotBroadcast := func() {
+		t.Helper()
+
+		select {
+		case tx := <-h.wallet.publishChan:
+			t.Fatalf("unexpected transaction broadcast: %v",
+				tx.TxHash())
+		default:
+		}
+	}
+
+	// If the withdrawal can't be removed, the account must not be
+	// modified and nothing must be broadcast.
+	mgr := h.manager.(*manager)
+	h.wallet.setFeeRate(chainfee.FeePerKwFloor)
+	h.store.setDeleteWithdrawalErr(errors.New("unable to delete"))
+	mgr.executeScheduledWithdrawals(bestHeight)
+	assertNotBroadcast()
+	assertState(StateOpen, 1)
+
+	// Once the removal succeeds, the withdrawal is broadcast and removed.
+	h.store.setDeleteWithdrawalErr(nil)
+	mgr.executeScheduledWithdrawals(bestHeight)
+	select {
+	case <-h.wallet.publishChan:
+	case <-time.After(timeout):
+		t.Fatal("expected withdrawal to be broadcast")
+	}
+	assertState(StatePendingUpdate, 0)
+
+	// Even once the account is open again, the withdrawal isn't executed
+	// a second time.
+	found, err := h.store.Account(traderKey)
+	require.NoError(t, err)
+	err = h.store.UpdateAccount(found, StateModifier(StateOpen))
+	require.NoError(t, err)
+	mgr.executeScheduledWithdrawals(bestHeight)
+	assertNotBroadcast()
+	assertState(StateOpen, 0)
+}
+
 // TestAccountSpendBatchNotFinalized ensures that if a pending batch exists at
 // the time of an account spend, then its updates are applied to the account in
 // order to properly locate the latest account output.
@@ -873,9 +1175,9 @@ func TestAccountWithdrawal(t *testing.T) {
 	// With our account created, we'll start with an invalid withdrawal to a
 	// dust output, which should fail.
 	dustOutput := &wire.TxOut{Value: 0, PkScript: p2wsh}
-	_, _, err := h.manager.WithdrawAccount(
+	_, _, _, err := h.manager.WithdrawAccount(
 		context.Background(), account.TraderKey.PubKey,
-		[]*wire.TxOut{dustOutput}, feeRate, bestHeight, 0,
+		[]*wire.TxOut{dustOutput}, feeRate, bestHeight, 0, nil, false,
 	)
 	if err == nil || !strings.Contains(err.Error(), "dust output") {
 		t.Fatalf("expected dust output error, got: %v", err)
@@ -906,13 +1208,48 @@ func TestAccountWithdrawal(t *testing.T) {
 	// we'll be withdrawing to.
 	const expectedFee btcutil.Amount = 260
 
+	// A fee ceiling below either the fee rate or the resulting fee aborts
+	// the withdrawal before anything is signed.
+	for _, limit := range []*FeeLimit{
+		{MaxFeeRate: feeRate - 1},
+		{MaxFee: expectedFee - 1},
+	} {
+		_, _, _, err = h.manager.WithdrawAccount(
+			context.Background(), account.TraderKey.PubKey, outputs,
+			feeRate, bestHeight, 0, limit, false,
+		)
+		require.ErrorIs(t, err, ErrFeeExceedsMax)
+	}
+	require.ErrorContains(t, err, expectedFee.String())
+
+	// A dry run returns the unsigned withdrawal transaction along with its
+	// fee without signing, broadcasting or persisting anything.
+	_, dryRunTx, dryRun, err := h.manager.WithdrawAccount(
+		context.Background(), account.TraderKey.PubKey, outputs,
+		feeRate, bestHeight, 0, &FeeLimit{MaxFee: expectedFee}, true,
+	)
+	require.NoError(t, err)
+	require.Same(t, dryRunTx, dryRun.Tx)
+	require.Equal(t, expectedFee, dryRun.Fee())
+	require.Equal(t, account.Value, dryRun.InputTotal)
+	require.InDelta(t, int64(feeRate), int64(dryRun.FeeRate()), 1)
+	require.Len(t, dryRunTx.TxIn, 1)
+	require.Empty(t, dryRunTx.TxIn[0].Witness)
+	require.Empty(t, dryRun.WalletInputs)
+	h.assertAccountExists(account)
+	select {
+	case <-h.wallet.publishChan:
+		t.Fatal("dry run transaction was published")
+	default:
+	}
+
 	// Attempt the withdrawal.
 	//
 	// If successful, we'll follow with a series of assertions to ensure it
 	// was performed correctly.
-	_, _, err = h.manager.WithdrawAccount(
+	_, _, _, err = h.manager.WithdrawAccount(
 		context.Background(), account.TraderKey.PubKey, outputs,
-		feeRate, bestHeight, 0,
+		feeRate, bestHeight, 0, &FeeLimit{MaxFee: expectedFee}, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to process account withdrawal: %v", err)
@@ -1001,9 +1338,9 @@ func TestAccountDeposit(t *testing.T) {
 	//
 	// If successful, we'll follow with a series of assertions to ensure it
 	// was performed correctly.
-	_, _, err := h.manager.DepositAccount(
+	_, _, _, err := h.manager.DepositAccount(
 		context.Background(), account.TraderKey.PubKey, depositAmount,
-		feeRate, bestHeight, 0,
+		feeRate, bestHeight, 0, nil, nil, false,
 	)
 	require.NoError(t, err)
 
@@ -1025,6 +1362,140 @@ func TestAccountDeposit(t *testing.T) {
 	_ = h.closeAccount(account, &expr, bestHeight)
 }
 
+// TestAccountDepositDryRun ensures that a deposit dry run returns the unsigned
+// deposit transaction, holds its inputs for a short time and that they're used
+// again by the following deposit if they're still unspent.
+func TestAccountDepositDryRun(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inputs reused", func(t *testing.T) {
+		testAccountDepositDryRun(t, false)
+	})
+	t.Run("inputs spent", func(t *testing.T) {
+		testAccountDepositDryRun(t, true)
+	})
+}
+
+func testAccountDepositDryRun(t *testing.T, inputsSpent bool) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	// We use the same values as in TestAccountDeposit.
+	const initialAccountValue = MinAccountValue
+	const valueAfterDeposit = initialAccountValue * 2
+	const utxoAmount = initialAccountValue * 3
+	const depositAmount = valueAfterDeposit - initialAccountValue
+
+	const feeRate = chainfee.FeePerKwFloor
+	const accountInputFees = 110
+	const expectedFee btcutil.Amount = accountInputFees + 236
+
+	const fundedOutputAmount = depositAmount + accountInputFees
+
+	const bestHeight = 100
+	account := h.openAccount(
+		initialAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+
+	accountOutputScript, _ := account.NextOutputScript()
+
+	utxos := []*lnwallet.Utxo{{
+		AddressType: lnwallet.WitnessPubKey,
+		Value:       utxoAmount,
+		PkScript:    p2wpkh,
+		OutPoint:    wire.OutPoint{Index: 1},
+	}}
+	h.wallet.utxos = utxos
+
+	// The funded packet is modified by the manager, so we need a new one
+	// for each deposit.
+	fundedPacket := func() *psbt.Packet {
+		return &psbt.Packet{
+			UnsignedTx: &wire.MsgTx{
+				Version: 2,
+				TxIn: []*wire.TxIn{{
+					PreviousOutPoint: utxos[0].OutPoint,
+				}},
+				TxOut: []*wire.TxOut{{
+					Value:    int64(fundedOutputAmount),
+					PkScript: accountOutputScript,
+				}, {
+					Value: int64(
+						utxoAmount - depositAmount -
+							expectedFee,
+					),
+					PkScript: np2wpkh,
+				}},
+			},
+			Inputs: []psbt.PInput{{
+				WitnessUtxo: &wire.TxOut{
+					Value:    int64(utxos[0].Value),
+					PkScript: utxos[0].PkScript,
+				},
+			}},
+			Outputs: []psbt.POutput{{}, {}},
+		}
+	}
+	h.wallet.fundPsbt = fundedPacket()
+	h.wallet.fundPsbtChangeIdx = 1
+
+	// The dry run should return the account as it would be after the
+	// deposit and the unsigned transaction, but not modify the account.
+	ctx := context.Background()
+	dryRunAccount, dryRunTx, dryRun, err := h.manager.DepositAccount(
+		ctx, account.TraderKey.PubKey, depositAmount, feeRate,
+		bestHeight, 0, nil, nil, true,
+	)
+	require.NoError(t, err)
+	require.Equal(t, valueAfterDeposit, dryRunAccount.Value)
+	require.Same(t, dryRunTx, dryRun.Tx)
+	require.Len(t, dryRunTx.TxIn, 2)
+	require.Equal(t, expectedFee, dryRun.Fee())
+	require.Equal(t, initialAccountValue+utxoAmount, dryRun.InputTotal)
+	h.assertAccountExists(account)
+	select {
+	case <-h.wallet.publishChan:
+		t.Fatal("dry run transaction was published")
+	default:
+	}
+
+	// The wallet input is now only held for a short time.
+	require.Equal(t, []wire.OutPoint{utxos[0].OutPoint}, dryRun.WalletInputs)
+	require.Equal(t, map[wire.OutPoint]time.Duration{
+		utxos[0].OutPoint: DryRunLeaseTime,
+	}, h.wallet.leases)
+
+	// If the input was spent in the meantime, the deposit lets lnd select
+	// the inputs again.
+	expectedInputs := []wire.OutPoint{utxos[0].OutPoint}
+	if inputsSpent {
+		h.wallet.utxos = nil
+		expectedInputs = nil
+	}
+
+	h.wallet.fundPsbt = fundedPacket()
+	_, _, dryRun, err = h.manager.DepositAccount(
+		ctx, account.TraderKey.PubKey, depositAmount, feeRate,
+		bestHeight, 0, nil, nil, false,
+	)
+	require.NoError(t, err)
+	require.Nil(t, dryRun)
+	require.Equal(t, expectedInputs, h.wallet.fundPsbtInputs)
+	require.Empty(t, h.wallet.leases)
+
+	const accountInputIdx = 1
+	const accountOutputIdx = 1
+	h.assertAccountModification(
+		account, utxos,
+		[]*wire.TxOut{h.wallet.fundPsbt.UnsignedTx.TxOut[0]},
+		valueAfterDeposit, accountInputIdx, accountOutputIdx,
+		bestHeight,
+	)
+}
+
 // TestAccountDepositInsufficientFee ensures that we get an error if for some
 // reason we get a funded transaction with insufficient fees.
 func TestAccountDepositInsufficientFee(t *testing.T) {
@@ -1093,9 +1564,9 @@ func TestAccountDepositInsufficientFee(t *testing.T) {
 	//
 	// If successful, we'll follow with a series of assertions to ensure it
 	// was performed correctly.
-	_, _, err := h.manager.DepositAccount(
+	_, _, _, err := h.manager.DepositAccount(
 		context.Background(), account.TraderKey.PubKey, depositAmount,
-		feeRate, bestHeight, 0,
+		feeRate, bestHeight, 0, nil, nil, false,
 	)
 	require.Error(t, err)
 	require.Contains(
@@ -1109,6 +1580,349 @@ func TestAccountDepositInsufficientFee(t *testing.T) {
 	_ = h.closeAccount(account, &expr, bestHeight)
 }
 
+// TestInitAccountCoinSelection ensures that a new account is only funded by
+// the selected inputs, that a shortfall is reported and that the change output
+// uses the selected address type.
+func TestInitAccountCoinSelection(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	const (
+		bestHeight = 100
+		utxoValue  = MinAccountValue * 2
+		feeRate    = chainfee.FeePerKwFloor
+	)
+	expiry := uint32(bestHeight + maxAccountExpiry)
+
+	utxo := &lnwallet.Utxo{
+		AddressType: lnwallet.WitnessPubKey,
+		Value:       utxoValue,
+		PkScript:    p2wpkh,
+		OutPoint:    wire.OutPoint{Index: 1},
+	}
+	h.wallet.utxos = []*lnwallet.Utxo{utxo}
+	ctx := context.Background()
+
+	// Inputs that aren't known to the wallet are rejected.
+	unknown := wire.OutPoint{Index: 2}
+	_, err := h.manager.InitAccount(
+		ctx, MinAccountValue, feeRate, expiry, bestHeight,
+		&CoinSelection{Inputs: []wire.OutPoint{unknown}}, nil,
+	)
+	require.ErrorContains(t, err, unknown.String())
+
+	// So is a selection that doesn't cover the account value and fees.
+	_, err = h.manager.InitAccount(
+		ctx, utxoValue, feeRate, expiry, bestHeight,
+		&CoinSelection{Inputs: []wire.OutPoint{utxo.OutPoint}}, nil,
+	)
+	require.ErrorContains(t, err, "shortfall")
+
+	// Neither attempt should have persisted an account.
+	accounts, err := h.store.Accounts()
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+
+	// With a sufficient input, the account is funded by exactly that input
+	// and the change output lnd added is replaced with a p2wkh one.
+	account, err := h.manager.InitAccount(
+		ctx, MinAccountValue, feeRate, expiry, bestHeight,
+		&CoinSelection{
+			Inputs:     []wire.OutPoint{utxo.OutPoint},
+			ChangeType: walletrpc.AddressType_WITNESS_PUBKEY_HASH,
+		}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, StatePendingOpen, account.State)
+
+	var tx *wire.MsgTx
+	select {
+	case tx = <-h.wallet.publishChan:
+	case <-time.After(timeout):
+		t.Fatal("funding transaction not published")
+	}
+	require.Len(t, tx.TxIn, 1)
+	require.Equal(t, utxo.OutPoint, tx.TxIn[0].PreviousOutPoint)
+	require.Equal(t, account.OutPoint.Hash, tx.TxHash())
+	require.Len(t, tx.TxOut, 2)
+
+	// The p2wkh change script is one byte shorter than the np2wkh one,
+	// which saves us four weight units worth of fees.
+	changeAddr, err := h.wallet.NextAddr(
+		ctx, "", walletrpc.AddressType_WITNESS_PUBKEY_HASH, true,
+	)
+	require.NoError(t, err)
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	require.NoError(t, err)
+
+	change := tx.TxOut[1]
+	require.Equal(t, changeScript, change.PkScript)
+	require.EqualValues(
+		t, mockChangeValue-feeRate.FeeForWeight(-4), change.Value,
+	)
+}
+
+// TestAccountDepositPsbt ensures that a deposit funded by an external wallet
+// through a PSBT is validated and finalized correctly.
+func TestAccountDepositPsbt(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	const initialAccountValue = MinAccountValue
+	const valueAfterDeposit = initialAccountValue * 2
+	const utxoAmount = initialAccountValue * 3
+	const depositAmount = valueAfterDeposit - initialAccountValue
+	const fee btcutil.Amount = 1000
+
+	const bestHeight = 100
+	account := h.openAccount(
+		initialAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+	ctx := context.Background()
+
+	// Nothing can be finalized before a deposit is initiated.
+	_, _, err := h.manager.FinalizeDeposit(ctx, traderKey, nil, bestHeight)
+	require.ErrorIs(t, err, ErrNoPendingDeposit)
+
+	packet, err := h.manager.DepositAccountPsbt(
+		ctx, traderKey, depositAmount, bestHeight, 0,
+	)
+	require.NoError(t, err)
+
+	// The PSBT should spend the account into the new account output.
+	accountOutputScript, err := account.NextOutputScript()
+	require.NoError(t, err)
+	require.Len(t, packet.UnsignedTx.TxIn, 1)
+	require.Equal(
+		t, account.OutPoint, packet.UnsignedTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Len(t, packet.UnsignedTx.TxOut, 1)
+	require.Equal(t, &wire.TxOut{
+		Value:    int64(valueAfterDeposit),
+		PkScript: accountOutputScript,
+	}, packet.UnsignedTx.TxOut[0])
+
+	// The external wallet now adds its input and a change output, signs
+	// its input and sorts the transaction.
+	utxo := &lnwallet.Utxo{
+		AddressType: lnwallet.WitnessPubKey,
+		Value:       utxoAmount,
+		PkScript:    p2wpkh,
+		OutPoint:    wire.OutPoint{Index: 1},
+	}
+	changeOutput := &wire.TxOut{
+		Value:    int64(utxoAmount - depositAmount - fee),
+		PkScript: np2wpkh,
+	}
+	packet.UnsignedTx.TxIn = append(packet.UnsignedTx.TxIn, &wire.TxIn{
+		PreviousOutPoint: utxo.OutPoint,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	packet.Inputs = append(packet.Inputs, psbt.PInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    int64(utxo.Value),
+			PkScript: utxo.PkScript,
+		},
+		FinalScriptWitness: []byte{0x01, 0x01, 0x01},
+	})
+	packet.UnsignedTx.TxOut = append(packet.UnsignedTx.TxOut, changeOutput)
+	packet.Outputs = append(packet.Outputs, psbt.POutput{})
+	require.NoError(t, psbt.InPlaceSort(packet))
+
+	finalizeDeposit := func() error {
+		_, _, err := h.manager.FinalizeDeposit(
+			ctx, traderKey, packet, bestHeight,
+		)
+		return err
+	}
+
+	// The auctioneer assumes a sequence of 0 for all inputs, so we reject
+	// anything else.
+	err = finalizeDeposit()
+	require.ErrorContains(t, err, "must have a sequence of 0")
+
+	accountInputIdx, err := locateAccountInput(packet.UnsignedTx, account)
+	require.NoError(t, err)
+	externalInputIdx := 1 - accountInputIdx
+	packet.UnsignedTx.TxIn[externalInputIdx].Sequence = 0
+
+	accountOutputIdx, ok := poolscript.LocateOutputScript(
+		packet.UnsignedTx, accountOutputScript,
+	)
+	require.True(t, ok)
+
+	// Changing the account output's value is not allowed either.
+	accountOutput := packet.UnsignedTx.TxOut[accountOutputIdx]
+	accountOutput.Value--
+	err = finalizeDeposit()
+	require.ErrorContains(t, err, "account output has value")
+	accountOutput.Value++
+
+	err = finalizeDeposit()
+	require.NoError(t, err)
+
+	h.assertAccountModification(
+		account, []*lnwallet.Utxo{utxo}, []*wire.TxOut{changeOutput},
+		valueAfterDeposit, uint32(accountInputIdx), accountOutputIdx,
+		bestHeight,
+	)
+
+	// The pending deposit is removed once it was finalized.
+	err = finalizeDeposit()
+	require.ErrorIs(t, err, ErrNoPendingDeposit)
+
+	// A deposit that was initiated before the account was modified can no
+	// longer be finalized and is removed.
+	_, err = h.manager.DepositAccountPsbt(
+		ctx, traderKey, depositAmount, bestHeight, 0,
+	)
+	require.NoError(t, err)
+
+	err = h.store.UpdateAccount(account, OutPointModifier(wire.OutPoint{
+		Index: 2,
+	}))
+	require.NoError(t, err)
+
+	err = finalizeDeposit()
+	require.ErrorContains(t, err, "account was modified")
+
+	_, err = h.store.PendingDeposit(traderKey)
+	require.ErrorIs(t, err, ErrNoPendingDeposit)
+}
+
+// TestAccountBumpFee ensures that the fee of a pending account transaction can
+// only be bumped through an output the wallet controls and that successful
+// bumps are recorded.
+func TestAccountBumpFee(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bestHeight = 100
+		feeRate    = chainfee.FeePerKwFloor * 10
+	)
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	ctx := context.Background()
+	account, err := h.manager.InitAccount(
+		ctx, maxAccountValue, chainfee.FeePerKwFloor,
+		bestHeight+maxAccountExpiry, bestHeight, nil, nil,
+	)
+	require.NoError(t, err)
+	traderKey := account.TraderKey.PubKey
+
+	// Without any output the wallet can spend, the fee can't be bumped.
+	err = h.manager.BumpAccountFee(ctx, traderKey, feeRate)
+	require.ErrorContains(t, err, "did not contain any eligible outputs")
+	require.Empty(t, h.store.feeBumps)
+
+	// Once the wallet controls one of the outputs, the bump succeeds and
+	// is recorded.
+	h.wallet.bumpableOutput = wire.OutPoint{
+		Hash:  account.LatestTx.TxHash(),
+		Index: uint32(len(account.LatestTx.TxOut) - 1),
+	}
+	require.NoError(t, h.manager.BumpAccountFee(ctx, traderKey, feeRate))
+	require.Equal(t, []chainfee.SatPerKWeight{feeRate}, h.store.feeBumps)
+
+	// Accounts that are part of a pending batch can't be bumped.
+	err = h.store.UpdateAccount(account, StateModifier(StatePendingBatch))
+	require.NoError(t, err)
+	err = h.manager.BumpAccountFee(ctx, traderKey, feeRate)
+	require.ErrorContains(t, err, "pending batch")
+	require.Len(t, h.store.feeBumps, 1)
+}
+
+// TestAccountWithdrawAndClose ensures that an account can be closed by
+// withdrawing to an external output and sending the remainder to the wallet,
+// as long as it has no active orders and no batch is pending.
+func TestAccountWithdrawAndClose(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bestHeight = 100
+		feeRate    = chainfee.FeePerKwFloor
+	)
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+	ctx := context.Background()
+
+	withdrawOutput := &wire.TxOut{
+		Value:    int64(account.Value / 2),
+		PkScript: np2wpkh,
+	}
+	withdrawAndClose := func(outputs ...*wire.TxOut) (*wire.MsgTx, error) {
+		return h.manager.WithdrawAndClose(
+			ctx, traderKey, outputs, feeRate, bestHeight,
+		)
+	}
+
+	// Accounts with active orders can't be closed.
+	h.hasActiveOrders = true
+	_, err := withdrawAndClose(withdrawOutput)
+	require.ErrorContains(t, err, "has active orders")
+	h.hasActiveOrders = false
+
+	// Neither can they while a batch is pending.
+	h.store.setPendingBatch(func() error { return nil })
+	_, err = withdrawAndClose(withdrawOutput)
+	require.ErrorContains(t, err, "batch is pending")
+	h.store.setPendingBatch(nil)
+
+	// A remainder that would be dust is rejected.
+	_, err = withdrawAndClose(&wire.TxOut{
+		Value:    int64(account.Value) - 100,
+		PkScript: np2wpkh,
+	})
+	require.ErrorContains(t, err, "results in dust")
+
+	closeTx, err := withdrawAndClose(withdrawOutput)
+	require.NoError(t, err)
+
+	// The remainder minus the fee for one account input and the two
+	// outputs should go to the wallet.
+	var weightEstimator input.TxWeightEstimator
+	weightEstimator.AddWitnessInput(poolscript.MultiSigWitnessSize)
+	weightEstimator.AddP2SHOutput()
+	weightEstimator.AddP2WKHOutput()
+	fee := feeRate.FeeForWeight(int64(weightEstimator.Weight()))
+
+	changeAddr, err := h.wallet.NextAddr(ctx, "", 0, false)
+	require.NoError(t, err)
+	changePkScript, err := txscript.PayToAddrScript(changeAddr)
+	require.NoError(t, err)
+
+	changeValue := account.Value - btcutil.Amount(withdrawOutput.Value) - fee
+	h.assertSpendTxBroadcast(account, nil, []*wire.TxOut{
+		withdrawOutput, {
+			Value:    int64(changeValue),
+			PkScript: changePkScript,
+		},
+	}, nil)
+	require.Len(t, closeTx.TxOut, 2)
+
+	closedAccount, err := h.store.Account(traderKey)
+	require.NoError(t, err)
+	require.Equal(t, StatePendingClosed, closedAccount.State)
+	require.Zero(t, closedAccount.Value)
+}
+
 // TestAccountConsecutiveBatches ensures that we can process an account update
 // through multiple consecutive batches that only confirm after we've already
 // updated our database state.
@@ -1281,3 +2095,321 @@ func TestMakeTxnLabel(t *testing.T) {
 		require.Equal(t, genLabel, testCase.label)
 	}
 }
+
+// TestValidateExpiryExtension makes sure account expiry extensions proposed by
+// the auctioneer as part of a batch are only accepted if they are within the
+// auctioneer's terms and extend the expiry far enough.
+func TestValidateExpiryExtension(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bestHeight    = 1000
+		currentExpiry = 1100
+	)
+	auctionTerms := &terms.AuctioneerTerms{
+		AutoRenewExtensionBlocks: 500,
+	}
+
+	testCases := []struct {
+		name         string
+		minExtension uint32
+		newExpiry    uint32
+		valid        bool
+	}{{
+		name:      "unchanged expiry",
+		newExpiry: currentExpiry,
+		valid:     true,
+	}, {
+		name:         "unchanged expiry with minimum extension",
+		minExtension: 100,
+		newExpiry:    currentExpiry,
+		valid:        true,
+	}, {
+		name:      "expiry decreased",
+		newExpiry: currentExpiry - 1,
+		valid:     false,
+	}, {
+		name:      "maximum extension",
+		newExpiry: bestHeight + 500 + expiryExtensionPadding,
+		valid:     true,
+	}, {
+		name:      "extension above maximum",
+		newExpiry: bestHeight + 500 + expiryExtensionPadding + 1,
+		valid:     false,
+	}, {
+		name:         "extension above minimum",
+		minExtension: 400,
+		newExpiry:    currentExpiry + 400,
+		valid:        true,
+	}, {
+		name:         "extension below minimum",
+		minExtension: 400,
+		newExpiry:    currentExpiry + 399,
+		valid:        false,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewManager(&ManagerConfig{
+				MinAcceptableExpiryExtension: tc.minExtension,
+			})
+			acct := &Account{Expiry: currentExpiry}
+
+			err := m.ValidateExpiryExtension(
+				acct, tc.newExpiry, bestHeight, auctionTerms,
+			)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrInvalidExpiryExtension)
+		})
+	}
+}
+
+// TestSweepExpired ensures that an expired account can be swept through its
+// expiry path without the auctioneer and that accounts which haven't expired
+// yet or were already spent are rejected.
+func TestSweepExpired(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bestHeight = 100
+		feeRate    = chainfee.FeePerKwFloor
+	)
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+
+	destAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		p2wpkh[2:], &chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+	sweep := func(height uint32) (*wire.MsgTx, error) {
+		return h.manager.SweepExpired(
+			context.Background(), traderKey, destAddr, feeRate,
+			height,
+		)
+	}
+
+	// The account can't be swept before it expires.
+	_, err = sweep(account.Expiry - 1)
+	require.ErrorContains(t, err, "before it expires")
+
+	h.expireAccount(account)
+
+	// If the account output was already spent, we get a distinct error
+	// and the account isn't modified.
+	h.wallet.publishErr = lnwallet.ErrDoubleSpend
+	_, err = sweep(account.Expiry)
+	require.ErrorIs(t, err, ErrAccountSpent)
+	h.assertAccountExists(account)
+	h.wallet.publishErr = nil
+
+	sweepTx, err := sweep(account.Expiry)
+	require.NoError(t, err)
+
+	// The sweep spends the expiry path, so the lock time must be set and
+	// the full value minus the fee goes to the destination address.
+	var weightEstimator input.TxWeightEstimator
+	weightEstimator.AddWitnessInput(poolscript.ExpiryWitnessSize)
+	weightEstimator.AddP2WKHOutput()
+	fee := feeRate.FeeForWeight(int64(weightEstimator.Weight()))
+
+	h.assertSpendTxBroadcast(account, nil, []*wire.TxOut{{
+		Value:    int64(account.Value - fee),
+		PkScript: p2wpkh,
+	}}, nil)
+	require.Equal(t, account.Expiry, sweepTx.LockTime)
+	require.True(t, poolscript.IsExpirySpend(sweepTx.TxIn[0].Witness))
+
+	account.Value = 0
+	account.State = StatePendingClosed
+	account.HeightHint = account.Expiry
+	account.LatestTx = sweepTx
+	h.assertAccountExists(account)
+
+	// Once the sweep confirms, the account is closed.
+	spendHeight := account.Expiry + 6
+	h.notifier.spendChan <- &chainntnfs.SpendDetail{
+		SpendingTx:     sweepTx,
+		SpendingHeight: int32(spendHeight),
+	}
+
+	account.State = StateClosed
+	account.HeightHint = spendHeight
+	h.assertAccountExists(account)
+
+	// A closed account can't be swept again.
+	_, err = sweep(account.Expiry)
+	require.ErrorIs(t, err, ErrAccountSpent)
+}
+
+// TestUpdateReserve ensures that new accounts are created with a default
+// reserve and that it can be updated within the bounds of the account value.
+func TestUpdateReserve(t *testing.T) {
+	t.Parallel()
+
+	const bestHeight = 100
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+
+	// The default reserve must at least cover sweeping the account
+	// through its expiry path at the initial fee rate.
+	var weightEstimator input.TxWeightEstimator
+	weightEstimator.AddWitnessInput(poolscript.ExpiryWitnessSize)
+	weightEstimator.AddP2WKHOutput()
+	sweepFee := chainfee.FeePerKwFloor.FeeForWeight(
+		int64(weightEstimator.Weight()),
+	)
+	require.Greater(t, account.Reserve, sweepFee)
+
+	// The reserve can't exceed the account value.
+	_, err := h.manager.UpdateReserve(traderKey, account.Value+1)
+	require.ErrorContains(t, err, "invalid reserve")
+
+	updated, err := h.manager.UpdateReserve(traderKey, account.Value)
+	require.NoError(t, err)
+	require.Equal(t, account.Value, updated.Reserve)
+
+	account.Reserve = account.Value
+	h.assertAccountExists(account)
+
+	// Closed accounts can't be updated anymore.
+	expr := defaultFeeExpr
+	h.closeAccount(account, &expr, bestHeight)
+	_, err = h.manager.UpdateReserve(traderKey, 0)
+	require.ErrorContains(t, err, "cannot update reserve")
+}
+
+// TestSubscribeUpdates ensures that subscribers are notified about every change
+// of an account's state and that new subscribers receive the current state of
+// all accounts.
+func TestSubscribeUpdates(t *testing.T) {
+	t.Parallel()
+
+	const bestHeight = 100
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	client, initial, err := h.manager.SubscribeUpdates()
+	require.NoError(t, err)
+	defer client.Cancel()
+	require.Empty(t, initial)
+
+	assertUpdate := func(prevState, newState State) *UpdateEvent {
+		t.Helper()
+
+		select {
+		case update := <-client.Updates():
+			event, ok := update.(*UpdateEvent)
+			require.True(t, ok)
+			require.Equal(t, prevState, event.PrevState)
+			require.Equal(t, newState, event.NewState)
+			require.False(t, event.Initial)
+
+			return event
+
+		case <-time.After(timeout):
+			t.Fatalf("expected update from %v to %v", prevState,
+				newState)
+		}
+
+		return nil
+	}
+
+	// Opening an account results in an event for the new account and for
+	// each of its state transitions.
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	assertUpdate(StateInitiated, StateInitiated)
+	event := assertUpdate(StateInitiated, StatePendingOpen)
+	require.Equal(t, account.OutPoint, event.OutPoint)
+	require.Equal(t, account.LatestTx.TxHash(), event.Tx.TxHash())
+	assertUpdate(StatePendingOpen, StateOpen)
+
+	// Updates that don't change the account's state, value or outpoint
+	// don't result in an event.
+	_, err = h.manager.UpdateAutoRenew(account.TraderKey.PubKey, false, 0)
+	require.NoError(t, err)
+
+	// Closing the account changes its value as well.
+	expr := defaultFeeExpr
+	closeTx := h.closeAccount(account, &expr, bestHeight)
+	event = assertUpdate(StateOpen, StatePendingClosed)
+	require.EqualValues(t, maxAccountValue, event.PrevValue)
+	require.Zero(t, event.Value)
+	require.Equal(t, closeTx.TxHash(), event.Tx.TxHash())
+	assertUpdate(StatePendingClosed, StateClosed)
+
+	select {
+	case update := <-client.Updates():
+		t.Fatalf("unexpected update: %v", spew.Sdump(update))
+	default:
+	}
+
+	// A new subscriber receives the current state of the account.
+	client2, initial, err := h.manager.SubscribeUpdates()
+	require.NoError(t, err)
+	defer client2.Cancel()
+
+	require.Len(t, initial, 1)
+	require.True(t, initial[0].Initial)
+	require.Equal(t, StateClosed, initial[0].NewState)
+	require.True(t, account.TraderKey.PubKey.IsEqual(initial[0].TraderKey))
+}
+
+// TestUpdateWatchHeightHint ensures the height hint of an account watched for
+// a spend is only persisted while the account still uses the watched output.
+func TestUpdateWatchHeightHint(t *testing.T) {
+	t.Parallel()
+
+	const bestHeight = 100
+
+	h := newTestHarness(t)
+	h.start()
+	defer h.stop()
+
+	account := h.openAccount(
+		maxAccountValue, bestHeight+maxAccountExpiry, bestHeight,
+	)
+	traderKey := account.TraderKey.PubKey
+
+	err := h.manager.(*manager).updateWatchHeightHint(
+		traderKey, account.OutPoint, bestHeight+200,
+	)
+	require.NoError(t, err)
+
+	account.HeightHint = bestHeight + 200
+	h.assertAccountExists(account)
+
+	// A hint for an output the account no longer uses is ignored.
+	staleOutPoint := account.OutPoint
+	staleOutPoint.Index++
+	err = h.manager.(*manager).updateWatchHeightHint(
+		traderKey, staleOutPoint, bestHeight+400,
+	)
+	require.NoError(t, err)
+	h.assertAccountExists(account)
+}
@@ -7,9 +7,12 @@ package account
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	v2 "github.com/btcsuite/btcd/btcec/v2"
 	btcutil "github.com/btcsuite/btcd/btcutil"
+	psbt "github.com/btcsuite/btcd/btcutil/psbt"
+	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
 	wire "github.com/btcsuite/btcd/wire"
 	wtxmgr "github.com/btcsuite/btcwallet/wtxmgr"
 	gomock "github.com/golang/mock/gomock"
@@ -18,6 +21,7 @@ import (
 	chainntnfs "github.com/lightningnetwork/lnd/chainntnfs"
 	keychain "github.com/lightningnetwork/lnd/keychain"
 	chainfee "github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	subscribe "github.com/lightningnetwork/lnd/subscribe"
 )
 
 // MockStore is a mock of Store interface.
@@ -87,6 +91,53 @@ func (mr *MockStoreMockRecorder) AddAccount(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccount", reflect.TypeOf((*MockStore)(nil).AddAccount), arg0)
 }
 
+// CompleteScheduledWithdrawal mocks base method.
+func (m *MockStore) CompleteScheduledWithdrawal(arg0 *Account, arg1 ...Modifier) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteScheduledWithdrawal", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteScheduledWithdrawal indicates an expected call of CompleteScheduledWithdrawal.
+func (mr *MockStoreMockRecorder) CompleteScheduledWithdrawal(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteScheduledWithdrawal", reflect.TypeOf((*MockStore)(nil).CompleteScheduledWithdrawal), varargs...)
+}
+
+// DeletePendingDeposit mocks base method.
+func (m *MockStore) DeletePendingDeposit(arg0 *v2.PublicKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingDeposit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingDeposit indicates an expected call of DeletePendingDeposit.
+func (mr *MockStoreMockRecorder) DeletePendingDeposit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingDeposit", reflect.TypeOf((*MockStore)(nil).DeletePendingDeposit), arg0)
+}
+
+// DeleteScheduledWithdrawal mocks base method.
+func (m *MockStore) DeleteScheduledWithdrawal(arg0 *v2.PublicKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledWithdrawal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledWithdrawal indicates an expected call of DeleteScheduledWithdrawal.
+func (mr *MockStoreMockRecorder) DeleteScheduledWithdrawal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledWithdrawal", reflect.TypeOf((*MockStore)(nil).DeleteScheduledWithdrawal), arg0)
+}
+
 // LockID mocks base method.
 func (m *MockStore) LockID() (wtxmgr.LockID, error) {
 	m.ctrl.T.Helper()
@@ -103,17 +154,17 @@ func (mr *MockStoreMockRecorder) LockID() *gomock.Call {
 }
 
 // MarkBatchComplete mocks base method.
-func (m *MockStore) MarkBatchComplete() error {
+func (m *MockStore) MarkBatchComplete(batchTxHash chainhash.Hash) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkBatchComplete")
+	ret := m.ctrl.Call(m, "MarkBatchComplete", batchTxHash)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkBatchComplete indicates an expected call of MarkBatchComplete.
-func (mr *MockStoreMockRecorder) MarkBatchComplete() *gomock.Call {
+func (mr *MockStoreMockRecorder) MarkBatchComplete(batchTxHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBatchComplete", reflect.TypeOf((*MockStore)(nil).MarkBatchComplete))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBatchComplete", reflect.TypeOf((*MockStore)(nil).MarkBatchComplete), batchTxHash)
 }
 
 // PendingBatch mocks base method.
@@ -130,6 +181,90 @@ func (mr *MockStoreMockRecorder) PendingBatch() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingBatch", reflect.TypeOf((*MockStore)(nil).PendingBatch))
 }
 
+// PendingDeposit mocks base method.
+func (m *MockStore) PendingDeposit(arg0 *v2.PublicKey) (*PendingDeposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingDeposit", arg0)
+	ret0, _ := ret[0].(*PendingDeposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingDeposit indicates an expected call of PendingDeposit.
+func (mr *MockStoreMockRecorder) PendingDeposit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposit", reflect.TypeOf((*MockStore)(nil).PendingDeposit), arg0)
+}
+
+// RecordFeeBump mocks base method.
+func (m *MockStore) RecordFeeBump(arg0 *v2.PublicKey, arg1 chainfee.SatPerKWeight) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFeeBump", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFeeBump indicates an expected call of RecordFeeBump.
+func (mr *MockStoreMockRecorder) RecordFeeBump(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFeeBump", reflect.TypeOf((*MockStore)(nil).RecordFeeBump), arg0, arg1)
+}
+
+// ScheduledWithdrawals mocks base method.
+func (m *MockStore) ScheduledWithdrawals() ([]*ScheduledWithdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduledWithdrawals")
+	ret0, _ := ret[0].([]*ScheduledWithdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduledWithdrawals indicates an expected call of ScheduledWithdrawals.
+func (mr *MockStoreMockRecorder) ScheduledWithdrawals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduledWithdrawals", reflect.TypeOf((*MockStore)(nil).ScheduledWithdrawals))
+}
+
+// StorePendingDeposit mocks base method.
+func (m *MockStore) StorePendingDeposit(arg0 *PendingDeposit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePendingDeposit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePendingDeposit indicates an expected call of StorePendingDeposit.
+func (mr *MockStoreMockRecorder) StorePendingDeposit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePendingDeposit", reflect.TypeOf((*MockStore)(nil).StorePendingDeposit), arg0)
+}
+
+// StoreScheduledWithdrawal mocks base method.
+func (m *MockStore) StoreScheduledWithdrawal(arg0 *ScheduledWithdrawal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreScheduledWithdrawal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreScheduledWithdrawal indicates an expected call of StoreScheduledWithdrawal.
+func (mr *MockStoreMockRecorder) StoreScheduledWithdrawal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreScheduledWithdrawal", reflect.TypeOf((*MockStore)(nil).StoreScheduledWithdrawal), arg0)
+}
+
+// SubscribeAccountUpdates mocks base method.
+func (m *MockStore) SubscribeAccountUpdates(cb UpdateCallback) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeAccountUpdates", cb)
+}
+
+// SubscribeAccountUpdates indicates an expected call of SubscribeAccountUpdates.
+func (mr *MockStoreMockRecorder) SubscribeAccountUpdates(cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeAccountUpdates", reflect.TypeOf((*MockStore)(nil).SubscribeAccountUpdates), cb)
+}
+
 // UpdateAccount mocks base method.
 func (m *MockStore) UpdateAccount(arg0 *Account, arg1 ...Modifier) error {
 	m.ctrl.T.Helper()
@@ -187,33 +322,34 @@ func (mr *MockAuctioneerMockRecorder) InitAccount(arg0, arg1 interface{}) *gomoc
 }
 
 // ModifyAccount mocks base method.
-func (m *MockAuctioneer) ModifyAccount(arg0 context.Context, arg1 *Account, arg2 []*wire.TxIn, arg3 []*wire.TxOut, arg4 []Modifier) ([]byte, error) {
+func (m *MockAuctioneer) ModifyAccount(arg0 context.Context, arg1 *Account, arg2 []*wire.TxIn, arg3 []*wire.TxOut, arg4 []Modifier, arg5 []byte, arg6 []*wire.TxOut) ([]byte, []byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyAccount", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "ModifyAccount", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ModifyAccount indicates an expected call of ModifyAccount.
-func (mr *MockAuctioneerMockRecorder) ModifyAccount(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockAuctioneerMockRecorder) ModifyAccount(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyAccount", reflect.TypeOf((*MockAuctioneer)(nil).ModifyAccount), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyAccount", reflect.TypeOf((*MockAuctioneer)(nil).ModifyAccount), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
 // ReserveAccount mocks base method.
-func (m *MockAuctioneer) ReserveAccount(arg0 context.Context, arg1 btcutil.Amount, arg2 uint32, arg3 *v2.PublicKey) (*Reservation, error) {
+func (m *MockAuctioneer) ReserveAccount(arg0 context.Context, arg1 btcutil.Amount, arg2 uint32, arg3 *v2.PublicKey, arg4 Version) (*Reservation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReserveAccount", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ReserveAccount", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*Reservation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReserveAccount indicates an expected call of ReserveAccount.
-func (mr *MockAuctioneerMockRecorder) ReserveAccount(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockAuctioneerMockRecorder) ReserveAccount(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveAccount", reflect.TypeOf((*MockAuctioneer)(nil).ReserveAccount), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveAccount", reflect.TypeOf((*MockAuctioneer)(nil).ReserveAccount), arg0, arg1, arg2, arg3, arg4)
 }
 
 // StartAccountSubscription mocks base method.
@@ -345,18 +481,18 @@ func (m *MockFeeExpr) EXPECT() *MockFeeExprMockRecorder {
 }
 
 // CloseOutputs mocks base method.
-func (m *MockFeeExpr) CloseOutputs(arg0 btcutil.Amount, arg1 witnessType) ([]*wire.TxOut, error) {
+func (m *MockFeeExpr) CloseOutputs(arg0 btcutil.Amount, arg1 witnessType, arg2 Version) ([]*wire.TxOut, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseOutputs", arg0, arg1)
+	ret := m.ctrl.Call(m, "CloseOutputs", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*wire.TxOut)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CloseOutputs indicates an expected call of CloseOutputs.
-func (mr *MockFeeExprMockRecorder) CloseOutputs(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockFeeExprMockRecorder) CloseOutputs(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseOutputs", reflect.TypeOf((*MockFeeExpr)(nil).CloseOutputs), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseOutputs", reflect.TypeOf((*MockFeeExpr)(nil).CloseOutputs), arg0, arg1, arg2)
 }
 
 // MockManager is a mock of Manager interface.
@@ -396,35 +532,82 @@ func (mr *MockManagerMockRecorder) BumpAccountFee(ctx, traderKey, newFeeRate int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BumpAccountFee", reflect.TypeOf((*MockManager)(nil).BumpAccountFee), ctx, traderKey, newFeeRate)
 }
 
+// CancelScheduledWithdraw mocks base method.
+func (m *MockManager) CancelScheduledWithdraw(traderKey *v2.PublicKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledWithdraw", traderKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledWithdraw indicates an expected call of CancelScheduledWithdraw.
+func (mr *MockManagerMockRecorder) CancelScheduledWithdraw(traderKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledWithdraw", reflect.TypeOf((*MockManager)(nil).CancelScheduledWithdraw), traderKey)
+}
+
 // CloseAccount mocks base method.
-func (m *MockManager) CloseAccount(ctx context.Context, traderKey *v2.PublicKey, feeExpr FeeExpr, bestHeight uint32) (*wire.MsgTx, error) {
+func (m *MockManager) CloseAccount(ctx context.Context, traderKey *v2.PublicKey, feeExpr FeeExpr, bestHeight uint32, limit *FeeLimit, dryRun bool) (*wire.MsgTx, *DryRun, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseAccount", ctx, traderKey, feeExpr, bestHeight)
+	ret := m.ctrl.Call(m, "CloseAccount", ctx, traderKey, feeExpr, bestHeight, limit, dryRun)
 	ret0, _ := ret[0].(*wire.MsgTx)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*DryRun)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // CloseAccount indicates an expected call of CloseAccount.
-func (mr *MockManagerMockRecorder) CloseAccount(ctx, traderKey, feeExpr, bestHeight interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) CloseAccount(ctx, traderKey, feeExpr, bestHeight, limit, dryRun interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockManager)(nil).CloseAccount), ctx, traderKey, feeExpr, bestHeight)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockManager)(nil).CloseAccount), ctx, traderKey, feeExpr, bestHeight, limit, dryRun)
 }
 
 // DepositAccount mocks base method.
-func (m *MockManager) DepositAccount(ctx context.Context, traderKey *v2.PublicKey, depositAmount btcutil.Amount, feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32) (*Account, *wire.MsgTx, error) {
+func (m *MockManager) DepositAccount(ctx context.Context, traderKey *v2.PublicKey, depositAmount btcutil.Amount, feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32, coins *CoinSelection, limit *FeeLimit, dryRun bool) (*Account, *wire.MsgTx, *DryRun, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DepositAccount", ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight)
+	ret := m.ctrl.Call(m, "DepositAccount", ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight, coins, limit, dryRun)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(*wire.MsgTx)
+	ret2, _ := ret[2].(*DryRun)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// DepositAccount indicates an expected call of DepositAccount.
+func (mr *MockManagerMockRecorder) DepositAccount(ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight, coins, limit, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositAccount", reflect.TypeOf((*MockManager)(nil).DepositAccount), ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight, coins, limit, dryRun)
+}
+
+// DepositAccountPsbt mocks base method.
+func (m *MockManager) DepositAccountPsbt(ctx context.Context, traderKey *v2.PublicKey, depositAmount btcutil.Amount, bestHeight, expiryHeight uint32) (*psbt.Packet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositAccountPsbt", ctx, traderKey, depositAmount, bestHeight, expiryHeight)
+	ret0, _ := ret[0].(*psbt.Packet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositAccountPsbt indicates an expected call of DepositAccountPsbt.
+func (mr *MockManagerMockRecorder) DepositAccountPsbt(ctx, traderKey, depositAmount, bestHeight, expiryHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositAccountPsbt", reflect.TypeOf((*MockManager)(nil).DepositAccountPsbt), ctx, traderKey, depositAmount, bestHeight, expiryHeight)
+}
+
+// FinalizeDeposit mocks base method.
+func (m *MockManager) FinalizeDeposit(ctx context.Context, traderKey *v2.PublicKey, packet *psbt.Packet, bestHeight uint32) (*Account, *wire.MsgTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizeDeposit", ctx, traderKey, packet, bestHeight)
 	ret0, _ := ret[0].(*Account)
 	ret1, _ := ret[1].(*wire.MsgTx)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// DepositAccount indicates an expected call of DepositAccount.
-func (mr *MockManagerMockRecorder) DepositAccount(ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight interface{}) *gomock.Call {
+// FinalizeDeposit indicates an expected call of FinalizeDeposit.
+func (mr *MockManagerMockRecorder) FinalizeDeposit(ctx, traderKey, packet, bestHeight interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositAccount", reflect.TypeOf((*MockManager)(nil).DepositAccount), ctx, traderKey, depositAmount, feeRate, bestHeight, expiryHeight)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizeDeposit", reflect.TypeOf((*MockManager)(nil).FinalizeDeposit), ctx, traderKey, packet, bestHeight)
 }
 
 // HandleAccountConf mocks base method.
@@ -469,19 +652,31 @@ func (mr *MockManagerMockRecorder) HandleAccountSpend(traderKey, spendDetails in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAccountSpend", reflect.TypeOf((*MockManager)(nil).HandleAccountSpend), traderKey, spendDetails)
 }
 
+// HandleNewBlock mocks base method.
+func (m *MockManager) HandleNewBlock(bestHeight uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleNewBlock", bestHeight)
+}
+
+// HandleNewBlock indicates an expected call of HandleNewBlock.
+func (mr *MockManagerMockRecorder) HandleNewBlock(bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleNewBlock", reflect.TypeOf((*MockManager)(nil).HandleNewBlock), bestHeight)
+}
+
 // InitAccount mocks base method.
-func (m *MockManager) InitAccount(ctx context.Context, value btcutil.Amount, feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32) (*Account, error) {
+func (m *MockManager) InitAccount(ctx context.Context, value btcutil.Amount, feeRate chainfee.SatPerKWeight, expiry, bestHeight uint32, coins *CoinSelection, limit *FeeLimit) (*Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitAccount", ctx, value, feeRate, expiry, bestHeight)
+	ret := m.ctrl.Call(m, "InitAccount", ctx, value, feeRate, expiry, bestHeight, coins, limit)
 	ret0, _ := ret[0].(*Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // InitAccount indicates an expected call of InitAccount.
-func (mr *MockManagerMockRecorder) InitAccount(ctx, value, feeRate, expiry, bestHeight interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) InitAccount(ctx, value, feeRate, expiry, bestHeight, coins, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitAccount", reflect.TypeOf((*MockManager)(nil).InitAccount), ctx, value, feeRate, expiry, bestHeight)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitAccount", reflect.TypeOf((*MockManager)(nil).InitAccount), ctx, value, feeRate, expiry, bestHeight, coins, limit)
 }
 
 // QuoteAccount mocks base method.
@@ -515,19 +710,50 @@ func (mr *MockManagerMockRecorder) RecoverAccount(ctx, account interface{}) *gom
 }
 
 // RenewAccount mocks base method.
-func (m *MockManager) RenewAccount(ctx context.Context, traderKey *v2.PublicKey, newExpiry uint32, feeRate chainfee.SatPerKWeight, bestHeight uint32) (*Account, *wire.MsgTx, error) {
+func (m *MockManager) RenewAccount(ctx context.Context, traderKey *v2.PublicKey, newExpiry uint32, feeRate chainfee.SatPerKWeight, bestHeight uint32, dryRun bool) (*Account, *wire.MsgTx, *DryRun, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RenewAccount", ctx, traderKey, newExpiry, feeRate, bestHeight)
+	ret := m.ctrl.Call(m, "RenewAccount", ctx, traderKey, newExpiry, feeRate, bestHeight, dryRun)
 	ret0, _ := ret[0].(*Account)
 	ret1, _ := ret[1].(*wire.MsgTx)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret2, _ := ret[2].(*DryRun)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
 }
 
 // RenewAccount indicates an expected call of RenewAccount.
-func (mr *MockManagerMockRecorder) RenewAccount(ctx, traderKey, newExpiry, feeRate, bestHeight interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) RenewAccount(ctx, traderKey, newExpiry, feeRate, bestHeight, dryRun interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAccount", reflect.TypeOf((*MockManager)(nil).RenewAccount), ctx, traderKey, newExpiry, feeRate, bestHeight)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAccount", reflect.TypeOf((*MockManager)(nil).RenewAccount), ctx, traderKey, newExpiry, feeRate, bestHeight, dryRun)
+}
+
+// ScheduleWithdraw mocks base method.
+func (m *MockManager) ScheduleWithdraw(traderKey *v2.PublicKey, outputs []*wire.TxOut, maxFeeRate chainfee.SatPerKWeight, expiry time.Time) (*ScheduledWithdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleWithdraw", traderKey, outputs, maxFeeRate, expiry)
+	ret0, _ := ret[0].(*ScheduledWithdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleWithdraw indicates an expected call of ScheduleWithdraw.
+func (mr *MockManagerMockRecorder) ScheduleWithdraw(traderKey, outputs, maxFeeRate, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleWithdraw", reflect.TypeOf((*MockManager)(nil).ScheduleWithdraw), traderKey, outputs, maxFeeRate, expiry)
+}
+
+// ScheduledWithdrawals mocks base method.
+func (m *MockManager) ScheduledWithdrawals() ([]*ScheduledWithdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduledWithdrawals")
+	ret0, _ := ret[0].([]*ScheduledWithdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduledWithdrawals indicates an expected call of ScheduledWithdrawals.
+func (mr *MockManagerMockRecorder) ScheduledWithdrawals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduledWithdrawals", reflect.TypeOf((*MockManager)(nil).ScheduledWithdrawals))
 }
 
 // Start mocks base method.
@@ -556,6 +782,81 @@ func (mr *MockManagerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockManager)(nil).Stop))
 }
 
+// SubscribeUpdates mocks base method.
+func (m *MockManager) SubscribeUpdates() (*subscribe.Client, []*UpdateEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeUpdates")
+	ret0, _ := ret[0].(*subscribe.Client)
+	ret1, _ := ret[1].([]*UpdateEvent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubscribeUpdates indicates an expected call of SubscribeUpdates.
+func (mr *MockManagerMockRecorder) SubscribeUpdates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeUpdates", reflect.TypeOf((*MockManager)(nil).SubscribeUpdates))
+}
+
+// SweepExpired mocks base method.
+func (m *MockManager) SweepExpired(ctx context.Context, traderKey *v2.PublicKey, destAddr btcutil.Address, feeRate chainfee.SatPerKWeight, bestHeight uint32) (*wire.MsgTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepExpired", ctx, traderKey, destAddr, feeRate, bestHeight)
+	ret0, _ := ret[0].(*wire.MsgTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SweepExpired indicates an expected call of SweepExpired.
+func (mr *MockManagerMockRecorder) SweepExpired(ctx, traderKey, destAddr, feeRate, bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepExpired", reflect.TypeOf((*MockManager)(nil).SweepExpired), ctx, traderKey, destAddr, feeRate, bestHeight)
+}
+
+// UpdateAutoRenew mocks base method.
+func (m *MockManager) UpdateAutoRenew(traderKey *v2.PublicKey, autoRenew bool, renewalWindow uint32) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoRenew", traderKey, autoRenew, renewalWindow)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoRenew indicates an expected call of UpdateAutoRenew.
+func (mr *MockManagerMockRecorder) UpdateAutoRenew(traderKey, autoRenew, renewalWindow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoRenew", reflect.TypeOf((*MockManager)(nil).UpdateAutoRenew), traderKey, autoRenew, renewalWindow)
+}
+
+// UpdateReserve mocks base method.
+func (m *MockManager) UpdateReserve(traderKey *v2.PublicKey, reserve btcutil.Amount) (*Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReserve", traderKey, reserve)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReserve indicates an expected call of UpdateReserve.
+func (mr *MockManagerMockRecorder) UpdateReserve(traderKey, reserve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReserve", reflect.TypeOf((*MockManager)(nil).UpdateReserve), traderKey, reserve)
+}
+
+// ValidateExpiryExtension mocks base method.
+func (m *MockManager) ValidateExpiryExtension(account *Account, newExpiry, bestHeight uint32, terms *terms.AuctioneerTerms) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateExpiryExtension", account, newExpiry, bestHeight, terms)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateExpiryExtension indicates an expected call of ValidateExpiryExtension.
+func (mr *MockManagerMockRecorder) ValidateExpiryExtension(account, newExpiry, bestHeight, terms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateExpiryExtension", reflect.TypeOf((*MockManager)(nil).ValidateExpiryExtension), account, newExpiry, bestHeight, terms)
+}
+
 // WatchMatchedAccounts mocks base method.
 func (m *MockManager) WatchMatchedAccounts(ctx context.Context, matchedAccounts []*v2.PublicKey) error {
 	m.ctrl.T.Helper()
@@ -571,17 +872,33 @@ func (mr *MockManagerMockRecorder) WatchMatchedAccounts(ctx, matchedAccounts int
 }
 
 // WithdrawAccount mocks base method.
-func (m *MockManager) WithdrawAccount(ctx context.Context, traderKey *v2.PublicKey, outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32) (*Account, *wire.MsgTx, error) {
+func (m *MockManager) WithdrawAccount(ctx context.Context, traderKey *v2.PublicKey, outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight, bestHeight, expiryHeight uint32, limit *FeeLimit, dryRun bool) (*Account, *wire.MsgTx, *DryRun, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WithdrawAccount", ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight)
+	ret := m.ctrl.Call(m, "WithdrawAccount", ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight, limit, dryRun)
 	ret0, _ := ret[0].(*Account)
 	ret1, _ := ret[1].(*wire.MsgTx)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret2, _ := ret[2].(*DryRun)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
 }
 
 // WithdrawAccount indicates an expected call of WithdrawAccount.
-func (mr *MockManagerMockRecorder) WithdrawAccount(ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) WithdrawAccount(ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight, limit, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawAccount", reflect.TypeOf((*MockManager)(nil).WithdrawAccount), ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight, limit, dryRun)
+}
+
+// WithdrawAndClose mocks base method.
+func (m *MockManager) WithdrawAndClose(ctx context.Context, traderKey *v2.PublicKey, outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight, bestHeight uint32) (*wire.MsgTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawAndClose", ctx, traderKey, outputs, feeRate, bestHeight)
+	ret0, _ := ret[0].(*wire.MsgTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawAndClose indicates an expected call of WithdrawAndClose.
+func (mr *MockManagerMockRecorder) WithdrawAndClose(ctx, traderKey, outputs, feeRate, bestHeight interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawAccount", reflect.TypeOf((*MockManager)(nil).WithdrawAccount), ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawAndClose", reflect.TypeOf((*MockManager)(nil).WithdrawAndClose), ctx, traderKey, outputs, feeRate, bestHeight)
 }
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
@@ -52,31 +53,50 @@ type mockStore struct {
 
 	mu               sync.Mutex
 	accounts         map[[33]byte]Account
+	withdrawals      map[[33]byte]ScheduledWithdrawal
+	deposits         map[[33]byte]PendingDeposit
+	feeBumps         []chainfee.SatPerKWeight
 	onFinalizedBatch func() error
+
+	// deleteWithdrawalErr, if set, is returned whenever a scheduled
+	// withdrawal is about to be removed.
+	deleteWithdrawalErr error
+
+	subscribersMtx sync.Mutex
+	subscribers    []UpdateCallback
 }
 
 func newMockStore() *mockStore {
 	return &mockStore{
-		accounts: make(map[[33]byte]Account),
+		accounts:    make(map[[33]byte]Account),
+		withdrawals: make(map[[33]byte]ScheduledWithdrawal),
+		deposits:    make(map[[33]byte]PendingDeposit),
 	}
 }
 
 func (s *mockStore) AddAccount(account *Account) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
 
 	var accountKey [33]byte
 	copy(accountKey[:], account.TraderKey.PubKey.SerializeCompressed())
 
 	s.accounts[accountKey] = *account
+	s.mu.Unlock()
+
+	s.notifyAccountUpdate()
 	return nil
 }
 
 func (s *mockStore) UpdateAccount(account *Account, modifiers ...Modifier) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	err := s.updateAccount(account, modifiers...)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
 
-	return s.updateAccount(account, modifiers...)
+	s.notifyAccountUpdate()
+	return nil
 }
 
 func (s *mockStore) updateAccount(account *Account, modifiers ...Modifier) error {
@@ -138,22 +158,167 @@ func (s *mockStore) PendingBatch() error {
 	return nil
 }
 
-func (s *mockStore) MarkBatchComplete() error {
+func (s *mockStore) MarkBatchComplete(_ chainhash.Hash) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	if err := s.onFinalizedBatch(); err != nil {
+		s.mu.Unlock()
 		return err
 	}
 
 	s.onFinalizedBatch = nil
+	s.mu.Unlock()
+
+	s.notifyAccountUpdate()
 	return nil
 }
 
+func (s *mockStore) SubscribeAccountUpdates(cb UpdateCallback) {
+	s.subscribersMtx.Lock()
+	defer s.subscribersMtx.Unlock()
+
+	s.subscribers = append(s.subscribers, cb)
+}
+
+func (s *mockStore) notifyAccountUpdate() {
+	s.subscribersMtx.Lock()
+	defer s.subscribersMtx.Unlock()
+
+	accounts, _ := s.Accounts()
+	for _, cb := range s.subscribers {
+		cb(accounts)
+	}
+}
+
 func (s *mockStore) LockID() (wtxmgr.LockID, error) {
 	return wtxmgr.LockID{1}, nil
 }
 
+func (s *mockStore) StoreScheduledWithdrawal(w *ScheduledWithdrawal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var traderKey [33]byte
+	copy(traderKey[:], w.TraderKey.SerializeCompressed())
+
+	s.withdrawals[traderKey] = *w
+	return nil
+}
+
+func (s *mockStore) ScheduledWithdrawals() ([]*ScheduledWithdrawal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	withdrawals := make([]*ScheduledWithdrawal, 0, len(s.withdrawals))
+	for _, withdrawal := range s.withdrawals {
+		withdrawal := withdrawal
+		withdrawals = append(withdrawals, &withdrawal)
+	}
+	return withdrawals, nil
+}
+
+func (s *mockStore) setDeleteWithdrawalErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteWithdrawalErr = err
+}
+
+func (s *mockStore) DeleteScheduledWithdrawal(
+	traderKey *btcec.PublicKey) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.deleteScheduledWithdrawal(traderKey)
+}
+
+func (s *mockStore) deleteScheduledWithdrawal(
+	traderKey *btcec.PublicKey) error {
+
+	if s.deleteWithdrawalErr != nil {
+		return s.deleteWithdrawalErr
+	}
+
+	var rawTraderKey [33]byte
+	copy(rawTraderKey[:], traderKey.SerializeCompressed())
+
+	if _, ok := s.withdrawals[rawTraderKey]; !ok {
+		return ErrNoScheduledWithdrawal
+	}
+
+	delete(s.withdrawals, rawTraderKey)
+	return nil
+}
+
+func (s *mockStore) CompleteScheduledWithdrawal(account *Account,
+	modifiers ...Modifier) error {
+
+	s.mu.Lock()
+	err := s.deleteScheduledWithdrawal(account.TraderKey.PubKey)
+	if err == nil {
+		err = s.updateAccount(account, modifiers...)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.notifyAccountUpdate()
+	return nil
+}
+
+func (s *mockStore) StorePendingDeposit(d *PendingDeposit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var traderKey [33]byte
+	copy(traderKey[:], d.TraderKey.SerializeCompressed())
+
+	s.deposits[traderKey] = *d
+	return nil
+}
+
+func (s *mockStore) PendingDeposit(
+	traderKey *btcec.PublicKey) (*PendingDeposit, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rawTraderKey [33]byte
+	copy(rawTraderKey[:], traderKey.SerializeCompressed())
+
+	deposit, ok := s.deposits[rawTraderKey]
+	if !ok {
+		return nil, ErrNoPendingDeposit
+	}
+	return &deposit, nil
+}
+
+func (s *mockStore) DeletePendingDeposit(traderKey *btcec.PublicKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rawTraderKey [33]byte
+	copy(rawTraderKey[:], traderKey.SerializeCompressed())
+
+	if _, ok := s.deposits[rawTraderKey]; !ok {
+		return ErrNoPendingDeposit
+	}
+
+	delete(s.deposits, rawTraderKey)
+	return nil
+}
+
+func (s *mockStore) RecordFeeBump(_ *btcec.PublicKey,
+	feeRate chainfee.SatPerKWeight) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.feeBumps = append(s.feeBumps, feeRate)
+	return nil
+}
+
 type mockAuctioneer struct {
 	Auctioneer
 
@@ -170,7 +335,8 @@ func newMockAuctioneer() *mockAuctioneer {
 }
 
 func (a *mockAuctioneer) ReserveAccount(context.Context,
-	btcutil.Amount, uint32, *btcec.PublicKey) (*Reservation, error) {
+	btcutil.Amount, uint32, *btcec.PublicKey, Version) (*Reservation,
+	error) {
 
 	return &Reservation{
 		AuctioneerKey:   testAuctioneerKey,
@@ -183,7 +349,8 @@ func (a *mockAuctioneer) InitAccount(context.Context, *Account) error {
 }
 
 func (a *mockAuctioneer) ModifyAccount(_ context.Context, _ *Account,
-	inputs []*wire.TxIn, outputs []*wire.TxOut, _ []Modifier) ([]byte, error) {
+	inputs []*wire.TxIn, outputs []*wire.TxOut, _ []Modifier, _ []byte,
+	_ []*wire.TxOut) ([]byte, []byte, error) {
 
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -195,7 +362,7 @@ func (a *mockAuctioneer) ModifyAccount(_ context.Context, _ *Account,
 		a.outputsReceived = append(a.outputsReceived, *output)
 	}
 
-	return []byte("auctioneer sig"), nil
+	return []byte("auctioneer sig"), nil, nil
 }
 
 func (a *mockAuctioneer) StartAccountSubscription(_ context.Context,
@@ -234,13 +401,37 @@ type mockWallet struct {
 	fundPsbt          *psbt.Packet
 	fundPsbtChangeIdx int32
 
+	// fundPsbtInputs are the inputs of the template of the last FundPsbt
+	// call.
+	fundPsbtInputs []wire.OutPoint
+
+	// leases are the outputs that are currently leased, along with the
+	// lease time they were leased for.
+	leases map[wire.OutPoint]time.Duration
+
 	sendOutputs func(context.Context, []*wire.TxOut,
 		chainfee.SatPerKWeight) (*wire.MsgTx, error)
+
+	// bumpableOutput is the only output the mock wallet accepts for fee
+	// bumps, all others are reported as not belonging to the wallet.
+	bumpableOutput wire.OutPoint
+
+	// publishErr, if set, is returned when publishing a transaction
+	// instead of broadcasting it.
+	publishErr error
+
+	mu      sync.Mutex
+	feeRate chainfee.SatPerKWeight
 }
 
+// mockChangeValue is the value of the change output the mock wallet adds when
+// funding a PSBT template.
+const mockChangeValue = 10_000
+
 func newMockWallet() *mockWallet {
 	return &mockWallet{
 		publishChan: make(chan *wire.MsgTx, 1),
+		leases:      make(map[wire.OutPoint]time.Duration),
 	}
 }
 
@@ -260,6 +451,10 @@ func (w *mockWallet) DeriveSharedKey(ctx context.Context,
 func (w *mockWallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx,
 	label string) error {
 
+	if w.publishErr != nil {
+		return w.publishErr
+	}
+
 	w.publishChan <- tx
 	return nil
 }
@@ -312,14 +507,24 @@ func (w *mockWallet) ListUnspent(_ context.Context, _, _ int32) (
 }
 
 func (w *mockWallet) LeaseOutput(_ context.Context, lockID wtxmgr.LockID,
-	op wire.OutPoint, _ time.Duration) (time.Time, error) {
+	op wire.OutPoint, leaseTime time.Duration) (time.Time, error) {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
 
-	return time.Now().Add(10 * time.Minute), nil
+	w.leases[op] = leaseTime
+
+	return time.Now().Add(leaseTime), nil
 }
 
 func (w *mockWallet) ReleaseOutput(_ context.Context, lockID wtxmgr.LockID,
 	op wire.OutPoint) error {
 
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.leases, op)
+
 	return nil
 }
 
@@ -344,7 +549,20 @@ func (w *mockWallet) ComputeInputScript(context.Context, *wire.MsgTx,
 func (w *mockWallet) EstimateFeeRate(_ context.Context,
 	_ int32) (chainfee.SatPerKWeight, error) {
 
-	return chainfee.FeePerKwFloor, nil
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.feeRate == 0 {
+		return chainfee.FeePerKwFloor, nil
+	}
+	return w.feeRate, nil
+}
+
+func (w *mockWallet) setFeeRate(feeRate chainfee.SatPerKWeight) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.feeRate = feeRate
 }
 
 func (w *mockWallet) EstimateFeeToP2WSH(_ context.Context, _ btcutil.Amount,
@@ -357,7 +575,34 @@ func (w *mockWallet) FundPsbt(_ context.Context,
 	req *walletrpc.FundPsbtRequest) (*psbt.Packet, int32,
 	[]*walletrpc.UtxoLease, error) {
 
-	return w.fundPsbt, w.fundPsbtChangeIdx, nil, nil
+	tpl := req.Template.(*walletrpc.FundPsbtRequest_Psbt)
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(tpl.Psbt), false)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	w.mu.Lock()
+	w.fundPsbtInputs = nil
+	for _, txIn := range packet.UnsignedTx.TxIn {
+		w.fundPsbtInputs = append(
+			w.fundPsbtInputs, txIn.PreviousOutPoint,
+		)
+	}
+	w.mu.Unlock()
+
+	if w.fundPsbt != nil {
+		return w.fundPsbt, w.fundPsbtChangeIdx, nil, nil
+	}
+
+	// Without a prepared packet, we use the template with its inputs and
+	// add an np2wkh change output to it.
+	packet.UnsignedTx.TxOut = append(packet.UnsignedTx.TxOut, &wire.TxOut{
+		Value:    mockChangeValue,
+		PkScript: np2wpkh,
+	})
+	packet.Outputs = append(packet.Outputs, psbt.POutput{})
+
+	return packet, int32(len(packet.UnsignedTx.TxOut) - 1), nil, nil
 }
 
 func (w *mockWallet) SignPsbt(_ context.Context,
@@ -376,6 +621,16 @@ func (w *mockWallet) SignPsbt(_ context.Context,
 	return packet, nil
 }
 
+func (w *mockWallet) BumpFee(_ context.Context, op wire.OutPoint,
+	_ chainfee.SatPerKWeight) error {
+
+	if op != w.bumpableOutput {
+		return lnwallet.ErrNotMine
+	}
+
+	return nil
+}
+
 func (w *mockWallet) FinalizePsbt(_ context.Context, packet *psbt.Packet,
 	account string) (*psbt.Packet, *wire.MsgTx, error) {
 
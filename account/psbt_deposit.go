@@ -0,0 +1,264 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/btcutil/txsort"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// DepositAccountPsbt initiates a deposit into the account associated with the
+// given trader key that is funded by an external wallet. The returned PSBT
+// spends the current account output into the new account output. The external
+// wallet needs to add its inputs and any change outputs, sign its inputs and
+// hand the PSBT back through FinalizeDeposit.
+//
+// NOTE: Because the auctioneer reconstructs the deposit transaction on its
+// side, the funded transaction must keep a version of 2 and a lock time of 0,
+// use a sequence of 0 for all inputs and be sorted according to BIP-69.
+func (m *manager) DepositAccountPsbt(ctx context.Context,
+	traderKey *btcec.PublicKey, depositAmount btcutil.Amount, bestHeight,
+	expiryHeight uint32) (*psbt.Packet, error) {
+
+	if depositAmount == 0 {
+		return nil, errors.New("deposit amount must be greater than " +
+			"zero")
+	}
+
+	// The account can only be modified in `StateOpen` and its new value
+	// should not exceed the maximum allowed.
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+	if account.State != StateOpen {
+		return nil, fmt.Errorf("account must be in %v to be "+
+			"modified", StateOpen)
+	}
+
+	terms, err := m.cfg.Auctioneer.Terms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not query auctioneer terms: %v",
+			err)
+	}
+
+	newAccountValue := account.Value + depositAmount
+	if newAccountValue > terms.MaxAccountValue {
+		return nil, fmt.Errorf("new account value is above accepted "+
+			"maximum of %v", terms.MaxAccountValue)
+	}
+
+	if expiryHeight != 0 {
+		err := validateAccountExpiry(expiryHeight, bestHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deposit := &PendingDeposit{
+		TraderKey: traderKey,
+		OutPoint:  account.OutPoint,
+		Value:     newAccountValue,
+		Expiry:    expiryHeight,
+	}
+	newAccountOutput, _, err := createNewAccountOutput(
+		account, deposit.Value, deposit.newExpiry(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := psbt.New(
+		[]*wire.OutPoint{&account.OutPoint},
+		[]*wire.TxOut{newAccountOutput}, 2, 0, []uint32{0},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating deposit PSBT: %v", err)
+	}
+
+	// Some signers, for example for Taproot inputs, need to know all the
+	// previous outputs of a transaction, so we include the account's.
+	packet.Inputs[0].WitnessUtxo, err = account.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	// We persist the deposit before handing out the PSBT so it can still
+	// be finalized if we're restarted in the meantime.
+	if err := m.cfg.Store.StorePendingDeposit(deposit); err != nil {
+		return nil, err
+	}
+
+	return packet, nil
+}
+
+// FinalizeDeposit completes the pending deposit of the account associated
+// with the given trader key. The PSBT must have been funded and signed by the
+// external wallet. After making sure it creates exactly the expected account
+// output, we add our and the auctioneer's signature for the account input and
+// broadcast the deposit transaction.
+func (m *manager) FinalizeDeposit(ctx context.Context,
+	traderKey *btcec.PublicKey, packet *psbt.Packet,
+	bestHeight uint32) (*Account, *wire.MsgTx, error) {
+
+	deposit, err := m.cfg.Store.PendingDeposit(traderKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account.State != StateOpen {
+		return nil, nil, fmt.Errorf("account must be in %v to be "+
+			"modified", StateOpen)
+	}
+
+	// If the account was spent since the deposit was initiated, the
+	// external signatures commit to an outdated account input and the
+	// deposit can never succeed, so we get rid of it.
+	if account.OutPoint != deposit.OutPoint {
+		err := m.cfg.Store.DeletePendingDeposit(traderKey)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return nil, nil, fmt.Errorf("account was modified since the "+
+			"deposit was initiated, current outpoint %v doesn't "+
+			"match %v", account.OutPoint, deposit.OutPoint)
+	}
+
+	newAccountOutput, modifiers, err := createNewAccountOutput(
+		account, deposit.Value, deposit.newExpiry(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = validateDepositPsbt(account, packet, newAccountOutput)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The external wallet might not have kept the previous output of our
+	// account input around, but we need it for signing.
+	accountInputIdx, err := locateAccountInput(packet.UnsignedTx, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	packet.Inputs[accountInputIdx].WitnessUtxo, err = account.Output()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modifiers = append(modifiers, StateModifier(StatePendingUpdate))
+	modifiedAccount, spendPkg, err := m.spendAccount(
+		ctx, account, packet, multiSigWitness, modifiers, false,
+		bestHeight, nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The deposit transaction is now stored as the account's latest
+	// transaction and will be re-published on restart if necessary.
+	if err := m.cfg.Store.DeletePendingDeposit(traderKey); err != nil {
+		log.Errorf("Unable to remove finalized pending deposit of "+
+			"account %x: %v", traderKey.SerializeCompressed(), err)
+	}
+
+	return modifiedAccount, spendPkg.tx, nil
+}
+
+// newExpiry returns the new expiry of the account after the deposit or nil if
+// it stays the same.
+func (d *PendingDeposit) newExpiry() *uint32 {
+	if d.Expiry == 0 {
+		return nil
+	}
+
+	expiry := d.Expiry
+	return &expiry
+}
+
+// validateDepositPsbt makes sure an externally funded deposit PSBT spends the
+// account into exactly the expected new account output and is constructed in
+// a way that allows the auctioneer to reconstruct the same transaction.
+func validateDepositPsbt(account *Account, packet *psbt.Packet,
+	newAccountOutput *wire.TxOut) error {
+
+	tx := packet.UnsignedTx
+	if tx.Version != 2 {
+		return fmt.Errorf("deposit transaction must have version 2, "+
+			"got %d", tx.Version)
+	}
+	if tx.LockTime != 0 {
+		return fmt.Errorf("deposit transaction must have a lock time "+
+			"of 0, got %d", tx.LockTime)
+	}
+	if len(tx.TxIn) < 2 {
+		return errors.New("deposit transaction is missing funding " +
+			"inputs")
+	}
+	if !txsort.IsSorted(tx) {
+		return errors.New("deposit transaction inputs and outputs " +
+			"must be sorted according to BIP-69")
+	}
+
+	var numAccountInputs int
+	for idx, txIn := range tx.TxIn {
+		if txIn.Sequence != 0 {
+			return fmt.Errorf("input %d must have a sequence of "+
+				"0, got %d", idx, txIn.Sequence)
+		}
+
+		if txIn.PreviousOutPoint == account.OutPoint {
+			numAccountInputs++
+			continue
+		}
+
+		// All other inputs belong to the external wallet and must
+		// already be signed.
+		pIn := packet.Inputs[idx]
+		if pIn.WitnessUtxo == nil {
+			return fmt.Errorf("input %d is missing its witness "+
+				"UTXO", idx)
+		}
+		if len(pIn.FinalScriptWitness) == 0 &&
+			len(pIn.FinalScriptSig) == 0 {
+
+			return fmt.Errorf("input %d is not signed", idx)
+		}
+	}
+	if numAccountInputs != 1 {
+		return fmt.Errorf("deposit transaction must spend the "+
+			"account %v exactly once", account.OutPoint)
+	}
+
+	var numAccountOutputs int
+	for _, txOut := range tx.TxOut {
+		if !bytes.Equal(txOut.PkScript, newAccountOutput.PkScript) {
+			continue
+		}
+
+		numAccountOutputs++
+		if txOut.Value != newAccountOutput.Value {
+			return fmt.Errorf("account output has value %v, "+
+				"expected %v", btcutil.Amount(txOut.Value),
+				btcutil.Amount(newAccountOutput.Value))
+		}
+	}
+	if numAccountOutputs != 1 {
+		return fmt.Errorf("deposit transaction must contain exactly "+
+			"one account output, found %d", numAccountOutputs)
+	}
+
+	return nil
+}
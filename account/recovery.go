@@ -3,6 +3,7 @@ package account
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -25,6 +26,10 @@ var (
 	// necessarily make as many requests on recovery, if no accounts are
 	// found for a certain number of tries.
 	DefaultAccountKeyWindow uint32 = 500
+
+	// ErrNoRecoveryCheckpoint is the error returned if there is no
+	// checkpoint of an interrupted recovery to resume from.
+	ErrNoRecoveryCheckpoint = errors.New("no recovery checkpoint found")
 )
 
 const (
@@ -43,8 +48,43 @@ const (
 	//
 	// NOTE: currently there are about 1100 batches (Jan 2022) on mainnet.
 	maxBatchCounter = 5000
+
+	// blockScanProgressInterval is the number of blocks after which the
+	// progress of scanning the chain for account updates is reported.
+	blockScanProgressInterval = 1000
 )
 
+// RecoveryCheckpoint is the persisted progress of an account recovery that
+// allows an interrupted recovery to be resumed, for example after a restart.
+type RecoveryCheckpoint struct {
+	// StartIndex is the first account key index of the recovery.
+	StartIndex uint32
+
+	// EndIndex is the account key index at which the recovery stops. The
+	// key with this index itself isn't tried anymore.
+	EndIndex uint32
+
+	// AccountTarget is the number of accounts after which the recovery
+	// stops, even if not all keys were tried.
+	AccountTarget uint32
+
+	// FullClient is true if the accounts are recovered by scanning the
+	// chain instead of asking the auctioneer.
+	FullClient bool
+
+	// FullRange is true if all keys of the range must be tried. Otherwise
+	// the server assisted recovery stops after a number of successive
+	// keys the auctioneer doesn't know of.
+	FullRange bool
+
+	// NextIndex is the index of the next account key to try.
+	NextIndex uint32
+
+	// AccountsFound is the number of accounts that were found and stored
+	// so far.
+	AccountsFound uint32
+}
+
 // GetAuctioneerData returns the auctioneer data for a given environment.
 func GetAuctioneerData(network string) (string, uint32) {
 	var auctioneerKey string
@@ -110,6 +150,14 @@ type RecoveryConfig struct {
 	// Number of accounts that we are trying to find.
 	AccountTarget uint32
 
+	// StartIndex is the first account key index that is tried.
+	StartIndex uint32
+
+	// EndIndex is the account key index at which we stop trying keys. The
+	// key with this index itself isn't tried. If zero, AccountTarget keys
+	// are tried starting at StartIndex.
+	EndIndex uint32
+
 	// FirstBlock block marks the initial height for our search.
 	FirstBlock uint32
 
@@ -148,6 +196,20 @@ type RecoveryConfig struct {
 
 	// Quit is a channel that is closed when the server is shutting down.
 	Quit chan struct{}
+
+	// OnBlockScanned is an optional callback that is invoked periodically
+	// with the height up to which the chain was scanned for account
+	// updates.
+	OnBlockScanned func(height uint32)
+}
+
+// keyRange returns the range of account key indexes to try.
+func (cfg *RecoveryConfig) keyRange() (uint32, uint32) {
+	if cfg.EndIndex == 0 {
+		return cfg.StartIndex, cfg.StartIndex + cfg.AccountTarget
+	}
+
+	return cfg.StartIndex, cfg.EndIndex
 }
 
 // getBitcoinConn returns a new rpc client for the btc backend node.
@@ -228,6 +290,12 @@ func RecoverAccounts(ctx context.Context, cfg RecoveryConfig) ([]*Account,
 	default:
 	}
 
+	// There's no need to scan the chain for updates if none of the keys
+	// was ever used for an account.
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+
 	return updateAccountStates(cfg, accounts)
 }
 
@@ -255,12 +323,13 @@ func recoverInitialState(ctx context.Context, cfg RecoveryConfig) ([]*Account,
 func recreatePossibleAccounts(ctx context.Context,
 	cfg RecoveryConfig) ([]*Account, error) {
 
-	possibleAccounts := make([]*Account, 0, cfg.AccountTarget)
+	startIndex, endIndex := cfg.keyRange()
+	possibleAccounts := make([]*Account, 0, endIndex-startIndex)
 
 	// Prepare the keys we are going to try. Possibly not all of them will
 	// be used.
-	KeyDescriptors, err := GenerateRecoveryKeys(
-		ctx, cfg.AccountTarget, cfg.Wallet,
+	KeyDescriptors, err := GenerateRecoveryKeyRange(
+		ctx, startIndex, endIndex, cfg.Wallet,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error generating keys: %v", err)
@@ -331,7 +400,7 @@ func findAccounts(cfg RecoveryConfig, possibleAccounts []*Account) []*Account {
 				default:
 				}
 
-				tx, idx, ok, err := helper.LocateAnyOutput(
+				tx, idx, version, ok, err := helper.LocateAnyOutput(
 					h, cfg.Transactions,
 				)
 				if err != nil {
@@ -368,6 +437,7 @@ func findAccounts(cfg RecoveryConfig, possibleAccounts []*Account) []*Account {
 				}
 				acc.LatestTx = tx
 				acc.State = StateOpen
+				acc.Version = versionFromScript(version)
 
 				// Remove the current account, so we don't try
 				// to find it again.
@@ -403,6 +473,13 @@ func updateAccountStates(cfg RecoveryConfig,
 	for i := cfg.FirstBlock; i < uint32(cfg.CurrentBlockHeight); i++ {
 		blockHeight := i
 
+		scanned := blockHeight - cfg.FirstBlock
+		if cfg.OnBlockScanned != nil &&
+			scanned%blockScanProgressInterval == 0 {
+
+			cfg.OnBlockScanned(blockHeight)
+		}
+
 		txs, err := getBlockTxs(cfg.bitcoinClient, int64(blockHeight))
 		if err != nil {
 			return nil, err
@@ -415,15 +492,16 @@ func updateAccountStates(cfg RecoveryConfig,
 				continue
 			}
 
-			tx, found := poolscript.MatchPreviousOutPoint(
-				acc.OutPoint, txs,
-			)
-			if !found {
+			tx, spend, err := findAccountSpend(acc, txs)
+			if err != nil {
+				return nil, err
+			}
+			if tx == nil {
 				// Go to next account.
 				continue
 			}
 
-			newAcc, err := findAccountUpdate(cfg, acc, tx)
+			newAcc, err := findAccountUpdate(cfg, acc, tx, spend)
 			if err != nil {
 				// If we cannot find the account update
 				// we assume it was closed/spent.
@@ -444,44 +522,78 @@ func updateAccountStates(cfg RecoveryConfig,
 			accounts[idx] = newAcc
 		}
 	}
+
+	if cfg.OnBlockScanned != nil {
+		cfg.OnBlockScanned(uint32(cfg.CurrentBlockHeight))
+	}
+
 	return accounts, nil
 }
 
-// findAccountUpdate tries to find the new account values after an update.
-func findAccountUpdate(cfg RecoveryConfig, acc *Account,
-	tx *wire.MsgTx) (*Account, error) {
-
-	newAcc := acc.Copy()
-	newAcc.BatchKey = poolscript.IncrementKey(newAcc.BatchKey)
+// findAccountSpend looks for the transaction that spends the current output
+// of the account among the given transactions. If none of them spends it, a
+// nil transaction is returned.
+func findAccountSpend(acc *Account, txs []*wire.MsgTx) (*wire.MsgTx,
+	*poolscript.SpendClassification, error) {
+
+	params := &poolscript.AccountParams{
+		OutPoint:      acc.OutPoint,
+		Expiry:        acc.Expiry,
+		TraderKey:     acc.TraderKey.PubKey,
+		AuctioneerKey: acc.AuctioneerKey,
+	}
+	for _, tx := range txs {
+		spend, err := poolscript.ClassifySpend(
+			tx, params, acc.BatchKey, acc.Secret,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	helper := &poolscript.RecoveryHelper{
-		BatchKey:      newAcc.BatchKey,
-		AuctioneerKey: cfg.AuctioneerPubKey,
+		if spend.Path != poolscript.SpendPathNone {
+			return tx, spend, nil
+		}
 	}
 
-	helper.NextAccount(acc.TraderKey.PubKey, acc.Secret)
-	// We only have a tx so if there is a match we know what tx it is.
-	_, idx, ok, err := helper.LocateAnyOutput(
-		newAcc.Expiry, []*wire.MsgTx{tx},
-	)
-	if err != nil {
+	return nil, nil, nil
+}
+
+// findAccountUpdate tries to find the new account values after an update.
+func findAccountUpdate(cfg RecoveryConfig, acc *Account, tx *wire.MsgTx,
+	spend *poolscript.SpendClassification) (*Account, error) {
+
+	// The expiry path always fully spends the account, so there is no
+	// update to find.
+	if spend.Path != poolscript.SpendPathMultiSig {
 		return nil, fmt.Errorf("account update not found")
 	}
-	if ok {
+
+	newAcc := acc.Copy()
+	newAcc.BatchKey = poolscript.IncrementKey(newAcc.BatchKey)
+
+	if spend.Recreated {
+		idx := spend.NextOutputIndex
 		newAcc.Value = btcutil.Amount(tx.TxOut[idx].Value)
 		newAcc.OutPoint = wire.OutPoint{
 			Hash:  tx.TxHash(),
 			Index: idx,
 		}
 		newAcc.LatestTx = tx
+		newAcc.Version = versionFromScript(spend.NextVersion)
 
 		return newAcc, nil
 	}
 
+	helper := &poolscript.RecoveryHelper{
+		BatchKey:      newAcc.BatchKey,
+		AuctioneerKey: cfg.AuctioneerPubKey,
+	}
+	helper.NextAccount(acc.TraderKey.PubKey, acc.Secret)
+
 	// If the update included a new expiration date we need to brute force
 	// our new expiration date again.
 	for height := cfg.FirstBlock; height <= cfg.LastBlock; height++ {
-		_, idx, ok, err := helper.LocateAnyOutput(
+		_, idx, version, ok, err := helper.LocateAnyOutput(
 			height, []*wire.MsgTx{tx},
 		)
 		if err != nil {
@@ -498,6 +610,7 @@ func findAccountUpdate(cfg RecoveryConfig, acc *Account,
 			Index: idx,
 		}
 		newAcc.LatestTx = tx
+		newAcc.Version = versionFromScript(version)
 
 		return newAcc, nil
 	}
@@ -510,8 +623,22 @@ func findAccountUpdate(cfg RecoveryConfig, acc *Account,
 func GenerateRecoveryKeys(ctx context.Context, accountTarget uint32,
 	wallet lndclient.WalletKitClient) ([]*keychain.KeyDescriptor, error) {
 
-	acctKeys := make([]*keychain.KeyDescriptor, accountTarget)
-	for i := uint32(0); i < accountTarget; i++ {
+	return GenerateRecoveryKeyRange(ctx, 0, accountTarget, wallet)
+}
+
+// GenerateRecoveryKeyRange generates the key descriptors of all account keys
+// with an index in the range [startIndex, endIndex).
+func GenerateRecoveryKeyRange(ctx context.Context, startIndex,
+	endIndex uint32,
+	wallet lndclient.WalletKitClient) ([]*keychain.KeyDescriptor, error) {
+
+	if endIndex < startIndex {
+		return nil, fmt.Errorf("invalid key range, end index %d is "+
+			"smaller than start index %d", endIndex, startIndex)
+	}
+
+	acctKeys := make([]*keychain.KeyDescriptor, 0, endIndex-startIndex)
+	for i := startIndex; i < endIndex; i++ {
 		key, err := wallet.DeriveKey(ctx, &keychain.KeyLocator{
 			Family: poolscript.AccountKeyFamily,
 			Index:  i,
@@ -520,7 +647,7 @@ func GenerateRecoveryKeys(ctx context.Context, accountTarget uint32,
 			return nil, err
 		}
 
-		acctKeys[i] = key
+		acctKeys = append(acctKeys, key)
 	}
 	return acctKeys, nil
 }
@@ -113,6 +113,7 @@ func TestFindInitialAccountState(t *testing.T) {
 					tc.config.InitialBatchKey,
 				)
 				script, _ := poolscript.AccountScript(
+					poolscript.VersionWitnessScript,
 					177, acc.TraderKey.PubKey,
 					tc.config.AuctioneerPubKey,
 					batchKey, acc.Secret,
@@ -143,6 +144,7 @@ var findAccountUpdateTestCases = []struct {
 	traderKey      string
 	expectedExpiry uint32
 	expectedValue  int64
+	expirySpend    bool
 	expectedError  string
 }{{
 	name: "match account update with the same expiry height",
@@ -200,6 +202,19 @@ var findAccountUpdateTestCases = []struct {
 	expectedExpiry: 220,
 	expectedValue:  100,
 	expectedError:  "account update not found",
+}, {
+	name: "account spent through expiry path returns an error",
+	config: RecoveryConfig{
+		FirstBlock:       100,
+		LastBlock:        200,
+		AuctioneerPubKey: getAuctioneerKey(),
+	},
+	traderKey: "0214cd678a565041d00e6cf8d62ef8add33b4af4786fb2beb87b366" +
+		"a2e151fcee7",
+	expectedExpiry: 120,
+	expectedValue:  100,
+	expirySpend:    true,
+	expectedError:  "account update not found",
 }}
 
 // TestFindAccountUpdate checks that we are able to find the changes of
@@ -218,11 +233,21 @@ func TestFindAccountUpdate(t *testing.T) {
 
 			batchKey := poolscript.IncrementKey(acc.BatchKey)
 			script, _ := poolscript.AccountScript(
+				poolscript.VersionWitnessScript,
 				tc.expectedExpiry, acc.TraderKey.PubKey,
 				acc.AuctioneerKey, batchKey, acc.Secret,
 			)
 
+			sig := []byte{1}
+			witness := poolscript.SpendMultiSig(nil, sig, sig)
+			if tc.expirySpend {
+				witness = poolscript.SpendExpiry(nil, sig)
+			}
 			tx := &wire.MsgTx{
+				TxIn: []*wire.TxIn{{
+					PreviousOutPoint: acc.OutPoint,
+					Witness:          witness,
+				}},
 				TxOut: []*wire.TxOut{
 					{
 						Value:    tc.expectedValue,
@@ -231,8 +256,14 @@ func TestFindAccountUpdate(t *testing.T) {
 				},
 			}
 
+			spendTx, spend, err := findAccountSpend(
+				acc, []*wire.MsgTx{tx},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tx, spendTx)
+
 			res, err := findAccountUpdate(
-				tc.config, acc, tx,
+				tc.config, acc, tx, spend,
 			)
 			if tc.expectedError != "" {
 				assert.EqualError(t, err, tc.expectedError)
@@ -267,3 +298,27 @@ func TestGenerateRecoveryKeys(t *testing.T) {
 			len(keys), DefaultAccountKeyWindow)
 	}
 }
+
+// TestGenerateRecoveryKeyRange tests that the keys of a specific index range
+// can be created for account recovery.
+func TestGenerateRecoveryKeyRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	walletKit := test.NewMockWalletKit()
+	keys, err := GenerateRecoveryKeyRange(ctx, 10, 15, walletKit)
+	require.NoError(t, err)
+	require.Len(t, keys, 5)
+	for i, key := range keys {
+		require.Equal(t, uint32(10+i), key.Index)
+		require.Equal(t, poolscript.AccountKeyFamily, key.Family)
+	}
+
+	// An empty range results in no keys, an inverted one in an error.
+	keys, err = GenerateRecoveryKeyRange(ctx, 10, 10, walletKit)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	_, err = GenerateRecoveryKeyRange(ctx, 10, 5, walletKit)
+	require.Error(t, err)
+}
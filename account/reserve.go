@@ -0,0 +1,64 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// reserveFeeRateMultiplier is applied to the current fee estimate when
+	// computing the default reserve of an account. This leaves some room
+	// for fees to rise before the account needs to be swept.
+	reserveFeeRateMultiplier = 2
+)
+
+// DefaultReserve returns the default reserve of an account for the given fee
+// rate. It covers the fee of sweeping the account through its expiry path to a
+// P2WKH output at a multiple of the fee rate and makes sure the swept output
+// isn't dust.
+func DefaultReserve(feeRate chainfee.SatPerKWeight) btcutil.Amount {
+	var weightEstimator input.TxWeightEstimator
+	weightEstimator.AddWitnessInput(poolscript.ExpiryWitnessSize)
+	weightEstimator.AddP2WKHOutput()
+
+	sweepFeeRate := feeRate * reserveFeeRateMultiplier
+	sweepFee := sweepFeeRate.FeeForWeight(int64(weightEstimator.Weight()))
+
+	return sweepFee + lnwallet.DustLimitForSize(input.P2WPKHSize)
+}
+
+// UpdateReserve updates the reserve of the account associated with the given
+// trader key. Orders may not spend into the reserve, so it can't be larger
+// than the account's current value.
+func (m *manager) UpdateReserve(traderKey *btcec.PublicKey,
+	reserve btcutil.Amount) (*Account, error) {
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch account.State {
+	case StatePendingClosed, StateClosed, StateCanceledAfterRecovery:
+		return nil, fmt.Errorf("cannot update reserve of account in "+
+			"state %v", account.State)
+	}
+
+	if reserve < 0 || reserve > account.Value {
+		return nil, fmt.Errorf("invalid reserve %v, must be between 0 "+
+			"and the account value %v", reserve, account.Value)
+	}
+
+	modifier := ReserveModifier(reserve)
+	if err := m.cfg.Store.UpdateAccount(account, modifier); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,187 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// scheduledWithdrawalConfTarget is the confirmation target used to
+	// estimate the fee rate that is compared against the maximum fee rate
+	// of scheduled withdrawals.
+	scheduledWithdrawalConfTarget = 6
+)
+
+// ScheduleWithdraw schedules a withdrawal from the account associated with the
+// given trader key into the provided outputs. The withdrawal is executed once
+// the estimated fee rate is at or below maxFeeRate, unless the expiry time is
+// reached first. Any previously scheduled withdrawal of the account is
+// replaced.
+func (m *manager) ScheduleWithdraw(traderKey *btcec.PublicKey,
+	outputs []*wire.TxOut, maxFeeRate chainfee.SatPerKWeight,
+	expiry time.Time) (*ScheduledWithdrawal, error) {
+
+	if len(outputs) == 0 {
+		return nil, errors.New("missing outputs for withdrawal")
+	}
+	if maxFeeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("max fee rate of %d sat/kw is too low, "+
+			"minimum is %d sat/kw", maxFeeRate,
+			chainfee.FeePerKwFloor)
+	}
+	if !expiry.After(time.Now()) {
+		return nil, errors.New("expiry must be in the future")
+	}
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+	switch account.State {
+	case StatePendingClosed, StateClosed, StateCanceledAfterRecovery:
+		return nil, fmt.Errorf("cannot schedule withdrawal from "+
+			"account in state %v", account.State)
+	}
+
+	// Make sure the account can afford the withdrawal at the maximum fee
+	// rate so we don't keep a withdrawal around that can never succeed.
+	_, err = valueAfterAccountUpdate(
+		account, outputs, multiSigWitness, maxFeeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawal := &ScheduledWithdrawal{
+		TraderKey:  traderKey,
+		Outputs:    outputs,
+		MaxFeeRate: maxFeeRate,
+		Expiry:     expiry,
+	}
+	if err := m.cfg.Store.StoreScheduledWithdrawal(withdrawal); err != nil {
+		return nil, err
+	}
+
+	return withdrawal, nil
+}
+
+// ScheduledWithdrawals returns all withdrawals that are scheduled but weren't
+// executed yet.
+func (m *manager) ScheduledWithdrawals() ([]*ScheduledWithdrawal, error) {
+	return m.cfg.Store.ScheduledWithdrawals()
+}
+
+// CancelScheduledWithdraw cancels the scheduled withdrawal of the account
+// associated with the given trader key.
+func (m *manager) CancelScheduledWithdraw(traderKey *btcec.PublicKey) error {
+	return m.cfg.Store.DeleteScheduledWithdrawal(traderKey)
+}
+
+// executeScheduledWithdrawals executes all scheduled withdrawals whose maximum
+// fee rate is at or above the current fee estimate. Withdrawals from accounts
+// that are currently involved in a batch or otherwise pending are deferred
+// until the account is open again. Expired withdrawals and those of closed
+// accounts are removed.
+func (m *manager) executeScheduledWithdrawals(bestHeight uint32) {
+	withdrawals, err := m.cfg.Store.ScheduledWithdrawals()
+	if err != nil {
+		log.Errorf("Unable to retrieve scheduled withdrawals: %v", err)
+		return
+	}
+	if len(withdrawals) == 0 {
+		return
+	}
+
+	pending, err := m.hasPendingBatch()
+	if err != nil {
+		log.Errorf("Unable to determine pending batch for scheduled "+
+			"withdrawals: %v", err)
+		return
+	}
+	if pending {
+		log.Debugf("Deferring %d scheduled withdrawal(s) until pending "+
+			"batch is resolved", len(withdrawals))
+		return
+	}
+
+	ctx := context.Background()
+	feeRate, err := m.cfg.Wallet.EstimateFeeRate(
+		ctx, scheduledWithdrawalConfTarget,
+	)
+	if err != nil {
+		log.Errorf("Unable to estimate fee rate for scheduled "+
+			"withdrawals: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, withdrawal := range withdrawals {
+		traderKey := withdrawal.TraderKey
+		rawTraderKey := traderKey.SerializeCompressed()
+
+		account, err := m.cfg.Store.Account(traderKey)
+		if err != nil {
+			log.Errorf("Unable to retrieve account %x for scheduled "+
+				"withdrawal: %v", rawTraderKey, err)
+			continue
+		}
+
+		var remove bool
+		switch {
+		case now.After(withdrawal.Expiry):
+			log.Infof("Scheduled withdrawal from account %x expired "+
+				"at %v", rawTraderKey, withdrawal.Expiry)
+			remove = true
+
+		case account.State == StatePendingClosed ||
+			account.State == StateClosed ||
+			account.State == StateCanceledAfterRecovery:
+
+			log.Infof("Removing scheduled withdrawal from closed "+
+				"account %x", rawTraderKey)
+			remove = true
+
+		// The account is part of a batch or another modification is
+		// still confirming. We keep the withdrawal around and try
+		// again once the account is open again.
+		case account.State != StateOpen:
+			continue
+
+		case feeRate > withdrawal.MaxFeeRate:
+			continue
+		}
+
+		if remove {
+			err := m.cfg.Store.DeleteScheduledWithdrawal(traderKey)
+			if err != nil {
+				log.Errorf("Unable to remove scheduled "+
+					"withdrawal from account %x: %v",
+					rawTraderKey, err)
+			}
+			continue
+		}
+
+		log.Infof("Executing scheduled withdrawal from account %x at "+
+			"fee rate %v (max %v)", rawTraderKey, feeRate,
+			withdrawal.MaxFeeRate)
+
+		// The withdrawal is removed in the same store update that
+		// marks the account as pending, before its transaction is
+		// broadcast. That way it can never be executed twice, even if
+		// we crash right after broadcasting.
+		_, _, _, err = m.withdrawAccount(
+			ctx, traderKey, withdrawal.Outputs, feeRate, bestHeight,
+			0, nil, false, m.cfg.Store.CompleteScheduledWithdrawal,
+		)
+		if err != nil {
+			log.Errorf("Unable to execute scheduled withdrawal from "+
+				"account %x: %v", rawTraderKey, err)
+		}
+	}
+}
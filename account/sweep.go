@@ -0,0 +1,129 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrAccountSpent is returned if an account can't be swept because its
+	// output was already spent by another transaction.
+	ErrAccountSpent = errors.New("account output already spent")
+)
+
+// SweepExpired sweeps the full value of an expired account to the given
+// address, or the backing lnd node's wallet if none is provided, by using the
+// account's expiry path. This doesn't require any cooperation from the
+// auctioneer and can therefore be used to recover the funds if the auctioneer
+// is unavailable. The account is marked as pending
+// closed once the sweep transaction is published and will be marked as closed
+// by the spend watcher once the transaction confirms.
+func (m *manager) SweepExpired(ctx context.Context, traderKey *btcec.PublicKey,
+	destAddr btcutil.Address, feeRate chainfee.SatPerKWeight,
+	bestHeight uint32) (*wire.MsgTx, error) {
+
+	account, err := m.cfg.Store.Account(traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch account.State {
+	case StateInitiated, StateCanceledAfterRecovery:
+		return nil, fmt.Errorf("account in state %v has no output to "+
+			"sweep", account.State)
+
+	case StateClosed:
+		return nil, ErrAccountSpent
+	}
+
+	// The expiry path of the account script can only be used once the
+	// account's CLTV has been reached.
+	if bestHeight < account.Expiry {
+		return nil, fmt.Errorf("account can't be swept before it "+
+			"expires at height %d, current height is %d",
+			account.Expiry, bestHeight)
+	}
+
+	// If no destination address was provided, we'll sweep the funds back
+	// into the backing lnd node's wallet.
+	if destAddr == nil {
+		changeType := walletrpc.AddressType_WITNESS_PUBKEY_HASH
+		destAddr, err = m.cfg.Wallet.NextAddr(
+			ctx, "", changeType, false,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pkScript, err := txscript.PayToAddrScript(destAddr)
+	if err != nil {
+		return nil, err
+	}
+	feeExpr := &OutputWithFee{
+		PkScript: pkScript,
+		FeeRate:  feeRate,
+	}
+	sweepOutputs, err := feeExpr.CloseOutputs(
+		account.Value, expiryWitness, account.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := m.createSpendTx(account, sweepOutputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The expiry path of the account script only requires our signature,
+	// so the transaction is fully signed once this returns.
+	spendPkg, err := m.signSpendTx(
+		ctx, account, packet, bestHeight, expiryWitness, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike for the other account modifications, we publish the
+	// transaction before updating our on-disk state. The sweep is only an
+	// emergency measure and we don't want to overwrite the account's
+	// latest transaction if the output turns out to be spent already.
+	acctKey := account.TraderKey.PubKey.SerializeCompressed()
+	contextLabel := fmt.Sprintf(" poold -- AccountSweep(acct_key=%x)",
+		acctKey)
+	label := makeTxnLabel(m.cfg.TxLabelPrefix, contextLabel)
+
+	err = m.cfg.Wallet.PublishTransaction(ctx, spendPkg.tx, label)
+	if err != nil {
+		doubleSpendErr := lnwallet.ErrDoubleSpend.Error()
+		if strings.Contains(err.Error(), doubleSpendErr) {
+			return nil, ErrAccountSpent
+		}
+
+		return nil, fmt.Errorf("unable to publish sweep transaction: "+
+			"%v", err)
+	}
+
+	log.Infof("Published sweep transaction %v for expired account %x",
+		spendPkg.tx.TxHash(), acctKey)
+
+	err = m.cfg.Store.UpdateAccount(
+		account, ValueModifier(0), StateModifier(StatePendingClosed),
+		HeightHintModifier(bestHeight), LatestTxModifier(spendPkg.tx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return spendPkg.tx, nil
+}
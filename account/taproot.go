@@ -0,0 +1,240 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+)
+
+var (
+	// taprootLndVersion is the minimum lnd version required for taproot
+	// accounts. Those rely on the MuSig2 signing RPCs of lnd's signer.
+	taprootLndVersion = &verrpc.Version{
+		AppMajor: 0,
+		AppMinor: 15,
+		AppPatch: 1,
+	}
+)
+
+// negotiateVersion returns the version a new account should be created with.
+// Taproot accounts are only created if both the auctioneer and the connected
+// lnd node support them, otherwise we fall back to the initial version.
+func (m *manager) negotiateVersion(terms *terms.AuctioneerTerms) Version {
+	if !terms.TaprootAccounts {
+		return VersionInitialNoVersion
+	}
+
+	err := lndclient.AssertVersionCompatible(
+		m.cfg.LndVersion, taprootLndVersion,
+	)
+	if err != nil {
+		log.Infof("Auctioneer supports taproot accounts but connected "+
+			"lnd doesn't (%v), creating %v account", err,
+			VersionInitialNoVersion)
+
+		return VersionInitialNoVersion
+	}
+
+	return VersionTaprootEnabled
+}
+
+// taprootOutput returns the taproot output of the account's current batch
+// key.
+func (a *Account) taprootOutput() (*poolscript.TaprootAccount, error) {
+	return poolscript.NewTaprootAccount(
+		a.Expiry, a.TraderKey.PubKey, a.AuctioneerKey, a.BatchKey,
+		a.Secret,
+	)
+}
+
+// TaprootMuSig2Session creates a new MuSig2 signing session in the backing lnd
+// node for spending the current output of the given taproot account through
+// its key spend path. The returned session contains our public nonces that
+// need to be sent to the auctioneer.
+func TaprootMuSig2Session(ctx context.Context, signer lndclient.SignerClient,
+	account *Account) (*input.MuSig2SessionInfo, error) {
+
+	if account.Version != VersionTaprootEnabled {
+		return nil, fmt.Errorf("account of version %v doesn't support "+
+			"MuSig2", account.Version)
+	}
+
+	tapAccount, err := account.taprootOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.MuSig2CreateSession(
+		ctx, &account.TraderKey.KeyLocator,
+		poolscript.MuSig2SignerKeys(
+			account.TraderKey.PubKey, account.AuctioneerKey,
+		),
+		lndclient.MuSig2TaprootTweakOpt(tapAccount.RootHash(), false),
+	)
+}
+
+// TaprootMuSig2Sign registers the auctioneer's public nonces with the given
+// MuSig2 session and creates our partial signature for the account input at
+// the given index. The outputs spent by all inputs of the transaction are
+// required to create the taproot signature hash. If cleanup is set, the
+// session is removed after signing, meaning the partial signatures can't be
+// combined by lnd anymore.
+func TaprootMuSig2Sign(ctx context.Context, signer lndclient.SignerClient,
+	sessionID [32]byte, serverNonces []byte, tx *wire.MsgTx,
+	prevOutputs []*wire.TxOut, inputIdx int, cleanup bool) ([]byte,
+	error) {
+
+	var nonces poolscript.MuSig2Nonces
+	if len(serverNonces) != len(nonces) {
+		return nil, fmt.Errorf("invalid auctioneer nonces length %d",
+			len(serverNonces))
+	}
+	copy(nonces[:], serverNonces)
+
+	haveAllNonces, err := signer.MuSig2RegisterNonces(
+		ctx, sessionID, [][66]byte{nonces},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error registering auctioneer nonces: "+
+			"%v", err)
+	}
+	if !haveAllNonces {
+		return nil, fmt.Errorf("MuSig2 session is missing nonces")
+	}
+
+	sigHash, err := taprootSigHash(tx, prevOutputs, inputIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.MuSig2Sign(ctx, sessionID, sigHash, cleanup)
+}
+
+// taprootSigHash returns the taproot key spend signature hash of the input
+// with the given index.
+func taprootSigHash(tx *wire.MsgTx, prevOutputs []*wire.TxOut,
+	inputIdx int) ([32]byte, error) {
+
+	var sigHash [32]byte
+	if len(prevOutputs) != len(tx.TxIn) {
+		return sigHash, fmt.Errorf("got %d previous outputs for %d "+
+			"inputs", len(prevOutputs), len(tx.TxIn))
+	}
+
+	prevOutFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for idx, txIn := range tx.TxIn {
+		prevOutFetcher.AddPrevOut(
+			txIn.PreviousOutPoint, prevOutputs[idx],
+		)
+	}
+
+	hash, err := txscript.CalcTaprootSignatureHash(
+		txscript.NewTxSigHashes(tx, prevOutFetcher),
+		txscript.SigHashDefault, tx, inputIdx, prevOutFetcher,
+	)
+	if err != nil {
+		return sigHash, fmt.Errorf("error calculating sighash: %v", err)
+	}
+	copy(sigHash[:], hash)
+
+	return sigHash, nil
+}
+
+// signTaprootExpiry signs the account input at the given index of the given
+// transaction through the expiry script path of the taproot account and
+// returns the full witness.
+func (m *manager) signTaprootExpiry(ctx context.Context, account *Account,
+	tx *wire.MsgTx, prevOutputs []*wire.TxOut,
+	inputIdx int) (wire.TxWitness, error) {
+
+	tapAccount, err := account.taprootOutput()
+	if err != nil {
+		return nil, err
+	}
+	controlBlock, err := tapAccount.ControlBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	traderKeyTweak := poolscript.TraderKeyTweak(
+		account.BatchKey, account.Secret, account.TraderKey.PubKey,
+	)
+	leafScript := tapAccount.ExpiryLeaf.Script
+	sigs, err := m.cfg.Signer.SignOutputRaw(
+		ctx, tx, []*lndclient.SignDescriptor{{
+			KeyDesc:       *account.TraderKey,
+			SingleTweak:   traderKeyTweak,
+			WitnessScript: leafScript,
+			SignMethod:    input.TaprootScriptSpendSignMethod,
+			Output:        prevOutputs[inputIdx],
+			HashType:      txscript.SigHashDefault,
+			InputIndex:    inputIdx,
+		}}, prevOutputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return poolscript.SpendExpiryTaproot(
+		leafScript, sigs[0], controlBlock,
+	), nil
+}
+
+// signTaprootMultiSig creates the MuSig2 signature for the account input of
+// the given spend package together with the auctioneer and returns the full
+// witness. The modifyAccount closure sends our nonces to the auctioneer and
+// returns their partial signature and nonces.
+func (m *manager) signTaprootMultiSig(ctx context.Context, account *Account,
+	spendPkg *spendPackage, modifyAccount func([]byte,
+		[]*wire.TxOut) ([]byte, []byte, error)) (wire.TxWitness, error) {
+
+	session, err := TaprootMuSig2Session(ctx, m.cfg.Signer, account)
+	if err != nil {
+		return nil, fmt.Errorf("error creating MuSig2 session: %v", err)
+	}
+
+	// The session isn't cleaned up by lnd if we don't arrive at combining
+	// the signatures.
+	var success bool
+	defer func() {
+		if !success {
+			_ = m.cfg.Signer.MuSig2Cleanup(ctx, session.SessionID)
+		}
+	}()
+
+	serverSig, serverNonces, err := modifyAccount(
+		session.PublicNonce[:], spendPkg.prevOutputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = TaprootMuSig2Sign(
+		ctx, m.cfg.Signer, session.SessionID, serverNonces,
+		spendPkg.tx, spendPkg.prevOutputs, spendPkg.accountInputIdx,
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error signing: %v", err)
+	}
+
+	haveAllSigs, combinedSig, err := m.cfg.Signer.MuSig2CombineSig(
+		ctx, session.SessionID, [][]byte{serverSig},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error combining signatures: %v", err)
+	}
+	if !haveAllSigs {
+		return nil, fmt.Errorf("MuSig2 session is missing signatures")
+	}
+	success = true
+
+	return poolscript.SpendMuSig2Taproot(combinedSig), nil
+}
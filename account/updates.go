@@ -0,0 +1,187 @@
+package account
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// UpdateCallback is a function that is called with the full list of accounts
+// after any of them was added or modified.
+type UpdateCallback func(accounts []*Account)
+
+// UpdateEvent describes a change of an account's state, value or outpoint.
+type UpdateEvent struct {
+	// TraderKey is the trader key that identifies the account.
+	TraderKey *btcec.PublicKey
+
+	// PrevState is the state of the account before the update. For new
+	// accounts and the initial state of an account it equals NewState.
+	PrevState State
+
+	// NewState is the state of the account after the update.
+	NewState State
+
+	// PrevValue is the value of the account before the update. For new
+	// accounts and the initial state of an account it equals Value.
+	PrevValue btcutil.Amount
+
+	// Value is the value of the account after the update.
+	Value btcutil.Amount
+
+	// OutPoint is the outpoint of the account after the update.
+	OutPoint wire.OutPoint
+
+	// Tx is the latest transaction of the account, which is the one that
+	// triggered the update if the account's on-chain state changed. This
+	// is nil for accounts in StateInitiated.
+	Tx *wire.MsgTx
+
+	// Initial is true if the event describes the current state of an
+	// account at the time of subscribing rather than an actual update.
+	Initial bool
+}
+
+// newUpdateEvent creates an update event for the transition of an account
+// from prev to cur. If prev is nil, the event describes the current state of
+// the account.
+func newUpdateEvent(prev, cur *Account) *UpdateEvent {
+	event := &UpdateEvent{
+		TraderKey: cur.TraderKey.PubKey,
+		PrevState: cur.State,
+		NewState:  cur.State,
+		PrevValue: cur.Value,
+		Value:     cur.Value,
+		OutPoint:  cur.OutPoint,
+		Tx:        cur.LatestTx,
+	}
+	if prev != nil {
+		event.PrevState = prev.State
+		event.PrevValue = prev.Value
+	}
+
+	return event
+}
+
+// startUpdates starts the account update event bus and subscribes to all
+// account changes in the store.
+func (m *manager) startUpdates() error {
+	if err := m.updateServer.Start(); err != nil {
+		return err
+	}
+
+	// We hold the lock while subscribing and reading the initial state so
+	// any change notified in between is only processed once we know what
+	// it changed.
+	m.knownAccountsMtx.Lock()
+	defer m.knownAccountsMtx.Unlock()
+
+	m.cfg.Store.SubscribeAccountUpdates(m.handleAccountsUpdate)
+
+	accounts, err := m.cfg.Store.Accounts()
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		m.knownAccounts[accountIndex(account)] = account.Copy()
+	}
+	m.reportBalances(accounts)
+
+	return nil
+}
+
+// handleAccountsUpdate compares the given list of all accounts with the last
+// known one and emits an update event for each account whose state, value or
+// outpoint changed.
+func (m *manager) handleAccountsUpdate(accounts []*Account) {
+	m.knownAccountsMtx.Lock()
+	defer m.knownAccountsMtx.Unlock()
+
+	m.reportBalances(accounts)
+
+	for _, account := range accounts {
+		key := accountIndex(account)
+
+		prev, ok := m.knownAccounts[key]
+		if ok && prev.State == account.State &&
+			prev.Value == account.Value &&
+			prev.OutPoint == account.OutPoint {
+
+			continue
+		}
+		m.knownAccounts[key] = account.Copy()
+
+		err := m.updateServer.SendUpdate(newUpdateEvent(prev, account))
+		switch {
+		// We're shutting down, nobody is listening anymore.
+		case err == subscribe.ErrServerShuttingDown:
+			return
+
+		case err != nil:
+			log.Errorf("Unable to send account update: %v", err)
+			return
+		}
+	}
+}
+
+// reportBalances reports the balances of all accounts that weren't closed or
+// canceled to the metrics recorder.
+func (m *manager) reportBalances(accounts []*Account) {
+	balances := make(map[string]btcutil.Amount, len(accounts))
+	for _, account := range accounts {
+		switch account.State {
+		case StateClosed, StateCanceledAfterRecovery:
+			continue
+		}
+
+		traderKey := account.TraderKey.PubKey.SerializeCompressed()
+		balances[hex.EncodeToString(traderKey)] = account.Value
+	}
+
+	m.cfg.Metrics.SetAccountBalances(balances)
+}
+
+// SubscribeUpdates returns a new subscription for account update events along
+// with the current state of all accounts. Because both are obtained
+// atomically, no update is missed between the two.
+func (m *manager) SubscribeUpdates() (*subscribe.Client, []*UpdateEvent,
+	error) {
+
+	m.knownAccountsMtx.Lock()
+	defer m.knownAccountsMtx.Unlock()
+
+	client, err := m.updateServer.Subscribe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	initial := make([]*UpdateEvent, 0, len(m.knownAccounts))
+	for _, account := range m.knownAccounts {
+		event := newUpdateEvent(nil, account)
+		event.Initial = true
+		initial = append(initial, event)
+	}
+
+	// Always replay the accounts in the same order.
+	sort.Slice(initial, func(i, j int) bool {
+		return bytes.Compare(
+			initial[i].TraderKey.SerializeCompressed(),
+			initial[j].TraderKey.SerializeCompressed(),
+		) < 0
+	})
+
+	return client, initial, nil
+}
+
+// accountIndex returns the serialized trader key of an account that is used to
+// index it.
+func accountIndex(account *Account) [33]byte {
+	var key [33]byte
+	copy(key[:], account.TraderKey.PubKey.SerializeCompressed())
+	return key
+}
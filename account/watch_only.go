@@ -0,0 +1,242 @@
+package account
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/poolscript"
+)
+
+var (
+	// ErrAccountNotSpent is returned when verifying a transaction that
+	// doesn't spend the watched account output.
+	ErrAccountNotSpent = errors.New("transaction does not spend account")
+)
+
+// WatchOnlyAccount is everything a third party needs to detect spends of an
+// account output. It deliberately contains neither the key locator of the
+// trader key nor the account's shared secret, so it can neither be used to
+// sign for the account nor to derive any of its other outputs.
+type WatchOnlyAccount struct {
+	// TraderKey is the base trader key that identifies the account.
+	TraderKey *btcec.PublicKey
+
+	// Version is the version of the account.
+	Version Version
+
+	// Value is the value of the current account output.
+	Value btcutil.Amount
+
+	// Expiry is the expiration block height of the account.
+	Expiry uint32
+
+	// AuctioneerKey is the base auctioneer key of the account.
+	AuctioneerKey *btcec.PublicKey
+
+	// BatchKey is the batch key the current account output was derived
+	// with.
+	BatchKey *btcec.PublicKey
+
+	// OutPoint is the outpoint of the current account output.
+	OutPoint wire.OutPoint
+
+	// PkScript is the output script of the current account output.
+	PkScript []byte
+
+	// NextPkScript is the output script the account is recreated with
+	// when it takes part in a batch or is modified without changing its
+	// expiry, which uses the next batch key.
+	NextPkScript []byte
+}
+
+// NewWatchOnlyAccount creates the watch-only view of an account.
+func NewWatchOnlyAccount(a *Account) (*WatchOnlyAccount, error) {
+	output, err := a.Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive account output: %v",
+			err)
+	}
+	nextPkScript, err := a.NextOutputScript()
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive next account output: "+
+			"%v", err)
+	}
+
+	return &WatchOnlyAccount{
+		TraderKey:     CopyPubKey(a.TraderKey.PubKey),
+		Version:       a.Version,
+		Value:         a.Value,
+		Expiry:        a.Expiry,
+		AuctioneerKey: CopyPubKey(a.AuctioneerKey),
+		BatchKey:      CopyPubKey(a.BatchKey),
+		OutPoint:      a.OutPoint,
+		PkScript:      output.PkScript,
+		NextPkScript:  nextPkScript,
+	}, nil
+}
+
+// watchOnlyAccountJSON is the JSON encoding of a watch-only account with all
+// keys, scripts and hashes hex encoded.
+type watchOnlyAccountJSON struct {
+	TraderKey     string `json:"trader_key"`
+	Version       uint8  `json:"version"`
+	ValueSat      int64  `json:"value_sat"`
+	Expiry        uint32 `json:"expiry"`
+	AuctioneerKey string `json:"auctioneer_key"`
+	BatchKey      string `json:"batch_key"`
+	OutPoint      string `json:"outpoint"`
+	PkScript      string `json:"pk_script"`
+	NextPkScript  string `json:"next_pk_script"`
+}
+
+// MarshalJSON encodes the watch-only account as JSON.
+func (w *WatchOnlyAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&watchOnlyAccountJSON{
+		TraderKey: hex.EncodeToString(
+			w.TraderKey.SerializeCompressed(),
+		),
+		Version:  uint8(w.Version),
+		ValueSat: int64(w.Value),
+		Expiry:   w.Expiry,
+		AuctioneerKey: hex.EncodeToString(
+			w.AuctioneerKey.SerializeCompressed(),
+		),
+		BatchKey: hex.EncodeToString(
+			w.BatchKey.SerializeCompressed(),
+		),
+		OutPoint:     w.OutPoint.String(),
+		PkScript:     hex.EncodeToString(w.PkScript),
+		NextPkScript: hex.EncodeToString(w.NextPkScript),
+	})
+}
+
+// UnmarshalJSON decodes a watch-only account that was encoded with
+// MarshalJSON.
+func (w *WatchOnlyAccount) UnmarshalJSON(data []byte) error {
+	var j watchOnlyAccountJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	var err error
+	if w.TraderKey, err = parseHexPubKey(j.TraderKey); err != nil {
+		return fmt.Errorf("invalid trader key: %v", err)
+	}
+	w.AuctioneerKey, err = parseHexPubKey(j.AuctioneerKey)
+	if err != nil {
+		return fmt.Errorf("invalid auctioneer key: %v", err)
+	}
+	if w.BatchKey, err = parseHexPubKey(j.BatchKey); err != nil {
+		return fmt.Errorf("invalid batch key: %v", err)
+	}
+	if w.OutPoint, err = parseOutPoint(j.OutPoint); err != nil {
+		return fmt.Errorf("invalid outpoint: %v", err)
+	}
+	if w.PkScript, err = hex.DecodeString(j.PkScript); err != nil {
+		return fmt.Errorf("invalid pk script: %v", err)
+	}
+	w.NextPkScript, err = hex.DecodeString(j.NextPkScript)
+	if err != nil {
+		return fmt.Errorf("invalid next pk script: %v", err)
+	}
+
+	w.Version = Version(j.Version)
+	w.Value = btcutil.Amount(j.ValueSat)
+	w.Expiry = j.Expiry
+
+	return nil
+}
+
+// AccountSpend is the result of verifying a transaction that spends a watched
+// account.
+type AccountSpend struct {
+	// Expected is true if the transaction recreated the account with the
+	// expected increment of its batch key. This is the case for every
+	// batch the account takes part in and every modification that
+	// doesn't change the account's expiry.
+	Expected bool
+
+	// NextOutputIndex is the index of the recreated account output. It is
+	// only set if the spend was expected.
+	NextOutputIndex uint32
+
+	// ExpirySpend is true if the account was spent through its expiry
+	// path, which only the trader can do once the account expired.
+	ExpirySpend bool
+}
+
+// VerifyAccountSpend checks how the given transaction spends the watched
+// account. Spends that don't recreate the account with the next batch key are
+// reported as unexpected. These include closing the account or renewing it,
+// so they aren't necessarily malicious but should be confirmed with the
+// trader. ErrAccountNotSpent is returned if the transaction doesn't spend the
+// account at all.
+func VerifyAccountSpend(w *WatchOnlyAccount,
+	tx *wire.MsgTx) (*AccountSpend, error) {
+
+	var spendingInput *wire.TxIn
+	for _, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint == w.OutPoint {
+			spendingInput = txIn
+			break
+		}
+	}
+	if spendingInput == nil {
+		return nil, ErrAccountNotSpent
+	}
+
+	spend := &AccountSpend{
+		ExpirySpend: poolscript.IsExpirySpend(spendingInput.Witness),
+	}
+
+	// Only the cooperative path with the auctioneer is used to recreate
+	// the account, the expiry path always fully spends it.
+	if spend.ExpirySpend {
+		return spend, nil
+	}
+
+	idx, ok := poolscript.LocateOutputScript(tx, w.NextPkScript)
+	if ok {
+		spend.Expected = true
+		spend.NextOutputIndex = idx
+	}
+
+	return spend, nil
+}
+
+// parseHexPubKey parses a hex encoded compressed public key.
+func parseHexPubKey(hexKey string) (*btcec.PublicKey, error) {
+	keyBytes, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(keyBytes)
+}
+
+// parseOutPoint parses an outpoint in the format txid:index.
+func parseOutPoint(outpoint string) (wire.OutPoint, error) {
+	parts := strings.Split(outpoint, ":")
+	if len(parts) != 2 {
+		return wire.OutPoint{}, fmt.Errorf("%s is not in the format "+
+			"txid:index", outpoint)
+	}
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return wire.OutPoint{}, err
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return wire.OutPoint{}, err
+	}
+
+	return wire.OutPoint{Hash: *hash, Index: uint32(index)}, nil
+}
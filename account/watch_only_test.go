@@ -0,0 +1,92 @@
+package account
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatchOnlyAccount makes sure a watch-only account survives its JSON
+// encoding and that spends of the account are classified correctly.
+func TestWatchOnlyAccount(t *testing.T) {
+	t.Parallel()
+
+	for _, version := range []Version{
+		VersionInitialNoVersion, VersionTaprootEnabled,
+	} {
+		a := &Account{
+			Value:         1_000_000,
+			Expiry:        800_000,
+			TraderKey:     testTraderKeyDesc,
+			AuctioneerKey: testAuctioneerKey,
+			BatchKey:      testBatchKey,
+			Secret:        [32]byte{0x1, 0x2, 0x3},
+			State:         StateOpen,
+			OutPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{4, 5, 6},
+				Index: 1,
+			},
+			Version: version,
+		}
+
+		watchOnly, err := NewWatchOnlyAccount(a)
+		require.NoError(t, err)
+
+		output, err := a.Output()
+		require.NoError(t, err)
+		require.Equal(t, output.PkScript, watchOnly.PkScript)
+
+		encoded, err := json.Marshal(watchOnly)
+		require.NoError(t, err)
+		require.NotContains(t, string(encoded), "secret")
+
+		var decoded WatchOnlyAccount
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		require.Equal(t, watchOnly, &decoded)
+
+		// A transaction that doesn't spend the account isn't verified.
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{})
+		_, err = VerifyAccountSpend(watchOnly, tx)
+		require.ErrorIs(t, err, ErrAccountNotSpent)
+
+		// Recreating the account with the next batch key is expected.
+		nextScript, err := a.NextOutputScript()
+		require.NoError(t, err)
+
+		tx.TxIn[0].PreviousOutPoint = a.OutPoint
+		tx.TxIn[0].Witness = poolscript.SpendMultiSig(
+			[]byte{1}, []byte{2}, []byte{3},
+		)
+		tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{7}})
+		tx.AddTxOut(&wire.TxOut{Value: 900_000, PkScript: nextScript})
+
+		spend, err := VerifyAccountSpend(&decoded, tx)
+		require.NoError(t, err)
+		require.Equal(t, &AccountSpend{
+			Expected:        true,
+			NextOutputIndex: 1,
+		}, spend)
+
+		// Any other output script, for example one with a batch key
+		// that skipped an increment, is unexpected.
+		tx.TxOut[1].PkScript = output.PkScript
+		spend, err = VerifyAccountSpend(&decoded, tx)
+		require.NoError(t, err)
+		require.Equal(t, &AccountSpend{}, spend)
+
+		// So is a spend through the expiry path, even if it recreated
+		// the account.
+		tx.TxOut[1].PkScript = nextScript
+		tx.TxIn[0].Witness = poolscript.SpendExpiry(
+			[]byte{1}, []byte{2},
+		)
+		spend, err = VerifyAccountSpend(&decoded, tx)
+		require.NoError(t, err)
+		require.Equal(t, &AccountSpend{ExpirySpend: true}, spend)
+	}
+}
@@ -13,6 +13,19 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// heightHintSafetyDepth is the number of blocks below the best height
+	// the height hint of a spend registration is advanced to in compact
+	// filter mode. It is also how far below the height of a reorg the
+	// registrations are re-armed, as the depth of a reorg isn't known.
+	heightHintSafetyDepth = 6
+
+	// heightHintUpdateInterval is the minimum number of blocks the height
+	// hint of a spend registration needs to advance by before it is
+	// persisted again in compact filter mode.
+	heightHintUpdateInterval = 144
+)
+
 // CtrlConfig contains all of the Controller's dependencies in order to carry out its
 // duties.
 type CtrlConfig struct {
@@ -22,6 +35,39 @@ type CtrlConfig struct {
 
 	// Handlers define the handler to be used after receiving every event.
 	Handlers EventHandler
+
+	// CompactFilters enables the watch mode for lnd nodes with a neutrino
+	// backend, which match the account scripts against compact block
+	// filters from the registration's height hint onward. In this mode
+	// the height hints of spend registrations are advanced and persisted
+	// as blocks are mined, and all registrations are re-armed below the
+	// height of a reorg.
+	CompactFilters bool
+
+	// UpdateHeightHint persists the new height hint of the account output
+	// with the given outpoint that is watched for a spend. It is only used
+	// in compact filter mode.
+	UpdateHeightHint func(traderKey *btcec.PublicKey,
+		outPoint wire.OutPoint, heightHint uint32) error
+}
+
+// confRequest is an active confirmation registration of an account.
+type confRequest struct {
+	traderKey  *btcec.PublicKey
+	txHash     chainhash.Hash
+	script     []byte
+	numConfs   uint32
+	heightHint uint32
+	cancel     func()
+}
+
+// spendRequest is an active spend registration of an account.
+type spendRequest struct {
+	traderKey  *btcec.PublicKey
+	outPoint   wire.OutPoint
+	script     []byte
+	heightHint uint32
+	cancel     func()
 }
 
 // controller implements the Controller interface.
@@ -37,9 +83,13 @@ type controller struct {
 	quit       chan struct{}
 	ctxCancels []func()
 
-	cancelMtx    sync.Mutex
-	spendCancels map[[33]byte]func()
-	confCancels  map[[33]byte]func()
+	// bestHeight is the height of the last block received. It is only
+	// tracked in compact filter mode to detect reorgs.
+	bestHeight uint32
+
+	cancelMtx     sync.Mutex
+	spendRequests map[[33]byte]*spendRequest
+	confRequests  map[[33]byte]*confRequest
 }
 
 // Compile time assertion that controller implements the Controller interface?
@@ -50,11 +100,11 @@ var _ Controller = (*controller)(nil)
 func NewController(cfg *CtrlConfig) *controller { // nolint:golint
 	watcher := NewExpiryWatcher(cfg.Handlers)
 	return &controller{
-		cfg:          cfg,
-		watcher:      watcher,
-		quit:         make(chan struct{}),
-		spendCancels: make(map[[33]byte]func()),
-		confCancels:  make(map[[33]byte]func()),
+		cfg:           cfg,
+		watcher:       watcher,
+		quit:          make(chan struct{}),
+		spendRequests: make(map[[33]byte]*spendRequest),
+		confRequests:  make(map[[33]byte]*confRequest),
 	}
 }
 
@@ -96,11 +146,11 @@ func (c *controller) Stop() {
 		}
 
 		c.cancelMtx.Lock()
-		for _, cancel := range c.spendCancels {
-			cancel()
+		for _, req := range c.spendRequests {
+			req.cancel()
 		}
-		for _, cancel := range c.confCancels {
-			cancel()
+		for _, req := range c.confRequests {
+			req.cancel()
 		}
 		c.cancelMtx.Unlock()
 	})
@@ -117,6 +167,8 @@ func (c *controller) expiryHandler(blockChan chan int32, errChan chan error) {
 	select {
 	case newBlock := <-blockChan:
 		c.watcher.NewBlock(uint32(newBlock))
+		c.maybeUpdateHeightHints(uint32(newBlock))
+
 	case err := <-errChan:
 		log.Errorf("Unable to receive initial block notification: %v",
 			err)
@@ -130,6 +182,7 @@ func (c *controller) expiryHandler(blockChan chan int32, errChan chan error) {
 		// height and notify any newly expired accounts.
 		case newBlock := <-blockChan:
 			c.watcher.NewBlock(uint32(newBlock))
+			c.maybeUpdateHeightHints(uint32(newBlock))
 
 		// An error occurred while being sent a block notification.
 		case err := <-errChan:
@@ -153,27 +206,43 @@ func (c *controller) WatchAccountConf(traderKey *btcec.PublicKey,
 	c.cancelMtx.Lock()
 	defer c.cancelMtx.Unlock()
 
+	return c.registerConf(&confRequest{
+		traderKey:  traderKey,
+		txHash:     txHash,
+		script:     script,
+		numConfs:   numConfs,
+		heightHint: heightHint,
+	})
+}
+
+// registerConf registers the given confirmation request with the chain
+// notifier, replacing any previous request of the same account.
+//
+// NOTE: The cancelMtx must be held when calling this method.
+func (c *controller) registerConf(req *confRequest) error {
 	var traderKeyRaw [33]byte
-	copy(traderKeyRaw[:], traderKey.SerializeCompressed())
+	copy(traderKeyRaw[:], req.traderKey.SerializeCompressed())
 
 	// Cancel a previous conf watcher if one still exists.
-	cancel, ok := c.confCancels[traderKeyRaw]
+	prevReq, ok := c.confRequests[traderKeyRaw]
 	if ok {
-		cancel()
+		prevReq.cancel()
 	}
 
 	ctxc, cancel := context.WithCancel(context.Background())
 	confChan, errChan, err := c.cfg.ChainNotifier.RegisterConfirmationsNtfn(
-		ctxc, &txHash, script, int32(numConfs), int32(heightHint),
+		ctxc, &req.txHash, req.script, int32(req.numConfs),
+		int32(req.heightHint),
 	)
 	if err != nil {
 		cancel()
 		return err
 	}
-	c.confCancels[traderKeyRaw] = cancel
+	req.cancel = cancel
+	c.confRequests[traderKeyRaw] = req
 
 	c.wg.Add(1)
-	go c.waitForAccountConf(traderKey, traderKeyRaw, confChan, errChan)
+	go c.waitForAccountConf(req, traderKeyRaw, confChan, errChan)
 
 	return nil
 }
@@ -182,18 +251,24 @@ func (c *controller) WatchAccountConf(traderKey *btcec.PublicKey,
 // necessary steps once confirmed.
 //
 // NOTE: This method must be run as a goroutine.
-func (c *controller) waitForAccountConf(traderKey *btcec.PublicKey,
+func (c *controller) waitForAccountConf(req *confRequest,
 	traderKeyRaw [33]byte, confChan chan *chainntnfs.TxConfirmation,
 	errChan chan error) {
 
 	defer func() {
 		c.wg.Done()
 
+		// The request might have been replaced by a new one in the
+		// meantime, which we must not remove.
 		c.cancelMtx.Lock()
-		delete(c.confCancels, traderKeyRaw)
+		if c.confRequests[traderKeyRaw] == req {
+			delete(c.confRequests, traderKeyRaw)
+		}
 		c.cancelMtx.Unlock()
 	}()
 
+	traderKey := req.traderKey
+
 	select {
 	case conf := <-confChan:
 		err := c.cfg.Handlers.HandleAccountConf(traderKey, conf)
@@ -232,27 +307,41 @@ func (c *controller) WatchAccountSpend(traderKey *btcec.PublicKey,
 	c.cancelMtx.Lock()
 	defer c.cancelMtx.Unlock()
 
+	return c.registerSpend(&spendRequest{
+		traderKey:  traderKey,
+		outPoint:   accountPoint,
+		script:     script,
+		heightHint: heightHint,
+	})
+}
+
+// registerSpend registers the given spend request with the chain notifier,
+// replacing any previous request of the same account.
+//
+// NOTE: The cancelMtx must be held when calling this method.
+func (c *controller) registerSpend(req *spendRequest) error {
 	var traderKeyRaw [33]byte
-	copy(traderKeyRaw[:], traderKey.SerializeCompressed())
+	copy(traderKeyRaw[:], req.traderKey.SerializeCompressed())
 
 	// Cancel a previous spend watcher if one still exists.
-	cancel, ok := c.spendCancels[traderKeyRaw]
+	prevReq, ok := c.spendRequests[traderKeyRaw]
 	if ok {
-		cancel()
+		prevReq.cancel()
 	}
 
 	ctxc, cancel := context.WithCancel(context.Background())
 	spendChan, errChan, err := c.cfg.ChainNotifier.RegisterSpendNtfn(
-		ctxc, &accountPoint, script, int32(heightHint),
+		ctxc, &req.outPoint, req.script, int32(req.heightHint),
 	)
 	if err != nil {
 		cancel()
 		return err
 	}
-	c.spendCancels[traderKeyRaw] = cancel
+	req.cancel = cancel
+	c.spendRequests[traderKeyRaw] = req
 
 	c.wg.Add(1)
-	go c.waitForAccountSpend(traderKey, traderKeyRaw, spendChan, errChan)
+	go c.waitForAccountSpend(req, traderKeyRaw, spendChan, errChan)
 
 	return nil
 }
@@ -261,18 +350,24 @@ func (c *controller) WatchAccountSpend(traderKey *btcec.PublicKey,
 // steps once spent.
 //
 // NOTE: This method must be run as a goroutine.
-func (c *controller) waitForAccountSpend(traderKey *btcec.PublicKey,
+func (c *controller) waitForAccountSpend(req *spendRequest,
 	traderKeyRaw [33]byte, spendChan chan *chainntnfs.SpendDetail,
 	errChan chan error) {
 
 	defer func() {
 		c.wg.Done()
 
+		// The request might have been replaced by a new one in the
+		// meantime, which we must not remove.
 		c.cancelMtx.Lock()
-		delete(c.spendCancels, traderKeyRaw)
+		if c.spendRequests[traderKeyRaw] == req {
+			delete(c.spendRequests, traderKeyRaw)
+		}
 		c.cancelMtx.Unlock()
 	}()
 
+	traderKey := req.traderKey
+
 	select {
 	case spend := <-spendChan:
 		err := c.cfg.Handlers.HandleAccountSpend(traderKey, spend)
@@ -317,9 +412,9 @@ func (c *controller) CancelAccountSpend(traderKey *btcec.PublicKey) {
 	var traderKeyRaw [33]byte
 	copy(traderKeyRaw[:], traderKey.SerializeCompressed())
 
-	cancel, ok := c.spendCancels[traderKeyRaw]
+	req, ok := c.spendRequests[traderKeyRaw]
 	if ok {
-		cancel()
+		req.cancel()
 	}
 }
 
@@ -332,8 +427,96 @@ func (c *controller) CancelAccountConf(traderKey *btcec.PublicKey) {
 	var traderKeyRaw [33]byte
 	copy(traderKeyRaw[:], traderKey.SerializeCompressed())
 
-	cancel, ok := c.confCancels[traderKeyRaw]
+	req, ok := c.confRequests[traderKeyRaw]
 	if ok {
-		cancel()
+		req.cancel()
+	}
+}
+
+// maybeUpdateHeightHints advances the height hints of all spend registrations
+// to a safe depth below the new block and persists them, so a restart
+// doesn't scan the compact filters from the height the account output was
+// created at. If the new block doesn't extend the chain, blocks were
+// disconnected and all registrations with a height hint above a safe depth
+// below the reorg are re-armed with that lower height hint instead. This is
+// only done in compact filter mode.
+func (c *controller) maybeUpdateHeightHints(height uint32) {
+	if !c.cfg.CompactFilters {
+		return
+	}
+
+	var safeHeight uint32
+	if height > heightHintSafetyDepth {
+		safeHeight = height - heightHintSafetyDepth
+	}
+
+	c.cancelMtx.Lock()
+
+	reorg := height <= c.bestHeight
+	c.bestHeight = height
+
+	var updates []*spendRequest
+	switch {
+	case reorg:
+		log.Infof("Detected reorg at height %d, re-arming account "+
+			"notifications at height %d", height, safeHeight)
+
+		for _, req := range c.confRequests {
+			if req.heightHint <= safeHeight {
+				continue
+			}
+
+			newReq := *req
+			newReq.heightHint = safeHeight
+			if err := c.registerConf(&newReq); err != nil {
+				log.Errorf("Unable to re-arm confirmation "+
+					"notification for account %x: %v",
+					req.traderKey.SerializeCompressed(),
+					err)
+			}
+		}
+
+		for _, req := range c.spendRequests {
+			if req.heightHint <= safeHeight {
+				continue
+			}
+
+			newReq := *req
+			newReq.heightHint = safeHeight
+			if err := c.registerSpend(&newReq); err != nil {
+				log.Errorf("Unable to re-arm spend "+
+					"notification for account %x: %v",
+					req.traderKey.SerializeCompressed(),
+					err)
+				continue
+			}
+			updates = append(updates, &newReq)
+		}
+
+	default:
+		// The chain notifier already scans every new block for the
+		// spends, so we only need to remember how far it got. There's
+		// no need to re-register with the new height hint.
+		for _, req := range c.spendRequests {
+			if safeHeight < req.heightHint+heightHintUpdateInterval {
+				continue
+			}
+
+			req.heightHint = safeHeight
+			updates = append(updates, req)
+		}
+	}
+
+	c.cancelMtx.Unlock()
+
+	for _, req := range updates {
+		err := c.cfg.UpdateHeightHint(
+			req.traderKey, req.outPoint, req.heightHint,
+		)
+		if err != nil {
+			log.Errorf("Unable to update height hint of account "+
+				"%x: %v", req.traderKey.SerializeCompressed(),
+				err)
+		}
 	}
 }
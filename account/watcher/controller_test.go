@@ -343,3 +343,118 @@ func TestWatcherControllerWatchAccountSpend(t *testing.T) {
 		})
 	}
 }
+
+// TestWatcherControllerCompactFilters makes sure the height hints of spend
+// registrations are advanced and persisted in compact filter mode and that
+// registrations are re-armed below the height of a reorg.
+func TestWatcherControllerCompactFilters(t *testing.T) {
+	t.Parallel()
+
+	traderKeyStr := "036b51e0cc2d9e5988ee4967e0ba67ef3727bb633fea21a0af58e0c9395446ba09"
+	traderKeyRaw, _ := hex.DecodeString(traderKeyStr)
+	traderKey, _ := btcec.ParsePubKey(traderKeyRaw)
+
+	var (
+		txHash   = chainhash.Hash{1, 2, 3}
+		outpoint = wire.OutPoint{Hash: txHash}
+		script   = []byte{4, 5, 6}
+	)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	blockChan := make(chan int32)
+	chainNotifier := test.NewMockChainNotifierClient(mockCtrl)
+	chainNotifier.EXPECT().
+		RegisterBlockEpochNtfn(gomock.Any()).
+		Return(blockChan, make(chan error), nil)
+
+	// We register for the confirmation of a new output of the account
+	// with a recent height hint while its current output is watched for
+	// a spend since height 8.
+	chainNotifier.EXPECT().
+		RegisterConfirmationsNtfn(
+			gomock.Any(), &txHash, script, int32(3), int32(180),
+		).
+		Return(make(chan *chainntnfs.TxConfirmation), make(chan error),
+			nil)
+	chainNotifier.EXPECT().
+		RegisterSpendNtfn(gomock.Any(), &outpoint, script, int32(8)).
+		Return(make(chan *chainntnfs.SpendDetail), make(chan error),
+			nil)
+
+	hintUpdates := make(chan uint32, 1)
+	cfg := &CtrlConfig{
+		ChainNotifier:  chainNotifier,
+		CompactFilters: true,
+		UpdateHeightHint: func(key *btcec.PublicKey,
+			op wire.OutPoint, heightHint uint32) error {
+
+			require.Equal(t, traderKey, key)
+			require.Equal(t, outpoint, op)
+			hintUpdates <- heightHint
+
+			return nil
+		},
+	}
+	watcher := NewMockExpiryWatcher(mockCtrl)
+	watcher.EXPECT().NewBlock(gomock.Any()).AnyTimes()
+
+	watcherController := NewController(cfg)
+	watcherController.watcher = watcher
+
+	require.NoError(t, watcherController.Start())
+	defer watcherController.Stop()
+
+	require.NoError(t, watcherController.WatchAccountConf(
+		traderKey, txHash, script, 3, 180,
+	))
+	require.NoError(t, watcherController.WatchAccountSpend(
+		traderKey, outpoint, script, 8,
+	))
+
+	assertNoHintUpdate := func() {
+		t.Helper()
+
+		select {
+		case hint := <-hintUpdates:
+			t.Fatalf("unexpected height hint update %d", hint)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	assertHintUpdate := func(expected uint32) {
+		t.Helper()
+
+		select {
+		case hint := <-hintUpdates:
+			require.Equal(t, expected, hint)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("height hint not updated on time")
+		}
+	}
+
+	// The spend height hint isn't persisted before it advanced by a full
+	// update interval.
+	blockChan <- 100
+	assertNoHintUpdate()
+
+	// Once it did, it's advanced to a safe depth below the best height.
+	blockChan <- 200
+	assertHintUpdate(194)
+
+	// A reorg re-arms both registrations below the height of the reorg
+	// and persists the lower spend height hint.
+	chainNotifier.EXPECT().
+		RegisterConfirmationsNtfn(
+			gomock.Any(), &txHash, script, int32(3), int32(144),
+		).
+		Return(make(chan *chainntnfs.TxConfirmation), make(chan error),
+			nil)
+	chainNotifier.EXPECT().
+		RegisterSpendNtfn(gomock.Any(), &outpoint, script, int32(144)).
+		Return(make(chan *chainntnfs.SpendDetail), make(chan error),
+			nil)
+
+	blockChan <- 150
+	assertHintUpdate(144)
+}
@@ -63,6 +63,11 @@ type EventHandler interface {
 	// account once it's expired. The account is identified by its user sub
 	// key (i.e., trader key).
 	HandleAccountExpiry(*btcec.PublicKey, uint32) error
+
+	// HandleNewBlock is called for every new block after all account
+	// expirations for its height have been handled. Implementations must
+	// not block as it is called from the watcher's block loop.
+	HandleNewBlock(bestHeight uint32)
 }
 
 // ExpiryWatcher is the interface for the component in charge of the accounts'
@@ -192,6 +192,18 @@ func (mr *MockEventHandlerMockRecorder) HandleAccountSpend(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAccountSpend", reflect.TypeOf((*MockEventHandler)(nil).HandleAccountSpend), arg0, arg1)
 }
 
+// HandleNewBlock mocks base method.
+func (m *MockEventHandler) HandleNewBlock(bestHeight uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleNewBlock", bestHeight)
+}
+
+// HandleNewBlock indicates an expected call of HandleNewBlock.
+func (mr *MockEventHandlerMockRecorder) HandleNewBlock(bestHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleNewBlock", reflect.TypeOf((*MockEventHandler)(nil).HandleNewBlock), bestHeight)
+}
+
 // MockExpiryWatcher is a mock of ExpiryWatcher interface.
 type MockExpiryWatcher struct {
 	ctrl     *gomock.Controller
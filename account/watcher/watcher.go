@@ -33,13 +33,15 @@ func NewExpiryWatcher(handlers EventHandler) *expiryWatcher { // nolint:golint
 	}
 }
 
-// NewBlock updates the current bestHeight.
+// NewBlock updates the current bestHeight, handles overdue expirations and then
+// notifies the handlers about the new block.
 func (w *expiryWatcher) NewBlock(bestHeight uint32) {
 	w.expirationsMtx.Lock()
-	defer w.expirationsMtx.Unlock()
-
 	w.bestHeight = bestHeight
 	w.overdueExpirations(w.bestHeight)
+	w.expirationsMtx.Unlock()
+
+	w.handlers.HandleNewBlock(bestHeight)
 }
 
 // overdueExpirations handles the expirations for the given block.
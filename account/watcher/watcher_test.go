@@ -120,6 +120,7 @@ func TestOverdueExpirations(t *testing.T) {
 					).
 					Return(nil)
 			}
+			handlers.EXPECT().HandleNewBlock(tc.blockHeight)
 
 			watcher.NewBlock(tc.blockHeight)
 
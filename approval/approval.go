@@ -0,0 +1,253 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrBatchNotApproved is the wrapped error that is returned if a batch
+	// was rejected by the approval hook or not approved in time.
+	ErrBatchNotApproved = errors.New("batch not approved")
+
+	// ErrNoBatchApproval is returned if no approval decision was stored
+	// for a batch.
+	ErrNoBatchApproval = errors.New("no batch approval found")
+)
+
+// Proposal is the distilled view of a batch from our point of view that is
+// sent to the approval hook before we sign it.
+type Proposal struct {
+	// BatchID is the unique ID of the batch.
+	BatchID [33]byte
+
+	// Version is the version of the batch verification protocol.
+	Version uint32
+
+	// BatchTxFeeRate is the miner fee rate in sat/kW of the batch
+	// transaction.
+	BatchTxFeeRate chainfee.SatPerKWeight
+
+	// ClearingPrices maps the lease duration markets our orders were
+	// matched in to the fixed rate clearing price of that market.
+	ClearingPrices map[uint32]uint32
+
+	// Matches are all matches of our orders in the batch.
+	Matches []*Match
+
+	// Accounts are the changes the batch makes to our accounts.
+	Accounts []*Account
+}
+
+// Match is a single match of one of our orders with an order of another
+// trader.
+type Match struct {
+	// OrderNonce is the nonce of our order.
+	OrderNonce [32]byte
+
+	// IsAsk is true if our order is an ask.
+	IsAsk bool
+
+	// MatchedNonce is the nonce of the order ours was matched with.
+	MatchedNonce [32]byte
+
+	// NodeKey is the node key of the counterparty.
+	NodeKey [33]byte
+
+	// UnitsFilled is the number of units that were filled by this match.
+	UnitsFilled uint32
+
+	// LeaseDuration is the lease duration of the channel in blocks.
+	LeaseDuration uint32
+
+	// Premium is the premium we pay for the channel if our order is a bid
+	// or receive if it is an ask.
+	Premium btcutil.Amount
+
+	// ExecutionFee is the execution fee we pay for the match.
+	ExecutionFee btcutil.Amount
+}
+
+// Account describes the changes a batch makes to one of our accounts.
+type Account struct {
+	// TraderKey is the trader key of the account.
+	TraderKey [33]byte
+
+	// StartingBalance is the balance of the account before the batch.
+	StartingBalance btcutil.Amount
+
+	// EndingBalance is the balance of the account after the batch.
+	EndingBalance btcutil.Amount
+
+	// ChainFee is the estimated chain fee the account pays for the
+	// channels created in the batch.
+	ChainFee btcutil.Amount
+
+	// NewExpiry is the new expiry height of the account. This is zero if
+	// the batch doesn't change the expiry.
+	NewExpiry uint32
+}
+
+// Decision is the answer of an approver to a batch proposal.
+type Decision struct {
+	// Approved is true if the batch may be signed.
+	Approved bool
+
+	// Reason is an optional human readable reason for the decision.
+	Reason string
+}
+
+// Approver decides whether we take part in a batch.
+type Approver interface {
+	// ApproveBatch is called with every batch we're about to accept. The
+	// context is canceled once the approval timeout is reached.
+	ApproveBatch(ctx context.Context, p *Proposal) (*Decision, error)
+}
+
+// ApproverFunc is a function that implements the Approver interface, which
+// allows library users to approve batches with a simple callback.
+type ApproverFunc func(ctx context.Context, p *Proposal) (*Decision, error)
+
+// ApproveBatch calls the function itself.
+//
+// NOTE: This method is part of the Approver interface.
+func (f ApproverFunc) ApproveBatch(ctx context.Context,
+	p *Proposal) (*Decision, error) {
+
+	return f(ctx, p)
+}
+
+// Record is the outcome of asking the approver about a batch.
+type Record struct {
+	// Approved is true if we may sign the batch.
+	Approved bool
+
+	// TimedOut is true if the approver didn't decide within the timeout
+	// and the configured timeout action was applied.
+	TimedOut bool
+
+	// Reason describes the decision.
+	Reason string
+
+	// Latency is the time it took to reach the decision.
+	Latency time.Duration
+
+	// DecidedAt is the time the decision was made.
+	DecidedAt time.Time
+}
+
+// Err returns nil if the batch was approved and an error wrapping
+// ErrBatchNotApproved otherwise.
+func (r *Record) Err() error {
+	if r.Approved {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrBatchNotApproved, r.Reason)
+}
+
+// Gate asks an approver about every batch and enforces the approval timeout.
+type Gate struct {
+	approver         Approver
+	timeout          time.Duration
+	approveOnTimeout bool
+
+	// stop releases any resources of the approver.
+	stop func() error
+}
+
+// NewGate creates a new approval gate from the given config. If a hook
+// address is configured, a connection to it is created.
+func NewGate(cfg *Config) (*Gate, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	gate := &Gate{
+		approver:         cfg.Approver,
+		timeout:          cfg.Timeout,
+		approveOnTimeout: cfg.TimeoutAction == TimeoutActionApprove,
+		stop:             func() error { return nil },
+	}
+
+	if cfg.Hook != "" {
+		hook, err := newGRPCApprover(cfg.Hook)
+		if err != nil {
+			return nil, err
+		}
+
+		gate.approver = hook
+		gate.stop = hook.close
+	}
+
+	if gate.approver == nil {
+		return nil, fmt.Errorf("no batch approval hook configured")
+	}
+
+	return gate, nil
+}
+
+// Stop closes the connection to the approval hook, if there is one.
+func (g *Gate) Stop() error {
+	return g.stop()
+}
+
+// Decide asks the approver about the given batch proposal and waits for its
+// decision until the timeout is reached. Failing to reach the approver always
+// rejects the batch, a timeout applies the configured timeout action.
+func (g *Gate) Decide(ctx context.Context, p *Proposal) *Record {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	type result struct {
+		decision *Decision
+		err      error
+	}
+
+	// We don't trust an in-process approver to honor the context, so we
+	// wait for its answer in a separate goroutine. The channel is buffered
+	// so the goroutine can exit even if we stopped waiting.
+	start := time.Now()
+	resultChan := make(chan result, 1)
+	go func() {
+		decision, err := g.approver.ApproveBatch(ctx, p)
+		resultChan <- result{decision: decision, err: err}
+	}()
+
+	var res result
+	select {
+	case res = <-resultChan:
+	case <-ctx.Done():
+		res.err = ctx.Err()
+	}
+
+	record := &Record{
+		Latency:   time.Since(start),
+		DecidedAt: time.Now(),
+	}
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		record.TimedOut = true
+		record.Approved = g.approveOnTimeout
+		record.Reason = fmt.Sprintf("no decision within %v, applied "+
+			"timeout action", g.timeout)
+
+	case res.err != nil:
+		record.Reason = fmt.Sprintf("unable to reach approval hook: %v",
+			res.err)
+
+	case res.decision == nil:
+		record.Reason = "approval hook returned no decision"
+
+	default:
+		record.Approved = res.decision.Approved
+		record.Reason = res.decision.Reason
+	}
+
+	return record
+}
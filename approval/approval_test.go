@@ -0,0 +1,137 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGateDecide makes sure the gate records the approver's decision and
+// applies the timeout action if no decision is made in time.
+func TestGateDecide(t *testing.T) {
+	t.Parallel()
+
+	// block is an approver that never decides and ignores the context.
+	block := ApproverFunc(func(context.Context, *Proposal) (*Decision,
+		error) {
+
+		select {}
+	})
+
+	testCases := []struct {
+		name             string
+		approver         Approver
+		timeoutAction    string
+		expectedApproved bool
+		expectedTimedOut bool
+		expectedReason   string
+	}{{
+		name: "approved",
+		approver: ApproverFunc(func(context.Context,
+			*Proposal) (*Decision, error) {
+
+			return &Decision{Approved: true, Reason: "ok"}, nil
+		}),
+		expectedApproved: true,
+		expectedReason:   "ok",
+	}, {
+		name: "rejected",
+		approver: ApproverFunc(func(context.Context,
+			*Proposal) (*Decision, error) {
+
+			return &Decision{Reason: "too expensive"}, nil
+		}),
+		timeoutAction:  TimeoutActionApprove,
+		expectedReason: "too expensive",
+	}, {
+		name: "hook error",
+		approver: ApproverFunc(func(context.Context,
+			*Proposal) (*Decision, error) {
+
+			return nil, errors.New("connection refused")
+		}),
+		timeoutAction:  TimeoutActionApprove,
+		expectedReason: "unable to reach approval hook",
+	}, {
+		name:             "timeout rejects",
+		approver:         block,
+		timeoutAction:    TimeoutActionReject,
+		expectedTimedOut: true,
+		expectedReason:   "no decision within",
+	}, {
+		name:             "timeout approves",
+		approver:         block,
+		timeoutAction:    TimeoutActionApprove,
+		expectedApproved: true,
+		expectedTimedOut: true,
+		expectedReason:   "no decision within",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultConfig()
+			cfg.Timeout = 50 * time.Millisecond
+			cfg.Approver = tc.approver
+			if tc.timeoutAction != "" {
+				cfg.TimeoutAction = tc.timeoutAction
+			}
+
+			gate, err := NewGate(cfg)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, gate.Stop())
+			}()
+
+			record := gate.Decide(context.Background(), &Proposal{})
+			require.Equal(t, tc.expectedApproved, record.Approved)
+			require.Equal(t, tc.expectedTimedOut, record.TimedOut)
+			require.Contains(t, record.Reason, tc.expectedReason)
+			require.False(t, record.DecidedAt.IsZero())
+
+			if tc.expectedApproved {
+				require.NoError(t, record.Err())
+			} else {
+				require.ErrorIs(
+					t, record.Err(), ErrBatchNotApproved,
+				)
+			}
+		})
+	}
+}
+
+// TestConfigValidate makes sure only supported hook URLs are accepted.
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultConfig()
+	require.False(t, cfg.Enabled())
+	require.NoError(t, cfg.Validate())
+
+	cfg.Hook = "grpc://localhost:10030"
+	require.True(t, cfg.Enabled())
+	require.NoError(t, cfg.Validate())
+
+	cfg.Hook = "grpcs://approver.example.com:443"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Hook = "http://localhost:10030"
+	require.ErrorContains(t, cfg.Validate(), "scheme must be")
+
+	cfg.Hook = "localhost:10030"
+	require.Error(t, cfg.Validate())
+
+	cfg.Hook = "grpc://localhost:10030"
+	cfg.Approver = ApproverFunc(nil)
+	require.ErrorContains(t, cfg.Validate(), "cannot use both")
+
+	cfg = DefaultConfig()
+	cfg.Timeout = 0
+	require.ErrorContains(t, cfg.Validate(), "must be positive")
+}
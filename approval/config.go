@@ -0,0 +1,101 @@
+package approval
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the default maximum time we wait for the approval
+	// hook to decide about a batch.
+	DefaultTimeout = 30 * time.Second
+
+	// TimeoutActionReject rejects a batch the hook didn't decide about in
+	// time.
+	TimeoutActionReject = "reject"
+
+	// TimeoutActionApprove approves a batch the hook didn't decide about
+	// in time.
+	TimeoutActionApprove = "approve"
+
+	// schemeGRPC is the hook URL scheme of a plaintext gRPC connection.
+	schemeGRPC = "grpc"
+
+	// schemeGRPCS is the hook URL scheme of a gRPC connection over TLS.
+	schemeGRPCS = "grpcs"
+)
+
+// Config holds the configuration of the batch approval hook.
+type Config struct {
+	Hook          string        `long:"hook" description:"The URL of an external BatchApprover gRPC service that must approve every batch before it is signed, for example grpc://localhost:10030 for a plaintext connection or grpcs://approver.example.com:443 for TLS. Batches are accepted without approval if this is not set."`
+	Timeout       time.Duration `long:"timeout" description:"The maximum time to wait for the approval hook to decide about a batch. Valid time units are {s, m, h}."`
+	TimeoutAction string        `long:"timeoutaction" description:"What to do with a batch the approval hook didn't decide about within the timeout." choice:"reject" choice:"approve"`
+
+	// Approver is an in-process approver that can be set instead of a
+	// hook if poold is used as a library.
+	Approver Approver
+}
+
+// DefaultConfig returns the default approval config which has no hook set.
+func DefaultConfig() *Config {
+	return &Config{
+		Timeout:       DefaultTimeout,
+		TimeoutAction: TimeoutActionReject,
+	}
+}
+
+// Enabled returns true if either a hook or an in-process approver is set.
+func (c *Config) Enabled() bool {
+	return c.Hook != "" || c.Approver != nil
+}
+
+// Validate makes sure the config is valid.
+func (c *Config) Validate() error {
+	if c.Hook != "" && c.Approver != nil {
+		return fmt.Errorf("cannot use both an approval hook and an " +
+			"in-process approver")
+	}
+
+	if c.Hook != "" {
+		if _, err := parseHook(c.Hook); err != nil {
+			return err
+		}
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("approval timeout must be positive")
+	}
+
+	switch c.TimeoutAction {
+	case TimeoutActionReject, TimeoutActionApprove:
+	default:
+		return fmt.Errorf("unknown approval timeout action: %v",
+			c.TimeoutAction)
+	}
+
+	return nil
+}
+
+// parseHook parses the URL of an approval hook and makes sure it uses one of
+// the supported schemes.
+func parseHook(hook string) (*url.URL, error) {
+	hookURL, err := url.Parse(hook)
+	if err != nil {
+		return nil, fmt.Errorf("invalid approval hook %s: %v", hook, err)
+	}
+
+	switch hookURL.Scheme {
+	case schemeGRPC, schemeGRPCS:
+	default:
+		return nil, fmt.Errorf("invalid approval hook %s: scheme must "+
+			"be %s:// or %s://", hook, schemeGRPC, schemeGRPCS)
+	}
+
+	if hookURL.Host == "" {
+		return nil, fmt.Errorf("invalid approval hook %s: missing "+
+			"host", hook)
+	}
+
+	return hookURL, nil
+}
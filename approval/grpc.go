@@ -0,0 +1,117 @@
+package approval
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// grpcApprover is an approver that forwards every batch proposal to an
+// external BatchApprover gRPC service.
+type grpcApprover struct {
+	conn   *grpc.ClientConn
+	client poolrpc.BatchApproverClient
+}
+
+// newGRPCApprover creates a connection to the approval hook at the given URL.
+// The connection is established lazily, so the hook doesn't need to be
+// reachable yet.
+func newGRPCApprover(hook string) (*grpcApprover, error) {
+	hookURL, err := parseHook(hook)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if hookURL.Scheme == schemeGRPCS {
+		creds = credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+		})
+	}
+
+	conn, err := grpc.Dial(
+		hookURL.Host, grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to approval hook: %v",
+			err)
+	}
+
+	log.Infof("Batch approval hook configured at %s", hookURL.Host)
+
+	return &grpcApprover{
+		conn:   conn,
+		client: poolrpc.NewBatchApproverClient(conn),
+	}, nil
+}
+
+// ApproveBatch sends the proposal to the approval hook and returns its
+// decision.
+//
+// NOTE: This method is part of the Approver interface.
+func (a *grpcApprover) ApproveBatch(ctx context.Context,
+	p *Proposal) (*Decision, error) {
+
+	resp, err := a.client.ApproveBatch(ctx, marshallProposal(p))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Decision{
+		Approved: resp.Approved,
+		Reason:   resp.Reason,
+	}, nil
+}
+
+// close closes the connection to the approval hook.
+func (a *grpcApprover) close() error {
+	return a.conn.Close()
+}
+
+// marshallProposal translates a batch proposal into its RPC counterpart.
+func marshallProposal(p *Proposal) *poolrpc.BatchApprovalRequest {
+	req := &poolrpc.BatchApprovalRequest{
+		BatchId:                p.BatchID[:],
+		BatchVersion:           p.Version,
+		BatchTxFeeRateSatPerKw: uint64(p.BatchTxFeeRate),
+		ClearingPrices:         p.ClearingPrices,
+		Matches: make(
+			[]*poolrpc.BatchApprovalMatch, len(p.Matches),
+		),
+		Accounts: make(
+			[]*poolrpc.BatchApprovalAccount, len(p.Accounts),
+		),
+	}
+
+	for idx, m := range p.Matches {
+		m := m
+		req.Matches[idx] = &poolrpc.BatchApprovalMatch{
+			OrderNonce:          m.OrderNonce[:],
+			IsAsk:               m.IsAsk,
+			MatchedOrderNonce:   m.MatchedNonce[:],
+			NodeKey:             m.NodeKey[:],
+			UnitsFilled:         m.UnitsFilled,
+			LeaseDurationBlocks: m.LeaseDuration,
+			PremiumSat:          uint64(m.Premium),
+			ExecutionFeeSat:     uint64(m.ExecutionFee),
+		}
+	}
+
+	for idx, a := range p.Accounts {
+		a := a
+		req.Accounts[idx] = &poolrpc.BatchApprovalAccount{
+			TraderKey:          a.TraderKey[:],
+			StartingBalanceSat: uint64(a.StartingBalance),
+			EndingBalanceSat:   uint64(a.EndingBalance),
+			ChainFeeSat:        uint64(a.ChainFee),
+			NewExpiry:          a.NewExpiry,
+		}
+	}
+
+	return req
+}
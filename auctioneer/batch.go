@@ -89,8 +89,11 @@ func (c *Client) checkPendingBatch() error {
 func (c *Client) finalizedBatchTx(
 	snapshot *clientdb.LocalBatchSnapshot) (*wire.MsgTx, error) {
 
+	ctx, cancel := c.callContext(context.Background())
+	defer cancel()
+
 	req := &auctioneerrpc.BatchSnapshotRequest{BatchId: snapshot.BatchID[:]}
-	batch, err := c.client.BatchSnapshot(context.Background(), req)
+	batch, err := c.client.BatchSnapshot(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("querying relevant batch snapshot "+
 			"failed: %v", err)
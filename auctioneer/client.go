@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"io"
 	"math"
-	"net"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -20,6 +19,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/metrics"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
 	"github.com/lightninglabs/pool/sidecar"
@@ -27,7 +27,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
-	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/subscribe"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -37,12 +37,12 @@ import (
 const (
 	reconnectRetries = math.MaxInt16
 
-	// maxUnusedAccountKeyLookup is the number of successive account keys
+	// MaxUnusedAccountKeyLookup is the number of successive account keys
 	// that we try and the server does not know of before aborting recovery.
 	// This is necessary to skip "holes" in our list of keys that can happen
 	// if the user tries to open an account but that fails. Then some keys
 	// aren't used.
-	maxUnusedAccountKeyLookup = 50
+	MaxUnusedAccountKeyLookup = 50
 )
 
 var (
@@ -61,17 +61,54 @@ var (
 	// ErrAuthCanceled is returned if the authentication process of a single
 	// account subscription is aborted.
 	ErrAuthCanceled = errors.New("authentication was canceled")
+
+	// ErrTokenChanged is the cause of a reconnect because the LSAT token
+	// the stream was authenticated with was revoked or replaced.
+	ErrTokenChanged = errors.New("LSAT token changed")
+)
+
+const (
+	// MainnetServer is the address of the mainnet auction server.
+	MainnetServer = "pool.lightning.finance:12010"
+
+	// TestnetServer is the address of the testnet auction server.
+	TestnetServer = "test.pool.lightning.finance:12010"
 )
 
 // Config holds the configuration options for the auctioneer client.
 type Config struct {
-	// ServerAddress is the domain:port of the auctioneer server.
+	// ServerAddress is the domain:port of the auctioneer server. It is only
+	// used if no Endpoints are set.
 	ServerAddress string
 
+	// Endpoints is the list of auction server endpoints to connect to. If
+	// one of them can't be reached, the client fails over to the next one.
+	// If this is empty, the ServerAddress is used as the only endpoint
+	// with the Insecure and TLSPathServer settings.
+	Endpoints []*Endpoint
+
+	// EndpointSelection is the strategy used to decide which of the
+	// endpoints to connect to.
+	EndpointSelection EndpointSelection
+
 	// ProxyAddress is the SOCKS proxy that should be used to establish the
 	// connection.
 	ProxyAddress string
 
+	// ProxyStreamIsolation signals that each connection through the SOCKS
+	// proxy should use random credentials, which makes Tor use a separate
+	// circuit for each of them.
+	ProxyStreamIsolation bool
+
+	// TorControlAddress is the optional host:port of the control port of
+	// the Tor daemon that runs the SOCKS proxy. If set, the control port is
+	// checked to be reachable on startup as well.
+	TorControlAddress string
+
+	// TorControlPassword is the password used to authenticate to the Tor
+	// control port if it uses the HASHEDPASSWORD authentication method.
+	TorControlPassword string
+
 	// Insecure signals that no TLS should be used if set to true.
 	Insecure bool
 
@@ -97,6 +134,30 @@ type Config struct {
 	// attempts.
 	MaxBackoff time.Duration
 
+	// KeepaliveTime is the time after which the client pings the auction
+	// server if it didn't see any activity on the connection. Keepalive
+	// pings are disabled if this is zero.
+	KeepaliveTime time.Duration
+
+	// KeepaliveTimeout is the time the client waits for the auction server
+	// to answer a keepalive ping before it closes the connection.
+	KeepaliveTimeout time.Duration
+
+	// KeepalivePermitWithoutStream signals that keepalive pings should
+	// also be sent if there is no active stream to the auction server.
+	KeepalivePermitWithoutStream bool
+
+	// CallTimeout is the maximum time a single unary call to the auction
+	// server can take. Calls are only bound by the caller's context if
+	// this is zero.
+	CallTimeout time.Duration
+
+	// BatchStreamTimeout is the maximum time the client waits for the next
+	// message of the auction server after it accepted or signed a batch.
+	// If no message arrives in time, the stream is considered dead and is
+	// re-established. The check is disabled if this is zero.
+	BatchStreamTimeout time.Duration
+
 	// BatchSource provides information about the current pending batch, if
 	// any.
 	BatchSource BatchSource
@@ -117,6 +178,10 @@ type Config struct {
 	// default SubscribeBatchAuction RPC the client should connect to
 	// SubscribeSidecar for getting batch updates.
 	ConnectSidecar bool
+
+	// Metrics is used to record the connection state and the latency of
+	// order submissions. If this is nil, nothing is recorded.
+	Metrics metrics.Recorder
 }
 
 // Client performs the client side part of auctions. This interface exists to be
@@ -131,7 +196,8 @@ type Client struct {
 	errChanSwitch  *ErrChanSwitch
 	FromServerChan chan *auctioneerrpc.ServerAuctionMessage
 
-	serverConn     *grpc.ClientConn
+	dialOpts       [][]grpc.DialOption
+	serverConn     *failoverConn
 	client         auctioneerrpc.ChannelAuctioneerClient
 	hashMailClient auctioneerrpc.HashMailClient
 
@@ -143,27 +209,76 @@ type Client struct {
 
 	subscribedAccts    map[[33]byte]*acctSubscription
 	subscribedAcctsMtx sync.Mutex
+
+	// lostAccts are the keys of all accounts whose subscription was closed
+	// together with the stream and that need to be subscribed again once
+	// the stream is re-established. This map is guarded by the
+	// subscribedAcctsMtx as well.
+	lostAccts map[[33]byte]*keychain.KeyDescriptor
+
+	orderBookFeeds    map[uint32]*orderBookFeed
+	orderBookFeedsMtx sync.Mutex
+
+	// reconnectChan receives a request each time the stream to the server
+	// needs to be re-established.
+	reconnectChan chan error
+
+	connState        ConnectionState
+	connStateMtx     sync.Mutex
+	connStateUpdates *subscribe.Server
+
+	// batchDeadline is the time until which we expect the next message of
+	// the auction server for the batch we're currently part of. It's zero
+	// if we aren't waiting for any batch message.
+	batchDeadline    time.Time
+	batchDeadlineMtx sync.Mutex
 }
 
 // NewClient returns a new instance to initiate auctions with.
 func NewClient(cfg *Config) (*Client, error) {
-	var err error
-	cfg.DialOpts, err = getAuctionServerDialOpts(
-		cfg.Insecure, cfg.ProxyAddress, cfg.TLSPathServer, cfg.DialOpts...,
-	)
-	if err != nil {
-		return nil, err
+	cfg.Metrics = metrics.OrDisabled(cfg.Metrics)
+
+	if len(cfg.Endpoints) == 0 {
+		cfg.Endpoints = []*Endpoint{{
+			Address:  cfg.ServerAddress,
+			Insecure: cfg.Insecure,
+			TLSPath:  cfg.TLSPathServer,
+		}}
+	}
+
+	// Each endpoint can have its own TLS settings, so we need a separate
+	// set of dial options for each of them.
+	dialOpts := make([][]grpc.DialOption, len(cfg.Endpoints))
+	for idx, endpoint := range cfg.Endpoints {
+		baseOpts := make([]grpc.DialOption, 0, len(cfg.DialOpts))
+		baseOpts = append(baseOpts, cfg.DialOpts...)
+
+		var err error
+		dialOpts[idx], err = getAuctionServerDialOpts(
+			endpoint.Insecure, cfg.ProxyAddress,
+			cfg.ProxyStreamIsolation, endpoint.TLSPath,
+			append(baseOpts, keepaliveDialOpts(cfg)...)...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid auction server "+
+				"endpoint %s: %v", endpoint.Address, err)
+		}
 	}
 
 	mainErrChan := make(chan error)
 	errChanSwitch := NewErrChanSwitch(mainErrChan)
 	return &Client{
-		cfg:             cfg,
-		FromServerChan:  make(chan *auctioneerrpc.ServerAuctionMessage),
-		StreamErrChan:   mainErrChan,
-		errChanSwitch:   errChanSwitch,
-		quit:            make(chan struct{}),
-		subscribedAccts: make(map[[33]byte]*acctSubscription),
+		cfg:              cfg,
+		dialOpts:         dialOpts,
+		FromServerChan:   make(chan *auctioneerrpc.ServerAuctionMessage),
+		StreamErrChan:    mainErrChan,
+		errChanSwitch:    errChanSwitch,
+		quit:             make(chan struct{}),
+		subscribedAccts:  make(map[[33]byte]*acctSubscription),
+		lostAccts:        make(map[[33]byte]*keychain.KeyDescriptor),
+		orderBookFeeds:   make(map[uint32]*orderBookFeed),
+		reconnectChan:    make(chan error, 1),
+		connStateUpdates: subscribe.NewServer(),
 	}, nil
 }
 
@@ -173,7 +288,22 @@ func (c *Client) Start() error {
 		return nil
 	}
 
-	serverConn, err := grpc.Dial(c.cfg.ServerAddress, c.cfg.DialOpts...)
+	// If all connections go through a proxy, we make sure it's actually
+	// there. Otherwise we'd just retry to connect forever.
+	if c.cfg.ProxyAddress != "" {
+		err := checkProxy(
+			c.cfg.ProxyAddress, c.cfg.TorControlAddress,
+			c.cfg.TorControlPassword,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to use proxy for auction "+
+				"server connection: %v", err)
+		}
+	}
+
+	serverConn, err := dialEndpoints(
+		c.cfg.Endpoints, c.dialOpts, c.cfg.EndpointSelection,
+	)
 	if err != nil {
 		return fmt.Errorf("unable to connect to RPC server: %v",
 			err)
@@ -185,12 +315,28 @@ func (c *Client) Start() error {
 
 	c.errChanSwitch.Start()
 
+	if err := c.connStateUpdates.Start(); err != nil {
+		return fmt.Errorf("unable to start connection state "+
+			"server: %v", err)
+	}
+
+	c.cfg.Metrics.SetConnectionState(c.ConnectionState().String())
+
+	c.wg.Add(1)
+	go c.reconnectHandler()
+
+	if c.cfg.BatchStreamTimeout > 0 {
+		c.wg.Add(1)
+		go c.batchStreamWatchdog()
+	}
+
 	return nil
 }
 
 // getAuctionServerDialOpts returns the dial options to connect to the auction
 // server.
-func getAuctionServerDialOpts(insecure bool, proxyAddress, tlsPath string,
+func getAuctionServerDialOpts(insecure bool, proxyAddress string,
+	streamIsolation bool, tlsPath string,
 	dialOpts ...grpc.DialOption) ([]grpc.DialOption, error) {
 
 	// Create a copy of the dial options array.
@@ -216,19 +362,16 @@ func getAuctionServerDialOpts(insecure bool, proxyAddress, tlsPath string,
 		creds := credentials.NewTLS(&tls.Config{})
 		opts = append(opts, grpc.WithTransportCredentials(creds))
 	}
-	// If a SOCKS proxy address was specified,
-	// then we should dial through it.
+	// If a SOCKS proxy address was specified, then we should dial through
+	// it. This covers all RPCs on the connection, including the mailbox
+	// used for negotiating sidecar tickets.
 	if proxyAddress != "" {
-		log.Infof("Proxying connection to auction server "+
-			"over Tor SOCKS proxy %v",
-			proxyAddress)
-		torDialer := func(_ context.Context, addr string) (net.Conn, error) {
-			return tor.Dial(
-				addr, proxyAddress, false, false,
-				tor.DefaultConnTimeout,
-			)
-		}
-		opts = append(opts, grpc.WithContextDialer(torDialer))
+		log.Infof("Proxying connection to auction server over SOCKS "+
+			"proxy %v (stream isolation: %v)", proxyAddress,
+			streamIsolation)
+		opts = append(opts, grpc.WithContextDialer(
+			newProxyDialer(proxyAddress, streamIsolation),
+		))
 	}
 
 	return opts, nil
@@ -249,6 +392,12 @@ func (c *Client) Stop() error {
 	c.wg.Wait()
 	close(c.FromServerChan)
 	c.errChanSwitch.Stop()
+
+	c.setConnectionState(ConnectionStateDisconnected, ErrClientShutdown)
+	if err := c.connStateUpdates.Stop(); err != nil {
+		log.Errorf("Unable to stop connection state server: %v", err)
+	}
+
 	return c.serverConn.Close()
 }
 
@@ -264,12 +413,17 @@ func (c *Client) closeStream() error {
 	err := c.serverStream.CloseSend()
 	c.streamCancel()
 	c.serverStream = nil
+	c.resetBatchDeadline()
 
-	// Close all pending subscriptions.
+	// Close all pending subscriptions and remember them so they can be
+	// subscribed again once the stream is re-established.
 	c.subscribedAcctsMtx.Lock()
-	for _, subscription := range c.subscribedAccts {
+	for key, subscription := range c.subscribedAccts {
 		close(subscription.quit)
 		close(subscription.msgChan)
+
+		c.lostAccts[key] = subscription.acctKey
+		delete(c.subscribedAccts, key)
 	}
 	c.subscribedAcctsMtx.Unlock()
 
@@ -280,14 +434,18 @@ func (c *Client) closeStream() error {
 // public key we should use for them in our 2-of-2 multi-sig construction, and
 // the initial batch key.
 func (c *Client) ReserveAccount(ctx context.Context, value btcutil.Amount,
-	expiry uint32, traderKey *btcec.PublicKey) (*account.Reservation,
-	error) {
+	expiry uint32, traderKey *btcec.PublicKey,
+	version account.Version) (*account.Reservation, error) {
+
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
 
 	resp, err := c.client.ReserveAccount(
 		ctx, &auctioneerrpc.ReserveAccountRequest{
 			AccountValue:  uint64(value),
 			TraderKey:     traderKey.SerializeCompressed(),
 			AccountExpiry: expiry,
+			Version:       marshallAccountVersion(version),
 		},
 	)
 	if err != nil {
@@ -317,6 +475,9 @@ func (c *Client) InitAccount(ctx context.Context, account *account.Account) erro
 		return fmt.Errorf("unable to construct account output: %v", err)
 	}
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	_, err = c.client.InitAccount(
 		ctx, &auctioneerrpc.ServerInitAccountRequest{
 			AccountPoint: &auctioneerrpc.OutPoint{
@@ -328,6 +489,7 @@ func (c *Client) InitAccount(ctx context.Context, account *account.Account) erro
 			AccountExpiry: account.Expiry,
 			TraderKey:     account.TraderKey.PubKey.SerializeCompressed(),
 			UserAgent:     c.cfg.GenUserAgent(ctx),
+			Version:       marshallAccountVersion(account.Version),
 		},
 	)
 	return err
@@ -340,10 +502,12 @@ func (c *Client) InitAccount(ctx context.Context, account *account.Account) erro
 // should exclude the account input being spent and the account output
 // potentially being recreated, since the auctioneer can construct those
 // themselves. If no modifiers are present, then the auctioneer will interpret
-// the request as an account closure.
+// the request as an account closure. For taproot accounts the auctioneer's
+// partial signature is returned along with its MuSig2 nonces.
 func (c *Client) ModifyAccount(ctx context.Context, account *account.Account,
 	inputs []*wire.TxIn, outputs []*wire.TxOut,
-	modifiers []account.Modifier) ([]byte, error) {
+	modifiers []account.Modifier, traderNonces []byte,
+	prevOutputs []*wire.TxOut) ([]byte, []byte, error) {
 
 	rpcInputs := make([]*auctioneerrpc.ServerInput, 0, len(inputs))
 	for _, input := range inputs {
@@ -357,13 +521,7 @@ func (c *Client) ModifyAccount(ctx context.Context, account *account.Account,
 		})
 	}
 
-	rpcOutputs := make([]*auctioneerrpc.ServerOutput, 0, len(outputs))
-	for _, output := range outputs {
-		rpcOutputs = append(rpcOutputs, &auctioneerrpc.ServerOutput{
-			Value:  uint64(output.Value),
-			Script: output.PkScript,
-		})
-	}
+	rpcOutputs := marshallServerOutputs(outputs)
 
 	var rpcNewParams *auctioneerrpc.ServerModifyAccountRequest_NewAccountParameters
 	modifiedAccount := account.Copy(modifiers...)
@@ -374,19 +532,54 @@ func (c *Client) ModifyAccount(ctx context.Context, account *account.Account,
 		}
 	}
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	resp, err := c.client.ModifyAccount(
 		ctx, &auctioneerrpc.ServerModifyAccountRequest{
-			TraderKey:  account.TraderKey.PubKey.SerializeCompressed(),
-			NewInputs:  rpcInputs,
-			NewOutputs: rpcOutputs,
-			NewParams:  rpcNewParams,
+			TraderKey:    account.TraderKey.PubKey.SerializeCompressed(),
+			NewInputs:    rpcInputs,
+			NewOutputs:   rpcOutputs,
+			NewParams:    rpcNewParams,
+			TraderNonces: traderNonces,
+			PrevOutputs:  marshallServerOutputs(prevOutputs),
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp.AccountSig, nil
+	return resp.AccountSig, resp.ServerNonces, nil
+}
+
+// marshallServerOutputs turns the given transaction outputs into their RPC
+// representation.
+func marshallServerOutputs(
+	outputs []*wire.TxOut) []*auctioneerrpc.ServerOutput {
+
+	rpcOutputs := make([]*auctioneerrpc.ServerOutput, 0, len(outputs))
+	for _, output := range outputs {
+		rpcOutputs = append(rpcOutputs, &auctioneerrpc.ServerOutput{
+			Value:  uint64(output.Value),
+			Script: output.PkScript,
+		})
+	}
+
+	return rpcOutputs
+}
+
+// marshallAccountVersion turns the given account version into its RPC
+// representation.
+func marshallAccountVersion(
+	version account.Version) auctioneerrpc.AccountVersion {
+
+	switch version {
+	case account.VersionTaprootEnabled:
+		return auctioneerrpc.AccountVersion_ACCOUNT_VERSION_TAPROOT
+
+	default:
+		return auctioneerrpc.AccountVersion_ACCOUNT_VERSION_LEGACY
+	}
 }
 
 // SubmitOrder sends a fully finished order message to the server and interprets
@@ -429,6 +622,8 @@ func (c *Client) SubmitOrder(ctx context.Context, o order.Order,
 		NodeAddr:                nodeAddrs,
 		ChannelType:             channelType,
 		MaxBatchFeeRateSatPerKw: uint64(o.Details().MaxBatchFeeRate),
+		UnannouncedChannel:      o.Details().UnannouncedChannel,
+		ZeroConfChannel:         o.Details().ZeroConfChannel,
 	}
 
 	details.AllowedNodeIds = make(
@@ -454,6 +649,7 @@ func (c *Client) SubmitOrder(ctx context.Context, o order.Order,
 			Details:             details,
 			LeaseDurationBlocks: castOrder.LeaseDuration,
 			Version:             uint32(castOrder.Version),
+			MinDistinctPeers:    castOrder.MinDistinctPeers,
 		}
 		rpcRequest.Details = &auctioneerrpc.ServerSubmitOrderRequest_Ask{
 			Ask: serverAsk,
@@ -482,7 +678,12 @@ func (c *Client) SubmitOrder(ctx context.Context, o order.Order,
 	}
 
 	// Submit the finished request and parse the response.
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	start := time.Now()
 	resp, err := c.client.SubmitOrder(ctx, rpcRequest)
+	c.cfg.Metrics.ObserveOrderSubmission(time.Since(start))
 	if err != nil {
 		return err
 	}
@@ -503,6 +704,9 @@ func (c *Client) SubmitOrder(ctx context.Context, o order.Order,
 
 // CancelOrder sends an order cancellation message to the server.
 func (c *Client) CancelOrder(ctx context.Context, noncePreimage lntypes.Preimage) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	_, err := c.client.CancelOrder(
 		ctx, &auctioneerrpc.ServerCancelOrderRequest{
 			OrderNoncePreimage: noncePreimage[:],
@@ -517,6 +721,9 @@ func (c *Client) CancelOrder(ctx context.Context, noncePreimage lntypes.Preimage
 func (c *Client) OrderState(ctx context.Context, nonce order.Nonce) (
 	*auctioneerrpc.ServerOrderStateResponse, error) {
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	return c.client.OrderState(ctx, &auctioneerrpc.ServerOrderStateRequest{
 		OrderNonce: nonce[:],
 	})
@@ -584,6 +791,8 @@ func (c *Client) connectAndAuthenticate(ctx context.Context,
 			return sub, false, fmt.Errorf("checking pending "+
 				"batch failed: %v", err)
 		}
+
+		c.setConnectionState(ConnectionStateConnected, nil)
 	}
 
 	// For the duration this subscription is active, we need to redirect any
@@ -623,10 +832,14 @@ func (c *Client) connectAndAuthenticate(ctx context.Context,
 		// more conclusive.
 		select {
 		case err := <-tempErrChan:
-			// Ah, so it's the server shutting down, so let's re-
-			// try our connection.
-			if err == ErrServerErrored {
-				return sub, false, c.HandleServerShutdown(nil)
+			// Ah, so it's the server shutting down. The reconnect
+			// was already requested and will subscribe the account
+			// again as it stays registered, so there's nothing
+			// left to do for us unless we're recovering.
+			if (err == ErrServerErrored ||
+				err == ErrServerShutdown) && !recovery {
+
+				return sub, true, nil
 			}
 
 		default:
@@ -701,133 +914,106 @@ func (c *Client) connectAndAuthenticate(ctx context.Context,
 	}
 }
 
-// RecoverAccounts tries to recover all given accounts with the help of the
-// auctioneer server. Because the trader derives a new account key for each
-// attempt of opening an account, there can be "holes" in our list of keys that
-// are actually used. For example if there is insufficient balance in lnd, a key
-// gets "used up" but no account is ever created with it. We'll do a sweep to
-// ensure we generate a key only up to the point where it's required. The total
-// number of requested recoveries is returned upon completion.
-func (c *Client) RecoverAccounts(ctx context.Context,
-	accountKeys []*keychain.KeyDescriptor) ([]*account.Account, error) {
-
-	numNotFoundAccounts := 0
-	var recoveredAccounts []*account.Account
-	for _, keyDesc := range accountKeys {
-		acctKeyBytes := keyDesc.PubKey.SerializeCompressed()
-		var resErr *AcctResNotCompletedError
-
-		// Start the recovery of this account by connecting and going
-		// through the 3-way-handshake. There's four possibilities of
-		// what can happen: 1. The account exists but only as a
-		// reservation. This special case needs to be handled
-		// separately. 2. We get an unexpected error in which case we
-		// abort the recovery. 3. The account doesn't exist and we skip
-		// it. 4. The account exists and we get a success message.
-		// Normal recovery can be attempted.
-		subscription, canRecover, err := c.connectAndAuthenticate(
-			ctx, keyDesc, true,
-		)
-
-		// Case 1: Special recovery of an account that was only
-		// reserved.
-		if errors.As(err, &resErr) {
-			acct, err := incompleteAcctFromErr(keyDesc, resErr)
-			if err != nil {
-				return nil, fmt.Errorf("could not recover "+
-					"reserved account %x: %v", acctKeyBytes,
-					err)
-			}
-			recoveredAccounts = append(recoveredAccounts, acct)
-
-			// Don't send any further messages to the auctioneer,
-			// we won't get any more information than was in the
-			// error.
-			continue
-		}
+// RecoverAccount tries to recover the account of the given key with the help
+// of the auctioneer server. Because the trader derives a new account key for
+// each attempt of opening an account, there can be "holes" in our list of keys
+// that are actually used. For example if there is insufficient balance in lnd,
+// a key gets "used up" but no account is ever created with it. If the
+// auctioneer doesn't know of an account for the key, nil is returned.
+func (c *Client) RecoverAccount(ctx context.Context,
+	keyDesc *keychain.KeyDescriptor) (*account.Account, error) {
+
+	acctKeyBytes := keyDesc.PubKey.SerializeCompressed()
+	var resErr *AcctResNotCompletedError
+
+	// Start the recovery of this account by connecting and going through
+	// the 3-way-handshake. There's four possibilities of what can happen:
+	// 1. The account exists but only as a reservation. This special case
+	// needs to be handled separately. 2. We get an unexpected error in
+	// which case we abort the recovery. 3. The account doesn't exist and we
+	// skip it. 4. The account exists and we get a success message. Normal
+	// recovery can be attempted.
+	subscription, canRecover, err := c.connectAndAuthenticate(
+		ctx, keyDesc, true,
+	)
 
-		// Case 2: Abort on unexpected error.
+	// Case 1: Special recovery of an account that was only reserved. We
+	// don't send any further messages to the auctioneer, we won't get any
+	// more information than was in the error.
+	if errors.As(err, &resErr) {
+		acct, err := incompleteAcctFromErr(keyDesc, resErr)
 		if err != nil {
-			return nil, fmt.Errorf("could not recover account %x: "+
-				"%v", acctKeyBytes, err)
+			return nil, fmt.Errorf("could not recover reserved "+
+				"account %x: %v", acctKeyBytes, err)
 		}
 
-		// Case 3: The auctioneer doesn't know the account.
-		if !canRecover {
-			numNotFoundAccounts++
+		return acct, nil
+	}
 
-			// Stop looking for further accounts if we've got a
-			// certain number of negative responses from the server.
-			if numNotFoundAccounts > maxUnusedAccountKeyLookup {
-				return recoveredAccounts, nil
-			}
+	// Case 2: Abort on unexpected error.
+	if err != nil {
+		return nil, fmt.Errorf("could not recover account %x: %v",
+			acctKeyBytes, err)
+	}
 
-			continue
-		}
+	// Case 3: The auctioneer doesn't know the account.
+	if !canRecover {
+		return nil, nil
+	}
 
-		// Case 4: Attempt normal recovery. First we reset our not found
-		// counter as we've found a recoverable account here. Then ask
-		// the auctioneer to send us back the state as it knows it in
-		// its database. The response to this message will be handled
-		// outside of the client.
-		numNotFoundAccounts = 0
-		err = c.SendAuctionMessage(&auctioneerrpc.ClientAuctionMessage{
-			Msg: &auctioneerrpc.ClientAuctionMessage_Recover{
-				Recover: &auctioneerrpc.AccountRecovery{
-					TraderKey: acctKeyBytes,
-				},
+	// Case 4: Attempt normal recovery. We ask the auctioneer to send us
+	// back the state as it knows it in its database. The response to this
+	// message will be handled outside of the client.
+	err = c.SendAuctionMessage(&auctioneerrpc.ClientAuctionMessage{
+		Msg: &auctioneerrpc.ClientAuctionMessage_Recover{
+			Recover: &auctioneerrpc.AccountRecovery{
+				TraderKey: acctKeyBytes,
 			},
-		})
-		if err != nil {
-			return nil, fmt.Errorf("error sending recover "+
-				"request: %v", err)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error sending recover request: %v", err)
+	}
+
+	// Now we wait for the server to send us the account to recover.
+	select {
+	case msg := <-subscription.msgChan:
+		acctMsg, ok := msg.Msg.(*auctioneerrpc.ServerAuctionMessage_Account)
+		if !ok {
+			return nil, fmt.Errorf("received unexpected recovery "+
+				"message from server: %v", msg)
 		}
 
-		// Now we wait for the server to send us the account to recover.
-		select {
-		case msg := <-subscription.msgChan:
-			acctMsg, ok := msg.Msg.(*auctioneerrpc.ServerAuctionMessage_Account)
-			if !ok {
-				return nil, fmt.Errorf("received unexpected "+
-					"recovery message from server: %v",
-					msg)
-			}
+		// The trader key must match our key, otherwise something got
+		// out of order.
+		acctKey, err := btcec.ParsePubKey(acctMsg.Account.TraderKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing account key: %v",
+				err)
+		}
+		if !acctKey.IsEqual(keyDesc.PubKey) {
+			return nil, fmt.Errorf("invalid trader key, got %x "+
+				"wanted %x", acctMsg.Account.TraderKey,
+				acctKeyBytes)
+		}
 
-			// The trader key must match our key, otherwise
-			// something got out of order.
-			acctKey, err := btcec.ParsePubKey(
-				acctMsg.Account.TraderKey,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("error parsing account "+
-					"key: %v", err)
-			}
-			if !acctKey.IsEqual(keyDesc.PubKey) {
-				return nil, fmt.Errorf("invalid trader key, "+
-					"got %x wanted %x",
-					acctMsg.Account.TraderKey,
-					keyDesc.PubKey.SerializeCompressed())
-			}
+		// Account is ok, parse the rest of the fields.
+		acct, err := unmarshallServerRecoveredAccount(
+			keyDesc, acctMsg.Account,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error recovering account: %v",
+				err)
+		}
 
-			// Account is ok, parse the rest of the fields.
-			acct, err := unmarshallServerRecoveredAccount(
-				keyDesc, acctMsg.Account,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("error recovering "+
-					"account: %v", err)
-			}
-			recoveredAccounts = append(recoveredAccounts, acct)
+		return acct, nil
 
-		case <-ctx.Done():
-			return nil, fmt.Errorf("user canceled operation")
+	case <-ctx.Done():
+		return nil, fmt.Errorf("user canceled operation")
 
-		case <-c.quit:
-			return nil, ErrClientShutdown
-		}
+	case <-c.quit:
+		return nil, ErrClientShutdown
 	}
-
-	return recoveredAccounts, nil
 }
 
 // incompleteAcctFromErr creates an account in the state initiated from an error
@@ -870,7 +1056,20 @@ func (c *Client) SendAuctionMessage(msg *auctioneerrpc.ClientAuctionMessage) err
 		return fmt.Errorf("cannot send message, stream not open")
 	}
 
-	return c.serverStream.Send(msg)
+	if err := c.serverStream.Send(msg); err != nil {
+		return err
+	}
+
+	c.expectBatchMsg(msg)
+
+	return nil
+}
+
+// ServerMessages returns the channel on which all messages sent by the server
+// over the long-lived stream are delivered. The channel is the same as
+// FromServerChan and is closed once the client shuts down.
+func (c *Client) ServerMessages() <-chan *auctioneerrpc.ServerAuctionMessage {
+	return c.FromServerChan
 }
 
 // wait blocks for a given amount of time but returns immediately if the client
@@ -902,6 +1101,13 @@ func (c *Client) connectServerStream(initialBackoff time.Duration,
 	c.streamMutex.Lock()
 	defer c.streamMutex.Unlock()
 
+	// Someone else might have connected the stream while we were waiting
+	// for the lock, for example a new account subscription while we're
+	// reconnecting.
+	if c.serverStream != nil {
+		return nil
+	}
+
 	var (
 		backoff = initialBackoff
 		ctxb    = context.Background()
@@ -911,23 +1117,25 @@ func (c *Client) connectServerStream(initialBackoff time.Duration,
 	for i := 0; i < numRetries; i++ {
 		// Wait before connecting in case this is a reconnect trial.
 		if backoff != 0 {
-			err = c.wait(backoff)
+			err = c.wait(jitter(backoff))
 			if err != nil {
 				return err
 			}
 		}
 
-		// Try connecting by querying a "cheap" RPC that the server can
-		// answer from memory only.
-		_, err = c.client.Terms(ctxb, &auctioneerrpc.TermsRequest{})
+		// Try connecting by pinging the endpoints. The first one that
+		// answers is used for the stream and all other calls.
+		var endpoint *Endpoint
+		endpoint, err = c.serverConn.connect(ctxb, c.pingEndpoint)
 		if err == nil {
-			log.Debugf("Connected successfully to server after "+
-				"%d tries", i+1)
+			log.Debugf("Connected successfully to server %s after "+
+				"%d tries", endpoint.Address, i+1)
 			break
 		}
 
 		// Connect wasn't successful, cancel the context and increase
-		// the time we'll wait until the next try.
+		// the time we'll wait until the next try. Some jitter is added
+		// to the backoff when waiting.
 		backoff *= 2
 		if backoff == 0 {
 			backoff = c.cfg.MinBackoff
@@ -976,6 +1184,50 @@ func (c *Client) connectServerStream(initialBackoff time.Duration,
 	return nil
 }
 
+// pingEndpoint pings a single auction server endpoint, limited by the call
+// timeout.
+func (c *Client) pingEndpoint(ctx context.Context,
+	conn grpc.ClientConnInterface) error {
+
+	pingCtx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	return pingTerms(pingCtx, conn)
+}
+
+// Endpoint returns the address of the auction server endpoint that is
+// currently used.
+func (c *Client) Endpoint() string {
+	if c.serverConn == nil {
+		return ""
+	}
+
+	return c.serverConn.activeConn().endpoint.Address
+}
+
+// SetEndpoint forces the client to use the auction server endpoint with the
+// given address, which must be one of the configured endpoints. The endpoint
+// is tried first on every reconnect from now on, the others are only used if
+// it can't be reached. If the address is empty, the endpoints are selected
+// with the configured strategy again.
+func (c *Client) SetEndpoint(address string) error {
+	if c.serverConn == nil {
+		return fmt.Errorf("client not started")
+	}
+
+	if err := c.serverConn.force(address); err != nil {
+		return err
+	}
+
+	// The stream is bound to the connection to the previous endpoint, so
+	// it needs to be re-established.
+	if c.IsSubscribed() {
+		c.requestReconnect(ErrEndpointSwitched)
+	}
+
+	return nil
+}
+
 // readIncomingStream reads incoming messages on a server update stream.
 // Messages read from the stream are placed in the FromServerChan channel.
 //
@@ -1003,6 +1255,9 @@ func (c *Client) readIncomingStream() { // nolint:gocyclo
 		msg, err := c.serverStream.Recv()
 		log.Tracef("Received msg=%v, err=%v from server",
 			poolrpc.PrintMsg(msg), err)
+		if err == nil {
+			c.resetBatchDeadline()
+		}
 
 		switch {
 		// EOF is the "normal" close signal, meaning the server has
@@ -1011,6 +1266,7 @@ func (c *Client) readIncomingStream() { // nolint:gocyclo
 		// reconnect scheduled. On an improper shutdown, we'll get an
 		// error, usually "transport is closing".
 		case err == io.EOF:
+			c.requestReconnect(ErrServerShutdown)
 			select {
 			case c.errChanSwitch.ErrChan() <- ErrServerShutdown:
 			case <-c.quit:
@@ -1033,6 +1289,7 @@ func (c *Client) readIncomingStream() { // nolint:gocyclo
 			// For any other error type, we'll attempt to trigger
 			// the reconnect logic so we'll always try to connect
 			// to the server in the background.
+			c.requestReconnect(err)
 			select {
 			case c.errChanSwitch.ErrChan() <- ErrServerErrored:
 			case <-c.quit:
@@ -1113,13 +1370,7 @@ func (c *Client) readIncomingStream() { // nolint:gocyclo
 			// The server is shutting down. No need to forward this,
 			// we can just shutdown the stream and try to reconnect.
 			case auctioneerrpc.SubscribeError_SERVER_SHUTDOWN:
-				err := c.HandleServerShutdown(nil)
-				if err != nil {
-					select {
-					case c.errChanSwitch.ErrChan() <- err:
-					case <-c.quit:
-					}
-				}
+				c.requestReconnect(nil)
 				return
 
 			// We received an account not found error. This is not
@@ -1193,7 +1444,8 @@ func (c *Client) sendToSubscription(traderAccountKey []byte,
 // HandleServerShutdown handles the signal from the server that it is going to
 // shut down. In that case, we try to reconnect a number of times with an
 // incremental backoff time we wait between trials. If the connection succeeds,
-// all previous subscriptions are sent again.
+// all previous subscriptions are sent again. The connection state is set to
+// degraded if not all of them could be restored.
 func (c *Client) HandleServerShutdown(err error) error {
 	if err == nil {
 		log.Infof("Server is shutting down, will reconnect in %v",
@@ -1201,6 +1453,8 @@ func (c *Client) HandleServerShutdown(err error) error {
 	} else {
 		log.Errorf("Error in stream, trying to reconnect: %v", err)
 	}
+	c.setConnectionState(ConnectionStateReconnecting, err)
+
 	err = c.closeStream()
 	if err != nil {
 		log.Errorf("Error closing stream connection: %v", err)
@@ -1216,24 +1470,39 @@ func (c *Client) HandleServerShutdown(err error) error {
 	// pending batch as finalized, or if we need to remove it due to the
 	// batch auction no longer including us.
 	if err := c.checkPendingBatch(); err != nil {
+		c.setConnectionState(ConnectionStateDegraded, err)
 		return err
 	}
 
-	// Subscribe to all accounts again. Remove the old subscriptions in the
-	// same move as new ones will be created.
+	// Subscribe to all accounts again that lost their subscription when
+	// the stream was closed. If one of them fails, we still try the
+	// remaining ones. A subscription is registered again before the
+	// authentication starts, so a failed one is closed and remembered
+	// again on the next reconnect.
 	c.subscribedAcctsMtx.Lock()
-	acctKeys := make([]*keychain.KeyDescriptor, 0, len(c.subscribedAccts))
-	for key, subscription := range c.subscribedAccts {
-		acctKeys = append(acctKeys, subscription.acctKey)
-		delete(c.subscribedAccts, key)
+	acctKeys := make([]*keychain.KeyDescriptor, 0, len(c.lostAccts))
+	for key, acctKey := range c.lostAccts {
+		acctKeys = append(acctKeys, acctKey)
+		delete(c.lostAccts, key)
 	}
 	c.subscribedAcctsMtx.Unlock()
+
+	var subscribeErr error
 	for _, acctKey := range acctKeys {
 		err := c.StartAccountSubscription(context.Background(), acctKey)
 		if err != nil {
-			return err
+			log.Errorf("Unable to subscribe account %x again: %v",
+				acctKey.PubKey.SerializeCompressed(), err)
+			subscribeErr = err
 		}
 	}
+	if subscribeErr != nil {
+		c.setConnectionState(ConnectionStateDegraded, subscribeErr)
+		return subscribeErr
+	}
+
+	c.setConnectionState(ConnectionStateConnected, nil)
+
 	return nil
 }
 
@@ -1318,12 +1587,30 @@ func unmarshallServerRecoveredAccount(keyDesc *keychain.KeyDescriptor,
 			Index: a.Outpoint.OutputIndex,
 		},
 		LatestTx: latestTx,
+		Version:  unmarshallAccountVersion(a.Version),
 	}, nil
 }
 
+// unmarshallAccountVersion parses the RPC representation of an account
+// version.
+func unmarshallAccountVersion(
+	version auctioneerrpc.AccountVersion) account.Version {
+
+	switch version {
+	case auctioneerrpc.AccountVersion_ACCOUNT_VERSION_TAPROOT:
+		return account.VersionTaprootEnabled
+
+	default:
+		return account.VersionInitialNoVersion
+	}
+}
+
 // Terms returns the current dynamic auctioneer terms like max account size, max
 // order duration in blocks and the auction fee schedule.
 func (c *Client) Terms(ctx context.Context) (*terms.AuctioneerTerms, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	resp, err := c.client.Terms(ctx, &auctioneerrpc.TermsRequest{})
 	if err != nil {
 		return nil, err
@@ -1338,6 +1625,12 @@ func (c *Client) Terms(ctx context.Context) (*terms.AuctioneerTerms, error) {
 		NextBatchFeeRate:         chainfee.SatPerKWeight(resp.NextBatchFeeRateSatPerKw),
 		NextBatchClear:           time.Unix(int64(resp.NextBatchClearTimestamp), 0),
 		AutoRenewExtensionBlocks: resp.AutoRenewExtensionBlocks,
+		TaprootAccounts:          resp.TaprootAccounts,
+		MaxSelfChanBalanceRatio:  resp.MaxSelfChanBalanceRatioPpm,
+		ChannelTypes:             resp.ChannelTypes,
+		Capabilities:             terms.Capability(resp.Capabilities),
+		ServerVersion:            resp.ServerVersion,
+		BatchVersion:             resp.BatchVersion,
 	}, nil
 }
 
@@ -1350,6 +1643,9 @@ func (c *Client) BatchSnapshot(ctx context.Context,
 	targetBatch order.BatchID) (*auctioneerrpc.BatchSnapshotResponse,
 	error) {
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	return c.client.BatchSnapshot(ctx, &auctioneerrpc.BatchSnapshotRequest{
 		BatchId: targetBatch[:],
 	})
@@ -1367,6 +1663,9 @@ func (c *Client) BatchSnapshots(ctx context.Context,
 	req *auctioneerrpc.BatchSnapshotsRequest) (
 	*auctioneerrpc.BatchSnapshotsResponse, error) {
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	return c.client.BatchSnapshots(ctx, req)
 }
 
@@ -1383,6 +1682,9 @@ func (c *Client) NodeRating(ctx context.Context,
 	req := &auctioneerrpc.ServerNodeRatingRequest{
 		NodePubkeys: pubKeys,
 	}
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	serverResp, err := c.client.NodeRating(ctx, req)
 	if err != nil {
 		return nil, err
@@ -1393,6 +1695,48 @@ func (c *Client) NodeRating(ctx context.Context,
 	}, nil
 }
 
+// NodeTiers returns the tiers the auctioneer currently assigns to the given
+// nodes. Nodes without a rating are in the lowest tier.
+func (c *Client) NodeTiers(ctx context.Context,
+	nodeKeys [][33]byte) (map[[33]byte]order.NodeTier, error) {
+
+	pubKeys := make([][]byte, 0, len(nodeKeys))
+	for _, nodeKey := range nodeKeys {
+		pubKeys = append(pubKeys, append([]byte(nil), nodeKey[:]...))
+	}
+
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	resp, err := c.client.NodeRating(
+		ctx, &auctioneerrpc.ServerNodeRatingRequest{
+			NodePubkeys: pubKeys,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make(map[[33]byte]order.NodeTier, len(nodeKeys))
+	for _, nodeKey := range nodeKeys {
+		tiers[nodeKey] = order.NodeTier0
+	}
+	for _, rating := range resp.NodeRatings {
+		var nodeKey [33]byte
+		copy(nodeKey[:], rating.NodePubkey)
+
+		switch rating.NodeTier {
+		case auctioneerrpc.NodeTier_TIER_1:
+			tiers[nodeKey] = order.NodeTier1
+
+		default:
+			tiers[nodeKey] = order.NodeTier0
+		}
+	}
+
+	return tiers, nil
+}
+
 // MarketInfo returns information about the current number of open orders and
 // open interest in units of all open markets.
 //
@@ -1401,6 +1745,9 @@ func (c *Client) NodeRating(ctx context.Context,
 func (c *Client) MarketInfo(ctx context.Context) (
 	*auctioneerrpc.MarketInfoResponse, error) {
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	return c.client.MarketInfo(ctx, &auctioneerrpc.MarketInfoRequest{})
 }
 
@@ -1436,6 +1783,9 @@ func (c *Client) InitTicketCipherBox(ctx context.Context, sid [64]byte,
 		return err
 	}
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	_, err = c.hashMailClient.NewCipherBox(ctx, streamAuth)
 	return err
 }
@@ -1450,6 +1800,9 @@ func (c *Client) DelSidecarMailbox(ctx context.Context,
 		return err
 	}
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	_, err = c.hashMailClient.DelCipherBox(ctx, streamAuth)
 	return err
 }
@@ -1491,6 +1844,9 @@ func (c *Client) InitAccountCipherBox(ctx context.Context, sid [64]byte,
 		return err
 	}
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	_, err = c.hashMailClient.NewCipherBox(ctx, streamAuth)
 	return err
 }
@@ -1505,6 +1861,9 @@ func (c *Client) DelAcctMailbox(ctx context.Context, sid [64]byte,
 		return err
 	}
 
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	_, err = c.hashMailClient.DelCipherBox(ctx, streamAuth)
 	return err
 }
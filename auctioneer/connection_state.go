@@ -0,0 +1,183 @@
+package auctioneer
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// ConnectionState is the state of the long-lived stream connection to the
+// auction server.
+type ConnectionState uint8
+
+const (
+	// ConnectionStateDisconnected is the state before the stream to the
+	// auction server was opened for the first time, which only happens once
+	// the first account is subscribed, and after the client shut down.
+	ConnectionStateDisconnected ConnectionState = 0
+
+	// ConnectionStateConnected means the stream to the auction server is
+	// open and all accounts are subscribed to it.
+	ConnectionStateConnected ConnectionState = 1
+
+	// ConnectionStateDegraded means the stream to the auction server could
+	// be re-established, but either not all accounts could be subscribed
+	// again or the pending batch couldn't be checked. Another reconnect
+	// attempt is scheduled.
+	ConnectionStateDegraded ConnectionState = 2
+
+	// ConnectionStateReconnecting means the stream to the auction server
+	// was lost and the client is trying to re-establish it.
+	ConnectionStateReconnecting ConnectionState = 3
+)
+
+// String returns a human readable representation of the connection state.
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionStateDisconnected:
+		return "Disconnected"
+
+	case ConnectionStateConnected:
+		return "Connected"
+
+	case ConnectionStateDegraded:
+		return "Degraded"
+
+	case ConnectionStateReconnecting:
+		return "Reconnecting"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// ConnectionStateUpdate is sent to all subscribers of the connection state
+// each time the state changes.
+type ConnectionStateUpdate struct {
+	// State is the new connection state.
+	State ConnectionState
+
+	// Timestamp is the time the state changed.
+	Timestamp time.Time
+
+	// Err is the error that caused the state change, if any.
+	Err error
+}
+
+// ConnectionState returns the current state of the stream connection to the
+// auction server.
+func (c *Client) ConnectionState() ConnectionState {
+	c.connStateMtx.Lock()
+	defer c.connStateMtx.Unlock()
+
+	return c.connState
+}
+
+// SubscribeConnectionState returns a client that receives a
+// *ConnectionStateUpdate each time the state of the stream connection to the
+// auction server changes.
+func (c *Client) SubscribeConnectionState() (*subscribe.Client, error) {
+	return c.connStateUpdates.Subscribe()
+}
+
+// setConnectionState updates the connection state and notifies all
+// subscribers if it changed.
+func (c *Client) setConnectionState(state ConnectionState, cause error) {
+	c.connStateMtx.Lock()
+	defer c.connStateMtx.Unlock()
+
+	if c.connState == state {
+		return
+	}
+
+	log.Infof("Auction server connection state changed from %v to %v",
+		c.connState, state)
+	c.connState = state
+	c.cfg.Metrics.SetConnectionState(state.String())
+
+	err := c.connStateUpdates.SendUpdate(&ConnectionStateUpdate{
+		State:     state,
+		Timestamp: time.Now(),
+		Err:       cause,
+	})
+	if err != nil && err != subscribe.ErrServerShuttingDown {
+		log.Errorf("Unable to send connection state update: %v", err)
+	}
+}
+
+// requestReconnect schedules a reconnect of the stream to the auction server
+// because of the given cause. Multiple requests that arrive while a reconnect
+// is already pending are merged into one.
+func (c *Client) requestReconnect(cause error) {
+	c.setConnectionState(ConnectionStateReconnecting, cause)
+
+	select {
+	case c.reconnectChan <- cause:
+	default:
+	}
+}
+
+// TokenChanged re-establishes the stream to the auction server so it is
+// authenticated with the current LSAT token of the token store. Nothing
+// happens if the stream isn't connected, it picks up the current token once it
+// connects.
+func (c *Client) TokenChanged() {
+	c.streamMutex.Lock()
+	connected := c.serverStream != nil
+	c.streamMutex.Unlock()
+
+	if !connected {
+		return
+	}
+
+	c.requestReconnect(ErrTokenChanged)
+}
+
+// reconnectHandler re-establishes the stream to the auction server and all
+// account subscriptions each time a reconnect is requested. If that fails, it
+// keeps trying with the maximum backoff until it succeeds or the client shuts
+// down.
+//
+// NOTE: This method must be called as a goroutine.
+func (c *Client) reconnectHandler() {
+	defer c.wg.Done()
+
+	for {
+		var cause error
+		select {
+		case cause = <-c.reconnectChan:
+		case <-c.quit:
+			return
+		}
+
+		for {
+			err := c.HandleServerShutdown(cause)
+			if err == nil {
+				break
+			}
+			if err == ErrClientShutdown {
+				return
+			}
+
+			log.Errorf("Unable to restore connection to auction "+
+				"server: %v", err)
+			cause = err
+
+			if err := c.wait(jitter(c.cfg.MaxBackoff)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// jitter adds a random duration of up to a fifth of the given backoff to it so
+// many traders that lost their connection at the same time don't all try to
+// reconnect at exactly the same moment.
+func jitter(backoff time.Duration) time.Duration {
+	if backoff < 5 {
+		return backoff
+	}
+
+	return backoff + time.Duration(rand.Int63n(int64(backoff/5)))
+}
@@ -0,0 +1,75 @@
+package auctioneer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestConnectionStateUpdates makes sure subscribers are only notified about
+// actual changes of the connection state and that reconnect requests are
+// merged while one is pending.
+func TestConnectionStateUpdates(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(&Config{Insecure: true})
+	require.NoError(t, err)
+	require.NoError(t, c.connStateUpdates.Start())
+	defer func() {
+		require.NoError(t, c.connStateUpdates.Stop())
+	}()
+
+	sub, err := c.SubscribeConnectionState()
+	require.NoError(t, err)
+	defer sub.Cancel()
+
+	nextUpdate := func() *ConnectionStateUpdate {
+		select {
+		case update := <-sub.Updates():
+			return update.(*ConnectionStateUpdate)
+
+		case <-time.After(time.Second):
+			t.Fatalf("no connection state update received")
+			return nil
+		}
+	}
+
+	require.Equal(t, ConnectionStateDisconnected, c.ConnectionState())
+
+	c.setConnectionState(ConnectionStateConnected, nil)
+	require.Equal(t, ConnectionStateConnected, nextUpdate().State)
+
+	// Setting the same state again doesn't result in an update, so the
+	// next one we receive is the reconnect.
+	c.setConnectionState(ConnectionStateConnected, nil)
+
+	streamErr := errors.New("transport is closing")
+	c.requestReconnect(streamErr)
+	c.requestReconnect(ErrServerShutdown)
+
+	update := nextUpdate()
+	require.Equal(t, ConnectionStateReconnecting, update.State)
+	require.Equal(t, streamErr, update.Err)
+	require.Equal(t, ConnectionStateReconnecting, c.ConnectionState())
+
+	// Only the first request is pending, the second one was merged into
+	// it.
+	require.Len(t, c.reconnectChan, 1)
+	require.Equal(t, streamErr, <-c.reconnectChan)
+}
+
+// TestJitter makes sure the jitter added to a backoff stays within bounds.
+func TestJitter(t *testing.T) {
+	t.Parallel()
+
+	const backoff = time.Second
+	for i := 0; i < 100; i++ {
+		withJitter := jitter(backoff)
+		require.GreaterOrEqual(t, withJitter, backoff)
+		require.Less(t, withJitter, backoff+backoff/5)
+	}
+
+	require.Equal(t, time.Duration(0), jitter(0))
+}
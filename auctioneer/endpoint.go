@@ -0,0 +1,362 @@
+package auctioneer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"google.golang.org/grpc"
+)
+
+// EndpointSelection is the strategy used to decide which auction server
+// endpoint to connect to.
+type EndpointSelection uint8
+
+const (
+	// SelectOrdered tries the endpoints in the order they are configured
+	// and uses the first one that can be reached.
+	SelectOrdered EndpointSelection = iota
+
+	// SelectPing pings all endpoints and uses the one that answers the
+	// fastest.
+	SelectPing
+)
+
+// String returns the name of the endpoint selection strategy.
+func (s EndpointSelection) String() string {
+	switch s {
+	case SelectOrdered:
+		return "ordered"
+
+	case SelectPing:
+		return "ping"
+
+	default:
+		return fmt.Sprintf("unknown <%d>", uint8(s))
+	}
+}
+
+// ParseEndpointSelection parses an endpoint selection strategy from its name.
+func ParseEndpointSelection(s string) (EndpointSelection, error) {
+	switch s {
+	case SelectOrdered.String():
+		return SelectOrdered, nil
+
+	case SelectPing.String():
+		return SelectPing, nil
+
+	default:
+		return 0, fmt.Errorf("unknown endpoint selection %s", s)
+	}
+}
+
+const (
+	// endpointOptInsecure is the endpoint option that disables TLS.
+	endpointOptInsecure = "insecure"
+
+	// endpointOptTLSPath is the endpoint option that sets the path to the
+	// self signed TLS certificate of the endpoint.
+	endpointOptTLSPath = "tlspath="
+)
+
+var (
+	// ErrEndpointSwitched is the cause of a reconnect because another
+	// endpoint was forced to be used.
+	ErrEndpointSwitched = errors.New("auction server endpoint switched")
+
+	// ErrUnknownEndpoint is returned if an endpoint is forced to be used
+	// that isn't configured.
+	ErrUnknownEndpoint = errors.New("unknown auction server endpoint")
+)
+
+// Endpoint is an address of the auction server together with the TLS settings
+// to connect to it.
+type Endpoint struct {
+	// Address is the domain:port of the endpoint.
+	Address string
+
+	// Insecure signals that no TLS should be used if set to true.
+	Insecure bool
+
+	// TLSPath is the path to a local file that holds the TLS certificate
+	// of the endpoint. This is only needed if it uses a self signed cert.
+	TLSPath string
+}
+
+// ParseEndpoint parses an endpoint in the format
+// <domain:port>[,insecure|,tlspath=<path>].
+func ParseEndpoint(s string) (*Endpoint, error) {
+	parts := strings.Split(s, ",")
+	endpoint := &Endpoint{
+		Address: strings.TrimSpace(parts[0]),
+	}
+	if endpoint.Address == "" {
+		return nil, fmt.Errorf("endpoint %s has no address", s)
+	}
+
+	for _, opt := range parts[1:] {
+		opt = strings.TrimSpace(opt)
+		switch {
+		case opt == endpointOptInsecure:
+			endpoint.Insecure = true
+
+		case strings.HasPrefix(opt, endpointOptTLSPath):
+			endpoint.TLSPath = strings.TrimPrefix(
+				opt, endpointOptTLSPath,
+			)
+
+		default:
+			return nil, fmt.Errorf("endpoint %s has unknown "+
+				"option %s", s, opt)
+		}
+	}
+
+	if endpoint.Insecure && endpoint.TLSPath != "" {
+		return nil, fmt.Errorf("endpoint %s cannot be insecure and "+
+			"use a TLS certificate", s)
+	}
+
+	return endpoint, nil
+}
+
+// endpointConn is the connection to a single auction server endpoint.
+type endpointConn struct {
+	endpoint *Endpoint
+	conn     *grpc.ClientConn
+}
+
+// failoverConn is a client connection that sends all calls to the currently
+// active auction server endpoint. Because the generated clients are created
+// once on top of it, switching the endpoint doesn't require re-creating them.
+type failoverConn struct {
+	conns     []*endpointConn
+	selection EndpointSelection
+
+	mu     sync.RWMutex
+	active int
+
+	// preferred is the index of the endpoint that was forced to be used.
+	// It is tried first on every connect. It's -1 if no endpoint was
+	// forced.
+	preferred int
+}
+
+// A compile-time check to make sure failoverConn implements the
+// grpc.ClientConnInterface.
+var _ grpc.ClientConnInterface = (*failoverConn)(nil)
+
+// dialEndpoints creates a connection to each of the given endpoints with the
+// dial options of the same index. The connections are established lazily, so
+// the endpoints don't need to be reachable yet.
+func dialEndpoints(endpoints []*Endpoint, dialOpts [][]grpc.DialOption,
+	selection EndpointSelection) (*failoverConn, error) {
+
+	f := &failoverConn{
+		selection: selection,
+		preferred: -1,
+	}
+	for idx, endpoint := range endpoints {
+		conn, err := grpc.Dial(endpoint.Address, dialOpts[idx]...)
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("unable to connect to %s: %v",
+				endpoint.Address, err)
+		}
+
+		f.conns = append(f.conns, &endpointConn{
+			endpoint: endpoint,
+			conn:     conn,
+		})
+	}
+
+	return f, nil
+}
+
+// Invoke performs a unary RPC on the active endpoint.
+//
+// NOTE: This is part of the grpc.ClientConnInterface interface.
+func (f *failoverConn) Invoke(ctx context.Context, method string, args,
+	reply interface{}, opts ...grpc.CallOption) error {
+
+	return f.activeConn().conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream begins a streaming RPC on the active endpoint.
+//
+// NOTE: This is part of the grpc.ClientConnInterface interface.
+func (f *failoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc,
+	method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+	return f.activeConn().conn.NewStream(ctx, desc, method, opts...)
+}
+
+// Close closes the connections to all endpoints.
+func (f *failoverConn) Close() error {
+	var closeErr error
+	for _, c := range f.conns {
+		if err := c.conn.Close(); err != nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
+}
+
+// activeConn returns the connection to the active endpoint.
+func (f *failoverConn) activeConn() *endpointConn {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.conns[f.active]
+}
+
+// index returns the index of the endpoint with the given address.
+func (f *failoverConn) index(address string) (int, error) {
+	for idx, c := range f.conns {
+		if c.endpoint.Address == address {
+			return idx, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrUnknownEndpoint, address)
+}
+
+// force makes the endpoint with the given address the active one and tries it
+// first on every connect from now on. If the address is empty, the endpoints
+// are selected with the configured strategy again.
+func (f *failoverConn) force(address string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if address == "" {
+		f.preferred = -1
+		return nil
+	}
+
+	idx, err := f.index(address)
+	if err != nil {
+		return err
+	}
+
+	f.active = idx
+	f.preferred = idx
+
+	return nil
+}
+
+// connect selects the endpoint to use and makes it the active one. The
+// preferred endpoint is tried first, then the other endpoints in the order of
+// the selection strategy. The first endpoint that answers the ping is used.
+func (f *failoverConn) connect(ctx context.Context,
+	ping func(context.Context, grpc.ClientConnInterface) error) (*Endpoint,
+	error) {
+
+	f.mu.RLock()
+	preferred := f.preferred
+	f.mu.RUnlock()
+
+	var candidates []int
+	if preferred >= 0 {
+		candidates = append(candidates, preferred)
+	}
+
+	order, pingErrs := f.candidates(ctx, ping)
+	for _, idx := range order {
+		if idx != preferred {
+			candidates = append(candidates, idx)
+		}
+	}
+
+	var lastErr error
+	for _, idx := range candidates {
+		// We already know whether the endpoint is reachable if we
+		// pinged all of them to select the fastest one.
+		err, pinged := pingErrs[idx]
+		if !pinged {
+			err = ping(ctx, f.conns[idx].conn)
+		}
+		if err != nil {
+			log.Debugf("Auction server endpoint %s unreachable: %v",
+				f.conns[idx].endpoint.Address, err)
+			lastErr = err
+			continue
+		}
+
+		f.mu.Lock()
+		if f.active != idx {
+			log.Infof("Switching to auction server endpoint %s",
+				f.conns[idx].endpoint.Address)
+		}
+		f.active = idx
+		f.mu.Unlock()
+
+		return f.conns[idx].endpoint, nil
+	}
+
+	return nil, lastErr
+}
+
+// candidates returns the indexes of all endpoints in the order they should be
+// tried. If the endpoints are selected by their ping, the result of the ping of
+// each endpoint is returned as well.
+func (f *failoverConn) candidates(ctx context.Context,
+	ping func(context.Context, grpc.ClientConnInterface) error) ([]int,
+	map[int]error) {
+
+	order := make([]int, len(f.conns))
+	for idx := range f.conns {
+		order[idx] = idx
+	}
+
+	if f.selection != SelectPing || len(f.conns) == 1 {
+		return order, nil
+	}
+
+	var (
+		wg        sync.WaitGroup
+		latencies = make([]time.Duration, len(f.conns))
+		errs      = make([]error, len(f.conns))
+	)
+	for idx, c := range f.conns {
+		wg.Add(1)
+		go func(idx int, conn grpc.ClientConnInterface) {
+			defer wg.Done()
+
+			start := time.Now()
+			errs[idx] = ping(ctx, conn)
+			latencies[idx] = time.Since(start)
+		}(idx, c.conn)
+	}
+	wg.Wait()
+
+	// Reachable endpoints come first, sorted by their latency. The
+	// unreachable ones keep their configured order at the end.
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if (errs[a] == nil) != (errs[b] == nil) {
+			return errs[a] == nil
+		}
+
+		return errs[a] == nil && latencies[a] < latencies[b]
+	})
+
+	pingErrs := make(map[int]error, len(errs))
+	for idx, err := range errs {
+		pingErrs[idx] = err
+	}
+
+	return order, pingErrs
+}
+
+// pingTerms pings an endpoint by querying a "cheap" RPC that the server can
+// answer from memory only.
+func pingTerms(ctx context.Context, conn grpc.ClientConnInterface) error {
+	client := auctioneerrpc.NewChannelAuctioneerClient(conn)
+	_, err := client.Terms(ctx, &auctioneerrpc.TermsRequest{})
+	return err
+}
@@ -0,0 +1,151 @@
+package auctioneer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TestParseEndpoint makes sure endpoints and their TLS options are parsed
+// correctly.
+func TestParseEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		endpoint string
+		expected *Endpoint
+	}{{
+		endpoint: "pool.example.com:12010",
+		expected: &Endpoint{Address: "pool.example.com:12010"},
+	}, {
+		endpoint: "localhost:12009,insecure",
+		expected: &Endpoint{Address: "localhost:12009", Insecure: true},
+	}, {
+		endpoint: "10.0.0.1:12010, tlspath=/tmp/tls.cert",
+		expected: &Endpoint{
+			Address: "10.0.0.1:12010",
+			TLSPath: "/tmp/tls.cert",
+		},
+	}, {
+		endpoint: ",insecure",
+	}, {
+		endpoint: "localhost:12009,plaintext",
+	}, {
+		endpoint: "localhost:12009,insecure,tlspath=/tmp/tls.cert",
+	}}
+	for _, tc := range testCases {
+		endpoint, err := ParseEndpoint(tc.endpoint)
+		if tc.expected == nil {
+			require.Error(t, err, tc.endpoint)
+			continue
+		}
+
+		require.NoError(t, err, tc.endpoint)
+		require.Equal(t, tc.expected, endpoint)
+	}
+}
+
+// newTestFailoverConn creates a failover connection to endpoints with the
+// given addresses. None of them needs to exist as the connections are only
+// established lazily.
+func newTestFailoverConn(t *testing.T, selection EndpointSelection,
+	addrs ...string) *failoverConn {
+
+	endpoints := make([]*Endpoint, len(addrs))
+	dialOpts := make([][]grpc.DialOption, len(addrs))
+	for idx, addr := range addrs {
+		endpoints[idx] = &Endpoint{Address: addr, Insecure: true}
+		dialOpts[idx] = []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}
+	}
+
+	f, err := dialEndpoints(endpoints, dialOpts, selection)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, f.Close())
+	})
+
+	return f
+}
+
+// testPing returns a ping function that answers with the given delay for all
+// endpoints that are up.
+func testPing(up map[string]time.Duration) func(context.Context,
+	grpc.ClientConnInterface) error {
+
+	return func(_ context.Context, conn grpc.ClientConnInterface) error {
+		delay, ok := up[conn.(*grpc.ClientConn).Target()]
+		if !ok {
+			return errors.New("unreachable")
+		}
+
+		time.Sleep(delay)
+		return nil
+	}
+}
+
+// TestFailoverConnect makes sure the endpoints are tried in the order of the
+// selection strategy and that a forced endpoint is always tried first.
+func TestFailoverConnect(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// With the ordered selection the first reachable endpoint is used.
+	f := newTestFailoverConn(t, SelectOrdered, "a:1", "b:1", "c:1")
+	endpoint, err := f.connect(ctx, testPing(map[string]time.Duration{
+		"b:1": 20 * time.Millisecond,
+		"c:1": 0,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "b:1", endpoint.Address)
+	require.Equal(t, "b:1", f.activeConn().endpoint.Address)
+
+	// If none of them can be reached, the active endpoint isn't changed.
+	_, err = f.connect(ctx, testPing(nil))
+	require.Error(t, err)
+	require.Equal(t, "b:1", f.activeConn().endpoint.Address)
+
+	// With the ping selection the fastest endpoint is used.
+	f = newTestFailoverConn(t, SelectPing, "a:1", "b:1", "c:1")
+	endpoint, err = f.connect(ctx, testPing(map[string]time.Duration{
+		"b:1": 50 * time.Millisecond,
+		"c:1": 0,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "c:1", endpoint.Address)
+
+	// A forced endpoint is used right away and tried first, as long as it
+	// can be reached.
+	require.ErrorIs(t, f.force("d:1"), ErrUnknownEndpoint)
+	require.NoError(t, f.force("a:1"))
+	require.Equal(t, "a:1", f.activeConn().endpoint.Address)
+
+	endpoint, err = f.connect(ctx, testPing(map[string]time.Duration{
+		"a:1": 50 * time.Millisecond,
+		"c:1": 0,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "a:1", endpoint.Address)
+
+	endpoint, err = f.connect(ctx, testPing(map[string]time.Duration{
+		"c:1": 0,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "c:1", endpoint.Address)
+
+	// Once the forced endpoint is reset, the fastest one is used again.
+	require.NoError(t, f.force(""))
+	endpoint, err = f.connect(ctx, testPing(map[string]time.Duration{
+		"a:1": 50 * time.Millisecond,
+		"c:1": 0,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "c:1", endpoint.Address)
+}
@@ -0,0 +1,142 @@
+package auctioneer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// DefaultKeepaliveTime is the default time after which the client
+	// pings the auction server if it didn't see any activity on the
+	// connection.
+	DefaultKeepaliveTime = time.Minute
+
+	// DefaultKeepaliveTimeout is the default time the client waits for the
+	// auction server to answer a keepalive ping before it considers the
+	// connection dead.
+	DefaultKeepaliveTimeout = 20 * time.Second
+
+	// DefaultCallTimeout is the default maximum time a single unary call
+	// to the auction server can take.
+	DefaultCallTimeout = 30 * time.Second
+
+	// DefaultBatchStreamTimeout is the default maximum time the client
+	// waits for the next message of the auction server after it accepted
+	// or signed a batch before it considers the stream dead.
+	DefaultBatchStreamTimeout = 2 * time.Minute
+)
+
+var (
+	// ErrBatchStreamTimeout is the cause of a reconnect if the auction
+	// server didn't send any message within the batch stream timeout while
+	// we were waiting for the next step of a batch.
+	ErrBatchStreamTimeout = errors.New("no message received from auction " +
+		"server during batch execution")
+)
+
+// keepaliveDialOpts returns the dial options that enable client side keepalive
+// pings on the connection to the auction server, if configured. Pings detect
+// connections that were silently dropped, for example by a NAT, which would
+// otherwise go unnoticed until the next message is sent.
+func keepaliveDialOpts(cfg *Config) []grpc.DialOption {
+	if cfg.KeepaliveTime <= 0 {
+		return nil
+	}
+
+	return []grpc.DialOption{
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                cfg.KeepaliveTime,
+			Timeout:             cfg.KeepaliveTimeout,
+			PermitWithoutStream: cfg.KeepalivePermitWithoutStream,
+		}),
+	}
+}
+
+// callContext derives the context for a single unary call to the auction
+// server from the given parent context. The call is canceled once the
+// configured call timeout is reached, unless the parent context has an earlier
+// deadline.
+func (c *Client) callContext(ctx context.Context) (context.Context,
+	context.CancelFunc) {
+
+	if c.cfg.CallTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.cfg.CallTimeout)
+}
+
+// expectBatchMsg arms the batch stream watchdog if the given message is one
+// the auction server needs to answer to continue the current batch.
+func (c *Client) expectBatchMsg(msg *auctioneerrpc.ClientAuctionMessage) {
+	if c.cfg.BatchStreamTimeout <= 0 {
+		return
+	}
+
+	switch msg.Msg.(type) {
+	case *auctioneerrpc.ClientAuctionMessage_Accept,
+		*auctioneerrpc.ClientAuctionMessage_Sign:
+
+		c.batchDeadlineMtx.Lock()
+		c.batchDeadline = time.Now().Add(c.cfg.BatchStreamTimeout)
+		c.batchDeadlineMtx.Unlock()
+	}
+}
+
+// resetBatchDeadline disarms the batch stream watchdog. This is done each time
+// a message arrives from the auction server or the stream is closed.
+func (c *Client) resetBatchDeadline() {
+	c.batchDeadlineMtx.Lock()
+	c.batchDeadline = time.Time{}
+	c.batchDeadlineMtx.Unlock()
+}
+
+// batchDeadlineExpired returns true and disarms the batch stream watchdog if
+// we're waiting for a batch message from the auction server for longer than
+// the batch stream timeout.
+func (c *Client) batchDeadlineExpired(now time.Time) bool {
+	c.batchDeadlineMtx.Lock()
+	defer c.batchDeadlineMtx.Unlock()
+
+	if c.batchDeadline.IsZero() || now.Before(c.batchDeadline) {
+		return false
+	}
+
+	c.batchDeadline = time.Time{}
+	return true
+}
+
+// batchStreamWatchdog forces a reconnect if the auction server doesn't send
+// any message within the batch stream timeout after we accepted or signed a
+// batch. A stream that died without an error would otherwise only be noticed
+// once the batch timed out on the server side and we missed it.
+//
+// NOTE: This method must be called as a goroutine.
+func (c *Client) batchStreamWatchdog() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.cfg.BatchStreamTimeout / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if !c.batchDeadlineExpired(now) {
+				continue
+			}
+
+			log.Warnf("No message received from auction server "+
+				"within %v during batch execution, reconnecting",
+				c.cfg.BatchStreamTimeout)
+			c.requestReconnect(ErrBatchStreamTimeout)
+
+		case <-c.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package auctioneer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchDeadline makes sure the batch stream watchdog is only armed by
+// messages the auction server needs to answer and is disarmed again once the
+// deadline expired.
+func TestBatchDeadline(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(&Config{
+		Insecure:           true,
+		BatchStreamTimeout: time.Minute,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.False(t, c.batchDeadlineExpired(now.Add(time.Hour)))
+
+	// A subscription doesn't need an answer within the batch timeout.
+	c.expectBatchMsg(&auctioneerrpc.ClientAuctionMessage{
+		Msg: &auctioneerrpc.ClientAuctionMessage_Subscribe{},
+	})
+	require.False(t, c.batchDeadlineExpired(now.Add(time.Hour)))
+
+	// Accepting a batch arms the watchdog.
+	c.expectBatchMsg(&auctioneerrpc.ClientAuctionMessage{
+		Msg: &auctioneerrpc.ClientAuctionMessage_Accept{},
+	})
+	require.False(t, c.batchDeadlineExpired(now))
+	require.True(t, c.batchDeadlineExpired(now.Add(time.Hour)))
+
+	// Once expired, the watchdog is disarmed so we only reconnect once.
+	require.False(t, c.batchDeadlineExpired(now.Add(time.Hour)))
+
+	// Any message from the server disarms it as well.
+	c.expectBatchMsg(&auctioneerrpc.ClientAuctionMessage{
+		Msg: &auctioneerrpc.ClientAuctionMessage_Sign{},
+	})
+	c.resetBatchDeadline()
+	require.False(t, c.batchDeadlineExpired(now.Add(time.Hour)))
+}
+
+// TestCallContext makes sure unary calls get the configured timeout unless the
+// parent context expires earlier.
+func TestCallContext(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(&Config{
+		Insecure:    true,
+		CallTimeout: time.Minute,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := c.callContext(context.Background())
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline,
+		time.Second)
+
+	parentCtx, parentCancel := context.WithTimeout(
+		context.Background(), time.Second,
+	)
+	defer parentCancel()
+	parentDeadline, _ := parentCtx.Deadline()
+
+	ctx, cancel = c.callContext(parentCtx)
+	defer cancel()
+
+	deadline, ok = ctx.Deadline()
+	require.True(t, ok)
+	require.Equal(t, parentDeadline, deadline)
+
+	// Without a call timeout, only the parent's deadline applies.
+	c.cfg.CallTimeout = 0
+	ctx, cancel = c.callContext(context.Background())
+	defer cancel()
+
+	_, ok = ctx.Deadline()
+	require.False(t, ok)
+}
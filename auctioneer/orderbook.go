@@ -0,0 +1,437 @@
+package auctioneer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+)
+
+const (
+	// MaxOrderBookLevels is the maximum number of rate levels per side of
+	// a market that are cached. If a market has more levels, only the best
+	// ones are kept, which are the lowest ask and the highest bid rates.
+	MaxOrderBookLevels = 500
+
+	// OrderBookQueueSize is the maximum number of order book updates that
+	// are queued for a single subscriber. A subscriber that falls further
+	// behind is disconnected.
+	OrderBookQueueSize = 100
+)
+
+var (
+	// ErrSlowSubscriber is the error that is sent to an order book
+	// subscriber that didn't keep up with the updates of the market.
+	ErrSlowSubscriber = errors.New("order book subscriber too slow")
+
+	// errOrderBookGap is the error that is returned if an order book
+	// update is received that doesn't directly follow the last one.
+	errOrderBookGap = errors.New("gap in order book updates")
+)
+
+// orderBook is the cached order book of a single market.
+type orderBook struct {
+	asks map[uint32]*auctioneerrpc.OrderBookLevel
+	bids map[uint32]*auctioneerrpc.OrderBookLevel
+
+	// sequence is the sequence number of the last applied update.
+	sequence uint64
+
+	// synced is true once a snapshot of the book was received.
+	synced bool
+}
+
+// newOrderBook creates a new empty order book.
+func newOrderBook() *orderBook {
+	return &orderBook{
+		asks: make(map[uint32]*auctioneerrpc.OrderBookLevel),
+		bids: make(map[uint32]*auctioneerrpc.OrderBookLevel),
+	}
+}
+
+// apply applies the given update to the book. A snapshot replaces all levels,
+// an incremental update must directly follow the last applied update.
+func (b *orderBook) apply(update *auctioneerrpc.OrderBookUpdate) error {
+	if update.Snapshot {
+		b.asks = make(map[uint32]*auctioneerrpc.OrderBookLevel)
+		b.bids = make(map[uint32]*auctioneerrpc.OrderBookLevel)
+		b.synced = true
+	} else if !b.synced || update.Sequence != b.sequence+1 {
+		return fmt.Errorf("%w: expected sequence %d, got %d",
+			errOrderBookGap, b.sequence+1, update.Sequence)
+	}
+	b.sequence = update.Sequence
+
+	applyLevels(b.asks, update.Asks)
+	applyLevels(b.bids, update.Bids)
+
+	// Only keep the best levels of each side to bound the memory of the
+	// cached book.
+	trimLevels(b.asks, MaxOrderBookLevels, false)
+	trimLevels(b.bids, MaxOrderBookLevels, true)
+
+	return nil
+}
+
+// snapshot returns a snapshot update of the full book with the ask levels
+// ordered by ascending and the bid levels by descending rate.
+func (b *orderBook) snapshot(
+	leaseDuration uint32) *auctioneerrpc.OrderBookUpdate {
+
+	return &auctioneerrpc.OrderBookUpdate{
+		LeaseDurationBlocks: leaseDuration,
+		Sequence:            b.sequence,
+		Snapshot:            true,
+		Asks:                sortedLevels(b.asks, false),
+		Bids:                sortedLevels(b.bids, true),
+	}
+}
+
+// applyLevels adds, updates or removes the given levels. A level without any
+// units is removed.
+func applyLevels(book map[uint32]*auctioneerrpc.OrderBookLevel,
+	levels []*auctioneerrpc.OrderBookLevel) {
+
+	for _, level := range levels {
+		if level.Units == 0 {
+			delete(book, level.RateFixed)
+			continue
+		}
+
+		book[level.RateFixed] = level
+	}
+}
+
+// sortedLevels returns all levels of one side of the book ordered by rate.
+func sortedLevels(book map[uint32]*auctioneerrpc.OrderBookLevel,
+	descending bool) []*auctioneerrpc.OrderBookLevel {
+
+	levels := make([]*auctioneerrpc.OrderBookLevel, 0, len(book))
+	for _, level := range book {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if descending {
+			return levels[i].RateFixed > levels[j].RateFixed
+		}
+		return levels[i].RateFixed < levels[j].RateFixed
+	})
+
+	return levels
+}
+
+// trimLevels removes the worst levels of one side of the book until at most
+// maxLevels are left.
+func trimLevels(book map[uint32]*auctioneerrpc.OrderBookLevel, maxLevels int,
+	descending bool) {
+
+	if len(book) <= maxLevels {
+		return
+	}
+
+	levels := sortedLevels(book, descending)
+	for _, level := range levels[maxLevels:] {
+		delete(book, level.RateFixed)
+	}
+}
+
+// OrderBookSubscription is a single local subscriber to the order book of a
+// market.
+type OrderBookSubscription struct {
+	id      uint64
+	updates chan *auctioneerrpc.OrderBookUpdate
+	errChan chan error
+	cancel  func()
+}
+
+// Updates returns the channel the order book updates are delivered on. The
+// first update is always a snapshot of the full book.
+func (s *OrderBookSubscription) Updates() <-chan *auctioneerrpc.OrderBookUpdate {
+	return s.updates
+}
+
+// Err returns the channel an error is delivered on if the subscription ended.
+func (s *OrderBookSubscription) Err() <-chan error {
+	return s.errChan
+}
+
+// Cancel ends the subscription.
+func (s *OrderBookSubscription) Cancel() {
+	s.cancel()
+}
+
+// orderBookFeed is the single upstream subscription to the order book of one
+// market that is fanned out to all local subscribers.
+type orderBookFeed struct {
+	leaseDuration uint32
+
+	book *orderBook
+
+	// synced is closed once the first snapshot of the book was received.
+	synced     chan struct{}
+	syncedOnce sync.Once
+
+	subscribers map[uint64]*OrderBookSubscription
+	nextID      uint64
+
+	mu sync.Mutex
+}
+
+// newOrderBookFeed creates a new feed for the market with the given lease
+// duration.
+func newOrderBookFeed(leaseDuration uint32) *orderBookFeed {
+	return &orderBookFeed{
+		leaseDuration: leaseDuration,
+		book:          newOrderBook(),
+		synced:        make(chan struct{}),
+		subscribers:   make(map[uint64]*OrderBookSubscription),
+	}
+}
+
+// subscribe adds a new subscriber to the feed. If the book is already known,
+// its snapshot is queued for the subscriber right away.
+func (f *orderBookFeed) subscribe() *OrderBookSubscription {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sub := &OrderBookSubscription{
+		id: f.nextID,
+		updates: make(
+			chan *auctioneerrpc.OrderBookUpdate, OrderBookQueueSize,
+		),
+		errChan: make(chan error, 1),
+	}
+	sub.cancel = func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		delete(f.subscribers, sub.id)
+	}
+	f.nextID++
+	f.subscribers[sub.id] = sub
+
+	if f.book.synced {
+		sub.updates <- f.book.snapshot(f.leaseDuration)
+	}
+
+	return sub
+}
+
+// handleUpdate applies an update received from the auctioneer to the cached
+// book and relays it to all subscribers. Snapshots are relayed as the trimmed
+// cached book.
+func (f *orderBookFeed) handleUpdate(
+	update *auctioneerrpc.OrderBookUpdate) error {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.book.apply(update); err != nil {
+		return err
+	}
+
+	if update.Snapshot {
+		f.syncedOnce.Do(func() {
+			close(f.synced)
+		})
+		update = f.book.snapshot(f.leaseDuration)
+	}
+
+	for id, sub := range f.subscribers {
+		select {
+		case sub.updates <- update:
+
+		// The subscriber's queue is full, so it can't keep up with the
+		// market. We disconnect it instead of queueing more updates.
+		default:
+			log.Warnf("Disconnecting slow order book subscriber "+
+				"for lease duration %d", f.leaseDuration)
+
+			delete(f.subscribers, id)
+			sub.errChan <- ErrSlowSubscriber
+		}
+	}
+
+	return nil
+}
+
+// resync marks the cached book as outdated, so only a new snapshot is applied
+// to it. The outdated book is still returned as the cached snapshot until then.
+func (f *orderBookFeed) resync() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.book.synced = false
+}
+
+// snapshot returns a snapshot of the cached book.
+func (f *orderBookFeed) snapshot() *auctioneerrpc.OrderBookUpdate {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.book.snapshot(f.leaseDuration)
+}
+
+// stop ends all subscriptions with the given error.
+func (f *orderBookFeed) stop(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for id, sub := range f.subscribers {
+		delete(f.subscribers, id)
+		sub.errChan <- err
+	}
+}
+
+// orderBookFeed returns the feed for the market with the given lease duration
+// and starts relaying it from the auctioneer if it isn't yet.
+func (c *Client) orderBookFeed(leaseDuration uint32) (*orderBookFeed, error) {
+	c.orderBookFeedsMtx.Lock()
+	defer c.orderBookFeedsMtx.Unlock()
+
+	select {
+	case <-c.quit:
+		return nil, ErrClientShutdown
+	default:
+	}
+
+	feed, ok := c.orderBookFeeds[leaseDuration]
+	if ok {
+		return feed, nil
+	}
+
+	feed = newOrderBookFeed(leaseDuration)
+	c.orderBookFeeds[leaseDuration] = feed
+
+	c.wg.Add(1)
+	go c.relayOrderBook(feed)
+
+	return feed, nil
+}
+
+// SubscribeOrderBook subscribes to the order book of the market with the given
+// lease duration. All local subscribers of a market share a single upstream
+// subscription to the auctioneer.
+func (c *Client) SubscribeOrderBook(
+	leaseDuration uint32) (*OrderBookSubscription, error) {
+
+	feed, err := c.orderBookFeed(leaseDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return feed.subscribe(), nil
+}
+
+// OrderBookSnapshot returns the cached order book of the market with the given
+// lease duration. If the market isn't relayed yet, the relay is started and
+// the first snapshot is awaited.
+func (c *Client) OrderBookSnapshot(ctx context.Context,
+	leaseDuration uint32) (*auctioneerrpc.OrderBookUpdate, error) {
+
+	feed, err := c.orderBookFeed(leaseDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-feed.synced:
+		return feed.snapshot(), nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-c.quit:
+		return nil, ErrClientShutdown
+	}
+}
+
+// relayOrderBook keeps the upstream subscription of the given feed alive and
+// reconnects with an incremental backoff if it fails. Every reconnect starts
+// with a new snapshot of the book, which is relayed to all subscribers so
+// they can resync.
+//
+// NOTE: This method must be called as a goroutine.
+func (c *Client) relayOrderBook(feed *orderBookFeed) {
+	defer c.wg.Done()
+	defer feed.stop(ErrClientShutdown)
+
+	var backoff time.Duration
+	for {
+		received, err := c.readOrderBook(feed)
+		select {
+		case <-c.quit:
+			return
+		default:
+		}
+
+		// We start over with the minimum backoff if the last
+		// subscription delivered any updates.
+		if received {
+			backoff = 0
+		}
+		backoff *= 2
+		if backoff == 0 {
+			backoff = c.cfg.MinBackoff
+		}
+		if backoff > c.cfg.MaxBackoff {
+			backoff = c.cfg.MaxBackoff
+		}
+		log.Warnf("Order book subscription for lease duration %d "+
+			"failed, reconnecting in %v: %v", feed.leaseDuration,
+			backoff, err)
+
+		if err := c.wait(backoff); err != nil {
+			return
+		}
+	}
+}
+
+// readOrderBook subscribes to the order book of the feed's market and applies
+// all updates until the subscription fails. It returns whether any update was
+// received.
+func (c *Client) readOrderBook(feed *orderBookFeed) (bool, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Make sure we abort the subscription if the client shuts down.
+	go func() {
+		select {
+		case <-c.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	stream, err := c.client.SubscribeOrderBook(
+		ctx, &auctioneerrpc.ServerOrderBookRequest{
+			LeaseDurationBlocks: feed.leaseDuration,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	// The auctioneer starts every subscription with a snapshot of the
+	// book, which replaces whatever we have cached.
+	feed.resync()
+
+	var received bool
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+
+		// A gap means we missed updates, so we need to resubscribe to
+		// get a fresh snapshot.
+		if err := feed.handleUpdate(update); err != nil {
+			return received, err
+		}
+		received = true
+	}
+}
@@ -0,0 +1,154 @@
+package auctioneer
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// level is a helper to create an order book level.
+func level(rate uint32, units uint64) *auctioneerrpc.OrderBookLevel {
+	return &auctioneerrpc.OrderBookLevel{
+		RateFixed: rate,
+		Units:     units,
+		NumOrders: 1,
+	}
+}
+
+// TestOrderBookApply makes sure snapshots and incremental updates are applied
+// to the cached book and that gaps in the updates are detected.
+func TestOrderBookApply(t *testing.T) {
+	t.Parallel()
+
+	book := newOrderBook()
+
+	// An incremental update can't be applied before the first snapshot.
+	err := book.apply(&auctioneerrpc.OrderBookUpdate{
+		Sequence: 1,
+		Asks:     []*auctioneerrpc.OrderBookLevel{level(10, 5)},
+	})
+	require.ErrorIs(t, err, errOrderBookGap)
+
+	require.NoError(t, book.apply(&auctioneerrpc.OrderBookUpdate{
+		Sequence: 5,
+		Snapshot: true,
+		Asks: []*auctioneerrpc.OrderBookLevel{
+			level(30, 1), level(10, 5),
+		},
+		Bids: []*auctioneerrpc.OrderBookLevel{
+			level(5, 2), level(8, 3),
+		},
+	}))
+
+	// A level without units is removed, others are added or replaced.
+	require.NoError(t, book.apply(&auctioneerrpc.OrderBookUpdate{
+		Sequence: 6,
+		Asks: []*auctioneerrpc.OrderBookLevel{
+			level(30, 0), level(20, 4),
+		},
+		Bids: []*auctioneerrpc.OrderBookLevel{level(8, 7)},
+	}))
+
+	snapshot := book.snapshot(2016)
+	require.Equal(t, &auctioneerrpc.OrderBookUpdate{
+		LeaseDurationBlocks: 2016,
+		Sequence:            6,
+		Snapshot:            true,
+		Asks: []*auctioneerrpc.OrderBookLevel{
+			level(10, 5), level(20, 4),
+		},
+		Bids: []*auctioneerrpc.OrderBookLevel{
+			level(8, 7), level(5, 2),
+		},
+	}, snapshot)
+
+	// Skipping a sequence number is a gap.
+	err = book.apply(&auctioneerrpc.OrderBookUpdate{Sequence: 8})
+	require.ErrorIs(t, err, errOrderBookGap)
+}
+
+// TestOrderBookTrim makes sure only the best levels of each side are cached.
+func TestOrderBookTrim(t *testing.T) {
+	t.Parallel()
+
+	update := &auctioneerrpc.OrderBookUpdate{Snapshot: true}
+	for i := 0; i < MaxOrderBookLevels+10; i++ {
+		update.Asks = append(update.Asks, level(uint32(i+1), 1))
+		update.Bids = append(update.Bids, level(uint32(i+1), 1))
+	}
+
+	book := newOrderBook()
+	require.NoError(t, book.apply(update))
+
+	snapshot := book.snapshot(2016)
+	require.Len(t, snapshot.Asks, MaxOrderBookLevels)
+	require.Len(t, snapshot.Bids, MaxOrderBookLevels)
+
+	// The lowest asks and the highest bids are kept.
+	last := MaxOrderBookLevels - 1
+	require.EqualValues(t, 1, snapshot.Asks[0].RateFixed)
+	require.EqualValues(t, MaxOrderBookLevels, snapshot.Asks[last].RateFixed)
+	require.EqualValues(t, MaxOrderBookLevels+10, snapshot.Bids[0].RateFixed)
+	require.EqualValues(t, 11, snapshot.Bids[last].RateFixed)
+}
+
+// TestOrderBookFeed makes sure updates are fanned out to all subscribers, new
+// subscribers start with a snapshot and slow subscribers are disconnected.
+func TestOrderBookFeed(t *testing.T) {
+	t.Parallel()
+
+	feed := newOrderBookFeed(2016)
+
+	// A subscriber that joins before the first snapshot doesn't get any
+	// update until the snapshot arrives.
+	early := feed.subscribe()
+	require.Empty(t, early.Updates())
+
+	require.NoError(t, feed.handleUpdate(&auctioneerrpc.OrderBookUpdate{
+		Sequence: 1,
+		Snapshot: true,
+		Asks:     []*auctioneerrpc.OrderBookLevel{level(10, 5)},
+	}))
+	require.Equal(t, feed.snapshot(), <-early.Updates())
+
+	select {
+	case <-feed.synced:
+	default:
+		t.Fatalf("feed not synced after snapshot")
+	}
+
+	// A subscriber that joins later starts with the cached snapshot.
+	late := feed.subscribe()
+	require.Equal(t, feed.snapshot(), <-late.Updates())
+
+	// Incremental updates are relayed to both subscribers.
+	delta := &auctioneerrpc.OrderBookUpdate{
+		Sequence: 2,
+		Bids:     []*auctioneerrpc.OrderBookLevel{level(8, 1)},
+	}
+	require.NoError(t, feed.handleUpdate(delta))
+	require.Equal(t, delta, <-early.Updates())
+	require.Equal(t, delta, <-late.Updates())
+
+	// A canceled subscriber doesn't receive any more updates.
+	late.Cancel()
+
+	// The early subscriber stops reading, so it is disconnected once its
+	// queue is full.
+	for i := 0; i <= OrderBookQueueSize; i++ {
+		require.NoError(t, feed.handleUpdate(
+			&auctioneerrpc.OrderBookUpdate{Sequence: uint64(i + 3)},
+		))
+	}
+	require.ErrorIs(t, <-early.Err(), ErrSlowSubscriber)
+	require.Empty(t, late.Updates())
+	require.Empty(t, feed.subscribers)
+
+	// After a resync, only a new snapshot is accepted.
+	feed.resync()
+	err := feed.handleUpdate(&auctioneerrpc.OrderBookUpdate{
+		Sequence: OrderBookQueueSize + 4,
+	})
+	require.ErrorIs(t, err, errOrderBookGap)
+}
@@ -0,0 +1,66 @@
+package auctioneer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+const (
+	// proxyCheckTimeout is the maximum time we wait for the SOCKS proxy or
+	// the Tor control port to accept a connection when checking them at
+	// startup.
+	proxyCheckTimeout = 10 * time.Second
+)
+
+// newProxyDialer returns a gRPC context dialer that establishes all
+// connections through the SOCKS5 proxy with the given address. If stream
+// isolation is requested, random credentials are used for each connection,
+// which makes Tor use a separate circuit for each of them.
+func newProxyDialer(proxyAddress string, streamIsolation bool) func(
+	context.Context, string) (net.Conn, error) {
+
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		timeout := tor.DefaultConnTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = time.Until(deadline)
+		}
+
+		return tor.Dial(
+			addr, proxyAddress, streamIsolation, false, timeout,
+		)
+	}
+}
+
+// checkProxy makes sure the SOCKS5 proxy and, if configured, the Tor control
+// port are reachable. This allows us to fail fast with a clear error at
+// startup instead of retrying to connect to the auction server forever.
+func checkProxy(proxyAddress, torControlAddress,
+	torControlPassword string) error {
+
+	conn, err := net.DialTimeout("tcp", proxyAddress, proxyCheckTimeout)
+	if err != nil {
+		return fmt.Errorf("SOCKS proxy %v is unreachable: %v",
+			proxyAddress, err)
+	}
+	_ = conn.Close()
+
+	if torControlAddress == "" {
+		return nil
+	}
+
+	// Starting the controller connects and authenticates to the control
+	// port, which tells us the Tor daemon is up and running.
+	controller := tor.NewController(
+		torControlAddress, "", torControlPassword,
+	)
+	if err := controller.Start(); err != nil {
+		return fmt.Errorf("tor control port %v is unreachable: %v",
+			torControlAddress, err)
+	}
+
+	return controller.Stop()
+}
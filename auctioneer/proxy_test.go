@@ -0,0 +1,36 @@
+package auctioneer
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckProxy makes sure an unreachable proxy is detected at startup.
+func TestCheckProxy(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	proxyAddress := listener.Addr().String()
+
+	// As long as something listens on the proxy address, the check
+	// passes.
+	require.NoError(t, checkProxy(proxyAddress, "", ""))
+
+	// Once the proxy is gone, we get a clear error.
+	require.NoError(t, listener.Close())
+	err = checkProxy(proxyAddress, "", "")
+	require.ErrorContains(t, err, "SOCKS proxy "+proxyAddress+" is "+
+		"unreachable")
+
+	// The same goes for the Tor control port if one is configured.
+	listener, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	err = checkProxy(listener.Addr().String(), proxyAddress, "")
+	require.ErrorContains(t, err, "tor control port "+proxyAddress+" is "+
+		"unreachable")
+}
@@ -32,6 +32,7 @@ type ChannelAuctioneerClient interface {
 	NodeRating(ctx context.Context, in *ServerNodeRatingRequest, opts ...grpc.CallOption) (*ServerNodeRatingResponse, error)
 	BatchSnapshots(ctx context.Context, in *BatchSnapshotsRequest, opts ...grpc.CallOption) (*BatchSnapshotsResponse, error)
 	MarketInfo(ctx context.Context, in *MarketInfoRequest, opts ...grpc.CallOption) (*MarketInfoResponse, error)
+	SubscribeOrderBook(ctx context.Context, in *ServerOrderBookRequest, opts ...grpc.CallOption) (ChannelAuctioneer_SubscribeOrderBookClient, error)
 }
 
 type channelAuctioneerClient struct {
@@ -212,6 +213,38 @@ func (c *channelAuctioneerClient) MarketInfo(ctx context.Context, in *MarketInfo
 	return out, nil
 }
 
+func (c *channelAuctioneerClient) SubscribeOrderBook(ctx context.Context, in *ServerOrderBookRequest, opts ...grpc.CallOption) (ChannelAuctioneer_SubscribeOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChannelAuctioneer_ServiceDesc.Streams[2], "/poolrpc.ChannelAuctioneer/SubscribeOrderBook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &channelAuctioneerSubscribeOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChannelAuctioneer_SubscribeOrderBookClient interface {
+	Recv() (*OrderBookUpdate, error)
+	grpc.ClientStream
+}
+
+type channelAuctioneerSubscribeOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *channelAuctioneerSubscribeOrderBookClient) Recv() (*OrderBookUpdate, error) {
+	m := new(OrderBookUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChannelAuctioneerServer is the server API for ChannelAuctioneer service.
 // All implementations must embed UnimplementedChannelAuctioneerServer
 // for forward compatibility
@@ -230,6 +263,7 @@ type ChannelAuctioneerServer interface {
 	NodeRating(context.Context, *ServerNodeRatingRequest) (*ServerNodeRatingResponse, error)
 	BatchSnapshots(context.Context, *BatchSnapshotsRequest) (*BatchSnapshotsResponse, error)
 	MarketInfo(context.Context, *MarketInfoRequest) (*MarketInfoResponse, error)
+	SubscribeOrderBook(*ServerOrderBookRequest, ChannelAuctioneer_SubscribeOrderBookServer) error
 	mustEmbedUnimplementedChannelAuctioneerServer()
 }
 
@@ -279,6 +313,9 @@ func (UnimplementedChannelAuctioneerServer) BatchSnapshots(context.Context, *Bat
 func (UnimplementedChannelAuctioneerServer) MarketInfo(context.Context, *MarketInfoRequest) (*MarketInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketInfo not implemented")
 }
+func (UnimplementedChannelAuctioneerServer) SubscribeOrderBook(*ServerOrderBookRequest, ChannelAuctioneer_SubscribeOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOrderBook not implemented")
+}
 func (UnimplementedChannelAuctioneerServer) mustEmbedUnimplementedChannelAuctioneerServer() {}
 
 // UnsafeChannelAuctioneerServer may be embedded to opt out of forward compatibility for this service.
@@ -560,6 +597,27 @@ func _ChannelAuctioneer_MarketInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelAuctioneer_SubscribeOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerOrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChannelAuctioneerServer).SubscribeOrderBook(m, &channelAuctioneerSubscribeOrderBookServer{stream})
+}
+
+type ChannelAuctioneer_SubscribeOrderBookServer interface {
+	Send(*OrderBookUpdate) error
+	grpc.ServerStream
+}
+
+type channelAuctioneerSubscribeOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *channelAuctioneerSubscribeOrderBookServer) Send(m *OrderBookUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ChannelAuctioneer_ServiceDesc is the grpc.ServiceDesc for ChannelAuctioneer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -629,6 +687,11 @@ var ChannelAuctioneer_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SubscribeOrderBook",
+			Handler:       _ChannelAuctioneer_SubscribeOrderBook_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "auctioneer.proto",
 }
@@ -12,43 +12,9 @@ import (
 	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/sidecar"
-	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
-// MailBox is an interface that abstracts over the HashMail functionality to
-// represent a generic mailbox that both sides will use to communicate with
-// each other.
-type MailBox interface {
-	// RecvSidecarPkt attempts to receive a new sidecar packet from the
-	// relevant mailbox defined by the ticket and sidecar ticket role.
-	RecvSidecarPkt(ctx context.Context, pkt *sidecar.Ticket,
-		provider bool) (*sidecar.Ticket, error)
-
-	// SendSidecarPkt attempts to send the specified sidecar ticket to the
-	// party designated by the provider bool.
-	SendSidecarPkt(ctx context.Context, pkt *sidecar.Ticket,
-		provider bool) error
-
-	// InitSidecarMailbox attempts to create the mailbox with the given
-	// stream ID using the sidecar ticket authentication mechanism. If the
-	// mailbox already exists, then a nil error is to be returned.
-	InitSidecarMailbox(streamID [64]byte, ticket *sidecar.Ticket) error
-
-	// DelSidecarMailbox tears down the mailbox the sidecar ticket
-	// recipient used to communicate with the provider.
-	DelSidecarMailbox(streamID [64]byte, ticket *sidecar.Ticket) error
-
-	// InitAcctMailbox attempts to create the mailbox with the given stream
-	// ID using account signature authentication mechanism. If the mailbox
-	// already exists, then a nil error is to be returned.
-	InitAcctMailbox(streamID [64]byte, pubKey *keychain.KeyDescriptor) error
-
-	// DelAcctMailbox tears down the mailbox that the sidecar ticket
-	// provider used to communicate with the recipient.
-	DelAcctMailbox(streamID [64]byte, pubKey *keychain.KeyDescriptor) error
-}
-
 // SidecarPacket encapsulates the current state of an auto sidecar negotiator.
 // Note that the state of the negotiator, and the ticket may differ, this is
 // what will trigger a state transition.
@@ -130,6 +96,10 @@ type SidecarDriver interface {
 	// storage.
 	UpdateSidecar(tkt *sidecar.Ticket) error
 
+	// UpdateSidecarWithReason writes the passed sidecar ticket to
+	// persistent storage and records the reason of its state change.
+	UpdateSidecarWithReason(tkt *sidecar.Ticket, reason string) error
+
 	// SubmitSidecarOrder submits a bid derived from the sidecar ticket,
 	// account, and bid template to the auctioneer.
 	SubmitSidecarOrder(*sidecar.Ticket, *order.Bid,
@@ -159,7 +129,7 @@ type AutoAcceptorConfig struct {
 
 	// MailBox is used to allow negotiators to send messages back and forth
 	// to each other.
-	MailBox MailBox
+	MailBox sidecar.MailBox
 }
 
 // finalization is a struct that contains the reason (state) and initiator of a
@@ -175,6 +145,21 @@ type finalization struct {
 	otherSide bool
 }
 
+// reason returns a human readable explanation of the finalization that is
+// recorded in the ticket's event log. Only cancellations have a reason.
+func (f *finalization) reason() string {
+	switch {
+	case f.state != sidecar.StateCanceled:
+		return ""
+
+	case f.otherSide:
+		return "canceled by the other party"
+
+	default:
+		return "canceled locally"
+	}
+}
+
 // SidecarNegotiator is a sub-system that uses a mailbox abstraction between a
 // provider and recipient of a sidecar channel to complete the manual steps in
 // automated manner.
@@ -377,7 +362,10 @@ func (a *SidecarNegotiator) autoSidecarReceiver(ctx context.Context,
 			// The ticket has been marked as finalized, so we'll
 			// update it as being in a final state in the database.
 			localTicket.State = fin.state
-			if err := a.cfg.Driver.UpdateSidecar(localTicket); err != nil {
+			err := a.cfg.Driver.UpdateSidecarWithReason(
+				localTicket, fin.reason(),
+			)
+			if err != nil {
 				log.Errorf("unable to update ticket to "+
 					"complete state: %v", err)
 				return
@@ -677,7 +665,10 @@ func (a *SidecarNegotiator) autoSidecarProvider(ctx context.Context,
 			// The ticket has been marked as finalized, so we'll
 			// update it as being in a final state in the database.
 			localTicket.State = fin.state
-			if err := a.cfg.Driver.UpdateSidecar(localTicket); err != nil {
+			err := a.cfg.Driver.UpdateSidecarWithReason(
+				localTicket, fin.reason(),
+			)
+			if err != nil {
 				log.Errorf("unable to update ticket to "+
 					"complete state: %v", err)
 				return
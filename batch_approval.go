@@ -0,0 +1,172 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/approval"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+)
+
+// approveBatch asks the external approval hook about the batch, if one is
+// configured, and persists its decision. An error wrapping
+// approval.ErrBatchNotApproved is returned if the batch must not be signed.
+func (s *rpcServer) approveBatch(batch *order.Batch) error {
+	if s.approvalGate == nil {
+		return nil
+	}
+
+	proposal, err := newBatchProposal(
+		batch, s.server.db.GetOrder, s.server.db.Account,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create batch proposal: %v", err)
+	}
+
+	record := s.approvalGate.Decide(context.Background(), proposal)
+
+	rpcLog.Infof("Batch %x approval decision: approved=%v, timed_out=%v, "+
+		"latency=%v, reason=%s", batch.ID[:], record.Approved,
+		record.TimedOut, record.Latency, record.Reason)
+
+	// The decision is only an audit record, we don't want to reject a
+	// batch the hook approved just because we failed to persist it.
+	if err := s.server.db.StoreBatchApproval(batch.ID, record); err != nil {
+		rpcLog.Errorf("Unable to store batch approval: %v", err)
+	}
+
+	return record.Err()
+}
+
+// newBatchProposal distills the parts of a batch that are relevant to the
+// approval hook.
+func newBatchProposal(batch *order.Batch, getOrder order.Fetcher,
+	getAccount func(*btcec.PublicKey) (*account.Account, error)) (
+	*approval.Proposal, error) {
+
+	proposal := &approval.Proposal{
+		BatchID:        batch.ID,
+		Version:        uint32(batch.Version),
+		BatchTxFeeRate: batch.BatchTxFeeRate,
+		ClearingPrices: make(
+			map[uint32]uint32, len(batch.ClearingPrices),
+		),
+	}
+	for duration, price := range batch.ClearingPrices {
+		proposal.ClearingPrices[duration] = uint32(price)
+	}
+
+	numChans := make(map[[33]byte]uint32)
+	for nonce, matches := range batch.MatchedOrders {
+		ourOrder, err := getOrder(nonce)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch order %v: %v",
+				nonce, err)
+		}
+		details := ourOrder.Details()
+
+		for _, match := range matches {
+			// The duration of the channel is always that specified
+			// by the bid order.
+			duration := details.LeaseDuration
+			if bid, ok := match.Order.(*order.Bid); ok {
+				duration = bid.LeaseDuration
+			}
+
+			amt := match.UnitsFilled.ToSatoshis()
+			clearingPrice := batch.ClearingPrices[duration]
+			m := &approval.Match{
+				OrderNonce:    nonce,
+				IsAsk:         ourOrder.Type() == order.TypeAsk,
+				MatchedNonce:  match.Order.Nonce(),
+				NodeKey:       match.NodeKey,
+				UnitsFilled:   uint32(match.UnitsFilled),
+				LeaseDuration: duration,
+				Premium: clearingPrice.LumpSumPremium(
+					amt, duration,
+				),
+				ExecutionFee: batch.ExecutionFee.BaseFee() +
+					batch.ExecutionFee.ExecutionFee(amt),
+			}
+			proposal.Matches = append(proposal.Matches, m)
+
+			numChans[details.AcctKey]++
+		}
+	}
+
+	for _, diff := range batch.AccountDiffs {
+		acct, err := getAccount(diff.AccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch account %x: %v",
+				diff.AccountKeyRaw[:], err)
+		}
+
+		a := &approval.Account{
+			TraderKey:       diff.AccountKeyRaw,
+			StartingBalance: acct.Value,
+			EndingBalance:   diff.EndingBalance,
+			ChainFee: order.EstimateTraderFee(
+				numChans[diff.AccountKeyRaw],
+				batch.BatchTxFeeRate, acct.Version,
+			),
+			NewExpiry: diff.NewExpiry,
+		}
+		proposal.Accounts = append(proposal.Accounts, a)
+	}
+
+	// Sort everything to get a stable proposal, the batch stores the
+	// matches in a map.
+	sort.Slice(proposal.Matches, func(i, j int) bool {
+		a, b := proposal.Matches[i], proposal.Matches[j]
+		cmp := bytes.Compare(a.OrderNonce[:], b.OrderNonce[:])
+		if cmp != 0 {
+			return cmp < 0
+		}
+		return bytes.Compare(a.MatchedNonce[:], b.MatchedNonce[:]) < 0
+	})
+	sort.Slice(proposal.Accounts, func(i, j int) bool {
+		return bytes.Compare(
+			proposal.Accounts[i].TraderKey[:],
+			proposal.Accounts[j].TraderKey[:],
+		) < 0
+	})
+
+	return proposal, nil
+}
+
+// marshallBatchApproval translates the approval decision about a batch into
+// its RPC counterpart.
+func marshallBatchApproval(
+	record *approval.Record) *poolrpc.BatchApprovalRecord {
+
+	return &poolrpc.BatchApprovalRecord{
+		Approved:  record.Approved,
+		TimedOut:  record.TimedOut,
+		Reason:    record.Reason,
+		LatencyMs: uint64(record.Latency.Milliseconds()),
+		DecidedAt: record.DecidedAt.Unix(),
+	}
+}
+
+// fetchBatchApproval returns the RPC representation of the approval decision
+// about the batch with the given ID or nil if there is none.
+func (s *rpcServer) fetchBatchApproval(
+	id order.BatchID) (*poolrpc.BatchApprovalRecord, error) {
+
+	record, err := s.server.db.BatchApproval(id)
+	switch {
+	case errors.Is(err, approval.ErrNoBatchApproval):
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return marshallBatchApproval(record), nil
+}
@@ -0,0 +1,356 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
+)
+
+const (
+	// batchReorgSafetyDepth is the number of confirmations after which we
+	// assume a batch transaction can't be reorged out of the chain anymore
+	// and forget the state from before the batch.
+	batchReorgSafetyDepth = 6
+)
+
+// batchConfEvent is a confirmation or reorg of a batch transaction.
+type batchConfEvent struct {
+	// Confirmed is true if the batch transaction reached the requested
+	// number of confirmations and false if the block it was included in
+	// was reorged out of the chain.
+	Confirmed bool
+
+	// Height is the height of the block the transaction confirmed in. It
+	// is only set for confirmations.
+	Height uint32
+}
+
+// batchConfNotifier notifies about both confirmations and reorgs of a batch
+// transaction. The lndclient chain notifier only forwards confirmations, so
+// we need our own notifier to find out about reorgs.
+type batchConfNotifier interface {
+	// RegisterBatchConf registers for confirmations and reorgs of the
+	// given transaction. The returned channel receives an event every time
+	// the transaction reaches the given number of confirmations or is
+	// reorged out of the chain again.
+	RegisterBatchConf(ctx context.Context, txid *chainhash.Hash,
+		pkScript []byte, numConfs, heightHint uint32) (
+		<-chan *batchConfEvent, <-chan error, error)
+}
+
+// chainBatchConfNotifier is a batchConfNotifier that is backed by lnd's chain
+// notifier RPC.
+type chainBatchConfNotifier struct {
+	client chainrpc.ChainNotifierClient
+}
+
+// A compile-time check to make sure chainBatchConfNotifier implements the
+// batchConfNotifier interface.
+var _ batchConfNotifier = (*chainBatchConfNotifier)(nil)
+
+// RegisterBatchConf registers for confirmations and reorgs of the given
+// transaction.
+//
+// NOTE: This is part of the batchConfNotifier interface.
+func (n *chainBatchConfNotifier) RegisterBatchConf(ctx context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint uint32) (
+	<-chan *batchConfEvent, <-chan error, error) {
+
+	stream, err := n.client.RegisterConfirmationsNtfn(
+		ctx, &chainrpc.ConfRequest{
+			Txid:       txid[:],
+			Script:     pkScript,
+			NumConfs:   numConfs,
+			HeightHint: heightHint,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eventChan := make(chan *batchConfEvent)
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			var confEvent *batchConfEvent
+			switch e := event.Event.(type) {
+			case *chainrpc.ConfEvent_Conf:
+				confEvent = &batchConfEvent{
+					Confirmed: true,
+					Height:    e.Conf.BlockHeight,
+				}
+
+			case *chainrpc.ConfEvent_Reorg:
+				confEvent = &batchConfEvent{}
+
+			default:
+				continue
+			}
+
+			select {
+			case eventChan <- confEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return eventChan, errChan, nil
+}
+
+// batchReorgStore is the part of the client database the batch reorg watcher
+// needs to revert batches.
+type batchReorgStore interface {
+	// ReversibleBatches returns the IDs of all completed batches that can
+	// still be reverted, in the order they were completed.
+	ReversibleBatches() ([]order.BatchID, error)
+
+	// GetLocalBatchSnapshot returns the local batch snapshot of the batch
+	// with the given ID.
+	GetLocalBatchSnapshot(order.BatchID) (*clientdb.LocalBatchSnapshot,
+		error)
+
+	// RevertBatch restores the state from before the completed batch with
+	// the given ID and makes it pending again.
+	RevertBatch(order.BatchID) error
+
+	// DeleteReversibleBatch removes the pre-batch state of the completed
+	// batch with the given ID, making it irreversible.
+	DeleteReversibleBatch(order.BatchID) error
+}
+
+// batchReorgWatcherConfig contains all functionality the batch reorg watcher
+// needs to detect reorgs of batch transactions and revert them.
+type batchReorgWatcherConfig struct {
+	// Store is the database the reversible batches are kept in.
+	Store batchReorgStore
+
+	// Notifier notifies about confirmations and reorgs of the batch
+	// transactions.
+	Notifier batchConfNotifier
+
+	// ConfDepth is the number of confirmations after which a batch can't
+	// be reverted anymore.
+	ConfDepth uint32
+
+	// OnRevert is called with the snapshot of each batch after it was
+	// reverted, so the accounts that took part in it can be resumed from
+	// their previous state.
+	OnRevert func(ctx context.Context,
+		snapshot *clientdb.LocalBatchSnapshot) error
+}
+
+// batchReorgWatcher watches the transactions of all completed batches until
+// they're buried deep enough. If a batch transaction is reorged out of the
+// chain in the meantime, the batch is reverted and becomes pending again. The
+// account watchers then complete it once more when the transaction re-confirms
+// or another batch replaces it.
+type batchReorgWatcher struct {
+	cfg *batchReorgWatcherConfig
+
+	// revertMtx makes sure batches are only reverted one at a time.
+	revertMtx sync.Mutex
+
+	watchedMtx sync.Mutex
+	watched    map[order.BatchID]struct{}
+
+	ctx    context.Context
+	cancel func()
+	wg     sync.WaitGroup
+}
+
+// newBatchReorgWatcher creates a new batch reorg watcher with the given
+// config.
+func newBatchReorgWatcher(cfg *batchReorgWatcherConfig) *batchReorgWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &batchReorgWatcher{
+		cfg:     cfg,
+		watched: make(map[order.BatchID]struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Stop stops watching all batch transactions and waits for all watchers to
+// exit.
+func (w *batchReorgWatcher) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+// WatchBatches starts watching the transactions of all reversible batches
+// that aren't watched yet. It should be called on every new block to pick up
+// batches that were completed in the meantime.
+func (w *batchReorgWatcher) WatchBatches() error {
+	batchIDs, err := w.cfg.Store.ReversibleBatches()
+	if err != nil {
+		return fmt.Errorf("unable to fetch reversible batches: %v", err)
+	}
+
+	w.watchedMtx.Lock()
+	defer w.watchedMtx.Unlock()
+
+	for _, batchID := range batchIDs {
+		if _, ok := w.watched[batchID]; ok {
+			continue
+		}
+
+		if err := w.watchBatch(batchID); err != nil {
+			return fmt.Errorf("unable to watch batch %x: %v",
+				batchID[:], err)
+		}
+		w.watched[batchID] = struct{}{}
+	}
+
+	return nil
+}
+
+// watchBatch registers for confirmations and reorgs of the transaction of the
+// batch with the given ID.
+//
+// NOTE: The watchedMtx must be held when calling this method.
+func (w *batchReorgWatcher) watchBatch(batchID order.BatchID) error {
+	snapshot, err := w.cfg.Store.GetLocalBatchSnapshot(batchID)
+	if err != nil {
+		return err
+	}
+
+	// The accounts that took part in the batch carry the height hint of
+	// the batch, which is a good place to start looking for it.
+	var heightHint uint32
+	for _, acct := range snapshot.Accounts {
+		if heightHint == 0 || acct.HeightHint < heightHint {
+			heightHint = acct.HeightHint
+		}
+	}
+
+	batchTx := snapshot.BatchTX
+	if len(batchTx.TxOut) == 0 {
+		return fmt.Errorf("batch transaction has no outputs")
+	}
+	txid := batchTx.TxHash()
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	eventChan, errChan, err := w.cfg.Notifier.RegisterBatchConf(
+		ctx, &txid, batchTx.TxOut[0].PkScript, w.cfg.ConfDepth,
+		heightHint,
+	)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	log.Debugf("Watching transaction %v of batch %x for reorgs", txid,
+		batchID[:])
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		defer cancel()
+
+		w.waitForBatch(ctx, batchID, eventChan, errChan)
+
+		w.watchedMtx.Lock()
+		delete(w.watched, batchID)
+		w.watchedMtx.Unlock()
+	}()
+
+	return nil
+}
+
+// waitForBatch waits until the transaction of the batch with the given ID is
+// either buried deep enough or reorged out of the chain.
+func (w *batchReorgWatcher) waitForBatch(ctx context.Context,
+	batchID order.BatchID, eventChan <-chan *batchConfEvent,
+	errChan <-chan error) {
+
+	select {
+	case event := <-eventChan:
+		if event.Confirmed {
+			log.Debugf("Batch %x confirmed at height %d, it can "+
+				"no longer be reverted", batchID[:],
+				event.Height)
+
+			err := w.cfg.Store.DeleteReversibleBatch(batchID)
+			if err != nil {
+				log.Errorf("Unable to delete reversible "+
+					"batch %x: %v", batchID[:], err)
+			}
+
+			return
+		}
+
+		log.Warnf("Transaction of batch %x was reorged out of the "+
+			"chain, reverting batch", batchID[:])
+
+		if err := w.revertBatch(ctx, batchID); err != nil {
+			log.Errorf("Unable to revert batch %x: %v", batchID[:],
+				err)
+		}
+
+	// We'll register for the batch again on the next block if it's still
+	// reversible by then.
+	case err := <-errChan:
+		log.Errorf("Unable to watch batch %x: %v", batchID[:], err)
+
+	case <-ctx.Done():
+	}
+}
+
+// revertBatch reverts the batch with the given ID. All batches completed after
+// it depend on its transaction, so they are reverted first.
+func (w *batchReorgWatcher) revertBatch(ctx context.Context,
+	batchID order.BatchID) error {
+
+	w.revertMtx.Lock()
+	defer w.revertMtx.Unlock()
+
+	batchIDs, err := w.cfg.Store.ReversibleBatches()
+	if err != nil {
+		return err
+	}
+
+	// Another watcher might already have reverted the batch together with
+	// one that was completed before it.
+	start := -1
+	for i, id := range batchIDs {
+		if id == batchID {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+
+	for i := len(batchIDs) - 1; i >= start; i-- {
+		snapshot, err := w.cfg.Store.GetLocalBatchSnapshot(batchIDs[i])
+		if err != nil {
+			return err
+		}
+
+		if err := w.cfg.Store.RevertBatch(batchIDs[i]); err != nil {
+			return err
+		}
+
+		log.Infof("Reverted batch %x, waiting for its transaction to "+
+			"confirm again", batchIDs[i][:])
+
+		if err := w.cfg.OnRevert(ctx, snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
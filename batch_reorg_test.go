@@ -0,0 +1,257 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBatchConfNotifier is a batchConfNotifier that lets the test send
+// confirmations and reorgs of the registered transactions.
+type mockBatchConfNotifier struct {
+	mu      sync.Mutex
+	streams map[chainhash.Hash]chan *batchConfEvent
+
+	registered chan chainhash.Hash
+}
+
+// RegisterBatchConf registers for confirmations and reorgs of the given
+// transaction.
+//
+// NOTE: This is part of the batchConfNotifier interface.
+func (n *mockBatchConfNotifier) RegisterBatchConf(_ context.Context,
+	txid *chainhash.Hash, _ []byte, _, _ uint32) (<-chan *batchConfEvent,
+	<-chan error, error) {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	eventChan := make(chan *batchConfEvent, 1)
+	n.streams[*txid] = eventChan
+	n.registered <- *txid
+
+	return eventChan, make(chan error), nil
+}
+
+// send sends the given event for the transaction with the given ID.
+func (n *mockBatchConfNotifier) send(txid chainhash.Hash,
+	event *batchConfEvent) {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.streams[txid] <- event
+}
+
+// mockBatchReorgStore is an in-memory batchReorgStore.
+type mockBatchReorgStore struct {
+	mu         sync.Mutex
+	reversible []order.BatchID
+	snapshots  map[order.BatchID]*clientdb.LocalBatchSnapshot
+	reverted   []order.BatchID
+	deleted    chan order.BatchID
+}
+
+func (s *mockBatchReorgStore) ReversibleBatches() ([]order.BatchID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]order.BatchID(nil), s.reversible...), nil
+}
+
+func (s *mockBatchReorgStore) GetLocalBatchSnapshot(
+	id order.BatchID) (*clientdb.LocalBatchSnapshot, error) {
+
+	return s.snapshots[id], nil
+}
+
+func (s *mockBatchReorgStore) remove(id order.BatchID) {
+	for i, batchID := range s.reversible {
+		if batchID == id {
+			s.reversible = append(
+				s.reversible[:i], s.reversible[i+1:]...,
+			)
+			return
+		}
+	}
+}
+
+func (s *mockBatchReorgStore) RevertBatch(id order.BatchID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.reversible[len(s.reversible)-1] != id {
+		return clientdb.ErrRevertOutOfOrder
+	}
+
+	s.remove(id)
+	s.reverted = append(s.reverted, id)
+
+	return nil
+}
+
+func (s *mockBatchReorgStore) DeleteReversibleBatch(id order.BatchID) error {
+	s.mu.Lock()
+	s.remove(id)
+	s.mu.Unlock()
+
+	s.deleted <- id
+
+	return nil
+}
+
+// TestBatchReorgWatcher makes sure completed batches are forgotten once they
+// are buried deep enough and reverted, together with all batches completed
+// after them, if their transaction is reorged out of the chain.
+func TestBatchReorgWatcher(t *testing.T) {
+	t.Parallel()
+
+	newSnapshot := func(id byte) *clientdb.LocalBatchSnapshot {
+		return &clientdb.LocalBatchSnapshot{
+			BatchID: order.BatchID{id},
+			BatchTX: &wire.MsgTx{
+				Version: 2,
+				TxIn: []*wire.TxIn{{
+					PreviousOutPoint: wire.OutPoint{
+						Index: uint32(id),
+					},
+				}},
+				TxOut: []*wire.TxOut{{
+					Value:    1000,
+					PkScript: []byte{id},
+				}},
+			},
+			Accounts: map[[33]byte]*account.Account{
+				{id}: {HeightHint: uint32(id) * 100},
+			},
+		}
+	}
+	first, second, third := newSnapshot(1), newSnapshot(2), newSnapshot(3)
+
+	store := &mockBatchReorgStore{
+		reversible: []order.BatchID{first.BatchID, second.BatchID},
+		snapshots: map[order.BatchID]*clientdb.LocalBatchSnapshot{
+			first.BatchID:  first,
+			second.BatchID: second,
+			third.BatchID:  third,
+		},
+		deleted: make(chan order.BatchID, 1),
+	}
+	notifier := &mockBatchConfNotifier{
+		streams:    make(map[chainhash.Hash]chan *batchConfEvent),
+		registered: make(chan chainhash.Hash, 3),
+	}
+	resumed := make(chan order.BatchID, 3)
+	watcher := newBatchReorgWatcher(&batchReorgWatcherConfig{
+		Store:     store,
+		Notifier:  notifier,
+		ConfDepth: batchReorgSafetyDepth,
+		OnRevert: func(_ context.Context,
+			snapshot *clientdb.LocalBatchSnapshot) error {
+
+			resumed <- snapshot.BatchID
+			return nil
+		},
+	})
+	defer watcher.Stop()
+
+	// expectRegistered makes sure the transactions of the given batches
+	// were registered for.
+	expectRegistered := func(snapshots ...*clientdb.LocalBatchSnapshot) {
+		t.Helper()
+
+		expected := make(map[chainhash.Hash]struct{})
+		for _, snapshot := range snapshots {
+			expected[snapshot.BatchTX.TxHash()] = struct{}{}
+		}
+
+		for range snapshots {
+			select {
+			case txid := <-notifier.registered:
+				require.Contains(t, expected, txid)
+				delete(expected, txid)
+
+			case <-time.After(ctxTimeout):
+				t.Fatalf("transaction not registered")
+			}
+		}
+	}
+
+	// Both reversible batches are watched, but only once.
+	require.NoError(t, watcher.WatchBatches())
+	expectRegistered(first, second)
+	require.NoError(t, watcher.WatchBatches())
+	require.Empty(t, notifier.registered)
+
+	// Once the first batch is buried deep enough, it's no longer
+	// reversible.
+	notifier.send(first.BatchTX.TxHash(), &batchConfEvent{
+		Confirmed: true,
+		Height:    106,
+	})
+	select {
+	case id := <-store.deleted:
+		require.Equal(t, first.BatchID, id)
+
+	case <-time.After(ctxTimeout):
+		t.Fatalf("batch not deleted")
+	}
+
+	// A third batch is completed on top of the second one and picked up
+	// with the next block.
+	store.mu.Lock()
+	store.reversible = append(store.reversible, third.BatchID)
+	store.mu.Unlock()
+	require.NoError(t, watcher.WatchBatches())
+	expectRegistered(third)
+
+	// If the second batch is reorged out, the third one depends on it and
+	// needs to be reverted first.
+	notifier.send(second.BatchTX.TxHash(), &batchConfEvent{})
+	for _, expected := range []order.BatchID{third.BatchID, second.BatchID} {
+		select {
+		case id := <-resumed:
+			require.Equal(t, expected, id)
+
+		case <-time.After(ctxTimeout):
+			t.Fatalf("batch not reverted")
+		}
+	}
+
+	store.mu.Lock()
+	require.Equal(
+		t, []order.BatchID{third.BatchID, second.BatchID},
+		store.reverted,
+	)
+	require.Empty(t, store.reversible)
+	store.mu.Unlock()
+
+	// The reorg of the third batch that follows is ignored as the batch
+	// was already reverted.
+	notifier.send(third.BatchTX.TxHash(), &batchConfEvent{})
+	select {
+	case id := <-resumed:
+		t.Fatalf("batch %x reverted twice", id[:])
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the second batch is completed again after its transaction
+	// re-confirmed, it is watched again.
+	store.mu.Lock()
+	store.reversible = append(store.reversible, second.BatchID)
+	store.mu.Unlock()
+	require.Eventually(t, func() bool {
+		require.NoError(t, watcher.WatchBatches())
+		return len(notifier.registered) > 0
+	}, ctxTimeout, 10*time.Millisecond)
+	expectRegistered(second)
+}
@@ -0,0 +1,230 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// batchDrainLogInterval is the interval in which we log which batch
+	// phase we're still waiting on during shutdown.
+	batchDrainLogInterval = 10 * time.Second
+)
+
+var (
+	// ErrShuttingDown is returned for new RPCs and batches once the daemon
+	// started shutting down.
+	ErrShuttingDown = errors.New("pool daemon is shutting down")
+)
+
+// batchPhase is the phase of a batch we're part of, as seen from our side.
+type batchPhase uint8
+
+const (
+	// batchPhaseNone means we're currently not part of any batch.
+	batchPhaseNone batchPhase = iota
+
+	// batchPhaseAccepted means we accepted a batch and are waiting for the
+	// auctioneer to ask us to sign it.
+	batchPhaseAccepted
+
+	// batchPhaseSigned means we signed a batch and are waiting for the
+	// auctioneer to finalize it.
+	batchPhaseSigned
+)
+
+// String returns a human readable description of the batch phase.
+func (p batchPhase) String() string {
+	switch p {
+	case batchPhaseNone:
+		return "no batch in flight"
+
+	case batchPhaseAccepted:
+		return "waiting for sign request"
+
+	case batchPhaseSigned:
+		return "waiting for finalize"
+
+	default:
+		return "unknown"
+	}
+}
+
+// inflightBatch keeps track of the batch we're currently part of so a shutdown
+// can wait for it to finish. It must only be accessed by the server handler
+// goroutine.
+type inflightBatch struct {
+	phase batchPhase
+	batch *order.Batch
+}
+
+// accepted registers that we accepted the given batch.
+func (b *inflightBatch) accepted(batch *order.Batch) {
+	b.phase = batchPhaseAccepted
+	b.batch = batch
+}
+
+// signed registers that we signed the batch we accepted before.
+func (b *inflightBatch) signed() {
+	b.phase = batchPhaseSigned
+}
+
+// done registers that the batch was either finalized or rejected.
+func (b *inflightBatch) done() {
+	b.phase = batchPhaseNone
+	b.batch = nil
+}
+
+// batchDrain is the state of a shutdown that waits for an in-flight batch.
+type batchDrain struct {
+	// done is closed once the in-flight batch finished or the grace
+	// period is over.
+	done chan struct{}
+
+	// deadline fires once the grace period is over.
+	deadline *time.Timer
+
+	// logTicker makes us log the phase we're waiting on periodically.
+	logTicker *time.Ticker
+}
+
+// isShuttingDown returns true if the daemon started shutting down and doesn't
+// accept any new RPCs or batches anymore.
+func (s *rpcServer) isShuttingDown() bool {
+	return atomic.LoadUint32(&s.shuttingDown) == 1
+}
+
+// drainBatch stops us from accepting new batches and waits for the batch we're
+// currently part of, if any, to be finalized or rejected. If that doesn't
+// happen within the configured grace period, a batch we only accepted is
+// rejected. A batch we already signed was persisted as pending when signing
+// and is checked again on the next startup.
+func (s *rpcServer) drainBatch() {
+	if !atomic.CompareAndSwapUint32(&s.shuttingDown, 0, 1) {
+		return
+	}
+
+	// Without the server handler there's no batch in flight and nobody to
+	// process the remaining batch messages.
+	if atomic.LoadUint32(&s.handlerStarted) == 0 {
+		return
+	}
+
+	done := make(chan struct{})
+	select {
+	case s.drainRequests <- done:
+	case <-s.quit:
+		return
+	}
+
+	<-done
+}
+
+// startDrain starts waiting for the in-flight batch when shutting down. It
+// returns nil if there's no batch to wait for.
+//
+// NOTE: This must only be called by the server handler goroutine.
+func (s *rpcServer) startDrain(done chan struct{}) *batchDrain {
+	if s.inflight.phase == batchPhaseNone {
+		rpcLog.Infof("Shutting down, no batch in flight")
+		close(done)
+		return nil
+	}
+
+	gracePeriod := s.server.cfg.ShutdownGracePeriod
+	rpcLog.Infof("Shutting down, waiting up to %v for batch %x to "+
+		"finish (%v)", gracePeriod, s.inflight.batch.ID[:],
+		s.inflight.phase)
+
+	return &batchDrain{
+		done:      done,
+		deadline:  time.NewTimer(gracePeriod),
+		logTicker: time.NewTicker(batchDrainLogInterval),
+	}
+}
+
+// finishDrain continues the shutdown once the in-flight batch finished or the
+// grace period is over.
+//
+// NOTE: This must only be called by the server handler goroutine.
+func (s *rpcServer) finishDrain(drain *batchDrain) {
+	drain.deadline.Stop()
+	drain.logTicker.Stop()
+
+	switch s.inflight.phase {
+	case batchPhaseNone:
+		rpcLog.Infof("In-flight batch finished, continuing shutdown")
+
+	// We told the auctioneer we're in but didn't sign anything yet. We
+	// reject the batch so it can be retried without us right away.
+	case batchPhaseAccepted:
+		rpcLog.Warnf("Grace period over while %v of batch %x, "+
+			"rejecting it", s.inflight.phase,
+			s.inflight.batch.ID[:])
+
+		err := s.sendRejectBatch(s.inflight.batch, ErrShuttingDown)
+		if err != nil {
+			rpcLog.Errorf("Unable to reject batch: %v", err)
+		}
+
+	// Our signature is out there, so the batch might still confirm. It
+	// was stored as our pending batch when we signed it, so we'll find
+	// out what happened to it on the next startup.
+	case batchPhaseSigned:
+		rpcLog.Warnf("Grace period over while %v of batch %x, "+
+			"batch is stored as pending and will be checked "+
+			"again on next startup", s.inflight.phase,
+			s.inflight.batch.ID[:])
+	}
+
+	close(drain.done)
+}
+
+// shutdownUnaryServerInterceptor rejects all new unary RPCs once the daemon
+// started shutting down.
+func shutdownUnaryServerInterceptor(
+	isShuttingDown func() bool) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if err := checkShuttingDown(isShuttingDown()); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// shutdownStreamServerInterceptor rejects all new streaming RPCs once the
+// daemon started shutting down.
+func shutdownStreamServerInterceptor(
+	isShuttingDown func() bool) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if err := checkShuttingDown(isShuttingDown()); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// checkShuttingDown returns an error if the daemon is shutting down.
+func checkShuttingDown(shuttingDown bool) error {
+	if !shuttingDown {
+		return nil
+	}
+
+	return status.Error(codes.Unavailable, ErrShuttingDown.Error())
+}
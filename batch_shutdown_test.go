@@ -0,0 +1,121 @@
+package pool
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newDrainTestServer creates an rpcServer with a running server handler that
+// uses the given shutdown grace period.
+func newDrainTestServer(t *testing.T,
+	gracePeriod time.Duration) *rpcServer {
+
+	s := &rpcServer{
+		server: &Server{cfg: &Config{
+			ShutdownGracePeriod: gracePeriod,
+		}},
+		auctioneer: &auctioneer.Client{
+			FromServerChan: make(
+				chan *auctioneerrpc.ServerAuctionMessage,
+			),
+			StreamErrChan: make(chan error),
+		},
+		quit:          make(chan struct{}),
+		drainRequests: make(chan chan struct{}),
+	}
+
+	s.wg.Add(1)
+	atomic.StoreUint32(&s.handlerStarted, 1)
+	go s.serverHandler(make(chan int32), make(chan error))
+
+	t.Cleanup(func() {
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+// TestDrainBatchIdle makes sure we don't wait on shutdown if we aren't part of
+// any batch.
+func TestDrainBatchIdle(t *testing.T) {
+	t.Parallel()
+
+	s := newDrainTestServer(t, time.Hour)
+	require.False(t, s.isShuttingDown())
+
+	done := make(chan struct{})
+	go func() {
+		s.drainBatch()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("drain didn't return")
+	}
+	require.True(t, s.isShuttingDown())
+
+	// Draining a second time returns right away.
+	s.drainBatch()
+}
+
+// TestDrainBatchGracePeriod makes sure we stop waiting for a signed batch once
+// the grace period is over.
+func TestDrainBatchGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	const gracePeriod = 100 * time.Millisecond
+	s := newDrainTestServer(t, gracePeriod)
+
+	// The in-flight batch must only be touched by the handler goroutine,
+	// so we set it before the handler can see any message.
+	s.inflight.accepted(&order.Batch{ID: order.BatchID{1}})
+	s.inflight.signed()
+
+	start := time.Now()
+	s.drainBatch()
+	require.GreaterOrEqual(t, time.Since(start), gracePeriod)
+	require.Equal(t, batchPhaseSigned, s.inflight.phase)
+}
+
+// TestShutdownInterceptor makes sure all RPCs are rejected once we're shutting
+// down.
+func TestShutdownInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var shuttingDown bool
+	interceptor := shutdownUnaryServerInterceptor(func() bool {
+		return shuttingDown
+	})
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func() error {
+		_, err := interceptor(
+			context.Background(), nil, &grpc.UnaryServerInfo{
+				FullMethod: "/poolrpc.Trader/GetInfo",
+			}, handler,
+		)
+		return err
+	}
+
+	require.NoError(t, call())
+
+	shuttingDown = true
+	err := call()
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), ErrShuttingDown.Error())
+}
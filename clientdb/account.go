@@ -6,8 +6,9 @@ import (
 	"io"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/account"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -19,6 +20,23 @@ var (
 	// ErrAccountNotFound is an error returned when we attempt to retrieve
 	// information about an account but it is not found.
 	ErrAccountNotFound = errors.New("account not found")
+
+	// accountAutoRenewKey is the key within an account's additional data
+	// bucket under which the flag for automatic renewals is stored.
+	accountAutoRenewKey = []byte("auto-renew")
+
+	// accountRenewalWindowKey is the key within an account's additional
+	// data bucket under which the automatic renewal window is stored.
+	accountRenewalWindowKey = []byte("renewal-window")
+
+	// accountReserveKey is the key within an account's additional data
+	// bucket under which the account's reserve is stored.
+	accountReserveKey = []byte("reserve")
+
+	// accountVersionKey is the key within an account's additional data
+	// bucket under which the account's version is stored. Accounts without
+	// a stored version use the initial P2WSH script.
+	accountVersionKey = []byte("version")
 )
 
 // getAccountKey returns the key for an account which is not partial.
@@ -28,14 +46,26 @@ func getAccountKey(account *account.Account) []byte {
 
 // AddAccount adds a record for the account to the database.
 func (db *DB) AddAccount(account *account.Account) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	err := db.Update(func(tx kvdb.RwTx) error {
 		accounts, err := getBucket(tx, accountBucketKey)
 		if err != nil {
 			return err
 		}
 
-		return storeAccount(accounts, account)
+		if err := storeAccount(accounts, account); err != nil {
+			return err
+		}
+
+		evt := NewAccountUpdateEvent(AccountActionOpen, nil, account)
+		return storeAccountEventTX(accounts, evt)
 	})
+	if err != nil {
+		return err
+	}
+
+	db.notifyAccountUpdate()
+
+	return nil
 }
 
 // UpdateAccount updates an account in the database according to the given
@@ -43,14 +73,8 @@ func (db *DB) AddAccount(account *account.Account) error {
 func (db *DB) UpdateAccount(acct *account.Account,
 	modifiers ...account.Modifier) error {
 
-	err := db.Update(func(tx *bbolt.Tx) error {
-		accounts, err := getBucket(tx, accountBucketKey)
-		if err != nil {
-			return err
-		}
-		accountKey := getAccountKey(acct)
-		_, err = updateAccount(accounts, accounts, accountKey, modifiers)
-		return err
+	err := db.Update(func(tx kvdb.RwTx) error {
+		return updateAccountTX(tx, acct, modifiers)
 	})
 	if err != nil {
 		return err
@@ -60,23 +84,71 @@ func (db *DB) UpdateAccount(acct *account.Account,
 		modifier(acct)
 	}
 
+	db.notifyAccountUpdate()
+
 	return nil
 }
 
-// updateAccount reads an account from the src bucket, applies the given
-// modifiers to it, and store it back into dst bucket.
-func updateAccount(src, dst *bbolt.Bucket, accountKey []byte,
-	modifiers []account.Modifier) (*account.Account, error) {
+// updateAccountTX updates an account in the database according to the given
+// modifiers within a database transaction and records the update in the
+// account's event log.
+func updateAccountTX(tx kvdb.RwTx, acct *account.Account,
+	modifiers []account.Modifier) error {
+
+	accounts, err := getBucket(tx, accountBucketKey)
+	if err != nil {
+		return err
+	}
+	accountKey := getAccountKey(acct)
+	prev, err := readAccount(accounts, accountKey)
+	if err != nil {
+		return err
+	}
+
+	a, err := updateAccount(accounts, accounts, accountKey, modifiers)
+	if err != nil {
+		return err
+	}
+
+	// Only record an event if anything relevant changed.
+	if !accountChanged(prev, a) {
+		return nil
+	}
+
+	action := accountUpdateAction(prev, a)
+	evt := NewAccountUpdateEvent(action, prev, a)
+	return storeAccountEventTX(accounts, evt)
+}
+
+// modifyAccount reads an account from the src bucket and applies the given
+// modifiers to the in-memory copy of it. Nothing is written to the database.
+// The state of the account before applying the modifiers is returned as well.
+func modifyAccount(src kvdb.RBucket, accountKey []byte,
+	modifiers []account.Modifier) (*account.Account, account.State, error) {
 
 	dbAccount, err := readAccount(src, accountKey)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
+	prevState := dbAccount.State
 	for _, modifier := range modifiers {
 		modifier(dbAccount)
 	}
 
+	return dbAccount, prevState, nil
+}
+
+// updateAccount reads an account from the src bucket, applies the given
+// modifiers to it, and store it back into dst bucket.
+func updateAccount(src, dst kvdb.RwBucket, accountKey []byte,
+	modifiers []account.Modifier) (*account.Account, error) {
+
+	dbAccount, _, err := modifyAccount(src, accountKey, modifiers)
+	if err != nil {
+		return nil, err
+	}
+
 	return dbAccount, storeAccount(dst, dbAccount)
 }
 
@@ -84,8 +156,8 @@ func updateAccount(src, dst *bbolt.Bucket, accountKey []byte,
 // ErrAccountNotFound if it's not found.
 func (db *DB) Account(traderKey *btcec.PublicKey) (*account.Account, error) {
 	var acct *account.Account
-	err := db.View(func(tx *bbolt.Tx) error {
-		accounts, err := getBucket(tx, accountBucketKey)
+	err := db.View(func(tx kvdb.RTx) error {
+		accounts, err := getReadBucket(tx, accountBucketKey)
 		if err != nil {
 			return err
 		}
@@ -105,26 +177,40 @@ func (db *DB) Account(traderKey *btcec.PublicKey) (*account.Account, error) {
 // Accounts retrieves all known accounts from the database.
 func (db *DB) Accounts() ([]*account.Account, error) {
 	var res []*account.Account
-	err := db.View(func(tx *bbolt.Tx) error {
-		accounts, err := getBucket(tx, accountBucketKey)
+	err := db.View(func(tx kvdb.RTx) error {
+		var err error
+		res, err = readAllAccountsTX(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// readAllAccountsTX reads all accounts from the database.
+func readAllAccountsTX(tx kvdb.RTx) ([]*account.Account, error) {
+	accounts, err := getReadBucket(tx, accountBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*account.Account
+	err = accounts.ForEach(func(k, v []byte) error {
+		// We'll also get buckets here, skip those (identified by nil
+		// value).
+		if v == nil {
+			return nil
+		}
+
+		acct, err := readAccount(accounts, k)
 		if err != nil {
 			return err
 		}
+		res = append(res, acct)
 
-		return accounts.ForEach(func(k, v []byte) error {
-			// We'll also get buckets here, skip those (identified
-			// by nil value).
-			if v == nil {
-				return nil
-			}
-
-			acct, err := readAccount(accounts, k)
-			if err != nil {
-				return err
-			}
-			res = append(res, acct)
-			return nil
-		})
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -133,7 +219,7 @@ func (db *DB) Accounts() ([]*account.Account, error) {
 	return res, nil
 }
 
-func storeAccount(targetBucket *bbolt.Bucket, a *account.Account) error {
+func storeAccount(targetBucket kvdb.RwBucket, a *account.Account) error {
 	accountKey := getAccountKey(a)
 
 	var accountBuf bytes.Buffer
@@ -141,18 +227,95 @@ func storeAccount(targetBucket *bbolt.Bucket, a *account.Account) error {
 		return err
 	}
 
-	return targetBucket.Put(accountKey, accountBuf.Bytes())
+	err := putRecord(targetBucket, accountKey, accountBuf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// The automatic renewal settings, the reserve and the version were
+	// added later, so they are stored in the account's additional data
+	// bucket.
+	additionalData, err := getAdditionalDataBucket(
+		targetBucket, accountKey, true,
+	)
+	if err != nil {
+		return err
+	}
+	err = writeAdditionalValue(
+		additionalData, accountAutoRenewKey, a.AutoRenew,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = writeAdditionalValue(
+		additionalData, accountRenewalWindowKey, a.RenewalWindow,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = writeAdditionalValue(
+		additionalData, accountReserveKey, a.Reserve,
+	)
+	if err != nil {
+		return err
+	}
+
+	return writeAdditionalValue(
+		additionalData, accountVersionKey, a.Version,
+	)
 }
 
-func readAccount(sourceBucket *bbolt.Bucket,
+func readAccount(sourceBucket kvdb.RBucket,
 	accountKey []byte) (*account.Account, error) {
 
 	accountBytes := sourceBucket.Get(accountKey)
 	if accountBytes == nil {
 		return nil, ErrAccountNotFound
 	}
+	if !recordValid(sourceBucket, accountKey, accountBytes) {
+		return nil, &ErrCorruptedRecord{
+			Bucket: string(accountBucketKey),
+			Key:    accountKey,
+		}
+	}
+
+	a, err := deserializeAccount(bytes.NewReader(accountBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	additionalData := getAdditionalDataReadBucket(sourceBucket, accountKey)
+	err = readAdditionalValue(
+		additionalData, accountAutoRenewKey, &a.AutoRenew, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = readAdditionalValue(
+		additionalData, accountRenewalWindowKey, &a.RenewalWindow,
+		uint32(0),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = readAdditionalValue(
+		additionalData, accountReserveKey, &a.Reserve,
+		btcutil.Amount(0),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = readAdditionalValue(
+		additionalData, accountVersionKey, &a.Version,
+		account.VersionInitialNoVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	return deserializeAccount(bytes.NewReader(accountBytes))
+	return a, nil
 }
 
 func serializeAccount(w *bytes.Buffer, a *account.Account) error {
@@ -0,0 +1,142 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/lightninglabs/pool/account"
+)
+
+const (
+	// AccountBackupFilename is the default file name of the account backup
+	// that is written next to the database.
+	AccountBackupFilename = "accounts.backup"
+
+	// accountBackupVersionInitial is the initial version of the account
+	// backup format that doesn't contain the account versions.
+	accountBackupVersionInitial uint32 = 0
+
+	// accountBackupVersion is the current version of the account backup
+	// format. Each account is followed by its version.
+	accountBackupVersion uint32 = 1
+)
+
+// SubscribeAccountUpdates registers a callback that is invoked with the
+// current list of all accounts every time an account is added or updated. The
+// callback is invoked synchronously after the modifying database transaction
+// was committed, one invocation at a time.
+func (db *DB) SubscribeAccountUpdates(cb account.UpdateCallback) {
+	db.accountSubscribersMtx.Lock()
+	defer db.accountSubscribersMtx.Unlock()
+
+	db.accountSubscribers = append(db.accountSubscribers, cb)
+}
+
+// notifyAccountUpdate reads all accounts from the database and passes them to
+// all registered account update callbacks. Because the accounts are read while
+// holding the subscriber mutex, the last invocation always sees the latest
+// state, even if multiple updates happen concurrently.
+func (db *DB) notifyAccountUpdate() {
+	db.accountSubscribersMtx.Lock()
+	defer db.accountSubscribersMtx.Unlock()
+
+	if len(db.accountSubscribers) == 0 {
+		return
+	}
+
+	accounts, err := db.Accounts()
+	if err != nil {
+		log.Errorf("Unable to read accounts for update notification: "+
+			"%v", err)
+		return
+	}
+
+	for _, cb := range db.accountSubscribers {
+		cb(accounts)
+	}
+}
+
+// SerializeAccountBackup writes the given accounts in the versioned account
+// backup format to the given writer.
+func SerializeAccountBackup(w io.Writer, accounts []*account.Account) error {
+	var b bytes.Buffer
+	err := WriteElements(
+		&b, accountBackupVersion, uint32(len(accounts)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, acct := range accounts {
+		if err := serializeAccount(&b, acct); err != nil {
+			return err
+		}
+		if err := WriteElement(&b, acct.Version); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(b.Bytes())
+	return err
+}
+
+// DeserializeAccountBackup reads a list of accounts in the account backup
+// format from the given reader.
+func DeserializeAccountBackup(r io.Reader) ([]*account.Account, error) {
+	var version, numAccounts uint32
+	if err := ReadElements(r, &version, &numAccounts); err != nil {
+		return nil, err
+	}
+
+	if version != accountBackupVersionInitial &&
+		version != accountBackupVersion {
+
+		return nil, fmt.Errorf("unknown account backup version %d",
+			version)
+	}
+
+	accounts := make([]*account.Account, 0, numAccounts)
+	for i := uint32(0); i < numAccounts; i++ {
+		acct, err := deserializeAccount(r)
+		if err != nil {
+			return nil, err
+		}
+
+		// Backups of the initial version only contain accounts that
+		// were created before account versions were introduced.
+		if version != accountBackupVersionInitial {
+			if err := ReadElement(r, &acct.Version); err != nil {
+				return nil, err
+			}
+		}
+
+		accounts = append(accounts, acct)
+	}
+
+	return accounts, nil
+}
+
+// WriteAccountBackup atomically replaces the account backup file at the given
+// path with a backup of the given accounts.
+func WriteAccountBackup(path string, accounts []*account.Account) error {
+	var b bytes.Buffer
+	if err := SerializeAccountBackup(&b, accounts); err != nil {
+		return err
+	}
+
+	// We write to a temporary file first and then rename it, so we never
+	// leave a partially written backup behind.
+	tempPath := path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, b.Bytes(), 0600); err != nil {
+		return fmt.Errorf("error writing account backup: %v", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("error replacing account backup: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package clientdb
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountBackup makes sure that every account modification is passed to
+// the account update subscribers and that the accounts survive a round trip
+// through the account backup file.
+func TestAccountBackup(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	backupPath := filepath.Join(t.TempDir(), AccountBackupFilename)
+	var numUpdates int
+	db.SubscribeAccountUpdates(func(accounts []*account.Account) {
+		numUpdates++
+		require.NoError(t, WriteAccountBackup(backupPath, accounts))
+	})
+
+	readBackup := func() []*account.Account {
+		backup, err := os.ReadFile(backupPath)
+		require.NoError(t, err)
+
+		accounts, err := DeserializeAccountBackup(
+			bytes.NewReader(backup),
+		)
+		require.NoError(t, err)
+
+		return accounts
+	}
+
+	a := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(a))
+	require.Equal(t, 1, numUpdates)
+	require.Equal(t, []*account.Account{a}, readBackup())
+
+	// Opening the account changes its outpoint, which must be reflected in
+	// the backup.
+	accountTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 1},
+		}},
+		TxOut: []*wire.TxOut{{Value: int64(a.Value)}},
+	}
+	err := db.UpdateAccount(
+		a, account.StateModifier(account.StateOpen),
+		account.OutPointModifier(testOutPoint),
+		account.LatestTxModifier(accountTx),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, numUpdates)
+
+	backup := readBackup()
+	require.Len(t, backup, 1)
+	require.Equal(t, testOutPoint, backup[0].OutPoint)
+	require.Equal(t, a.TraderKey.KeyLocator, backup[0].TraderKey.KeyLocator)
+	require.Equal(t, a.Secret, backup[0].Secret)
+
+	// A backup of a different version must be refused.
+	var b bytes.Buffer
+	require.NoError(t, WriteElements(&b, accountBackupVersion+1, uint32(0)))
+	_, err = DeserializeAccountBackup(&b)
+	require.Error(t, err)
+}
@@ -0,0 +1,388 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// AccountAction is the action that triggered an update of an account.
+type AccountAction uint8
+
+const (
+	// AccountActionUnknown is the action of an update whose cause cannot
+	// be determined.
+	AccountActionUnknown AccountAction = 0
+
+	// AccountActionOpen is the action of an account being created and
+	// funded.
+	AccountActionOpen AccountAction = 1
+
+	// AccountActionDeposit is the action of funds being deposited into an
+	// account.
+	AccountActionDeposit AccountAction = 2
+
+	// AccountActionWithdrawal is the action of funds being withdrawn from
+	// an account.
+	AccountActionWithdrawal AccountAction = 3
+
+	// AccountActionRenewal is the action of an account's expiry being
+	// changed.
+	AccountActionRenewal AccountAction = 4
+
+	// AccountActionClose is the action of an account being closed.
+	AccountActionClose AccountAction = 5
+
+	// AccountActionBatch is the action of an account being modified by a
+	// batch it participated in.
+	AccountActionBatch AccountAction = 6
+
+	// AccountActionStateChange is the action of an account changing its
+	// state because of an on-chain event, for example a confirmation, a
+	// spend or the account expiring.
+	AccountActionStateChange AccountAction = 7
+
+	// AccountActionFeeBump is the action of the fee of an account's latest
+	// transaction being bumped through CPFP. The account itself isn't
+	// changed by this action.
+	AccountActionFeeBump AccountAction = 8
+)
+
+// String returns a human readable representation of the action.
+func (a AccountAction) String() string {
+	switch a {
+	case AccountActionOpen:
+		return "Open"
+
+	case AccountActionDeposit:
+		return "Deposit"
+
+	case AccountActionWithdrawal:
+		return "Withdrawal"
+
+	case AccountActionRenewal:
+		return "Renewal"
+
+	case AccountActionClose:
+		return "Close"
+
+	case AccountActionBatch:
+		return "Batch"
+
+	case AccountActionStateChange:
+		return "StateChange"
+
+	case AccountActionFeeBump:
+		return "FeeBump"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", a)
+	}
+}
+
+// AccountUpdateEvent is an event implementation that tracks the changes of an
+// account's value, expiry or state.
+type AccountUpdateEvent struct {
+	// timestamp is the unique timestamp the event was created/recorded at.
+	timestamp time.Time
+
+	// traderKey is the key of the account this event refers to.
+	traderKey [33]byte
+
+	// Action is the action that triggered the update.
+	Action AccountAction
+
+	// PrevState is the state the account had previous to the update.
+	PrevState account.State
+
+	// NewState is the state the account had after the update.
+	NewState account.State
+
+	// PrevValue is the value the account had previous to the update.
+	PrevValue btcutil.Amount
+
+	// NewValue is the value the account had after the update.
+	NewValue btcutil.Amount
+
+	// PrevExpiry is the expiry the account had previous to the update.
+	PrevExpiry uint32
+
+	// NewExpiry is the expiry the account had after the update.
+	NewExpiry uint32
+
+	// OutPoint is the on-chain outpoint of the account after the update.
+	OutPoint wire.OutPoint
+
+	// FeeRate is the fee rate that was requested for a fee bump. This is
+	// only set for events with the AccountActionFeeBump action.
+	FeeRate chainfee.SatPerKWeight
+}
+
+// NewAccountUpdateEvent creates a new AccountUpdateEvent from an account and
+// its state previous to the update with the current system time as the
+// timestamp. The previous account is allowed to be nil for newly created
+// accounts.
+func NewAccountUpdateEvent(action AccountAction, prev,
+	a *account.Account) *AccountUpdateEvent {
+
+	evt := &AccountUpdateEvent{
+		timestamp: time.Now(),
+		Action:    action,
+		NewState:  a.State,
+		NewValue:  a.Value,
+		NewExpiry: a.Expiry,
+		OutPoint:  a.OutPoint,
+	}
+	copy(evt.traderKey[:], getAccountKey(a))
+
+	if prev != nil {
+		evt.PrevState = prev.State
+		evt.PrevValue = prev.Value
+		evt.PrevExpiry = prev.Expiry
+	}
+
+	return evt
+}
+
+// Type returns the type of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdateEvent) Type() event.Type {
+	return event.TypeAccountUpdate
+}
+
+// Timestamp is the time the event happened. This will be made unique once it is
+// stored. To avoid collisions, the timestamp is adjusted on the nanosecond
+// scale to reach uniqueness.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdateEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// SetTimestamp updates the timestamp of the event. This is needed to adjust
+// timestamps in case they collide to ensure the global uniqueness of all event
+// timestamps.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdateEvent) SetTimestamp(ts time.Time) {
+	e.timestamp = ts
+}
+
+// String returns a human readable representation of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdateEvent) String() string {
+	return fmt.Sprintf("AccountUpdate(%v, %v)", e.Action, e.NewState)
+}
+
+// Serialize writes the event data to a binary storage format. This does not
+// serialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdateEvent) Serialize(w *bytes.Buffer) error {
+	err := WriteElements(
+		w, e.traderKey, uint8(e.Action), e.PrevState, e.NewState,
+		e.PrevValue, e.NewValue, e.PrevExpiry, e.NewExpiry, e.OutPoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The fee rate was added after the initial event format, so we only
+	// write it for the action that uses it to stay compatible with events
+	// that were stored before.
+	if e.Action == AccountActionFeeBump {
+		return WriteElement(w, e.FeeRate)
+	}
+
+	return nil
+}
+
+// Deserialize reads the event data from a binary storage format. This does not
+// deserialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *AccountUpdateEvent) Deserialize(r io.Reader) error {
+	var action uint8
+	err := ReadElements(
+		r, &e.traderKey, &action, &e.PrevState, &e.NewState,
+		&e.PrevValue, &e.NewValue, &e.PrevExpiry, &e.NewExpiry,
+		&e.OutPoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	e.Action = AccountAction(action)
+	if e.Action == AccountActionFeeBump {
+		return ReadElement(r, &e.FeeRate)
+	}
+
+	return nil
+}
+
+// TraderKey returns the raw trader key of the account this event refers to.
+func (e *AccountUpdateEvent) TraderKey() [33]byte {
+	return e.traderKey
+}
+
+// A compile time assertion to make sure AccountUpdateEvent implements the
+// event.Event interface.
+var _ event.Event = (*AccountUpdateEvent)(nil)
+
+// accountUpdateAction determines the action that caused an account to change
+// from the previous to the new state outside of a batch.
+func accountUpdateAction(prev, a *account.Account) AccountAction {
+	switch {
+	case a.State == account.StatePendingClosed:
+		return AccountActionClose
+
+	// All modifications initiated by the trader put the account into the
+	// pending update state until the new account output confirms.
+	case a.State == account.StatePendingUpdate && a.Expiry != prev.Expiry:
+		return AccountActionRenewal
+
+	case a.State == account.StatePendingUpdate && a.Value > prev.Value:
+		return AccountActionDeposit
+
+	case a.State == account.StatePendingUpdate && a.Value < prev.Value:
+		return AccountActionWithdrawal
+
+	case a.State != prev.State:
+		return AccountActionStateChange
+
+	default:
+		return AccountActionUnknown
+	}
+}
+
+// accountChanged returns true if the value, expiry or state of an account
+// differs between the previous and the new version.
+func accountChanged(prev, a *account.Account) bool {
+	return prev.Value != a.Value || prev.Expiry != a.Expiry ||
+		prev.State != a.State
+}
+
+// getAccountEventBucket returns the per-account sub bucket that houses the
+// references to the events of the account with the given key. The bucket is
+// created if it doesn't exist yet.
+func getAccountEventBucket(accounts kvdb.RwBucket,
+	accountKey []byte) (kvdb.RwBucket, error) {
+
+	return getAdditionalDataBucket(accounts, accountKey, true)
+}
+
+// storeAccountEventTX stores the given account event both in the main events
+// bucket and a reference to it in the account's sub bucket.
+func storeAccountEventTX(accounts kvdb.RwBucket,
+	evt *AccountUpdateEvent) error {
+
+	if accounts.Get(evt.traderKey[:]) == nil {
+		return ErrAccountNotFound
+	}
+
+	ownerBucket, err := getAccountEventBucket(accounts, evt.traderKey[:])
+	if err != nil {
+		return err
+	}
+
+	return storeEventTX(ownerBucket, evt)
+}
+
+// StoreAccountEvent stores the given account event and a reference to it in
+// the sub bucket of the account it refers to.
+func (db *DB) StoreAccountEvent(evt *AccountUpdateEvent) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		accounts, err := getBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return storeAccountEventTX(accounts, evt)
+	})
+}
+
+// RecordFeeBump records a fee bump of the latest transaction of the account
+// with the given trader key at the given fee rate in the account's event log.
+func (db *DB) RecordFeeBump(traderKey *btcec.PublicKey,
+	feeRate chainfee.SatPerKWeight) error {
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		accounts, err := getBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+
+		a, err := readAccount(accounts, traderKey.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		evt := NewAccountUpdateEvent(AccountActionFeeBump, a, a)
+		evt.FeeRate = feeRate
+		return storeAccountEventTX(accounts, evt)
+	})
+}
+
+// GetAccountEvents returns all events of the account with the given trader key
+// in chronological order.
+func (db *DB) GetAccountEvents(traderKey *btcec.PublicKey) (
+	[]*AccountUpdateEvent, error) {
+
+	accountKey := traderKey.SerializeCompressed()
+
+	var events []*AccountUpdateEvent
+	err := db.View(func(tx kvdb.RTx) error {
+		accounts, err := getReadBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if accounts.Get(accountKey) == nil {
+			return ErrAccountNotFound
+		}
+
+		// Accounts that haven't been updated since events were
+		// introduced don't have an event bucket yet.
+		ownerBucket := getAdditionalDataReadBucket(
+			accounts, accountKey,
+		)
+		if ownerBucket == nil {
+			return nil
+		}
+
+		dbEvents, err := getReferencedEventsTX(tx, ownerBucket)
+		if err != nil {
+			return err
+		}
+
+		events = make([]*AccountUpdateEvent, 0, len(dbEvents))
+		for _, dbEvent := range dbEvents {
+			evt, ok := dbEvent.(*AccountUpdateEvent)
+			if !ok {
+				return fmt.Errorf("unexpected account event "+
+					"type %v", dbEvent.Type())
+			}
+			events = append(events, evt)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -51,7 +52,7 @@ func newTestDB(t *testing.T) (*DB, func()) {
 		t.Fatalf("unable to create temp dir: %v", err)
 	}
 
-	db, err := New(tempDir, DBFilename)
+	db, err := openTestDB(tempDir)
 	if err != nil {
 		os.RemoveAll(tempDir)
 		t.Fatalf("unable to create new db: %v", err)
@@ -63,6 +64,18 @@ func newTestDB(t *testing.T) (*DB, func()) {
 	}
 }
 
+// openTestDB opens the client database that belongs to the given directory.
+// Depending on the build tags, the database is either backed by a bolt file in
+// that directory or by a postgres database that is unique to the directory.
+func openTestDB(dir string) (*DB, error) {
+	backend, _, err := kvdb.GetTestBackend(dir, DBFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDB(backend, dir)
+}
+
 func assertAccountExists(t *testing.T, db *DB, expected *account.Account) {
 	t.Helper()
 
@@ -136,6 +149,21 @@ func TestAccounts(t *testing.T) {
 	}
 	assertAccountExists(t, db, a)
 
+	// The automatic renewal settings are stored alongside the account and
+	// must survive further updates.
+	err = db.UpdateAccount(a, account.AutoRenewModifier(true, 144))
+	if err != nil {
+		t.Fatalf("unable to update account: %v", err)
+	}
+	assertAccountExists(t, db, a)
+
+	// The same goes for the account's reserve.
+	err = db.UpdateAccount(a, account.ReserveModifier(5_000))
+	if err != nil {
+		t.Fatalf("unable to update account: %v", err)
+	}
+	assertAccountExists(t, db, a)
+
 	// Now, transition the account from StatePendingOpen to
 	// StatePendingClosed and include a closing transaction. If the database
 	// update is successful, the in-memory account should be updated as
@@ -173,3 +201,51 @@ func TestAccounts(t *testing.T) {
 			spew.Sdump(accounts[0]))
 	}
 }
+
+// TestAccountVersion makes sure the version of an account is persisted and
+// that accounts without a stored version use the initial version.
+func TestAccountVersion(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	a := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+		Version:       account.VersionTaprootEnabled,
+	}
+	if err := db.AddAccount(a); err != nil {
+		t.Fatalf("unable to add account: %v", err)
+	}
+	assertAccountExists(t, db, a)
+
+	// Remove the stored version to simulate an account that was created
+	// before versions were introduced.
+	err := db.Update(func(tx kvdb.RwTx) error {
+		accounts, err := getBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+		additionalData, err := getAdditionalDataBucket(
+			accounts, getAccountKey(a), false,
+		)
+		if err != nil {
+			return err
+		}
+
+		return additionalData.Delete(accountVersionKey)
+	})
+	if err != nil {
+		t.Fatalf("unable to remove account version: %v", err)
+	}
+
+	a.Version = account.VersionInitialNoVersion
+	assertAccountExists(t, db, a)
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/order"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -13,18 +13,24 @@ var (
 	// information about batches we've participated in.
 	batchBucketKey = []byte("batch")
 
-	// pendingBatchIDKey is a key we'll use to store the ID of a batch we're
-	// currently participating in.
+	// pendingBatchIDKey is a key we'll use to store the ID of the most
+	// recent pending batch we're currently participating in.
 	pendingBatchIDKey = []byte("pending-id")
 
+	// pendingBatchesBucketKey is the key of a bucket nested within the top
+	// level batch bucket that houses a sub-bucket for each pending batch,
+	// keyed by its batch ID. More than one batch can be pending at the
+	// same time if the auctioneer replaced an unconfirmed batch.
+	pendingBatchesBucketKey = []byte("pending-batches")
+
 	// pendingBatchAccountsBucketKey is the key of a bucket nested within
-	// the top level batch bucket that is responsible for storing the
-	// updates of an account that has participated in a batch.
+	// a pending batch's bucket that is responsible for storing the updates
+	// of an account that has participated in that batch.
 	pendingBatchAccountsBucketKey = []byte("pending-accounts")
 
-	// pendingBatchOrdersBucketKey is the key of a bucket nested within
-	// the top level batch bucket that is responsible for storing the
-	// updates of an order that has matched in a batch.
+	// pendingBatchOrdersBucketKey is the key of a bucket nested within a
+	// pending batch's bucket that is responsible for storing the updates
+	// of an order that has matched in that batch.
 	pendingBatchOrdersBucketKey = []byte("pending-orders")
 
 	zeroBatchID order.BatchID
@@ -40,31 +46,40 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 	accountModifiers [][]account.Modifier) error {
 
 	// Catch the most obvious problems first.
-	if len(orders) != len(orderModifiers) {
-		return fmt.Errorf("order modifier length mismatch")
-	}
-	if len(accounts) != len(accountModifiers) {
-		return fmt.Errorf("account modifier length mismatch")
+	err := checkModifierLengths(
+		orders, orderModifiers, accounts, accountModifiers,
+	)
+	if err != nil {
+		return err
 	}
 
 	// Wrap the whole batch update in a single update transaction.
-	return db.Update(func(tx *bbolt.Tx) error {
-		// Before updating the set of orders and accounts, we'll first
-		// delete the buckets containing any existing staged updates.
-		// This is to done to handle the case where the first version of
-		// a batch updated an order/account, but its second version
-		// didn't. Without this, our state would become desynchronized
-		// with the auction.
+	return db.Update(func(tx kvdb.RwTx) error {
 		bucket, err := getBucket(tx, batchBucketKey)
 		if err != nil {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchAccountsBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		pendingBatches, err := getNestedBucket(
+			bucket, pendingBatchesBucketKey, true,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Before updating the set of orders and accounts, we'll first
+		// delete the bucket containing any existing staged updates of
+		// the same batch. This is to done to handle the case where the
+		// first version of a batch updated an order/account, but its
+		// second version didn't. Without this, our state would become
+		// desynchronized with the auction. Other pending batches are
+		// left untouched.
+		batchID := batch.ID
+		err = pendingBatches.DeleteNestedBucket(batchID[:])
+		if err != nil && err != kvdb.ErrBucketNotFound {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchOrdersBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		batchBucket, err := pendingBatches.CreateBucket(batchID[:])
+		if err != nil {
 			return err
 		}
 
@@ -74,22 +89,31 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 			return err
 		}
 		pendingOrdersBucket, err := getNestedBucket(
-			bucket, pendingBatchOrdersBucketKey, true,
+			batchBucket, pendingBatchOrdersBucketKey, true,
 		)
 		if err != nil {
 			return err
 		}
 
+		// We apply the modifiers and validate the result exactly like
+		// ValidatePendingBatch does, so a batch that fails the dry run
+		// can't be stored either. We don't record an event for the
+		// update yet, that is only done once the batch is marked as
+		// complete.
 		var updatedOrders []order.Order
 		for idx, nonce := range orders {
-			o, err := updateOrder(
-				ordersBucket, pendingOrdersBucket, nonce,
-				orderModifiers[idx],
+			o, err := modifyBatchOrder(
+				ordersBucket, nonce, orderModifiers[idx],
 			)
 			if err != nil {
 				return err
 			}
 
+			err = storeFullOrderTX(pendingOrdersBucket, o, nil)
+			if err != nil {
+				return err
+			}
+
 			updatedOrders = append(updatedOrders, o)
 		}
 
@@ -99,7 +123,7 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 			return err
 		}
 		pendingAccountsBucket, err := getNestedBucket(
-			bucket, pendingBatchAccountsBucketKey, true,
+			batchBucket, pendingBatchAccountsBucketKey, true,
 		)
 		if err != nil {
 			return err
@@ -107,20 +131,23 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 
 		var updatedAccounts []*account.Account
 		for idx, acct := range accounts {
-			accountKey := getAccountKey(acct)
-			a, err := updateAccount(
-				accountsBucket, pendingAccountsBucket,
-				accountKey, accountModifiers[idx],
+			a, err := modifyBatchAccount(
+				accountsBucket, getAccountKey(acct),
+				accountModifiers[idx],
 			)
 			if err != nil {
 				return err
 			}
 
+			err = storeAccount(pendingAccountsBucket, a)
+			if err != nil {
+				return err
+			}
+
 			updatedAccounts = append(updatedAccounts, a)
 		}
 
-		// Finally, write the ID and transaction of the pending batch.
-		batchID := batch.ID
+		// Finally, mark this batch as the most recent pending one.
 		if err := bucket.Put(pendingBatchIDKey, batchID[:]); err != nil {
 			return err
 		}
@@ -138,22 +165,277 @@ func (db *DB) StorePendingBatch(batch *order.Batch, orders []order.Nonce,
 	})
 }
 
-// PendingBatchSnapshot retrieves the snapshot of the currently pending batch.
-// If there isn't one, account.ErrNoPendingBatch is returned.
+// ValidatePendingBatch performs a dry run of StorePendingBatch with the same
+// arguments. All modifiers are applied to in-memory copies of the orders and
+// accounts, and the resulting state is validated. The post-batch orders and
+// accounts are returned without anything being written to disk.
+func (db *DB) ValidatePendingBatch(batch *order.Batch, orders []order.Nonce,
+	orderModifiers [][]order.Modifier, accounts []*account.Account,
+	accountModifiers [][]account.Modifier) ([]order.Order,
+	[]*account.Account, error) {
+
+	err := checkModifierLengths(
+		orders, orderModifiers, accounts, accountModifiers,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		updatedOrders   []order.Order
+		updatedAccounts []*account.Account
+	)
+	err = db.View(func(tx kvdb.RTx) error {
+		ordersBucket, err := getReadBucket(tx, ordersBucketKey)
+		if err != nil {
+			return err
+		}
+
+		for idx, nonce := range orders {
+			o, err := modifyBatchOrder(
+				ordersBucket, nonce, orderModifiers[idx],
+			)
+			if err != nil {
+				return err
+			}
+
+			updatedOrders = append(updatedOrders, o)
+		}
+
+		accountsBucket, err := getReadBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+
+		for idx, acct := range accounts {
+			a, err := modifyBatchAccount(
+				accountsBucket, getAccountKey(acct),
+				accountModifiers[idx],
+			)
+			if err != nil {
+				return err
+			}
+
+			updatedAccounts = append(updatedAccounts, a)
+		}
+
+		// Make sure we'd also be able to create the snapshot that is
+		// stored together with the pending batch.
+		_, err = NewSnapshot(batch, updatedOrders, updatedAccounts)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return updatedOrders, updatedAccounts, nil
+}
+
+// checkModifierLengths makes sure there is exactly one set of modifiers for
+// each order and account.
+func checkModifierLengths(orders []order.Nonce,
+	orderModifiers [][]order.Modifier, accounts []*account.Account,
+	accountModifiers [][]account.Modifier) error {
+
+	if len(orders) != len(orderModifiers) {
+		return fmt.Errorf("order modifier length mismatch")
+	}
+	if len(accounts) != len(accountModifiers) {
+		return fmt.Errorf("account modifier length mismatch")
+	}
+
+	return nil
+}
+
+// modifyBatchOrder applies the modifiers of a batch to an in-memory copy of
+// the order with the given nonce and makes sure the result is valid. Nothing
+// is written to the database.
+func modifyBatchOrder(ordersBucket kvdb.RBucket, nonce order.Nonce,
+	modifiers []order.Modifier) (order.Order, error) {
+
+	o, prev, err := modifyOrder(ordersBucket, nonce, modifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateOrderUpdate(o, prev.State); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// modifyBatchAccount applies the modifiers of a batch to an in-memory copy of
+// the account with the given key and makes sure the result is valid. Nothing
+// is written to the database.
+func modifyBatchAccount(accountsBucket kvdb.RBucket, accountKey []byte,
+	modifiers []account.Modifier) (*account.Account, error) {
+
+	a, prevState, err := modifyAccount(accountsBucket, accountKey, modifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateAccountUpdate(a, prevState); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// validateOrderUpdate makes sure an order that was modified as part of a batch
+// ends up in a sane state.
+func validateOrderUpdate(o order.Order, prevState order.State) error {
+	nonce := o.Nonce()
+	if prevState.Archived() {
+		return fmt.Errorf("order %v is already in archived state %v",
+			nonce, prevState)
+	}
+
+	details := o.Details()
+	if details.UnitsUnfulfilled > details.Units {
+		return fmt.Errorf("order %v would have %d unfulfilled units "+
+			"but only has %d units in total", nonce,
+			details.UnitsUnfulfilled, details.Units)
+	}
+
+	return nil
+}
+
+// validateAccountUpdate makes sure an account that was modified as part of a
+// batch ends up in a sane state.
+func validateAccountUpdate(a *account.Account, prevState account.State) error {
+	switch prevState {
+	case account.StateInitiated, account.StatePendingClosed,
+		account.StateClosed, account.StateCanceledAfterRecovery:
+
+		return fmt.Errorf("account %x in state %v cannot participate "+
+			"in a batch", a.TraderKey.PubKey.SerializeCompressed(),
+			prevState)
+	}
+
+	if a.Value < 0 {
+		return fmt.Errorf("account %x would have negative balance %v",
+			a.TraderKey.PubKey.SerializeCompressed(), a.Value)
+	}
+
+	return nil
+}
+
+// PendingBatchSnapshot retrieves the snapshot of the most recent pending
+// batch. If there isn't one, account.ErrNoPendingBatch is returned.
 func (db *DB) PendingBatchSnapshot() (*LocalBatchSnapshot, error) {
 	var batchSnapshot *LocalBatchSnapshot
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.RTx) error {
+		batchID, err := pendingBatchID(tx)
+		if err != nil {
+			return err
+		}
+
+		batchSnapshot, err = fetchPendingBatchSnapshot(tx, batchID)
+		return err
+	})
+	return batchSnapshot, err
+}
+
+// GetPendingBatchSnapshot retrieves the snapshot of the pending batch with the
+// given ID. If there isn't one, account.ErrNoPendingBatch is returned.
+func (db *DB) GetPendingBatchSnapshot(
+	batchID order.BatchID) (*LocalBatchSnapshot, error) {
+
+	var batchSnapshot *LocalBatchSnapshot
+	err := db.View(func(tx kvdb.RTx) error {
 		var err error
-		batchSnapshot, err = fetchPendingBatchSnapshot(tx)
+		batchSnapshot, err = fetchPendingBatchSnapshot(tx, batchID)
 		return err
 	})
 	return batchSnapshot, err
 }
 
-// pendingBatchID retrieves the stored pending batch ID within a database
-// transaction.
-func pendingBatchID(tx *bbolt.Tx) (order.BatchID, error) {
+// PendingBatches returns the IDs of all batches that are currently pending.
+func (db *DB) PendingBatches() ([]order.BatchID, error) {
+	var batchIDs []order.BatchID
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, batchBucketKey)
+		if err != nil {
+			return err
+		}
+
+		pendingBatches := bucket.NestedReadBucket(
+			pendingBatchesBucketKey,
+		)
+		if pendingBatches == nil {
+			return nil
+		}
+
+		return pendingBatches.ForEach(func(k, v []byte) error {
+			// Only go into things that we know are sub-bucket
+			// keys.
+			if v != nil {
+				return nil
+			}
+
+			var batchID order.BatchID
+			copy(batchID[:], k)
+			batchIDs = append(batchIDs, batchID)
+
+			return nil
+		})
+	})
+	return batchIDs, err
+}
+
+// pendingBatchOrderNonces returns the nonces of all orders that are referenced
+// by any of the pending batches within a database transaction.
+func pendingBatchOrderNonces(tx kvdb.RwTx) (map[order.Nonce]struct{}, error) {
 	bucket, err := getBucket(tx, batchBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonces := make(map[order.Nonce]struct{})
+	pendingBatches := bucket.NestedReadWriteBucket(pendingBatchesBucketKey)
+	if pendingBatches == nil {
+		return nonces, nil
+	}
+
+	err = pendingBatches.ForEach(func(batchID, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		pendingOrders := pendingBatches.NestedReadWriteBucket(
+			batchID,
+		).NestedReadWriteBucket(pendingBatchOrdersBucketKey)
+		if pendingOrders == nil {
+			return nil
+		}
+
+		return pendingOrders.ForEach(func(k, _ []byte) error {
+			// Filter out any keys that are not nonces.
+			var nonce order.Nonce
+			if len(k) != len(nonce) {
+				return nil
+			}
+
+			copy(nonce[:], k)
+			nonces[nonce] = struct{}{}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nonces, nil
+}
+
+// pendingBatchID retrieves the stored ID of the most recent pending batch
+// within a database transaction.
+func pendingBatchID(tx kvdb.RTx) (order.BatchID, error) {
+	bucket, err := getReadBucket(tx, batchBucketKey)
 	if err != nil {
 		return zeroBatchID, err
 	}
@@ -168,64 +450,97 @@ func pendingBatchID(tx *bbolt.Tx) (order.BatchID, error) {
 	return batchID, nil
 }
 
-// DeletePendingBatch removes all references to the current pending batch
-// without applying its staged updates to accounts and orders. If no pending
-// batch exists, this acts as a no-op.
+// DeletePendingBatch removes all references to all pending batches without
+// applying their staged updates to accounts and orders. If no pending batch
+// exists, this acts as a no-op.
 func (db *DB) DeletePendingBatch() error {
-	return db.Update(func(tx *bbolt.Tx) error {
-		bucket, err := getBucket(tx, batchBucketKey)
-		if err != nil {
-			return err
-		}
+	return db.Update(deletePendingBatches)
+}
+
+// deletePendingBatches removes all pending batches, their staged updates and
+// their snapshots within a database transaction.
+func deletePendingBatches(tx kvdb.RwTx) error {
+	bucket, err := getBucket(tx, batchBucketKey)
+	if err != nil {
+		return err
+	}
 
-		if err := bucket.Delete(pendingBatchIDKey); err != nil {
+	if err := bucket.Delete(pendingBatchIDKey); err != nil {
+		return err
+	}
+	err = bucket.DeleteNestedBucket(pendingBatchesBucketKey)
+	if err != nil && err != kvdb.ErrBucketNotFound {
+		return err
+	}
+
+	// Also delete the pending batch snapshots, as they are stored together
+	// with the pending batches.
+	return deletePendingSnapshots(tx)
+}
+
+// MarkBatchComplete marks the pending batch with the given ID as complete,
+// applying its staged modifications, and allowing a trader to participate in a
+// new batch. All other pending batches are removed as they can no longer
+// confirm, together with the proposals of all batches we signed for. If the
+// pending batch is not found, account.ErrNoPendingBatch is returned.
+func (db *DB) MarkBatchComplete(batchID order.BatchID) error {
+	err := db.Update(func(tx kvdb.RwTx) error {
+		// Keep the state from before the batch around until its
+		// transaction is buried deep enough, in case it is reorged out
+		// of the chain.
+		if err := storeReversibleBatch(tx, batchID); err != nil {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchAccountsBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err := applyBatchUpdates(tx, batchID); err != nil {
 			return err
 		}
-		err = bucket.DeleteBucket(pendingBatchOrdersBucketKey)
-		if err != nil && err != bbolt.ErrBucketNotFound {
+		if err := finalizeBatchSnapshot(tx, batchID); err != nil {
 			return err
 		}
 
-		// Also delete the pending batch snapshot, as it will be stored
-		// together with the pending batch.
-		return deletePendingSnapshot(tx)
-	})
-}
-
-// MarkBatchComplete marks a pending batch as complete, applying any staged
-// modifications necessary, and allowing a trader to participate in a new batch.
-// If a pending batch is not found, account.ErrNoPendingBatch is returned.
-func (db *DB) MarkBatchComplete() error {
-	return db.Update(func(tx *bbolt.Tx) error {
-		pendingID, err := pendingBatchID(tx)
-		if err != nil {
-			return err
-		}
-		if err := applyBatchUpdates(tx); err != nil {
+		// Now that the batch is final, there's nothing left to recover
+		// from the proposals we signed for.
+		if err := deleteAcceptedBatchProposals(tx); err != nil {
 			return err
 		}
 
-		return finalizeBatchSnapshot(tx, pendingID)
+		// The batch we just completed conflicts with all its siblings,
+		// so we can get rid of them now.
+		return deletePendingBatches(tx)
 	})
+	if err != nil {
+		return err
+	}
+
+	// The batch's account updates were applied, so the account
+	// subscribers need to know about them.
+	db.notifyAccountUpdate()
+
+	return nil
 }
 
 // applyBatchUpdates applies the staged updates for any accounts and orders that
-// participated in the latest pending batch.
-func applyBatchUpdates(tx *bbolt.Tx) error {
+// participated in the pending batch with the given ID.
+func applyBatchUpdates(tx kvdb.RwTx, batchID order.BatchID) error {
 	bucket, err := getBucket(tx, batchBucketKey)
 	if err != nil {
 		return err
 	}
 
+	pendingBatches := bucket.NestedReadWriteBucket(pendingBatchesBucketKey)
+	if pendingBatches == nil {
+		return account.ErrNoPendingBatch
+	}
+	batchBucket := pendingBatches.NestedReadWriteBucket(batchID[:])
+	if batchBucket == nil {
+		return account.ErrNoPendingBatch
+	}
+
 	// We'll start by first applying the account updates. This simply
 	// involves fetching the updated state as part of the batch, and copying
 	// it over to the main account state.
 	pendingAccounts, err := getNestedBucket(
-		bucket, pendingBatchAccountsBucketKey, false,
+		batchBucket, pendingBatchAccountsBucketKey, false,
 	)
 	if err != nil {
 		return err
@@ -239,22 +554,31 @@ func applyBatchUpdates(tx *bbolt.Tx) error {
 		if len(k) != 33 {
 			return nil
 		}
-		_, err := updateAccount(pendingAccounts, accounts, k, nil)
-		return err
+
+		prev, err := readAccount(accounts, k)
+		if err != nil {
+			return err
+		}
+
+		a, err := updateAccount(pendingAccounts, accounts, k, nil)
+		if err != nil {
+			return err
+		}
+
+		if !accountChanged(prev, a) {
+			return nil
+		}
+
+		evt := NewAccountUpdateEvent(AccountActionBatch, prev, a)
+		return storeAccountEventTX(accounts, evt)
 	})
 	if err != nil {
 		return err
 	}
 
-	// Once we've updated all of the accounts, we can remove the pending
-	// updates as they've been committed.
-	if err := bucket.DeleteBucket(pendingBatchAccountsBucketKey); err != nil {
-		return err
-	}
-
 	// We'll do the same for orders as well.
 	pendingOrders, err := getNestedBucket(
-		bucket, pendingBatchOrdersBucketKey, false,
+		batchBucket, pendingBatchOrdersBucketKey, false,
 	)
 	if err != nil {
 		return err
@@ -263,25 +587,37 @@ func applyBatchUpdates(tx *bbolt.Tx) error {
 	if err != nil {
 		return err
 	}
-	err = pendingOrders.ForEach(func(k, v []byte) error {
+	return pendingOrders.ForEach(func(k, v []byte) error {
 		// Filter out any keys that are not nonces.
 		var nonce order.Nonce
 		if len(k) != len(nonce) {
 			return nil
 		}
 		copy(nonce[:], k)
-		return copyOrder(pendingOrders, orders, nonce)
-	})
-	if err != nil {
-		return err
-	}
 
-	// Once again, remove the pending order updates as they've been
-	// committed.
-	if err := bucket.DeleteBucket(pendingBatchOrdersBucketKey); err != nil {
-		return err
-	}
+		// The order in the main bucket still has the state from before
+		// the batch, which we need for the event.
+		_, prev, err := modifyOrder(orders, nonce, nil)
+		if err != nil {
+			return err
+		}
 
-	// Finally, remove the reference to the pending batch ID.
-	return bucket.Delete(pendingBatchIDKey)
+		o, err := copyOrder(pendingOrders, orders, nonce)
+		if err != nil {
+			return err
+		}
+
+		if o.Details().State != prev.State {
+			if err := indexOrderStateTX(tx, o); err != nil {
+				return err
+			}
+		}
+
+		// Now that the batch is final, we record the update of the
+		// order as an event. Add the event to the global event store
+		// but also add a reference to it in the order's event
+		// reference sub bucket.
+		evt := NewUpdatedEvent(prev, o, &batchID)
+		return storeEventTX(orders.NestedReadWriteBucket(nonce[:]), evt)
+	})
 }
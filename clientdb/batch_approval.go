@@ -0,0 +1,126 @@
+package clientdb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/lightninglabs/pool/approval"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// batchSnapshotApprovalBucketKey is a sub-bucket of the batch snapshot
+	// bucket where we store the decision of the batch approval hook,
+	// keyed by batch ID.
+	batchSnapshotApprovalBucketKey = []byte("batch-snapshot-approval-bucket")
+)
+
+// StoreBatchApproval stores the decision of the batch approval hook about the
+// batch with the given ID, replacing any previous decision. The prepare
+// message of a batch can be sent multiple times, so only the latest decision
+// is kept.
+func (db *DB) StoreBatchApproval(id order.BatchID,
+	record *approval.Record) error {
+
+	var buf bytes.Buffer
+	if err := serializeBatchApproval(&buf, record); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		topBucket, err := getBucket(tx, batchSnapshotBucketKey)
+		if err != nil {
+			return err
+		}
+		approvalBucket, err := getNestedBucket(
+			topBucket, batchSnapshotApprovalBucketKey, true,
+		)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(approvalBucket, id[:], buf.Bytes())
+	})
+}
+
+// BatchApproval returns the decision of the batch approval hook about the
+// batch with the given ID. If no decision was stored,
+// approval.ErrNoBatchApproval is returned.
+func (db *DB) BatchApproval(id order.BatchID) (*approval.Record, error) {
+	var record *approval.Record
+	err := db.View(func(tx kvdb.RTx) error {
+		topBucket, err := getReadBucket(tx, batchSnapshotBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// The bucket is only created once the first decision is
+		// stored.
+		approvalBucket := topBucket.NestedReadBucket(
+			batchSnapshotApprovalBucketKey,
+		)
+		if approvalBucket == nil {
+			return approval.ErrNoBatchApproval
+		}
+
+		recordBytes := approvalBucket.Get(id[:])
+		if recordBytes == nil {
+			return approval.ErrNoBatchApproval
+		}
+		if !recordValid(approvalBucket, id[:], recordBytes) {
+			return &ErrCorruptedRecord{
+				Bucket: string(batchSnapshotApprovalBucketKey),
+				Key:    copyBytes(id[:]),
+			}
+		}
+
+		record, err = deserializeBatchApproval(
+			bytes.NewReader(recordBytes),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// serializeBatchApproval serializes an approval record. The reason is stored
+// last and takes up the rest of the record.
+func serializeBatchApproval(w *bytes.Buffer, r *approval.Record) error {
+	err := WriteElements(
+		w, r.Approved, r.TimedOut, uint64(r.Latency), r.DecidedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.WriteString(r.Reason)
+	return err
+}
+
+func deserializeBatchApproval(r io.Reader) (*approval.Record, error) {
+	var (
+		record  = &approval.Record{}
+		latency uint64
+	)
+	err := ReadElements(
+		r, &record.Approved, &record.TimedOut, &latency,
+		&record.DecidedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	record.Latency = time.Duration(latency)
+
+	reason, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	record.Reason = string(reason)
+
+	return record, nil
+}
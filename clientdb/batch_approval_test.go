@@ -0,0 +1,49 @@
+package clientdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/approval"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchApproval makes sure the approval decision of a batch can be stored
+// and is replaced if the batch is prepared again.
+func TestBatchApproval(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	id := order.BatchID{1, 2, 3}
+	_, err := db.BatchApproval(id)
+	require.ErrorIs(t, err, approval.ErrNoBatchApproval)
+
+	record := &approval.Record{
+		TimedOut:  true,
+		Reason:    "no decision within 30s",
+		Latency:   30 * time.Second,
+		DecidedAt: time.Unix(1_700_000_000, 0),
+	}
+	require.NoError(t, db.StoreBatchApproval(id, record))
+
+	stored, err := db.BatchApproval(id)
+	require.NoError(t, err)
+	require.Equal(t, record, stored)
+
+	record = &approval.Record{
+		Approved:  true,
+		Latency:   1500 * time.Millisecond,
+		DecidedAt: time.Unix(1_700_000_100, 0),
+	}
+	require.NoError(t, db.StoreBatchApproval(id, record))
+
+	stored, err = db.BatchApproval(id)
+	require.NoError(t, err)
+	require.Equal(t, record, stored)
+
+	_, err = db.BatchApproval(order.BatchID{4})
+	require.ErrorIs(t, err, approval.ErrNoBatchApproval)
+}
@@ -0,0 +1,94 @@
+package clientdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightninglabs/pool/policy"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// batchPolicyBucketKey is the top level bucket where we store the
+	// batch participation policy.
+	batchPolicyBucketKey = []byte("batch-policy")
+
+	// activeBatchPolicyKey is the key under which the active batch policy
+	// is stored.
+	activeBatchPolicyKey = []byte("active-policy")
+)
+
+// StoreBatchPolicy stores the given batch policy, replacing any previously
+// stored one.
+//
+// NOTE: This method is part of the policy.Store interface.
+func (db *DB) StoreBatchPolicy(p *policy.Policy) error {
+	var buf bytes.Buffer
+	if err := serializeBatchPolicy(&buf, p); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, batchPolicyBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, activeBatchPolicyKey, buf.Bytes())
+	})
+}
+
+// BatchPolicy returns the stored batch policy. If no policy was stored yet,
+// policy.ErrNoBatchPolicy is returned.
+//
+// NOTE: This method is part of the policy.Store interface.
+func (db *DB) BatchPolicy() (*policy.Policy, error) {
+	var p *policy.Policy
+	err := db.View(func(tx kvdb.RTx) error {
+		// A read-only database might have been created before batch
+		// policies were introduced, so we don't require the bucket to
+		// exist.
+		bucket := tx.ReadBucket(batchPolicyBucketKey)
+		if bucket == nil {
+			return policy.ErrNoBatchPolicy
+		}
+
+		policyBytes := bucket.Get(activeBatchPolicyKey)
+		if policyBytes == nil {
+			return policy.ErrNoBatchPolicy
+		}
+		if !recordValid(bucket, activeBatchPolicyKey, policyBytes) {
+			return &ErrCorruptedRecord{
+				Bucket: string(batchPolicyBucketKey),
+				Key:    copyBytes(activeBatchPolicyKey),
+			}
+		}
+
+		var err error
+		p, err = deserializeBatchPolicy(bytes.NewReader(policyBytes))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func serializeBatchPolicy(w *bytes.Buffer, p *policy.Policy) error {
+	return WriteElements(
+		w, p.MaxChainFee, p.MaxPriceDeviationBps, p.MaxMatchedOrders,
+	)
+}
+
+func deserializeBatchPolicy(r io.Reader) (*policy.Policy, error) {
+	p := &policy.Policy{}
+	err := ReadElements(
+		r, &p.MaxChainFee, &p.MaxPriceDeviationBps, &p.MaxMatchedOrders,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
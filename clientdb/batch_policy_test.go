@@ -0,0 +1,38 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/policy"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchPolicy makes sure the batch policy can be stored and replaced.
+func TestBatchPolicy(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	_, err := db.BatchPolicy()
+	require.ErrorIs(t, err, policy.ErrNoBatchPolicy)
+
+	p := &policy.Policy{
+		MaxChainFee:          10_000,
+		MaxPriceDeviationBps: 250,
+		MaxMatchedOrders:     3,
+	}
+	require.NoError(t, db.StoreBatchPolicy(p))
+
+	stored, err := db.BatchPolicy()
+	require.NoError(t, err)
+	require.Equal(t, p, stored)
+
+	// Storing an empty policy disables all rules but keeps a policy
+	// around.
+	require.NoError(t, db.StoreBatchPolicy(&policy.Policy{}))
+
+	stored, err = db.BatchPolicy()
+	require.NoError(t, err)
+	require.True(t, stored.IsEmpty())
+}
@@ -0,0 +1,306 @@
+package clientdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrNoAcceptedBatchProposal is the error returned if no accepted
+	// batch proposal with the given batch ID exists in the store.
+	ErrNoAcceptedBatchProposal = errors.New("no accepted batch proposal " +
+		"found")
+
+	// acceptedProposalsBucketKey is the key of a bucket nested within the
+	// top level batch bucket that stores the proposals of all batches we
+	// signed for, keyed by their batch ID. It is kept separate from the
+	// pending batches so the proposals survive a batch being re-staged.
+	acceptedProposalsBucketKey = []byte("accepted-proposals")
+)
+
+// AcceptedBatchProposal is a distilled form of a batch proposal we signed for.
+// It contains everything that was agreed upon with the auctioneer so it can be
+// reconstructed after a crash between signing and finalizing the batch.
+type AcceptedBatchProposal struct {
+	// Version is the version of the batch verification protocol.
+	Version order.BatchVersion
+
+	// BatchID is the batch's unique ID.
+	BatchID order.BatchID
+
+	// MatchedUnits is a map of all our orders that were matched in the
+	// batch and the total number of units that were filled for each.
+	MatchedUnits map[order.Nonce]order.SupplyUnit
+
+	// ClearingPrices is a map of the lease duration markets and the fixed
+	// rate the orders were cleared at within that market.
+	ClearingPrices map[uint32]order.FixedRatePremium
+
+	// AccountDiffs is the list of the ending states of our accounts that
+	// participated in the batch.
+	AccountDiffs []*order.AccountDiff
+
+	// ExecutionFee is the FeeSchedule that was used by the server to
+	// calculate the execution fee.
+	ExecutionFee terms.LinearFeeSchedule
+
+	// BatchTX is the complete batch transaction we signed for.
+	BatchTX *wire.MsgTx
+
+	// BatchTxFeeRate is the miner fee rate in sat/kW that was chosen for
+	// the batch transaction.
+	BatchTxFeeRate chainfee.SatPerKWeight
+
+	// FeeRebate is the rebate that was offered to the trader if another
+	// batch participant wants to pay for their orders to be executed.
+	FeeRebate btcutil.Amount
+}
+
+// NewAcceptedBatchProposal distills the given batch into the proposal that is
+// stored once we sign for it.
+func NewAcceptedBatchProposal(batch *order.Batch) (*AcceptedBatchProposal,
+	error) {
+
+	// We only support LinearFeeSchedule at this point (because of
+	// serialization).
+	feeSched, ok := batch.ExecutionFee.(*terms.LinearFeeSchedule)
+	if !ok {
+		return nil, fmt.Errorf("unsupported fee schedule: %T",
+			batch.ExecutionFee)
+	}
+
+	matchedUnits := make(map[order.Nonce]order.SupplyUnit)
+	for nonce, matches := range batch.MatchedOrders {
+		for _, match := range matches {
+			matchedUnits[nonce] += match.UnitsFilled
+		}
+	}
+
+	return &AcceptedBatchProposal{
+		Version:        batch.Version,
+		BatchID:        batch.ID,
+		MatchedUnits:   matchedUnits,
+		ClearingPrices: batch.ClearingPrices,
+		AccountDiffs:   batch.AccountDiffs,
+		ExecutionFee:   *feeSched,
+		BatchTX:        batch.BatchTX,
+		BatchTxFeeRate: batch.BatchTxFeeRate,
+		FeeRebate:      batch.FeeRebate,
+	}, nil
+}
+
+// StoreAcceptedBatchProposal stores the proposal of a batch we signed for. An
+// existing proposal for the same batch ID is overwritten.
+func (db *DB) StoreAcceptedBatchProposal(batchID order.BatchID,
+	proposal *AcceptedBatchProposal) error {
+
+	var w bytes.Buffer
+	if err := serializeAcceptedBatchProposal(&w, proposal); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, batchBucketKey)
+		if err != nil {
+			return err
+		}
+		proposals, err := getNestedBucket(
+			bucket, acceptedProposalsBucketKey, true,
+		)
+		if err != nil {
+			return err
+		}
+
+		return proposals.Put(batchID[:], w.Bytes())
+	})
+}
+
+// GetAcceptedBatchProposal returns the proposal of the batch with the given ID
+// that we signed for. If there is none, ErrNoAcceptedBatchProposal is
+// returned.
+func (db *DB) GetAcceptedBatchProposal(
+	batchID order.BatchID) (*AcceptedBatchProposal, error) {
+
+	var proposal *AcceptedBatchProposal
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, batchBucketKey)
+		if err != nil {
+			return err
+		}
+
+		proposals := bucket.NestedReadBucket(acceptedProposalsBucketKey)
+		if proposals == nil {
+			return ErrNoAcceptedBatchProposal
+		}
+
+		proposalBytes := proposals.Get(batchID[:])
+		if proposalBytes == nil {
+			return ErrNoAcceptedBatchProposal
+		}
+
+		proposal, err = deserializeAcceptedBatchProposal(
+			bytes.NewReader(proposalBytes),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// deleteAcceptedBatchProposals removes the proposals of all batches we signed
+// for within a database transaction.
+func deleteAcceptedBatchProposals(tx kvdb.RwTx) error {
+	bucket, err := getBucket(tx, batchBucketKey)
+	if err != nil {
+		return err
+	}
+
+	err = bucket.DeleteNestedBucket(acceptedProposalsBucketKey)
+	if err != nil && err != kvdb.ErrBucketNotFound {
+		return err
+	}
+
+	return nil
+}
+
+func serializeAcceptedBatchProposal(w *bytes.Buffer,
+	p *AcceptedBatchProposal) error {
+
+	err := WriteElements(
+		w, p.Version, p.BatchID[:], p.ExecutionFee, p.BatchTX,
+		p.BatchTxFeeRate, p.FeeRebate,
+	)
+	if err != nil {
+		return err
+	}
+
+	numMatched := uint32(len(p.MatchedUnits))
+	if err := WriteElements(w, numMatched); err != nil {
+		return err
+	}
+	for nonce, units := range p.MatchedUnits {
+		if err := WriteElements(w, nonce, units); err != nil {
+			return err
+		}
+	}
+
+	numPrices := uint32(len(p.ClearingPrices))
+	if err := WriteElements(w, numPrices); err != nil {
+		return err
+	}
+	for duration, price := range p.ClearingPrices {
+		if err := WriteElements(w, duration, price); err != nil {
+			return err
+		}
+	}
+
+	numDiffs := uint32(len(p.AccountDiffs))
+	if err := WriteElements(w, numDiffs); err != nil {
+		return err
+	}
+	for _, diff := range p.AccountDiffs {
+		err := WriteElements(
+			w, diff.AccountKeyRaw, uint32(diff.EndingState),
+			diff.EndingBalance, uint32(diff.OutpointIndex),
+			diff.NewExpiry,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeAcceptedBatchProposal(r io.Reader) (*AcceptedBatchProposal,
+	error) {
+
+	p := &AcceptedBatchProposal{
+		MatchedUnits:   make(map[order.Nonce]order.SupplyUnit),
+		ClearingPrices: make(map[uint32]order.FixedRatePremium),
+	}
+	err := ReadElements(
+		r, &p.Version, p.BatchID[:], &p.ExecutionFee, &p.BatchTX,
+		&p.BatchTxFeeRate, &p.FeeRebate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var numMatched uint32
+	if err := ReadElements(r, &numMatched); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numMatched; i++ {
+		var (
+			nonce order.Nonce
+			units order.SupplyUnit
+		)
+		if err := ReadElements(r, &nonce, &units); err != nil {
+			return nil, err
+		}
+
+		p.MatchedUnits[nonce] = units
+	}
+
+	var numPrices uint32
+	if err := ReadElements(r, &numPrices); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numPrices; i++ {
+		var (
+			duration uint32
+			price    order.FixedRatePremium
+		)
+		if err := ReadElements(r, &duration, &price); err != nil {
+			return nil, err
+		}
+
+		p.ClearingPrices[duration] = price
+	}
+
+	var numDiffs uint32
+	if err := ReadElements(r, &numDiffs); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numDiffs; i++ {
+		var (
+			diff                     order.AccountDiff
+			endingState, outpointIdx uint32
+		)
+		err := ReadElements(
+			r, &diff.AccountKeyRaw, &endingState,
+			&diff.EndingBalance, &outpointIdx, &diff.NewExpiry,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		diff.AccountKey, err = btcec.ParsePubKey(diff.AccountKeyRaw[:])
+		if err != nil {
+			return nil, err
+		}
+		diff.EndingState = auctioneerrpc.AccountDiff_AccountState(
+			endingState,
+		)
+		diff.OutpointIndex = int32(outpointIdx)
+
+		p.AccountDiffs = append(p.AccountDiffs, &diff)
+	}
+
+	return p, nil
+}
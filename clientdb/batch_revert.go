@@ -0,0 +1,454 @@
+package clientdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// batch
+//
+//	|
+//	|-- reversible-batches
+//	               |
+//	               |-- <batch id>
+//	               |        |
+//	               |        |-- reversible-seq: <sequence num>
+//	               |        |-- prev-accounts
+//	               |        |        |
+//	               |        |        |-- <account key>: <account>
+//	               |        |
+//	               |        |-- prev-orders
+//	               |                 |
+//	               |                 |-- <nonce>: <order>
+//	               |
+//	              ...
+var (
+	// ErrNoReversibleBatch is the error returned if no reversible batch
+	// with the given ID exists in the store.
+	ErrNoReversibleBatch = errors.New("no reversible batch found")
+
+	// ErrRevertOutOfOrder is the error returned if a batch is reverted
+	// while a batch that was completed after it is still reversible.
+	ErrRevertOutOfOrder = errors.New("a later batch must be reverted " +
+		"first")
+
+	// reversibleBatchesBucketKey is the key of a bucket nested within the
+	// top level batch bucket that houses a sub-bucket for each completed
+	// batch that can still be reverted, keyed by its batch ID.
+	reversibleBatchesBucketKey = []byte("reversible-batches")
+
+	// reversibleSeqKey is the key under which the order of completion of
+	// a reversible batch is stored.
+	reversibleSeqKey = []byte("reversible-seq")
+
+	// prevAccountsBucketKey is the key of a bucket nested within a
+	// reversible batch's bucket that stores the state of the accounts
+	// from before the batch was applied.
+	prevAccountsBucketKey = []byte("prev-accounts")
+
+	// prevOrdersBucketKey is the key of a bucket nested within a
+	// reversible batch's bucket that stores the state of the orders from
+	// before the batch was applied.
+	prevOrdersBucketKey = []byte("prev-orders")
+)
+
+// storeReversibleBatch stores the current state of all accounts and orders
+// that are about to be updated by the pending batch with the given ID, so the
+// batch can be reverted if its transaction is reorged out of the chain.
+func storeReversibleBatch(tx kvdb.RwTx, batchID order.BatchID) error {
+	bucket, err := getBucket(tx, batchBucketKey)
+	if err != nil {
+		return err
+	}
+
+	pendingBatches := bucket.NestedReadWriteBucket(pendingBatchesBucketKey)
+	if pendingBatches == nil {
+		return nil
+	}
+	batchBucket := pendingBatches.NestedReadWriteBucket(batchID[:])
+	if batchBucket == nil {
+		return nil
+	}
+
+	reversibleBatches, err := getNestedBucket(
+		bucket, reversibleBatchesBucketKey, true,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Remove any leftovers of the same batch. This happens if it was
+	// reverted and completed again in the meantime.
+	err = reversibleBatches.DeleteNestedBucket(batchID[:])
+	if err != nil && err != kvdb.ErrBucketNotFound {
+		return err
+	}
+	reversible, err := reversibleBatches.CreateBucket(batchID[:])
+	if err != nil {
+		return err
+	}
+
+	sequence, err := reversibleBatches.NextSequence()
+	if err != nil {
+		return err
+	}
+	var seqBytes [8]byte
+	binary.BigEndian.PutUint64(seqBytes[:], sequence)
+	if err := reversible.Put(reversibleSeqKey, seqBytes[:]); err != nil {
+		return err
+	}
+
+	// Copy all accounts touched by the batch from the main bucket before
+	// their staged updates overwrite them.
+	pendingAccounts, err := getNestedBucket(
+		batchBucket, pendingBatchAccountsBucketKey, false,
+	)
+	if err != nil {
+		return err
+	}
+	prevAccounts, err := reversible.CreateBucket(prevAccountsBucketKey)
+	if err != nil {
+		return err
+	}
+	accounts, err := getBucket(tx, accountBucketKey)
+	if err != nil {
+		return err
+	}
+	err = pendingAccounts.ForEach(func(k, v []byte) error {
+		// Filter out any keys that are not for accounts.
+		if len(k) != 33 {
+			return nil
+		}
+
+		_, err := updateAccount(accounts, prevAccounts, k, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// We'll do the same for orders as well.
+	pendingOrders, err := getNestedBucket(
+		batchBucket, pendingBatchOrdersBucketKey, false,
+	)
+	if err != nil {
+		return err
+	}
+	prevOrders, err := reversible.CreateBucket(prevOrdersBucketKey)
+	if err != nil {
+		return err
+	}
+	orders, err := getBucket(tx, ordersBucketKey)
+	if err != nil {
+		return err
+	}
+	return pendingOrders.ForEach(func(k, v []byte) error {
+		// Filter out any keys that are not nonces.
+		var nonce order.Nonce
+		if len(k) != len(nonce) {
+			return nil
+		}
+		copy(nonce[:], k)
+
+		_, err := copyOrder(orders, prevOrders, nonce)
+		return err
+	})
+}
+
+// ReversibleBatches returns the IDs of all completed batches that can still be
+// reverted, in the order they were completed.
+func (db *DB) ReversibleBatches() ([]order.BatchID, error) {
+	var batchIDs []order.BatchID
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, batchBucketKey)
+		if err != nil {
+			return err
+		}
+
+		batchIDs, err = reversibleBatchIDs(bucket)
+		return err
+	})
+	return batchIDs, err
+}
+
+// reversibleBatchIDs returns the IDs of all reversible batches found in the
+// given batch bucket, in the order they were completed.
+func reversibleBatchIDs(bucket kvdb.RBucket) ([]order.BatchID, error) {
+	reversibleBatches := bucket.NestedReadBucket(reversibleBatchesBucketKey)
+	if reversibleBatches == nil {
+		return nil, nil
+	}
+
+	var (
+		batchIDs  []order.BatchID
+		sequences = make(map[order.BatchID]uint64)
+	)
+	err := reversibleBatches.ForEach(func(k, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		var batchID order.BatchID
+		copy(batchID[:], k)
+
+		seqBytes := reversibleBatches.NestedReadBucket(k).Get(
+			reversibleSeqKey,
+		)
+		if len(seqBytes) != 8 {
+			return ErrNoReversibleBatch
+		}
+
+		batchIDs = append(batchIDs, batchID)
+		sequences[batchID] = binary.BigEndian.Uint64(seqBytes)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(batchIDs, func(i, j int) bool {
+		return sequences[batchIDs[i]] < sequences[batchIDs[j]]
+	})
+
+	return batchIDs, nil
+}
+
+// DeleteReversibleBatch removes the pre-batch state of the completed batch
+// with the given ID once its transaction is buried deep enough that it can't
+// be reorged out anymore. If the batch isn't reversible, this acts as a no-op.
+func (db *DB) DeleteReversibleBatch(batchID order.BatchID) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, batchBucketKey)
+		if err != nil {
+			return err
+		}
+
+		reversibleBatches := bucket.NestedReadWriteBucket(
+			reversibleBatchesBucketKey,
+		)
+		if reversibleBatches == nil {
+			return nil
+		}
+
+		err = reversibleBatches.DeleteNestedBucket(batchID[:])
+		if err != nil && err != kvdb.ErrBucketNotFound {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// RevertBatch reverts the completed batch with the given ID after its
+// transaction was reorged out of the chain. The accounts and orders are reset
+// to the state they had before the batch was applied, while their current
+// state is staged again as the batch's pending updates. The batch snapshot is
+// moved back to the pending batches, so the batch can be completed again once
+// its transaction re-confirms. If a later batch is still reversible, it needs
+// to be reverted first and ErrRevertOutOfOrder is returned.
+func (db *DB) RevertBatch(batchID order.BatchID) error {
+	err := db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, batchBucketKey)
+		if err != nil {
+			return err
+		}
+
+		batchIDs, err := reversibleBatchIDs(bucket)
+		if err != nil {
+			return err
+		}
+		if len(batchIDs) == 0 {
+			return ErrNoReversibleBatch
+		}
+		if batchIDs[len(batchIDs)-1] != batchID {
+			for _, id := range batchIDs {
+				if id == batchID {
+					return ErrRevertOutOfOrder
+				}
+			}
+			return ErrNoReversibleBatch
+		}
+
+		reversibleBatches := bucket.NestedReadWriteBucket(
+			reversibleBatchesBucketKey,
+		)
+		reversible := reversibleBatches.NestedReadWriteBucket(
+			batchID[:],
+		)
+
+		err = revertBatchUpdates(tx, reversible, batchID)
+		if err != nil {
+			return err
+		}
+		if err := unfinalizeBatchSnapshot(tx, batchID); err != nil {
+			return err
+		}
+
+		// The batch is pending again. We only make it the most recent
+		// pending batch if we're not already part of a newer one.
+		if bucket.Get(pendingBatchIDKey) == nil {
+			err := bucket.Put(pendingBatchIDKey, batchID[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		return reversibleBatches.DeleteNestedBucket(batchID[:])
+	})
+	if err != nil {
+		return err
+	}
+
+	// The batch's account updates were reverted, so the account
+	// subscribers need to know about them.
+	db.notifyAccountUpdate()
+
+	return nil
+}
+
+// revertBatchUpdates stages the current state of all accounts and orders that
+// were updated by the batch with the given ID as its pending updates and
+// restores their state from before the batch.
+func revertBatchUpdates(tx kvdb.RwTx, reversible kvdb.RwBucket,
+	batchID order.BatchID) error {
+
+	bucket, err := getBucket(tx, batchBucketKey)
+	if err != nil {
+		return err
+	}
+	pendingBatches, err := getNestedBucket(
+		bucket, pendingBatchesBucketKey, true,
+	)
+	if err != nil {
+		return err
+	}
+	err = pendingBatches.DeleteNestedBucket(batchID[:])
+	if err != nil && err != kvdb.ErrBucketNotFound {
+		return err
+	}
+	batchBucket, err := pendingBatches.CreateBucket(batchID[:])
+	if err != nil {
+		return err
+	}
+
+	pendingAccounts, err := batchBucket.CreateBucket(
+		pendingBatchAccountsBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+	prevAccounts, err := getNestedBucket(
+		reversible, prevAccountsBucketKey, false,
+	)
+	if err != nil {
+		return err
+	}
+	accounts, err := getBucket(tx, accountBucketKey)
+	if err != nil {
+		return err
+	}
+	err = prevAccounts.ForEach(func(k, v []byte) error {
+		// Filter out any keys that are not for accounts.
+		if len(k) != 33 {
+			return nil
+		}
+
+		_, err := updateAccount(accounts, pendingAccounts, k, nil)
+		if err != nil {
+			return err
+		}
+
+		_, err = updateAccount(prevAccounts, accounts, k, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	pendingOrders, err := batchBucket.CreateBucket(
+		pendingBatchOrdersBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+	prevOrders, err := getNestedBucket(
+		reversible, prevOrdersBucketKey, false,
+	)
+	if err != nil {
+		return err
+	}
+	orders, err := getBucket(tx, ordersBucketKey)
+	if err != nil {
+		return err
+	}
+	return prevOrders.ForEach(func(k, v []byte) error {
+		// Filter out any keys that are not nonces.
+		var nonce order.Nonce
+		if len(k) != len(nonce) {
+			return nil
+		}
+		copy(nonce[:], k)
+
+		o, err := copyOrder(orders, pendingOrders, nonce)
+		if err != nil {
+			return err
+		}
+
+		prev, err := copyOrder(prevOrders, orders, nonce)
+		if err != nil {
+			return err
+		}
+
+		if o.Details().State != prev.Details().State {
+			return indexOrderStateTX(tx, prev)
+		}
+
+		return nil
+	})
+}
+
+// unfinalizeBatchSnapshot moves the snapshot of the completed batch with the
+// given ID back into the pending snapshots. It is the inverse of
+// finalizeBatchSnapshot.
+func unfinalizeBatchSnapshot(tx kvdb.RwTx, batchID order.BatchID) error {
+	topBucket, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
+	if err != nil {
+		return err
+	}
+
+	// Values are only valid until they are deleted, so we need to copy
+	// them first.
+	seqBytes := append([]byte(nil), indexBucket.Get(batchID[:])...)
+	if len(seqBytes) == 0 {
+		return ErrNoReversibleBatch
+	}
+	snapshotBucket, err := getNestedBucket(seqBucket, seqBytes, false)
+	if err != nil {
+		return err
+	}
+	rawSnapshot := append(
+		[]byte(nil), snapshotBucket.Get(batchSnapshotBatchKey)...,
+	)
+
+	if err := seqBucket.DeleteNestedBucket(seqBytes); err != nil {
+		return err
+	}
+	if err := indexBucket.Delete(batchID[:]); err != nil {
+		return err
+	}
+
+	pendingBucket, err := getNestedBucket(
+		topBucket, batchSnapshotPendingBucketKey, true,
+	)
+	if err != nil {
+		return err
+	}
+
+	return pendingBucket.Put(batchID[:], rawSnapshot)
+}
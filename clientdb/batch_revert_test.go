@@ -0,0 +1,180 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRevertBatch makes sure a completed batch can be reverted to the state
+// from before it was applied and completed again afterwards.
+func TestRevertBatch(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	acct := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateOpen,
+		HeightHint:    1,
+		LatestTx:      testBatchTx,
+	}
+	ask := &order.Ask{
+		Kit: *dummyOrder(900000, 1337),
+	}
+	ask.State = order.StateSubmitted
+	bid := &order.Bid{
+		Kit: *dummyOrder(900000, 1337),
+	}
+	bid.State = order.StateSubmitted
+
+	require.NoError(t, db.AddAccount(acct))
+	require.NoError(t, db.SubmitOrder(ask))
+	require.NoError(t, db.SubmitOrder(bid))
+
+	// storeBatch stages a batch that partially fills the bid and modifies
+	// the account. If the ask is still active, the batch fully executes
+	// it as well.
+	storeBatch := func(batch *order.Batch, value btcutil.Amount,
+		withAsk bool) {
+
+		t.Helper()
+
+		nonces := []order.Nonce{bid.Nonce()}
+		modifiers := [][]order.Modifier{{
+			order.StateModifier(order.StatePartiallyFilled),
+			order.UnitsFulfilledModifier(21),
+		}}
+		if withAsk {
+			nonces = append(nonces, ask.Nonce())
+			modifiers = append(modifiers, []order.Modifier{
+				order.StateModifier(order.StateExecuted),
+				order.UnitsFulfilledModifier(0),
+			})
+		}
+
+		err := db.StorePendingBatch(
+			batch, nonces, modifiers,
+			[]*account.Account{acct}, [][]account.Modifier{{
+				account.StateModifier(account.StatePendingBatch),
+				account.ValueModifier(value),
+			}},
+		)
+		require.NoError(t, err)
+	}
+
+	// assertState makes sure the account and orders are in the state
+	// from before the batch or after it.
+	assertState := func(applied bool) {
+		t.Helper()
+
+		var (
+			askState, bidState = order.StateSubmitted,
+				order.StateSubmitted
+			askUnits, bidUnits = ask.UnitsUnfulfilled,
+				bid.UnitsUnfulfilled
+			acctState = account.StateOpen
+			acctValue = acct.Value
+		)
+		if applied {
+			askState, bidState = order.StateExecuted,
+				order.StatePartiallyFilled
+			askUnits, bidUnits = 0, 21
+			acctState = account.StatePendingBatch
+			acctValue = btcutil.SatoshiPerBitcoin / 2
+		}
+
+		dbAsk, err := db.GetOrder(ask.Nonce())
+		require.NoError(t, err)
+		require.Equal(t, askState, dbAsk.Details().State)
+		require.Equal(t, askUnits, dbAsk.Details().UnitsUnfulfilled)
+
+		dbBid, err := db.GetOrder(bid.Nonce())
+		require.NoError(t, err)
+		require.Equal(t, bidState, dbBid.Details().State)
+		require.Equal(t, bidUnits, dbBid.Details().UnitsUnfulfilled)
+
+		dbAcct, err := db.Account(acct.TraderKey.PubKey)
+		require.NoError(t, err)
+		require.Equal(t, acctState, dbAcct.State)
+		require.Equal(t, acctValue, dbAcct.Value)
+	}
+
+	storeBatch(testBatch, btcutil.SatoshiPerBitcoin/2, true)
+	require.NoError(t, db.MarkBatchComplete(testBatchID))
+	assertState(true)
+
+	batchIDs, err := db.ReversibleBatches()
+	require.NoError(t, err)
+	require.Equal(t, []order.BatchID{testBatchID}, batchIDs)
+
+	// Reverting the batch must bring back the previous state and make the
+	// batch pending again.
+	require.NoError(t, db.RevertBatch(testBatchID))
+	assertState(false)
+
+	_, err = db.GetLocalBatchSnapshot(testBatchID)
+	require.Error(t, err)
+	snapshot, err := db.PendingBatchSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, testBatchID, snapshot.BatchID)
+
+	batchIDs, err = db.ReversibleBatches()
+	require.NoError(t, err)
+	require.Empty(t, batchIDs)
+	require.ErrorIs(t, db.RevertBatch(testBatchID), ErrNoReversibleBatch)
+
+	// Once the batch transaction re-confirms, the staged updates can be
+	// applied again.
+	require.NoError(t, db.MarkBatchComplete(testBatchID))
+	assertState(true)
+
+	snapshot, err = db.GetLocalBatchSnapshot(testBatchID)
+	require.NoError(t, err)
+	require.Equal(t, testBatchID, snapshot.BatchID)
+	_, err = db.PendingBatchSnapshot()
+	require.ErrorIs(t, err, account.ErrNoPendingBatch)
+
+	// A batch that was completed after the first one depends on it, so it
+	// needs to be reverted first. The ask was fully executed by the first
+	// batch, so it can't be part of the second one.
+	secondBatchID := order.BatchID{0x04, 0x05, 0x06}
+	secondBatch := &order.Batch{
+		ID:           secondBatchID,
+		ExecutionFee: testBatch.ExecutionFee,
+		BatchTX: &wire.MsgTx{
+			Version: 2,
+			TxIn: []*wire.TxIn{{
+				PreviousOutPoint: wire.OutPoint{
+					Hash: testBatchTx.TxHash(),
+				},
+			}},
+		},
+	}
+	storeBatch(secondBatch, 0, false)
+	require.NoError(t, db.MarkBatchComplete(secondBatchID))
+
+	batchIDs, err = db.ReversibleBatches()
+	require.NoError(t, err)
+	require.Equal(
+		t, []order.BatchID{testBatchID, secondBatchID}, batchIDs,
+	)
+	require.ErrorIs(t, db.RevertBatch(testBatchID), ErrRevertOutOfOrder)
+
+	// Once a batch is buried deep enough, it can't be reverted anymore.
+	require.NoError(t, db.DeleteReversibleBatch(secondBatchID))
+	require.ErrorIs(t, db.RevertBatch(secondBatchID), ErrNoReversibleBatch)
+
+	require.NoError(t, db.RevertBatch(testBatchID))
+	assertState(false)
+}
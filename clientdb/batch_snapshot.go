@@ -5,44 +5,61 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sort"
+	"time"
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
-	"go.etcd.io/bbolt"
 )
 
 // batch-snapshot-bucket
-//         |
-//         |-- batch-snapshot-pending-key: <batch snapshot>
-//         |
-//         |-- batch-snapshot-seq-bucket
-//         |              |
-//         |              |-- <sequence num>
-//         |              |        |
-//         |              |        |-- batch-snapshot-batch: <batch snapshot>
-//         |              |
-//         |              |-- <sequence num>
-//         |              |        |
-//         |             ...      ...
-//         |
-//         |-- batch-snapshot-batchid-index-bucket
-//                       |
-//                       |-- <batch id>: <sequence-num>
-//                       |-- <batch id>: <sequence-num>
-//                       |
-//                      ...
+//
+//	|
+//	|-- batch-snapshot-pending-bucket
+//	|              |
+//	|              |-- <batch id>: <batch snapshot>
+//	|              |-- <batch id>: <batch snapshot>
+//	|              |
+//	|             ...
+//	|
+//	|-- batch-snapshot-seq-bucket
+//	|              |
+//	|              |-- <sequence num>
+//	|              |        |
+//	|              |        |-- batch-snapshot-batch: <batch snapshot>
+//	|              |
+//	|              |-- <sequence num>
+//	|              |        |
+//	|             ...      ...
+//	|
+//	|-- batch-snapshot-batchid-index-bucket
+//	|              |
+//	|              |-- <batch id>: <sequence-num>
+//	|              |-- <batch id>: <sequence-num>
+//	|              |
+//	|             ...
+//	|
+//	|-- batch-snapshot-approval-bucket
+//	               |
+//	               |-- <batch id>: <approval record>
+//	               |-- <batch id>: <approval record>
+//	               |
+//	              ...
 var (
 	// batchSnapshotBucketKey is the top level bucket where we'll find
 	// snapshot information about all batches we have participated in.
 	batchSnapshotBucketKey = []byte("batch-snapshot-bucket")
 
-	// batchSnapshotPendingKey is a key where we will store the snapshot
-	// for a pending batch. When the batch has been finalizd we'll move it
-	// into a sub-bucket for long-term record keeping.
-	batchSnapshotPendingKey = []byte("batch-snapshot-pending")
+	// batchSnapshotPendingBucketKey is a sub-bucket where we will store
+	// the snapshots of all pending batches, keyed by their batch ID. When
+	// a batch has been finalized we'll move its snapshot into a sub-bucket
+	// for long-term record keeping.
+	batchSnapshotPendingBucketKey = []byte("batch-snapshot-pending-bucket")
 
 	// batchSnapshotSeqBucketKey is a sub-bucket where we'll store batch
 	// snapshots indexed by sequence number.
@@ -137,9 +154,9 @@ func NewSnapshot(batch *order.Batch, ourOrders []order.Order,
 // participated in.
 func (db *DB) GetLocalBatchSnapshots() ([]*LocalBatchSnapshot, error) {
 	var snapshots []*LocalBatchSnapshot
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.RTx) error {
 		var err error
-		snapshots, err = db.fetchLocalBatchSnapshots(tx)
+		snapshots, err = fetchLocalBatchSnapshots(tx)
 		return err
 	})
 	if err != nil {
@@ -154,42 +171,106 @@ func (db *DB) GetLocalBatchSnapshot(id order.BatchID) (
 	*LocalBatchSnapshot, error) {
 
 	var snapshot *LocalBatchSnapshot
-	err := db.View(func(tx *bbolt.Tx) error {
-		_, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
+	err := db.View(func(tx kvdb.RTx) error {
+		var err error
+		snapshot, err = fetchLocalBatchSnapshotByID(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// fetchLocalBatchSnapshotByID fetches the batch snapshot for the given batch ID
+// within a database transaction.
+func fetchLocalBatchSnapshotByID(tx kvdb.RTx,
+	id order.BatchID) (*LocalBatchSnapshot, error) {
+
+	_, seqBucket, indexBucket, err := getSnapshotReadBuckets(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	seq := indexBucket.Get(id[:])
+	if seq == nil {
+		return nil, fmt.Errorf("snapshot of batch %x not found", id[:])
+	}
+	rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchLocalBatchSnapshot(seqBucket, seq, rootOrderBucket)
+}
+
+// GetLocalBatchSnapshotsFrom returns at most count snapshots of batches the
+// trader has participated in, starting at the batch with the given ID and going
+// back in history from there. If the start ID is the zero batch ID, the most
+// recently finalized batch is used as the starting point. A count of zero
+// returns all snapshots up to and including the start batch.
+func (db *DB) GetLocalBatchSnapshotsFrom(start order.BatchID,
+	count uint32) ([]*LocalBatchSnapshot, error) {
+
+	var snapshots []*LocalBatchSnapshot
+	err := db.View(func(tx kvdb.RTx) error {
+		_, seqBucket, indexBucket, err := getSnapshotReadBuckets(tx)
 		if err != nil {
 			return err
 		}
-
-		seq := indexBucket.Get(id[:])
-		if seq == nil {
-			return fmt.Errorf("snapshot of batch %x not found",
-				id[:])
-		}
-		rootOrderBucket, err := getBucket(tx, ordersBucketKey)
+		rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
 
-		snapshot, err = fetchLocalBatchSnapshot(
-			seqBucket, seq, rootOrderBucket,
-		)
-		return err
+		// The sequence numbers are stored big endian, so we can walk
+		// the sequence bucket backwards to go back in history.
+		cursor := seqBucket.ReadCursor()
+		var seq []byte
+		if start == zeroBatchID {
+			seq, _ = cursor.Last()
+		} else {
+			startSeq := indexBucket.Get(start[:])
+			if startSeq == nil {
+				return fmt.Errorf("snapshot of batch %x not "+
+					"found", start[:])
+			}
+			seq, _ = cursor.Seek(startSeq)
+		}
+
+		for ; seq != nil; seq, _ = cursor.Prev() {
+			if count > 0 && uint32(len(snapshots)) >= count {
+				return nil
+			}
+
+			batchSnapshot, err := fetchLocalBatchSnapshot(
+				seqBucket, seq, rootOrderBucket,
+			)
+			if err != nil {
+				return err
+			}
+			snapshots = append(snapshots, batchSnapshot)
+		}
+
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return snapshot, nil
+	return snapshots, nil
 }
 
-func (db *DB) fetchLocalBatchSnapshots(tx *bbolt.Tx) ([]*LocalBatchSnapshot,
-	error) {
+// fetchLocalBatchSnapshots returns snapshots for all batches the trader has
+// participated in within a database transaction.
+func fetchLocalBatchSnapshots(tx kvdb.RTx) ([]*LocalBatchSnapshot, error) {
 
-	_, seqBucket, _, err := getSnapshotBuckets(tx)
+	_, seqBucket, _, err := getSnapshotReadBuckets(tx)
 	if err != nil {
 		return nil, err
 	}
-	rootOrderBucket, err := getBucket(tx, ordersBucketKey)
+	rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
 	if err != nil {
 		return nil, err
 	}
@@ -216,10 +297,10 @@ func (db *DB) fetchLocalBatchSnapshots(tx *bbolt.Tx) ([]*LocalBatchSnapshot,
 	return snapshots, nil
 }
 
-func fetchLocalBatchSnapshot(seqBucket *bbolt.Bucket, seqNum []byte,
-	rootOrderBucket *bbolt.Bucket) (*LocalBatchSnapshot, error) {
+func fetchLocalBatchSnapshot(seqBucket kvdb.RBucket, seqNum []byte,
+	rootOrderBucket kvdb.RBucket) (*LocalBatchSnapshot, error) {
 
-	snapshotBucket, err := getNestedBucket(seqBucket, seqNum, false)
+	snapshotBucket, err := getNestedReadBucket(seqBucket, seqNum)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +322,8 @@ func fetchLocalBatchSnapshot(seqBucket *bbolt.Bucket, seqNum []byte,
 	// The snapshot doesn't contain all the order information needed, so
 	// we'll retrieve the missing data.
 	for nonce, o := range batchSnapshot.Orders {
-		orderBucket, err := getNestedBucket(
-			rootOrderBucket, nonce[:], false,
+		orderBucket, err := getNestedReadBucket(
+			rootOrderBucket, nonce[:],
 		)
 		if err != nil {
 			return nil, ErrNoOrder
@@ -299,33 +380,82 @@ func fetchLocalBatchSnapshot(seqBucket *bbolt.Bucket, seqNum []byte,
 	return batchSnapshot, nil
 }
 
-func storePendingBatchSnapshot(tx *bbolt.Tx,
+// batchSnapshotOrderNonces returns the nonces of all our orders that are
+// referenced by the snapshot of any finalized batch within a database
+// transaction.
+func batchSnapshotOrderNonces(tx kvdb.RTx) (map[order.Nonce]struct{},
+	error) {
+
+	_, seqBucket, _, err := getSnapshotReadBuckets(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonces := make(map[order.Nonce]struct{})
+	err = seqBucket.ForEach(func(seq, _ []byte) error {
+		batchSnapshot, err := fetchRawLocalBatchSnapshot(seqBucket, seq)
+		if err != nil {
+			return err
+		}
+
+		for nonce := range batchSnapshot.Orders {
+			nonces[nonce] = struct{}{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nonces, nil
+}
+
+// storePendingBatchSnapshot stores the snapshot of a pending batch under its
+// batch ID. An existing pending snapshot with the same ID is overwritten.
+func storePendingBatchSnapshot(tx kvdb.RwTx,
 	snapshot *LocalBatchSnapshot) error {
 
 	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
 		return err
 	}
+	pendingBucket, err := getNestedBucket(
+		topBucket, batchSnapshotPendingBucketKey, true,
+	)
+	if err != nil {
+		return err
+	}
 
 	buf := bytes.Buffer{}
 	if err := serializeLocalBatchSnapshot(&buf, snapshot); err != nil {
 		return err
 	}
 
-	// Store the batch under the pending key, we'll move it when it is
+	// Store the batch in the pending bucket, we'll move it when it is
 	// finalized.
-	return topBucket.Put(batchSnapshotPendingKey, buf.Bytes())
+	return pendingBucket.Put(snapshot.BatchID[:], buf.Bytes())
 }
 
-// fetchPendingBatchSnapshot retrieves the currently pending batch snapshot from
-// the database or returns the account.ErrNoPendingBatch error if none exists.
-func fetchPendingBatchSnapshot(tx *bbolt.Tx) (*LocalBatchSnapshot, error) {
-	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
+// fetchPendingBatchSnapshot retrieves the snapshot of the pending batch with
+// the given ID from the database or returns the account.ErrNoPendingBatch
+// error if none exists.
+func fetchPendingBatchSnapshot(tx kvdb.RTx,
+	batchID order.BatchID) (*LocalBatchSnapshot, error) {
+
+	topBucket, err := getReadBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
 		return nil, err
 	}
 
-	snapshotBytes := topBucket.Get(batchSnapshotPendingKey)
+	pendingBucket := topBucket.NestedReadBucket(
+		batchSnapshotPendingBucketKey,
+	)
+	if pendingBucket == nil {
+		return nil, account.ErrNoPendingBatch
+	}
+
+	snapshotBytes := pendingBucket.Get(batchID[:])
 	if len(snapshotBytes) == 0 {
 		return nil, account.ErrNoPendingBatch
 	}
@@ -333,20 +463,27 @@ func fetchPendingBatchSnapshot(tx *bbolt.Tx) (*LocalBatchSnapshot, error) {
 	return deserializeLocalBatchSnapshot(bytes.NewReader(snapshotBytes))
 }
 
-// finalizeBatchSnapshot moves the pending batch snapshot into the sub-bucket
-// indexed by sequence numbers.
-func finalizeBatchSnapshot(tx *bbolt.Tx, batchID order.BatchID) error {
+// finalizeBatchSnapshot moves the snapshot of the pending batch with the given
+// ID into the sub-bucket indexed by sequence numbers.
+func finalizeBatchSnapshot(tx kvdb.RwTx, batchID order.BatchID) error {
 	topBucket, seqBucket, indexBucket, err := getSnapshotBuckets(tx)
 	if err != nil {
 		return err
 	}
 
-	rawSnapshot := topBucket.Get(batchSnapshotPendingKey)
+	pendingBucket := topBucket.NestedReadWriteBucket(
+		batchSnapshotPendingBucketKey,
+	)
+	if pendingBucket == nil {
+		return fmt.Errorf("pending snapshot not found")
+	}
+
+	rawSnapshot := pendingBucket.Get(batchID[:])
 	if rawSnapshot == nil {
 		return fmt.Errorf("pending snapshot not found")
 	}
 
-	err = topBucket.Delete(batchSnapshotPendingKey)
+	err = pendingBucket.Delete(batchID[:])
 	if err != nil {
 		return err
 	}
@@ -379,8 +516,10 @@ func finalizeBatchSnapshot(tx *bbolt.Tx, batchID order.BatchID) error {
 	return nil
 }
 
-func getSnapshotBuckets(tx *bbolt.Tx) (*bbolt.Bucket, *bbolt.Bucket,
-	*bbolt.Bucket, error) {
+// getSnapshotBuckets returns the top level batch snapshot bucket together with
+// its sequence and batch ID index sub-buckets.
+func getSnapshotBuckets(tx kvdb.RwTx) (kvdb.RwBucket, kvdb.RwBucket,
+	kvdb.RwBucket, error) {
 
 	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
@@ -406,6 +545,34 @@ func getSnapshotBuckets(tx *bbolt.Tx) (*bbolt.Bucket, *bbolt.Bucket,
 	return topBucket, seqBucket, indexBucket, nil
 }
 
+// getSnapshotReadBuckets is the read-only version of getSnapshotBuckets.
+func getSnapshotReadBuckets(tx kvdb.RTx) (kvdb.RBucket, kvdb.RBucket,
+	kvdb.RBucket, error) {
+
+	topBucket, err := getReadBucket(tx, batchSnapshotBucketKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Get the sub-buckets. We expect them to be created at DB init, so we
+	// don't attempt to create them if non-existent.
+	seqBucket, err := getNestedReadBucket(
+		topBucket, batchSnapshotSeqBucketKey,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	indexBucket, err := getNestedReadBucket(
+		topBucket, batchSnapshotBatchIDIndexBucketKey,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return topBucket, seqBucket, indexBucket, nil
+}
+
 func serializeLocalBatchSnapshot(w *bytes.Buffer, b *LocalBatchSnapshot) error {
 	// The previous batch versions had a single clearing price but because
 	// we now always store the price map afterwards, we signal a new batch
@@ -470,6 +637,25 @@ func serializeLocalBatchSnapshot(w *bytes.Buffer, b *LocalBatchSnapshot) error {
 		}
 	}
 
+	// The additional data of the matched orders, like whether a bid asked
+	// for a zero conf channel, is appended as one length prefixed tlv
+	// stream per match, in the same order as the matches above.
+	for _, m := range matchedOrders {
+		var tlvData bytes.Buffer
+		err := serializeOrderTlvData(&tlvData, m.match.Order)
+		if err != nil {
+			return err
+		}
+
+		err = WriteElements(w, uint32(tlvData.Len()))
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(tlvData.Bytes()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -503,6 +689,7 @@ func deserializeLocalBatchSnapshot(r io.Reader) (*LocalBatchSnapshot, error) {
 	}
 
 	b.MatchedOrders = make(map[order.Nonce][]*order.MatchedOrder)
+	matches := make([]*order.MatchedOrder, 0, numMatches)
 	for i := uint32(0); i < numMatches; i++ {
 		nonce, m, err := deserializeMatchedOrder(r)
 		if err != nil {
@@ -510,6 +697,7 @@ func deserializeLocalBatchSnapshot(r io.Reader) (*LocalBatchSnapshot, error) {
 		}
 
 		b.MatchedOrders[nonce] = append(b.MatchedOrders[nonce], m)
+		matches = append(matches, m)
 	}
 
 	// Older batches had a single clearing price instead of a map. If we
@@ -540,6 +728,30 @@ func deserializeLocalBatchSnapshot(r io.Reader) (*LocalBatchSnapshot, error) {
 		b.ClearingPrices[duration] = price
 	}
 
+	// Snapshots that were stored before the additional data of the
+	// matched orders was added end here.
+	for idx, m := range matches {
+		var tlvLen uint32
+		err := ReadElements(r, &tlvLen)
+		switch {
+		case err == io.EOF && idx == 0:
+			return b, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		tlvData := make([]byte, tlvLen)
+		if _, err := io.ReadFull(r, tlvData); err != nil {
+			return nil, err
+		}
+
+		err = deserializeOrderTlvData(bytes.NewReader(tlvData), m.Order)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return b, nil
 }
 
@@ -693,12 +905,188 @@ func deserializeMatchedOrder(r io.Reader) (order.Nonce,
 	return ourNonce, m, nil
 }
 
-// deletePendingSnapshot deletes the pending batch snapshot.
-func deletePendingSnapshot(tx *bbolt.Tx) error {
+// deletePendingSnapshots deletes the snapshots of all pending batches.
+func deletePendingSnapshots(tx kvdb.RwTx) error {
 	topBucket, err := getBucket(tx, batchSnapshotBucketKey)
 	if err != nil {
 		return err
 	}
 
-	return topBucket.Delete(batchSnapshotPendingKey)
+	err = topBucket.DeleteNestedBucket(batchSnapshotPendingBucketKey)
+	if err != nil && err != kvdb.ErrBucketNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// BatchSnapshotFilter restricts the batch snapshots returned by
+// Snapshot.FilteredLocalBatchSnapshots. The zero value doesn't filter at all.
+type BatchSnapshotFilter struct {
+	// BatchIDs, if set, only returns the snapshots of these batches.
+	BatchIDs []order.BatchID
+
+	// Accounts, if set, only returns the snapshots of batches at least one
+	// of these accounts participated in.
+	Accounts map[[33]byte]struct{}
+
+	// StartTime, if set, only returns the snapshots of batches that were
+	// finalized at or after this time.
+	StartTime time.Time
+
+	// EndTime, if set, only returns the snapshots of batches that were
+	// finalized before this time.
+	EndTime time.Time
+}
+
+// matches returns true if the given snapshot of a batch that was finalized at
+// the given time satisfies all criteria of the filter, except for the batch
+// IDs which are looked up directly.
+func (f BatchSnapshotFilter) matches(snapshot *LocalBatchSnapshot,
+	finalizedAt time.Time) bool {
+
+	// Batches we don't know the finalization time of can't be part of
+	// any time range.
+	switch {
+	case !f.StartTime.IsZero() &&
+		(finalizedAt.IsZero() || finalizedAt.Before(f.StartTime)):
+
+		return false
+
+	case !f.EndTime.IsZero() &&
+		(finalizedAt.IsZero() || !finalizedAt.Before(f.EndTime)):
+
+		return false
+	}
+
+	if len(f.Accounts) == 0 {
+		return true
+	}
+	for acctKey := range snapshot.Accounts {
+		if _, ok := f.Accounts[acctKey]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimedBatchSnapshot is the snapshot of a finalized batch together with the
+// time the batch was finalized at.
+type TimedBatchSnapshot struct {
+	*LocalBatchSnapshot
+
+	// FinalizedAt is the time the batch was finalized at. The snapshots
+	// themselves don't carry a timestamp, so it is derived from the order
+	// update events that are recorded once a batch is final. It is zero
+	// for batches that were finalized before the batch ID was recorded in
+	// these events.
+	FinalizedAt time.Time
+}
+
+// fetchFilteredBatchSnapshots returns the snapshots of all finalized batches
+// that match the given filter within a database transaction. The snapshots
+// are returned in the order the batches were finalized, oldest first.
+func fetchFilteredBatchSnapshots(tx kvdb.RTx,
+	filter BatchSnapshotFilter) ([]*TimedBatchSnapshot, error) {
+
+	_, seqBucket, indexBucket, err := getSnapshotReadBuckets(tx)
+	if err != nil {
+		return nil, err
+	}
+	rootOrderBucket, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	finalizeTimes, err := batchFinalizeTimes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sequence numbers are stored big endian, so their byte order is
+	// the order the batches were finalized in.
+	var seqNums [][]byte
+	if len(filter.BatchIDs) == 0 {
+		err := seqBucket.ForEach(func(k, v []byte) error {
+			// The snapshots are stored in sub buckets, so we skip
+			// any plain values.
+			if v == nil {
+				seqNums = append(seqNums, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		seen := make(map[order.BatchID]struct{}, len(filter.BatchIDs))
+		for _, batchID := range filter.BatchIDs {
+			if _, ok := seen[batchID]; ok {
+				continue
+			}
+			seen[batchID] = struct{}{}
+
+			seqNum := indexBucket.Get(batchID[:])
+			if seqNum == nil {
+				return nil, fmt.Errorf("snapshot of batch %x "+
+					"not found", batchID[:])
+			}
+			seqNums = append(seqNums, seqNum)
+		}
+
+		sort.Slice(seqNums, func(i, j int) bool {
+			return bytes.Compare(seqNums[i], seqNums[j]) < 0
+		})
+	}
+
+	var snapshots []*TimedBatchSnapshot
+	for _, seqNum := range seqNums {
+		snapshot, err := fetchLocalBatchSnapshot(
+			seqBucket, seqNum, rootOrderBucket,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		finalizedAt := finalizeTimes[snapshot.BatchID]
+		if !filter.matches(snapshot, finalizedAt) {
+			continue
+		}
+
+		snapshots = append(snapshots, &TimedBatchSnapshot{
+			LocalBatchSnapshot: snapshot,
+			FinalizedAt:        finalizedAt,
+		})
+	}
+
+	return snapshots, nil
+}
+
+// batchFinalizeTimes returns the time each batch was finalized at, which is
+// the time of the earliest order update event that references the batch.
+func batchFinalizeTimes(tx kvdb.RTx) (map[order.BatchID]time.Time, error) {
+	events, err := getEventsTX(tx, func(_ time.Time, t event.Type) bool {
+		return t == event.TypeOrderStateChange
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	finalizeTimes := make(map[order.BatchID]time.Time)
+	for _, evt := range events {
+		updateEvt, ok := evt.(*UpdatedEvent)
+		if !ok || updateEvt.BatchID == nil {
+			continue
+		}
+
+		batchID := *updateEvt.BatchID
+		ts, ok := finalizeTimes[batchID]
+		if !ok || evt.Timestamp().Before(ts) {
+			finalizeTimes[batchID] = evt.Timestamp()
+		}
+	}
+
+	return finalizeTimes, nil
 }
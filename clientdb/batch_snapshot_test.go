@@ -6,14 +6,15 @@ import (
 	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/stretchr/testify/require"
-	"go.etcd.io/bbolt"
 )
 
 var (
@@ -85,7 +86,11 @@ var (
 		testNonce2: {
 			{
 				Order: &order.Bid{
-					Kit: *dummyOrder(50000, 144),
+					Kit: func() order.Kit {
+						kit := dummyOrder(50000, 144)
+						kit.ZeroConfChannel = true
+						return *kit
+					}(),
 				},
 				MultiSigKey: [33]byte{1, 2, 3},
 				NodeKey:     [33]byte{1, 2, 3},
@@ -207,7 +212,7 @@ func TestGetLocalBatchSnapshots(t *testing.T) {
 	// Store the same batch 10 times, only changing the batch ID each time.
 	for i := 0; i < 10; i++ {
 		i := i
-		err := store.Update(func(tx *bbolt.Tx) error {
+		err := store.Update(func(tx kvdb.RwTx) error {
 			testSnapshot.BatchID[0] = byte(i)
 			err := storePendingBatchSnapshot(tx, testSnapshot)
 			if err != nil {
@@ -235,14 +240,14 @@ func TestGetLocalBatchSnapshots(t *testing.T) {
 	// Store and delete a pending snapshot, and make sure all other batches are
 	// still there.
 	testSnapshot.BatchID[0] = byte(99)
-	err = store.Update(func(tx *bbolt.Tx) error {
+	err = store.Update(func(tx kvdb.RwTx) error {
 		return storePendingBatchSnapshot(tx, testSnapshot)
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	err = store.Update(deletePendingSnapshot)
+	err = store.Update(deletePendingSnapshots)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -278,4 +283,191 @@ func TestGetLocalBatchSnapshots(t *testing.T) {
 		t.Fatalf("mismatch: %v vs %v",
 			spew.Sdump(testSnapshot), spew.Sdump(snapshot))
 	}
+
+	// We should also be able to go back in history, starting at a specific
+	// batch.
+	snapshots, err = store.GetLocalBatchSnapshotsFrom(id, 3)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+	for i, snapshot := range snapshots {
+		require.EqualValues(t, 4-i, snapshot.BatchID[0])
+	}
+
+	// Asking for more batches than there are should stop at the first
+	// batch.
+	snapshots, err = store.GetLocalBatchSnapshotsFrom(id, 100)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 5)
+
+	// Without a start ID we start at the most recent batch.
+	snapshots, err = store.GetLocalBatchSnapshotsFrom(zeroBatchID, 0)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 10)
+	require.EqualValues(t, 9, snapshots[0].BatchID[0])
+
+	// An unknown start batch should result in an error.
+	id[0] = 42
+	_, err = store.GetLocalBatchSnapshotsFrom(id, 1)
+	require.Error(t, err)
+}
+
+// TestFilteredLocalBatchSnapshots makes sure batch snapshots can be filtered by
+// batch ID, account and the time the batch was finalized at and are returned
+// in the order they were finalized.
+func TestFilteredLocalBatchSnapshots(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	bid := &order.Bid{Kit: *dummyOrder(5_000_000, 144)}
+	require.NoError(t, store.SubmitOrder(bid))
+
+	acctA, acctB := [33]byte{0x0a}, [33]byte{0x0b}
+	newSnapshot := func(id byte, acctKey [33]byte) *LocalBatchSnapshot {
+		return &LocalBatchSnapshot{
+			Version:      order.DefaultBatchVersion,
+			BatchID:      order.BatchID{id},
+			ExecutionFee: *terms.NewLinearFeeSchedule(1, 100),
+			BatchTX:      testBatchTx,
+			Accounts: map[[33]byte]*account.Account{
+				acctKey: testAccount,
+			},
+			Orders: map[order.Nonce]order.Order{
+				bid.Nonce(): bid,
+			},
+			MatchedOrders: map[order.Nonce][]*order.MatchedOrder{},
+		}
+	}
+
+	// finalizeBatch finalizes the given batch and records the order
+	// update event that references it at the given time.
+	finalizeBatch := func(snapshot *LocalBatchSnapshot, ts time.Time) {
+		err := store.Update(func(tx kvdb.RwTx) error {
+			err := storePendingBatchSnapshot(tx, snapshot)
+			if err != nil {
+				return err
+			}
+			err = finalizeBatchSnapshot(tx, snapshot.BatchID)
+			if err != nil {
+				return err
+			}
+
+			if ts.IsZero() {
+				return nil
+			}
+
+			ordersBucket, err := getBucket(tx, ordersBucketKey)
+			if err != nil {
+				return err
+			}
+
+			nonce := bid.Nonce()
+			batchID := snapshot.BatchID
+			return storeEventTX(
+				ordersBucket.NestedReadWriteBucket(nonce[:]),
+				&UpdatedEvent{
+					timestamp: ts,
+					nonce:     nonce,
+					BatchID:   &batchID,
+				},
+			)
+		})
+		require.NoError(t, err)
+	}
+
+	// The first batch was finalized before the batch ID was recorded in
+	// the order update events, so we don't know when it was finalized.
+	now := time.Now()
+	batch1, batch2, batch3 := newSnapshot(1, acctA), newSnapshot(2, acctB),
+		newSnapshot(3, acctA)
+	finalizeBatch(batch1, time.Time{})
+	finalizeBatch(batch2, now.Add(-2*time.Hour))
+	finalizeBatch(batch3, now)
+
+	filterBatches := func(filter BatchSnapshotFilter) []order.BatchID {
+		t.Helper()
+
+		var batchIDs []order.BatchID
+		err := store.ViewSnapshot(func(s *Snapshot) error {
+			snapshots, err := s.FilteredLocalBatchSnapshots(filter)
+			if err != nil {
+				return err
+			}
+
+			for _, snapshot := range snapshots {
+				batchIDs = append(batchIDs, snapshot.BatchID)
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		return batchIDs
+	}
+
+	// Without a filter, all batches are returned in the order they were
+	// finalized.
+	allBatches := []order.BatchID{
+		batch1.BatchID, batch2.BatchID, batch3.BatchID,
+	}
+	require.Equal(t, allBatches, filterBatches(BatchSnapshotFilter{}))
+
+	// Batches requested by their ID are also returned in that order.
+	require.Equal(
+		t, []order.BatchID{batch1.BatchID, batch3.BatchID},
+		filterBatches(BatchSnapshotFilter{
+			BatchIDs: []order.BatchID{
+				batch3.BatchID, batch1.BatchID, batch3.BatchID,
+			},
+		}),
+	)
+
+	require.Equal(
+		t, []order.BatchID{batch2.BatchID},
+		filterBatches(BatchSnapshotFilter{
+			Accounts: map[[33]byte]struct{}{acctB: {}},
+		}),
+	)
+
+	// Batches without a known finalization time are never part of a time
+	// range.
+	require.Equal(
+		t, []order.BatchID{batch2.BatchID},
+		filterBatches(BatchSnapshotFilter{
+			StartTime: now.Add(-3 * time.Hour),
+			EndTime:   now,
+		}),
+	)
+	require.Equal(
+		t, []order.BatchID{batch3.BatchID},
+		filterBatches(BatchSnapshotFilter{
+			Accounts:  map[[33]byte]struct{}{acctA: {}},
+			StartTime: now.Add(-time.Hour),
+		}),
+	)
+
+	// The finalization time is returned with each snapshot.
+	err := store.ViewSnapshot(func(s *Snapshot) error {
+		snapshots, err := s.FilteredLocalBatchSnapshots(
+			BatchSnapshotFilter{},
+		)
+		require.NoError(t, err)
+		require.True(t, snapshots[0].FinalizedAt.IsZero())
+		require.Equal(
+			t, now.UnixNano(), snapshots[2].FinalizedAt.UnixNano(),
+		)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Asking for an unknown batch fails.
+	err = store.ViewSnapshot(func(s *Snapshot) error {
+		_, err := s.FilteredLocalBatchSnapshots(BatchSnapshotFilter{
+			BatchIDs: []order.BatchID{{0x42}},
+		})
+		return err
+	})
+	require.ErrorContains(t, err, "not found")
 }
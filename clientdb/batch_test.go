@@ -9,10 +9,12 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -123,7 +125,157 @@ var (
 			runTest: func(db *DB, a *order.Ask, b *order.Bid,
 				acct *account.Account) error {
 
-				return db.MarkBatchComplete()
+				return db.MarkBatchComplete(testBatchID)
+			},
+		},
+		{
+			name:        "validate negative account balance",
+			expectedErr: "would have negative balance",
+			runTest: func(db *DB, a *order.Ask, _ *order.Bid,
+				acct *account.Account) error {
+
+				modifiers := [][]account.Modifier{{
+					account.ValueModifier(-1),
+				}}
+				_, _, err := db.ValidatePendingBatch(
+					testBatch, nil, nil,
+					[]*account.Account{acct}, modifiers,
+				)
+				return err
+			},
+		},
+		{
+			name:        "validate archived order",
+			expectedErr: "already in archived state",
+			runTest: func(db *DB, a *order.Ask, _ *order.Bid,
+				_ *account.Account) error {
+
+				err := db.UpdateOrder(
+					a.Nonce(),
+					order.StateModifier(order.StateCanceled),
+				)
+				if err != nil {
+					return err
+				}
+
+				modifiers := [][]order.Modifier{{
+					order.StateModifier(order.StateExecuted),
+				}}
+				_, _, err = db.ValidatePendingBatch(
+					testBatch, []order.Nonce{a.Nonce()},
+					modifiers, nil, nil,
+				)
+				return err
+			},
+		},
+		{
+			name:        "store negative account balance",
+			expectedErr: "would have negative balance",
+			runTest: func(db *DB, a *order.Ask, _ *order.Bid,
+				acct *account.Account) error {
+
+				modifiers := [][]account.Modifier{{
+					account.ValueModifier(-1),
+				}}
+				err := db.StorePendingBatch(
+					testBatch, nil, nil,
+					[]*account.Account{acct}, modifiers,
+				)
+
+				// Nothing must have been staged for the
+				// rejected batch.
+				_, snapshotErr := db.PendingBatchSnapshot()
+				if snapshotErr != account.ErrNoPendingBatch {
+					return fmt.Errorf("unexpected pending "+
+						"batch: %v", snapshotErr)
+				}
+
+				return err
+			},
+		},
+		{
+			name:        "store archived order",
+			expectedErr: "already in archived state",
+			runTest: func(db *DB, a *order.Ask, _ *order.Bid,
+				_ *account.Account) error {
+
+				err := db.UpdateOrder(
+					a.Nonce(),
+					order.StateModifier(order.StateCanceled),
+				)
+				if err != nil {
+					return err
+				}
+
+				modifiers := [][]order.Modifier{{
+					order.StateModifier(order.StateExecuted),
+				}}
+				return db.StorePendingBatch(
+					testBatch, []order.Nonce{a.Nonce()},
+					modifiers, nil, nil,
+				)
+			},
+		},
+		{
+			name:        "validate happy path",
+			expectedErr: "",
+			runTest: func(db *DB, a *order.Ask, _ *order.Bid,
+				acct *account.Account) error {
+
+				orderModifiers := [][]order.Modifier{{
+					order.StateModifier(order.StateExecuted),
+					order.UnitsFulfilledModifier(0),
+				}}
+				accountModifiers := [][]account.Modifier{{
+					account.StateModifier(
+						account.StatePendingBatch,
+					),
+					account.ValueModifier(1),
+				}}
+				orders, accounts, err := db.ValidatePendingBatch(
+					testBatch, []order.Nonce{a.Nonce()},
+					orderModifiers, []*account.Account{acct},
+					accountModifiers,
+				)
+				if err != nil {
+					return err
+				}
+
+				// The returned orders and accounts should
+				// reflect the modifications.
+				if orders[0].Details().State != order.StateExecuted {
+					return fmt.Errorf("unexpected order "+
+						"state %v",
+						orders[0].Details().State)
+				}
+				if accounts[0].Value != 1 {
+					return fmt.Errorf("unexpected account "+
+						"value %v", accounts[0].Value)
+				}
+
+				// But nothing should have been written to the
+				// database.
+				o, err := db.GetOrder(a.Nonce())
+				if err != nil {
+					return err
+				}
+				if o.Details().State != order.StateSubmitted {
+					return fmt.Errorf("order was modified")
+				}
+				dbAcct, err := db.Account(acct.TraderKey.PubKey)
+				if err != nil {
+					return err
+				}
+				if dbAcct.Value != acct.Value {
+					return fmt.Errorf("account was modified")
+				}
+				_, err = db.PendingBatchSnapshot()
+				if err != account.ErrNoPendingBatch {
+					return fmt.Errorf("unexpected pending "+
+						"batch: %v", err)
+				}
+
+				return nil
 			},
 		},
 		{
@@ -184,7 +336,8 @@ var (
 				}
 
 				// Mark the batch as complete.
-				if err := db.MarkBatchComplete(); err != nil {
+				err = db.MarkBatchComplete(testBatchID)
+				if err != nil {
 					return err
 				}
 
@@ -247,7 +400,102 @@ var (
 				// Mark the batch as complete. We should only
 				// see the update for our ask order applied, but
 				// not the rest.
-				if err := db.MarkBatchComplete(); err != nil {
+				err = db.MarkBatchComplete(testBatchID)
+				if err != nil {
+					return err
+				}
+
+				return checkUpdate(
+					db, a.Nonce(), b.Nonce(), 42,
+					b.UnitsUnfulfilled,
+					acct.TraderKey.PubKey, acct.State,
+				)
+			},
+		},
+		{
+			name:        "multiple pending batches",
+			expectedErr: "",
+			runTest: func(db *DB, a *order.Ask, b *order.Bid,
+				acct *account.Account) error {
+
+				// We'll store two different batches, the first
+				// one only updating the ask and the second one
+				// only updating the bid.
+				modifiers := [][]order.Modifier{{
+					order.UnitsFulfilledModifier(42),
+				}}
+				err := db.StorePendingBatch(
+					testBatch, []order.Nonce{a.Nonce()},
+					modifiers, nil, nil,
+				)
+				if err != nil {
+					return err
+				}
+
+				replacement := *testBatch
+				replacement.ID = order.BatchID{0x04, 0x05}
+				modifiers = [][]order.Modifier{{
+					order.UnitsFulfilledModifier(21),
+				}}
+				err = db.StorePendingBatch(
+					&replacement, []order.Nonce{b.Nonce()},
+					modifiers, nil, nil,
+				)
+				if err != nil {
+					return err
+				}
+
+				// Both batches should be pending now, with the
+				// second one being the most recent.
+				batchIDs, err := db.PendingBatches()
+				if err != nil {
+					return err
+				}
+				if len(batchIDs) != 2 {
+					return fmt.Errorf("expected 2 pending "+
+						"batches, got %d",
+						len(batchIDs))
+				}
+				dbSnapshot, err := db.PendingBatchSnapshot()
+				if err != nil {
+					return err
+				}
+				if dbSnapshot.BatchID != replacement.ID {
+					return fmt.Errorf("unexpected pending "+
+						"batch %x", dbSnapshot.BatchID)
+				}
+
+				// Completing an unknown batch should fail.
+				err = db.MarkBatchComplete(order.BatchID{0x09})
+				if err != account.ErrNoPendingBatch {
+					return fmt.Errorf("unexpected "+
+						"error: %v", err)
+				}
+
+				// Complete the first batch. Only its updates
+				// should be applied and its sibling removed.
+				err = db.MarkBatchComplete(testBatchID)
+				if err != nil {
+					return err
+				}
+				batchIDs, err = db.PendingBatches()
+				if err != nil {
+					return err
+				}
+				if len(batchIDs) != 0 {
+					return fmt.Errorf("expected no "+
+						"pending batches, got %d",
+						len(batchIDs))
+				}
+				_, err = db.GetPendingBatchSnapshot(
+					replacement.ID,
+				)
+				if err != account.ErrNoPendingBatch {
+					return fmt.Errorf("unexpected "+
+						"error: %v", err)
+				}
+				_, err = db.GetLocalBatchSnapshot(testBatchID)
+				if err != nil {
 					return err
 				}
 
@@ -396,19 +644,40 @@ func TestDeletePendingBatch(t *testing.T) {
 	assertPendingBatch := func(exists bool) {
 		t.Helper()
 
-		err := db.View(func(tx *bbolt.Tx) error {
-			root := tx.Bucket(batchBucketKey)
+		err := db.View(func(tx kvdb.RTx) error {
+			root := tx.ReadBucket(batchBucketKey)
 
 			if (root.Get(pendingBatchIDKey) != nil) != exists {
 				return fmt.Errorf("found unexpected key %v",
 					string(pendingBatchIDKey))
 			}
-			if (root.Bucket(pendingBatchAccountsBucketKey) != nil) != exists {
+			pendingBatches := root.NestedReadBucket(
+				pendingBatchesBucketKey,
+			)
+			if (pendingBatches != nil) != exists {
 				return fmt.Errorf("found unexpected bucket %v",
+					string(pendingBatchesBucketKey))
+			}
+			if !exists {
+				return nil
+			}
+
+			batch := pendingBatches.NestedReadBucket(testBatchID[:])
+			if batch == nil {
+				return fmt.Errorf("pending batch not found")
+			}
+			accounts := batch.NestedReadBucket(
+				pendingBatchAccountsBucketKey,
+			)
+			if accounts == nil {
+				return fmt.Errorf("bucket %v not found",
 					string(pendingBatchAccountsBucketKey))
 			}
-			if (root.Bucket(pendingBatchOrdersBucketKey) != nil) != exists {
-				return fmt.Errorf("found unexpected bucket %v",
+			orders := batch.NestedReadBucket(
+				pendingBatchOrdersBucketKey,
+			)
+			if orders == nil {
+				return fmt.Errorf("bucket %v not found",
 					string(pendingBatchOrdersBucketKey))
 			}
 
@@ -434,3 +703,62 @@ func TestDeletePendingBatch(t *testing.T) {
 	}
 	assertPendingBatch(false)
 }
+
+// TestAcceptedBatchProposal makes sure the proposal of a batch we signed for
+// survives the pending batch being re-staged and is only removed once the
+// batch is complete.
+func TestAcceptedBatchProposal(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// There is no proposal yet.
+	_, err := db.GetAcceptedBatchProposal(testBatchID)
+	require.ErrorIs(t, err, ErrNoAcceptedBatchProposal)
+
+	var rawTraderKey [33]byte
+	copy(rawTraderKey[:], testTraderKey.SerializeCompressed())
+	batch := *testBatch
+	batch.Version = order.DefaultBatchVersion
+	batch.MatchedOrders = map[order.Nonce][]*order.MatchedOrder{
+		{0x01}: {{UnitsFilled: 2}, {UnitsFilled: 3}},
+		{0x02}: {{UnitsFilled: 7}},
+	}
+	batch.ClearingPrices = map[uint32]order.FixedRatePremium{
+		2016: 123,
+		4032: 456,
+	}
+	batch.AccountDiffs = []*order.AccountDiff{{
+		AccountKeyRaw: rawTraderKey,
+		AccountKey:    testTraderKey,
+		EndingState:   auctioneerrpc.AccountDiff_OUTPUT_RECREATED,
+		EndingBalance: 123_456,
+		OutpointIndex: 0,
+		NewExpiry:     1337,
+	}}
+	batch.BatchTxFeeRate = 253
+	batch.FeeRebate = 99
+
+	proposal, err := NewAcceptedBatchProposal(&batch)
+	require.NoError(t, err)
+	require.Equal(t, order.SupplyUnit(5), proposal.MatchedUnits[order.Nonce{0x01}])
+	require.Equal(t, order.SupplyUnit(7), proposal.MatchedUnits[order.Nonce{0x02}])
+
+	err = db.StoreAcceptedBatchProposal(testBatchID, proposal)
+	require.NoError(t, err)
+
+	// Re-staging the batch must not remove the proposal.
+	require.NoError(t, db.StorePendingBatch(testBatch, nil, nil, nil, nil))
+	require.NoError(t, db.DeletePendingBatch())
+	require.NoError(t, db.StorePendingBatch(testBatch, nil, nil, nil, nil))
+
+	dbProposal, err := db.GetAcceptedBatchProposal(testBatchID)
+	require.NoError(t, err)
+	require.Equal(t, proposal, dbProposal)
+
+	// Once the batch is complete, the proposal is no longer needed.
+	require.NoError(t, db.MarkBatchComplete(testBatchID))
+	_, err = db.GetAcceptedBatchProposal(testBatchID)
+	require.ErrorIs(t, err, ErrNoAcceptedBatchProposal)
+}
@@ -0,0 +1,95 @@
+package clientdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// checksumBucketKey is the key of the sub-bucket that holds the CRC32
+	// checksums of the records stored in its parent bucket. The checksums
+	// are keyed by the same key as the record they belong to.
+	checksumBucketKey = []byte("record-checksums")
+)
+
+// ErrCorruptedRecord is the error returned if a record read from the database
+// doesn't match the checksum that was stored alongside it when it was written.
+type ErrCorruptedRecord struct {
+	// Bucket is the name of the bucket the record belongs to. Records in
+	// nested buckets are identified by the path of bucket names, separated
+	// by slashes.
+	Bucket string
+
+	// Key is the key that identifies the corrupted record within its
+	// bucket.
+	Key []byte
+}
+
+// Error returns a human readable representation of the error.
+//
+// NOTE: This is part of the error interface.
+func (e *ErrCorruptedRecord) Error() string {
+	return fmt.Sprintf("corrupted record %x in bucket %s", e.Key, e.Bucket)
+}
+
+// recordChecksum returns the CRC32 checksum of the given record.
+func recordChecksum(value []byte) [4]byte {
+	var checksum [4]byte
+	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(value))
+
+	return checksum
+}
+
+// putRecord stores the given value under the key in the target bucket and
+// stores its checksum in the bucket's checksum sub-bucket.
+func putRecord(targetBucket kvdb.RwBucket, key, value []byte) error {
+	checksums, err := targetBucket.CreateBucketIfNotExists(
+		checksumBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	checksum := recordChecksum(value)
+	if err := checksums.Put(key, checksum[:]); err != nil {
+		return err
+	}
+
+	return targetBucket.Put(key, value)
+}
+
+// deleteRecord removes the value stored under the key in the target bucket
+// together with its checksum.
+func deleteRecord(targetBucket kvdb.RwBucket, key []byte) error {
+	checksums := targetBucket.NestedReadWriteBucket(checksumBucketKey)
+	if checksums != nil {
+		if err := checksums.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return targetBucket.Delete(key)
+}
+
+// recordValid returns false if a checksum for the given record exists in the
+// source bucket and it doesn't match the value. Records that were written
+// before checksums were introduced don't have one and are always considered
+// valid.
+func recordValid(sourceBucket kvdb.RBucket, key, value []byte) bool {
+	checksums := sourceBucket.NestedReadBucket(checksumBucketKey)
+	if checksums == nil {
+		return true
+	}
+
+	storedChecksum := checksums.Get(key)
+	if storedChecksum == nil {
+		return true
+	}
+
+	checksum := recordChecksum(value)
+	return bytes.Equal(storedChecksum, checksum[:])
+}
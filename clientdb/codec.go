@@ -14,10 +14,10 @@ import (
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
-	"go.etcd.io/bbolt"
 )
 
 var (
@@ -56,6 +56,9 @@ func WriteElement(w *bytes.Buffer, element interface{}) error {
 	case account.State:
 		return lnwire.WriteElement(w, uint8(e))
 
+	case account.Version:
+		return lnwire.WriteElement(w, uint8(e))
+
 	case order.Version:
 		return lnwire.WriteElement(w, uint32(e))
 
@@ -152,6 +155,13 @@ func ReadElement(r io.Reader, element interface{}) error { // nolint:gocyclo
 		}
 		*e = account.State(s)
 
+	case *account.Version:
+		var v uint8
+		if err := lnwire.ReadElement(r, &v); err != nil {
+			return err
+		}
+		*e = account.Version(v)
+
 	case *order.Version:
 		var v uint32
 		if err := lnwire.ReadElement(r, &v); err != nil {
@@ -270,26 +280,43 @@ func ReadElement(r io.Reader, element interface{}) error { // nolint:gocyclo
 	return nil
 }
 
-// getAdditionalDataBucket returns the bucket for additional data of a database
-// object located at mainKey.
-func getAdditionalDataBucket(parentBucket *bbolt.Bucket,
-	mainKey []byte, createBucket bool) (*bbolt.Bucket, error) {
-
+// additionalDataKey returns the key of the bucket for additional data of a
+// database object located at mainKey.
+func additionalDataKey(mainKey []byte) []byte {
 	keyLen := len(mainKey) + len(additionalDataSuffix)
 	additionalDataKey := make([]byte, keyLen)
 	copy(additionalDataKey, mainKey)
 	copy(additionalDataKey[len(mainKey):], additionalDataSuffix)
 
-	// When only reading from the DB we don't want to create the bucket if
-	// it doesn't exist as that would fail on the read-only TX anyway.
+	return additionalDataKey
+}
+
+// getAdditionalDataBucket returns the bucket for additional data of a database
+// object located at mainKey.
+func getAdditionalDataBucket(parentBucket kvdb.RwBucket,
+	mainKey []byte, createBucket bool) (kvdb.RwBucket, error) {
+
 	if createBucket {
-		return parentBucket.CreateBucketIfNotExists(additionalDataKey)
+		return parentBucket.CreateBucketIfNotExists(
+			additionalDataKey(mainKey),
+		)
 	}
 
-	return parentBucket.Bucket(additionalDataKey), nil
+	return parentBucket.NestedReadWriteBucket(additionalDataKey(mainKey)),
+		nil
+}
+
+// getAdditionalDataReadBucket returns the bucket for additional data of a
+// database object located at mainKey for reading. When only reading from the
+// DB we don't want to create the bucket if it doesn't exist as that would fail
+// on the read-only TX anyway, so nil is returned in that case.
+func getAdditionalDataReadBucket(parentBucket kvdb.RBucket,
+	mainKey []byte) kvdb.RBucket {
+
+	return parentBucket.NestedReadBucket(additionalDataKey(mainKey))
 }
 
-func writeAdditionalValue(targetBucket *bbolt.Bucket, valueKey []byte,
+func writeAdditionalValue(targetBucket kvdb.RwBucket, valueKey []byte,
 	value interface{}) error {
 
 	var valueBuf bytes.Buffer
@@ -300,7 +327,7 @@ func writeAdditionalValue(targetBucket *bbolt.Bucket, valueKey []byte,
 	return targetBucket.Put(valueKey, valueBuf.Bytes())
 }
 
-func readAdditionalValue(sourceBucket *bbolt.Bucket, valueKey []byte,
+func readAdditionalValue(sourceBucket kvdb.RBucket, valueKey []byte,
 	valueTarget interface{}, defaultValue interface{}) error {
 
 	// First of all, check that the valueTarget is a pointer to the same
@@ -0,0 +1,369 @@
+package clientdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// DefaultAutoCompactMinAge is the default minimum time that needs to
+	// pass since the last compaction before the database is compacted
+	// again on startup.
+	DefaultAutoCompactMinAge = 7 * 24 * time.Hour
+
+	// compactTxMaxSize is the maximum size of a single transaction used to
+	// copy the data into the compacted database file.
+	compactTxMaxSize = 65536
+
+	// compactProgressInterval is the interval at which we log the progress
+	// of a running compaction.
+	compactProgressInterval = 10 * time.Second
+
+	// DefaultAutoCompactTimeout is the default maximum time a compaction
+	// may take before it is aborted and the original database file is
+	// used instead.
+	DefaultAutoCompactTimeout = 30 * time.Minute
+
+	// compactTempFileSuffix is the suffix of the temporary file the
+	// database is compacted into.
+	compactTempFileSuffix = ".tmp"
+
+	// compactBackupFileSuffix is the suffix of the backup of the original
+	// database file that is kept until the next startup after a
+	// compaction.
+	compactBackupFileSuffix = ".bak"
+
+	// lastCompactionFileSuffix is the suffix of the file that stores the
+	// timestamp of the last compaction attempt.
+	lastCompactionFileSuffix = ".last-compacted"
+)
+
+var (
+	// errCompactTimeout is returned if a compaction didn't finish within
+	// its timeout.
+	errCompactTimeout = errors.New("database compaction timed out")
+)
+
+// compactDB compacts the database file at the given path if the last
+// compaction happened longer ago than the given minimum age. The database is
+// copied into a fresh temporary file which then atomically replaces the
+// original file. A backup of the original file is kept until the next startup.
+// If the compaction takes longer than the given timeout, it is aborted and the
+// original file is kept. A timeout of zero means no timeout is applied.
+func compactDB(path string, minAge, timeout time.Duration) error {
+	// A backup of a previous compaction is only kept for one run.
+	backupPath := path + compactBackupFileSuffix
+	if fileExists(backupPath) {
+		log.Infof("Removing database backup %v of previous compaction",
+			backupPath)
+
+		if err := os.Remove(backupPath); err != nil {
+			return err
+		}
+	}
+
+	// There is nothing to compact for a new database.
+	if !fileExists(path) {
+		return nil
+	}
+
+	lastCompactionPath := path + lastCompactionFileSuffix
+	lastCompaction, err := readLastCompaction(lastCompactionPath)
+	if err != nil {
+		return err
+	}
+	if time.Since(lastCompaction) < minAge {
+		log.Debugf("Skipping database compaction, last compaction "+
+			"was at %v", lastCompaction)
+
+		return nil
+	}
+
+	srcInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	// Remove any leftovers of a previously interrupted compaction.
+	tempPath := path + compactTempFileSuffix
+	if fileExists(tempPath) {
+		if err := os.Remove(tempPath); err != nil {
+			return err
+		}
+	}
+
+	// We record the attempt before we start, so a compaction that fails,
+	// times out or is interrupted isn't retried on every startup but only
+	// once the minimum age has passed again.
+	err = writeLastCompaction(lastCompactionPath, time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Compacting database file at %v (%d bytes), this might "+
+		"take a while", path, srcInfo.Size())
+
+	start := time.Now()
+	err = compactInto(path, tempPath, srcInfo.Size(), timeout)
+	switch {
+	case err == errCompactTimeout:
+		_ = os.Remove(tempPath)
+		log.Warnf("Database compaction didn't finish within %v, "+
+			"keeping original database", timeout)
+
+		return nil
+
+	case err != nil:
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("error compacting database: %v", err)
+	}
+
+	dstInfo, err := os.Stat(tempPath)
+	if err != nil {
+		return err
+	}
+
+	// Make sure we don't replace the original file with something that
+	// obviously went wrong.
+	if dstInfo.Size() == 0 || dstInfo.Size() > srcInfo.Size() {
+		_ = os.Remove(tempPath)
+		log.Warnf("Compacted database has unexpected size of %d "+
+			"bytes (original size %d bytes), keeping original "+
+			"database", dstInfo.Size(), srcInfo.Size())
+
+		return nil
+	}
+
+	// We keep a backup of the original file as a hard link, then
+	// atomically replace the original file with the compacted one.
+	if err := os.Link(path, backupPath); err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("error creating database backup: %v", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("error replacing database file: %v", err)
+	}
+
+	log.Infof("Compacted database from %d to %d bytes in %v",
+		srcInfo.Size(), dstInfo.Size(), time.Since(start))
+
+	return nil
+}
+
+// compactInto copies all data of the database at srcPath into a fresh
+// database at dstPath. The progress is logged periodically as compacting a
+// large database can take a long time. If the copy doesn't finish within the
+// given timeout, it is aborted and errCompactTimeout is returned. A timeout of
+// zero means no timeout is applied.
+func compactInto(srcPath, dstPath string, srcSize int64,
+	timeout time.Duration) error {
+
+	src, err := bbolt.Open(srcPath, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  DefaultPoolDBTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := bbolt.Open(dstPath, dbFilePermission, &bbolt.Options{
+		Timeout: DefaultPoolDBTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	quit := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- compactBolt(dst, src, compactTxMaxSize, quit)
+	}()
+
+	var timeoutChan <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		timeoutChan = timer.C
+	}
+
+	ticker := time.NewTicker(compactProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-errChan:
+			return err
+
+		case <-ticker.C:
+			var written int64
+			if info, err := os.Stat(dstPath); err == nil {
+				written = info.Size()
+			}
+
+			log.Infof("Still compacting database, %d of at "+
+				"most %d bytes written", written, srcSize)
+
+		case <-timeoutChan:
+			// We wait for the copy to stop so both databases are
+			// closed before the caller removes the new file.
+			close(quit)
+			<-errChan
+
+			return errCompactTimeout
+		}
+	}
+}
+
+// compactBolt copies all buckets and key/value pairs of the src database into
+// the dst database. A new destination transaction is started every time the
+// current one reached the given maximum size. This is equivalent to
+// bbolt.Compact, except that the copy is aborted with errCompactTimeout as
+// soon as the quit channel is closed.
+func compactBolt(dst, src *bbolt.DB, txMaxSize int64,
+	quit <-chan struct{}) error {
+
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The transaction is already closed if it was committed.
+		_ = tx.Rollback()
+	}()
+
+	var size int64
+	copyFn := func(keys [][]byte, k, v []byte, seq uint64) error {
+		select {
+		case <-quit:
+			return errCompactTimeout
+		default:
+		}
+
+		// Commit regularly, so we don't run out of memory for large
+		// databases.
+		sz := int64(len(k) + len(v))
+		if size+sz > txMaxSize && txMaxSize != 0 {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+
+			tx, err = dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			size = 0
+		}
+		size += sz
+
+		// Top level buckets are created on the transaction itself.
+		if len(keys) == 0 {
+			bucket, err := tx.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return bucket.SetSequence(seq)
+		}
+
+		b := tx.Bucket(keys[0])
+		for _, key := range keys[1:] {
+			b = b.Bucket(key)
+		}
+
+		// Fill the entire page for the best compaction.
+		b.FillPercent = 1.0
+
+		// A nil value means the key is a nested bucket.
+		if v == nil {
+			bucket, err := b.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return bucket.SetSequence(seq)
+		}
+
+		return b.Put(k, v)
+	}
+
+	// The keys and values we copy are only valid for the lifetime of the
+	// source transaction, so we need to commit the last destination
+	// transaction before it ends.
+	return src.View(func(srcTx *bbolt.Tx) error {
+		err := srcTx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+			return walkBucket(
+				b, nil, name, nil, b.Sequence(), copyFn,
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+}
+
+// walkBucket calls the given function for the given key and, if the key is a
+// nested bucket, recursively for all of its keys.
+func walkBucket(b *bbolt.Bucket, keyPath [][]byte, k, v []byte, seq uint64,
+	fn func(keys [][]byte, k, v []byte, seq uint64) error) error {
+
+	if err := fn(keyPath, k, v, seq); err != nil {
+		return err
+	}
+
+	// If this is not a bucket, we're done.
+	if v != nil {
+		return nil
+	}
+
+	keyPath = append(keyPath, k)
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested := b.Bucket(k)
+			return walkBucket(
+				nested, keyPath, k, nil, nested.Sequence(), fn,
+			)
+		}
+
+		return walkBucket(b, keyPath, k, v, b.Sequence(), fn)
+	})
+}
+
+// readLastCompaction reads the timestamp of the last compaction from the file
+// at the given path. The zero time is returned if the file doesn't exist.
+func readLastCompaction(path string) (time.Time, error) {
+	if !fileExists(path) {
+		return time.Time{}, nil
+	}
+
+	tsBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(tsBytes) != 8 {
+		return time.Time{}, fmt.Errorf("invalid last compaction "+
+			"timestamp in %v", path)
+	}
+
+	return time.Unix(0, int64(binary.BigEndian.Uint64(tsBytes))), nil
+}
+
+// writeLastCompaction stores the timestamp of the last compaction in the file
+// at the given path.
+func writeLastCompaction(path string, ts time.Time) error {
+	var tsBytes [8]byte
+	binary.BigEndian.PutUint64(tsBytes[:], uint64(ts.UnixNano()))
+
+	return ioutil.WriteFile(
+		filepath.Clean(path), tsBytes[:], dbFilePermission,
+	)
+}
@@ -0,0 +1,134 @@
+package clientdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAutoCompact makes sure the database is compacted on startup if requested
+// and that its content survives the compaction.
+func TestAutoCompact(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "client-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	var (
+		path       = filepath.Join(tempDir, DBFilename)
+		backupPath = path + compactBackupFileSuffix
+		lastPath   = path + lastCompactionFileSuffix
+		opts       = &DBOptions{AutoCompact: true}
+	)
+
+	// Create a database with a few orders, then remove most of them again
+	// to create some free space that can be reclaimed.
+	db, err := New(tempDir, DBFilename, nil)
+	require.NoError(t, err)
+
+	var keep order.Nonce
+	for i := 0; i < 100; i++ {
+		kit := dummyOrder(500000, 1337)
+		require.NoError(t, db.SubmitOrder(&order.Ask{Kit: *kit}))
+
+		if i == 0 {
+			keep = kit.Nonce()
+			continue
+		}
+		require.NoError(t, db.DeleteOrder(kit.Nonce()))
+	}
+	require.NoError(t, db.Close())
+
+	// Opening the database with auto compaction enabled should compact it
+	// and keep a backup of the original file.
+	db, err = New(tempDir, DBFilename, opts)
+	require.NoError(t, err)
+
+	_, err = db.GetOrder(keep)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	require.FileExists(t, backupPath)
+	require.FileExists(t, lastPath)
+	lastCompaction, err := readLastCompaction(lastPath)
+	require.NoError(t, err)
+
+	// The next run should remove the backup again and, because the last
+	// compaction was only just now, skip the compaction.
+	opts.AutoCompactMinAge = DefaultAutoCompactMinAge
+	db, err = New(tempDir, DBFilename, opts)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	require.NoFileExists(t, backupPath)
+	lastCompaction2, err := readLastCompaction(lastPath)
+	require.NoError(t, err)
+	require.Equal(t, lastCompaction, lastCompaction2)
+}
+
+// TestAutoCompactTimeout makes sure a compaction that takes too long is
+// aborted, the original database file is kept and the attempt is recorded so
+// it isn't retried on the next startup.
+func TestAutoCompactTimeout(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	var (
+		path       = filepath.Join(tempDir, DBFilename)
+		backupPath = path + compactBackupFileSuffix
+		tempPath   = path + compactTempFileSuffix
+		lastPath   = path + lastCompactionFileSuffix
+	)
+
+	db, err := New(tempDir, DBFilename, nil)
+	require.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		kit := dummyOrder(500000, 1337)
+		require.NoError(t, db.SubmitOrder(&order.Ask{Kit: *kit}))
+	}
+	require.NoError(t, db.Close())
+
+	original, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	// The compaction can't finish within a nanosecond, so the original
+	// file must be left untouched.
+	require.NoError(t, compactDB(path, 0, time.Nanosecond))
+
+	compacted, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, original, compacted)
+	require.NoFileExists(t, backupPath)
+	require.NoFileExists(t, tempPath)
+
+	// The attempt is still recorded, so the next startup skips the
+	// compaction until the minimum age has passed.
+	require.FileExists(t, lastPath)
+	lastCompaction, err := readLastCompaction(lastPath)
+	require.NoError(t, err)
+
+	require.NoError(t, compactDB(path, DefaultAutoCompactMinAge, 0))
+	lastCompaction2, err := readLastCompaction(lastPath)
+	require.NoError(t, err)
+	require.Equal(t, lastCompaction, lastCompaction2)
+	require.NoFileExists(t, backupPath)
+
+	// Without a timeout, the compaction succeeds and the database is
+	// still readable afterwards.
+	require.NoError(t, compactDB(path, 0, 0))
+	require.FileExists(t, backupPath)
+
+	db, err = New(tempDir, DBFilename, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	orders, err := db.GetOrders()
+	require.NoError(t, err)
+	require.Len(t, orders, 200)
+}
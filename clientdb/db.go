@@ -1,12 +1,19 @@
 package clientdb
 
 import (
+	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/metrics"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/kvdb/postgres"
 	"go.etcd.io/bbolt"
 )
 
@@ -24,75 +31,292 @@ const (
 	// timeout we error out after the given time instead of just blocking
 	// for forever.
 	DefaultPoolDBTimeout = 5 * time.Second
+
+	// postgresTablePrefix is the prefix of all tables the client database
+	// creates in a postgres database.
+	postgresTablePrefix = "pool"
+
+	// BackendBolt is the name of the database backend that stores all data
+	// in a local bbolt database file.
+	BackendBolt = "bolt"
+
+	// BackendPostgres is the name of the database backend that stores all
+	// data in a remote postgres database.
+	BackendPostgres = "postgres"
 )
 
 var (
 	// byteOrder is the default byte order we'll use for serialization
 	// within the database.
 	byteOrder = binary.BigEndian
+
+	// ErrNoPostgresSupport is the error returned if the postgres backend
+	// is selected but the postgres driver wasn't compiled in.
+	ErrNoPostgresSupport = errors.New("pool was built without postgres " +
+		"support (build with -tags=kvdb_postgres)")
 )
 
-// DB is a bolt-backed persistent store.
+// DBOptions holds the optional configuration of the client database.
+type DBOptions struct {
+	// Backend is the name of the database backend to use.
+	Backend string `long:"backend" description:"The database backend to use for storing all of the client's state. The postgres backend is only available if pool was built with -tags=kvdb_postgres." choice:"bolt" choice:"postgres"`
+
+	// AutoCompact indicates whether the database file should be compacted
+	// on startup to reclaim the space of deleted keys. This only applies
+	// to the bolt backend.
+	AutoCompact bool `long:"auto-compact" description:"Compact the database file on startup to reclaim unused space. Only applies to the bolt backend."`
+
+	// AutoCompactMinAge is the minimum time that needs to pass since the
+	// last compaction before the database is compacted again.
+	AutoCompactMinAge time.Duration `long:"auto-compact-min-age" description:"The minimum age of the last compaction before the database is compacted again on startup. Set to 0 to compact on every startup."`
+
+	// AutoCompactTimeout is the maximum time a compaction may take before
+	// it is aborted and the original database file is used instead.
+	AutoCompactTimeout time.Duration `long:"auto-compact-timeout" description:"The maximum time a compaction may take before it is aborted and the original database file is used instead. Set to 0 to never abort a compaction."`
+
+	// Postgres holds the connection settings of the postgres backend.
+	Postgres *postgres.Config `group:"postgres" namespace:"postgres"`
+
+	// ReadOnly indicates whether the database should be opened in
+	// read-only mode. Any attempt to write to it then fails with
+	// ErrDBReadOnly. The database must already exist and be migrated to
+	// the latest version.
+	ReadOnly bool `no-flag:"true"`
+
+	// Metrics is used to record the duration of all database
+	// transactions. If this is nil, nothing is recorded.
+	Metrics metrics.Recorder `no-flag:"true"`
+}
+
+// DefaultDBOptions returns the default options of the client database.
+func DefaultDBOptions() *DBOptions {
+	return &DBOptions{
+		Backend:            BackendBolt,
+		AutoCompact:        false,
+		AutoCompactMinAge:  DefaultAutoCompactMinAge,
+		AutoCompactTimeout: DefaultAutoCompactTimeout,
+		Postgres: &postgres.Config{
+			Timeout: kvdb.DefaultDBTimeout,
+		},
+	}
+}
+
+// DB is a persistent store that is either backed by a local bbolt database
+// file or by a remote postgres database.
 type DB struct {
-	*bbolt.DB
+	backend kvdb.Backend
+
+	// dir is the directory the client's state is stored in. Files that
+	// don't live in the database itself, like the LSAT token, are kept
+	// here.
+	dir string
+
+	// accountSubscribers is the list of callbacks that are invoked after
+	// any account was added or updated.
+	accountSubscribers    []account.UpdateCallback
+	accountSubscribersMtx sync.Mutex
+
+	// sidecarSubscribers is the list of callbacks that are invoked after
+	// any sidecar event was recorded.
+	sidecarSubscribers    []SidecarEventCallback
+	sidecarSubscribersMtx sync.Mutex
+
+	// metrics is used to record the duration of all database
+	// transactions.
+	metrics metrics.Recorder
 }
 
-// New creates a new bolt database that can be found at the given directory.
-func New(dir, fileName string) (*DB, error) {
-	firstInit := false
-	path := filepath.Join(dir, fileName)
+// A compile-time check to make sure DB implements the Store interface.
+var _ Store = (*DB)(nil)
 
-	// If the database file does not exist yet, create its directory.
-	if !fileExists(path) {
-		if err := os.MkdirAll(dir, 0700); err != nil {
-			return nil, err
+// New creates a new client database. Depending on the given options it is
+// either a bolt database that can be found at the given directory or a
+// postgres database. If no options are given, the default options are used.
+func New(dir, fileName string, opts *DBOptions) (*DB, error) {
+	if opts == nil {
+		opts = DefaultDBOptions()
+	}
+
+	var (
+		backend kvdb.Backend
+		err     error
+	)
+	switch opts.Backend {
+	case BackendBolt, "":
+		if opts.ReadOnly {
+			backend, err = openReadOnlyBoltBackend(dir, fileName)
+			break
 		}
-		firstInit = true
+
+		backend, err = openBoltBackend(dir, fileName, opts)
+
+	case BackendPostgres:
+		if !kvdb.PostgresBackend {
+			return nil, ErrNoPostgresSupport
+		}
+		if opts.Postgres == nil || opts.Postgres.Dsn == "" {
+			return nil, fmt.Errorf("postgres DSN is required")
+		}
+
+		backend, err = kvdb.Open(
+			kvdb.PostgresBackendName, context.Background(),
+			opts.Postgres, postgresTablePrefix,
+		)
+		if err == nil && opts.ReadOnly {
+			backend = &readOnlyBackend{Backend: backend}
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown database backend %q",
+			opts.Backend)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	db, err := initDB(path, firstInit)
+	var db *DB
+	if opts.ReadOnly {
+		db, err = newReadOnlyDB(backend, dir)
+	} else {
+		db, err = newDB(backend, dir)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	db.metrics = metrics.OrDisabled(opts.Metrics)
+
+	return db, nil
+}
+
+// newDB initializes all required top-level buckets of the given backend and
+// makes sure it is migrated to the latest version.
+func newDB(backend kvdb.Backend, dir string) (*DB, error) {
+	db := &DB{
+		backend: backend,
+		dir:     dir,
+		metrics: metrics.Disabled,
+	}
+
+	if err := initDB(db); err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+
 	// Attempt to sync the database's current version with the latest known
 	// version available.
 	if err := syncVersions(db); err != nil {
+		_ = backend.Close()
 		return nil, err
 	}
 
-	return &DB{DB: db}, nil
+	return db, nil
 }
 
-// fileExists reports whether the named file or directory exists.
-func fileExists(path string) bool {
-	if _, err := os.Stat(path); err != nil {
-		if os.IsNotExist(err) {
-			return false
-		}
+// newReadOnlyDB creates a database from a backend that was opened in read-only
+// mode. Because we can neither create missing buckets nor run migrations, the
+// database must already be at the latest version.
+func newReadOnlyDB(backend kvdb.Backend, dir string) (*DB, error) {
+	db := &DB{
+		backend: backend,
+		dir:     dir,
+		metrics: metrics.Disabled,
 	}
-	return true
+
+	if err := checkLatestVersion(db); err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+
+	return db, nil
 }
 
-// initDB initializes all of the required top-level buckets for the database.
-func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
-	db, err := bbolt.Open(filepath, dbFilePermission, &bbolt.Options{
-		Timeout: DefaultPoolDBTimeout,
+// openBoltBackend opens the bolt database file at the given directory, creating
+// it if it doesn't exist yet.
+func openBoltBackend(dir, fileName string, opts *DBOptions) (kvdb.Backend,
+	error) {
+
+	path := filepath.Join(dir, fileName)
+
+	// Compact the database before we open it, if that's requested.
+	if opts.AutoCompact {
+		err := compactDB(
+			path, opts.AutoCompactMinAge, opts.AutoCompactTimeout,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If the database file does not exist yet, create its directory.
+	if !fileExists(path) {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	backend, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
+		DBPath:     dir,
+		DBFileName: fileName,
+		DBTimeout:  DefaultPoolDBTimeout,
 	})
 	if err == bbolt.ErrTimeout {
 		return nil, fmt.Errorf("error while trying to open %s: timed "+
 			"out after %v when trying to obtain exclusive lock - "+
 			"make sure no other pool daemon process (standalone "+
 			"or embedded in lightning-terminal) is running",
-			filepath, DefaultPoolDBTimeout)
+			path, DefaultPoolDBTimeout)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	err = db.Update(func(tx *bbolt.Tx) error {
-		if firstInit {
-			metadataBucket, err := tx.CreateBucketIfNotExists(
+	return backend, nil
+}
+
+// Update executes the given function within a read-write database
+// transaction. All changes are committed if the function returns without an
+// error.
+func (db *DB) Update(f func(tx kvdb.RwTx) error) error {
+	defer db.observeTx(metrics.TxWrite, time.Now())
+
+	return kvdb.Update(db.backend, f, func() {})
+}
+
+// View executes the given function within a read-only database transaction.
+func (db *DB) View(f func(tx kvdb.RTx) error) error {
+	defer db.observeTx(metrics.TxRead, time.Now())
+
+	return kvdb.View(db.backend, f, func() {})
+}
+
+// observeTx records the duration of a database transaction of the given kind
+// that started at the given time.
+func (db *DB) observeTx(kind metrics.TxKind, start time.Time) {
+	db.metrics.ObserveDBTransaction(kind, time.Since(start))
+}
+
+// Close closes the underlying database backend.
+func (db *DB) Close() error {
+	return db.backend.Close()
+}
+
+// fileExists reports whether the named file or directory exists.
+func fileExists(path string) bool {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return false
+		}
+	}
+	return true
+}
+
+// initDB initializes all of the required top-level buckets for the database.
+// The metadata of a new database is initialized with the latest version.
+func initDB(db *DB) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		if tx.ReadWriteBucket(metadataBucketKey) == nil {
+			metadataBucket, err := tx.CreateTopLevelBucket(
 				metadataBucketKey,
 			)
 			if err != nil {
@@ -107,27 +331,67 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 			}
 		}
 
-		_, err = tx.CreateBucketIfNotExists(accountBucketKey)
+		_, err := tx.CreateTopLevelBucket(accountBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(ordersBucketKey)
+		_, err = tx.CreateTopLevelBucket(ordersBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(sidecarsBucketKey)
+		_, err = tx.CreateTopLevelBucket(orderStateIndexBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(batchBucketKey)
+		_, err = tx.CreateTopLevelBucket(sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = tx.CreateBucketIfNotExists(eventBucketKey)
+		_, err = tx.CreateTopLevelBucket(batchBucketKey)
 		if err != nil {
 			return err
 		}
-		snapshotBucket, err := tx.CreateBucketIfNotExists(
+		_, err = tx.CreateTopLevelBucket(eventBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(termsBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(scheduledWithdrawalsBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(pendingDepositsBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(recoveryBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(orderTemplatesBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(batchPolicyBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(fundingIntentsBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(leaseAuditsBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateTopLevelBucket(leaseChannelsBucketKey)
+		if err != nil {
+			return err
+		}
+		snapshotBucket, err := tx.CreateTopLevelBucket(
 			batchSnapshotBucketKey,
 		)
 		if err != nil {
@@ -146,9 +410,4 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 		)
 		return err
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return db, nil
 }
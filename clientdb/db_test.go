@@ -0,0 +1,25 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewWithoutPostgresSupport makes sure selecting the postgres backend in a
+// build without the postgres driver fails with a descriptive error.
+func TestNewWithoutPostgresSupport(t *testing.T) {
+	t.Parallel()
+
+	if kvdb.PostgresBackend {
+		t.Skip("postgres driver is compiled in")
+	}
+
+	opts := DefaultDBOptions()
+	opts.Backend = BackendPostgres
+	opts.Postgres.Dsn = "postgres://localhost:5432/pool"
+
+	_, err := New(t.TempDir(), DBFilename, opts)
+	require.ErrorIs(t, err, ErrNoPostgresSupport)
+}
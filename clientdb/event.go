@@ -8,7 +8,7 @@ import (
 	"time"
 
 	"github.com/lightninglabs/pool/event"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -64,7 +64,7 @@ func (db *DB) AllEvents(evtType event.Type) ([]event.Event, error) {
 // events that match the given predicate.
 func (db *DB) getEvents(predicate event.Predicate) ([]event.Event, error) {
 	var events []event.Event
-	err := db.View(func(tx *bbolt.Tx) error {
+	err := db.View(func(tx kvdb.RTx) error {
 		var err error
 		events, err = getEventsTX(tx, predicate)
 		return err
@@ -78,11 +78,11 @@ func (db *DB) getEvents(predicate event.Predicate) ([]event.Event, error) {
 
 // getEventsTX retrieves all events that match the given predicate from the main
 // event bucket within the given database transaction.
-func getEventsTX(tx *bbolt.Tx, predicate event.Predicate) ([]event.Event,
+func getEventsTX(tx kvdb.RTx, predicate event.Predicate) ([]event.Event,
 	error) {
 
 	events := make([]event.Event, 0, eventsAllocSize)
-	eventBucket := tx.Bucket(eventBucketKey)
+	eventBucket := tx.ReadBucket(eventBucketKey)
 	err := eventBucket.ForEach(func(k, v []byte) error {
 		// There shouldn't be any other keys below the main
 		// event bucket so we fail hard if a key doesn't match
@@ -133,7 +133,7 @@ func getEventsTX(tx *bbolt.Tx, predicate event.Predicate) ([]event.Event,
 // storeEventTX stores a single event both in the main events bucket (ensuring
 // the uniqueness of its timestamp in the process) and a reference to it in the
 // "owner"'s bucket under an event reference sub bucket.
-func storeEventTX(ownerBucket *bbolt.Bucket, evt event.Event) error {
+func storeEventTX(ownerBucket kvdb.RwBucket, evt event.Event) error {
 	// For convenience, the event is allowed to be nil, in case no update
 	// really happened.
 	if evt == nil {
@@ -141,7 +141,7 @@ func storeEventTX(ownerBucket *bbolt.Bucket, evt event.Event) error {
 	}
 
 	// First of all, write the event to the global event bucket.
-	evtBucket := ownerBucket.Tx().Bucket(eventBucketKey)
+	evtBucket := ownerBucket.Tx().ReadWriteBucket(eventBucketKey)
 	err := event.StoreEvent(evtBucket, evt)
 	if err != nil {
 		return err
@@ -166,6 +166,98 @@ func storeEventTX(ownerBucket *bbolt.Bucket, evt event.Event) error {
 	return eventSubBucket.Put(refKey[:], []byte{byte(evt.Type())})
 }
 
+// getReferencedEventsTX returns all events that are referenced in the event
+// reference sub bucket of the given "owner" bucket, sorted by their
+// timestamps. If the owner doesn't have any events yet, an empty list is
+// returned.
+func getReferencedEventsTX(tx kvdb.RTx, ownerBucket kvdb.RBucket) (
+	[]event.Event, error) {
+
+	eventSubBucket := ownerBucket.NestedReadBucket(eventRefSubBucket)
+	if eventSubBucket == nil {
+		return nil, nil
+	}
+
+	// We first need to collect all timestamps from the owner bucket.
+	eventTimestampMap := make(map[time.Time]struct{})
+	err := eventSubBucket.ForEach(func(k, v []byte) error {
+		// Only look at keys with correct length.
+		if len(k) != event.TimestampLength {
+			return nil
+		}
+
+		// Assert there are no corrupt values, in the reference key we
+		// only store the event's type, not the value itself.
+		if len(v) != 1 {
+			return fmt.Errorf("unexpected timestamp value "+
+				"length: %d", len(v))
+		}
+
+		ts := time.Unix(0, int64(byteOrder.Uint64(k)))
+		eventTimestampMap[ts] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Now get the events themselves and return them. This is always
+	// sorted by the events' timestamps.
+	predicate := func(ts time.Time, _ event.Type) bool {
+		_, ok := eventTimestampMap[ts]
+		return ok
+	}
+	return getEventsTX(tx, predicate)
+}
+
+// lastEventTimestamp returns the timestamp of the most recent event referenced
+// in the event reference sub bucket of the given "owner" bucket. False is
+// returned if there are no event references.
+func lastEventTimestamp(ownerBucket kvdb.RwBucket) (time.Time, bool) {
+	eventSubBucket := ownerBucket.NestedReadWriteBucket(eventRefSubBucket)
+	if eventSubBucket == nil {
+		return time.Time{}, false
+	}
+
+	// The reference keys are big endian encoded timestamps, so the last
+	// key is also the most recent event.
+	c := eventSubBucket.ReadWriteCursor()
+	for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+		if len(k) != event.TimestampLength {
+			continue
+		}
+
+		return time.Unix(0, int64(byteOrder.Uint64(k))), true
+	}
+
+	return time.Time{}, false
+}
+
+// deleteEventsTX removes all events referenced in the event reference sub
+// bucket of the given "owner" bucket from the main events bucket. The
+// reference sub bucket itself is removed as well.
+func deleteEventsTX(eventBucket, ownerBucket kvdb.RwBucket) error {
+	eventSubBucket := ownerBucket.NestedReadWriteBucket(eventRefSubBucket)
+	if eventSubBucket == nil {
+		return nil
+	}
+
+	err := eventSubBucket.ForEach(func(k, v []byte) error {
+		// Only look at keys with correct length.
+		if len(k) != event.TimestampLength {
+			return nil
+		}
+
+		return eventBucket.Delete(k)
+	})
+	if err != nil {
+		return err
+	}
+
+	return ownerBucket.DeleteNestedBucket(eventRefSubBucket)
+}
+
 // deserializeEvent reads a single event from the given reader and deserializes
 // it according to the given event type.
 func deserializeEvent(reader io.Reader, timestamp time.Time,
@@ -183,6 +275,21 @@ func deserializeEvent(reader io.Reader, timestamp time.Time,
 	case event.TypeOrderMatch:
 		evt = &MatchEvent{}
 
+	case event.TypeOrderFeeRateBump:
+		evt = &FeeRateBumpEvent{}
+
+	case event.TypeAccountUpdate:
+		evt = &AccountUpdateEvent{}
+
+	case event.TypeSidecarStateChange:
+		evt = &SidecarEvent{}
+
+	case event.TypeLeaseAudit:
+		evt = &LeaseAuditEvent{}
+
+	case event.TypeLeaseClosedEarly:
+		evt = &LeaseClosedEarlyEvent{}
+
 	default:
 		return nil, fmt.Errorf("unknown event type <%d>", eventType)
 	}
@@ -1,14 +1,18 @@
 package clientdb
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
-	"go.etcd.io/bbolt"
 )
 
 // TestOrderEvents tests that storing and updating orders creates the correct
@@ -118,10 +122,10 @@ func getOrderEventTimestamps(store *DB, o order.Nonce, evtType event.Type) (
 	map[time.Time]struct{}, error) {
 
 	orderEventTimestamps := make(map[time.Time]struct{})
-	err := store.DB.View(func(tx *bbolt.Tx) error {
-		ordersBucket := tx.Bucket(ordersBucketKey)
-		orderBucket := ordersBucket.Bucket(o[:])
-		eventBucket := orderBucket.Bucket(eventRefSubBucket)
+	err := store.View(func(tx kvdb.RTx) error {
+		ordersBucket := tx.ReadBucket(ordersBucketKey)
+		orderBucket := ordersBucket.NestedReadBucket(o[:])
+		eventBucket := orderBucket.NestedReadBucket(eventRefSubBucket)
 		return eventBucket.ForEach(func(k, v []byte) error {
 			// Only look at keys with correct length.
 			if len(k) != event.TimestampLength {
@@ -149,3 +153,254 @@ func getOrderEventTimestamps(store *DB, o order.Nonce, evtType event.Type) (
 	})
 	return orderEventTimestamps, err
 }
+
+// TestOrderBatchEvents makes sure that order updates of a pending batch are
+// only recorded as events once the batch is marked as complete and that the
+// events contain the batch ID and the number of filled units.
+func TestOrderBatchEvents(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	o := &order.Bid{
+		Kit: *dummyOrder(500000, 1337),
+	}
+	o.State = order.StateSubmitted
+	o.Units = 5
+	o.UnitsUnfulfilled = 5
+	require.NoError(t, store.SubmitOrder(o))
+
+	// Staging the update in a pending batch shouldn't create an event
+	// yet, as the batch could still be discarded.
+	err := store.StorePendingBatch(
+		testBatch, []order.Nonce{o.Nonce()}, [][]order.Modifier{{
+			order.StateModifier(order.StatePartiallyFilled),
+			order.UnitsFulfilledModifier(2),
+		}}, nil, nil,
+	)
+	require.NoError(t, err)
+	assertOrderStateEvents(t, store, o.Nonce(), []order.State{})
+
+	// Once the batch is complete, the event should be recorded.
+	require.NoError(t, store.MarkBatchComplete(testBatchID))
+	assertOrderStateEvents(t, store, o.Nonce(), []order.State{
+		order.StatePartiallyFilled,
+	})
+
+	// A regular update shouldn't reference any batch.
+	err = store.UpdateOrder(
+		o.Nonce(), order.StateModifier(order.StateExecuted),
+		order.UnitsFulfilledModifier(0),
+	)
+	require.NoError(t, err)
+
+	events, err := store.GetOrderEvents(o.Nonce())
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.IsType(t, &CreatedEvent{}, events[0])
+
+	batchEvent := events[1].(*UpdatedEvent)
+	require.Equal(t, order.StateSubmitted, batchEvent.PrevState)
+	require.Equal(t, order.StatePartiallyFilled, batchEvent.NewState)
+	require.Equal(t, order.SupplyUnit(3), batchEvent.UnitsFilled)
+	require.Equal(t, order.SupplyUnit(3), batchEvent.UnitsFilledDelta)
+	require.Equal(t, &testBatchID, batchEvent.BatchID)
+
+	updateEvent := events[2].(*UpdatedEvent)
+	require.Equal(t, order.StatePartiallyFilled, updateEvent.PrevState)
+	require.Equal(t, order.StateExecuted, updateEvent.NewState)
+	require.Equal(t, order.SupplyUnit(5), updateEvent.UnitsFilled)
+	require.Equal(t, order.SupplyUnit(2), updateEvent.UnitsFilledDelta)
+	require.Nil(t, updateEvent.BatchID)
+}
+
+// TestUpdatedEventLegacySerialization makes sure an updated event that was
+// serialized without the additional tlv fields can still be read.
+func TestUpdatedEventLegacySerialization(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b     bytes.Buffer
+		nonce = order.Nonce{1, 2, 3}
+	)
+	err := WriteElements(
+		&b, nonce, order.StateSubmitted, order.StateExecuted,
+		order.SupplyUnit(7),
+	)
+	require.NoError(t, err)
+
+	evt := &UpdatedEvent{}
+	require.NoError(t, evt.Deserialize(&b))
+	require.Equal(t, nonce, evt.Nonce())
+	require.Equal(t, order.StateSubmitted, evt.PrevState)
+	require.Equal(t, order.StateExecuted, evt.NewState)
+	require.Equal(t, order.SupplyUnit(7), evt.UnitsFilled)
+	require.Equal(t, order.SupplyUnit(0), evt.UnitsFilledDelta)
+	require.Nil(t, evt.BatchID)
+}
+
+// TestFeeRateBumpEvent makes sure the replacement of an order with a higher
+// max batch fee rate is recorded in the event log of the new order.
+func TestFeeRateBumpEvent(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	o := &order.Bid{
+		Kit: *dummyOrder(500000, 1337),
+	}
+	require.NoError(t, store.SubmitOrder(o))
+
+	bump := &order.FeeRateBump{
+		Nonce:        o.Nonce(),
+		Replaces:     order.Nonce{1, 2, 3},
+		PrevFeeRate:  1_000,
+		NewFeeRate:   5_000,
+		BatchFeeRate: 4_000,
+	}
+	require.NoError(t, store.StoreFeeRateBump(bump))
+
+	timestamps, err := getOrderEventTimestamps(
+		store, o.Nonce(), event.TypeOrderFeeRateBump,
+	)
+	require.NoError(t, err)
+	events, err := store.GetEvents(timestamps)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	bumpEvent, ok := events[0].(*FeeRateBumpEvent)
+	require.True(t, ok)
+	require.Equal(t, o.Nonce(), bumpEvent.Nonce())
+	require.Equal(t, bump.Replaces, bumpEvent.ReplacedOrder)
+	require.Equal(t, bump.PrevFeeRate, bumpEvent.PrevFeeRate)
+	require.Equal(t, bump.NewFeeRate, bumpEvent.NewFeeRate)
+	require.Equal(t, bump.BatchFeeRate, bumpEvent.BatchFeeRate)
+}
+
+// TestAccountEvents makes sure that every change of an account's value, expiry
+// or state is recorded as an event with the action that triggered it.
+func TestAccountEvents(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	acct := *testAccount
+	acct.State = account.StatePendingOpen
+	acct.LatestTx = testBatchTx
+	require.NoError(t, store.AddAccount(&acct))
+
+	updates := []struct {
+		modifiers []account.Modifier
+		action    AccountAction
+	}{{
+		modifiers: []account.Modifier{
+			account.StateModifier(account.StateOpen),
+		},
+		action: AccountActionStateChange,
+	}, {
+		modifiers: []account.Modifier{
+			account.ValueModifier(acct.Value + 1000),
+			account.StateModifier(account.StatePendingUpdate),
+			account.OutPointModifier(wire.OutPoint{Index: 2}),
+		},
+		action: AccountActionDeposit,
+	}, {
+		modifiers: []account.Modifier{
+			account.StateModifier(account.StateOpen),
+		},
+		action: AccountActionStateChange,
+	}, {
+		modifiers: []account.Modifier{
+			account.ValueModifier(acct.Value),
+			account.StateModifier(account.StatePendingUpdate),
+		},
+		action: AccountActionWithdrawal,
+	}, {
+		modifiers: []account.Modifier{
+			account.ExpiryModifier(acct.Expiry + 100),
+			account.StateModifier(account.StatePendingUpdate),
+		},
+		action: AccountActionRenewal,
+	}, {
+		modifiers: []account.Modifier{
+			account.StateModifier(account.StateOpen),
+		},
+		action: AccountActionStateChange,
+	}}
+	for _, update := range updates {
+		err := store.UpdateAccount(&acct, update.modifiers...)
+		require.NoError(t, err)
+	}
+
+	// An update that doesn't change the value, expiry or state shouldn't
+	// be recorded.
+	require.NoError(t, store.UpdateAccount(
+		&acct, account.HeightHintModifier(acct.HeightHint+1),
+	))
+
+	// Updates through a batch should only be recorded once the batch is
+	// complete.
+	err := store.StorePendingBatch(
+		testBatch, nil, nil, []*account.Account{&acct},
+		[][]account.Modifier{{account.ValueModifier(acct.Value - 500)}},
+	)
+	require.NoError(t, err)
+	events, err := store.GetAccountEvents(acct.TraderKey.PubKey)
+	require.NoError(t, err)
+	require.Len(t, events, len(updates)+1)
+
+	require.NoError(t, store.MarkBatchComplete(testBatchID))
+
+	// Finally, close the account and bump the fee of the closing
+	// transaction.
+	require.NoError(t, store.UpdateAccount(
+		&acct, account.StateModifier(account.StatePendingClosed),
+	))
+	const bumpFeeRate = chainfee.FeePerKwFloor * 4
+	err = store.RecordFeeBump(acct.TraderKey.PubKey, bumpFeeRate)
+	require.NoError(t, err)
+
+	events, err = store.GetAccountEvents(acct.TraderKey.PubKey)
+	require.NoError(t, err)
+	require.Len(t, events, len(updates)+4)
+
+	require.Equal(t, AccountActionOpen, events[0].Action)
+	require.Equal(t, account.StatePendingOpen, events[0].NewState)
+	require.Equal(t, testAccount.Value, events[0].NewValue)
+	for idx, update := range updates {
+		require.Equal(t, update.action, events[idx+1].Action)
+	}
+
+	deposit := events[2]
+	require.Equal(t, account.StateOpen, deposit.PrevState)
+	require.Equal(t, account.StatePendingUpdate, deposit.NewState)
+	require.Equal(t, testAccount.Value, deposit.PrevValue)
+	require.Equal(t, testAccount.Value+1000, deposit.NewValue)
+	require.Equal(t, wire.OutPoint{Index: 2}, deposit.OutPoint)
+
+	renewal := events[5]
+	require.Equal(t, testAccount.Expiry, renewal.PrevExpiry)
+	require.Equal(t, testAccount.Expiry+100, renewal.NewExpiry)
+
+	batch := events[len(updates)+1]
+	require.Equal(t, AccountActionBatch, batch.Action)
+	require.Equal(t, testAccount.Value, batch.PrevValue)
+	require.Equal(t, testAccount.Value-500, batch.NewValue)
+
+	closeEvent := events[len(updates)+2]
+	require.Equal(t, AccountActionClose, closeEvent.Action)
+	require.Equal(t, account.StatePendingClosed, closeEvent.NewState)
+	require.Zero(t, closeEvent.FeeRate)
+
+	// A fee bump doesn't change the account but keeps track of the
+	// requested fee rate.
+	feeBump := events[len(updates)+3]
+	require.Equal(t, AccountActionFeeBump, feeBump.Action)
+	require.Equal(t, account.StatePendingClosed, feeBump.PrevState)
+	require.Equal(t, account.StatePendingClosed, feeBump.NewState)
+	require.Equal(t, feeBump.PrevValue, feeBump.NewValue)
+	require.Equal(t, bumpFeeRate, feeBump.FeeRate)
+}
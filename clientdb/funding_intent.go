@@ -0,0 +1,228 @@
+package clientdb
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// ErrNoFundingIntent is the error returned if no funding intent with
+	// the given pending channel ID exists in the store.
+	ErrNoFundingIntent = errors.New("no funding intent found")
+
+	// fundingIntentsBucketKey is the top level bucket where we store the
+	// funding intents of all channels we are about to open or accept as
+	// part of a batch, keyed by their pending channel ID. An intent is
+	// removed once its channel confirmed or its batch was replaced.
+	fundingIntentsBucketKey = []byte("funding-intents")
+)
+
+// FundingIntent contains everything we need to know about a channel of a batch
+// to resume its funding flow after a restart.
+type FundingIntent struct {
+	// PendingChanID is the pending channel ID that is unique to the pair
+	// of matched orders.
+	PendingChanID [32]byte
+
+	// BatchID is the ID of the batch the channel is part of.
+	BatchID order.BatchID
+
+	// OrderNonce is the nonce of our order.
+	OrderNonce order.Nonce
+
+	// MatchedOrderNonce is the nonce of the order ours was matched with.
+	MatchedOrderNonce order.Nonce
+
+	// NodeKey is the node key of the counterparty.
+	NodeKey [33]byte
+
+	// ChanPoint is the expected channel output on the batch transaction.
+	ChanPoint wire.OutPoint
+
+	// Initiator is true if our order is the ask and we open the channel.
+	// Otherwise we only register the funding shim and wait for the
+	// counterparty to open the channel.
+	Initiator bool
+
+	// Amt is the total capacity of the channel.
+	Amt btcutil.Amount
+
+	// LocalKey is our multisig key of the channel.
+	LocalKey *keychain.KeyDescriptor
+
+	// RemoteKey is the multisig key of the counterparty.
+	RemoteKey [33]byte
+
+	// ThawHeight is the thaw height of the funding shim. For script
+	// enforced leases this is the absolute lease expiry height, otherwise
+	// it is the lease duration relative to the channel's confirmation.
+	ThawHeight uint32
+
+	// LeaseDuration is the lease duration in blocks of the bid.
+	LeaseDuration uint32
+
+	// ScriptEnforced is true if the lease is enforced by the channel
+	// script.
+	ScriptEnforced bool
+
+	// FailureReason is set if the funding flow can't be resumed
+	// automatically and needs manual intervention.
+	FailureReason string
+
+	// FailedAt is the time the failure was detected.
+	FailedAt time.Time
+}
+
+// Failed returns true if the funding flow of the channel can't be resumed.
+func (i *FundingIntent) Failed() bool {
+	return i.FailureReason != ""
+}
+
+// StoreFundingIntent stores the given funding intent, replacing any existing
+// one with the same pending channel ID.
+func (db *DB) StoreFundingIntent(intent *FundingIntent) error {
+	var buf bytes.Buffer
+	if err := serializeFundingIntent(&buf, intent); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, fundingIntentsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, intent.PendingChanID[:], buf.Bytes())
+	})
+}
+
+// FundingIntents returns all stored funding intents.
+func (db *DB) FundingIntents() ([]*FundingIntent, error) {
+	var res []*FundingIntent
+	err := db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		res = nil
+
+		// A read-only database might have been created before funding
+		// intents were introduced, so we don't require the bucket to
+		// exist.
+		bucket := tx.ReadBucket(fundingIntentsBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// We'll also get buckets here, skip those (identified
+			// by nil value).
+			if v == nil {
+				return nil
+			}
+
+			if !recordValid(bucket, k, v) {
+				return &ErrCorruptedRecord{
+					Bucket: string(fundingIntentsBucketKey),
+					Key:    copyBytes(k),
+				}
+			}
+
+			intent, err := deserializeFundingIntent(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+			copy(intent.PendingChanID[:], k)
+			res = append(res, intent)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteFundingIntent removes the funding intent with the given pending
+// channel ID. If there is none, ErrNoFundingIntent is returned.
+func (db *DB) DeleteFundingIntent(pendingChanID [32]byte) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, fundingIntentsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if bucket.Get(pendingChanID[:]) == nil {
+			return ErrNoFundingIntent
+		}
+
+		return deleteRecord(bucket, pendingChanID[:])
+	})
+}
+
+// serializeFundingIntent serializes a funding intent without its pending
+// channel ID, which is used as the key. The failure reason is stored last and
+// takes up the rest of the record.
+func serializeFundingIntent(w *bytes.Buffer, i *FundingIntent) error {
+	err := WriteElements(
+		w, i.BatchID[:], i.OrderNonce, i.MatchedOrderNonce, i.NodeKey,
+		i.ChanPoint, i.Initiator, i.Amt, i.LocalKey, i.RemoteKey,
+		i.ThawHeight, i.LeaseDuration, i.ScriptEnforced, i.Failed(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !i.Failed() {
+		return nil
+	}
+
+	if err := WriteElements(w, i.FailedAt); err != nil {
+		return err
+	}
+
+	_, err = w.WriteString(i.FailureReason)
+	return err
+}
+
+func deserializeFundingIntent(r io.Reader) (*FundingIntent, error) {
+	var (
+		intent = &FundingIntent{}
+		failed bool
+	)
+	err := ReadElements(
+		r, intent.BatchID[:], &intent.OrderNonce,
+		&intent.MatchedOrderNonce, &intent.NodeKey, &intent.ChanPoint,
+		&intent.Initiator, &intent.Amt, &intent.LocalKey,
+		&intent.RemoteKey, &intent.ThawHeight, &intent.LeaseDuration,
+		&intent.ScriptEnforced, &failed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !failed {
+		return intent, nil
+	}
+
+	if err := ReadElements(r, &intent.FailedAt); err != nil {
+		return nil, err
+	}
+
+	reason, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	intent.FailureReason = string(reason)
+
+	return intent, nil
+}
@@ -0,0 +1,75 @@
+package clientdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFundingIntents makes sure funding intents can be stored, updated with a
+// failure and deleted.
+func TestFundingIntents(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	intents, err := db.FundingIntents()
+	require.NoError(t, err)
+	require.Empty(t, intents)
+
+	intent := &FundingIntent{
+		PendingChanID:     [32]byte{1, 2, 3},
+		BatchID:           order.BatchID{4, 5, 6},
+		OrderNonce:        order.Nonce{7},
+		MatchedOrderNonce: order.Nonce{8},
+		NodeKey:           [33]byte{2, 9},
+		ChanPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{10},
+			Index: 3,
+		},
+		Amt: 1_000_000,
+		LocalKey: &keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyMultiSig,
+				Index:  11,
+			},
+			PubKey: testTraderKey,
+		},
+		RemoteKey:      [33]byte{3, 12},
+		ThawHeight:     702_016,
+		LeaseDuration:  2016,
+		ScriptEnforced: true,
+	}
+	require.NoError(t, db.StoreFundingIntent(intent))
+
+	intents, err = db.FundingIntents()
+	require.NoError(t, err)
+	require.Equal(t, []*FundingIntent{intent}, intents)
+
+	// Marking the intent as failed replaces the stored one.
+	intent.FailureReason = "funding flow lost by lnd"
+	intent.FailedAt = time.Unix(1_700_000_000, 0)
+	require.NoError(t, db.StoreFundingIntent(intent))
+
+	intents, err = db.FundingIntents()
+	require.NoError(t, err)
+	require.Len(t, intents, 1)
+	require.True(t, intents[0].Failed())
+	require.Equal(t, intent, intents[0])
+
+	require.NoError(t, db.DeleteFundingIntent(intent.PendingChanID))
+	require.ErrorIs(
+		t, db.DeleteFundingIntent(intent.PendingChanID),
+		ErrNoFundingIntent,
+	)
+
+	intents, err = db.FundingIntents()
+	require.NoError(t, err)
+	require.Empty(t, intents)
+}
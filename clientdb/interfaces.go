@@ -0,0 +1,129 @@
+package clientdb
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
+)
+
+// Store is the interface of the client database that is used by the account,
+// order, funding and sidecar managers. It is implemented by DB for all of the
+// supported database backends.
+type Store interface {
+	order.Store
+	sidecar.Store
+
+	// AddAccount adds a record for a new account to the database.
+	AddAccount(*account.Account) error
+
+	// UpdateAccount updates an account in the database according to the
+	// given modifiers.
+	UpdateAccount(*account.Account, ...account.Modifier) error
+
+	// Account retrieves a specific account by trader key or returns
+	// ErrAccountNotFound if it's not found.
+	Account(*btcec.PublicKey) (*account.Account, error)
+
+	// Accounts retrieves all known accounts from the database.
+	Accounts() ([]*account.Account, error)
+
+	// LockID retrieves the global lock ID used to lease outputs from the
+	// backing lnd node's wallet.
+	LockID() (wtxmgr.LockID, error)
+
+	// PendingBatches returns the IDs of all batches that are currently
+	// pending.
+	PendingBatches() ([]order.BatchID, error)
+
+	// PendingBatchSnapshot retrieves the snapshot of the most recent
+	// pending batch. If there isn't one, account.ErrNoPendingBatch is
+	// returned.
+	PendingBatchSnapshot() (*LocalBatchSnapshot, error)
+
+	// GetLocalBatchSnapshot returns the local batch snapshot of the batch
+	// with the given ID.
+	GetLocalBatchSnapshot(order.BatchID) (*LocalBatchSnapshot, error)
+
+	// DeletePendingBatch removes all references to all pending batches
+	// without applying their staged updates to accounts and orders.
+	DeletePendingBatch() error
+
+	// ReversibleBatches returns the IDs of all completed batches that can
+	// still be reverted, in the order they were completed.
+	ReversibleBatches() ([]order.BatchID, error)
+
+	// RevertBatch restores the state from before the completed batch with
+	// the given ID and makes it pending again.
+	RevertBatch(order.BatchID) error
+
+	// DeleteReversibleBatch removes the pre-batch state of the completed
+	// batch with the given ID, making it irreversible.
+	DeleteReversibleBatch(order.BatchID) error
+
+	// StoreFundingIntent stores the given funding intent, replacing any
+	// existing one with the same pending channel ID.
+	StoreFundingIntent(*FundingIntent) error
+
+	// FundingIntents returns all stored funding intents.
+	FundingIntents() ([]*FundingIntent, error)
+
+	// DeleteFundingIntent removes the funding intent with the given
+	// pending channel ID. If there is none, ErrNoFundingIntent is
+	// returned.
+	DeleteFundingIntent([32]byte) error
+
+	// StoreLeaseAudit adds the result of a lease audit to the lease event
+	// log.
+	StoreLeaseAudit(*LeaseAuditEvent) error
+
+	// LeaseAudits returns the results of all lease audits in
+	// chronological order.
+	LeaseAudits() ([]*LeaseAuditEvent, error)
+
+	// StoreLeaseClosedEarly adds an early close of a lease channel to the
+	// lease event log.
+	StoreLeaseClosedEarly(*LeaseClosedEarlyEvent) error
+
+	// LeasesClosedEarly returns all early closes of lease channels in
+	// chronological order.
+	LeasesClosedEarly() ([]*LeaseClosedEarlyEvent, error)
+
+	// StoreLeaseChannel stores the given lease channel, replacing any
+	// existing one with the same channel point.
+	StoreLeaseChannel(*LeaseChannel) error
+
+	// LeaseChannels returns all stored lease channels.
+	LeaseChannels() ([]*LeaseChannel, error)
+
+	// AddSidecarWithBid is identical to AddSidecar, but it also stores a
+	// bid template to facilitate automated negotiation of sidecar
+	// channels.
+	AddSidecarWithBid(*sidecar.Ticket, *order.Bid) error
+
+	// ArchiveSidecar moves a sidecar ticket that reached a terminal state
+	// to the archive.
+	ArchiveSidecar(*sidecar.Ticket) error
+
+	// SidecarBidTemplate attempts to retrieve a bid template associated
+	// with the passed sidecar ticket.
+	SidecarBidTemplate(*sidecar.Ticket) (*order.Bid, error)
+
+	// ExportState serializes all accounts, orders, sidecar tickets and the
+	// current LSAT token into a portable stream.
+	ExportState(io.Writer) error
+
+	// ImportState reads a state export created by ExportState and stores
+	// all of its content, including the LSAT token, in the database.
+	ImportState(io.Reader) error
+
+	// VerifyRecords scans the whole database and returns all corrupted
+	// records without modifying anything.
+	VerifyRecords() ([]*ErrCorruptedRecord, error)
+
+	// Close closes the database.
+	Close() error
+}
@@ -0,0 +1,189 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// leaseChannelsBucketKey is the top level bucket where we store what
+	// we know about the channels of our leases after their batch
+	// confirmed, keyed by the channel point.
+	leaseChannelsBucketKey = []byte("lease-channels")
+)
+
+// LeaseChannelState is the state of the channel of a lease as last reported by
+// lnd.
+type LeaseChannelState uint8
+
+const (
+	// LeaseChannelOpen is the state of a lease channel that is open.
+	LeaseChannelOpen LeaseChannelState = 0
+
+	// LeaseChannelClosed is the state of a lease channel that was closed
+	// cooperatively or after its lease expired.
+	LeaseChannelClosed LeaseChannelState = 1
+
+	// LeaseChannelClosedEarly is the state of a lease channel that was
+	// force closed before its lease expired.
+	LeaseChannelClosedEarly LeaseChannelState = 2
+)
+
+// String returns a human readable representation of the state.
+func (s LeaseChannelState) String() string {
+	switch s {
+	case LeaseChannelOpen:
+		return "LeaseChannelOpen"
+
+	case LeaseChannelClosed:
+		return "LeaseChannelClosed"
+
+	case LeaseChannelClosedEarly:
+		return "LeaseChannelClosedEarly"
+
+	default:
+		return fmt.Sprintf("unknown <%d>", uint8(s))
+	}
+}
+
+// LeaseChannel is the channel of a lease that completed its funding flow.
+type LeaseChannel struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// BatchID is the ID of the batch the channel was created in.
+	BatchID order.BatchID
+
+	// OrderNonce is the nonce of our order that resulted in the channel.
+	OrderNonce order.Nonce
+
+	// LeaseDuration is the lease duration in blocks of the bid.
+	LeaseDuration uint32
+
+	// ShortChanID is the short channel ID of the channel. It is zero if
+	// the channel was closed before we learned about its confirmation.
+	ShortChanID lnwire.ShortChannelID
+
+	// LeaseExpiry is the absolute height the lease expires at. It is zero
+	// as long as it depends on the confirmation height of a channel we
+	// haven't seen confirmed yet.
+	LeaseExpiry uint32
+
+	// State is the state of the channel.
+	State LeaseChannelState
+
+	// CloseHeight is the height the channel was closed at. It is zero
+	// while the channel is open.
+	CloseHeight uint32
+}
+
+// Closed returns true if the channel of the lease is closed.
+func (c *LeaseChannel) Closed() bool {
+	return c.State != LeaseChannelOpen
+}
+
+// StoreLeaseChannel stores the given lease channel, replacing any existing one
+// with the same channel point.
+func (db *DB) StoreLeaseChannel(c *LeaseChannel) error {
+	var key, value bytes.Buffer
+	if err := WriteElements(&key, c.ChanPoint); err != nil {
+		return err
+	}
+	if err := serializeLeaseChannel(&value, c); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, leaseChannelsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, key.Bytes(), value.Bytes())
+	})
+}
+
+// LeaseChannels returns all stored lease channels.
+func (db *DB) LeaseChannels() ([]*LeaseChannel, error) {
+	var res []*LeaseChannel
+	err := db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		res = nil
+
+		// A read-only database might have been created before lease
+		// channels were introduced, so we don't require the bucket to
+		// exist.
+		bucket := tx.ReadBucket(leaseChannelsBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// We'll also get buckets here, skip those (identified
+			// by nil value).
+			if v == nil {
+				return nil
+			}
+
+			if !recordValid(bucket, k, v) {
+				return &ErrCorruptedRecord{
+					Bucket: string(leaseChannelsBucketKey),
+					Key:    copyBytes(k),
+				}
+			}
+
+			c, err := deserializeLeaseChannel(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			err = ReadElements(bytes.NewReader(k), &c.ChanPoint)
+			if err != nil {
+				return err
+			}
+			res = append(res, c)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// serializeLeaseChannel serializes a lease channel without its channel point,
+// which is used as the key.
+func serializeLeaseChannel(w *bytes.Buffer, c *LeaseChannel) error {
+	return WriteElements(
+		w, c.BatchID[:], c.OrderNonce, c.LeaseDuration,
+		c.ShortChanID.ToUint64(), c.LeaseExpiry, uint8(c.State),
+		c.CloseHeight,
+	)
+}
+
+func deserializeLeaseChannel(r io.Reader) (*LeaseChannel, error) {
+	var (
+		c           = &LeaseChannel{}
+		shortChanID uint64
+		state       uint8
+	)
+	err := ReadElements(
+		r, c.BatchID[:], &c.OrderNonce, &c.LeaseDuration, &shortChanID,
+		&c.LeaseExpiry, &state, &c.CloseHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ShortChanID = lnwire.NewShortChanIDFromInt(shortChanID)
+	c.State = LeaseChannelState(state)
+
+	return c, nil
+}
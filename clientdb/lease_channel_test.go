@@ -0,0 +1,60 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLeaseChannels makes sure lease channels can be stored, updated and read
+// back.
+func TestLeaseChannels(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	channels, err := db.LeaseChannels()
+	require.NoError(t, err)
+	require.Empty(t, channels)
+
+	pending := &LeaseChannel{
+		ChanPoint:     wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2},
+		BatchID:       order.BatchID{3},
+		OrderNonce:    order.Nonce{4},
+		LeaseDuration: 2016,
+	}
+	open := &LeaseChannel{
+		ChanPoint:     wire.OutPoint{Hash: chainhash.Hash{5}, Index: 6},
+		BatchID:       order.BatchID{3},
+		OrderNonce:    order.Nonce{7},
+		LeaseDuration: 4032,
+		ShortChanID: lnwire.ShortChannelID{
+			BlockHeight: 700_000,
+			TxIndex:     12,
+			TxPosition:  6,
+		},
+		LeaseExpiry: 704_032,
+	}
+	require.NoError(t, db.StoreLeaseChannel(pending))
+	require.NoError(t, db.StoreLeaseChannel(open))
+
+	channels, err = db.LeaseChannels()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*LeaseChannel{pending, open}, channels)
+
+	// Closing a channel replaces its previous state.
+	open.State = LeaseChannelClosedEarly
+	open.CloseHeight = 701_000
+	require.NoError(t, db.StoreLeaseChannel(open))
+
+	channels, err = db.LeaseChannels()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*LeaseChannel{pending, open}, channels)
+	require.False(t, pending.Closed())
+	require.True(t, open.Closed())
+}
@@ -0,0 +1,602 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// leaseAuditsBucketKey is the top level bucket that houses one bucket
+	// per channel with the references to the channel's lease audit and
+	// early close events, keyed by the channel point.
+	leaseAuditsBucketKey = []byte("lease-audits")
+)
+
+const (
+	// leaseAuditChanTxidType is the tlv type we use to store the funding
+	// transaction ID of the audited channel.
+	leaseAuditChanTxidType tlv.Type = 1
+
+	// leaseAuditChanIndexType is the tlv type we use to store the funding
+	// output index of the audited channel.
+	leaseAuditChanIndexType tlv.Type = 2
+
+	// leaseAuditBatchIDType is the tlv type we use to store the ID of the
+	// batch the channel was created in.
+	leaseAuditBatchIDType tlv.Type = 3
+
+	// leaseAuditOrderNonceType is the tlv type we use to store the nonce
+	// of our order that resulted in the channel.
+	leaseAuditOrderNonceType tlv.Type = 4
+
+	// leaseAuditScriptEnforcedType is the tlv type we use to store whether
+	// the lease is enforced by the channel script.
+	leaseAuditScriptEnforcedType tlv.Type = 5
+
+	// leaseAuditExpectedExpiryType is the tlv type we use to store the
+	// lease expiry height promised in the batch.
+	leaseAuditExpectedExpiryType tlv.Type = 6
+
+	// leaseAuditActualExpiryType is the tlv type we use to store the lease
+	// expiry height of the channel as reported by lnd.
+	leaseAuditActualExpiryType tlv.Type = 7
+
+	// leaseAuditReasonType is the tlv type we use to store the reason the
+	// audit failed.
+	leaseAuditReasonType tlv.Type = 8
+)
+
+const (
+	// leaseClosedChanTxidType is the tlv type we use to store the funding
+	// transaction ID of the closed channel.
+	leaseClosedChanTxidType tlv.Type = 1
+
+	// leaseClosedChanIndexType is the tlv type we use to store the funding
+	// output index of the closed channel.
+	leaseClosedChanIndexType tlv.Type = 2
+
+	// leaseClosedClosingTxidType is the tlv type we use to store the ID of
+	// the transaction that closed the channel.
+	leaseClosedClosingTxidType tlv.Type = 3
+
+	// leaseClosedBatchIDType is the tlv type we use to store the ID of the
+	// batch the channel was created in.
+	leaseClosedBatchIDType tlv.Type = 4
+
+	// leaseClosedOrderNonceType is the tlv type we use to store the nonce
+	// of our order that resulted in the channel.
+	leaseClosedOrderNonceType tlv.Type = 5
+
+	// leaseClosedPurchasedType is the tlv type we use to store whether we
+	// bought the channel.
+	leaseClosedPurchasedType tlv.Type = 6
+
+	// leaseClosedRemoteKeyType is the tlv type we use to store the node
+	// key of the channel peer.
+	leaseClosedRemoteKeyType tlv.Type = 7
+
+	// leaseClosedCapacityType is the tlv type we use to store the capacity
+	// of the channel.
+	leaseClosedCapacityType tlv.Type = 8
+
+	// leaseClosedCloseTypeType is the tlv type we use to store the type of
+	// the channel close.
+	leaseClosedCloseTypeType tlv.Type = 9
+
+	// leaseClosedDurationType is the tlv type we use to store the lease
+	// duration in blocks.
+	leaseClosedDurationType tlv.Type = 10
+
+	// leaseClosedExpiryType is the tlv type we use to store the lease
+	// expiry height.
+	leaseClosedExpiryType tlv.Type = 11
+
+	// leaseClosedCloseHeightType is the tlv type we use to store the
+	// height the channel was closed at.
+	leaseClosedCloseHeightType tlv.Type = 12
+)
+
+// LeaseAuditEvent is an event implementation that records the outcome of
+// comparing the lease expiry of a channel created in a batch with the one that
+// was promised in the batch, once the channel finished its funding flow.
+type LeaseAuditEvent struct {
+	// timestamp is the unique timestamp the event was created/recorded at.
+	timestamp time.Time
+
+	// ChanPoint is the funding outpoint of the audited channel.
+	ChanPoint wire.OutPoint
+
+	// BatchID is the ID of the batch the channel was created in.
+	BatchID order.BatchID
+
+	// OrderNonce is the nonce of our order that resulted in the channel.
+	OrderNonce order.Nonce
+
+	// ScriptEnforced is true if the lease is enforced by the channel
+	// script through an absolute CLTV.
+	ScriptEnforced bool
+
+	// ExpectedExpiry is the lease expiry height promised in the batch.
+	ExpectedExpiry uint32
+
+	// ActualExpiry is the lease expiry height of the channel as reported
+	// by lnd.
+	ActualExpiry uint32
+
+	// Reason explains why the lease of the channel doesn't match the
+	// batch. It is empty if the audit passed.
+	Reason string
+}
+
+// NewLeaseAuditEvent creates a new LeaseAuditEvent with the current system
+// time as the timestamp.
+func NewLeaseAuditEvent(chanPoint wire.OutPoint, batchID order.BatchID,
+	orderNonce order.Nonce, scriptEnforced bool, expectedExpiry,
+	actualExpiry uint32, reason string) *LeaseAuditEvent {
+
+	return &LeaseAuditEvent{
+		timestamp:      time.Now(),
+		ChanPoint:      chanPoint,
+		BatchID:        batchID,
+		OrderNonce:     orderNonce,
+		ScriptEnforced: scriptEnforced,
+		ExpectedExpiry: expectedExpiry,
+		ActualExpiry:   actualExpiry,
+		Reason:         reason,
+	}
+}
+
+// Mismatch returns true if the lease of the channel doesn't match the one
+// promised in the batch.
+func (e *LeaseAuditEvent) Mismatch() bool {
+	return e.Reason != ""
+}
+
+// Type returns the type of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseAuditEvent) Type() event.Type {
+	return event.TypeLeaseAudit
+}
+
+// Timestamp is the time the event happened. This will be made unique once it is
+// stored. To avoid collisions, the timestamp is adjusted on the nanosecond
+// scale to reach uniqueness.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseAuditEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// SetTimestamp updates the timestamp of the event. This is needed to adjust
+// timestamps in case they collide to ensure the global uniqueness of all event
+// timestamps.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseAuditEvent) SetTimestamp(ts time.Time) {
+	e.timestamp = ts
+}
+
+// String returns a human readable representation of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseAuditEvent) String() string {
+	if e.Mismatch() {
+		return fmt.Sprintf("LeaseAudit(%v, mismatch: %s)", e.ChanPoint,
+			e.Reason)
+	}
+	return fmt.Sprintf("LeaseAudit(%v, expiry=%d)", e.ChanPoint,
+		e.ActualExpiry)
+}
+
+// Serialize writes the event data to a binary storage format. This does not
+// serialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseAuditEvent) Serialize(w *bytes.Buffer) error {
+	var (
+		txid           = [32]byte(e.ChanPoint.Hash)
+		batchID        = [33]byte(e.BatchID)
+		nonce          = [32]byte(e.OrderNonce)
+		scriptEnforced uint8
+		reason         = []byte(e.Reason)
+	)
+	if e.ScriptEnforced {
+		scriptEnforced = 1
+	}
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(leaseAuditChanTxidType, &txid),
+		tlv.MakePrimitiveRecord(
+			leaseAuditChanIndexType, &e.ChanPoint.Index,
+		),
+		tlv.MakePrimitiveRecord(leaseAuditBatchIDType, &batchID),
+		tlv.MakePrimitiveRecord(leaseAuditOrderNonceType, &nonce),
+		tlv.MakePrimitiveRecord(
+			leaseAuditScriptEnforcedType, &scriptEnforced,
+		),
+		tlv.MakePrimitiveRecord(
+			leaseAuditExpectedExpiryType, &e.ExpectedExpiry,
+		),
+		tlv.MakePrimitiveRecord(
+			leaseAuditActualExpiryType, &e.ActualExpiry,
+		),
+		tlv.MakePrimitiveRecord(leaseAuditReasonType, &reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// Deserialize reads the event data from a binary storage format. This does not
+// deserialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseAuditEvent) Deserialize(r io.Reader) error {
+	var (
+		txid, nonce    [32]byte
+		batchID        [33]byte
+		scriptEnforced uint8
+		reason         []byte
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(leaseAuditChanTxidType, &txid),
+		tlv.MakePrimitiveRecord(
+			leaseAuditChanIndexType, &e.ChanPoint.Index,
+		),
+		tlv.MakePrimitiveRecord(leaseAuditBatchIDType, &batchID),
+		tlv.MakePrimitiveRecord(leaseAuditOrderNonceType, &nonce),
+		tlv.MakePrimitiveRecord(
+			leaseAuditScriptEnforcedType, &scriptEnforced,
+		),
+		tlv.MakePrimitiveRecord(
+			leaseAuditExpectedExpiryType, &e.ExpectedExpiry,
+		),
+		tlv.MakePrimitiveRecord(
+			leaseAuditActualExpiryType, &e.ActualExpiry,
+		),
+		tlv.MakePrimitiveRecord(leaseAuditReasonType, &reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	e.ChanPoint.Hash = txid
+	e.BatchID = batchID
+	e.OrderNonce = nonce
+	e.ScriptEnforced = scriptEnforced == 1
+	e.Reason = string(reason)
+
+	return nil
+}
+
+// A compile time assertion to make sure LeaseAuditEvent implements the
+// event.Event interface.
+var _ event.Event = (*LeaseAuditEvent)(nil)
+
+// StoreLeaseAudit adds the result of a lease audit to the lease event log. The
+// event is stored both in the main events bucket and a reference to it in the
+// channel's bucket.
+func (db *DB) StoreLeaseAudit(evt *LeaseAuditEvent) error {
+	var chanKey bytes.Buffer
+	if err := WriteElements(&chanKey, evt.ChanPoint); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		auditsBucket, err := getBucket(tx, leaseAuditsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		chanBucket, err := auditsBucket.CreateBucketIfNotExists(
+			chanKey.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return storeEventTX(chanBucket, evt)
+	})
+}
+
+// LeaseAudits returns the results of all lease audits in chronological order.
+func (db *DB) LeaseAudits() ([]*LeaseAuditEvent, error) {
+	dbEvents, err := db.AllEvents(event.TypeLeaseAudit)
+	if err != nil {
+		return nil, err
+	}
+
+	audits := make([]*LeaseAuditEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		evt, ok := dbEvent.(*LeaseAuditEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected lease audit event "+
+				"type %v", dbEvent.Type())
+		}
+		audits = append(audits, evt)
+	}
+
+	return audits, nil
+}
+
+// LeaseClosedEarlyEvent is an event implementation that records the channel of
+// a lease being force closed before the lease expired. It contains everything
+// needed to dispute the lease with the auctioneer.
+type LeaseClosedEarlyEvent struct {
+	// timestamp is the unique timestamp the event was created/recorded at.
+	timestamp time.Time
+
+	// ChanPoint is the funding outpoint of the closed channel.
+	ChanPoint wire.OutPoint
+
+	// ClosingTxid is the ID of the transaction that closed the channel.
+	ClosingTxid chainhash.Hash
+
+	// BatchID is the ID of the batch the channel was created in.
+	BatchID order.BatchID
+
+	// OrderNonce is the nonce of our order that resulted in the channel.
+	OrderNonce order.Nonce
+
+	// Purchased is true if we bought the channel with a bid.
+	Purchased bool
+
+	// RemoteNodeKey is the node key of the channel peer.
+	RemoteNodeKey [33]byte
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// CloseType is the type of the force close.
+	CloseType lndclient.CloseType
+
+	// LeaseDuration is the lease duration in blocks of the bid.
+	LeaseDuration uint32
+
+	// LeaseExpiry is the height the lease was supposed to expire at.
+	LeaseExpiry uint32
+
+	// CloseHeight is the height the channel was closed at.
+	CloseHeight uint32
+}
+
+// NewLeaseClosedEarlyEvent creates a new LeaseClosedEarlyEvent for the given
+// lease channel with the current system time as the timestamp.
+func NewLeaseClosedEarlyEvent(c *LeaseChannel, closingTxid chainhash.Hash,
+	purchased bool, remoteNodeKey [33]byte, capacity btcutil.Amount,
+	closeType lndclient.CloseType) *LeaseClosedEarlyEvent {
+
+	return &LeaseClosedEarlyEvent{
+		timestamp:     time.Now(),
+		ChanPoint:     c.ChanPoint,
+		ClosingTxid:   closingTxid,
+		BatchID:       c.BatchID,
+		OrderNonce:    c.OrderNonce,
+		Purchased:     purchased,
+		RemoteNodeKey: remoteNodeKey,
+		Capacity:      capacity,
+		CloseType:     closeType,
+		LeaseDuration: c.LeaseDuration,
+		LeaseExpiry:   c.LeaseExpiry,
+		CloseHeight:   c.CloseHeight,
+	}
+}
+
+// BlocksRemaining returns the number of blocks the lease had left when its
+// channel was closed.
+func (e *LeaseClosedEarlyEvent) BlocksRemaining() uint32 {
+	if e.CloseHeight >= e.LeaseExpiry {
+		return 0
+	}
+
+	return e.LeaseExpiry - e.CloseHeight
+}
+
+// Type returns the type of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseClosedEarlyEvent) Type() event.Type {
+	return event.TypeLeaseClosedEarly
+}
+
+// Timestamp is the time the event happened. This will be made unique once it is
+// stored. To avoid collisions, the timestamp is adjusted on the nanosecond
+// scale to reach uniqueness.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseClosedEarlyEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// SetTimestamp updates the timestamp of the event. This is needed to adjust
+// timestamps in case they collide to ensure the global uniqueness of all event
+// timestamps.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseClosedEarlyEvent) SetTimestamp(ts time.Time) {
+	e.timestamp = ts
+}
+
+// String returns a human readable representation of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseClosedEarlyEvent) String() string {
+	return fmt.Sprintf("LeaseClosedEarly(%v, blocks_remaining=%d)",
+		e.ChanPoint, e.BlocksRemaining())
+}
+
+// Serialize writes the event data to a binary storage format. This does not
+// serialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseClosedEarlyEvent) Serialize(w *bytes.Buffer) error {
+	var (
+		txid        = [32]byte(e.ChanPoint.Hash)
+		closingTxid = [32]byte(e.ClosingTxid)
+		batchID     = [33]byte(e.BatchID)
+		nonce       = [32]byte(e.OrderNonce)
+		purchased   uint8
+		capacity    = uint64(e.Capacity)
+		closeType   = uint8(e.CloseType)
+	)
+	if e.Purchased {
+		purchased = 1
+	}
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(leaseClosedChanTxidType, &txid),
+		tlv.MakePrimitiveRecord(
+			leaseClosedChanIndexType, &e.ChanPoint.Index,
+		),
+		tlv.MakePrimitiveRecord(
+			leaseClosedClosingTxidType, &closingTxid,
+		),
+		tlv.MakePrimitiveRecord(leaseClosedBatchIDType, &batchID),
+		tlv.MakePrimitiveRecord(leaseClosedOrderNonceType, &nonce),
+		tlv.MakePrimitiveRecord(leaseClosedPurchasedType, &purchased),
+		tlv.MakePrimitiveRecord(
+			leaseClosedRemoteKeyType, &e.RemoteNodeKey,
+		),
+		tlv.MakePrimitiveRecord(leaseClosedCapacityType, &capacity),
+		tlv.MakePrimitiveRecord(leaseClosedCloseTypeType, &closeType),
+		tlv.MakePrimitiveRecord(
+			leaseClosedDurationType, &e.LeaseDuration,
+		),
+		tlv.MakePrimitiveRecord(leaseClosedExpiryType, &e.LeaseExpiry),
+		tlv.MakePrimitiveRecord(
+			leaseClosedCloseHeightType, &e.CloseHeight,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// Deserialize reads the event data from a binary storage format. This does not
+// deserialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *LeaseClosedEarlyEvent) Deserialize(r io.Reader) error {
+	var (
+		txid, closingTxid, nonce [32]byte
+		batchID                  [33]byte
+		purchased, closeType     uint8
+		capacity                 uint64
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(leaseClosedChanTxidType, &txid),
+		tlv.MakePrimitiveRecord(
+			leaseClosedChanIndexType, &e.ChanPoint.Index,
+		),
+		tlv.MakePrimitiveRecord(
+			leaseClosedClosingTxidType, &closingTxid,
+		),
+		tlv.MakePrimitiveRecord(leaseClosedBatchIDType, &batchID),
+		tlv.MakePrimitiveRecord(leaseClosedOrderNonceType, &nonce),
+		tlv.MakePrimitiveRecord(leaseClosedPurchasedType, &purchased),
+		tlv.MakePrimitiveRecord(
+			leaseClosedRemoteKeyType, &e.RemoteNodeKey,
+		),
+		tlv.MakePrimitiveRecord(leaseClosedCapacityType, &capacity),
+		tlv.MakePrimitiveRecord(leaseClosedCloseTypeType, &closeType),
+		tlv.MakePrimitiveRecord(
+			leaseClosedDurationType, &e.LeaseDuration,
+		),
+		tlv.MakePrimitiveRecord(leaseClosedExpiryType, &e.LeaseExpiry),
+		tlv.MakePrimitiveRecord(
+			leaseClosedCloseHeightType, &e.CloseHeight,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	e.ChanPoint.Hash = txid
+	e.ClosingTxid = closingTxid
+	e.BatchID = batchID
+	e.OrderNonce = nonce
+	e.Purchased = purchased == 1
+	e.Capacity = btcutil.Amount(capacity)
+	e.CloseType = lndclient.CloseType(closeType)
+
+	return nil
+}
+
+// A compile time assertion to make sure LeaseClosedEarlyEvent implements the
+// event.Event interface.
+var _ event.Event = (*LeaseClosedEarlyEvent)(nil)
+
+// StoreLeaseClosedEarly adds an early close of a lease channel to the lease
+// event log. The event is stored both in the main events bucket and a
+// reference to it in the channel's bucket.
+func (db *DB) StoreLeaseClosedEarly(evt *LeaseClosedEarlyEvent) error {
+	var chanKey bytes.Buffer
+	if err := WriteElements(&chanKey, evt.ChanPoint); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		auditsBucket, err := getBucket(tx, leaseAuditsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		chanBucket, err := auditsBucket.CreateBucketIfNotExists(
+			chanKey.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return storeEventTX(chanBucket, evt)
+	})
+}
+
+// LeasesClosedEarly returns all early closes of lease channels in chronological
+// order.
+func (db *DB) LeasesClosedEarly() ([]*LeaseClosedEarlyEvent, error) {
+	dbEvents, err := db.AllEvents(event.TypeLeaseClosedEarly)
+	if err != nil {
+		return nil, err
+	}
+
+	closes := make([]*LeaseClosedEarlyEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		evt, ok := dbEvent.(*LeaseClosedEarlyEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected lease closed early "+
+				"event type %v", dbEvent.Type())
+		}
+		closes = append(closes, evt)
+	}
+
+	return closes, nil
+}
@@ -0,0 +1,98 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLeaseAudits makes sure lease audits are added to the event log and read
+// back in chronological order.
+func TestLeaseAudits(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	audits, err := db.LeaseAudits()
+	require.NoError(t, err)
+	require.Empty(t, audits)
+
+	chanPoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2}
+	passed := NewLeaseAuditEvent(
+		chanPoint, order.BatchID{3}, order.Nonce{4}, true, 702_016,
+		702_016, "",
+	)
+	require.NoError(t, db.StoreLeaseAudit(passed))
+
+	// A channel can be audited more than once.
+	failed := NewLeaseAuditEvent(
+		chanPoint, order.BatchID{3}, order.Nonce{4}, false, 702_016,
+		702_000, "lease expires at height 702000 instead of 702016",
+	)
+	require.NoError(t, db.StoreLeaseAudit(failed))
+
+	audits, err = db.LeaseAudits()
+	require.NoError(t, err)
+	require.Len(t, audits, 2)
+
+	// The timestamps are only stored with nanosecond precision.
+	for idx, expected := range []*LeaseAuditEvent{passed, failed} {
+		require.True(
+			t, expected.Timestamp().Equal(audits[idx].Timestamp()),
+		)
+		audits[idx].SetTimestamp(expected.Timestamp())
+		require.Equal(t, expected, audits[idx])
+	}
+	require.False(t, audits[0].Mismatch())
+	require.True(t, audits[1].Mismatch())
+}
+
+// TestLeasesClosedEarly makes sure early closes of lease channels are added to
+// the event log and read back in chronological order.
+func TestLeasesClosedEarly(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	closes, err := db.LeasesClosedEarly()
+	require.NoError(t, err)
+	require.Empty(t, closes)
+
+	leaseChan := &LeaseChannel{
+		ChanPoint:     wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2},
+		BatchID:       order.BatchID{3},
+		OrderNonce:    order.Nonce{4},
+		LeaseDuration: 2016,
+		LeaseExpiry:   702_016,
+		State:         LeaseChannelClosedEarly,
+		CloseHeight:   700_300,
+	}
+	closed := NewLeaseClosedEarlyEvent(
+		leaseChan, chainhash.Hash{5}, true, [33]byte{2, 6}, 1_000_000,
+		lndclient.CloseTypeRemoteForce,
+	)
+	require.NoError(t, db.StoreLeaseClosedEarly(closed))
+
+	// Audits of the same channel aren't returned as early closes.
+	audit := NewLeaseAuditEvent(
+		leaseChan.ChanPoint, leaseChan.BatchID, leaseChan.OrderNonce,
+		true, 702_016, 702_016, "",
+	)
+	require.NoError(t, db.StoreLeaseAudit(audit))
+
+	closes, err = db.LeasesClosedEarly()
+	require.NoError(t, err)
+	require.Len(t, closes, 1)
+
+	// The timestamps are only stored with nanosecond precision.
+	require.True(t, closed.Timestamp().Equal(closes[0].Timestamp()))
+	closes[0].SetTimestamp(closed.Timestamp())
+	require.Equal(t, closed, closes[0])
+	require.EqualValues(t, 1716, closes[0].BlocksRemaining())
+}
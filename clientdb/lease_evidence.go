@@ -0,0 +1,200 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// LeaseEvidenceVersion is the version of the serialization format of a lease
+// evidence.
+type LeaseEvidenceVersion uint8
+
+const (
+	// LeaseEvidenceVersionInitial is the first version of the lease
+	// evidence serialization format.
+	LeaseEvidenceVersionInitial LeaseEvidenceVersion = 0
+
+	// LatestLeaseEvidenceVersion is the version new lease evidences are
+	// serialized with.
+	LatestLeaseEvidenceVersion = LeaseEvidenceVersionInitial
+)
+
+const (
+	// leaseEvidenceChannelType is the tlv type we use to store the lease
+	// channel.
+	leaseEvidenceChannelType tlv.Type = 1
+
+	// leaseEvidenceSnapshotType is the tlv type we use to store the batch
+	// snapshot.
+	leaseEvidenceSnapshotType tlv.Type = 2
+
+	// leaseEvidenceOrderType is the tlv type we use to store our order.
+	leaseEvidenceOrderType tlv.Type = 3
+
+	// leaseEvidenceOrderSigType is the tlv type we use to store the
+	// signature of our order.
+	leaseEvidenceOrderSigType tlv.Type = 4
+
+	// leaseEvidenceOpenTxType is the tlv type we use to store the channel
+	// funding transaction.
+	leaseEvidenceOpenTxType tlv.Type = 5
+
+	// leaseEvidenceCloseTxType is the tlv type we use to store the
+	// channel closing transaction.
+	leaseEvidenceCloseTxType tlv.Type = 6
+)
+
+// LeaseEvidence bundles everything needed to prove to the auctioneer that the
+// channel of a lease was closed before the lease expired.
+type LeaseEvidence struct {
+	// Version is the version of the serialization format.
+	Version LeaseEvidenceVersion
+
+	// Channel is the lease channel including the lease terms and the
+	// height the channel was closed at.
+	Channel *LeaseChannel
+
+	// Snapshot is our snapshot of the batch the channel was created in.
+	Snapshot *LocalBatchSnapshot
+
+	// Order is our order that resulted in the channel.
+	Order order.Order
+
+	// OrderSig is the signature of the order's digest by the trader key
+	// of the order's account.
+	OrderSig []byte
+
+	// OpenTx is the transaction that funded the channel.
+	OpenTx *wire.MsgTx
+
+	// CloseTx is the transaction that closed the channel.
+	CloseTx *wire.MsgTx
+}
+
+// SerializeLeaseEvidence serializes a lease evidence, starting with its
+// version. All parts are stored as tlv records so new parts can be added
+// without breaking older readers.
+func SerializeLeaseEvidence(w *bytes.Buffer, e *LeaseEvidence) error {
+	var channel, snapshot, ourOrder, openTx, closeTx bytes.Buffer
+	if err := WriteElement(&channel, e.Channel.ChanPoint); err != nil {
+		return err
+	}
+	if err := serializeLeaseChannel(&channel, e.Channel); err != nil {
+		return err
+	}
+	err := serializeLocalBatchSnapshot(&snapshot, e.Snapshot)
+	if err != nil {
+		return err
+	}
+	if err := SerializeUnsignedOrder(e.Order, &ourOrder); err != nil {
+		return err
+	}
+	if err := WriteElement(&openTx, e.OpenTx); err != nil {
+		return err
+	}
+	if err := WriteElement(&closeTx, e.CloseTx); err != nil {
+		return err
+	}
+
+	var (
+		channelBytes  = channel.Bytes()
+		snapshotBytes = snapshot.Bytes()
+		orderBytes    = ourOrder.Bytes()
+		openTxBytes   = openTx.Bytes()
+		closeTxBytes  = closeTx.Bytes()
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(leaseEvidenceChannelType, &channelBytes),
+		tlv.MakePrimitiveRecord(
+			leaseEvidenceSnapshotType, &snapshotBytes,
+		),
+		tlv.MakePrimitiveRecord(leaseEvidenceOrderType, &orderBytes),
+		tlv.MakePrimitiveRecord(leaseEvidenceOrderSigType, &e.OrderSig),
+		tlv.MakePrimitiveRecord(leaseEvidenceOpenTxType, &openTxBytes),
+		tlv.MakePrimitiveRecord(
+			leaseEvidenceCloseTxType, &closeTxBytes,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteElement(w, uint8(e.Version)); err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// DeserializeLeaseEvidence deserializes a lease evidence that was serialized
+// with SerializeLeaseEvidence.
+func DeserializeLeaseEvidence(r io.Reader) (*LeaseEvidence, error) {
+	var version uint8
+	if err := ReadElement(r, &version); err != nil {
+		return nil, err
+	}
+
+	e := &LeaseEvidence{
+		Version: LeaseEvidenceVersion(version),
+	}
+	if e.Version > LatestLeaseEvidenceVersion {
+		return nil, fmt.Errorf("unknown lease evidence version %d",
+			e.Version)
+	}
+
+	var channel, snapshot, ourOrder, openTx, closeTx []byte
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(leaseEvidenceChannelType, &channel),
+		tlv.MakePrimitiveRecord(leaseEvidenceSnapshotType, &snapshot),
+		tlv.MakePrimitiveRecord(leaseEvidenceOrderType, &ourOrder),
+		tlv.MakePrimitiveRecord(leaseEvidenceOrderSigType, &e.OrderSig),
+		tlv.MakePrimitiveRecord(leaseEvidenceOpenTxType, &openTx),
+		tlv.MakePrimitiveRecord(leaseEvidenceCloseTxType, &closeTx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := tlvStream.Decode(r); err != nil {
+		return nil, err
+	}
+
+	var chanPoint wire.OutPoint
+	channelReader := bytes.NewReader(channel)
+	if err := ReadElement(channelReader, &chanPoint); err != nil {
+		return nil, err
+	}
+	e.Channel, err = deserializeLeaseChannel(channelReader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lease channel: %v", err)
+	}
+	e.Channel.ChanPoint = chanPoint
+
+	e.Snapshot, err = deserializeLocalBatchSnapshot(
+		bytes.NewReader(snapshot),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch snapshot: %v", err)
+	}
+
+	e.Order, err = DeserializeUnsignedOrder(bytes.NewReader(ourOrder))
+	if err != nil {
+		return nil, fmt.Errorf("invalid order: %v", err)
+	}
+
+	e.OpenTx = &wire.MsgTx{}
+	if err := e.OpenTx.Deserialize(bytes.NewReader(openTx)); err != nil {
+		return nil, fmt.Errorf("invalid funding transaction: %v", err)
+	}
+
+	e.CloseTx = &wire.MsgTx{}
+	if err := e.CloseTx.Deserialize(bytes.NewReader(closeTx)); err != nil {
+		return nil, fmt.Errorf("invalid closing transaction: %v", err)
+	}
+
+	return e, nil
+}
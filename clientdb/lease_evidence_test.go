@@ -0,0 +1,70 @@
+package clientdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSerializeLeaseEvidence makes sure a lease evidence survives a round trip
+// through its serialization and that unknown versions are rejected.
+func TestSerializeLeaseEvidence(t *testing.T) {
+	t.Parallel()
+
+	closeTx := wire.NewMsgTx(2)
+	closeTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  testBatchTx.TxHash(),
+			Index: 0,
+		},
+		SignatureScript: []byte{},
+		Witness:         wire.TxWitness{{1, 2, 3}},
+	})
+	closeTx.AddTxOut(&wire.TxOut{Value: 90_000, PkScript: []byte{4, 5}})
+
+	bid := &order.Bid{
+		Kit:         *newOrderKit(testNonce1, testDuration),
+		MinNodeTier: 1,
+	}
+	bid.AllowedNodeIDs = [][33]byte{{1, 2, 3}}
+
+	evidence := &LeaseEvidence{
+		Version: LatestLeaseEvidenceVersion,
+		Channel: &LeaseChannel{
+			ChanPoint:     wire.OutPoint{Hash: chainhash.Hash{1}},
+			BatchID:       testBatchID,
+			OrderNonce:    testNonce1,
+			LeaseDuration: testDuration,
+			ShortChanID: lnwire.ShortChannelID{
+				BlockHeight: 700_000,
+				TxIndex:     1,
+			},
+			LeaseExpiry: 700_000 + testDuration,
+			State:       LeaseChannelClosedEarly,
+			CloseHeight: 700_010,
+		},
+		Snapshot: testSnapshot,
+		Order:    bid,
+		OrderSig: []byte{9, 8, 7},
+		OpenTx:   testBatchTx,
+		CloseTx:  closeTx,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, SerializeLeaseEvidence(&buf, evidence))
+	serialized := buf.Bytes()
+
+	decoded, err := DeserializeLeaseEvidence(bytes.NewReader(serialized))
+	require.NoError(t, err)
+	require.Equal(t, evidence, decoded)
+
+	// A version we don't know yet must not be interpreted.
+	serialized[0] = uint8(LatestLeaseEvidenceVersion) + 1
+	_, err = DeserializeLeaseEvidence(bytes.NewReader(serialized))
+	require.ErrorContains(t, err, "unknown lease evidence version")
+}
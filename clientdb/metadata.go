@@ -7,13 +7,13 @@ import (
 
 	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightninglabs/pool/clientdb/migrations"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 // migration is a function which takes a prior outdated version of the database
 // instance and mutates the key/bucket structure to arrive at a more up-to-date
 // version of the database.
-type migration func(tx *bbolt.Tx) error
+type migration func(tx kvdb.RwTx) error
 
 var (
 	// metadataBucketKey stores all the metadata concerning the state of the
@@ -41,13 +41,16 @@ var (
 	// current db.
 	dbVersions = []migration{
 		migrations.AddInitialOrderTimestamps,
+		migrations.MigratePendingBatches,
+		migrations.AddOrderStateIndex,
+		migrations.MarkPartialOrderTotals,
 	}
 
 	latestDBVersion = uint32(len(dbVersions))
 )
 
 // getDBVersion retrieves the current database version.
-func getDBVersion(bucket *bbolt.Bucket) (uint32, error) {
+func getDBVersion(bucket kvdb.RBucket) (uint32, error) {
 	versionBytes := bucket.Get(dbVersionKey)
 	if versionBytes == nil {
 		return 0, errors.New("database version not found")
@@ -56,15 +59,25 @@ func getDBVersion(bucket *bbolt.Bucket) (uint32, error) {
 }
 
 // setDBVersion updates the current database version.
-func setDBVersion(bucket *bbolt.Bucket, version uint32) error {
+func setDBVersion(bucket kvdb.RwBucket, version uint32) error {
 	var b [4]byte
 	byteOrder.PutUint32(b[:], version)
 	return bucket.Put(dbVersionKey, b[:])
 }
 
-// getBucket retrieves the bucket with the given key.
-func getBucket(tx *bbolt.Tx, key []byte) (*bbolt.Bucket, error) {
-	bucket := tx.Bucket(key)
+// getBucket retrieves the top level bucket with the given key for writing.
+func getBucket(tx kvdb.RwTx, key []byte) (kvdb.RwBucket, error) {
+	bucket := tx.ReadWriteBucket(key)
+	if bucket == nil {
+		return nil, fmt.Errorf("bucket \"%v\" does not exist",
+			string(key))
+	}
+	return bucket, nil
+}
+
+// getReadBucket retrieves the top level bucket with the given key for reading.
+func getReadBucket(tx kvdb.RTx, key []byte) (kvdb.RBucket, error) {
+	bucket := tx.ReadBucket(key)
 	if bucket == nil {
 		return nil, fmt.Errorf("bucket \"%v\" does not exist",
 			string(key))
@@ -75,10 +88,10 @@ func getBucket(tx *bbolt.Tx, key []byte) (*bbolt.Bucket, error) {
 // getNestedBucket retrieves the nested bucket with the given key found within
 // the given bucket. If the bucket does not exist and `create` is true, then the
 // bucket is created.
-func getNestedBucket(bucket *bbolt.Bucket, key []byte,
-	create bool) (*bbolt.Bucket, error) {
+func getNestedBucket(bucket kvdb.RwBucket, key []byte,
+	create bool) (kvdb.RwBucket, error) {
 
-	nestedBucket := bucket.Bucket(key)
+	nestedBucket := bucket.NestedReadWriteBucket(key)
 	if nestedBucket == nil && create {
 		return bucket.CreateBucketIfNotExists(key)
 	}
@@ -89,19 +102,25 @@ func getNestedBucket(bucket *bbolt.Bucket, key []byte,
 	return nestedBucket, nil
 }
 
+// getNestedReadBucket retrieves the nested bucket with the given key found
+// within the given bucket for reading.
+func getNestedReadBucket(bucket kvdb.RBucket, key []byte) (kvdb.RBucket,
+	error) {
+
+	nestedBucket := bucket.NestedReadBucket(key)
+	if nestedBucket == nil {
+		return nil, fmt.Errorf("nested bucket \"%v\" does not exist",
+			string(key))
+	}
+	return nestedBucket, nil
+}
+
 // syncVersions function is used for safe db version synchronization. It
-// applies migration functions to the current database and recovers the
-// previous state of db if at least one error/panic appeared during migration.
-func syncVersions(db *bbolt.DB) error {
-	var currentVersion uint32
-	err := db.View(func(tx *bbolt.Tx) error {
-		metadata, err := getBucket(tx, metadataBucketKey)
-		if err != nil {
-			return err
-		}
-		currentVersion, err = getDBVersion(metadata)
-		return err
-	})
+// applies all pending migration functions to the current database, one
+// transaction per migration, and refuses to open a database that has a higher
+// version than the latest one known to this binary.
+func syncVersions(db *DB) error {
+	currentVersion, err := currentDBVersion(db)
 	if err != nil {
 		return err
 	}
@@ -128,30 +147,76 @@ func syncVersions(db *bbolt.DB) error {
 
 	log.Infof("Performing database schema migration")
 
-	// Otherwise we execute the migrations serially within a single database
-	// transaction to ensure the migration is atomic.
-	return db.Update(func(tx *bbolt.Tx) error {
-		for v := currentVersion; v < latestDBVersion; v++ {
-			log.Infof("Applying migration #%v", v+1)
+	// Otherwise we execute the migrations serially, each within its own
+	// database transaction that also bumps the version. That way each
+	// migration is atomic and a failing migration leaves the database at
+	// the version of the last successful one.
+	for v := currentVersion; v < latestDBVersion; v++ {
+		log.Infof("Applying migration #%v", v+1)
 
-			migration := dbVersions[v]
+		migration := dbVersions[v]
+		err := db.Update(func(tx kvdb.RwTx) error {
 			if err := migration(tx); err != nil {
-				log.Infof("Unable to apply migration #%v", v+1)
 				return err
 			}
+
+			metadata, err := getBucket(tx, metadataBucketKey)
+			if err != nil {
+				return err
+			}
+			return setDBVersion(metadata, v+1)
+		})
+		if err != nil {
+			log.Errorf("Unable to apply migration #%v: %v", v+1,
+				err)
+			return err
 		}
+	}
+
+	return nil
+}
+
+// checkLatestVersion makes sure the database is at the latest version known
+// to this binary without attempting to migrate it. This is used for databases
+// that were opened in read-only mode.
+func checkLatestVersion(db *DB) error {
+	currentVersion, err := currentDBVersion(db)
+	if err != nil {
+		return err
+	}
 
-		metadata, err := getBucket(tx, metadataBucketKey)
+	switch {
+	case currentVersion > latestDBVersion:
+		return ErrDBReversion
+
+	case currentVersion < latestDBVersion:
+		return fmt.Errorf("database version %d needs to be migrated "+
+			"to version %d which is not possible in read-only mode",
+			currentVersion, latestDBVersion)
+	}
+
+	return nil
+}
+
+// currentDBVersion returns the version stored in the database's metadata
+// bucket.
+func currentDBVersion(db *DB) (uint32, error) {
+	var currentVersion uint32
+	err := db.View(func(tx kvdb.RTx) error {
+		metadata, err := getReadBucket(tx, metadataBucketKey)
 		if err != nil {
 			return err
 		}
-		return setDBVersion(metadata, latestDBVersion)
+		currentVersion, err = getDBVersion(metadata)
+		return err
 	})
+
+	return currentVersion, err
 }
 
 // storeRandomLockID generates a random lock ID backed by the system's CSPRNG
 // and stores it under the metadata bucket.
-func storeRandomLockID(metadata *bbolt.Bucket) error {
+func storeRandomLockID(metadata kvdb.RwBucket) error {
 	var lockID wtxmgr.LockID
 	if _, err := rand.Read(lockID[:]); err != nil {
 		return err
@@ -163,8 +228,8 @@ func storeRandomLockID(metadata *bbolt.Bucket) error {
 // backing lnd node's wallet.
 func (db *DB) LockID() (wtxmgr.LockID, error) {
 	var lockID wtxmgr.LockID
-	err := db.View(func(tx *bbolt.Tx) error {
-		metadata, err := getBucket(tx, metadataBucketKey)
+	err := db.View(func(tx kvdb.RTx) error {
+		metadata, err := getReadBucket(tx, metadataBucketKey)
 		if err != nil {
 			return err
 		}
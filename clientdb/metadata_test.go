@@ -0,0 +1,150 @@
+package clientdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/pool/metrics"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// createFixtureDB creates a new database in the given directory, stores the
+// given orders in it and then rewinds it to the given version by calling the
+// rewind function and setting the version number.
+func createFixtureDB(t *testing.T, dir string, version uint32,
+	orders []order.Order, rewind func(tx kvdb.RwTx) error) {
+
+	t.Helper()
+
+	db, err := openTestDB(dir)
+	require.NoError(t, err)
+
+	for _, o := range orders {
+		require.NoError(t, db.SubmitOrder(o))
+	}
+
+	err = db.Update(func(tx kvdb.RwTx) error {
+		if rewind != nil {
+			if err := rewind(tx); err != nil {
+				return err
+			}
+		}
+
+		metadata, err := getBucket(tx, metadataBucketKey)
+		if err != nil {
+			return err
+		}
+		return setDBVersion(metadata, version)
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
+
+// readDBVersion returns the version stored in the metadata of the database.
+func readDBVersion(t *testing.T, db *DB) uint32 {
+	t.Helper()
+
+	var version uint32
+	err := db.View(func(tx kvdb.RTx) error {
+		metadata, err := getReadBucket(tx, metadataBucketKey)
+		if err != nil {
+			return err
+		}
+
+		version, err = getDBVersion(metadata)
+		return err
+	})
+	require.NoError(t, err)
+
+	return version
+}
+
+// TestMigrateOrderStateIndex makes sure a database created before the order
+// state index existed is migrated to the latest version when opened and the
+// index is built from the existing orders.
+func TestMigrateOrderStateIndex(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	submitted := dummyOrder(500000, 1337)
+	submitted.State = order.StateSubmitted
+	executed := dummyOrder(500000, 1337)
+	orders := []order.Order{
+		&order.Ask{Kit: *submitted}, &order.Bid{Kit: *executed},
+	}
+
+	// Version 2 didn't know about the order state index yet.
+	createFixtureDB(t, dir, 2, orders, func(tx kvdb.RwTx) error {
+		return tx.DeleteTopLevelBucket(orderStateIndexBucketKey)
+	})
+
+	db, err := openTestDB(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.Equal(t, latestDBVersion, readDBVersion(t, db))
+
+	active, err := db.GetOrdersByState(order.StateSubmitted)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, submitted.Nonce(), active[0].Nonce())
+
+	archived, err := db.GetOrdersByState(order.StateExecuted)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	require.Equal(t, executed.Nonce(), archived[0].Nonce())
+}
+
+// TestDBReversion makes sure a database with a higher version than the latest
+// known one is refused.
+func TestDBReversion(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	createFixtureDB(t, dir, latestDBVersion+1, nil, nil)
+
+	_, err := openTestDB(dir)
+	require.ErrorIs(t, err, ErrDBReversion)
+}
+
+// TestFailedMigration makes sure a failing migration leaves the database at
+// the version of the last successful migration.
+//
+// NOTE: This test modifies the global list of migrations and therefore must
+// not run in parallel.
+func TestFailedMigration(t *testing.T) {
+	dir := t.TempDir()
+	createFixtureDB(t, dir, latestDBVersion, nil, nil)
+
+	// Add two more migrations, of which only the first one succeeds.
+	errMigration := errors.New("migration failed")
+	oldVersions, oldLatest := dbVersions, latestDBVersion
+	dbVersions = append(
+		append([]migration{}, oldVersions...),
+		func(tx kvdb.RwTx) error {
+			return nil
+		},
+		func(tx kvdb.RwTx) error {
+			return errMigration
+		},
+	)
+	latestDBVersion = uint32(len(dbVersions))
+	defer func() {
+		dbVersions, latestDBVersion = oldVersions, oldLatest
+	}()
+
+	_, err := openTestDB(dir)
+	require.ErrorIs(t, err, errMigration)
+
+	// Open the backend directly, as opening the DB would attempt to apply
+	// the failing migration again.
+	backend, _, err := kvdb.GetTestBackend(dir, DBFilename)
+	require.NoError(t, err)
+	db := &DB{backend: backend, dir: dir, metrics: metrics.Disabled}
+	defer db.Close()
+
+	require.Equal(t, oldLatest+1, readDBVersion(t, db))
+}
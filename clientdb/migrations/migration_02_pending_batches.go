@@ -0,0 +1,150 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// batchBucketKey is the top level bucket where we can find all
+	// information about batches we've participated in.
+	batchBucketKey = []byte("batch")
+
+	// pendingBatchIDKey is the key under which the ID of the pending batch
+	// is stored.
+	pendingBatchIDKey = []byte("pending-id")
+
+	// pendingBatchesBucketKey is the key of the bucket nested within the
+	// batch bucket that houses a sub-bucket for each pending batch.
+	pendingBatchesBucketKey = []byte("pending-batches")
+
+	// pendingBatchAccountsBucketKey is the key of the bucket that stores
+	// the staged account updates of a pending batch.
+	pendingBatchAccountsBucketKey = []byte("pending-accounts")
+
+	// pendingBatchOrdersBucketKey is the key of the bucket that stores the
+	// staged order updates of a pending batch.
+	pendingBatchOrdersBucketKey = []byte("pending-orders")
+
+	// batchSnapshotBucketKey is the top level bucket where we'll find
+	// snapshot information about all batches we have participated in.
+	batchSnapshotBucketKey = []byte("batch-snapshot-bucket")
+
+	// batchSnapshotPendingKey is the legacy key under which the snapshot
+	// of the single pending batch was stored.
+	batchSnapshotPendingKey = []byte("batch-snapshot-pending")
+
+	// batchSnapshotPendingBucketKey is the key of the bucket nested within
+	// the snapshot bucket that stores the snapshots of all pending
+	// batches, keyed by their batch ID.
+	batchSnapshotPendingBucketKey = []byte("batch-snapshot-pending-bucket")
+)
+
+// MigratePendingBatches moves the staged updates and the snapshot of the
+// legacy single pending batch into the new layout where pending batches are
+// stored in sub-buckets keyed by their batch ID. The pending batch ID key is
+// kept, as it now references the most recent pending batch.
+func MigratePendingBatches(tx kvdb.RwTx) error {
+	batchBucket := tx.ReadWriteBucket(batchBucketKey)
+	if batchBucket == nil {
+		return fmt.Errorf("bucket \"%v\" does not exist",
+			string(batchBucketKey))
+	}
+	snapshotBucket := tx.ReadWriteBucket(batchSnapshotBucketKey)
+	if snapshotBucket == nil {
+		return fmt.Errorf("bucket \"%v\" does not exist",
+			string(batchSnapshotBucketKey))
+	}
+
+	pendingBatchesBucket, err := batchBucket.CreateBucketIfNotExists(
+		pendingBatchesBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+	pendingSnapshotsBucket, err := snapshotBucket.CreateBucketIfNotExists(
+		batchSnapshotPendingBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	// If there is no pending batch, there is nothing else to migrate. We
+	// still clean up any leftovers of the legacy layout.
+	batchID := batchBucket.Get(pendingBatchIDKey)
+	if batchID == nil {
+		return deleteLegacyPendingBatch(batchBucket, snapshotBucket)
+	}
+
+	// Move the staged account and order updates into the bucket of the
+	// pending batch.
+	pendingBatchBucket, err := pendingBatchesBucket.CreateBucketIfNotExists(
+		batchID,
+	)
+	if err != nil {
+		return err
+	}
+	for _, key := range [][]byte{
+		pendingBatchAccountsBucketKey, pendingBatchOrdersBucketKey,
+	} {
+		dst, err := pendingBatchBucket.CreateBucketIfNotExists(key)
+		if err != nil {
+			return err
+		}
+
+		src := batchBucket.NestedReadWriteBucket(key)
+		if src == nil {
+			continue
+		}
+		if err := copyBucket(src, dst); err != nil {
+			return err
+		}
+	}
+
+	// Then move the snapshot of the pending batch.
+	rawSnapshot := snapshotBucket.Get(batchSnapshotPendingKey)
+	if rawSnapshot != nil {
+		err := pendingSnapshotsBucket.Put(batchID, rawSnapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	return deleteLegacyPendingBatch(batchBucket, snapshotBucket)
+}
+
+// deleteLegacyPendingBatch removes the staged updates and the snapshot of the
+// single pending batch from their legacy location.
+func deleteLegacyPendingBatch(batchBucket, snapshotBucket kvdb.RwBucket) error {
+	for _, key := range [][]byte{
+		pendingBatchAccountsBucketKey, pendingBatchOrdersBucketKey,
+	} {
+		err := batchBucket.DeleteNestedBucket(key)
+		if err != nil && err != kvdb.ErrBucketNotFound {
+			return err
+		}
+	}
+
+	return snapshotBucket.Delete(batchSnapshotPendingKey)
+}
+
+// copyBucket recursively copies all keys and nested buckets of the src bucket
+// into the dst bucket.
+func copyBucket(src, dst kvdb.RwBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		// A nil value indicates a nested bucket.
+		if v == nil {
+			nestedDst, err := dst.CreateBucketIfNotExists(k)
+			if err != nil {
+				return err
+			}
+
+			return copyBucket(
+				src.NestedReadWriteBucket(k), nestedDst,
+			)
+		}
+
+		return dst.Put(k, v)
+	})
+}
@@ -0,0 +1,95 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigratePendingBatches makes sure the legacy single pending batch is
+// moved into its own sub-bucket keyed by the batch ID.
+func TestMigratePendingBatches(t *testing.T) {
+	t.Parallel()
+
+	db := newTestBackend(t)
+
+	var (
+		batchID    = []byte{0x01, 0x02, 0x03}
+		accountKey = []byte("account")
+		nonce      = []byte("nonce")
+		orderKey   = []byte("order")
+		snapshot   = []byte("snapshot")
+	)
+
+	// Create the legacy layout with a single pending batch first.
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		batchBucket, err := tx.CreateTopLevelBucket(batchBucketKey)
+		require.NoError(t, err)
+		require.NoError(t, batchBucket.Put(pendingBatchIDKey, batchID))
+
+		accounts, err := batchBucket.CreateBucket(
+			pendingBatchAccountsBucketKey,
+		)
+		require.NoError(t, err)
+		require.NoError(t, accounts.Put(accountKey, []byte{0x01}))
+
+		orders, err := batchBucket.CreateBucket(
+			pendingBatchOrdersBucketKey,
+		)
+		require.NoError(t, err)
+		orderBucket, err := orders.CreateBucket(nonce)
+		require.NoError(t, err)
+		require.NoError(t, orderBucket.Put(orderKey, []byte{0x02}))
+
+		snapshotBucket, err := tx.CreateTopLevelBucket(
+			batchSnapshotBucketKey,
+		)
+		require.NoError(t, err)
+		return snapshotBucket.Put(batchSnapshotPendingKey, snapshot)
+	}, func() {})
+	require.NoError(t, err)
+
+	err = kvdb.Update(db, MigratePendingBatches, func() {})
+	require.NoError(t, err)
+
+	// All data should now be found in the new layout and the legacy
+	// buckets and keys should be gone.
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		batchBucket := tx.ReadBucket(batchBucketKey)
+		require.Equal(t, batchID, batchBucket.Get(pendingBatchIDKey))
+		require.Nil(t, batchBucket.NestedReadBucket(
+			pendingBatchAccountsBucketKey,
+		))
+		require.Nil(t, batchBucket.NestedReadBucket(
+			pendingBatchOrdersBucketKey,
+		))
+
+		pendingBatch := batchBucket.NestedReadBucket(
+			pendingBatchesBucketKey,
+		).NestedReadBucket(batchID)
+		require.NotNil(t, pendingBatch)
+
+		accounts := pendingBatch.NestedReadBucket(
+			pendingBatchAccountsBucketKey,
+		)
+		require.Equal(t, []byte{0x01}, accounts.Get(accountKey))
+
+		orderBucket := pendingBatch.NestedReadBucket(
+			pendingBatchOrdersBucketKey,
+		).NestedReadBucket(nonce)
+		require.NotNil(t, orderBucket)
+		require.Equal(t, []byte{0x02}, orderBucket.Get(orderKey))
+
+		snapshotBucket := tx.ReadBucket(batchSnapshotBucketKey)
+		require.Nil(t, snapshotBucket.Get(batchSnapshotPendingKey))
+		require.Equal(
+			t, snapshot, snapshotBucket.NestedReadBucket(
+				batchSnapshotPendingBucketKey,
+			).Get(batchID),
+		)
+
+		return nil
+	}, func() {})
+	require.NoError(t, err)
+}
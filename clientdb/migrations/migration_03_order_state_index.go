@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// orderStateOffset is the offset of the order state within a
+	// serialized order. It is preceded by the 32 byte preimage, the 4 byte
+	// version and the 1 byte order type.
+	orderStateOffset = 32 + 4 + 1
+)
+
+var (
+	// orderKey is the key that stores the serialized order. It is nested
+	// within the sub-bucket for each order.
+	orderKey = []byte("order")
+
+	// orderStateIndexBucketKey is the top level bucket that indexes the
+	// nonces of all orders by their current state.
+	orderStateIndexBucketKey = []byte("order-state-index")
+)
+
+// AddOrderStateIndex builds the order state index from all existing orders.
+// Each order's nonce is added to the sub-bucket of the index that corresponds
+// to the order's current state.
+func AddOrderStateIndex(tx kvdb.RwTx) error {
+	ordersBucket := tx.ReadWriteBucket(ordersBucketKey)
+	if ordersBucket == nil {
+		return fmt.Errorf("bucket \"%v\" does not exist",
+			string(ordersBucketKey))
+	}
+
+	indexBucket, err := tx.CreateTopLevelBucket(
+		orderStateIndexBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	return ordersBucket.ForEach(func(nonce, val []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if val != nil {
+			return nil
+		}
+
+		orderBucket := ordersBucket.NestedReadWriteBucket(nonce)
+		orderBytes := orderBucket.Get(orderKey)
+		if len(orderBytes) <= orderStateOffset {
+			return fmt.Errorf("invalid order %x", nonce)
+		}
+
+		state := orderBytes[orderStateOffset]
+		stateBucket, err := indexBucket.CreateBucketIfNotExists(
+			[]byte{state},
+		)
+		if err != nil {
+			return err
+		}
+
+		return stateBucket.Put(nonce, []byte{})
+	})
+}
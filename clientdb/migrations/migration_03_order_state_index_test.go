@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddOrderStateIndex makes sure the order state index is built from the
+// states of all existing orders.
+func TestAddOrderStateIndex(t *testing.T) {
+	t.Parallel()
+
+	db := newTestBackend(t)
+
+	orderStates := map[string]byte{
+		"nonce-1": 0,
+		"nonce-2": 2,
+		"nonce-3": 0,
+	}
+
+	// Store a few orders that only contain the fields up to and including
+	// the state.
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		ordersBucket, err := tx.CreateTopLevelBucket(ordersBucketKey)
+		require.NoError(t, err)
+
+		for nonce, state := range orderStates {
+			orderBucket, err := ordersBucket.CreateBucket(
+				[]byte(nonce),
+			)
+			require.NoError(t, err)
+
+			orderBytes := make([]byte, orderStateOffset+1)
+			orderBytes[orderStateOffset] = state
+			err = orderBucket.Put(orderKey, orderBytes)
+			require.NoError(t, err)
+		}
+
+		return nil
+	}, func() {})
+	require.NoError(t, err)
+
+	err = kvdb.Update(db, AddOrderStateIndex, func() {})
+	require.NoError(t, err)
+
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		indexBucket := tx.ReadBucket(orderStateIndexBucketKey)
+		require.NotNil(t, indexBucket)
+
+		for nonce, state := range orderStates {
+			stateBucket := indexBucket.NestedReadBucket(
+				[]byte{state},
+			)
+			require.NotNil(t, stateBucket)
+			require.NotNil(t, stateBucket.Get([]byte(nonce)))
+		}
+
+		submitted := indexBucket.NestedReadBucket([]byte{0})
+		numSubmitted := 0
+		err := submitted.ForEach(func(_, _ []byte) error {
+			numSubmitted++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, numSubmitted)
+
+		return nil
+	}, func() {})
+	require.NoError(t, err)
+}
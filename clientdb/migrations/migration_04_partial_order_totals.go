@@ -0,0 +1,101 @@
+package migrations
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+const (
+	// orderUnitsOffset is the offset of the order's number of units within
+	// a serialized order. It is preceded by the 32 byte preimage, the 4
+	// byte version, the 1 byte order type, the 1 byte state, the 4 byte
+	// fixed rate and the 8 byte amount.
+	orderUnitsOffset = 32 + 4 + 1 + 1 + 4 + 8
+
+	// orderUnitsUnfulfilledOffset is the offset of the order's number of
+	// unfulfilled units within a serialized order. It is preceded by the
+	// units, the 8 byte multisig key locator, the 8 byte max batch fee
+	// rate and the 33 byte account key.
+	orderUnitsUnfulfilledOffset = orderUnitsOffset + 8 + 8 + 8 + 33
+
+	// orderTotalsPartialType is the tlv type of the flag that marks the
+	// premium and fee totals of an order as partial.
+	orderTotalsPartialType = 19
+)
+
+var (
+	// orderTlvKey is a key within the order bucket for additional, tlv
+	// encoded data.
+	orderTlvKey = []byte("order-tlv")
+
+	// checksumBucketKey is the key of the sub-bucket that holds the CRC32
+	// checksums of the records stored in its parent bucket.
+	checksumBucketKey = []byte("record-checksums")
+
+	// totalsPartialRecord is the raw tlv record that sets the totals
+	// partial flag of an order. It consists of the type, the length and
+	// the value, each encoded in a single byte.
+	totalsPartialRecord = []byte{orderTotalsPartialType, 1, 1}
+)
+
+// MarkPartialOrderTotals marks the premium and fee totals of all existing
+// orders that were already matched in a batch as partial. The totals of all
+// orders start at zero, which is only accurate for orders that were never
+// matched before.
+func MarkPartialOrderTotals(tx kvdb.RwTx) error {
+	ordersBucket := tx.ReadWriteBucket(ordersBucketKey)
+	if ordersBucket == nil {
+		return fmt.Errorf("bucket \"%v\" does not exist",
+			string(ordersBucketKey))
+	}
+
+	return ordersBucket.ForEach(func(nonce, val []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if val != nil {
+			return nil
+		}
+
+		orderBucket := ordersBucket.NestedReadWriteBucket(nonce)
+		orderBytes := orderBucket.Get(orderKey)
+		if len(orderBytes) < orderUnitsUnfulfilledOffset+8 {
+			return fmt.Errorf("invalid order %x", nonce)
+		}
+
+		units := byteOrder.Uint64(orderBytes[orderUnitsOffset:])
+		unitsUnfulfilled := byteOrder.Uint64(
+			orderBytes[orderUnitsUnfulfilledOffset:],
+		)
+		if units == unitsUnfulfilled {
+			return nil
+		}
+
+		// All tlv types that existed before are lower than the type of
+		// the new record, so we can simply append it to the stream.
+		tlvData := append(
+			[]byte(nil), orderBucket.Get(orderTlvKey)...,
+		)
+		tlvData = append(tlvData, totalsPartialRecord...)
+
+		return putRecord(orderBucket, orderTlvKey, tlvData)
+	})
+}
+
+// putRecord stores the given value under the key in the target bucket and
+// updates its checksum if the bucket already has a checksum sub-bucket.
+func putRecord(targetBucket kvdb.RwBucket, key, value []byte) error {
+	checksums := targetBucket.NestedReadWriteBucket(checksumBucketKey)
+	if checksums != nil {
+		var checksum [4]byte
+		binary.BigEndian.PutUint32(
+			checksum[:], crc32.ChecksumIEEE(value),
+		)
+		if err := checksums.Put(key, checksum[:]); err != nil {
+			return err
+		}
+	}
+
+	return targetBucket.Put(key, value)
+}
@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMarkPartialOrderTotals makes sure only the totals of orders that were
+// already matched are marked as partial and that their existing tlv data is
+// kept.
+func TestMarkPartialOrderTotals(t *testing.T) {
+	t.Parallel()
+
+	db := newTestBackend(t)
+
+	type testOrder struct {
+		units            uint64
+		unitsUnfulfilled uint64
+		tlvData          []byte
+		expectedTlvData  []byte
+	}
+	orders := map[string]testOrder{
+		// An order that was never matched keeps its tlv data.
+		"unmatched": {
+			units:            5,
+			unitsUnfulfilled: 5,
+			tlvData:          []byte{3, 1, 1},
+			expectedTlvData:  []byte{3, 1, 1},
+		},
+		// A partially filled order gets the flag appended.
+		"partial": {
+			units:            5,
+			unitsUnfulfilled: 2,
+			tlvData:          []byte{3, 1, 1},
+			expectedTlvData:  []byte{3, 1, 1, 19, 1, 1},
+		},
+		// A filled order without any tlv data only gets the flag.
+		"filled": {
+			units:            5,
+			unitsUnfulfilled: 0,
+			expectedTlvData:  []byte{19, 1, 1},
+		},
+	}
+
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		ordersBucket, err := tx.CreateTopLevelBucket(ordersBucketKey)
+		require.NoError(t, err)
+
+		for nonce, o := range orders {
+			orderBucket, err := ordersBucket.CreateBucket(
+				[]byte(nonce),
+			)
+			require.NoError(t, err)
+
+			orderBytes := make(
+				[]byte, orderUnitsUnfulfilledOffset+12,
+			)
+			byteOrder.PutUint64(
+				orderBytes[orderUnitsOffset:], o.units,
+			)
+			byteOrder.PutUint64(
+				orderBytes[orderUnitsUnfulfilledOffset:],
+				o.unitsUnfulfilled,
+			)
+			err = orderBucket.Put(orderKey, orderBytes)
+			require.NoError(t, err)
+
+			if o.tlvData != nil {
+				err = orderBucket.Put(orderTlvKey, o.tlvData)
+				require.NoError(t, err)
+			}
+		}
+
+		return nil
+	}, func() {})
+	require.NoError(t, err)
+
+	err = kvdb.Update(db, MarkPartialOrderTotals, func() {})
+	require.NoError(t, err)
+
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		ordersBucket := tx.ReadBucket(ordersBucketKey)
+		for nonce, o := range orders {
+			orderBucket := ordersBucket.NestedReadBucket(
+				[]byte(nonce),
+			)
+			require.Equal(
+				t, o.expectedTlvData,
+				orderBucket.Get(orderTlvKey), nonce,
+			)
+		}
+
+		return nil
+	}, func() {})
+	require.NoError(t, err)
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBackend creates a new, empty database backend for a test. Depending
+// on the build tags this is either a bolt or a postgres backend.
+func newTestBackend(t *testing.T) kvdb.Backend {
+	t.Helper()
+
+	db, cleanup, err := kvdb.GetTestBackend(t.TempDir(), "test.db")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+		cleanup()
+	})
+
+	return db
+}
@@ -5,13 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/tlv"
-	"go.etcd.io/bbolt"
 )
 
 const (
@@ -34,6 +36,77 @@ const (
 	// notAllowedNodeIDsType is the tlv type we use to store the list of
 	// node ids the order is not allowed to match with.
 	notAllowedNodeIDsType tlv.Type = 5
+
+	// orderLabelType is the tlv type we use to store the optional label of
+	// an order.
+	orderLabelType tlv.Type = 6
+
+	// askMinDistinctPeersType is the tlv type we use to store the minimum
+	// number of distinct peers of an ask order.
+	askMinDistinctPeersType tlv.Type = 7
+
+	// orderMaxChainFeeType is the tlv type we use to store the maximum
+	// chain fee an order is willing to pay in a batch.
+	orderMaxChainFeeType tlv.Type = 8
+
+	// orderRecurrenceRemainingType is the tlv type we use to store the
+	// number of remaining resubmissions of a recurring order.
+	orderRecurrenceRemainingType tlv.Type = 9
+
+	// orderRecurrenceChainType is the tlv type we use to store the nonces
+	// of the previous orders of a recurring order.
+	orderRecurrenceChainType tlv.Type = 10
+
+	// orderReplacesType is the tlv type we use to store the nonce of the
+	// order an order replaced.
+	orderReplacesType tlv.Type = 11
+
+	// bidNewNodesOnlyType is the tlv type we use to store the flag that
+	// marks a bid as only to be matched with asks of new nodes.
+	bidNewNodesOnlyType tlv.Type = 12
+
+	// orderActivationFeeRateType is the tlv type we use to store the fee
+	// rate at which a staged order is activated automatically.
+	orderActivationFeeRateType tlv.Type = 13
+
+	// orderExpiryHeightType is the tlv type we use to store the block
+	// height at which an order expires.
+	orderExpiryHeightType tlv.Type = 14
+
+	// orderExpiryUnixType is the tlv type we use to store the unix
+	// timestamp at which an order expires.
+	orderExpiryUnixType tlv.Type = 15
+
+	// orderPremiumTotalType is the tlv type we use to store the total
+	// premium an order paid or earned.
+	orderPremiumTotalType tlv.Type = 16
+
+	// orderExecutionFeeTotalType is the tlv type we use to store the total
+	// execution fee an order paid.
+	orderExecutionFeeTotalType tlv.Type = 17
+
+	// orderChainFeeTotalType is the tlv type we use to store the total
+	// chain fee share an order paid.
+	orderChainFeeTotalType tlv.Type = 18
+
+	// orderTotalsPartialType is the tlv type we use to store the flag that
+	// marks the totals of an order as not including all batches it was
+	// matched in.
+	orderTotalsPartialType tlv.Type = 19
+
+	// orderUnannouncedChannelType is the tlv type we use to store the flag
+	// that marks an order as only to be matched for unannounced channels.
+	orderUnannouncedChannelType tlv.Type = 20
+
+	// orderZeroConfChannelType is the tlv type we use to store the flag
+	// that marks a bid as requesting or an ask as serving zero conf
+	// channels.
+	orderZeroConfChannelType tlv.Type = 21
+
+	// orderAutoUpdateFeeRateType is the tlv type we use to store the flag
+	// that marks an order to be replaced with a higher max batch fee rate
+	// if a batch is rejected because of its fee rate.
+	orderAutoUpdateFeeRateType tlv.Type = 22
 )
 
 var (
@@ -92,7 +165,7 @@ type orderCallback func(nonce order.Nonce, rawOrder []byte,
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) SubmitOrder(newOrder order.Order) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.RwTx) error {
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
@@ -113,49 +186,16 @@ func (db *DB) SubmitOrder(newOrder order.Order) error {
 			return err
 		}
 
-		// Serialize and store the order now that we know it doesn't
-		// exist yet.
-		var w bytes.Buffer
-		err = SerializeOrder(newOrder, &w)
-		if err != nil {
-			return err
-		}
-
 		// Create an event for the initial order and store it in the
 		// order's event bucket but also in the global events under an
 		// unique timestamp.
 		evt := NewCreatedEvent(newOrder)
-		err = storeOrderTX(
-			rootBucket, newOrder.Nonce(), w.Bytes(), evt,
-		)
+		err = storeFullOrderTX(rootBucket, newOrder, evt)
 		if err != nil {
 			return err
 		}
-		err = storeOrderMinUnitsMatchTX(
-			rootBucket, newOrder.Nonce(),
-			newOrder.Details().MinUnitsMatch,
-		)
-		if err != nil {
-			return err
-		}
-
-		// Next, store any additional order data as a tlv stream.
-		if err := storeOrderTlvTX(
-			rootBucket, newOrder.Nonce(), newOrder,
-		); err != nil {
-			return err
-		}
 
-		// Finally, store the min node tier, but only if this is a Bid
-		// order.
-		if bidOrder, ok := newOrder.(*order.Bid); ok {
-			return storeOrderMinNoderTierTX(
-				rootBucket, newOrder.Nonce(),
-				bidOrder.MinNodeTier,
-			)
-		}
-
-		return nil
+		return indexOrderStateTX(tx, newOrder)
 	})
 }
 
@@ -164,40 +204,89 @@ func (db *DB) SubmitOrder(newOrder order.Order) error {
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) UpdateOrder(nonce order.Nonce, modifiers ...order.Modifier) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.RwTx) error {
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
-		_, err = updateOrder(rootBucket, rootBucket, nonce, modifiers)
-		return err
+		return updateOrderInPlace(rootBucket, nonce, modifiers)
 	})
 }
 
+// OrderUpdateError is the error returned by UpdateOrders if one of the orders
+// couldn't be updated. None of the orders are updated in that case.
+type OrderUpdateError struct {
+	// Nonce is the nonce of the order that couldn't be updated.
+	Nonce order.Nonce
+
+	// Err is the reason the order couldn't be updated.
+	Err error
+}
+
+// Error returns a human readable representation of the error.
+//
+// NOTE: This is part of the error interface.
+func (e *OrderUpdateError) Error() string {
+	return fmt.Sprintf("unable to update order %v: %v", e.Nonce, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *OrderUpdateError) Unwrap() error {
+	return e.Err
+}
+
 // UpdateOrders atomically updates a list of orders in the database according to
-// the given modifiers.
+// the given modifiers. Either all orders are updated or none of them are. If an
+// order cannot be updated, an *OrderUpdateError is returned.
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) UpdateOrders(nonces []order.Nonce,
 	modifiers [][]order.Modifier) error {
 
 	if len(nonces) != len(modifiers) {
-		return fmt.Errorf("invalid number of modifiers")
+		return fmt.Errorf("order modifier length mismatch")
 	}
 
 	// Read and update the orders in one single transaction that they are
 	// updated atomically.
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.RwTx) error {
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
 		for idx, nonce := range nonces {
-			_, err := updateOrder(
-				rootBucket, rootBucket, nonce, modifiers[idx],
+			err := updateOrderInPlace(
+				rootBucket, nonce, modifiers[idx],
 			)
 			if err != nil {
-				return err
+				return &OrderUpdateError{
+					Nonce: nonce,
+					Err:   err,
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// ExpireOrders atomically marks the orders with the given nonces as canceled
+// because they expired. The state change event of each order is flagged as an
+// expiry to tell it apart from a cancellation by the user.
+//
+// NOTE: This is part of the Store interface.
+func (db *DB) ExpireOrders(nonces []order.Nonce) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		rootBucket, err := getBucket(tx, ordersBucketKey)
+		if err != nil {
+			return err
+		}
+		for _, nonce := range nonces {
+			err := expireOrderInPlace(rootBucket, nonce)
+			if err != nil {
+				return &OrderUpdateError{
+					Nonce: nonce,
+					Err:   err,
+				}
 			}
 		}
 		return nil
@@ -237,8 +326,8 @@ func (db *DB) GetOrder(nonce order.Nonce) (order.Order, error) {
 			return nil
 		}
 	)
-	err = db.View(func(tx *bbolt.Tx) error {
-		rootBucket, err := getBucket(tx, ordersBucketKey)
+	err = db.View(func(tx kvdb.RTx) error {
+		rootBucket, err := getReadBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
 		}
@@ -247,61 +336,240 @@ func (db *DB) GetOrder(nonce order.Nonce) (order.Order, error) {
 	return o, err
 }
 
+// OrderFilter is a set of criteria that can be used to narrow down the orders
+// returned by GetOrdersPaginated.
+type OrderFilter struct {
+	// ActiveOnly, if set, only returns orders that are not yet in an
+	// archived state.
+	ActiveOnly bool
+
+	// ArchivedOnly, if set, only returns orders that are in an archived
+	// state.
+	ArchivedOnly bool
+}
+
+// matches returns true if the given order satisfies all criteria of the filter.
+func (f OrderFilter) matches(o order.Order) bool {
+	archived := o.Details().State.Archived()
+	switch {
+	case f.ActiveOnly && archived:
+		return false
+
+	case f.ArchivedOnly && !archived:
+		return false
+
+	default:
+		return true
+	}
+}
+
 // GetOrders returns all orders that are currently known to the store.
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) GetOrders() ([]order.Order, error) {
+	var orders []order.Order
+	err := db.View(func(tx kvdb.RTx) error {
+		var err error
+		orders, err = fetchOrdersTX(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// CreatedOrder is an order together with the time it was created at.
+type CreatedOrder struct {
+	order.Order
+
+	// CreatedAt is the time the order was created at, as recorded by its
+	// creation event.
+	CreatedAt time.Time
+}
+
+// GetOrdersPaginated returns at most limit orders that match the given filter,
+// skipping the first offset matching orders. The orders are returned in the
+// order they were created in, oldest first. A limit of zero means no limit is
+// applied.
+func (db *DB) GetOrdersPaginated(offset, limit uint32,
+	filter OrderFilter) ([]*CreatedOrder, error) {
+
+	var orders []*CreatedOrder
+	err := db.View(func(tx kvdb.RTx) error {
+		var err error
+		orders, err = fetchOrdersPaginatedTX(tx, offset, limit, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// decodeOrder decodes an order from the raw data that is passed to an
+// orderCallback.
+func decodeOrder(nonce order.Nonce, rawOrder []byte,
+	extraData *extraOrderData) (order.Order, error) {
+
+	r := bytes.NewReader(rawOrder)
+	o, err := DeserializeOrder(nonce, r)
+	if err != nil {
+		return nil, err
+	}
+
+	tlvReader := bytes.NewReader(extraData.tlvData)
+	err = deserializeOrderTlvData(tlvReader, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if bidOrder, ok := o.(*order.Bid); ok {
+		bidOrder.MinNodeTier = extraData.minNodeTier
+	}
+	o.Details().MinUnitsMatch = extraData.minUnitsMatch
+
+	return o, nil
+}
+
+// fetchOrdersTX returns all orders of the store within a database
+// transaction, in the order of their nonces.
+func fetchOrdersTX(tx kvdb.RTx) ([]order.Order, error) {
 	var (
 		orders   []order.Order
 		callback = func(nonce order.Nonce, rawOrder []byte,
 			extraData *extraOrderData) error {
 
-			r := bytes.NewReader(rawOrder)
-			o, err := DeserializeOrder(nonce, r)
+			o, err := decodeOrder(nonce, rawOrder, extraData)
 			if err != nil {
 				return err
 			}
 
-			tlvReader := bytes.NewReader(extraData.tlvData)
-			err = deserializeOrderTlvData(tlvReader, o)
+			orders = append(orders, o)
+			return nil
+		}
+	)
+	// First, we'll grab our main order bucket key.
+	rootBucket, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll now traverse the root bucket for all active orders. The
+	// primary key is the order nonce itself.
+	err = rootBucket.ForEach(func(k, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		// Get the order from the bucket and pass it to the caller.
+		var nonce order.Nonce
+		copy(nonce[:], k)
+		return fetchOrderTX(rootBucket, nonce, callback)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// fetchOrdersPaginatedTX returns at most limit orders that match the given
+// filter, skipping the first offset matching orders, within a database
+// transaction. The orders are returned in the order they were created in.
+// Orders are found through their creation events, which is why every order
+// must be stored with one, including imported orders.
+func fetchOrdersPaginatedTX(tx kvdb.RTx, offset, limit uint32,
+	filter OrderFilter) ([]*CreatedOrder, error) {
+
+	if filter.ActiveOnly && filter.ArchivedOnly {
+		return nil, fmt.Errorf("cannot filter for active and archived " +
+			"orders at the same time")
+	}
+
+	rootBucket, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		orders    []*CreatedOrder
+		skipped   uint32
+		createdAt time.Time
+		callback  = func(nonce order.Nonce, rawOrder []byte,
+			extraData *extraOrderData) error {
+
+			o, err := decodeOrder(nonce, rawOrder, extraData)
 			if err != nil {
 				return err
 			}
 
-			if bidOrder, ok := o.(*order.Bid); ok {
-				bidOrder.MinNodeTier = extraData.minNodeTier
+			if !filter.matches(o) {
+				return nil
 			}
-			o.Details().MinUnitsMatch = extraData.minUnitsMatch
 
-			orders = append(orders, o)
+			// Only start collecting orders once we've skipped past
+			// the requested offset.
+			if skipped < offset {
+				skipped++
+				return nil
+			}
+
+			orders = append(orders, &CreatedOrder{
+				Order:     o,
+				CreatedAt: createdAt,
+			})
 			return nil
 		}
 	)
-	err := db.View(func(tx *bbolt.Tx) error {
-		// First, we'll grab our main order bucket key.
-		rootBucket, err := getBucket(tx, ordersBucketKey)
+
+	// Every order has exactly one creation event and the main event bucket
+	// is keyed by the events' timestamps. So walking its creation events
+	// with a cursor visits the orders in the order they were created in,
+	// and we can stop as soon as our page is full without reading all the
+	// other orders or events.
+	eventBucket := tx.ReadBucket(eventBucketKey)
+	cursor := eventBucket.ReadCursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if limit > 0 && uint32(len(orders)) >= limit {
+			break
+		}
+
+		if len(k) != event.TimestampLength {
+			return nil, fmt.Errorf("unexpected timestamp key "+
+				"length: %d", len(k))
+		}
+		if len(v) < 1 || event.Type(v[0]) != event.TypeOrderCreated {
+			continue
+		}
+
+		ts := time.Unix(0, int64(byteOrder.Uint64(k)))
+		evt, err := deserializeEvent(
+			bytes.NewReader(v[1:]), ts, event.TypeOrderCreated,
+		)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		createdEvent, ok := evt.(*CreatedEvent)
+		if !ok {
+			return nil, fmt.Errorf("invalid order create event: "+
+				"%v", evt)
 		}
 
-		// We'll now traverse the root bucket for all orders. The
-		// primary key is the order nonce itself.
-		return rootBucket.ForEach(func(nonceBytes, val []byte) error {
-			// Only go into things that we know are sub-bucket keys.
-			if val != nil {
-				return nil
-			}
+		// Pruned orders leave no creation event behind, but we skip
+		// any order we can't find anyway to not fail the whole page.
+		createdAt = ts
+		err = fetchOrderTX(rootBucket, createdEvent.Nonce(), callback)
+		switch {
+		case err == ErrNoOrder:
+			continue
 
-			// Get the order from the bucket and pass it to the
-			// caller.
-			var nonce order.Nonce
-			copy(nonce[:], nonceBytes)
-			return fetchOrderTX(rootBucket, nonce, callback)
-		})
-	})
-	if err != nil {
-		return nil, err
+		case err != nil:
+			return nil, err
+		}
 	}
+
 	return orders, nil
 }
 
@@ -310,7 +578,7 @@ func (db *DB) GetOrders() ([]order.Order, error) {
 //
 // NOTE: This is part of the Store interface.
 func (db *DB) DeleteOrder(nonce order.Nonce) error {
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.RwTx) error {
 		// First, we'll grab our main order bucket key.
 		rootBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
@@ -318,18 +586,182 @@ func (db *DB) DeleteOrder(nonce order.Nonce) error {
 		}
 
 		// Check that the order exists in the main bucket.
-		orderBucket := rootBucket.Bucket(nonce[:])
+		orderBucket := rootBucket.NestedReadWriteBucket(nonce[:])
 		if orderBucket == nil {
 			return ErrNoOrder
 		}
 
-		return rootBucket.DeleteBucket(nonce[:])
+		if err := unindexOrderStateTX(tx, nonce); err != nil {
+			return err
+		}
+
+		return rootBucket.DeleteNestedBucket(nonce[:])
 	})
 }
 
+// PruneArchivedOrders removes all archived orders whose most recent event
+// happened before the given time, together with all of their events. If any
+// states are given, only archived orders in one of those states are removed.
+// Orders that are referenced by a pending batch or by the snapshot of a
+// finalized batch are never removed, as the batch history and the leases
+// created from it still need them. The number of removed orders is returned.
+func (db *DB) PruneArchivedOrders(olderThan time.Time,
+	states []order.State) (int, error) {
+
+	stateFilter := make(map[order.State]struct{}, len(states))
+	for _, state := range states {
+		if !state.Archived() {
+			return 0, fmt.Errorf("cannot prune orders in non-"+
+				"archived state %v", state)
+		}
+		stateFilter[state] = struct{}{}
+	}
+
+	var numPruned int
+	err := db.Update(func(tx kvdb.RwTx) error {
+		rootBucket, err := getBucket(tx, ordersBucketKey)
+		if err != nil {
+			return err
+		}
+		eventBucket, err := getBucket(tx, eventBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// We must not remove any orders that are part of a batch that
+		// hasn't been completed yet, or that the snapshot of a
+		// completed batch refers to.
+		keepNonces, err := pendingBatchOrderNonces(tx)
+		if err != nil {
+			return err
+		}
+		snapshotNonces, err := batchSnapshotOrderNonces(tx)
+		if err != nil {
+			return err
+		}
+		for nonce := range snapshotNonces {
+			keepNonces[nonce] = struct{}{}
+		}
+
+		// We can't delete buckets while iterating over them, so we
+		// first collect the nonces of all orders to remove.
+		var toPrune []order.Nonce
+		err = rootBucket.ForEach(func(k, v []byte) error {
+			// Only go into things that we know are sub-bucket
+			// keys.
+			if v != nil {
+				return nil
+			}
+
+			var nonce order.Nonce
+			copy(nonce[:], k)
+			if _, ok := keepNonces[nonce]; ok {
+				return nil
+			}
+
+			orderBucket := rootBucket.NestedReadWriteBucket(k)
+			orderBytes := orderBucket.Get(orderKey)
+			if orderBytes == nil {
+				return ErrNoOrder
+			}
+			o, err := DeserializeOrder(
+				nonce, bytes.NewReader(orderBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			state := o.Details().State
+			if !state.Archived() {
+				return nil
+			}
+			if _, ok := stateFilter[state]; len(states) > 0 && !ok {
+				return nil
+			}
+
+			// An order without any events can't be dated, so we
+			// leave it alone.
+			lastEvent, ok := lastEventTimestamp(orderBucket)
+			if !ok || !lastEvent.Before(olderThan) {
+				return nil
+			}
+
+			toPrune = append(toPrune, nonce)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, nonce := range toPrune {
+			orderBucket := rootBucket.NestedReadWriteBucket(
+				nonce[:],
+			)
+			err := deleteEventsTX(eventBucket, orderBucket)
+			if err != nil {
+				return err
+			}
+
+			err = rootBucket.DeleteNestedBucket(nonce[:])
+			if err != nil {
+				return err
+			}
+
+			if err := unindexOrderStateTX(tx, nonce); err != nil {
+				return err
+			}
+		}
+
+		numPruned = len(toPrune)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+// storeFullOrderTX serializes the given order and stores it together with all
+// of its additional data in its sub bucket within the root orders bucket. The
+// given event is allowed to be nil.
+func storeFullOrderTX(rootBucket kvdb.RwBucket, o order.Order,
+	evt event.Event) error {
+
+	var w bytes.Buffer
+	if err := SerializeOrder(o, &w); err != nil {
+		return err
+	}
+
+	err := storeOrderTX(rootBucket, o.Nonce(), w.Bytes(), evt)
+	if err != nil {
+		return err
+	}
+	err = storeOrderMinUnitsMatchTX(
+		rootBucket, o.Nonce(), o.Details().MinUnitsMatch,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Next, store any additional order data as a tlv stream.
+	if err := storeOrderTlvTX(rootBucket, o.Nonce(), o); err != nil {
+		return err
+	}
+
+	// Finally, store the min node tier, but only if this is a Bid order.
+	if bidOrder, ok := o.(*order.Bid); ok {
+		return storeOrderMinNoderTierTX(
+			rootBucket, o.Nonce(), bidOrder.MinNodeTier,
+		)
+	}
+
+	return nil
+}
+
 // storeOrderTX saves a byte serialized order in its specific sub bucket within
 // the root orders bucket.
-func storeOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	orderBytes []byte, evt event.Event) error {
 
 	// From the root bucket, we'll make a new sub order bucket using
@@ -346,12 +778,12 @@ func storeOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 	}
 
 	// With the order bucket created, we'll store the order itself.
-	return orderBucket.Put(orderKey, orderBytes)
+	return putRecord(orderBucket, orderKey, orderBytes)
 }
 
 // storeOrderMinNoderTierTX saves the current node tier for a given order to
 // the proper bucket.
-func storeOrderMinNoderTierTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderMinNoderTierTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	minNodeTier order.NodeTier) error {
 
 	orderBucket, err := rootBucket.CreateBucketIfNotExists(nonce[:])
@@ -364,12 +796,12 @@ func storeOrderMinNoderTierTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 		return err
 	}
 
-	return orderBucket.Put(orderTierKey, b.Bytes())
+	return putRecord(orderBucket, orderTierKey, b.Bytes())
 }
 
 // storeOrderMinUnitsMatchTX saves the order's minimum units match within the
 // order's root bucket.
-func storeOrderMinUnitsMatchTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderMinUnitsMatchTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	minUnitsMatch order.SupplyUnit) error {
 
 	// From the root bucket, we'll make a new sub order bucket using
@@ -385,12 +817,12 @@ func storeOrderMinUnitsMatchTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 	if err := WriteElement(&buf, minUnitsMatch); err != nil {
 		return err
 	}
-	return orderBucket.Put(orderMinUnitsMatchKey, buf.Bytes())
+	return putRecord(orderBucket, orderMinUnitsMatchKey, buf.Bytes())
 }
 
 // storeOrderTlvTX saves the order's additional information as a tlv stream
 // within the order's root bucket.
-func storeOrderTlvTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func storeOrderTlvTX(rootBucket kvdb.RwBucket, nonce order.Nonce,
 	o order.Order) error {
 
 	orderBucket, err := rootBucket.CreateBucketIfNotExists(nonce[:])
@@ -403,16 +835,16 @@ func storeOrderTlvTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 		return err
 	}
 
-	return orderBucket.Put(orderTlvKey, b.Bytes())
+	return putRecord(orderBucket, orderTlvKey, b.Bytes())
 }
 
 // fetchOrderTX fetches the binary data of one order specified by its nonce from
 // the root orders bucket.
-func fetchOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
+func fetchOrderTX(rootBucket kvdb.RBucket, nonce order.Nonce,
 	callback orderCallback) error {
 
 	// Get the main order bucket next.
-	orderBucket := rootBucket.Bucket(nonce[:])
+	orderBucket := rootBucket.NestedReadBucket(nonce[:])
 	if orderBucket == nil {
 		return ErrNoOrder
 	}
@@ -427,6 +859,20 @@ func fetchOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 		return nil
 	}
 
+	// Make sure none of the order's records were corrupted before we
+	// attempt to decode any of them.
+	for _, key := range [][]byte{
+		orderKey, orderTlvKey, orderTierKey, orderMinUnitsMatchKey,
+	} {
+		value := orderBucket.Get(key)
+		if value != nil && !recordValid(orderBucket, key, value) {
+			return &ErrCorruptedRecord{
+				Bucket: string(ordersBucketKey),
+				Key:    nonce[:],
+			}
+		}
+	}
+
 	// Try to read our extra order data. Use a default of 1 for the min
 	// units matched if the field does not exist (possible for older
 	// orders).
@@ -459,14 +905,12 @@ func fetchOrderTX(rootBucket *bbolt.Bucket, nonce order.Nonce,
 	return callback(nonce, orderBytes, extraData)
 }
 
-// updateOrder fetches the binary data of one order specified by its nonce from
-// the orders bucket, applies the modifiers, and stores it back into dst bucket.
-// The dst bucket can be a different bucket than the orders bucket if an order
-// update should be written to a staging area instead of being applied to the
-// original order directly. Do not use this function for applying the staged
-// changes to the final bucket but use copyOrder instead.
-func updateOrder(ordersBucket, dst *bbolt.Bucket, nonce order.Nonce,
-	modifiers []order.Modifier) (order.Order, error) {
+// modifyOrder fetches one order specified by its nonce from the orders bucket
+// and applies the given modifiers to the in-memory copy of it. Nothing is
+// written to the database. A copy of the order details before applying the
+// modifiers is returned as well so callers can detect a state change.
+func modifyOrder(ordersBucket kvdb.RBucket, nonce order.Nonce,
+	modifiers []order.Modifier) (order.Order, *order.Kit, error) {
 
 	var (
 		o        order.Order
@@ -498,65 +942,98 @@ func updateOrder(ordersBucket, dst *bbolt.Bucket, nonce order.Nonce,
 	// Retrieve the order stored in the database.
 	err = fetchOrderTX(ordersBucket, nonce, callback)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Preserve the original state to detect a state change later.
-	prevState := o.Details().State
+	// Preserve the original details to detect a state change later.
+	prev := *o.Details()
 
-	// Apply the given modifications to it and store it back.
+	// Apply the given modifications to it.
 	for _, modifier := range modifiers {
 		modifier(o.Details())
 	}
-	var w bytes.Buffer
-	err = SerializeOrder(o, &w)
+
+	return o, &prev, nil
+}
+
+// updateOrderInPlace applies the modifiers to the order with the given nonce
+// within the orders bucket, updates the order state index and records the
+// update as an event of the order.
+func updateOrderInPlace(ordersBucket kvdb.RwBucket, nonce order.Nonce,
+	modifiers []order.Modifier) error {
+
+	o, prev, err := updateOrder(
+		ordersBucket, ordersBucket, nonce, modifiers,
+	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Create an event for the update now. We always store the event
-	// reference to the order in the main order bucket, even if the
-	// destination bucket is the pending orders bucket.
-	evt := NewUpdatedEvent(prevState, o)
-	orderBucket := ordersBucket.Bucket(nonce[:])
-	if orderBucket == nil {
-		return nil, ErrNoOrder
+	if o.Details().State != prev.State {
+		err := indexOrderStateTX(ordersBucket.Tx(), o)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Add the event to the global event store but also add a reference to
 	// it in the order's event reference sub bucket.
-	if err := storeEventTX(orderBucket, evt); err != nil {
-		return nil, err
-	}
+	evt := NewUpdatedEvent(prev, o, nil)
+	return storeEventTX(ordersBucket.NestedReadWriteBucket(nonce[:]), evt)
+}
 
-	// Store the order to the destination bucket now, skipping the event as
-	// we've already written that to the source bucket.
-	if err := storeOrderTX(dst, nonce, w.Bytes(), nil); err != nil {
-		return nil, err
-	}
-	err = storeOrderMinUnitsMatchTX(dst, nonce, o.Details().MinUnitsMatch)
+// expireOrderInPlace marks a single order as canceled because it expired and
+// records the state change as an expiry event.
+func expireOrderInPlace(ordersBucket kvdb.RwBucket, nonce order.Nonce) error {
+	o, prev, err := updateOrder(
+		ordersBucket, ordersBucket, nonce,
+		[]order.Modifier{order.StateModifier(order.StateCanceled)},
+	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Next, store any additional order data as a tlv stream.
-	if err := storeOrderTlvTX(dst, nonce, o); err != nil {
-		return nil, err
+	if err := indexOrderStateTX(ordersBucket.Tx(), o); err != nil {
+		return err
 	}
 
-	if bidOrder, ok := o.(*order.Bid); ok {
-		err = storeOrderMinNoderTierTX(dst, nonce, bidOrder.MinNodeTier)
-		if err != nil {
-			return nil, err
-		}
+	evt := NewUpdatedEvent(prev, o, nil)
+	evt.Expired = true
+	return storeEventTX(ordersBucket.NestedReadWriteBucket(nonce[:]), evt)
+}
+
+// updateOrder fetches the binary data of one order specified by its nonce from
+// the orders bucket, applies the modifiers, and stores it back into dst bucket.
+// The dst bucket can be a different bucket than the orders bucket if an order
+// update should be written to a staging area instead of being applied to the
+// original order directly. Do not use this function for applying the staged
+// changes to the final bucket but use copyOrder instead. No event is recorded
+// for the update, that is up to the caller. A copy of the order details before
+// applying the modifiers is returned together with the updated order.
+func updateOrder(ordersBucket, dst kvdb.RwBucket, nonce order.Nonce,
+	modifiers []order.Modifier) (order.Order, *order.Kit, error) {
+
+	// Retrieve the order stored in the database and apply the given
+	// modifications to it.
+	o, prev, err := modifyOrder(ordersBucket, nonce, modifiers)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return o, nil
+	// Store the modified order to the destination bucket now.
+	if err := storeFullOrderTX(dst, o, nil); err != nil {
+		return nil, nil, err
+	}
+
+	return o, prev, nil
 }
 
 // copyOrder copies a single order from the source to destination bucket. No
-// event references are copied, only the order itself.
-func copyOrder(src, dst *bbolt.Bucket, nonce order.Nonce) error {
+// event references are copied, only the order itself. The copied order is
+// returned.
+func copyOrder(src, dst kvdb.RwBucket, nonce order.Nonce) (order.Order,
+	error) {
+
 	var (
 		o             order.Order
 		orderBytes    []byte
@@ -592,26 +1069,29 @@ func copyOrder(src, dst *bbolt.Bucket, nonce order.Nonce) error {
 	// Retrieve the order stored in the source bucket.
 	err = fetchOrderTX(src, nonce, callback)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Store it in the new bucket.
 	if err := storeOrderTX(dst, nonce, orderBytes, nil); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Next, store any additional order data as a tlv stream.
 	if err := storeOrderTlvTX(dst, nonce, o); err != nil {
-		return err
+		return nil, err
 	}
 
-	if _, ok := o.(*order.Bid); ok {
+	if bidOrder, ok := o.(*order.Bid); ok {
 		err := storeOrderMinNoderTierTX(dst, nonce, nodeTier)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		bidOrder.MinNodeTier = nodeTier
 	}
-	return storeOrderMinUnitsMatchTX(dst, nonce, minUnitsMatch)
+	o.Details().MinUnitsMatch = minUnitsMatch
+
+	return o, storeOrderMinUnitsMatchTX(dst, nonce, minUnitsMatch)
 }
 
 // SerializeOrder binary serializes an order to a writer using the common LN
@@ -664,6 +1144,59 @@ func DeserializeOrder(nonce order.Nonce, r io.Reader) (
 	}
 }
 
+// SerializeUnsignedOrder serializes an order together with its nonce and all
+// of its additional data. This is the format an order is handed to an offline
+// signer in and submitted again once it's signed.
+func SerializeUnsignedOrder(o order.Order, w *bytes.Buffer) error {
+	if err := WriteElement(w, o.Nonce()); err != nil {
+		return err
+	}
+	if err := SerializeOrder(o, w); err != nil {
+		return err
+	}
+
+	var minNodeTier order.NodeTier
+	if bidOrder, ok := o.(*order.Bid); ok {
+		minNodeTier = bidOrder.MinNodeTier
+	}
+	err := WriteElements(w, o.Details().MinUnitsMatch, minNodeTier)
+	if err != nil {
+		return err
+	}
+
+	// The tlv stream goes last as it is read until the end.
+	return serializeOrderTlvData(w, o)
+}
+
+// DeserializeUnsignedOrder deserializes an order that was serialized with
+// SerializeUnsignedOrder.
+func DeserializeUnsignedOrder(r io.Reader) (order.Order, error) {
+	var nonce order.Nonce
+	if err := ReadElement(r, &nonce); err != nil {
+		return nil, err
+	}
+
+	o, err := DeserializeOrder(nonce, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var minNodeTier order.NodeTier
+	err = ReadElements(r, &o.Details().MinUnitsMatch, &minNodeTier)
+	if err != nil {
+		return nil, err
+	}
+	if bidOrder, ok := o.(*order.Bid); ok {
+		bidOrder.MinNodeTier = minNodeTier
+	}
+
+	if err := deserializeOrderTlvData(r, o); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
 // deserializeOrderTlvData attempts to decode the remaining bytes in the
 // supplied reader by interpreting it as a tlv stream. If successful any
 // non-default values of the additional data will be set on the given order.
@@ -674,6 +1207,23 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		channelType       uint8
 		allowedNodeIDs    []byte
 		notAllowedNodeIDs []byte
+		label             []byte
+		minDistinctPeers  uint32
+		maxChainFee       uint64
+		remaining         uint32
+		recurrenceChain   []byte
+		replaces          [32]byte
+		newNodesOnly      uint8
+		activationFeeRate uint64
+		expiryHeight      uint32
+		expiryUnix        uint64
+		premiumTotal      uint64
+		execFeeTotal      uint64
+		chainFeeTotal     uint64
+		totalsPartial     uint8
+		unannounced       uint8
+		zeroConf          uint8
+		autoUpdateFeeRate uint8
 	)
 
 	// We'll add records for all possible additional order data fields here
@@ -689,6 +1239,37 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		tlv.MakePrimitiveRecord(
 			notAllowedNodeIDsType, &notAllowedNodeIDs,
 		),
+		tlv.MakePrimitiveRecord(orderLabelType, &label),
+		tlv.MakePrimitiveRecord(
+			askMinDistinctPeersType, &minDistinctPeers,
+		),
+		tlv.MakePrimitiveRecord(orderMaxChainFeeType, &maxChainFee),
+		tlv.MakePrimitiveRecord(
+			orderRecurrenceRemainingType, &remaining,
+		),
+		tlv.MakePrimitiveRecord(
+			orderRecurrenceChainType, &recurrenceChain,
+		),
+		tlv.MakePrimitiveRecord(orderReplacesType, &replaces),
+		tlv.MakePrimitiveRecord(bidNewNodesOnlyType, &newNodesOnly),
+		tlv.MakePrimitiveRecord(
+			orderActivationFeeRateType, &activationFeeRate,
+		),
+		tlv.MakePrimitiveRecord(orderExpiryHeightType, &expiryHeight),
+		tlv.MakePrimitiveRecord(orderExpiryUnixType, &expiryUnix),
+		tlv.MakePrimitiveRecord(orderPremiumTotalType, &premiumTotal),
+		tlv.MakePrimitiveRecord(
+			orderExecutionFeeTotalType, &execFeeTotal,
+		),
+		tlv.MakePrimitiveRecord(orderChainFeeTotalType, &chainFeeTotal),
+		tlv.MakePrimitiveRecord(orderTotalsPartialType, &totalsPartial),
+		tlv.MakePrimitiveRecord(
+			orderUnannouncedChannelType, &unannounced,
+		),
+		tlv.MakePrimitiveRecord(orderZeroConfChannelType, &zeroConf),
+		tlv.MakePrimitiveRecord(
+			orderAutoUpdateFeeRateType, &autoUpdateFeeRate,
+		),
 	)
 	if err != nil {
 		return err
@@ -703,6 +1284,10 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 	// assign any parsed fields to our order.
 	switch castOrder := o.(type) {
 	case *order.Ask:
+		t, ok := parsedTypes[askMinDistinctPeersType]
+		if ok && t == nil {
+			castOrder.MinDistinctPeers = minDistinctPeers
+		}
 
 	case *order.Bid:
 		if t, ok := parsedTypes[bidSelfChanBalanceType]; ok && t == nil {
@@ -719,6 +1304,10 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 				return err
 			}
 		}
+
+		if t, ok := parsedTypes[bidNewNodesOnlyType]; ok && t == nil {
+			castOrder.NewNodesOnly = newNodesOnly == 1
+		}
 	}
 
 	if t, ok := parsedTypes[orderChannelType]; ok && t == nil {
@@ -741,6 +1330,71 @@ func deserializeOrderTlvData(r io.Reader, o order.Order) error {
 		o.Details().NotAllowedNodeIDs = nodeIDs
 	}
 
+	if t, ok := parsedTypes[orderLabelType]; ok && t == nil {
+		o.Details().Label = string(label)
+	}
+
+	if t, ok := parsedTypes[orderMaxChainFeeType]; ok && t == nil {
+		o.Details().MaxChainFee = btcutil.Amount(maxChainFee)
+	}
+
+	if t, ok := parsedTypes[orderRecurrenceRemainingType]; ok && t == nil {
+		chain, err := assembleNonceSlice(recurrenceChain)
+		if err != nil {
+			return err
+		}
+		o.Details().Recurrence = &order.Recurrence{
+			Remaining: remaining,
+			Chain:     chain,
+		}
+	}
+
+	if t, ok := parsedTypes[orderReplacesType]; ok && t == nil {
+		o.Details().Replaces = replaces
+	}
+
+	if t, ok := parsedTypes[orderActivationFeeRateType]; ok && t == nil {
+		o.Details().ActivationFeeRate = chainfee.SatPerKWeight(
+			activationFeeRate,
+		)
+	}
+
+	if t, ok := parsedTypes[orderExpiryHeightType]; ok && t == nil {
+		o.Details().ExpiryHeight = expiryHeight
+	}
+
+	if t, ok := parsedTypes[orderExpiryUnixType]; ok && t == nil {
+		o.Details().ExpiryUnix = int64(expiryUnix)
+	}
+
+	if t, ok := parsedTypes[orderPremiumTotalType]; ok && t == nil {
+		o.Details().PremiumTotal = btcutil.Amount(premiumTotal)
+	}
+
+	if t, ok := parsedTypes[orderExecutionFeeTotalType]; ok && t == nil {
+		o.Details().ExecutionFeeTotal = btcutil.Amount(execFeeTotal)
+	}
+
+	if t, ok := parsedTypes[orderChainFeeTotalType]; ok && t == nil {
+		o.Details().ChainFeeTotal = btcutil.Amount(chainFeeTotal)
+	}
+
+	if t, ok := parsedTypes[orderTotalsPartialType]; ok && t == nil {
+		o.Details().TotalsPartial = totalsPartial == 1
+	}
+
+	if t, ok := parsedTypes[orderUnannouncedChannelType]; ok && t == nil {
+		o.Details().UnannouncedChannel = unannounced == 1
+	}
+
+	if t, ok := parsedTypes[orderZeroConfChannelType]; ok && t == nil {
+		o.Details().ZeroConfChannel = zeroConf == 1
+	}
+
+	if t, ok := parsedTypes[orderAutoUpdateFeeRateType]; ok && t == nil {
+		o.Details().AutoUpdateFeeRate = autoUpdateFeeRate == 1
+	}
+
 	return nil
 }
 
@@ -798,6 +1452,129 @@ func serializeOrderTlvData(w io.Writer, o order.Order) error {
 		)
 	}
 
+	if o.Details().Label != "" {
+		label := []byte(o.Details().Label)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderLabelType, &label,
+		))
+	}
+
+	// The records of a tlv stream must be sorted by type, so the ask
+	// specific data comes after the common fields added so far.
+	ask, isAsk := o.(*order.Ask)
+	if isAsk && ask.MinDistinctPeers != 0 {
+		minDistinctPeers := ask.MinDistinctPeers
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			askMinDistinctPeersType, &minDistinctPeers,
+		))
+	}
+
+	if o.Details().MaxChainFee != 0 {
+		maxChainFee := uint64(o.Details().MaxChainFee)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderMaxChainFeeType, &maxChainFee,
+		))
+	}
+
+	if recurrence := o.Details().Recurrence; recurrence != nil {
+		remaining := recurrence.Remaining
+		chain := flattenNonceSlice(recurrence.Chain)
+		tlvRecords = append(
+			tlvRecords, tlv.MakePrimitiveRecord(
+				orderRecurrenceRemainingType, &remaining,
+			), tlv.MakePrimitiveRecord(
+				orderRecurrenceChainType, &chain,
+			),
+		)
+	}
+
+	if o.Details().Replaces != order.ZeroNonce {
+		replaces := [32]byte(o.Details().Replaces)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderReplacesType, &replaces,
+		))
+	}
+
+	// The tlv types of the bid specific flag and the remaining common
+	// fields must stay in ascending order.
+	bid, isBid := o.(*order.Bid)
+	if isBid && bid.NewNodesOnly {
+		newNodesOnly := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			bidNewNodesOnlyType, &newNodesOnly,
+		))
+	}
+
+	if o.Details().ActivationFeeRate != 0 {
+		activationFeeRate := uint64(o.Details().ActivationFeeRate)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderActivationFeeRateType, &activationFeeRate,
+		))
+	}
+
+	if o.Details().ExpiryHeight != 0 {
+		expiryHeight := o.Details().ExpiryHeight
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderExpiryHeightType, &expiryHeight,
+		))
+	}
+
+	if o.Details().ExpiryUnix != 0 {
+		expiryUnix := uint64(o.Details().ExpiryUnix)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderExpiryUnixType, &expiryUnix,
+		))
+	}
+
+	if o.Details().PremiumTotal != 0 {
+		premiumTotal := uint64(o.Details().PremiumTotal)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderPremiumTotalType, &premiumTotal,
+		))
+	}
+
+	if o.Details().ExecutionFeeTotal != 0 {
+		execFeeTotal := uint64(o.Details().ExecutionFeeTotal)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderExecutionFeeTotalType, &execFeeTotal,
+		))
+	}
+
+	if o.Details().ChainFeeTotal != 0 {
+		chainFeeTotal := uint64(o.Details().ChainFeeTotal)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderChainFeeTotalType, &chainFeeTotal,
+		))
+	}
+
+	if o.Details().TotalsPartial {
+		totalsPartial := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderTotalsPartialType, &totalsPartial,
+		))
+	}
+
+	if o.Details().UnannouncedChannel {
+		unannounced := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderUnannouncedChannelType, &unannounced,
+		))
+	}
+
+	if o.Details().ZeroConfChannel {
+		zeroConf := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderZeroConfChannelType, &zeroConf,
+		))
+	}
+
+	if o.Details().AutoUpdateFeeRate {
+		autoUpdateFeeRate := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderAutoUpdateFeeRateType, &autoUpdateFeeRate,
+		))
+	}
+
 	tlvStream, err := tlv.NewStream(tlvRecords...)
 	if err != nil {
 		return err
@@ -837,3 +1614,34 @@ func AssemblePubKeySlice(flatten []byte) ([][33]byte, error) {
 	}
 	return pubKeys, nil
 }
+
+// flattenNonceSlice serializes a slice of order nonces into a single byte
+// slice.
+func flattenNonceSlice(nonces []order.Nonce) []byte {
+	flatten := make([]byte, 0, len(nonces)*32)
+	for _, nonce := range nonces {
+		flatten = append(flatten, nonce[:]...)
+	}
+
+	return flatten
+}
+
+// assembleNonceSlice is the inverse of flattenNonceSlice.
+func assembleNonceSlice(flatten []byte) ([]order.Nonce, error) {
+	if len(flatten) == 0 {
+		return nil, nil
+	}
+
+	// Sanity check that this is a valid encoded slice.
+	if len(flatten)%32 != 0 {
+		return nil, fmt.Errorf("invalid length for a nonce encoded "+
+			"slice: %v", len(flatten))
+	}
+
+	nonces := make([]order.Nonce, len(flatten)/32)
+	for i := range nonces {
+		copy(nonces[i][:], flatten[i*32:(i+1)*32])
+	}
+
+	return nonces, nil
+}
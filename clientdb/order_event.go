@@ -10,7 +10,23 @@ import (
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// updatedEventUnitsFilledDeltaType is the tlv type we use to store the
+	// number of units that were filled by an order update.
+	updatedEventUnitsFilledDeltaType tlv.Type = 1
+
+	// updatedEventBatchIDType is the tlv type we use to store the ID of
+	// the batch that caused an order update.
+	updatedEventBatchIDType tlv.Type = 2
+
+	// updatedEventExpiredType is the tlv type we use to flag an order
+	// update as the cancellation of an expired order.
+	updatedEventExpiredType tlv.Type = 3
 )
 
 // OrderEvent is the main interface for order specific events.
@@ -123,20 +139,50 @@ type UpdatedEvent struct {
 	// UnitsFilled is the number of units that was filled at the moment of
 	// the update.
 	UnitsFilled order.SupplyUnit
+
+	// UnitsFilledDelta is the number of units that were filled by this
+	// update.
+	UnitsFilledDelta order.SupplyUnit
+
+	// BatchID is the ID of the batch that caused the update. This is nil
+	// if the update didn't happen as part of a batch.
+	BatchID *order.BatchID
+
+	// Expired is true if the update canceled the order because it reached
+	// its expiry height or time.
+	Expired bool
 }
 
-// NewUpdatedEvent creates a new UpdatedEvent from an order and its previous
-// state with the current system time as the timestamp.
-func NewUpdatedEvent(prevState order.State,
-	o order.Order) *UpdatedEvent {
+// NewUpdatedEvent creates a new UpdatedEvent from an order and its details
+// previous to the update with the current system time as the timestamp. The
+// batch ID is optional and should only be set if the update was caused by a
+// batch.
+func NewUpdatedEvent(prev *order.Kit, o order.Order,
+	batchID *order.BatchID) *UpdatedEvent {
+
+	prevUnitsFilled := prev.Units - prev.UnitsUnfulfilled
+	unitsFilled := o.Details().Units - o.Details().UnitsUnfulfilled
 
-	return &UpdatedEvent{
+	evt := &UpdatedEvent{
 		timestamp:   time.Now(),
 		nonce:       o.Nonce(),
-		PrevState:   prevState,
+		PrevState:   prev.State,
 		NewState:    o.Details().State,
-		UnitsFilled: o.Details().Units - o.Details().UnitsUnfulfilled,
+		UnitsFilled: unitsFilled,
+	}
+
+	// The number of filled units can only grow, but we don't want to
+	// underflow in case an order was modified in an unexpected way.
+	if unitsFilled > prevUnitsFilled {
+		evt.UnitsFilledDelta = unitsFilled - prevUnitsFilled
 	}
+
+	if batchID != nil {
+		id := *batchID
+		evt.BatchID = &id
+	}
+
+	return evt
 }
 
 // Type returns the type of the event.
@@ -168,6 +214,9 @@ func (e *UpdatedEvent) SetTimestamp(ts time.Time) {
 //
 // NOTE: This is part of the event.Event interface.
 func (e *UpdatedEvent) String() string {
+	if e.Expired {
+		return fmt.Sprintf("OrderUpdate(%v, expired)", e.NewState)
+	}
 	return fmt.Sprintf("OrderUpdate(%v)", e.NewState)
 }
 
@@ -177,7 +226,42 @@ func (e *UpdatedEvent) String() string {
 //
 // NOTE: This is part of the event.Event interface.
 func (e *UpdatedEvent) Serialize(w *bytes.Buffer) error {
-	return WriteElements(w, e.nonce, e.PrevState, e.NewState, e.UnitsFilled)
+	err := WriteElements(
+		w, e.nonce, e.PrevState, e.NewState, e.UnitsFilled,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Any fields that were added later are stored as a tlv stream so
+	// older events can still be read.
+	unitsFilledDelta := uint64(e.UnitsFilledDelta)
+	tlvRecords := []tlv.Record{
+		tlv.MakePrimitiveRecord(
+			updatedEventUnitsFilledDeltaType, &unitsFilledDelta,
+		),
+	}
+
+	if e.BatchID != nil {
+		batchID := [33]byte(*e.BatchID)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			updatedEventBatchIDType, &batchID,
+		))
+	}
+
+	if e.Expired {
+		expired := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			updatedEventExpiredType, &expired,
+		))
+	}
+
+	tlvStream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
 }
 
 // Deserialize reads the event data from a binary storage format. This does not
@@ -186,9 +270,44 @@ func (e *UpdatedEvent) Serialize(w *bytes.Buffer) error {
 //
 // NOTE: This is part of the event.Event interface.
 func (e *UpdatedEvent) Deserialize(r io.Reader) error {
-	return ReadElements(
+	err := ReadElements(
 		r, &e.nonce, &e.PrevState, &e.NewState, &e.UnitsFilled,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Events stored by older versions don't have the tlv stream, which
+	// results in an empty stream being decoded.
+	var (
+		unitsFilledDelta uint64
+		batchID          [33]byte
+		expired          uint8
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			updatedEventUnitsFilledDeltaType, &unitsFilledDelta,
+		),
+		tlv.MakePrimitiveRecord(updatedEventBatchIDType, &batchID),
+		tlv.MakePrimitiveRecord(updatedEventExpiredType, &expired),
+	)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return err
+	}
+
+	e.UnitsFilledDelta = order.SupplyUnit(unitsFilledDelta)
+	if t, ok := parsedTypes[updatedEventBatchIDType]; ok && t == nil {
+		id := order.BatchID(batchID)
+		e.BatchID = &id
+	}
+	e.Expired = expired == 1
+
+	return nil
 }
 
 // Nonce returns the nonce of the order this event refers to.
@@ -314,59 +433,118 @@ func (e *MatchEvent) Nonce() order.Nonce {
 var _ event.Event = (*MatchEvent)(nil)
 var _ OrderEvent = (*MatchEvent)(nil)
 
-// GetOrderEvents returns all events of an order by looking up the event
-// reference keys in the order bucket.
-func (db *DB) GetOrderEvents(o order.Nonce) ([]event.Event, error) {
-	var events []event.Event
-	err := db.View(func(tx *bbolt.Tx) error {
-		ordersBucket, err := getBucket(tx, ordersBucketKey)
-		if err != nil {
-			return err
-		}
+// FeeRateBumpEvent is an event implementation that tracks the creation of an
+// order that replaced another order with a max batch fee rate that was too low
+// for a batch. The event is recorded for the new order.
+type FeeRateBumpEvent struct {
+	// timestamp is the unique timestamp the event was created/recorded at.
+	timestamp time.Time
 
-		orderBucket := ordersBucket.Bucket(o[:])
-		if orderBucket == nil {
-			return ErrNoOrder
-		}
+	// Nonce of the order this event refers to.
+	nonce order.Nonce
 
-		eventSubBucket := orderBucket.Bucket(eventRefSubBucket)
-		if eventSubBucket == nil {
-			return fmt.Errorf("order event sub bucket not found")
-		}
+	// ReplacedOrder is the nonce of the order that was replaced.
+	ReplacedOrder order.Nonce
 
-		// We first need to collect all timestamps from the order
-		// bucket.
-		eventTimestampMap := make(map[time.Time]struct{})
-		err = eventSubBucket.ForEach(func(k, v []byte) error {
-			// Only look at keys with correct length.
-			if len(k) != event.TimestampLength {
-				return nil
-			}
+	// PrevFeeRate is the max batch fee rate of the replaced order.
+	PrevFeeRate chainfee.SatPerKWeight
 
-			// Assert there are no corrupt values, in the reference
-			// key we only store the event's type, not the value
-			// itself.
-			if len(v) != 1 {
-				return fmt.Errorf("unexpected timestamp "+
-					"value length: %d", len(v))
-			}
+	// NewFeeRate is the max batch fee rate of the new order.
+	NewFeeRate chainfee.SatPerKWeight
 
-			ts := time.Unix(0, int64(byteOrder.Uint64(k)))
-			eventTimestampMap[ts] = struct{}{}
+	// BatchFeeRate is the fee rate of the batch that was rejected.
+	BatchFeeRate chainfee.SatPerKWeight
+}
 
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+// NewFeeRateBumpEvent creates a new FeeRateBumpEvent from a fee rate bump with
+// the current system time as the timestamp.
+func NewFeeRateBumpEvent(bump *order.FeeRateBump) *FeeRateBumpEvent {
+	return &FeeRateBumpEvent{
+		timestamp:     time.Now(),
+		nonce:         bump.Nonce,
+		ReplacedOrder: bump.Replaces,
+		PrevFeeRate:   bump.PrevFeeRate,
+		NewFeeRate:    bump.NewFeeRate,
+		BatchFeeRate:  bump.BatchFeeRate,
+	}
+}
 
-		// Now get the events themselves and return them. This is always
-		// sorted by the events' timestamps.
-		predicate := func(ts time.Time, _ event.Type) bool {
-			_, ok := eventTimestampMap[ts]
-			return ok
-		}
-		events, err = getEventsTX(tx, predicate)
+// Type returns the type of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *FeeRateBumpEvent) Type() event.Type {
+	return event.TypeOrderFeeRateBump
+}
+
+// Timestamp is the time the event happened. This will be made unique once it is
+// stored. To avoid collisions, the timestamp is adjusted on the nanosecond
+// scale to reach uniqueness.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *FeeRateBumpEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// SetTimestamp updates the timestamp of the event. This is needed to adjust
+// timestamps in case they collide to ensure the global uniqueness of all event
+// timestamps.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *FeeRateBumpEvent) SetTimestamp(ts time.Time) {
+	e.timestamp = ts
+}
+
+// String returns a human readable representation of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *FeeRateBumpEvent) String() string {
+	return fmt.Sprintf("OrderFeeRateBump(%v -> %v)", e.PrevFeeRate,
+		e.NewFeeRate)
+}
+
+// Serialize writes the event data to a binary storage format. This does not
+// serialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *FeeRateBumpEvent) Serialize(w *bytes.Buffer) error {
+	return WriteElements(
+		w, e.nonce, e.ReplacedOrder, e.PrevFeeRate, e.NewFeeRate,
+		e.BatchFeeRate,
+	)
+}
+
+// Deserialize reads the event data from a binary storage format. This does not
+// deserialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *FeeRateBumpEvent) Deserialize(r io.Reader) error {
+	return ReadElements(
+		r, &e.nonce, &e.ReplacedOrder, &e.PrevFeeRate, &e.NewFeeRate,
+		&e.BatchFeeRate,
+	)
+}
+
+// Nonce returns the nonce of the order this event refers to.
+//
+// NOTE: This is part of the order.OrderEvent interface.
+func (e *FeeRateBumpEvent) Nonce() order.Nonce {
+	return e.nonce
+}
+
+// A compile time assertion to make sure FeeRateBumpEvent implements both the
+// event.Event and order.OrderEvent interface.
+var _ event.Event = (*FeeRateBumpEvent)(nil)
+var _ OrderEvent = (*FeeRateBumpEvent)(nil)
+
+// GetOrderEvents returns all events of an order by looking up the event
+// reference keys in the order bucket.
+func (db *DB) GetOrderEvents(o order.Nonce) ([]event.Event, error) {
+	var events []event.Event
+	err := db.View(func(tx kvdb.RTx) error {
+		var err error
+		events, err = getOrderEventsTX(tx, o)
 		return err
 	})
 	if err != nil {
@@ -376,6 +554,26 @@ func (db *DB) GetOrderEvents(o order.Nonce) ([]event.Event, error) {
 	return events, nil
 }
 
+// getOrderEventsTX returns all events of the order with the given nonce within
+// a database transaction.
+func getOrderEventsTX(tx kvdb.RTx, o order.Nonce) ([]event.Event, error) {
+	ordersBucket, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBucket := ordersBucket.NestedReadBucket(o[:])
+	if orderBucket == nil {
+		return nil, ErrNoOrder
+	}
+
+	if orderBucket.NestedReadBucket(eventRefSubBucket) == nil {
+		return nil, fmt.Errorf("order event sub bucket not found")
+	}
+
+	return getReferencedEventsTX(tx, orderBucket)
+}
+
 // StoreOrderEvents stores a list of individual order events in a single
 // database transaction. The events' timestamps are adjusted on the nanosecond
 // scale to ensure they're unique.
@@ -389,7 +587,7 @@ func (db *DB) StoreOrderEvents(events []OrderEvent) error {
 	}
 	event.MakeUniqueTimestamps(baseEvents)
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	return db.Update(func(tx kvdb.RwTx) error {
 		ordersBucket, err := getBucket(tx, ordersBucketKey)
 		if err != nil {
 			return err
@@ -399,7 +597,9 @@ func (db *DB) StoreOrderEvents(events []OrderEvent) error {
 		// up the individual order bucket in each iteration.
 		for _, evt := range events {
 			nonce := evt.Nonce()
-			orderBucket := ordersBucket.Bucket(nonce[:])
+			orderBucket := ordersBucket.NestedReadWriteBucket(
+				nonce[:],
+			)
 			if orderBucket == nil {
 				return ErrNoOrder
 			}
@@ -413,6 +613,14 @@ func (db *DB) StoreOrderEvents(events []OrderEvent) error {
 	})
 }
 
+// StoreFeeRateBump records the replacement of an order by one with a higher
+// max batch fee rate in the event log of the new order.
+//
+// NOTE: This is part of the order.Store interface.
+func (db *DB) StoreFeeRateBump(bump *order.FeeRateBump) error {
+	return db.StoreOrderEvents([]OrderEvent{NewFeeRateBumpEvent(bump)})
+}
+
 // StoreBatchEvents creates a match event of the given match state for each of
 // our orders involved in a batch and stores it to the main event store. In case
 // of a batch reject the RPC reason enum value can optionally be specified.
@@ -459,6 +667,27 @@ func (db *DB) StoreBatchPartialRejectEvents(batch *order.Batch,
 	const reasonDuplicatePeer = uint32(
 		poolrpc.MatchRejectReason_PARTIAL_REJECT_DUPLICATE_PEER,
 	)
+	const reasonNodeNotAllowed = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_NODE_NOT_ALLOWED,
+	)
+	const reasonMinUnitsMatch = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_MIN_UNITS_MATCH,
+	)
+	const reasonChanTypeMismatch = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_CHANNEL_TYPE_MISMATCH,
+	)
+	const reasonAnnouncementMismatch = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_ANNOUNCEMENT_MISMATCH,
+	)
+	const reasonZeroConfMismatch = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_ZERO_CONF_MISMATCH,
+	)
+	const reasonNodeTierTooLow = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_NODE_TIER_TOO_LOW,
+	)
+	const reasonSidecarBalanceMismatch = uint32(
+		poolrpc.MatchRejectReason_PARTIAL_REJECT_SIDECAR_BALANCE_MISMATCH,
+	)
 
 	ts := time.Now()
 	events := make([]OrderEvent, 0, len(batch.MatchedOrders))
@@ -479,6 +708,27 @@ func (db *DB) StoreBatchPartialRejectEvents(batch *order.Batch,
 
 				case auctioneerrpc.OrderReject_DUPLICATE_PEER:
 					evt.RejectReason = reasonDuplicatePeer
+
+				case auctioneerrpc.OrderReject_NODE_NOT_ALLOWED:
+					evt.RejectReason = reasonNodeNotAllowed
+
+				case auctioneerrpc.OrderReject_MIN_UNITS_MATCH:
+					evt.RejectReason = reasonMinUnitsMatch
+
+				case auctioneerrpc.OrderReject_CHANNEL_TYPE_MISMATCH:
+					evt.RejectReason = reasonChanTypeMismatch
+
+				case auctioneerrpc.OrderReject_ANNOUNCEMENT_MISMATCH:
+					evt.RejectReason = reasonAnnouncementMismatch
+
+				case auctioneerrpc.OrderReject_ZERO_CONF_MISMATCH:
+					evt.RejectReason = reasonZeroConfMismatch
+
+				case auctioneerrpc.OrderReject_NODE_TIER_TOO_LOW:
+					evt.RejectReason = reasonNodeTierTooLow
+
+				case auctioneerrpc.OrderReject_SIDECAR_BALANCE_MISMATCH:
+					evt.RejectReason = reasonSidecarBalanceMismatch
 				}
 			}
 
@@ -0,0 +1,141 @@
+package clientdb
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// order-state-index
+//
+//	|
+//	|-- <order state>
+//	|         |
+//	|         |-- <nonce>: <empty>
+//	|         |-- <nonce>: <empty>
+//	|         |
+//	|        ...
+//	|
+//	|-- <order state>
+//	|         |
+//	|        ...
+var (
+	// orderStateIndexBucketKey is the top level bucket that indexes the
+	// nonces of all orders by their current state. Each state has its own
+	// sub-bucket that contains the nonces of all orders in that state.
+	orderStateIndexBucketKey = []byte("order-state-index")
+)
+
+// stateIndexKey returns the key of the sub-bucket of the order state index
+// that houses all orders in the given state.
+func stateIndexKey(state order.State) []byte {
+	return []byte{byte(state)}
+}
+
+// indexOrderStateTX adds the given order to the sub-bucket of its current
+// state in the order state index and removes it from the sub-buckets of all
+// other states.
+func indexOrderStateTX(tx kvdb.RwTx, o order.Order) error {
+	indexBucket, err := getBucket(tx, orderStateIndexBucketKey)
+	if err != nil {
+		return err
+	}
+
+	nonce := o.Nonce()
+	if err := unindexOrderStateTX(tx, nonce); err != nil {
+		return err
+	}
+
+	stateBucket, err := indexBucket.CreateBucketIfNotExists(
+		stateIndexKey(o.Details().State),
+	)
+	if err != nil {
+		return err
+	}
+
+	return stateBucket.Put(nonce[:], []byte{})
+}
+
+// unindexOrderStateTX removes the order with the given nonce from the order
+// state index.
+func unindexOrderStateTX(tx kvdb.RwTx, nonce order.Nonce) error {
+	indexBucket, err := getBucket(tx, orderStateIndexBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// An order is only ever in a single state sub-bucket. But as there are
+	// only a handful of states, it's cheaper to just look into all of them
+	// than to read the previous state of the order first.
+	return indexBucket.ForEach(func(k, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		return indexBucket.NestedReadWriteBucket(k).Delete(nonce[:])
+	})
+}
+
+// GetOrdersByState returns all orders that are currently in one of the given
+// states. In contrast to GetOrders, only the orders in the requested states are
+// read from the database, using the order state index. The orders are returned
+// in the order of their nonces.
+func (db *DB) GetOrdersByState(states ...order.State) ([]order.Order, error) {
+	var orders []order.Order
+	err := db.View(func(tx kvdb.RTx) error {
+		rootBucket, err := getReadBucket(tx, ordersBucketKey)
+		if err != nil {
+			return err
+		}
+		indexBucket, err := getReadBucket(tx, orderStateIndexBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// Collect the nonces of all orders in the requested states
+		// first, so we can return them sorted by nonce, the same way
+		// GetOrders does.
+		var nonces []order.Nonce
+		for _, state := range states {
+			stateBucket := indexBucket.NestedReadBucket(
+				stateIndexKey(state),
+			)
+			if stateBucket == nil {
+				continue
+			}
+
+			err := stateBucket.ForEach(func(k, _ []byte) error {
+				var nonce order.Nonce
+				copy(nonce[:], k)
+				nonces = append(nonces, nonce)
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		sort.Slice(nonces, func(i, j int) bool {
+			return bytes.Compare(nonces[i][:], nonces[j][:]) < 0
+		})
+
+		orders = make([]order.Order, 0, len(nonces))
+		for _, nonce := range nonces {
+			o, _, err := modifyOrder(rootBucket, nonce, nil)
+			if err != nil {
+				return err
+			}
+			orders = append(orders, o)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
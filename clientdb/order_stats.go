@@ -0,0 +1,222 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// OrderStats are the aggregated fill statistics of our orders in a single
+// lease duration market.
+type OrderStats struct {
+	// LeaseDuration is the lease duration market the statistics are for.
+	LeaseDuration uint32
+
+	// NumBatches is the number of batches our orders were filled in.
+	NumBatches uint32
+
+	// UnitsFilled is the total number of units that were filled.
+	UnitsFilled order.SupplyUnit
+
+	// Volume is the total amount of all channels that were leased.
+	Volume btcutil.Amount
+
+	// WeightedAvgRate is the average clearing price our orders were
+	// filled at, weighted by the number of units filled at each price.
+	WeightedAvgRate order.FixedRatePremium
+
+	// PremiumsPaid is the total premium our bids paid.
+	PremiumsPaid btcutil.Amount
+
+	// PremiumsEarned is the total premium our asks earned.
+	PremiumsEarned btcutil.Amount
+
+	// rateSum is the sum of all clearing prices multiplied by the units
+	// filled at them, used to calculate the weighted average rate.
+	rateSum uint64
+}
+
+// OrderStats aggregates the fill statistics of all our orders per lease
+// duration market. If a lease duration is given, only that market is
+// included. If a start time is given, only batches that were finalized at or
+// after that time are included.
+//
+// The statistics are calculated from the batch snapshots, where the units
+// filled of every match are recorded exactly once per batch. Orders that were
+// partially filled before being canceled or replaced are therefore only
+// counted with the units that were actually filled.
+func (db *DB) OrderStats(duration uint32,
+	since time.Time) (map[uint32]*OrderStats, error) {
+
+	var stats map[uint32]*OrderStats
+	err := db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		stats = make(map[uint32]*OrderStats)
+
+		_, seqBucket, indexBucket, err := getSnapshotReadBuckets(tx)
+		if err != nil {
+			return err
+		}
+
+		addBatch := func(seqNum []byte) error {
+			snapshot, err := fetchRawLocalBatchSnapshot(
+				seqBucket, seqNum,
+			)
+			if err != nil {
+				return err
+			}
+
+			addSnapshotStats(stats, snapshot, duration)
+			return nil
+		}
+
+		// Without a start time, we can simply use all snapshots. This
+		// also includes batches from before we started recording the
+		// batch ID in the order update events.
+		if since.IsZero() {
+			return seqBucket.ForEach(func(k, v []byte) error {
+				// The snapshots are stored in sub buckets, so
+				// we skip any plain values.
+				if v != nil {
+					return nil
+				}
+
+				return addBatch(k)
+			})
+		}
+
+		// The snapshots themselves don't carry a timestamp, so we use
+		// the order update events that are recorded once a batch is
+		// final to find the batches in the requested time range.
+		events, err := getEventsTX(
+			tx, func(ts time.Time, t event.Type) bool {
+				return t == event.TypeOrderStateChange &&
+					!ts.Before(since)
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		// Multiple of our orders can be part of the same batch, each
+		// of them having its own event. Every batch must only be
+		// counted once.
+		seenBatches := make(map[order.BatchID]struct{})
+		for _, evt := range events {
+			updateEvt, ok := evt.(*UpdatedEvent)
+			if !ok || updateEvt.BatchID == nil {
+				continue
+			}
+
+			batchID := *updateEvt.BatchID
+			if _, ok := seenBatches[batchID]; ok {
+				continue
+			}
+			seenBatches[batchID] = struct{}{}
+
+			seqNum := indexBucket.Get(batchID[:])
+			if seqNum == nil {
+				return fmt.Errorf("snapshot of batch %x not "+
+					"found", batchID[:])
+			}
+
+			if err := addBatch(seqNum); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, durationStats := range stats {
+		if durationStats.UnitsFilled == 0 {
+			continue
+		}
+
+		durationStats.WeightedAvgRate = order.FixedRatePremium(
+			durationStats.rateSum /
+				uint64(durationStats.UnitsFilled),
+		)
+	}
+
+	return stats, nil
+}
+
+// addSnapshotStats adds the fills of all our orders in the given batch snapshot
+// to the statistics of their lease duration market. If a lease duration is
+// given, all other markets are ignored.
+func addSnapshotStats(stats map[uint32]*OrderStats,
+	snapshot *LocalBatchSnapshot, duration uint32) {
+
+	batchMarkets := make(map[uint32]struct{})
+	for nonce, matches := range snapshot.MatchedOrders {
+		o, ok := snapshot.Orders[nonce]
+		if !ok {
+			continue
+		}
+
+		leaseDuration := o.Details().LeaseDuration
+		if duration != 0 && leaseDuration != duration {
+			continue
+		}
+
+		durationStats, ok := stats[leaseDuration]
+		if !ok {
+			durationStats = &OrderStats{
+				LeaseDuration: leaseDuration,
+			}
+			stats[leaseDuration] = durationStats
+		}
+		if _, ok := batchMarkets[leaseDuration]; !ok {
+			batchMarkets[leaseDuration] = struct{}{}
+			durationStats.NumBatches++
+		}
+
+		clearingPrice := snapshot.ClearingPrices[leaseDuration]
+		for _, match := range matches {
+			amt := match.UnitsFilled.ToSatoshis()
+			premium := clearingPrice.LumpSumPremium(
+				amt, leaseDuration,
+			)
+
+			durationStats.UnitsFilled += match.UnitsFilled
+			durationStats.Volume += amt
+			durationStats.rateSum += uint64(clearingPrice) *
+				uint64(match.UnitsFilled)
+
+			if o.Type() == order.TypeBid {
+				durationStats.PremiumsPaid += premium
+			} else {
+				durationStats.PremiumsEarned += premium
+			}
+		}
+	}
+}
+
+// fetchRawLocalBatchSnapshot returns the snapshot with the given sequence
+// number as it was stored. Unlike fetchLocalBatchSnapshot, the order details
+// that are stored separately are not added, so this also works for snapshots
+// of batches whose orders were already pruned.
+func fetchRawLocalBatchSnapshot(seqBucket kvdb.RBucket,
+	seqNum []byte) (*LocalBatchSnapshot, error) {
+
+	snapshotBucket, err := getNestedReadBucket(seqBucket, seqNum)
+	if err != nil {
+		return nil, err
+	}
+
+	rawBatch := snapshotBucket.Get(batchSnapshotBatchKey)
+	if rawBatch == nil {
+		return nil, fmt.Errorf("batch not found for snapshot")
+	}
+
+	return deserializeLocalBatchSnapshot(bytes.NewReader(rawBatch))
+}
@@ -0,0 +1,191 @@
+package clientdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrderStats makes sure the fill statistics are aggregated correctly per
+// lease duration market and that units of a batch are only counted once.
+func TestOrderStats(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	bid := &order.Bid{
+		Kit: *dummyOrder(5_000_000, 144),
+	}
+	ask := &order.Ask{
+		Kit: *dummyOrder(5_000_000, 2016),
+	}
+	require.NoError(t, store.SubmitOrder(bid))
+	require.NoError(t, store.SubmitOrder(ask))
+
+	// The first batch partially fills both orders in their respective
+	// markets.
+	batchA := &LocalBatchSnapshot{
+		Version: order.DefaultBatchVersion,
+		BatchID: order.BatchID{0x0a},
+		ClearingPrices: map[uint32]order.FixedRatePremium{
+			144:  1000,
+			2016: 2000,
+		},
+		ExecutionFee: *terms.NewLinearFeeSchedule(1, 100),
+		BatchTX:      testBatchTx,
+		Orders: map[order.Nonce]order.Order{
+			bid.Nonce(): bid,
+			ask.Nonce(): ask,
+		},
+		MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+			bid.Nonce(): {{
+				Order: &order.Ask{
+					Kit: *dummyOrder(1_000_000, 144),
+				},
+				UnitsFilled: 10,
+			}, {
+				Order: &order.Ask{
+					Kit: *dummyOrder(500_000, 144),
+				},
+				UnitsFilled: 5,
+			}},
+			ask.Nonce(): {{
+				Order: &order.Bid{
+					Kit: *dummyOrder(2_000_000, 2016),
+				},
+				UnitsFilled: 20,
+			}},
+		},
+	}
+
+	// The second batch only fills the bid again.
+	batchB := &LocalBatchSnapshot{
+		Version: order.DefaultBatchVersion,
+		BatchID: order.BatchID{0x0b},
+		ClearingPrices: map[uint32]order.FixedRatePremium{
+			144: 3000,
+		},
+		ExecutionFee: *terms.NewLinearFeeSchedule(1, 100),
+		BatchTX:      testBatchTx,
+		Orders: map[order.Nonce]order.Order{
+			bid.Nonce(): bid,
+		},
+		MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+			bid.Nonce(): {{
+				Order: &order.Ask{
+					Kit: *dummyOrder(1_000_000, 144),
+				},
+				UnitsFilled: 10,
+			}},
+		},
+	}
+
+	storeBatch := func(snapshot *LocalBatchSnapshot, ts time.Time) {
+		err := store.Update(func(tx kvdb.RwTx) error {
+			err := storePendingBatchSnapshot(tx, snapshot)
+			if err != nil {
+				return err
+			}
+			err = finalizeBatchSnapshot(tx, snapshot.BatchID)
+			if err != nil {
+				return err
+			}
+
+			ordersBucket, err := getBucket(tx, ordersBucketKey)
+			if err != nil {
+				return err
+			}
+
+			batchID := snapshot.BatchID
+			for nonce := range snapshot.MatchedOrders {
+				nonce := nonce
+				evt := &UpdatedEvent{
+					timestamp: ts,
+					nonce:     nonce,
+					BatchID:   &batchID,
+				}
+				err := storeEventTX(
+					ordersBucket.NestedReadWriteBucket(
+						nonce[:],
+					), evt,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now()
+	storeBatch(batchA, now.Add(-2*time.Hour))
+
+	// Canceling the partially filled bid creates another update event
+	// that must not lead to its units being counted again.
+	err := store.UpdateOrder(
+		bid.Nonce(), order.StateModifier(order.StateCanceled),
+	)
+	require.NoError(t, err)
+
+	storeBatch(batchB, now)
+
+	premium := func(price order.FixedRatePremium, units order.SupplyUnit,
+		duration uint32) btcutil.Amount {
+
+		return price.LumpSumPremium(units.ToSatoshis(), duration)
+	}
+
+	// Without any filters, both markets and batches are included.
+	stats, err := store.OrderStats(0, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, &OrderStats{
+		LeaseDuration:   144,
+		NumBatches:      2,
+		UnitsFilled:     25,
+		Volume:          2_500_000,
+		WeightedAvgRate: 1800,
+		PremiumsPaid: premium(1000, 10, 144) +
+			premium(1000, 5, 144) + premium(3000, 10, 144),
+		rateSum: 1000*15 + 3000*10,
+	}, stats[144])
+	require.Equal(t, &OrderStats{
+		LeaseDuration:   2016,
+		NumBatches:      1,
+		UnitsFilled:     20,
+		Volume:          2_000_000,
+		WeightedAvgRate: 2000,
+		PremiumsEarned:  premium(2000, 20, 2016),
+		rateSum:         2000 * 20,
+	}, stats[2016])
+
+	// Filtering by lease duration only returns the requested market.
+	stats, err = store.OrderStats(2016, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, 20, stats[2016].UnitsFilled)
+
+	// With a start time, only the second batch is included.
+	stats, err = store.OrderStats(0, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, 1, stats[144].NumBatches)
+	require.EqualValues(t, 10, stats[144].UnitsFilled)
+	require.EqualValues(t, 3000, stats[144].WeightedAvgRate)
+
+	// A start time that includes both batches counts each of them exactly
+	// once, even though there are multiple events for the first one.
+	stats, err = store.OrderStats(144, now.Add(-3*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.EqualValues(t, 2, stats[144].NumBatches)
+	require.EqualValues(t, 25, stats[144].UnitsFilled)
+}
@@ -0,0 +1,173 @@
+package clientdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// orderTemplatesBucketKey is the top level bucket where we store the
+	// named order templates. The templates are indexed by their name.
+	orderTemplatesBucketKey = []byte("order-templates")
+)
+
+// StoreOrderTemplate stores the given order template, replacing any existing
+// template of the same name.
+func (db *DB) StoreOrderTemplate(t *order.Template) error {
+	var buf bytes.Buffer
+	if err := serializeOrderTemplate(&buf, t.Order); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, orderTemplatesBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, []byte(t.Name), buf.Bytes())
+	})
+}
+
+// OrderTemplate returns the order template with the given name. If no such
+// template exists, order.ErrNoOrderTemplate is returned.
+func (db *DB) OrderTemplate(name string) (*order.Template, error) {
+	var t *order.Template
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, orderTemplatesBucketKey)
+		if err != nil {
+			return err
+		}
+
+		t, err = readOrderTemplate(bucket, []byte(name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// ListOrderTemplates returns all stored order templates, sorted by name.
+func (db *DB) ListOrderTemplates() ([]*order.Template, error) {
+	var res []*order.Template
+	err := db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		res = nil
+
+		bucket, err := getReadBucket(tx, orderTemplatesBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// We'll also get buckets here, skip those (identified
+			// by nil value).
+			if v == nil {
+				return nil
+			}
+
+			t, err := readOrderTemplate(bucket, k)
+			if err != nil {
+				return err
+			}
+			res = append(res, t)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteOrderTemplate removes the order template with the given name. If no
+// such template exists, order.ErrNoOrderTemplate is returned.
+func (db *DB) DeleteOrderTemplate(name string) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, orderTemplatesBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if bucket.Get([]byte(name)) == nil {
+			return order.ErrNoOrderTemplate
+		}
+
+		return deleteRecord(bucket, []byte(name))
+	})
+}
+
+func readOrderTemplate(sourceBucket kvdb.RBucket,
+	name []byte) (*order.Template, error) {
+
+	templateBytes := sourceBucket.Get(name)
+	if templateBytes == nil {
+		return nil, order.ErrNoOrderTemplate
+	}
+	if !recordValid(sourceBucket, name, templateBytes) {
+		return nil, &ErrCorruptedRecord{
+			Bucket: string(orderTemplatesBucketKey),
+			Key:    copyBytes(name),
+		}
+	}
+
+	o, err := deserializeOrderTemplate(bytes.NewReader(templateBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.Template{
+		Name:  string(name),
+		Order: o,
+	}, nil
+}
+
+// serializeOrderTemplate serializes the order of a template. The additional
+// order data that is stored in separate records for regular orders is
+// appended to the order itself, with the tlv stream coming last.
+func serializeOrderTemplate(w *bytes.Buffer, o order.Order) error {
+	if err := SerializeOrder(o, w); err != nil {
+		return err
+	}
+
+	var minNodeTier order.NodeTier
+	if bid, ok := o.(*order.Bid); ok {
+		minNodeTier = bid.MinNodeTier
+	}
+	err := WriteElements(w, o.Details().MinUnitsMatch, minNodeTier)
+	if err != nil {
+		return err
+	}
+
+	return serializeOrderTlvData(w, o)
+}
+
+// deserializeOrderTemplate deserializes the order of a template.
+func deserializeOrderTemplate(r io.Reader) (order.Order, error) {
+	o, err := DeserializeOrder(order.ZeroNonce, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var minNodeTier order.NodeTier
+	err = ReadElements(r, &o.Details().MinUnitsMatch, &minNodeTier)
+	if err != nil {
+		return nil, err
+	}
+	if bid, ok := o.(*order.Bid); ok {
+		bid.MinNodeTier = minNodeTier
+	}
+
+	if err := deserializeOrderTlvData(r, o); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
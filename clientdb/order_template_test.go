@@ -0,0 +1,78 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrderTemplates makes sure order templates can be stored, replaced,
+// listed and deleted and that all template parameters survive the round trip.
+func TestOrderTemplates(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	_, err := db.OrderTemplate("weekly")
+	require.ErrorIs(t, err, order.ErrNoOrderTemplate)
+
+	ask := &order.Ask{
+		Kit: *order.NewKit(order.ZeroNonce),
+	}
+	ask.Version = order.VersionMinDistinctPeers
+	ask.FixedRate = 21
+	ask.MaxBatchFeeRate = 1000
+	ask.LeaseDuration = 2016
+	ask.MinUnitsMatch = 3
+	ask.ChannelType = order.ChannelTypeScriptEnforced
+	ask.NotAllowedNodeIDs = [][33]byte{{2, 3, 4}}
+	ask.Label = "weekly ask"
+	ask.MaxChainFee = 5000
+	ask.MinDistinctPeers = 2
+	askTemplate, err := order.NewTemplate("weekly", ask)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreOrderTemplate(askTemplate))
+
+	bid := &order.Bid{
+		Kit:         *order.NewKit(order.ZeroNonce),
+		MinNodeTier: order.NodeTier0,
+	}
+	bid.Version = order.VersionChannelType
+	bid.FixedRate = 42
+	bid.MaxBatchFeeRate = 2000
+	bid.LeaseDuration = 4032
+	bid.MinUnitsMatch = 1
+	bid.AllowedNodeIDs = [][33]byte{{2, 1}, {3, 1}}
+	bidTemplate, err := order.NewTemplate("a-bid", bid)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreOrderTemplate(bidTemplate))
+
+	dbTemplate, err := db.OrderTemplate("weekly")
+	require.NoError(t, err)
+	require.Equal(t, askTemplate, dbTemplate)
+
+	// The templates are listed by name.
+	templates, err := db.ListOrderTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []*order.Template{bidTemplate, askTemplate}, templates)
+
+	// Storing another template with the same name replaces the first one.
+	ask.FixedRate = 22
+	askTemplate, err = order.NewTemplate("weekly", ask)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreOrderTemplate(askTemplate))
+
+	dbTemplate, err = db.OrderTemplate("weekly")
+	require.NoError(t, err)
+	require.EqualValues(t, 22, dbTemplate.Order.Details().FixedRate)
+
+	require.NoError(t, db.DeleteOrderTemplate("weekly"))
+	templates, err = db.ListOrderTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []*order.Template{bidTemplate}, templates)
+
+	err = db.DeleteOrderTemplate("weekly")
+	require.ErrorIs(t, err, order.ErrNoOrderTemplate)
+}
@@ -1,18 +1,23 @@
 package clientdb
 
 import (
+	"bytes"
 	"crypto/rand"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSubmitOrder tests that orders can be stored and retrieved correctly.
@@ -40,6 +45,7 @@ func TestSubmitOrder(t *testing.T) {
 				MultiSigKeyIndex: 7,
 			},
 		},
+		NewNodesOnly: true,
 	}
 	o.Details().MinUnitsMatch = 10
 	o.Details().ChannelType = order.ChannelTypeScriptEnforced
@@ -49,6 +55,22 @@ func TestSubmitOrder(t *testing.T) {
 	// serialization/deserialization here.
 	o.Details().AllowedNodeIDs = [][33]byte{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
 	o.Details().NotAllowedNodeIDs = [][33]byte{{4, 5, 6}, {5, 6, 7}}
+	o.Details().Label = "inbound for my ⚡ node"
+	o.Details().MaxChainFee = 1_234
+	o.Details().Recurrence = &order.Recurrence{
+		Remaining: 3,
+		Chain:     []order.Nonce{{1, 2, 3}, {4, 5, 6}},
+	}
+	o.Details().Replaces = order.Nonce{7, 8, 9}
+	o.Details().ActivationFeeRate = 2_500
+	o.Details().ExpiryHeight = 800_000
+	o.Details().ExpiryUnix = 1_700_000_000
+	o.Details().PremiumTotal = 1_111
+	o.Details().ExecutionFeeTotal = 222
+	o.Details().ChainFeeTotal = 333
+	o.Details().TotalsPartial = true
+	o.Details().UnannouncedChannel = true
+	o.Details().ZeroConfChannel = true
 	err := store.SubmitOrder(o)
 	if err != nil {
 		t.Fatalf("unable to store order: %v", err)
@@ -92,6 +114,66 @@ func TestSubmitOrder(t *testing.T) {
 		t.Fatalf("unexpected order type. got %d expected %d",
 			allOrders[0].Type(), order.TypeBid)
 	}
+
+	// Asks store their own additional data after the common fields, so
+	// we make sure that still results in a valid tlv stream.
+	ask := &order.Ask{
+		Kit:              *dummyOrder(500000, 1337),
+		MinDistinctPeers: 5,
+	}
+	ask.Details().Version = order.VersionMinDistinctPeers
+	ask.Details().Label = "spread over 5 peers"
+	ask.Details().MaxChainFee = 5_678
+	if err := store.SubmitOrder(ask); err != nil {
+		t.Fatalf("unable to store order: %v", err)
+	}
+	storedOrder, err = store.GetOrder(ask.Nonce())
+	if err != nil {
+		t.Fatalf("unable to retrieve order: %v", err)
+	}
+	if !reflect.DeepEqual(ask, storedOrder) {
+		t.Fatalf("expected order: %v\ngot: %v", spew.Sdump(ask),
+			spew.Sdump(storedOrder))
+	}
+}
+
+// TestSerializeUnsignedOrder makes sure an order that is handed to an offline
+// signer can be deserialized again with all its data and the same digest.
+func TestSerializeUnsignedOrder(t *testing.T) {
+	t.Parallel()
+
+	bid := &order.Bid{
+		Kit:             *dummyOrder(500000, 1337),
+		MinNodeTier:     2,
+		SelfChanBalance: 123,
+		NewNodesOnly:    true,
+	}
+	bid.Details().Version = order.VersionZeroConfChannel
+	bid.Details().MinUnitsMatch = 10
+	bid.Details().Label = "signed offline"
+	bid.Details().ZeroConfChannel = true
+
+	ask := &order.Ask{
+		Kit:              *dummyOrder(500000, 1337),
+		MinDistinctPeers: 5,
+	}
+	ask.Details().Version = order.VersionMinDistinctPeers
+	ask.Details().MinUnitsMatch = 3
+
+	for _, o := range []order.Order{bid, ask} {
+		var b bytes.Buffer
+		require.NoError(t, SerializeUnsignedOrder(o, &b))
+
+		decoded, err := DeserializeUnsignedOrder(&b)
+		require.NoError(t, err)
+		require.Equal(t, o, decoded)
+
+		digest, err := o.Digest()
+		require.NoError(t, err)
+		decodedDigest, err := decoded.Digest()
+		require.NoError(t, err)
+		require.Equal(t, digest, decodedDigest)
+	}
 }
 
 // TestUpdateOrders tests that orders can be updated correctly.
@@ -108,6 +190,7 @@ func TestUpdateOrders(t *testing.T) {
 	}
 	o1.Details().MinUnitsMatch = 10
 	o1.Details().ChannelType = order.ChannelTypeScriptEnforced
+	o1.Details().Label = "first order"
 	err := store.SubmitOrder(o1)
 	if err != nil {
 		t.Fatalf("unable to store order: %v", err)
@@ -161,6 +244,498 @@ func TestUpdateOrders(t *testing.T) {
 				o.Details().State, order.StateCleared)
 		}
 	}
+
+	// If one of the orders can't be updated, none of them should be and
+	// the error should tell us which order failed.
+	var unknownNonce order.Nonce
+	unknownNonce[0] = 0xff
+	err = store.UpdateOrders(
+		[]order.Nonce{o1.Nonce(), unknownNonce},
+		[][]order.Modifier{
+			{order.StateModifier(order.StateSubmitted)},
+			{order.StateModifier(order.StateSubmitted)},
+		},
+	)
+	var updateErr *OrderUpdateError
+	require.ErrorAs(t, err, &updateErr)
+	require.Equal(t, unknownNonce, updateErr.Nonce)
+	require.ErrorIs(t, err, ErrNoOrder)
+
+	storedOrder, err = store.GetOrder(o1.Nonce())
+	require.NoError(t, err)
+	require.Equal(t, order.StateCleared, storedOrder.Details().State)
+
+	// A mismatch between nonces and modifiers is rejected.
+	err = store.UpdateOrders([]order.Nonce{o1.Nonce()}, nil)
+	require.Error(t, err)
+
+	// Archiving an order must not drop its label.
+	err = store.UpdateOrder(
+		o1.Nonce(), order.StateModifier(order.StateExecuted),
+	)
+	if err != nil {
+		t.Fatalf("unable to update order: %v", err)
+	}
+	storedOrder, err = store.GetOrder(o1.Nonce())
+	if err != nil {
+		t.Fatalf("unable to retrieve order: %v", err)
+	}
+	if storedOrder.Details().Label != o1.Details().Label {
+		t.Fatalf("unexpected order label. got %q expected %q",
+			storedOrder.Details().Label, o1.Details().Label)
+	}
+}
+
+// TestExpireOrders makes sure expired orders are canceled and their state
+// change is recorded as an expiry.
+func TestExpireOrders(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	o := &order.Ask{
+		Kit: *dummyOrder(500000, 1337),
+	}
+	o.Details().ExpiryHeight = 800_000
+	require.NoError(t, store.SubmitOrder(o))
+
+	require.NoError(t, store.ExpireOrders([]order.Nonce{o.Nonce()}))
+
+	storedOrder, err := store.GetOrder(o.Nonce())
+	require.NoError(t, err)
+	require.Equal(t, order.StateCanceled, storedOrder.Details().State)
+
+	canceled, err := store.GetOrdersByState(order.StateCanceled)
+	require.NoError(t, err)
+	require.Len(t, canceled, 1)
+
+	events, err := store.GetOrderEvents(o.Nonce())
+	require.NoError(t, err)
+
+	var updates []*UpdatedEvent
+	for _, evt := range events {
+		if updateEvt, ok := evt.(*UpdatedEvent); ok {
+			updates = append(updates, updateEvt)
+		}
+	}
+	require.Len(t, updates, 1)
+	require.True(t, updates[0].Expired)
+	require.Equal(t, order.StateCanceled, updates[0].NewState)
+
+	// Expiring an unknown order fails with an error that names it.
+	var unknownNonce order.Nonce
+	unknownNonce[0] = 0xff
+	err = store.ExpireOrders([]order.Nonce{unknownNonce})
+	var updateErr *OrderUpdateError
+	require.ErrorAs(t, err, &updateErr)
+	require.Equal(t, unknownNonce, updateErr.Nonce)
+}
+
+// TestGetOrdersPaginated tests that orders can be queried page by page in the
+// order they were created in and filtered by their archived state.
+func TestGetOrdersPaginated(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// Create a few orders, every other one of them in an archived state.
+	// We store them in the reverse order of their nonces, so the order
+	// they're indexed in differs from the order they were created in.
+	const numOrders = 10
+	kits := make([]*order.Kit, numOrders)
+	for i := range kits {
+		kits[i] = dummyOrder(500000, 1337)
+	}
+	sort.Slice(kits, func(i, j int) bool {
+		ni, nj := kits[i].Nonce(), kits[j].Nonce()
+		return bytes.Compare(ni[:], nj[:]) > 0
+	})
+	createdNonces := make([]order.Nonce, numOrders)
+	for i, kit := range kits {
+		kit.State = order.StateSubmitted
+		if i%2 == 0 {
+			kit.State = order.StateCanceled
+		}
+		err := store.SubmitOrder(&order.Ask{Kit: *kit})
+		if err != nil {
+			t.Fatalf("unable to store order: %v", err)
+		}
+		createdNonces[i] = kit.Nonce()
+	}
+
+	allOrders, err := store.GetOrders()
+	if err != nil {
+		t.Fatalf("unable to get all orders: %v", err)
+	}
+	if len(allOrders) != numOrders {
+		t.Fatalf("unexpected number of orders. got %d expected %d",
+			len(allOrders), numOrders)
+	}
+
+	// Paging through all orders should yield all of them in the sequence
+	// they were created in, with their creation time.
+	var (
+		pagedNonces []order.Nonce
+		lastCreated time.Time
+	)
+	for offset := uint32(0); ; offset += 3 {
+		page, err := store.GetOrdersPaginated(offset, 3, OrderFilter{})
+		if err != nil {
+			t.Fatalf("unable to get orders page: %v", err)
+		}
+		if len(page) > 3 {
+			t.Fatalf("page too large: %d", len(page))
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, o := range page {
+			if !o.CreatedAt.After(lastCreated) {
+				t.Fatalf("order %v not created after previous "+
+					"order", o.Nonce())
+			}
+			lastCreated = o.CreatedAt
+			pagedNonces = append(pagedNonces, o.Nonce())
+		}
+	}
+	if !reflect.DeepEqual(createdNonces, pagedNonces) {
+		t.Fatalf("expected orders: %v\ngot: %v",
+			spew.Sdump(createdNonces), spew.Sdump(pagedNonces))
+	}
+
+	// Make sure the filters only return active or archived orders.
+	active, err := store.GetOrdersPaginated(
+		0, 0, OrderFilter{ActiveOnly: true},
+	)
+	if err != nil {
+		t.Fatalf("unable to get active orders: %v", err)
+	}
+	if len(active) != numOrders/2 {
+		t.Fatalf("unexpected number of active orders. got %d "+
+			"expected %d", len(active), numOrders/2)
+	}
+	for _, o := range active {
+		if o.Details().State.Archived() {
+			t.Fatalf("unexpected archived order %v", o.Nonce())
+		}
+	}
+
+	archived, err := store.GetOrdersPaginated(
+		1, 2, OrderFilter{ArchivedOnly: true},
+	)
+	if err != nil {
+		t.Fatalf("unable to get archived orders: %v", err)
+	}
+	if len(archived) != 2 {
+		t.Fatalf("unexpected number of archived orders. got %d "+
+			"expected %d", len(archived), 2)
+	}
+	for _, o := range archived {
+		if !o.Details().State.Archived() {
+			t.Fatalf("unexpected active order %v", o.Nonce())
+		}
+	}
+
+	// Asking for both at the same time is invalid.
+	_, err = store.GetOrdersPaginated(
+		0, 0, OrderFilter{ActiveOnly: true, ArchivedOnly: true},
+	)
+	if err == nil {
+		t.Fatalf("expected error for conflicting filter")
+	}
+}
+
+// TestGetOrdersPaginatedImported makes sure imported orders show up in the
+// paginated order listing, even if the export doesn't carry the time they were
+// created at.
+func TestGetOrdersPaginatedImported(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	state := &traderState{}
+	for i := 0; i < 3; i++ {
+		o := &order.Ask{Kit: *dummyOrder(500000, 2016)}
+		o.State = order.StateSubmitted
+		state.orders = append(state.orders, o)
+	}
+
+	var export bytes.Buffer
+	require.NoError(t, serializeTraderState(&export, state))
+	require.NoError(t, store.ImportState(&export))
+
+	allOrders, err := store.GetOrders()
+	require.NoError(t, err)
+	require.Len(t, allOrders, len(state.orders))
+
+	// Without a creation time in the export, the orders are dated to the
+	// time of the import, in the order they were imported.
+	orders, err := store.GetOrdersPaginated(0, 0, OrderFilter{})
+	require.NoError(t, err)
+	require.Len(t, orders, len(state.orders))
+	for i, o := range orders {
+		require.Equal(t, state.orders[i].Nonce(), o.Nonce())
+	}
+
+	orders, err = store.GetOrdersPaginated(1, 1, OrderFilter{})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, state.orders[1].Nonce(), orders[0].Nonce())
+}
+
+// TestPruneArchivedOrders makes sure only archived orders matching the filter
+// are pruned and that orders of a pending batch are left alone.
+func TestPruneArchivedOrders(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// Store one order per state, and an additional order that is part of
+	// a pending batch. That order is only canceled after it was matched,
+	// so it is archived while the batch is still pending.
+	states := []order.State{
+		order.StateSubmitted, order.StateCanceled, order.StateExpired,
+		order.StateSubmitted,
+	}
+	nonces := make([]order.Nonce, len(states))
+	for idx, state := range states {
+		kit := dummyOrder(500000, 1337)
+		kit.State = state
+		if err := store.SubmitOrder(&order.Ask{Kit: *kit}); err != nil {
+			t.Fatalf("unable to store order: %v", err)
+		}
+		nonces[idx] = kit.Nonce()
+	}
+	err := store.StorePendingBatch(
+		testBatch, []order.Nonce{nonces[3]}, [][]order.Modifier{nil},
+		nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to store pending batch: %v", err)
+	}
+	err = store.UpdateOrder(
+		nonces[3], order.StateModifier(order.StateCanceled),
+	)
+	if err != nil {
+		t.Fatalf("unable to cancel order: %v", err)
+	}
+
+	assertPruned := func(olderThan time.Time, states []order.State,
+		expected int) {
+
+		t.Helper()
+
+		numPruned, err := store.PruneArchivedOrders(olderThan, states)
+		if err != nil {
+			t.Fatalf("unable to prune orders: %v", err)
+		}
+		if numPruned != expected {
+			t.Fatalf("unexpected number of pruned orders. got %d "+
+				"expected %d", numPruned, expected)
+		}
+	}
+
+	// None of the orders are old enough yet.
+	assertPruned(time.Now().Add(-time.Hour), nil, 0)
+
+	// Only the canceled order that isn't part of the pending batch should
+	// be removed, including its events.
+	future := time.Now().Add(time.Hour)
+	assertPruned(future, []order.State{order.StateCanceled}, 1)
+	if _, err := store.GetOrder(nonces[1]); err != ErrNoOrder {
+		t.Fatalf("expected order to be pruned, got err %v", err)
+	}
+	events, err := store.AllEvents(event.TypeAny)
+	if err != nil {
+		t.Fatalf("unable to get events: %v", err)
+	}
+	for _, evt := range events {
+		orderEvt, ok := evt.(OrderEvent)
+		if ok && orderEvt.Nonce() == nonces[1] {
+			t.Fatalf("found event of pruned order")
+		}
+	}
+
+	// Without a state filter, the expired order is pruned as well.
+	assertPruned(future, nil, 1)
+	if _, err := store.GetOrder(nonces[2]); err != ErrNoOrder {
+		t.Fatalf("expected order to be pruned, got err %v", err)
+	}
+
+	// Pruning orders that aren't archived isn't allowed.
+	_, err = store.PruneArchivedOrders(
+		future, []order.State{order.StateSubmitted},
+	)
+	if err == nil {
+		t.Fatalf("expected error when pruning active orders")
+	}
+
+	// Once the pending batch is gone, the last canceled order can be
+	// pruned too.
+	if err := store.DeletePendingBatch(); err != nil {
+		t.Fatalf("unable to delete pending batch: %v", err)
+	}
+	assertPruned(future, nil, 1)
+}
+
+// TestPruneKeepsBatchOrders makes sure orders that were executed in a
+// finalized batch aren't pruned, so the batch snapshots, and the leases and
+// info that are read from them, stay readable.
+func TestPruneKeepsBatchOrders(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	// The first order is executed in a batch, the second one is canceled
+	// without ever being matched.
+	executed := &order.Ask{Kit: *dummyOrder(500000, 1337)}
+	executed.State = order.StateSubmitted
+	canceled := &order.Ask{Kit: *dummyOrder(500000, 1337)}
+	canceled.State = order.StateCanceled
+	require.NoError(t, store.SubmitOrder(executed))
+	require.NoError(t, store.SubmitOrder(canceled))
+
+	err := store.StorePendingBatch(
+		testBatch, []order.Nonce{executed.Nonce()},
+		[][]order.Modifier{{
+			order.StateModifier(order.StateExecuted),
+			order.UnitsFulfilledModifier(0),
+		}}, nil, nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.MarkBatchComplete(testBatchID))
+
+	// Only the canceled order can be pruned, the executed one is still
+	// referenced by the batch snapshot.
+	future := time.Now().Add(time.Hour)
+	numPruned, err := store.PruneArchivedOrders(future, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, numPruned)
+
+	_, err = store.GetOrder(canceled.Nonce())
+	require.ErrorIs(t, err, ErrNoOrder)
+	dbOrder, err := store.GetOrder(executed.Nonce())
+	require.NoError(t, err)
+	require.Equal(t, order.StateExecuted, dbOrder.Details().State)
+
+	// All the ways the batch snapshots are read must still work.
+	snapshots, err := store.GetLocalBatchSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Contains(t, snapshots[0].Orders, executed.Nonce())
+
+	err = store.ViewSnapshot(func(snapshot *Snapshot) error {
+		// GetInfo reads all snapshots and orders.
+		batches, err := snapshot.LocalBatchSnapshots()
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+
+		orders, err := snapshot.Orders()
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+
+		// Leases reads the filtered snapshots.
+		timed, err := snapshot.FilteredLocalBatchSnapshots(
+			BatchSnapshotFilter{},
+		)
+		require.NoError(t, err)
+		require.Len(t, timed, 1)
+		require.Contains(t, timed[0].Orders, executed.Nonce())
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Pruning again doesn't remove anything else.
+	numPruned, err = store.PruneArchivedOrders(future, nil)
+	require.NoError(t, err)
+	require.Zero(t, numPruned)
+}
+
+// TestGetOrdersByState makes sure the order state index is kept up to date
+// when orders are submitted, updated, modified by a batch and removed.
+func TestGetOrdersByState(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newTestDB(t)
+	defer cleanup()
+
+	states := []order.State{
+		order.StateSubmitted, order.StateSubmitted,
+		order.StatePartiallyFilled, order.StateCanceled,
+	}
+	nonces := make([]order.Nonce, len(states))
+	for idx, state := range states {
+		kit := dummyOrder(500000, 1337)
+		kit.State = state
+		err := store.SubmitOrder(&order.Ask{Kit: *kit})
+		require.NoError(t, err)
+		nonces[idx] = kit.Nonce()
+	}
+
+	assertStates := func(expected map[order.State][]order.Nonce) {
+		t.Helper()
+
+		for state, expectedNonces := range expected {
+			orders, err := store.GetOrdersByState(state)
+			require.NoError(t, err)
+
+			nonces := make([]order.Nonce, 0, len(orders))
+			for _, o := range orders {
+				require.Equal(t, state, o.Details().State)
+				nonces = append(nonces, o.Nonce())
+			}
+			require.ElementsMatch(t, expectedNonces, nonces)
+		}
+	}
+	assertStates(map[order.State][]order.Nonce{
+		order.StateSubmitted:       {nonces[0], nonces[1]},
+		order.StatePartiallyFilled: {nonces[2]},
+		order.StateCanceled:        {nonces[3]},
+		order.StateExecuted:        nil,
+	})
+
+	// Multiple states can be queried at once.
+	orders, err := store.GetOrdersByState(
+		order.StateSubmitted, order.StatePartiallyFilled,
+	)
+	require.NoError(t, err)
+	require.Len(t, orders, 3)
+
+	// Cancel the first order and execute the second one in a batch. The
+	// batch update should only be reflected once the batch is complete.
+	err = store.UpdateOrder(
+		nonces[0], order.StateModifier(order.StateCanceled),
+	)
+	require.NoError(t, err)
+	err = store.StorePendingBatch(
+		testBatch, []order.Nonce{nonces[1]}, [][]order.Modifier{{
+			order.StateModifier(order.StateExecuted),
+		}}, nil, nil,
+	)
+	require.NoError(t, err)
+	assertStates(map[order.State][]order.Nonce{
+		order.StateSubmitted: {nonces[1]},
+		order.StateCanceled:  {nonces[0], nonces[3]},
+		order.StateExecuted:  nil,
+	})
+
+	require.NoError(t, store.MarkBatchComplete(testBatchID))
+	assertStates(map[order.State][]order.Nonce{
+		order.StateSubmitted: nil,
+		order.StateExecuted:  {nonces[1]},
+	})
+
+	// Deleted orders should be removed from the index as well.
+	require.NoError(t, store.DeleteOrder(nonces[3]))
+	assertStates(map[order.State][]order.Nonce{
+		order.StateCanceled: {nonces[0]},
+	})
 }
 
 func dummyOrder(amt btcutil.Amount, leaseDuration uint32) *order.Kit {
@@ -179,6 +754,7 @@ func dummyOrder(amt btcutil.Amount, leaseDuration uint32) *order.Kit {
 	}
 	kit.MaxBatchFeeRate = chainfee.FeePerKwFloor
 	copy(kit.AcctKey[:], testTraderKey.SerializeCompressed())
+	kit.Units = 741
 	kit.UnitsUnfulfilled = 741
 	kit.LeaseDuration = leaseDuration
 	return kit
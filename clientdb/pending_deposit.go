@@ -0,0 +1,120 @@
+package clientdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// pendingDepositsBucketKey is the top level bucket where we store the
+	// deposits that are funded by an external wallet and were initiated
+	// but not yet finalized. The deposits are indexed by the trader key of
+	// the account they deposit into, so there's at most one per account.
+	pendingDepositsBucketKey = []byte("pending-deposits")
+)
+
+// StorePendingDeposit stores the given pending deposit, replacing any existing
+// one of the same account.
+func (db *DB) StorePendingDeposit(d *account.PendingDeposit) error {
+	var buf bytes.Buffer
+	if err := serializePendingDeposit(&buf, d); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		accounts, err := getReadBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+		traderKey := d.TraderKey.SerializeCompressed()
+		if accounts.Get(traderKey) == nil {
+			return ErrAccountNotFound
+		}
+
+		bucket, err := getBucket(tx, pendingDepositsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, traderKey, buf.Bytes())
+	})
+}
+
+// PendingDeposit returns the pending deposit of the account with the given
+// trader key. If there is none, account.ErrNoPendingDeposit is returned.
+func (db *DB) PendingDeposit(
+	traderKey *btcec.PublicKey) (*account.PendingDeposit, error) {
+
+	var deposit *account.PendingDeposit
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, pendingDepositsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		deposit, err = readPendingDeposit(
+			bucket, traderKey.SerializeCompressed(),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deposit, nil
+}
+
+// DeletePendingDeposit removes the pending deposit of the account with the
+// given trader key. If there is none, account.ErrNoPendingDeposit is returned.
+func (db *DB) DeletePendingDeposit(traderKey *btcec.PublicKey) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, pendingDepositsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		key := traderKey.SerializeCompressed()
+		if bucket.Get(key) == nil {
+			return account.ErrNoPendingDeposit
+		}
+
+		return deleteRecord(bucket, key)
+	})
+}
+
+func readPendingDeposit(sourceBucket kvdb.RBucket,
+	traderKey []byte) (*account.PendingDeposit, error) {
+
+	depositBytes := sourceBucket.Get(traderKey)
+	if depositBytes == nil {
+		return nil, account.ErrNoPendingDeposit
+	}
+	if !recordValid(sourceBucket, traderKey, depositBytes) {
+		return nil, &ErrCorruptedRecord{
+			Bucket: string(pendingDepositsBucketKey),
+			Key:    copyBytes(traderKey),
+		}
+	}
+
+	return deserializePendingDeposit(bytes.NewReader(depositBytes))
+}
+
+func serializePendingDeposit(w *bytes.Buffer,
+	d *account.PendingDeposit) error {
+
+	return WriteElements(w, d.TraderKey, d.OutPoint, d.Value, d.Expiry)
+}
+
+func deserializePendingDeposit(r io.Reader) (*account.PendingDeposit, error) {
+	var d account.PendingDeposit
+	err := ReadElements(r, &d.TraderKey, &d.OutPoint, &d.Value, &d.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
@@ -0,0 +1,57 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingDeposits makes sure pending deposits can be stored, retrieved and
+// deleted.
+func TestPendingDeposits(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	deposit := &account.PendingDeposit{
+		TraderKey: testTraderKey,
+		OutPoint:  wire.OutPoint{Index: 3},
+		Value:     2 * btcutil.SatoshiPerBitcoin,
+		Expiry:    2016,
+	}
+
+	// A deposit can only be stored for an existing account.
+	err := db.StorePendingDeposit(deposit)
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	_, err = db.PendingDeposit(testTraderKey)
+	require.ErrorIs(t, err, account.ErrNoPendingDeposit)
+
+	acct := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(acct))
+	require.NoError(t, db.StorePendingDeposit(deposit))
+
+	stored, err := db.PendingDeposit(testTraderKey)
+	require.NoError(t, err)
+	require.Equal(t, deposit, stored)
+
+	require.NoError(t, db.DeletePendingDeposit(testTraderKey))
+	_, err = db.PendingDeposit(testTraderKey)
+	require.ErrorIs(t, err, account.ErrNoPendingDeposit)
+
+	err = db.DeletePendingDeposit(testTraderKey)
+	require.ErrorIs(t, err, account.ErrNoPendingDeposit)
+}
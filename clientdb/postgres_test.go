@@ -0,0 +1,27 @@
+//go:build kvdb_postgres
+// +build kvdb_postgres
+
+package clientdb
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// TestMain starts an embedded postgres instance that all tests of this package
+// run against.
+func TestMain(m *testing.M) {
+	stop, err := kvdb.StartEmbeddedPostgres()
+	if err != nil {
+		fmt.Printf("Error starting embedded postgres: %v\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	_ = stop()
+
+	os.Exit(code)
+}
@@ -0,0 +1,222 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"go.etcd.io/bbolt"
+)
+
+var (
+	// ErrDBReadOnly is returned if a write is attempted on a database that
+	// was opened in read-only mode.
+	ErrDBReadOnly = errors.New("database is opened in read-only mode")
+)
+
+// readOnlyBackend wraps a database backend that doesn't support being opened
+// in read-only mode, like postgres, and rejects all read-write transactions.
+type readOnlyBackend struct {
+	kvdb.Backend
+}
+
+// A compile-time check to make sure readOnlyBackend implements the
+// kvdb.Backend interface.
+var _ kvdb.Backend = (*readOnlyBackend)(nil)
+
+// BeginReadWriteTx always returns ErrDBReadOnly.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (b *readOnlyBackend) BeginReadWriteTx() (kvdb.RwTx, error) {
+	return nil, ErrDBReadOnly
+}
+
+// Update always returns ErrDBReadOnly without executing the given function.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (b *readOnlyBackend) Update(func(tx kvdb.RwTx) error,
+	func()) error {
+
+	return ErrDBReadOnly
+}
+
+// openReadOnlyBoltBackend opens an existing bolt database file in read-only
+// mode. Instead of the exclusive lock a writer holds, bolt only obtains a
+// shared lock on the file. That allows multiple readers at the same time but
+// no writer while the file is open.
+func openReadOnlyBoltBackend(dir, fileName string) (kvdb.Backend, error) {
+	path := filepath.Join(dir, fileName)
+	if !fileExists(path) {
+		return nil, fmt.Errorf("database file %s does not exist, "+
+			"cannot create it in read-only mode", path)
+	}
+
+	db, err := bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  DefaultPoolDBTimeout,
+	})
+	if err == bbolt.ErrTimeout {
+		return nil, fmt.Errorf("error while trying to open %s: timed "+
+			"out after %v when trying to obtain shared lock - "+
+			"make sure no other pool daemon process is running "+
+			"in read-write mode", path, DefaultPoolDBTimeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltReadOnlyDB{db: db}, nil
+}
+
+// A compile-time check to make sure boltReadOnlyDB implements the
+// kvdb.Backend interface.
+var _ kvdb.Backend = (*boltReadOnlyDB)(nil)
+
+// boltReadOnlyDB is a minimal kvdb.Backend implementation on top of a bolt
+// database that was opened in read-only mode. The walletdb bolt driver always
+// opens the file for writing, which is why we need our own implementation.
+type boltReadOnlyDB struct {
+	db *bbolt.DB
+}
+
+// BeginReadTx starts a new read-only transaction.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) BeginReadTx() (kvdb.RTx, error) {
+	tx, err := d.db.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltReadTx{tx: tx}, nil
+}
+
+// BeginReadWriteTx always returns ErrDBReadOnly.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) BeginReadWriteTx() (kvdb.RwTx, error) {
+	return nil, ErrDBReadOnly
+}
+
+// Copy writes a copy of the database to the provided writer.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) Copy(w io.Writer) error {
+	return d.db.View(func(tx *bbolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// Close closes the database file and releases the shared lock.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) Close() error {
+	return d.db.Close()
+}
+
+// PrintStats returns the statistics of the underlying bolt database.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) PrintStats() string {
+	return fmt.Sprintf("%#v", d.db.Stats())
+}
+
+// View executes the given function within a read-only transaction.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) View(f func(tx kvdb.RTx) error,
+	reset func()) error {
+
+	reset()
+	return d.db.View(func(tx *bbolt.Tx) error {
+		return f(&boltReadTx{tx: tx})
+	})
+}
+
+// Update always returns ErrDBReadOnly without executing the given function.
+//
+// NOTE: This is part of the kvdb.Backend interface.
+func (d *boltReadOnlyDB) Update(func(tx kvdb.RwTx) error,
+	func()) error {
+
+	return ErrDBReadOnly
+}
+
+// boltReadTx is a read-only transaction of a bolt database.
+type boltReadTx struct {
+	tx *bbolt.Tx
+}
+
+// ReadBucket returns the top level bucket with the given key or nil if it
+// doesn't exist.
+//
+// NOTE: This is part of the kvdb.RTx interface.
+func (t *boltReadTx) ReadBucket(key []byte) kvdb.RBucket {
+	bucket := t.tx.Bucket(key)
+	if bucket == nil {
+		return nil
+	}
+
+	return &boltReadBucket{bucket: bucket}
+}
+
+// ForEachBucket calls the given function for the key of each top level
+// bucket.
+//
+// NOTE: This is part of the kvdb.RTx interface.
+func (t *boltReadTx) ForEachBucket(f func(key []byte) error) error {
+	return t.tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+		return f(name)
+	})
+}
+
+// Rollback closes the transaction.
+//
+// NOTE: This is part of the kvdb.RTx interface.
+func (t *boltReadTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// boltReadBucket is a bucket of a read-only bolt transaction.
+type boltReadBucket struct {
+	bucket *bbolt.Bucket
+}
+
+// NestedReadBucket returns the nested bucket with the given key or nil if it
+// doesn't exist.
+//
+// NOTE: This is part of the kvdb.RBucket interface.
+func (b *boltReadBucket) NestedReadBucket(key []byte) kvdb.RBucket {
+	bucket := b.bucket.Bucket(key)
+	if bucket == nil {
+		return nil
+	}
+
+	return &boltReadBucket{bucket: bucket}
+}
+
+// ForEach calls the given function for each key/value pair of the bucket.
+// The value of nested buckets is nil.
+//
+// NOTE: This is part of the kvdb.RBucket interface.
+func (b *boltReadBucket) ForEach(f func(k, v []byte) error) error {
+	return b.bucket.ForEach(f)
+}
+
+// Get returns the value for the given key or nil if the key doesn't exist or
+// is a nested bucket.
+//
+// NOTE: This is part of the kvdb.RBucket interface.
+func (b *boltReadBucket) Get(key []byte) []byte {
+	return b.bucket.Get(key)
+}
+
+// ReadCursor returns a new cursor for iterating over the bucket.
+//
+// NOTE: This is part of the kvdb.RBucket interface.
+func (b *boltReadBucket) ReadCursor() kvdb.RCursor {
+	return b.bucket.Cursor()
+}
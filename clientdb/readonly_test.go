@@ -0,0 +1,58 @@
+package clientdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadOnlyDB makes sure an existing database can be opened in read-only
+// mode, that its content can be read and that all writes are rejected.
+func TestReadOnlyDB(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "client-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	opts := &DBOptions{ReadOnly: true}
+
+	// A database that doesn't exist yet can't be created in read-only
+	// mode.
+	_, err = New(tempDir, DBFilename, opts)
+	require.Error(t, err)
+
+	db, err := New(tempDir, DBFilename, nil)
+	require.NoError(t, err)
+
+	kit := dummyOrder(500000, 1337)
+	require.NoError(t, db.SubmitOrder(&order.Ask{Kit: *kit}))
+	require.NoError(t, db.Close())
+
+	// Multiple read-only instances can be opened at the same time.
+	db, err = New(tempDir, DBFilename, opts)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	db2, err := New(tempDir, DBFilename, opts)
+	require.NoError(t, err)
+	require.NoError(t, db2.Close())
+
+	dbOrder, err := db.GetOrder(kit.Nonce())
+	require.NoError(t, err)
+	require.Equal(t, kit.Nonce(), dbOrder.Nonce())
+
+	orders, err := db.GetOrders()
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	// Any attempt to modify the database fails.
+	err = db.SubmitOrder(&order.Ask{Kit: *dummyOrder(500000, 1337)})
+	require.ErrorIs(t, err, ErrDBReadOnly)
+	require.ErrorIs(t, db.DeleteOrder(kit.Nonce()), ErrDBReadOnly)
+}
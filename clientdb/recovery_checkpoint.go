@@ -0,0 +1,113 @@
+package clientdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// recoveryBucketKey is the top level bucket where we store the
+	// progress of an account recovery that is currently in progress.
+	recoveryBucketKey = []byte("recovery")
+
+	// recoveryCheckpointKey is the key under which the checkpoint of the
+	// current account recovery is stored. There can only be one recovery
+	// at a time.
+	recoveryCheckpointKey = []byte("checkpoint")
+)
+
+// StoreRecoveryCheckpoint stores the given recovery checkpoint, replacing any
+// previous one.
+func (db *DB) StoreRecoveryCheckpoint(c *account.RecoveryCheckpoint) error {
+	var buf bytes.Buffer
+	if err := serializeRecoveryCheckpoint(&buf, c); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, recoveryBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, recoveryCheckpointKey, buf.Bytes())
+	})
+}
+
+// RecoveryCheckpoint returns the checkpoint of the last account recovery that
+// didn't complete. If there is none, account.ErrNoRecoveryCheckpoint is
+// returned.
+func (db *DB) RecoveryCheckpoint() (*account.RecoveryCheckpoint, error) {
+	var checkpoint *account.RecoveryCheckpoint
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, recoveryBucketKey)
+		if err != nil {
+			return err
+		}
+
+		checkpointBytes := bucket.Get(recoveryCheckpointKey)
+		if checkpointBytes == nil {
+			return account.ErrNoRecoveryCheckpoint
+		}
+		if !recordValid(bucket, recoveryCheckpointKey, checkpointBytes) {
+			return &ErrCorruptedRecord{
+				Bucket: string(recoveryBucketKey),
+				Key:    copyBytes(recoveryCheckpointKey),
+			}
+		}
+
+		checkpoint, err = deserializeRecoveryCheckpoint(
+			bytes.NewReader(checkpointBytes),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// DeleteRecoveryCheckpoint removes the checkpoint of the account recovery, if
+// there is one.
+func (db *DB) DeleteRecoveryCheckpoint() error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, recoveryBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if bucket.Get(recoveryCheckpointKey) == nil {
+			return nil
+		}
+
+		return deleteRecord(bucket, recoveryCheckpointKey)
+	})
+}
+
+func serializeRecoveryCheckpoint(w *bytes.Buffer,
+	c *account.RecoveryCheckpoint) error {
+
+	return WriteElements(
+		w, c.StartIndex, c.EndIndex, c.AccountTarget, c.FullClient,
+		c.FullRange, c.NextIndex, c.AccountsFound,
+	)
+}
+
+func deserializeRecoveryCheckpoint(
+	r io.Reader) (*account.RecoveryCheckpoint, error) {
+
+	var c account.RecoveryCheckpoint
+	err := ReadElements(
+		r, &c.StartIndex, &c.EndIndex, &c.AccountTarget, &c.FullClient,
+		&c.FullRange, &c.NextIndex, &c.AccountsFound,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,50 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecoveryCheckpoint makes sure the checkpoint of an account recovery can
+// be stored, updated and deleted.
+func TestRecoveryCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	_, err := db.RecoveryCheckpoint()
+	require.ErrorIs(t, err, account.ErrNoRecoveryCheckpoint)
+
+	// Deleting a non-existent checkpoint is not an error.
+	require.NoError(t, db.DeleteRecoveryCheckpoint())
+
+	checkpoint := &account.RecoveryCheckpoint{
+		StartIndex:    10,
+		EndIndex:      500,
+		AccountTarget: 20,
+		FullClient:    true,
+		FullRange:     true,
+		NextIndex:     10,
+	}
+	require.NoError(t, db.StoreRecoveryCheckpoint(checkpoint))
+
+	stored, err := db.RecoveryCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, checkpoint, stored)
+
+	// Storing the checkpoint again replaces the previous one.
+	checkpoint.NextIndex = 42
+	checkpoint.AccountsFound = 3
+	require.NoError(t, db.StoreRecoveryCheckpoint(checkpoint))
+
+	stored, err = db.RecoveryCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, checkpoint, stored)
+
+	require.NoError(t, db.DeleteRecoveryCheckpoint())
+	_, err = db.RecoveryCheckpoint()
+	require.ErrorIs(t, err, account.ErrNoRecoveryCheckpoint)
+}
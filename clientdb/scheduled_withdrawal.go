@@ -0,0 +1,201 @@
+package clientdb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// scheduledWithdrawalsBucketKey is the top level bucket where we store
+	// the withdrawals that are executed once the fee rate drops far
+	// enough. The withdrawals are indexed by the trader key of the account
+	// they withdraw from, so there's at most one per account.
+	scheduledWithdrawalsBucketKey = []byte("scheduled-withdrawals")
+)
+
+// StoreScheduledWithdrawal stores the given scheduled withdrawal, replacing any
+// existing one of the same account.
+func (db *DB) StoreScheduledWithdrawal(w *account.ScheduledWithdrawal) error {
+	var buf bytes.Buffer
+	if err := serializeScheduledWithdrawal(&buf, w); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		accounts, err := getReadBucket(tx, accountBucketKey)
+		if err != nil {
+			return err
+		}
+		traderKey := w.TraderKey.SerializeCompressed()
+		if accounts.Get(traderKey) == nil {
+			return ErrAccountNotFound
+		}
+
+		bucket, err := getBucket(tx, scheduledWithdrawalsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return putRecord(bucket, traderKey, buf.Bytes())
+	})
+}
+
+// ScheduledWithdrawals returns all scheduled withdrawals.
+func (db *DB) ScheduledWithdrawals() ([]*account.ScheduledWithdrawal, error) {
+	var res []*account.ScheduledWithdrawal
+	err := db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		res = nil
+
+		bucket, err := getReadBucket(tx, scheduledWithdrawalsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// We'll also get buckets here, skip those (identified
+			// by nil value).
+			if v == nil {
+				return nil
+			}
+
+			w, err := readScheduledWithdrawal(bucket, k)
+			if err != nil {
+				return err
+			}
+			res = append(res, w)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteScheduledWithdrawal removes the scheduled withdrawal of the account
+// with the given trader key. If there is none, account.ErrNoScheduledWithdrawal
+// is returned.
+func (db *DB) DeleteScheduledWithdrawal(traderKey *btcec.PublicKey) error {
+	return db.Update(func(tx kvdb.RwTx) error {
+		return deleteScheduledWithdrawal(tx, traderKey)
+	})
+}
+
+// CompleteScheduledWithdrawal updates an account according to the given
+// modifiers and removes its scheduled withdrawal within the same database
+// transaction. This makes sure a withdrawal that was recorded as executed
+// can never be executed a second time. If the account has no scheduled
+// withdrawal, account.ErrNoScheduledWithdrawal is returned and the account is
+// left untouched.
+func (db *DB) CompleteScheduledWithdrawal(acct *account.Account,
+	modifiers ...account.Modifier) error {
+
+	err := db.Update(func(tx kvdb.RwTx) error {
+		err := deleteScheduledWithdrawal(tx, acct.TraderKey.PubKey)
+		if err != nil {
+			return err
+		}
+
+		return updateAccountTX(tx, acct, modifiers)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, modifier := range modifiers {
+		modifier(acct)
+	}
+
+	db.notifyAccountUpdate()
+
+	return nil
+}
+
+// deleteScheduledWithdrawal removes the scheduled withdrawal of the account
+// with the given trader key within a database transaction.
+func deleteScheduledWithdrawal(tx kvdb.RwTx,
+	traderKey *btcec.PublicKey) error {
+
+	bucket, err := getBucket(tx, scheduledWithdrawalsBucketKey)
+	if err != nil {
+		return err
+	}
+
+	key := traderKey.SerializeCompressed()
+	if bucket.Get(key) == nil {
+		return account.ErrNoScheduledWithdrawal
+	}
+
+	return deleteRecord(bucket, key)
+}
+
+func readScheduledWithdrawal(sourceBucket kvdb.RBucket,
+	traderKey []byte) (*account.ScheduledWithdrawal, error) {
+
+	withdrawalBytes := sourceBucket.Get(traderKey)
+	if withdrawalBytes == nil {
+		return nil, account.ErrNoScheduledWithdrawal
+	}
+	if !recordValid(sourceBucket, traderKey, withdrawalBytes) {
+		return nil, &ErrCorruptedRecord{
+			Bucket: string(scheduledWithdrawalsBucketKey),
+			Key:    copyBytes(traderKey),
+		}
+	}
+
+	return deserializeScheduledWithdrawal(bytes.NewReader(withdrawalBytes))
+}
+
+func serializeScheduledWithdrawal(w *bytes.Buffer,
+	s *account.ScheduledWithdrawal) error {
+
+	numOutputs := uint32(len(s.Outputs))
+	err := WriteElements(
+		w, s.TraderKey, s.MaxFeeRate, s.Expiry, numOutputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, output := range s.Outputs {
+		if err := wire.WriteTxOut(w, 0, 0, output); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeScheduledWithdrawal(
+	r io.Reader) (*account.ScheduledWithdrawal, error) {
+
+	var (
+		s          account.ScheduledWithdrawal
+		numOutputs uint32
+	)
+	err := ReadElements(
+		r, &s.TraderKey, &s.MaxFeeRate, &s.Expiry, &numOutputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Outputs = make([]*wire.TxOut, numOutputs)
+	for i := range s.Outputs {
+		var output wire.TxOut
+		if err := wire.ReadTxOut(r, 0, 0, &output); err != nil {
+			return nil, err
+		}
+		s.Outputs[i] = &output
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,101 @@
+package clientdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScheduledWithdrawals makes sure scheduled withdrawals can be stored,
+// replaced, listed and deleted.
+func TestScheduledWithdrawals(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	withdrawal := &account.ScheduledWithdrawal{
+		TraderKey: testTraderKey,
+		Outputs: []*wire.TxOut{{
+			Value:    100_000,
+			PkScript: []byte{0x00, 0x14, 0x01, 0x02},
+		}, {
+			Value:    200_000,
+			PkScript: []byte{0x00, 0x20, 0x03},
+		}},
+		MaxFeeRate: chainfee.FeePerKwFloor,
+		Expiry:     time.Unix(1_700_000_000, 0),
+	}
+
+	// A withdrawal can only be scheduled for an existing account.
+	err := db.StoreScheduledWithdrawal(withdrawal)
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	acct := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(acct))
+	require.NoError(t, db.StoreScheduledWithdrawal(withdrawal))
+
+	withdrawals, err := db.ScheduledWithdrawals()
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, withdrawal.Outputs, withdrawals[0].Outputs)
+	require.Equal(t, withdrawal.MaxFeeRate, withdrawals[0].MaxFeeRate)
+	require.True(t, withdrawal.Expiry.Equal(withdrawals[0].Expiry))
+	require.True(t, withdrawal.TraderKey.IsEqual(withdrawals[0].TraderKey))
+
+	// Storing another withdrawal for the same account replaces the first
+	// one.
+	withdrawal.Outputs = withdrawal.Outputs[:1]
+	require.NoError(t, db.StoreScheduledWithdrawal(withdrawal))
+
+	withdrawals, err = db.ScheduledWithdrawals()
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, withdrawal.Outputs, withdrawals[0].Outputs)
+
+	require.NoError(t, db.DeleteScheduledWithdrawal(testTraderKey))
+	withdrawals, err = db.ScheduledWithdrawals()
+	require.NoError(t, err)
+	require.Empty(t, withdrawals)
+
+	err = db.DeleteScheduledWithdrawal(testTraderKey)
+	require.ErrorIs(t, err, account.ErrNoScheduledWithdrawal)
+
+	// Completing a withdrawal updates the account and removes the
+	// withdrawal at the same time.
+	require.NoError(t, db.StoreScheduledWithdrawal(withdrawal))
+	newValue := acct.Value / 2
+	err = db.CompleteScheduledWithdrawal(
+		acct, account.ValueModifier(newValue),
+	)
+	require.NoError(t, err)
+
+	dbAcct, err := db.Account(testTraderKey)
+	require.NoError(t, err)
+	require.Equal(t, newValue, dbAcct.Value)
+	withdrawals, err = db.ScheduledWithdrawals()
+	require.NoError(t, err)
+	require.Empty(t, withdrawals)
+
+	// Without a scheduled withdrawal, the account is left untouched.
+	err = db.CompleteScheduledWithdrawal(acct, account.ValueModifier(1))
+	require.ErrorIs(t, err, account.ErrNoScheduledWithdrawal)
+
+	dbAcct, err = db.Account(testTraderKey)
+	require.NoError(t, err)
+	require.Equal(t, newValue, dbAcct.Value)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/sidecar"
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 var (
@@ -25,6 +25,11 @@ var (
 	// template of a sidecar ticket for the provider to be able to execute
 	// automated negotiation of the order.
 	bidTemplateBucket = []byte("sidecar-bids")
+
+	// archivedSidecarsBucketKey is a sub-bucket of the sidecars bucket
+	// that contains all tickets that reached a terminal state. It is keyed
+	// the same way as the main sidecars bucket.
+	archivedSidecarsBucketKey = []byte("archived-sidecars")
 )
 
 const (
@@ -60,7 +65,8 @@ func (db *DB) AddSidecar(ticket *sidecar.Ticket) error {
 		return err
 	}
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	evt := NewSidecarEvent(ticket.State, ticket, "")
+	err = db.Update(func(tx kvdb.RwTx) error {
 		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
@@ -72,8 +78,20 @@ func (db *DB) AddSidecar(ticket *sidecar.Ticket) error {
 				sidecarKey)
 		}
 
-		return storeSidecar(sidecarBucket, sidecarKey, ticket)
+		err = storeSidecar(sidecarBucket, sidecarKey, ticket)
+		if err != nil {
+			return err
+		}
+
+		return storeSidecarEventTX(sidecarBucket, sidecarKey, evt)
 	})
+	if err != nil {
+		return err
+	}
+
+	db.notifySidecarEvent(evt)
+
+	return nil
 }
 
 // AddSidecarWithBid is identical to the AddSidecar method, but it also inserts
@@ -92,7 +110,8 @@ func (db *DB) AddSidecarWithBid(ticket *sidecar.Ticket, bid *order.Bid) error {
 	bidNonce := bid.Nonce()
 	copy(ticket.Order.BidNonce[:], bidNonce[:])
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	evt := NewSidecarEvent(ticket.State, ticket, "")
+	err = db.Update(func(tx kvdb.RwTx) error {
 		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
@@ -108,6 +127,11 @@ func (db *DB) AddSidecarWithBid(ticket *sidecar.Ticket, bid *order.Bid) error {
 			return err
 		}
 
+		err = storeSidecarEventTX(sidecarBucket, sidecarKey, evt)
+		if err != nil {
+			return err
+		}
+
 		bidBucket, err := sidecarBucket.CreateBucketIfNotExists(
 			bidTemplateBucket,
 		)
@@ -117,38 +141,111 @@ func (db *DB) AddSidecarWithBid(ticket *sidecar.Ticket, bid *order.Bid) error {
 
 		return storeBidTemplate(bidBucket, bid, bidNonce)
 	})
+	if err != nil {
+		return err
+	}
+
+	db.notifySidecarEvent(evt)
+
+	return nil
 }
 
-// UpdateSidecar updates a sidecar in the database.
+// UpdateSidecar updates a sidecar in the database. If the ticket reached a
+// terminal state, it is moved to the archive.
 func (db *DB) UpdateSidecar(ticket *sidecar.Ticket) error {
+	return db.UpdateSidecarWithReason(ticket, "")
+}
+
+// UpdateSidecarWithReason updates a sidecar in the database and records the
+// given reason in the ticket's event log if its state changed. If the ticket
+// reached a terminal state, it is moved to the archive.
+func (db *DB) UpdateSidecarWithReason(ticket *sidecar.Ticket,
+	reason string) error {
+
 	sidecarKey, err := getSidecarKey(ticket.ID, ticket.Offer.SignPubKey)
 	if err != nil {
 		return err
 	}
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	var evt *SidecarEvent
+	err = db.Update(func(tx kvdb.RwTx) error {
+		// Reset the event in case the transaction is retried.
+		evt = nil
+
 		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
-		sidecarValue := sidecarBucket.Get(sidecarKey)
-		if len(sidecarValue) == 0 {
-			return ErrNoSidecar
+		// Tickets that were already archived are updated in place in
+		// the archive.
+		targetBucket := sidecarBucket
+		archived := len(sidecarBucket.Get(sidecarKey)) == 0
+		if archived {
+			targetBucket = sidecarBucket.NestedReadWriteBucket(
+				archivedSidecarsBucketKey,
+			)
+			if targetBucket == nil {
+				return ErrNoSidecar
+			}
 		}
 
-		// If the ticket is in a terminal state, we won't ever need the
-		// bid template again, so we remove it (if it still exists).
-		if ticket.State.IsTerminal() && ticket.Order != nil {
-			err := removeBidTemplate(
-				sidecarBucket, ticket.Order.BidNonce,
+		prev, err := readSidecar(targetBucket, sidecarKey)
+		if err != nil {
+			return err
+		}
+
+		if prev.State != ticket.State {
+			evt = NewSidecarEvent(prev.State, ticket, reason)
+			err := storeSidecarEventTX(
+				sidecarBucket, sidecarKey, evt,
 			)
 			if err != nil {
 				return err
 			}
 		}
 
-		return storeSidecar(sidecarBucket, sidecarKey, ticket)
+		if !archived && ticket.State.IsTerminal() {
+			return archiveSidecar(sidecarBucket, sidecarKey, ticket)
+		}
+
+		return storeSidecar(targetBucket, sidecarKey, ticket)
+	})
+	if err != nil {
+		return err
+	}
+
+	db.notifySidecarEvent(evt)
+
+	return nil
+}
+
+// ArchiveSidecar moves a sidecar ticket that reached a terminal state from the
+// active tickets to the archive. Archived tickets can still be looked up by
+// their ID.
+func (db *DB) ArchiveSidecar(ticket *sidecar.Ticket) error {
+	if !ticket.State.IsTerminal() {
+		return fmt.Errorf("cannot archive sidecar ticket in "+
+			"non-terminal state %v", ticket.State)
+	}
+
+	sidecarKey, err := getSidecarKey(ticket.ID, ticket.Offer.SignPubKey)
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		sidecarValue := sidecarBucket.Get(sidecarKey)
+		if len(sidecarValue) == 0 {
+			return ErrNoSidecar
+		}
+
+		return archiveSidecar(sidecarBucket, sidecarKey, ticket)
 	})
 }
 
@@ -163,13 +260,27 @@ func (db *DB) Sidecar(id [8]byte,
 	}
 
 	var s *sidecar.Ticket
-	err = db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err = db.View(func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
 		s, err = readSidecar(sidecarBucket, sidecarKey)
+		if err != ErrNoSidecar {
+			return err
+		}
+
+		// If the ticket isn't an active one, it might have been
+		// archived already.
+		archiveBucket := sidecarBucket.NestedReadBucket(
+			archivedSidecarsBucketKey,
+		)
+		if archiveBucket == nil {
+			return ErrNoSidecar
+		}
+
+		s, err = readSidecar(archiveBucket, sidecarKey)
 		return err
 	})
 	if err != nil {
@@ -189,32 +300,30 @@ func (db *DB) Sidecar(id [8]byte,
 // same ID and tricking the victim into registering that.
 func (db *DB) SidecarsByID(id [8]byte) ([]*sidecar.Ticket, error) {
 	var res []*sidecar.Ticket
-	err := db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err := db.View(func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
-		// The first 8 bytes of the key is a sidecar ticket's ID. So as
-		// long as the key's prefix is matching, we still have tickets
-		// with the same ID.
-		cursor := sidecarBucket.Cursor()
-		key, val := cursor.Seek(id[:])
-		for ; key != nil && bytes.HasPrefix(key, id[:]); key, val = cursor.Next() {
-			// The main sidecar bucket has a sub-bucket that's used
-			// to store order bid information, so we'll skip this
-			// bucket when attempting to read out all the tickets.
-			if val == nil {
-				return nil
-			}
+		res, err = readSidecarsByID(sidecarBucket, id)
+		if err != nil {
+			return err
+		}
 
-			s, err := readSidecar(sidecarBucket, key)
-			if err != nil {
-				return err
-			}
-			res = append(res, s)
+		archiveBucket := sidecarBucket.NestedReadBucket(
+			archivedSidecarsBucketKey,
+		)
+		if archiveBucket == nil {
+			return nil
 		}
 
+		archived, err := readSidecarsByID(archiveBucket, id)
+		if err != nil {
+			return err
+		}
+		res = append(res, archived...)
+
 		return nil
 	})
 	if err != nil {
@@ -229,13 +338,13 @@ func (db *DB) SidecarsByID(id [8]byte) ([]*sidecar.Ticket, error) {
 func (db *DB) SidecarBidTemplate(ticket *sidecar.Ticket) (*order.Bid, error) {
 	var bid *order.Bid
 
-	err := db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err := db.View(func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
-		bidBucket := sidecarBucket.Bucket(bidTemplateBucket)
+		bidBucket := sidecarBucket.NestedReadBucket(bidTemplateBucket)
 		if bidBucket == nil {
 			return err
 		}
@@ -250,31 +359,46 @@ func (db *DB) SidecarBidTemplate(ticket *sidecar.Ticket) (*order.Bid, error) {
 	return bid, nil
 }
 
-// Sidecars retrieves all known sidecars from the database.
-func (db *DB) Sidecars() ([]*sidecar.Ticket, error) {
+// Sidecars retrieves all known sidecars from the database. If any states are
+// given, only tickets in one of those states are returned. Archived tickets
+// are only read if no states or at least one terminal state is given.
+func (db *DB) Sidecars(states ...sidecar.State) ([]*sidecar.Ticket, error) {
+	readArchived := len(states) == 0
+	for _, state := range states {
+		if state.IsTerminal() {
+			readArchived = true
+		}
+	}
+
 	var res []*sidecar.Ticket
-	err := db.View(func(tx *bbolt.Tx) error {
-		sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	err := db.View(func(tx kvdb.RTx) error {
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
 		if err != nil {
 			return err
 		}
 
-		return sidecarBucket.ForEach(func(k, v []byte) error {
-			// The main sidecar bucket has a sub-bucket that's used
-			// to store order bid information, so we'll skip this
-			// bucket when attempting to read out all the tickets.
-			if v == nil {
-				return nil
-			}
-
-			s, err := readSidecar(sidecarBucket, k)
-			if err != nil {
-				return err
-			}
-			res = append(res, s)
+		// Tickets that reached a terminal state before the archive was
+		// introduced are still in the main bucket, so we can't skip it
+		// when looking for archived tickets.
+		res, err = readSidecars(sidecarBucket, states)
+		if err != nil {
+			return err
+		}
 
+		archiveBucket := sidecarBucket.NestedReadBucket(
+			archivedSidecarsBucketKey,
+		)
+		if !readArchived || archiveBucket == nil {
 			return nil
-		})
+		}
+
+		archived, err := readSidecars(archiveBucket, states)
+		if err != nil {
+			return err
+		}
+		res = append(res, archived...)
+
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -283,7 +407,7 @@ func (db *DB) Sidecars() ([]*sidecar.Ticket, error) {
 	return res, nil
 }
 
-func storeSidecar(targetBucket *bbolt.Bucket, key []byte,
+func storeSidecar(targetBucket kvdb.RwBucket, key []byte,
 	ticket *sidecar.Ticket) error {
 
 	var sidecarBuf bytes.Buffer
@@ -293,21 +417,126 @@ func storeSidecar(targetBucket *bbolt.Bucket, key []byte,
 
 	// TODO(roasbeef): store bid along side in new key?
 
-	return targetBucket.Put(key, sidecarBuf.Bytes())
+	return putRecord(targetBucket, key, sidecarBuf.Bytes())
 }
 
-func readSidecar(sourceBucket *bbolt.Bucket, id []byte) (*sidecar.Ticket,
+// readSidecars reads all tickets of the given bucket that are in one of the
+// given states. If no states are given, all tickets are returned.
+func readSidecars(sourceBucket kvdb.RBucket,
+	states []sidecar.State) ([]*sidecar.Ticket, error) {
+
+	var res []*sidecar.Ticket
+	err := sourceBucket.ForEach(func(k, v []byte) error {
+		// The main sidecar bucket has sub-buckets that are used to
+		// store order bid information and archived tickets, so we'll
+		// skip those when attempting to read out all the tickets.
+		if v == nil {
+			return nil
+		}
+
+		s, err := readSidecar(sourceBucket, k)
+		if err != nil {
+			return err
+		}
+
+		if len(states) > 0 && !containsState(states, s.State) {
+			return nil
+		}
+		res = append(res, s)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// readSidecarsByID reads all tickets of the given bucket with the given ID.
+func readSidecarsByID(sourceBucket kvdb.RBucket,
+	id [8]byte) ([]*sidecar.Ticket, error) {
+
+	// The first 8 bytes of the key is a sidecar ticket's ID. So as long as
+	// the key's prefix is matching, we still have tickets with the same ID.
+	var (
+		res    []*sidecar.Ticket
+		cursor = sourceBucket.ReadCursor()
+	)
+	key, val := cursor.Seek(id[:])
+	for ; key != nil && bytes.HasPrefix(key, id[:]); key, val = cursor.Next() {
+		// Skip over any sub-buckets that happen to share the prefix.
+		if val == nil {
+			continue
+		}
+
+		s, err := readSidecar(sourceBucket, key)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+// containsState returns true if the given state is in the list of states.
+func containsState(states []sidecar.State, state sidecar.State) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// archiveSidecar moves the given ticket from the main sidecars bucket to the
+// archive, removing its bid template if one still exists.
+func archiveSidecar(sidecarBucket kvdb.RwBucket, key []byte,
+	ticket *sidecar.Ticket) error {
+
+	// We won't ever need the bid template of an archived ticket again, so
+	// we remove it (if it still exists).
+	if ticket.Order != nil {
+		err := removeBidTemplate(sidecarBucket, ticket.Order.BidNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	archiveBucket, err := sidecarBucket.CreateBucketIfNotExists(
+		archivedSidecarsBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := storeSidecar(archiveBucket, key, ticket); err != nil {
+		return err
+	}
+
+	return deleteRecord(sidecarBucket, key)
+}
+
+func readSidecar(sourceBucket kvdb.RBucket, id []byte) (*sidecar.Ticket,
 	error) {
 
 	sidecarBytes := sourceBucket.Get(id)
 	if sidecarBytes == nil {
 		return nil, ErrNoSidecar
 	}
+	if !recordValid(sourceBucket, id, sidecarBytes) {
+		return nil, &ErrCorruptedRecord{
+			Bucket: string(sidecarsBucketKey),
+			Key:    id,
+		}
+	}
 
 	return sidecar.DeserializeTicket(bytes.NewReader(sidecarBytes))
 }
 
-func storeBidTemplate(bidBucket *bbolt.Bucket, bid *order.Bid,
+func storeBidTemplate(bidBucket kvdb.RwBucket, bid *order.Bid,
 	ticketNonce order.Nonce) error {
 
 	var w bytes.Buffer
@@ -331,7 +560,7 @@ func storeBidTemplate(bidBucket *bbolt.Bucket, bid *order.Bid,
 	return storeOrderMinNoderTierTX(bidBucket, ticketNonce, bid.MinNodeTier)
 }
 
-func readBidTemplate(bidBucket *bbolt.Bucket,
+func readBidTemplate(bidBucket kvdb.RBucket,
 	ticketNonce order.Nonce) (*order.Bid, error) {
 
 	var (
@@ -370,10 +599,10 @@ func readBidTemplate(bidBucket *bbolt.Bucket,
 	return o.(*order.Bid), nil
 }
 
-func removeBidTemplate(sidecarBucket *bbolt.Bucket,
+func removeBidTemplate(sidecarBucket kvdb.RwBucket,
 	ticketNonce order.Nonce) error {
 
-	bidBucket := sidecarBucket.Bucket(bidTemplateBucket)
+	bidBucket := sidecarBucket.NestedReadWriteBucket(bidTemplateBucket)
 
 	// If there is no bucket for the bid templates yet, we don't have
 	// anything to do.
@@ -388,8 +617,8 @@ func removeBidTemplate(sidecarBucket *bbolt.Bucket,
 	}
 
 	// If the template was already removed, we ignore the error.
-	err := bidBucket.DeleteBucket(ticketNonce[:])
-	if err != bbolt.ErrBucketNotFound {
+	err := bidBucket.DeleteNestedBucket(ticketNonce[:])
+	if err != kvdb.ErrBucketNotFound {
 		return err
 	}
 
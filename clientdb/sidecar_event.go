@@ -0,0 +1,304 @@
+package clientdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// sidecarEventsBucketKey is a sub-bucket of the sidecars bucket that
+	// houses one bucket per ticket with the references to the ticket's
+	// events. It is keyed the same way as the main sidecars bucket. The
+	// events are kept separate from the tickets themselves so they don't
+	// need to be moved when a ticket is archived.
+	sidecarEventsBucketKey = []byte("sidecar-events")
+)
+
+const (
+	// sidecarEventIDType is the tlv type we use to store the ID of the
+	// ticket an event refers to.
+	sidecarEventIDType tlv.Type = 1
+
+	// sidecarEventSignKeyType is the tlv type we use to store the offer
+	// signing key of the ticket an event refers to.
+	sidecarEventSignKeyType tlv.Type = 2
+
+	// sidecarEventPrevStateType is the tlv type we use to store the state
+	// the ticket had before the state change.
+	sidecarEventPrevStateType tlv.Type = 3
+
+	// sidecarEventNewStateType is the tlv type we use to store the state
+	// the ticket had after the state change.
+	sidecarEventNewStateType tlv.Type = 4
+
+	// sidecarEventReasonType is the tlv type we use to store the reason of
+	// a state change, if one was given.
+	sidecarEventReasonType tlv.Type = 5
+)
+
+// SidecarEventCallback is a function that is called with each sidecar event
+// after it was stored in the database.
+type SidecarEventCallback func(evt *SidecarEvent)
+
+// SidecarEvent is an event implementation that tracks the state changes of a
+// sidecar ticket. The first event of a ticket is recorded when it's added to
+// the database and has the same previous and new state.
+type SidecarEvent struct {
+	// timestamp is the unique timestamp the event was created/recorded at.
+	timestamp time.Time
+
+	// ID is the ID of the ticket this event refers to.
+	ID [8]byte
+
+	// OfferSignPubKey is the offer signing key of the ticket this event
+	// refers to. Together with the ID it identifies the ticket.
+	OfferSignPubKey [33]byte
+
+	// PrevState is the state the ticket had previous to the state change.
+	PrevState sidecar.State
+
+	// NewState is the state the ticket had after the state change.
+	NewState sidecar.State
+
+	// Reason is an optional human readable explanation of the state
+	// change. This is mostly set for tickets that failed or were canceled.
+	Reason string
+}
+
+// NewSidecarEvent creates a new SidecarEvent for the transition of a ticket
+// from the given previous state to its current state with the current system
+// time as the timestamp.
+func NewSidecarEvent(prevState sidecar.State, ticket *sidecar.Ticket,
+	reason string) *SidecarEvent {
+
+	evt := &SidecarEvent{
+		timestamp: time.Now(),
+		ID:        ticket.ID,
+		PrevState: prevState,
+		NewState:  ticket.State,
+		Reason:    reason,
+	}
+	copy(
+		evt.OfferSignPubKey[:],
+		ticket.Offer.SignPubKey.SerializeCompressed(),
+	)
+
+	return evt
+}
+
+// Type returns the type of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *SidecarEvent) Type() event.Type {
+	return event.TypeSidecarStateChange
+}
+
+// Timestamp is the time the event happened. This will be made unique once it is
+// stored. To avoid collisions, the timestamp is adjusted on the nanosecond
+// scale to reach uniqueness.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *SidecarEvent) Timestamp() time.Time {
+	return e.timestamp
+}
+
+// SetTimestamp updates the timestamp of the event. This is needed to adjust
+// timestamps in case they collide to ensure the global uniqueness of all event
+// timestamps.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *SidecarEvent) SetTimestamp(ts time.Time) {
+	e.timestamp = ts
+}
+
+// String returns a human readable representation of the event.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *SidecarEvent) String() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("SidecarUpdate(%v, %s)", e.NewState,
+			e.Reason)
+	}
+	return fmt.Sprintf("SidecarUpdate(%v)", e.NewState)
+}
+
+// Serialize writes the event data to a binary storage format. This does not
+// serialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *SidecarEvent) Serialize(w *bytes.Buffer) error {
+	var (
+		id        = e.ID[:]
+		prevState = uint8(e.PrevState)
+		newState  = uint8(e.NewState)
+		reason    = []byte(e.Reason)
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(sidecarEventIDType, &id),
+		tlv.MakePrimitiveRecord(
+			sidecarEventSignKeyType, &e.OfferSignPubKey,
+		),
+		tlv.MakePrimitiveRecord(sidecarEventPrevStateType, &prevState),
+		tlv.MakePrimitiveRecord(sidecarEventNewStateType, &newState),
+		tlv.MakePrimitiveRecord(sidecarEventReasonType, &reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// Deserialize reads the event data from a binary storage format. This does not
+// deserialize the event type as that's handled generically to allow for easy
+// filtering.
+//
+// NOTE: This is part of the event.Event interface.
+func (e *SidecarEvent) Deserialize(r io.Reader) error {
+	var (
+		id                  []byte
+		prevState, newState uint8
+		reason              []byte
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(sidecarEventIDType, &id),
+		tlv.MakePrimitiveRecord(
+			sidecarEventSignKeyType, &e.OfferSignPubKey,
+		),
+		tlv.MakePrimitiveRecord(sidecarEventPrevStateType, &prevState),
+		tlv.MakePrimitiveRecord(sidecarEventNewStateType, &newState),
+		tlv.MakePrimitiveRecord(sidecarEventReasonType, &reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	if len(id) != len(e.ID) {
+		return fmt.Errorf("invalid sidecar ID length %d", len(id))
+	}
+	copy(e.ID[:], id)
+	e.PrevState = sidecar.State(prevState)
+	e.NewState = sidecar.State(newState)
+	e.Reason = string(reason)
+
+	return nil
+}
+
+// A compile time assertion to make sure SidecarEvent implements the
+// event.Event interface.
+var _ event.Event = (*SidecarEvent)(nil)
+
+// SubscribeSidecarEvents registers a callback that is invoked with every
+// sidecar event that is recorded. The callback is invoked synchronously after
+// the modifying database transaction was committed.
+func (db *DB) SubscribeSidecarEvents(cb SidecarEventCallback) {
+	db.sidecarSubscribersMtx.Lock()
+	defer db.sidecarSubscribersMtx.Unlock()
+
+	db.sidecarSubscribers = append(db.sidecarSubscribers, cb)
+}
+
+// notifySidecarEvent passes the given event to all registered sidecar event
+// callbacks. The event is allowed to be nil in case no state change happened.
+func (db *DB) notifySidecarEvent(evt *SidecarEvent) {
+	if evt == nil {
+		return
+	}
+
+	db.sidecarSubscribersMtx.Lock()
+	defer db.sidecarSubscribersMtx.Unlock()
+
+	for _, cb := range db.sidecarSubscribers {
+		cb(evt)
+	}
+}
+
+// storeSidecarEventTX stores the given sidecar event both in the main events
+// bucket and a reference to it in the ticket's event bucket.
+func storeSidecarEventTX(sidecarBucket kvdb.RwBucket, sidecarKey []byte,
+	evt *SidecarEvent) error {
+
+	eventsBucket, err := getNestedBucket(
+		sidecarBucket, sidecarEventsBucketKey, true,
+	)
+	if err != nil {
+		return err
+	}
+
+	ownerBucket, err := eventsBucket.CreateBucketIfNotExists(sidecarKey)
+	if err != nil {
+		return err
+	}
+
+	return storeEventTX(ownerBucket, evt)
+}
+
+// GetSidecarEvents returns all recorded state changes of the ticket with the
+// given ID and offer signing key in chronological order.
+func (db *DB) GetSidecarEvents(id [8]byte,
+	offerSignPubKey *btcec.PublicKey) ([]*SidecarEvent, error) {
+
+	sidecarKey, err := getSidecarKey(id, offerSignPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*SidecarEvent
+	err = db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		events = nil
+
+		sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// Tickets that weren't updated since events were introduced
+		// don't have any events yet.
+		eventsBucket := sidecarBucket.NestedReadBucket(
+			sidecarEventsBucketKey,
+		)
+		if eventsBucket == nil {
+			return nil
+		}
+		ownerBucket := eventsBucket.NestedReadBucket(sidecarKey)
+		if ownerBucket == nil {
+			return nil
+		}
+
+		dbEvents, err := getReferencedEventsTX(tx, ownerBucket)
+		if err != nil {
+			return err
+		}
+
+		events = make([]*SidecarEvent, 0, len(dbEvents))
+		for _, dbEvent := range dbEvents {
+			evt, ok := dbEvent.(*SidecarEvent)
+			if !ok {
+				return fmt.Errorf("unexpected sidecar event "+
+					"type %v", dbEvent.Type())
+			}
+			events = append(events, evt)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
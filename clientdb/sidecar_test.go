@@ -206,3 +206,134 @@ func TestSidecarsWithSameID(t *testing.T) {
 	require.Equal(t, testBatchKey, byID[0].Offer.SignPubKey)
 	require.Equal(t, testTraderKey, byID[1].Offer.SignPubKey)
 }
+
+// TestSidecarArchive makes sure tickets that reach a terminal state are moved
+// to the archive and can still be looked up and filtered by their state.
+func TestSidecarArchive(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	newTicket := func(id byte) *sidecar.Ticket {
+		return &sidecar.Ticket{
+			ID:    [8]byte{id},
+			State: sidecar.StateOffered,
+			Offer: sidecar.Offer{
+				Capacity:            1000000,
+				SignPubKey:          testTraderKey,
+				LeaseDurationBlocks: 2016,
+			},
+		}
+	}
+	active, completed := newTicket(1), newTicket(2)
+	require.NoError(t, db.AddSidecar(active))
+	require.NoError(t, db.AddSidecar(completed))
+
+	// Only terminal tickets can be archived explicitly.
+	require.Error(t, db.ArchiveSidecar(active))
+
+	// Completing a ticket moves it to the archive, it should still be
+	// found by its ID.
+	completed.State = sidecar.StateCompleted
+	require.NoError(t, db.UpdateSidecar(completed))
+	assertSidecarExists(t, db, completed)
+
+	byID, err := db.SidecarsByID(completed.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*sidecar.Ticket{completed}, byID)
+
+	// An archived ticket can still be updated, for example when it's
+	// canceled after completing.
+	completed.State = sidecar.StateCanceled
+	require.NoError(t, db.UpdateSidecar(completed))
+	assertSidecarExists(t, db, completed)
+
+	all, err := db.Sidecars()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	offered, err := db.Sidecars(sidecar.StateOffered)
+	require.NoError(t, err)
+	require.Equal(t, []*sidecar.Ticket{active}, offered)
+
+	canceled, err := db.Sidecars(sidecar.StateCanceled)
+	require.NoError(t, err)
+	require.Equal(t, []*sidecar.Ticket{completed}, canceled)
+
+	none, err := db.Sidecars(sidecar.StateCompleted)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+// TestSidecarEvents makes sure every state change of a ticket is recorded in
+// its event log and passed to the event subscribers.
+func TestSidecarEvents(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	var notified []*SidecarEvent
+	db.SubscribeSidecarEvents(func(evt *SidecarEvent) {
+		notified = append(notified, evt)
+	})
+
+	s := &sidecar.Ticket{
+		ID:    [8]byte{12, 34, 56},
+		State: sidecar.StateOffered,
+		Offer: sidecar.Offer{
+			Capacity:            1000000,
+			SignPubKey:          testTraderKey,
+			LeaseDurationBlocks: 2016,
+		},
+	}
+	require.NoError(t, db.AddSidecar(s))
+
+	// Updates that don't change the state aren't recorded.
+	s.Offer.Capacity = 2000000
+	require.NoError(t, db.UpdateSidecar(s))
+
+	s.State = sidecar.StateRegistered
+	require.NoError(t, db.UpdateSidecar(s))
+
+	// The ticket is archived when it's canceled, its events are kept.
+	s.State = sidecar.StateCanceled
+	require.NoError(t, db.UpdateSidecarWithReason(s, "canceled by user"))
+
+	events, err := db.GetSidecarEvents(s.ID, s.Offer.SignPubKey)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Len(t, notified, 3)
+
+	expected := []struct {
+		prev, cur sidecar.State
+		reason    string
+	}{
+		{sidecar.StateOffered, sidecar.StateOffered, ""},
+		{sidecar.StateOffered, sidecar.StateRegistered, ""},
+		{sidecar.StateRegistered, sidecar.StateCanceled,
+			"canceled by user"},
+	}
+	for idx, evt := range events {
+		require.Equal(t, s.ID, evt.ID)
+		require.Equal(
+			t, testTraderKey.SerializeCompressed(),
+			evt.OfferSignPubKey[:],
+		)
+		require.Equal(t, expected[idx].prev, evt.PrevState)
+		require.Equal(t, expected[idx].cur, evt.NewState)
+		require.Equal(t, expected[idx].reason, evt.Reason)
+
+		require.Equal(t, notified[idx].NewState, evt.NewState)
+		require.Equal(
+			t, notified[idx].Timestamp().UnixNano(),
+			evt.Timestamp().UnixNano(),
+		)
+	}
+
+	// Tickets without events return an empty history.
+	events, err = db.GetSidecarEvents([8]byte{1}, testTraderKey)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
@@ -0,0 +1,812 @@
+package clientdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// stateExportVersion is the current version of the state export
+	// format. It is written right after the magic bytes at the beginning
+	// of every export.
+	stateExportVersion uint32 = 1
+
+	// maxStateRecordSize is the maximum size of a single record within a
+	// state export we accept when importing.
+	maxStateRecordSize = 1 << 20
+
+	// lsatTokenFileName is the name of the file the LSAT file store keeps
+	// the current paid token in. The token store uses the same directory
+	// as the database.
+	lsatTokenFileName = "lsat.token"
+)
+
+const (
+	// stateEndType is the type of the record that marks the end of a
+	// state export. It doesn't carry a value.
+	stateEndType tlv.Type = 0
+
+	// stateAccountType is the type of a record that contains a single
+	// serialized account.
+	stateAccountType tlv.Type = 1
+
+	// stateOrderType is the type of a record that contains a single
+	// order, encoded as a nested tlv stream.
+	stateOrderType tlv.Type = 2
+
+	// stateSidecarType is the type of a record that contains a single
+	// serialized sidecar ticket.
+	stateSidecarType tlv.Type = 3
+
+	// stateBidTemplateType is the type of a record that contains the bid
+	// template of a sidecar ticket, encoded the same way as an order.
+	stateBidTemplateType tlv.Type = 4
+
+	// stateLsatTokenType is the type of the record that contains the raw
+	// LSAT token as it is stored by the LSAT file store.
+	stateLsatTokenType tlv.Type = 5
+)
+
+const (
+	// orderNonceType is the tlv type of an order's nonce within an
+	// exported order record.
+	orderNonceType tlv.Type = 1
+
+	// orderDataType is the tlv type of an order's serialized base data
+	// within an exported order record.
+	orderDataType tlv.Type = 2
+
+	// orderMinUnitsMatchType is the tlv type of an order's minimum units
+	// match within an exported order record.
+	orderMinUnitsMatchType tlv.Type = 3
+
+	// orderMinNodeTierType is the tlv type of a bid's minimum node tier
+	// within an exported order record.
+	orderMinNodeTierType tlv.Type = 4
+
+	// orderTlvDataType is the tlv type of an order's serialized
+	// additional data within an exported order record.
+	orderTlvDataType tlv.Type = 5
+
+	// orderCreatedAtType is the tlv type of the unix nano timestamp an
+	// order was created at within an exported order record. The type is
+	// odd so imports of older versions skip it instead of failing.
+	orderCreatedAtType tlv.Type = 7
+)
+
+var (
+	// ErrStateNotEmpty is the error returned if a state export is
+	// imported into a database that already contains accounts.
+	ErrStateNotEmpty = errors.New("database already contains accounts, " +
+		"refusing to merge imported state")
+
+	// stateExportMagic are the magic bytes every state export starts with.
+	stateExportMagic = [4]byte{'p', 'o', 'o', 'l'}
+)
+
+// traderState is the full trader state that is contained in a state export.
+type traderState struct {
+	accounts       []*account.Account
+	orders         []order.Order
+	orderCreatedAt map[order.Nonce]time.Time
+	sidecars       []*sidecar.Ticket
+	bidTemplates   []*order.Bid
+	lsatToken      []byte
+}
+
+// ExportState serializes all accounts, orders (active and archived), sidecar
+// tickets and the current LSAT token into a versioned, portable stream that
+// can be imported into a database of a different version with ImportState.
+// Pending batches, batch snapshots and events are not part of the export, only
+// the time each order was created at is carried over.
+func (db *DB) ExportState(w io.Writer) error {
+	var state traderState
+	err := db.View(func(tx kvdb.RTx) error {
+		var err error
+		state.accounts, err = readAllAccountsTX(tx)
+		if err != nil {
+			return err
+		}
+
+		state.orders, state.orderCreatedAt, err = readAllOrdersTX(tx)
+		if err != nil {
+			return err
+		}
+
+		state.sidecars, state.bidTemplates, err = readAllSidecarsTX(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// The LSAT token is optional, as a trader that never talked to the
+	// auctioneer doesn't have one yet.
+	tokenPath := db.lsatTokenPath()
+	if fileExists(tokenPath) {
+		state.lsatToken, err = ioutil.ReadFile(tokenPath)
+		if err != nil {
+			return fmt.Errorf("error reading LSAT token: %v", err)
+		}
+	}
+
+	// We serialize everything into a buffer first so we don't write a
+	// partial export if any of the records can't be serialized.
+	var b bytes.Buffer
+	if err := serializeTraderState(&b, &state); err != nil {
+		return err
+	}
+
+	_, err = w.Write(b.Bytes())
+	return err
+}
+
+// ImportState reads a state export created by ExportState and stores all of
+// its content in the database. The import fails if the database already
+// contains any accounts. Every record is deserialized and validated before
+// anything is written and all records are stored within a single database
+// transaction.
+func (db *DB) ImportState(r io.Reader) error {
+	state, err := deserializeTraderState(r)
+	if err != nil {
+		return fmt.Errorf("error reading state export: %v", err)
+	}
+
+	// We never overwrite an existing LSAT token, as that is what
+	// identifies the trader to the auctioneer.
+	tokenPath := db.lsatTokenPath()
+	if state.lsatToken != nil {
+		if fileExists(tokenPath) {
+			return fmt.Errorf("LSAT token already exists at %v",
+				tokenPath)
+		}
+
+		if err := validateLsatToken(state.lsatToken); err != nil {
+			return fmt.Errorf("invalid LSAT token: %v", err)
+		}
+
+		err := ioutil.WriteFile(tokenPath, state.lsatToken, 0600)
+		if err != nil {
+			return fmt.Errorf("error writing LSAT token: %v", err)
+		}
+	}
+
+	err = db.Update(func(tx kvdb.RwTx) error {
+		return storeTraderStateTX(tx, state)
+	})
+	if err != nil {
+		// Don't leave the token behind if we couldn't import the rest
+		// of the state.
+		if state.lsatToken != nil {
+			_ = os.Remove(tokenPath)
+		}
+
+		return err
+	}
+
+	db.notifyAccountUpdate()
+
+	return nil
+}
+
+// lsatTokenPath returns the path of the LSAT token file that belongs to the
+// database.
+func (db *DB) lsatTokenPath() string {
+	return filepath.Join(db.dir, lsatTokenFileName)
+}
+
+// validateLsatToken makes sure the given raw token can be read by the LSAT
+// file store by writing it to a temporary store.
+func validateLsatToken(rawToken []byte) error {
+	tempDir, err := ioutil.TempDir("", "pool-lsat")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	err = ioutil.WriteFile(
+		filepath.Join(tempDir, lsatTokenFileName), rawToken, 0600,
+	)
+	if err != nil {
+		return err
+	}
+
+	store, err := lsat.NewFileStore(tempDir)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.CurrentToken()
+	return err
+}
+
+// readAllOrdersTX reads all orders from the database, regardless of their
+// state, together with the time each of them was created at. Orders without a
+// creation event are missing from the returned map.
+func readAllOrdersTX(tx kvdb.RTx) ([]order.Order, map[order.Nonce]time.Time,
+	error) {
+
+	rootBucket, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		res       []order.Order
+		createdAt = make(map[order.Nonce]time.Time)
+	)
+	err = rootBucket.ForEach(func(k, v []byte) error {
+		// Only go into things that we know are sub-bucket keys.
+		if v != nil {
+			return nil
+		}
+
+		var nonce order.Nonce
+		copy(nonce[:], k)
+		o, _, err := modifyOrder(rootBucket, nonce, nil)
+		if err != nil {
+			return err
+		}
+		res = append(res, o)
+
+		ts, ok := orderCreatedAtTX(rootBucket.NestedReadBucket(k))
+		if ok {
+			createdAt[nonce] = ts
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, createdAt, nil
+}
+
+// orderCreatedAtTX returns the timestamp of the creation event referenced in
+// the given order bucket. False is returned if the order has no creation
+// event.
+func orderCreatedAtTX(orderBucket kvdb.RBucket) (time.Time, bool) {
+	eventSubBucket := orderBucket.NestedReadBucket(eventRefSubBucket)
+	if eventSubBucket == nil {
+		return time.Time{}, false
+	}
+
+	// The reference keys are big endian encoded timestamps and their value
+	// is the event type, so we don't need to look at the events themselves.
+	c := eventSubBucket.ReadCursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != event.TimestampLength || len(v) != 1 {
+			continue
+		}
+		if event.Type(v[0]) != event.TypeOrderCreated {
+			continue
+		}
+
+		return time.Unix(0, int64(byteOrder.Uint64(k))), true
+	}
+
+	return time.Time{}, false
+}
+
+// readAllSidecarsTX reads all sidecar tickets and their bid templates from the
+// database.
+func readAllSidecarsTX(tx kvdb.RTx) ([]*sidecar.Ticket, []*order.Bid, error) {
+	sidecarBucket, err := getReadBucket(tx, sidecarsBucketKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The bid templates are stored in a sub-bucket, we'll read them
+	// separately.
+	tickets, err := readSidecars(sidecarBucket, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	archiveBucket := sidecarBucket.NestedReadBucket(
+		archivedSidecarsBucketKey,
+	)
+	if archiveBucket != nil {
+		archived, err := readSidecars(archiveBucket, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		tickets = append(tickets, archived...)
+	}
+
+	bidBucket := sidecarBucket.NestedReadBucket(bidTemplateBucket)
+	if bidBucket == nil {
+		return tickets, nil, nil
+	}
+
+	var bids []*order.Bid
+	err = bidBucket.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+
+		var nonce order.Nonce
+		copy(nonce[:], k)
+		bid, err := readBidTemplate(bidBucket, nonce)
+		if err != nil {
+			return err
+		}
+		bids = append(bids, bid)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tickets, bids, nil
+}
+
+// storeTraderStateTX stores the full trader state in the database. An error is
+// returned if the database already contains any accounts or if any of the
+// orders or sidecar tickets already exist.
+func storeTraderStateTX(tx kvdb.RwTx, state *traderState) error {
+	accounts, err := getBucket(tx, accountBucketKey)
+	if err != nil {
+		return err
+	}
+
+	// To avoid accidentally merging two different trader states, we only
+	// import into a database that doesn't have any accounts yet.
+	err = accounts.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return ErrStateNotEmpty
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, acct := range state.accounts {
+		if err := storeAccount(accounts, acct); err != nil {
+			return err
+		}
+	}
+
+	rootBucket, err := getBucket(tx, ordersBucketKey)
+	if err != nil {
+		return err
+	}
+	importTime := time.Now()
+	for _, o := range state.orders {
+		err := fetchOrderTX(rootBucket, o.Nonce(), nil)
+		switch err {
+		case nil:
+			return ErrOrderExists
+
+		case ErrNoOrder:
+
+		default:
+			return err
+		}
+
+		// We don't export any events, but every order needs its
+		// creation event to show up in the paginated order listing and
+		// to be dated when pruning. Exports that don't carry the
+		// creation time fall back to the time of the import.
+		createdAt, ok := state.orderCreatedAt[o.Nonce()]
+		if !ok {
+			createdAt = importTime
+		}
+		evt := &CreatedEvent{
+			timestamp: createdAt,
+			nonce:     o.Nonce(),
+		}
+		if err := storeFullOrderTX(rootBucket, o, evt); err != nil {
+			return err
+		}
+		if err := indexOrderStateTX(tx, o); err != nil {
+			return err
+		}
+	}
+
+	sidecarBucket, err := getBucket(tx, sidecarsBucketKey)
+	if err != nil {
+		return err
+	}
+	for _, ticket := range state.sidecars {
+		sidecarKey, err := getSidecarKey(
+			ticket.ID, ticket.Offer.SignPubKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(sidecarBucket.Get(sidecarKey)) != 0 {
+			return fmt.Errorf("sidecar for key %x already exists",
+				sidecarKey)
+		}
+
+		// Tickets in a terminal state go directly to the archive.
+		targetBucket := sidecarBucket
+		if ticket.State.IsTerminal() {
+			targetBucket, err = getNestedBucket(
+				sidecarBucket, archivedSidecarsBucketKey, true,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = storeSidecar(targetBucket, sidecarKey, ticket)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(state.bidTemplates) == 0 {
+		return nil
+	}
+
+	bidBucket, err := sidecarBucket.CreateBucketIfNotExists(
+		bidTemplateBucket,
+	)
+	if err != nil {
+		return err
+	}
+	for _, bid := range state.bidTemplates {
+		err := storeBidTemplate(bidBucket, bid, bid.Nonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serializeTraderState writes the full trader state to the given writer. The
+// export starts with the magic bytes and the export version, followed by one
+// record per item and a final end record.
+func serializeTraderState(w *bytes.Buffer, state *traderState) error {
+	_, _ = w.Write(stateExportMagic[:])
+	if err := WriteElement(w, stateExportVersion); err != nil {
+		return err
+	}
+
+	for _, acct := range state.accounts {
+		var b bytes.Buffer
+		if err := serializeAccount(&b, acct); err != nil {
+			return err
+		}
+		if err := WriteElement(&b, acct.Version); err != nil {
+			return err
+		}
+
+		err := writeStateRecord(w, stateAccountType, b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, o := range state.orders {
+		var b bytes.Buffer
+		createdAt := state.orderCreatedAt[o.Nonce()]
+		if err := serializeStateOrder(&b, o, createdAt); err != nil {
+			return err
+		}
+
+		err := writeStateRecord(w, stateOrderType, b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ticket := range state.sidecars {
+		var b bytes.Buffer
+		if err := sidecar.SerializeTicket(&b, ticket); err != nil {
+			return err
+		}
+
+		err := writeStateRecord(w, stateSidecarType, b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, bid := range state.bidTemplates {
+		var b bytes.Buffer
+		err := serializeStateOrder(&b, bid, time.Time{})
+		if err != nil {
+			return err
+		}
+
+		err = writeStateRecord(w, stateBidTemplateType, b.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	if state.lsatToken != nil {
+		err := writeStateRecord(w, stateLsatTokenType, state.lsatToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeStateRecord(w, stateEndType, nil)
+}
+
+// deserializeTraderState reads a full trader state from the given reader and
+// deserializes all of its records.
+func deserializeTraderState(r io.Reader) (*traderState, error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, err
+	}
+	if magic != stateExportMagic {
+		return nil, fmt.Errorf("not a pool state export")
+	}
+
+	var version uint32
+	if err := ReadElement(r, &version); err != nil {
+		return nil, err
+	}
+	if version != stateExportVersion {
+		return nil, fmt.Errorf("unknown state export version %d",
+			version)
+	}
+
+	state := &traderState{
+		orderCreatedAt: make(map[order.Nonce]time.Time),
+	}
+	for {
+		recordType, value, err := readStateRecord(r)
+		if err != nil {
+			return nil, err
+		}
+
+		valueReader := bytes.NewReader(value)
+		switch recordType {
+		case stateEndType:
+			return state, nil
+
+		case stateAccountType:
+			acct, err := deserializeAccount(valueReader)
+			if err != nil {
+				return nil, fmt.Errorf("invalid account: %v",
+					err)
+			}
+
+			// Exports created before account versions were
+			// introduced don't contain the version.
+			if valueReader.Len() > 0 {
+				err := ReadElement(valueReader, &acct.Version)
+				if err != nil {
+					return nil, fmt.Errorf("invalid "+
+						"account version: %v", err)
+				}
+			}
+			state.accounts = append(state.accounts, acct)
+
+		case stateOrderType:
+			o, createdAt, err := deserializeStateOrder(valueReader)
+			if err != nil {
+				return nil, fmt.Errorf("invalid order: %v", err)
+			}
+			state.orders = append(state.orders, o)
+			if !createdAt.IsZero() {
+				state.orderCreatedAt[o.Nonce()] = createdAt
+			}
+
+		case stateSidecarType:
+			ticket, err := sidecar.DeserializeTicket(valueReader)
+			if err != nil {
+				return nil, fmt.Errorf("invalid sidecar "+
+					"ticket: %v", err)
+			}
+			state.sidecars = append(state.sidecars, ticket)
+
+		case stateBidTemplateType:
+			o, _, err := deserializeStateOrder(valueReader)
+			if err != nil {
+				return nil, fmt.Errorf("invalid bid template: "+
+					"%v", err)
+			}
+
+			bid, ok := o.(*order.Bid)
+			if !ok {
+				return nil, fmt.Errorf("bid template %v is "+
+					"not a bid", o.Nonce())
+			}
+			state.bidTemplates = append(state.bidTemplates, bid)
+
+		case stateLsatTokenType:
+			if state.lsatToken != nil {
+				return nil, fmt.Errorf("duplicate LSAT token")
+			}
+			state.lsatToken = value
+
+		default:
+			return nil, fmt.Errorf("unknown record type %d",
+				recordType)
+		}
+	}
+}
+
+// writeStateRecord writes a single record consisting of its type, the length
+// of its value and the value itself to the given writer.
+func writeStateRecord(w io.Writer, recordType tlv.Type, value []byte) error {
+	var buf [8]byte
+	if err := tlv.WriteVarInt(w, uint64(recordType), &buf); err != nil {
+		return err
+	}
+	if err := tlv.WriteVarInt(w, uint64(len(value)), &buf); err != nil {
+		return err
+	}
+
+	_, err := w.Write(value)
+	return err
+}
+
+// readStateRecord reads a single record written by writeStateRecord from the
+// given reader.
+func readStateRecord(r io.Reader) (tlv.Type, []byte, error) {
+	var buf [8]byte
+	recordType, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	length, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return 0, nil, err
+	}
+	if length > maxStateRecordSize {
+		return 0, nil, fmt.Errorf("record of type %d exceeds maximum "+
+			"size: %d", recordType, length)
+	}
+
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return 0, nil, err
+	}
+
+	return tlv.Type(recordType), value, nil
+}
+
+// serializeStateOrder encodes an order together with all of its additional
+// data as a single tlv stream. The creation time is only included if it is
+// set.
+func serializeStateOrder(w io.Writer, o order.Order,
+	createdAt time.Time) error {
+
+	var orderBytes bytes.Buffer
+	if err := SerializeOrder(o, &orderBytes); err != nil {
+		return err
+	}
+
+	var tlvData bytes.Buffer
+	if err := serializeOrderTlvData(&tlvData, o); err != nil {
+		return err
+	}
+
+	var (
+		nonce         = [32]byte(o.Nonce())
+		data          = orderBytes.Bytes()
+		minUnitsMatch = uint64(o.Details().MinUnitsMatch)
+		minNodeTier   uint32
+		extraData     = tlvData.Bytes()
+	)
+
+	tlvRecords := []tlv.Record{
+		tlv.MakePrimitiveRecord(orderNonceType, &nonce),
+		tlv.MakePrimitiveRecord(orderDataType, &data),
+		tlv.MakePrimitiveRecord(
+			orderMinUnitsMatchType, &minUnitsMatch,
+		),
+	}
+
+	if bid, ok := o.(*order.Bid); ok {
+		minNodeTier = uint32(bid.MinNodeTier)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderMinNodeTierType, &minNodeTier,
+		))
+	}
+
+	tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+		orderTlvDataType, &extraData,
+	))
+
+	var createdAtNs uint64
+	if !createdAt.IsZero() {
+		createdAtNs = uint64(createdAt.UnixNano())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			orderCreatedAtType, &createdAtNs,
+		))
+	}
+
+	tlvStream, err := tlv.NewStream(tlvRecords...)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeStateOrder decodes an order that was encoded with
+// serializeStateOrder. The returned creation time is zero if the record
+// doesn't contain one.
+func deserializeStateOrder(r io.Reader) (order.Order, time.Time, error) {
+	var (
+		nonce         [32]byte
+		data          []byte
+		minUnitsMatch uint64
+		minNodeTier   uint32
+		extraData     []byte
+		createdAtNs   uint64
+		createdAt     time.Time
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(orderNonceType, &nonce),
+		tlv.MakePrimitiveRecord(orderDataType, &data),
+		tlv.MakePrimitiveRecord(
+			orderMinUnitsMatchType, &minUnitsMatch,
+		),
+		tlv.MakePrimitiveRecord(orderMinNodeTierType, &minNodeTier),
+		tlv.MakePrimitiveRecord(orderTlvDataType, &extraData),
+		tlv.MakePrimitiveRecord(orderCreatedAtType, &createdAtNs),
+	)
+	if err != nil {
+		return nil, createdAt, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, createdAt, err
+	}
+
+	for _, t := range []tlv.Type{
+		orderNonceType, orderDataType, orderMinUnitsMatchType,
+	} {
+		if _, ok := parsedTypes[t]; !ok {
+			return nil, createdAt, fmt.Errorf("missing order "+
+				"record type %d", t)
+		}
+	}
+
+	o, err := DeserializeOrder(nonce, bytes.NewReader(data))
+	if err != nil {
+		return nil, createdAt, err
+	}
+
+	err = deserializeOrderTlvData(bytes.NewReader(extraData), o)
+	if err != nil {
+		return nil, createdAt, err
+	}
+
+	o.Details().MinUnitsMatch = order.SupplyUnit(minUnitsMatch)
+	if bid, ok := o.(*order.Bid); ok {
+		bid.MinNodeTier = order.NodeTier(minNodeTier)
+	}
+
+	if _, ok := parsedTypes[orderCreatedAtType]; ok {
+		createdAt = time.Unix(0, int64(createdAtNs))
+	}
+
+	return o, createdAt, nil
+}
@@ -0,0 +1,166 @@
+package clientdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon.v2"
+)
+
+// writeTestLsatToken writes a paid LSAT token in the format of the LSAT file
+// store next to the given database.
+func writeTestLsatToken(t *testing.T, db *DB) []byte {
+	t.Helper()
+
+	mac, err := macaroon.New(
+		[]byte("root-key"), []byte("id"), "lsat",
+		macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	for _, element := range []interface{}{
+		uint32(len(macBytes)), macBytes, [32]byte{1}, [32]byte{2},
+		uint64(1000), uint64(10), time.Now().UnixNano(),
+	} {
+		require.NoError(t, binary.Write(&b, byteOrder, element))
+	}
+
+	err = ioutil.WriteFile(db.lsatTokenPath(), b.Bytes(), 0600)
+	require.NoError(t, err)
+
+	return b.Bytes()
+}
+
+// TestExportImportState makes sure the full trader state can be exported from
+// one database and imported into another one.
+func TestExportImportState(t *testing.T) {
+	t.Parallel()
+
+	src, cleanupSrc := newTestDB(t)
+	defer cleanupSrc()
+
+	// Fill the source database with an account, an active and an archived
+	// order, a sidecar ticket with a bid template and an LSAT token.
+	acct := *testAccount
+	acct.State = account.StateOpen
+	acct.LatestTx = testBatchTx
+	require.NoError(t, src.AddAccount(&acct))
+
+	ask := &order.Ask{Kit: *dummyOrder(500000, 2016)}
+	ask.State = order.StateSubmitted
+	require.NoError(t, src.SubmitOrder(ask))
+
+	bid := &order.Bid{
+		Kit:             *dummyOrder(100000, 2016),
+		MinNodeTier:     order.NodeTier1,
+		SelfChanBalance: 123,
+	}
+	bid.Details().MinUnitsMatch = 2
+	bid.Details().AllowedNodeIDs = [][33]byte{{1, 2, 3}}
+	require.NoError(t, src.SubmitOrder(bid))
+
+	ticket := &sidecar.Ticket{
+		ID:    [8]byte{12, 34, 56},
+		State: sidecar.StateOffered,
+		Offer: sidecar.Offer{
+			Capacity:            1000000,
+			PushAmt:             200000,
+			SignPubKey:          testTraderKey,
+			LeaseDurationBlocks: 2016,
+		},
+	}
+	templateBid := &order.Bid{
+		Kit:         *dummyOrder(1000000, 2016),
+		MinNodeTier: order.NodeTier0,
+	}
+	templateBid.Details().MinUnitsMatch = 10
+	require.NoError(t, src.AddSidecarWithBid(ticket, templateBid))
+
+	rawToken := writeTestLsatToken(t, src)
+
+	var export bytes.Buffer
+	require.NoError(t, src.ExportState(&export))
+
+	// Importing into an empty database should result in the same state.
+	dst, cleanupDst := newTestDB(t)
+	defer cleanupDst()
+
+	require.NoError(t, dst.ImportState(bytes.NewReader(export.Bytes())))
+
+	srcAccounts, err := src.Accounts()
+	require.NoError(t, err)
+	dstAccounts, err := dst.Accounts()
+	require.NoError(t, err)
+	require.Equal(t, srcAccounts, dstAccounts)
+
+	srcOrders, err := src.GetOrders()
+	require.NoError(t, err)
+	dstOrders, err := dst.GetOrders()
+	require.NoError(t, err)
+	require.Len(t, dstOrders, 2)
+	require.ElementsMatch(t, srcOrders, dstOrders)
+
+	// The imported orders keep the time they were created at, so they're
+	// listed the same way as in the source database.
+	srcPage, err := src.GetOrdersPaginated(0, 0, OrderFilter{})
+	require.NoError(t, err)
+	dstPage, err := dst.GetOrdersPaginated(0, 0, OrderFilter{})
+	require.NoError(t, err)
+	require.Len(t, dstPage, 2)
+	require.Equal(t, srcPage, dstPage)
+
+	dstTicket, err := dst.Sidecar(ticket.ID, ticket.Offer.SignPubKey)
+	require.NoError(t, err)
+	require.Equal(t, ticket, dstTicket)
+
+	dstTemplate, err := dst.SidecarBidTemplate(ticket)
+	require.NoError(t, err)
+	require.Equal(t, templateBid, dstTemplate)
+
+	dstToken, err := ioutil.ReadFile(dst.lsatTokenPath())
+	require.NoError(t, err)
+	require.Equal(t, rawToken, dstToken)
+
+	// Importing a second time must fail as the destination already has an
+	// LSAT token.
+	err = dst.ImportState(bytes.NewReader(export.Bytes()))
+	require.Error(t, err)
+
+	// A database that already has an account must also refuse the import
+	// and the LSAT token must not be left behind.
+	other, cleanupOther := newTestDB(t)
+	defer cleanupOther()
+
+	require.NoError(t, other.AddAccount(&acct))
+	err = other.ImportState(bytes.NewReader(export.Bytes()))
+	require.ErrorIs(t, err, ErrStateNotEmpty)
+	require.False(t, fileExists(other.lsatTokenPath()))
+
+	orders, err := other.GetOrders()
+	require.NoError(t, err)
+	require.Empty(t, orders)
+
+	// Finally, a corrupted export must be rejected before anything is
+	// written.
+	corrupted := export.Bytes()[:export.Len()-10]
+	fresh, cleanupFresh := newTestDB(t)
+	defer cleanupFresh()
+
+	err = fresh.ImportState(bytes.NewReader(corrupted))
+	require.Error(t, err)
+	require.False(t, fileExists(fresh.lsatTokenPath()))
+
+	accounts, err := fresh.Accounts()
+	require.NoError(t, err)
+	require.Empty(t, accounts)
+}
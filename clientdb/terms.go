@@ -0,0 +1,200 @@
+package clientdb
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// termsBucketKey is the top level bucket where we store the last known
+	// auctioneer terms.
+	termsBucketKey = []byte("terms")
+
+	// cachedTermsKey is the key under which the last known auctioneer
+	// terms are stored, together with the time they were stored.
+	cachedTermsKey = []byte("cached-terms")
+
+	// ErrNoCachedTerms is the error returned if no auctioneer terms were
+	// cached yet.
+	ErrNoCachedTerms = errors.New("no cached auctioneer terms found")
+)
+
+// StoreTerms stores the given auctioneer terms as the last known terms,
+// replacing any previously cached ones. The current time is stored alongside
+// them so the age of the cached terms can be determined later.
+func (db *DB) StoreTerms(t *terms.AuctioneerTerms) error {
+	var w bytes.Buffer
+	if err := serializeTerms(&w, time.Now(), t); err != nil {
+		return err
+	}
+
+	return db.Update(func(tx kvdb.RwTx) error {
+		bucket, err := getBucket(tx, termsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(cachedTermsKey, w.Bytes())
+	})
+}
+
+// CachedTerms returns the last known auctioneer terms and the time they were
+// stored. If no terms were stored yet, ErrNoCachedTerms is returned.
+func (db *DB) CachedTerms() (*terms.AuctioneerTerms, time.Time, error) {
+	var (
+		cachedTerms *terms.AuctioneerTerms
+		storedAt    time.Time
+	)
+	err := db.View(func(tx kvdb.RTx) error {
+		bucket, err := getReadBucket(tx, termsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		termsBytes := bucket.Get(cachedTermsKey)
+		if termsBytes == nil {
+			return ErrNoCachedTerms
+		}
+
+		cachedTerms, storedAt, err = deserializeTerms(
+			bytes.NewReader(termsBytes),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return cachedTerms, storedAt, nil
+}
+
+func serializeTerms(w *bytes.Buffer, storedAt time.Time,
+	t *terms.AuctioneerTerms) error {
+
+	err := WriteElements(
+		w, storedAt, t.MaxAccountValue, t.OrderExecBaseFee,
+		t.OrderExecFeeRate, t.NextBatchConfTarget, t.NextBatchFeeRate,
+		t.NextBatchClear, t.AutoRenewExtensionBlocks,
+	)
+	if err != nil {
+		return err
+	}
+
+	numBuckets := uint32(len(t.LeaseDurationBuckets))
+	if err := WriteElements(w, numBuckets); err != nil {
+		return err
+	}
+	for duration, state := range t.LeaseDurationBuckets {
+		if err := WriteElements(w, duration, uint32(state)); err != nil {
+			return err
+		}
+	}
+
+	err = WriteElements(w, t.MaxSelfChanBalanceRatio)
+	if err != nil {
+		return err
+	}
+
+	numChanTypes := uint32(len(t.ChannelTypes))
+	if err := WriteElements(w, numChanTypes); err != nil {
+		return err
+	}
+	for _, chanType := range t.ChannelTypes {
+		if err := WriteElements(w, uint32(chanType)); err != nil {
+			return err
+		}
+	}
+
+	return WriteElements(w, uint64(t.Capabilities))
+}
+
+func deserializeTerms(r io.Reader) (*terms.AuctioneerTerms, time.Time,
+	error) {
+
+	var (
+		storedAt time.Time
+		t        = &terms.AuctioneerTerms{
+			LeaseDurationBuckets: make(
+				map[uint32]auctioneerrpc.DurationBucketState,
+			),
+		}
+	)
+	err := ReadElements(
+		r, &storedAt, &t.MaxAccountValue, &t.OrderExecBaseFee,
+		&t.OrderExecFeeRate, &t.NextBatchConfTarget,
+		&t.NextBatchFeeRate, &t.NextBatchClear,
+		&t.AutoRenewExtensionBlocks,
+	)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var numBuckets uint32
+	if err := ReadElements(r, &numBuckets); err != nil {
+		return nil, time.Time{}, err
+	}
+	for i := uint32(0); i < numBuckets; i++ {
+		var duration, state uint32
+		if err := ReadElements(r, &duration, &state); err != nil {
+			return nil, time.Time{}, err
+		}
+
+		t.LeaseDurationBuckets[duration] =
+			auctioneerrpc.DurationBucketState(state)
+	}
+
+	// Terms that were cached before the self channel balance ratio was
+	// added end here, the default ratio applies to those.
+	err = ReadElements(r, &t.MaxSelfChanBalanceRatio)
+	switch {
+	case err == io.EOF:
+		return t, storedAt, nil
+
+	case err != nil:
+		return nil, time.Time{}, err
+	}
+
+	// The same applies to terms that were cached before the channel types
+	// were added, the default channel types apply to those.
+	var numChanTypes uint32
+	err = ReadElements(r, &numChanTypes)
+	switch {
+	case err == io.EOF:
+		return t, storedAt, nil
+
+	case err != nil:
+		return nil, time.Time{}, err
+	}
+	for i := uint32(0); i < numChanTypes; i++ {
+		var chanType uint32
+		if err := ReadElements(r, &chanType); err != nil {
+			return nil, time.Time{}, err
+		}
+
+		t.ChannelTypes = append(
+			t.ChannelTypes,
+			auctioneerrpc.OrderChannelType(chanType),
+		)
+	}
+
+	// Terms that were cached before the capabilities were added don't
+	// signal any optional features.
+	var capabilities uint64
+	err = ReadElements(r, &capabilities)
+	switch {
+	case err == io.EOF:
+		return t, storedAt, nil
+
+	case err != nil:
+		return nil, time.Time{}, err
+	}
+	t.Capabilities = terms.Capability(capabilities)
+
+	return t, storedAt, nil
+}
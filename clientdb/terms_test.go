@@ -0,0 +1,59 @@
+package clientdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCachedTerms makes sure the auctioneer terms can be cached and read back
+// together with the time they were stored.
+func TestCachedTerms(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	_, _, err := db.CachedTerms()
+	require.ErrorIs(t, err, ErrNoCachedTerms)
+
+	auctionTerms := &terms.AuctioneerTerms{
+		MaxAccountValue:  btcutil.SatoshiPerBitcoin,
+		OrderExecBaseFee: 1,
+		OrderExecFeeRate: 100,
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+			4032: auctioneerrpc.DurationBucketState_ACCEPTING_ORDERS,
+		},
+		NextBatchConfTarget:      6,
+		NextBatchFeeRate:         chainfee.FeePerKwFloor,
+		NextBatchClear:           time.Unix(1234567, 0),
+		AutoRenewExtensionBlocks: 144,
+		MaxSelfChanBalanceRatio:  2_000_000,
+		ChannelTypes: []auctioneerrpc.OrderChannelType{
+			auctioneerrpc.OrderChannelType_ORDER_CHANNEL_TYPE_SCRIPT_ENFORCED,
+		},
+		Capabilities: terms.CapabilityZeroConfChannels,
+	}
+
+	before := time.Now()
+	require.NoError(t, db.StoreTerms(auctionTerms))
+
+	cachedTerms, storedAt, err := db.CachedTerms()
+	require.NoError(t, err)
+	require.False(t, storedAt.Before(before))
+	require.False(t, cachedTerms.IsCached())
+
+	// The time is deserialized in the local time zone, so we only compare
+	// the instants.
+	require.True(t, auctionTerms.NextBatchClear.Equal(
+		cachedTerms.NextBatchClear,
+	))
+	cachedTerms.NextBatchClear = auctionTerms.NextBatchClear
+	require.Equal(t, auctionTerms, cachedTerms)
+}
@@ -0,0 +1,206 @@
+package clientdb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"unicode"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// VerifyRecords scans the whole database and returns all corrupted records. A
+// record is considered corrupted if it doesn't match the checksum that was
+// stored alongside it or, in case of accounts, orders and sidecar tickets, if
+// it cannot be decoded. The latter also catches corruption of records that
+// were written before checksums were introduced. Nothing in the database is
+// modified.
+func (db *DB) VerifyRecords() ([]*ErrCorruptedRecord, error) {
+	var corrupted []*ErrCorruptedRecord
+	err := db.View(func(tx kvdb.RTx) error {
+		// Reset the result in case the transaction is retried.
+		corrupted = nil
+
+		err := tx.ForEachBucket(func(name []byte) error {
+			return verifyChecksums(
+				tx.ReadBucket(name), bucketName(name),
+				&corrupted,
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		return verifyDecoding(tx, &corrupted)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return corrupted, nil
+}
+
+// verifyChecksums recursively checks all records of the given bucket and its
+// nested buckets against their stored checksums.
+func verifyChecksums(bucket kvdb.RBucket, path string,
+	corrupted *[]*ErrCorruptedRecord) error {
+
+	if bucket == nil {
+		return nil
+	}
+
+	checksums := bucket.NestedReadBucket(checksumBucketKey)
+	if checksums != nil {
+		err := checksums.ForEach(func(k, _ []byte) error {
+			value := bucket.Get(k)
+			if value != nil && recordValid(bucket, k, value) {
+				return nil
+			}
+
+			*corrupted = append(*corrupted, &ErrCorruptedRecord{
+				Bucket: path,
+				Key:    copyBytes(k),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return bucket.ForEach(func(k, v []byte) error {
+		if v != nil || bytes.Equal(k, checksumBucketKey) {
+			return nil
+		}
+
+		return verifyChecksums(
+			bucket.NestedReadBucket(k), path+"/"+bucketName(k),
+			corrupted,
+		)
+	})
+}
+
+// verifyDecoding attempts to decode all accounts, orders and sidecar tickets
+// and adds all records that fail to decode to the list of corrupted records.
+// Records with a checksum mismatch are skipped as they were already reported
+// by verifyChecksums.
+func verifyDecoding(tx kvdb.RTx, corrupted *[]*ErrCorruptedRecord) error {
+	report := func(bucketKey, key []byte, decode func() error) {
+		err := safeDecode(decode)
+
+		var corruptedErr *ErrCorruptedRecord
+		if err == nil || errors.As(err, &corruptedErr) {
+			return
+		}
+
+		*corrupted = append(*corrupted, &ErrCorruptedRecord{
+			Bucket: bucketName(bucketKey),
+			Key:    copyBytes(key),
+		})
+	}
+
+	accounts, err := getReadBucket(tx, accountBucketKey)
+	if err != nil {
+		return err
+	}
+	err = accounts.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return nil
+		}
+
+		report(accountBucketKey, k, func() error {
+			_, err := readAccount(accounts, k)
+			return err
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	orders, err := getReadBucket(tx, ordersBucketKey)
+	if err != nil {
+		return err
+	}
+	err = orders.ForEach(func(k, v []byte) error {
+		var nonce order.Nonce
+		if v != nil || len(k) != len(nonce) {
+			return nil
+		}
+		copy(nonce[:], k)
+
+		report(ordersBucketKey, k, func() error {
+			_, _, err := modifyOrder(orders, nonce, nil)
+			return err
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sidecars, err := getReadBucket(tx, sidecarsBucketKey)
+	if err != nil {
+		return err
+	}
+	for _, bucket := range []kvdb.RBucket{
+		sidecars, sidecars.NestedReadBucket(archivedSidecarsBucketKey),
+	} {
+		if bucket == nil {
+			continue
+		}
+
+		err := bucket.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+
+			report(sidecarsBucketKey, k, func() error {
+				_, err := readSidecar(bucket, k)
+				return err
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// safeDecode calls the given decode function and turns a panic caused by
+// decoding garbage data into an error.
+func safeDecode(decode func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while decoding record: %v", r)
+		}
+	}()
+
+	return decode()
+}
+
+// bucketName returns a printable name of a bucket key. Keys that are not
+// printable, for example order nonces, are hex encoded.
+func bucketName(key []byte) string {
+	for _, r := range string(key) {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return hex.EncodeToString(key)
+		}
+	}
+
+	return string(key)
+}
+
+// copyBytes returns a copy of the given byte slice. Keys returned by the
+// database are only valid during the transaction, so they need to be copied
+// before they are returned.
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+
+	return c
+}
@@ -0,0 +1,108 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCorruptedRecords makes sure that corrupted records are detected when
+// reading them and when verifying the whole database.
+func TestCorruptedRecords(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	acct := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(acct))
+
+	ask := &order.Ask{Kit: *dummyOrder(500000, 1337)}
+	require.NoError(t, db.SubmitOrder(ask))
+
+	ticket := &sidecar.Ticket{
+		ID:    [8]byte{1, 2, 3},
+		State: sidecar.StateOffered,
+		Offer: sidecar.Offer{
+			Capacity:            1000000,
+			SignPubKey:          testTraderKey,
+			LeaseDurationBlocks: 2016,
+		},
+	}
+	require.NoError(t, db.AddSidecar(ticket))
+
+	// A healthy database doesn't have any corrupted records.
+	corrupted, err := db.VerifyRecords()
+	require.NoError(t, err)
+	require.Empty(t, corrupted)
+
+	// Now we truncate the account and order records behind the back of
+	// the store, without updating their checksums. We also add a ticket
+	// that has no checksum, like one written by an older version, that
+	// can't be decoded.
+	acctKey := getAccountKey(acct)
+	nonce := ask.Nonce()
+	garbageKey := []byte("garbage")
+	err = db.Update(func(tx kvdb.RwTx) error {
+		accounts := tx.ReadWriteBucket(accountBucketKey)
+		acctBytes := accounts.Get(acctKey)
+		err := accounts.Put(acctKey, acctBytes[:len(acctBytes)/2])
+		if err != nil {
+			return err
+		}
+
+		orderBucket := tx.ReadWriteBucket(ordersBucketKey).
+			NestedReadWriteBucket(nonce[:])
+		orderBytes := orderBucket.Get(orderKey)
+		err = orderBucket.Put(orderKey, orderBytes[:len(orderBytes)-1])
+		if err != nil {
+			return err
+		}
+
+		sidecars := tx.ReadWriteBucket(sidecarsBucketKey)
+		return sidecars.Put(garbageKey, []byte{0xff})
+	})
+	require.NoError(t, err)
+
+	_, err = db.Account(acct.TraderKey.PubKey)
+	var corruptedErr *ErrCorruptedRecord
+	require.ErrorAs(t, err, &corruptedErr)
+	require.Equal(t, string(accountBucketKey), corruptedErr.Bucket)
+	require.Equal(t, acctKey, corruptedErr.Key)
+
+	_, err = db.GetOrder(nonce)
+	require.ErrorAs(t, err, &corruptedErr)
+	require.Equal(t, string(ordersBucketKey), corruptedErr.Bucket)
+	require.Equal(t, nonce[:], corruptedErr.Key)
+
+	// The ticket that was stored properly can still be read.
+	_, err = db.Sidecar(ticket.ID, ticket.Offer.SignPubKey)
+	require.NoError(t, err)
+
+	corrupted, err = db.VerifyRecords()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*ErrCorruptedRecord{{
+		Bucket: string(accountBucketKey),
+		Key:    acctKey,
+	}, {
+		Bucket: string(ordersBucketKey) + "/" + bucketName(nonce[:]),
+		Key:    orderKey,
+	}, {
+		Bucket: string(sidecarsBucketKey),
+		Key:    garbageKey,
+	}}, corrupted)
+}
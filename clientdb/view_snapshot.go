@@ -0,0 +1,89 @@
+package clientdb
+
+import (
+	"time"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// Snapshot is a read-only view of the database. All of its accessors read
+// from the same database transaction, so the data they return is consistent
+// across buckets, even if a batch is completed concurrently.
+//
+// NOTE: A Snapshot is only valid within the function passed to ViewSnapshot
+// and must not be used after it returned.
+type Snapshot struct {
+	tx kvdb.RTx
+}
+
+// ViewSnapshot executes the given function with a read-only snapshot of the
+// database that is backed by a single database transaction.
+func (db *DB) ViewSnapshot(f func(s *Snapshot) error) error {
+	return db.View(func(tx kvdb.RTx) error {
+		return f(&Snapshot{tx: tx})
+	})
+}
+
+// Accounts returns all known accounts.
+func (s *Snapshot) Accounts() ([]*account.Account, error) {
+	return readAllAccountsTX(s.tx)
+}
+
+// Orders returns all known orders.
+func (s *Snapshot) Orders() ([]order.Order, error) {
+	return fetchOrdersTX(s.tx)
+}
+
+// OrdersPaginated returns at most limit orders that match the given filter,
+// skipping the first offset matching orders. The orders are returned in the
+// order they were created in, oldest first. A limit of zero means no limit is
+// applied.
+func (s *Snapshot) OrdersPaginated(offset, limit uint32,
+	filter OrderFilter) ([]*CreatedOrder, error) {
+
+	return fetchOrdersPaginatedTX(s.tx, offset, limit, filter)
+}
+
+// OrderEvents returns all events of the order with the given nonce.
+func (s *Snapshot) OrderEvents(nonce order.Nonce) ([]event.Event, error) {
+	return getOrderEventsTX(s.tx, nonce)
+}
+
+// AllEvents returns all events that are of the given type. Use event.TypeAny
+// to not filter by type.
+func (s *Snapshot) AllEvents(evtType event.Type) ([]event.Event, error) {
+	return getEventsTX(s.tx, func(_ time.Time, t event.Type) bool {
+		return evtType == event.TypeAny || evtType == t
+	})
+}
+
+// PendingBatchID returns the ID of the most recent pending batch. If there
+// isn't one, account.ErrNoPendingBatch is returned.
+func (s *Snapshot) PendingBatchID() (order.BatchID, error) {
+	return pendingBatchID(s.tx)
+}
+
+// LocalBatchSnapshots returns snapshots for all batches the trader has
+// participated in.
+func (s *Snapshot) LocalBatchSnapshots() ([]*LocalBatchSnapshot, error) {
+	return fetchLocalBatchSnapshots(s.tx)
+}
+
+// FilteredLocalBatchSnapshots returns the snapshots of all batches the trader
+// has participated in that match the given filter, in the order the batches
+// were finalized, oldest first.
+func (s *Snapshot) FilteredLocalBatchSnapshots(
+	filter BatchSnapshotFilter) ([]*TimedBatchSnapshot, error) {
+
+	return fetchFilteredBatchSnapshots(s.tx, filter)
+}
+
+// LocalBatchSnapshot returns the batch snapshot for the given batch ID.
+func (s *Snapshot) LocalBatchSnapshot(
+	id order.BatchID) (*LocalBatchSnapshot, error) {
+
+	return fetchLocalBatchSnapshotByID(s.tx, id)
+}
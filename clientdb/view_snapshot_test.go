@@ -0,0 +1,78 @@
+package clientdb
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/event"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// TestViewSnapshot makes sure all accessors of a snapshot return the same data
+// as their individual counterparts on the database.
+func TestViewSnapshot(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup := newTestDB(t)
+	defer cleanup()
+
+	acct := &account.Account{
+		Value:         btcutil.SatoshiPerBitcoin,
+		Expiry:        1337,
+		TraderKey:     testTraderKeyDesc,
+		AuctioneerKey: testAuctioneerKey,
+		BatchKey:      testBatchKey,
+		Secret:        sharedSecret,
+		State:         account.StateInitiated,
+		HeightHint:    1,
+	}
+	require.NoError(t, db.AddAccount(acct))
+
+	ask := &order.Ask{Kit: *dummyOrder(500000, 1337)}
+	ask.State = order.StateSubmitted
+	require.NoError(t, db.SubmitOrder(ask))
+
+	dbOrders, err := db.GetOrders()
+	require.NoError(t, err)
+	dbEvents, err := db.GetOrderEvents(ask.Nonce())
+	require.NoError(t, err)
+
+	err = db.ViewSnapshot(func(snapshot *Snapshot) error {
+		accounts, err := snapshot.Accounts()
+		require.NoError(t, err)
+		require.Equal(t, []*account.Account{acct}, accounts)
+
+		orders, err := snapshot.Orders()
+		require.NoError(t, err)
+		require.Equal(t, dbOrders, orders)
+
+		page, err := snapshot.OrdersPaginated(
+			0, 1, OrderFilter{ArchivedOnly: true},
+		)
+		require.NoError(t, err)
+		require.Empty(t, page)
+
+		events, err := snapshot.OrderEvents(ask.Nonce())
+		require.NoError(t, err)
+		require.Equal(t, dbEvents, events)
+
+		events, err = snapshot.AllEvents(event.TypeOrderCreated)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		_, err = snapshot.PendingBatchID()
+		require.ErrorIs(t, err, account.ErrNoPendingBatch)
+
+		batches, err := snapshot.LocalBatchSnapshots()
+		require.NoError(t, err)
+		require.Empty(t, batches)
+
+		_, err = snapshot.LocalBatchSnapshot(order.BatchID{})
+		require.Error(t, err)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/urfave/cli"
+)
+
+func main() {
+	app := cli.NewApp()
+
+	app.Version = pool.Version()
+	app.Name = "pool-sign"
+	app.Usage = "sign pool orders with a trader key offline"
+	app.Description = `
+	Sign an order that was prepared with 'pool orders submit --offline'
+	with the trader key of its account. The digest of the order is
+	recomputed from the serialized order and all order details are shown
+	before anything is signed. This tool never connects to the network, so
+	it can be run on a machine that is kept offline.`
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name: "order",
+			Usage: "the hex encoded serialized order as returned " +
+				"when preparing it",
+		},
+		cli.StringFlag{
+			Name: "keyfile",
+			Usage: "path to a file that contains the private " +
+				"trader key, either hex encoded or in WIF " +
+				"format",
+		},
+		cli.BoolFlag{
+			Name:  "force",
+			Usage: "skip the confirmation of the order details",
+		},
+	}
+	app.Action = signOrder
+
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "[pool-sign] %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func signOrder(ctx *cli.Context) error {
+	if !ctx.IsSet("order") || !ctx.IsSet("keyfile") {
+		return cli.ShowAppHelp(ctx)
+	}
+
+	serializedOrder, err := hex.DecodeString(ctx.String("order"))
+	if err != nil {
+		return fmt.Errorf("unable to decode order: %v", err)
+	}
+	o, err := clientdb.DeserializeUnsignedOrder(
+		bytes.NewReader(serializedOrder),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid serialized order: %v", err)
+	}
+
+	// We never trust a digest we're given but always compute it from the
+	// order details we show.
+	digest, err := o.Digest()
+	if err != nil {
+		return fmt.Errorf("unable to digest order: %v", err)
+	}
+
+	privKey, err := readPrivKey(ctx.String("keyfile"))
+	if err != nil {
+		return err
+	}
+	pubKey := privKey.PubKey().SerializeCompressed()
+	if !bytes.Equal(pubKey, o.Details().AcctKey[:]) {
+		return fmt.Errorf("key %x is not the trader key %x of the "+
+			"order's account", pubKey, o.Details().AcctKey[:])
+	}
+
+	printOrder(o, digest)
+
+	if !ctx.Bool("force") && !promptForConfirmation("Sign order "+
+		"(yes/no): ") {
+
+		fmt.Println("Not signing order")
+		return nil
+	}
+
+	sig, err := order.SignDigest(privKey, digest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Signature: %x\n", sig)
+
+	return nil
+}
+
+// readPrivKey reads a hex or WIF encoded private key from the given file.
+func readPrivKey(fileName string) (*btcec.PrivateKey, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %v", err)
+	}
+	encoded := strings.TrimSpace(string(content))
+
+	if keyBytes, err := hex.DecodeString(encoded); err == nil {
+		if len(keyBytes) != btcec.PrivKeyBytesLen {
+			return nil, fmt.Errorf("private key must be %d bytes",
+				btcec.PrivKeyBytesLen)
+		}
+
+		privKey, _ := btcec.PrivKeyFromBytes(keyBytes)
+		return privKey, nil
+	}
+
+	wif, err := btcutil.DecodeWIF(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("key is neither hex nor WIF encoded: %v",
+			err)
+	}
+
+	return wif.PrivKey, nil
+}
+
+// printOrder shows the details of an order before it is signed.
+func printOrder(o order.Order, digest [32]byte) {
+	details := o.Details()
+	nonce := o.Nonce()
+
+	fmt.Println("-- Order Details --")
+	fmt.Printf("Type: %v\n", o.Type())
+	fmt.Printf("Nonce: %x\n", nonce[:])
+	fmt.Printf("Version: %d\n", details.Version)
+	fmt.Printf("Account: %x\n", details.AcctKey[:])
+	fmt.Printf("Amount: %v\n", details.Amt)
+	fmt.Printf("Min Chan Amount: %v\n", details.MinUnitsMatch.ToSatoshis())
+	fmt.Printf("Fixed Rate: %d (parts per billion per block)\n",
+		details.FixedRate)
+	fmt.Printf("Lease Duration: %d blocks\n", details.LeaseDuration)
+	fmt.Printf("Max Batch Fee Rate: %v\n", details.MaxBatchFeeRate)
+
+	switch castOrder := o.(type) {
+	case *order.Ask:
+		fmt.Printf("Min Distinct Peers: %d\n",
+			castOrder.MinDistinctPeers)
+
+	case *order.Bid:
+		fmt.Printf("Min Node Tier: %v\n", castOrder.MinNodeTier)
+		fmt.Printf("Self Chan Balance: %v\n", castOrder.SelfChanBalance)
+		fmt.Printf("New Nodes Only: %v\n", castOrder.NewNodesOnly)
+	}
+
+	fmt.Printf("Channel Type: %v\n", details.ChannelType)
+	fmt.Printf("Unannounced Channel: %v\n", details.UnannouncedChannel)
+	fmt.Printf("Zero Conf Channel: %v\n", details.ZeroConfChannel)
+	if len(details.AllowedNodeIDs) > 0 {
+		fmt.Printf("Allowed Node IDs: %x\n", details.AllowedNodeIDs)
+	}
+	if len(details.NotAllowedNodeIDs) > 0 {
+		fmt.Printf("Not Allowed Node IDs: %x\n",
+			details.NotAllowedNodeIDs)
+	}
+	fmt.Printf("Digest: %x\n", digest[:])
+}
+
+// promptForConfirmation continuously prompts the user for the message until
+// receiving a response of "yes" or "no" and returns their answer as a bool.
+func promptForConfirmation(msg string) bool {
+	reader := bufio.NewReader(os.Stdin)
+
+	for {
+		fmt.Print(msg)
+
+		answer, err := reader.ReadString('\n')
+		if err != nil {
+			return false
+		}
+
+		answer = strings.ToLower(strings.TrimSpace(answer))
+
+		switch {
+		case answer == "yes":
+			return true
+		case answer == "no":
+			return false
+		default:
+			continue
+		}
+	}
+}
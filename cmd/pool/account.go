@@ -2,13 +2,25 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/protobuf-hex-display/proto"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/urfave/cli"
 )
@@ -23,11 +35,23 @@ var accountsCommands = []cli.Command{
 			newAccountCommand,
 			listAccountsCommand,
 			depositAccountCommand,
+			depositAccountPsbtCommand,
+			finalizeDepositCommand,
 			withdrawAccountCommand,
+			scheduleWithdrawCommand,
+			listScheduledWithdrawalsCommand,
+			cancelScheduledWithdrawCommand,
 			renewAccountCommand,
+			autoRenewAccountCommand,
+			reserveAccountCommand,
 			closeAccountCommand,
+			withdrawAndCloseAccountCommand,
+			sweepAccountCommand,
 			bumpAccountFeeCommand,
 			recoverAccountsCommand,
+			accountEventsCommand,
+			subscribeAccountsCommand,
+			exportWatchOnlyCommand,
 		},
 	},
 }
@@ -40,6 +64,10 @@ type Account struct {
 	ExpirationHeight uint32 `json:"expiration_height"`
 	State            string `json:"state"`
 	LatestTxid       string `json:"latest_txid"`
+	AutoRenew        bool   `json:"auto_renew"`
+	RenewalWindow    uint32 `json:"renewal_window"`
+	ReserveSat       uint64 `json:"reserve_sat"`
+	Version          string `json:"version"`
 }
 
 // NewAccountFromProto creates a display Account from its proto.
@@ -56,9 +84,123 @@ func NewAccountFromProto(a *poolrpc.Account) *Account {
 		ExpirationHeight: a.ExpirationHeight,
 		State:            a.State.String(),
 		LatestTxid:       latestTxHash.String(),
+		AutoRenew:        a.AutoRenew,
+		RenewalWindow:    a.RenewalWindow,
+		ReserveSat:       a.ReserveSat,
+		Version:          a.Version.String(),
 	}
 }
 
+// AccountDerived holds the fields of a display Account that can't be read from
+// the raw proto account directly.
+type AccountDerived struct {
+	OutPoint   string `json:"outpoint"`
+	LatestTxid string `json:"latest_txid"`
+}
+
+// accountRespDerived holds the derived fields of a response that only
+// contains an account.
+type accountRespDerived struct {
+	Account *AccountDerived `json:"account"`
+}
+
+// newAccountRespDerived creates the derived fields of a response that only
+// contains the given account.
+func newAccountRespDerived(a *poolrpc.Account) *accountRespDerived {
+	return &accountRespDerived{
+		Account: NewAccountDerivedFromProto(a),
+	}
+}
+
+// NewAccountDerivedFromProto creates the derived fields of a display Account
+// from its proto.
+func NewAccountDerivedFromProto(a *poolrpc.Account) *AccountDerived {
+	display := NewAccountFromProto(a)
+	return &AccountDerived{
+		OutPoint:   display.OutPoint,
+		LatestTxid: display.LatestTxid,
+	}
+}
+
+// AccountTxDryRun is the display version of the unsigned transaction an
+// account modification would publish.
+type AccountTxDryRun struct {
+	RawTx           string   `json:"raw_tx"`
+	InputTotalSat   uint64   `json:"input_total_sat"`
+	OutputTotalSat  uint64   `json:"output_total_sat"`
+	FeeSat          uint64   `json:"fee_sat"`
+	VSize           uint64   `json:"vsize"`
+	FeeRateSatPerKw uint64   `json:"fee_rate_sat_per_kw"`
+	WalletInputs    []string `json:"wallet_inputs"`
+	LeaseExpiry     string   `json:"lease_expiry,omitempty"`
+}
+
+// NewAccountTxDryRunFromProto creates a display AccountTxDryRun from its
+// proto.
+func NewAccountTxDryRunFromProto(d *poolrpc.AccountTxDryRun) *AccountTxDryRun {
+	dryRun := &AccountTxDryRun{
+		RawTx:           hex.EncodeToString(d.RawTx),
+		InputTotalSat:   d.InputTotalSat,
+		OutputTotalSat:  d.OutputTotalSat,
+		FeeSat:          d.FeeSat,
+		VSize:           d.Vsize,
+		FeeRateSatPerKw: d.FeeRateSatPerKw,
+		WalletInputs:    make([]string, 0, len(d.WalletInputs)),
+	}
+	for _, op := range d.WalletInputs {
+		var hash chainhash.Hash
+		copy(hash[:], op.Txid)
+		dryRun.WalletInputs = append(
+			dryRun.WalletInputs,
+			fmt.Sprintf("%v:%d", hash, op.OutputIndex),
+		)
+	}
+	if d.LeaseExpiry != 0 {
+		dryRun.LeaseExpiry = time.Unix(d.LeaseExpiry, 0).Format(
+			time.RFC3339,
+		)
+	}
+
+	return dryRun
+}
+
+// AccountTxDryRunDerived holds the fields of a display AccountTxDryRun that
+// can't be read from the raw proto directly.
+type AccountTxDryRunDerived struct {
+	WalletInputs []string `json:"wallet_inputs"`
+	LeaseExpiry  string   `json:"lease_expiry,omitempty"`
+}
+
+// printDryRun prints the response of an account modification that was only
+// simulated. The account is the one the modification would result in and may
+// be nil if the account would be closed.
+func printDryRun(resp proto.Message, a *poolrpc.Account,
+	d *poolrpc.AccountTxDryRun) {
+
+	dryRun := NewAccountTxDryRunFromProto(d)
+	display := struct {
+		Account *Account         `json:"account,omitempty"`
+		DryRun  *AccountTxDryRun `json:"dry_run"`
+	}{
+		DryRun: dryRun,
+	}
+	derived := struct {
+		Account *AccountDerived         `json:"account,omitempty"`
+		DryRun  *AccountTxDryRunDerived `json:"dry_run"`
+	}{
+		DryRun: &AccountTxDryRunDerived{
+			WalletInputs: dryRun.WalletInputs,
+			LeaseExpiry:  dryRun.LeaseExpiry,
+		},
+	}
+	if a != nil {
+		display.Account = NewAccountFromProto(a)
+		derived.Account = NewAccountDerivedFromProto(a)
+	}
+
+	printResponse(resp, display, derived)
+}
+
 const (
 	accountExpiryAbsolute = "expiry_height"
 
@@ -67,6 +209,11 @@ const (
 	// Equivalent to approx. 30d in blocks.
 	defaultExpiryRelative = 30 * 144
 
+	// Equivalent to approx. 3d in blocks.
+	defaultRenewalWindow = 3 * 144
+
+	defaultScheduledWithdrawalExpiry = 7 * 24 * time.Hour
+
 	defaultFundingConfTarget = 6
 
 	// defaultAccountTarget is the default number of accounts that will try
@@ -80,8 +227,107 @@ var (
 		Usage: "the new block height which this account " +
 			"should expire at",
 	}
+
+	inputsFlag = cli.StringSliceFlag{
+		Name: "inputs",
+		Usage: "a UTXO of the lnd wallet in the format txid:index " +
+			"to fund the transaction with, can be specified " +
+			"multiple times; if set, no other inputs are used",
+	}
+
+	changeTypeFlag = cli.StringFlag{
+		Name: "change_type",
+		Usage: "the address type of the change output if one is " +
+			"needed, either p2wkh or p2tr; lnd decides if not set",
+	}
+
+	maxFeeFlag = cli.Uint64Flag{
+		Name: "max_fee",
+		Usage: "the maximum total fee in satoshis the transaction " +
+			"may pay; it is aborted before signing otherwise",
+	}
+
+	maxFeeRateFlag = cli.Uint64Flag{
+		Name: "max_sat_per_vbyte",
+		Usage: "the maximum fee rate expressed in sat/vbyte the " +
+			"transaction may pay; it is aborted before signing " +
+			"otherwise",
+	}
+
+	dryRunFlag = cli.BoolFlag{
+		Name: "dry_run",
+		Usage: "only create the transaction and show it along with " +
+			"its fee, without signing or publishing it; the " +
+			"selected wallet inputs are held for a short time " +
+			"to be reused by the same request without this flag",
+	}
 )
 
+// parseFeeLimit parses the fee ceiling set with the --max_fee and
+// --max_sat_per_vbyte flags. If neither is set, nil is returned.
+func parseFeeLimit(ctx *cli.Context) *poolrpc.FeeLimit {
+	maxFee := ctx.Uint64(maxFeeFlag.Name)
+	maxSatPerVByte := ctx.Uint64(maxFeeRateFlag.Name)
+	if maxFee == 0 && maxSatPerVByte == 0 {
+		return nil
+	}
+
+	maxFeeRate := chainfee.SatPerKVByte(
+		maxSatPerVByte * 1000,
+	).FeePerKWeight()
+	return &poolrpc.FeeLimit{
+		MaxFeeSat:          maxFee,
+		MaxFeeRateSatPerKw: uint64(maxFeeRate),
+	}
+}
+
+// parseCoinSelection parses the inputs and change address type selected with
+// the --inputs and --change_type flags.
+func parseCoinSelection(ctx *cli.Context) ([]*auctioneerrpc.OutPoint,
+	poolrpc.ChangeAddressType, error) {
+
+	var inputs []*auctioneerrpc.OutPoint
+	for _, input := range ctx.StringSlice(inputsFlag.Name) {
+		parts := strings.Split(input, ":")
+		if len(parts) != 2 {
+			return nil, 0, fmt.Errorf("invalid input %s, must be "+
+				"in the format txid:index", input)
+		}
+		hash, err := chainhash.NewHashFromStr(parts[0])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid input txid %s: %v",
+				parts[0], err)
+		}
+		index, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid input index %s: %v",
+				parts[1], err)
+		}
+		inputs = append(inputs, &auctioneerrpc.OutPoint{
+			Txid:        hash[:],
+			OutputIndex: uint32(index),
+		})
+	}
+
+	var changeType poolrpc.ChangeAddressType
+	switch ctx.String(changeTypeFlag.Name) {
+	case "":
+		changeType = poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_DEFAULT
+
+	case "p2wkh":
+		changeType = poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2WKH
+
+	case "p2tr":
+		changeType = poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2TR
+
+	default:
+		return nil, 0, fmt.Errorf("unknown change type %s, must be "+
+			"p2wkh or p2tr", ctx.String(changeTypeFlag.Name))
+	}
+
+	return inputs, changeType, nil
+}
+
 var newAccountCommand = cli.Command{
 	Name:      "new",
 	ShortName: "n",
@@ -89,9 +335,10 @@ var newAccountCommand = cli.Command{
 	ArgsUsage: "amt [--expiry_height | --expiry_blocks]",
 	Description: `
 		Send the amount in satoshis specified by the amt argument to a
-		new account.`,
+		new account. The amount can also be given with a unit, for
+		example 0.1btc.`,
 	Flags: []cli.Flag{
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name:  "amt",
 			Usage: "the amount in satoshis to create account for",
 		},
@@ -119,6 +366,10 @@ var newAccountCommand = cli.Command{
 			Name:  "force",
 			Usage: "skip account fee confirmation",
 		},
+		inputsFlag,
+		changeTypeFlag,
+		maxFeeFlag,
+		maxFeeRateFlag,
 	},
 	Action: newAccount,
 }
@@ -126,7 +377,12 @@ var newAccountCommand = cli.Command{
 func newAccount(ctx *cli.Context) error {
 	cmd := "new"
 
-	amt, err := parseUint64(ctx, 0, "amt", cmd)
+	amt, err := parseAmtArg(ctx, 0, "amt", cmd)
+	if err != nil {
+		return err
+	}
+
+	inputs, changeType, err := parseCoinSelection(ctx)
 	if err != nil {
 		return err
 	}
@@ -134,6 +390,9 @@ func newAccount(ctx *cli.Context) error {
 	req := &poolrpc.InitAccountRequest{
 		AccountValue: amt,
 		Initiator:    defaultInitiator,
+		Inputs:       inputs,
+		ChangeType:   changeType,
+		FeeLimit:     parseFeeLimit(ctx),
 	}
 
 	satPerVByte := ctx.Uint64("sat_per_vbyte")
@@ -204,7 +463,7 @@ func newAccount(ctx *cli.Context) error {
 		}
 
 		if !promptForConfirmation("Confirm account (yes/no): ") {
-			fmt.Println("Cancelling account...")
+			fmt.Fprintln(humanOutput(), "Cancelling account...")
 			return nil
 		}
 	}
@@ -214,7 +473,10 @@ func newAccount(ctx *cli.Context) error {
 		return err
 	}
 
-	printJSON(NewAccountFromProto(resp))
+	printResponse(
+		resp, NewAccountFromProto(resp),
+		NewAccountDerivedFromProto(resp),
+	)
 
 	return nil
 }
@@ -222,10 +484,13 @@ func newAccount(ctx *cli.Context) error {
 func printAccountFees(client poolrpc.TraderClient, amt btcutil.Amount,
 	satPerVByte uint64, confTarget uint32) error {
 
+	w := humanOutput()
+
 	if confTarget == 0 {
-		fmt.Println("-- Account Funding Details --")
-		fmt.Printf("Amount: %v\n", amt)
-		fmt.Printf("Fee rate (estimated): %d sat/vByte\n", satPerVByte)
+		fmt.Fprintln(w, "-- Account Funding Details --")
+		fmt.Fprintf(w, "Amount: %v\n", amt)
+		fmt.Fprintf(w, "Fee rate (estimated): %d sat/vByte\n",
+			satPerVByte)
 
 		return nil
 	}
@@ -246,11 +511,11 @@ func printAccountFees(client poolrpc.TraderClient, amt btcutil.Amount,
 
 	feeRate := chainfee.SatPerKWeight(resp.MinerFeeRateSatPerKw)
 	feePerVByte := float64(feeRate.FeePerKVByte()) / 1000
-	fmt.Println("-- Account Funding Details --")
-	fmt.Printf("Amount: %v\n", amt)
-	fmt.Printf("Confirmation target: %v blocks\n", confTarget)
-	fmt.Printf("Fee rate (estimated): %.1f sat/vByte\n", feePerVByte)
-	fmt.Printf("Total miner fee (estimated): %v\n",
+	fmt.Fprintln(w, "-- Account Funding Details --")
+	fmt.Fprintf(w, "Amount: %v\n", amt)
+	fmt.Fprintf(w, "Confirmation target: %v blocks\n", confTarget)
+	fmt.Fprintf(w, "Fee rate (estimated): %.1f sat/vByte\n", feePerVByte)
+	fmt.Fprintf(w, "Total miner fee (estimated): %v\n",
 		btcutil.Amount(resp.MinerFeeTotal))
 
 	return nil
@@ -297,12 +562,21 @@ func listAccounts(ctx *cli.Context) error {
 	}{
 		Accounts: make([]*Account, 0, len(resp.Accounts)),
 	}
+	var derived = struct {
+		Accounts []*AccountDerived `json:"accounts"`
+	}{
+		Accounts: make([]*AccountDerived, 0, len(resp.Accounts)),
+	}
 	for _, protoAccount := range resp.Accounts {
 		a := NewAccountFromProto(protoAccount)
 		listAccountsResp.Accounts = append(listAccountsResp.Accounts, a)
+		derived.Accounts = append(
+			derived.Accounts,
+			NewAccountDerivedFromProto(protoAccount),
+		)
 	}
 
-	printJSON(listAccountsResp)
+	printResponse(resp, listAccountsResp, derived)
 
 	return nil
 }
@@ -335,6 +609,7 @@ var renewAccountCommand = cli.Command{
 				"at (default of 30 days equivalent in blocks)",
 			Value: defaultExpiryRelative,
 		},
+		dryRunFlag,
 	},
 	Action: renewAccount,
 }
@@ -363,6 +638,7 @@ func renewAccount(ctx *cli.Context) error {
 	req := &poolrpc.RenewAccountRequest{
 		AccountKey:      traderKey,
 		FeeRateSatPerKw: uint64(feeRate),
+		DryRun:          ctx.Bool(dryRunFlag.Name),
 	}
 	switch {
 	case ctx.Uint64(accountExpiryAbsolute) != 0:
@@ -387,6 +663,11 @@ func renewAccount(ctx *cli.Context) error {
 		return err
 	}
 
+	if resp.DryRun != nil {
+		printDryRun(resp, resp.Account, resp.DryRun)
+		return nil
+	}
+
 	var renewalTxid chainhash.Hash
 	copy(renewalTxid[:], resp.RenewalTxid)
 
@@ -398,7 +679,137 @@ func renewAccount(ctx *cli.Context) error {
 		RenewalTxid: renewalTxid.String(),
 	}
 
-	printJSON(renewResp)
+	printResponse(resp, renewResp, struct {
+		Account     *AccountDerived `json:"account"`
+		RenewalTxid string          `json:"renewal_txid"`
+	}{
+		Account:     NewAccountDerivedFromProto(resp.Account),
+		RenewalTxid: renewResp.RenewalTxid,
+	})
+
+	return nil
+}
+
+var autoRenewAccountCommand = cli.Command{
+	Name:      "autorenew",
+	ShortName: "ar",
+	Usage:     "enable or disable the automatic renewal of an account",
+	Description: `
+	Enables or disables the automatic renewal of an account. If enabled,
+	the account is renewed once the chain height comes within the renewal
+	window of its expiry. The fee rate of the renewal transaction is
+	estimated by lnd but capped by the --autorenewmaxfeerate option of the
+	daemon. The new expiry is determined by the daemon's
+	--autorenewexpiryblocks option. Renewals are deferred while a batch is
+	pending.`,
+	ArgsUsage: "trader_key [--disable] [--renewal_window]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"update",
+		},
+		cli.BoolFlag{
+			Name:  "disable",
+			Usage: "disable the automatic renewal of the account",
+		},
+		cli.Uint64Flag{
+			Name: "renewal_window",
+			Usage: "the number of blocks before the account's " +
+				"expiry at which it should be renewed",
+			Value: defaultRenewalWindow,
+		},
+	},
+	Action: autoRenewAccount,
+}
+
+func autoRenewAccount(ctx *cli.Context) error {
+	cmd := "autorenew"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.UpdateAccountAutoRenew(
+		context.Background(), &poolrpc.UpdateAccountAutoRenewRequest{
+			TraderKey:     traderKey,
+			AutoRenew:     !ctx.Bool("disable"),
+			RenewalWindow: uint32(ctx.Uint64("renewal_window")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printResponse(
+		resp, NewAccountFromProto(resp.Account),
+		newAccountRespDerived(resp.Account),
+	)
+
+	return nil
+}
+
+var reserveAccountCommand = cli.Command{
+	Name:  "reserve",
+	Usage: "update the reserve of an account",
+	Description: `
+	Updates the reserve of an account. Orders may not spend into the
+	reserve, which ensures the account always retains enough funds to be
+	swept through its expiry path. New accounts are created with a reserve
+	based on the fee estimate at the time of their creation.`,
+	ArgsUsage: "trader_key reserve_sat",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"update",
+		},
+		cli.Uint64Flag{
+			Name: "reserve_sat",
+			Usage: "the amount of satoshis in the account that " +
+				"orders may not spend",
+		},
+	},
+	Action: reserveAccount,
+}
+
+func reserveAccount(ctx *cli.Context) error {
+	cmd := "reserve"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	reserve, err := parseUint64(ctx, 1, "reserve_sat", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.UpdateAccountReserve(
+		context.Background(), &poolrpc.UpdateAccountReserveRequest{
+			TraderKey:  traderKey,
+			ReserveSat: reserve,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printResponse(
+		resp, NewAccountFromProto(resp.Account),
+		newAccountRespDerived(resp.Account),
+	)
 
 	return nil
 }
@@ -417,7 +828,7 @@ var depositAccountCommand = cli.Command{
 			Usage: "the hex-encoded trader key of the account to " +
 				"deposit funds into",
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name:  "amt",
 			Usage: "the amount to deposit into the account",
 		},
@@ -433,6 +844,11 @@ var depositAccountCommand = cli.Command{
 				"chain height) that the account should expire " +
 				"at",
 		},
+		inputsFlag,
+		changeTypeFlag,
+		maxFeeFlag,
+		maxFeeRateFlag,
+		dryRunFlag,
 	},
 	Action: depositAccount,
 }
@@ -443,7 +859,7 @@ func depositAccount(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	amt, err := parseUint64(ctx, 1, "amt", cmd)
+	amt, err := parseAmtArg(ctx, 1, "amt", cmd)
 	if err != nil {
 		return err
 	}
@@ -459,10 +875,19 @@ func depositAccount(ctx *cli.Context) error {
 		feeRate = chainfee.FeePerKwFloor
 	}
 
+	inputs, changeType, err := parseCoinSelection(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &poolrpc.DepositAccountRequest{
 		TraderKey:       traderKey,
 		AmountSat:       amt,
 		FeeRateSatPerKw: uint64(feeRate),
+		Inputs:          inputs,
+		ChangeType:      changeType,
+		FeeLimit:        parseFeeLimit(ctx),
+		DryRun:          ctx.Bool(dryRunFlag.Name),
 	}
 
 	absoluteExpiry := ctx.Uint64(accountExpiryAbsolute)
@@ -494,6 +919,11 @@ func depositAccount(ctx *cli.Context) error {
 		return err
 	}
 
+	if resp.DryRun != nil {
+		printDryRun(resp, resp.Account, resp.DryRun)
+		return nil
+	}
+
 	var depositTxid chainhash.Hash
 	copy(depositTxid[:], resp.DepositTxid)
 
@@ -505,38 +935,42 @@ func depositAccount(ctx *cli.Context) error {
 		DepositTxid: depositTxid.String(),
 	}
 
-	printJSON(depositAccountResp)
+	printResponse(resp, depositAccountResp, struct {
+		Account     *AccountDerived `json:"account"`
+		DepositTxid string          `json:"deposit_txid"`
+	}{
+		Account:     NewAccountDerivedFromProto(resp.Account),
+		DepositTxid: depositAccountResp.DepositTxid,
+	})
 
 	return nil
 }
 
-var withdrawAccountCommand = cli.Command{
-	Name:      "withdraw",
-	ShortName: "w",
-	Usage:     "withdraw funds from an existing account",
+var depositAccountPsbtCommand = cli.Command{
+	Name:      "depositpsbt",
+	ShortName: "dp",
+	Usage:     "initiate a deposit funded by an external wallet",
 	Description: `
-	Withdraw funds from an existing account to a supported address.
+	Initiate a deposit into an existing account that is funded by an
+	external wallet, for example a hardware wallet or a multisig setup.
+
+	The returned base64 encoded PSBT spends the account into the new
+	account output. The external wallet needs to add its inputs and any
+	change outputs, sign its inputs and pass the PSBT to the
+	finalizedeposit command. The funded transaction must keep version 2
+	and a lock time of 0, use a sequence of 0 for all inputs and be sorted
+	according to BIP-69.
 	`,
-	ArgsUsage: "trader_key amt addr sat_per_vbyte",
+	ArgsUsage: "trader_key amt",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "trader_key",
 			Usage: "the hex-encoded trader key of the account to " +
-				"withdraw funds from",
+				"deposit funds into",
 		},
 		cli.StringFlag{
-			Name:  "addr",
-			Usage: "the address the withdrawn funds should go to",
-		},
-		cli.Uint64Flag{
-			Name: "amt",
-			Usage: "the amount that will be sent to the address " +
-				"and withdrawn from the account",
-		},
-		cli.Uint64Flag{
-			Name: "sat_per_vbyte",
-			Usage: "the fee rate expressed in sat/vbyte that " +
-				"should be used for the withdrawal",
+			Name:  "amt",
+			Usage: "the amount to deposit into the account",
 		},
 		expiryAbsoluteFlag,
 		cli.Uint64Flag{
@@ -546,44 +980,23 @@ var withdrawAccountCommand = cli.Command{
 				"at",
 		},
 	},
-	Action: withdrawAccount,
+	Action: depositAccountPsbt,
 }
 
-func withdrawAccount(ctx *cli.Context) error {
-	cmd := "withdraw"
+func depositAccountPsbt(ctx *cli.Context) error {
+	cmd := "depositpsbt"
 	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
 	if err != nil {
 		return err
 	}
-	amt, err := parseUint64(ctx, 1, "amt", cmd)
-	if err != nil {
-		return err
-	}
-	addr, err := parseStr(ctx, 2, "addr", cmd)
-	if err != nil {
-		return err
-	}
-	satPerVByte, err := parseUint64(ctx, 3, "sat_per_vbyte", cmd)
+	amt, err := parseAmtArg(ctx, 1, "amt", cmd)
 	if err != nil {
 		return err
 	}
 
-	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
-	// sat/byte is used.
-	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
-	if feeRate < chainfee.FeePerKwFloor {
-		feeRate = chainfee.FeePerKwFloor
-	}
-
-	req := &poolrpc.WithdrawAccountRequest{
+	req := &poolrpc.DepositAccountPsbtRequest{
 		TraderKey: traderKey,
-		Outputs: []*poolrpc.Output{
-			{
-				ValueSat: amt,
-				Address:  addr,
-			},
-		},
-		FeeRateSatPerKw: uint64(feeRate),
+		AmountSat: amt,
 	}
 
 	absoluteExpiry := ctx.Uint64(accountExpiryAbsolute)
@@ -594,12 +1007,12 @@ func withdrawAccount(ctx *cli.Context) error {
 			"set in the same request")
 
 	case absoluteExpiry != 0:
-		req.AccountExpiry = &poolrpc.WithdrawAccountRequest_AbsoluteExpiry{
+		req.AccountExpiry = &poolrpc.DepositAccountPsbtRequest_AbsoluteExpiry{
 			AbsoluteExpiry: uint32(absoluteExpiry),
 		}
 
 	case relativeExpiry != 0:
-		req.AccountExpiry = &poolrpc.WithdrawAccountRequest_RelativeExpiry{
+		req.AccountExpiry = &poolrpc.DepositAccountPsbtRequest_RelativeExpiry{
 			RelativeExpiry: uint32(relativeExpiry),
 		}
 	}
@@ -610,70 +1023,60 @@ func withdrawAccount(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	resp, err := client.WithdrawAccount(context.Background(), req)
+	resp, err := client.DepositAccountPsbt(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
-	var withdrawTxid chainhash.Hash
-	copy(withdrawTxid[:], resp.WithdrawTxid)
-
-	var withdrawAccountResp = struct {
-		Account      *Account `json:"account"`
-		WithdrawTxid string   `json:"withdraw_txid"`
+	psbtResp := struct {
+		Psbt string `json:"psbt"`
 	}{
-		Account:      NewAccountFromProto(resp.Account),
-		WithdrawTxid: withdrawTxid.String(),
+		Psbt: base64.StdEncoding.EncodeToString(resp.Psbt),
 	}
-
-	printJSON(withdrawAccountResp)
+	printResponse(resp, psbtResp, psbtResp)
 
 	return nil
 }
 
-var closeAccountCommand = cli.Command{
-	Name:      "close",
-	ShortName: "c",
-	Usage:     "close an existing account",
+var finalizeDepositCommand = cli.Command{
+	Name:      "finalizedeposit",
+	ShortName: "fd",
+	Usage:     "finalize a deposit funded by an external wallet",
 	Description: `
-	Close an existing account. An optional address can be provided which the
-	funds of the account to close will be sent to, otherwise they are sent
-	to an address under control of the connected lnd node.
+	Finalize a deposit that was initiated with the depositpsbt command
+	using the base64 encoded PSBT that was funded and signed by the
+	external wallet. The deposit transaction is broadcast once the
+	auctioneer signed the account input.
 	`,
-	ArgsUsage: "trader_key sat_per_vbyte",
+	ArgsUsage: "trader_key psbt",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name: "trader_key",
-			Usage: "the trader key associated with the account " +
-				"to close",
-		},
-		cli.Uint64Flag{
-			Name: "sat_per_vbyte",
-			Usage: "the fee rate expressed in sat/vbyte that " +
-				"should be used for the closing transaction",
+			Usage: "the hex-encoded trader key of the account to " +
+				"deposit funds into",
 		},
 		cli.StringFlag{
-			Name: "addr",
-			Usage: "an optional address which the funds of the " +
-				"account to close will be sent to",
+			Name: "psbt",
+			Usage: "the base64 encoded PSBT funded and signed by " +
+				"the external wallet",
 		},
 	},
-	Action: closeAccount,
+	Action: finalizeDeposit,
 }
 
-func closeAccount(ctx *cli.Context) error {
-	cmd := "close"
+func finalizeDeposit(ctx *cli.Context) error {
+	cmd := "finalizedeposit"
 	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
 	if err != nil {
 		return err
 	}
-	satPerVByte, err := parseUint64(ctx, 1, "sat_per_vbyte", cmd)
+	psbtStr, err := parseStr(ctx, 1, "psbt", cmd)
 	if err != nil {
 		return err
 	}
-	satPerKw := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
-	if satPerKw < chainfee.FeePerKwFloor {
-		satPerKw = chainfee.FeePerKwFloor
+	signedPsbt, err := base64.StdEncoding.DecodeString(psbtStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode PSBT: %v", err)
 	}
 
 	client, cleanup, err := getClient(ctx)
@@ -682,33 +1085,661 @@ func closeAccount(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	resp, err := client.CloseAccount(
-		context.Background(), &poolrpc.CloseAccountRequest{
-			TraderKey: traderKey,
-			FundsDestination: &poolrpc.CloseAccountRequest_OutputWithFee{
-				OutputWithFee: &poolrpc.OutputWithFee{
-					Address: ctx.String("addr"),
-					Fees: &poolrpc.OutputWithFee_FeeRateSatPerKw{
-						FeeRateSatPerKw: uint64(satPerKw),
-					},
-				},
-			},
+	resp, err := client.FinalizeDeposit(
+		context.Background(), &poolrpc.FinalizeDepositRequest{
+			TraderKey:  traderKey,
+			SignedPsbt: signedPsbt,
 		},
 	)
 	if err != nil {
 		return err
 	}
 
-	var closeTxid chainhash.Hash
+	var depositTxid chainhash.Hash
+	copy(depositTxid[:], resp.DepositTxid)
+
+	printResponse(resp, struct {
+		Account     *Account `json:"account"`
+		DepositTxid string   `json:"deposit_txid"`
+	}{
+		Account:     NewAccountFromProto(resp.Account),
+		DepositTxid: depositTxid.String(),
+	}, struct {
+		Account     *AccountDerived `json:"account"`
+		DepositTxid string          `json:"deposit_txid"`
+	}{
+		Account:     NewAccountDerivedFromProto(resp.Account),
+		DepositTxid: depositTxid.String(),
+	})
+
+	return nil
+}
+
+var withdrawAccountCommand = cli.Command{
+	Name:      "withdraw",
+	ShortName: "w",
+	Usage:     "withdraw funds from an existing account",
+	Description: `
+	Withdraw funds from an existing account to a supported address.
+	`,
+	ArgsUsage: "trader_key amt addr sat_per_vbyte",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"withdraw funds from",
+		},
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the address the withdrawn funds should go to",
+		},
+		cli.StringFlag{
+			Name: "amt",
+			Usage: "the amount that will be sent to the address " +
+				"and withdrawn from the account",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the withdrawal",
+		},
+		expiryAbsoluteFlag,
+		cli.Uint64Flag{
+			Name: accountExpiryRelative,
+			Usage: "the new relative height (from the current " +
+				"chain height) that the account should expire " +
+				"at",
+		},
+		maxFeeFlag,
+		maxFeeRateFlag,
+		dryRunFlag,
+	},
+	Action: withdrawAccount,
+}
+
+func withdrawAccount(ctx *cli.Context) error {
+	cmd := "withdraw"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	amt, err := parseAmtArg(ctx, 1, "amt", cmd)
+	if err != nil {
+		return err
+	}
+	addr, err := parseStr(ctx, 2, "addr", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 3, "sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+	// sat/byte is used.
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	req := &poolrpc.WithdrawAccountRequest{
+		TraderKey: traderKey,
+		Outputs: []*poolrpc.Output{
+			{
+				ValueSat: amt,
+				Address:  addr,
+			},
+		},
+		FeeRateSatPerKw: uint64(feeRate),
+		FeeLimit:        parseFeeLimit(ctx),
+		DryRun:          ctx.Bool(dryRunFlag.Name),
+	}
+
+	absoluteExpiry := ctx.Uint64(accountExpiryAbsolute)
+	relativeExpiry := ctx.Uint64(accountExpiryRelative)
+	switch {
+	case absoluteExpiry != 0 && relativeExpiry != 0:
+		return errors.New("relative and absolute height cannot be " +
+			"set in the same request")
+
+	case absoluteExpiry != 0:
+		req.AccountExpiry = &poolrpc.WithdrawAccountRequest_AbsoluteExpiry{
+			AbsoluteExpiry: uint32(absoluteExpiry),
+		}
+
+	case relativeExpiry != 0:
+		req.AccountExpiry = &poolrpc.WithdrawAccountRequest_RelativeExpiry{
+			RelativeExpiry: uint32(relativeExpiry),
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.WithdrawAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	if resp.DryRun != nil {
+		printDryRun(resp, resp.Account, resp.DryRun)
+		return nil
+	}
+
+	var withdrawTxid chainhash.Hash
+	copy(withdrawTxid[:], resp.WithdrawTxid)
+
+	var withdrawAccountResp = struct {
+		Account      *Account `json:"account"`
+		WithdrawTxid string   `json:"withdraw_txid"`
+	}{
+		Account:      NewAccountFromProto(resp.Account),
+		WithdrawTxid: withdrawTxid.String(),
+	}
+
+	printResponse(resp, withdrawAccountResp, struct {
+		Account      *AccountDerived `json:"account"`
+		WithdrawTxid string          `json:"withdraw_txid"`
+	}{
+		Account:      NewAccountDerivedFromProto(resp.Account),
+		WithdrawTxid: withdrawAccountResp.WithdrawTxid,
+	})
+
+	return nil
+}
+
+var closeAccountCommand = cli.Command{
+	Name:      "close",
+	ShortName: "c",
+	Usage:     "close an existing account",
+	Description: `
+	Close an existing account. An optional address can be provided which the
+	funds of the account to close will be sent to, otherwise they are sent
+	to an address under control of the connected lnd node.
+	`,
+	ArgsUsage: "trader_key sat_per_vbyte",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account " +
+				"to close",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the closing transaction",
+		},
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "an optional address which the funds of the " +
+				"account to close will be sent to",
+		},
+		maxFeeFlag,
+		maxFeeRateFlag,
+		dryRunFlag,
+	},
+	Action: closeAccount,
+}
+
+func closeAccount(ctx *cli.Context) error {
+	cmd := "close"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 1, "sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+	satPerKw := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if satPerKw < chainfee.FeePerKwFloor {
+		satPerKw = chainfee.FeePerKwFloor
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CloseAccount(
+		context.Background(), &poolrpc.CloseAccountRequest{
+			TraderKey: traderKey,
+			FundsDestination: &poolrpc.CloseAccountRequest_OutputWithFee{
+				OutputWithFee: &poolrpc.OutputWithFee{
+					Address: ctx.String("addr"),
+					Fees: &poolrpc.OutputWithFee_FeeRateSatPerKw{
+						FeeRateSatPerKw: uint64(satPerKw),
+					},
+				},
+			},
+			FeeLimit: parseFeeLimit(ctx),
+			DryRun:   ctx.Bool(dryRunFlag.Name),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if resp.DryRun != nil {
+		printDryRun(resp, nil, resp.DryRun)
+		return nil
+	}
+
+	var closeTxid chainhash.Hash
+	copy(closeTxid[:], resp.CloseTxid)
+
+	closeAccountResp := struct {
+		CloseTxid string `json:"close_txid"`
+	}{
+		CloseTxid: closeTxid.String(),
+	}
+
+	printResponse(resp, closeAccountResp, closeAccountResp)
+
+	return nil
+}
+
+// ScheduledWithdrawal is the display representation of a scheduled withdrawal.
+type ScheduledWithdrawal struct {
+	TraderKey          string            `json:"trader_key"`
+	Outputs            []*poolrpc.Output `json:"outputs"`
+	MaxFeeRateSatPerKw uint64            `json:"max_fee_rate_sat_per_kw"`
+	Expiry             string            `json:"expiry"`
+}
+
+// NewScheduledWithdrawalFromProto creates a display ScheduledWithdrawal from
+// its proto.
+func NewScheduledWithdrawalFromProto(
+	w *poolrpc.ScheduledWithdrawal) *ScheduledWithdrawal {
+
+	expiry := time.Unix(int64(w.ExpiryTimestamp), 0)
+	return &ScheduledWithdrawal{
+		TraderKey:          hex.EncodeToString(w.TraderKey),
+		Outputs:            w.Outputs,
+		MaxFeeRateSatPerKw: w.MaxFeeRateSatPerKw,
+		Expiry:             expiry.Format(time.RFC3339),
+	}
+}
+
+// ScheduledWithdrawalDerived holds the fields of a display ScheduledWithdrawal
+// that can't be read from the raw proto directly.
+type ScheduledWithdrawalDerived struct {
+	Expiry string `json:"expiry"`
+}
+
+// derived returns the derived fields of the scheduled withdrawal.
+func (w *ScheduledWithdrawal) derived() *ScheduledWithdrawalDerived {
+	return &ScheduledWithdrawalDerived{
+		Expiry: w.Expiry,
+	}
+}
+
+var scheduleWithdrawCommand = cli.Command{
+	Name:      "schedulewithdraw",
+	ShortName: "sw",
+	Usage:     "withdraw funds from an account once fees are low enough",
+	Description: `
+	Schedules a withdrawal of funds from an existing account to a
+	supported address. The withdrawal is executed automatically as soon as
+	the estimated fee rate drops to or below the given maximum fee rate. If
+	the account is part of a pending batch, the withdrawal is deferred
+	until the batch is resolved. Any previously scheduled withdrawal of the
+	account is replaced.
+	`,
+	ArgsUsage: "trader_key amt addr max_sat_per_vbyte [--expiry]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"withdraw funds from",
+		},
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the address the withdrawn funds should go to",
+		},
+		cli.StringFlag{
+			Name: "amt",
+			Usage: "the amount that will be sent to the address " +
+				"and withdrawn from the account",
+		},
+		cli.Uint64Flag{
+			Name: "max_sat_per_vbyte",
+			Usage: "the highest fee rate expressed in sat/vbyte " +
+				"at which the withdrawal should be executed",
+		},
+		cli.DurationFlag{
+			Name: "expiry",
+			Usage: "the duration after which the withdrawal is " +
+				"no longer attempted",
+			Value: defaultScheduledWithdrawalExpiry,
+		},
+	},
+	Action: scheduleWithdraw,
+}
+
+func scheduleWithdraw(ctx *cli.Context) error {
+	cmd := "schedulewithdraw"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	amt, err := parseAmtArg(ctx, 1, "amt", cmd)
+	if err != nil {
+		return err
+	}
+	addr, err := parseStr(ctx, 2, "addr", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 3, "max_sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+	// sat/byte is used.
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	expiry := time.Now().Add(ctx.Duration("expiry"))
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ScheduleWithdrawAccount(
+		context.Background(), &poolrpc.ScheduleWithdrawAccountRequest{
+			TraderKey: traderKey,
+			Outputs: []*poolrpc.Output{{
+				ValueSat: amt,
+				Address:  addr,
+			}},
+			MaxFeeRateSatPerKw: uint64(feeRate),
+			ExpiryTimestamp:    uint64(expiry.Unix()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	withdrawal := NewScheduledWithdrawalFromProto(resp.Withdrawal)
+	printResponse(resp, withdrawal, struct {
+		Withdrawal *ScheduledWithdrawalDerived `json:"withdrawal"`
+	}{
+		Withdrawal: withdrawal.derived(),
+	})
+
+	return nil
+}
+
+var listScheduledWithdrawalsCommand = cli.Command{
+	Name:      "listscheduled",
+	ShortName: "ls",
+	Usage:     "list all scheduled withdrawals",
+	Action:    listScheduledWithdrawals,
+}
+
+func listScheduledWithdrawals(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListScheduledWithdrawals(
+		context.Background(), &poolrpc.ListScheduledWithdrawalsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	var listResp = struct {
+		Withdrawals []*ScheduledWithdrawal `json:"withdrawals"`
+	}{
+		Withdrawals: make(
+			[]*ScheduledWithdrawal, 0, len(resp.Withdrawals),
+		),
+	}
+	var derived = struct {
+		Withdrawals []*ScheduledWithdrawalDerived `json:"withdrawals"`
+	}{
+		Withdrawals: make(
+			[]*ScheduledWithdrawalDerived, 0,
+			len(resp.Withdrawals),
+		),
+	}
+	for _, protoWithdrawal := range resp.Withdrawals {
+		withdrawal := NewScheduledWithdrawalFromProto(protoWithdrawal)
+		listResp.Withdrawals = append(listResp.Withdrawals, withdrawal)
+		derived.Withdrawals = append(
+			derived.Withdrawals, withdrawal.derived(),
+		)
+	}
+
+	printResponse(resp, listResp, derived)
+
+	return nil
+}
+
+var cancelScheduledWithdrawCommand = cli.Command{
+	Name:      "cancelscheduled",
+	ShortName: "cs",
+	Usage:     "cancel the scheduled withdrawal of an account",
+	ArgsUsage: "trader_key",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"cancel the scheduled withdrawal of",
+		},
+	},
+	Action: cancelScheduledWithdraw,
+}
+
+func cancelScheduledWithdraw(ctx *cli.Context) error {
+	cmd := "cancelscheduled"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CancelScheduledWithdraw(
+		context.Background(), &poolrpc.CancelScheduledWithdrawRequest{
+			TraderKey: traderKey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var withdrawAndCloseAccountCommand = cli.Command{
+	Name:      "withdrawclose",
+	ShortName: "wc",
+	Usage:     "withdraw part of an account's funds and close it",
+	Description: `
+	Close an existing account by withdrawing the given amount to an
+	address and sending the remaining balance, minus fees, to the backing
+	lnd node's wallet in a single transaction. The account must not have
+	any active orders and no batch must be pending.
+	`,
+	ArgsUsage: "trader_key amt addr sat_per_vbyte",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the account to " +
+				"close",
+		},
+		cli.StringFlag{
+			Name:  "amt",
+			Usage: "the amount that will be sent to the address",
+		},
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the address the withdrawn funds should go to",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the closing transaction",
+		},
+	},
+	Action: withdrawAndCloseAccount,
+}
+
+func withdrawAndCloseAccount(ctx *cli.Context) error {
+	cmd := "withdrawclose"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	amt, err := parseAmtArg(ctx, 1, "amt", cmd)
+	if err != nil {
+		return err
+	}
+	addr, err := parseStr(ctx, 2, "addr", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 3, "sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+	// sat/byte is used.
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.WithdrawAndCloseAccount(
+		context.Background(), &poolrpc.WithdrawAndCloseAccountRequest{
+			TraderKey: traderKey,
+			Outputs: []*poolrpc.Output{{
+				ValueSat: amt,
+				Address:  addr,
+			}},
+			FeeRateSatPerKw: uint64(feeRate),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	var closeTxid chainhash.Hash
 	copy(closeTxid[:], resp.CloseTxid)
 
-	closeAccountResp := struct {
+	closeResp := struct {
 		CloseTxid string `json:"close_txid"`
 	}{
 		CloseTxid: closeTxid.String(),
 	}
+	printResponse(resp, closeResp, closeResp)
+
+	return nil
+}
+
+var sweepAccountCommand = cli.Command{
+	Name:  "sweep",
+	Usage: "sweep an expired account without the auctioneer",
+	Description: `
+	Sweep the full balance of an expired account through the account's
+	expiry path. This only requires the trader's signature and can be used
+	to recover the funds if the auctioneer is unavailable. An optional
+	address can be provided which the funds will be sent to, otherwise they
+	are sent to an address under control of the connected lnd node.
+	`,
+	ArgsUsage: "trader_key sat_per_vbyte",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the hex-encoded trader key of the expired " +
+				"account to sweep",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate expressed in sat/vbyte that " +
+				"should be used for the sweep transaction",
+		},
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "an optional address which the funds of the " +
+				"account will be sent to",
+		},
+	},
+	Action: sweepAccount,
+}
+
+func sweepAccount(ctx *cli.Context) error {
+	cmd := "sweep"
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", cmd)
+	if err != nil {
+		return err
+	}
+	satPerVByte, err := parseUint64(ctx, 1, "sat_per_vbyte", cmd)
+	if err != nil {
+		return err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw by rounding up if 1
+	// sat/byte is used.
+	feeRate := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	if feeRate < chainfee.FeePerKwFloor {
+		feeRate = chainfee.FeePerKwFloor
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SweepExpiredAccount(
+		context.Background(), &poolrpc.SweepExpiredAccountRequest{
+			TraderKey:       traderKey,
+			Address:         ctx.String("addr"),
+			FeeRateSatPerKw: uint64(feeRate),
+		},
+	)
+	if err != nil {
+		return err
+	}
 
-	printJSON(closeAccountResp)
+	var sweepTxid chainhash.Hash
+	copy(sweepTxid[:], resp.SweepTxid)
+
+	sweepResp := struct {
+		SweepTxid string `json:"sweep_txid"`
+	}{
+		SweepTxid: sweepTxid.String(),
+	}
+	printResponse(resp, sweepResp, sweepResp)
 
 	return nil
 }
@@ -724,6 +1755,8 @@ var bumpAccountFeeCommand = cli.Command{
 	contain an output under its control for a successful bump. If a CPFP has
 	already been performed for an account, and this RPC is invoked again,
 	then a replacing transaction (RBF) of the child will be broadcast.
+	Accounts that are part of a pending batch can't be bumped. Every
+	successful bump is recorded in the account's event history.
 	`,
 	ArgsUsage: "trader_key sat_per_vbyte",
 	Flags: []cli.Flag{
@@ -789,8 +1822,25 @@ var recoverAccountsCommand = cli.Command{
 	if there already are open accounts in the trader's database.
 	All open or pending orders of any recovered account will be canceled on
 	the auctioneer's side and won't be restored in the trader's database.
+
+	If an account backup file (accounts.backup in the trader's data
+	directory) survived the data loss, it can be passed with --from_backup
+	to restore the accounts from it directly, without asking the auctioneer
+	or scanning the chain.
+
+	By default the account keys are tried starting at index 0 until
+	account_target keys were tried or, when asking the auctioneer, a number
+	of successive keys are unused. Use --start_index and --end_index to try
+	all keys of a specific range instead. The progress is printed while the
+	recovery runs. If the recovery is interrupted, for example because the
+	daemon was restarted, it can be continued with --resume.
 	`,
 	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "from_backup",
+			Usage: "the path to an account backup file to " +
+				"restore the accounts from",
+		},
 		cli.BoolFlag{
 			Name: "full_client",
 			Usage: "fetch the latest account state from the " +
@@ -804,6 +1854,22 @@ var recoverAccountsCommand = cli.Command{
 				"20 accounts",
 			Value: defaultAccountTarget,
 		},
+		cli.Uint64Flag{
+			Name:  "start_index",
+			Usage: "the first account key index to try",
+		},
+		cli.Uint64Flag{
+			Name: "end_index",
+			Usage: "the account key index to stop at, the key " +
+				"with this index is not tried; if set, all " +
+				"keys in the range are tried",
+		},
+		cli.BoolFlag{
+			Name: "resume",
+			Usage: "resume the last interrupted recovery; the " +
+				"bitcoind/btcd flags must be set again for " +
+				"`full_client` recoveries",
+		},
 		cli.StringFlag{
 			Name: "auctioneer_key",
 			Usage: "Auctioneer's public key. Only used during " +
@@ -858,10 +1924,24 @@ func recoverAccounts(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	resp, err := client.RecoverAccounts(
+	var backup []byte
+	if ctx.IsSet("from_backup") {
+		backupPath := lncfg.CleanAndExpandPath(ctx.String("from_backup"))
+		backup, err = ioutil.ReadFile(backupPath)
+		if err != nil {
+			return fmt.Errorf("unable to read account backup: %v",
+				err)
+		}
+	}
+
+	stream, err := client.RecoverAccounts(
 		context.Background(), &poolrpc.RecoverAccountsRequest{
+			AccountBackup: backup,
 			FullClient:    ctx.Bool("full_client"),
 			AccountTarget: uint32(ctx.Uint64("account_target")),
+			StartIndex:    uint32(ctx.Uint64("start_index")),
+			EndIndex:      uint32(ctx.Uint64("end_index")),
+			Resume:        ctx.Bool("resume"),
 			AuctioneerKey: ctx.String("auctioneer_key"),
 			HeightHint:    uint32(ctx.Uint64("height_hint")),
 
@@ -879,7 +1959,184 @@ func recoverAccounts(ctx *cli.Context) error {
 		return err
 	}
 
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
+var accountEventsCommand = cli.Command{
+	Name:      "events",
+	ShortName: "e",
+	Usage:     "list the history of changes of an account",
+	Description: `
+	List all changes of an account's value, expiry or state in
+	chronological order, together with the action that triggered them and
+	the account's on-chain outpoint after the change.
+	`,
+	ArgsUsage: "trader_key",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "trader_key",
+			Usage: "the trader key associated with the account " +
+				"to list the events of",
+		},
+	},
+	Action: accountEvents,
+}
+
+func accountEvents(ctx *cli.Context) error {
+	traderKey, err := parseHexStr(ctx, 0, "trader_key", "events")
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.AccountEvents(
+		context.Background(), &poolrpc.AccountEventsRequest{
+			TraderKey: traderKey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	printRespJSON(resp)
 
 	return nil
 }
+
+var subscribeAccountsCommand = cli.Command{
+	Name:  "subscribe",
+	Usage: "stream updates of all accounts",
+	Description: `
+	Print the current state of all accounts, followed by an update every
+	time the state, value or outpoint of an account changes. The command
+	runs until it is interrupted.
+	`,
+	Action: subscribeAccounts,
+}
+
+func subscribeAccounts(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.SubscribeAccountUpdates(
+		context.Background(), &poolrpc.SubscribeAccountUpdatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
+var exportWatchOnlyCommand = cli.Command{
+	Name:  "exportwatchonly",
+	Usage: "export the accounts for an external monitoring service",
+	Description: `
+	Export everything a third party needs to detect unexpected spends of
+	the current outputs of all active accounts as JSON. The export contains
+	no key material that allows signing for an account. It can be verified
+	against a spending transaction with account.VerifyAccountSpend.
+	`,
+	Action: exportWatchOnly,
+}
+
+func exportWatchOnly(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ExportAccountWatchOnly(
+		context.Background(), &poolrpc.ExportAccountWatchOnlyRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We print the same JSON encoding the account package reads, so the
+	// output can be fed to VerifyAccountSpend directly.
+	accounts := make([]*account.WatchOnlyAccount, 0, len(resp.Accounts))
+	for _, rpcAccount := range resp.Accounts {
+		watchOnly, err := unmarshallWatchOnlyAccount(rpcAccount)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, watchOnly)
+	}
+
+	printResponse(resp, accounts, nil)
+
+	return nil
+}
+
+// unmarshallWatchOnlyAccount converts a watch-only account from its RPC
+// representation.
+func unmarshallWatchOnlyAccount(
+	a *poolrpc.WatchOnlyAccount) (*account.WatchOnlyAccount, error) {
+
+	traderKey, err := btcec.ParsePubKey(a.TraderKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trader key: %v", err)
+	}
+	auctioneerKey, err := btcec.ParsePubKey(a.AuctioneerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auctioneer key: %v", err)
+	}
+	batchKey, err := btcec.ParsePubKey(a.BatchKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %v", err)
+	}
+	hash, err := chainhash.NewHash(a.Outpoint.Txid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid outpoint: %v", err)
+	}
+
+	version := account.VersionInitialNoVersion
+	if a.Version == auctioneerrpc.AccountVersion_ACCOUNT_VERSION_TAPROOT {
+		version = account.VersionTaprootEnabled
+	}
+
+	return &account.WatchOnlyAccount{
+		TraderKey:     traderKey,
+		Version:       version,
+		Value:         btcutil.Amount(a.Value),
+		Expiry:        a.ExpirationHeight,
+		AuctioneerKey: auctioneerKey,
+		BatchKey:      batchKey,
+		OutPoint: wire.OutPoint{
+			Hash:  *hash,
+			Index: a.Outpoint.OutputIndex,
+		},
+		PkScript:     a.PkScript,
+		NextPkScript: a.NextPkScript,
+	}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightninglabs/pool/auctioneerrpc"
@@ -16,6 +18,9 @@ type Lease struct {
 	ChannelAmtSat         uint64 `json:"channel_amt_sat"`
 	ChannelDurationBlocks uint32 `json:"channel_duration_blocks"`
 	ChannelLeaseExpiry    uint32 `json:"channel_lease_expiry"`
+	ChannelID             uint64 `json:"channel_id"`
+	ChannelState          string `json:"channel_state"`
+	ChannelCloseHeight    uint32 `json:"channel_close_height"`
 	ChannelRemoteNodeKey  string `json:"channel_node_key"`
 	ChannelNodeTier       string `json:"channel_node_tier"`
 	PremiumSat            uint64 `json:"premium_sat"`
@@ -28,6 +33,9 @@ type Lease struct {
 	Purchased             bool   `json:"purchased"`
 	SelfChanBalance       uint64 `json:"self_chan_balance"`
 	SidecarChannel        bool   `json:"sidecar_channel"`
+	SidecarPushAmtSat     uint64 `json:"sidecar_push_amt_sat"`
+	BatchID               string `json:"batch_id"`
+	BatchTimestampNs      int64  `json:"batch_timestamp_ns"`
 }
 
 // NewLeaseFromProto creates a display Lease from its proto.
@@ -41,6 +49,9 @@ func NewLeaseFromProto(a *poolrpc.Lease) *Lease {
 		ChannelAmtSat:         a.ChannelAmtSat,
 		ChannelDurationBlocks: a.ChannelDurationBlocks,
 		ChannelLeaseExpiry:    a.ChannelLeaseExpiry,
+		ChannelID:             a.ChannelId,
+		ChannelState:          a.ChannelState.String(),
+		ChannelCloseHeight:    a.ChannelCloseHeight,
 		ChannelRemoteNodeKey:  hex.EncodeToString(a.ChannelRemoteNodeKey),
 		ChannelNodeTier:       a.ChannelNodeTier.String(),
 		PremiumSat:            a.PremiumSat,
@@ -53,6 +64,83 @@ func NewLeaseFromProto(a *poolrpc.Lease) *Lease {
 		Purchased:             a.Purchased,
 		SelfChanBalance:       a.SelfChanBalance,
 		SidecarChannel:        a.SidecarChannel,
+		SidecarPushAmtSat:     a.SidecarPushAmtSat,
+		BatchID:               hex.EncodeToString(a.BatchId),
+		BatchTimestampNs:      a.BatchTimestampNs,
+	}
+}
+
+// LeaseDerived holds the fields of a display Lease that can't be read from the
+// raw proto lease directly.
+type LeaseDerived struct {
+	ChannelPoint string `json:"channel_point"`
+}
+
+// NewLeaseDerivedFromProto creates the derived fields of a display Lease from
+// its proto.
+func NewLeaseDerivedFromProto(a *poolrpc.Lease) *LeaseDerived {
+	return &LeaseDerived{
+		ChannelPoint: NewLeaseFromProto(a).ChannelPoint,
+	}
+}
+
+// LeaseEvent is the display version of an early lease close, with all hashes
+// and keys encoded the same way lnd shows them so it can be used as evidence
+// in a complaint.
+type LeaseEvent struct {
+	TimestampNs           int64  `json:"timestamp_ns"`
+	ChannelPoint          string `json:"channel_point"`
+	ClosingTxid           string `json:"closing_txid"`
+	BatchID               string `json:"batch_id"`
+	OrderNonce            string `json:"order_nonce"`
+	Purchased             bool   `json:"purchased"`
+	ChannelRemoteNodeKey  string `json:"channel_node_key"`
+	ChannelAmtSat         uint64 `json:"channel_amt_sat"`
+	CloseType             string `json:"close_type"`
+	ChannelDurationBlocks uint32 `json:"channel_duration_blocks"`
+	ChannelLeaseExpiry    uint32 `json:"channel_lease_expiry"`
+	CloseHeight           uint32 `json:"close_height"`
+	BlocksRemaining       uint32 `json:"blocks_remaining"`
+}
+
+// NewLeaseEventFromProto creates a display LeaseEvent from its proto.
+func NewLeaseEventFromProto(e *poolrpc.LeaseEvent) *LeaseEvent {
+	var opHash, closingTxid chainhash.Hash
+	copy(opHash[:], e.ChannelPoint.Txid)
+	copy(closingTxid[:], e.ClosingTxid)
+
+	chanPoint := fmt.Sprintf("%v:%d", opHash, e.ChannelPoint.OutputIndex)
+	return &LeaseEvent{
+		TimestampNs:           e.TimestampNs,
+		ChannelPoint:          chanPoint,
+		ClosingTxid:           closingTxid.String(),
+		BatchID:               hex.EncodeToString(e.BatchId),
+		OrderNonce:            hex.EncodeToString(e.OrderNonce),
+		Purchased:             e.Purchased,
+		ChannelRemoteNodeKey:  hex.EncodeToString(e.ChannelRemoteNodeKey),
+		ChannelAmtSat:         e.ChannelAmtSat,
+		CloseType:             e.CloseType.String(),
+		ChannelDurationBlocks: e.ChannelDurationBlocks,
+		ChannelLeaseExpiry:    e.ChannelLeaseExpiry,
+		CloseHeight:           e.CloseHeight,
+		BlocksRemaining:       e.BlocksRemaining,
+	}
+}
+
+// LeaseEventDerived holds the fields of a display LeaseEvent that can't be
+// read from the raw proto event directly.
+type LeaseEventDerived struct {
+	ChannelPoint string `json:"channel_point"`
+	ClosingTxid  string `json:"closing_txid"`
+}
+
+// NewLeaseEventDerivedFromProto creates the derived fields of a display
+// LeaseEvent from its proto.
+func NewLeaseEventDerivedFromProto(e *poolrpc.LeaseEvent) *LeaseEventDerived {
+	display := NewLeaseEventFromProto(e)
+	return &LeaseEventDerived{
+		ChannelPoint: display.ChannelPoint,
+		ClosingTxid:  display.ClosingTxid,
 	}
 }
 
@@ -107,7 +195,12 @@ var auctionCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			auctionFeeCommand,
 			batchSnapshotCommand,
+			localBatchSnapshotsCommand,
+			acceptedBatchProposalCommand,
+			fundingFailuresCommand,
+			leaseAuditCommand,
 			leasesCommand,
+			leaseEventsCommand,
 			leaseDurationsCommand,
 			nextBatchInfoCommand,
 			ratingsCommand,
@@ -213,7 +306,196 @@ func batchSnapshot(ctx *cli.Context) error {
 	// are removed from the proto (alpha->beta transition?).
 	// TODO(guggero): Use original proto message once deprecated fields are
 	// removed.
-	printJSON(NewSnapshotsFromProto(resp.Batches))
+	printResponse(resp, NewSnapshotsFromProto(resp.Batches), nil)
+
+	return nil
+}
+
+var localBatchSnapshotsCommand = cli.Command{
+	Name:      "localsnapshots",
+	ShortName: "ls",
+	Usage: "return information about prior batches our orders " +
+		"participated in",
+	ArgsUsage: "batch_id",
+	Description: `
+		Returns the locally stored information about prior batches our
+		orders were matched in, such as the clearing prices, the orders
+		matched and the chain fees paid. Unlike the snapshot command,
+		this doesn't require the auction server to be queried.
+		`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "batch_id",
+			Usage: "the batch ID to start at, if left blank, the " +
+				"most recent batch we participated in is used",
+		},
+		cli.Uint64Flag{
+			Name: "num_batches",
+			Usage: "the number of batches to show, starting at " +
+				"the batch_id and going back through the " +
+				"history of batches, 0 means all batches",
+		},
+	},
+	Action: localBatchSnapshots,
+}
+
+func localBatchSnapshots(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	var batchIDStr string
+	switch {
+	case ctx.Args().Present():
+		batchIDStr = ctx.Args().First()
+
+	case ctx.IsSet("batch_id"):
+		batchIDStr = ctx.String("batch_id")
+	}
+
+	batchID, err := hex.DecodeString(batchIDStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode batch ID: %v", err)
+	}
+
+	resp, err := client.ListLocalBatchSnapshots(
+		context.Background(), &poolrpc.ListLocalBatchSnapshotsRequest{
+			StartBatchId:   batchID,
+			NumBatchesBack: uint32(ctx.Uint64("num_batches")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var acceptedBatchProposalCommand = cli.Command{
+	Name:      "proposal",
+	ShortName: "p",
+	Usage: "return the proposal of a batch we signed for that isn't " +
+		"finalized yet",
+	ArgsUsage: "batch_id",
+	Description: `
+		Returns what we agreed to with the auctioneer when signing for
+		a batch that wasn't finalized yet, such as the matched units,
+		the ending states of our accounts and the batch transaction.
+		`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "batch_id",
+			Usage: "the batch ID to return the proposal for, if " +
+				"left blank, the current pending batch is used",
+		},
+	},
+	Action: acceptedBatchProposal,
+}
+
+func acceptedBatchProposal(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	var batchIDStr string
+	switch {
+	case ctx.Args().Present():
+		batchIDStr = ctx.Args().First()
+
+	case ctx.IsSet("batch_id"):
+		batchIDStr = ctx.String("batch_id")
+	}
+
+	batchID, err := hex.DecodeString(batchIDStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode batch ID: %v", err)
+	}
+
+	resp, err := client.AcceptedBatchProposal(
+		context.Background(), &poolrpc.AcceptedBatchProposalRequest{
+			BatchId: batchID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var fundingFailuresCommand = cli.Command{
+	Name:  "fundingfailures",
+	Usage: "list channels whose funding could not be resumed",
+	Description: `
+		Returns all channels of signed batches whose funding flow could
+		not be resumed after the daemon was restarted. These channels
+		need manual intervention.
+		`,
+	Action: fundingFailures,
+}
+
+func fundingFailures(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListFundingFailures(
+		context.Background(), &poolrpc.ListFundingFailuresRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var leaseAuditCommand = cli.Command{
+	Name:  "leaseaudit",
+	Usage: "list the lease audits of channels created in batches",
+	Description: `
+		Returns the results of comparing the lease expiry of every
+		channel that completed its funding flow with the lease expiry
+		promised in its batch. Mismatches are flagged.
+		`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "mismatches_only",
+			Usage: "only list channels whose lease doesn't match " +
+				"their batch",
+		},
+	},
+	Action: leaseAudit,
+}
+
+func leaseAudit(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.LeaseAudit(
+		context.Background(), &poolrpc.LeaseAuditRequest{
+			MismatchesOnly: ctx.Bool("mismatches_only"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
 
 	return nil
 }
@@ -225,33 +507,65 @@ var leasesCommand = cli.Command{
 	Description: `
 	Returns the list of leases (i.e., channels) that were either purchased
 	or sold by the trader within the auction. An optional list of batch IDs
-	and accounts can be specified to filter the leases returned.
+	and accounts as well as a time range can be specified to filter the
+	leases returned. Leases are ordered by the time of their batch, so large
+	result sets can be paged through with the index_offset and max_leases
+	flags.
 	`,
-	Flags: []cli.Flag{
-		cli.StringSliceFlag{
-			Name: "batch_ids",
-			Usage: "the target batch IDs to obtain leases for, " +
-				"if left blank, leases from all batches are " +
-				"returned",
+	Flags: append(leaseFilterFlags,
+		cli.UintFlag{
+			Name: "index_offset",
+			Usage: "the number of matching leases to skip before " +
+				"the first one that is returned",
 		},
-		cli.StringSliceFlag{
-			Name: "accounts",
-			Usage: "the target accounts to obtain leases for, if " +
-				"left blank, leases from all accounts are " +
-				"returned",
+		cli.UintFlag{
+			Name: "max_leases",
+			Usage: "the maximum number of leases to return, if " +
+				"left blank, all matching leases are returned",
 		},
-	},
+	),
 	Action: leases,
 }
 
-func leases(ctx *cli.Context) error {
+// leaseFilterFlags are the flags of all commands that query leases with the
+// filters of the Leases RPC.
+var leaseFilterFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name: "batch_ids",
+		Usage: "the target batch IDs to obtain leases for, if left " +
+			"blank, leases from all batches are returned",
+	},
+	cli.StringSliceFlag{
+		Name: "accounts",
+		Usage: "the target accounts to obtain leases for, if left " +
+			"blank, leases from all accounts are returned",
+	},
+	cli.StringFlag{
+		Name: "start_time",
+		Usage: "only return leases of batches that happened at or " +
+			"after the given RFC3339 time",
+	},
+	cli.StringFlag{
+		Name: "end_time",
+		Usage: "only return leases of batches that happened before " +
+			"the given RFC3339 time",
+	},
+	cli.BoolFlag{
+		Name:  "open_only",
+		Usage: "only return leases whose channel is still open",
+	},
+}
+
+// parseLeasesRequest creates a leases request from the leaseFilterFlags of the
+// given command.
+func parseLeasesRequest(ctx *cli.Context) (*poolrpc.LeasesRequest, error) {
 	hexBatchIDs := ctx.StringSlice("batch_ids")
 	batchIDs := make([][]byte, 0, len(hexBatchIDs))
 	for _, hexBatchID := range hexBatchIDs {
 		batchID, err := hex.DecodeString(hexBatchID)
 		if err != nil {
-			return fmt.Errorf("invalid batch ID %v: %v", hexBatchID,
-				err)
+			return nil, fmt.Errorf("invalid batch ID %v: %v",
+				hexBatchID, err)
 		}
 		batchIDs = append(batchIDs, batchID)
 	}
@@ -261,46 +575,141 @@ func leases(ctx *cli.Context) error {
 	for _, hexAccountKey := range hexAccountKeys {
 		accountKey, err := hex.DecodeString(hexAccountKey)
 		if err != nil {
-			return fmt.Errorf("invalid account key %v: %v",
+			return nil, fmt.Errorf("invalid account key %v: %v",
 				hexAccountKey, err)
 		}
 		accounts = append(accounts, accountKey)
 	}
 
+	req := &poolrpc.LeasesRequest{
+		BatchIds:         batchIDs,
+		Accounts:         accounts,
+		OpenChannelsOnly: ctx.Bool("open_only"),
+	}
+	if ctx.IsSet("start_time") {
+		startTime, err := time.Parse(
+			time.RFC3339, ctx.String("start_time"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse start_time: %v",
+				err)
+		}
+		req.StartTimestampNs = startTime.UnixNano()
+	}
+	if ctx.IsSet("end_time") {
+		endTime, err := time.Parse(time.RFC3339, ctx.String("end_time"))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse end_time: %v",
+				err)
+		}
+		req.EndTimestampNs = endTime.UnixNano()
+	}
+
+	return req, nil
+}
+
+func leases(ctx *cli.Context) error {
+	req, err := parseLeasesRequest(ctx)
+	if err != nil {
+		return err
+	}
+	req.IndexOffset = uint32(ctx.Uint("index_offset"))
+	req.MaxNumLeases = uint32(ctx.Uint("max_leases"))
+
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
-	resp, err := client.Leases(context.Background(), &poolrpc.LeasesRequest{
-		BatchIds: batchIDs,
-		Accounts: accounts,
-	})
+	resp, err := client.Leases(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
 	displayLeases := make([]*Lease, 0, len(resp.Leases))
+	derivedLeases := make([]*LeaseDerived, 0, len(resp.Leases))
 	for _, lease := range resp.Leases {
 		displayLeases = append(displayLeases, NewLeaseFromProto(lease))
+		derivedLeases = append(
+			derivedLeases, NewLeaseDerivedFromProto(lease),
+		)
 	}
 
 	leasesResp := struct {
 		Leases            []*Lease `json:"leases"`
 		TotalAmtEarnedSat uint64   `json:"total_amt_earned_sat"`
 		TotalAmtPaidSat   uint64   `json:"total_amt_paid_sat"`
+		TotalNumLeases    uint32   `json:"total_num_leases"`
 	}{
 		Leases:            displayLeases,
 		TotalAmtEarnedSat: resp.TotalAmtEarnedSat,
 		TotalAmtPaidSat:   resp.TotalAmtPaidSat,
+		TotalNumLeases:    resp.TotalNumLeases,
+	}
+
+	derived := struct {
+		Leases []*LeaseDerived `json:"leases"`
+	}{
+		Leases: derivedLeases,
 	}
 
-	printJSON(leasesResp)
+	printResponse(resp, leasesResp, derived)
 
 	return nil
 }
 
+var leaseEventsCommand = cli.Command{
+	Name:  "leaseevents",
+	Usage: "stream channels of leases that were closed early",
+	Description: `
+	Print an event every time the channel of a lease is force closed before
+	the lease expired. Each event contains the channel point, the closing
+	transaction and the terms of the lease, which is everything needed to
+	file a complaint about the peer. The command runs until it is
+	interrupted.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "history",
+			Usage: "print all recorded early closes before " +
+				"waiting for new ones",
+		},
+	},
+	Action: leaseEvents,
+}
+
+func leaseEvents(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.SubscribeLeaseEvents(
+		context.Background(), &poolrpc.SubscribeLeaseEventsRequest{
+			IncludeHistory: ctx.Bool("history"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		evt, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printResponse(
+			evt, NewLeaseEventFromProto(evt),
+			NewLeaseEventDerivedFromProto(evt),
+		)
+	}
+}
+
 var leaseDurationsCommand = cli.Command{
 	Name:      "leasedurations",
 	ShortName: "ld",
@@ -5,10 +5,12 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/urfave/cli"
 	"gopkg.in/macaroon.v2"
 )
@@ -26,6 +28,12 @@ type printableToken struct {
 	FileName        string `json:"file_name"`
 }
 
+// printableTokenDerived holds the fields of a printableToken that are decoded
+// from the token's macaroon instead of being part of the raw response.
+type printableTokenDerived struct {
+	ID string `json:"id"`
+}
+
 var listAuthCommand = cli.Command{
 	Name:        "listauth",
 	Usage:       "list all LSAT tokens",
@@ -48,6 +56,11 @@ func listAuth(ctx *cli.Context) error {
 	}
 
 	tokens := make([]*printableToken, len(resp.Tokens))
+	derived := struct {
+		Tokens []*printableTokenDerived `json:"tokens"`
+	}{
+		Tokens: make([]*printableTokenDerived, len(resp.Tokens)),
+	}
 	for i, t := range resp.Tokens {
 		mac := &macaroon.Macaroon{}
 		err := mac.UnmarshalBinary(t.BaseMacaroon)
@@ -74,8 +87,182 @@ func listAuth(ctx *cli.Context) error {
 			Expired:  t.Expired,
 			FileName: t.StorageName,
 		}
+		derived.Tokens[i] = &printableTokenDerived{
+			ID: tokens[i].ID,
+		}
+	}
+
+	printResponse(resp, tokens, derived)
+	return nil
+}
+
+var authCommands = []cli.Command{
+	{
+		Name:     "auth",
+		Usage:    "Manage the LSAT tokens used to authenticate.",
+		Category: "Auction",
+		Subcommands: []cli.Command{
+			listTokensCommand,
+			revokeTokenCommand,
+			importTokenCommand,
+		},
+	},
+}
+
+type printableTokenInfo struct {
+	ID             string `json:"id"`
+	PaymentHash    string `json:"payment_hash"`
+	AmountPaid     int64  `json:"amount_paid_msat"`
+	RoutingFeePaid int64  `json:"routing_fee_paid_msat"`
+	TimeCreated    string `json:"time_created"`
+	Pending        bool   `json:"pending"`
+	Revoked        bool   `json:"revoked"`
+	FileName       string `json:"file_name"`
+}
+
+func newPrintableTokenInfo(t *poolrpc.LsatTokenInfo) *printableTokenInfo {
+	return &printableTokenInfo{
+		ID:             hex.EncodeToString(t.TokenId),
+		PaymentHash:    hex.EncodeToString(t.PaymentHash),
+		AmountPaid:     t.AmountPaidMsat,
+		RoutingFeePaid: t.RoutingFeePaidMsat,
+		TimeCreated: time.Unix(t.TimeCreated, 0).Format(
+			time.RFC3339,
+		),
+		Pending:  t.Pending,
+		Revoked:  t.Revoked,
+		FileName: t.StorageName,
+	}
+}
+
+var listTokensCommand = cli.Command{
+	Name:        "list",
+	Usage:       "list all LSAT tokens",
+	Description: "Shows all LSAT tokens, including pending and revoked ones",
+	Action:      listTokens,
+}
+
+func listTokens(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListLsatTokens(
+		context.Background(), &poolrpc.ListLsatTokensRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	tokens := make([]*printableTokenInfo, len(resp.Tokens))
+	for i, t := range resp.Tokens {
+		tokens[i] = newPrintableTokenInfo(t)
+	}
+
+	printResponse(resp, tokens, nil)
+	return nil
+}
+
+var revokeTokenCommand = cli.Command{
+	Name:      "revoke",
+	Usage:     "revoke the current LSAT token",
+	ArgsUsage: "id",
+	Description: `
+	Revoke the current LSAT token, for example after it might have leaked.
+	The token is kept for accounting purposes but no longer used. A new
+	token is paid for with the next call to the auction server.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the hex encoded ID of the token to revoke",
+		},
+	},
+	Action: revokeToken,
+}
+
+func revokeToken(ctx *cli.Context) error {
+	idStr := ctx.String("id")
+	if idStr == "" && ctx.NArg() > 0 {
+		idStr = ctx.Args().First()
+	}
+	if idStr == "" {
+		return cli.ShowCommandHelp(ctx, "revoke")
+	}
+
+	tokenID, err := hex.DecodeString(idStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode token ID: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RevokeLsatToken(
+		context.Background(), &poolrpc.RevokeLsatTokenRequest{
+			TokenId: tokenID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var importTokenCommand = cli.Command{
+	Name:      "import",
+	Usage:     "import a paid LSAT token",
+	ArgsUsage: "token_file",
+	Description: `
+	Import a paid LSAT token, for example to move a trader to a new
+	machine. The token file is the lsat.token file in the base directory
+	of the other trader daemon. The current token must be revoked first.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "token_file",
+			Usage: "the path of the token file to import",
+		},
+	},
+	Action: importToken,
+}
+
+func importToken(ctx *cli.Context) error {
+	tokenFile := ctx.String("token_file")
+	if tokenFile == "" && ctx.NArg() > 0 {
+		tokenFile = ctx.Args().First()
+	}
+	if tokenFile == "" {
+		return cli.ShowCommandHelp(ctx, "import")
+	}
+
+	token, err := ioutil.ReadFile(lncfg.CleanAndExpandPath(tokenFile))
+	if err != nil {
+		return fmt.Errorf("unable to read token file: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ImportLsatToken(
+		context.Background(), &poolrpc.ImportLsatTokenRequest{
+			Token: token,
+		},
+	)
+	if err != nil {
+		return err
 	}
 
-	printJSON(tokens)
+	printResponse(resp, newPrintableTokenInfo(resp.Token), nil)
 	return nil
 }
@@ -2,8 +2,10 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
+	"os"
 	"path"
 	"path/filepath"
 
@@ -29,6 +31,11 @@ var debugCommands = []cli.Command{
 			dumpPendingBatcheCommand,
 			removePendingBatchCommand,
 			deleteOrderCommand,
+			exportStateCommand,
+			importStateCommand,
+			verifyDBCommand,
+			setEndpointCommand,
+			dumpConfigCommand,
 		},
 	},
 }
@@ -136,6 +143,7 @@ func dumpOrders(ctx *cli.Context) error {
 				Details:             details,
 				LeaseDurationBlocks: dbDetails.LeaseDuration,
 				Version:             uint32(o.Version),
+				MinDistinctPeers:    o.MinDistinctPeers,
 			}
 			asks = append(asks, rpcAsk)
 
@@ -241,14 +249,30 @@ func dumpPendingBatch(ctx *cli.Context) error {
 		return fmt.Errorf("error getting pending batch: %v", err)
 	}
 
-	fmt.Printf("Batch ID:\t%x\n", snapshot.BatchID[:])
-	fmt.Printf("TXID:\t\t%s\n", snapshot.BatchTX.TxHash())
-
 	var buf bytes.Buffer
 	err = snapshot.BatchTX.Serialize(&buf)
 	if err != nil {
 		return fmt.Errorf("error serializing TX: %v", err)
 	}
+
+	// There is no proto response for the local database, so we print our
+	// own object in machine-readable mode.
+	if jsonOutput {
+		printJSON(struct {
+			BatchID string `json:"batch_id"`
+			Txid    string `json:"txid"`
+			RawTx   string `json:"raw_tx"`
+		}{
+			BatchID: hex.EncodeToString(snapshot.BatchID[:]),
+			Txid:    snapshot.BatchTX.TxHash().String(),
+			RawTx:   hex.EncodeToString(buf.Bytes()),
+		})
+
+		return nil
+	}
+
+	fmt.Printf("Batch ID:\t%x\n", snapshot.BatchID[:])
+	fmt.Printf("TXID:\t\t%s\n", snapshot.BatchTX.TxHash())
 	fmt.Printf("Raw TX:\t\t%x\n", buf.Bytes())
 
 	return nil
@@ -278,6 +302,152 @@ func removePendingBatch(ctx *cli.Context) error {
 	return db.DeletePendingBatch()
 }
 
+var exportStateCommand = cli.Command{
+	Name:      "exportstate",
+	ShortName: "es",
+	Usage: "export the full trader state into a portable file that " +
+		"can be imported on another machine",
+	Description: `
+	Export all accounts, orders, sidecar tickets and the LSAT token of the
+	local database into a versioned file. The pool daemon must not be
+	running while exporting the state.
+	`,
+	ArgsUsage: "file",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "the file to write the exported state to",
+		},
+		cli.StringFlag{
+			Name: "db",
+			Usage: "the specific pool database to use instead " +
+				"of the default one on ~/.pool/<network>/" +
+				"pool.db",
+		},
+	},
+	Action: exportState,
+}
+
+func exportState(ctx *cli.Context) error {
+	fileName, err := stateFileName(ctx)
+	if err != nil {
+		return err
+	}
+
+	db, err := getPoolDB(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading DB: %v", err)
+	}
+
+	f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("error creating state file: %v", err)
+	}
+	defer f.Close()
+
+	if err := db.ExportState(f); err != nil {
+		return fmt.Errorf("error exporting state: %v", err)
+	}
+
+	return f.Sync()
+}
+
+var importStateCommand = cli.Command{
+	Name:      "importstate",
+	ShortName: "is",
+	Usage:     "import a trader state that was exported with exportstate",
+	Description: `
+	Import all accounts, orders, sidecar tickets and the LSAT token of a
+	state file created with exportstate into the local database. The
+	import fails if the local database already contains any accounts. The
+	pool daemon must not be running while importing the state.
+	`,
+	ArgsUsage: "file",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "the file to read the exported state from",
+		},
+		cli.StringFlag{
+			Name: "db",
+			Usage: "the specific pool database to use instead " +
+				"of the default one on ~/.pool/<network>/" +
+				"pool.db",
+		},
+	},
+	Action: importState,
+}
+
+func importState(ctx *cli.Context) error {
+	fileName, err := stateFileName(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("error opening state file: %v", err)
+	}
+	defer f.Close()
+
+	db, err := getPoolDB(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading DB: %v", err)
+	}
+
+	if err := db.ImportState(f); err != nil {
+		return fmt.Errorf("error importing state: %v", err)
+	}
+
+	return nil
+}
+
+var verifyDBCommand = cli.Command{
+	Name:      "verifydb",
+	ShortName: "vdb",
+	Usage:     "scan the local database for corrupted records",
+	Description: `
+	Ask the running pool daemon to scan its database for records that don't
+	match their checksum or cannot be decoded and list them. The database
+	is not modified.
+	`,
+	Action: verifyDB,
+}
+
+func verifyDB(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.VerifyDB(
+		context.Background(), &poolrpc.VerifyDBRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// stateFileName returns the name of the state file from either the file flag
+// or the first positional argument.
+func stateFileName(ctx *cli.Context) (string, error) {
+	switch {
+	case ctx.IsSet("file"):
+		return lncfg.CleanAndExpandPath(ctx.String("file")), nil
+
+	case ctx.Args().Present():
+		return lncfg.CleanAndExpandPath(ctx.Args().First()), nil
+
+	default:
+		return "", fmt.Errorf("file argument missing")
+	}
+}
+
 func getPoolDB(ctx *cli.Context) (*clientdb.DB, error) {
 	fullDbPath := filepath.Join(
 		pool.DefaultBaseDir, ctx.GlobalString("network"),
@@ -287,7 +457,9 @@ func getPoolDB(ctx *cli.Context) (*clientdb.DB, error) {
 		fullDbPath = lncfg.CleanAndExpandPath(ctx.String("db"))
 	}
 
-	db, err := clientdb.New(path.Dir(fullDbPath), path.Base(fullDbPath))
+	db, err := clientdb.New(
+		path.Dir(fullDbPath), path.Base(fullDbPath), nil,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error opening DB at %v: %v", fullDbPath,
 			err)
@@ -295,3 +467,85 @@ func getPoolDB(ctx *cli.Context) (*clientdb.DB, error) {
 
 	return db, nil
 }
+
+var setEndpointCommand = cli.Command{
+	Name:      "setendpoint",
+	ShortName: "se",
+	Usage:     "force the auction server endpoint to use",
+	ArgsUsage: "[address]",
+	Description: `
+	Ask the running pool daemon to switch to the given auction server
+	endpoint, which must be one of the configured endpoints. The endpoint
+	is tried first on every reconnect from now on, the other endpoints are
+	only used if it can't be reached. Without an address the endpoints are
+	selected automatically again.
+	`,
+	Action: setEndpoint,
+}
+
+func setEndpoint(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SetAuctioneerEndpoint(
+		context.Background(), &poolrpc.SetAuctioneerEndpointRequest{
+			Address: ctx.Args().First(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var dumpConfigCommand = cli.Command{
+	Name:      "dumpconfig",
+	ShortName: "dc",
+	Usage:     "print the effective config of the daemon",
+	Description: `
+	Ask the running pool daemon for its effective config after the command
+	line flags, environment variables and config file were combined, in the
+	format of the config file. The values of secret options are redacted.
+	Warnings about unknown sections and options in the config file are
+	printed as comments at the top.
+	`,
+	Action: dumpConfig,
+}
+
+func dumpConfig(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DumpConfig(
+		context.Background(), &poolrpc.DumpConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		printRespJSON(resp)
+		return nil
+	}
+
+	configFile := resp.ConfigFile
+	if configFile == "" {
+		configFile = "none"
+	}
+	fmt.Printf("; Config file: %s\n", configFile)
+	for _, warning := range resp.Warnings {
+		fmt.Printf("; Warning: %s\n", warning)
+	}
+	fmt.Printf("\n%s", resp.Config)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"io"
 
 	"github.com/lightninglabs/pool/poolrpc"
 	"github.com/urfave/cli"
@@ -11,7 +12,8 @@ var getInfoCommand = cli.Command{
 	Name:  "getinfo",
 	Usage: "show info about the daemon's current state",
 	Description: "Displays basic info about the current state of the Pool " +
-		"trader daemon",
+		"trader daemon, its LSAT token, the connected lnd node and " +
+		"the auction server, including the versions both sides use",
 	Action: getInfo,
 }
 
@@ -33,3 +35,39 @@ func getInfo(ctx *cli.Context) error {
 
 	return nil
 }
+
+var serverStateCommand = cli.Command{
+	Name:  "serverstate",
+	Usage: "follow the state of the connection to the auction server",
+	Description: "Displays the current state of the connection to the " +
+		"auction server and then every change of it until " +
+		"interrupted",
+	Action: serverState,
+}
+
+func serverState(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.SubscribeServerState(
+		context.Background(), &poolrpc.SubscribeServerStateRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/urfave/cli"
+)
+
+var leasesCommands = []cli.Command{
+	{
+		Name:     "leases",
+		Usage:    "Export and dispute the leases of channels.",
+		Category: "Auction",
+		Subcommands: []cli.Command{
+			leaseEvidenceCommand,
+			leaseExportCommand,
+		},
+	},
+}
+
+var leaseEvidenceCommand = cli.Command{
+	Name:      "evidence",
+	Usage:     "collect the evidence of a lease channel closed early",
+	ArgsUsage: "channel_point",
+	Description: `
+	Collect everything needed to prove that the channel with the given
+	channel point was closed before its lease expired into a single
+	serialized proof that can be handed to the auctioneer operator. The
+	proof contains the lease terms, our snapshot of the batch the channel
+	was created in, our signed order and the channel's funding and closing
+	transactions.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "output",
+			Usage: "write the serialized proof to the given file " +
+				"instead of printing it hex encoded",
+		},
+	},
+	Action: leaseEvidence,
+}
+
+func leaseEvidence(ctx *cli.Context) error {
+	// Show help if no arguments are provided.
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, "evidence")
+		return nil
+	}
+
+	chanPoint, err := parseChanPoint(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.LeaseEvidence(
+		context.Background(), &poolrpc.LeaseEvidenceRequest{
+			ChannelPoint: chanPoint,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if ctx.IsSet("output") {
+		err := os.WriteFile(ctx.String("output"), resp.Evidence, 0600)
+		if err != nil {
+			return fmt.Errorf("unable to write evidence: %v", err)
+		}
+
+		fmt.Fprintf(humanOutput(), "Wrote version %d evidence to %v\n",
+			resp.Version, ctx.String("output"))
+		return nil
+	}
+
+	printResponse(resp, struct {
+		Version  uint32 `json:"version"`
+		Evidence string `json:"evidence"`
+	}{
+		Version:  resp.Version,
+		Evidence: hex.EncodeToString(resp.Evidence),
+	}, nil)
+
+	return nil
+}
+
+const (
+	// leaseExportPageSize is the number of leases that are requested at
+	// once when exporting all leases.
+	leaseExportPageSize = 500
+)
+
+// leaseExportColumns are the header columns of a CSV lease export.
+var leaseExportColumns = []string{
+	"batch_id", "batch_timestamp", "channel_point", "channel_amt_sat",
+	"channel_duration_blocks", "channel_state", "channel_node_key",
+	"purchased", "premium_sat", "execution_fee_sat", "chain_fee_sat",
+	"order_nonce", "matched_order_nonce",
+}
+
+var leaseExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "export all leases matching the given filters",
+	Description: `
+	Export all leases (i.e., channels) that were either purchased or sold
+	by the trader within the auction, ordered by the time of their batch.
+	With --csv, one line is written per lease that contains the premium,
+	execution fee and chain fee of the lease in satoshis, which can be
+	imported into accounting software. Otherwise the leases are printed in
+	the same format as the auction leases command.
+	`,
+	Flags: append(leaseFilterFlags,
+		cli.BoolFlag{
+			Name:  "csv",
+			Usage: "export the leases in the CSV format",
+		},
+		cli.StringFlag{
+			Name: "output",
+			Usage: "write the export to the given file instead of " +
+				"printing it",
+		},
+	),
+	Action: leaseExport,
+}
+
+func leaseExport(ctx *cli.Context) error {
+	req, err := parseLeasesRequest(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// Page through all leases, the stable ordering of the RPC makes sure
+	// we don't miss any.
+	var leases []*poolrpc.Lease
+	req.MaxNumLeases = leaseExportPageSize
+	for {
+		resp, err := client.Leases(context.Background(), req)
+		if err != nil {
+			return err
+		}
+		leases = append(leases, resp.Leases...)
+
+		req.IndexOffset += uint32(len(resp.Leases))
+		if len(resp.Leases) == 0 ||
+			req.IndexOffset >= resp.TotalNumLeases {
+
+			break
+		}
+	}
+
+	var out io.Writer = os.Stdout
+	if ctx.IsSet("output") {
+		f, err := os.OpenFile(
+			ctx.String("output"), os.O_CREATE|os.O_TRUNC|os.O_WRONLY,
+			0600,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to create export file: %v",
+				err)
+		}
+		defer f.Close()
+
+		out = f
+	}
+
+	if ctx.Bool("csv") {
+		if err := writeLeasesCSV(out, leases); err != nil {
+			return fmt.Errorf("unable to write export: %v", err)
+		}
+	} else {
+		displayLeases := make([]*Lease, 0, len(leases))
+		for _, lease := range leases {
+			displayLeases = append(
+				displayLeases, NewLeaseFromProto(lease),
+			)
+		}
+
+		b, err := json.MarshalIndent(struct {
+			Leases []*Lease `json:"leases"`
+		}{
+			Leases: displayLeases,
+		}, "", "\t")
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(out, string(b)); err != nil {
+			return fmt.Errorf("unable to write export: %v", err)
+		}
+	}
+
+	if ctx.IsSet("output") {
+		fmt.Fprintf(humanOutput(), "Exported %d leases to %v\n",
+			len(leases), ctx.String("output"))
+	}
+
+	return nil
+}
+
+// writeLeasesCSV writes the given leases to the given writer in the CSV
+// format, with one line per lease after the header line.
+func writeLeasesCSV(w io.Writer, leases []*poolrpc.Lease) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(leaseExportColumns); err != nil {
+		return err
+	}
+
+	for _, lease := range leases {
+		l := NewLeaseFromProto(lease)
+
+		var batchTimestamp string
+		if lease.BatchTimestampNs != 0 {
+			batchTimestamp = time.Unix(0, lease.BatchTimestampNs).
+				UTC().Format(time.RFC3339)
+		}
+
+		err := csvWriter.Write([]string{
+			l.BatchID, batchTimestamp, l.ChannelPoint,
+			strconv.FormatUint(l.ChannelAmtSat, 10),
+			strconv.FormatUint(uint64(l.ChannelDurationBlocks), 10),
+			l.ChannelState, l.ChannelRemoteNodeKey,
+			strconv.FormatBool(l.Purchased),
+			strconv.FormatUint(l.PremiumSat, 10),
+			strconv.FormatUint(l.ExecutionFeeSat, 10),
+			strconv.FormatUint(l.ChainFeeSat, 10),
+			l.OrderNonce, l.MatchedOrderNonce,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// parseChanPoint parses a channel point in the format txid:index.
+func parseChanPoint(chanPoint string) (*auctioneerrpc.OutPoint, error) {
+	parts := strings.Split(chanPoint, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid channel point %s, must be in "+
+			"the format txid:index", chanPoint)
+	}
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel point txid %s: %v",
+			parts[0], err)
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel point index %s: %v",
+			parts[1], err)
+	}
+
+	return &auctioneerrpc.OutPoint{
+		Txid:        hash[:],
+		OutputIndex: uint32(index),
+	}, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lightninglabs/pool/perms"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var bakeMacaroonCommand = cli.Command{
+	Name:      "bakemacaroon",
+	Usage:     "bake a new macaroon with custom permissions",
+	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] permissions...",
+	Description: fmt.Sprintf(`
+	Bake a new macaroon that only grants the given permissions and
+	optionally restrict it with an expiry and an IP address lock.
+
+	Permissions are given as a list of entity:action pairs. The read-only
+	permissions can be added with the shortcut 'readonly'.
+
+	Supported permissions: %s`, permissionList()),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "save the macaroon to this file instead of " +
+				"printing it hex encoded",
+		},
+		cli.Uint64Flag{
+			Name: "timeout",
+			Usage: "the number of seconds the macaroon is valid " +
+				"for",
+		},
+		cli.StringFlag{
+			Name:  "ip_address",
+			Usage: "the IP address the macaroon is bound to",
+		},
+		cli.Uint64Flag{
+			Name: "root_key_id",
+			Usage: "the numeric ID of the root key the macaroon " +
+				"is derived from",
+		},
+	},
+	Action: bakeMacaroon,
+}
+
+// permissionList returns all permissions that can be granted, formatted as
+// entity:action pairs.
+func permissionList() string {
+	allPerms := perms.AllPermissions()
+	names := make([]string, len(allPerms))
+	for idx, op := range allPerms {
+		names[idx] = fmt.Sprintf("%s:%s", op.Entity, op.Action)
+	}
+
+	return strings.Join(names, " ")
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return cli.ShowCommandHelp(ctx, "bakemacaroon")
+	}
+
+	var permissions []*poolrpc.MacaroonPermission
+	for _, arg := range ctx.Args() {
+		if arg == "readonly" {
+			for _, op := range perms.ReadOnlyPermissions() {
+				permissions = append(
+					permissions, &poolrpc.MacaroonPermission{
+						Entity: op.Entity,
+						Action: op.Action,
+					},
+				)
+			}
+
+			continue
+		}
+
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid permission %q, must be in "+
+				"the format entity:action", arg)
+		}
+
+		permissions = append(permissions, &poolrpc.MacaroonPermission{
+			Entity: parts[0],
+			Action: parts[1],
+		})
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.BakeMacaroon(
+		context.Background(), &poolrpc.BakeMacaroonRequest{
+			Permissions:    permissions,
+			RootKeyId:      ctx.Uint64("root_key_id"),
+			IpAddress:      ctx.String("ip_address"),
+			TimeoutSeconds: ctx.Uint64("timeout"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("save_to") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return fmt.Errorf("unable to decode macaroon: %v", err)
+	}
+
+	saveTo := lncfg.CleanAndExpandPath(ctx.String("save_to"))
+	if err := os.WriteFile(saveTo, macBytes, 0644); err != nil {
+		return fmt.Errorf("unable to save macaroon: %v", err)
+	}
+
+	fmt.Fprintf(humanOutput(), "Macaroon saved to %s\n", saveTo)
+
+	return nil
+}
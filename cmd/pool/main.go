@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -15,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool"
+	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
 	"github.com/lightninglabs/protobuf-hex-display/json"
 	"github.com/lightninglabs/protobuf-hex-display/jsonpb"
@@ -23,7 +25,9 @@ import (
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/urfave/cli"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 	"gopkg.in/macaroon.v2"
 )
 
@@ -57,6 +61,20 @@ var (
 		Usage: "path to macaroon file",
 		Value: pool.DefaultMacaroonPath,
 	}
+	noMacaroonsFlag = cli.BoolFlag{
+		Name: "no-macaroons",
+		Usage: "don't send a macaroon, only works if the daemon " +
+			"was started with --no-macaroons",
+	}
+	jsonFlag = cli.BoolFlag{
+		Name: "json",
+		Usage: "print the raw RPC responses for scripting; errors " +
+			"are printed as a JSON object with code and message",
+	}
+
+	// jsonOutput is set if machine-readable output was requested with the
+	// global --json flag.
+	jsonOutput bool
 )
 
 const (
@@ -76,6 +94,10 @@ func (e *invalidUsageError) Error() string {
 	return fmt.Sprintf("invalid usage of command %s", e.command)
 }
 
+// derivedKey is the key under which fields that were derived from a raw
+// response for display are added to it in machine-readable output.
+const derivedKey = "derived"
+
 func printJSON(resp interface{}) {
 	b, err := json.Marshal(resp)
 	if err != nil {
@@ -104,11 +126,88 @@ func printRespJSON(resp proto.Message) { // nolint
 	fmt.Println(jsonStr)
 }
 
-func fatal(err error) {
+// printResponse prints the response of a command. By default the given display
+// version of the response is printed. If machine-readable output was requested,
+// the raw proto response is printed instead. Any fields the display version
+// derives from the response are then added under a separate key, so they
+// aren't lost.
+func printResponse(resp proto.Message, display, derived interface{}) {
+	if !jsonOutput {
+		printJSON(display)
+		return
+	}
+
+	if derived == nil {
+		printRespJSON(resp)
+		return
+	}
+
+	jsonMarshaler := &jsonpb.Marshaler{
+		EmitDefaults: true,
+		OrigName:     true,
+	}
+	rawResp, err := jsonMarshaler.MarshalToString(resp)
+	if err != nil {
+		fatal(fmt.Errorf("unable to decode response: %v", err))
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(rawResp), &fields); err != nil {
+		fatal(err)
+	}
+	derivedFields, err := json.Marshal(derived)
+	if err != nil {
+		fatal(err)
+	}
+	fields[derivedKey] = derivedFields
+
+	printJSON(fields)
+}
+
+// humanOutput returns the writer that human-readable information, like fee
+// quotes and confirmation prompts, is written to. In machine-readable mode this
+// is stderr, so only JSON is ever written to stdout.
+func humanOutput() io.Writer {
+	if jsonOutput {
+		return os.Stderr
+	}
+
+	return os.Stdout
+}
+
+// printJSONError prints the given error as a JSON object with the gRPC status
+// code and message. Errors that didn't originate from the daemon are reported
+// with the code Unknown, invalid usage with the code InvalidArgument.
+func printJSONError(err error) {
+	code, msg := codes.Unknown, err.Error()
+	if s, ok := status.FromError(err); ok {
+		code, msg = s.Code(), s.Message()
+	}
+
 	var e *invalidUsageError
 	if errors.As(err, &e) {
+		code = codes.InvalidArgument
+	}
+
+	printJSON(struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    code.String(),
+		Message: msg,
+	})
+}
+
+func fatal(err error) {
+	var e *invalidUsageError
+	switch {
+	case jsonOutput:
+		printJSONError(err)
+
+	case errors.As(err, &e):
 		_ = cli.ShowCommandHelp(e.ctx, e.command)
-	} else {
+
+	default:
 		_, _ = fmt.Fprintf(os.Stderr, "[pool] %v\n", err)
 	}
 	os.Exit(1)
@@ -130,13 +229,24 @@ func main() {
 		baseDirFlag,
 		tlsCertFlag,
 		macaroonPathFlag,
+		noMacaroonsFlag,
+		jsonFlag,
+	}
+	app.Before = func(ctx *cli.Context) error {
+		jsonOutput = ctx.GlobalBool(jsonFlag.Name)
+		return nil
 	}
 	app.Commands = append(app.Commands, accountsCommands...)
 	app.Commands = append(app.Commands, ordersCommands...)
 	app.Commands = append(app.Commands, sidecarCommands...)
 	app.Commands = append(app.Commands, auctionCommands...)
+	app.Commands = append(app.Commands, leasesCommands...)
+	app.Commands = append(app.Commands, policyCommands...)
 	app.Commands = append(app.Commands, listAuthCommand)
+	app.Commands = append(app.Commands, authCommands...)
 	app.Commands = append(app.Commands, getInfoCommand)
+	app.Commands = append(app.Commands, serverStateCommand)
+	app.Commands = append(app.Commands, bakeMacaroonCommand)
 	app.Commands = append(app.Commands, debugCommands...)
 	app.Commands = append(app.Commands, stopDaemonCommand)
 
@@ -186,11 +296,11 @@ func getClient(ctx *cli.Context) (poolrpc.TraderClient, func(),
 }
 
 func parseAmt(text string) (btcutil.Amount, error) {
-	amtInt64, err := strconv.ParseInt(text, 10, 64)
+	amt, err := order.ParseAmount(text)
 	if err != nil {
 		return 0, fmt.Errorf("invalid amt value: %v", err)
 	}
-	return btcutil.Amount(amtInt64), nil
+	return amt, nil
 }
 
 // extractPathArgs parses the TLS certificate and macaroon paths from the
@@ -222,12 +332,23 @@ func extractPathArgs(ctx *cli.Context) (string, string, error) {
 	// directory set. This allows us to set a custom base directory, along
 	// with custom paths to the TLS cert and macaroon file.
 	if baseDir != pool.DefaultBaseDir || networkStr != pool.DefaultNetwork {
-		tlsCertPath = filepath.Join(
-			baseDir, networkStr, pool.DefaultTLSCertFilename,
-		)
-		macPath = filepath.Join(
-			baseDir, networkStr, pool.DefaultMacaroonFilename,
-		)
+		if !ctx.GlobalIsSet(tlsCertFlag.Name) {
+			tlsCertPath = filepath.Join(
+				baseDir, networkStr,
+				pool.DefaultTLSCertFilename,
+			)
+		}
+		if !ctx.GlobalIsSet(macaroonPathFlag.Name) {
+			macPath = filepath.Join(
+				baseDir, networkStr,
+				pool.DefaultMacaroonFilename,
+			)
+		}
+	}
+
+	// Without macaroons, there's no macaroon file to read.
+	if ctx.GlobalBool(noMacaroonsFlag.Name) {
+		macPath = ""
 	}
 
 	return tlsCertPath, macPath, nil
@@ -236,15 +357,17 @@ func extractPathArgs(ctx *cli.Context) (string, string, error) {
 func getClientConn(address, tlsCertPath, macaroonPath string) (*grpc.ClientConn,
 	error) {
 
-	// We always need to send a macaroon.
-	macOption, err := readMacaroon(macaroonPath)
-	if err != nil {
-		return nil, err
-	}
-
 	opts := []grpc.DialOption{
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
-		macOption,
+	}
+
+	// We need to send a macaroon unless macaroons are disabled.
+	if macaroonPath != "" {
+		macOption, err := readMacaroon(macaroonPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, macOption)
 	}
 
 	// TLS cannot be disabled, we'll always have a cert file to read.
@@ -293,6 +416,23 @@ func parseUint64(ctx *cli.Context, argIdx int, flag, cmd string) (uint64, error)
 	return strconv.ParseUint(str, 10, 64)
 }
 
+// parseAmtArg parses an amount in satoshis from the given flag or positional
+// argument. The amount can have a unit suffix like "btc" or "sat".
+func parseAmtArg(ctx *cli.Context, argIdx int, flag, cmd string) (uint64,
+	error) {
+
+	str, err := parseStr(ctx, argIdx, flag, cmd)
+	if err != nil {
+		return 0, err
+	}
+
+	amt, err := parseAmt(str)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(amt), nil
+}
+
 // readMacaroon tries to read the macaroon file at the specified path and create
 // gRPC dial options from it.
 func readMacaroon(macPath string) (grpc.DialOption, error) {
@@ -2,11 +2,13 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/auctioneer"
@@ -29,6 +31,10 @@ const (
 // Default max batch fee rate to 100 sat/vByte.
 const defaultMaxBatchFeeRateSatPerVByte = 100
 
+// defaultPremiumWarnPercent is the default percentage of the order amount
+// above which the premium of a new order needs to be confirmed separately.
+const defaultPremiumWarnPercent = 5
+
 var ordersCommands = []cli.Command{
 	{
 		Name:     "orders",
@@ -38,6 +44,12 @@ var ordersCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			ordersListCommand,
 			ordersCancelCommand,
+			ordersActivateCommand,
+			ordersUpdateCommand,
+			ordersPruneCommand,
+			ordersStatsCommand,
+			ordersSimulateCommand,
+			ordersTemplateCommand,
 			{
 				Name:    "submit",
 				Aliases: []string{"s"},
@@ -47,6 +59,7 @@ var ordersCommands = []cli.Command{
 					ordersSubmitBidCommand,
 				},
 			},
+			ordersSubmitSignedCommand,
 		},
 	},
 }
@@ -60,15 +73,17 @@ var baseBidFlags = []cli.Flag{
 		Usage: "the total percent one is willing to pay or " +
 			"accept as yield for the specified interval",
 	},
-	cli.Uint64Flag{
+	aprFlag,
+	cli.StringFlag{
 		Name: "amt",
-		Usage: "the amount of inbound liquidity in satoshis " +
-			"to request",
+		Usage: "the amount of inbound liquidity to request, in " +
+			"satoshis or with a unit like 0.5btc",
 	},
 	cli.StringFlag{
 		Name: "acct_key",
 		Usage: "the account key to use to pay the order " +
-			"fees with",
+			"fees with; if not set, the account with the " +
+			"furthest expiry and enough free balance is used",
 	},
 	cli.Uint64Flag{
 		Name: "lease_duration_blocks",
@@ -84,24 +99,35 @@ var baseBidFlags = []cli.Flag{
 			"considered regardless of 'quality'",
 		Value: uint64(order.NodeTierDefault),
 	},
-	cli.Uint64Flag{
+	cli.StringFlag{
 		Name: "min_chan_amt",
 		Usage: "the minimum amount of satoshis that a " +
 			"resulting channel from this order must have",
 	},
 	cli.BoolFlag{
-		Name:  "force",
-		Usage: "skip order placement confirmation",
+		Name: "force",
+		Usage: "skip order placement confirmation and the " +
+			"premium sanity check",
 	},
-	cli.Uint64Flag{
+	cli.StringFlag{
 		Name: "self_chan_balance",
 		Usage: "give the channel leased by this bid order an " +
 			"initial balance by adding additional funds " +
-			"from our account into the channel; can be " +
-			"used to create up to 50/50 balanced channels",
+			"from our account into the channel; the maximum " +
+			"balance relative to the order amount is set by " +
+			"the auctioneer",
 	},
 }
 
+// aprFlag is the flag to set the rate of an order as an annualized
+// percentage.
+var aprFlag = cli.StringFlag{
+	Name: "apr",
+	Usage: "the rate one is willing to pay or accept as an " +
+		"annualized percentage, e.g. 3.5%; cannot be used " +
+		"together with interest_rate_percent",
+}
+
 var sharedFlags = []cli.Flag{
 	cli.Uint64Flag{
 		Name: "max_batch_fee_rate",
@@ -116,20 +142,140 @@ var sharedFlags = []cli.Flag{
 			channelTypePeerDependent,
 			channelTypeScriptEnforced),
 	},
+	cli.BoolFlag{
+		Name: "unannounced",
+		Usage: "only match with orders that also request " +
+			"unannounced channels; the resulting channels are " +
+			"not announced to the network",
+	},
+	cli.BoolFlag{
+		Name: "zero_conf",
+		Usage: "for bids, request channels that are usable " +
+			"before the batch transaction confirms; for asks, " +
+			"opt in to open such zero conf channels",
+	},
 	cli.StringSliceFlag{
-		Name: "allowed_node_id",
+		Name: "allowed_node_id, allowed_id",
 		Usage: "the list of nodes this order is allowed to match " +
 			"with; if empty, the order will be able to match " +
 			"with any node unless not_allowed_node_id is set. " +
 			"Can be specified multiple times",
 	},
 	cli.StringSliceFlag{
-		Name: "not_allowed_node_id",
+		Name: "not_allowed_node_id, not_allowed_id",
 		Usage: "the list of nodes this order is not allowed to match " +
 			"with; if empty, the order will be able to match " +
 			"with any node unless allowed_node_id is set. Can be " +
 			"specified multiple times",
 	},
+	cli.StringFlag{
+		Name: "label",
+		Usage: "an optional label to identify the order with; the " +
+			"label is only stored locally and can be at most " +
+			"500 bytes long",
+	},
+	cli.Uint64Flag{
+		Name: "max_chain_fee",
+		Usage: "the maximum share of a batch transaction's chain " +
+			"fee in satoshis the order is willing to pay; " +
+			"batches exceeding it are rejected. If zero, only " +
+			"max_batch_fee_rate applies",
+	},
+	cli.BoolFlag{
+		Name: "auto_update_fee_rate",
+		Usage: "replace the order with one with a higher " +
+			"max_batch_fee_rate if a batch is rejected because " +
+			"its fee rate is too high; the new rate is the " +
+			"current fee estimate of lnd, capped at the maximum " +
+			"configured in poold",
+	},
+	cli.Uint64Flag{
+		Name: "expiry_height",
+		Usage: "cancel the order automatically once the chain " +
+			"reaches this block height",
+	},
+	cli.DurationFlag{
+		Name: "expiry",
+		Usage: "cancel the order automatically after this " +
+			"duration, for example 72h",
+	},
+	cli.Float64Flag{
+		Name: "premium_warn_percent",
+		Usage: "ask for an additional confirmation if the " +
+			"total premium exceeds this percentage of the " +
+			"order amount",
+		Value: defaultPremiumWarnPercent,
+	},
+	cli.BoolFlag{
+		Name: "stage",
+		Usage: "only store the order locally without sending it " +
+			"to the auctioneer; staged orders are activated " +
+			"with 'pool orders activate' or automatically " +
+			"once activation_fee_rate is reached",
+	},
+	cli.Uint64Flag{
+		Name: "activation_fee_rate",
+		Usage: "with --stage, automatically activate the order " +
+			"once the estimated chain fee rate (sat/vByte) " +
+			"falls to or below this value",
+	},
+	cli.BoolFlag{
+		Name: "allow_duplicate",
+		Usage: "submit the order even if another active order " +
+			"with the same type, amount, rate, lease duration " +
+			"and account was submitted recently",
+	},
+}
+
+// templateFlags is the set of flags of the order submit commands that deal
+// with order templates and recurring orders.
+var templateFlags = []cli.Flag{
+	cli.Uint64Flag{
+		Name: "recurrences",
+		Usage: "resubmit the order with the same parameters from " +
+			"the same account up to this many times once it is " +
+			"fully executed; resubmission stops early if the " +
+			"account's free balance falls below the minimum " +
+			"order size",
+	},
+	cli.StringFlag{
+		Name: "template",
+		Usage: "create the order from the template with this name; " +
+			"only the amount, account key, max_batch_fee_rate " +
+			"and label are read from the command line, all " +
+			"other order parameters are taken from the template",
+	},
+	cli.StringFlag{
+		Name: "save_template",
+		Usage: "save the parameters of the order except its " +
+			"amount and account as a template with this name " +
+			"before submitting it, replacing any existing " +
+			"template of the same name",
+	},
+}
+
+// offlineFlag is the flag of the order submit commands that only prepares an
+// order so it can be signed with a trader key the daemon doesn't have access
+// to.
+var offlineFlag = cli.BoolFlag{
+	Name: "offline",
+	Usage: "don't sign and submit the order but only prepare it; " +
+		"the digest of the prepared order must be signed with " +
+		"the trader key using pool-sign and the order then " +
+		"submitted with 'pool orders submitsigned'",
+}
+
+// templateParamFlags is the list of order submit flags that set order
+// parameters which are part of a template and therefore cannot be used when
+// submitting an order from a template.
+var templateParamFlags = []string{
+	"interest_rate_percent", "apr", "lease_duration_blocks", "min_chan_amt",
+	"min_distinct_peers", "min_node_tier", "self_chan_balance",
+	"sidecar_ticket", "channel_type", "allowed_node_id",
+	"not_allowed_node_id", "max_chain_fee", "save_template", "recurrences",
+	"new_nodes_only", "stage", "activation_fee_rate", "expiry_height",
+	"expiry", "unannounced", "zero_conf", "auto_update_fee_rate",
+	"offline",
 }
 
 // promptForConfirmation continuously prompts the user for the message until
@@ -138,7 +284,7 @@ func promptForConfirmation(msg string) bool {
 	reader := bufio.NewReader(os.Stdin)
 
 	for {
-		fmt.Print(msg)
+		fmt.Fprint(humanOutput(), msg)
 
 		answer, err := reader.ReadString('\n')
 		if err != nil {
@@ -170,7 +316,11 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 
 	switch {
 	case ctx.IsSet("amt"):
-		params.Amt = ctx.Uint64("amt")
+		amt, err := parseAmtFlag(ctx, "amt")
+		if err != nil {
+			return nil, err
+		}
+		params.Amt = uint64(amt)
 	case args.Present():
 		amt, err := parseAmt(args.First())
 		if err != nil {
@@ -182,7 +332,10 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 
 	// If the minimum channel amount flag wasn't provided, use a default of
 	// 10% and round to the nearest unit.
-	minChanAmt := btcutil.Amount(ctx.Uint64("min_chan_amt"))
+	minChanAmt, err := parseAmtFlag(ctx, "min_chan_amt")
+	if err != nil {
+		return nil, err
+	}
 	if minChanAmt == 0 {
 		minChanAmt = order.RoundToNextSupplyUnit(
 			btcutil.Amount(params.Amt) / 10,
@@ -205,51 +358,26 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 	}
 	params.MinUnitsMatch = uint32(minChanAmt / order.BaseSupplyUnit)
 
-	var err error
-	params.TraderKey, err = parseAccountKey(ctx, args)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse acct_key: %v", err)
-	}
-
-	// Convert the cmd line flag from sat/vByte to sat/kw which is used
-	// internally.
-	satPerByte := ctx.Uint64("max_batch_fee_rate")
-	if satPerByte == 0 {
-		return nil, fmt.Errorf("max batch fee rate must be at " +
-			"least 1 sat/vByte")
+	// The account key is optional, the daemon selects an account with
+	// enough free balance if none is given.
+	if ctx.IsSet("acct_key") || args.Present() {
+		var err error
+		params.TraderKey, err = parseAccountKey(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse acct_key: %v",
+				err)
+		}
 	}
 
-	satPerKw := chainfee.SatPerKVByte(satPerByte * 1000).FeePerKWeight()
-
-	// Because of rounding, we ensure the set rate is at least our fee
-	// floor.
-	if satPerKw < chainfee.FeePerKwFloor {
-		satPerKw = chainfee.FeePerKwFloor
+	satPerKw, err := parseMaxBatchFeeRate(ctx)
+	if err != nil {
+		return nil, err
 	}
-
 	params.MaxBatchFeeRateSatPerKw = uint64(satPerKw)
 
-	// We'll map the interest rate specified on the command line to our
-	// internal "rate_fixed" unit.
-	//
-	// rate = % / 100
-	// rate = rateFixed / totalParts
-	// rateFixed = rate * totalParts
-	interestPercent := ctx.Float64("interest_rate_percent")
-	interestRate := interestPercent / 100
-	rateFixedFloat := interestRate * order.FeeRateTotalParts
-
-	// We then take this rate fixed, and divide it by the number of blocks
-	// as the user wants this rate to be the final lump sum they pay.
-	rateFixed := uint32(rateFixedFloat / float64(blockDuration))
-
-	// At this point, if this value is less than 1, then we aren't able to
-	// express it given the current precision allowed by our fixed point.
-	if rateFixed < 1 {
-		return nil, fmt.Errorf("fixed rate of %v is too small "+
-			"(%v%% over %v blocks), min is 1 (%v%%)", rateFixed,
-			interestPercent, blockDuration,
-			float64(1)/order.FeeRateTotalParts)
+	rateFixed, err := parseRateFlags(ctx, blockDuration)
+	if err != nil {
+		return nil, err
 	}
 
 	params.RateFixed = rateFixed
@@ -269,6 +397,8 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 	default:
 		return nil, fmt.Errorf("unknown channel type %q", channelType)
 	}
+	params.UnannouncedChannel = ctx.Bool("unannounced")
+	params.ZeroConfChannel = ctx.Bool("zero_conf")
 
 	// Get the list of node ids this order is allowed/not allowed to match
 	// with.
@@ -295,10 +425,154 @@ func parseCommonParams(ctx *cli.Context, blockDuration uint32) (*poolrpc.Order,
 
 	params.AllowedNodeIds = allowedNodeIDs
 	params.NotAllowedNodeIds = notAllowedNodeIDs
+	params.Label = ctx.String("label")
+	params.MaxChainFeeSat = ctx.Uint64("max_chain_fee")
+	params.AutoUpdateFeeRate = ctx.Bool("auto_update_fee_rate")
+	params.Recurrences = uint32(ctx.Uint64("recurrences"))
+	params.ExpiryHeight = uint32(ctx.Uint64("expiry_height"))
+	if ctx.IsSet("expiry") {
+		expiry := time.Now().Add(ctx.Duration("expiry"))
+		params.ExpiryTimestamp = expiry.Unix()
+	}
 
 	return params, nil
 }
 
+// parseAmtFlag parses the amount of the given flag, which can have a unit
+// suffix like "btc" or "sat". Zero is returned if the flag isn't set.
+func parseAmtFlag(ctx *cli.Context, flag string) (btcutil.Amount, error) {
+	if !ctx.IsSet(flag) {
+		return 0, nil
+	}
+
+	amt, err := parseAmt(ctx.String(flag))
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse %s: %v", flag, err)
+	}
+
+	return amt, nil
+}
+
+// parseRateFlags reads the rate of an order for the given lease duration from
+// either the interest_rate_percent or the apr flag and converts it to our
+// internal "rate_fixed" unit. An annualized rate is converted back and printed
+// so the user can verify the conversion.
+func parseRateFlags(ctx *cli.Context, blockDuration uint32) (uint32, error) {
+	if !ctx.IsSet("apr") {
+		return parseFixedRate(
+			ctx.Float64("interest_rate_percent"), blockDuration,
+		)
+	}
+
+	if ctx.IsSet("interest_rate_percent") {
+		return 0, fmt.Errorf("apr and interest_rate_percent cannot " +
+			"be set together")
+	}
+
+	apr, err := order.ParsePercent(ctx.String("apr"))
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse apr: %v", err)
+	}
+	rate, err := order.NewFixedRateFromAPR(apr)
+	if err != nil {
+		return 0, err
+	}
+
+	fmt.Fprintf(humanOutput(), "Converted %v%% APR to a fixed rate of %d "+
+		"(%.4f%% APR, %.4f%% over %d blocks)\n", apr, rate, rate.APR(),
+		rate.InterestPercent(blockDuration), blockDuration)
+
+	return uint32(rate), nil
+}
+
+// parseFixedRate maps the interest rate percentage specified on the command
+// line to our internal "rate_fixed" unit for the given lease duration.
+func parseFixedRate(interestPercent float64, blockDuration uint32) (uint32,
+	error) {
+
+	rate, err := order.NewFixedRateFromPercent(
+		interestPercent, blockDuration,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(rate), nil
+}
+
+// confirmPremium asks for an additional confirmation if the total premium of
+// an order exceeds the percentage of the order amount given with the
+// premium_warn_percent flag. Such a premium is most likely caused by a rate
+// that was entered in the wrong unit.
+func confirmPremium(ctx *cli.Context, amt btcutil.Amount,
+	rate order.FixedRatePremium, leaseDuration uint32) bool {
+
+	premium := rate.LumpSumPremium(amt, leaseDuration)
+	if amt == 0 || premium == 0 {
+		return true
+	}
+
+	premiumPercent := float64(premium) / float64(amt) * 100
+	warnPercent := ctx.Float64("premium_warn_percent")
+	if premiumPercent <= warnPercent {
+		return true
+	}
+
+	fmt.Fprintf(humanOutput(), "WARNING: the total premium of %v is "+
+		"%.2f%% of the order amount of %v, which is more than %v%%\n",
+		premium, premiumPercent, amt, warnPercent)
+
+	return promptForConfirmation("Is this premium intended (yes/no): ")
+}
+
+// parseMaxBatchFeeRate reads the max batch fee rate flag and converts it from
+// sat/vByte to sat/kw which is used internally.
+func parseMaxBatchFeeRate(ctx *cli.Context) (chainfee.SatPerKWeight, error) {
+	satPerByte := ctx.Uint64("max_batch_fee_rate")
+	if satPerByte == 0 {
+		return 0, fmt.Errorf("max batch fee rate must be at " +
+			"least 1 sat/vByte")
+	}
+
+	satPerKw := chainfee.SatPerKVByte(satPerByte * 1000).FeePerKWeight()
+
+	// Because of rounding, we ensure the set rate is at least our fee
+	// floor.
+	if satPerKw < chainfee.FeePerKwFloor {
+		satPerKw = chainfee.FeePerKwFloor
+	}
+
+	return satPerKw, nil
+}
+
+// parseActivationFeeRate reads the activation fee rate flag of a staged order
+// and converts it from sat/vByte to sat/kw which is used internally.
+func parseActivationFeeRate(ctx *cli.Context) (uint64, error) {
+	if !ctx.IsSet("activation_fee_rate") {
+		return 0, nil
+	}
+	if !ctx.Bool("stage") {
+		return 0, fmt.Errorf("activation_fee_rate can only be used " +
+			"together with stage")
+	}
+
+	satPerByte := ctx.Uint64("activation_fee_rate")
+	if satPerByte == 0 {
+		return 0, fmt.Errorf("activation fee rate must be at " +
+			"least 1 sat/vByte")
+	}
+
+	satPerKw := chainfee.SatPerKVByte(satPerByte * 1000).FeePerKWeight()
+
+	// Because of rounding, we ensure the set rate is at least our fee
+	// floor.
+	if satPerKw < chainfee.FeePerKwFloor {
+		satPerKw = chainfee.FeePerKwFloor
+	}
+
+	return uint64(satPerKw), nil
+}
+
 // parseAccountKey tries to read the account key parameter from the command
 // line positional arguments and/or flags.
 func parseAccountKey(ctx *cli.Context, args cli.Args) ([]byte, error) {
@@ -344,7 +618,7 @@ func parseNodePubKeySlice(ctx *cli.Context, key string) ([][]byte, error) {
 var ordersSubmitAskCommand = cli.Command{
 	Name:  "ask",
 	Usage: "offer channel liquidity",
-	ArgsUsage: "amt acct_key [--interest_rate_percent=R] " +
+	ArgsUsage: "amt [acct_key] [--interest_rate_percent=R] " +
 		"[--max_batch_fee_rate=F] [--lease_duration_blocks=M]",
 	Description: `
 	Create an offer to provide inbound liquidity to an auction participant
@@ -355,15 +629,18 @@ var ordersSubmitAskCommand = cli.Command{
 			Usage: "the total percent one is willing to pay or " +
 				"accept as yield for the specified interval",
 		},
-		cli.Uint64Flag{
+		aprFlag,
+		cli.StringFlag{
 			Name: "amt",
-			Usage: "the amount to offer for channel creation in " +
-				"satoshis",
+			Usage: "the amount to offer for channel creation, in " +
+				"satoshis or with a unit like 0.5btc",
 		},
 		cli.StringFlag{
 			Name: "acct_key",
 			Usage: "the account key to use to offer " +
-				"liquidity from",
+				"liquidity from; if not set, the account " +
+				"with the furthest expiry and enough free " +
+				"balance is used",
 		},
 		cli.Uint64Flag{
 			Name: "lease_duration_blocks",
@@ -371,16 +648,23 @@ var ordersSubmitAskCommand = cli.Command{
 				"liquidity should be offered for",
 			Value: defaultAskMaxDuration,
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name: "min_chan_amt",
 			Usage: "the minimum amount of satoshis that a " +
 				"resulting channel from this order must have",
 		},
+		cli.Uint64Flag{
+			Name: "min_distinct_peers",
+			Usage: "the minimum number of distinct nodes the " +
+				"order must be matched with in a single batch",
+		},
 		cli.BoolFlag{
-			Name:  "force",
-			Usage: "skip order placement confirmation",
+			Name: "force",
+			Usage: "skip order placement confirmation and the " +
+				"premium sanity check",
 		},
-	}, sharedFlags...),
+		offlineFlag,
+	}, append(sharedFlags, templateFlags...)...),
 	Action: ordersSubmitAsk,
 }
 
@@ -391,9 +675,21 @@ func ordersSubmitAsk(ctx *cli.Context) error { // nolint: dupl
 		return nil
 	}
 
+	if ctx.IsSet("template") {
+		return ordersSubmitFromTemplate(ctx, true)
+	}
+
 	ask := &poolrpc.Ask{
 		LeaseDurationBlocks: uint32(ctx.Uint64("lease_duration_blocks")),
 		Version:             uint32(order.VersionChannelType),
+		MinDistinctPeers:    uint32(ctx.Uint64("min_distinct_peers")),
+	}
+
+	// Only use the newer order version if the feature is requested, so
+	// asks without it are still accepted by auctioneers that don't know
+	// about the new version yet.
+	if ask.MinDistinctPeers > 0 {
+		ask.Version = uint32(order.VersionMinDistinctPeers)
 	}
 
 	params, err := parseCommonParams(ctx, ask.LeaseDurationBlocks)
@@ -402,6 +698,13 @@ func ordersSubmitAsk(ctx *cli.Context) error { // nolint: dupl
 	}
 
 	ask.Details = params
+	switch {
+	case params.ZeroConfChannel:
+		ask.Version = uint32(order.VersionZeroConfChannel)
+
+	case params.UnannouncedChannel:
+		ask.Version = uint32(order.VersionUnannouncedChannel)
+	}
 
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
@@ -425,20 +728,50 @@ func ordersSubmitAsk(ctx *cli.Context) error { // nolint: dupl
 			return fmt.Errorf("unable to print order details: %v", err)
 		}
 
-		if !promptForConfirmation("Confirm order (yes/no): ") {
-			fmt.Println("Cancelling order...")
+		if !confirmPremium(
+			ctx, btcutil.Amount(ask.Details.Amt),
+			order.FixedRatePremium(ask.Details.RateFixed),
+			ask.LeaseDurationBlocks,
+		) || !promptForConfirmation("Confirm order (yes/no): ") {
+			fmt.Fprintln(humanOutput(), "Cancelling order...")
 			return nil
 		}
 	}
 
-	resp, err := client.SubmitOrder(
-		context.Background(), &poolrpc.SubmitOrderRequest{
-			Details: &poolrpc.SubmitOrderRequest_Ask{
-				Ask: ask,
+	if ctx.IsSet("save_template") {
+		_, err := client.SaveOrderTemplate(
+			context.Background(), &poolrpc.SaveOrderTemplateRequest{
+				Name: ctx.String("save_template"),
+				Details: &poolrpc.SaveOrderTemplateRequest_Ask{
+					Ask: ask,
+				},
 			},
-			Initiator: defaultInitiator,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to save order template: %v",
+				err)
+		}
+	}
+
+	activationFeeRate, err := parseActivationFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.SubmitOrderRequest{
+		Details: &poolrpc.SubmitOrderRequest_Ask{
+			Ask: ask,
 		},
-	)
+		Initiator:                 defaultInitiator,
+		Stage:                     ctx.Bool("stage"),
+		ActivationFeeRateSatPerKw: activationFeeRate,
+		Force:                     ctx.Bool("allow_duplicate"),
+	}
+	if ctx.Bool("offline") {
+		return prepareOfflineOrder(client, req)
+	}
+
+	resp, err := client.SubmitOrder(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -453,6 +786,8 @@ func printOrderDetails(client poolrpc.TraderClient, amt btcutil.Amount,
 	maxBatchFeeRate chainfee.SatPerKWeight, isAsk bool,
 	sidecarTicket *sidecar.Ticket) error {
 
+	w := humanOutput()
+
 	quote, err := client.QuoteOrder(
 		context.Background(), &poolrpc.QuoteOrderRequest{
 			Amt:                     uint64(amt),
@@ -473,28 +808,30 @@ func printOrderDetails(client poolrpc.TraderClient, amt btcutil.Amount,
 		premiumDescription = "yield from taker"
 	}
 
-	fmt.Println("-- Order Details --")
-	fmt.Printf("%v Amount: %v\n", orderType, amt)
-	fmt.Printf("%v Duration: %v\n", orderType, leaseDuration)
-	fmt.Printf("Total Premium (%v): %v \n", premiumDescription,
+	fmt.Fprintln(w, "-- Order Details --")
+	fmt.Fprintf(w, "%v Amount: %v\n", orderType, amt)
+	fmt.Fprintf(w, "%v Duration: %v\n", orderType, leaseDuration)
+	fmt.Fprintf(w, "Total Premium (%v): %v \n", premiumDescription,
 		btcutil.Amount(quote.TotalPremiumSat))
-	fmt.Printf("Rate Fixed: %v\n", rate)
-	fmt.Printf("Rate Per Block: %.9f (%.7f%%)\n", quote.RatePerBlock,
+	fmt.Fprintf(w, "Rate Fixed: %v\n", rate)
+	fmt.Fprintf(w, "Rate Per Block: %.9f (%.7f%%)\n", quote.RatePerBlock,
 		quote.RatePercent)
-	fmt.Println("Execution Fee: ",
+	fmt.Fprintf(w, "Annualized Rate: %.4f%%\n", quote.RateAnnualizedPercent)
+	fmt.Fprintln(w, "Execution Fee: ",
 		btcutil.Amount(quote.TotalExecutionFeeSat))
-	fmt.Printf("Max batch fee rate: %d sat/vByte\n",
+	fmt.Fprintf(w, "Max batch fee rate: %d sat/vByte\n",
 		maxBatchFeeRate.FeePerKVByte()/1000)
-	fmt.Println("Max chain fee:",
+	fmt.Fprintln(w, "Max chain fee:",
 		btcutil.Amount(quote.WorstCaseChainFeeSat))
 
 	if selfChanBalance > 0 {
-		fmt.Printf("Self channel balance: %v\n", selfChanBalance)
+		fmt.Fprintf(w, "Self channel balance: %v\n",
+			selfChanBalance)
 	}
 
 	if sidecarTicket != nil {
-		fmt.Println("Sidecar order: ")
-		fmt.Printf("  Recipient node: %x\n",
+		fmt.Fprintln(w, "Sidecar order: ")
+		fmt.Fprintf(w, "  Recipient node: %x\n",
 			sidecarTicket.Recipient.NodePubKey.SerializeCompressed())
 	}
 
@@ -504,7 +841,7 @@ func printOrderDetails(client poolrpc.TraderClient, amt btcutil.Amount,
 var ordersSubmitBidCommand = cli.Command{
 	Name:  "bid",
 	Usage: "obtain channel liquidity",
-	ArgsUsage: "amt acct_key [--interest_rate_percent=R]" +
+	ArgsUsage: "amt [acct_key] [--interest_rate_percent=R]" +
 		"[--max_batch_fee_rate=F] [--lease_duration_blocks=M]",
 	Description: `
 	Place an offer for acquiring inbound liquidity by lending
@@ -523,7 +860,14 @@ var ordersSubmitBidCommand = cli.Command{
 					"amt, min_chan_amt, lease_duration_blocks " +
 					"and self_chan_balance fields",
 			},
-		), sharedFlags...,
+			cli.BoolFlag{
+				Name: "new_nodes_only",
+				Usage: "only match this bid with asks of " +
+					"nodes the connected lnd node " +
+					"doesn't have any channel with yet",
+			},
+			offlineFlag,
+		), append(sharedFlags, templateFlags...)...,
 	),
 	Action: ordersSubmitBid,
 }
@@ -551,6 +895,7 @@ func parseBaseBid(ctx *cli.Context) (*poolrpc.Bid, *sidecar.Ticket, error) {
 		LeaseDurationBlocks: uint32(ctx.Uint64("lease_duration_blocks")),
 		Version:             uint32(order.VersionChannelType),
 		MinNodeTier:         nodeTier,
+		NewNodesOnly:        ctx.Bool("new_nodes_only"),
 	}
 
 	// Let's find out if this is an order for a sidecar channel because if
@@ -604,21 +949,24 @@ func parseBaseBid(ctx *cli.Context) (*poolrpc.Bid, *sidecar.Ticket, error) {
 	}
 
 	bid.Details = params
+	switch {
+	case params.ZeroConfChannel:
+		bid.Version = uint32(order.VersionZeroConfChannel)
+
+	case params.UnannouncedChannel:
+		bid.Version = uint32(order.VersionUnannouncedChannel)
+	}
 
-	// Make sure the self channel balance is within reasonable limits
-	// (currently max the same of the total order amount) and that the min
-	// units matched is also set to 100% of the order amount.
+	// Make sure the min units matched is set to 100% of the order amount
+	// when using a self channel balance. The maximum self channel balance
+	// depends on the auctioneer's terms and is validated by the daemon.
 	if ctx.IsSet("self_chan_balance") {
-		bid.SelfChanBalance = ctx.Uint64("self_chan_balance")
-		bidAmt := btcutil.Amount(bid.Details.Amt)
-		err := sidecar.CheckOfferParams(
-			bidAmt, btcutil.Amount(bid.SelfChanBalance),
-			order.BaseSupplyUnit,
-		)
+		selfChanBalance, err := parseAmtFlag(ctx, "self_chan_balance")
 		if err != nil {
 			return nil, nil, err
 		}
-
+		bid.SelfChanBalance = uint64(selfChanBalance)
+		bidAmt := btcutil.Amount(bid.Details.Amt)
 		bidUnits := order.NewSupplyFromSats(bidAmt)
 		if bid.Details.MinUnitsMatch != uint32(bidUnits) {
 			return nil, nil, fmt.Errorf("when using self_chan_balance the " +
@@ -637,6 +985,10 @@ func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 		return nil
 	}
 
+	if ctx.IsSet("template") {
+		return ordersSubmitFromTemplate(ctx, false)
+	}
+
 	bid, ticket, err := parseBaseBid(ctx)
 	if err != nil {
 		return err
@@ -665,20 +1017,50 @@ func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 			return fmt.Errorf("unable to print order details: %v", err)
 		}
 
-		if !promptForConfirmation("Confirm order (yes/no): ") {
-			fmt.Println("Cancelling order...")
+		if !confirmPremium(
+			ctx, btcutil.Amount(bid.Details.Amt),
+			order.FixedRatePremium(bid.Details.RateFixed),
+			bid.LeaseDurationBlocks,
+		) || !promptForConfirmation("Confirm order (yes/no): ") {
+			fmt.Fprintln(humanOutput(), "Cancelling order...")
 			return nil
 		}
 	}
 
-	resp, err := client.SubmitOrder(
-		context.Background(), &poolrpc.SubmitOrderRequest{
-			Details: &poolrpc.SubmitOrderRequest_Bid{
-				Bid: bid,
+	if ctx.IsSet("save_template") {
+		_, err := client.SaveOrderTemplate(
+			context.Background(), &poolrpc.SaveOrderTemplateRequest{
+				Name: ctx.String("save_template"),
+				Details: &poolrpc.SaveOrderTemplateRequest_Bid{
+					Bid: bid,
+				},
 			},
-			Initiator: defaultInitiator,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to save order template: %v",
+				err)
+		}
+	}
+
+	activationFeeRate, err := parseActivationFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.SubmitOrderRequest{
+		Details: &poolrpc.SubmitOrderRequest_Bid{
+			Bid: bid,
 		},
-	)
+		Initiator:                 defaultInitiator,
+		Stage:                     ctx.Bool("stage"),
+		ActivationFeeRateSatPerKw: activationFeeRate,
+		Force:                     ctx.Bool("allow_duplicate"),
+	}
+	if ctx.Bool("offline") {
+		return prepareOfflineOrder(client, req)
+	}
+
+	resp, err := client.SubmitOrder(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -688,91 +1070,82 @@ func ordersSubmitBid(ctx *cli.Context) error { // nolint: dupl
 	return nil
 }
 
-var ordersListCommand = cli.Command{
-	Name:    "list",
-	Aliases: []string{"l"},
-	Usage:   "list all existing orders",
-	Description: `
-	List all orders that are stored in the local order database`,
-	Flags: []cli.Flag{
-		cli.BoolFlag{
-			Name:  "verbose",
-			Usage: "show verbose output including events",
-		},
-		cli.BoolFlag{
-			Name:  "show_archived",
-			Usage: "include orders no longer active",
-		},
-	},
-	Action: ordersList,
+// preparedOrder is the hex printable version of an order that was prepared to
+// be signed offline.
+type preparedOrder struct {
+	OrderNonce      string `json:"order_nonce"`
+	Digest          string `json:"digest"`
+	SerializedOrder string `json:"serialized_order"`
+	TraderKey       string `json:"trader_key"`
+	AccountSelected bool   `json:"account_selected"`
 }
 
-func ordersList(ctx *cli.Context) error {
-	client, cleanup, err := getClient(ctx)
+// prepareOfflineOrder prepares an order without signing and submitting it and
+// prints everything that is needed to sign it offline.
+func prepareOfflineOrder(client poolrpc.TraderClient,
+	req *poolrpc.SubmitOrderRequest) error {
+
+	resp, err := client.PrepareOrder(context.Background(), req)
 	if err != nil {
 		return err
 	}
-	defer cleanup()
 
-	// Default to only showing active orders.
-	activeOnly := true
-	if ctx.Bool("show_archived") {
-		activeOnly = false
+	if resp.InvalidOrder != nil {
+		printRespJSON(resp)
+		return nil
 	}
 
-	resp, err := client.ListOrders(
-		context.Background(), &poolrpc.ListOrdersRequest{
-			Verbose:    ctx.Bool("verbose"),
-			ActiveOnly: activeOnly,
-		},
-	)
-	if err != nil {
-		return err
-	}
+	printResponse(resp, &preparedOrder{
+		OrderNonce:      hex.EncodeToString(resp.OrderNonce),
+		Digest:          hex.EncodeToString(resp.Digest),
+		SerializedOrder: hex.EncodeToString(resp.SerializedOrder),
+		TraderKey:       hex.EncodeToString(resp.TraderKey),
+		AccountSelected: resp.AccountSelected,
+	}, nil)
+	fmt.Fprintf(humanOutput(), "\nSign the order on the machine "+
+		"holding the trader key with:\n\tpool-sign --order=%x\n"+
+		"Then submit it with:\n\tpool orders submitsigned "+
+		"--order=%x --sig=<signature>\n", resp.SerializedOrder,
+		resp.SerializedOrder)
 
-	printRespJSON(resp)
 	return nil
 }
 
-var ordersCancelCommand = cli.Command{
-	Name:      "cancel",
-	Aliases:   []string{"c"},
-	Usage:     "remove an order from the order book by canceling it",
-	ArgsUsage: "order_nonce",
+var ordersSubmitSignedCommand = cli.Command{
+	Name:      "submitsigned",
+	Usage:     "submit an order that was signed offline",
+	ArgsUsage: "--order=O --sig=S",
 	Description: `
-	Remove a pending offer from the order book.`,
+	Submit an order that was prepared with 'pool orders submit --offline'
+	and then signed with the trader key of its account using pool-sign.
+	The signature is verified against the trader key before the order is
+	sent to the auction server.`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  "order_nonce",
-			Usage: "the order nonce of the order to cancel",
+			Name: "order",
+			Usage: "the hex encoded serialized order as returned " +
+				"when preparing it",
+		},
+		cli.StringFlag{
+			Name:  "sig",
+			Usage: "the hex encoded signature of the order digest",
 		},
 	},
-	Action: ordersCancel,
+	Action: ordersSubmitSigned,
 }
 
-func ordersCancel(ctx *cli.Context) error { // nolint: dupl
-	// Show help if no arguments or flags are provided.
-	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
-		_ = cli.ShowCommandHelp(ctx, "cancel")
-		return nil
+func ordersSubmitSigned(ctx *cli.Context) error {
+	if !ctx.IsSet("order") || !ctx.IsSet("sig") {
+		return cli.ShowCommandHelp(ctx, "submitsigned")
 	}
 
-	var (
-		nonceHex string
-		args     = ctx.Args()
-	)
-	switch {
-	case ctx.IsSet("order_nonce"):
-		nonceHex = ctx.String("order_nonce")
-	case args.Present():
-		nonceHex = args.First()
-		args = args.Tail()
-	default:
-		return fmt.Errorf("order_nonce argument missing")
+	serializedOrder, err := hex.DecodeString(ctx.String("order"))
+	if err != nil {
+		return fmt.Errorf("unable to decode order: %v", err)
 	}
-	nonce, err := hex.DecodeString(nonceHex)
+	sig, err := hex.DecodeString(ctx.String("sig"))
 	if err != nil {
-		return fmt.Errorf("cannot hex decode order nonce: %v", err)
+		return fmt.Errorf("unable to decode signature: %v", err)
 	}
 
 	client, cleanup, err := getClient(ctx)
@@ -781,7 +1154,374 @@ func ordersCancel(ctx *cli.Context) error { // nolint: dupl
 	}
 	defer cleanup()
 
-	resp, err := client.CancelOrder(
+	resp, err := client.SubmitSignedOrder(
+		context.Background(), &poolrpc.SubmitSignedOrderRequest{
+			SerializedOrder: serializedOrder,
+			Signature:       sig,
+			Initiator:       defaultInitiator,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// ordersSubmitFromTemplate submits a new ask or bid order from the template
+// given on the command line.
+func ordersSubmitFromTemplate(ctx *cli.Context, isAsk bool) error {
+	for _, flag := range templateParamFlags {
+		if ctx.IsSet(flag) {
+			return fmt.Errorf("flag %s cannot be used together "+
+				"with template", flag)
+		}
+	}
+
+	var (
+		args = ctx.Args()
+		req  = &poolrpc.SubmitOrderFromTemplateRequest{
+			Name:      ctx.String("template"),
+			Label:     ctx.String("label"),
+			Initiator: defaultInitiator,
+			Force:     ctx.Bool("allow_duplicate"),
+		}
+	)
+	switch {
+	case ctx.IsSet("amt"):
+		amt, err := parseAmtFlag(ctx, "amt")
+		if err != nil {
+			return err
+		}
+		req.Amt = uint64(amt)
+	case args.Present():
+		amt, err := parseAmt(args.First())
+		if err != nil {
+			return fmt.Errorf("unable to decode amount: %v", err)
+		}
+		req.Amt = uint64(amt)
+		args = args.Tail()
+	default:
+		return fmt.Errorf("amt argument missing")
+	}
+
+	if ctx.IsSet("acct_key") || args.Present() {
+		var err error
+		req.TraderKey, err = parseAccountKey(ctx, args)
+		if err != nil {
+			return fmt.Errorf("unable to parse acct_key: %v", err)
+		}
+	}
+
+	// The max batch fee rate flag has a default value, so we only replace
+	// the fee rate of the template if it was set explicitly.
+	if ctx.IsSet("max_batch_fee_rate") {
+		satPerKw, err := parseMaxBatchFeeRate(ctx)
+		if err != nil {
+			return err
+		}
+		req.MaxBatchFeeRateSatPerKw = uint64(satPerKw)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	templates, err := client.ListOrderTemplates(
+		context.Background(), &poolrpc.ListOrderTemplatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		details       *poolrpc.Order
+		leaseDuration uint32
+		found         bool
+	)
+	for _, tpl := range templates.Templates {
+		if tpl.Name != req.Name {
+			continue
+		}
+
+		switch d := tpl.Details.(type) {
+		case *poolrpc.OrderTemplate_Ask:
+			details = d.Ask.Details
+			leaseDuration = d.Ask.LeaseDurationBlocks
+			found = isAsk
+
+		case *poolrpc.OrderTemplate_Bid:
+			details = d.Bid.Details
+			leaseDuration = d.Bid.LeaseDurationBlocks
+			found = !isAsk
+		}
+		if !found {
+			return fmt.Errorf("template %q is of a different "+
+				"order type", req.Name)
+		}
+	}
+	if !found {
+		return fmt.Errorf("template %q not found", req.Name)
+	}
+
+	// If the user didn't opt to force submit this order, then we'll show a
+	// break down of the final order details and request a confirmation
+	// before we submit.
+	if !ctx.Bool("force") {
+		maxBatchFeeRate := details.MaxBatchFeeRateSatPerKw
+		if req.MaxBatchFeeRateSatPerKw != 0 {
+			maxBatchFeeRate = req.MaxBatchFeeRateSatPerKw
+		}
+
+		if err := printOrderDetails(
+			client, btcutil.Amount(req.Amt),
+			order.SupplyUnit(details.MinUnitsMatch), 0,
+			order.FixedRatePremium(details.RateFixed),
+			leaseDuration, chainfee.SatPerKWeight(maxBatchFeeRate),
+			isAsk, nil,
+		); err != nil {
+			return fmt.Errorf("unable to print order details: %v", err)
+		}
+
+		if !promptForConfirmation("Confirm order (yes/no): ") {
+			fmt.Fprintln(humanOutput(), "Cancelling order...")
+			return nil
+		}
+	}
+
+	resp, err := client.SubmitOrderFromTemplate(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var ordersTemplateCommand = cli.Command{
+	Name:  "template",
+	Usage: "manage order templates",
+	Description: `
+	Order templates are created with the --save_template flag of the
+	submit commands and can be used to submit new orders with the
+	--template flag.`,
+	Subcommands: []cli.Command{
+		ordersTemplateListCommand,
+		ordersTemplateDeleteCommand,
+	},
+}
+
+var ordersTemplateListCommand = cli.Command{
+	Name:   "list",
+	Usage:  "list all order templates",
+	Action: ordersTemplateList,
+}
+
+func ordersTemplateList(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListOrderTemplates(
+		context.Background(), &poolrpc.ListOrderTemplatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var ordersTemplateDeleteCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "delete an order template",
+	ArgsUsage: "name",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "name",
+			Usage: "the name of the template to delete",
+		},
+	},
+	Action: ordersTemplateDelete,
+}
+
+func ordersTemplateDelete(ctx *cli.Context) error {
+	var name string
+	switch {
+	case ctx.IsSet("name"):
+		name = ctx.String("name")
+	case ctx.Args().Present():
+		name = ctx.Args().First()
+	default:
+		return fmt.Errorf("name argument missing")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DeleteOrderTemplate(
+		context.Background(), &poolrpc.DeleteOrderTemplateRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var ordersListCommand = cli.Command{
+	Name:    "list",
+	Aliases: []string{"l"},
+	Usage:   "list all existing orders",
+	Description: `
+	List all orders that are stored in the local order database`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "verbose",
+			Usage: "show verbose output including events",
+		},
+		cli.BoolFlag{
+			Name: "events",
+			Usage: "show the timeline of events of each order, " +
+				"implies --verbose",
+		},
+		cli.BoolFlag{
+			Name:  "show_archived",
+			Usage: "include orders no longer active",
+		},
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "the number of orders to skip before returning " +
+				"any results",
+		},
+		cli.Uint64Flag{
+			Name: "max_num_orders",
+			Usage: "the maximum number of orders to return, 0 " +
+				"means no limit",
+		},
+	},
+	Action: ordersList,
+}
+
+func ordersList(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// Default to only showing active orders.
+	activeOnly := true
+	if ctx.Bool("show_archived") {
+		activeOnly = false
+	}
+
+	resp, err := client.ListOrders(
+		context.Background(), &poolrpc.ListOrdersRequest{
+			Verbose:      ctx.Bool("verbose") || ctx.Bool("events"),
+			ActiveOnly:   activeOnly,
+			IndexOffset:  uint32(ctx.Uint64("index_offset")),
+			MaxNumOrders: uint32(ctx.Uint64("max_num_orders")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var ordersCancelCommand = cli.Command{
+	Name:      "cancel",
+	Aliases:   []string{"c"},
+	Usage:     "remove an order from the order book by canceling it",
+	ArgsUsage: "order_nonce",
+	Description: `
+	Remove a pending offer from the order book.
+
+	If --all is set, all active orders that match the given filters are
+	canceled instead.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "order_nonce",
+			Usage: "the order nonce of the order to cancel",
+		},
+		cli.BoolFlag{
+			Name:  "all",
+			Usage: "cancel all active orders matching the filters",
+		},
+		cli.StringFlag{
+			Name: "acct_key",
+			Usage: "with --all, only cancel the orders of the " +
+				"account with this trader key",
+		},
+		cli.StringFlag{
+			Name: "type",
+			Usage: "with --all, only cancel orders of this type, " +
+				"either 'ask' or 'bid'",
+		},
+		cli.Uint64Flag{
+			Name: "lease_duration_blocks",
+			Usage: "with --all, only cancel the orders for this " +
+				"lease duration bucket",
+		},
+	},
+	Action: ordersCancel,
+}
+
+func ordersCancel(ctx *cli.Context) error { // nolint: dupl
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "cancel")
+		return nil
+	}
+
+	if ctx.Bool("all") {
+		return ordersCancelAll(ctx)
+	}
+
+	var (
+		nonceHex string
+		args     = ctx.Args()
+	)
+	switch {
+	case ctx.IsSet("order_nonce"):
+		nonceHex = ctx.String("order_nonce")
+	case args.Present():
+		nonceHex = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("order_nonce argument missing")
+	}
+	nonce, err := hex.DecodeString(nonceHex)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode order nonce: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CancelOrder(
 		context.Background(), &poolrpc.CancelOrderRequest{
 			OrderNonce: nonce,
 		},
@@ -792,3 +1532,376 @@ func ordersCancel(ctx *cli.Context) error { // nolint: dupl
 	printRespJSON(resp)
 	return nil
 }
+
+var ordersActivateCommand = cli.Command{
+	Name:      "activate",
+	Usage:     "send a staged order to the auctioneer",
+	ArgsUsage: "order_nonce",
+	Description: `
+	Activate an order that was submitted with --stage by sending it to the
+	auctioneer. The order is validated against the current account state
+	and auctioneer terms before it is sent.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "order_nonce",
+			Usage: "the order nonce of the order to activate",
+		},
+	},
+	Action: ordersActivate,
+}
+
+func ordersActivate(ctx *cli.Context) error { // nolint: dupl
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "activate")
+		return nil
+	}
+
+	var (
+		nonceHex string
+		args     = ctx.Args()
+	)
+	switch {
+	case ctx.IsSet("order_nonce"):
+		nonceHex = ctx.String("order_nonce")
+	case args.Present():
+		nonceHex = args.First()
+	default:
+		return fmt.Errorf("order_nonce argument missing")
+	}
+	nonce, err := hex.DecodeString(nonceHex)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode order nonce: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ActivateOrder(
+		context.Background(), &poolrpc.ActivateOrderRequest{
+			OrderNonce: nonce,
+			Initiator:  defaultInitiator,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+func ordersCancelAll(ctx *cli.Context) error {
+	if ctx.IsSet("order_nonce") || ctx.NArg() > 0 {
+		return fmt.Errorf("cannot use an order nonce with --all")
+	}
+
+	req := &poolrpc.CancelAllOrdersRequest{
+		LeaseDurationBlocks: uint32(ctx.Uint64("lease_duration_blocks")),
+	}
+	if ctx.IsSet("acct_key") {
+		acctKey, err := hex.DecodeString(ctx.String("acct_key"))
+		if err != nil {
+			return fmt.Errorf("cannot hex decode account key: %v",
+				err)
+		}
+		req.TraderKey = acctKey
+	}
+
+	switch ctx.String("type") {
+	case "":
+
+	case "ask":
+		req.OrderType = poolrpc.OrderTypeFilter_ORDER_TYPE_ASK
+
+	case "bid":
+		req.OrderType = poolrpc.OrderTypeFilter_ORDER_TYPE_BID
+
+	default:
+		return fmt.Errorf("invalid order type %q, must be 'ask' or "+
+			"'bid'", ctx.String("type"))
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CancelAllOrders(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var ordersUpdateCommand = cli.Command{
+	Name:      "update",
+	Usage:     "replace an order with one that has different parameters",
+	ArgsUsage: "order_nonce",
+	Description: `
+	Replace an active order with a new order that uses the same account
+	and parameters, except for the ones given on the command line. The old
+	order is canceled and the new one is submitted right away.
+
+	If the amount isn't given, the new order is for the amount that is
+	still unfulfilled in the old order. If the new order can't be
+	submitted, the old order is NOT restored.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "order_nonce",
+			Usage: "the order nonce of the order to replace",
+		},
+		cli.Float64Flag{
+			Name: "interest_rate_percent",
+			Usage: "the new total percent to pay or receive " +
+				"for a LCL",
+		},
+		cli.StringFlag{
+			Name: "apr",
+			Usage: "the new rate to pay or receive as an " +
+				"annualized percentage, e.g. 3.5%",
+		},
+		cli.StringFlag{
+			Name: "amt",
+			Usage: "the new amount of the order, in satoshis or " +
+				"with a unit like 0.5btc",
+		},
+		cli.Uint64Flag{
+			Name: "max_batch_fee_rate",
+			Usage: "the new maximum fee rate (sat/vByte) to use " +
+				"for the batch transaction",
+		},
+	},
+	Action: ordersUpdate,
+}
+
+func ordersUpdate(ctx *cli.Context) error { // nolint: dupl
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "update")
+		return nil
+	}
+
+	var nonceHex string
+	switch {
+	case ctx.IsSet("order_nonce"):
+		nonceHex = ctx.String("order_nonce")
+	case ctx.Args().Present():
+		nonceHex = ctx.Args().First()
+	default:
+		return fmt.Errorf("order_nonce argument missing")
+	}
+	nonce, err := hex.DecodeString(nonceHex)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode order nonce: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	amt, err := parseAmtFlag(ctx, "amt")
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.ReplaceOrderRequest{
+		OrderNonce: nonce,
+		Amt:        uint64(amt),
+		Initiator:  defaultInitiator,
+	}
+	if ctx.IsSet("max_batch_fee_rate") {
+		satPerKw, err := parseMaxBatchFeeRate(ctx)
+		if err != nil {
+			return err
+		}
+		req.MaxBatchFeeRateSatPerKw = uint64(satPerKw)
+	}
+
+	// The fixed rate depends on the lease duration of the order, so we
+	// need to look up the order first.
+	if ctx.IsSet("interest_rate_percent") || ctx.IsSet("apr") {
+		leaseDuration, err := orderLeaseDuration(client, nonce)
+		if err != nil {
+			return err
+		}
+
+		req.RateFixed, err = parseRateFlags(ctx, leaseDuration)
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.ReplaceOrder(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+// orderLeaseDuration returns the lease duration of the active order with the
+// given nonce.
+func orderLeaseDuration(client poolrpc.TraderClient, nonce []byte) (uint32,
+	error) {
+
+	resp, err := client.ListOrders(
+		context.Background(), &poolrpc.ListOrdersRequest{
+			ActiveOnly: true,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ask := range resp.Asks {
+		if bytes.Equal(ask.Details.OrderNonce, nonce) {
+			return ask.LeaseDurationBlocks, nil
+		}
+	}
+	for _, bid := range resp.Bids {
+		if bytes.Equal(bid.Details.OrderNonce, nonce) {
+			return bid.LeaseDurationBlocks, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no active order with nonce %x found", nonce)
+}
+
+var ordersPruneCommand = cli.Command{
+	Name:  "prune",
+	Usage: "remove old archived orders from the local database",
+	Description: `
+	Remove archived orders and their events from the local order database
+	if nothing happened to them within the given duration. Orders that were
+	matched in a batch, pending or finalized, are never removed as the batch
+	history and the leases still refer to them.`,
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: "older_than",
+			Usage: "only remove orders that were last updated " +
+				"longer ago than this duration, e.g. 720h",
+		},
+		cli.StringSliceFlag{
+			Name: "state",
+			Usage: "only remove orders in this state, can be " +
+				"specified multiple times; possible values " +
+				"are executed, canceled, expired and " +
+				"failed; if left blank, orders in any of " +
+				"these states are removed",
+		},
+	},
+	Action: ordersPrune,
+}
+
+func ordersPrune(ctx *cli.Context) error {
+	if !ctx.IsSet("older_than") {
+		return fmt.Errorf("older_than flag missing")
+	}
+	olderThan := time.Now().Add(-ctx.Duration("older_than"))
+
+	var states []auctioneerrpc.OrderState
+	for _, state := range ctx.StringSlice("state") {
+		switch state {
+		case order.StateExecuted.String():
+			states = append(
+				states, auctioneerrpc.OrderState_ORDER_EXECUTED,
+			)
+
+		case order.StateCanceled.String():
+			states = append(
+				states, auctioneerrpc.OrderState_ORDER_CANCELED,
+			)
+
+		case order.StateExpired.String():
+			states = append(
+				states, auctioneerrpc.OrderState_ORDER_EXPIRED,
+			)
+
+		case order.StateFailed.String():
+			states = append(
+				states, auctioneerrpc.OrderState_ORDER_FAILED,
+			)
+
+		default:
+			return fmt.Errorf("invalid order state %v", state)
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PruneArchivedOrders(
+		context.Background(), &poolrpc.PruneArchivedOrdersRequest{
+			OlderThanTimestampNs: olderThan.UnixNano(),
+			States:               states,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+	return nil
+}
+
+var ordersStatsCommand = cli.Command{
+	Name:  "stats",
+	Usage: "show fill statistics per lease duration market",
+	Description: `
+	Show the number of units filled, the volume, the average clearing price
+	and the total premiums paid and earned by all orders, aggregated per
+	lease duration market. Only units that were actually filled in a batch
+	are counted.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "lease_duration_blocks",
+			Usage: "only show the statistics of this lease " +
+				"duration market; if left blank, all markets " +
+				"are shown",
+		},
+		cli.DurationFlag{
+			Name: "since",
+			Usage: "only include batches that happened within " +
+				"this duration, e.g. 720h; if left blank, all " +
+				"batches are included",
+		},
+	},
+	Action: ordersStats,
+}
+
+func ordersStats(ctx *cli.Context) error {
+	var startTimestamp int64
+	if ctx.IsSet("since") {
+		since := time.Now().Add(-ctx.Duration("since"))
+		startTimestamp = since.UnixNano()
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.OrderStats(
+		context.Background(), &poolrpc.OrderStatsRequest{
+			LeaseDurationBlocks: uint32(
+				ctx.Uint64("lease_duration_blocks"),
+			),
+			StartTimestampNs: startTimestamp,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	printRespJSON(resp)
+	return nil
+}
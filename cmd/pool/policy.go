@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/pool/policy"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var policyCommands = []cli.Command{
+	{
+		Name:     "policy",
+		Usage:    "Manage the rules every batch must satisfy.",
+		Category: "Auction",
+		Subcommands: []cli.Command{
+			setBatchPolicyCommand,
+			showBatchPolicyCommand,
+		},
+	},
+}
+
+var setBatchPolicyCommand = cli.Command{
+	Name:  "set",
+	Usage: "replace the batch policy",
+	Description: `
+	Replace the batch policy every batch the trader takes part in must
+	satisfy. Batches that violate any of the rules are rejected. Rules
+	that aren't set are disabled, so calling this command without any
+	flags disables the policy. The policy is persisted and applies to the
+	next batch right away.
+
+	The policy can also be read from a JSON file, for example:
+	{"max_chain_fee_sat": 20000, "max_matched_orders": 3}
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "max_chain_fee",
+			Usage: "the maximum total chain fee in satoshis all " +
+				"accounts may pay for a single batch",
+		},
+		cli.Uint64Flag{
+			Name: "max_price_deviation_bps",
+			Usage: "the maximum deviation of the clearing price " +
+				"from the rate of any matched order, in " +
+				"basis points of the order's rate",
+		},
+		cli.Uint64Flag{
+			Name: "max_matched_orders",
+			Usage: "the maximum number of orders that may be " +
+				"matched in a single batch",
+		},
+		cli.StringFlag{
+			Name: "file",
+			Usage: "read the policy from the given JSON file " +
+				"instead of the flags above",
+		},
+	},
+	Action: setBatchPolicy,
+}
+
+func setBatchPolicy(ctx *cli.Context) error {
+	rpcPolicy := &poolrpc.BatchPolicy{
+		MaxChainFeeSat: ctx.Uint64("max_chain_fee"),
+		MaxPriceDeviationBps: uint32(
+			ctx.Uint64("max_price_deviation_bps"),
+		),
+		MaxMatchedOrders: uint32(ctx.Uint64("max_matched_orders")),
+	}
+
+	if ctx.IsSet("file") {
+		if ctx.NumFlags() > 1 {
+			return fmt.Errorf("rule flags cannot be combined " +
+				"with --file")
+		}
+
+		p, err := policy.LoadFile(
+			lncfg.CleanAndExpandPath(ctx.String("file")),
+		)
+		if err != nil {
+			return err
+		}
+
+		rpcPolicy = &poolrpc.BatchPolicy{
+			MaxChainFeeSat:       uint64(p.MaxChainFee),
+			MaxPriceDeviationBps: p.MaxPriceDeviationBps,
+			MaxMatchedOrders:     p.MaxMatchedOrders,
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SetBatchPolicy(
+		context.Background(), &poolrpc.SetBatchPolicyRequest{
+			Policy: rpcPolicy,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var showBatchPolicyCommand = cli.Command{
+	Name:   "show",
+	Usage:  "show the batch policy that is currently enforced",
+	Action: showBatchPolicy,
+}
+
+func showBatchPolicy(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetBatchPolicy(
+		context.Background(), &poolrpc.GetBatchPolicyRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"strconv"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/urfave/cli"
 )
@@ -26,6 +30,8 @@ var sidecarCommands = []cli.Command{
 			sidecarExpectChannelCommand,
 			sidecarListCommand,
 			sidecarCancelCommand,
+			sidecarInspectCommand,
+			sidecarSubscribeCommand,
 		},
 	},
 }
@@ -40,7 +46,8 @@ var sidecarOfferCommand = cli.Command{
 	If the auto flag is specified, then all bid information needs to be 
 	specified as normal. If the auto flag isn't specified, then only 
 	capacity, self_chan_balance, lease_duration_blocks, and acct_key
-	need to set.`,
+	need to set. With --num_tickets, multiple tickets with the same offer
+	are created at once, but only if the account can fund all of them.`,
 	Flags: append(
 		append(baseBidFlags, sharedFlags...),
 		cli.BoolFlag{
@@ -49,6 +56,13 @@ var sidecarOfferCommand = cli.Command{
 				"be specified as automated negotiation will be " +
 				"attempted",
 		},
+		cli.UintFlag{
+			Name: "num_tickets",
+			Usage: "the number of tickets to create with the same " +
+				"offer",
+			Value: 1,
+		},
+		sidecarTransportFlag,
 	),
 	Action: sidecarOffer,
 }
@@ -89,7 +103,11 @@ func sidecarOffer(ctx *cli.Context) error {
 
 		switch {
 		case ctx.IsSet("self_chan_balance"):
-			pushAmt = ctx.Uint64("self_chan_balance")
+			parsed, err := parseAmtFlag(ctx, "self_chan_balance")
+			if err != nil {
+				return err
+			}
+			pushAmt = uint64(parsed)
 		case args.Present():
 			parsed, err := parseAmt(args.First())
 			if err != nil {
@@ -130,7 +148,15 @@ func sidecarOffer(ctx *cli.Context) error {
 		// We must make sure that the min chan amount is set to the full
 		// order amount, otherwise we'll get an error during the auto
 		// bid submission.
-		if ctx.Uint64("amt") != ctx.Uint64("min_chan_amt") {
+		amt, err := parseAmtFlag(ctx, "amt")
+		if err != nil {
+			return err
+		}
+		minChanAmt, err := parseAmtFlag(ctx, "min_chan_amt")
+		if err != nil {
+			return err
+		}
+		if amt != minChanAmt {
 			return fmt.Errorf("must set --min_chan_amt to same " +
 				"value as --amt")
 		}
@@ -166,17 +192,40 @@ func sidecarOffer(ctx *cli.Context) error {
 		}
 
 		if !promptForConfirmation("Confirm order (yes/no): ") {
-			fmt.Println("Cancelling order...")
+			fmt.Fprintln(humanOutput(), "Cancelling order...")
 			return nil
 		}
 	}
 
-	resp, err := client.OfferSidecar(
-		context.Background(), &poolrpc.OfferSidecarRequest{
-			AutoNegotiate: ctx.Bool("auto"),
-			Bid:           bid,
-		},
-	)
+	transport, err := parseSidecarTransport(ctx.String("transport"))
+	if err != nil {
+		return err
+	}
+
+	req := &poolrpc.OfferSidecarRequest{
+		AutoNegotiate: ctx.Bool("auto"),
+		Bid:           bid,
+		Transport:     transport,
+	}
+
+	numTickets := ctx.Uint("num_tickets")
+	if numTickets != 1 {
+		resp, err := client.OfferSidecarBatch(
+			context.Background(), &poolrpc.OfferSidecarBatchRequest{
+				NumTickets: uint32(numTickets),
+				Offer:      req,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+
+		return nil
+	}
+
+	resp, err := client.OfferSidecar(context.Background(), req)
 	if err != nil {
 		return err
 	}
@@ -233,17 +282,39 @@ var sidecarRegisterCommand = cli.Command{
 	Description: `
 	Registers a sidecar ticket for an incoming sidecar channel with the node
 	and adds its recipient information to it, resulting in an updated ticket
-	that needs to be handed back to the provider.`,
+	that needs to be handed back to the provider.
+
+	If the ticket is negotiated automatically over the peer transport, the
+	identity pubkey of the provider's node must be specified.`,
+	Flags: []cli.Flag{
+		sidecarTransportFlag,
+		cli.StringFlag{
+			Name: "provider_node",
+			Usage: "the hex encoded identity pubkey of the " +
+				"provider's node, required for the peer " +
+				"transport",
+		},
+	},
 	Action: sidecarRegister,
 }
 
 func sidecarRegister(ctx *cli.Context) error {
-	// Show help if no arguments or flags are provided.
-	if ctx.NArg() != 1 || ctx.NumFlags() != 0 {
+	// Show help if no arguments are provided.
+	if ctx.NArg() != 1 {
 		_ = cli.ShowCommandHelp(ctx, "register")
 		return nil
 	}
 
+	transport, err := parseSidecarTransport(ctx.String("transport"))
+	if err != nil {
+		return err
+	}
+
+	providerNode, err := hex.DecodeString(ctx.String("provider_node"))
+	if err != nil {
+		return fmt.Errorf("unable to decode provider node: %v", err)
+	}
+
 	client, cleanup, err := getClient(ctx)
 	if err != nil {
 		return err
@@ -252,7 +323,9 @@ func sidecarRegister(ctx *cli.Context) error {
 
 	resp, err := client.RegisterSidecar(
 		context.Background(), &poolrpc.RegisterSidecarRequest{
-			Ticket: ctx.Args().First(),
+			Ticket:       ctx.Args().First(),
+			Transport:    transport,
+			ProviderNode: providerNode,
 		},
 	)
 	if err != nil {
@@ -305,12 +378,31 @@ var sidecarListCommand = cli.Command{
 	Name:    "list",
 	Aliases: []string{"l"},
 	Usage:   "list all sidecar tickets",
-	Action:  sidecarList,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "state",
+			Usage: "only list tickets in the given state, for " +
+				"example \"offered\", \"completed\" or " +
+				"\"canceled\"",
+		},
+		cli.StringFlag{
+			Name: "created_after",
+			Usage: "only list tickets that were created at or " +
+				"after the given RFC3339 time",
+		},
+		cli.StringFlag{
+			Name: "created_before",
+			Usage: "only list tickets that were created before " +
+				"the given RFC3339 time, for example to find " +
+				"unclaimed tickets to cancel",
+		},
+	},
+	Action: sidecarList,
 }
 
 func sidecarList(ctx *cli.Context) error {
-	// Show help if no arguments or flags are provided.
-	if ctx.NArg() != 0 || ctx.NumFlags() != 0 {
+	// Show help if any arguments are provided.
+	if ctx.NArg() != 0 {
 		_ = cli.ShowCommandHelp(ctx, "list")
 		return nil
 	}
@@ -321,18 +413,112 @@ func sidecarList(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	resp, err := client.ListSidecars(
-		context.Background(), &poolrpc.ListSidecarsRequest{},
-	)
+	req := &poolrpc.ListSidecarsRequest{
+		State: ctx.String("state"),
+	}
+	if ctx.IsSet("created_after") {
+		createdAfter, err := time.Parse(
+			time.RFC3339, ctx.String("created_after"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to parse created_after: %v",
+				err)
+		}
+		req.CreatedAfterTimestampNs = createdAfter.UnixNano()
+	}
+	if ctx.IsSet("created_before") {
+		createdBefore, err := time.Parse(
+			time.RFC3339, ctx.String("created_before"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to parse created_before: %v",
+				err)
+		}
+		req.CreatedBeforeTimestampNs = createdBefore.UnixNano()
+	}
+
+	resp, err := client.ListSidecars(context.Background(), req)
 	if err != nil {
 		return err
 	}
 
-	printRespJSON(resp)
+	var listResp = struct {
+		Tickets []*SidecarTicket `json:"tickets"`
+	}{
+		Tickets: make([]*SidecarTicket, 0, len(resp.Tickets)),
+	}
+	var derived = struct {
+		Tickets []*SidecarTicketDerived `json:"tickets"`
+	}{
+		Tickets: make([]*SidecarTicketDerived, 0, len(resp.Tickets)),
+	}
+	for _, ticket := range resp.Tickets {
+		display := NewSidecarTicketFromProto(ticket)
+		listResp.Tickets = append(listResp.Tickets, display)
+		derived.Tickets = append(derived.Tickets, display.derived())
+	}
+
+	printResponse(resp, listResp, derived)
 
 	return nil
 }
 
+// SidecarTicket is the display version of a decoded sidecar ticket that also
+// shows when the ticket was created, when it expires and how much time is
+// remaining until then.
+type SidecarTicket struct {
+	*poolrpc.DecodedSidecarTicket
+
+	Created       string `json:"created,omitempty"`
+	Expiry        string `json:"expiry,omitempty"`
+	TimeRemaining string `json:"time_remaining,omitempty"`
+}
+
+// NewSidecarTicketFromProto creates a display SidecarTicket from its proto.
+func NewSidecarTicketFromProto(t *poolrpc.DecodedSidecarTicket) *SidecarTicket {
+	ticket := &SidecarTicket{
+		DecodedSidecarTicket: t,
+	}
+
+	if t.CreationTimestampNs != 0 {
+		created := time.Unix(0, int64(t.CreationTimestampNs))
+		ticket.Created = created.Format(time.RFC3339)
+	}
+
+	// Tickets without an expiry never expire, so there's nothing to add.
+	if t.ExpiryTimestamp == 0 {
+		return ticket
+	}
+
+	expiry := time.Unix(int64(t.ExpiryTimestamp), 0)
+	ticket.Expiry = expiry.Format(time.RFC3339)
+
+	remaining := time.Until(expiry).Truncate(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	ticket.TimeRemaining = remaining.String()
+
+	return ticket
+}
+
+// SidecarTicketDerived holds the fields of a display SidecarTicket that can't
+// be read from the raw proto ticket directly.
+type SidecarTicketDerived struct {
+	Created       string `json:"created,omitempty"`
+	Expiry        string `json:"expiry,omitempty"`
+	TimeRemaining string `json:"time_remaining,omitempty"`
+}
+
+// derived returns the fields of the ticket that were derived from its proto.
+func (t *SidecarTicket) derived() *SidecarTicketDerived {
+	return &SidecarTicketDerived{
+		Created:       t.Created,
+		Expiry:        t.Expiry,
+		TimeRemaining: t.TimeRemaining,
+	}
+}
+
 var sidecarCancelCommand = cli.Command{
 	Name:    "cancel",
 	Aliases: []string{"c"},
@@ -377,3 +563,179 @@ func sidecarCancel(ctx *cli.Context) error {
 
 	return nil
 }
+
+var sidecarSubscribeCommand = cli.Command{
+	Name:      "subscribe",
+	Usage:     "stream state changes of a sidecar ticket",
+	ArgsUsage: "ticket_id",
+	Description: `
+	Print the current state of the sidecar ticket identified by its ID,
+	followed by an update every time the ticket changes its state. The
+	command runs until it is interrupted.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "history",
+			Usage: "print all recorded state changes of the " +
+				"ticket before its current state",
+		},
+	},
+	Action: sidecarSubscribe,
+}
+
+func sidecarSubscribe(ctx *cli.Context) error {
+	// Show help if no arguments are provided.
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, "subscribe")
+		return nil
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	idBytes, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("error decoding ticket ID: %v", err)
+	}
+
+	stream, err := client.SubscribeSidecar(
+		context.Background(), &poolrpc.SubscribeSidecarRequest{
+			SidecarId:      idBytes,
+			IncludeHistory: ctx.Bool("history"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
+// sidecarTransportFlag is the flag to select the transport that is used to
+// automatically negotiate a sidecar ticket.
+var sidecarTransportFlag = cli.StringFlag{
+	Name: "transport",
+	Usage: "the transport used to automatically negotiate the ticket " +
+		"with the other party, either 'hashmail' or 'peer'; uses " +
+		"the daemon's default if not set",
+}
+
+// parseSidecarTransport parses the sidecar transport flag into its RPC
+// counterpart.
+func parseSidecarTransport(transport string) (poolrpc.SidecarTransport,
+	error) {
+
+	switch transport {
+	case "":
+		return poolrpc.SidecarTransport_SIDECAR_TRANSPORT_DEFAULT, nil
+
+	case "hashmail":
+		return poolrpc.SidecarTransport_SIDECAR_TRANSPORT_HASHMAIL, nil
+
+	case "peer":
+		return poolrpc.SidecarTransport_SIDECAR_TRANSPORT_PEER, nil
+
+	default:
+		return 0, fmt.Errorf("unknown transport %q, must be either "+
+			"'hashmail' or 'peer'", transport)
+	}
+}
+
+var sidecarInspectCommand = cli.Command{
+	Name:      "inspect",
+	Aliases:   []string{"i"},
+	Usage:     "decode and verify a sidecar ticket offline",
+	ArgsUsage: "ticket",
+	Description: `
+	Decodes the given sidecar ticket, checks its version and verifies the
+	signatures of the provider contained within. Unlike printticket, this
+	command doesn't need a running daemon, so it can be used to validate
+	tickets offline.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "signer_pubkey",
+			Usage: "the hex encoded public key the ticket is " +
+				"expected to be signed with",
+		},
+	},
+	Action: sidecarInspect,
+}
+
+// SidecarSummary is the display version of a verified sidecar ticket summary.
+type SidecarSummary struct {
+	ID                  string `json:"id"`
+	Version             uint32 `json:"version"`
+	State               string `json:"state"`
+	Capacity            uint64 `json:"capacity"`
+	PushAmount          uint64 `json:"push_amount"`
+	LeaseDurationBlocks uint32 `json:"lease_duration_blocks"`
+	Auto                bool   `json:"auto"`
+	Expiry              string `json:"expiry,omitempty"`
+	SignPubKey          string `json:"sign_pubkey"`
+	HasRecipient        bool   `json:"has_recipient"`
+	HasOrder            bool   `json:"has_order"`
+	OrderSigned         bool   `json:"order_signed"`
+	HasExecution        bool   `json:"has_execution"`
+}
+
+func sidecarInspect(ctx *cli.Context) error {
+	// Show help if no arguments are provided.
+	if ctx.NArg() != 1 {
+		_ = cli.ShowCommandHelp(ctx, "inspect")
+		return nil
+	}
+
+	var signerPub *btcec.PublicKey
+	if ctx.IsSet("signer_pubkey") {
+		pubKeyBytes, err := hex.DecodeString(ctx.String("signer_pubkey"))
+		if err != nil {
+			return fmt.Errorf("unable to decode signer pubkey: %v",
+				err)
+		}
+		signerPub, err = btcec.ParsePubKey(pubKeyBytes)
+		if err != nil {
+			return fmt.Errorf("invalid signer pubkey: %v", err)
+		}
+	}
+
+	summary, err := sidecar.DecodeAndVerify(ctx.Args().First(), signerPub)
+	if err != nil {
+		return err
+	}
+
+	display := &SidecarSummary{
+		ID:                  hex.EncodeToString(summary.ID[:]),
+		Version:             uint32(summary.Version),
+		State:               summary.State.String(),
+		Capacity:            uint64(summary.Capacity),
+		PushAmount:          uint64(summary.PushAmt),
+		LeaseDurationBlocks: summary.LeaseDurationBlocks,
+		Auto:                summary.Auto,
+		SignPubKey: hex.EncodeToString(
+			summary.SignPubKey.SerializeCompressed(),
+		),
+		HasRecipient: summary.HasRecipient,
+		HasOrder:     summary.HasOrder,
+		OrderSigned:  summary.OrderSigned,
+		HasExecution: summary.HasExecution,
+	}
+	if !summary.Expiry.IsZero() {
+		display.Expiry = summary.Expiry.Format(time.RFC3339)
+	}
+
+	printJSON(display)
+
+	return nil
+}
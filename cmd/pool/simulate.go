@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/urfave/cli"
+)
+
+// simulateFlags are the flags that are common to simulating an ask and a bid.
+var simulateFlags = []cli.Flag{
+	cli.Float64Flag{
+		Name: "interest_rate_percent",
+		Usage: "the total percent one is willing to pay or " +
+			"accept as yield for the specified interval",
+	},
+	aprFlag,
+	cli.StringFlag{
+		Name: "amt",
+		Usage: "the amount of the order, in satoshis or with a " +
+			"unit like 0.5btc",
+	},
+	cli.Uint64Flag{
+		Name: "lease_duration_blocks",
+		Usage: "the number of blocks that the liquidity should " +
+			"be leased for",
+		Value: defaultBidMinDuration,
+	},
+	cli.StringFlag{
+		Name: "min_chan_amt",
+		Usage: "the minimum amount of satoshis that a " +
+			"resulting channel from this order must have",
+	},
+	cli.Uint64Flag{
+		Name: "max_batch_fee_rate",
+		Usage: "the maximum fee rate (sat/vByte) to use to for " +
+			"the batch transaction",
+		Value: defaultMaxBatchFeeRateSatPerVByte,
+	},
+}
+
+var ordersSimulateCommand = cli.Command{
+	Name:  "simulate",
+	Usage: "estimate what an order would pay or earn in the next batch",
+	Description: `
+	Simulate the next batch with a prospective order added to the current
+	order book of its market, without submitting the order. The result
+	shows how many units would be filled, the clearing rate and the premium
+	and fees that would be paid or earned.
+
+	The simulation is only an estimate based on a snapshot of the order
+	book, the actual match depends on the orders present when the next
+	batch is cleared.`,
+	Subcommands: []cli.Command{
+		{
+			Name:      "ask",
+			Usage:     "simulate offering channel liquidity",
+			ArgsUsage: "amt [--interest_rate_percent=R | --apr=A]",
+			Flags:     simulateFlags,
+			Action: func(ctx *cli.Context) error {
+				return ordersSimulate(ctx, true)
+			},
+		},
+		{
+			Name:      "bid",
+			Usage:     "simulate requesting channel liquidity",
+			ArgsUsage: "amt [--interest_rate_percent=R | --apr=A]",
+			Flags:     simulateFlags,
+			Action: func(ctx *cli.Context) error {
+				return ordersSimulate(ctx, false)
+			},
+		},
+	},
+}
+
+func ordersSimulate(ctx *cli.Context, isAsk bool) error {
+	// Show help if no arguments or flags are provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, ctx.Command.Name)
+		return nil
+	}
+
+	leaseDuration := uint32(ctx.Uint64("lease_duration_blocks"))
+	params, err := parseCommonParams(ctx, leaseDuration)
+	if err != nil {
+		return fmt.Errorf("unable to parse order params: %v", err)
+	}
+
+	version := uint32(order.VersionChannelType)
+	req := &poolrpc.QuoteMatchRequest{}
+	if isAsk {
+		req.Details = &poolrpc.QuoteMatchRequest_Ask{
+			Ask: &poolrpc.Ask{
+				Details:             params,
+				LeaseDurationBlocks: leaseDuration,
+				Version:             version,
+			},
+		}
+	} else {
+		req.Details = &poolrpc.QuoteMatchRequest_Bid{
+			Bid: &poolrpc.Bid{
+				Details:             params,
+				LeaseDurationBlocks: leaseDuration,
+				Version:             version,
+			},
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.QuoteMatch(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -14,10 +14,6 @@ import (
 	"github.com/lightninglabs/pool"
 )
 
-var (
-	defaultConfigFilename = "poold.conf"
-)
-
 func main() {
 	err := start()
 	if err != nil {
@@ -29,9 +25,8 @@ func main() {
 func start() error {
 	config := pool.DefaultConfig()
 
-	// Parse command line flags.
-	parser := flags.NewParser(&config, flags.Default)
-	parser.SubcommandsOptional = true
+	// Parse command line flags and environment variables.
+	parser := pool.NewConfigParser(&config)
 
 	_, err := parser.Parse()
 	if e, ok := err.(*flags.Error); ok && e.Type == flags.ErrHelp {
@@ -42,16 +37,8 @@ func start() error {
 	}
 
 	// Parse ini file.
-	poolDir := filepath.Join(config.BaseDir, config.Network)
-	configFile := filepath.Join(poolDir, defaultConfigFilename)
-
-	if err := flags.IniParse(configFile, &config); err != nil {
-		// If it's a parsing related error, then we'll return
-		// immediately, otherwise we can proceed as possibly the config
-		// file doesn't exist which is OK.
-		if _, ok := err.(*flags.IniError); ok {
-			return err
-		}
+	if err := pool.LoadConfigFile(parser, &config); err != nil {
+		return err
 	}
 
 	// Parse command line flags again to restore flags overwritten by ini
@@ -11,8 +11,15 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/approval"
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/metrics"
 	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightningnetwork/lnd/cert"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"google.golang.org/grpc"
@@ -43,6 +50,30 @@ var (
 	defaultMinBackoff = 5 * time.Second
 	defaultMaxBackoff = 1 * time.Minute
 
+	// minKeepaliveTime is the shortest keepalive ping interval gRPC
+	// allows.
+	minKeepaliveTime = 10 * time.Second
+
+	defaultTermsCacheTTL = 24 * time.Hour
+
+	defaultOrderSubmitRate     float64 = 30
+	defaultOrderSubmitBurst            = 10
+	defaultOrderDedupWindow            = 10 * time.Minute
+	defaultSidecarTransport            = string(sidecar.TransportHashMail)
+	defaultSidecarTicketExpiry         = 24 * time.Hour
+
+	defaultOrderMaxAutoFeeRate uint64 = 50
+
+	defaultNodeTierCacheTTL = 10 * time.Minute
+
+	defaultAutoRenewMaxFeeRate   uint64 = 50
+	defaultAutoRenewExpiryBlocks uint32 = 30 * 144
+
+	defaultHealthInterval    = 30 * time.Second
+	defaultHealthGracePeriod = 5 * time.Minute
+
+	defaultShutdownGracePeriod = time.Minute
+
 	// DefaultTLSCertFilename is the default file name for the autogenerated
 	// TLS certificate.
 	DefaultTLSCertFilename = "tls.cert"
@@ -79,6 +110,16 @@ var (
 		DefaultBaseDir, DefaultNetwork, DefaultMacaroonFilename,
 	)
 
+	// DefaultReadOnlyMacaroonFilename is the default file name for the
+	// autogenerated pool macaroon that only grants read access.
+	DefaultReadOnlyMacaroonFilename = "pool.readonly.macaroon"
+
+	// DefaultReadOnlyMacaroonPath is the default full path of the
+	// read-only pool macaroon.
+	DefaultReadOnlyMacaroonPath = filepath.Join(
+		DefaultBaseDir, DefaultNetwork, DefaultReadOnlyMacaroonFilename,
+	)
+
 	// DefaultLndDir is the default location where we look for lnd's tls and
 	// macaroon files.
 	DefaultLndDir = btcutil.AppDataDir("lnd", false)
@@ -111,6 +152,36 @@ type LndConfig struct {
 	MacaroonPath string `long:"macaroonpath" description:"The full path to the single macaroon to use, either the admin.macaroon or a custom baked one. Cannot be specified at the same time as macaroondir. A custom macaroon must contain ALL permissions required for all subservers to work, otherwise permission errors will occur."`
 
 	TLSPath string `long:"tlspath" description:"Path to lnd tls certificate"`
+
+	// CompactFilters is set if the connected lnd node uses a neutrino
+	// backend that relies on compact block filters.
+	CompactFilters bool `long:"compactfilters" description:"Set if the connected lnd node uses a neutrino backend. Accounts are then watched in a mode that advances and persists their height hints as blocks are mined, so notifications don't rescan the compact filters from the account's creation height after every restart, and that re-arms all notifications after a reorg."`
+}
+
+// HealthConfig holds the configuration of the lnd health monitor.
+type HealthConfig struct {
+	Disable      bool          `long:"disable" description:"Disable the lnd health monitor. If disabled, batches are never rejected because the connected lnd node is unhealthy."`
+	Interval     time.Duration `long:"interval" description:"The interval in which the health of the connected lnd node is checked. Valid time units are {s, m, h}."`
+	GracePeriod  time.Duration `long:"graceperiod" description:"The amount of time the connected lnd node may be unhealthy, meaning it isn't synced to the chain or its wallet is locked, before all batches are rejected. Valid time units are {s, m, h}."`
+	CancelOrders bool          `long:"cancelorders" description:"Cancel all open orders once the grace period of an unhealthy lnd node has passed. Canceled orders are not re-submitted once the node is healthy again."`
+}
+
+// AuctioneerConfig holds the configuration of the connection to the auction
+// server.
+type AuctioneerConfig struct {
+	Proxy           string `long:"proxy" description:"The host:port of a SOCKS5 proxy, for example Tor, through which all connections to the auction server are established, including the mailbox used to negotiate sidecar tickets. Takes precedence over the deprecated --proxy option."`
+	StreamIsolation bool   `long:"streamisolation" description:"Use random credentials for each connection through the SOCKS5 proxy so Tor uses a separate circuit for each of them."`
+	TorControl      string `long:"torcontrol" description:"The optional host:port of the Tor control port. If set, poold authenticates to it on startup to make sure Tor is running."`
+	TorPassword     string `long:"torpassword" description:"The password to authenticate to the Tor control port with if it uses the HASHEDPASSWORD authentication method."`
+
+	Endpoints         []string `long:"endpoint" description:"An auction server endpoint in the format <host:port>[,insecure|,tlspath=<path>]. Can be specified multiple times to fail over to the next endpoint if one can't be reached, for example a primary and a backup endpoint. Replaces --auctionserver, --insecure and --tlspathauctserver."`
+	EndpointSelection string   `long:"endpointselection" description:"How to select the endpoint to connect to on every connect and reconnect. Either 'ordered' to use the first endpoint that can be reached, in the order they are specified, or 'ping' to use the endpoint that answers the fastest." choice:"ordered" choice:"ping"`
+
+	KeepaliveTime                time.Duration `long:"keepalivetime" description:"Ping the auction server if there was no activity on the connection for this long, which detects connections that were silently dropped, for example by a NAT. Set to 0 to disable keepalive pings. Valid time units are {s, m, h}."`
+	KeepaliveTimeout             time.Duration `long:"keepalivetimeout" description:"How long to wait for the auction server to answer a keepalive ping before the connection is considered dead. Valid time units are {s, m, h}."`
+	KeepalivePermitWithoutStream bool          `long:"keepalivepermitwithoutstream" description:"Also send keepalive pings if there is no open stream to the auction server."`
+	CallTimeout                  time.Duration `long:"calltimeout" description:"The maximum time a single call to the auction server can take. Set to 0 to disable the timeout. Valid time units are {s, m, h}."`
+	BatchStreamTimeout           time.Duration `long:"batchstreamtimeout" description:"Reconnect to the auction server if it didn't send the next message within this time after a batch was accepted or signed. Set to 0 to disable the check. Valid time units are {s, m, h}."`
 }
 
 type Config struct {
@@ -118,11 +189,12 @@ type Config struct {
 	Insecure       bool   `long:"insecure" description:"disable tls"`
 	Network        string `long:"network" description:"network to run on" choice:"regtest" choice:"testnet" choice:"mainnet" choice:"simnet"`
 	AuctionServer  string `long:"auctionserver" description:"auction server address host:port"`
-	Proxy          string `long:"proxy" description:"The host:port of a SOCKS proxy through which all connections to the pool server will be established over"`
+	Proxy          string `long:"proxy" description:"Deprecated, use --auctioneer.proxy instead. The host:port of a SOCKS proxy through which all connections to the pool server will be established over"`
 	TLSPathAuctSrv string `long:"tlspathauctserver" description:"Path to auction server tls certificate"`
 	RPCListen      string `long:"rpclisten" description:"Address to listen on for gRPC clients"`
-	RESTListen     string `long:"restlisten" description:"Address to listen on for REST clients"`
+	RESTListen     string `long:"restlisten" description:"Address to listen on for REST clients. Streaming RPCs are available through WebSockets on the same address. Set to an empty string to disable REST."`
 	BaseDir        string `long:"basedir" description:"The base directory where pool stores all its data. If set, this option overwrites --logdir, --macaroonpath, --tlscertpath and --tlskeypath."`
+	ConfigFile     string `long:"config" description:"Path to the config file. Defaults to pool.conf in the data directory of the selected network. Options set on the command line take precedence over environment variables, which take precedence over the config file."`
 
 	LogDir         string `long:"logdir" description:"Directory to log output."`
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
@@ -139,10 +211,19 @@ type Config struct {
 	TLSAutoRefresh     bool     `long:"tlsautorefresh" description:"Re-generate TLS certificate and key if the IPs or domains are changed."`
 	TLSDisableAutofill bool     `long:"tlsdisableautofill" description:"Do not include the interface IPs or the system hostname in TLS certificate, use first --tlsextradomain as Common Name instead, if set."`
 
-	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for pool's RPC and REST services if it doesn't exist."`
+	RESTCORS       []string      `long:"restcors" description:"Add an ip:port/hostname to allow cross origin access from. To allow all origins, set as \"*\"."`
+	WSPingInterval time.Duration `long:"ws-ping-interval" description:"The interval in which ping messages are sent to WebSocket clients of streaming REST calls. Set to 0 to disable pings. Valid time units are {s, m, h}."`
+	WSPongWait     time.Duration `long:"ws-pong-wait" description:"The time we wait for a WebSocket client to respond to a ping before we close the connection. Valid time units are {s, m, h}."`
+
+	MacaroonPath         string `long:"macaroonpath" description:"Path to write the macaroon for pool's RPC and REST services if it doesn't exist."`
+	ReadOnlyMacaroonPath string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for pool's RPC and REST services if it doesn't exist."`
+	NoMacaroons          bool   `long:"no-macaroons" description:"Disable macaroon authentication for pool's RPC and REST services. Anyone who can reach the RPC port then has full access, so only use this if access is restricted by other means."`
 
 	NewNodesOnly bool `long:"newnodesonly" description:"Only accept channels from nodes that the connected lnd node doesn't already have open or pending channels with."`
 
+	PeerConnectStrategy string        `long:"peerconnectstrategy" description:"How the advertised addresses of a matched peer are tried when connecting to it during a batch. Either 'sequential' to try one address after the other or 'parallel' to try all of them at once. Failed attempts are retried with a backoff until the batch step times out." choice:"sequential" choice:"parallel"`
+	PeerConnectTimeout  time.Duration `long:"peerconnecttimeout" description:"The maximum time a single connection attempt to one address of a matched peer can take. Set to 0 to only limit the attempts by the batch step timeout. Valid time units are {s, m, h}."`
+
 	LsatMaxRoutingFee btcutil.Amount `long:"lsatmaxroutingfee" description:"The maximum amount in satoshis we are willing to pay in routing fees when paying for the one-time LSAT auth token that is required to use the Pool service."`
 
 	Profile  string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65535"`
@@ -150,8 +231,47 @@ type Config struct {
 
 	TxLabelPrefix string `long:"txlabelprefix" description:"If set, then every transaction poold makes will be created with a label that has this string as a prefix."`
 
+	TermsCacheTTL time.Duration `long:"termscachettl" description:"The maximum age of the locally cached auctioneer terms that are used if the auction server can't be reached. Older terms are still used but a warning is logged. Valid time units are {s, m, h}."`
+
+	OrderSubmitRate  float64       `long:"ordersubmitrate" description:"The maximum number of orders per minute that can be submitted on average. Set to 0 to disable the rate limit."`
+	OrderSubmitBurst int           `long:"ordersubmitburst" description:"The maximum number of orders that can be submitted at once before the rate limit applies."`
+	OrderDedupWindow time.Duration `long:"orderdedupwindow" description:"The duration after its submission an active order is considered a duplicate of a new order with the same type, amount, rate, lease duration and account. Duplicates are refused unless forced. Set to 0 to disable duplicate detection. Valid time units are {s, m, h}."`
+
+	SkipNodeTierCheck bool          `long:"skipnodetiercheck" description:"Don't verify that the nodes of asks matched with our bids are rated at or above the minimum node tier of the bid. By default, the node ratings of the auctioneer are checked and matches below the tier are rejected."`
+	NodeTierCacheTTL  time.Duration `long:"nodetiercachettl" description:"The duration a node rating fetched from the auctioneer is cached before it is fetched again. Set to 0 to fetch the ratings for every batch. Valid time units are {s, m, h}."`
+
+	OrderMaxAutoFeeRate uint64 `long:"ordermaxautofeerate" description:"The maximum max batch fee rate in sat/vByte an order with automatic fee rate updates is replaced with after a batch was rejected because of its fee rate. If the estimated fee rate is higher, it is capped to this value."`
+
+	AutoRenewMaxFeeRate   uint64 `long:"autorenewmaxfeerate" description:"The maximum fee rate in sat/vByte used when automatically renewing accounts. If the estimated fee rate is higher, it is capped to this value."`
+	AutoRenewExpiryBlocks uint32 `long:"autorenewexpiryblocks" description:"The number of blocks, relative to the current height, the expiry of an automatically renewed account is set to."`
+
+	MinExpiryExtension uint32 `long:"minexpiryextension" description:"The minimum number of blocks the auctioneer must extend an account's expiry by if it does so as part of a batch. Batches with a shorter extension are rejected. Set to 0 to accept any extension."`
+
+	SidecarTransport    string        `long:"sidecartransport" description:"The transport used to automatically negotiate sidecar tickets with the other party if none is specified for the ticket. Either 'hashmail' to use the auctioneer's mailbox or 'peer' to send custom lnd peer messages over a direct connection to the other node." choice:"hashmail" choice:"peer"`
+	SidecarTicketExpiry time.Duration `long:"sidecarticketexpiry" description:"The duration after which an offered sidecar ticket expires. Expired tickets can no longer be registered by the recipient and are canceled together with their bid. Set to 0 to create tickets without an expiry that can also be registered by older versions. Valid time units are {s, m, h}."`
+
+	ReadOnly bool `long:"readonly" description:"Run the daemon in watch-only mode. The database is opened read-only, nothing is signed or published and all RPCs that would modify accounts, orders or sidecar tickets are rejected."`
+
+	BatchPolicyFile string `long:"batchpolicyfile" description:"Path to a JSON file with the batch policy to enforce. If set, the policy replaces the one stored in the database on startup. The policy can also be changed at runtime with the SetBatchPolicy RPC."`
+
+	ShutdownGracePeriod time.Duration `long:"shutdowngraceperiod" description:"The maximum amount of time to wait for a batch we're part of to be finalized or rejected when shutting down. No new RPCs or batches are accepted in the meantime. Set to 0 to not wait. Valid time units are {s, m, h}."`
+
 	Lnd *LndConfig `group:"lnd" namespace:"lnd"`
 
+	Health *HealthConfig `group:"health" namespace:"health"`
+
+	Auctioneer *AuctioneerConfig `group:"auctioneer" namespace:"auctioneer"`
+
+	DB *clientdb.DBOptions `group:"db" namespace:"db"`
+
+	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
+
+	BatchApproval *approval.Config `group:"batchapproval" namespace:"batchapproval"`
+
+	ChannelAcceptor *funding.AcceptorConfig `group:"channelacceptor" namespace:"channelacceptor"`
+
+	RemoteSigner *RemoteSignerConfig `group:"remotesigner" namespace:"remotesigner"`
+
 	// RPCListener is a network listener that can be set if poold should be
 	// used as a library and listen on the given listener instead of what is
 	// configured in the --rpclisten parameter. Setting this will also
@@ -165,6 +285,14 @@ type Config struct {
 	// DebugConfig is a set of debug options used for development and
 	// testing only.
 	DebugConfig *DebugConfig `group:"debug" namespace:"debug" hidden:"true"`
+
+	// loadedConfigFile is the path of the config file that was read, if
+	// any.
+	loadedConfigFile string
+
+	// configWarnings holds the warnings about unknown sections and
+	// options that were skipped when reading the config file.
+	configWarnings []string
 }
 
 // DebugConfig is a set of debug options used for development and testing only.
@@ -173,8 +301,8 @@ type DebugConfig struct {
 }
 
 const (
-	MainnetServer = "pool.lightning.finance:12010"
-	TestnetServer = "test.pool.lightning.finance:12010"
+	MainnetServer = auctioneer.MainnetServer
+	TestnetServer = auctioneer.TestnetServer
 
 	// defaultRPCTimeout is the default number of seconds an unary RPC call
 	// is allowed to take to complete.
@@ -186,25 +314,57 @@ const (
 // DefaultConfig returns the default value for the Config struct.
 func DefaultConfig() Config {
 	return Config{
-		Network:           DefaultNetwork,
-		RPCListen:         "localhost:12010",
-		RESTListen:        "localhost:8281",
-		Insecure:          false,
-		BaseDir:           DefaultBaseDir,
-		LogDir:            defaultLogDir,
-		MaxLogFiles:       defaultMaxLogFiles,
-		MaxLogFileSize:    defaultMaxLogFileSize,
-		MinBackoff:        defaultMinBackoff,
-		MaxBackoff:        defaultMaxBackoff,
-		DebugLevel:        defaultLogLevel,
-		TLSCertPath:       DefaultTLSCertPath,
-		TLSKeyPath:        DefaultTLSKeyPath,
-		MacaroonPath:      DefaultMacaroonPath,
-		LsatMaxRoutingFee: defaultLsatMaxFee,
+		Network:               DefaultNetwork,
+		RPCListen:             "localhost:12010",
+		RESTListen:            "localhost:8281",
+		Insecure:              false,
+		BaseDir:               DefaultBaseDir,
+		LogDir:                defaultLogDir,
+		MaxLogFiles:           defaultMaxLogFiles,
+		MaxLogFileSize:        defaultMaxLogFileSize,
+		MinBackoff:            defaultMinBackoff,
+		MaxBackoff:            defaultMaxBackoff,
+		DebugLevel:            defaultLogLevel,
+		TLSCertPath:           DefaultTLSCertPath,
+		TLSKeyPath:            DefaultTLSKeyPath,
+		WSPingInterval:        lnrpc.DefaultPingInterval,
+		WSPongWait:            lnrpc.DefaultPongWait,
+		MacaroonPath:          DefaultMacaroonPath,
+		ReadOnlyMacaroonPath:  DefaultReadOnlyMacaroonPath,
+		LsatMaxRoutingFee:     defaultLsatMaxFee,
+		TermsCacheTTL:         defaultTermsCacheTTL,
+		OrderSubmitRate:       defaultOrderSubmitRate,
+		OrderSubmitBurst:      defaultOrderSubmitBurst,
+		OrderDedupWindow:      defaultOrderDedupWindow,
+		OrderMaxAutoFeeRate:   defaultOrderMaxAutoFeeRate,
+		NodeTierCacheTTL:      defaultNodeTierCacheTTL,
+		AutoRenewMaxFeeRate:   defaultAutoRenewMaxFeeRate,
+		AutoRenewExpiryBlocks: defaultAutoRenewExpiryBlocks,
+		SidecarTransport:      defaultSidecarTransport,
+		SidecarTicketExpiry:   defaultSidecarTicketExpiry,
+		ShutdownGracePeriod:   defaultShutdownGracePeriod,
+		PeerConnectStrategy:   funding.ConnectSequential.String(),
+		PeerConnectTimeout:    funding.DefaultPeerConnectTimeout,
 		Lnd: &LndConfig{
 			Host:         "localhost:10009",
 			MacaroonPath: DefaultLndMacaroonPath,
 		},
+		Health: &HealthConfig{
+			Interval:    defaultHealthInterval,
+			GracePeriod: defaultHealthGracePeriod,
+		},
+		Auctioneer: &AuctioneerConfig{
+			KeepaliveTime:      auctioneer.DefaultKeepaliveTime,
+			KeepaliveTimeout:   auctioneer.DefaultKeepaliveTimeout,
+			CallTimeout:        auctioneer.DefaultCallTimeout,
+			BatchStreamTimeout: auctioneer.DefaultBatchStreamTimeout,
+			EndpointSelection:  auctioneer.SelectOrdered.String(),
+		},
+		DB:              clientdb.DefaultDBOptions(),
+		Metrics:         &metrics.Config{},
+		BatchApproval:   approval.DefaultConfig(),
+		ChannelAcceptor: funding.DefaultAcceptorConfig(),
+		RemoteSigner:    &RemoteSignerConfig{},
 		DebugConfig: &DebugConfig{
 			BatchVersion: uint32(order.ExtendAccountBatchVersion),
 		},
@@ -216,9 +376,14 @@ func Validate(cfg *Config) error {
 	// Cleanup any paths before we use them.
 	cfg.BaseDir = lncfg.CleanAndExpandPath(cfg.BaseDir)
 	cfg.LogDir = lncfg.CleanAndExpandPath(cfg.LogDir)
+	cfg.ConfigFile = lncfg.CleanAndExpandPath(cfg.ConfigFile)
 	cfg.TLSCertPath = lncfg.CleanAndExpandPath(cfg.TLSCertPath)
 	cfg.TLSKeyPath = lncfg.CleanAndExpandPath(cfg.TLSKeyPath)
 	cfg.MacaroonPath = lncfg.CleanAndExpandPath(cfg.MacaroonPath)
+	cfg.ReadOnlyMacaroonPath = lncfg.CleanAndExpandPath(
+		cfg.ReadOnlyMacaroonPath,
+	)
+	cfg.BatchPolicyFile = lncfg.CleanAndExpandPath(cfg.BatchPolicyFile)
 
 	// Since our pool directory overrides our log and TLS dir values, make
 	// sure that they are not set when base dir is set. We hard here rather
@@ -230,6 +395,8 @@ func Validate(cfg *Config) error {
 		tlsCertPathSet := cfg.TLSCertPath != DefaultTLSCertPath
 		tlsKeyPathSet := cfg.TLSKeyPath != DefaultTLSKeyPath
 		macaroonPathSet := cfg.MacaroonPath != DefaultMacaroonPath
+		readOnlyMacaroonPathSet := cfg.ReadOnlyMacaroonPath !=
+			DefaultReadOnlyMacaroonPath
 
 		if logDirSet {
 			return fmt.Errorf("basedir overwrites logdir, please " +
@@ -251,6 +418,12 @@ func Validate(cfg *Config) error {
 				"please only set one value")
 		}
 
+		if readOnlyMacaroonPathSet {
+			return fmt.Errorf("basedir overwrites " +
+				"readonlymacaroonpath, please only set one " +
+				"value")
+		}
+
 		// Once we are satisfied that no other config value was set, we
 		// replace them with our pool dir.
 		cfg.LogDir = filepath.Join(cfg.BaseDir, defaultLogDirname)
@@ -279,6 +452,11 @@ func Validate(cfg *Config) error {
 			cfg.BaseDir, DefaultMacaroonFilename,
 		)
 	}
+	if cfg.ReadOnlyMacaroonPath == DefaultReadOnlyMacaroonPath {
+		cfg.ReadOnlyMacaroonPath = filepath.Join(
+			cfg.BaseDir, DefaultReadOnlyMacaroonFilename,
+		)
+	}
 
 	// If either of these directories do not exist, create them.
 	if err := os.MkdirAll(cfg.BaseDir, os.ModePerm); err != nil {
@@ -326,6 +504,135 @@ func Validate(cfg *Config) error {
 		)
 	}
 
+	// The postgres backend can't be used without its driver or without a
+	// database to connect to.
+	if cfg.DB.Backend == clientdb.BackendPostgres {
+		if !kvdb.PostgresBackend {
+			return clientdb.ErrNoPostgresSupport
+		}
+
+		if cfg.DB.Postgres.Dsn == "" {
+			return fmt.Errorf("must specify --db.postgres.dsn " +
+				"when using the postgres database backend")
+		}
+	}
+
+	// The health checks need a positive interval to run in.
+	if !cfg.Health.Disable && cfg.Health.Interval <= 0 {
+		return fmt.Errorf("--health.interval must be positive")
+	}
+
+	if cfg.ShutdownGracePeriod < 0 {
+		return fmt.Errorf("--shutdowngraceperiod cannot be negative")
+	}
+
+	// The proxy of the auction server connection used to be configured
+	// with the top level option that we still support.
+	if cfg.Auctioneer.Proxy == "" {
+		cfg.Auctioneer.Proxy = cfg.Proxy
+	}
+	if cfg.Auctioneer.Proxy == "" && (cfg.Auctioneer.StreamIsolation ||
+		cfg.Auctioneer.TorControl != "") {
+
+		return fmt.Errorf("--auctioneer.streamisolation and " +
+			"--auctioneer.torcontrol require --auctioneer.proxy")
+	}
+
+	// gRPC doesn't allow pinging more often than every 10 seconds, so we
+	// rather tell the user instead of silently using a different value.
+	if cfg.Auctioneer.KeepaliveTime < 0 ||
+		(cfg.Auctioneer.KeepaliveTime > 0 &&
+			cfg.Auctioneer.KeepaliveTime < minKeepaliveTime) {
+
+		return fmt.Errorf("--auctioneer.keepalivetime must be 0 or at "+
+			"least %v", minKeepaliveTime)
+	}
+	if cfg.Auctioneer.KeepaliveTimeout < 0 {
+		return fmt.Errorf("--auctioneer.keepalivetimeout cannot be " +
+			"negative")
+	}
+	if cfg.Auctioneer.CallTimeout < 0 {
+		return fmt.Errorf("--auctioneer.calltimeout cannot be negative")
+	}
+	if cfg.Auctioneer.BatchStreamTimeout < 0 {
+		return fmt.Errorf("--auctioneer.batchstreamtimeout cannot be " +
+			"negative")
+	}
+	if len(cfg.Auctioneer.Endpoints) > 0 {
+		if cfg.AuctionServer != "" || cfg.Insecure ||
+			cfg.TLSPathAuctSrv != "" {
+
+			return fmt.Errorf("--auctioneer.endpoint cannot be " +
+				"combined with --auctionserver, --insecure or " +
+				"--tlspathauctserver")
+		}
+		for _, endpoint := range cfg.Auctioneer.Endpoints {
+			_, err := auctioneer.ParseEndpoint(endpoint)
+			if err != nil {
+				return fmt.Errorf("invalid --auctioneer."+
+					"endpoint: %v", err)
+			}
+		}
+	}
+	_, err := auctioneer.ParseEndpointSelection(
+		cfg.Auctioneer.EndpointSelection,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid --auctioneer.endpointselection: %v",
+			err)
+	}
+
+	if cfg.OrderSubmitRate < 0 {
+		return fmt.Errorf("--ordersubmitrate cannot be negative")
+	}
+	if cfg.OrderSubmitRate > 0 && cfg.OrderSubmitBurst < 1 {
+		return fmt.Errorf("--ordersubmitburst must be at least 1 if " +
+			"the order submission rate is limited")
+	}
+	if cfg.OrderDedupWindow < 0 {
+		return fmt.Errorf("--orderdedupwindow cannot be negative")
+	}
+	if cfg.NodeTierCacheTTL < 0 {
+		return fmt.Errorf("--nodetiercachettl cannot be negative")
+	}
+	if cfg.SidecarTicketExpiry < 0 {
+		return fmt.Errorf("--sidecarticketexpiry cannot be negative")
+	}
+	if _, err := sidecar.ParseTransport(cfg.SidecarTransport); err != nil {
+		return fmt.Errorf("invalid --sidecartransport: %v", err)
+	}
+	_, err = funding.ParseConnectStrategy(cfg.PeerConnectStrategy)
+	if err != nil {
+		return fmt.Errorf("invalid --peerconnectstrategy: %v", err)
+	}
+	if cfg.PeerConnectTimeout < 0 {
+		return fmt.Errorf("--peerconnecttimeout cannot be negative")
+	}
+	if err := cfg.BatchApproval.Validate(); err != nil {
+		return fmt.Errorf("invalid batch approval config: %v", err)
+	}
+	if err := cfg.ChannelAcceptor.Validate(); err != nil {
+		return fmt.Errorf("invalid channel acceptor config: %v", err)
+	}
+	if err := cfg.RemoteSigner.Validate(); err != nil {
+		return err
+	}
+
+	// In read-only mode the database can't be compacted as that requires
+	// re-writing the file.
+	if cfg.ReadOnly {
+		if cfg.DB.AutoCompact {
+			return fmt.Errorf("cannot use --db.auto-compact in " +
+				"read-only mode")
+		}
+		if cfg.RemoteSigner.Enable {
+			return fmt.Errorf("cannot use --remotesigner.enable " +
+				"in read-only mode")
+		}
+
+		cfg.DB.ReadOnly = true
+	}
+
 	return nil
 }
 
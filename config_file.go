@@ -0,0 +1,346 @@
+package pool
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/lightningnetwork/lnd/lncfg"
+)
+
+const (
+	// DefaultConfigFilename is the default file name of the config file
+	// that is read from the data directory of the selected network.
+	DefaultConfigFilename = "pool.conf"
+
+	// legacyConfigFilename is the file name of the config file used by
+	// previous versions. It is only read if there is no pool.conf.
+	legacyConfigFilename = "poold.conf"
+
+	// mainGroupName is the name of the option group and config file
+	// section that holds all options that aren't in a namespace.
+	mainGroupName = "Application Options"
+
+	// envPrefix is the prefix of the environment variables that can be
+	// used to set config options.
+	envPrefix = "POOL_"
+
+	// redactedValue replaces the value of secret options when dumping
+	// the config.
+	redactedValue = "<redacted>"
+)
+
+var (
+	// secretOptions is the set of options, by their long name including
+	// the namespace, whose values are redacted when dumping the config.
+	secretOptions = map[string]struct{}{
+		"auctioneer.torpassword": {},
+		"db.postgres.dsn":        {},
+	}
+
+	// envReplacer turns the long name of an option into the suffix of
+	// its environment variable.
+	envReplacer = strings.NewReplacer(".", "_", "-", "_")
+)
+
+// NewConfigParser creates a command line parser for the given config. Every
+// option can also be set through an environment variable that is named after
+// its long name in upper case, prefixed with POOL_ and with dots and dashes
+// replaced by underscores, for example POOL_LND_HOST for --lnd.host. Options
+// that can be specified multiple times take a comma separated list.
+func NewConfigParser(cfg *Config) *flags.Parser {
+	parser := flags.NewParser(cfg, flags.Default)
+	parser.SubcommandsOptional = true
+
+	forEachOption(parser.Group, func(opt *flags.Option) {
+		opt.EnvDefaultKey = envKey(opt)
+		if opt.Field().Type.Kind() == reflect.Slice {
+			opt.EnvDefaultDelim = ","
+		}
+	})
+
+	return parser
+}
+
+// LoadConfigFile reads the config file into the config of the given parser,
+// which must have been created with NewConfigParser and have parsed the
+// command line once already. The file given with --config is used if set,
+// otherwise pool.conf in the data directory of the selected network is read
+// if it exists. Options that are also set through an environment variable are
+// skipped, so the parser must parse the command line again afterwards to
+// arrive at the precedence of command line over environment over file.
+//
+// Unknown sections and options are skipped with a warning that is logged once
+// the daemon starts, so a config file can be shared across versions.
+func LoadConfigFile(parser *flags.Parser, cfg *Config) error {
+	configFile, err := configFilePath(cfg)
+	if err != nil || configFile == "" {
+		return err
+	}
+
+	content, lineNumbers, warnings, err := filterConfigFile(
+		parser, configFile,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = flags.NewIniParser(parser).Parse(strings.NewReader(content))
+	if iniErr, ok := err.(*flags.IniError); ok {
+		// Point the error to the line of the original file instead of
+		// the filtered content.
+		iniErr.File = configFile
+		if iniErr.LineNumber > 0 &&
+			iniErr.LineNumber <= uint(len(lineNumbers)) {
+
+			iniErr.LineNumber = lineNumbers[iniErr.LineNumber-1]
+		}
+
+		return iniErr
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg.loadedConfigFile = configFile
+	cfg.configWarnings = warnings
+
+	return nil
+}
+
+// configFilePath returns the path of the config file to read or an empty
+// string if there is none. A config file that was explicitly set with
+// --config must exist.
+func configFilePath(cfg *Config) (string, error) {
+	if cfg.ConfigFile != "" {
+		configFile := lncfg.CleanAndExpandPath(cfg.ConfigFile)
+		if _, err := os.Stat(configFile); err != nil {
+			return "", fmt.Errorf("unable to read config file: %v",
+				err)
+		}
+
+		return configFile, nil
+	}
+
+	networkDir := filepath.Join(
+		lncfg.CleanAndExpandPath(cfg.BaseDir), cfg.Network,
+	)
+	for _, fileName := range []string{
+		DefaultConfigFilename, legacyConfigFilename,
+	} {
+		configFile := filepath.Join(networkDir, fileName)
+		if _, err := os.Stat(configFile); err == nil {
+			return configFile, nil
+		}
+	}
+
+	return "", nil
+}
+
+// filterConfigFile reads the config file with the given path and returns its
+// content in the ini format the parser understands, without all options that
+// are unknown or also set through an environment variable. The line numbers
+// of the original file are returned for each line of the filtered content,
+// together with a warning for each unknown section and option.
+func filterConfigFile(parser *flags.Parser, configFile string) (string,
+	[]uint, []string, error) {
+
+	f, err := os.Open(configFile)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("unable to open config file: %v",
+			err)
+	}
+	defer f.Close()
+
+	mainGroup := parser.Group.Find(mainGroupName)
+
+	var (
+		content     strings.Builder
+		lineNumbers []uint
+		warnings    []string
+		section     = mainGroup
+		lineNumber  uint
+	)
+	addLine := func(line string) {
+		content.WriteString(line + "\n")
+		lineNumbers = append(lineNumbers, lineNumber)
+	}
+	addLine("[" + mainGroupName + "]")
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", line[0] == ';', line[0] == '#':
+			continue
+
+		case line[0] == '[' && line[len(line)-1] == ']':
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			section = parser.Group.Find(name)
+			if section == nil {
+				warnings = append(warnings, fmt.Sprintf(
+					"%s:%d: ignoring unknown section [%s]",
+					configFile, lineNumber, name,
+				))
+			}
+
+			continue
+
+		// All options of an unknown section are skipped, we already
+		// warned about the section itself.
+		case section == nil:
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+
+		opt := findOption(section, name)
+		if opt == nil {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s:%d: ignoring unknown option %s", configFile,
+				lineNumber, name,
+			))
+
+			continue
+		}
+
+		// Environment variables take precedence over the config file,
+		// the parser already set their values.
+		if _, ok := os.LookupEnv(opt.EnvDefaultKey); ok {
+			continue
+		}
+
+		if !hasValue {
+			addLine(opt.LongNameWithNamespace())
+			continue
+		}
+		addLine(opt.LongNameWithNamespace() + "=" + value)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, nil, fmt.Errorf("unable to read config file: %v",
+			err)
+	}
+
+	return content.String(), lineNumbers, warnings, nil
+}
+
+// findOption returns the option of the given group or any of its sub groups
+// that matches the given name from a config file, using the same rules as the
+// ini parser. Options are matched by their long name including the namespace
+// or by the name of their struct field.
+func findOption(group *flags.Group, name string) *flags.Option {
+	var match *flags.Option
+	forEachOption(group, func(opt *flags.Option) {
+		if match == nil && (opt.LongNameWithNamespace() == name ||
+			opt.Field().Name == name) {
+
+			match = opt
+		}
+	})
+
+	return match
+}
+
+// forEachOption calls the given function for every option of the given group
+// and all of its sub groups.
+func forEachOption(group *flags.Group, cb func(*flags.Option)) {
+	for _, opt := range group.Options() {
+		cb(opt)
+	}
+	for _, subGroup := range group.Groups() {
+		forEachOption(subGroup, cb)
+	}
+}
+
+// envKey returns the name of the environment variable that sets the given
+// option.
+func envKey(opt *flags.Option) string {
+	return envPrefix + strings.ToUpper(
+		envReplacer.Replace(opt.LongNameWithNamespace()),
+	)
+}
+
+// dumpConfig returns the effective value of every option of the given config
+// in the format of the config file, with one section per option group. The
+// values of secret options are redacted.
+func dumpConfig(cfg *Config) string {
+	// The parser allocates all option groups that aren't set, so we use
+	// a copy to not modify the config of the running daemon.
+	cfgCopy := *cfg
+	parser := NewConfigParser(&cfgCopy)
+
+	var dump strings.Builder
+	var dumpGroup func(group *flags.Group)
+	dumpGroup = func(group *flags.Group) {
+		fmt.Fprintf(&dump, "[%s]\n", group.ShortDescription)
+
+		for _, opt := range group.Options() {
+			name := opt.LongNameWithNamespace()
+			for _, value := range formatOptionValue(opt.Value()) {
+				_, secret := secretOptions[name]
+				if secret && value != "" {
+					value = redactedValue
+				}
+
+				fmt.Fprintf(&dump, "%s=%s\n", name, value)
+			}
+		}
+
+		for _, subGroup := range group.Groups() {
+			dump.WriteString("\n")
+			dumpGroup(subGroup)
+		}
+	}
+	dumpGroup(parser.Group.Find(mainGroupName))
+
+	return dump.String()
+}
+
+// formatOptionValue formats the given option value the way it would be
+// written in the config file. Options that can be specified multiple times
+// result in one value per element.
+func formatOptionValue(value interface{}) []string {
+	if duration, ok := value.(time.Duration); ok {
+		return []string{duration.String()}
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice:
+		values := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i).Interface()
+			values = append(values, formatOptionValue(elem)...)
+		}
+
+		return values
+
+	case reflect.Bool:
+		return []string{strconv.FormatBool(v.Bool())}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+
+		return []string{strconv.FormatInt(v.Int(), 10)}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+
+		return []string{strconv.FormatUint(v.Uint(), 10)}
+
+	case reflect.Float32, reflect.Float64:
+		return []string{strconv.FormatFloat(v.Float(), 'f', -1, 64)}
+
+	default:
+		return []string{fmt.Sprint(value)}
+	}
+}
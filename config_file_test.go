@@ -0,0 +1,122 @@
+package pool
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `
+; Options without a section belong to the main group.
+debuglevel=debug
+
+[Application Options]
+rpclisten=file:12010
+restlisten=file:8281
+unknownoption=1
+tlsextraip=1.1.1.1
+tlsextraip=2.2.2.2
+
+[lnd]
+lnd.host=file:10009
+lnd.tlspath=/file/tls.cert
+
+[auctioneer]
+auctioneer.proxy=file:9050
+auctioneer.torpassword=secret
+
+[metrics]
+metrics.listen=localhost:8989
+
+[unknownsection]
+unknownoption=1
+`
+
+// loadTestConfig loads the config the same way poold does, from the given
+// command line arguments and config file content.
+func loadTestConfig(t *testing.T, args []string, content string) (*Config,
+	string, error) {
+
+	configFile := filepath.Join(t.TempDir(), DefaultConfigFilename)
+	require.NoError(t, os.WriteFile(configFile, []byte(content), 0600))
+
+	cfg := DefaultConfig()
+	parser := NewConfigParser(&cfg)
+	parser.Options &^= flags.PrintErrors
+
+	args = append([]string{"--config=" + configFile}, args...)
+	_, err := parser.ParseArgs(args)
+	require.NoError(t, err)
+
+	if err := LoadConfigFile(parser, &cfg); err != nil {
+		return nil, configFile, err
+	}
+
+	_, err = parser.ParseArgs(args)
+	require.NoError(t, err)
+
+	return &cfg, configFile, nil
+}
+
+// TestLoadConfigFile makes sure options set on the command line take
+// precedence over environment variables, which in turn take precedence over
+// the config file, and that unknown options are skipped with a warning.
+func TestLoadConfigFile(t *testing.T) {
+	t.Setenv("POOL_RESTLISTEN", "env:8281")
+	t.Setenv("POOL_LND_HOST", "env:10009")
+	t.Setenv("POOL_TLSEXTRAIP", "3.3.3.3,4.4.4.4")
+
+	cfg, configFile, err := loadTestConfig(
+		t, []string{"--rpclisten=cli:12010"}, testConfigFile,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "cli:12010", cfg.RPCListen)
+	require.Equal(t, "env:8281", cfg.RESTListen)
+	require.Equal(t, "debug", cfg.DebugLevel)
+	require.Equal(t, []string{"3.3.3.3", "4.4.4.4"}, cfg.TLSExtraIPs)
+	require.Equal(t, "env:10009", cfg.Lnd.Host)
+	require.Equal(t, "/file/tls.cert", cfg.Lnd.TLSPath)
+	require.Equal(t, DefaultLndMacaroonPath, cfg.Lnd.MacaroonPath)
+	require.Equal(t, "file:9050", cfg.Auctioneer.Proxy)
+	require.Equal(t, "localhost:8989", cfg.Metrics.Listen)
+
+	require.Equal(t, configFile, cfg.loadedConfigFile)
+	require.Len(t, cfg.configWarnings, 2)
+	require.Contains(t, cfg.configWarnings[0], ":8: ignoring unknown option")
+	require.Contains(
+		t, cfg.configWarnings[1], ":23: ignoring unknown section",
+	)
+
+	// The dump contains the effective values without any secrets.
+	dump := dumpConfig(cfg)
+	require.Contains(t, dump, "[lnd]\nlnd.host=env:10009\n")
+	require.Contains(t, dump, "tlsextraip=3.3.3.3\ntlsextraip=4.4.4.4\n")
+	require.Contains(t, dump, "auctioneer.torpassword=<redacted>\n")
+	require.Contains(t, dump, "db.postgres.dsn=\n")
+	require.Contains(t, dump, "minbackoff=5s\n")
+	require.NotContains(t, dump, "secret")
+}
+
+// TestLoadConfigFileErrors makes sure invalid values are reported with the
+// line of the original config file and that an explicitly set config file
+// must exist.
+func TestLoadConfigFileErrors(t *testing.T) {
+	_, configFile, err := loadTestConfig(
+		t, nil, "; comment\nunknownoption=1\n\nnetwork=invalid\n",
+	)
+	require.Error(t, err)
+	require.Truef(
+		t, strings.HasPrefix(err.Error(), configFile+":4: "),
+		"unexpected error: %v", err,
+	)
+
+	cfg := DefaultConfig()
+	cfg.ConfigFile = filepath.Join(t.TempDir(), DefaultConfigFilename)
+	err = LoadConfigFile(NewConfigParser(&cfg), &cfg)
+	require.ErrorContains(t, err, "unable to read config file")
+}
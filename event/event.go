@@ -8,7 +8,7 @@ import (
 	"sort"
 	"time"
 
-	"go.etcd.io/bbolt"
+	"github.com/lightningnetwork/lnd/kvdb"
 )
 
 const (
@@ -48,6 +48,28 @@ const (
 	// If a reject happens for any reason, the order might not make it to
 	// the final batch and not all match states would therefore be present.
 	TypeOrderMatch Type = 3
+
+	// TypeAccountUpdate is the type of event that is emitted when an
+	// account's value, expiry or state changes in the account database.
+	TypeAccountUpdate Type = 4
+
+	// TypeOrderFeeRateBump is the type of event that is emitted when an
+	// order is replaced by one with a higher max batch fee rate after a
+	// batch was rejected because of its fee rate.
+	TypeOrderFeeRateBump Type = 5
+
+	// TypeSidecarStateChange is the type of event that is emitted when a
+	// sidecar ticket is added to the database or changes its state.
+	TypeSidecarStateChange Type = 6
+
+	// TypeLeaseAudit is the type of event that is emitted when the lease
+	// expiry of a channel is compared with the one promised in its batch
+	// after the channel finished its funding flow.
+	TypeLeaseAudit Type = 7
+
+	// TypeLeaseClosedEarly is the type of event that is emitted when the
+	// channel of a lease is force closed before the lease expired.
+	TypeLeaseClosedEarly Type = 8
 )
 
 // Event is the main interface all events have to implement.
@@ -96,7 +118,7 @@ type Predicate func(time.Time, Type) bool
 // function should be called first to ensure the timestamps are already unique
 // among themselves. If no "gap" in the timestamps can be found after 1000 tries
 // an error is returned.
-func StoreEvent(bucket *bbolt.Bucket, event Event) error {
+func StoreEvent(bucket kvdb.RwBucket, event Event) error {
 	// We use a fixed length array as our buffer so we don't allocate new
 	// memory for each try.
 	var tsScratchSpace [TimestampLength]byte
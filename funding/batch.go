@@ -2,12 +2,16 @@ package funding
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/chaninfo"
 	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/lnrpc"
 )
 
@@ -147,3 +151,50 @@ func AbandonCanceledChannels(matchedOrders map[order.Nonce][]*order.MatchedOrder
 
 	return nil
 }
+
+// MarshallChannelInfo turns the given channel information map into its RPC
+// counterpart.
+func MarshallChannelInfo(chanInfos map[wire.OutPoint]*chaninfo.ChannelInfo) (
+	map[string]*auctioneerrpc.ChannelInfo, error) {
+
+	rpcChannelInfos := make(
+		map[string]*auctioneerrpc.ChannelInfo, len(chanInfos),
+	)
+	for chanPoint, chanInfo := range chanInfos {
+		var channelType auctioneerrpc.ChannelType
+		switch chanInfo.Version {
+		case chanbackup.TweaklessCommitVersion:
+			channelType = auctioneerrpc.ChannelType_TWEAKLESS
+
+		// The AnchorsCommitVersion was never widely deployed (at least
+		// in mainnet) because the lnd version that included it guarded
+		// the anchor channels behind a config flag. Also, the two
+		// anchor versions only differ in the fee negotiation and not
+		// the commitment TX format, so we don't need to distinguish
+		// between them for our purpose.
+		case chanbackup.AnchorsCommitVersion,
+			chanbackup.AnchorsZeroFeeHtlcTxCommitVersion:
+			channelType = auctioneerrpc.ChannelType_ANCHORS
+
+		case chanbackup.ScriptEnforcedLeaseVersion:
+			channelType = auctioneerrpc.ChannelType_SCRIPT_ENFORCED_LEASE
+
+		default:
+			return nil, fmt.Errorf("unknown channel type: %v",
+				chanInfo.Version)
+		}
+		rpcChannelInfos[chanPoint.String()] = &auctioneerrpc.ChannelInfo{
+			Type: channelType,
+			LocalNodeKey: chanInfo.LocalNodeKey.
+				SerializeCompressed(),
+			RemoteNodeKey: chanInfo.RemoteNodeKey.
+				SerializeCompressed(),
+			LocalPaymentBasePoint: chanInfo.LocalPaymentBasePoint.
+				SerializeCompressed(),
+			RemotePaymentBasePoint: chanInfo.RemotePaymentBasePoint.
+				SerializeCompressed(),
+		}
+	}
+
+	return rpcChannelInfos, nil
+}
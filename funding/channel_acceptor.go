@@ -0,0 +1,309 @@
+package funding
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// zeroConfAcceptDepth is the number of confirmations we require before a
+// channel of a zero conf bid can be used.
+//
+// NOTE: The lnd version we currently build against can't negotiate channels
+// without any confirmations and alias short channel IDs yet. Until it does, we
+// require the minimum number of confirmations lnd supports rather than its
+// default, so the channel is usable as early as possible.
+const zeroConfAcceptDepth = 1
+
+// ChannelAcceptorClient is the part of the lnd client that is needed to
+// intercept incoming channel requests.
+type ChannelAcceptorClient interface {
+	// ChannelAcceptor create a channel acceptor using the accept function
+	// passed in. The timeout provided will be used to timeout the passed
+	// accept closure when it exceeds the amount of time we allow.
+	ChannelAcceptor(ctx context.Context, timeout time.Duration,
+		accept lndclient.AcceptorFunction) (chan error, error)
+}
+
+// ChannelAcceptor is a type that adds an RPC level interceptor for accepting
+// channels in lnd. Its main task is to validate the self channel balance (or
+// as it's known in the LN lingo: push amount) of incoming channels against the
+// expected (and paid for!) amount in the order. All other channels are
+// forwarded to the downstream acceptor, if one is configured.
+type ChannelAcceptor struct {
+	lightning ChannelAcceptorClient
+	cfg       *AcceptorConfig
+
+	expectedChans    map[[32]byte]*order.Bid
+	expectedChansMtx sync.Mutex
+
+	// downstream decides about all channels that aren't part of a batch.
+	// If this is nil, all of those channels are accepted.
+	downstream      DownstreamAcceptor
+	closeDownstream func() error
+
+	acceptorCancel func()
+	errChan        chan error
+	quit           chan struct{}
+	wg             sync.WaitGroup
+}
+
+// NewChannelAcceptor creates a new channel acceptor with the given lnd client.
+func NewChannelAcceptor(lightning ChannelAcceptorClient,
+	cfg *AcceptorConfig) *ChannelAcceptor {
+
+	return &ChannelAcceptor{
+		lightning:       lightning,
+		cfg:             cfg,
+		expectedChans:   make(map[[32]byte]*order.Bid),
+		closeDownstream: func() error { return nil },
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start starts the channel acceptor and subscribes to receive all incoming
+// channel events of lnd.
+func (s *ChannelAcceptor) Start(errChan chan error) error {
+	s.errChan = errChan
+
+	if s.cfg.Downstream != "" {
+		downstream, err := newGRPCDownstreamAcceptor(s.cfg.Downstream)
+		if err != nil {
+			return err
+		}
+
+		s.downstream = downstream
+		s.closeDownstream = downstream.close
+	}
+
+	ctxc := context.Background()
+	ctxc, s.acceptorCancel = context.WithCancel(ctxc)
+
+	rpcErrChan, err := s.lightning.ChannelAcceptor(
+		ctxc, order.DefaultBatchStepTimeout, s.acceptChannel,
+	)
+	if err != nil {
+		return err
+	}
+
+	s.wg.Add(1)
+	go s.subscribe(rpcErrChan)
+
+	return nil
+}
+
+// subscribe subscribes to errors coming from the RPC error channel and forwards
+// them to our main error channel.
+func (s *ChannelAcceptor) subscribe(rpcErrChan chan error) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case err := <-rpcErrChan:
+			select {
+			case s.errChan <- err:
+			case <-s.quit:
+			}
+
+		case <-s.quit:
+			s.acceptorCancel()
+
+			return
+		}
+	}
+}
+
+// Stop shuts down the channel acceptor.
+func (s *ChannelAcceptor) Stop() {
+	s.acceptorCancel()
+	close(s.quit)
+
+	s.wg.Wait()
+
+	if err := s.closeDownstream(); err != nil {
+		log.Errorf("Unable to close downstream acceptor connection: %v",
+			err)
+	}
+}
+
+// ShimRegistered is a function that should be called whenever a funding shim
+// is created for a bid order where we expect an incoming channel at any moment.
+func (s *ChannelAcceptor) ShimRegistered(bid *order.Bid, pid [32]byte) {
+	s.expectedChansMtx.Lock()
+	defer s.expectedChansMtx.Unlock()
+
+	s.expectedChans[pid] = bid
+}
+
+// ShimRemoved is a function that should be called whenever a funding shim is
+// cleaned up and we no longer expect an incoming channel for it.
+func (s *ChannelAcceptor) ShimRemoved(bid *order.Bid) {
+	s.expectedChansMtx.Lock()
+	defer s.expectedChansMtx.Unlock()
+
+	for pid, expectedBid := range s.expectedChans {
+		if expectedBid.Nonce() == bid.Nonce() {
+			delete(s.expectedChans, pid)
+		}
+	}
+}
+
+// acceptChannel is the callback that is invoked each time a new incoming
+// channel message is received in lnd. We inspect it here and if it corresponds
+// to a pending channel ID that we have an expectation for, we check whether the
+// self chan balance (=push amount) is correct.
+//
+// NOTE: lnd stops sending us channel requests if this returns an error, so all
+// failures must be turned into a response.
+func (s *ChannelAcceptor) acceptChannel(ctx context.Context,
+	req *lndclient.AcceptorRequest) (*lndclient.AcceptorResponse, error) {
+
+	// We don't hold the lock while asking the downstream acceptor, so new
+	// shims can still be registered in the meantime.
+	s.expectedChansMtx.Lock()
+	expectedChanBid, ok := s.expectedChans[req.PendingChanID]
+	s.expectedChansMtx.Unlock()
+
+	// It's not a channel we've registered within the funding manager, so
+	// it's up to the downstream acceptor to decide about it. Without one,
+	// we just accept it to not interfere with the normal node operation.
+	if !ok {
+		return s.acceptDownstream(ctx, req), nil
+	}
+
+	// The push amount in the acceptor request is in milli sats, we need to
+	// convert it first.
+	pushAmtSat := lnwire.MilliSatoshi(req.PushAmt).ToSatoshis()
+
+	// Push amount must be exactly what we expect. Otherwise the asker could
+	// be trying to cheat.
+	if expectedChanBid.SelfChanBalance != pushAmtSat {
+		return &lndclient.AcceptorResponse{
+			Accept: false,
+			Error: fmt.Sprintf("invalid push amount %v",
+				req.PushAmt),
+		}, nil
+	}
+
+	// Both orders of the match agreed on whether the channel is announced,
+	// so the asker must set the announce flag accordingly.
+	flags := lnwire.FundingFlag(req.ChannelFlags)
+	announced := flags&lnwire.FFAnnounceChannel != 0
+	switch {
+	case announced && expectedChanBid.UnannouncedChannel:
+		return &lndclient.AcceptorResponse{
+			Accept: false,
+			Error:  "expected unannounced channel",
+		}, nil
+
+	case !announced && !expectedChanBid.UnannouncedChannel:
+		return &lndclient.AcceptorResponse{
+			Accept: false,
+			Error:  "expected announced channel",
+		}, nil
+	}
+
+	switch expectedChanBid.ChannelType {
+	// The bid doesn't have specific requirements for the channel type.
+	case order.ChannelTypePeerDependent:
+		break
+
+	// The bid expects a channel type that enforces the channel lease
+	// maturity in its output scripts.
+	case order.ChannelTypeScriptEnforced:
+		if req.CommitmentType == nil {
+			return &lndclient.AcceptorResponse{
+				Accept: false,
+				Error:  "expected explicit channel negotiation",
+			}, nil
+		}
+
+		switch *req.CommitmentType {
+		case lnwallet.CommitmentTypeScriptEnforcedLease:
+		default:
+			return &lndclient.AcceptorResponse{
+				Accept: false,
+				Error: "expected script enforced channel " +
+					"lease commitment type",
+			}, nil
+		}
+
+	default:
+		log.Warnf("Unhandled channel type %v for bid %v",
+			expectedChanBid.ChannelType, expectedChanBid.Nonce())
+		return &lndclient.AcceptorResponse{
+			Accept: false,
+			Error:  "internal error",
+		}, nil
+	}
+
+	resp := &lndclient.AcceptorResponse{Accept: true}
+	if expectedChanBid.ZeroConfChannel {
+		resp.MinAcceptDepth = zeroConfAcceptDepth
+	}
+
+	return resp, nil
+}
+
+// acceptDownstream asks the downstream acceptor about a channel that isn't
+// part of a batch and waits for its decision until the timeout is reached. If
+// the downstream acceptor fails or doesn't decide in time, the configured fail
+// mode is applied.
+func (s *ChannelAcceptor) acceptDownstream(ctx context.Context,
+	req *lndclient.AcceptorRequest) *lndclient.AcceptorResponse {
+
+	if s.downstream == nil {
+		return &lndclient.AcceptorResponse{Accept: true}
+	}
+
+	ctxt, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	type result struct {
+		resp *lndclient.AcceptorResponse
+		err  error
+	}
+
+	// We don't trust an in-process acceptor to honor the context, so we
+	// wait for its answer in a separate goroutine. The channel is buffered
+	// so the goroutine can exit even if we stopped waiting.
+	resultChan := make(chan result, 1)
+	go func() {
+		resp, err := s.downstream.AcceptChannel(ctxt, req)
+		resultChan <- result{resp: resp, err: err}
+	}()
+
+	var res result
+	select {
+	case res = <-resultChan:
+	case <-ctxt.Done():
+		res.err = ctxt.Err()
+	}
+
+	if res.err == nil && res.resp == nil {
+		res.err = fmt.Errorf("no decision")
+	}
+	if res.err != nil {
+		log.Warnf("Downstream acceptor failed to decide about "+
+			"channel %x from %x, applying fail mode %s: %v",
+			req.PendingChanID[:], req.NodePubkey[:],
+			s.cfg.FailMode, res.err)
+
+		if s.cfg.FailMode == DownstreamFailAccept {
+			return &lndclient.AcceptorResponse{Accept: true}
+		}
+
+		return &lndclient.AcceptorResponse{
+			Accept: false,
+			Error:  "channel acceptor unavailable",
+		}
+	}
+
+	return res.resp
+}
@@ -0,0 +1,262 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// downstreamFunc is a function that implements the DownstreamAcceptor
+// interface.
+type downstreamFunc func(context.Context,
+	*lndclient.AcceptorRequest) (*lndclient.AcceptorResponse, error)
+
+// AcceptChannel calls the function itself.
+func (f downstreamFunc) AcceptChannel(ctx context.Context,
+	req *lndclient.AcceptorRequest) (*lndclient.AcceptorResponse, error) {
+
+	return f(ctx, req)
+}
+
+// TestAcceptChannelDownstream makes sure channels of a batch are only handled
+// by our own acceptor and all other channels are forwarded to the downstream
+// acceptor, applying the fail mode if it doesn't decide.
+func TestAcceptChannelDownstream(t *testing.T) {
+	t.Parallel()
+
+	batchChanID := [32]byte{1}
+	otherChanID := [32]byte{2}
+	bid := &order.Bid{Kit: *order.NewKit(order.Nonce{3})}
+	errUnavailable := errors.New("connection refused")
+
+	testCases := []struct {
+		name       string
+		downstream DownstreamAcceptor
+		failMode   string
+		chanID     [32]byte
+		expected   *lndclient.AcceptorResponse
+	}{{
+		name:     "no downstream",
+		chanID:   otherChanID,
+		expected: &lndclient.AcceptorResponse{Accept: true},
+	}, {
+		name: "batch channel not forwarded",
+		downstream: downstreamFunc(func(context.Context,
+			*lndclient.AcceptorRequest) (
+			*lndclient.AcceptorResponse, error) {
+
+			return nil, errors.New("must not be called")
+		}),
+		chanID:   batchChanID,
+		expected: &lndclient.AcceptorResponse{Accept: true},
+	}, {
+		name: "downstream rejects",
+		downstream: downstreamFunc(func(_ context.Context,
+			req *lndclient.AcceptorRequest) (
+			*lndclient.AcceptorResponse, error) {
+
+			return &lndclient.AcceptorResponse{
+				Error: "channel too small",
+			}, nil
+		}),
+		chanID: otherChanID,
+		expected: &lndclient.AcceptorResponse{
+			Error: "channel too small",
+		},
+	}, {
+		name: "downstream unavailable, reject",
+		downstream: downstreamFunc(func(context.Context,
+			*lndclient.AcceptorRequest) (
+			*lndclient.AcceptorResponse, error) {
+
+			return nil, errUnavailable
+		}),
+		failMode: DownstreamFailReject,
+		chanID:   otherChanID,
+		expected: &lndclient.AcceptorResponse{
+			Error: "channel acceptor unavailable",
+		},
+	}, {
+		name: "downstream unavailable, accept",
+		downstream: downstreamFunc(func(context.Context,
+			*lndclient.AcceptorRequest) (
+			*lndclient.AcceptorResponse, error) {
+
+			return nil, errUnavailable
+		}),
+		failMode: DownstreamFailAccept,
+		chanID:   otherChanID,
+		expected: &lndclient.AcceptorResponse{Accept: true},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultAcceptorConfig()
+			if tc.failMode != "" {
+				cfg.FailMode = tc.failMode
+			}
+			acceptor := NewChannelAcceptor(nil, cfg)
+			acceptor.downstream = tc.downstream
+			acceptor.ShimRegistered(bid, batchChanID)
+
+			resp, err := acceptor.acceptChannel(
+				context.Background(), &lndclient.AcceptorRequest{
+					PendingChanID: tc.chanID,
+					ChannelFlags: uint32(
+						lnwire.FFAnnounceChannel,
+					),
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp)
+		})
+	}
+}
+
+// TestAcceptChannelDownstreamTimeout makes sure a downstream acceptor that
+// doesn't decide in time is subject to the fail mode and doesn't block the
+// registration of new funding shims.
+func TestAcceptChannelDownstreamTimeout(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultAcceptorConfig()
+	cfg.Timeout = 50 * time.Millisecond
+	acceptor := NewChannelAcceptor(nil, cfg)
+
+	bid := &order.Bid{Kit: *order.NewKit(order.Nonce{3})}
+	release := make(chan struct{})
+	defer close(release)
+	acceptor.downstream = downstreamFunc(func(context.Context,
+		*lndclient.AcceptorRequest) (*lndclient.AcceptorResponse,
+		error) {
+
+		// A shim can be registered while the downstream acceptor is
+		// still deciding.
+		acceptor.ShimRegistered(bid, [32]byte{1})
+
+		<-release
+		return &lndclient.AcceptorResponse{Accept: true}, nil
+	})
+
+	start := time.Now()
+	resp, err := acceptor.acceptChannel(
+		context.Background(), &lndclient.AcceptorRequest{
+			PendingChanID: [32]byte{2},
+		},
+	)
+	require.NoError(t, err)
+	require.False(t, resp.Accept)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+// mockDownstreamServer is a DownstreamAcceptor gRPC service that accepts all
+// channels of at least a minimum size.
+type mockDownstreamServer struct {
+	poolrpc.UnimplementedDownstreamAcceptorServer
+
+	requests chan *poolrpc.DownstreamAcceptRequest
+}
+
+func (s *mockDownstreamServer) AcceptChannel(_ context.Context,
+	req *poolrpc.DownstreamAcceptRequest) (
+	*poolrpc.DownstreamAcceptResponse, error) {
+
+	s.requests <- req
+
+	if req.FundingAmtSat < 1_000_000 {
+		return &poolrpc.DownstreamAcceptResponse{
+			Error: "channel too small",
+		}, nil
+	}
+
+	return &poolrpc.DownstreamAcceptResponse{
+		Accept:         true,
+		MinAcceptDepth: 3,
+	}, nil
+}
+
+// TestGRPCDownstreamAcceptor makes sure channel requests are forwarded to a
+// downstream acceptor over gRPC.
+func TestGRPCDownstreamAcceptor(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &mockDownstreamServer{
+		requests: make(chan *poolrpc.DownstreamAcceptRequest, 2),
+	}
+	grpcServer := grpc.NewServer()
+	poolrpc.RegisterDownstreamAcceptorServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	downstream, err := newGRPCDownstreamAcceptor(
+		"grpc://" + lis.Addr().String(),
+	)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, downstream.close()) }()
+
+	req := &lndclient.AcceptorRequest{
+		NodePubkey:    [33]byte{2, 3},
+		PendingChanID: [32]byte{4},
+		FundingAmt:    500_000,
+		PushAmt:       1000,
+		CsvDelay:      144,
+	}
+	resp, err := downstream.AcceptChannel(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, &lndclient.AcceptorResponse{
+		Error: "channel too small",
+	}, resp)
+
+	rpcReq := <-server.requests
+	require.Equal(t, req.NodePubkey[:], rpcReq.NodePubkey)
+	require.Equal(t, req.PendingChanID[:], rpcReq.PendingChanId)
+	require.EqualValues(t, req.PushAmt, rpcReq.PushAmtMsat)
+	require.EqualValues(t, req.CsvDelay, rpcReq.CsvDelay)
+
+	req.FundingAmt = 2_000_000
+	resp, err = downstream.AcceptChannel(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, &lndclient.AcceptorResponse{
+		Accept:         true,
+		MinAcceptDepth: 3,
+	}, resp)
+}
+
+// TestAcceptorConfigValidate makes sure invalid channel acceptor configs are
+// rejected.
+func TestAcceptorConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultAcceptorConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Downstream = "grpcs://acceptor.example.com:443"
+	require.NoError(t, cfg.Validate())
+
+	cfg.Downstream = "http://localhost:10040"
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultAcceptorConfig()
+	cfg.Timeout = order.DefaultBatchStepTimeout
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultAcceptorConfig()
+	cfg.FailMode = "ignore"
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,342 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+const (
+	// DefaultPeerConnectTimeout is the default maximum time a single
+	// connection attempt to one address of a matched peer can take.
+	DefaultPeerConnectTimeout = 5 * time.Second
+
+	// minConnectBackoff is the time we wait before the second round of
+	// connection attempts to a matched peer. The wait time doubles with
+	// every further round.
+	minConnectBackoff = 250 * time.Millisecond
+
+	// maxConnectBackoff is the maximum time we wait between two rounds of
+	// connection attempts to a matched peer.
+	maxConnectBackoff = 2 * time.Second
+)
+
+// ConnectStrategy describes how we try the addresses of a matched peer.
+type ConnectStrategy uint8
+
+const (
+	// ConnectSequential tries one address after the other until a
+	// connection is established.
+	ConnectSequential ConnectStrategy = iota
+
+	// ConnectParallel tries all addresses at the same time.
+	ConnectParallel
+)
+
+// String returns the name of the strategy as used in the config.
+func (s ConnectStrategy) String() string {
+	switch s {
+	case ConnectSequential:
+		return "sequential"
+
+	case ConnectParallel:
+		return "parallel"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", s)
+	}
+}
+
+// ParseConnectStrategy parses the name of a connection strategy.
+func ParseConnectStrategy(s string) (ConnectStrategy, error) {
+	switch s {
+	case "", ConnectSequential.String():
+		return ConnectSequential, nil
+
+	case ConnectParallel.String():
+		return ConnectParallel, nil
+
+	default:
+		return 0, fmt.Errorf("unknown peer connect strategy: %v", s)
+	}
+}
+
+// connectFunc attempts to connect to a peer at the given address.
+type connectFunc func(ctx context.Context, addr string) error
+
+// ConnAttempt is the outcome of a single connection attempt to a matched
+// peer.
+type ConnAttempt struct {
+	// Addr is the address we tried to connect to.
+	Addr string
+
+	// Err is the error the attempt failed with or nil if it succeeded.
+	Err error
+}
+
+// connAttempts keeps track of all connection attempts to the matched peers of
+// a batch so failed connections can be explained to the auctioneer.
+type connAttempts struct {
+	mu       sync.Mutex
+	attempts map[route.Vertex][]*ConnAttempt
+}
+
+// newConnAttempts creates a new empty connection attempt tracker.
+func newConnAttempts() *connAttempts {
+	return &connAttempts{
+		attempts: make(map[route.Vertex][]*ConnAttempt),
+	}
+}
+
+// add records the outcome of an attempt to connect to the given peer.
+func (c *connAttempts) add(peer route.Vertex, addr string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.attempts[peer] = append(c.attempts[peer], &ConnAttempt{
+		Addr: addr,
+		Err:  err,
+	})
+}
+
+// summary returns a short description of the last outcome for each address
+// of the given peer we tried. An empty string is returned if we didn't try to
+// connect to the peer at all.
+func (c *connAttempts) summary(peer route.Vertex) string {
+	if c == nil {
+		return ""
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		addrs    []string
+		outcomes = make(map[string]string)
+	)
+	for _, attempt := range c.attempts[peer] {
+		if _, ok := outcomes[attempt.Addr]; !ok {
+			addrs = append(addrs, attempt.Addr)
+		}
+
+		outcomes[attempt.Addr] = "ok"
+		if attempt.Err != nil {
+			outcomes[attempt.Addr] = attempt.Err.Error()
+		}
+	}
+
+	parts := make([]string, len(addrs))
+	for idx, addr := range addrs {
+		parts[idx] = fmt.Sprintf("%s (%s)", addr, outcomes[addr])
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// peerConnector connects to a matched peer using the configured strategy and
+// retries until a connection is established or the context is canceled.
+type peerConnector struct {
+	strategy ConnectStrategy
+	timeout  time.Duration
+	connect  connectFunc
+	attempts *connAttempts
+	quit     <-chan struct{}
+}
+
+// connectPeer tries to connect to the given peer on all of its addresses. The
+// preferred address is tried first, all other addresses are ordered with
+// clearnet addresses first as those usually connect faster. This blocks until
+// a connection was established, the context is canceled or we're shutting
+// down and returns the address we connected to.
+func (c *peerConnector) connectPeer(ctx context.Context, peer route.Vertex,
+	addrs []net.Addr, preferred string) (string, error) {
+
+	ordered := orderAddrs(addrs, preferred)
+	if len(ordered) == 0 {
+		return "", fmt.Errorf("no addresses known for peer %x",
+			peer[:])
+	}
+
+	var backoff time.Duration
+	for round := 1; ; round++ {
+		var (
+			addr string
+			err  error
+		)
+		switch c.strategy {
+		case ConnectParallel:
+			addr, err = c.connectParallel(ctx, peer, ordered)
+
+		default:
+			addr, err = c.connectSequential(ctx, peer, ordered)
+		}
+		if err == nil {
+			return addr, nil
+		}
+
+		backoff *= 2
+		if backoff == 0 {
+			backoff = minConnectBackoff
+		}
+		if backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+
+		log.Debugf("Connection round %d to peer %x failed, retrying "+
+			"in %v", round, peer[:], backoff)
+
+		select {
+		case <-time.After(backoff):
+
+		case <-ctx.Done():
+			return "", ctx.Err()
+
+		case <-c.quit:
+			return "", fmt.Errorf("shutting down")
+		}
+	}
+}
+
+// connectSequential tries the given addresses one after the other.
+func (c *peerConnector) connectSequential(ctx context.Context,
+	peer route.Vertex, addrs []string) (string, error) {
+
+	var lastErr error
+	for _, addr := range addrs {
+		if err := c.attempt(ctx, peer, addr); err != nil {
+			lastErr = err
+
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			continue
+		}
+
+		return addr, nil
+	}
+
+	return "", lastErr
+}
+
+// connectParallel tries all of the given addresses at the same time and
+// returns as soon as one of them succeeds.
+func (c *peerConnector) connectParallel(ctx context.Context,
+	peer route.Vertex, addrs []string) (string, error) {
+
+	ctxc, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		addr string
+		err  error
+	}
+	results := make(chan result, len(addrs))
+	for _, addr := range addrs {
+		go func(addr string) {
+			results <- result{
+				addr: addr,
+				err:  c.attempt(ctxc, peer, addr),
+			}
+		}(addr)
+	}
+
+	var lastErr error
+	for range addrs {
+		res := <-results
+		if res.err == nil {
+			return res.addr, nil
+		}
+		lastErr = res.err
+	}
+
+	return "", lastErr
+}
+
+// attempt makes a single connection attempt to the peer at the given address
+// and records its outcome. Being connected already counts as success.
+func (c *peerConnector) attempt(ctx context.Context, peer route.Vertex,
+	addr string) error {
+
+	ctxt := ctx
+	if c.timeout > 0 {
+		var cancel func()
+		ctxt, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	err := c.connect(ctxt, addr)
+	if err != nil && strings.Contains(err.Error(), "already connected") {
+		err = nil
+	}
+
+	// An attempt that was aborted because another one succeeded in the
+	// meantime isn't worth reporting.
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		return err
+	}
+
+	c.attempts.add(peer, addr, err)
+	if err != nil {
+		log.Warnf("Unable to connect to trader at %x@%v: %v", peer[:],
+			addr, err)
+		return err
+	}
+
+	log.Debugf("Connected to trader at %x@%v", peer[:], addr)
+
+	return nil
+}
+
+// orderAddrs returns the string representation of the given addresses with
+// the preferred address first, followed by all clearnet and then all onion
+// addresses. The order of the advertised addresses is kept otherwise.
+func orderAddrs(addrs []net.Addr, preferred string) []string {
+	ordered := make([]string, 0, len(addrs))
+	seen := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		addrStr := addr.String()
+		if _, ok := seen[addrStr]; ok {
+			continue
+		}
+
+		seen[addrStr] = struct{}{}
+		ordered = append(ordered, addrStr)
+	}
+
+	rank := func(addr string) int {
+		switch {
+		case preferred != "" && addr == preferred:
+			return 0
+
+		case isOnionAddr(addr):
+			return 2
+
+		default:
+			return 1
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return rank(ordered[i]) < rank(ordered[j])
+	})
+
+	return ordered
+}
+
+// isOnionAddr returns true if the given host:port address is a Tor onion
+// address.
+func isOnionAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	return tor.IsOnionHost(host)
+}
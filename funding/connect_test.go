@@ -0,0 +1,182 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	clearnetAddr = &net.TCPAddr{IP: net.IP{1, 2, 3, 4}, Port: 9735}
+	onionAddr    = &net.TCPAddr{IP: net.IP{5, 6, 7, 8}, Port: 9735}
+	onionHost    = "3g2upl4pq6kufc4m.onion:9735"
+)
+
+// onionNetAddr is a net.Addr for an onion address.
+type onionNetAddr string
+
+func (a onionNetAddr) Network() string { return "tcp" }
+func (a onionNetAddr) String() string  { return string(a) }
+
+// TestOrderAddrs makes sure the preferred address comes first, followed by
+// clearnet and then onion addresses.
+func TestOrderAddrs(t *testing.T) {
+	t.Parallel()
+
+	addrs := []net.Addr{
+		onionNetAddr(onionHost), clearnetAddr, onionAddr, clearnetAddr,
+	}
+
+	require.Equal(t, []string{
+		clearnetAddr.String(), onionAddr.String(), onionHost,
+	}, orderAddrs(addrs, ""))
+
+	require.Equal(t, []string{
+		onionHost, clearnetAddr.String(), onionAddr.String(),
+	}, orderAddrs(addrs, onionHost))
+}
+
+// TestConnectPeer makes sure a peer is connected to with both strategies,
+// that failed rounds are retried and that all attempts are recorded.
+func TestConnectPeer(t *testing.T) {
+	t.Parallel()
+
+	peer := route.Vertex{1, 2, 3}
+	addrs := []net.Addr{clearnetAddr, onionNetAddr(onionHost)}
+	errTimeout := errors.New("dial timeout")
+
+	for _, strategy := range []ConnectStrategy{
+		ConnectSequential, ConnectParallel,
+	} {
+		strategy := strategy
+
+		t.Run(strategy.String(), func(t *testing.T) {
+			t.Parallel()
+
+			// The onion address only works in the second round,
+			// the clearnet address never does.
+			var (
+				mu    sync.Mutex
+				calls = make(map[string]int)
+			)
+			connect := func(_ context.Context, addr string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				calls[addr]++
+				if addr == onionHost && calls[addr] > 1 {
+					return nil
+				}
+
+				return errTimeout
+			}
+
+			attempts := newConnAttempts()
+			connector := &peerConnector{
+				strategy: strategy,
+				timeout:  time.Second,
+				connect:  connect,
+				attempts: attempts,
+				quit:     make(chan struct{}),
+			}
+
+			addr, err := connector.connectPeer(
+				context.Background(), peer, addrs, "",
+			)
+			require.NoError(t, err)
+			require.Equal(t, onionHost, addr)
+
+			mu.Lock()
+			require.Equal(t, 2, calls[onionHost])
+			mu.Unlock()
+
+			// The attempts of the parallel strategy can finish in
+			// any order.
+			summary := attempts.summary(peer)
+			require.Contains(
+				t, summary, clearnetAddr.String()+
+					" (dial timeout)",
+			)
+			require.Contains(t, summary, onionHost+" (ok)")
+		})
+	}
+}
+
+// TestConnectPeerDeadline makes sure we stop retrying once the overall
+// deadline is reached and that the failed attempts are reported to the
+// auctioneer.
+func TestConnectPeerDeadline(t *testing.T) {
+	t.Parallel()
+
+	peer := route.Vertex{1, 2, 3}
+	attempts := newConnAttempts()
+	connector := &peerConnector{
+		timeout: 10 * time.Millisecond,
+		connect: func(ctx context.Context, _ string) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		attempts: attempts,
+		quit:     make(chan struct{}),
+	}
+
+	ctxt, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	_, err := connector.connectPeer(
+		ctxt, peer, []net.Addr{clearnetAddr}, "",
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	nonce := order.Nonce{1}
+	batch := &order.Batch{
+		MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+			{2}: {{
+				NodeKey: peer,
+				Order: &order.Ask{
+					Kit: newKitFromTemplate(
+						nonce, &order.Kit{},
+					),
+				},
+			}},
+		},
+	}
+
+	m := &Manager{}
+	rejects := m.rejectFailedConnections(
+		map[route.Vertex]struct{}{peer: {}}, batch, attempts,
+	)
+	require.Equal(t, map[order.Nonce]*auctioneerrpc.OrderReject{
+		nonce: {
+			ReasonCode: auctioneerrpc.OrderReject_CHANNEL_FUNDING_FAILED,
+			Reason: "connection not established before timeout, " +
+				"tried " + clearnetAddr.String() +
+				" (context deadline exceeded)",
+		},
+	}, rejects)
+}
+
+// TestParseConnectStrategy makes sure all strategies can be parsed from their
+// names.
+func TestParseConnectStrategy(t *testing.T) {
+	t.Parallel()
+
+	for _, strategy := range []ConnectStrategy{
+		ConnectSequential, ConnectParallel,
+	} {
+		parsed, err := ParseConnectStrategy(strategy.String())
+		require.NoError(t, err)
+		require.Equal(t, strategy, parsed)
+	}
+
+	_, err := ParseConnectStrategy("random")
+	require.Error(t, err)
+}
@@ -0,0 +1,211 @@
+package funding
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// DefaultDownstreamTimeout is the default maximum time we wait for the
+	// downstream acceptor to decide about a channel.
+	DefaultDownstreamTimeout = 5 * time.Second
+
+	// DownstreamFailReject rejects a channel if the downstream acceptor
+	// can't be reached or doesn't decide in time.
+	DownstreamFailReject = "reject"
+
+	// DownstreamFailAccept accepts a channel if the downstream acceptor
+	// can't be reached or doesn't decide in time.
+	DownstreamFailAccept = "accept"
+
+	// schemeGRPC is the downstream URL scheme of a plaintext gRPC
+	// connection.
+	schemeGRPC = "grpc"
+
+	// schemeGRPCS is the downstream URL scheme of a gRPC connection over
+	// TLS.
+	schemeGRPCS = "grpcs"
+)
+
+// AcceptorConfig holds the configuration of the channel acceptor.
+type AcceptorConfig struct {
+	Downstream string        `long:"downstream" description:"The URL of an external DownstreamAcceptor gRPC service that decides about all incoming channels that aren't part of a batch, for example grpc://localhost:10040 for a plaintext connection or grpcs://acceptor.example.com:443 for TLS. All of these channels are accepted if this is not set."`
+	Timeout    time.Duration `long:"timeout" description:"The maximum time to wait for the downstream acceptor to decide about a channel. lnd processes one channel request at a time, so this also delays channels of a batch that arrive in the meantime. Valid time units are {s, m, h}."`
+	FailMode   string        `long:"failmode" description:"What to do with a channel if the downstream acceptor can't be reached, fails or doesn't decide within the timeout." choice:"reject" choice:"accept"`
+}
+
+// DefaultAcceptorConfig returns the default channel acceptor config which has
+// no downstream acceptor set.
+func DefaultAcceptorConfig() *AcceptorConfig {
+	return &AcceptorConfig{
+		Timeout:  DefaultDownstreamTimeout,
+		FailMode: DownstreamFailReject,
+	}
+}
+
+// Validate makes sure the config is valid.
+func (c *AcceptorConfig) Validate() error {
+	if c.Downstream != "" {
+		if _, err := parseDownstream(c.Downstream); err != nil {
+			return err
+		}
+	}
+
+	// lnd gives us as much time as the batch step timeout to answer. The
+	// downstream acceptor needs to answer before that, so we still have
+	// time to apply the fail mode.
+	if c.Timeout <= 0 || c.Timeout >= order.DefaultBatchStepTimeout {
+		return fmt.Errorf("downstream acceptor timeout must be "+
+			"positive and less than %v",
+			order.DefaultBatchStepTimeout)
+	}
+
+	switch c.FailMode {
+	case DownstreamFailReject, DownstreamFailAccept:
+	default:
+		return fmt.Errorf("unknown downstream acceptor fail mode: %v",
+			c.FailMode)
+	}
+
+	return nil
+}
+
+// parseDownstream parses the URL of a downstream acceptor and makes sure it
+// uses one of the supported schemes.
+func parseDownstream(downstream string) (*url.URL, error) {
+	downstreamURL, err := url.Parse(downstream)
+	if err != nil {
+		return nil, fmt.Errorf("invalid downstream acceptor %s: %v",
+			downstream, err)
+	}
+
+	switch downstreamURL.Scheme {
+	case schemeGRPC, schemeGRPCS:
+	default:
+		return nil, fmt.Errorf("invalid downstream acceptor %s: scheme "+
+			"must be %s:// or %s://", downstream, schemeGRPC,
+			schemeGRPCS)
+	}
+
+	if downstreamURL.Host == "" {
+		return nil, fmt.Errorf("invalid downstream acceptor %s: "+
+			"missing host", downstream)
+	}
+
+	return downstreamURL, nil
+}
+
+// DownstreamAcceptor decides about all incoming channels that aren't part of
+// a batch.
+type DownstreamAcceptor interface {
+	// AcceptChannel is called with every incoming channel request that
+	// doesn't belong to one of our bids. The context is canceled once the
+	// downstream timeout is reached.
+	AcceptChannel(ctx context.Context,
+		req *lndclient.AcceptorRequest) (*lndclient.AcceptorResponse,
+		error)
+}
+
+// grpcDownstreamAcceptor is a downstream acceptor that forwards every channel
+// request to an external DownstreamAcceptor gRPC service.
+type grpcDownstreamAcceptor struct {
+	conn   *grpc.ClientConn
+	client poolrpc.DownstreamAcceptorClient
+}
+
+// newGRPCDownstreamAcceptor creates a connection to the downstream acceptor at
+// the given URL. The connection is established lazily, so the acceptor doesn't
+// need to be reachable yet.
+func newGRPCDownstreamAcceptor(
+	downstream string) (*grpcDownstreamAcceptor, error) {
+
+	downstreamURL, err := parseDownstream(downstream)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if downstreamURL.Scheme == schemeGRPCS {
+		creds = credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+		})
+	}
+
+	conn, err := grpc.Dial(
+		downstreamURL.Host, grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to downstream "+
+			"acceptor: %v", err)
+	}
+
+	log.Infof("Downstream channel acceptor configured at %s",
+		downstreamURL.Host)
+
+	return &grpcDownstreamAcceptor{
+		conn:   conn,
+		client: poolrpc.NewDownstreamAcceptorClient(conn),
+	}, nil
+}
+
+// AcceptChannel forwards the channel request to the downstream acceptor and
+// returns its decision.
+//
+// NOTE: This method is part of the DownstreamAcceptor interface.
+func (a *grpcDownstreamAcceptor) AcceptChannel(ctx context.Context,
+	req *lndclient.AcceptorRequest) (*lndclient.AcceptorResponse, error) {
+
+	resp, err := a.client.AcceptChannel(ctx, marshallAcceptorRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &lndclient.AcceptorResponse{
+		Accept:          resp.Accept,
+		Error:           resp.Error,
+		UpfrontShutdown: resp.UpfrontShutdown,
+		CsvDelay:        resp.CsvDelay,
+		ReserveSat:      resp.ReserveSat,
+		InFlightMaxMsat: resp.InFlightMaxMsat,
+		MaxHtlcCount:    resp.MaxHtlcCount,
+		MinHtlcIn:       resp.MinHtlcIn,
+		MinAcceptDepth:  resp.MinAcceptDepth,
+	}, nil
+}
+
+// close closes the connection to the downstream acceptor.
+func (a *grpcDownstreamAcceptor) close() error {
+	return a.conn.Close()
+}
+
+// marshallAcceptorRequest translates a channel acceptor request into the
+// request sent to the downstream acceptor.
+func marshallAcceptorRequest(
+	req *lndclient.AcceptorRequest) *poolrpc.DownstreamAcceptRequest {
+
+	return &poolrpc.DownstreamAcceptRequest{
+		NodePubkey:           req.NodePubkey[:],
+		ChainHash:            req.ChainHash,
+		PendingChanId:        req.PendingChanID[:],
+		FundingAmtSat:        uint64(req.FundingAmt),
+		PushAmtMsat:          uint64(req.PushAmt),
+		DustLimitSat:         uint64(req.DustLimit),
+		MaxValueInFlightMsat: uint64(req.MaxValueInFlight),
+		ChannelReserveSat:    uint64(req.ChannelReserve),
+		MinHtlcMsat:          uint64(req.MinHtlc),
+		FeePerKw:             uint64(req.FeePerKw),
+		CsvDelay:             req.CsvDelay,
+		MaxAcceptedHtlcs:     req.MaxAcceptedHtlcs,
+		ChannelFlags:         req.ChannelFlags,
+	}
+}
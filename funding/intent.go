@@ -0,0 +1,426 @@
+package funding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+const (
+	// reasonInitiatorLost is the failure reason of a channel we opened
+	// that lnd no longer knows about. We can't restart the funding flow
+	// on our own as the counterparty has most likely given up on it.
+	reasonInitiatorLost = "lnd lost the pending channel we opened, the " +
+		"channel output needs to be recovered manually"
+)
+
+// newFundingIntent creates the funding intent of a channel from the funding
+// shim that was derived for it.
+func newFundingIntent(batchID order.BatchID, ourOrder order.Order,
+	matchedOrder *order.MatchedOrder, shim *lnrpc.FundingShim,
+	initiator bool) (*clientdb.FundingIntent, error) {
+
+	chanPointShim := shim.GetChanPointShim()
+	if chanPointShim == nil {
+		return nil, fmt.Errorf("funding shim is not a chan point shim")
+	}
+
+	// The lease duration is always the one of the bid.
+	bid, ok := ourOrder.(*order.Bid)
+	if !ok {
+		bid, ok = matchedOrder.Order.(*order.Bid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("no bid in match of order %v",
+			ourOrder.Nonce())
+	}
+	chanType := order.NegotiatedChannelType(
+		ourOrder.Details().ChannelType,
+		matchedOrder.Order.Details().ChannelType,
+	)
+
+	intent := &clientdb.FundingIntent{
+		BatchID:           batchID,
+		OrderNonce:        ourOrder.Nonce(),
+		MatchedOrderNonce: matchedOrder.Order.Nonce(),
+		NodeKey:           matchedOrder.NodeKey,
+		Initiator:         initiator,
+		Amt:               btcutil.Amount(chanPointShim.Amt),
+		ThawHeight:        chanPointShim.ThawHeight,
+		LeaseDuration:     bid.LeaseDuration,
+		ScriptEnforced:    chanType == order.ChannelTypeScriptEnforced,
+	}
+	copy(intent.PendingChanID[:], chanPointShim.PendingChanId)
+	copy(intent.RemoteKey[:], chanPointShim.RemoteKey)
+
+	txid, err := chainhash.NewHash(
+		chanPointShim.ChanPoint.GetFundingTxidBytes(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	intent.ChanPoint = wire.OutPoint{
+		Hash:  *txid,
+		Index: chanPointShim.ChanPoint.OutputIndex,
+	}
+
+	localKey := chanPointShim.LocalKey
+	localPubKey, err := btcec.ParsePubKey(localKey.RawKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	intent.LocalKey = &keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(localKey.KeyLoc.KeyFamily),
+			Index:  uint32(localKey.KeyLoc.KeyIndex),
+		},
+		PubKey: localPubKey,
+	}
+
+	return intent, nil
+}
+
+// fundingShimFromIntent re-creates the funding shim of a channel from its
+// persisted funding intent.
+func fundingShimFromIntent(intent *clientdb.FundingIntent) *lnrpc.FundingShim {
+	txid := intent.ChanPoint.Hash
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: txid[:],
+		},
+		OutputIndex: intent.ChanPoint.Index,
+	}
+
+	return &lnrpc.FundingShim{
+		Shim: &lnrpc.FundingShim_ChanPointShim{
+			ChanPointShim: &lnrpc.ChanPointShim{
+				Amt:       int64(intent.Amt),
+				ChanPoint: chanPoint,
+				LocalKey: &lnrpc.KeyDescriptor{
+					RawKeyBytes: intent.LocalKey.PubKey.
+						SerializeCompressed(),
+					KeyLoc: &lnrpc.KeyLocator{
+						KeyFamily: int32(
+							intent.LocalKey.Family,
+						),
+						KeyIndex: int32(
+							intent.LocalKey.Index,
+						),
+					},
+				},
+				RemoteKey:     intent.RemoteKey[:],
+				PendingChanId: intent.PendingChanID[:],
+				ThawHeight:    intent.ThawHeight,
+			},
+		},
+	}
+}
+
+// storeFundingIntent persists the funding intent of a channel we're about to
+// open or accept so its funding flow can be resumed after a restart.
+func (m *Manager) storeFundingIntent(batchID order.BatchID,
+	ourOrder order.Order, matchedOrder *order.MatchedOrder,
+	shim *lnrpc.FundingShim, initiator bool) error {
+
+	intent, err := newFundingIntent(
+		batchID, ourOrder, matchedOrder, shim, initiator,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create funding intent: %v", err)
+	}
+
+	if err := m.cfg.DB.StoreFundingIntent(intent); err != nil {
+		return fmt.Errorf("unable to store funding intent: %v", err)
+	}
+
+	return nil
+}
+
+// removeFundingIntents removes the funding intents of all channels that were
+// to be created by the given batch transaction.
+func (m *Manager) removeFundingIntents(batchTxHash chainhash.Hash) error {
+	intents, err := m.cfg.DB.FundingIntents()
+	if err != nil {
+		return err
+	}
+
+	for _, intent := range intents {
+		if intent.ChanPoint.Hash != batchTxHash {
+			continue
+		}
+
+		err := m.cfg.DB.DeleteFundingIntent(intent.PendingChanID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// completeFundingIntent audits the lease of the given channel and removes its
+// funding intent, if there is one, as its funding flow is complete.
+func (m *Manager) completeFundingIntent(channel *lnrpc.Channel) {
+	intents, err := m.cfg.DB.FundingIntents()
+	if err != nil {
+		log.Errorf("Unable to fetch funding intents: %v", err)
+		return
+	}
+
+	for _, intent := range intents {
+		if intent.ChanPoint.String() != channel.ChannelPoint {
+			continue
+		}
+
+		log.Debugf("Channel %v confirmed, removing funding intent",
+			channel.ChannelPoint)
+
+		if err := m.auditLease(intent, channel); err != nil {
+			log.Errorf("Unable to audit lease: %v", err)
+		}
+
+		err := m.trackLeaseChannel(intent, channel.ChanId)
+		if err != nil {
+			log.Errorf("Unable to track lease channel: %v", err)
+		}
+
+		err = m.cfg.DB.DeleteFundingIntent(intent.PendingChanID)
+		if err != nil {
+			log.Errorf("Unable to remove funding intent: %v", err)
+		}
+	}
+}
+
+// resumeFundingIntents reconciles all persisted funding intents with the
+// channels lnd knows about. Intents of channels that confirmed or were closed
+// are removed, shims that lnd lost are registered again and channels that
+// can't be recovered automatically are marked as failed.
+func (m *Manager) resumeFundingIntents(ctx context.Context) error {
+	intents, err := m.cfg.DB.FundingIntents()
+	if err != nil {
+		return fmt.Errorf("unable to fetch funding intents: %v", err)
+	}
+
+	// There's no need to query lnd if we didn't leave any funding flows
+	// behind.
+	if len(intents) == 0 {
+		return nil
+	}
+
+	log.Infof("Reconciling %d funding intent(s) with lnd", len(intents))
+
+	pending, done, open, err := m.knownChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, intent := range intents {
+		chanPoint := intent.ChanPoint.String()
+
+		switch {
+		// The channel made it through the funding flow and is either
+		// open or already closed again, nothing left to resume.
+		case done[chanPoint]:
+			log.Infof("Channel %v of batch %x completed its "+
+				"funding flow, removing funding intent",
+				chanPoint, intent.BatchID[:])
+
+			// We missed the channel opening while we were down,
+			// so we audit its lease now if it's still open. If it
+			// was closed already, the lease channel is updated
+			// once we reconcile it with lnd.
+			var chanID uint64
+			if channel, ok := open[chanPoint]; ok {
+				err := m.auditLease(intent, channel)
+				if err != nil {
+					return err
+				}
+
+				chanID = channel.ChanId
+			}
+
+			err := m.trackLeaseChannel(intent, chanID)
+			if err != nil {
+				return err
+			}
+
+			err = m.cfg.DB.DeleteFundingIntent(intent.PendingChanID)
+			if err != nil {
+				return err
+			}
+
+		// lnd still has the pending channel, we only need to wait for
+		// the batch to confirm. Someone might have fixed a previously
+		// failed channel manually.
+		case pending[chanPoint]:
+			if !intent.Failed() {
+				continue
+			}
+
+			intent.FailureReason = ""
+			intent.FailedAt = time.Time{}
+			err := m.cfg.DB.StoreFundingIntent(intent)
+			if err != nil {
+				return err
+			}
+
+		// We already reported this channel, there's nothing else we
+		// can do automatically.
+		case intent.Failed():
+
+		case intent.Initiator:
+			log.Errorf("Channel %v of batch %x to peer %x can't be "+
+				"resumed: %s", chanPoint, intent.BatchID[:],
+				intent.NodeKey[:], reasonInitiatorLost)
+
+			err := m.failFundingIntent(intent, reasonInitiatorLost)
+			if err != nil {
+				return err
+			}
+
+		default:
+			err := m.reregisterFundingShim(ctx, intent)
+			if err != nil {
+				reason := fmt.Sprintf("unable to re-register "+
+					"funding shim: %v", err)
+				log.Errorf("Channel %v of batch %x to peer %x "+
+					"can't be resumed: %s", chanPoint,
+					intent.BatchID[:], intent.NodeKey[:],
+					reason)
+
+				err := m.failFundingIntent(intent, reason)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// knownChannels returns the channel points of all channels lnd considers
+// pending open and of all channels that completed their funding flow, which
+// includes the ones that are already closed again. The channels that are
+// currently open are also returned by their channel point.
+func (m *Manager) knownChannels(ctx context.Context) (map[string]bool,
+	map[string]bool, map[string]*lnrpc.Channel, error) {
+
+	openChans, err := m.cfg.BaseClient.ListChannels(
+		ctx, &lnrpc.ListChannelsRequest{},
+	)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing open "+
+			"channels: %v", err)
+	}
+	pendingChans, err := m.cfg.LightningClient.PendingChannels(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing pending "+
+			"channels: %v", err)
+	}
+	closedChans, err := m.cfg.LightningClient.ClosedChannels(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing closed "+
+			"channels: %v", err)
+	}
+
+	pending := make(map[string]bool)
+	for _, pendingChan := range pendingChans.PendingOpen {
+		pending[pendingChan.ChannelPoint.String()] = true
+	}
+
+	done := make(map[string]bool)
+	open := make(map[string]*lnrpc.Channel)
+	for _, openChan := range openChans.Channels {
+		done[openChan.ChannelPoint] = true
+		open[openChan.ChannelPoint] = openChan
+	}
+	for _, closingChan := range pendingChans.WaitingClose {
+		done[closingChan.ChannelPoint.String()] = true
+	}
+	for _, closingChan := range pendingChans.PendingForceClose {
+		done[closingChan.ChannelPoint.String()] = true
+	}
+	for _, closedChan := range closedChans {
+		done[closedChan.ChannelPoint] = true
+	}
+
+	return pending, done, open, nil
+}
+
+// reregisterFundingShim registers the funding shim of a channel we expect the
+// counterparty to open with lnd again.
+func (m *Manager) reregisterFundingShim(ctx context.Context,
+	intent *clientdb.FundingIntent) error {
+
+	log.Infof("Re-registering funding shim for channel %v of batch %x",
+		intent.ChanPoint, intent.BatchID[:])
+
+	_, err := m.cfg.BaseClient.FundingStateStep(
+		ctx, &lnrpc.FundingTransitionMsg{
+			Trigger: &lnrpc.FundingTransitionMsg_ShimRegister{
+				ShimRegister: fundingShimFromIntent(intent),
+			},
+		},
+	)
+
+	// If only we were restarted but not lnd, the shim is still there.
+	if err != nil && !strings.Contains(err.Error(), "already has intent") {
+		return err
+	}
+
+	// The channel acceptor needs to know about the shim again to verify
+	// the push amount of the incoming channel.
+	if m.cfg.NotifyShimCreated == nil {
+		return nil
+	}
+	ourOrder, err := m.cfg.DB.GetOrder(intent.OrderNonce)
+	if err != nil {
+		return fmt.Errorf("unable to fetch order %v: %v",
+			intent.OrderNonce, err)
+	}
+	if ourBid, ok := ourOrder.(*order.Bid); ok {
+		m.cfg.NotifyShimCreated(ourBid, intent.PendingChanID)
+	}
+
+	return nil
+}
+
+// failFundingIntent marks the funding intent as failed so it is reported to
+// the user.
+func (m *Manager) failFundingIntent(intent *clientdb.FundingIntent,
+	reason string) error {
+
+	intent.FailureReason = reason
+	intent.FailedAt = time.Now()
+
+	return m.cfg.DB.StoreFundingIntent(intent)
+}
+
+// FundingFailures returns the funding intents of all channels whose funding
+// flow couldn't be resumed after a restart and need manual intervention.
+func (m *Manager) FundingFailures() ([]*clientdb.FundingIntent, error) {
+	intents, err := m.cfg.DB.FundingIntents()
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []*clientdb.FundingIntent
+	for _, intent := range intents {
+		if intent.Failed() {
+			failures = append(failures, intent)
+		}
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,109 @@
+package funding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResumeFundingIntents makes sure the persisted funding intents are
+// reconciled with the channels lnd knows about on startup.
+func TestResumeFundingIntents(t *testing.T) {
+	h := newManagerHarness(t)
+	defer h.stop()
+
+	_, localKey := test.CreateKey(0)
+	batchTxHash := chainhash.Hash{1, 2, 3}
+	newIntent := func(idx uint32, initiator bool) *clientdb.FundingIntent {
+		return &clientdb.FundingIntent{
+			PendingChanID: [32]byte{byte(idx)},
+			BatchID:       order.BatchID{4, 5, 6},
+			OrderNonce:    order.Nonce{byte(idx)},
+			NodeKey:       node1Key,
+			ChanPoint: wire.OutPoint{
+				Hash:  batchTxHash,
+				Index: idx,
+			},
+			Initiator: initiator,
+			Amt:       100_000,
+			LocalKey: &keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamilyMultiSig,
+					Index:  idx,
+				},
+				PubKey: localKey,
+			},
+			RemoteKey:     node2Key,
+			ThawHeight:    2016,
+			LeaseDuration: 2016,
+		}
+	}
+
+	// The first channel confirmed, the second one is still pending and lnd
+	// lost the last two, one of which we opened ourselves.
+	confirmed := newIntent(1, true)
+	pending := newIntent(2, false)
+	lostShim := newIntent(3, false)
+	lostChannel := newIntent(4, true)
+	for _, intent := range []*clientdb.FundingIntent{
+		confirmed, pending, lostShim, lostChannel,
+	} {
+		require.NoError(t, h.db.StoreFundingIntent(intent))
+	}
+
+	h.baseClientMock.channels = append(
+		h.baseClientMock.channels, &lnrpc.Channel{
+			ChannelPoint: confirmed.ChanPoint.String(),
+			ThawHeight:   confirmed.ThawHeight,
+		},
+	)
+	h.lnMock.ChannelsPending = append(
+		h.lnMock.ChannelsPending, lndclient.PendingChannel{
+			ChannelPoint: &pending.ChanPoint,
+		},
+	)
+
+	require.NoError(t, h.mgr.resumeFundingIntents(context.Background()))
+
+	// Only the shim lnd lost was registered again.
+	require.Len(t, h.baseClientMock.fundingShims, 1)
+	shim := h.baseClientMock.fundingShims[lostShim.PendingChanID]
+	require.Equal(
+		t, fundingShimFromIntent(lostShim).GetChanPointShim(), shim,
+	)
+
+	// The lease of the confirmed channel was audited before its intent was
+	// removed.
+	audits, err := h.mgr.LeaseAudits(false)
+	require.NoError(t, err)
+	require.Len(t, audits, 1)
+	require.Equal(t, confirmed.ChanPoint, audits[0].ChanPoint)
+	require.False(t, audits[0].Mismatch())
+
+	// The intent of the confirmed channel is gone, the one of the channel
+	// we can't resume is reported as a failure.
+	intents, err := h.db.FundingIntents()
+	require.NoError(t, err)
+	require.Len(t, intents, 3)
+
+	failures, err := h.mgr.FundingFailures()
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	require.Equal(t, lostChannel.PendingChanID, failures[0].PendingChanID)
+	require.Equal(t, reasonInitiatorLost, failures[0].FailureReason)
+
+	// Once the batch is replaced, all of its intents are removed.
+	require.NoError(t, h.mgr.removeFundingIntents(batchTxHash))
+	intents, err = h.db.FundingIntents()
+	require.NoError(t, err)
+	require.Empty(t, intents)
+}
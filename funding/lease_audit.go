@@ -0,0 +1,98 @@
+package funding
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// newLeaseAudit compares the lease of a channel that completed its funding
+// flow with the lease that was promised in its batch. We don't rely on lnd
+// having negotiated the channel with the parameters of our funding shim.
+func newLeaseAudit(intent *clientdb.FundingIntent,
+	channel *lnrpc.Channel) *clientdb.LeaseAuditEvent {
+
+	var (
+		confHeight = lnwire.NewShortChanIDFromInt(
+			channel.ChanId,
+		).BlockHeight
+		expectedExpiry, actualExpiry uint32
+		reason                       string
+	)
+	if intent.ScriptEnforced {
+		// The CLTV of a script enforced lease is an absolute height
+		// that we derived from the batch's height hint and the lease
+		// duration when registering the funding shim.
+		expectedExpiry = intent.ThawHeight
+		actualExpiry = channel.ThawHeight
+
+		scriptEnforced := lnrpc.CommitmentType_SCRIPT_ENFORCED_LEASE
+		if channel.CommitmentType != scriptEnforced {
+			reason = fmt.Sprintf("channel has commitment type %v "+
+				"instead of %v", channel.CommitmentType,
+				scriptEnforced)
+		}
+	} else {
+		// Other leases only start once the batch confirmed, lnd
+		// reports their thaw height relative to the confirmation.
+		expectedExpiry = confHeight + intent.LeaseDuration
+		actualExpiry = confHeight + channel.ThawHeight
+	}
+
+	if reason == "" && actualExpiry != expectedExpiry {
+		reason = fmt.Sprintf("lease expires at height %d instead of "+
+			"%d", actualExpiry, expectedExpiry)
+	}
+
+	return clientdb.NewLeaseAuditEvent(
+		intent.ChanPoint, intent.BatchID, intent.OrderNonce,
+		intent.ScriptEnforced, expectedExpiry, actualExpiry, reason,
+	)
+}
+
+// auditLease audits the lease of a channel that completed its funding flow and
+// adds the result to the lease event log. There's nothing we can do about a
+// mismatch at this point other than reporting it.
+func (m *Manager) auditLease(intent *clientdb.FundingIntent,
+	channel *lnrpc.Channel) error {
+
+	audit := newLeaseAudit(intent, channel)
+	if audit.Mismatch() {
+		log.Errorf("Lease of channel %v of batch %x to peer %x doesn't "+
+			"match the batch: %s", audit.ChanPoint,
+			intent.BatchID[:], intent.NodeKey[:], audit.Reason)
+	} else {
+		log.Infof("Lease of channel %v expires at height %d as "+
+			"promised in batch %x", audit.ChanPoint,
+			audit.ActualExpiry, intent.BatchID[:])
+	}
+
+	return m.cfg.DB.StoreLeaseAudit(audit)
+}
+
+// LeaseAudits returns the results of all lease audits. If mismatchesOnly is
+// set, only the audits of channels whose lease doesn't match their batch are
+// returned.
+func (m *Manager) LeaseAudits(
+	mismatchesOnly bool) ([]*clientdb.LeaseAuditEvent, error) {
+
+	audits, err := m.cfg.DB.LeaseAudits()
+	if err != nil {
+		return nil, err
+	}
+
+	if !mismatchesOnly {
+		return audits, nil
+	}
+
+	var mismatches []*clientdb.LeaseAuditEvent
+	for _, audit := range audits {
+		if audit.Mismatch() {
+			mismatches = append(mismatches, audit)
+		}
+	}
+
+	return mismatches, nil
+}
@@ -0,0 +1,109 @@
+package funding
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewLeaseAudit makes sure the lease of a channel is compared with the one
+// promised in the batch for both script enforced and other leases.
+func TestNewLeaseAudit(t *testing.T) {
+	t.Parallel()
+
+	const (
+		heightHint    = 700_000
+		confHeight    = 700_002
+		leaseDuration = 2016
+	)
+	chanID := lnwire.ShortChannelID{BlockHeight: confHeight}.ToUint64()
+	scriptEnforcedType := lnrpc.CommitmentType_SCRIPT_ENFORCED_LEASE
+
+	testCases := []struct {
+		name           string
+		scriptEnforced bool
+		commitType     lnrpc.CommitmentType
+		thawHeight     uint32
+		expectedExpiry uint32
+		actualExpiry   uint32
+		mismatch       bool
+	}{{
+		name:           "script enforced",
+		scriptEnforced: true,
+		commitType:     scriptEnforcedType,
+		thawHeight:     heightHint + leaseDuration,
+		expectedExpiry: heightHint + leaseDuration,
+		actualExpiry:   heightHint + leaseDuration,
+	}, {
+		name:           "script enforced wrong cltv",
+		scriptEnforced: true,
+		commitType:     scriptEnforcedType,
+		thawHeight:     confHeight + leaseDuration - 144,
+		expectedExpiry: heightHint + leaseDuration,
+		actualExpiry:   confHeight + leaseDuration - 144,
+		mismatch:       true,
+	}, {
+		name:           "script enforced wrong commitment type",
+		scriptEnforced: true,
+		commitType:     lnrpc.CommitmentType_ANCHORS,
+		thawHeight:     heightHint + leaseDuration,
+		expectedExpiry: heightHint + leaseDuration,
+		actualExpiry:   heightHint + leaseDuration,
+		mismatch:       true,
+	}, {
+		name:           "peer dependent",
+		commitType:     lnrpc.CommitmentType_ANCHORS,
+		thawHeight:     leaseDuration,
+		expectedExpiry: confHeight + leaseDuration,
+		actualExpiry:   confHeight + leaseDuration,
+	}, {
+		name:           "peer dependent wrong duration",
+		commitType:     lnrpc.CommitmentType_ANCHORS,
+		thawHeight:     144,
+		expectedExpiry: confHeight + leaseDuration,
+		actualExpiry:   confHeight + 144,
+		mismatch:       true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			intent := &clientdb.FundingIntent{
+				BatchID:    order.BatchID{1},
+				OrderNonce: order.Nonce{2},
+				ChanPoint: wire.OutPoint{
+					Hash:  chainhash.Hash{3},
+					Index: 1,
+				},
+				ThawHeight:     leaseDuration,
+				LeaseDuration:  leaseDuration,
+				ScriptEnforced: tc.scriptEnforced,
+			}
+			if tc.scriptEnforced {
+				intent.ThawHeight += heightHint
+			}
+
+			audit := newLeaseAudit(intent, &lnrpc.Channel{
+				ChannelPoint:   intent.ChanPoint.String(),
+				ChanId:         chanID,
+				CommitmentType: tc.commitType,
+				ThawHeight:     tc.thawHeight,
+			})
+			require.Equal(t, intent.ChanPoint, audit.ChanPoint)
+			require.Equal(t, intent.BatchID, audit.BatchID)
+			require.Equal(t, intent.OrderNonce, audit.OrderNonce)
+			require.Equal(t, tc.expectedExpiry, audit.ExpectedExpiry)
+			require.Equal(t, tc.actualExpiry, audit.ActualExpiry)
+			require.Equal(t, tc.mismatch, audit.Mismatch())
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package funding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// newLeaseChannel creates the lease channel of a channel that completed its
+// funding flow. The lease expiry of a script enforced lease is known from the
+// batch already, the one of other leases only once the channel confirmed.
+func newLeaseChannel(intent *clientdb.FundingIntent) *clientdb.LeaseChannel {
+	c := &clientdb.LeaseChannel{
+		ChanPoint:     intent.ChanPoint,
+		BatchID:       intent.BatchID,
+		OrderNonce:    intent.OrderNonce,
+		LeaseDuration: intent.LeaseDuration,
+		State:         clientdb.LeaseChannelOpen,
+	}
+	if intent.ScriptEnforced {
+		c.LeaseExpiry = intent.ThawHeight
+	}
+
+	return c
+}
+
+// confirmLeaseChannel sets the short channel ID of a lease channel and derives
+// its lease expiry from the confirmation height if it isn't known yet. It
+// returns true if the lease channel was changed.
+func confirmLeaseChannel(c *clientdb.LeaseChannel, chanID uint64) bool {
+	if chanID == 0 || c.ShortChanID.ToUint64() == chanID {
+		return false
+	}
+
+	c.ShortChanID = lnwire.NewShortChanIDFromInt(chanID)
+	if c.LeaseExpiry == 0 {
+		c.LeaseExpiry = c.ShortChanID.BlockHeight + c.LeaseDuration
+	}
+
+	return true
+}
+
+// closeLeaseChannel marks a lease channel as closed at the given height. If it
+// was force closed before its lease expired, it is flagged as closed early
+// because the premium was paid for a longer lease than the one the channel
+// actually provided.
+func closeLeaseChannel(c *clientdb.LeaseChannel, chanID uint64,
+	closeHeight uint32, forceClose bool) {
+
+	confirmLeaseChannel(c, chanID)
+
+	c.CloseHeight = closeHeight
+	c.State = clientdb.LeaseChannelClosed
+	if forceClose && closeHeight < c.LeaseExpiry {
+		c.State = clientdb.LeaseChannelClosedEarly
+	}
+}
+
+// trackLeaseChannel starts tracking the channel of a lease that completed its
+// funding flow. The given short channel ID is zero if the channel isn't known
+// to be confirmed yet.
+func (m *Manager) trackLeaseChannel(intent *clientdb.FundingIntent,
+	chanID uint64) error {
+
+	m.leaseChansMtx.Lock()
+	defer m.leaseChansMtx.Unlock()
+
+	c := newLeaseChannel(intent)
+	confirmLeaseChannel(c, chanID)
+
+	return m.cfg.DB.StoreLeaseChannel(c)
+}
+
+// channelClose describes how a channel was closed, as reported by lnd either
+// through a channel event or its list of closed channels.
+type channelClose struct {
+	chanPoint   string
+	chanID      uint64
+	closingTxid string
+	closeHeight uint32
+	closeType   lndclient.CloseType
+	remoteKey   route.Vertex
+	capacity    btcutil.Amount
+}
+
+// closeFromSummary creates the channel close described by the summary of a
+// channel close event.
+func closeFromSummary(
+	summary *lnrpc.ChannelCloseSummary) (*channelClose, error) {
+
+	var closeType lndclient.CloseType
+	switch summary.CloseType {
+	case lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE:
+		closeType = lndclient.CloseTypeCooperative
+
+	case lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE:
+		closeType = lndclient.CloseTypeLocalForce
+
+	case lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE:
+		closeType = lndclient.CloseTypeRemoteForce
+
+	case lnrpc.ChannelCloseSummary_BREACH_CLOSE:
+		closeType = lndclient.CloseTypeBreach
+
+	case lnrpc.ChannelCloseSummary_FUNDING_CANCELED:
+		closeType = lndclient.CloseTypeFundingCancelled
+
+	case lnrpc.ChannelCloseSummary_ABANDONED:
+		closeType = lndclient.CloseTypeAbandoned
+
+	default:
+		return nil, fmt.Errorf("unknown close type %v",
+			summary.CloseType)
+	}
+
+	remoteKey, err := route.NewVertexFromStr(summary.RemotePubkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote pubkey: %v", err)
+	}
+
+	return &channelClose{
+		chanPoint:   summary.ChannelPoint,
+		chanID:      summary.ChanId,
+		closingTxid: summary.ClosingTxHash,
+		closeHeight: summary.CloseHeight,
+		closeType:   closeType,
+		remoteKey:   remoteKey,
+		capacity:    btcutil.Amount(summary.Capacity),
+	}, nil
+}
+
+// closeFromClosedChannel creates the channel close described by a closed
+// channel lnd returned.
+func closeFromClosedChannel(closedChan lndclient.ClosedChannel) *channelClose {
+	return &channelClose{
+		chanPoint:   closedChan.ChannelPoint,
+		chanID:      closedChan.ChannelID,
+		closingTxid: closedChan.ClosingTxHash,
+		closeHeight: closedChan.CloseHeight,
+		closeType:   closedChan.CloseType,
+		remoteKey:   closedChan.PubKeyBytes,
+		capacity:    closedChan.Capacity,
+	}
+}
+
+// forceClose returns true if the channel was closed unilaterally.
+func (c *channelClose) forceClose() bool {
+	switch c.closeType {
+	case lndclient.CloseTypeLocalForce, lndclient.CloseTypeRemoteForce,
+		lndclient.CloseTypeBreach:
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// handleChannelClose updates the lease channel of the channel described by the
+// given close summary, if it is one we track.
+func (m *Manager) handleChannelClose(summary *lnrpc.ChannelCloseSummary) error {
+	m.leaseChansMtx.Lock()
+	defer m.leaseChansMtx.Unlock()
+
+	channels, err := m.cfg.DB.LeaseChannels()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range channels {
+		if c.Closed() || c.ChanPoint.String() != summary.ChannelPoint {
+			continue
+		}
+
+		chanClose, err := closeFromSummary(summary)
+		if err != nil {
+			return err
+		}
+
+		return m.closeLeaseChannel(c, chanClose)
+	}
+
+	return nil
+}
+
+// closeLeaseChannel stores the close of a lease channel. If the channel was
+// closed before its lease expired, the early close is added to the lease event
+// log and sent to all subscribers.
+//
+// NOTE: The lease channel mutex must be held when calling this method.
+func (m *Manager) closeLeaseChannel(c *clientdb.LeaseChannel,
+	chanClose *channelClose) error {
+
+	closeLeaseChannel(
+		c, chanClose.chanID, chanClose.closeHeight,
+		chanClose.forceClose(),
+	)
+	log.Infof("Channel %v of batch %x closed at height %d: %v",
+		c.ChanPoint, c.BatchID[:], c.CloseHeight, c.State)
+
+	if err := m.cfg.DB.StoreLeaseChannel(c); err != nil {
+		return err
+	}
+
+	if c.State != clientdb.LeaseChannelClosedEarly {
+		return nil
+	}
+
+	closingTxid, err := chainhash.NewHashFromStr(chanClose.closingTxid)
+	if err != nil {
+		return fmt.Errorf("invalid closing txid: %v", err)
+	}
+
+	// Whether we bought or sold the channel decides which side was
+	// short-changed by the early close.
+	ourOrder, err := m.cfg.DB.GetOrder(c.OrderNonce)
+	if err != nil {
+		return fmt.Errorf("unable to fetch order %v: %v", c.OrderNonce,
+			err)
+	}
+
+	evt := clientdb.NewLeaseClosedEarlyEvent(
+		c, *closingTxid, ourOrder.Type() == order.TypeBid,
+		chanClose.remoteKey, chanClose.capacity, chanClose.closeType,
+	)
+	log.Warnf("Channel %v of batch %x to peer %x was closed by a %v "+
+		"close at height %d, %d blocks before its lease expired",
+		c.ChanPoint, c.BatchID[:], evt.RemoteNodeKey[:],
+		evt.CloseType, evt.CloseHeight, evt.BlocksRemaining())
+
+	if err := m.cfg.DB.StoreLeaseClosedEarly(evt); err != nil {
+		return err
+	}
+
+	return m.leaseEventServer.SendUpdate(evt)
+}
+
+// SubscribeLeaseEvents creates a new subscription client that receives an
+// update every time the channel of a lease is closed before the lease expired.
+func (m *Manager) SubscribeLeaseEvents() (*subscribe.Client, error) {
+	return m.leaseEventServer.Subscribe()
+}
+
+// LeasesClosedEarly returns all recorded early closes of lease channels in
+// chronological order.
+func (m *Manager) LeasesClosedEarly() ([]*clientdb.LeaseClosedEarlyEvent,
+	error) {
+
+	return m.cfg.DB.LeasesClosedEarly()
+}
+
+// reconcileLeaseChannels looks up the channels of all leases we consider open
+// in lnd and stores their short channel ID once they confirmed and whether
+// they were closed while we weren't watching.
+func (m *Manager) reconcileLeaseChannels(ctx context.Context) error {
+	m.leaseChansMtx.Lock()
+	defer m.leaseChansMtx.Unlock()
+
+	channels, err := m.cfg.DB.LeaseChannels()
+	if err != nil {
+		return fmt.Errorf("unable to fetch lease channels: %v", err)
+	}
+
+	var openChannels []*clientdb.LeaseChannel
+	for _, c := range channels {
+		if !c.Closed() {
+			openChannels = append(openChannels, c)
+		}
+	}
+
+	// There's no need to query lnd if all leases are closed already.
+	if len(openChannels) == 0 {
+		return nil
+	}
+
+	log.Infof("Reconciling %d lease channel(s) with lnd",
+		len(openChannels))
+
+	openChans, err := m.cfg.BaseClient.ListChannels(
+		ctx, &lnrpc.ListChannelsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("error listing open channels: %v", err)
+	}
+	closedChans, err := m.cfg.LightningClient.ClosedChannels(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing closed channels: %v", err)
+	}
+
+	open := make(map[string]*lnrpc.Channel, len(openChans.Channels))
+	for _, openChan := range openChans.Channels {
+		open[openChan.ChannelPoint] = openChan
+	}
+	closed := make(map[string]lndclient.ClosedChannel, len(closedChans))
+	for _, closedChan := range closedChans {
+		closed[closedChan.ChannelPoint] = closedChan
+	}
+
+	for _, c := range openChannels {
+		chanPoint := c.ChanPoint.String()
+		openChan, isOpen := open[chanPoint]
+		closedChan, isClosed := closed[chanPoint]
+
+		switch {
+		case isClosed:
+			err := m.closeLeaseChannel(
+				c, closeFromClosedChannel(closedChan),
+			)
+			if err != nil {
+				return err
+			}
+
+			continue
+
+		// We only need to store the short channel ID if we didn't
+		// know it yet.
+		case isOpen:
+			if !confirmLeaseChannel(c, openChan.ChanId) {
+				continue
+			}
+
+		// lnd might still be waiting for the channel's closing
+		// transaction to confirm.
+		default:
+			continue
+		}
+
+		if err := m.cfg.DB.StoreLeaseChannel(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
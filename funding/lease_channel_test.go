@@ -0,0 +1,210 @@
+package funding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCloseLeaseChannel makes sure only force closes before the lease expired
+// are flagged as closed early.
+func TestCloseLeaseChannel(t *testing.T) {
+	t.Parallel()
+
+	const (
+		confHeight    = 700_000
+		leaseDuration = 2016
+	)
+	chanID := lnwire.ShortChannelID{BlockHeight: confHeight}.ToUint64()
+
+	testCases := []struct {
+		name          string
+		closeHeight   uint32
+		forceClose    bool
+		expectedState clientdb.LeaseChannelState
+	}{{
+		name:          "cooperative close before expiry",
+		closeHeight:   confHeight + 100,
+		expectedState: clientdb.LeaseChannelClosed,
+	}, {
+		name:          "force close before expiry",
+		closeHeight:   confHeight + 100,
+		forceClose:    true,
+		expectedState: clientdb.LeaseChannelClosedEarly,
+	}, {
+		name:          "force close after expiry",
+		closeHeight:   confHeight + leaseDuration,
+		forceClose:    true,
+		expectedState: clientdb.LeaseChannelClosed,
+	}}
+
+	for _, tc := range testCases {
+		c := newLeaseChannel(&clientdb.FundingIntent{
+			LeaseDuration: leaseDuration,
+		})
+		closeLeaseChannel(c, chanID, tc.closeHeight, tc.forceClose)
+
+		require.Equal(t, chanID, c.ShortChanID.ToUint64(), tc.name)
+		require.EqualValues(
+			t, confHeight+leaseDuration, c.LeaseExpiry, tc.name,
+		)
+		require.Equal(t, tc.closeHeight, c.CloseHeight, tc.name)
+		require.Equal(t, tc.expectedState, c.State, tc.name)
+	}
+}
+
+// TestReconcileLeaseChannels makes sure the channels of our leases are tracked
+// once they completed their funding flow and are updated with what lnd knows
+// about them.
+func TestReconcileLeaseChannels(t *testing.T) {
+	h := newManagerHarness(t)
+	defer h.stop()
+
+	const (
+		heightHint    = 700_000
+		confHeight    = 700_002
+		leaseDuration = 2016
+	)
+	chanID := lnwire.ShortChannelID{BlockHeight: confHeight}.ToUint64()
+	_, localKey := test.CreateKey(0)
+	newIntent := func(idx uint32) *clientdb.FundingIntent {
+		return &clientdb.FundingIntent{
+			PendingChanID: [32]byte{byte(idx)},
+			BatchID:       order.BatchID{4, 5, 6},
+			OrderNonce:    order.Nonce{byte(idx)},
+			ChanPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{1, 2, 3},
+				Index: idx,
+			},
+			LocalKey: &keychain.KeyDescriptor{
+				PubKey: localKey,
+			},
+			ThawHeight:     heightHint + leaseDuration,
+			LeaseDuration:  leaseDuration,
+			ScriptEnforced: true,
+		}
+	}
+
+	// The first channel confirmed while we were down, the second one was
+	// even force closed already.
+	open := newIntent(1)
+	closed := newIntent(2)
+	require.NoError(t, h.db.StoreFundingIntent(open))
+	require.NoError(t, h.db.StoreFundingIntent(closed))
+
+	// We bought the channel that was closed early.
+	require.NoError(t, h.db.SubmitOrder(&order.Bid{
+		Kit: *order.NewKit(closed.OrderNonce),
+	}))
+
+	h.baseClientMock.channels = append(
+		h.baseClientMock.channels, &lnrpc.Channel{
+			ChannelPoint: open.ChanPoint.String(),
+			ChanId:       chanID,
+			ThawHeight:   open.ThawHeight,
+		},
+	)
+	closingTxid := chainhash.Hash{7, 8, 9}
+	remoteKey := route.Vertex{2, 3, 4}
+	h.lnMock.ChannelsClosed = append(
+		h.lnMock.ChannelsClosed, lndclient.ClosedChannel{
+			ChannelPoint:  closed.ChanPoint.String(),
+			ChannelID:     chanID + 1,
+			ClosingTxHash: closingTxid.String(),
+			CloseType:     lndclient.CloseTypeRemoteForce,
+			CloseHeight:   confHeight + 10,
+			PubKeyBytes:   remoteKey,
+			Capacity:      1_000_000,
+		},
+	)
+
+	leaseEvents, err := h.mgr.SubscribeLeaseEvents()
+	require.NoError(t, err)
+	defer leaseEvents.Cancel()
+
+	ctx := context.Background()
+	require.NoError(t, h.mgr.resumeFundingIntents(ctx))
+	require.NoError(t, h.mgr.reconcileLeaseChannels(ctx))
+
+	channels, err := h.db.LeaseChannels()
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+
+	leaseChans := make(map[wire.OutPoint]*clientdb.LeaseChannel)
+	for _, c := range channels {
+		leaseChans[c.ChanPoint] = c
+	}
+
+	openChan := leaseChans[open.ChanPoint]
+	require.Equal(t, chanID, openChan.ShortChanID.ToUint64())
+	require.EqualValues(t, heightHint+leaseDuration, openChan.LeaseExpiry)
+	require.Equal(t, clientdb.LeaseChannelOpen, openChan.State)
+
+	closedChan := leaseChans[closed.ChanPoint]
+	require.Equal(t, chanID+1, closedChan.ShortChanID.ToUint64())
+	require.Equal(t, clientdb.LeaseChannelClosedEarly, closedChan.State)
+	require.EqualValues(t, confHeight+10, closedChan.CloseHeight)
+
+	// The early close is recorded and sent to subscribers.
+	closedEarly, err := h.db.LeasesClosedEarly()
+	require.NoError(t, err)
+	require.Len(t, closedEarly, 1)
+
+	evt := closedEarly[0]
+	require.Equal(t, closed.ChanPoint, evt.ChanPoint)
+	require.Equal(t, closingTxid, evt.ClosingTxid)
+	require.Equal(t, closed.OrderNonce, evt.OrderNonce)
+	require.True(t, evt.Purchased)
+	require.EqualValues(t, remoteKey, evt.RemoteNodeKey)
+	require.Equal(t, lndclient.CloseTypeRemoteForce, evt.CloseType)
+	require.EqualValues(
+		t, heightHint+leaseDuration-confHeight-10,
+		evt.BlocksRemaining(),
+	)
+
+	select {
+	case update := <-leaseEvents.Updates():
+		require.Equal(t, closed.ChanPoint, update.(*clientdb.
+			LeaseClosedEarlyEvent).ChanPoint)
+
+	case <-time.After(time.Second):
+		t.Fatalf("no lease event received")
+	}
+
+	// Once the open channel is closed cooperatively, it is marked as
+	// closed. Channels we don't know are ignored.
+	require.NoError(t, h.mgr.handleChannelClose(&lnrpc.ChannelCloseSummary{
+		ChannelPoint: "0000000000000000000000000000000000000000000000" +
+			"000000000000000000:0",
+		CloseType: lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE,
+	}))
+	require.NoError(t, h.mgr.handleChannelClose(&lnrpc.ChannelCloseSummary{
+		ChannelPoint: open.ChanPoint.String(),
+		ChanId:       chanID,
+		CloseHeight:  confHeight + 20,
+		CloseType:    lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE,
+		RemotePubkey: remoteKey.String(),
+	}))
+
+	channels, err = h.db.LeaseChannels()
+	require.NoError(t, err)
+	for _, c := range channels {
+		require.True(t, c.Closed())
+		if c.ChanPoint == open.ChanPoint {
+			require.Equal(t, clientdb.LeaseChannelClosed, c.State)
+			require.EqualValues(t, confHeight+20, c.CloseHeight)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package funding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/order"
+)
+
+// LeaseEvidence collects everything needed to prove to the auctioneer that the
+// channel with the given channel point was closed before its lease expired:
+// the lease channel itself, our snapshot of its batch, our order together with
+// a signature of its trader key and the channel's funding and closing
+// transactions.
+func (m *Manager) LeaseEvidence(ctx context.Context,
+	chanPoint wire.OutPoint) (*clientdb.LeaseEvidence, error) {
+
+	channels, err := m.cfg.DB.LeaseChannels()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch lease channels: %v", err)
+	}
+
+	var leaseChan *clientdb.LeaseChannel
+	for _, c := range channels {
+		if c.ChanPoint == chanPoint {
+			leaseChan = c
+			break
+		}
+	}
+	if leaseChan == nil {
+		return nil, fmt.Errorf("no lease channel with channel point "+
+			"%v found", chanPoint)
+	}
+	if !leaseChan.Closed() {
+		return nil, fmt.Errorf("channel %v is still open", chanPoint)
+	}
+
+	snapshot, err := m.cfg.DB.GetLocalBatchSnapshot(leaseChan.BatchID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch snapshot of batch %x: "+
+			"%v", leaseChan.BatchID[:], err)
+	}
+
+	// The batch transaction is the one that funded the channel, we make
+	// sure it matches before we hand it out as evidence.
+	if snapshot.BatchTX == nil ||
+		snapshot.BatchTX.TxHash() != chanPoint.Hash {
+
+		return nil, fmt.Errorf("batch %x did not fund channel %v",
+			leaseChan.BatchID[:], chanPoint)
+	}
+
+	ourOrder, err := m.cfg.DB.GetOrder(leaseChan.OrderNonce)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch order %v: %v",
+			leaseChan.OrderNonce, err)
+	}
+	orderSig, err := m.signOrder(ctx, ourOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	closeTx, err := m.closingTx(ctx, leaseChan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientdb.LeaseEvidence{
+		Version:  clientdb.LatestLeaseEvidenceVersion,
+		Channel:  leaseChan,
+		Snapshot: snapshot,
+		Order:    ourOrder,
+		OrderSig: orderSig,
+		OpenTx:   snapshot.BatchTX,
+		CloseTx:  closeTx,
+	}, nil
+}
+
+// signOrder signs the digest of the given order with the trader key of its
+// account, the same way the order was signed when it was submitted.
+func (m *Manager) signOrder(ctx context.Context,
+	ourOrder order.Order) ([]byte, error) {
+
+	acctKey := ourOrder.Details().AcctKey
+	traderKey, err := btcec.ParsePubKey(acctKey[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid account key: %v", err)
+	}
+	acct, err := m.cfg.DB.Account(traderKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch account %x: %v",
+			acctKey[:], err)
+	}
+
+	digest, err := ourOrder.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("could not digest order: %v", err)
+	}
+	sig, err := m.cfg.SignerClient.SignMessage(
+		ctx, digest[:], acct.TraderKey.KeyLocator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign order: %v", err)
+	}
+
+	return sig, nil
+}
+
+// closingTx looks up the transaction that closed the given lease channel in
+// lnd.
+func (m *Manager) closingTx(ctx context.Context,
+	c *clientdb.LeaseChannel) (*wire.MsgTx, error) {
+
+	closedChans, err := m.cfg.LightningClient.ClosedChannels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing closed channels: %v", err)
+	}
+
+	var closingTxid string
+	for _, closedChan := range closedChans {
+		if closedChan.ChannelPoint == c.ChanPoint.String() {
+			closingTxid = closedChan.ClosingTxHash
+			break
+		}
+	}
+	if closingTxid == "" {
+		return nil, fmt.Errorf("channel %v not found in lnd's closed "+
+			"channels", c.ChanPoint)
+	}
+
+	// The closing transaction always spends the channel output, but it
+	// is only known to lnd's wallet if it pays to one of our outputs.
+	height := int32(c.CloseHeight)
+	txs, err := m.cfg.LightningClient.ListTransactions(ctx, height, height)
+	if err != nil {
+		return nil, fmt.Errorf("error listing transactions: %v", err)
+	}
+	for _, tx := range txs {
+		if tx.TxHash == closingTxid {
+			return tx.Tx, nil
+		}
+	}
+
+	return nil, fmt.Errorf("closing transaction %v of channel %v not "+
+		"found in lnd's wallet", closingTxid, c.ChanPoint)
+}
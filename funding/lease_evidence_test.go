@@ -0,0 +1,117 @@
+package funding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLeaseEvidence makes sure the evidence of a lease channel that was closed
+// early is collected from our database and lnd.
+func TestLeaseEvidence(t *testing.T) {
+	h := newManagerHarness(t)
+	defer h.stop()
+
+	const closeHeight = 700_010
+
+	_, traderKey := test.CreateKey(1)
+	_, auctioneerKey := test.CreateKey(2)
+	_, batchKey := test.CreateKey(3)
+	acct := &account.Account{
+		Value:  1_000_000,
+		Expiry: 800_000,
+		TraderKey: &keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: 220,
+				Index:  4,
+			},
+			PubKey: traderKey,
+		},
+		AuctioneerKey: auctioneerKey,
+		BatchKey:      batchKey,
+		State:         account.StateInitiated,
+	}
+	require.NoError(t, h.db.AddAccount(acct))
+
+	bid := &order.Bid{Kit: *order.NewKit(order.Nonce{1})}
+	bid.LeaseDuration = 2016
+	copy(bid.AcctKey[:], traderKey.SerializeCompressed())
+	require.NoError(t, h.db.SubmitOrder(bid))
+
+	batchTx := wire.NewMsgTx(2)
+	batchTx.AddTxIn(&wire.TxIn{SignatureScript: []byte{}})
+	batchTx.AddTxOut(&wire.TxOut{Value: 100_000, PkScript: []byte{1}})
+	batch := &order.Batch{
+		ID:           order.BatchID{4, 5, 6},
+		ExecutionFee: terms.NewLinearFeeSchedule(1, 1000),
+		BatchTX:      batchTx,
+	}
+	require.NoError(t, h.db.StorePendingBatch(
+		batch, []order.Nonce{bid.Nonce()}, [][]order.Modifier{nil},
+		nil, nil,
+	))
+	require.NoError(t, h.db.MarkBatchComplete(batch.ID))
+
+	leaseChan := &clientdb.LeaseChannel{
+		ChanPoint:     wire.OutPoint{Hash: batchTx.TxHash()},
+		BatchID:       batch.ID,
+		OrderNonce:    bid.Nonce(),
+		LeaseDuration: bid.LeaseDuration,
+		LeaseExpiry:   702_016,
+	}
+	require.NoError(t, h.db.StoreLeaseChannel(leaseChan))
+
+	// There is no evidence as long as the channel is open.
+	ctx := context.Background()
+	_, err := h.mgr.LeaseEvidence(ctx, leaseChan.ChanPoint)
+	require.ErrorContains(t, err, "still open")
+
+	leaseChan.State = clientdb.LeaseChannelClosedEarly
+	leaseChan.CloseHeight = closeHeight
+	require.NoError(t, h.db.StoreLeaseChannel(leaseChan))
+
+	// The closing transaction is looked up in lnd's wallet.
+	closeTx := wire.NewMsgTx(2)
+	closeTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: leaseChan.ChanPoint,
+		SignatureScript:  []byte{},
+	})
+	closeTx.AddTxOut(&wire.TxOut{Value: 90_000, PkScript: []byte{2}})
+	h.lnMock.ChannelsClosed = append(
+		h.lnMock.ChannelsClosed, lndclient.ClosedChannel{
+			ChannelPoint:  leaseChan.ChanPoint.String(),
+			ClosingTxHash: closeTx.TxHash().String(),
+			CloseType:     lndclient.CloseTypeRemoteForce,
+			CloseHeight:   closeHeight,
+		},
+	)
+	_, err = h.mgr.LeaseEvidence(ctx, leaseChan.ChanPoint)
+	require.ErrorContains(t, err, "not found in lnd's wallet")
+
+	h.lnMock.Transactions = append(
+		h.lnMock.Transactions, lndclient.Transaction{
+			Tx:     closeTx,
+			TxHash: closeTx.TxHash().String(),
+		},
+	)
+	h.signerMock.Signature = []byte{7, 8, 9}
+
+	evidence, err := h.mgr.LeaseEvidence(ctx, leaseChan.ChanPoint)
+	require.NoError(t, err)
+	require.Equal(t, clientdb.LatestLeaseEvidenceVersion, evidence.Version)
+	require.Equal(t, leaseChan, evidence.Channel)
+	require.Equal(t, batch.ID, evidence.Snapshot.BatchID)
+	require.Equal(t, bid.Nonce(), evidence.Order.Nonce())
+	require.Equal(t, []byte{7, 8, 9}, evidence.OrderSig)
+	require.Equal(t, batchTx.TxHash(), evidence.OpenTx.TxHash())
+	require.Equal(t, closeTx, evidence.CloseTx)
+}
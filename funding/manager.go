@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"net"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -26,7 +25,6 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/subscribe"
-	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -39,21 +37,6 @@ var (
 	rpcCodeFundingFailed = auctioneerrpc.OrderReject_CHANNEL_FUNDING_FAILED
 )
 
-// MatchRejectErr is an error type that is returned from the funding manager if
-// the trader rejects certain orders instead of the whole batch.
-type MatchRejectErr struct {
-	// RejectedOrders is the map of matches we reject, keyed with our order
-	// nonce.
-	RejectedOrders map[order.Nonce]*auctioneerrpc.OrderReject
-}
-
-// Error returns the underlying error string.
-//
-// NOTE: This is part of the error interface.
-func (e *MatchRejectErr) Error() string {
-	return fmt.Sprintf("trader rejected orders: %v", e.RejectedOrders)
-}
-
 // BaseClient is an interface that contains all methods necessary to open a
 // channel with a funding shim and query peer connections.
 type BaseClient interface {
@@ -89,6 +72,11 @@ type BaseClient interface {
 	AbandonChannel(ctx context.Context, in *lnrpc.AbandonChannelRequest,
 		opts ...grpc.CallOption) (*lnrpc.AbandonChannelResponse, error)
 
+	// ListChannels returns a description of all the open channels that
+	// this node is a participant in.
+	ListChannels(ctx context.Context, in *lnrpc.ListChannelsRequest,
+		opts ...grpc.CallOption) (*lnrpc.ListChannelsResponse, error)
+
 	// SubscribeChannelEvents creates a uni-directional stream from the
 	// server to the client in which any updates relevant to the state of
 	// the channels are sent over. Events include new active channels,
@@ -101,7 +89,7 @@ type BaseClient interface {
 // ManagerConfig holds all the items passed into the funding manager externally.
 type ManagerConfig struct {
 	// DB is the client database.
-	DB *clientdb.DB
+	DB clientdb.Store
 
 	// WalletKit is an lndclient wrapped walletrpc client.
 	WalletKit lndclient.WalletKitClient
@@ -132,6 +120,20 @@ type ManagerConfig struct {
 	// funding shim is created for a bid order where we expect an incoming
 	// channel at any moment.
 	NotifyShimCreated func(ourBid *order.Bid, pendingChanID [32]byte)
+
+	// SidecarTicketExpiry is the duration after which an offered sidecar
+	// ticket expires. If zero, tickets are created with the default
+	// version that doesn't support an expiry.
+	SidecarTicketExpiry time.Duration
+
+	// PeerConnectStrategy determines whether the addresses of a matched
+	// peer are tried one after the other or all at the same time.
+	PeerConnectStrategy ConnectStrategy
+
+	// PeerConnectTimeout is the maximum time a single connection attempt
+	// to one address of a matched peer can take. If zero, only the
+	// overall batch step timeout applies.
+	PeerConnectTimeout time.Duration
 }
 
 // Manager is responsible for everything channel funding related during the
@@ -148,6 +150,21 @@ type Manager struct {
 	pendingOpenChanCancel func()
 	pendingOpenChanServer *subscribe.Server
 	pendingOpenChanClient *subscribe.Client
+
+	// peerAddrs is the address we last successfully connected to for
+	// each matched peer. We try that address first the next time we're
+	// matched with the same peer.
+	peerAddrs    map[route.Vertex]string
+	peerAddrsMtx sync.Mutex
+
+	// leaseChansMtx serializes the updates of the stored lease channels
+	// that can be triggered by channel events and the reconciliation with
+	// lnd at the same time.
+	leaseChansMtx sync.Mutex
+
+	// leaseEventServer notifies subscribers about lease channels that
+	// were closed before their lease expired.
+	leaseEventServer *subscribe.Server
 }
 
 // NewManager creates a new funding manager from the given config.
@@ -156,6 +173,8 @@ func NewManager(cfg *ManagerConfig) *Manager {
 		cfg:                   cfg,
 		quit:                  make(chan struct{}),
 		pendingOpenChanServer: subscribe.NewServer(),
+		peerAddrs:             make(map[route.Vertex]string),
+		leaseEventServer:      subscribe.NewServer(),
 	}
 }
 
@@ -182,6 +201,10 @@ func (m *Manager) Start() error {
 		return fmt.Errorf("error starting pending chan subscription "+
 			"server: %v", err)
 	}
+	if err := m.leaseEventServer.Start(); err != nil {
+		return fmt.Errorf("error starting lease event subscription "+
+			"server: %v", err)
+	}
 
 	// We want to make sure we don't miss any channel updates as long as we
 	// are running. But we might not be the only manager interested in the
@@ -195,6 +218,24 @@ func (m *Manager) Start() error {
 	m.wg.Add(1)
 	go m.consumePendingOpenChannels(subStream)
 
+	// If we were shut down in the middle of funding the channels of a
+	// batch, we need to make sure lnd is still able to complete them.
+	if m.cfg.DB != nil {
+		err := m.resumeFundingIntents(context.Background())
+		if err != nil {
+			return fmt.Errorf("error resuming funding intents: %v",
+				err)
+		}
+
+		// We might have missed channels of our leases confirming or
+		// closing while we were down.
+		err = m.reconcileLeaseChannels(context.Background())
+		if err != nil {
+			log.Errorf("Unable to reconcile lease channels: %v",
+				err)
+		}
+	}
+
 	log.Infof("Funding manager is now active")
 
 	return nil
@@ -221,6 +262,11 @@ func (m *Manager) Stop() error {
 
 	m.wg.Wait()
 
+	if err := m.leaseEventServer.Stop(); err != nil {
+		return fmt.Errorf("error stopping lease event subscription "+
+			"server: %v", err)
+	}
+
 	log.Info("Stopped funding manager")
 
 	return nil
@@ -260,6 +306,23 @@ func (m *Manager) consumePendingOpenChannels(
 			continue
 		}
 
+		// Once a channel is open, there's nothing left to resume
+		// after a restart, but we still audit its lease.
+		openChan := msg.GetOpenChannel()
+		if openChan != nil && m.cfg.DB != nil {
+			m.completeFundingIntent(openChan)
+		}
+
+		// Closing the channel of a lease before it expired affects
+		// what the lease was actually worth, so we keep track of it.
+		closedChan := msg.GetClosedChannel()
+		if closedChan != nil && m.cfg.DB != nil {
+			if err := m.handleChannelClose(closedChan); err != nil {
+				log.Errorf("Unable to update lease channel: %v",
+					err)
+			}
+		}
+
 		// Skip any events other than the pending open channel one.
 		channel, ok := msg.Channel.(*lnrpc.ChannelEventUpdate_PendingOpenChannel)
 		if !ok {
@@ -317,17 +380,20 @@ func (m *Manager) deriveFundingShim(ourOrder order.Order,
 
 	// If either order requires script enforcement, our thaw height needs to
 	// be an absolute height instead of a relative one.
-	switch {
-	case ourOrder.Details().ChannelType == order.ChannelTypeScriptEnforced:
-		fallthrough
-	case matchedOrder.Order.Details().ChannelType == order.ChannelTypeScriptEnforced:
+	chanType := order.NegotiatedChannelType(
+		ourOrder.Details().ChannelType,
+		matchedOrder.Order.Details().ChannelType,
+	)
+	if chanType == order.ChannelTypeScriptEnforced {
 		thawHeight += batchHeightHint
 	}
 
 	pendingChanID := order.PendingChanKey(
 		askNonce, bidNonce,
 	)
-	chanSize := matchedOrder.UnitsFilled.ToSatoshis()
+	// A self channel balance is added on top of the matched amount, so
+	// the channel is funded with both.
+	chanSize := matchedOrder.UnitsFilled.ToSatoshis() + selfChanBalance
 
 	// Next, we'll need to find the location of this channel output on the
 	// funding transaction, so we'll re-compute the funding script from
@@ -382,7 +448,7 @@ func (m *Manager) deriveFundingShim(ourOrder order.Order,
 	// shim, and register it so we use the proper funding key when we
 	// receive the marker's incoming funding request.
 	chanPointShim := &lnrpc.ChanPointShim{
-		Amt:       int64(chanSize + selfChanBalance),
+		Amt:       int64(chanSize),
 		ChanPoint: chanPoint,
 		LocalKey: &lnrpc.KeyDescriptor{
 			RawKeyBytes: ourMultiSigKey.PubKey.SerializeCompressed(),
@@ -407,13 +473,21 @@ func (m *Manager) deriveFundingShim(ourOrder order.Order,
 // side of a new matched order. To prepare ourselves for their incoming funding
 // request, we'll register a shim with all the expected parameters.
 func (m *Manager) registerFundingShim(ourBid *order.Bid,
-	matchedOrder *order.MatchedOrder, batchTx *wire.MsgTx,
-	batchHeightHint uint32) error {
+	matchedOrder *order.MatchedOrder, batch *order.Batch) error {
 
 	ctxb := context.Background()
 
 	fundingShim, pendingChanID, err := m.deriveFundingShim(
-		ourBid, matchedOrder, batchTx, batchHeightHint,
+		ourBid, matchedOrder, batch.BatchTX, batch.HeightHint,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Persist the shim first, so we can register it again if lnd loses it
+	// because of a restart.
+	err = m.storeFundingIntent(
+		batch.ID, ourBid, matchedOrder, fundingShim, false,
 	)
 	if err != nil {
 		return err
@@ -466,25 +540,25 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 
 	// Before we connect out to peers, we check that we don't get any new
 	// channels from peers we already have channels with, in case this is
-	// requested by the trader.
-	if m.cfg.NewNodesOnly {
-		fundingRejects, err := m.rejectDuplicateChannels(batch)
-		if err != nil {
-			return err
-		}
+	// requested by the trader, either for all orders or for single bids.
+	fundingRejects, err := m.rejectDuplicateChannels(batch, getOrder)
+	if err != nil {
+		return err
+	}
 
-		// In case we have any orders we don't like, tell the auctioneer
-		// now. They'll hopefully prepare and send us another batch.
-		if len(fundingRejects) > 0 {
-			return &MatchRejectErr{
-				RejectedOrders: fundingRejects,
-			}
+	// In case we have any orders we don't like, tell the auctioneer now.
+	// They'll hopefully prepare and send us another batch.
+	if len(fundingRejects) > 0 {
+		return &order.MatchRejectErr{
+			RejectedOrders: fundingRejects,
 		}
 	}
 
 	// Now that we know this batch passes our sanity checks, we'll register
 	// all the funding shims we need to be able to respond
 	connsInitiated := make(map[route.Vertex]struct{})
+	attempts := newConnAttempts()
+	preferredAddrs := m.preferredPeerAddrs(setupCtx)
 	for ourOrderNonce, matchedOrders := range batch.MatchedOrders {
 		ourOrder, err := getOrder(ourOrderNonce)
 		if err != nil {
@@ -550,6 +624,7 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 				go m.connectToMatchedTrader(
 					setupCtx, nodeKey,
 					matchedOrder.NodeAddrs,
+					preferredAddrs[nodeKey], attempts,
 				)
 				connsInitiated[nodeKey] = struct{}{}
 			}
@@ -565,8 +640,7 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 			// phase w/o any issues and accept the incoming channel
 			// from the asker.
 			err := m.registerFundingShim(
-				ourOrderBid, matchedOrder, batch.BatchTX,
-				batch.HeightHint,
+				ourOrderBid, matchedOrder, batch,
 			)
 			if err != nil {
 				return fmt.Errorf("unable to register funding "+
@@ -578,7 +652,9 @@ func (m *Manager) PrepChannelFunding(batch *order.Batch,
 	// We need to wait for all connections to be established now. Otherwise
 	// the asker won't be able to open the channel as it doesn't know the
 	// connection details of the bidder.
-	return m.waitForPeerConnections(setupCtx, connsInitiated, batch)
+	return m.waitForPeerConnections(
+		setupCtx, connsInitiated, batch, attempts,
+	)
 }
 
 // BatchChannelSetup will attempt to establish new funding flows with all
@@ -690,12 +766,25 @@ func (m *Manager) BatchChannelSetup(
 			chanAmt := matchedOrder.UnitsFilled.ToSatoshis()
 			chanAmt += matchedOrderBid.SelfChanBalance
 			var commitmentType lnrpc.CommitmentType
-			switch {
-			case ourOrder.Details().ChannelType == order.ChannelTypeScriptEnforced:
-				fallthrough
-			case matchedOrderBid.Details().ChannelType == order.ChannelTypeScriptEnforced:
+			chanType := order.NegotiatedChannelType(
+				ourOrder.Details().ChannelType,
+				matchedOrderBid.Details().ChannelType,
+			)
+			if chanType == order.ChannelTypeScriptEnforced {
 				commitmentType = lnrpc.CommitmentType_SCRIPT_ENFORCED_LEASE
 			}
+
+			// Persist what we need to know about the channel before
+			// we start the funding flow, so we can find out whether
+			// it completed after a restart.
+			err = m.storeFundingIntent(
+				batch.ID, ourOrder, matchedOrder, fundingShim,
+				true,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 			fundingReq := &lnrpc.OpenChannelRequest{
 				NodePubkey:         matchedOrder.NodeKey[:],
 				LocalFundingAmount: int64(chanAmt),
@@ -704,6 +793,8 @@ func (m *Manager) BatchChannelSetup(
 					matchedOrderBid.SelfChanBalance,
 				),
 				CommitmentType: commitmentType,
+				Private: ourOrder.Details().
+					UnannouncedChannel,
 			}
 			chanStream, err := m.cfg.BaseClient.OpenChannel(
 				setupCtx, fundingReq,
@@ -918,7 +1009,7 @@ func (m *Manager) waitForChannelOpen(ctx context.Context,
 					Reason:     timeoutErrStr,
 				}
 			}
-			return nil, &MatchRejectErr{
+			return nil, &order.MatchRejectErr{
 				RejectedOrders: fundingRejects,
 			}
 
@@ -957,7 +1048,7 @@ func (m *Manager) waitForChannelOpen(ctx context.Context,
 	// channels failed directly when opening but the rest succeeded without
 	// a timeout. We still want to reject the batch because of the failures.
 	if len(fundingRejects) > 0 {
-		return nil, &MatchRejectErr{
+		return nil, &order.MatchRejectErr{
 			RejectedOrders: fundingRejects,
 		}
 	}
@@ -1008,6 +1099,13 @@ func (m *Manager) RemovePendingBatchArtifacts(
 			"previous pending batch: %v", err)
 	}
 
+	// None of the channels of the batch will ever be funded, so there's
+	// nothing to resume.
+	if err := m.removeFundingIntents(batchTx.TxHash()); err != nil {
+		return fmt.Errorf("error removing funding intents from "+
+			"previous pending batch: %v", err)
+	}
+
 	return nil
 }
 
@@ -1026,11 +1124,26 @@ func (m *Manager) OfferSidecar(ctx context.Context, capacity,
 		return nil, err
 	}
 
+	// Tickets only expire if an expiry is configured. Otherwise we keep
+	// creating tickets with the default version so they can still be
+	// registered by recipients that don't know about expiries.
+	var (
+		version = sidecar.VersionDefault
+		expiry  time.Time
+	)
+	if m.cfg.SidecarTicketExpiry > 0 {
+		version = sidecar.VersionExpiry
+		// The expiry is only serialized with second precision.
+		expiry = time.Unix(
+			time.Now().Add(m.cfg.SidecarTicketExpiry).Unix(), 0,
+		)
+	}
+
 	// So far everything looks good. Let's create the ticket with the offer
 	// now.
 	ticket, err := sidecar.NewTicket(
-		sidecar.VersionDefault, capacity, pushAmt, duration,
-		acctPubKey.PubKey, auto,
+		version, capacity, pushAmt, duration, acctPubKey.PubKey, auto,
+		expiry,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating sidecar ticket: %v", err)
@@ -1060,30 +1173,68 @@ func (m *Manager) OfferSidecar(ctx context.Context, capacity,
 }
 
 // connectToMatchedTrader attempts to connect to a trader that we've had an
-// order matched with, on all available addresses.
+// order matched with, on all available addresses. Failed rounds of connection
+// attempts are retried with a backoff until the context is canceled. The
+// outcome of each attempt is recorded in the given tracker.
 func (m *Manager) connectToMatchedTrader(ctx context.Context,
-	nodeKey [33]byte, addrs []net.Addr) {
+	nodeKey [33]byte, addrs []net.Addr, preferred string,
+	attempts *connAttempts) {
+
+	connector := &peerConnector{
+		strategy: m.cfg.PeerConnectStrategy,
+		timeout:  m.cfg.PeerConnectTimeout,
+		connect: func(ctx context.Context, addr string) error {
+			return m.cfg.LightningClient.Connect(
+				ctx, nodeKey, addr, false,
+			)
+		},
+		attempts: attempts,
+		quit:     m.quit,
+	}
 
-	for _, addr := range addrs {
-		err := m.cfg.LightningClient.Connect(
-			ctx, nodeKey, addr.String(), false,
-		)
-		if err != nil {
-			// If we're already connected, then we can stop now.
-			if strings.Contains(err.Error(), "already connected") {
-				return
-			}
+	addr, err := connector.connectPeer(ctx, nodeKey, addrs, preferred)
+	if err != nil {
+		log.Warnf("Unable to connect to trader %x: %v, attempts: %s",
+			nodeKey[:], err, attempts.summary(nodeKey))
+		return
+	}
 
-			log.Warnf("unable to connect to trader at %x@%v",
-				nodeKey[:], addr)
+	m.peerAddrsMtx.Lock()
+	m.peerAddrs[nodeKey] = addr
+	m.peerAddrsMtx.Unlock()
+}
+
+// preferredPeerAddrs returns the address we should try first for each peer
+// we might connect to. That's the address of an existing connection to the
+// peer or otherwise the address we last connected to successfully.
+func (m *Manager) preferredPeerAddrs(
+	ctx context.Context) map[route.Vertex]string {
 
+	m.peerAddrsMtx.Lock()
+	preferred := make(map[route.Vertex]string, len(m.peerAddrs))
+	for peer, addr := range m.peerAddrs {
+		preferred[peer] = addr
+	}
+	m.peerAddrsMtx.Unlock()
+
+	// Not knowing the current connections only means we might not try
+	// the best address first, so we don't fail the batch because of it.
+	resp, err := m.cfg.BaseClient.ListPeers(ctx, &lnrpc.ListPeersRequest{})
+	if err != nil {
+		log.Warnf("Unable to query peers: %v", err)
+		return preferred
+	}
+
+	for _, peer := range resp.Peers {
+		peerKey, err := route.NewVertexFromStr(peer.PubKey)
+		if err != nil || peer.Address == "" {
 			continue
 		}
 
-		// We connected successfully, no need to try any of the
-		// other addresses.
-		break
+		preferred[peerKey] = peer.Address
 	}
+
+	return preferred
 }
 
 // waitForPeerConnections makes sure the connected lnd has a persistent
@@ -1094,7 +1245,8 @@ func (m *Manager) connectToMatchedTrader(ctx context.Context,
 // NOTE: The passed context MUST have a timeout applied to it, otherwise this
 // method will block forever in case a connection doesn't succeed.
 func (m *Manager) waitForPeerConnections(ctx context.Context,
-	peers map[route.Vertex]struct{}, batch *order.Batch) error {
+	peers map[route.Vertex]struct{}, batch *order.Batch,
+	attempts *connAttempts) error {
 
 	// First of all, subscribe to new peer events so we certainly don't miss
 	// an update while we look for the already connected peers.
@@ -1143,9 +1295,9 @@ func (m *Manager) waitForPeerConnections(ctx context.Context,
 		// to open all required connections, we reject the orders that
 		// came from the peers with the failed connections.
 		case <-ctx.Done():
-			return &MatchRejectErr{
+			return &order.MatchRejectErr{
 				RejectedOrders: m.rejectFailedConnections(
-					peers, batch,
+					peers, batch, attempts,
 				),
 			}
 
@@ -1201,10 +1353,35 @@ func (m *Manager) waitForPeerConnections(ctx context.Context,
 // rejectDuplicateChannels gathers a list of matched orders that should be
 // rejected because they would create channels to peers that the trader already
 // has channels with.
-func (m *Manager) rejectDuplicateChannels(
-	batch *order.Batch) (map[order.Nonce]*auctioneerrpc.OrderReject, error) {
+func (m *Manager) rejectDuplicateChannels(batch *order.Batch,
+	getOrder order.Fetcher) (map[order.Nonce]*auctioneerrpc.OrderReject,
+	error) {
+
+	// Find out which of our orders only accept matches with new nodes.
+	// That's all of them if the trader requested it globally.
+	newNodesOnly := make(map[order.Nonce]struct{})
+	for ourOrderNonce := range batch.MatchedOrders {
+		if m.cfg.NewNodesOnly {
+			newNodesOnly[ourOrderNonce] = struct{}{}
+			continue
+		}
+
+		ourOrder, err := getOrder(ourOrderNonce)
+		if err != nil {
+			return nil, err
+		}
+		bid, isBid := ourOrder.(*order.Bid)
+		if isBid && bid.NewNodesOnly {
+			newNodesOnly[ourOrderNonce] = struct{}{}
+		}
+	}
+
+	// There's no need to query our channels if none of our orders care.
+	if len(newNodesOnly) == 0 {
+		return nil, nil
+	}
 
-	// We gather all peers from the open and pending channels.
+	// We gather all peers from the open, pending and closing channels.
 	ctxb := context.Background()
 	peers := make(map[route.Vertex]struct{})
 	openChans, err := m.cfg.LightningClient.ListChannels(ctxb, false, false)
@@ -1223,13 +1400,23 @@ func (m *Manager) rejectDuplicateChannels(
 	for _, pendingChan := range pendingChans.PendingOpen {
 		peers[pendingChan.PubKeyBytes] = struct{}{}
 	}
+	for _, closingChan := range pendingChans.WaitingClose {
+		peers[closingChan.PubKeyBytes] = struct{}{}
+	}
+	for _, closingChan := range pendingChans.PendingForceClose {
+		peers[closingChan.PubKeyBytes] = struct{}{}
+	}
 
 	// Gather the list of matches we reject because they are to peers we
 	// already have channels with.
 	const haveChannel = "already have open/pending channel with peer"
 	const rejectCode = auctioneerrpc.OrderReject_DUPLICATE_PEER
 	fundingRejects := make(map[order.Nonce]*auctioneerrpc.OrderReject)
-	for _, matchedOrders := range batch.MatchedOrders {
+	for ourOrderNonce, matchedOrders := range batch.MatchedOrders {
+		if _, ok := newNodesOnly[ourOrderNonce]; !ok {
+			continue
+		}
+
 		for _, matchedOrder := range matchedOrders {
 			_, ok := peers[matchedOrder.NodeKey]
 			if !ok {
@@ -1250,9 +1437,12 @@ func (m *Manager) rejectDuplicateChannels(
 }
 
 // rejectFailedConnections gathers a list of all matched orders that are to
-// peers to which we couldn't connect and want to reject because of that.
+// peers to which we couldn't connect and want to reject because of that. The
+// outcome of our connection attempts is added to the reason, if we tried to
+// connect to the peer ourselves.
 func (m *Manager) rejectFailedConnections(peers map[route.Vertex]struct{},
-	batch *order.Batch) map[order.Nonce]*auctioneerrpc.OrderReject {
+	batch *order.Batch,
+	attempts *connAttempts) map[order.Nonce]*auctioneerrpc.OrderReject {
 
 	// Gather the list of matches we reject because the connection to them
 	// failed.
@@ -1266,12 +1456,19 @@ func (m *Manager) rejectFailedConnections(peers map[route.Vertex]struct{},
 				continue
 			}
 
+			reason := connFailed
+			summary := attempts.summary(matchedOrder.NodeKey)
+			if summary != "" {
+				reason = fmt.Sprintf("%s, tried %s", connFailed,
+					summary)
+			}
+
 			log.Debugf("Rejecting channel to node %x: %v",
-				matchedOrder.NodeKey[:], connFailed)
+				matchedOrder.NodeKey[:], reason)
 			otherNonce := matchedOrder.Order.Nonce()
 			fundingRejects[otherNonce] = &auctioneerrpc.OrderReject{
 				ReasonCode: rejectCode,
-				Reason:     connFailed,
+				Reason:     reason,
 			}
 		}
 	}
@@ -1283,13 +1480,7 @@ func (m *Manager) rejectFailedConnections(peers map[route.Vertex]struct{},
 // of active Uris for a node.
 func nodeHasTorAddrs(nodeAddrs []string) bool {
 	for _, nodeAddr := range nodeAddrs {
-		// Obtain the host to determine if this is a Tor address.
-		host, _, err := net.SplitHostPort(nodeAddr)
-		if err != nil {
-			host = nodeAddr
-		}
-
-		if tor.IsOnionHost(host) {
+		if isOnionAddr(nodeAddr) {
 			return true
 		}
 	}
@@ -123,6 +123,7 @@ func (c *channelEventStream) Recv() (*lnrpc.ChannelEventUpdate, error) {
 type fundingBaseClientMock struct {
 	lightningClient *test.MockLightning
 	fundingShims    map[[32]byte]*lnrpc.ChanPointShim
+	channels        []*lnrpc.Channel
 
 	peerList      map[route.Vertex]string
 	peerEvents    chan *lnrpc.PeerEvent
@@ -193,6 +194,13 @@ func (m *fundingBaseClientMock) OpenChannel(ctx context.Context,
 	return stream, nil
 }
 
+func (m *fundingBaseClientMock) ListChannels(_ context.Context,
+	_ *lnrpc.ListChannelsRequest,
+	_ ...grpc.CallOption) (*lnrpc.ListChannelsResponse, error) {
+
+	return &lnrpc.ListChannelsResponse{Channels: m.channels}, nil
+}
+
 func (m *fundingBaseClientMock) SubscribeChannelEvents(ctx context.Context,
 	_ *lnrpc.ChannelEventSubscription, _ ...grpc.CallOption) (
 	lnrpc.Lightning_SubscribeChannelEventsClient, error) {
@@ -253,7 +261,7 @@ func newManagerHarness(t *testing.T) *managerHarness {
 	tempDir, err := ioutil.TempDir("", "client-db")
 	require.NoError(t, err)
 
-	db, err := clientdb.New(tempDir, clientdb.DBFilename)
+	db, err := clientdb.New(tempDir, clientdb.DBFilename, nil)
 	if err != nil {
 		_ = os.RemoveAll(tempDir)
 		t.Fatalf("unable to create new db: %v", err)
@@ -428,7 +436,7 @@ func TestFundingManager(t *testing.T) {
 	err = h.mgr.PrepChannelFunding(batch, h.db.GetOrder)
 	require.Error(t, err)
 
-	expectedErr := &MatchRejectErr{
+	expectedErr := &order.MatchRejectErr{
 		RejectedOrders: map[order.Nonce]*auctioneerrpc.OrderReject{
 			ask.Nonce(): {
 				ReasonCode: auctioneerrpc.OrderReject_DUPLICATE_PEER,
@@ -439,19 +447,45 @@ func TestFundingManager(t *testing.T) {
 	}
 	require.Equal(t, expectedErr, err)
 
+	// The same match is rejected if only our bid asks for new nodes,
+	// even if the channel with the matched node is still closing.
+	h.mgr.cfg.NewNodesOnly = false
+	h.lnMock.Channels = h.lnMock.Channels[:len(h.lnMock.Channels)-1]
+	h.lnMock.ChannelsClosing = append(
+		h.lnMock.ChannelsClosing, lndclient.WaitingCloseChannel{
+			PendingChannel: lndclient.PendingChannel{
+				PubKeyBytes: node1Key,
+			},
+		},
+	)
+	newNodesOnlyBid := func(nonce order.Nonce) (order.Order, error) {
+		o, err := h.db.GetOrder(nonce)
+		if err != nil {
+			return nil, err
+		}
+		if ourBid, ok := o.(*order.Bid); ok {
+			ourBid.NewNodesOnly = true
+		}
+
+		return o, nil
+	}
+	err = h.mgr.PrepChannelFunding(batch, newNodesOnlyBid)
+	require.Equal(t, expectedErr, err)
+	h.lnMock.ChannelsClosing = nil
+
 	// As a last check of the funding preparation, make sure we get a reject
 	// error if the connections to the remote peers couldn't be established.
-	h.mgr.cfg.NewNodesOnly = false
 	h.baseClientMock.peerList = make(map[route.Vertex]string)
 	err = h.mgr.PrepChannelFunding(batch, h.db.GetOrder)
 	require.Error(t, err)
 
-	expectedErr = &MatchRejectErr{
+	expectedErr = &order.MatchRejectErr{
 		RejectedOrders: map[order.Nonce]*auctioneerrpc.OrderReject{
 			ask.Nonce(): {
 				ReasonCode: auctioneerrpc.OrderReject_CHANNEL_FUNDING_FAILED,
 				Reason: "connection not established before " +
-					"timeout",
+					"timeout, tried " + addr1.String() +
+					" (ok)",
 			},
 		},
 	}
@@ -473,7 +507,7 @@ func TestFundingManager(t *testing.T) {
 		Reason: "timed out waiting for pending open " +
 			"channel notification",
 	}
-	expectedErr = &MatchRejectErr{
+	expectedErr = &order.MatchRejectErr{
 		RejectedOrders: map[order.Nonce]*auctioneerrpc.OrderReject{
 			ask.Nonce(): code,
 			bid.Nonce(): code,
@@ -620,6 +654,7 @@ func TestDeriveFundingShim(t *testing.T) {
 	// And the second test is with a sidecar channel bid.
 	ticket, err := sidecar.NewTicket(
 		sidecar.VersionDefault, 400_000, 0, 12345, pubKeyBid, false,
+		time.Time{},
 	)
 	require.NoError(t, err)
 	ticket.Recipient = &sidecar.Recipient{
@@ -701,7 +736,9 @@ func TestWaitForPeerConnections(t *testing.T) {
 		node1Key: {},
 		node2Key: {},
 	}
-	err := h.mgr.waitForPeerConnections(ctxt, expectedConnections, nil)
+	err := h.mgr.waitForPeerConnections(
+		ctxt, expectedConnections, nil, nil,
+	)
 	require.NoError(t, err)
 
 	// Next, make sure that connections established while waiting are
@@ -730,7 +767,9 @@ func TestWaitForPeerConnections(t *testing.T) {
 		node1Key: {},
 		node2Key: {},
 	}
-	err = h.mgr.waitForPeerConnections(ctxt, expectedConnections, nil)
+	err = h.mgr.waitForPeerConnections(
+		ctxt, expectedConnections, nil, nil,
+	)
 	require.NoError(t, err)
 
 	// Final test, make sure we get the correct error message back if we
@@ -765,14 +804,16 @@ func TestWaitForPeerConnections(t *testing.T) {
 		node1Key: {},
 		node2Key: {},
 	}
-	err = h.mgr.waitForPeerConnections(ctxt, expectedConnections, fakeBatch)
+	err = h.mgr.waitForPeerConnections(
+		ctxt, expectedConnections, fakeBatch, nil,
+	)
 	require.Error(t, err)
 
 	code := &auctioneerrpc.OrderReject{
 		ReasonCode: auctioneerrpc.OrderReject_CHANNEL_FUNDING_FAILED,
 		Reason:     "connection not established before timeout",
 	}
-	expectedErr := &MatchRejectErr{
+	expectedErr := &order.MatchRejectErr{
 		RejectedOrders: map[order.Nonce]*auctioneerrpc.OrderReject{
 			fakeNonce2: code,
 		},
@@ -790,7 +831,9 @@ func TestWaitForPeerConnections(t *testing.T) {
 		close(h.baseClientMock.cancelSub)
 	}()
 
-	err = h.mgr.waitForPeerConnections(ctxt, expectedConnections, fakeBatch)
+	err = h.mgr.waitForPeerConnections(
+		ctxt, expectedConnections, fakeBatch, nil,
+	)
 	require.Error(t, err)
 	require.Equal(t, expectedErr, err)
 }
@@ -864,11 +907,34 @@ func TestOfferSidecar(t *testing.T) {
 	require.Equal(t, pushAmt, ticket.Offer.PushAmt)
 	require.Equal(t, privKey.PubKey(), ticket.Offer.SignPubKey)
 	require.Equal(t, sig, ticket.Offer.SigOfferDigest)
+	require.Equal(t, sidecar.VersionDefault, ticket.Version)
+	require.True(t, ticket.Expiry.IsZero())
 
 	// Make sure the DB has the exact same ticket now.
 	dbTicket, err := h.db.Sidecar(ticket.ID, privKey.PubKey())
 	require.NoError(t, err)
 	require.Equal(t, ticket, dbTicket)
+
+	// With an expiry configured, the ticket uses the new version and
+	// expires after the configured duration.
+	h.mgr.cfg.SidecarTicketExpiry = time.Hour
+	ticket, err = h.mgr.OfferSidecar(
+		context.Background(), capacity, pushAmt, 2016,
+		&keychain.KeyDescriptor{
+			PubKey: privKey.PubKey(),
+		}, nil, false,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sidecar.VersionExpiry, ticket.Version)
+	require.WithinDuration(
+		t, time.Now().Add(time.Hour), ticket.Expiry, 2*time.Second,
+	)
+	require.False(t, ticket.IsExpired(time.Now()))
+	require.True(t, ticket.IsExpired(ticket.Expiry))
+
+	dbTicket, err = h.db.Sidecar(ticket.ID, privKey.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, ticket, dbTicket)
 }
 
 func newKitFromTemplate(nonce order.Nonce, tpl *order.Kit) order.Kit {
@@ -0,0 +1,248 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+var (
+	// ErrTraderUnhealthy is returned if a batch is rejected because the
+	// trader's lnd node has been unhealthy for longer than the configured
+	// grace period.
+	ErrTraderUnhealthy = errors.New("trader unhealthy")
+)
+
+// healthGateState is the state of the health gate that keeps the trader from
+// taking part in batches while its lnd node is unhealthy.
+type healthGateState uint8
+
+const (
+	// healthGateOpen means the lnd node is healthy.
+	healthGateOpen healthGateState = iota
+
+	// healthGateGracePeriod means the lnd node is unhealthy but the grace
+	// period hasn't passed yet.
+	healthGateGracePeriod
+
+	// healthGateClosed means the lnd node has been unhealthy for longer
+	// than the grace period and all batches are rejected.
+	healthGateClosed
+)
+
+// healthMonitorConfig contains all functionality the health monitor needs to
+// check the lnd node and react to it being unhealthy.
+type healthMonitorConfig struct {
+	// Interval is the interval in which the lnd node is checked.
+	Interval time.Duration
+
+	// GracePeriod is the amount of time the lnd node may be unhealthy
+	// before the health gate closes.
+	GracePeriod time.Duration
+
+	// CancelOrders indicates whether all open orders should be canceled
+	// once the health gate closes.
+	CancelOrders bool
+
+	// CheckHealth returns a non-nil error describing why the lnd node is
+	// unhealthy, if it is.
+	CheckHealth func(ctx context.Context) error
+
+	// CancelAllOrders cancels all open orders of the trader.
+	CancelAllOrders func(ctx context.Context) error
+}
+
+// healthMonitor periodically checks the health of the trader's lnd node. If
+// the node is unhealthy for longer than the configured grace period, the
+// health gate closes and the trader rejects all batches until the node is
+// healthy again. That prevents the trader from accepting batches it can't
+// complete, which would be punished by the auctioneer.
+type healthMonitor struct {
+	cfg *healthMonitorConfig
+
+	mu             sync.Mutex
+	state          healthGateState
+	unhealthySince time.Time
+	reason         error
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newHealthMonitor creates a new health monitor with the given config.
+func newHealthMonitor(cfg *healthMonitorConfig) *healthMonitor {
+	return &healthMonitor{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the periodic health checks.
+func (h *healthMonitor) Start() {
+	h.wg.Add(1)
+	go h.monitor()
+}
+
+// Stop stops the health checks and waits for them to finish.
+func (h *healthMonitor) Stop() {
+	close(h.quit)
+	h.wg.Wait()
+}
+
+// monitor checks the health of the lnd node in the configured interval until
+// the monitor is stopped.
+func (h *healthMonitor) monitor() {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(h.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		h.check()
+
+		select {
+		case <-ticker.C:
+
+		case <-h.quit:
+			return
+		}
+	}
+}
+
+// check runs a single health check and cancels all open orders if that
+// closed the health gate and the monitor is configured to do so.
+func (h *healthMonitor) check() {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), h.cfg.Interval,
+	)
+	defer cancel()
+
+	closed := h.update(time.Now(), h.cfg.CheckHealth(ctx))
+	if !closed || !h.cfg.CancelOrders {
+		return
+	}
+
+	log.Infof("Canceling all open orders because lnd is unhealthy")
+	if err := h.cfg.CancelAllOrders(ctx); err != nil {
+		log.Errorf("Unable to cancel all open orders: %v", err)
+	}
+}
+
+// update transitions the health gate according to the result of a health
+// check at the given time. It returns true if the gate was closed by this
+// update.
+func (h *healthMonitor) update(now time.Time, checkErr error) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// A healthy node opens the gate again, no matter how long it was
+	// unhealthy before.
+	if checkErr == nil {
+		if h.state != healthGateOpen {
+			log.Infof("lnd is healthy again, health gate open")
+		}
+
+		h.state = healthGateOpen
+		h.unhealthySince = time.Time{}
+		h.reason = nil
+
+		return false
+	}
+
+	h.reason = checkErr
+	if h.state == healthGateOpen {
+		log.Warnf("lnd is unhealthy, closing health gate in %v if it "+
+			"doesn't recover: %v", h.cfg.GracePeriod, checkErr)
+
+		h.state = healthGateGracePeriod
+		h.unhealthySince = now
+	}
+
+	if h.state == healthGateGracePeriod &&
+		now.Sub(h.unhealthySince) >= h.cfg.GracePeriod {
+
+		log.Errorf("lnd unhealthy since %v, health gate closed, "+
+			"rejecting all batches: %v", h.unhealthySince, checkErr)
+
+		h.state = healthGateClosed
+		return true
+	}
+
+	return false
+}
+
+// Allowed returns an error wrapping ErrTraderUnhealthy if the health gate is
+// closed and the trader must not take part in batches.
+func (h *healthMonitor) Allowed() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.state != healthGateClosed {
+		return nil
+	}
+
+	return fmt.Errorf("%w: lnd unhealthy since %v: %v",
+		ErrTraderUnhealthy, h.unhealthySince, h.reason)
+}
+
+// RPCState returns the current state of the health gate in its RPC
+// representation.
+func (h *healthMonitor) RPCState() *poolrpc.HealthGate {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	gate := &poolrpc.HealthGate{
+		CancelOrders: h.cfg.CancelOrders,
+	}
+
+	switch h.state {
+	case healthGateOpen:
+		gate.State = poolrpc.HealthGateState_HEALTH_GATE_OPEN
+
+	case healthGateGracePeriod:
+		gate.State = poolrpc.HealthGateState_HEALTH_GATE_GRACE_PERIOD
+
+	case healthGateClosed:
+		gate.State = poolrpc.HealthGateState_HEALTH_GATE_CLOSED
+	}
+
+	if h.reason != nil {
+		gate.UnhealthyReason = h.reason.Error()
+	}
+	if !h.unhealthySince.IsZero() {
+		gate.UnhealthySince = h.unhealthySince.Unix()
+	}
+
+	return gate
+}
+
+// checkLndHealth returns an error if the given lnd node's wallet isn't
+// unlocked and active or if the node isn't synced to the chain.
+func checkLndHealth(ctx context.Context, state lndclient.StateClient,
+	client lnrpc.LightningClient) error {
+
+	walletState, err := state.GetState(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query wallet state: %v", err)
+	}
+	if !walletState.ReadyForGetInfo() {
+		return fmt.Errorf("wallet not ready, state is %v", walletState)
+	}
+
+	info, err := client.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to query node info: %v", err)
+	}
+	if !info.SyncedToChain {
+		return fmt.Errorf("not synced to chain, best height is %d",
+			info.BlockHeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package pool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHealthMonitorGate makes sure the health gate only closes once the lnd
+// node was unhealthy for longer than the grace period and opens again as soon
+// as the node is healthy.
+func TestHealthMonitorGate(t *testing.T) {
+	t.Parallel()
+
+	const gracePeriod = 5 * time.Minute
+
+	h := newHealthMonitor(&healthMonitorConfig{
+		Interval:    time.Second,
+		GracePeriod: gracePeriod,
+	})
+	errUnsynced := errors.New("not synced to chain")
+	start := time.Unix(1_000_000, 0)
+
+	// A healthy node keeps the gate open.
+	require.False(t, h.update(start, nil))
+	require.NoError(t, h.Allowed())
+	require.Equal(
+		t, poolrpc.HealthGateState_HEALTH_GATE_OPEN, h.RPCState().State,
+	)
+
+	// Once the node becomes unhealthy, we're in the grace period and still
+	// take part in batches.
+	require.False(t, h.update(start, errUnsynced))
+	require.NoError(t, h.Allowed())
+
+	state := h.RPCState()
+	require.Equal(
+		t, poolrpc.HealthGateState_HEALTH_GATE_GRACE_PERIOD,
+		state.State,
+	)
+	require.Equal(t, errUnsynced.Error(), state.UnhealthyReason)
+	require.Equal(t, start.Unix(), state.UnhealthySince)
+
+	// Further failed checks within the grace period don't change that.
+	require.False(t, h.update(start.Add(gracePeriod/2), errUnsynced))
+	require.NoError(t, h.Allowed())
+
+	// After the grace period the gate closes exactly once.
+	require.True(t, h.update(start.Add(gracePeriod), errUnsynced))
+	require.False(t, h.update(start.Add(2*gracePeriod), errUnsynced))
+	require.ErrorIs(t, h.Allowed(), ErrTraderUnhealthy)
+	require.Equal(
+		t, poolrpc.HealthGateState_HEALTH_GATE_CLOSED,
+		h.RPCState().State,
+	)
+
+	// A single successful check opens the gate again.
+	require.False(t, h.update(start.Add(3*gracePeriod), nil))
+	require.NoError(t, h.Allowed())
+
+	state = h.RPCState()
+	require.Equal(t, poolrpc.HealthGateState_HEALTH_GATE_OPEN, state.State)
+	require.Empty(t, state.UnhealthyReason)
+	require.Zero(t, state.UnhealthySince)
+
+	// The grace period starts over when the node becomes unhealthy again.
+	later := start.Add(4 * gracePeriod)
+	require.False(t, h.update(later, errUnsynced))
+	require.False(
+		t, h.update(later.Add(gracePeriod-time.Second), errUnsynced),
+	)
+	require.True(t, h.update(later.Add(gracePeriod), errUnsynced))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
 )
 
@@ -13,4 +14,11 @@ type Marshaler interface {
 	// of an account with the account.AvailableBalance value populated.
 	MarshallAccountsWithAvailableBalance(ctx context.Context,
 		accounts []*account.Account) ([]*poolrpc.Account, error)
+
+	// MarshallAccountsWithOrders returns the RPC representation of an
+	// account with the account.AvailableBalance value populated from the
+	// given orders.
+	MarshallAccountsWithOrders(ctx context.Context,
+		accounts []*account.Account,
+		orders []order.Order) ([]*poolrpc.Account, error)
 }
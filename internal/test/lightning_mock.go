@@ -80,6 +80,7 @@ type MockLightning struct {
 	Channels         []lndclient.ChannelInfo
 	ChannelsClosed   []lndclient.ClosedChannel
 	ChannelsPending  []lndclient.PendingChannel
+	ChannelsClosing  []lndclient.WaitingCloseChannel
 	ForwardingEvents []lndclient.ForwardingEvent
 	Payments         []lndclient.Payment
 
@@ -340,7 +341,8 @@ func (m *MockLightning) PendingChannels(
 	context.Context) (*lndclient.PendingChannels, error) {
 
 	return &lndclient.PendingChannels{
-		PendingOpen: m.ChannelsPending,
+		PendingOpen:  m.ChannelsPending,
+		WaitingClose: m.ChannelsClosing,
 	}, nil
 }
 
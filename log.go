@@ -9,10 +9,14 @@ import (
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/approval"
 	"github.com/lightninglabs/pool/auctioneer"
 	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/metrics"
 	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/policy"
+	"github.com/lightninglabs/pool/sidecaracceptor"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
@@ -56,6 +60,15 @@ func SetupLoggers(root *build.RotatingLogWriter, intercept signal.Interceptor) {
 	lnd.AddSubLogger(
 		root, clientdb.Subsystem, intercept, clientdb.UseLogger,
 	)
+	lnd.AddSubLogger(
+		root, sidecaracceptor.Subsystem, intercept,
+		sidecaracceptor.UseLogger,
+	)
+	lnd.AddSubLogger(root, metrics.Subsystem, intercept, metrics.UseLogger)
+	lnd.AddSubLogger(root, policy.Subsystem, intercept, policy.UseLogger)
+	lnd.AddSubLogger(
+		root, approval.Subsystem, intercept, approval.UseLogger,
+	)
 }
 
 // genSubLogger creates a logger for a subsystem. We provide an instance of
@@ -0,0 +1,335 @@
+package lsatstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// tokenFileName is the name of the file the lsat.FileStore keeps the
+	// current, paid token in.
+	tokenFileName = "lsat.token"
+
+	// pendingTokenFileName is the name of the file the lsat.FileStore
+	// keeps a token in until its payment succeeded.
+	pendingTokenFileName = "lsat.token.pending"
+
+	// revokedTokenInfix is added to the file name of a token once it's
+	// revoked. The file name still starts with tokenFileName so the
+	// revoked token shows up in lsat.FileStore.AllTokens for accounting
+	// purposes, but it is never used again.
+	revokedTokenInfix = ".revoked."
+)
+
+var (
+	// ErrTokenExists is returned if a token is imported while the store
+	// still contains a token that is not revoked.
+	ErrTokenExists = errors.New("store already contains a token, revoke " +
+		"it first")
+
+	// ErrTokenNotFound is returned if the token to revoke isn't the current
+	// token of the store.
+	ErrTokenNotFound = errors.New("no current token with the given ID " +
+		"found")
+
+	// ErrTokenPending is returned if a token that wasn't paid for yet is
+	// imported.
+	ErrTokenPending = errors.New("the payment of the token to import is " +
+		"still pending")
+)
+
+// TokenInfo contains the information about a token in the store.
+type TokenInfo struct {
+	// ID is the token ID encoded in the identifier of the base macaroon.
+	ID lsat.TokenID
+
+	// PaymentHash is the hash of the payment that was paid to obtain the
+	// token.
+	PaymentHash lntypes.Hash
+
+	// AmountPaid is the amount that was paid to obtain the token, not
+	// including routing fees.
+	AmountPaid lnwire.MilliSatoshi
+
+	// RoutingFeePaid is the routing fee that was paid to obtain the
+	// token.
+	RoutingFeePaid lnwire.MilliSatoshi
+
+	// TimeCreated is the time the token was created.
+	TimeCreated time.Time
+
+	// Pending is true if the payment of the token is still in flight.
+	Pending bool
+
+	// Revoked is true if the token was revoked and is no longer used.
+	Revoked bool
+
+	// StorageName is the name of the file the token is stored in.
+	StorageName string
+}
+
+// Store is an LSAT token store that keeps its tokens in files, just like the
+// lsat.FileStore it wraps, and additionally allows tokens to be listed,
+// revoked and imported. Everyone interested in the current token changing
+// outside of the LSAT payment flow can register a listener.
+type Store struct {
+	*lsat.FileStore
+
+	dir string
+
+	// mu serializes all modifications of the token files.
+	mu sync.Mutex
+
+	listenersMtx sync.Mutex
+	listeners    []func()
+}
+
+// A compile-time check to make sure Store implements the lsat.Store interface.
+var _ lsat.Store = (*Store)(nil)
+
+// New creates a new token store that keeps its tokens in the given directory.
+// If the directory does not exist, it will be created.
+func New(dir string) (*Store, error) {
+	fileStore, err := lsat.NewFileStore(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		FileStore: fileStore,
+		dir:       dir,
+	}, nil
+}
+
+// StoreToken saves a token to the store.
+//
+// NOTE: This is part of the lsat.Store interface.
+func (s *Store) StoreToken(token *lsat.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.FileStore.StoreToken(token)
+}
+
+// RemovePendingToken removes a pending token from the store or returns
+// lsat.ErrNoToken if there is no pending token.
+//
+// NOTE: This is part of the lsat.Store interface.
+func (s *Store) RemovePendingToken() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.FileStore.RemovePendingToken()
+}
+
+// AddListener registers a function that is called each time the current token
+// is revoked or a token is imported.
+func (s *Store) AddListener(listener func()) {
+	s.listenersMtx.Lock()
+	defer s.listenersMtx.Unlock()
+
+	s.listeners = append(s.listeners, listener)
+}
+
+// notifyListeners calls all registered listeners.
+func (s *Store) notifyListeners() {
+	s.listenersMtx.Lock()
+	listeners := make([]func(), len(s.listeners))
+	copy(listeners, s.listeners)
+	s.listenersMtx.Unlock()
+
+	for _, listener := range listeners {
+		listener()
+	}
+}
+
+// ListTokens returns all tokens of the store, including pending and revoked
+// ones, ordered by their creation time.
+func (s *Store) ListTokens() ([]*TokenInfo, error) {
+	tokens, err := s.AllTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*TokenInfo, 0, len(tokens))
+	for fileName, token := range tokens {
+		info, err := newTokenInfo(token, fileName)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].TimeCreated.Before(infos[j].TimeCreated)
+	})
+
+	return infos, nil
+}
+
+// CurrentTokenInfo returns the information about the current token of the
+// store, which might still be pending. If there is no current token,
+// lsat.ErrNoToken is returned.
+func (s *Store) CurrentTokenInfo() (*TokenInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range []string{tokenFileName, pendingTokenFileName} {
+		fileName := filepath.Join(s.dir, name)
+		if _, err := os.Stat(fileName); err != nil {
+			continue
+		}
+
+		return readTokenInfo(fileName)
+	}
+
+	return nil, lsat.ErrNoToken
+}
+
+// RevokeToken revokes the current token of the store if it has the given ID.
+// The token is kept for accounting purposes but a new one is acquired for the
+// next call to the auction server.
+func (s *Store) RevokeToken(id lsat.TokenID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range []string{tokenFileName, pendingTokenFileName} {
+		fileName := filepath.Join(s.dir, name)
+		if _, err := os.Stat(fileName); err != nil {
+			continue
+		}
+
+		info, err := readTokenInfo(fileName)
+		if err != nil {
+			return err
+		}
+		if info.ID != id {
+			continue
+		}
+
+		revokedName := fmt.Sprintf(
+			"%s%s%d", fileName, revokedTokenInfix,
+			time.Now().UnixNano(),
+		)
+		if err := os.Rename(fileName, revokedName); err != nil {
+			return fmt.Errorf("unable to revoke token: %v", err)
+		}
+
+		s.notifyListeners()
+
+		return nil
+	}
+
+	return ErrTokenNotFound
+}
+
+// ImportToken imports a paid token that was serialized by another token store,
+// for example the lsat.token file of another trader daemon. The store must not
+// contain any token that is still in use.
+func (s *Store) ImportToken(serialized []byte) (*TokenInfo, error) {
+	token, err := deserializeToken(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %v", err)
+	}
+	if token.Preimage == (lntypes.Preimage{}) {
+		return nil, ErrTokenPending
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.CurrentToken()
+	switch {
+	case err == lsat.ErrNoToken:
+
+	case err != nil:
+		return nil, err
+
+	default:
+		return nil, ErrTokenExists
+	}
+
+	if err := s.FileStore.StoreToken(token); err != nil {
+		return nil, err
+	}
+
+	s.notifyListeners()
+
+	return newTokenInfo(token, filepath.Join(s.dir, tokenFileName))
+}
+
+// deserializeToken decodes a token in the format of the lsat.FileStore. As the
+// decoding isn't exported, the token is read through a temporary file store.
+func deserializeToken(serialized []byte) (*lsat.Token, error) {
+	tempDir, err := os.MkdirTemp("", "lsat-import")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	err = os.WriteFile(
+		filepath.Join(tempDir, tokenFileName), serialized, 0600,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tempStore, err := lsat.NewFileStore(tempDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return tempStore.CurrentToken()
+}
+
+// readTokenInfo reads the token in the given file.
+func readTokenInfo(fileName string) (*TokenInfo, error) {
+	serialized, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := deserializeToken(serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTokenInfo(token, fileName)
+}
+
+// newTokenInfo extracts the information about a token stored in the given
+// file.
+func newTokenInfo(token *lsat.Token, fileName string) (*TokenInfo, error) {
+	id, err := lsat.DecodeIdentifier(
+		bytes.NewReader(token.BaseMacaroon().Id()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode token ID: %v", err)
+	}
+
+	return &TokenInfo{
+		ID:             id.TokenID,
+		PaymentHash:    token.PaymentHash,
+		AmountPaid:     token.AmountPaid,
+		RoutingFeePaid: token.RoutingFeePaid,
+		TimeCreated:    token.TimeCreated,
+		Pending:        token.Preimage == (lntypes.Preimage{}),
+		Revoked: strings.Contains(
+			filepath.Base(fileName), revokedTokenInfix,
+		),
+		StorageName: fileName,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package lsatstore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon.v2"
+)
+
+// serializedToken creates a token with the given ID and preimage in the format
+// of the lsat.FileStore.
+func serializedToken(t *testing.T, id lsat.TokenID,
+	preimage lntypes.Preimage) []byte {
+
+	var macID bytes.Buffer
+	err := lsat.EncodeIdentifier(&macID, &lsat.Identifier{
+		Version:     lsat.LatestVersion,
+		PaymentHash: preimage.Hash(),
+		TokenID:     id,
+	})
+	require.NoError(t, err)
+
+	mac, err := macaroon.New(
+		[]byte("root key"), macID.Bytes(), "auctioneer",
+		macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	for _, elem := range []interface{}{
+		uint32(len(macBytes)), macBytes, preimage.Hash(), preimage,
+		uint64(1_000_000), uint64(1_000), time.Now().UnixNano(),
+	} {
+		require.NoError(t, binary.Write(&b, binary.BigEndian, elem))
+	}
+
+	return b.Bytes()
+}
+
+// TestRevokeImportToken makes sure the current token can be revoked, that a
+// token can only be imported once the store doesn't contain a current token
+// anymore and that all listeners are notified about both.
+func TestRevokeImportToken(t *testing.T) {
+	t.Parallel()
+
+	store, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	var notifications int
+	store.AddListener(func() {
+		notifications++
+	})
+
+	id1, id2 := lsat.TokenID{1}, lsat.TokenID{2}
+	token1 := serializedToken(t, id1, lntypes.Preimage{1})
+	token2 := serializedToken(t, id2, lntypes.Preimage{2})
+
+	// Tokens that weren't paid for can't be imported.
+	_, err = store.ImportToken(serializedToken(t, id1, lntypes.Preimage{}))
+	require.ErrorIs(t, err, ErrTokenPending)
+	_, err = store.ImportToken([]byte{1, 2, 3})
+	require.Error(t, err)
+
+	// The first token can be imported into the empty store, the second
+	// one only after the first one was revoked.
+	info, err := store.ImportToken(token1)
+	require.NoError(t, err)
+	require.Equal(t, id1, info.ID)
+	require.False(t, info.Pending)
+	require.False(t, info.Revoked)
+	require.Equal(t, 1, notifications)
+
+	_, err = store.ImportToken(token2)
+	require.ErrorIs(t, err, ErrTokenExists)
+
+	require.ErrorIs(t, store.RevokeToken(id2), ErrTokenNotFound)
+	require.NoError(t, store.RevokeToken(id1))
+	require.Equal(t, 2, notifications)
+
+	_, err = store.CurrentToken()
+	require.ErrorIs(t, err, lsat.ErrNoToken)
+	_, err = store.CurrentTokenInfo()
+	require.ErrorIs(t, err, lsat.ErrNoToken)
+
+	_, err = store.ImportToken(token2)
+	require.NoError(t, err)
+	require.Equal(t, 3, notifications)
+
+	current, err := store.CurrentToken()
+	require.NoError(t, err)
+	require.Equal(t, lntypes.Preimage{2}, current.Preimage)
+
+	currentInfo, err := store.CurrentTokenInfo()
+	require.NoError(t, err)
+	require.Equal(t, id2, currentInfo.ID)
+	require.False(t, currentInfo.Pending)
+
+	// The revoked token is still listed for accounting purposes.
+	tokens, err := store.ListTokens()
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, id1, tokens[0].ID)
+	require.True(t, tokens[0].Revoked)
+	require.Equal(t, id2, tokens[1].ID)
+	require.False(t, tokens[1].Revoked)
+}
@@ -1,5 +1,21 @@
 package pool
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/perms"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
 const (
 	// poolMacaroonLocation is the value we use for the pool macaroons'
 	// "Location" field when baking them.
@@ -17,4 +33,93 @@ var (
 	// create/unlock calls in the RPC. Using a password should be optional
 	// though.
 	macDbDefaultPw = []byte("")
+
+	// errMacaroonsDisabled is returned if a macaroon should be baked while
+	// the macaroon service isn't running.
+	errMacaroonsDisabled = errors.New("macaroon authentication is " +
+		"disabled, remove --no-macaroons to bake macaroons")
 )
+
+// startMacaroonService creates and starts the macaroon service and lets it
+// create the default admin macaroon and the read-only macaroon in case they
+// don't exist yet.
+func (s *Server) startMacaroonService() error {
+	var err error
+	s.macaroonService, err = lndclient.NewMacaroonService(
+		&lndclient.MacaroonServiceConfig{
+			DBPath:           s.cfg.BaseDir,
+			DBTimeout:        clientdb.DefaultPoolDBTimeout,
+			MacaroonLocation: poolMacaroonLocation,
+			MacaroonPath:     s.cfg.MacaroonPath,
+			Checkers: []macaroons.Checker{
+				macaroons.IPLockChecker,
+			},
+			RequiredPerms: perms.RequiredPermissions,
+			DBPassword:    macDbDefaultPw,
+			LndClient:     &s.lndServices.LndServices,
+			EphemeralKey:  lndclient.SharedKeyNUMS,
+			KeyLocator:    lndclient.SharedKeyLocator,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.macaroonService.Start(); err != nil {
+		return err
+	}
+
+	if err := s.writeReadOnlyMacaroon(); err != nil {
+		_ = s.macaroonService.Stop()
+		return fmt.Errorf("unable to create read-only macaroon: %v",
+			err)
+	}
+
+	return nil
+}
+
+// writeReadOnlyMacaroon bakes a macaroon that grants all read permissions and
+// writes it to the configured path if there's no file there yet.
+func (s *Server) writeReadOnlyMacaroon() error {
+	path := s.cfg.ReadOnlyMacaroonPath
+	if path == "" || lnrpc.FileExists(path) {
+		return nil
+	}
+
+	mac, err := s.bakeMacaroon(
+		context.Background(), 0, perms.ReadOnlyPermissions(),
+	)
+	if err != nil {
+		return err
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, macBytes, 0644)
+}
+
+// bakeMacaroon bakes a new macaroon with the given root key ID that grants the
+// given permissions and adds all given constraints to it.
+func (s *Server) bakeMacaroon(ctx context.Context, rootKeyID uint64,
+	ops []bakery.Op, constraints ...macaroons.Constraint) (
+	*macaroon.Macaroon, error) {
+
+	if s.macaroonService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	// The default root key ID is the digit 0 as a string, so we encode
+	// all other IDs the same way.
+	rawRootKeyID := []byte(strconv.FormatUint(rootKeyID, 10))
+	ctx = macaroons.ContextWithRootKeyID(ctx, rawRootKeyID)
+
+	mac, err := s.macaroonService.NewMacaroon(ctx, rawRootKeyID, ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	return macaroons.AddConstraints(mac.M(), constraints...)
+}
@@ -39,6 +39,24 @@ func NewMarshaler(cfg *marshalerConfig) *marshaler { // nolint:golint
 func (m *marshaler) MarshallAccountsWithAvailableBalance(ctx context.Context,
 	accounts []*account.Account) ([]*poolrpc.Account, error) {
 
+	// For each account, we'll need to compute the available balance, which
+	// requires us to sum up all the debits from outstanding orders.
+	orders, err := m.cfg.GetOrders()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.MarshallAccountsWithOrders(ctx, accounts, orders)
+}
+
+// MarshallAccountsWithOrders returns the RPC representation of an account with
+// the account.AvailableBalance value populated from the given orders. This
+// should be used if the accounts and orders were read within the same database
+// transaction.
+func (m *marshaler) MarshallAccountsWithOrders(ctx context.Context,
+	accounts []*account.Account,
+	orders []order.Order) ([]*poolrpc.Account, error) {
+
 	rpcAccounts := make([]*poolrpc.Account, 0, len(accounts))
 	for _, acct := range accounts {
 		rpcAccount, err := MarshallAccount(acct)
@@ -48,13 +66,6 @@ func (m *marshaler) MarshallAccountsWithAvailableBalance(ctx context.Context,
 		rpcAccounts = append(rpcAccounts, rpcAccount)
 	}
 
-	// For each account, we'll need to compute the available balance, which
-	// requires us to sum up all the debits from outstanding orders.
-	orders, err := m.cfg.GetOrders()
-	if err != nil {
-		return nil, err
-	}
-
 	// Get the current fee schedule so we can compute the worst-case
 	// account debit assuming all our standing orders were matched.
 	auctionTerms, err := m.cfg.Terms(ctx)
@@ -64,12 +75,13 @@ func (m *marshaler) MarshallAccountsWithAvailableBalance(ctx context.Context,
 	}
 
 	// For each active account, consume the worst-case account delta if the
-	// order were to be matched.
+	// order were to be matched. The account's reserve can't be used by
+	// orders either, so it's never available.
 	accountDebits := make(map[[33]byte]btcutil.Amount)
 	auctionFeeSchedule := auctionTerms.FeeSchedule()
 	for _, acct := range accounts {
 		var (
-			debitAmt btcutil.Amount
+			debitAmt = acct.Reserve
 			acctKey  [33]byte
 		)
 
@@ -97,10 +109,14 @@ func (m *marshaler) MarshallAccountsWithAvailableBalance(ctx context.Context,
 		var acctKey [33]byte
 		copy(acctKey[:], rpcAccount.TraderKey)
 
-		accountDebit := accountDebits[acctKey]
-		availableBalance := rpcAccount.Value - uint64(accountDebit)
+		// The account's value might have dropped below its reserve,
+		// in which case nothing is available.
+		accountDebit := uint64(accountDebits[acctKey])
+		if accountDebit > rpcAccount.Value {
+			accountDebit = rpcAccount.Value
+		}
 
-		rpcAccount.AvailableBalance = availableBalance
+		rpcAccount.AvailableBalance = rpcAccount.Value - accountDebit
 	}
 	return rpcAccounts, nil
 }
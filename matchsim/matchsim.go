@@ -0,0 +1,220 @@
+package matchsim
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/order"
+)
+
+var (
+	// ErrNoUnits is returned if the simulated order doesn't have any
+	// units.
+	ErrNoUnits = errors.New("order must have at least one unit")
+
+	// ErrNoRate is returned if the simulated order doesn't have a rate.
+	ErrNoRate = errors.New("order must have a fixed rate")
+)
+
+// Level is a price level of one side of an order book.
+type Level struct {
+	// RateFixed is the fixed rate of all orders at this level.
+	RateFixed order.FixedRatePremium
+
+	// Units is the total number of unmatched units of all orders at this
+	// level.
+	Units order.SupplyUnit
+}
+
+// Book is a snapshot of both sides of the order book of a single market. The
+// levels don't need to be sorted.
+type Book struct {
+	// Asks are the levels of all asks of the market.
+	Asks []Level
+
+	// Bids are the levels of all bids of the market.
+	Bids []Level
+}
+
+// Order is the prospective order whose match is simulated.
+type Order struct {
+	// Type is the type of the order, either an ask or a bid.
+	Type order.Type
+
+	// RateFixed is the fixed rate of the order.
+	RateFixed order.FixedRatePremium
+
+	// Units is the number of units the order offers or requests.
+	Units order.SupplyUnit
+
+	// MinUnitsMatch is the minimum number of units the order must be
+	// filled with. If zero, any fill is accepted.
+	MinUnitsMatch order.SupplyUnit
+}
+
+// Result is the outcome of a simulated batch.
+type Result struct {
+	// ClearingRate is the uniform rate all matched orders of the batch
+	// pay or earn. It is zero if no orders would be matched at all.
+	ClearingRate order.FixedRatePremium
+
+	// FilledUnits is the number of units of the simulated order that
+	// would be filled.
+	FilledUnits order.SupplyUnit
+
+	// MarketUnits is the total number of units that would be matched in
+	// the batch, including the ones of the simulated order.
+	MarketUnits order.SupplyUnit
+}
+
+// Premium returns the premium the simulated order would pay or earn for its
+// filled units at the clearing rate over the given lease duration.
+func (r *Result) Premium(leaseDuration uint32) btcutil.Amount {
+	return r.ClearingRate.LumpSumPremium(
+		r.FilledUnits.ToSatoshis(), leaseDuration,
+	)
+}
+
+// bookLevel is a level of the book during the simulation.
+type bookLevel struct {
+	rate  order.FixedRatePremium
+	units order.SupplyUnit
+
+	// own is true if the level is the simulated order.
+	own bool
+}
+
+// Simulate simulates the next batch of the given order book with the order
+// added to it. It uses the uniform-price clearing of the auction: the highest
+// bids are matched with the lowest asks for as long as the bid rate is at
+// least the ask rate, and all matched orders clear at the rate of the last,
+// lowest bid that was matched. As a new order, the simulated order comes last
+// among the orders with the same rate.
+//
+// If the order can't be filled with at least its minimum number of units, it
+// isn't matched at all and the batch is simulated without it.
+func Simulate(book *Book, o *Order) (*Result, error) {
+	switch {
+	case o.Type != order.TypeAsk && o.Type != order.TypeBid:
+		return nil, fmt.Errorf("unknown order type %v", o.Type)
+
+	case o.Units == 0:
+		return nil, ErrNoUnits
+
+	case o.RateFixed == 0:
+		return nil, ErrNoRate
+
+	case o.MinUnitsMatch > o.Units:
+		return nil, fmt.Errorf("min units match %d exceeds order "+
+			"units %d", o.MinUnitsMatch, o.Units)
+	}
+
+	result := clearBook(book, o)
+	if result.FilledUnits == 0 || result.FilledUnits >= o.MinUnitsMatch {
+		return result, nil
+	}
+
+	return clearBook(book, nil), nil
+}
+
+// clearBook runs the uniform-price clearing of the given book with the
+// optional order added to it.
+func clearBook(book *Book, o *Order) *Result {
+	asks := sortedLevels(book.Asks, false)
+	bids := sortedLevels(book.Bids, true)
+
+	if o != nil {
+		ownLevel := bookLevel{
+			rate:  o.RateFixed,
+			units: o.Units,
+			own:   true,
+		}
+		if o.Type == order.TypeAsk {
+			asks = insertLevel(asks, ownLevel, false)
+		} else {
+			bids = insertLevel(bids, ownLevel, true)
+		}
+	}
+
+	var (
+		result   Result
+		askIdx   int
+		bidIdx   int
+		lastRate order.FixedRatePremium
+	)
+	for askIdx < len(asks) && bidIdx < len(bids) {
+		ask, bid := &asks[askIdx], &bids[bidIdx]
+		if bid.rate < ask.rate {
+			break
+		}
+
+		units := ask.units
+		if bid.units < units {
+			units = bid.units
+		}
+
+		result.MarketUnits += units
+		if ask.own || bid.own {
+			result.FilledUnits += units
+		}
+		lastRate = bid.rate
+
+		ask.units -= units
+		bid.units -= units
+		if ask.units == 0 {
+			askIdx++
+		}
+		if bid.units == 0 {
+			bidIdx++
+		}
+	}
+	result.ClearingRate = lastRate
+
+	return &result
+}
+
+// sortedLevels returns a copy of the given levels without empty ones, ordered
+// by their rate. Levels with the same rate are kept in their original order.
+func sortedLevels(levels []Level, descending bool) []bookLevel {
+	sorted := make([]bookLevel, 0, len(levels)+1)
+	for _, level := range levels {
+		if level.Units == 0 {
+			continue
+		}
+
+		sorted = append(sorted, bookLevel{
+			rate:  level.RateFixed,
+			units: level.Units,
+		})
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if descending {
+			return sorted[i].rate > sorted[j].rate
+		}
+		return sorted[i].rate < sorted[j].rate
+	})
+
+	return sorted
+}
+
+// insertLevel inserts the given level into the sorted levels after all levels
+// with a better or the same rate.
+func insertLevel(levels []bookLevel, level bookLevel,
+	descending bool) []bookLevel {
+
+	idx := sort.Search(len(levels), func(i int) bool {
+		if descending {
+			return levels[i].rate < level.rate
+		}
+		return levels[i].rate > level.rate
+	})
+
+	levels = append(levels, bookLevel{})
+	copy(levels[idx+1:], levels[idx:])
+	levels[idx] = level
+
+	return levels
+}
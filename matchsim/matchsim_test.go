@@ -0,0 +1,240 @@
+package matchsim
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/order"
+	"github.com/stretchr/testify/require"
+)
+
+// testBook is an order book whose bids and asks cross between the rates 1000
+// and 2000.
+var testBook = &Book{
+	Asks: []Level{
+		{RateFixed: 3000, Units: 10},
+		{RateFixed: 1000, Units: 5},
+		{RateFixed: 1500, Units: 5},
+	},
+	Bids: []Level{
+		{RateFixed: 500, Units: 20},
+		{RateFixed: 2000, Units: 4},
+		{RateFixed: 1200, Units: 4},
+	},
+}
+
+// TestSimulate makes sure the uniform-price clearing of a book with a
+// prospective order results in the expected fills and clearing rate.
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		book   *Book
+		order  *Order
+		result *Result
+	}{{
+		// The bids at 2000 and 1200 take all 5 units of the ask at
+		// 1000. The remaining bid units at 1200 are below our ask.
+		name: "ask not matched",
+		book: testBook,
+		order: &Order{
+			Type:      order.TypeAsk,
+			RateFixed: 1300,
+			Units:     5,
+		},
+		result: &Result{
+			ClearingRate: 1200,
+			FilledUnits:  0,
+			MarketUnits:  5,
+		},
+	}, {
+		// An ask that undercuts everyone is matched first, the clearing
+		// rate is still set by the last accepted bid.
+		name: "cheapest ask filled",
+		book: testBook,
+		order: &Order{
+			Type:      order.TypeAsk,
+			RateFixed: 100,
+			Units:     3,
+		},
+		result: &Result{
+			ClearingRate: 1200,
+			FilledUnits:  3,
+			MarketUnits:  8,
+		},
+	}, {
+		// Our ask at 1000 comes after the existing ask at the same
+		// rate, so it only gets what's left of the bids.
+		name: "ask after existing orders at same rate",
+		book: testBook,
+		order: &Order{
+			Type:      order.TypeAsk,
+			RateFixed: 1000,
+			Units:     10,
+		},
+		result: &Result{
+			ClearingRate: 1200,
+			FilledUnits:  3,
+			MarketUnits:  8,
+		},
+	}, {
+		// A high bid is matched with the cheapest asks first, but
+		// doesn't pay its own rate. The clearing rate is set by the
+		// last accepted bid at 2000.
+		name: "bid filled completely",
+		book: testBook,
+		order: &Order{
+			Type:      order.TypeBid,
+			RateFixed: 5000,
+			Units:     6,
+		},
+		result: &Result{
+			ClearingRate: 2000,
+			FilledUnits:  6,
+			MarketUnits:  10,
+		},
+	}, {
+		// A bid at 1500 comes after the bid at 2000 and takes the rest
+		// of the asks at 1000 and 1500.
+		name: "bid sets clearing rate",
+		book: testBook,
+		order: &Order{
+			Type:      order.TypeBid,
+			RateFixed: 1500,
+			Units:     20,
+		},
+		result: &Result{
+			ClearingRate: 1500,
+			FilledUnits:  6,
+			MarketUnits:  10,
+		},
+	}, {
+		// The bid can only be filled with 6 units, which is below its
+		// minimum, so the batch is cleared without it.
+		name: "bid below min units match",
+		book: testBook,
+		order: &Order{
+			Type:          order.TypeBid,
+			RateFixed:     1500,
+			Units:         20,
+			MinUnitsMatch: 10,
+		},
+		result: &Result{
+			ClearingRate: 1200,
+			FilledUnits:  0,
+			MarketUnits:  5,
+		},
+	}, {
+		name: "empty book",
+		book: &Book{},
+		order: &Order{
+			Type:      order.TypeBid,
+			RateFixed: 1500,
+			Units:     1,
+		},
+		result: &Result{},
+	}, {
+		// Empty levels are ignored and don't set the clearing rate.
+		name: "empty levels",
+		book: &Book{
+			Asks: []Level{{RateFixed: 100, Units: 0}},
+			Bids: []Level{{RateFixed: 200, Units: 0}},
+		},
+		order: &Order{
+			Type:      order.TypeAsk,
+			RateFixed: 150,
+			Units:     1,
+		},
+		result: &Result{},
+	}, {
+		// The order alone can't cross the book.
+		name: "bid below all asks",
+		book: &Book{
+			Asks: []Level{{RateFixed: 1000, Units: 5}},
+		},
+		order: &Order{
+			Type:      order.TypeBid,
+			RateFixed: 999,
+			Units:     1,
+		},
+		result: &Result{},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := Simulate(tc.book, tc.order)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, result)
+		})
+	}
+}
+
+// TestSimulateBookUnchanged makes sure the simulation doesn't modify the book
+// it's given, so a cached snapshot can be simulated against multiple times.
+func TestSimulateBookUnchanged(t *testing.T) {
+	t.Parallel()
+
+	book := &Book{
+		Asks: []Level{
+			{RateFixed: 300, Units: 1}, {RateFixed: 100, Units: 2},
+		},
+		Bids: []Level{{RateFixed: 500, Units: 3}},
+	}
+	bookCopy := &Book{
+		Asks: append([]Level(nil), book.Asks...),
+		Bids: append([]Level(nil), book.Bids...),
+	}
+
+	o := &Order{Type: order.TypeAsk, RateFixed: 200, Units: 1}
+	first, err := Simulate(book, o)
+	require.NoError(t, err)
+	require.Equal(t, bookCopy, book)
+
+	second, err := Simulate(book, o)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+}
+
+// TestSimulateInvalidOrder makes sure invalid orders are rejected.
+func TestSimulateInvalidOrder(t *testing.T) {
+	t.Parallel()
+
+	_, err := Simulate(testBook, &Order{
+		Type: order.Type(7), RateFixed: 1, Units: 1,
+	})
+	require.ErrorContains(t, err, "unknown order type")
+
+	_, err = Simulate(testBook, &Order{
+		Type: order.TypeAsk, RateFixed: 1,
+	})
+	require.ErrorIs(t, err, ErrNoUnits)
+
+	_, err = Simulate(testBook, &Order{
+		Type: order.TypeAsk, Units: 1,
+	})
+	require.ErrorIs(t, err, ErrNoRate)
+
+	_, err = Simulate(testBook, &Order{
+		Type: order.TypeBid, RateFixed: 1, Units: 1, MinUnitsMatch: 2,
+	})
+	require.ErrorContains(t, err, "exceeds order units")
+}
+
+// TestResultPremium makes sure the premium of a simulated fill is calculated
+// at the clearing rate.
+func TestResultPremium(t *testing.T) {
+	t.Parallel()
+
+	result := &Result{
+		ClearingRate: 4960,
+		FilledUnits:  100,
+	}
+	require.Equal(t, btcutil.Amount(99_993), result.Premium(2016))
+
+	require.Zero(t, (&Result{}).Premium(2016))
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// metricsPath is the HTTP path the metrics are served under.
+	metricsPath = "/metrics"
+
+	// shutdownTimeout is the maximum time we wait for pending scrapes to
+	// complete when shutting down the exporter.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Config holds the configuration of the Prometheus exporter.
+type Config struct {
+	// Listen is the address the exporter listens on. The exporter is
+	// disabled if this is empty.
+	Listen string `long:"listen" description:"The host:port to serve Prometheus metrics on, for example localhost:8989. Metrics are disabled if this is not set."`
+}
+
+// Exporter serves the metrics of a Prometheus registry over HTTP.
+type Exporter struct {
+	listen   string
+	gatherer prometheus.Gatherer
+
+	listener net.Listener
+	server   *http.Server
+	wg       sync.WaitGroup
+}
+
+// NewExporter creates a new exporter that serves the metrics collected by the
+// given gatherer on the given address.
+func NewExporter(listen string, gatherer prometheus.Gatherer) *Exporter {
+	return &Exporter{
+		listen:   listen,
+		gatherer: gatherer,
+	}
+}
+
+// Start starts listening for scrape requests.
+func (e *Exporter) Start() error {
+	var err error
+	e.listener, err = net.Listen("tcp", e.listen)
+	if err != nil {
+		return fmt.Errorf("metrics exporter unable to listen on %s: %v",
+			e.listen, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(
+		e.gatherer, promhttp.HandlerOpts{},
+	))
+	e.server = &http.Server{Handler: mux}
+
+	log.Infof("Prometheus exporter listening on %s", e.listener.Addr())
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+
+		err := e.server.Serve(e.listener)
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("Unable to serve metrics: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop shuts down the exporter.
+func (e *Exporter) Stop() error {
+	if e.server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), shutdownTimeout,
+	)
+	defer cancel()
+
+	err := e.server.Shutdown(ctx)
+	e.wg.Wait()
+
+	return err
+}
@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// BatchOutcome describes what happened to a batch we were part of.
+type BatchOutcome string
+
+const (
+	// BatchPrepared is recorded each time the auction server sends us a
+	// batch that includes some of our orders.
+	BatchPrepared BatchOutcome = "prepared"
+
+	// BatchAccepted is recorded each time we accept a batch.
+	BatchAccepted BatchOutcome = "accepted"
+
+	// BatchRejected is recorded each time we reject a batch.
+	BatchRejected BatchOutcome = "rejected"
+
+	// BatchFinalized is recorded each time a batch we were part of is
+	// finalized.
+	BatchFinalized BatchOutcome = "finalized"
+)
+
+// TxKind is the kind of a database transaction.
+type TxKind string
+
+const (
+	// TxRead is a read-only database transaction.
+	TxRead TxKind = "read"
+
+	// TxWrite is a read-write database transaction.
+	TxWrite TxKind = "write"
+)
+
+// Recorder is used by the managers to report the internal state of the trader.
+// Implementations must be safe for concurrent use.
+type Recorder interface {
+	// SetOrderCounts sets the number of orders that aren't archived yet
+	// per order state. States that aren't part of the map have no orders.
+	SetOrderCounts(counts map[string]int)
+
+	// SetAccountBalances sets the balance of all open accounts, keyed by
+	// their hex encoded trader key. Accounts that aren't part of the map
+	// are no longer reported.
+	SetAccountBalances(balances map[string]btcutil.Amount)
+
+	// AddBatch counts a batch we were part of with the given outcome.
+	AddBatch(outcome BatchOutcome)
+
+	// SetConnectionState sets the current state of the connection to the
+	// auction server.
+	SetConnectionState(state string)
+
+	// ObserveBatchVerification records how long it took to verify a
+	// batch.
+	ObserveBatchVerification(duration time.Duration)
+
+	// ObserveOrderSubmission records how long it took the auction server
+	// to answer an order submission.
+	ObserveOrderSubmission(duration time.Duration)
+
+	// ObserveDBTransaction records how long a database transaction of the
+	// given kind took.
+	ObserveDBTransaction(kind TxKind, duration time.Duration)
+}
+
+// Disabled is the recorder that is used if metrics are disabled. All of its
+// methods are no-ops.
+var Disabled Recorder = &noopRecorder{}
+
+// noopRecorder is a Recorder that discards everything that is recorded.
+type noopRecorder struct{}
+
+// SetOrderCounts is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) SetOrderCounts(map[string]int) {}
+
+// SetAccountBalances is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) SetAccountBalances(map[string]btcutil.Amount) {}
+
+// AddBatch is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) AddBatch(BatchOutcome) {}
+
+// SetConnectionState is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) SetConnectionState(string) {}
+
+// ObserveBatchVerification is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) ObserveBatchVerification(time.Duration) {}
+
+// ObserveOrderSubmission is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) ObserveOrderSubmission(time.Duration) {}
+
+// ObserveDBTransaction is a no-op.
+//
+// NOTE: This is part of the Recorder interface.
+func (*noopRecorder) ObserveDBTransaction(TxKind, time.Duration) {}
+
+// OrDisabled returns the given recorder or the Disabled recorder if it is nil.
+// This allows components to leave the recorder unset in their configuration.
+func OrDisabled(recorder Recorder) Recorder {
+	if recorder == nil {
+		return Disabled
+	}
+
+	return recorder
+}
@@ -0,0 +1,183 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// namespace is the prefix of all metrics exported by the trader.
+	namespace = "pool"
+)
+
+// PrometheusRecorder is a Recorder that maintains Prometheus collectors.
+type PrometheusRecorder struct {
+	orders           *prometheus.GaugeVec
+	accountBalances  *prometheus.GaugeVec
+	batches          *prometheus.CounterVec
+	connectionState  *prometheus.GaugeVec
+	batchVerifyTime  prometheus.Histogram
+	orderSubmitTime  prometheus.Histogram
+	dbTransactionDur *prometheus.HistogramVec
+
+	// mu serializes the updates of the gauge vectors that are replaced as
+	// a whole.
+	mu sync.Mutex
+}
+
+// A compile-time check to make sure PrometheusRecorder implements the Recorder
+// interface.
+var _ Recorder = (*PrometheusRecorder)(nil)
+
+// NewPrometheusRecorder creates a new recorder and registers all of its
+// collectors with the given registerer.
+func NewPrometheusRecorder(
+	registerer prometheus.Registerer) (*PrometheusRecorder, error) {
+
+	r := &PrometheusRecorder{
+		orders: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "orders",
+			Help:      "Number of orders that aren't archived yet.",
+		}, []string{"state"}),
+		accountBalances: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "account_balance_sat",
+			Help:      "Balance of each open account in satoshis.",
+		}, []string{"trader_key"}),
+		batches: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "batches_total",
+			Help:      "Number of batches we were part of.",
+		}, []string{"outcome"}),
+		connectionState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "auctioneer_connection_state",
+			Help: "State of the auction server connection, 1 for " +
+				"the current state and 0 for all others.",
+		}, []string{"state"}),
+		batchVerifyTime: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "batch_verification_seconds",
+				Help:      "Time it took to verify a batch.",
+				Buckets:   prometheus.DefBuckets,
+			},
+		),
+		orderSubmitTime: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "order_submission_seconds",
+				Help: "Time it took the auction server to " +
+					"answer an order submission.",
+				Buckets: prometheus.DefBuckets,
+			},
+		),
+		dbTransactionDur: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "db_transaction_seconds",
+				Help:      "Duration of database transactions.",
+				Buckets: prometheus.ExponentialBuckets(
+					0.0005, 2, 14,
+				),
+			}, []string{"kind"},
+		),
+	}
+
+	collectors := []prometheus.Collector{
+		r.orders, r.accountBalances, r.batches, r.connectionState,
+		r.batchVerifyTime, r.orderSubmitTime, r.dbTransactionDur,
+	}
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// SetOrderCounts sets the number of orders that aren't archived yet per order
+// state. States that aren't part of the map have no orders.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) SetOrderCounts(counts map[string]int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.orders.Reset()
+	for state, count := range counts {
+		r.orders.WithLabelValues(state).Set(float64(count))
+	}
+}
+
+// SetAccountBalances sets the balance of all open accounts, keyed by their hex
+// encoded trader key. Accounts that aren't part of the map are no longer
+// reported.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) SetAccountBalances(
+	balances map[string]btcutil.Amount) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.accountBalances.Reset()
+	for traderKey, balance := range balances {
+		r.accountBalances.WithLabelValues(traderKey).Set(
+			float64(balance),
+		)
+	}
+}
+
+// AddBatch counts a batch we were part of with the given outcome.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) AddBatch(outcome BatchOutcome) {
+	r.batches.WithLabelValues(string(outcome)).Inc()
+}
+
+// SetConnectionState sets the current state of the connection to the auction
+// server.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) SetConnectionState(state string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.connectionState.Reset()
+	r.connectionState.WithLabelValues(state).Set(1)
+}
+
+// ObserveBatchVerification records how long it took to verify a batch.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) ObserveBatchVerification(
+	duration time.Duration) {
+
+	r.batchVerifyTime.Observe(duration.Seconds())
+}
+
+// ObserveOrderSubmission records how long it took the auction server to answer
+// an order submission.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) ObserveOrderSubmission(duration time.Duration) {
+	r.orderSubmitTime.Observe(duration.Seconds())
+}
+
+// ObserveDBTransaction records how long a database transaction of the given
+// kind took.
+//
+// NOTE: This is part of the Recorder interface.
+func (r *PrometheusRecorder) ObserveDBTransaction(kind TxKind,
+	duration time.Duration) {
+
+	r.dbTransactionDur.WithLabelValues(string(kind)).Observe(
+		duration.Seconds(),
+	)
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPrometheusRecorder makes sure the gauges that are replaced as a whole
+// don't keep stale label values around.
+func TestPrometheusRecorder(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+	r, err := NewPrometheusRecorder(registry)
+	require.NoError(t, err)
+
+	r.SetOrderCounts(map[string]int{"submitted": 2, "cleared": 1})
+	require.Equal(t, 2.0, testutil.ToFloat64(
+		r.orders.WithLabelValues("submitted"),
+	))
+
+	r.SetOrderCounts(map[string]int{"submitted": 1})
+	require.Equal(t, 1, testutil.CollectAndCount(r.orders))
+
+	r.SetAccountBalances(map[string]btcutil.Amount{"02aa": 100_000})
+	r.SetAccountBalances(map[string]btcutil.Amount{"02bb": 200_000})
+	require.Equal(t, 1, testutil.CollectAndCount(r.accountBalances))
+	require.Equal(t, 200_000.0, testutil.ToFloat64(
+		r.accountBalances.WithLabelValues("02bb"),
+	))
+
+	r.SetConnectionState("Connected")
+	r.SetConnectionState("Reconnecting")
+	require.Equal(t, 1, testutil.CollectAndCount(r.connectionState))
+	require.Equal(t, 1.0, testutil.ToFloat64(
+		r.connectionState.WithLabelValues("Reconnecting"),
+	))
+
+	r.AddBatch(BatchPrepared)
+	r.AddBatch(BatchPrepared)
+	r.AddBatch(BatchRejected)
+	require.Equal(t, 2.0, testutil.ToFloat64(
+		r.batches.WithLabelValues(string(BatchPrepared)),
+	))
+
+	r.ObserveDBTransaction(TxRead, time.Millisecond)
+	r.ObserveDBTransaction(TxWrite, time.Millisecond)
+	require.Equal(t, 2, testutil.CollectAndCount(r.dbTransactionDur))
+
+	// Registering a second recorder with the same registry fails as the
+	// metrics already exist.
+	_, err = NewPrometheusRecorder(registry)
+	require.Error(t, err)
+}
+
+// TestExporter makes sure the exporter serves the recorded metrics.
+func TestExporter(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+	r, err := NewPrometheusRecorder(registry)
+	require.NoError(t, err)
+	r.AddBatch(BatchFinalized)
+
+	exporter := NewExporter("127.0.0.1:0", registry)
+	require.NoError(t, exporter.Start())
+	defer func() {
+		require.NoError(t, exporter.Stop())
+	}()
+
+	resp, err := http.Get(
+		"http://" + exporter.listener.Addr().String() + metricsPath,
+	)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(
+		t, string(body), `pool_batches_total{outcome="finalized"} 1`,
+	)
+}
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	account "github.com/lightninglabs/pool/account"
+	order "github.com/lightninglabs/pool/order"
 	poolrpc "github.com/lightninglabs/pool/poolrpc"
 )
 
@@ -50,3 +51,18 @@ func (mr *MockMarshalerMockRecorder) MarshallAccountsWithAvailableBalance(ctx, a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarshallAccountsWithAvailableBalance", reflect.TypeOf((*MockMarshaler)(nil).MarshallAccountsWithAvailableBalance), ctx, accounts)
 }
+
+// MarshallAccountsWithOrders mocks base method.
+func (m *MockMarshaler) MarshallAccountsWithOrders(ctx context.Context, accounts []*account.Account, orders []order.Order) ([]*poolrpc.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarshallAccountsWithOrders", ctx, accounts, orders)
+	ret0, _ := ret[0].([]*poolrpc.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarshallAccountsWithOrders indicates an expected call of MarshallAccountsWithOrders.
+func (mr *MockMarshalerMockRecorder) MarshallAccountsWithOrders(ctx, accounts, orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarshallAccountsWithOrders", reflect.TypeOf((*MockMarshaler)(nil).MarshallAccountsWithOrders), ctx, accounts, orders)
+}
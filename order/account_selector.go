@@ -0,0 +1,185 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+)
+
+var (
+	// ErrNoSuitableAccount is returned if an account should be selected
+	// automatically for an order but none of the accounts has enough free
+	// balance to cover the order.
+	ErrNoSuitableAccount = errors.New("no account with sufficient free " +
+		"balance found for order")
+)
+
+// reservationLedger is an in-memory index of all orders that can still be
+// matched, grouped by the account that submitted them. Each of those orders
+// reserves a part of its account's balance. The ledger is reconciled with the
+// order store on startup and whenever a batch changes the state of orders.
+type reservationLedger struct {
+	sync.Mutex
+
+	orders map[[33]byte]map[Nonce]Order
+}
+
+// newReservationLedger creates a new, empty reservation ledger.
+func newReservationLedger() *reservationLedger {
+	return &reservationLedger{
+		orders: make(map[[33]byte]map[Nonce]Order),
+	}
+}
+
+// reconcile replaces the content of the ledger with the given orders. Orders
+// that can't be matched anymore are ignored.
+func (l *reservationLedger) reconcile(orders []Order) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.orders = make(map[[33]byte]map[Nonce]Order)
+	for _, o := range orders {
+		l.addLocked(o)
+	}
+}
+
+// add adds a newly submitted order to the ledger.
+func (l *reservationLedger) add(o Order) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.addLocked(o)
+}
+
+// addLocked adds the order to the ledger if it can still be matched.
+//
+// NOTE: The ledger's mutex must be held when calling this method.
+func (l *reservationLedger) addLocked(o Order) {
+	if o.Details().State.Archived() {
+		return
+	}
+
+	acctKey := o.Details().AcctKey
+	if l.orders[acctKey] == nil {
+		l.orders[acctKey] = make(map[Nonce]Order)
+	}
+	l.orders[acctKey][o.Nonce()] = o
+}
+
+// release removes the order with the given nonce from the ledger.
+func (l *reservationLedger) release(nonce Nonce) {
+	l.Lock()
+	defer l.Unlock()
+
+	for acctKey, orders := range l.orders {
+		if _, ok := orders[nonce]; !ok {
+			continue
+		}
+
+		delete(orders, nonce)
+		if len(orders) == 0 {
+			delete(l.orders, acctKey)
+		}
+
+		return
+	}
+}
+
+// reserved returns the total balance of the given account that is reserved by
+// its orders that can still be matched.
+func (l *reservationLedger) reserved(acctKey [33]byte,
+	feeSchedule terms.FeeSchedule) btcutil.Amount {
+
+	l.Lock()
+	defer l.Unlock()
+
+	var reserved btcutil.Amount
+	for _, o := range l.orders[acctKey] {
+		reserved += o.ReservedValue(feeSchedule)
+	}
+
+	return reserved
+}
+
+// SelectAccount picks the account an order should be submitted from if the
+// trader didn't specify one. Only accounts that are able to submit orders and
+// have enough free balance, which is their value minus their reserve and the
+// balance reserved by their open orders, are considered. Of those, the account
+// with the furthest expiry is selected.
+func (m *manager) SelectAccount(o Order,
+	terms *terms.AuctioneerTerms) (*account.Account, error) {
+
+	accounts, err := m.cfg.AcctStore.Accounts()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch accounts: %v", err)
+	}
+
+	feeSchedule := terms.FeeSchedule()
+	orderReserved := o.ReservedValue(feeSchedule)
+
+	var selected *account.Account
+	for _, acct := range accounts {
+		switch acct.State {
+		case account.StateOpen, account.StatePendingUpdate,
+			account.StatePendingBatch:
+
+		default:
+			continue
+		}
+
+		var acctKey [33]byte
+		copy(acctKey[:], acct.TraderKey.PubKey.SerializeCompressed())
+
+		reserved := m.reservations.reserved(acctKey, feeSchedule)
+		if acct.Value < reserved+orderReserved+acct.Reserve {
+			log.Debugf("Skipping account %x for order %v, value "+
+				"%v with %v reserved by orders and a reserve "+
+				"of %v is insufficient", acctKey[:], o.Nonce(),
+				acct.Value, reserved, acct.Reserve)
+			continue
+		}
+
+		if selected == nil || acct.Expiry > selected.Expiry {
+			selected = acct
+		}
+	}
+
+	if selected == nil {
+		return nil, ErrNoSuitableAccount
+	}
+
+	log.Infof("Selected account %x with expiry %d for order %v",
+		selected.TraderKey.PubKey.SerializeCompressed(), selected.Expiry,
+		o.Nonce())
+
+	return selected, nil
+}
+
+// ReleaseReservation removes the order with the given nonce from the ledger of
+// reserved account balances and drops the reservation of its fingerprint made
+// by CheckSubmission. This must be called once an order can no longer be
+// matched because it was canceled or failed.
+func (m *manager) ReleaseReservation(nonce Nonce) {
+	m.reservations.release(nonce)
+	m.submitGuard.release(nonce)
+}
+
+// reconcileReservations rebuilds the ledger of reserved account balances
+// from all orders in the store that can still be matched.
+func (m *manager) reconcileReservations() error {
+	dbOrders, err := m.cfg.Store.GetOrdersByState(
+		StateSubmitted, StateCleared, StatePartiallyFilled,
+		StateStaged,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch open orders: %v", err)
+	}
+
+	m.reservations.reconcile(dbOrders)
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSelectAccount makes sure an account is selected for an order based on
+// its free balance and expiry and that the reserved balances are tracked
+// correctly.
+func TestSelectAccount(t *testing.T) {
+	t.Parallel()
+
+	newAccount := func(key *btcec.PublicKey, value btcutil.Amount,
+		expiry uint32, state account.State) *account.Account {
+
+		return &account.Account{
+			Value:  value,
+			Expiry: expiry,
+			State:  state,
+			TraderKey: &keychain.KeyDescriptor{
+				PubKey: key,
+			},
+		}
+	}
+	newAsk := func(nonce Nonce, key *btcec.PublicKey, units SupplyUnit,
+		state State) *Ask {
+
+		var acctKey [33]byte
+		copy(acctKey[:], key.SerializeCompressed())
+
+		return &Ask{
+			Kit: newKitFromTemplate(nonce, &Kit{
+				State:            state,
+				Units:            units,
+				UnitsUnfulfilled: units,
+				FixedRate:        2_000,
+				MaxBatchFeeRate:  1000,
+				AcctKey:          acctKey,
+				LeaseDuration:    144,
+				MinUnitsMatch:    100,
+			}),
+		}
+	}
+
+	// The closed account has the furthest expiry but must never be
+	// selected.
+	accountA := newAccount(
+		acctKeySmall, btcutil.SatoshiPerBitcoin, 3000,
+		account.StateOpen,
+	)
+	accountB := newAccount(
+		acctKeyBig, 2*btcutil.SatoshiPerBitcoin, 2000,
+		account.StateOpen,
+	)
+	accountC := newAccount(
+		unrelatedKey, 5*btcutil.SatoshiPerBitcoin, 5000,
+		account.StateClosed,
+	)
+
+	orderStore := newMockStore()
+	mgr := NewManager(&ManagerConfig{
+		Store: orderStore,
+		AcctStore: &mockAccountStore{
+			accounts: []*account.Account{
+				accountA, accountB, accountC,
+			},
+		},
+	})
+
+	testTerms := &terms.AuctioneerTerms{
+		OrderExecBaseFee: 1,
+		OrderExecFeeRate: 100,
+	}
+
+	// Without any open orders, the open account with the furthest expiry
+	// is selected.
+	newOrder := newAsk(Nonce{0x01}, acctKeySmall, 500, StateSubmitted)
+	selected, err := mgr.SelectAccount(newOrder, testTerms)
+	require.NoError(t, err)
+	require.Equal(t, accountA, selected)
+
+	// We now add an order to account A that reserves enough of its
+	// balance for the new order to no longer fit. Archived orders don't
+	// reserve any balance, so the canceled order of account B is ignored.
+	openOrder := newAsk(Nonce{0x02}, acctKeySmall, 600, StateSubmitted)
+	canceledOrder := newAsk(Nonce{0x03}, acctKeyBig, 2000, StateCanceled)
+	require.NoError(t, orderStore.SubmitOrder(openOrder))
+	require.NoError(t, orderStore.SubmitOrder(canceledOrder))
+	require.NoError(t, mgr.reconcileReservations())
+
+	selected, err = mgr.SelectAccount(newOrder, testTerms)
+	require.NoError(t, err)
+	require.Equal(t, accountB, selected)
+
+	// Once the open order is released, account A is selected again.
+	mgr.ReleaseReservation(openOrder.Nonce())
+	selected, err = mgr.SelectAccount(newOrder, testTerms)
+	require.NoError(t, err)
+	require.Equal(t, accountA, selected)
+
+	// An order that doesn't fit into any of the open accounts results in
+	// an error.
+	largeOrder := newAsk(Nonce{0x04}, acctKeySmall, 3000, StateSubmitted)
+	_, err = mgr.SelectAccount(largeOrder, testTerms)
+	require.ErrorIs(t, err, ErrNoSuitableAccount)
+}
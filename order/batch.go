@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
-	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
@@ -220,6 +219,15 @@ type Batch struct {
 	// which the batch transaction can be found within. This will be used by
 	// traders to base off their absolute channel lease maturity height.
 	HeightHint uint32
+
+	// ServerNonces are the auctioneer's MuSig2 public nonces for each of
+	// our taproot accounts that is spent in the batch transaction.
+	ServerNonces AccountNonces
+
+	// PreviousOutputs are the outputs spent by the inputs of the batch
+	// transaction, in the order of the inputs. These are required to sign
+	// for taproot accounts.
+	PreviousOutputs []*wire.TxOut
 }
 
 // Fetcher describes a function that's able to fetch the latest version of an
@@ -313,9 +321,15 @@ type MatchedOrder struct {
 }
 
 // BatchSignature is a map type that is keyed by a trader's account key and
-// contains the multi-sig signature for the input that
-// spends from the current account in a batch.
-type BatchSignature map[[33]byte]*ecdsa.Signature
+// contains the multi-sig signature for the input that spends from the current
+// account in a batch. For legacy accounts this is a DER encoded ECDSA
+// signature, for taproot accounts it is a partial MuSig2 signature.
+type BatchSignature map[[33]byte][]byte
+
+// AccountNonces is a map type that is keyed by a trader's account key and
+// contains the MuSig2 public nonces of a taproot account being spent in a
+// batch.
+type AccountNonces map[[33]byte]poolscript.MuSig2Nonces
 
 // BatchVerifier is an interface that can verify a batch from the point of view
 // of the trader.
@@ -329,8 +343,9 @@ type BatchVerifier interface {
 // a batch.
 type BatchSigner interface {
 	// Sign returns the witness stack of all account inputs in a batch that
-	// belong to the trader.
-	Sign(*Batch) (BatchSignature, error)
+	// belong to the trader, along with our MuSig2 nonces for all taproot
+	// accounts.
+	Sign(*Batch) (BatchSignature, AccountNonces, error)
 }
 
 // BatchStorer is an interface that can store a batch to the local database by
@@ -340,9 +355,9 @@ type BatchStorer interface {
 	// are correctly and atomically stored to the database.
 	StorePendingBatch(_ *Batch) error
 
-	// MarkBatchComplete marks a pending batch as complete, allowing a
-	// trader to participate in a new batch.
-	MarkBatchComplete() error
+	// MarkBatchComplete marks the pending batch with the given ID as
+	// complete, allowing a trader to participate in a new batch.
+	MarkBatchComplete(BatchID) error
 }
 
 // DecrementingBatchIDs lists all possible batch IDs that can exist between a
@@ -20,11 +20,16 @@ type batchSigner struct {
 }
 
 // Sign returns the witness stack of all account inputs in a batch that
-// belong to the trader.
+// belong to the trader. Legacy accounts are signed with a full ECDSA signature
+// while taproot accounts get a partial MuSig2 signature, for which our nonces
+// are returned as well. Both account versions can be spent in the same batch.
 //
 // NOTE: This method is part of the BatchSigner interface.
-func (s *batchSigner) Sign(batch *Batch) (BatchSignature, error) {
+func (s *batchSigner) Sign(batch *Batch) (BatchSignature, AccountNonces,
+	error) {
+
 	ourSigs := make(BatchSignature)
+	ourNonces := make(AccountNonces)
 
 	// At this point we know that the accounts charged are correct. So we
 	// can just go through them, find the corresponding input in the batch
@@ -33,12 +38,13 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, error) {
 		// Get account from DB and make sure we can create the output.
 		acct, err := s.getAccount(acctDiff.AccountKey)
 		if err != nil {
-			return nil, fmt.Errorf("account not found: %v", err)
+			return nil, nil, fmt.Errorf("account not found: %v",
+				err)
 		}
 		acctOut, err := acct.Output()
 		if err != nil {
-			return nil, fmt.Errorf("could not get account output: "+
-				"%v", err)
+			return nil, nil, fmt.Errorf("could not get account "+
+				"output: %v", err)
 		}
 		var acctKey [33]byte
 		copy(acctKey[:], acct.TraderKey.PubKey.SerializeCompressed())
@@ -51,7 +57,21 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, error) {
 			}
 		}
 		if inputIndex == -1 {
-			return nil, fmt.Errorf("account input not found")
+			return nil, nil, fmt.Errorf("account input not found")
+		}
+
+		if acct.Version == account.VersionTaprootEnabled {
+			sig, nonces, err := s.signTaproot(
+				batch, acct, acctKey, inputIndex,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			ourSigs[acctKey] = sig
+			ourNonces[acctKey] = nonces
+
+			continue
 		}
 
 		// Gather the remaining components required to sign the
@@ -64,7 +84,7 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, error) {
 			acct.BatchKey, acct.Secret,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		signDesc := &lndclient.SignDescriptor{
 			KeyDesc:       *acct.TraderKey,
@@ -79,15 +99,52 @@ func (s *batchSigner) Sign(batch *Batch) (BatchSignature, error) {
 			[]*lndclient.SignDescriptor{signDesc}, nil,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		ourSigs[acctKey], err = ecdsa.ParseDERSignature(sigs[0])
+		sig, err := ecdsa.ParseDERSignature(sigs[0])
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		ourSigs[acctKey] = sig.Serialize()
+	}
+
+	return ourSigs, ourNonces, nil
+}
+
+// signTaproot creates our partial MuSig2 signature for the input of the batch
+// transaction that spends the given taproot account and returns it along with
+// our public nonces. The auctioneer combines the partial signatures, so the
+// signing session is removed from lnd right away.
+func (s *batchSigner) signTaproot(batch *Batch, acct *account.Account,
+	acctKey [33]byte, inputIndex int) ([]byte, poolscript.MuSig2Nonces,
+	error) {
+
+	var ourNonces poolscript.MuSig2Nonces
+
+	serverNonces, ok := batch.ServerNonces[acctKey]
+	if !ok {
+		return nil, ourNonces, fmt.Errorf("auctioneer nonces for "+
+			"account %x missing", acctKey[:])
+	}
+
+	ctx := context.Background()
+	session, err := account.TaprootMuSig2Session(ctx, s.signer, acct)
+	if err != nil {
+		return nil, ourNonces, fmt.Errorf("error creating MuSig2 "+
+			"session: %v", err)
+	}
+
+	sig, err := account.TaprootMuSig2Sign(
+		ctx, s.signer, session.SessionID, serverNonces[:],
+		batch.BatchTX, batch.PreviousOutputs, inputIndex, true,
+	)
+	if err != nil {
+		_ = s.signer.MuSig2Cleanup(ctx, session.SessionID)
+		return nil, ourNonces, fmt.Errorf("error signing account "+
+			"%x: %v", acctKey[:], err)
 	}
 
-	return ourSigs, nil
+	return sig, session.PublicNonce, nil
 }
 
 // A compile-time constraint to ensure batchSigner implements BatchSigner.
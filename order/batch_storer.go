@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneerrpc"
@@ -24,18 +25,51 @@ type batchStorer struct {
 //
 // NOTE: This method is part of the BatchStorer interface.
 func (s *batchStorer) StorePendingBatch(batch *Batch) error {
+	mods, err := newBatchModifications(batch, s.orderStore, s.getAccount)
+	if err != nil {
+		return err
+	}
+
+	// Everything is ready to be persisted now.
+	return s.orderStore.StorePendingBatch(
+		batch, mods.orders, mods.orderModifiers, mods.accounts,
+		mods.accountModifiers,
+	)
+}
+
+// batchModifications groups all order and account modifications that are the
+// result of a trader participating in a batch.
+type batchModifications struct {
+	orders           []Nonce
+	orderModifiers   [][]Modifier
+	accounts         []*account.Account
+	accountModifiers [][]account.Modifier
+}
+
+// newBatchModifications creates the order and account modifiers that need to
+// be applied to our local state when the given batch is executed.
+func newBatchModifications(batch *Batch, orderStore Store,
+	getAccount func(*btcec.PublicKey) (*account.Account, error)) (
+	*batchModifications, error) {
+
 	// Prepare the order modifications first.
 	orders := make([]Nonce, len(batch.MatchedOrders))
 	orderModifiers := make([][]Modifier, len(orders))
+	ourOrders := make([]Order, len(orders))
+	numAccountChans := make(map[[33]byte]uint32)
 	orderIndex := 0
 	for nonce, theirOrders := range batch.MatchedOrders {
 		// Get our order first to find out the number of unfulfilled
 		// units.
-		ourOrder, err := s.orderStore.GetOrder(nonce)
+		ourOrder, err := orderStore.GetOrder(nonce)
 		if err != nil {
-			return fmt.Errorf("error getting order: %v", err)
+			return nil, fmt.Errorf("error getting order: %v", err)
 		}
 		orders[orderIndex] = nonce
+		ourOrders[orderIndex] = ourOrder
+
+		acctKey := ourOrder.Details().AcctKey
+		numAccountChans[acctKey] += uint32(len(theirOrders))
 
 		// Find out if the order has unfulfilled units left or not.
 		unitsUnfulfilled := ourOrder.Details().UnitsUnfulfilled
@@ -70,15 +104,30 @@ func (s *batchStorer) StorePendingBatch(batch *Batch) error {
 		orderIndex++
 	}
 
+	// Now that we know how many channels each account creates, we can
+	// add what each order paid or earned in this batch to its totals.
+	for idx, ourOrder := range ourOrders {
+		totalsModifier, err := newTotalsModifier(
+			batch, ourOrder, numAccountChans, getAccount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		orderModifiers[idx] = append(
+			orderModifiers[idx], totalsModifier,
+		)
+	}
+
 	// Next create our account modifiers.
 	accounts := make([]*account.Account, len(batch.AccountDiffs))
 	accountModifiers := make([][]account.Modifier, len(accounts))
 	for idx, diff := range batch.AccountDiffs {
 		// Get the current state of the account first so we can create
 		// a proper diff.
-		acct, err := s.getAccount(diff.AccountKey)
+		acct, err := getAccount(diff.AccountKey)
 		if err != nil {
-			return fmt.Errorf("error getting account: %v", err)
+			return nil, fmt.Errorf("error getting account: %v", err)
 		}
 		accounts[idx] = acct
 		var modifiers []account.Modifier
@@ -122,8 +171,8 @@ func (s *batchStorer) StorePendingBatch(batch *Batch) error {
 			)
 
 		default:
-			return fmt.Errorf("invalid ending account state %d",
-				diff.EndingState)
+			return nil, fmt.Errorf("invalid ending account "+
+				"state %d", diff.EndingState)
 		}
 
 		// Finally update the account value, height hint, and its latest
@@ -141,16 +190,55 @@ func (s *batchStorer) StorePendingBatch(batch *Batch) error {
 		accountModifiers[idx] = modifiers
 	}
 
-	// Everything is ready to be persisted now.
-	return s.orderStore.StorePendingBatch(
-		batch, orders, orderModifiers, accounts, accountModifiers,
+	return &batchModifications{
+		orders:           orders,
+		orderModifiers:   orderModifiers,
+		accounts:         accounts,
+		accountModifiers: accountModifiers,
+	}, nil
+}
+
+// newTotalsModifier creates the modifier that adds the premium, execution fee
+// and chain fee share the given order paid or earned in the batch to its
+// running totals.
+func newTotalsModifier(batch *Batch, ourOrder Order,
+	numAccountChans map[[33]byte]uint32,
+	getAccount func(*btcec.PublicKey) (*account.Account, error)) (Modifier,
+	error) {
+
+	details := ourOrder.Details()
+	clearingPrice := batch.ClearingPrices[details.LeaseDuration]
+	theirOrders := batch.MatchedOrders[ourOrder.Nonce()]
+
+	var premium, execFee btcutil.Amount
+	for _, theirOrder := range theirOrders {
+		chanAmt := theirOrder.UnitsFilled.ToSatoshis()
+		premium += clearingPrice.LumpSumPremium(
+			chanAmt, details.LeaseDuration,
+		)
+		execFee += executionFee(chanAmt, batch.ExecutionFee)
+	}
+
+	acctKey, err := btcec.ParsePubKey(details.AcctKey[:])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing account key: %v", err)
+	}
+	acct, err := getAccount(acctKey)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account: %v", err)
+	}
+	chainFee := OrderChainFee(
+		uint32(len(theirOrders)), numAccountChans[details.AcctKey],
+		batch.BatchTxFeeRate, acct.Version,
 	)
+
+	return TotalsModifier(premium, execFee, chainFee), nil
 }
 
-// MarkBatchComplete marks a pending batch as complete, allowing a trader to
-// participate in a new batch.
-func (s *batchStorer) MarkBatchComplete() error {
-	return s.orderStore.MarkBatchComplete()
+// MarkBatchComplete marks the pending batch with the given ID as complete,
+// allowing a trader to participate in a new batch.
+func (s *batchStorer) MarkBatchComplete(batchID BatchID) error {
+	return s.orderStore.MarkBatchComplete(batchID)
 }
 
 // A compile-time constraint to ensure batchStorer implements BatchStorer.
@@ -6,8 +6,10 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
 )
 
 // TestBatchStorer makes sure a batch is prepared correctly for serialization by
@@ -51,6 +53,12 @@ func TestBatchStorer(t *testing.T) {
 	ask := &Ask{Kit: newKit(Nonce{0x01}, 4, 1)}
 	bid1 := &Bid{Kit: newKit(Nonce{0x02}, 3, 1)}
 	bid2 := &Bid{Kit: newKit(Nonce{0x03}, 3, 2)}
+	ask.AcctKey = acctIDSmall
+	bid1.AcctKey = acctIDBig
+	bid2.AcctKey = acctIDBig
+	for _, kit := range []*Kit{&ask.Kit, &bid1.Kit, &bid2.Kit} {
+		kit.LeaseDuration = leaseDuration
+	}
 	batchTx := &wire.MsgTx{
 		Version: 2,
 		TxOut: []*wire.TxOut{{
@@ -106,6 +114,12 @@ func TestBatchStorer(t *testing.T) {
 		BatchTX:        batchTx,
 		BatchTxFeeRate: chainfee.FeePerKwFloor,
 		HeightHint:     1337,
+		ExecutionFee: terms.NewLinearFeeSchedule(
+			execFeeBase, execFeeRate,
+		),
+		ClearingPrices: map[uint32]FixedRatePremium{
+			leaseDuration: clearingPrice,
+		},
 	}
 
 	// Create the starting database state now.
@@ -153,6 +167,24 @@ func TestBatchStorer(t *testing.T) {
 			bid2.UnitsUnfulfilled, 1)
 	}
 
+	// Every channel is 2 units large. The ask paid for both its channels,
+	// each bid for one of the channels of its account.
+	chanAmt := SupplyUnit(2).ToSatoshis()
+	chanPremium := clearingPrice.LumpSumPremium(chanAmt, leaseDuration)
+	chanExecFee := executionFee(chanAmt, batch.ExecutionFee)
+	require.Equal(t, 2*chanPremium, ask.PremiumTotal)
+	require.Equal(t, 2*chanExecFee, ask.ExecutionFeeTotal)
+	require.Equal(t, EstimateTraderFee(
+		2, batch.BatchTxFeeRate, smallAcct.Version,
+	), ask.ChainFeeTotal)
+	for _, bid := range []*Bid{bid1, bid2} {
+		require.Equal(t, chanPremium, bid.PremiumTotal)
+		require.Equal(t, chanExecFee, bid.ExecutionFeeTotal)
+		require.Equal(t, EstimateTraderFee(
+			2, batch.BatchTxFeeRate, bigAcct.Version,
+		)/2, bid.ChainFeeTotal)
+	}
+
 	// Check the account states next.
 	if smallAcct.State != account.StatePendingClosed {
 		t.Fatalf("invalid account state, got %d wanted %d",
@@ -3,6 +3,7 @@ package order
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/wire"
@@ -99,6 +100,7 @@ func TestChannelOutput(t *testing.T) {
 	// And the second test is with a sidecar channel bid.
 	ticket, err := sidecar.NewTicket(
 		sidecar.VersionDefault, 400_000, 0, 12345, pubKeyBid, false,
+		time.Time{},
 	)
 	require.NoError(t, err)
 	ticket.Recipient = &sidecar.Recipient{
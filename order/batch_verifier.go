@@ -1,14 +1,27 @@
 package order
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/policy"
+	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 const (
@@ -20,6 +33,12 @@ const (
 	// height to avoid any discrepancies in block propagation between us and
 	// the auctioneer.
 	heightHintPadding = 3
+
+	// batchFeeRateTolerance is the percentage by which the fee rate we
+	// calculate for a batch transaction may exceed the highest max batch
+	// fee rate of our matched orders. We only estimate the witness size of
+	// other traders' inputs, so we need to allow for some deviation.
+	batchFeeRateTolerance = 10
 )
 
 var (
@@ -60,6 +79,22 @@ func newMismatchErr(cause error, msg string, args ...interface{}) error {
 	}
 }
 
+// MatchRejectErr is an error type that is returned if the trader rejects
+// certain orders of a batch instead of the whole batch. The auctioneer can
+// then repeat the matchmaking without the rejected orders.
+type MatchRejectErr struct {
+	// RejectedOrders is the map of matches we reject, keyed with the nonce
+	// of the other trader's order.
+	RejectedOrders map[Nonce]*auctioneerrpc.OrderReject
+}
+
+// Error returns the underlying error string.
+//
+// NOTE: This is part of the error interface.
+func (e *MatchRejectErr) Error() string {
+	return fmt.Sprintf("trader rejected orders: %v", e.RejectedOrders)
+}
+
 // batchVerifier is a type that implements BatchVerifier and can verify a batch
 // from the point of view of the trader.
 type batchVerifier struct {
@@ -68,6 +103,28 @@ type batchVerifier struct {
 	wallet        lndclient.WalletKitClient
 	ourNodePubkey [33]byte
 	version       BatchVersion
+
+	// fetchTerms returns the current auctioneer terms. They are only
+	// queried if the batch changes the expiry of one of our accounts.
+	fetchTerms func() (*terms.AuctioneerTerms, error)
+
+	// validateExpiry makes sure an account expiry proposed by the
+	// auctioneer is acceptable.
+	validateExpiry func(acct *account.Account, newExpiry,
+		bestHeight uint32, terms *terms.AuctioneerTerms) error
+
+	// nodeTiers returns the tiers the auctioneer assigned to the given
+	// nodes. If this is nil, the node tiers of the asks our bids are
+	// matched with aren't checked.
+	nodeTiers func(nodeKeys [][33]byte) (map[[33]byte]NodeTier, error)
+
+	// evaluatePolicy checks the batch against the trader's batch policy.
+	// If this is nil, no policy is enforced.
+	evaluatePolicy func(b *policy.Batch) error
+
+	// numWorkers is the maximum number of matches that are verified
+	// concurrently. If this is zero, GOMAXPROCS is used.
+	numWorkers int
 }
 
 // Verify makes sure the batch prepared by the server is correct and can be
@@ -91,16 +148,26 @@ func (v *batchVerifier) Verify(batch *Batch, bestHeight uint32) error {
 		return ErrInvalidBatchHeightHint
 	}
 
+	// We don't trust the auctioneer to only match our bids with nodes of
+	// the requested tier, so we look up the tiers of all relevant nodes
+	// at once.
+	tiers, err := v.matchedNodeTiers(batch)
+	if err != nil {
+		return err
+	}
+
 	// First go through all orders that were matched for us. We'll make sure
-	// we know of the order and that the numbers check out on a high level.
+	// we know of the order and the account it spends from.
 	tallies := make(map[[33]byte]*AccountTally)
 	accounts := make(map[[33]byte]*account.Account)
-	for nonce, theirOrders := range batch.MatchedOrders {
+	ourOrders := make(map[Nonce]Order, len(batch.MatchedOrders))
+	for nonce := range batch.MatchedOrders {
 		// Find our order in the database.
 		ourOrder, err := v.orderStore.GetOrder(nonce)
 		if err != nil {
 			return fmt.Errorf("order %v not found: %v", nonce, err)
 		}
+		ourOrders[nonce] = ourOrder
 
 		// We'll index our account tallies by the serialized form of
 		// the account key so some copying is necessary first.
@@ -112,59 +179,86 @@ func (v *batchVerifier) Verify(batch *Batch, bestHeight uint32) error {
 
 		// Find the account the order spends from, if it isn't already
 		// in the cache because another order spends from it.
-		tally, ok := tallies[acctKeyRaw]
-		if !ok {
-			acct, err := v.getAccount(acctKey)
-			if err != nil {
-				return fmt.Errorf("account %x not found: %v",
-					acctKeyRaw, err)
-			}
-			tally = &AccountTally{
-				EndingBalance: acct.Value,
-			}
-			tallies[acctKeyRaw] = tally
-			accounts[acctKeyRaw] = acct
+		if _, ok := tallies[acctKeyRaw]; ok {
+			continue
+		}
+		acct, err := v.getAccount(acctKey)
+		if err != nil {
+			return fmt.Errorf("account %x not found: %v",
+				acctKeyRaw, err)
 		}
+		tallies[acctKeyRaw] = &AccountTally{
+			EndingBalance: acct.Value,
+		}
+		accounts[acctKeyRaw] = acct
+	}
+
+	// The checks of each single match don't depend on each other, so they
+	// run concurrently. This includes deriving our multisig keys to find
+	// the channel outputs, which is the most expensive part of verifying
+	// a batch with many matches.
+	matchRejects, err := v.verifyMatches(batch, ourOrders, tiers)
+	if err != nil {
+		return err
+	}
+
+	// Now that we know all matches are sound, we can make sure the numbers
+	// check out on a high level. Matches that violate a constraint of our
+	// order are collected instead of failing right away, so we can reject
+	// all of them at once. We go through our orders in a fixed order so
+	// the same batch always results in the same error.
+	rejects := make(map[Nonce]*auctioneerrpc.OrderReject)
+	for _, nonce := range sortedNonces(ourOrders) {
+		ourOrder := ourOrders[nonce]
+		tally := tallies[ourOrder.Details().AcctKey]
 
 		// The clearing price is different for each duration.
 		ourOrderDuration := ourOrder.Details().LeaseDuration
 		clearingPrice := batch.ClearingPrices[ourOrderDuration]
 
-		// Now that we know which of our orders were involved in the
-		// match, we can start validating the match and tally up the
-		// account balance, executed units and fee diffs.
+		// Tally up the account balance, executed units and fee diffs.
 		unitsFilled := SupplyUnit(0)
-		for _, theirOrder := range theirOrders {
-			// Verify order compatibility and fee structure.
-			err = v.validateMatchedOrder(
+		distinctPeers := make(map[[33]byte]struct{})
+		hasRejects := false
+		for idx, theirOrder := range batch.MatchedOrders[nonce] {
+			tallyMatch(
 				tally, ourOrder, theirOrder, batch.ExecutionFee,
 				clearingPrice,
 			)
-			if err != nil {
-				return newMismatchErr(
-					err, "error matching against order %v",
-					theirOrder.Order.Nonce(),
-				)
-			}
 
-			// Make sure there is a channel output included in the
-			// batch transaction that has the multisig script we
-			// expect.
-			err = v.validateChannelOutput(
-				batch, ourOrder, theirOrder,
-			)
-			if err != nil {
-				return newMismatchErr(
-					err, "error finding channel output "+
-						"for matched order %v",
-					theirOrder.Order.Nonce(),
-				)
+			// Make sure we never pair our order with a node it
+			// isn't allowed to match with or with fewer units than
+			// it requires.
+			if reject := matchRejects[nonce][idx]; reject != nil {
+				rejects[theirOrder.Order.Nonce()] = reject
+				hasRejects = true
+				continue
 			}
 
 			// The match looks good, one channel output more to pay
 			// chain fees for.
 			tally.NumChansCreated++
 			unitsFilled += theirOrder.UnitsFilled
+			distinctPeers[theirOrder.NodeKey] = struct{}{}
+		}
+
+		// The remaining checks are about all matches of our order
+		// combined, which will be different once the auctioneer
+		// repeats the matchmaking without the rejected ones.
+		if hasRejects {
+			continue
+		}
+
+		// An ask can require its matches to be spread over a minimum
+		// number of distinct nodes.
+		ask, isAsk := ourOrder.(*Ask)
+		if isAsk && uint32(len(distinctPeers)) < ask.MinDistinctPeers {
+			return &MismatchErr{
+				msg: fmt.Sprintf("ask order %v matched with %d "+
+					"distinct peers, but minimum is %d",
+					nonce, len(distinctPeers),
+					ask.MinDistinctPeers),
+			}
 		}
 
 		// Verify the clearing price satisfies our order.
@@ -203,19 +297,69 @@ func (v *batchVerifier) Verify(batch *Batch, bestHeight uint32) error {
 				),
 			}
 
-		case unitsFilled < ourOrder.Details().MinUnitsMatch:
-			return &MismatchErr{
-				msg: fmt.Sprintf("invalid units to be filled "+
-					"for order %v. matched %d units, but "+
-					"minimum is %d", ourOrder.Nonce(),
-					unitsFilled,
-					ourOrder.Details().MinUnitsMatch),
-			}
+		}
+	}
+
+	// If any of the matches violate a constraint of our orders, we reject
+	// only those matches. There's no point in checking the account diffs
+	// as the auctioneer needs to repeat the matchmaking anyway.
+	if len(rejects) > 0 {
+		return &MatchRejectErr{
+			RejectedOrders: rejects,
+		}
+	}
+
+	// The auctioneer shouldn't include any of our orders in a batch with a
+	// fee rate above the order's max batch fee rate. We collect all
+	// affected orders so the ones that opted in can be updated.
+	var feeRateExceeded []Nonce
+	for nonce, ourOrder := range ourOrders {
+		if batch.BatchTxFeeRate > ourOrder.Details().MaxBatchFeeRate {
+			feeRateExceeded = append(feeRateExceeded, nonce)
+		}
+	}
+	if len(feeRateExceeded) > 0 {
+		return &BatchFeeRateErr{
+			Nonces:       feeRateExceeded,
+			BatchFeeRate: batch.BatchTxFeeRate,
+		}
+	}
+
+	// We don't only trust the fee rate the auctioneer claims, we also make
+	// sure the batch transaction doesn't actually pay a lot more.
+	if err := verifyBatchFeeRate(batch, ourOrders, accounts); err != nil {
+		return err
+	}
+
+	// Now that we know how many channels each account creates, we can make
+	// sure none of our orders pays a higher share of the chain fee than
+	// its owner is willing to. Like the reserve below, this is a policy
+	// decision on our side, so we don't return a MismatchErr.
+	for nonce, ourOrder := range ourOrders {
+		maxChainFee := ourOrder.Details().MaxChainFee
+		if maxChainFee == 0 {
+			continue
+		}
+
+		acctKey := ourOrder.Details().AcctKey
+		chainFee := OrderChainFee(
+			uint32(len(batch.MatchedOrders[nonce])),
+			tallies[acctKey].NumChansCreated, batch.BatchTxFeeRate,
+			accounts[acctKey].Version,
+		)
+		if chainFee > maxChainFee {
+			return fmt.Errorf("order %v: %w: chain fee share %v, "+
+				"maximum %v", nonce, ErrChainFeeExceeded,
+				chainFee, maxChainFee)
 		}
 	}
 
 	// Now that we know all the accounts that were involved in the batch,
 	// we can make sure we got a diff for each of them.
+	var (
+		auctionTerms  *terms.AuctioneerTerms
+		totalChainFee btcutil.Amount
+	)
 	for _, diff := range batch.AccountDiffs {
 		// We only should get diffs for accounts that have orders in the
 		// batch. If not, something's messed up.
@@ -230,7 +374,11 @@ func (v *batchVerifier) Verify(batch *Batch, bestHeight uint32) error {
 
 		// Now that we know how many channels were created from the
 		// given account, let's also account for the chain fees.
-		tally.ChainFees(batch.BatchTxFeeRate)
+		tally.ChainFees(batch.BatchTxFeeRate, acct.Version)
+		totalChainFee += EstimateTraderFee(
+			tally.NumChansCreated, batch.BatchTxFeeRate,
+			acct.Version,
+		)
 
 		// Even if the account output is dust, we should arrive at the
 		// same number with our tally as the server.
@@ -242,10 +390,42 @@ func (v *batchVerifier) Verify(batch *Batch, bestHeight uint32) error {
 			}
 		}
 
-		// Update account expiry if needed.
+		// The auctioneer doesn't know about our account's reserve, so
+		// we double check the batch doesn't spend into it. Our orders
+		// are validated against the reserve when they're submitted,
+		// but the reserve might have been raised since. Like for the
+		// expiry below, this is a policy decision on our side.
+		if tally.EndingBalance < acct.Reserve {
+			return fmt.Errorf("account %x: %w: ending balance %v, "+
+				"reserve %v", diff.AccountKeyRaw,
+				ErrReserveViolated, tally.EndingBalance,
+				acct.Reserve)
+		}
+
+		// Update account expiry if needed, but only after making sure
+		// we accept the new expiry. We don't wrap the error in a
+		// MismatchErr as this isn't a disagreement about the batch
+		// itself but a policy decision on our side.
 		if batch.Version.SupportsAccountExtension() &&
 			diff.NewExpiry != 0 {
 
+			if auctionTerms == nil {
+				var err error
+				auctionTerms, err = v.fetchTerms()
+				if err != nil {
+					return fmt.Errorf("unable to fetch "+
+						"auctioneer terms: %v", err)
+				}
+			}
+
+			err := v.validateExpiry(
+				acct, diff.NewExpiry, bestHeight, auctionTerms,
+			)
+			if err != nil {
+				return fmt.Errorf("account %x: %w",
+					diff.AccountKeyRaw, err)
+			}
+
 			acct.Expiry = diff.NewExpiry
 		}
 
@@ -259,16 +439,517 @@ func (v *batchVerifier) Verify(batch *Batch, bestHeight uint32) error {
 		}
 	}
 
+	// The batch is valid, but the trader might not want to take part in
+	// it. Like the reserve and expiry above, this is a policy decision on
+	// our side, so we don't return a MismatchErr.
+	if v.evaluatePolicy != nil {
+		summary := policyBatch(batch, ourOrders, totalChainFee)
+		if err := v.evaluatePolicy(summary); err != nil {
+			return err
+		}
+	}
+
+	// As a last step, we do a dry run of applying the batch to our local
+	// state. This makes sure the modifications wouldn't result in an
+	// invalid order or account state once we store the batch.
+	mods, err := newBatchModifications(batch, v.orderStore, v.getAccount)
+	if err != nil {
+		return newMismatchErr(err, "unable to create batch modifiers")
+	}
+	_, _, err = v.orderStore.ValidatePendingBatch(
+		batch, mods.orders, mods.orderModifiers, mods.accounts,
+		mods.accountModifiers,
+	)
+	if err != nil {
+		return newMismatchErr(
+			err, "batch would result in invalid local state",
+		)
+	}
+
 	// From what we can tell, the batch looks good. At least our part checks
 	// out at this point.
 	return nil
 }
 
-// validateMatchedOrder validates our order against another trader's order and
-// tallies up our order's account balance.
-func (v *batchVerifier) validateMatchedOrder(tally *AccountTally,
-	ourOrder Order, otherOrder *MatchedOrder, executionFee terms.FeeSchedule,
-	clearingPrice FixedRatePremium) error {
+// matchErr is the error of verifying the match of one of our orders with an
+// order of another trader.
+type matchErr struct {
+	ourNonce Nonce
+	err      error
+}
+
+// Error returns the error message including the nonce of our order.
+//
+// NOTE: This method is part of the error interface.
+func (e *matchErr) Error() string {
+	return fmt.Sprintf("order %v: %v", e.ourNonce, e.err)
+}
+
+// matchErrs is a list of the errors of all matches of a batch that failed
+// verification.
+type matchErrs []*matchErr
+
+// Error returns the error messages of all matches.
+//
+// NOTE: This method is part of the error interface.
+func (e matchErrs) Error() string {
+	msgs := make([]string, len(e))
+	for idx, err := range e {
+		msgs[idx] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// matchJob is the verification of a single match of one of our orders with an
+// order of another trader.
+type matchJob struct {
+	ourOrder   Order
+	theirOrder *MatchedOrder
+
+	err    error
+	reject *auctioneerrpc.OrderReject
+}
+
+// verifyMatches verifies all matches of our orders in the batch, using a pool
+// of workers. If any match doesn't check out, a MismatchErr is returned that
+// contains the errors of all such matches, sorted by the nonce of our order
+// and in the order of the batch for each of them. Otherwise the rejects of
+// all matches are returned, in the same order as the matches of each of our
+// orders in the batch. The reject of a match is nil if it is acceptable.
+func (v *batchVerifier) verifyMatches(batch *Batch, ourOrders map[Nonce]Order,
+	tiers map[[33]byte]NodeTier) (map[Nonce][]*auctioneerrpc.OrderReject,
+	error) {
+
+	nonces := sortedNonces(ourOrders)
+
+	var jobs []*matchJob
+	for _, nonce := range nonces {
+		for _, theirOrder := range batch.MatchedOrders[nonce] {
+			jobs = append(jobs, &matchJob{
+				ourOrder:   ourOrders[nonce],
+				theirOrder: theirOrder,
+			})
+		}
+	}
+
+	numWorkers := v.numWorkers
+	if numWorkers <= 0 {
+		numWorkers = runtime.GOMAXPROCS(0)
+	}
+	if numWorkers > len(jobs) {
+		numWorkers = len(jobs)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		jobsChan = make(chan *matchJob)
+	)
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for job := range jobsChan {
+				job.reject, job.err = v.verifyMatch(
+					batch, job.ourOrder, job.theirOrder,
+					tiers,
+				)
+			}
+		}()
+	}
+	for _, job := range jobs {
+		jobsChan <- job
+	}
+	close(jobsChan)
+	wg.Wait()
+
+	// The jobs are still in the order we created them in, so collecting
+	// the results doesn't depend on which worker finished first.
+	var errs matchErrs
+	rejects := make(map[Nonce][]*auctioneerrpc.OrderReject, len(nonces))
+	for _, job := range jobs {
+		nonce := job.ourOrder.Nonce()
+		rejects[nonce] = append(rejects[nonce], job.reject)
+
+		if job.err != nil {
+			errs = append(errs, &matchErr{
+				ourNonce: nonce,
+				err:      job.err,
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, newMismatchErr(
+			errs, "%d of %d matches failed verification",
+			len(errs), len(jobs),
+		)
+	}
+
+	return rejects, nil
+}
+
+// verifyMatch verifies the match of our order with an order of another trader.
+// If the match violates a constraint of our order, the reason for rejecting
+// it is returned.
+func (v *batchVerifier) verifyMatch(batch *Batch, ourOrder Order,
+	theirOrder *MatchedOrder,
+	tiers map[[33]byte]NodeTier) (*auctioneerrpc.OrderReject, error) {
+
+	// Verify order compatibility.
+	err := v.validateMatchedOrder(ourOrder, theirOrder)
+	if err != nil {
+		return nil, fmt.Errorf("error matching against order %v: %w",
+			theirOrder.Order.Nonce(), err)
+	}
+
+	// Make sure there is a channel output included in the batch
+	// transaction that has the multisig script we expect.
+	err = v.validateChannelOutput(batch, ourOrder, theirOrder)
+	if err != nil {
+		return nil, fmt.Errorf("error finding channel output for "+
+			"matched order %v: %w", theirOrder.Order.Nonce(), err)
+	}
+
+	return matchReject(ourOrder, theirOrder, tiers), nil
+}
+
+// sortedNonces returns the nonces of the given orders in ascending order.
+func sortedNonces(orders map[Nonce]Order) []Nonce {
+	nonces := make([]Nonce, 0, len(orders))
+	for nonce := range orders {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool {
+		return bytes.Compare(nonces[i][:], nonces[j][:]) < 0
+	})
+
+	return nonces
+}
+
+// policyBatch summarizes the batch for evaluating the trader's batch policy.
+func policyBatch(batch *Batch, ourOrders map[Nonce]Order,
+	chainFee btcutil.Amount) *policy.Batch {
+
+	b := &policy.Batch{
+		ChainFee: chainFee,
+		Orders:   make([]policy.MatchedOrder, 0, len(ourOrders)),
+	}
+	for nonce, ourOrder := range ourOrders {
+		duration := ourOrder.Details().LeaseDuration
+		b.Orders = append(b.Orders, policy.MatchedOrder{
+			Nonce:         nonce.String(),
+			Rate:          ourOrder.Details().FixedRate,
+			ClearingPrice: uint32(batch.ClearingPrices[duration]),
+		})
+	}
+
+	// Sort the orders so violations are always reported in the same
+	// order.
+	sort.Slice(b.Orders, func(i, j int) bool {
+		return b.Orders[i].Nonce < b.Orders[j].Nonce
+	})
+
+	return b
+}
+
+// verifyBatchFeeRate makes sure the fee rate the batch transaction actually
+// pays doesn't exceed the highest max batch fee rate of our matched orders by
+// more than the tolerance. The fee rate can only be calculated if the
+// auctioneer sent us all outputs the batch transaction spends.
+func verifyBatchFeeRate(batch *Batch, ourOrders map[Nonce]Order,
+	accounts map[[33]byte]*account.Account) error {
+
+	switch len(batch.PreviousOutputs) {
+	case 0:
+		log.Debugf("Missing previous outputs of batch %x, not "+
+			"verifying its fee rate", batch.ID[:])
+		return nil
+
+	case len(batch.BatchTX.TxIn):
+
+	default:
+		return &MismatchErr{
+			msg: fmt.Sprintf("got %d previous outputs for %d "+
+				"batch transaction inputs",
+				len(batch.PreviousOutputs),
+				len(batch.BatchTX.TxIn)),
+		}
+	}
+
+	var maxFeeRate chainfee.SatPerKWeight
+	for _, ourOrder := range ourOrders {
+		if ourOrder.Details().MaxBatchFeeRate > maxFeeRate {
+			maxFeeRate = ourOrder.Details().MaxBatchFeeRate
+		}
+	}
+	maxFeeRate += maxFeeRate * batchFeeRateTolerance / 100
+
+	feeRate, err := batchFeeRate(batch, accounts)
+	if err != nil {
+		return newMismatchErr(err, "unable to calculate batch fee rate")
+	}
+	if feeRate > maxFeeRate {
+		return &MismatchErr{
+			msg: fmt.Sprintf("batch transaction pays fee rate %v, "+
+				"but stated fee rate is %v and maximum of our "+
+				"orders including tolerance is %v", feeRate,
+				batch.BatchTxFeeRate, maxFeeRate),
+		}
+	}
+
+	return nil
+}
+
+// batchFeeRate calculates the fee rate the batch transaction pays from the
+// values of the outputs it spends and creates. The witness size of our own
+// account inputs is known, for all other inputs we estimate it from the type
+// of the spent output. We rather overestimate the witness size so we never
+// reject a batch with a fee rate that is in fact acceptable.
+func batchFeeRate(batch *Batch,
+	accounts map[[33]byte]*account.Account) (chainfee.SatPerKWeight,
+	error) {
+
+	ourInputs := make(map[wire.OutPoint]account.Version, len(accounts))
+	for _, acct := range accounts {
+		ourInputs[acct.OutPoint] = acct.Version
+	}
+
+	var (
+		weightEstimator input.TxWeightEstimator
+		inputTotal      int64
+		outputTotal     int64
+	)
+	for idx, txIn := range batch.BatchTX.TxIn {
+		prevOut := batch.PreviousOutputs[idx]
+		inputTotal += prevOut.Value
+
+		version, ok := ourInputs[txIn.PreviousOutPoint]
+		if ok {
+			weightEstimator.AddWitnessInput(
+				version.ScriptVersion().MultiSigWitnessSize(),
+			)
+			continue
+		}
+
+		switch txscript.GetScriptClass(prevOut.PkScript) {
+		case txscript.WitnessV0PubKeyHashTy:
+			weightEstimator.AddWitnessInput(input.P2WKHWitnessSize)
+
+		// A taproot account of another trader is always spent with a
+		// MuSig2 key spend in a batch.
+		case txscript.WitnessV1TaprootTy:
+			weightEstimator.AddWitnessInput(
+				poolscript.TaprootMultiSigWitnessSize,
+			)
+
+		// All other inputs are assumed to be accounts of the first
+		// version, which have the largest witness.
+		default:
+			weightEstimator.AddWitnessInput(
+				poolscript.MultiSigWitnessSize,
+			)
+		}
+	}
+	for _, txOut := range batch.BatchTX.TxOut {
+		outputTotal += txOut.Value
+		weightEstimator.AddTxOutput(txOut)
+	}
+
+	fee := btcutil.Amount(inputTotal - outputTotal)
+	if fee < 0 {
+		return 0, fmt.Errorf("batch transaction spends %v but creates "+
+			"outputs worth %v", btcutil.Amount(inputTotal),
+			btcutil.Amount(outputTotal))
+	}
+
+	weight := int64(weightEstimator.Weight())
+	return chainfee.SatPerKWeight(int64(fee) * 1000 / weight), nil
+}
+
+// matchReject returns the reason for rejecting the match of our order with the
+// given order of another trader if the match violates a constraint of our
+// order. The node tiers are only checked if the map of tiers isn't nil. If the
+// match is acceptable, nil is returned.
+func matchReject(ourOrder Order, theirOrder *MatchedOrder,
+	tiers map[[33]byte]NodeTier) *auctioneerrpc.OrderReject {
+
+	details := ourOrder.Details()
+
+	isValidMatch := IsNodeIDAValidMatch(
+		theirOrder.NodeKey, details.AllowedNodeIDs,
+		details.NotAllowedNodeIDs,
+	)
+	if !isValidMatch {
+		return &auctioneerrpc.OrderReject{
+			ReasonCode: auctioneerrpc.OrderReject_NODE_NOT_ALLOWED,
+			Reason: fmt.Sprintf("order %v matched with node %x "+
+				"it is not allowed to match with",
+				ourOrder.Nonce(), theirOrder.NodeKey[:]),
+		}
+	}
+
+	theirChanType := theirOrder.Order.Details().ChannelType
+	if theirChanType != details.ChannelType {
+		return &auctioneerrpc.OrderReject{
+			ReasonCode: auctioneerrpc.OrderReject_CHANNEL_TYPE_MISMATCH,
+			Reason: fmt.Sprintf("order %v with channel type %v "+
+				"matched with order %v with channel type %v",
+				ourOrder.Nonce(), details.ChannelType,
+				theirOrder.Order.Nonce(), theirChanType),
+		}
+	}
+
+	theirUnannounced := theirOrder.Order.Details().UnannouncedChannel
+	if theirUnannounced != details.UnannouncedChannel {
+		return &auctioneerrpc.OrderReject{
+			ReasonCode: auctioneerrpc.OrderReject_ANNOUNCEMENT_MISMATCH,
+			Reason: fmt.Sprintf("order %v with unannounced channel "+
+				"%v matched with order %v with unannounced "+
+				"channel %v", ourOrder.Nonce(),
+				details.UnannouncedChannel,
+				theirOrder.Order.Nonce(), theirUnannounced),
+		}
+	}
+
+	// A zero conf bid can only be served by an ask that opted in to open
+	// zero conf channels. Asks that did can still be matched with regular
+	// bids though.
+	bidZeroConf := details.ZeroConfChannel
+	askZeroConf := theirOrder.Order.Details().ZeroConfChannel
+	if ourOrder.Type() == TypeAsk {
+		bidZeroConf, askZeroConf = askZeroConf, bidZeroConf
+	}
+	if bidZeroConf && !askZeroConf {
+		return &auctioneerrpc.OrderReject{
+			ReasonCode: auctioneerrpc.OrderReject_ZERO_CONF_MISMATCH,
+			Reason: fmt.Sprintf("order %v matched with order %v "+
+				"for a zero conf channel, but the ask didn't "+
+				"opt in to zero conf channels",
+				ourOrder.Nonce(), theirOrder.Order.Nonce()),
+		}
+	}
+
+	if theirOrder.UnitsFilled < details.MinUnitsMatch {
+		return &auctioneerrpc.OrderReject{
+			ReasonCode: auctioneerrpc.OrderReject_MIN_UNITS_MATCH,
+			Reason: fmt.Sprintf("order %v matched %d units with "+
+				"order %v, but minimum is %d",
+				ourOrder.Nonce(), theirOrder.UnitsFilled,
+				theirOrder.Order.Nonce(),
+				details.MinUnitsMatch),
+		}
+	}
+
+	// The funding shim of a sidecar channel must result in exactly the
+	// balances the ticket promised to the recipient.
+	bid, isBid := ourOrder.(*Bid)
+	if isBid && bid.SidecarTicket != nil {
+		reject := SidecarBalanceReject(bid, theirOrder)
+		if reject != nil {
+			return reject
+		}
+	}
+
+	// Every node is at least in the lowest tier, so only bids that require
+	// a higher one need to be checked.
+	if isBid && tiers != nil {
+		minTier := bid.EffectiveMinNodeTier()
+		tier := tiers[theirOrder.NodeKey]
+		if minTier > NodeTier0 && tier < minTier {
+			return &auctioneerrpc.OrderReject{
+				ReasonCode: auctioneerrpc.OrderReject_NODE_TIER_TOO_LOW,
+				Reason: fmt.Sprintf("order %v matched with "+
+					"node %x of tier %v, but minimum is %v",
+					ourOrder.Nonce(), theirOrder.NodeKey[:],
+					tier, minTier),
+			}
+		}
+	}
+
+	return nil
+}
+
+// SidecarBalanceReject makes sure the initial balances of the sidecar channel
+// that is created for the match of the given sidecar bid are the ones the
+// bid's ticket specifies. The recipient's side of the channel must be funded
+// with exactly the ticket's push amount and the maker's side with exactly the
+// ticket's capacity. The funding shim we register with lnd derives the channel
+// size from the units filled plus the bid's self channel balance and expects
+// the self channel balance to be pushed to us, so both need to match the
+// ticket. If the balances match, nil is returned.
+func SidecarBalanceReject(bid *Bid,
+	theirOrder *MatchedOrder) *auctioneerrpc.OrderReject {
+
+	var (
+		offer          = bid.SidecarTicket.Offer
+		expectedLocal  = offer.PushAmt
+		expectedRemote = offer.Capacity
+		shimLocal      = bid.SelfChanBalance
+		shimRemote     = theirOrder.UnitsFilled.ToSatoshis()
+	)
+	if shimLocal == expectedLocal && shimRemote == expectedRemote {
+		return nil
+	}
+
+	return &auctioneerrpc.OrderReject{
+		ReasonCode: auctioneerrpc.OrderReject_SIDECAR_BALANCE_MISMATCH,
+		Reason: fmt.Sprintf("sidecar order %v matched with order %v "+
+			"would result in local balance %v and remote balance "+
+			"%v, but ticket %x specifies local balance %v and "+
+			"remote balance %v", bid.Nonce(),
+			theirOrder.Order.Nonce(), shimLocal, shimRemote,
+			bid.SidecarTicket.ID[:], expectedLocal,
+			expectedRemote),
+	}
+}
+
+// matchedNodeTiers returns the tiers of all nodes whose asks are matched with
+// our bids that require a minimum node tier. If the node tiers aren't checked,
+// nil is returned.
+func (v *batchVerifier) matchedNodeTiers(
+	batch *Batch) (map[[33]byte]NodeTier, error) {
+
+	if v.nodeTiers == nil {
+		return nil, nil
+	}
+
+	var (
+		nodeKeys [][33]byte
+		seen     = make(map[[33]byte]struct{})
+	)
+	for nonce, theirOrders := range batch.MatchedOrders {
+		ourOrder, err := v.orderStore.GetOrder(nonce)
+		if err != nil {
+			return nil, fmt.Errorf("order %v not found: %v", nonce,
+				err)
+		}
+
+		bid, isBid := ourOrder.(*Bid)
+		if !isBid || bid.EffectiveMinNodeTier() <= NodeTier0 {
+			continue
+		}
+
+		for _, theirOrder := range theirOrders {
+			if _, ok := seen[theirOrder.NodeKey]; ok {
+				continue
+			}
+			seen[theirOrder.NodeKey] = struct{}{}
+			nodeKeys = append(nodeKeys, theirOrder.NodeKey)
+		}
+	}
+
+	if len(nodeKeys) == 0 {
+		return map[[33]byte]NodeTier{}, nil
+	}
+
+	return v.nodeTiers(nodeKeys)
+}
+
+// validateMatchedOrder validates our order against another trader's order.
+func (v *batchVerifier) validateMatchedOrder(ourOrder Order,
+	otherOrder *MatchedOrder) error {
 
 	// Order type must be opposite.
 	if otherOrder.Order.Type() == ourOrder.Type() {
@@ -281,10 +962,8 @@ func (v *batchVerifier) validateMatchedOrder(tally *AccountTally,
 		return fmt.Errorf("other order is an order from our node")
 	}
 
-	// Verify that the durations overlap. Then tally up all the fees and
-	// units that were paid/accrued in this matched order pair. We can
-	// safely cast orders here because we made sure we have the right types
-	// in the previous step.
+	// Verify that the durations overlap. We can safely cast orders here
+	// because we made sure we have the right types in the previous step.
 	switch ours := ourOrder.(type) {
 	case *Ask:
 		other := otherOrder.Order.(*Bid)
@@ -298,13 +977,6 @@ func (v *batchVerifier) validateMatchedOrder(tally *AccountTally,
 			return fmt.Errorf("ask price greater than bid price")
 		}
 
-		// This match checks out, deduct it from the account's balance.
-		tally.CalcMakerDelta(
-			executionFee, clearingPrice,
-			otherOrder.UnitsFilled.ToSatoshis(),
-			other.LeaseDuration,
-		)
-
 	case *Bid:
 		other := otherOrder.Order.(*Ask)
 		if other.LeaseDuration != ours.LeaseDuration {
@@ -316,17 +988,34 @@ func (v *batchVerifier) validateMatchedOrder(tally *AccountTally,
 		if other.FixedRate > ours.FixedRate {
 			return fmt.Errorf("ask price greater than bid price")
 		}
+	}
 
-		// This match checks out, deduct it from the account's balance.
+	// Everything checks out so far.
+	return nil
+}
+
+// tallyMatch tallies up the fees and units that were paid/accrued in the
+// match of our order with another trader's order in our order's account
+// balance. The match must have been validated with validateMatchedOrder
+// before.
+func tallyMatch(tally *AccountTally, ourOrder Order, otherOrder *MatchedOrder,
+	executionFee terms.FeeSchedule, clearingPrice FixedRatePremium) {
+
+	switch ours := ourOrder.(type) {
+	case *Ask:
+		tally.CalcMakerDelta(
+			executionFee, clearingPrice,
+			otherOrder.UnitsFilled.ToSatoshis(),
+			otherOrder.Order.Details().LeaseDuration,
+		)
+
+	case *Bid:
 		tally.CalcTakerDelta(
 			executionFee, clearingPrice,
 			otherOrder.UnitsFilled.ToSatoshis(),
 			ours.SelfChanBalance, ours.LeaseDuration,
 		)
 	}
-
-	// Everything checks out so far.
-	return nil
 }
 
 // validateChannelOutput makes sure there is a channel output in the batch TX
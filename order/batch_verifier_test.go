@@ -2,14 +2,20 @@ package order
 
 import (
 	"context"
+	"fmt"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneerrpc"
 	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/policy"
+	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -32,12 +38,26 @@ var (
 	stateRecreated        = auctioneerrpc.AccountDiff_OUTPUT_RECREATED
 	stateExtendedOffchain = auctioneerrpc.AccountDiff_OUTPUT_DUST_EXTENDED_OFFCHAIN
 	extendAccountExpiry   = 1000
+	nodeNotAllowedCode    = auctioneerrpc.OrderReject_NODE_NOT_ALLOWED
+	minUnitsMatchCode     = auctioneerrpc.OrderReject_MIN_UNITS_MATCH
+	chanTypeMismatchCode  = auctioneerrpc.OrderReject_CHANNEL_TYPE_MISMATCH
+	announceMismatchCode  = auctioneerrpc.OrderReject_ANNOUNCEMENT_MISMATCH
+	zeroConfMismatchCode  = auctioneerrpc.OrderReject_ZERO_CONF_MISMATCH
+	nodeTierTooLowCode    = auctioneerrpc.OrderReject_NODE_TIER_TOO_LOW
+	sidecarBalanceCode    = auctioneerrpc.OrderReject_SIDECAR_BALANCE_MISMATCH
 )
 
 func TestBatchVerifier(t *testing.T) {
 	t.Parallel()
 
-	const bestHeight = 1337
+	const (
+		bestHeight = 1337
+
+		// maxBatchFeeRate is the max batch fee rate of all our orders.
+		// It's well above the fee rate of the batch so test cases can
+		// raise the latter.
+		maxBatchFeeRate = chainfee.FeePerKwFloor * 100
+	)
 	var (
 		walletKit   = test.NewMockWalletKit()
 		batchID     BatchID
@@ -193,11 +213,259 @@ func TestBatchVerifier(t *testing.T) {
 		{
 			name:         "invalid min units match",
 			batchVersion: DefaultBatchVersion,
-			expectedErr:  "units, but minimum is",
+			expectedErr:  "but minimum is 100",
 			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
 				b *Batch) error {
 
 				a.MinUnitsMatch = b1.MinUnitsMatch * 100
+				err := v.Verify(b, bestHeight)
+
+				// Only the matches of the ask are rejected,
+				// not the whole batch.
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				require.NotErrorIs(t, err, ErrMismatchErr)
+				rejects := rejectErr.RejectedOrders
+				require.Len(t, rejects, 2)
+				require.Equal(
+					t, minUnitsMatchCode,
+					rejects[b1.nonce].ReasonCode,
+				)
+				require.Equal(
+					t, minUnitsMatchCode,
+					rejects[b2.nonce].ReasonCode,
+				)
+
+				return err
+			},
+		},
+		{
+			name:         "channel type mismatch",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "with channel type peer_dependent",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// Both bids the ask is matched with don't
+				// require a script enforced channel, so the
+				// ask rejects both of them and the first bid
+				// rejects the ask.
+				a.ChannelType = ChannelTypeScriptEnforced
+				err := v.Verify(b, bestHeight)
+
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				rejects := rejectErr.RejectedOrders
+				require.Len(t, rejects, 3)
+				for _, nonce := range []Nonce{
+					a.nonce, b1.nonce, b2.nonce,
+				} {
+					require.Equal(
+						t, chanTypeMismatchCode,
+						rejects[nonce].ReasonCode,
+					)
+				}
+
+				return err
+			},
+		},
+		{
+			name:         "announcement mismatch",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "with unannounced channel true",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// Only the first bid requests an unannounced
+				// channel, so it rejects the ask and the ask
+				// rejects it. The second bid is unaffected.
+				b1.UnannouncedChannel = true
+				err := v.Verify(b, bestHeight)
+
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				rejects := rejectErr.RejectedOrders
+				require.Len(t, rejects, 2)
+				for _, nonce := range []Nonce{
+					a.nonce, b1.nonce,
+				} {
+					require.Equal(
+						t, announceMismatchCode,
+						rejects[nonce].ReasonCode,
+					)
+				}
+
+				return err
+			},
+		},
+		{
+			name:         "zero conf bid with regular ask",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "for a zero conf channel",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// The first bid asks for a zero conf channel
+				// but the ask didn't opt in, so both reject
+				// each other. The second bid doesn't care.
+				b1.ZeroConfChannel = true
+				err := v.Verify(b, bestHeight)
+
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				rejects := rejectErr.RejectedOrders
+				require.Len(t, rejects, 2)
+				for _, nonce := range []Nonce{
+					a.nonce, b1.nonce,
+				} {
+					require.Equal(
+						t, zeroConfMismatchCode,
+						rejects[nonce].ReasonCode,
+					)
+				}
+
+				// Once the ask opts in, the batch is valid.
+				a.ZeroConfChannel = true
+				require.NoError(t, v.Verify(b, bestHeight))
+
+				return err
+			},
+		},
+		{
+			name:         "node tier too low",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "but minimum is NodeTier1",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				var (
+					requested [][33]byte
+					tier      = NodeTier0
+				)
+				v.(*batchVerifier).nodeTiers = func(
+					keys [][33]byte) (map[[33]byte]NodeTier,
+					error) {
+
+					requested = append(requested, keys...)
+					tiers := make(map[[33]byte]NodeTier)
+					for _, key := range keys {
+						tiers[key] = tier
+					}
+					return tiers, nil
+				}
+
+				// Both bids require the default tier but are
+				// matched with the ask of a tier 0 node, which
+				// is only looked up once.
+				err := v.Verify(b, bestHeight)
+
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				require.Len(t, rejectErr.RejectedOrders, 1)
+				require.Equal(
+					t, nodeTierTooLowCode,
+					rejectErr.RejectedOrders[a.nonce].ReasonCode,
+				)
+				require.Len(t, requested, 1)
+
+				// Once the node is rated high enough, the
+				// batch is valid.
+				tier = NodeTier1
+				require.NoError(t, v.Verify(b, bestHeight))
+
+				// Bids that opted out of the node tier don't
+				// need a lookup at all.
+				tier = NodeTier0
+				requested = nil
+				b1.MinNodeTier = NodeTier0
+				b2.MinNodeTier = NodeTier0
+				require.NoError(t, v.Verify(b, bestHeight))
+				require.Empty(t, requested)
+
+				return err
+			},
+		},
+		{
+			name:         "rejects of multiple orders",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "trader rejected orders",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// The ask can't be matched with the node of the
+				// first bid and the second bid requires more
+				// units than it was matched with. All rejects
+				// are reported together.
+				b.MatchedOrders[a.nonce][0].NodeKey = [33]byte{
+					0x02,
+				}
+				a.NotAllowedNodeIDs = [][33]byte{{0x02}}
+				b2.MinUnitsMatch = 3
+
+				err := v.Verify(b, bestHeight)
+				var rejectErr *MatchRejectErr
+				require.ErrorAs(t, err, &rejectErr)
+				rejects := rejectErr.RejectedOrders
+				require.Len(t, rejects, 2)
+				require.Equal(
+					t, nodeNotAllowedCode,
+					rejects[b1.nonce].ReasonCode,
+				)
+				require.Equal(
+					t, minUnitsMatchCode,
+					rejects[a.nonce].ReasonCode,
+				)
+
+				return err
+			},
+		},
+		{
+			name:         "too few distinct peers",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "distinct peers, but minimum is 2",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// Both bids are from the same node.
+				a.MinDistinctPeers = 2
+				return v.Verify(b, bestHeight)
+			},
+		},
+		{
+			name:         "enough distinct peers",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				a.MinDistinctPeers = 2
+				b.MatchedOrders[a.nonce][1].NodeKey = [33]byte{
+					0x02,
+				}
+				return v.Verify(b, bestHeight)
+			},
+		},
+		{
+			name:         "match with not allowed node",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "it is not allowed to match with",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				a.NotAllowedNodeIDs = [][33]byte{
+					b.MatchedOrders[a.nonce][0].NodeKey,
+				}
+				return v.Verify(b, bestHeight)
+			},
+		},
+		{
+			name:         "match with node not in allowed list",
+			batchVersion: DefaultBatchVersion,
+			expectedErr:  "it is not allowed to match with",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				a.AllowedNodeIDs = [][33]byte{{0x02}}
 				return v.Verify(b, bestHeight)
 			},
 		},
@@ -245,6 +513,7 @@ func TestBatchVerifier(t *testing.T) {
 						AcctKey:          acctIDSmall,
 						FixedRate:        b1.FixedRate,
 						LeaseDuration:    leaseDuration,
+						MaxBatchFeeRate:  maxBatchFeeRate,
 					}),
 				}
 				v.(*batchVerifier).orderStore.(*mockStore).orders[ask.nonce] = ask
@@ -339,6 +608,122 @@ func TestBatchVerifier(t *testing.T) {
 				return v.Verify(b, bestHeight)
 			},
 		},
+		{
+			name:        "account reserve violated",
+			expectedErr: ErrReserveViolated.Error(),
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// Raise the reserve of the accounts to their
+				// full value, which the batch must not spend
+				// into.
+				bv := v.(*batchVerifier)
+				getAccount := bv.getAccount
+				bv.getAccount = func(
+					key *btcec.PublicKey) (*account.Account,
+					error) {
+
+					acct, err := getAccount(key)
+					if err != nil {
+						return nil, err
+					}
+					acct.Reserve = acct.Value
+
+					return acct, nil
+				}
+
+				err := v.Verify(b, bestHeight)
+				require.ErrorIs(t, err, ErrReserveViolated)
+				require.NotErrorIs(t, err, ErrMismatchErr)
+
+				return err
+			},
+		},
+		{
+			name:        "max chain fee not exceeded",
+			expectedErr: "",
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// The ask pays the full chain fee of its
+				// account as it's the only order of it.
+				a.MaxChainFee = OrderChainFee(
+					2, 2, b.BatchTxFeeRate,
+					account.VersionInitialNoVersion,
+				)
+				return v.Verify(b, bestHeight)
+			},
+		},
+		{
+			name:        "max chain fee exceeded",
+			expectedErr: ErrChainFeeExceeded.Error(),
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// The same limit is exceeded once the fee rate
+				// of the batch goes up.
+				a.MaxChainFee = OrderChainFee(
+					2, 2, b.BatchTxFeeRate,
+					account.VersionInitialNoVersion,
+				)
+				b.BatchTxFeeRate *= 10
+
+				err := v.Verify(b, bestHeight)
+				require.ErrorIs(t, err, ErrChainFeeExceeded)
+				require.NotErrorIs(t, err, ErrMismatchErr)
+
+				return err
+			},
+		},
+		{
+			name:        "batch policy violated",
+			expectedErr: policy.ErrPolicyViolation.Error(),
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// All three of our orders are matched, which
+				// is one more than the policy allows.
+				p := &policy.Policy{MaxMatchedOrders: 2}
+				v.(*batchVerifier).evaluatePolicy = p.Evaluate
+
+				err := v.Verify(b, bestHeight)
+				var violationErr *policy.ViolationErr
+				require.ErrorAs(t, err, &violationErr)
+				require.Len(t, violationErr.Violations, 1)
+				require.Equal(
+					t, policy.RuleMaxMatchedOrders,
+					violationErr.Violations[0].Rule,
+				)
+				require.NotErrorIs(t, err, ErrMismatchErr)
+
+				return err
+			},
+		},
+		{
+			name:        "max batch fee rate exceeded",
+			expectedErr: ErrBatchFeeRateExceeded.Error(),
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				// Only the order with a max batch fee rate
+				// below the batch fee rate is reported.
+				b1.MaxBatchFeeRate = b.BatchTxFeeRate - 1
+
+				err := v.Verify(b, bestHeight)
+				var feeRateErr *BatchFeeRateErr
+				require.ErrorAs(t, err, &feeRateErr)
+				require.Equal(
+					t, []Nonce{b1.nonce}, feeRateErr.Nonces,
+				)
+				require.Equal(
+					t, b.BatchTxFeeRate,
+					feeRateErr.BatchFeeRate,
+				)
+				require.NotErrorIs(t, err, ErrMismatchErr)
+
+				return err
+			},
+		},
 		{
 			name:        "happy path",
 			expectedErr: "",
@@ -348,6 +733,29 @@ func TestBatchVerifier(t *testing.T) {
 				return v.Verify(b, bestHeight)
 			},
 		},
+		{
+			name:         "account expiry extension rejected",
+			batchVersion: ExtendAccountBatchVersion,
+			expectedErr:  account.ErrInvalidExpiryExtension.Error(),
+			doVerify: func(v BatchVerifier, a *Ask, b1, b2 *Bid,
+				b *Batch) error {
+
+				bv := v.(*batchVerifier)
+				bv.validateExpiry = func(*account.Account,
+					uint32, uint32,
+					*terms.AuctioneerTerms) error {
+
+					return account.ErrInvalidExpiryExtension
+				}
+
+				errInvalid := account.ErrInvalidExpiryExtension
+				err := v.Verify(b, bestHeight)
+				require.ErrorIs(t, err, errInvalid)
+				require.NotErrorIs(t, err, ErrMismatchErr)
+
+				return err
+			},
+		},
 		{
 			name:         "happy path extend account support",
 			batchVersion: ExtendAccountBatchVersion,
@@ -401,6 +809,7 @@ func TestBatchVerifier(t *testing.T) {
 				AcctKey:          acctIDSmall,
 				FixedRate:        uint32(clearingPrice) / 2,
 				LeaseDuration:    leaseDuration,
+				MaxBatchFeeRate:  maxBatchFeeRate,
 			}),
 		}
 		bid1 := &Bid{
@@ -414,7 +823,8 @@ func TestBatchVerifier(t *testing.T) {
 				AcctKey:          acctIDBig,
 				FixedRate:        uint32(clearingPrice) * 2,
 				// 1000 * (200_000 * 5000 / 1_000_000_000) = 1000 sats premium
-				LeaseDuration: leaseDuration,
+				LeaseDuration:   leaseDuration,
+				MaxBatchFeeRate: maxBatchFeeRate,
 			}),
 		}
 		bid2 := &Bid{
@@ -428,7 +838,8 @@ func TestBatchVerifier(t *testing.T) {
 				AcctKey:          acctIDBig,
 				FixedRate:        uint32(clearingPrice),
 				// 2000 * (200_000 * 5000 / 1_000_000_000) = 1000 sats premium
-				LeaseDuration: leaseDuration,
+				LeaseDuration:   leaseDuration,
+				MaxBatchFeeRate: maxBatchFeeRate,
 			}),
 			SelfChanBalance: 50,
 		}
@@ -561,6 +972,14 @@ func TestBatchVerifier(t *testing.T) {
 			ourNodePubkey: nodePubkey,
 			getAccount:    storeMock.getAccount,
 			version:       tc.batchVersion,
+			fetchTerms: func() (*terms.AuctioneerTerms, error) {
+				return &terms.AuctioneerTerms{}, nil
+			},
+			validateExpiry: func(*account.Account, uint32, uint32,
+				*terms.AuctioneerTerms) error {
+
+				return nil
+			},
 		}
 		storeMock.accounts = map[[33]byte]*account.Account{
 			acctIDBig:   bigAcct,
@@ -604,6 +1023,145 @@ func newKitFromTemplate(nonce Nonce, tpl *Kit) Kit {
 	return *kit
 }
 
+// TestBatchFeeRate makes sure the fee rate of a batch transaction is
+// calculated correctly for all types of inputs and that a batch paying more
+// than our orders allow is rejected.
+func TestBatchFeeRate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		p2wkhScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+		p2wshScript = append([]byte{0x00, 0x20}, make([]byte, 32)...)
+		p2trScript  = append([]byte{0x51, 0x20}, make([]byte, 32)...)
+		ourAcctV0   = &account.Account{
+			OutPoint: wire.OutPoint{Index: 1},
+			Version:  account.VersionInitialNoVersion,
+		}
+		ourAcctV1 = &account.Account{
+			OutPoint: wire.OutPoint{Index: 2},
+			Version:  account.VersionTaprootEnabled,
+		}
+		accounts = map[[33]byte]*account.Account{
+			{1}: ourAcctV0,
+			{2}: ourAcctV1,
+		}
+	)
+
+	// The batch spends both of our accounts and one account of each
+	// version of another trader plus a wallet input of the auctioneer. It
+	// creates a channel output and re-creates one of our accounts but
+	// doesn't have a change output.
+	newBatch := func(fee int64) *Batch {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: ourAcctV0.OutPoint})
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: ourAcctV1.OutPoint})
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 3}})
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 4}})
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 5}})
+		tx.AddTxOut(&wire.TxOut{Value: 1_000_000, PkScript: p2wshScript})
+		tx.AddTxOut(&wire.TxOut{Value: 500_000, PkScript: p2trScript})
+
+		return &Batch{
+			BatchTX: tx,
+			PreviousOutputs: []*wire.TxOut{
+				{Value: 300_000, PkScript: p2wshScript},
+				{Value: 300_000, PkScript: p2trScript},
+				{Value: 300_000, PkScript: p2wkhScript},
+				{Value: 300_000, PkScript: p2wshScript},
+				{Value: 300_000 + fee, PkScript: p2trScript},
+			},
+		}
+	}
+
+	// The non-witness data is 301 bytes, the witnesses of the inputs are
+	// 229 + 66 + 109 + 229 + 66 bytes plus the segwit marker and flag.
+	batch := newBatch(19_050)
+	feeRate, err := batchFeeRate(batch, accounts)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.SatPerKWeight(10_000), feeRate)
+
+	// A change output adds 31 bytes of non-witness data.
+	batch = newBatch(20_290)
+	batch.BatchTX.AddTxOut(&wire.TxOut{Value: 0, PkScript: p2wkhScript})
+	feeRate, err = batchFeeRate(batch, accounts)
+	require.NoError(t, err)
+	require.Equal(t, chainfee.SatPerKWeight(10_000), feeRate)
+
+	// A batch that creates more than it spends is invalid.
+	_, err = batchFeeRate(newBatch(-1), accounts)
+	require.Error(t, err)
+
+	// The fee rate may exceed the max batch fee rate of our orders only
+	// within the tolerance.
+	ourOrders := map[Nonce]Order{
+		{1}: &Bid{Kit: Kit{MaxBatchFeeRate: 5_000}},
+		{2}: &Ask{Kit: Kit{MaxBatchFeeRate: 9_500}},
+	}
+	err = verifyBatchFeeRate(newBatch(19_050), ourOrders, accounts)
+	require.NoError(t, err)
+
+	err = verifyBatchFeeRate(newBatch(20_000), ourOrders, accounts)
+	require.ErrorIs(t, err, ErrMismatchErr)
+
+	// Without the previous outputs we can't calculate the fee rate, but
+	// we don't accept an incomplete list.
+	batch = newBatch(20_000)
+	batch.PreviousOutputs = batch.PreviousOutputs[1:]
+	err = verifyBatchFeeRate(batch, ourOrders, accounts)
+	require.ErrorIs(t, err, ErrMismatchErr)
+
+	batch.PreviousOutputs = nil
+	require.NoError(t, verifyBatchFeeRate(batch, ourOrders, accounts))
+}
+
+// TestSidecarBalanceReject makes sure matches of sidecar bids are rejected if
+// the balances of the resulting channel don't match the ticket.
+func TestSidecarBalanceReject(t *testing.T) {
+	t.Parallel()
+
+	const (
+		capacity = btcutil.Amount(2 * BaseSupplyUnit)
+		pushAmt  = btcutil.Amount(50_000)
+	)
+	newBid := func() *Bid {
+		return &Bid{
+			Kit:             *NewKit(Nonce{1}),
+			SelfChanBalance: pushAmt,
+			SidecarTicket: &sidecar.Ticket{
+				Offer: sidecar.Offer{
+					Capacity: capacity,
+					PushAmt:  pushAmt,
+				},
+			},
+		}
+	}
+	match := &MatchedOrder{
+		Order:       &Ask{Kit: *NewKit(Nonce{2})},
+		UnitsFilled: 2,
+	}
+
+	// A bid that matches its ticket is accepted.
+	require.Nil(t, SidecarBalanceReject(newBid(), match))
+
+	// A self channel balance that differs from the ticket's push amount
+	// would be pushed to the recipient by the funding shim.
+	bid := newBid()
+	bid.SelfChanBalance = pushAmt + 1
+	reject := SidecarBalanceReject(bid, match)
+	require.NotNil(t, reject)
+	require.Equal(t, sidecarBalanceCode, reject.ReasonCode)
+
+	// A match with fewer units than the ticket's capacity results in a
+	// smaller remote balance.
+	smallMatch := &MatchedOrder{
+		Order:       match.Order,
+		UnitsFilled: 1,
+	}
+	reject = SidecarBalanceReject(newBid(), smallMatch)
+	require.NotNil(t, reject)
+	require.Equal(t, sidecarBalanceCode, reject.ReasonCode)
+}
+
 func scriptForChan(t *testing.T, walletKit *test.MockWalletKit, loc1,
 	loc2 keychain.KeyLocator) []byte {
 
@@ -635,3 +1193,163 @@ func scriptForAcct(t *testing.T, acct *account.Account) []byte {
 	}
 	return script
 }
+
+// slowWalletKit is a wallet that takes a while to derive keys, just like lnd
+// does when it's asked over RPC.
+type slowWalletKit struct {
+	*test.MockWalletKit
+
+	delay time.Duration
+}
+
+// DeriveKey derives a key after waiting for the configured delay.
+func (w *slowWalletKit) DeriveKey(ctx context.Context,
+	loc *keychain.KeyLocator) (*keychain.KeyDescriptor, error) {
+
+	time.Sleep(w.delay)
+	return w.MockWalletKit.DeriveKey(ctx, loc)
+}
+
+// newMatchesBatch creates a batch in which each of the given number of our
+// asks is matched with a bid of another trader. All channel outputs are part
+// of the batch transaction.
+func newMatchesBatch(t testing.TB, walletKit lndclient.WalletKitClient,
+	numOrders int) (*Batch, map[Nonce]Order) {
+
+	batch := &Batch{
+		BatchTX:       wire.NewMsgTx(2),
+		MatchedOrders: make(map[Nonce][]*MatchedOrder, numOrders),
+	}
+	ourOrders := make(map[Nonce]Order, numOrders)
+	for i := 0; i < numOrders; i++ {
+		ask := &Ask{Kit: *NewKit(Nonce{byte(i), 1})}
+		ask.LeaseDuration = leaseDuration
+		ask.MultiSigKeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamilyMultiSig,
+			Index:  uint32(i),
+		}
+		bid := &Bid{Kit: *NewKit(Nonce{byte(i), 2})}
+		bid.LeaseDuration = leaseDuration
+		bid.FixedRate = 1
+
+		ourKey, err := walletKit.DeriveKey(
+			context.Background(), &ask.MultiSigKeyLocator,
+		)
+		require.NoError(t, err)
+		_, theirKey := test.CreateKey(int32(i + numOrders))
+
+		match := &MatchedOrder{
+			Order:       bid,
+			UnitsFilled: 1,
+		}
+		copy(match.MultiSigKey[:], theirKey.SerializeCompressed())
+
+		_, out, err := input.GenFundingPkScript(
+			ourKey.PubKey.SerializeCompressed(),
+			match.MultiSigKey[:], int64(BaseSupplyUnit),
+		)
+		require.NoError(t, err)
+		batch.BatchTX.AddTxOut(out)
+
+		ourOrders[ask.Nonce()] = ask
+		batch.MatchedOrders[ask.Nonce()] = []*MatchedOrder{match}
+	}
+
+	return batch, ourOrders
+}
+
+// TestVerifyMatchesErrors makes sure the errors of all matches that fail
+// verification are reported in the same order, no matter in which order the
+// workers verified them.
+func TestVerifyMatchesErrors(t *testing.T) {
+	t.Parallel()
+
+	walletKit := test.NewMockWalletKit()
+	batch, ourOrders := newMatchesBatch(t, walletKit, 20)
+	verifier := &batchVerifier{
+		wallet:        walletKit,
+		ourNodePubkey: nodePubkey,
+		numWorkers:    8,
+	}
+
+	// With all channel outputs in place, every match is accepted.
+	rejects, err := verifier.verifyMatches(batch, ourOrders, nil)
+	require.NoError(t, err)
+	require.Len(t, rejects, len(ourOrders))
+	for nonce, orderRejects := range rejects {
+		require.Equal(t, []*auctioneerrpc.OrderReject{nil}, orderRejects)
+		require.Contains(t, ourOrders, nonce)
+	}
+
+	// Break every other match, some of them in two different ways.
+	for _, nonce := range sortedNonces(ourOrders) {
+		switch nonce[0] % 4 {
+		case 1:
+			batch.MatchedOrders[nonce][0].UnitsFilled = 2
+
+		case 3:
+			batch.MatchedOrders[nonce][0].NodeKey = nodePubkey
+		}
+	}
+
+	_, err = verifier.verifyMatches(batch, ourOrders, nil)
+	require.ErrorIs(t, err, ErrMismatchErr)
+	require.Contains(t, err.Error(), "10 of 20 matches failed")
+
+	var errs matchErrs
+	require.ErrorAs(t, err.(*MismatchErr).cause, &errs)
+	require.Len(t, errs, 10)
+	for idx, matchErr := range errs {
+		require.Equal(t, byte(idx*2+1), matchErr.ourNonce[0])
+		if idx%2 == 0 {
+			require.Contains(
+				t, matchErr.Error(), "error finding channel "+
+					"output",
+			)
+		} else {
+			require.Contains(
+				t, matchErr.Error(), "order from our node",
+			)
+		}
+	}
+
+	// No matter how often we verify the batch, the error stays the same.
+	for i := 0; i < 10; i++ {
+		_, err2 := verifier.verifyMatches(batch, ourOrders, nil)
+		require.Equal(t, err.Error(), err2.Error())
+	}
+}
+
+// BenchmarkVerifyMatches compares verifying the matches of a batch with 100 of
+// our orders one after the other with verifying them concurrently. Deriving
+// our multisig keys over RPC dominates the time it takes.
+func BenchmarkVerifyMatches(b *testing.B) {
+	walletKit := &slowWalletKit{
+		MockWalletKit: test.NewMockWalletKit(),
+		delay:         time.Millisecond,
+	}
+	batch, ourOrders := newMatchesBatch(b, walletKit.MockWalletKit, 100)
+
+	for _, numWorkers := range []int{1, 0} {
+		name := fmt.Sprintf("workers=%d", numWorkers)
+		if numWorkers == 0 {
+			name = fmt.Sprintf("workers=gomaxprocs(%d)",
+				runtime.GOMAXPROCS(0))
+		}
+
+		b.Run(name, func(b *testing.B) {
+			verifier := &batchVerifier{
+				wallet:        walletKit,
+				ourNodePubkey: nodePubkey,
+				numWorkers:    numWorkers,
+			}
+
+			for i := 0; i < b.N; i++ {
+				_, err := verifier.verifyMatches(
+					batch, ourOrders, nil,
+				)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
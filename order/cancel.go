@@ -0,0 +1,142 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// maxConcurrentCancellations is the maximum number of order cancellation
+// requests that are sent to the auctioneer at the same time.
+const maxConcurrentCancellations = 8
+
+// CancelFilter restricts the set of orders that are canceled by
+// CancelAllOrders. A zero value field matches all orders.
+type CancelFilter struct {
+	// AcctKey is the trader key of the account whose orders should be
+	// canceled.
+	AcctKey [33]byte
+
+	// Type is the type of orders that should be canceled.
+	Type *Type
+
+	// LeaseDuration is the lease duration bucket of the orders that
+	// should be canceled.
+	LeaseDuration uint32
+}
+
+// matches returns true if the given order is matched by the filter.
+func (f *CancelFilter) matches(o Order) bool {
+	details := o.Details()
+
+	switch {
+	case f.AcctKey != [33]byte{} && f.AcctKey != details.AcctKey:
+		return false
+
+	case f.Type != nil && *f.Type != o.Type():
+		return false
+
+	case f.LeaseDuration != 0 && f.LeaseDuration != details.LeaseDuration:
+		return false
+
+	default:
+		return true
+	}
+}
+
+// CancelAllOrders cancels all orders that are not yet in a terminal state and
+// are matched by the given filter. The cancellation requests are sent to the
+// auctioneer concurrently, then all orders that were canceled successfully are
+// marked as canceled in a single database transaction. The returned map
+// contains the result of each order's cancellation, keyed by its nonce. A nil
+// value means the order was canceled.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) CancelAllOrders(ctx context.Context,
+	filter *CancelFilter) (map[Nonce]error, error) {
+
+	dbOrders, err := m.cfg.Store.GetOrders()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg         sync.WaitGroup
+		results    = make(map[Nonce]error)
+		resultsMtx sync.Mutex
+		sem        = make(chan struct{}, maxConcurrentCancellations)
+	)
+	for _, dbOrder := range dbOrders {
+		if dbOrder.Details().State.Archived() {
+			continue
+		}
+		if !filter.matches(dbOrder) {
+			continue
+		}
+
+		// Staged orders were never sent to the auctioneer, so they
+		// only need to be canceled locally.
+		if dbOrder.Details().State == StateStaged {
+			resultsMtx.Lock()
+			results[dbOrder.Nonce()] = nil
+			resultsMtx.Unlock()
+
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(o Order) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := m.cfg.CancelOrder(ctx, o.Details().Preimage)
+
+			resultsMtx.Lock()
+			results[o.Nonce()] = err
+			resultsMtx.Unlock()
+		}(dbOrder)
+	}
+	wg.Wait()
+
+	var (
+		canceled  []Nonce
+		modifiers [][]Modifier
+	)
+	for nonce, err := range results {
+		if err != nil {
+			log.Warnf("Unable to cancel order %v: %v", nonce, err)
+			continue
+		}
+
+		canceled = append(canceled, nonce)
+		modifiers = append(
+			modifiers, []Modifier{StateModifier(StateCanceled)},
+		)
+	}
+
+	if len(canceled) == 0 {
+		return results, nil
+	}
+
+	// Now that we've canceled the orders on the server side, we'll update
+	// our local state to reflect this change. If this fails, we'll sync up
+	// the order states once we restart again.
+	if err := m.cfg.Store.UpdateOrders(canceled, modifiers); err != nil {
+		return nil, fmt.Errorf("unable to update canceled orders: %v",
+			err)
+	}
+	for _, nonce := range canceled {
+		m.ReleaseReservation(nonce)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,108 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCancelAllOrders makes sure only the active orders matched by a filter are
+// canceled and that a failed cancellation doesn't prevent the other orders
+// from being marked as canceled.
+func TestCancelAllOrders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = context.Background()
+		store      = newMockStore()
+		acctKeyA   = [33]byte{2, 1}
+		acctKeyB   = [33]byte{2, 2}
+		errFail    = errors.New("server unavailable")
+		canceled   = make(map[lntypes.Preimage]struct{})
+		canceledMu sync.Mutex
+	)
+	newKit := func(nonce byte, acctKey [33]byte, duration uint32,
+		state State) Kit {
+
+		var preimage lntypes.Preimage
+		preimage[0] = nonce
+		kit := NewKitWithPreimage(preimage)
+		kit.AcctKey = acctKey
+		kit.LeaseDuration = duration
+		kit.State = state
+
+		return *kit
+	}
+
+	askA := &Ask{Kit: newKit(1, acctKeyA, 2016, StateSubmitted)}
+	bidA := &Bid{Kit: newKit(2, acctKeyA, 2016, StatePartiallyFilled)}
+	bidA4032 := &Bid{Kit: newKit(3, acctKeyA, 4032, StateSubmitted)}
+	bidB := &Bid{Kit: newKit(4, acctKeyB, 2016, StateSubmitted)}
+	bidFail := &Bid{Kit: newKit(5, acctKeyA, 2016, StateSubmitted)}
+	executed := &Bid{Kit: newKit(6, acctKeyA, 2016, StateExecuted)}
+	staged := &Bid{Kit: newKit(7, acctKeyB, 2016, StateStaged)}
+	for _, o := range []Order{
+		askA, bidA, bidA4032, bidB, bidFail, executed, staged,
+	} {
+		require.NoError(t, store.SubmitOrder(o))
+	}
+
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		CancelOrder: func(_ context.Context,
+			preimage lntypes.Preimage) error {
+
+			if preimage == bidFail.Preimage {
+				return errFail
+			}
+
+			canceledMu.Lock()
+			canceled[preimage] = struct{}{}
+			canceledMu.Unlock()
+
+			return nil
+		},
+	})
+
+	bidType := TypeBid
+	results, err := mgr.CancelAllOrders(ctx, &CancelFilter{
+		AcctKey:       acctKeyA,
+		Type:          &bidType,
+		LeaseDuration: 2016,
+	})
+	require.NoError(t, err)
+
+	// Only the active bids of account A in the 2016 block bucket are
+	// matched, one of them couldn't be canceled.
+	require.Equal(t, map[Nonce]error{
+		bidA.Nonce():    nil,
+		bidFail.Nonce(): errFail,
+	}, results)
+	require.Len(t, canceled, 1)
+	require.Contains(t, canceled, bidA.Preimage)
+
+	require.Equal(t, StateCanceled, bidA.State)
+	require.Equal(t, StateSubmitted, bidFail.State)
+	require.Equal(t, StateSubmitted, askA.State)
+	require.Equal(t, StateSubmitted, bidA4032.State)
+	require.Equal(t, StateSubmitted, bidB.State)
+	require.Equal(t, StateExecuted, executed.State)
+
+	// An empty filter matches all remaining active orders. The staged
+	// order was never sent to the auctioneer, so it's only canceled
+	// locally.
+	results, err = mgr.CancelAllOrders(ctx, &CancelFilter{})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	require.ErrorIs(t, results[bidFail.Nonce()], errFail)
+	require.NoError(t, results[staged.Nonce()])
+	require.NotContains(t, canceled, staged.Preimage)
+	require.Equal(t, StateCanceled, askA.State)
+	require.Equal(t, StateCanceled, bidA4032.State)
+	require.Equal(t, StateCanceled, bidB.State)
+	require.Equal(t, StateCanceled, staged.State)
+}
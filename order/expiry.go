@@ -0,0 +1,75 @@
+package order
+
+import (
+	"context"
+	"time"
+)
+
+// ExpireOrders cancels all orders that expired at the given block height or
+// time with the auctioneer and marks them as canceled locally. Orders that are
+// part of the pending batch are skipped, they are canceled on one of the next
+// calls once the batch is resolved. The same applies to orders that couldn't
+// be canceled with the auctioneer.
+//
+// NOTE: This must be called from the same goroutine that processes the batch
+// messages of the auctioneer, as it accesses the pending batch.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) ExpireOrders(ctx context.Context, height uint32,
+	now time.Time) error {
+
+	// Cleared orders are part of a batch that is being executed, so we
+	// don't even look at those.
+	dbOrders, err := m.cfg.Store.GetOrdersByState(
+		StateSubmitted, StatePartiallyFilled, StateStaged,
+	)
+	if err != nil {
+		return err
+	}
+
+	var expired []Nonce
+	for _, o := range dbOrders {
+		details := o.Details()
+		if !details.Expired(height, now) {
+			continue
+		}
+
+		// An order must not be canceled in the middle of a batch.
+		if m.HasPendingBatch() {
+			_, inBatch := m.pendingBatch.MatchedOrders[o.Nonce()]
+			if inBatch {
+				log.Debugf("Not expiring order %v while it is "+
+					"part of pending batch %x", o.Nonce(),
+					m.pendingBatch.ID[:])
+				continue
+			}
+		}
+
+		// Staged orders were never sent to the auctioneer, so they
+		// only need to be canceled locally.
+		if details.State != StateStaged {
+			err := m.cfg.CancelOrder(ctx, details.Preimage)
+			if err != nil {
+				log.Warnf("Unable to cancel expired order %v: %v",
+					o.Nonce(), err)
+				continue
+			}
+		}
+
+		expired = append(expired, o.Nonce())
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	if err := m.cfg.Store.ExpireOrders(expired); err != nil {
+		return err
+	}
+	for _, nonce := range expired {
+		m.ReleaseReservation(nonce)
+		log.Infof("Canceled expired order %v", nonce)
+	}
+
+	return nil
+}
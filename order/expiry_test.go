@@ -0,0 +1,99 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExpireOrders makes sure orders are canceled once they reach their expiry
+// height or time, unless they are part of the pending batch.
+func TestExpireOrders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		store    = newMockStore()
+		now      = time.Now()
+		height   = uint32(800_000)
+		errFail  = errors.New("server unavailable")
+		canceled = make(map[lntypes.Preimage]struct{})
+	)
+	newAsk := func(nonce byte, state State, expiryHeight uint32,
+		expiryUnix int64) *Ask {
+
+		var preimage lntypes.Preimage
+		preimage[0] = nonce
+		kit := NewKitWithPreimage(preimage)
+		kit.State = state
+		kit.ExpiryHeight = expiryHeight
+		kit.ExpiryUnix = expiryUnix
+
+		ask := &Ask{Kit: *kit}
+		require.NoError(t, store.SubmitOrder(ask))
+
+		return ask
+	}
+
+	noExpiry := newAsk(1, StateSubmitted, 0, 0)
+	notYet := newAsk(2, StateSubmitted, height+1, now.Unix()+60)
+	byHeight := newAsk(3, StatePartiallyFilled, height, 0)
+	byTime := newAsk(4, StateSubmitted, 0, now.Unix())
+	staged := newAsk(5, StateStaged, height-10, 0)
+	inBatch := newAsk(6, StatePartiallyFilled, height, 0)
+	cleared := newAsk(7, StateCleared, height, 0)
+	cancelFail := newAsk(8, StateSubmitted, height, 0)
+
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		CancelOrder: func(_ context.Context,
+			preimage lntypes.Preimage) error {
+
+			if preimage == cancelFail.Preimage {
+				return errFail
+			}
+			canceled[preimage] = struct{}{}
+
+			return nil
+		},
+	})
+	mgr.pendingBatch = &Batch{
+		MatchedOrders: map[Nonce][]*MatchedOrder{
+			inBatch.Nonce(): nil,
+		},
+	}
+	atomic.StoreUint32(&mgr.hasPendingBatch, 1)
+
+	require.NoError(t, mgr.ExpireOrders(ctx, height, now))
+
+	// Only the expired orders that aren't part of the pending batch are
+	// canceled. The staged order was never sent to the auctioneer.
+	require.Equal(t, map[Nonce]struct{}{
+		byHeight.Nonce(): {},
+		byTime.Nonce():   {},
+		staged.Nonce():   {},
+	}, store.expired)
+	require.Equal(t, map[lntypes.Preimage]struct{}{
+		byHeight.Preimage: {},
+		byTime.Preimage:   {},
+	}, canceled)
+
+	require.Equal(t, StateSubmitted, noExpiry.State)
+	require.Equal(t, StateSubmitted, notYet.State)
+	require.Equal(t, StatePartiallyFilled, inBatch.State)
+	require.Equal(t, StateCleared, cleared.State)
+	require.Equal(t, StateSubmitted, cancelFail.State)
+
+	// Once the batch is resolved, the order is expired on the next try.
+	atomic.StoreUint32(&mgr.hasPendingBatch, 0)
+	mgr.pendingBatch = nil
+
+	require.NoError(t, mgr.ExpireOrders(ctx, height+1, now))
+	require.Contains(t, store.expired, inBatch.Nonce())
+	require.Equal(t, StateCanceled, inBatch.State)
+}
@@ -0,0 +1,202 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// feeBumpConfTarget is the confirmation target used to estimate the
+	// new max batch fee rate of orders that are updated automatically.
+	feeBumpConfTarget = 6
+)
+
+// BatchFeeRateErr is the error that is returned by the batch verifier if the
+// fee rate of a batch transaction exceeds the max batch fee rate of some of our
+// orders.
+type BatchFeeRateErr struct {
+	// Nonces are the nonces of our orders with a max batch fee rate below
+	// the fee rate of the batch.
+	Nonces []Nonce
+
+	// BatchFeeRate is the fee rate of the batch transaction.
+	BatchFeeRate chainfee.SatPerKWeight
+}
+
+// Unwrap returns the underlying error cause. This is always
+// ErrBatchFeeRateExceeded so we can compare the error with errors.Is().
+func (e *BatchFeeRateErr) Unwrap() error {
+	return ErrBatchFeeRateExceeded
+}
+
+// Error returns the underlying error message.
+//
+// NOTE: This method is part of the error interface.
+func (e *BatchFeeRateErr) Error() string {
+	return fmt.Sprintf("%v: batch fee rate %v, orders %v",
+		ErrBatchFeeRateExceeded, e.BatchFeeRate, e.Nonces)
+}
+
+// FeeRateBump describes the replacement of an order by one with a higher max
+// batch fee rate after a batch was rejected because of its fee rate.
+type FeeRateBump struct {
+	// Nonce is the nonce of the new order.
+	Nonce Nonce
+
+	// Replaces is the nonce of the replaced order.
+	Replaces Nonce
+
+	// PrevFeeRate is the max batch fee rate of the replaced order.
+	PrevFeeRate chainfee.SatPerKWeight
+
+	// NewFeeRate is the max batch fee rate of the new order.
+	NewFeeRate chainfee.SatPerKWeight
+
+	// BatchFeeRate is the fee rate of the rejected batch.
+	BatchFeeRate chainfee.SatPerKWeight
+}
+
+// bumpFeeRates replaces all orders with the given nonces that have automatic
+// fee rate updates enabled with orders that have a higher max batch fee rate.
+//
+// NOTE: This must be run as a goroutine.
+func (m *manager) bumpFeeRates(nonces []Nonce,
+	batchFeeRate chainfee.SatPerKWeight) {
+
+	defer m.wg.Done()
+
+	m.feeBumpMtx.Lock()
+	defer m.feeBumpMtx.Unlock()
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), defaultLndTimeout,
+	)
+	defer cancel()
+
+	var newFeeRate chainfee.SatPerKWeight
+	for _, nonce := range nonces {
+		o, err := m.cfg.Store.GetOrder(nonce)
+		if err != nil {
+			log.Errorf("Unable to fetch order %v: %v", nonce, err)
+			continue
+		}
+
+		// An order could already have been replaced because of an
+		// earlier batch.
+		details := o.Details()
+		if !details.AutoUpdateFeeRate || details.State.Archived() ||
+			details.State == StateStaged {
+
+			continue
+		}
+
+		// We only need to estimate the fee rate once for all orders.
+		if newFeeRate == 0 {
+			newFeeRate, err = m.autoFeeRate(ctx, batchFeeRate)
+			if err != nil {
+				log.Errorf("Unable to estimate fee rate: %v",
+					err)
+				return
+			}
+		}
+
+		if newFeeRate <= details.MaxBatchFeeRate {
+			log.Warnf("Not bumping max batch fee rate of order "+
+				"%v, new fee rate %v isn't higher than %v",
+				nonce, newFeeRate, details.MaxBatchFeeRate)
+			continue
+		}
+
+		err = m.bumpFeeRate(ctx, o, newFeeRate, batchFeeRate)
+		if err != nil {
+			log.Errorf("Unable to bump max batch fee rate of "+
+				"order %v: %v", nonce, err)
+		}
+	}
+}
+
+// bumpFeeRate replaces the given order with one that has the given max batch
+// fee rate and records the replacement in the new order's event log.
+func (m *manager) bumpFeeRate(ctx context.Context, o Order, newFeeRate,
+	batchFeeRate chainfee.SatPerKWeight) error {
+
+	newOrder, err := m.ReplaceOrder(
+		ctx, o.Nonce(), &ReplaceParams{MaxBatchFeeRate: newFeeRate},
+		func(newOrder Order, auctionTerms *terms.AuctioneerTerms) error {
+			acct, err := m.fundingAccount(newOrder.Details().AcctKey)
+			if err != nil {
+				return err
+			}
+
+			return m.submitOrder(ctx, newOrder, acct, auctionTerms)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Replaced order %v with %v to bump max batch fee rate from "+
+		"%v to %v", o.Nonce(), newOrder.Nonce(),
+		o.Details().MaxBatchFeeRate, newFeeRate)
+
+	return m.cfg.Store.StoreFeeRateBump(&FeeRateBump{
+		Nonce:        newOrder.Nonce(),
+		Replaces:     o.Nonce(),
+		PrevFeeRate:  o.Details().MaxBatchFeeRate,
+		NewFeeRate:   newFeeRate,
+		BatchFeeRate: batchFeeRate,
+	})
+}
+
+// autoFeeRate returns the max batch fee rate orders are bumped to after a batch
+// with the given fee rate was rejected. This is the current fee estimate of our
+// lnd node but at least the batch fee rate, capped at the configured maximum.
+func (m *manager) autoFeeRate(ctx context.Context,
+	batchFeeRate chainfee.SatPerKWeight) (chainfee.SatPerKWeight, error) {
+
+	feeRate, err := m.cfg.Wallet.EstimateFeeRate(ctx, feeBumpConfTarget)
+	if err != nil {
+		return 0, err
+	}
+
+	if feeRate < batchFeeRate {
+		feeRate = batchFeeRate
+	}
+
+	if m.cfg.MaxAutoFeeRate > 0 && feeRate > m.cfg.MaxAutoFeeRate {
+		log.Debugf("Capping automatic max batch fee rate of %v at %v",
+			feeRate, m.cfg.MaxAutoFeeRate)
+		feeRate = m.cfg.MaxAutoFeeRate
+	}
+
+	return feeRate, nil
+}
+
+// fundingAccount returns the account with the given key if it is in a state
+// that allows it to fund new orders.
+func (m *manager) fundingAccount(acctKey [33]byte) (*account.Account, error) {
+	acctPubKey, err := btcec.ParsePubKey(acctKey[:])
+	if err != nil {
+		return nil, err
+	}
+	acct, err := m.cfg.AcctStore.Account(acctPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch account: %v", err)
+	}
+
+	switch acct.State {
+	case account.StateOpen, account.StatePendingUpdate,
+		account.StatePendingBatch:
+
+		return acct, nil
+
+	default:
+		return nil, fmt.Errorf("account %x is in state %v", acctKey[:],
+			acct.State)
+	}
+}
@@ -0,0 +1,178 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAutoFeeRate makes sure the new max batch fee rate of an order is the fee
+// estimate of our lnd node but at least the batch fee rate, capped at the
+// configured maximum.
+func TestAutoFeeRate(t *testing.T) {
+	t.Parallel()
+
+	// The mock wallet always estimates a fee rate of 10k sat/kw.
+	const estimate = chainfee.SatPerKWeight(10_000)
+
+	testCases := []struct {
+		name         string
+		batchFeeRate chainfee.SatPerKWeight
+		maxFeeRate   chainfee.SatPerKWeight
+		expected     chainfee.SatPerKWeight
+	}{{
+		name:         "estimate above batch fee rate",
+		batchFeeRate: 5_000,
+		expected:     estimate,
+	}, {
+		name:         "estimate below batch fee rate",
+		batchFeeRate: 20_000,
+		expected:     20_000,
+	}, {
+		name:         "capped at maximum",
+		batchFeeRate: 20_000,
+		maxFeeRate:   8_000,
+		expected:     8_000,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mgr := NewManager(&ManagerConfig{
+				Wallet:         test.NewMockWalletKit(),
+				MaxAutoFeeRate: tc.maxFeeRate,
+			})
+			feeRate, err := mgr.autoFeeRate(
+				context.Background(), tc.batchFeeRate,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, feeRate)
+		})
+	}
+}
+
+// TestBumpFeeRates makes sure only active orders that opted in to automatic
+// fee rate updates are replaced with a higher max batch fee rate and that the
+// replacement is recorded.
+func TestBumpFeeRates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		store     = newMockStore()
+		sent      []Order
+		liveTerms = &terms.AuctioneerTerms{
+			OrderExecBaseFee: 1,
+			OrderExecFeeRate: 100,
+			LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+				2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+			},
+		}
+	)
+	acct := &account.Account{
+		Value: btcutil.SatoshiPerBitcoin,
+		State: account.StateOpen,
+		TraderKey: &keychain.KeyDescriptor{
+			PubKey: acctKeySmall,
+		},
+	}
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		AcctStore: &mockAccountStore{
+			accounts: []*account.Account{acct},
+		},
+		Wallet:       test.NewMockWalletKit(),
+		Lightning:    test.NewMockLightning(),
+		Signer:       test.NewMockSigner(),
+		BatchVersion: LatestBatchVersion,
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			return liveTerms, nil
+		},
+		SendOrder: func(_ context.Context, o Order,
+			_ *ServerOrderParams) error {
+
+			sent = append(sent, o)
+			return nil
+		},
+		CancelOrder: func(context.Context, lntypes.Preimage) error {
+			return nil
+		},
+		TermsStore:     store,
+		TermsCacheTTL:  time.Hour,
+		MaxAutoFeeRate: 8_000,
+	})
+	require.NoError(t, mgr.Start())
+	defer mgr.Stop()
+
+	var acctKey [33]byte
+	copy(acctKey[:], acctKeySmall.SerializeCompressed())
+	newBid := func(nonce byte, state State, autoUpdate bool,
+		feeRate chainfee.SatPerKWeight) *Bid {
+
+		bid := &Bid{
+			Kit: newKitFromTemplate(Nonce{nonce}, &Kit{
+				State:            state,
+				FixedRate:        100,
+				Amt:              500_000,
+				Units:            5,
+				UnitsUnfulfilled: 5,
+				MinUnitsMatch:    1,
+				AcctKey:          acctKey,
+				MaxBatchFeeRate:  feeRate,
+				LeaseDuration:    2016,
+			}),
+		}
+		bid.AutoUpdateFeeRate = autoUpdate
+		require.NoError(t, store.SubmitOrder(bid))
+
+		return bid
+	}
+
+	var (
+		bumped     = newBid(1, StateSubmitted, true, 1_000)
+		notOptedIn = newBid(2, StateSubmitted, false, 1_000)
+		canceled   = newBid(3, StateCanceled, true, 1_000)
+		highRate   = newBid(4, StatePartiallyFilled, true, 9_000)
+	)
+
+	mgr.wg.Add(1)
+	mgr.bumpFeeRates([]Nonce{
+		bumped.Nonce(), notOptedIn.Nonce(), canceled.Nonce(),
+		highRate.Nonce(),
+	}, 5_000)
+
+	// Only the first order was replaced, with the estimate of 10k sat/kw
+	// capped at the configured maximum.
+	require.Equal(t, StateCanceled, bumped.State)
+	require.Equal(t, StateSubmitted, notOptedIn.State)
+	require.Equal(t, StatePartiallyFilled, highRate.State)
+
+	require.Len(t, sent, 1)
+	replacement := sent[0].Details()
+	require.Equal(t, bumped.Nonce(), replacement.Replaces)
+	require.EqualValues(t, 8_000, replacement.MaxBatchFeeRate)
+	require.True(t, replacement.AutoUpdateFeeRate)
+	require.Contains(t, store.orders, sent[0].Nonce())
+
+	require.Equal(t, []*FeeRateBump{{
+		Nonce:        sent[0].Nonce(),
+		Replaces:     bumped.Nonce(),
+		PrevFeeRate:  1_000,
+		NewFeeRate:   8_000,
+		BatchFeeRate: 5_000,
+	}}, store.feeRateBumps)
+}
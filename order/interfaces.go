@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
+	"unicode/utf8"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/account"
@@ -18,6 +20,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 // Nonce is a 32 byte pseudo randomly generated unique order ID.
@@ -72,6 +75,37 @@ const (
 	// type field. Only orders with this version are allowed to use the
 	// channel type field.
 	VersionChannelType Version = 5
+
+	// VersionMinDistinctPeers is the order version that added the minimum
+	// number of distinct peers field for ask orders. Only asks with this
+	// version are allowed to use the field, which is committed to in the
+	// order digest as an additional tlv stream.
+	VersionMinDistinctPeers Version = 6
+
+	// VersionUnannouncedChannel is the order version that added the
+	// unannounced channel flag for ask and bid orders. Only orders with
+	// this version are allowed to request unannounced channels. The flag
+	// is committed to in the order digest as part of the tlv stream.
+	VersionUnannouncedChannel Version = 7
+
+	// VersionZeroConfChannel is the order version that added the zero conf
+	// channel flag for ask and bid orders. Only orders with this version
+	// are allowed to request or serve zero conf channels.
+	VersionZeroConfChannel Version = 8
+)
+
+const (
+	// askMinDistinctPeersType is the tlv type of the minimum number of
+	// distinct peers field within the digest of an ask order.
+	askMinDistinctPeersType tlv.Type = 1
+
+	// unannouncedChannelType is the tlv type of the unannounced channel
+	// flag within the digest of both ask and bid orders.
+	unannouncedChannelType tlv.Type = 2
+
+	// zeroConfChannelType is the tlv type of the zero conf channel flag
+	// within the digest of both ask and bid orders.
+	zeroConfChannelType tlv.Type = 3
 )
 
 // Type is the type of an order. We don't use iota for the constants due to the
@@ -132,6 +166,12 @@ const (
 	// StateFailed is the state an order has if any irrecoverable error
 	// happens in its lifetime.
 	StateFailed State = 6
+
+	// StateStaged is the state an order has after it's been signed and
+	// stored locally but not yet sent to the auctioneer. A staged order
+	// reserves account balance locally but is unknown to the auctioneer
+	// until it is activated.
+	StateStaged State = 7
 )
 
 // String returns a human readable string representation of the order state.
@@ -158,6 +198,9 @@ func (s State) String() string {
 	case StateFailed:
 		return "failed"
 
+	case StateStaged:
+		return "staged"
+
 	default:
 		return fmt.Sprintf("unknown<%d>", s)
 	}
@@ -246,15 +289,94 @@ const (
 	ChannelTypeScriptEnforced ChannelType = 1
 )
 
+// String returns a human readable string representation of the channel type.
+func (c ChannelType) String() string {
+	switch c {
+	case ChannelTypePeerDependent:
+		return "peer_dependent"
+
+	case ChannelTypeScriptEnforced:
+		return "script_enforced"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(c))
+	}
+}
+
+// NegotiatedChannelType returns the type of the channel that is opened for a
+// match between two orders of the given channel types. If either of the orders
+// requires a script enforced channel, the channel is script enforced.
+func NegotiatedChannelType(a, b ChannelType) ChannelType {
+	if a == ChannelTypeScriptEnforced || b == ChannelTypeScriptEnforced {
+		return ChannelTypeScriptEnforced
+	}
+
+	return ChannelTypePeerDependent
+}
+
+// MaxLabelLength is the maximum length of an order label in bytes.
+const MaxLabelLength = 500
+
+// MaxNodeIDs is the maximum number of node IDs an order's allowed or not
+// allowed node ID list can contain.
+const MaxNodeIDs = 300
+
 var (
 	// ErrInsufficientBalance is the error that is returned if an account
 	// has insufficient balance to perform a requested action.
 	ErrInsufficientBalance = errors.New("insufficient account balance")
 
+	// ErrReserveViolated is the error that is returned if a batch would
+	// reduce an account's balance below its reserve.
+	ErrReserveViolated = errors.New("account balance below reserve")
+
+	// ErrChainFeeExceeded is the error that is returned if an order's
+	// share of the chain fee of a batch exceeds its maximum chain fee.
+	ErrChainFeeExceeded = errors.New("order chain fee exceeds maximum")
+
+	// ErrBatchFeeRateExceeded is the error that is returned if the fee
+	// rate of a batch transaction exceeds the max batch fee rate of one of
+	// our orders.
+	ErrBatchFeeRateExceeded = errors.New("batch fee rate exceeds " +
+		"order maximum")
+
 	// ZeroNonce is used to find out if a user-provided nonce is empty.
 	ZeroNonce Nonce
+
+	// ErrLabelTooLong is the error that is returned if an order label
+	// exceeds the maximum allowed length.
+	ErrLabelTooLong = fmt.Errorf("order label cannot exceed %d bytes",
+		MaxLabelLength)
+
+	// ErrLabelInvalidUTF8 is the error that is returned if an order label
+	// is not a valid UTF-8 string.
+	ErrLabelInvalidUTF8 = errors.New("order label must be valid UTF-8")
+
+	// ErrBothNodeIDLists is the error that is returned if an order has
+	// both an allowed and a not allowed node ID list.
+	ErrBothNodeIDLists = errors.New("allowed and not allowed node ids " +
+		"cannot be set at the same time")
+
+	// ErrTooManyNodeIDs is the error that is returned if an order's
+	// allowed or not allowed node ID list is too long.
+	ErrTooManyNodeIDs = fmt.Errorf("allowed and not allowed node id "+
+		"lists cannot contain more than %d ids", MaxNodeIDs)
 )
 
+// ValidateLabel makes sure the given order label is valid UTF-8 and doesn't
+// exceed the maximum label length.
+func ValidateLabel(label string) error {
+	if len(label) > MaxLabelLength {
+		return ErrLabelTooLong
+	}
+
+	if !utf8.ValidString(label) {
+		return ErrLabelInvalidUTF8
+	}
+
+	return nil
+}
+
 // Order is an interface to allow generic handling of both ask and bid orders
 // by both store and manager.
 type Order interface {
@@ -340,6 +462,17 @@ type Kit struct {
 	// resulting matched channels.
 	ChannelType ChannelType
 
+	// UnannouncedChannel denotes that the resulting matched channels must
+	// not be announced to the network. Both orders of a match must agree
+	// on this flag.
+	UnannouncedChannel bool
+
+	// ZeroConfChannel denotes for bids that the resulting matched channels
+	// should be usable before the batch transaction confirms. For asks, it
+	// denotes that the maker is willing to open such zero conf channels.
+	// Zero conf bids are only matched with asks that opted in.
+	ZeroConfChannel bool
+
 	// AllowedNodeIDs is the list of node ids this order is allowed to
 	// match with.
 	AllowedNodeIDs [][33]byte
@@ -347,6 +480,70 @@ type Kit struct {
 	// NotAllowedNodeIDs is the list of node ids this order is not allowed
 	// to match with.
 	NotAllowedNodeIDs [][33]byte
+
+	// Label is an optional, free form label the trader can attach to an
+	// order to identify it more easily. The label is only stored locally
+	// and is not part of the order digest, so it is never sent to the
+	// auctioneer.
+	Label string
+
+	// MaxChainFee is the maximum share of a batch transaction's chain fee
+	// the order is willing to pay. Batches in which the order's share is
+	// higher are rejected. Like the label, this is only enforced locally
+	// and not part of the order digest. A value of zero means there is no
+	// limit other than the max batch fee rate.
+	MaxChainFee btcutil.Amount
+
+	// AutoUpdateFeeRate is set if the order should be replaced by one with
+	// a higher max batch fee rate if a batch is rejected because its fee
+	// rate exceeds the order's maximum. This is only known locally and not
+	// part of the order digest.
+	AutoUpdateFeeRate bool
+
+	// Recurrence is set if the order should be resubmitted with the same
+	// parameters once it is fully executed. Like the label, this is only
+	// known locally and not part of the order digest.
+	Recurrence *Recurrence
+
+	// Replaces is the nonce of the order this order replaced through
+	// ReplaceOrder. It is ZeroNonce if the order didn't replace another
+	// order. This is only known locally and not part of the order digest.
+	Replaces Nonce
+
+	// ActivationFeeRate is the chain fee rate at or below which a staged
+	// order is activated automatically. A value of zero means the order
+	// is only activated manually. This is only known locally and not part
+	// of the order digest.
+	ActivationFeeRate chainfee.SatPerKWeight
+
+	// ExpiryHeight is the block height at which the order is canceled
+	// automatically. A value of zero means the order doesn't expire at a
+	// certain height. This is only known locally and not part of the
+	// order digest.
+	ExpiryHeight uint32
+
+	// ExpiryUnix is the unix timestamp in seconds at which the order is
+	// canceled automatically. A value of zero means the order doesn't
+	// expire at a certain time. This is only known locally and not part
+	// of the order digest.
+	ExpiryUnix int64
+
+	// PremiumTotal is the total premium in satoshis the order paid (bids)
+	// or earned (asks) across all batches it was matched in.
+	PremiumTotal btcutil.Amount
+
+	// ExecutionFeeTotal is the total execution fee in satoshis the order
+	// paid to the auctioneer across all batches it was matched in.
+	ExecutionFeeTotal btcutil.Amount
+
+	// ChainFeeTotal is the total share of the batch transaction chain fees
+	// in satoshis the order paid across all batches it was matched in.
+	ChainFeeTotal btcutil.Amount
+
+	// TotalsPartial is set if the order was matched in batches before the
+	// totals above were recorded. The totals then only include the
+	// batches the order was matched in since.
+	TotalsPartial bool
 }
 
 // Nonce is the unique identifier of each order and MUST be created by hashing a
@@ -365,6 +562,124 @@ func (k *Kit) Details() *Kit {
 	return k
 }
 
+// Expired returns true if the order reached its expiry height or time at the
+// given block height and time.
+func (k *Kit) Expired(height uint32, now time.Time) bool {
+	switch {
+	case k.ExpiryHeight != 0 && height >= k.ExpiryHeight:
+		return true
+
+	case k.ExpiryUnix != 0 && now.Unix() >= k.ExpiryUnix:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// ValidateNodeIDs makes sure at most one of the allowed and not allowed node ID
+// lists of the order is set and that it doesn't exceed the maximum length.
+func (k *Kit) ValidateNodeIDs() error {
+	if len(k.AllowedNodeIDs) > 0 && len(k.NotAllowedNodeIDs) > 0 {
+		return ErrBothNodeIDLists
+	}
+
+	if len(k.AllowedNodeIDs) > MaxNodeIDs ||
+		len(k.NotAllowedNodeIDs) > MaxNodeIDs {
+
+		return ErrTooManyNodeIDs
+	}
+
+	return nil
+}
+
+// ValidateChannelType makes sure the channel type of the order is known and
+// currently accepted by the auctioneer.
+func (k *Kit) ValidateChannelType(terms *terms.AuctioneerTerms) error {
+	var rpcType auctioneerrpc.OrderChannelType
+	switch k.ChannelType {
+	case ChannelTypePeerDependent:
+		rpcType = auctioneerrpc.OrderChannelType_ORDER_CHANNEL_TYPE_PEER_DEPENDENT
+
+	case ChannelTypeScriptEnforced:
+		rpcType = auctioneerrpc.OrderChannelType_ORDER_CHANNEL_TYPE_SCRIPT_ENFORCED
+
+	default:
+		return fmt.Errorf("unknown channel type %v", k.ChannelType)
+	}
+
+	if !terms.SupportsChannelType(rpcType) {
+		return fmt.Errorf("channel type %v is not supported by the "+
+			"auctioneer, must be one of %v", k.ChannelType,
+			terms.SupportedChannelTypes())
+	}
+
+	return nil
+}
+
+// ValidateUnannouncedChannel makes sure that all conditions to use the
+// UnannouncedChannel flag on an order are met.
+func (k *Kit) ValidateUnannouncedChannel() error {
+	if k.Version < VersionUnannouncedChannel {
+		return fmt.Errorf("cannot use unannounced channel with old " +
+			"order version")
+	}
+
+	return nil
+}
+
+// ValidateZeroConfChannel makes sure that all conditions to use the
+// ZeroConfChannel flag on an order are met.
+func (k *Kit) ValidateZeroConfChannel(
+	auctionTerms *terms.AuctioneerTerms) error {
+
+	if k.Version < VersionZeroConfChannel {
+		return fmt.Errorf("cannot use zero conf channel with old " +
+			"order version")
+	}
+
+	if !auctionTerms.HasCapability(terms.CapabilityZeroConfChannels) {
+		return fmt.Errorf("zero conf channels are not supported by " +
+			"the auctioneer")
+	}
+
+	return nil
+}
+
+// digestFlags holds the encoded values of the flags common to both ask and
+// bid orders that are committed to in the order digest as tlv records.
+type digestFlags struct {
+	unannounced uint8
+	zeroConf    uint8
+}
+
+// digestTlvRecords returns the tlv records of the fields common to both ask
+// and bid orders that are committed to in the order digest from version
+// VersionUnannouncedChannel on. The given flags are used as the backing
+// storage of the records.
+func (k *Kit) digestTlvRecords(flags *digestFlags) []tlv.Record {
+	var records []tlv.Record
+	if k.Version >= VersionUnannouncedChannel {
+		if k.UnannouncedChannel {
+			flags.unannounced = 1
+		}
+		records = append(records, tlv.MakePrimitiveRecord(
+			unannouncedChannelType, &flags.unannounced,
+		))
+	}
+
+	if k.Version >= VersionZeroConfChannel {
+		if k.ZeroConfChannel {
+			flags.zeroConf = 1
+		}
+		records = append(records, tlv.MakePrimitiveRecord(
+			zeroConfChannelType, &flags.zeroConf,
+		))
+	}
+
+	return records
+}
+
 // NewKitWithPreimage creates a new kit by hashing the preimage to generate the
 // unique nonce.
 func NewKitWithPreimage(preimage lntypes.Preimage) *Kit {
@@ -392,6 +707,12 @@ func NewKit(nonce Nonce) *Kit {
 type Ask struct {
 	// Kit contains all the common order parameters.
 	Kit
+
+	// MinDistinctPeers is the minimum number of distinct nodes the ask
+	// must be matched with in a single batch. This allows a maker to
+	// spread the channel risk of a large ask over multiple takers. A value
+	// of zero means there is no such constraint.
+	MinDistinctPeers uint32
 }
 
 // Type returns the order type.
@@ -443,6 +764,35 @@ func (a *Ask) Digest() ([hashSize]byte, error) {
 			return result, err
 		}
 
+	case VersionMinDistinctPeers, VersionUnannouncedChannel,
+		VersionZeroConfChannel:
+
+		err := lnwire.WriteElements(
+			&msg, a.nonce[:], uint32(a.Version), a.FixedRate,
+			a.Amt, a.LeaseDuration, uint64(a.MaxBatchFeeRate),
+			uint32(a.MinUnitsMatch), uint8(a.ChannelType),
+		)
+		if err != nil {
+			return result, err
+		}
+
+		// Any fields added from this version on are appended as a tlv
+		// stream, so new optional fields don't require a new digest
+		// format.
+		var flags digestFlags
+		tlvRecords := append(
+			[]tlv.Record{tlv.MakePrimitiveRecord(
+				askMinDistinctPeersType, &a.MinDistinctPeers,
+			)}, a.digestTlvRecords(&flags)...,
+		)
+		tlvStream, err := tlv.NewStream(tlvRecords...)
+		if err != nil {
+			return result, err
+		}
+		if err := tlvStream.Encode(&msg); err != nil {
+			return result, err
+		}
+
 	default:
 		return result, fmt.Errorf("unknown version %d", a.Kit.Version)
 	}
@@ -488,11 +838,15 @@ func reservedValue(o Order,
 		balanceDelta += perMatchDelta(rem)
 	}
 
-	// Subtract the worst case chain fee from the balance.
+	// Subtract the worst case chain fee from the balance. The chain fee of
+	// a legacy account is larger, so we use that for the worst case.
 	maxFeeRate := o.Details().MaxBatchFeeRate
-	balanceDelta -= maxNumMatches * EstimateTraderFee(1, maxFeeRate)
+	chainFee := EstimateTraderFee(
+		1, maxFeeRate, account.VersionInitialNoVersion,
+	)
+	balanceDelta -= maxNumMatches * chainFee
 	if rem > 0 {
-		balanceDelta -= EstimateTraderFee(1, maxFeeRate)
+		balanceDelta -= chainFee
 	}
 
 	// If the balance delta is negative, meaning this order will decrease
@@ -506,6 +860,26 @@ func reservedValue(o Order,
 	return 0
 }
 
+// ValidateMinDistinctPeers makes sure that all conditions to use the
+// MinDistinctPeers field on an ask order are met.
+func (a *Ask) ValidateMinDistinctPeers() error {
+	if a.Version < VersionMinDistinctPeers {
+		return fmt.Errorf("cannot use min distinct peers with old " +
+			"order version")
+	}
+
+	// Every peer needs to be matched with at least the minimum number of
+	// units, so the order must be large enough to be split up that way.
+	minUnits := SupplyUnit(a.MinDistinctPeers) * a.MinUnitsMatch
+	if minUnits > a.Units {
+		return fmt.Errorf("order of %d units cannot be matched with "+
+			"%d distinct peers of at least %d units each", a.Units,
+			a.MinDistinctPeers, a.MinUnitsMatch)
+	}
+
+	return nil
+}
+
 // ReservedValue returns the maximum value that could be deducted from a single
 // account if the ask is is matched under the worst case fee conditions.
 func (a *Ask) ReservedValue(feeSchedule terms.FeeSchedule) btcutil.Amount {
@@ -591,6 +965,13 @@ type Bid struct {
 	// happens out of band). This will only be used if the order version is
 	// VersionSidecarChannel or greater.
 	SidecarTicket *sidecar.Ticket
+
+	// NewNodesOnly indicates that this bid should only be matched with
+	// asks of nodes our lnd node doesn't have any channel with yet. The
+	// auctioneer doesn't know our channels, so this is enforced locally by
+	// rejecting such matches during the batch preparation. Like the
+	// label, this is not part of the order digest.
+	NewNodesOnly bool
 }
 
 // Type returns the order type.
@@ -600,6 +981,16 @@ func (b *Bid) Type() Type {
 	return TypeBid
 }
 
+// EffectiveMinNodeTier returns the minimum node tier the bid's matches must
+// have, resolving the default tier to the tier it currently stands for.
+func (b *Bid) EffectiveMinNodeTier() NodeTier {
+	if b.MinNodeTier == NodeTierDefault {
+		return DefaultMinNodeTier
+	}
+
+	return b.MinNodeTier
+}
+
 // Digest returns a deterministic SHA256 hash over the contents of a bid order.
 // Deterministic in this context means that if two orders have the same content,
 // their digest have to be identical as well.
@@ -657,7 +1048,7 @@ func (b *Bid) Digest() ([hashSize]byte, error) {
 			return result, err
 		}
 
-	case VersionChannelType:
+	case VersionChannelType, VersionMinDistinctPeers:
 		var isSidecar uint8
 		if b.SidecarTicket != nil {
 			isSidecar = 1
@@ -674,6 +1065,34 @@ func (b *Bid) Digest() ([hashSize]byte, error) {
 			return result, err
 		}
 
+	case VersionUnannouncedChannel, VersionZeroConfChannel:
+		var isSidecar uint8
+		if b.SidecarTicket != nil {
+			isSidecar = 1
+		}
+
+		err := lnwire.WriteElements(
+			&msg, b.nonce[:], uint32(b.Version), b.FixedRate,
+			b.Amt, b.LeaseDuration, uint64(b.MaxBatchFeeRate),
+			uint32(b.MinNodeTier), uint32(b.MinUnitsMatch),
+			uint64(b.SelfChanBalance), isSidecar,
+			uint8(b.ChannelType),
+		)
+		if err != nil {
+			return result, err
+		}
+
+		// Like for asks, any fields added from this version on are
+		// appended as a tlv stream.
+		var flags digestFlags
+		tlvStream, err := tlv.NewStream(b.digestTlvRecords(&flags)...)
+		if err != nil {
+			return result, err
+		}
+		if err := tlvStream.Encode(&msg); err != nil {
+			return result, err
+		}
+
 	default:
 		return result, fmt.Errorf("unknown version %d", b.Kit.Version)
 	}
@@ -698,17 +1117,25 @@ func (b *Bid) ReservedValue(feeSchedule terms.FeeSchedule) btcutil.Amount {
 }
 
 // ValidateSelfChanBalance makes sure that all conditions to use the
-// SelfChanBalance field on a bid order are met.
-func (b *Bid) ValidateSelfChanBalance() error {
+// SelfChanBalance field on a bid order are met. The self channel balance is
+// limited by the ratio the auctioneer announces in its terms.
+func (b *Bid) ValidateSelfChanBalance(terms *terms.AuctioneerTerms) error {
 	if b.Version < VersionSelfChanBalance {
 		return fmt.Errorf("cannot use self chan balance with old " +
 			"order version")
 	}
 
-	if err := sidecar.CheckOfferParams(
-		b.Amt, b.SelfChanBalance, BaseSupplyUnit,
-	); err != nil {
-		return fmt.Errorf("invalid self chan balance: %v", err)
+	if b.Amt == 0 || b.Amt%BaseSupplyUnit != 0 {
+		return fmt.Errorf("invalid self chan balance: channel "+
+			"capacity must be positive multiple of %d",
+			BaseSupplyUnit)
+	}
+
+	maxSelfChanBalance := terms.MaxSelfChanBalance(b.Amt)
+	if b.SelfChanBalance > maxSelfChanBalance {
+		return fmt.Errorf("invalid self chan balance: %v exceeds "+
+			"the maximum of %v allowed for a bid amount of %v",
+			b.SelfChanBalance, maxSelfChanBalance, b.Amt)
 	}
 
 	if b.Units != b.MinUnitsMatch {
@@ -742,6 +1169,16 @@ func UnitsFulfilledModifier(newUnfulfilledUnits SupplyUnit) Modifier {
 	}
 }
 
+// TotalsModifier is a functional option that adds the premium, execution fee
+// and chain fee the order paid or earned in a batch to its running totals.
+func TotalsModifier(premium, executionFee, chainFee btcutil.Amount) Modifier {
+	return func(order *Kit) {
+		order.PremiumTotal += premium
+		order.ExecutionFeeTotal += executionFee
+		order.ChainFeeTotal += chainFee
+	}
+}
+
 // Store is the interface a store has to implement to support persisting orders.
 type Store interface {
 	// SubmitOrder stores an order by using the orders's nonce as an
@@ -764,6 +1201,10 @@ type Store interface {
 	// GetOrders returns all orders that are currently known to the store.
 	GetOrders() ([]Order, error)
 
+	// GetOrdersByState returns all orders that are currently in one of
+	// the given states.
+	GetOrdersByState(...State) ([]Order, error)
+
 	// DeleteOrder removes the order with the given Nonce.
 	//
 	// Note: this method deletes the order without checking if it is
@@ -779,11 +1220,57 @@ type Store interface {
 		orderModifiers [][]Modifier, accounts []*account.Account,
 		accountModifiers [][]account.Modifier) error
 
-	// MarkBatchComplete marks a pending batch as complete, applying any
-	// staged modifications necessary, and allowing a trader to participate
-	// in a new batch. If a pending batch is not found, ErrNoPendingBatch is
+	// ValidatePendingBatch performs a dry run of StorePendingBatch with
+	// the same arguments. All modifiers are applied to in-memory copies of
+	// the orders and accounts and the resulting state is validated without
+	// anything being written to disk. The post-batch orders and accounts
+	// are returned.
+	ValidatePendingBatch(_ *Batch, orders []Nonce,
+		orderModifiers [][]Modifier, accounts []*account.Account,
+		accountModifiers [][]account.Modifier) ([]Order,
+		[]*account.Account, error)
+
+	// MarkBatchComplete marks the pending batch with the given ID as
+	// complete, applying any staged modifications necessary, and allowing
+	// a trader to participate in a new batch. All other pending batches
+	// are removed. If the pending batch is not found, ErrNoPendingBatch is
 	// returned.
-	MarkBatchComplete() error
+	MarkBatchComplete(BatchID) error
+
+	// StoreOrderTemplate stores the given order template, replacing any
+	// existing template of the same name.
+	StoreOrderTemplate(*Template) error
+
+	// OrderTemplate returns the order template with the given name. If no
+	// such template exists, ErrNoOrderTemplate is returned.
+	OrderTemplate(name string) (*Template, error)
+
+	// ListOrderTemplates returns all stored order templates.
+	ListOrderTemplates() ([]*Template, error)
+
+	// DeleteOrderTemplate removes the order template with the given name.
+	// If no such template exists, ErrNoOrderTemplate is returned.
+	DeleteOrderTemplate(name string) error
+
+	// ExpireOrders atomically marks the orders with the given nonces as
+	// canceled because they expired.
+	ExpireOrders([]Nonce) error
+
+	// StoreFeeRateBump records the replacement of an order by one with a
+	// higher max batch fee rate in the event log of the new order.
+	StoreFeeRateBump(*FeeRateBump) error
+}
+
+// TermsStore is the interface a store has to implement to cache the last known
+// auctioneer terms.
+type TermsStore interface {
+	// StoreTerms stores the given auctioneer terms as the last known
+	// terms, replacing any previously cached ones.
+	StoreTerms(*terms.AuctioneerTerms) error
+
+	// CachedTerms returns the last known auctioneer terms and the time
+	// they were stored.
+	CachedTerms() (*terms.AuctioneerTerms, time.Time, error)
 }
 
 // UserError is an error type that is returned if an action fails because of
@@ -846,10 +1333,50 @@ type Manager interface {
 	// Stop stops all concurrent tasks the manager is responsible for.
 	Stop()
 
+	// Terms returns the current auctioneer terms. If the auctioneer can't
+	// be reached, the last known terms are returned instead and marked as
+	// cached.
+	Terms(ctx context.Context) (*terms.AuctioneerTerms, error)
+
+	// CheckSubmission makes sure a new order can be submitted without
+	// exceeding the submission rate limit and, unless force is set, that
+	// it isn't a duplicate of another recently submitted active order. If
+	// the check passes, the order is reserved until it is stored or
+	// ReleaseReservation is called for it.
+	CheckSubmission(o Order, force bool) error
+
+	// QuoteOrder calculates the premium, execution fee and worst case
+	// chain fee of the given order without storing or submitting it.
+	QuoteOrder(ctx context.Context, order Order) (*Quote, error)
+
 	// PrepareOrder validates an order, signs it and then stores it locally.
 	PrepareOrder(ctx context.Context, order Order, acct *account.Account,
 		terms *terms.AuctioneerTerms) (*ServerOrderParams, error)
 
+	// PrepareUnsignedOrder validates an order and derives the multisig key
+	// for it, but neither signs nor stores it. The returned digest needs
+	// to be signed with the account's trader key outside of the daemon.
+	PrepareUnsignedOrder(ctx context.Context, order Order,
+		acct *account.Account,
+		terms *terms.AuctioneerTerms) ([hashSize]byte, error)
+
+	// PrepareSignedOrder validates an order that was prepared with
+	// PrepareUnsignedOrder, verifies the given signature against the
+	// account's trader key and then stores the order locally.
+	PrepareSignedOrder(ctx context.Context, order Order,
+		acct *account.Account, terms *terms.AuctioneerTerms,
+		sig []byte) (*ServerOrderParams, error)
+
+	// SelectAccount picks the account with the furthest expiry that has
+	// enough free balance to submit the given order from.
+	SelectAccount(order Order,
+		terms *terms.AuctioneerTerms) (*account.Account, error)
+
+	// ReleaseReservation removes the order with the given nonce from the
+	// ledger of reserved account balances and drops the reservation made
+	// for it by CheckSubmission.
+	ReleaseReservation(nonce Nonce)
+
 	// OrderMatchValidate verifies an incoming batch is sane before accepting it.
 	OrderMatchValidate(batch *Batch, bestHeight uint32) error
 
@@ -860,8 +1387,9 @@ type Manager interface {
 	PendingBatch() *Batch
 
 	// BatchSign returns the witness stack of all account inputs in a batch that
-	// belong to the trader.
-	BatchSign() (BatchSignature, error)
+	// belong to the trader, along with our MuSig2 nonces for all taproot
+	// accounts.
+	BatchSign() (BatchSignature, AccountNonces, error)
 
 	// BatchFinalize marks a batch as complete upon receiving the finalize message
 	// from the auctioneer.
@@ -870,4 +1398,38 @@ type Manager interface {
 	// OurNodePubkey returns our lnd node's public identity key or an error if the
 	// manager wasn't fully started yet.
 	OurNodePubkey() ([33]byte, error)
+
+	// SaveOrderTemplate validates the given order parameters against the
+	// current auctioneer terms and stores them as a template with the
+	// given name.
+	SaveOrderTemplate(ctx context.Context, name string, params Order) error
+
+	// SubmitOrderFromTemplate creates a new order from the template with
+	// the given name and the given overrides and hands it to the submit
+	// function if the template is still valid under the current
+	// auctioneer terms.
+	SubmitOrderFromTemplate(ctx context.Context, name string,
+		overrides *TemplateOverrides,
+		submit func(Order, *terms.AuctioneerTerms) error) (Order, error)
+
+	// CancelAllOrders cancels all orders that are not yet in a terminal
+	// state and are matched by the given filter, returning the result of
+	// each order's cancellation keyed by its nonce.
+	CancelAllOrders(ctx context.Context,
+		filter *CancelFilter) (map[Nonce]error, error)
+
+	// ReplaceOrder replaces the active order with the given nonce by a
+	// new order with the given parameters by canceling the old order and
+	// handing the new one to the submit function right away.
+	ReplaceOrder(ctx context.Context, oldNonce Nonce,
+		params *ReplaceParams,
+		submit func(Order, *terms.AuctioneerTerms) error) (Order, error)
+
+	// ActivateOrder sends the staged order with the given nonce to the
+	// auctioneer.
+	ActivateOrder(ctx context.Context, nonce Nonce) error
+
+	// ExpireOrders cancels all orders that expired at the given block
+	// height or time.
+	ExpireOrders(ctx context.Context, height uint32, now time.Time) error
 }
@@ -1,10 +1,13 @@
 package order
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/terms"
+	"github.com/stretchr/testify/require"
 )
 
 // TestOrderReservedValue checks orders' ReservedValue merhod returning the
@@ -237,6 +240,7 @@ func TestOrderReservedValue(t *testing.T) {
 				exeFee := executionFee(amt, simpleFeeSchedule)
 				chainFee := EstimateTraderFee(
 					1, o.MaxBatchFeeRate,
+					account.VersionInitialNoVersion,
 				)
 
 				// For bids the lump sum, chain fee  and the
@@ -275,6 +279,7 @@ func TestOrderReservedValue(t *testing.T) {
 				exeFee := executionFee(amt, simpleFeeSchedule)
 				chainFee := EstimateTraderFee(
 					1, o.MaxBatchFeeRate,
+					account.VersionInitialNoVersion,
 				)
 
 				// For asks the amount itself, the chain fee
@@ -307,3 +312,46 @@ func TestOrderReservedValue(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateLabel makes sure order labels are validated correctly and that
+// the label of an order doesn't influence its digest.
+func TestValidateLabel(t *testing.T) {
+	require.NoError(t, ValidateLabel(""))
+	require.NoError(t, ValidateLabel("lease for my ⚡ node"))
+	require.NoError(t, ValidateLabel(strings.Repeat("a", MaxLabelLength)))
+	require.ErrorIs(
+		t, ValidateLabel(strings.Repeat("a", MaxLabelLength+1)),
+		ErrLabelTooLong,
+	)
+	require.ErrorIs(t, ValidateLabel("\xff\xfe"), ErrLabelInvalidUTF8)
+
+	bid := &Bid{Kit: Kit{Version: VersionChannelType, Amt: 100_000}}
+	digest, err := bid.Digest()
+	require.NoError(t, err)
+
+	bid.Label = "some label"
+	labeledDigest, err := bid.Digest()
+	require.NoError(t, err)
+	require.Equal(t, digest, labeledDigest)
+}
+
+// TestDigestChannelFlags makes sure the unannounced and zero conf channel
+// flags are committed to in the digest of both asks and bids.
+func TestDigestChannelFlags(t *testing.T) {
+	kit := Kit{Version: VersionZeroConfChannel, Amt: 100_000}
+	orders := []Order{&Ask{Kit: kit}, &Bid{Kit: kit}}
+	for _, o := range orders {
+		digest, err := o.Digest()
+		require.NoError(t, err)
+
+		o.Details().UnannouncedChannel = true
+		unannouncedDigest, err := o.Digest()
+		require.NoError(t, err)
+		require.NotEqual(t, digest, unannouncedDigest)
+
+		o.Details().ZeroConfChannel = true
+		zeroConfDigest, err := o.Digest()
+		require.NoError(t, err)
+		require.NotEqual(t, unannouncedDigest, zeroConfDigest)
+	}
+}
@@ -11,12 +11,16 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/metrics"
+	"github.com/lightninglabs/pool/policy"
 	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/tor"
 )
@@ -83,6 +87,71 @@ type ManagerConfig struct {
 	// BatchVersion is the batch version that we should use to verify new
 	// batches against.
 	BatchVersion BatchVersion
+
+	// FetchTerms fetches the current terms from the auctioneer.
+	FetchTerms func(ctx context.Context) (*terms.AuctioneerTerms, error)
+
+	// SendOrder sends a prepared order to the auctioneer. This is used to
+	// resubmit recurring orders once they are fully executed.
+	SendOrder func(ctx context.Context, o Order,
+		params *ServerOrderParams) error
+
+	// CancelOrder cancels the order with the given nonce preimage with
+	// the auctioneer.
+	CancelOrder func(ctx context.Context,
+		noncePreimage lntypes.Preimage) error
+
+	// TermsStore is used to cache the last known auctioneer terms so we
+	// can still validate orders if the auctioneer is briefly unreachable.
+	TermsStore TermsStore
+
+	// TermsCacheTTL is the maximum age of the cached auctioneer terms
+	// before we warn about using stale terms.
+	TermsCacheTTL time.Duration
+
+	// ValidateExpiryExtension makes sure the new expiry the auctioneer
+	// proposes for one of our accounts as part of a batch is acceptable.
+	ValidateExpiryExtension func(acct *account.Account, newExpiry,
+		bestHeight uint32, terms *terms.AuctioneerTerms) error
+
+	// SubmitRateLimit is the maximum number of orders per minute that can
+	// be submitted on average. If this is zero, the rate of order
+	// submissions is not limited.
+	SubmitRateLimit float64
+
+	// SubmitBurst is the maximum number of orders that can be submitted
+	// at once without being rate limited.
+	SubmitBurst int
+
+	// DedupWindow is the duration after its submission an active order is
+	// considered a duplicate of a new order with the same economic
+	// parameters. If this is zero, duplicate orders are not detected.
+	DedupWindow time.Duration
+
+	// MaxAutoFeeRate is the maximum max batch fee rate an order with
+	// AutoUpdateFeeRate set is replaced with after a batch was rejected
+	// because of its fee rate. A value of zero means there is no limit.
+	MaxAutoFeeRate chainfee.SatPerKWeight
+
+	// FetchNodeTiers returns the tiers the auctioneer assigned to the
+	// given nodes. It is used to make sure our bids are only matched with
+	// asks of nodes in the requested minimum tier. If this is nil, the
+	// node tiers aren't checked.
+	FetchNodeTiers func(ctx context.Context,
+		nodeKeys [][33]byte) (map[[33]byte]NodeTier, error)
+
+	// NodeTierCacheTTL is the duration after which the cached tier of a
+	// node is fetched again.
+	NodeTierCacheTTL time.Duration
+
+	// Metrics is used to report the number of open orders and how long
+	// batch verifications take. If this is nil, nothing is reported.
+	Metrics metrics.Recorder
+
+	// EvaluateBatchPolicy checks a batch that is otherwise valid against
+	// the trader's batch policy. If this is nil, all valid batches are
+	// accepted.
+	EvaluateBatchPolicy func(b *policy.Batch) error
 }
 
 // manager is responsible for the management of orders.
@@ -105,6 +174,23 @@ type manager struct {
 	batchSigner   BatchSigner
 	batchStorer   BatchStorer
 	pendingBatch  *Batch
+
+	// reservations keeps track of the account balance reserved by orders
+	// that can still be matched. It is used to select an account for
+	// orders that don't specify one.
+	reservations *reservationLedger
+
+	// activateMtx makes sure a staged order is only activated once if it
+	// is activated manually and automatically at the same time.
+	activateMtx sync.Mutex
+
+	// feeBumpMtx makes sure the max batch fee rate of an order is only
+	// bumped once if multiple batches are rejected in quick succession.
+	feeBumpMtx sync.Mutex
+
+	// submitGuard limits the rate of order submissions and detects
+	// duplicate orders.
+	submitGuard *submitGuard
 }
 
 // Compile time assertion that manager implements the Manager interface.
@@ -112,9 +198,16 @@ var _ Manager = (*manager)(nil)
 
 // NewManager instantiates a new Manager backed by the given config.
 func NewManager(cfg *ManagerConfig) *manager { // nolint:golint
+	managerCfg := *cfg
+	managerCfg.Metrics = metrics.OrDisabled(cfg.Metrics)
+
 	return &manager{
-		cfg:  *cfg,
-		quit: make(chan struct{}),
+		cfg:          managerCfg,
+		quit:         make(chan struct{}),
+		reservations: newReservationLedger(),
+		submitGuard: newSubmitGuard(
+			cfg.SubmitRateLimit, cfg.SubmitBurst, cfg.DedupWindow,
+		),
 	}
 }
 
@@ -145,6 +238,18 @@ func (m *manager) Start() error {
 			wallet:        m.cfg.Wallet,
 			ourNodePubkey: m.ourNodeInfo.IdentityPubkey,
 			version:       m.cfg.BatchVersion,
+			fetchTerms: func() (*terms.AuctioneerTerms, error) {
+				ctxt, cancel := context.WithTimeout(
+					context.Background(),
+					DefaultBatchStepTimeout,
+				)
+				defer cancel()
+
+				return m.Terms(ctxt)
+			},
+			validateExpiry: m.cfg.ValidateExpiryExtension,
+			nodeTiers:      m.nodeTierLookup(),
+			evaluatePolicy: m.cfg.EvaluateBatchPolicy,
 		}
 		m.batchSigner = &batchSigner{
 			getAccount: m.cfg.AcctStore.Account,
@@ -155,6 +260,23 @@ func (m *manager) Start() error {
 			getAccount: m.cfg.AcctStore.Account,
 		}
 
+		// Load the balance reservations of all orders that can still
+		// be matched so we can select accounts for new orders.
+		err = m.reconcileReservations()
+		if err != nil {
+			return
+		}
+
+		// Staged orders with an activation fee rate are sent to the
+		// auctioneer once the chain fees are low enough.
+		m.wg.Add(1)
+		go m.watchStagedOrders()
+
+		if m.metricsEnabled() {
+			m.wg.Add(1)
+			go m.watchOrderMetrics()
+		}
+
 		atomic.StoreUint32(&m.isStarted, 1)
 	})
 	return err
@@ -170,6 +292,33 @@ func (m *manager) Stop() {
 	})
 }
 
+// QuoteOrder calculates the premium, execution fee and worst case chain fee
+// of the given order based on the current auctioneer terms. The order is
+// neither stored nor submitted.
+func (m *manager) QuoteOrder(ctx context.Context, order Order) (*Quote,
+	error) {
+
+	details := order.Details()
+	if details.MinUnitsMatch == 0 {
+		return nil, NewValidationError(
+			ValidationInvalidMinUnitsMatch, "min_units_match", 0,
+			errors.New("min units match must be greater than 0"),
+		)
+	}
+
+	// If the auctioneer can't be reached, the last known terms are used.
+	auctionTerms, err := m.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQuote(
+		details.Amt, details.MinUnitsMatch.ToSatoshis(),
+		FixedRatePremium(details.FixedRate), details.LeaseDuration,
+		details.MaxBatchFeeRate, auctionTerms.FeeSchedule(),
+	), nil
+}
+
 // PrepareOrder validates an order, signs it and then stores it locally.
 func (m *manager) PrepareOrder(ctx context.Context, order Order,
 	acct *account.Account,
@@ -181,6 +330,103 @@ func (m *manager) PrepareOrder(ctx context.Context, order Order,
 		return nil, err
 	}
 
+	params, err := m.orderParams(ctx, order, acct, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the order digest with the account key.
+	params.RawSig, err = m.signOrder(ctx, order, acct)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.storeOrder(order); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// PrepareUnsignedOrder validates an order and derives the multisig key for it,
+// but neither signs nor stores it. The returned digest of the order needs to
+// be signed with the trader key of the account outside of the daemon before
+// the order can be submitted with PrepareSignedOrder.
+func (m *manager) PrepareUnsignedOrder(ctx context.Context, order Order,
+	acct *account.Account,
+	terms *terms.AuctioneerTerms) ([hashSize]byte, error) {
+
+	var digest [hashSize]byte
+	if bid, ok := order.(*Bid); ok && bid.SidecarTicket != nil {
+		return digest, ErrOfflineSidecar
+	}
+
+	err := m.validateOrder(order, acct, terms)
+	if err != nil {
+		return digest, err
+	}
+
+	// We collect the server params now already, so we don't have the order
+	// signed offline just to find out it can't be submitted anyway.
+	if _, err := m.orderParams(ctx, order, acct, true); err != nil {
+		return digest, err
+	}
+
+	digest, err = order.Digest()
+	if err != nil {
+		return digest, fmt.Errorf("could not digest order: %v", err)
+	}
+
+	return digest, nil
+}
+
+// PrepareSignedOrder validates an order that was prepared with
+// PrepareUnsignedOrder and signed outside of the daemon, verifies its signature
+// against the trader key of the account and then stores it locally.
+func (m *manager) PrepareSignedOrder(ctx context.Context, order Order,
+	acct *account.Account, terms *terms.AuctioneerTerms,
+	sig []byte) (*ServerOrderParams, error) {
+
+	if bid, ok := order.(*Bid); ok && bid.SidecarTicket != nil {
+		return nil, ErrOfflineSidecar
+	}
+
+	err := m.validateOrder(order, acct, terms)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := order.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("could not digest order: %v", err)
+	}
+	err = VerifyDigestSig(acct.TraderKey.PubKey, digest, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	// The multisig key was already derived when the order was prepared, so
+	// we only look it up again.
+	params, err := m.orderParams(ctx, order, acct, false)
+	if err != nil {
+		return nil, err
+	}
+	params.RawSig = sig
+
+	if err := m.storeOrder(order); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// orderParams collects all information about the order the auctioneer needs,
+// except for its signature. Unless the order is a bid for a sidecar ticket, the
+// multisig key of the order's channels is either derived freshly or, if
+// deriveNext is false, looked up again by the key locator of the order.
+func (m *manager) orderParams(ctx context.Context, order Order,
+	acct *account.Account, deriveNext bool) (*ServerOrderParams, error) {
+
 	params := &ServerOrderParams{}
 	bid, isBid := order.(*Bid)
 	isSidecar := isBid && bid.SidecarTicket != nil
@@ -189,7 +435,8 @@ func (m *manager) PrepareOrder(ctx context.Context, order Order,
 	// the channel ourselves and are instead leasing a channel for another
 	// node. Therefore we need to add the other node's identity and multisig
 	// public key in the bid order we send to the auctioneer.
-	if isSidecar {
+	switch {
+	case isSidecar:
 		ticket := bid.SidecarTicket
 
 		// Make sure the sidecar ticket is in the correct state. It
@@ -219,7 +466,8 @@ func (m *manager) PrepareOrder(ctx context.Context, order Order,
 		// ticket too? Probably won't be used often unless set up with
 		// tor by default.
 		params.Addrs = nil
-	} else {
+
+	case deriveNext:
 		// Grab the additional information needed from our local node.
 		nextMultiSigKey, err := m.cfg.Wallet.DeriveNextKey(
 			ctx, int32(keychain.KeyFamilyMultiSig),
@@ -229,20 +477,28 @@ func (m *manager) PrepareOrder(ctx context.Context, order Order,
 				"key: %v", err)
 		}
 		order.Details().MultiSigKeyLocator = nextMultiSigKey.KeyLocator
-		copy(
-			params.MultiSigKey[:],
-			nextMultiSigKey.PubKey.SerializeCompressed(),
-		)
-		info, err := m.cfg.Lightning.GetInfo(ctx)
+
+		err = m.addNodeParams(ctx, params, nextMultiSigKey.PubKey)
 		if err != nil {
-			return nil, fmt.Errorf("unable to get local node "+
-				"info: %v", err)
+			return nil, err
+		}
+
+	default:
+		keyLocator := order.Details().MultiSigKeyLocator
+		if keyLocator.Family != keychain.KeyFamilyMultiSig {
+			return nil, fmt.Errorf("invalid multi sig key family %d",
+				keyLocator.Family)
 		}
-		params.NodePubkey = info.IdentityPubkey
-		params.Addrs, err = parseNodeUris(info.Uris)
+
+		multiSigKey, err := m.cfg.Wallet.DeriveKey(ctx, &keyLocator)
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse node uris: %v",
-				err)
+			return nil, fmt.Errorf("unable to derive multi sig "+
+				"key: %v", err)
+		}
+
+		err = m.addNodeParams(ctx, params, multiSigKey.PubKey)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -255,29 +511,98 @@ func (m *manager) PrepareOrder(ctx context.Context, order Order,
 			"ask order")
 	}
 
-	// Sign the order digest with the account key.
-	digest, err := order.Digest()
+	return params, nil
+}
+
+// storeOrder stores a prepared order in our local database and reserves the
+// account balance it requires.
+func (m *manager) storeOrder(order Order) error {
+	// There shouldn't be anything that can go wrong on our side, so store
+	// the pending order in our local database.
+	err := m.cfg.Store.SubmitOrder(order)
 	if err != nil {
-		return nil, fmt.Errorf("could not digest "+
+		return fmt.Errorf("unable to store "+
 			"order: %v", err)
 	}
-	params.RawSig, err = m.cfg.Signer.SignMessage(
+	m.reservations.add(order)
+	m.submitGuard.add(order.Nonce(), time.Now())
+
+	return nil
+}
+
+// addNodeParams adds the given multisig key and the identity and addresses of
+// our local node to the given server order params.
+func (m *manager) addNodeParams(ctx context.Context, params *ServerOrderParams,
+	multiSigKey *btcec.PublicKey) error {
+
+	copy(params.MultiSigKey[:], multiSigKey.SerializeCompressed())
+
+	info, err := m.cfg.Lightning.GetInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get local node info: %v", err)
+	}
+	params.NodePubkey = info.IdentityPubkey
+	params.Addrs, err = parseNodeUris(info.Uris)
+	if err != nil {
+		return fmt.Errorf("unable to parse node uris: %v", err)
+	}
+
+	return nil
+}
+
+// signOrder signs the digest of the given order with the key of the account
+// the order is submitted from.
+func (m *manager) signOrder(ctx context.Context, order Order,
+	acct *account.Account) ([]byte, error) {
+
+	digest, err := order.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("could not digest order: %v", err)
+	}
+	sig, err := m.cfg.Signer.SignMessage(
 		ctx, digest[:], acct.TraderKey.KeyLocator,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to sign "+
-			"order: %v", err)
+		return nil, fmt.Errorf("unable to sign order: %v", err)
 	}
 
-	// There shouldn't be anything that can go wrong on our side, so store
-	// the pending order in our local database.
-	err = m.cfg.Store.SubmitOrder(order)
-	if err != nil {
-		return nil, fmt.Errorf("unable to store "+
-			"order: %v", err)
+	return sig, nil
+}
+
+// Terms returns the current auctioneer terms. If the auctioneer can't be
+// reached, the last known terms are returned instead and marked as cached. A
+// warning is logged if the cached terms are older than the configured TTL.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) Terms(ctx context.Context) (*terms.AuctioneerTerms, error) {
+	liveTerms, err := m.cfg.FetchTerms(ctx)
+	if err == nil {
+		if err := m.cfg.TermsStore.StoreTerms(liveTerms); err != nil {
+			log.Errorf("Unable to cache auctioneer terms: %v", err)
+		}
+
+		return liveTerms, nil
 	}
 
-	return params, nil
+	cachedTerms, storedAt, cacheErr := m.cfg.TermsStore.CachedTerms()
+	if cacheErr != nil {
+		log.Debugf("Unable to read cached auctioneer terms: %v",
+			cacheErr)
+
+		return nil, fmt.Errorf("could not query auctioneer terms: %v",
+			err)
+	}
+
+	log.Warnf("Could not query auctioneer terms, using cached terms "+
+		"from %v instead: %v", storedAt, err)
+
+	if age := time.Since(storedAt); age > m.cfg.TermsCacheTTL {
+		log.Warnf("Cached auctioneer terms are %v old which exceeds "+
+			"the TTL of %v", age, m.cfg.TermsCacheTTL)
+	}
+
+	cachedTerms.CachedAt = storedAt
+	return cachedTerms, nil
 }
 
 // validateOrder makes sure an order is formally correct and that the associated
@@ -285,35 +610,123 @@ func (m *manager) PrepareOrder(ctx context.Context, order Order,
 func (m *manager) validateOrder(order Order, acct *account.Account,
 	terms *terms.AuctioneerTerms) error {
 
+	if terms.IsCached() {
+		log.Warnf("Validating order %v against cached auctioneer "+
+			"terms from %v", order.Nonce(), terms.CachedAt)
+	}
+
 	duration := order.Details().LeaseDuration
 	_, ok := terms.LeaseDurationBuckets[duration]
 	if !ok {
-		return fmt.Errorf("invalid lease duration, must be one of %v",
-			terms.LeaseDurationBuckets)
+		return NewValidationError(
+			ValidationInvalidLeaseDuration, "lease_duration_blocks",
+			duration, fmt.Errorf("invalid lease duration, must "+
+				"be one of %v", terms.LeaseDurationBuckets),
+		)
 	}
 
-	if order.Details().MaxBatchFeeRate < chainfee.FeePerKwFloor {
-		return fmt.Errorf("invalid max batch fee rate %v, must be "+
-			"greater than %v", order.Details().MaxBatchFeeRate,
-			chainfee.FeePerKwFloor)
+	maxBatchFeeRate := order.Details().MaxBatchFeeRate
+	if maxBatchFeeRate < chainfee.FeePerKwFloor {
+		return NewValidationError(
+			ValidationInvalidMaxBatchFeeRate,
+			"max_batch_fee_rate_sat_per_kw", uint64(maxBatchFeeRate),
+			fmt.Errorf("invalid max batch fee rate %v, must be "+
+				"greater than %v", maxBatchFeeRate,
+				chainfee.FeePerKwFloor),
+		)
+	}
+
+	if err := order.Details().ValidateNodeIDs(); err != nil {
+		return NewValidationError(
+			ValidationInvalidNodeIDs, "allowed_node_ids", nil, err,
+		)
+	}
+
+	if err := order.Details().ValidateChannelType(terms); err != nil {
+		return NewValidationError(
+			ValidationInvalidChannelType, "channel_type",
+			order.Details().ChannelType, err,
+		)
+	}
+
+	if order.Details().UnannouncedChannel {
+		err := order.Details().ValidateUnannouncedChannel()
+		if err != nil {
+			return NewValidationError(
+				ValidationInvalidChannelFlags,
+				"unannounced_channel", true, err,
+			)
+		}
+	}
+
+	if order.Details().ZeroConfChannel {
+		err := order.Details().ValidateZeroConfChannel(terms)
+		if err != nil {
+			return NewValidationError(
+				ValidationInvalidChannelFlags,
+				"zero_conf_channel", true, err,
+			)
+		}
 	}
 
 	// Check all conditions that come with the use of the self chan balance.
 	bid, isBid := order.(*Bid)
 	if isBid && bid.SelfChanBalance > 0 {
-		if err := bid.ValidateSelfChanBalance(); err != nil {
-			return err
+		if err := bid.ValidateSelfChanBalance(terms); err != nil {
+			return NewValidationError(
+				ValidationInvalidSelfChanBalance,
+				"self_chan_balance", uint64(bid.SelfChanBalance),
+				err,
+			)
+		}
+	}
+
+	// The sidecar ticket is signed for the multisig key and node of the
+	// recipient, which we can't restore when activating a staged order.
+	if order.Details().State == StateStaged && isBid &&
+		bid.SidecarTicket != nil {
+
+		return NewValidationError(
+			ValidationInvalidSidecar, "sidecar_ticket", nil,
+			fmt.Errorf("bids for sidecar tickets cannot be staged"),
+		)
+	}
+
+	// A sidecar ticket can only be used for a single bid, so such bids
+	// can't be resubmitted.
+	if order.Details().Recurrence != nil && isBid &&
+		bid.SidecarTicket != nil {
+
+		return NewValidationError(
+			ValidationInvalidSidecar, "sidecar_ticket", nil,
+			fmt.Errorf("bids for sidecar tickets cannot be "+
+				"recurring"),
+		)
+	}
+
+	// Check all conditions that come with the use of min distinct peers.
+	ask, isAsk := order.(*Ask)
+	if isAsk && ask.MinDistinctPeers > 0 {
+		if err := ask.ValidateMinDistinctPeers(); err != nil {
+			return NewValidationError(
+				ValidationInvalidMinDistinctPeers,
+				"min_distinct_peers", ask.MinDistinctPeers, err,
+			)
 		}
 	}
 
-	// Get all existing orders.
-	dbOrders, err := m.cfg.Store.GetOrders()
+	// Get all existing orders that can still be matched. Archived orders
+	// don't reserve any balance, so there's no need to read them.
+	dbOrders, err := m.cfg.Store.GetOrdersByState(
+		StateSubmitted, StateCleared, StatePartiallyFilled,
+		StateStaged,
+	)
 	if err != nil {
 		return err
 	}
 
 	// Ensure the total reserved value won't be larger than the account
-	// value when adding this order.
+	// value minus its reserve when adding this order.
 	var acctKey [33]byte
 	copy(acctKey[:], acct.TraderKey.PubKey.SerializeCompressed())
 	feeSchedule := terms.FeeSchedule()
@@ -328,8 +741,13 @@ func (m *manager) validateOrder(order Order, acct *account.Account,
 		reserved += o.ReservedValue(feeSchedule)
 	}
 
-	if acct.Value < reserved {
-		return ErrInsufficientBalance
+	// Orders may never spend into the account's reserve, even if they're
+	// all matched under the worst case fee conditions.
+	if acct.Value < reserved+acct.Reserve {
+		return NewValidationError(
+			ValidationInsufficientBalance, "amt",
+			uint64(order.Details().Amt), ErrInsufficientBalance,
+		)
 	}
 
 	return nil
@@ -339,34 +757,27 @@ func (m *manager) validateOrder(order Order, acct *account.Account,
 func (m *manager) OrderMatchValidate(batch *Batch, bestHeight uint32) error {
 	// Make sure we have no objection to the current batch. Then store
 	// it in case it ends up being the final version.
+	start := time.Now()
 	err := m.batchVerifier.Verify(batch, bestHeight)
+	m.cfg.Metrics.ObserveBatchVerification(time.Since(start))
 	if err != nil {
+		// Orders that opted in to automatic fee rate updates are
+		// replaced in the background, as that involves talking to
+		// our lnd node and the auctioneer.
+		var feeRateErr *BatchFeeRateErr
+		if errors.As(err, &feeRateErr) {
+			m.wg.Add(1)
+			go m.bumpFeeRates(
+				feeRateErr.Nonces, feeRateErr.BatchFeeRate,
+			)
+		}
+
 		// This error will lead to us sending an OrderMatchReject
 		// message and canceling all funding shims we might already have
 		// set up.
 		return fmt.Errorf("error validating batch: %w", err)
 	}
 
-	// Verify that the id of the matched node was not filtered out.
-	for nonce, matches := range batch.MatchedOrders {
-		o, err := m.cfg.Store.GetOrder(nonce)
-		if err != nil {
-			return fmt.Errorf("error validating matched orders: %w",
-				err)
-		}
-
-		for _, match := range matches {
-			isValidMatch := IsNodeIDAValidMatch(
-				match.NodeKey, o.Details().AllowedNodeIDs,
-				o.Details().NotAllowedNodeIDs,
-			)
-			if !isValidMatch {
-				return fmt.Errorf("invalid match with %x in "+
-					"order %x", match.NodeKey, nonce)
-			}
-		}
-	}
-
 	m.pendingBatch = batch
 	atomic.StoreUint32(&m.hasPendingBatch, 1)
 
@@ -384,21 +795,21 @@ func (m *manager) PendingBatch() *Batch {
 }
 
 // BatchSign returns the witness stack of all account inputs in a batch that
-// belong to the trader. Before sending off the signature to the auctioneer,
-// we'll also persist the batch to disk as pending to ensure we can recover
-// after a crash.
-func (m *manager) BatchSign() (BatchSignature, error) {
-	sig, err := m.batchSigner.Sign(m.pendingBatch)
+// belong to the trader, along with our MuSig2 nonces for all taproot accounts.
+// Before sending off the signature to the auctioneer, we'll also persist the
+// batch to disk as pending to ensure we can recover after a crash.
+func (m *manager) BatchSign() (BatchSignature, AccountNonces, error) {
+	sig, nonces, err := m.batchSigner.Sign(m.pendingBatch)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	err = m.batchStorer.StorePendingBatch(m.pendingBatch)
 	if err != nil {
-		return nil, fmt.Errorf("unable to store batch: %v", err)
+		return nil, nil, fmt.Errorf("unable to store batch: %v", err)
 	}
 
-	return sig, nil
+	return sig, nonces, nil
 }
 
 // BatchFinalize marks a batch as complete upon receiving the finalize message
@@ -413,13 +824,31 @@ func (m *manager) BatchFinalize(batchID BatchID) error {
 
 	// Create a diff and then persist that. Finally signal that we are ready
 	// for the next batch by removing the current pending batch.
-	if err := m.batchStorer.MarkBatchComplete(); err != nil {
+	if err := m.batchStorer.MarkBatchComplete(batchID); err != nil {
 		return fmt.Errorf("unable to mark batch as complete: %v", err)
 	}
 
+	matchedNonces := make([]Nonce, 0, len(m.pendingBatch.MatchedOrders))
+	for nonce := range m.pendingBatch.MatchedOrders {
+		matchedNonces = append(matchedNonces, nonce)
+	}
+
 	m.pendingBatch = nil
 	atomic.StoreUint32(&m.hasPendingBatch, 0)
 
+	// The batch changed the unfulfilled units or state of the matched
+	// orders, so the reserved balances need to be updated.
+	if err := m.reconcileReservations(); err != nil {
+		log.Errorf("Unable to reconcile reserved balances: %v", err)
+	}
+	m.updateOrderMetrics()
+
+	// Recurring orders that were fully executed by this batch are
+	// resubmitted in the background, as that involves talking to our lnd
+	// node and the auctioneer.
+	m.wg.Add(1)
+	go m.resubmitRecurringOrders(matchedNonces)
+
 	return nil
 }
 
@@ -2,8 +2,11 @@ package order
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/auctioneerrpc"
@@ -98,6 +101,23 @@ func TestValidateOrderAccountIsolation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("order A failed validation: %v", err)
 	}
+
+	// The order may use all of account A's balance up to its reserve, but
+	// not a single satoshi of the reserve itself.
+	reserved := orderA.ReservedValue(testTerms.FeeSchedule())
+	accountA.Reserve = accountA.Value - reserved
+	err = orderManager.validateOrder(orderA, &accountA, testTerms)
+	if err != nil {
+		t.Fatalf("order A failed validation: %v", err)
+	}
+
+	accountA.Reserve++
+	err = orderManager.validateOrder(orderA, &accountA, testTerms)
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	var valErr *ValidationError
+	require.ErrorAs(t, err, &valErr)
+	require.Equal(t, ValidationInsufficientBalance, valErr.Code)
 }
 
 // TestValidateOrder makes sure that the order manager properly validates
@@ -128,10 +148,21 @@ func TestValidateOrder(t *testing.T) {
 		},
 	}
 
+	largeRatioTerms := *testTerms
+	largeRatioTerms.MaxSelfChanBalanceRatio = 3_000_000
+
+	scriptEnforcedTerms := *testTerms
+	scriptEnforcedTerms.ChannelTypes = []auctioneerrpc.OrderChannelType{
+		auctioneerrpc.OrderChannelType_ORDER_CHANNEL_TYPE_SCRIPT_ENFORCED,
+	}
+
+	tooManyNodeIDs := make([][33]byte, MaxNodeIDs+1)
+
 	testCases := []struct {
 		name        string
 		expectedErr string
 		order       Order
+		terms       *terms.AuctioneerTerms
 	}{{
 		name:        "invalid lease duration",
 		expectedErr: "invalid lease duration, must be one of map[144",
@@ -161,6 +192,84 @@ func TestValidateOrder(t *testing.T) {
 				UnitsUnfulfilled: 1_000,
 			},
 		},
+	}, {
+		name:        "both node id lists set",
+		expectedErr: ErrBothNodeIDLists.Error(),
+		order: &Ask{
+			Kit: Kit{
+				LeaseDuration:     144,
+				MaxBatchFeeRate:   253,
+				AllowedNodeIDs:    [][33]byte{{0x02}},
+				NotAllowedNodeIDs: [][33]byte{{0x03}},
+			},
+		},
+	}, {
+		name:        "too many node ids",
+		expectedErr: ErrTooManyNodeIDs.Error(),
+		order: &Ask{
+			Kit: Kit{
+				LeaseDuration:     144,
+				MaxBatchFeeRate:   253,
+				NotAllowedNodeIDs: tooManyNodeIDs,
+			},
+		},
+	}, {
+		name:        "unknown channel type",
+		expectedErr: "unknown channel type unknown<7>",
+		order: &Ask{
+			Kit: Kit{
+				LeaseDuration:   144,
+				MaxBatchFeeRate: 253,
+				ChannelType:     7,
+			},
+		},
+	}, {
+		name: "channel type not supported",
+		expectedErr: "channel type peer_dependent is not supported " +
+			"by the auctioneer",
+		order: &Ask{
+			Kit: Kit{
+				LeaseDuration:   144,
+				MaxBatchFeeRate: 253,
+			},
+		},
+		terms: &scriptEnforcedTerms,
+	}, {
+		name: "invalid version for zero conf channel",
+		expectedErr: "cannot use zero conf channel with old order " +
+			"version",
+		order: &Bid{
+			Kit: Kit{
+				Version:         VersionUnannouncedChannel,
+				LeaseDuration:   144,
+				MaxBatchFeeRate: 253,
+				ZeroConfChannel: true,
+			},
+		},
+	}, {
+		name: "zero conf channel not supported",
+		expectedErr: "zero conf channels are not supported by the " +
+			"auctioneer",
+		order: &Bid{
+			Kit: Kit{
+				Version:         VersionZeroConfChannel,
+				LeaseDuration:   144,
+				MaxBatchFeeRate: 253,
+				ZeroConfChannel: true,
+			},
+		},
+	}, {
+		name: "invalid version for unannounced channel",
+		expectedErr: "cannot use unannounced channel with old order " +
+			"version",
+		order: &Bid{
+			Kit: Kit{
+				Version:            VersionMinDistinctPeers,
+				LeaseDuration:      144,
+				MaxBatchFeeRate:    253,
+				UnannouncedChannel: true,
+			},
+		},
 	}, {
 		name: "invalid version for self chan balance",
 		expectedErr: "cannot use self chan balance with old order " +
@@ -191,8 +300,8 @@ func TestValidateOrder(t *testing.T) {
 		},
 	}, {
 		name: "invalid self chan balance",
-		expectedErr: "self channel balance must be smaller than " +
-			"or equal to capacity",
+		expectedErr: "invalid self chan balance: 0.00100001 BTC " +
+			"exceeds the maximum of 0.001 BTC",
 		order: &Bid{
 			Kit: Kit{
 				Version:          VersionSelfChanBalance,
@@ -204,6 +313,39 @@ func TestValidateOrder(t *testing.T) {
 			},
 			SelfChanBalance: 100_001,
 		},
+	}, {
+		name: "self chan balance above announced ratio",
+		expectedErr: "invalid self chan balance: 0.00300001 BTC " +
+			"exceeds the maximum of 0.003 BTC",
+		order: &Bid{
+			Kit: Kit{
+				Version:          VersionSelfChanBalance,
+				LeaseDuration:    144,
+				MaxBatchFeeRate:  253,
+				MinUnitsMatch:    1,
+				Amt:              100_000,
+				UnitsUnfulfilled: 1,
+				Units:            1,
+			},
+			SelfChanBalance: 300_001,
+		},
+		terms: &largeRatioTerms,
+	}, {
+		name:        "self chan balance larger than amount",
+		expectedErr: "",
+		order: &Bid{
+			Kit: Kit{
+				Version:          VersionSelfChanBalance,
+				LeaseDuration:    144,
+				MaxBatchFeeRate:  253,
+				MinUnitsMatch:    1,
+				Amt:              100_000,
+				UnitsUnfulfilled: 1,
+				Units:            1,
+			},
+			SelfChanBalance: 300_000,
+		},
+		terms: &largeRatioTerms,
 	}, {
 		name: "min units match must equal amount",
 		expectedErr: "to use self chan balance the min units match " +
@@ -219,6 +361,53 @@ func TestValidateOrder(t *testing.T) {
 			},
 			SelfChanBalance: 50_000,
 		},
+	}, {
+		name: "invalid version for min distinct peers",
+		expectedErr: "cannot use min distinct peers with old order " +
+			"version",
+		order: &Ask{
+			Kit: Kit{
+				Version:          VersionChannelType,
+				LeaseDuration:    144,
+				MaxBatchFeeRate:  253,
+				MinUnitsMatch:    1,
+				Amt:              500_000,
+				Units:            5,
+				UnitsUnfulfilled: 5,
+			},
+			MinDistinctPeers: 5,
+		},
+	}, {
+		name: "order too small for min distinct peers",
+		expectedErr: "order of 5 units cannot be matched with 3 " +
+			"distinct peers of at least 2 units each",
+		order: &Ask{
+			Kit: Kit{
+				Version:          VersionMinDistinctPeers,
+				LeaseDuration:    144,
+				MaxBatchFeeRate:  253,
+				MinUnitsMatch:    2,
+				Amt:              500_000,
+				Units:            5,
+				UnitsUnfulfilled: 5,
+			},
+			MinDistinctPeers: 3,
+		},
+	}, {
+		name:        "happy path min distinct peers",
+		expectedErr: "",
+		order: &Ask{
+			Kit: Kit{
+				Version:          VersionMinDistinctPeers,
+				LeaseDuration:    144,
+				MaxBatchFeeRate:  253,
+				MinUnitsMatch:    1,
+				Amt:              500_000,
+				Units:            5,
+				UnitsUnfulfilled: 5,
+			},
+			MinDistinctPeers: 5,
+		},
 	}, {
 		name:        "happy path",
 		expectedErr: "",
@@ -240,8 +429,13 @@ func TestValidateOrder(t *testing.T) {
 		tc := tc
 
 		t.Run(tc.name, func(t *testing.T) {
+			auctionTerms := testTerms
+			if tc.terms != nil {
+				auctionTerms = tc.terms
+			}
+
 			err := orderManager.validateOrder(
-				tc.order, testAccount, testTerms,
+				tc.order, testAccount, auctionTerms,
 			)
 
 			if tc.expectedErr == "" {
@@ -256,6 +450,112 @@ func TestValidateOrder(t *testing.T) {
 
 type mockAccountStore struct {
 	account.Store
+
+	accounts []*account.Account
+}
+
+func (s *mockAccountStore) Accounts() ([]*account.Account, error) {
+	return s.accounts, nil
+}
+
+func (s *mockAccountStore) Account(
+	traderKey *btcec.PublicKey) (*account.Account, error) {
+
+	for _, acct := range s.accounts {
+		if acct.TraderKey.PubKey.IsEqual(traderKey) {
+			return acct, nil
+		}
+	}
+
+	return nil, errors.New("account not found")
+}
+
+// TestPrepareSignedOrder makes sure an order can be prepared without being
+// signed and is only accepted with a valid signature of the trader key.
+func TestPrepareSignedOrder(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	mgr := NewManager(&ManagerConfig{
+		Store:        store,
+		AcctStore:    &mockAccountStore{},
+		Wallet:       test.NewMockWalletKit(),
+		Lightning:    test.NewMockLightning(),
+		Signer:       test.NewMockSigner(),
+		BatchVersion: LatestBatchVersion,
+	})
+	require.NoError(t, mgr.Start())
+	defer mgr.Stop()
+
+	traderKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	acct := &account.Account{
+		Value: btcutil.SatoshiPerBitcoin,
+		TraderKey: &keychain.KeyDescriptor{
+			PubKey: traderKey.PubKey(),
+		},
+	}
+	var acctKey [33]byte
+	copy(acctKey[:], acct.TraderKey.PubKey.SerializeCompressed())
+	bid := &Bid{
+		Kit: newKitFromTemplate(Nonce{0x01}, &Kit{
+			Amt:             5_000_000,
+			State:           StateSubmitted,
+			AcctKey:         acctKey,
+			MaxBatchFeeRate: 1000,
+			LeaseDuration:   2016,
+			MinUnitsMatch:   50,
+		}),
+	}
+	testTerms := &terms.AuctioneerTerms{
+		OrderExecBaseFee: 1,
+		OrderExecFeeRate: 100,
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+		},
+	}
+
+	ctx := context.Background()
+	digest, err := mgr.PrepareUnsignedOrder(ctx, bid, acct, testTerms)
+	require.NoError(t, err)
+	require.Equal(
+		t, keychain.KeyFamilyMultiSig,
+		bid.MultiSigKeyLocator.Family,
+	)
+	require.Empty(t, store.orders)
+
+	// A signature of another key is rejected and the order not stored.
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	badSig, err := SignDigest(otherKey, digest)
+	require.NoError(t, err)
+	_, err = mgr.PrepareSignedOrder(ctx, bid, acct, testTerms, badSig)
+	require.ErrorIs(t, err, ErrInvalidOrderSig)
+	require.Empty(t, store.orders)
+
+	sig, err := SignDigest(traderKey, digest)
+	require.NoError(t, err)
+	params, err := mgr.PrepareSignedOrder(ctx, bid, acct, testTerms, sig)
+	require.NoError(t, err)
+	require.Equal(t, sig, params.RawSig)
+	require.Contains(t, store.orders, bid.Nonce())
+
+	// The multisig key is the one derived while preparing the order.
+	multiSigKey, err := mgr.cfg.Wallet.DeriveKey(
+		ctx, &bid.MultiSigKeyLocator,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, multiSigKey.PubKey.SerializeCompressed(),
+		params.MultiSigKey[:],
+	)
+
+	// Bids for sidecar tickets can't be signed offline at all.
+	bid.SidecarTicket = &sidecar.Ticket{}
+	_, err = mgr.PrepareUnsignedOrder(ctx, bid, acct, testTerms)
+	require.ErrorIs(t, err, ErrOfflineSidecar)
+	_, err = mgr.PrepareSignedOrder(ctx, bid, acct, testTerms, sig)
+	require.ErrorIs(t, err, ErrOfflineSidecar)
 }
 
 // TestPrepareOrderSidecarTicket makes sure that a bid order with a sidecar
@@ -266,6 +566,7 @@ func TestPrepareOrderSidecarTicket(t *testing.T) {
 	mockLightning := test.NewMockLightning()
 	mockSigner := test.NewMockSigner()
 	mgr := NewManager(&ManagerConfig{
+		Store:        newMockStore(),
 		AcctStore:    &mockAccountStore{},
 		Wallet:       test.NewMockWalletKit(),
 		Lightning:    mockLightning,
@@ -399,3 +700,58 @@ func TestPrepareOrderSidecarTicket(t *testing.T) {
 		}
 	}
 }
+
+// TestTermsCacheFallback makes sure the order manager caches the auctioneer
+// terms and falls back to the cached copy if they can't be fetched.
+func TestTermsCacheFallback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newMockStore()
+	liveTerms := &terms.AuctioneerTerms{
+		MaxAccountValue: btcutil.SatoshiPerBitcoin,
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+		},
+	}
+
+	var fetchErr error
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			if fetchErr != nil {
+				return nil, fetchErr
+			}
+			return liveTerms, nil
+		},
+		TermsStore:    store,
+		TermsCacheTTL: time.Hour,
+	})
+
+	// Without any cached terms, a failed fetch is an error.
+	fetchErr = errors.New("auctioneer unreachable")
+	_, err := mgr.Terms(ctx)
+	require.ErrorContains(t, err, fetchErr.Error())
+
+	// A successful fetch returns the live terms and caches them.
+	fetchErr = nil
+	fetchedTerms, err := mgr.Terms(ctx)
+	require.NoError(t, err)
+	require.Equal(t, liveTerms, fetchedTerms)
+	require.False(t, fetchedTerms.IsCached())
+	require.Equal(t, liveTerms, store.terms)
+
+	// Once the auctioneer is unreachable again, the cached terms are used
+	// and marked as such.
+	fetchErr = errors.New("auctioneer unreachable")
+	cachedTerms, err := mgr.Terms(ctx)
+	require.NoError(t, err)
+	require.True(t, cachedTerms.IsCached())
+	require.Equal(t, store.termsStoredAt, cachedTerms.CachedAt)
+	require.Equal(
+		t, liveTerms.LeaseDurationBuckets,
+		cachedTerms.LeaseDurationBuckets,
+	)
+}
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"time"
+
+	"github.com/lightninglabs/pool/metrics"
+)
+
+const (
+	// orderMetricsInterval is the interval in which the number of open
+	// orders is reported, in addition to after each batch.
+	orderMetricsInterval = time.Minute
+)
+
+var (
+	// openStates are all order states that aren't archived.
+	openStates = []State{
+		StateSubmitted, StateCleared, StatePartiallyFilled, StateStaged,
+	}
+)
+
+// metricsEnabled returns true if the manager reports its state to an actual
+// metrics recorder. We don't want to query the database just to discard the
+// results otherwise.
+func (m *manager) metricsEnabled() bool {
+	return m.cfg.Metrics != metrics.Disabled
+}
+
+// updateOrderMetrics reports the number of open orders per state.
+func (m *manager) updateOrderMetrics() {
+	if !m.metricsEnabled() {
+		return
+	}
+
+	dbOrders, err := m.cfg.Store.GetOrdersByState(openStates...)
+	if err != nil {
+		log.Errorf("Unable to fetch open orders for metrics: %v", err)
+		return
+	}
+
+	counts := make(map[string]int, len(openStates))
+	for _, state := range openStates {
+		counts[state.String()] = 0
+	}
+	for _, o := range dbOrders {
+		counts[o.Details().State.String()]++
+	}
+
+	m.cfg.Metrics.SetOrderCounts(counts)
+}
+
+// watchOrderMetrics periodically reports the number of open orders so orders
+// that are submitted, canceled or expire between batches are picked up too.
+//
+// NOTE: This method must be called as a goroutine.
+func (m *manager) watchOrderMetrics() {
+	defer m.wg.Done()
+
+	m.updateOrderMetrics()
+
+	ticker := time.NewTicker(orderMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.updateOrderMetrics()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/pool/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRecorder is a metrics recorder that only remembers the last order counts
+// it was given.
+type mockRecorder struct {
+	metrics.Recorder
+
+	orderCounts map[string]int
+}
+
+// SetOrderCounts stores the given order counts.
+func (r *mockRecorder) SetOrderCounts(counts map[string]int) {
+	r.orderCounts = counts
+}
+
+// TestUpdateOrderMetrics makes sure only open orders are counted and that all
+// open states are reported, even if they have no orders.
+func TestUpdateOrderMetrics(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	recorder := &mockRecorder{}
+	m := NewManager(&ManagerConfig{
+		Store:   store,
+		Metrics: recorder,
+	})
+
+	addOrder := func(nonce Nonce, state State) {
+		require.NoError(t, store.SubmitOrder(&Bid{
+			Kit: newKitFromTemplate(nonce, &Kit{State: state}),
+		}))
+	}
+	addOrder(Nonce{1}, StateSubmitted)
+	addOrder(Nonce{2}, StateSubmitted)
+	addOrder(Nonce{3}, StatePartiallyFilled)
+	addOrder(Nonce{4}, StateExecuted)
+	addOrder(Nonce{5}, StateCanceled)
+
+	m.updateOrderMetrics()
+	require.Equal(t, map[string]int{
+		"submitted":        2,
+		"cleared":          0,
+		"partially_filled": 1,
+		"staged":           0,
+	}, recorder.orderCounts)
+}
+
+// TestOrderMetricsDisabled makes sure the database isn't queried if metrics
+// are disabled.
+func TestOrderMetricsDisabled(t *testing.T) {
+	t.Parallel()
+
+	m := NewManager(&ManagerConfig{})
+	require.False(t, m.metricsEnabled())
+
+	// The store is nil, so this would panic if it queried the orders.
+	m.updateOrderMetrics()
+}
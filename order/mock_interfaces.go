@@ -7,6 +7,7 @@ package order
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	btcutil "github.com/btcsuite/btcd/btcutil"
 	gomock "github.com/golang/mock/gomock"
@@ -145,6 +146,34 @@ func (mr *MockStoreMockRecorder) DeleteOrder(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockStore)(nil).DeleteOrder), arg0)
 }
 
+// DeleteOrderTemplate mocks base method.
+func (m *MockStore) DeleteOrderTemplate(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrderTemplate", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrderTemplate indicates an expected call of DeleteOrderTemplate.
+func (mr *MockStoreMockRecorder) DeleteOrderTemplate(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrderTemplate", reflect.TypeOf((*MockStore)(nil).DeleteOrderTemplate), name)
+}
+
+// ExpireOrders mocks base method.
+func (m *MockStore) ExpireOrders(arg0 []Nonce) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOrders", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireOrders indicates an expected call of ExpireOrders.
+func (mr *MockStoreMockRecorder) ExpireOrders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOrders", reflect.TypeOf((*MockStore)(nil).ExpireOrders), arg0)
+}
+
 // GetOrder mocks base method.
 func (m *MockStore) GetOrder(arg0 Nonce) (Order, error) {
 	m.ctrl.T.Helper()
@@ -175,18 +204,95 @@ func (mr *MockStoreMockRecorder) GetOrders() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockStore)(nil).GetOrders))
 }
 
+// GetOrdersByState mocks base method.
+func (m *MockStore) GetOrdersByState(arg0 ...State) ([]Order, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrdersByState", varargs...)
+	ret0, _ := ret[0].([]Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrdersByState indicates an expected call of GetOrdersByState.
+func (mr *MockStoreMockRecorder) GetOrdersByState(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersByState", reflect.TypeOf((*MockStore)(nil).GetOrdersByState), arg0...)
+}
+
+// ListOrderTemplates mocks base method.
+func (m *MockStore) ListOrderTemplates() ([]*Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrderTemplates")
+	ret0, _ := ret[0].([]*Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrderTemplates indicates an expected call of ListOrderTemplates.
+func (mr *MockStoreMockRecorder) ListOrderTemplates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrderTemplates", reflect.TypeOf((*MockStore)(nil).ListOrderTemplates))
+}
+
 // MarkBatchComplete mocks base method.
-func (m *MockStore) MarkBatchComplete() error {
+func (m *MockStore) MarkBatchComplete(arg0 BatchID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkBatchComplete")
+	ret := m.ctrl.Call(m, "MarkBatchComplete", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkBatchComplete indicates an expected call of MarkBatchComplete.
-func (mr *MockStoreMockRecorder) MarkBatchComplete() *gomock.Call {
+func (mr *MockStoreMockRecorder) MarkBatchComplete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBatchComplete", reflect.TypeOf((*MockStore)(nil).MarkBatchComplete), arg0)
+}
+
+// OrderTemplate mocks base method.
+func (m *MockStore) OrderTemplate(name string) (*Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderTemplate", name)
+	ret0, _ := ret[0].(*Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderTemplate indicates an expected call of OrderTemplate.
+func (mr *MockStoreMockRecorder) OrderTemplate(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderTemplate", reflect.TypeOf((*MockStore)(nil).OrderTemplate), name)
+}
+
+// StoreFeeRateBump mocks base method.
+func (m *MockStore) StoreFeeRateBump(arg0 *FeeRateBump) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreFeeRateBump", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreFeeRateBump indicates an expected call of StoreFeeRateBump.
+func (mr *MockStoreMockRecorder) StoreFeeRateBump(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBatchComplete", reflect.TypeOf((*MockStore)(nil).MarkBatchComplete))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreFeeRateBump", reflect.TypeOf((*MockStore)(nil).StoreFeeRateBump), arg0)
+}
+
+// StoreOrderTemplate mocks base method.
+func (m *MockStore) StoreOrderTemplate(arg0 *Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreOrderTemplate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreOrderTemplate indicates an expected call of StoreOrderTemplate.
+func (mr *MockStoreMockRecorder) StoreOrderTemplate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreOrderTemplate", reflect.TypeOf((*MockStore)(nil).StoreOrderTemplate), arg0)
 }
 
 // StorePendingBatch mocks base method.
@@ -250,6 +356,75 @@ func (mr *MockStoreMockRecorder) UpdateOrders(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrders", reflect.TypeOf((*MockStore)(nil).UpdateOrders), arg0, arg1)
 }
 
+// ValidatePendingBatch mocks base method.
+func (m *MockStore) ValidatePendingBatch(arg0 *Batch, orders []Nonce, orderModifiers [][]Modifier, accounts []*account.Account, accountModifiers [][]account.Modifier) ([]Order, []*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePendingBatch", arg0, orders, orderModifiers, accounts, accountModifiers)
+	ret0, _ := ret[0].([]Order)
+	ret1, _ := ret[1].([]*account.Account)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ValidatePendingBatch indicates an expected call of ValidatePendingBatch.
+func (mr *MockStoreMockRecorder) ValidatePendingBatch(arg0, orders, orderModifiers, accounts, accountModifiers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePendingBatch", reflect.TypeOf((*MockStore)(nil).ValidatePendingBatch), arg0, orders, orderModifiers, accounts, accountModifiers)
+}
+
+// MockTermsStore is a mock of TermsStore interface.
+type MockTermsStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTermsStoreMockRecorder
+}
+
+// MockTermsStoreMockRecorder is the mock recorder for MockTermsStore.
+type MockTermsStoreMockRecorder struct {
+	mock *MockTermsStore
+}
+
+// NewMockTermsStore creates a new mock instance.
+func NewMockTermsStore(ctrl *gomock.Controller) *MockTermsStore {
+	mock := &MockTermsStore{ctrl: ctrl}
+	mock.recorder = &MockTermsStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTermsStore) EXPECT() *MockTermsStoreMockRecorder {
+	return m.recorder
+}
+
+// CachedTerms mocks base method.
+func (m *MockTermsStore) CachedTerms() (*terms.AuctioneerTerms, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CachedTerms")
+	ret0, _ := ret[0].(*terms.AuctioneerTerms)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CachedTerms indicates an expected call of CachedTerms.
+func (mr *MockTermsStoreMockRecorder) CachedTerms() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CachedTerms", reflect.TypeOf((*MockTermsStore)(nil).CachedTerms))
+}
+
+// StoreTerms mocks base method.
+func (m *MockTermsStore) StoreTerms(arg0 *terms.AuctioneerTerms) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreTerms", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreTerms indicates an expected call of StoreTerms.
+func (mr *MockTermsStoreMockRecorder) StoreTerms(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTerms", reflect.TypeOf((*MockTermsStore)(nil).StoreTerms), arg0)
+}
+
 // MockManager is a mock of Manager interface.
 type MockManager struct {
 	ctrl     *gomock.Controller
@@ -273,6 +448,20 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// ActivateOrder mocks base method.
+func (m *MockManager) ActivateOrder(ctx context.Context, nonce Nonce) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateOrder", ctx, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateOrder indicates an expected call of ActivateOrder.
+func (mr *MockManagerMockRecorder) ActivateOrder(ctx, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateOrder", reflect.TypeOf((*MockManager)(nil).ActivateOrder), ctx, nonce)
+}
+
 // BatchFinalize mocks base method.
 func (m *MockManager) BatchFinalize(batchID BatchID) error {
 	m.ctrl.T.Helper()
@@ -288,12 +477,13 @@ func (mr *MockManagerMockRecorder) BatchFinalize(batchID interface{}) *gomock.Ca
 }
 
 // BatchSign mocks base method.
-func (m *MockManager) BatchSign() (BatchSignature, error) {
+func (m *MockManager) BatchSign() (BatchSignature, AccountNonces, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BatchSign")
 	ret0, _ := ret[0].(BatchSignature)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(AccountNonces)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // BatchSign indicates an expected call of BatchSign.
@@ -302,6 +492,49 @@ func (mr *MockManagerMockRecorder) BatchSign() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSign", reflect.TypeOf((*MockManager)(nil).BatchSign))
 }
 
+// CancelAllOrders mocks base method.
+func (m *MockManager) CancelAllOrders(ctx context.Context, filter *CancelFilter) (map[Nonce]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAllOrders", ctx, filter)
+	ret0, _ := ret[0].(map[Nonce]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAllOrders indicates an expected call of CancelAllOrders.
+func (mr *MockManagerMockRecorder) CancelAllOrders(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAllOrders", reflect.TypeOf((*MockManager)(nil).CancelAllOrders), ctx, filter)
+}
+
+// CheckSubmission mocks base method.
+func (m *MockManager) CheckSubmission(o Order, force bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSubmission", o, force)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSubmission indicates an expected call of CheckSubmission.
+func (mr *MockManagerMockRecorder) CheckSubmission(o, force interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSubmission", reflect.TypeOf((*MockManager)(nil).CheckSubmission), o, force)
+}
+
+// ExpireOrders mocks base method.
+func (m *MockManager) ExpireOrders(ctx context.Context, height uint32, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOrders", ctx, height, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireOrders indicates an expected call of ExpireOrders.
+func (mr *MockManagerMockRecorder) ExpireOrders(ctx, height, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOrders", reflect.TypeOf((*MockManager)(nil).ExpireOrders), ctx, height, now)
+}
+
 // HasPendingBatch mocks base method.
 func (m *MockManager) HasPendingBatch() bool {
 	m.ctrl.T.Helper()
@@ -374,6 +607,107 @@ func (mr *MockManagerMockRecorder) PrepareOrder(ctx, order, acct, terms interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareOrder", reflect.TypeOf((*MockManager)(nil).PrepareOrder), ctx, order, acct, terms)
 }
 
+// PrepareSignedOrder mocks base method.
+func (m *MockManager) PrepareSignedOrder(ctx context.Context, order Order, acct *account.Account, terms *terms.AuctioneerTerms, sig []byte) (*ServerOrderParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareSignedOrder", ctx, order, acct, terms, sig)
+	ret0, _ := ret[0].(*ServerOrderParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareSignedOrder indicates an expected call of PrepareSignedOrder.
+func (mr *MockManagerMockRecorder) PrepareSignedOrder(ctx, order, acct, terms, sig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareSignedOrder", reflect.TypeOf((*MockManager)(nil).PrepareSignedOrder), ctx, order, acct, terms, sig)
+}
+
+// PrepareUnsignedOrder mocks base method.
+func (m *MockManager) PrepareUnsignedOrder(ctx context.Context, order Order, acct *account.Account, terms *terms.AuctioneerTerms) ([32]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareUnsignedOrder", ctx, order, acct, terms)
+	ret0, _ := ret[0].([32]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareUnsignedOrder indicates an expected call of PrepareUnsignedOrder.
+func (mr *MockManagerMockRecorder) PrepareUnsignedOrder(ctx, order, acct, terms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareUnsignedOrder", reflect.TypeOf((*MockManager)(nil).PrepareUnsignedOrder), ctx, order, acct, terms)
+}
+
+// QuoteOrder mocks base method.
+func (m *MockManager) QuoteOrder(ctx context.Context, order Order) (*Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrder", ctx, order)
+	ret0, _ := ret[0].(*Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteOrder indicates an expected call of QuoteOrder.
+func (mr *MockManagerMockRecorder) QuoteOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrder", reflect.TypeOf((*MockManager)(nil).QuoteOrder), ctx, order)
+}
+
+// ReleaseReservation mocks base method.
+func (m *MockManager) ReleaseReservation(nonce Nonce) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseReservation", nonce)
+}
+
+// ReleaseReservation indicates an expected call of ReleaseReservation.
+func (mr *MockManagerMockRecorder) ReleaseReservation(nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseReservation", reflect.TypeOf((*MockManager)(nil).ReleaseReservation), nonce)
+}
+
+// ReplaceOrder mocks base method.
+func (m *MockManager) ReplaceOrder(ctx context.Context, oldNonce Nonce, params *ReplaceParams, submit func(Order, *terms.AuctioneerTerms) error) (Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceOrder", ctx, oldNonce, params, submit)
+	ret0, _ := ret[0].(Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceOrder indicates an expected call of ReplaceOrder.
+func (mr *MockManagerMockRecorder) ReplaceOrder(ctx, oldNonce, params, submit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceOrder", reflect.TypeOf((*MockManager)(nil).ReplaceOrder), ctx, oldNonce, params, submit)
+}
+
+// SaveOrderTemplate mocks base method.
+func (m *MockManager) SaveOrderTemplate(ctx context.Context, name string, params Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOrderTemplate", ctx, name, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOrderTemplate indicates an expected call of SaveOrderTemplate.
+func (mr *MockManagerMockRecorder) SaveOrderTemplate(ctx, name, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOrderTemplate", reflect.TypeOf((*MockManager)(nil).SaveOrderTemplate), ctx, name, params)
+}
+
+// SelectAccount mocks base method.
+func (m *MockManager) SelectAccount(order Order, terms *terms.AuctioneerTerms) (*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAccount", order, terms)
+	ret0, _ := ret[0].(*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAccount indicates an expected call of SelectAccount.
+func (mr *MockManagerMockRecorder) SelectAccount(order, terms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAccount", reflect.TypeOf((*MockManager)(nil).SelectAccount), order, terms)
+}
+
 // Start mocks base method.
 func (m *MockManager) Start() error {
 	m.ctrl.T.Helper()
@@ -399,3 +733,33 @@ func (mr *MockManagerMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockManager)(nil).Stop))
 }
+
+// SubmitOrderFromTemplate mocks base method.
+func (m *MockManager) SubmitOrderFromTemplate(ctx context.Context, name string, overrides *TemplateOverrides, submit func(Order, *terms.AuctioneerTerms) error) (Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitOrderFromTemplate", ctx, name, overrides, submit)
+	ret0, _ := ret[0].(Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitOrderFromTemplate indicates an expected call of SubmitOrderFromTemplate.
+func (mr *MockManagerMockRecorder) SubmitOrderFromTemplate(ctx, name, overrides, submit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOrderFromTemplate", reflect.TypeOf((*MockManager)(nil).SubmitOrderFromTemplate), ctx, name, overrides, submit)
+}
+
+// Terms mocks base method.
+func (m *MockManager) Terms(ctx context.Context) (*terms.AuctioneerTerms, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Terms", ctx)
+	ret0, _ := ret[0].(*terms.AuctioneerTerms)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Terms indicates an expected call of Terms.
+func (mr *MockManagerMockRecorder) Terms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terms", reflect.TypeOf((*MockManager)(nil).Terms), ctx)
+}
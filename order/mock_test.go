@@ -3,21 +3,30 @@ package order
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
 )
 
 type mockStore struct {
 	orders         map[Nonce]Order
 	accounts       map[[33]byte]*account.Account
 	pendingBatchID *BatchID
+	terms          *terms.AuctioneerTerms
+	termsStoredAt  time.Time
+	templates      map[string]*Template
+	expired        map[Nonce]struct{}
+	feeRateBumps   []*FeeRateBump
 }
 
 func newMockStore() *mockStore {
 	return &mockStore{
-		orders:   make(map[Nonce]Order),
-		accounts: make(map[[33]byte]*account.Account),
+		orders:    make(map[Nonce]Order),
+		accounts:  make(map[[33]byte]*account.Account),
+		templates: make(map[string]*Template),
+		expired:   make(map[Nonce]struct{}),
 	}
 }
 
@@ -83,6 +92,21 @@ func (s *mockStore) GetOrders() ([]Order, error) {
 	return orders, nil
 }
 
+// GetOrdersByState returns all orders that are currently in one of the given
+// states.
+func (s *mockStore) GetOrdersByState(states ...State) ([]Order, error) {
+	var orders []Order
+	for _, o := range s.orders {
+		for _, state := range states {
+			if o.Details().State == state {
+				orders = append(orders, o)
+				break
+			}
+		}
+	}
+	return orders, nil
+}
+
 // DeleteOrder removes the order with the given nonce from the local store.
 func (s *mockStore) DeleteOrder(nonce Nonce) error {
 	delete(s.orders, nonce)
@@ -111,11 +135,68 @@ func (s *mockStore) StorePendingBatch(batch *Batch,
 	return nil
 }
 
-// MarkBatchComplete marks a pending batch as complete, applying any staged
-// modifications necessary, and allowing a trader to participate in a new batch.
-// If a pending batch is not found, account.ErrNoPendingBatch is returned.
-func (s *mockStore) MarkBatchComplete() error {
-	if s.pendingBatchID == nil {
+// ValidatePendingBatch performs a dry run of StorePendingBatch. The modifiers
+// are applied to copies of the orders and accounts which are then returned.
+func (s *mockStore) ValidatePendingBatch(_ *Batch, orders []Nonce,
+	orderModifiers [][]Modifier, accts []*account.Account,
+	acctModifiers [][]account.Modifier) ([]Order, []*account.Account,
+	error) {
+
+	if len(orders) != len(orderModifiers) {
+		return nil, nil, fmt.Errorf("order modifier length mismatch")
+	}
+	if len(accts) != len(acctModifiers) {
+		return nil, nil, fmt.Errorf("account modifier length mismatch")
+	}
+
+	updatedOrders := make([]Order, 0, len(orders))
+	for idx, nonce := range orders {
+		o, ok := s.orders[nonce]
+		if !ok {
+			return nil, nil, fmt.Errorf("order not found")
+		}
+
+		var orderCopy Order
+		switch t := o.(type) {
+		case *Ask:
+			c := *t
+			orderCopy = &c
+
+		case *Bid:
+			c := *t
+			orderCopy = &c
+
+		default:
+			return nil, nil, fmt.Errorf("unknown order type")
+		}
+
+		for _, modifier := range orderModifiers[idx] {
+			modifier(orderCopy.Details())
+		}
+		updatedOrders = append(updatedOrders, orderCopy)
+	}
+
+	updatedAccounts := make([]*account.Account, 0, len(accts))
+	for idx, acct := range accts {
+		a := *acct
+		for _, modifier := range acctModifiers[idx] {
+			modifier(&a)
+		}
+		if a.Value < 0 {
+			return nil, nil, fmt.Errorf("negative account balance")
+		}
+		updatedAccounts = append(updatedAccounts, &a)
+	}
+
+	return updatedOrders, updatedAccounts, nil
+}
+
+// MarkBatchComplete marks the pending batch with the given ID as complete,
+// applying any staged modifications necessary, and allowing a trader to
+// participate in a new batch. If the pending batch is not found,
+// account.ErrNoPendingBatch is returned.
+func (s *mockStore) MarkBatchComplete(batchID BatchID) error {
+	if s.pendingBatchID == nil || *s.pendingBatchID != batchID {
 		return account.ErrNoPendingBatch
 	}
 
@@ -123,6 +204,72 @@ func (s *mockStore) MarkBatchComplete() error {
 	return nil
 }
 
+// StoreOrderTemplate stores the given order template, replacing any existing
+// template of the same name.
+func (s *mockStore) StoreOrderTemplate(t *Template) error {
+	s.templates[t.Name] = t
+	return nil
+}
+
+// OrderTemplate returns the order template with the given name.
+func (s *mockStore) OrderTemplate(name string) (*Template, error) {
+	t, ok := s.templates[name]
+	if !ok {
+		return nil, ErrNoOrderTemplate
+	}
+
+	return t, nil
+}
+
+// ListOrderTemplates returns all stored order templates.
+func (s *mockStore) ListOrderTemplates() ([]*Template, error) {
+	templates := make([]*Template, 0, len(s.templates))
+	for _, t := range s.templates {
+		templates = append(templates, t)
+	}
+
+	return templates, nil
+}
+
+// DeleteOrderTemplate removes the order template with the given name.
+func (s *mockStore) DeleteOrderTemplate(name string) error {
+	if _, ok := s.templates[name]; !ok {
+		return ErrNoOrderTemplate
+	}
+
+	delete(s.templates, name)
+	return nil
+}
+
+// ExpireOrders atomically marks the orders with the given nonces as canceled
+// because they expired.
+func (s *mockStore) ExpireOrders(nonces []Nonce) error {
+	for _, nonce := range nonces {
+		if _, ok := s.orders[nonce]; !ok {
+			return fmt.Errorf("order not found")
+		}
+	}
+
+	for _, nonce := range nonces {
+		s.orders[nonce].Details().State = StateCanceled
+		s.expired[nonce] = struct{}{}
+	}
+
+	return nil
+}
+
+// StoreFeeRateBump records the replacement of an order by one with a higher
+// max batch fee rate.
+func (s *mockStore) StoreFeeRateBump(bump *FeeRateBump) error {
+	if _, ok := s.orders[bump.Nonce]; !ok {
+		return fmt.Errorf("order not found")
+	}
+
+	s.feeRateBumps = append(s.feeRateBumps, bump)
+
+	return nil
+}
+
 func (s *mockStore) getAccount(acctKey *btcec.PublicKey) (
 	*account.Account, error) {
 
@@ -170,3 +317,23 @@ func (s *mockStore) updateAccount(acct *account.Account,
 
 	return nil
 }
+
+// StoreTerms stores the given auctioneer terms as the last known terms,
+// replacing any previously cached ones.
+func (s *mockStore) StoreTerms(t *terms.AuctioneerTerms) error {
+	s.terms = t
+	s.termsStoredAt = time.Now()
+	return nil
+}
+
+// CachedTerms returns the last known auctioneer terms and the time they were
+// stored.
+func (s *mockStore) CachedTerms() (*terms.AuctioneerTerms, time.Time, error) {
+	if s.terms == nil {
+		return nil, time.Time{}, errors.New("no cached terms")
+	}
+
+	// Return a copy, just like a real store would.
+	t := *s.terms
+	return &t, s.termsStoredAt, nil
+}
@@ -0,0 +1,112 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// nodeTierEntry is a cached node tier together with the time it was fetched.
+type nodeTierEntry struct {
+	tier      NodeTier
+	fetchedAt time.Time
+}
+
+// nodeTierCache caches the node tiers the auctioneer assigns to nodes so they
+// don't need to be fetched again for every batch.
+type nodeTierCache struct {
+	// fetch queries the auctioneer for the tiers of the given nodes.
+	fetch func(ctx context.Context,
+		nodeKeys [][33]byte) (map[[33]byte]NodeTier, error)
+
+	// ttl is the duration after which a cached tier is fetched again.
+	ttl time.Duration
+
+	// now returns the current time.
+	now func() time.Time
+
+	entries map[[33]byte]nodeTierEntry
+	mtx     sync.Mutex
+}
+
+// newNodeTierCache creates a new node tier cache with the given fetch function
+// and expiry.
+func newNodeTierCache(fetch func(ctx context.Context,
+	nodeKeys [][33]byte) (map[[33]byte]NodeTier, error),
+	ttl time.Duration) *nodeTierCache {
+
+	return &nodeTierCache{
+		fetch:   fetch,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[[33]byte]nodeTierEntry),
+	}
+}
+
+// tiers returns the tiers of all given nodes. Only the tiers that aren't cached
+// or expired are fetched from the auctioneer, all of them in a single call.
+func (c *nodeTierCache) tiers(ctx context.Context,
+	nodeKeys [][33]byte) (map[[33]byte]NodeTier, error) {
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := c.now()
+	result := make(map[[33]byte]NodeTier, len(nodeKeys))
+	var missing [][33]byte
+	for _, nodeKey := range nodeKeys {
+		entry, ok := c.entries[nodeKey]
+		if ok && now.Sub(entry.fetchedAt) < c.ttl {
+			result[nodeKey] = entry.tier
+			continue
+		}
+
+		missing = append(missing, nodeKey)
+	}
+
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	fetched, err := c.fetch(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch node ratings: %w", err)
+	}
+
+	for _, nodeKey := range missing {
+		tier, ok := fetched[nodeKey]
+		if !ok {
+			return nil, fmt.Errorf("no rating for node %x",
+				nodeKey[:])
+		}
+
+		c.entries[nodeKey] = nodeTierEntry{
+			tier:      tier,
+			fetchedAt: now,
+		}
+		result[nodeKey] = tier
+	}
+
+	return result, nil
+}
+
+// nodeTierLookup returns the function the batch verifier uses to look up the
+// tiers of matched nodes through a cache, or nil if node tiers aren't checked.
+func (m *manager) nodeTierLookup() func(
+	[][33]byte) (map[[33]byte]NodeTier, error) {
+
+	if m.cfg.FetchNodeTiers == nil {
+		return nil
+	}
+
+	cache := newNodeTierCache(m.cfg.FetchNodeTiers, m.cfg.NodeTierCacheTTL)
+	return func(nodeKeys [][33]byte) (map[[33]byte]NodeTier, error) {
+		ctxt, cancel := context.WithTimeout(
+			context.Background(), DefaultBatchStepTimeout,
+		)
+		defer cancel()
+
+		return cache.tiers(ctxt, nodeKeys)
+	}
+}
@@ -0,0 +1,80 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeTierCache makes sure node tiers are only fetched if they aren't
+// cached yet or the cached entry expired, and that all missing tiers are
+// fetched at once.
+func TestNodeTierCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		nodeA     = [33]byte{2, 1}
+		nodeB     = [33]byte{2, 2}
+		nodeC     = [33]byte{2, 3}
+		now       = time.Unix(1_000_000, 0)
+		fetchErr  error
+		requested [][][33]byte
+		ratings   = map[[33]byte]NodeTier{
+			nodeA: NodeTier1,
+			nodeB: NodeTier0,
+		}
+	)
+	cache := newNodeTierCache(func(_ context.Context,
+		nodeKeys [][33]byte) (map[[33]byte]NodeTier, error) {
+
+		requested = append(requested, nodeKeys)
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+
+		tiers := make(map[[33]byte]NodeTier)
+		for _, nodeKey := range nodeKeys {
+			if tier, ok := ratings[nodeKey]; ok {
+				tiers[nodeKey] = tier
+			}
+		}
+		return tiers, nil
+	}, time.Minute)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	// The first lookup fetches both nodes in a single call.
+	tiers, err := cache.tiers(ctx, [][33]byte{nodeA, nodeB})
+	require.NoError(t, err)
+	require.Equal(t, map[[33]byte]NodeTier{
+		nodeA: NodeTier1,
+		nodeB: NodeTier0,
+	}, tiers)
+	require.Equal(t, [][][33]byte{{nodeA, nodeB}}, requested)
+
+	// Before the entries expire, they're served from the cache, even if
+	// the auctioneer can't be reached.
+	fetchErr = errors.New("server unavailable")
+	now = now.Add(30 * time.Second)
+	tiers, err = cache.tiers(ctx, [][33]byte{nodeA})
+	require.NoError(t, err)
+	require.Equal(t, NodeTier1, tiers[nodeA])
+	require.Len(t, requested, 1)
+
+	// Expired entries are fetched again, and failing to do so is an
+	// error.
+	now = now.Add(time.Minute)
+	_, err = cache.tiers(ctx, [][33]byte{nodeA})
+	require.ErrorIs(t, err, fetchErr)
+
+	// A node the auctioneer didn't return a rating for is an error as
+	// well.
+	fetchErr = nil
+	_, err = cache.tiers(ctx, [][33]byte{nodeA, nodeC})
+	require.ErrorContains(t, err, "no rating for node")
+}
@@ -0,0 +1,63 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrInvalidOrderSig is returned if the signature of an order that was
+	// signed outside of the daemon doesn't match its digest and the trader
+	// key of its account.
+	ErrInvalidOrderSig = errors.New("invalid order signature")
+
+	// ErrOfflineSidecar is returned if a bid for a sidecar ticket should be
+	// signed outside of the daemon. The ticket itself needs to be signed
+	// with the trader key while the order is prepared, which isn't possible
+	// if the key isn't available to the daemon.
+	ErrOfflineSidecar = errors.New("bids for sidecar tickets cannot be " +
+		"signed offline")
+)
+
+// SignDigest signs the digest of an order with the given trader key. The
+// signature is created exactly like the one of lnd's signer RPC, so an order
+// can be signed on a machine that holds the trader key but doesn't run lnd.
+// The digest should always be recomputed from the order itself with
+// Order.Digest instead of being trusted blindly.
+func SignDigest(privKey *btcec.PrivateKey, digest [hashSize]byte) ([]byte,
+	error) {
+
+	sig := ecdsa.Sign(privKey, chainhash.HashB(digest[:]))
+	wireSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode signature: %v", err)
+	}
+
+	return wireSig.ToSignatureBytes(), nil
+}
+
+// VerifyDigestSig makes sure the given signature was created over the digest
+// of an order with the private key of the given trader key.
+func VerifyDigestSig(pubKey *btcec.PublicKey, digest [hashSize]byte,
+	rawSig []byte) error {
+
+	wireSig, err := lnwire.NewSigFromRawSignature(rawSig)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidOrderSig, err)
+	}
+	sig, err := wireSig.ToSignature()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidOrderSig, err)
+	}
+
+	if !sig.Verify(chainhash.HashB(digest[:]), pubKey) {
+		return ErrInvalidOrderSig
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignDigest makes sure an order digest signed offline results in the same
+// signature lnd's signer creates and that it is only valid for the trader key.
+func TestSignDigest(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	digest := [hashSize]byte{1, 2, 3}
+	sig, err := SignDigest(privKey, digest)
+	require.NoError(t, err)
+
+	lndSigner := keychain.NewPrivKeyMessageSigner(
+		privKey, keychain.KeyLocator{},
+	)
+	lndSig, err := lndSigner.SignMessage(digest[:], false)
+	require.NoError(t, err)
+	lndWireSig, err := lnwire.NewSigFromSignature(lndSig)
+	require.NoError(t, err)
+	require.Equal(t, lndWireSig.ToSignatureBytes(), sig)
+
+	require.NoError(t, VerifyDigestSig(privKey.PubKey(), digest, sig))
+	require.ErrorIs(
+		t, VerifyDigestSig(otherKey.PubKey(), digest, sig),
+		ErrInvalidOrderSig,
+	)
+	require.ErrorIs(
+		t, VerifyDigestSig(privKey.PubKey(), [hashSize]byte{}, sig),
+		ErrInvalidOrderSig,
+	)
+	require.ErrorIs(
+		t, VerifyDigestSig(privKey.PubKey(), digest, sig[:10]),
+		ErrInvalidOrderSig,
+	)
+}
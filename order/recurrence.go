@@ -0,0 +1,182 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// ErrRecurrenceBalance is the error that is returned if a recurring
+	// order isn't resubmitted because the free balance of its account is
+	// below the minimum order size.
+	ErrRecurrenceBalance = errors.New("account free balance below " +
+		"minimum order size")
+)
+
+// Recurrence describes how often an order is resubmitted with the same
+// parameters once it is fully executed. Each resubmission is a new order with
+// its own nonce that is funded from the same account.
+type Recurrence struct {
+	// Remaining is the number of times the order is still resubmitted.
+	Remaining uint32
+
+	// Chain is the list of nonces of all previous orders of the same
+	// recurrence, starting with the original order.
+	Chain []Nonce
+}
+
+// Nonces returns the nonces of all orders of the recurrence up to and
+// including the order with the given nonce, starting with the original order.
+func (r *Recurrence) Nonces(nonce Nonce) []Nonce {
+	nonces := make([]Nonce, 0, len(r.Chain)+1)
+	nonces = append(nonces, r.Chain...)
+	return append(nonces, nonce)
+}
+
+// resubmitRecurringOrders resubmits all orders with the given nonces that were
+// fully executed and are still due to recur.
+//
+// NOTE: This must be run as a goroutine.
+func (m *manager) resubmitRecurringOrders(nonces []Nonce) {
+	defer m.wg.Done()
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), defaultLndTimeout,
+	)
+	defer cancel()
+
+	for _, nonce := range nonces {
+		o, err := m.cfg.Store.GetOrder(nonce)
+		if err != nil {
+			log.Errorf("Unable to fetch order %v: %v", nonce, err)
+			continue
+		}
+
+		recurrence := o.Details().Recurrence
+		if o.Details().State != StateExecuted || recurrence == nil ||
+			recurrence.Remaining == 0 {
+
+			continue
+		}
+
+		newOrder, err := m.resubmitOrder(ctx, o)
+		if err != nil {
+			log.Warnf("Not resubmitting recurring order %v: %v",
+				nonce, err)
+			continue
+		}
+
+		log.Infof("Resubmitted fully executed order %v as %v, %d "+
+			"resubmissions remaining", nonce, newOrder.Nonce(),
+			newOrder.Details().Recurrence.Remaining)
+	}
+}
+
+// resubmitOrder creates a new order with the same parameters as the given
+// fully executed recurring order, stores it and sends it to the auctioneer.
+func (m *manager) resubmitOrder(ctx context.Context, prev Order) (Order,
+	error) {
+
+	o, acct, auctionTerms, err := m.nextRecurringOrder(ctx, prev)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.submitOrder(ctx, o, acct, auctionTerms); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// submitOrder prepares the given order, which stores it locally, and sends it
+// to the auctioneer. If the auctioneer can't be reached, the order is marked
+// as failed.
+func (m *manager) submitOrder(ctx context.Context, o Order,
+	acct *account.Account, auctionTerms *terms.AuctioneerTerms) error {
+
+	params, err := m.PrepareOrder(ctx, o, acct, auctionTerms)
+	if err != nil {
+		return err
+	}
+
+	if err := m.cfg.SendOrder(ctx, o, params); err != nil {
+		// The order never made it into the order book, so we mark it
+		// as failed, just like an order the user submitted.
+		err2 := m.cfg.Store.UpdateOrder(
+			o.Nonce(), StateModifier(StateFailed),
+		)
+		if err2 != nil {
+			log.Errorf("Could not update failed order: %v", err2)
+		}
+		m.ReleaseReservation(o.Nonce())
+
+		return fmt.Errorf("error submitting order to auctioneer: %v",
+			err)
+	}
+
+	return nil
+}
+
+// nextRecurringOrder creates the order that follows the given fully executed
+// recurring order, together with the account it is funded from and the
+// current auctioneer terms. An error is returned if the account can't fund
+// another order.
+func (m *manager) nextRecurringOrder(ctx context.Context, prev Order) (Order,
+	*account.Account, *terms.AuctioneerTerms, error) {
+
+	prevDetails := prev.Details()
+	acct, err := m.fundingAccount(prevDetails.AcctKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	auctionTerms, err := m.Terms(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// There's no point in trying to submit another order if the account
+	// couldn't even afford the smallest possible one.
+	reserved := m.reservations.reserved(
+		prevDetails.AcctKey, auctionTerms.FeeSchedule(),
+	)
+	if acct.Value < reserved+acct.Reserve+BaseSupplyUnit {
+		return nil, nil, nil, ErrRecurrenceBalance
+	}
+
+	var preimage lntypes.Preimage
+	preimageBytes, err := randomPreimage()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot generate nonce: %v",
+			err)
+	}
+	copy(preimage[:], preimageBytes)
+
+	o, err := copyOrderParams(prev, NewKitWithPreimage(preimage))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	kit := o.Details()
+	kit.AcctKey = prevDetails.AcctKey
+	kit.Amt = prevDetails.Amt
+	kit.Units = NewSupplyFromSats(kit.Amt)
+	kit.UnitsUnfulfilled = kit.Units
+	kit.Recurrence = &Recurrence{
+		Remaining: prevDetails.Recurrence.Remaining - 1,
+		Chain:     prevDetails.Recurrence.Nonces(prev.Nonce()),
+	}
+
+	// The expiry is absolute, so it applies to the whole recurrence and
+	// not just the original order.
+	kit.ExpiryHeight = prevDetails.ExpiryHeight
+	kit.ExpiryUnix = prevDetails.ExpiryUnix
+
+	return o, acct, auctionTerms, nil
+}
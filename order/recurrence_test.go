@@ -0,0 +1,104 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNextRecurringOrder makes sure a fully executed recurring order is
+// followed by an order with the same parameters but a new nonce, and that no
+// further order is created once the account can't fund one anymore.
+func TestNextRecurringOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newMockStore()
+	acct := &account.Account{
+		Value: btcutil.SatoshiPerBitcoin,
+		State: account.StateOpen,
+		TraderKey: &keychain.KeyDescriptor{
+			PubKey: acctKeySmall,
+		},
+	}
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		AcctStore: &mockAccountStore{
+			accounts: []*account.Account{acct},
+		},
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			return &terms.AuctioneerTerms{}, nil
+		},
+		TermsStore:    store,
+		TermsCacheTTL: time.Hour,
+	})
+
+	var acctKey [33]byte
+	copy(acctKey[:], acctKeySmall.SerializeCompressed())
+	original := &Ask{
+		Kit: newKitFromTemplate(Nonce{0x01}, &Kit{
+			Version:         VersionMinDistinctPeers,
+			State:           StateExecuted,
+			FixedRate:       21,
+			Amt:             500_000,
+			Units:           5,
+			MaxBatchFeeRate: chainfee.FeePerKwFloor,
+			AcctKey:         acctKey,
+			LeaseDuration:   2016,
+			MinUnitsMatch:   2,
+		}),
+		MinDistinctPeers: 2,
+	}
+	original.Recurrence = &Recurrence{
+		Remaining: 2,
+	}
+
+	// The first resubmission keeps the parameters of the original order
+	// and starts the chain of nonces.
+	next, nextAcct, _, err := mgr.nextRecurringOrder(ctx, original)
+	require.NoError(t, err)
+	require.Equal(t, acct, nextAcct)
+	require.NotEqual(t, original.Nonce(), next.Nonce())
+
+	nextAsk, ok := next.(*Ask)
+	require.True(t, ok)
+	require.Equal(t, original.Amt, nextAsk.Amt)
+	require.Equal(t, original.AcctKey, nextAsk.AcctKey)
+	require.Equal(t, original.FixedRate, nextAsk.FixedRate)
+	require.Equal(t, original.MinDistinctPeers, nextAsk.MinDistinctPeers)
+	require.Equal(t, StateSubmitted, nextAsk.State)
+	require.Equal(t, original.Units, nextAsk.UnitsUnfulfilled)
+	require.Equal(t, &Recurrence{
+		Remaining: 1,
+		Chain:     []Nonce{original.Nonce()},
+	}, nextAsk.Recurrence)
+
+	// The chain grows with every resubmission.
+	last, _, _, err := mgr.nextRecurringOrder(ctx, nextAsk)
+	require.NoError(t, err)
+	require.Equal(t, &Recurrence{
+		Remaining: 0,
+		Chain:     []Nonce{original.Nonce(), nextAsk.Nonce()},
+	}, last.Details().Recurrence)
+
+	// Once the account's balance is almost used up, the order isn't
+	// resubmitted anymore.
+	acct.Value = BaseSupplyUnit - 1
+	_, _, _, err = mgr.nextRecurringOrder(ctx, original)
+	require.ErrorIs(t, err, ErrRecurrenceBalance)
+
+	// Orders of closed accounts are never resubmitted.
+	acct.Value = btcutil.SatoshiPerBitcoin
+	acct.State = account.StateClosed
+	_, _, _, err = mgr.nextRecurringOrder(ctx, original)
+	require.ErrorContains(t, err, "is in state")
+}
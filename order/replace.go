@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ReplaceParams are the order parameters that are changed when replacing an
+// order. A zero value field keeps the value of the replaced order.
+type ReplaceParams struct {
+	// Amt is the amount of the new order. If this isn't set, the new order
+	// is for the amount that is still unfulfilled in the replaced order.
+	Amt btcutil.Amount
+
+	// FixedRate is the new per block fixed rate premium.
+	FixedRate uint32
+
+	// MaxBatchFeeRate is the new max batch fee rate.
+	MaxBatchFeeRate chainfee.SatPerKWeight
+}
+
+// ReplaceError is the error that is returned by ReplaceOrder if the replaced
+// order was already canceled but its replacement couldn't be submitted. The
+// replaced order is not restored in that case.
+type ReplaceError struct {
+	// OldNonce is the nonce of the canceled order.
+	OldNonce Nonce
+
+	// NewNonce is the nonce of the replacement that couldn't be
+	// submitted.
+	NewNonce Nonce
+
+	// Err is the reason the replacement couldn't be submitted.
+	Err error
+}
+
+// Error returns a human readable representation of the error.
+//
+// NOTE: This is part of the error interface.
+func (e *ReplaceError) Error() string {
+	return fmt.Sprintf("order %v was canceled but its replacement %v "+
+		"couldn't be submitted: %v", e.OldNonce, e.NewNonce, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ReplaceError) Unwrap() error {
+	return e.Err
+}
+
+// ReplaceOrder replaces the active order with the given nonce by a new order
+// with the given parameters. The old order is canceled with the auctioneer
+// first, then the new order is handed to the submit function together with the
+// current auctioneer terms right away. If the submission fails, a
+// *ReplaceError is returned as the old order is gone at that point.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) ReplaceOrder(ctx context.Context, oldNonce Nonce,
+	params *ReplaceParams,
+	submit func(Order, *terms.AuctioneerTerms) error) (Order, error) {
+
+	old, err := m.cfg.Store.GetOrder(oldNonce)
+	if err != nil {
+		return nil, err
+	}
+	if old.Details().State.Archived() {
+		return nil, fmt.Errorf("unable to replace order in terminal "+
+			"state %v", old.Details().State)
+	}
+	if old.Details().State == StateStaged {
+		return nil, errors.New("staged orders cannot be replaced, " +
+			"cancel the order and stage a new one instead")
+	}
+
+	o, err := newReplacementOrder(old, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// We make sure the parameters of the new order are valid under the
+	// current terms before canceling the old order. Everything that
+	// depends on the account is validated when submitting the new order,
+	// once the old one doesn't reserve any of the account's balance
+	// anymore.
+	auctionTerms, err := m.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = validateTemplate(&Template{Order: o}, auctionTerms)
+	if err != nil {
+		return nil, fmt.Errorf("invalid replacement order: %w", err)
+	}
+
+	log.Infof("Replacing order %v with %v", oldNonce, o.Nonce())
+
+	err = m.cfg.CancelOrder(ctx, old.Details().Preimage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel order %v: %v",
+			oldNonce, err)
+	}
+	err = m.cfg.Store.UpdateOrder(
+		oldNonce, StateModifier(StateCanceled),
+	)
+	if err != nil {
+		return nil, &ReplaceError{
+			OldNonce: oldNonce,
+			NewNonce: o.Nonce(),
+			Err:      err,
+		}
+	}
+	m.ReleaseReservation(oldNonce)
+
+	if err := submit(o, auctionTerms); err != nil {
+		return nil, &ReplaceError{
+			OldNonce: oldNonce,
+			NewNonce: o.Nonce(),
+			Err:      err,
+		}
+	}
+
+	return o, nil
+}
+
+// newReplacementOrder creates a new order with a fresh nonce from the account
+// and parameters of the given order and the given replacement parameters.
+func newReplacementOrder(old Order, params *ReplaceParams) (Order, error) {
+	preimageBytes, err := randomPreimage()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %v", err)
+	}
+	var preimage lntypes.Preimage
+	copy(preimage[:], preimageBytes)
+
+	o, err := copyOrderParams(old, NewKitWithPreimage(preimage))
+	if err != nil {
+		return nil, err
+	}
+
+	oldDetails := old.Details()
+	kit := o.Details()
+	kit.AcctKey = oldDetails.AcctKey
+	kit.Amt = oldDetails.UnitsUnfulfilled.ToSatoshis()
+	if params.Amt != 0 {
+		kit.Amt = params.Amt
+	}
+	kit.Units = NewSupplyFromSats(kit.Amt)
+	kit.UnitsUnfulfilled = kit.Units
+	if params.FixedRate != 0 {
+		kit.FixedRate = params.FixedRate
+	}
+	if params.MaxBatchFeeRate != 0 {
+		kit.MaxBatchFeeRate = params.MaxBatchFeeRate
+	}
+	kit.Replaces = old.Nonce()
+
+	// The replacement shouldn't outlive the order it replaces.
+	kit.ExpiryHeight = oldDetails.ExpiryHeight
+	kit.ExpiryUnix = oldDetails.ExpiryUnix
+
+	if kit.MinUnitsMatch > kit.Units {
+		return nil, fmt.Errorf("order amount of %v is smaller than the "+
+			"min units match of %d units", kit.Amt,
+			kit.MinUnitsMatch)
+	}
+
+	return o, nil
+}
@@ -0,0 +1,126 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReplaceOrder makes sure an order is replaced by a new order with the
+// changed parameters and that a failed submission of the replacement is
+// reported without restoring the old order.
+func TestReplaceOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx       = context.Background()
+		store     = newMockStore()
+		cancelErr error
+		errSubmit = errors.New("order rejected")
+		liveTerms = &terms.AuctioneerTerms{
+			LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+				2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+			},
+		}
+	)
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			return liveTerms, nil
+		},
+		CancelOrder: func(context.Context, lntypes.Preimage) error {
+			return cancelErr
+		},
+		TermsStore:    store,
+		TermsCacheTTL: time.Hour,
+	})
+
+	newBid := func(nonce byte, state State) *Bid {
+		var preimage lntypes.Preimage
+		preimage[0] = nonce
+		kit := NewKitWithPreimage(preimage)
+		kit.State = state
+		kit.FixedRate = 100
+		kit.Amt = 1_000_000
+		kit.Units = 10
+		kit.UnitsUnfulfilled = 4
+		kit.MaxBatchFeeRate = chainfee.FeePerKwFloor
+		kit.AcctKey = [33]byte{2, 1}
+		kit.LeaseDuration = 2016
+		kit.MinUnitsMatch = 1
+
+		bid := &Bid{Kit: *kit, MinNodeTier: 1}
+		require.NoError(t, store.SubmitOrder(bid))
+
+		return bid
+	}
+
+	// Orders in a terminal state can't be replaced.
+	executed := newBid(1, StateExecuted)
+	_, err := mgr.ReplaceOrder(
+		ctx, executed.Nonce(), &ReplaceParams{}, nil,
+	)
+	require.ErrorContains(t, err, "terminal state")
+
+	// If the old order can't be canceled, it stays active and nothing is
+	// submitted.
+	old := newBid(2, StatePartiallyFilled)
+	cancelErr = errors.New("server unavailable")
+	_, err = mgr.ReplaceOrder(
+		ctx, old.Nonce(), &ReplaceParams{FixedRate: 200},
+		func(Order, *terms.AuctioneerTerms) error {
+			t.Fatalf("order submitted")
+			return nil
+		},
+	)
+	require.ErrorContains(t, err, "server unavailable")
+	require.Equal(t, StatePartiallyFilled, old.State)
+
+	// The replacement is for the unfulfilled amount of the old order and
+	// links back to it.
+	cancelErr = nil
+	var submitted Order
+	newOrder, err := mgr.ReplaceOrder(
+		ctx, old.Nonce(), &ReplaceParams{FixedRate: 200},
+		func(o Order, _ *terms.AuctioneerTerms) error {
+			submitted = o
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, submitted, newOrder)
+	require.Equal(t, StateCanceled, old.State)
+
+	newBidOrder, ok := newOrder.(*Bid)
+	require.True(t, ok)
+	require.NotEqual(t, old.Nonce(), newBidOrder.Nonce())
+	require.Equal(t, old.Nonce(), newBidOrder.Replaces)
+	require.Equal(t, uint32(200), newBidOrder.FixedRate)
+	require.Equal(t, SupplyUnit(4), newBidOrder.Units)
+	require.Equal(t, SupplyUnit(4), newBidOrder.UnitsUnfulfilled)
+	require.Equal(t, old.AcctKey, newBidOrder.AcctKey)
+	require.Equal(t, old.MinNodeTier, newBidOrder.MinNodeTier)
+
+	// A failed submission is reported, the old order stays canceled.
+	old = newBid(3, StateSubmitted)
+	_, err = mgr.ReplaceOrder(
+		ctx, old.Nonce(), &ReplaceParams{FixedRate: 200},
+		func(Order, *terms.AuctioneerTerms) error {
+			return errSubmit
+		},
+	)
+	var replaceErr *ReplaceError
+	require.ErrorAs(t, err, &replaceErr)
+	require.Equal(t, old.Nonce(), replaceErr.OldNonce)
+	require.ErrorIs(t, err, errSubmit)
+	require.Equal(t, StateCanceled, old.State)
+}
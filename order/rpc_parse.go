@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/pool/auctioneerrpc"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
@@ -26,6 +27,10 @@ type ParseOption func(*parseOptions)
 // parseOptions houses the set of functional options used to parse RPC orders.
 type parseOptions struct {
 	chanTypeSelector func() ChannelType
+
+	template bool
+
+	noNonce bool
 }
 
 // ChanTypeSelector defines a function capable of selecting a channel type
@@ -40,6 +45,23 @@ func WithDefaultChannelType(selector ChanTypeSelector) ParseOption {
 	}
 }
 
+// AsTemplate indicates that the order is parsed to be saved as an order
+// template. The amount of a template is only known once an order is created
+// from it, so it isn't checked against the min units match.
+func AsTemplate() ParseOption {
+	return func(o *parseOptions) {
+		o.template = true
+	}
+}
+
+// WithoutNonce indicates that the order is only parsed to be inspected, for
+// example to quote it, so no nonce is generated for it if none is provided.
+func WithoutNonce() ParseOption {
+	return func(o *parseOptions) {
+		o.noNonce = true
+	}
+}
+
 // defaultParseOptions returns the set of default parse options.
 func defaultParseOptions() *parseOptions {
 	return &parseOptions{}
@@ -60,7 +82,7 @@ func ParseRPCOrder(version, leaseDuration uint32,
 	kit := NewKit(nonce)
 
 	// If the user didn't provide a nonce, we generate one.
-	if nonce == ZeroNonce {
+	if nonce == ZeroNonce && !opts.noNonce {
 		preimageBytes, err := randomPreimage()
 		if err != nil {
 			return nil, fmt.Errorf("cannot generate nonce: %v", err)
@@ -84,12 +106,19 @@ func ParseRPCOrder(version, leaseDuration uint32,
 	switch {
 	// If a min units match constraint was not provided, return an error.
 	case details.MinUnitsMatch == 0:
-		return nil, errors.New("min units match must be greater than 0")
+		return nil, NewValidationError(
+			ValidationInvalidMinUnitsMatch, "min_units_match",
+			details.MinUnitsMatch, errors.New("min units match "+
+				"must be greater than 0"),
+		)
 
 	// The min units match must not exceed the total order units.
-	case details.MinUnitsMatch > uint32(kit.Units):
-		return nil, errors.New("min units match must not exceed " +
-			"total order units")
+	case !opts.template && details.MinUnitsMatch > uint32(kit.Units):
+		return nil, NewValidationError(
+			ValidationInvalidMinUnitsMatch, "min_units_match",
+			details.MinUnitsMatch, errors.New("min units match "+
+				"must not exceed total order units"),
+		)
 	}
 	kit.MinUnitsMatch = SupplyUnit(details.MinUnitsMatch)
 
@@ -114,13 +143,8 @@ func ParseRPCOrder(version, leaseDuration uint32,
 		return nil, fmt.Errorf("unhandled channel type %v",
 			details.ChannelType)
 	}
-
-	if len(details.AllowedNodeIds) > 0 &&
-		len(details.NotAllowedNodeIds) > 0 {
-
-		return nil, errors.New("allowed and not allowed node ids set " +
-			"at the same time")
-	}
+	kit.UnannouncedChannel = details.UnannouncedChannel
+	kit.ZeroConfChannel = details.ZeroConfChannel
 
 	kit.AllowedNodeIDs = make([][33]byte, len(details.AllowedNodeIds))
 	for idx, nodeID := range details.AllowedNodeIds {
@@ -139,6 +163,29 @@ func ParseRPCOrder(version, leaseDuration uint32,
 		}
 		copy(kit.NotAllowedNodeIDs[idx][:], nodeID)
 	}
+	if err := kit.ValidateNodeIDs(); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateLabel(details.Label); err != nil {
+		return nil, err
+	}
+	kit.Label = details.Label
+	kit.MaxChainFee = btcutil.Amount(details.MaxChainFeeSat)
+	kit.AutoUpdateFeeRate = details.AutoUpdateFeeRate
+
+	if details.Recurrences > 0 {
+		kit.Recurrence = &Recurrence{
+			Remaining: details.Recurrences,
+		}
+	}
+
+	if details.ExpiryTimestamp < 0 {
+		return nil, fmt.Errorf("invalid expiry timestamp %d",
+			details.ExpiryTimestamp)
+	}
+	kit.ExpiryHeight = details.ExpiryHeight
+	kit.ExpiryUnix = details.ExpiryTimestamp
 
 	return kit, nil
 }
@@ -260,6 +307,8 @@ func ParseRPCServerOrder(version uint32, details *auctioneerrpc.ServerOrder,
 		return nil, nodeKey, nil, multiSigKey,
 			fmt.Errorf("unhandled channel type %v", details.ChannelType)
 	}
+	kit.UnannouncedChannel = details.UnannouncedChannel
+	kit.ZeroConfChannel = details.ZeroConfChannel
 
 	return kit, nodeKey, nodeAddrs, multiSigKey, nil
 }
@@ -283,7 +332,8 @@ func ParseRPCServerAsk(details *auctioneerrpc.ServerAsk) (*MatchedOrder, error)
 	kit.LeaseDuration = details.LeaseDurationBlocks
 
 	o.Order = &Ask{
-		Kit: *kit,
+		Kit:              *kit,
+		MinDistinctPeers: details.MinDistinctPeers,
 	}
 
 	return o, nil
@@ -324,6 +374,7 @@ func ParseRPCBatch(prepareMsg *auctioneerrpc.OrderMatchPrepare) (*Batch,
 		BatchTX:        &wire.MsgTx{},
 		ClearingPrices: make(map[uint32]FixedRatePremium),
 		HeightHint:     prepareMsg.BatchHeightHint,
+		ServerNonces:   make(AccountNonces),
 	}
 
 	// Parse matched orders market by market.
@@ -398,6 +449,33 @@ func ParseRPCBatch(prepareMsg *auctioneerrpc.OrderMatchPrepare) (*Batch,
 		return nil, fmt.Errorf("error parsing batch TX: %v", err)
 	}
 
+	// Parse the auctioneer's nonces for our taproot accounts and the
+	// outputs spent by the batch transaction.
+	for acctKeyHex, rpcNonces := range prepareMsg.ServerNonces {
+		var acctKey [33]byte
+		acctKeyBytes, err := hex.DecodeString(acctKeyHex)
+		if err != nil || len(acctKeyBytes) != len(acctKey) {
+			return nil, fmt.Errorf("error parsing account key %s",
+				acctKeyHex)
+		}
+		copy(acctKey[:], acctKeyBytes)
+
+		var nonces poolscript.MuSig2Nonces
+		if len(rpcNonces) != len(nonces) {
+			return nil, fmt.Errorf("invalid nonces length %d for "+
+				"account %s", len(rpcNonces), acctKeyHex)
+		}
+		copy(nonces[:], rpcNonces)
+
+		b.ServerNonces[acctKey] = nonces
+	}
+	for _, prevOut := range prepareMsg.PrevOutputs {
+		b.PreviousOutputs = append(b.PreviousOutputs, &wire.TxOut{
+			Value:    int64(prevOut.Value),
+			PkScript: prevOut.Script,
+		})
+	}
+
 	// Convert clearing price, fee rate and rebate.
 	b.BatchTxFeeRate = chainfee.SatPerKWeight(prepareMsg.FeeRateSatPerKw)
 	b.FeeRebate = btcutil.Amount(prepareMsg.FeeRebateSat)
@@ -0,0 +1,195 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/account"
+)
+
+const (
+	// stagedOrderPollInterval is the interval at which we check whether
+	// the chain fees are low enough to activate staged orders.
+	stagedOrderPollInterval = time.Minute
+
+	// activationConfTarget is the confirmation target we use to estimate
+	// the chain fee rate that is compared to the activation fee rate of
+	// staged orders.
+	activationConfTarget = 6
+)
+
+// ActivateOrder sends the staged order with the given nonce to the auctioneer
+// and marks it as submitted. If the auctioneer rejects the order, it stays
+// staged so it can be activated again or canceled.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) ActivateOrder(ctx context.Context, nonce Nonce) error {
+	m.activateMtx.Lock()
+	defer m.activateMtx.Unlock()
+
+	o, err := m.cfg.Store.GetOrder(nonce)
+	if err != nil {
+		return err
+	}
+	if o.Details().State != StateStaged {
+		return fmt.Errorf("order %v is not staged but in state %v",
+			nonce, o.Details().State)
+	}
+
+	params, err := m.stagedOrderParams(ctx, o)
+	if err != nil {
+		return err
+	}
+
+	if err := m.cfg.SendOrder(ctx, o, params); err != nil {
+		return fmt.Errorf("error submitting order to auctioneer: %w",
+			err)
+	}
+
+	// The order is now in the order book. If we crash before updating
+	// the state, it is synced with the auctioneer on the next startup.
+	return m.cfg.Store.UpdateOrder(nonce, StateModifier(StateSubmitted))
+}
+
+// stagedOrderParams assembles the params needed to send the given staged order
+// to the auctioneer. The order was already validated and signed when it was
+// staged, but the account and the auctioneer terms might have changed since.
+func (m *manager) stagedOrderParams(ctx context.Context,
+	o Order) (*ServerOrderParams, error) {
+
+	details := o.Details()
+	acctPubKey, err := btcec.ParsePubKey(details.AcctKey[:])
+	if err != nil {
+		return nil, err
+	}
+	acct, err := m.cfg.AcctStore.Account(acctPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch account: %v", err)
+	}
+
+	switch acct.State {
+	case account.StateOpen, account.StatePendingUpdate,
+		account.StatePendingBatch:
+
+	default:
+		return nil, fmt.Errorf("account %x is in state %v",
+			details.AcctKey[:], acct.State)
+	}
+
+	auctionTerms, err := m.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, ok := auctionTerms.LeaseDurationBuckets[details.LeaseDuration]
+	if !ok {
+		return nil, fmt.Errorf("invalid lease duration %d, must be "+
+			"one of %v", details.LeaseDuration,
+			auctionTerms.LeaseDurationBuckets)
+	}
+	if err := details.ValidateChannelType(auctionTerms); err != nil {
+		return nil, err
+	}
+	if details.ZeroConfChannel {
+		err := details.ValidateZeroConfChannel(auctionTerms)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The multisig key was already derived when the order was staged, so
+	// we only need to look it up again.
+	multiSigKey, err := m.cfg.Wallet.DeriveKey(
+		ctx, &details.MultiSigKeyLocator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive multi sig key: %v",
+			err)
+	}
+
+	params := &ServerOrderParams{}
+	err = m.addNodeParams(ctx, params, multiSigKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params.RawSig, err = m.signOrder(ctx, o, acct)
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// watchStagedOrders periodically activates all staged orders whose activation
+// fee rate is reached until the manager is stopped.
+//
+// NOTE: This must be run as a goroutine.
+func (m *manager) watchStagedOrders() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(stagedOrderPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.activateStagedOrders()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// activateStagedOrders activates all staged orders that have an activation fee
+// rate at or above the fee rate our lnd node currently estimates.
+func (m *manager) activateStagedOrders() {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), defaultLndTimeout,
+	)
+	defer cancel()
+
+	staged, err := m.cfg.Store.GetOrdersByState(StateStaged)
+	if err != nil {
+		log.Errorf("Unable to fetch staged orders: %v", err)
+		return
+	}
+
+	var triggered []Order
+	for _, o := range staged {
+		if o.Details().ActivationFeeRate != 0 {
+			triggered = append(triggered, o)
+		}
+	}
+
+	// There's no need to bother lnd if no order would be activated
+	// anyway.
+	if len(triggered) == 0 {
+		return
+	}
+
+	feeRate, err := m.cfg.Wallet.EstimateFeeRate(
+		ctx, activationConfTarget,
+	)
+	if err != nil {
+		log.Errorf("Unable to estimate fee rate: %v", err)
+		return
+	}
+
+	for _, o := range triggered {
+		if feeRate > o.Details().ActivationFeeRate {
+			continue
+		}
+
+		if err := m.ActivateOrder(ctx, o.Nonce()); err != nil {
+			log.Warnf("Unable to activate staged order %v: %v",
+				o.Nonce(), err)
+			continue
+		}
+
+		log.Infof("Activated staged order %v at estimated fee rate %v",
+			o.Nonce(), feeRate)
+	}
+}
@@ -0,0 +1,122 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/internal/test"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestActivateStagedOrders makes sure staged orders are only sent to the
+// auctioneer once they are activated manually or their activation fee rate is
+// reached, and that they stay staged if the auctioneer rejects them.
+func TestActivateStagedOrders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		store   = newMockStore()
+		sendErr error
+		sent    []Nonce
+		acct    = &account.Account{
+			Value: btcutil.SatoshiPerBitcoin,
+			State: account.StateOpen,
+			TraderKey: &keychain.KeyDescriptor{
+				PubKey: acctKeySmall,
+			},
+		}
+	)
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		AcctStore: &mockAccountStore{
+			accounts: []*account.Account{acct},
+		},
+		Wallet:    test.NewMockWalletKit(),
+		Lightning: test.NewMockLightning(),
+		Signer:    test.NewMockSigner(),
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			return &terms.AuctioneerTerms{
+				LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+					2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+				},
+			}, nil
+		},
+		SendOrder: func(_ context.Context, o Order,
+			_ *ServerOrderParams) error {
+
+			if sendErr != nil {
+				return sendErr
+			}
+			sent = append(sent, o.Nonce())
+
+			return nil
+		},
+		TermsStore:    store,
+		TermsCacheTTL: time.Hour,
+	})
+
+	var acctKey [33]byte
+	copy(acctKey[:], acctKeySmall.SerializeCompressed())
+	newAsk := func(nonce byte, state State,
+		activationFeeRate chainfee.SatPerKWeight) *Ask {
+
+		ask := &Ask{
+			Kit: newKitFromTemplate(Nonce{nonce}, &Kit{
+				State:           state,
+				FixedRate:       21,
+				Amt:             500_000,
+				Units:           5,
+				MaxBatchFeeRate: chainfee.FeePerKwFloor,
+				AcctKey:         acctKey,
+				LeaseDuration:   2016,
+				MinUnitsMatch:   1,
+			}),
+		}
+		ask.ActivationFeeRate = activationFeeRate
+		require.NoError(t, store.SubmitOrder(ask))
+
+		return ask
+	}
+
+	// Only staged orders can be activated.
+	submitted := newAsk(1, StateSubmitted, 0)
+	err := mgr.ActivateOrder(ctx, submitted.Nonce())
+	require.ErrorContains(t, err, "is not staged")
+
+	// If the auctioneer rejects the order, it stays staged.
+	manual := newAsk(2, StateStaged, 0)
+	sendErr = errors.New("order rejected")
+	err = mgr.ActivateOrder(ctx, manual.Nonce())
+	require.ErrorIs(t, err, sendErr)
+	require.Equal(t, StateStaged, manual.State)
+
+	sendErr = nil
+	require.NoError(t, mgr.ActivateOrder(ctx, manual.Nonce()))
+	require.Equal(t, StateSubmitted, manual.State)
+	require.Equal(t, []Nonce{manual.Nonce()}, sent)
+
+	// The mock wallet estimates a fee rate of 10k sat/kw, so only the
+	// order with an activation fee rate above that is activated. Orders
+	// without an activation fee rate are never activated automatically.
+	sent = nil
+	noRate := newAsk(3, StateStaged, 0)
+	lowRate := newAsk(4, StateStaged, 5_000)
+	highRate := newAsk(5, StateStaged, 20_000)
+	mgr.activateStagedOrders()
+
+	require.Equal(t, []Nonce{highRate.Nonce()}, sent)
+	require.Equal(t, StateStaged, noRate.State)
+	require.Equal(t, StateStaged, lowRate.State)
+	require.Equal(t, StateSubmitted, highRate.State)
+}
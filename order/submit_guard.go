@@ -0,0 +1,240 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// ErrDuplicateOrder is the error that is returned if an order is
+	// submitted that has the same economic parameters as another active
+	// order that was submitted recently.
+	ErrDuplicateOrder = errors.New("duplicate order")
+
+	// ErrSubmitRateLimited is the error that is returned if an order is
+	// submitted while the order submission rate limit is exceeded.
+	ErrSubmitRateLimited = errors.New("order submission rate limit " +
+		"exceeded")
+)
+
+// fingerprint is the set of economic parameters that is used to detect
+// duplicate orders.
+type fingerprint struct {
+	orderType     Type
+	amt           btcutil.Amount
+	fixedRate     uint32
+	leaseDuration uint32
+	acctKey       [33]byte
+}
+
+// orderFingerprint returns the economic fingerprint of the given order.
+func orderFingerprint(o Order) fingerprint {
+	details := o.Details()
+	return fingerprint{
+		orderType:     o.Type(),
+		amt:           details.Amt,
+		fixedRate:     details.FixedRate,
+		leaseDuration: details.LeaseDuration,
+		acctKey:       details.AcctKey,
+	}
+}
+
+// submitGuard limits the rate at which orders can be submitted and keeps
+// track of recently submitted orders to detect duplicates.
+type submitGuard struct {
+	// limiter is the token bucket new order submissions are taken from.
+	// If this is nil, the rate of submissions is not limited.
+	limiter *rate.Limiter
+
+	// dedupWindow is the duration after its submission an active order
+	// is considered when looking for duplicates. If this is zero, no
+	// duplicate detection is performed.
+	dedupWindow time.Duration
+
+	// submitted maps the nonces of recently submitted orders to the time
+	// they were submitted.
+	submitted map[Nonce]time.Time
+
+	// pending maps the nonces of orders that passed the submission check
+	// but aren't stored yet to their fingerprint. This makes sure two
+	// identical orders that are submitted concurrently can't both pass
+	// the duplicate check.
+	pending map[Nonce]fingerprint
+
+	// checkMtx serializes submission checks so the duplicate check and
+	// the reservation of the new order's fingerprint happen atomically.
+	checkMtx sync.Mutex
+
+	mu sync.Mutex
+}
+
+// newSubmitGuard creates a new submit guard that allows the given number of
+// orders per minute with the given burst size and detects duplicates within
+// the given window. A rate of zero disables the rate limit.
+func newSubmitGuard(perMinute float64, burst int,
+	dedupWindow time.Duration) *submitGuard {
+
+	g := &submitGuard{
+		dedupWindow: dedupWindow,
+		submitted:   make(map[Nonce]time.Time),
+		pending:     make(map[Nonce]fingerprint),
+	}
+	if perMinute > 0 {
+		g.limiter = rate.NewLimiter(rate.Limit(perMinute/60), burst)
+	}
+
+	return g
+}
+
+// allow returns true if an order can be submitted without exceeding the rate
+// limit and takes a token from the bucket if so.
+func (g *submitGuard) allow() bool {
+	return g.limiter == nil || g.limiter.Allow()
+}
+
+// add records the submission of the order with the given nonce at the given
+// time and drops its pending reservation.
+func (g *submitGuard) add(nonce Nonce, now time.Time) {
+	if g.dedupWindow == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.submitted[nonce] = now
+	delete(g.pending, nonce)
+}
+
+// reserve records the fingerprint of an order that passed the submission
+// check but isn't stored yet.
+func (g *submitGuard) reserve(nonce Nonce, fp fingerprint) {
+	if g.dedupWindow == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.pending[nonce] = fp
+}
+
+// release drops the pending reservation of the order with the given nonce.
+func (g *submitGuard) release(nonce Nonce) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.pending, nonce)
+}
+
+// pendingDuplicate returns the nonce of another order with the given
+// fingerprint that passed the submission check but isn't stored yet.
+func (g *submitGuard) pendingDuplicate(nonce Nonce,
+	fp fingerprint) (Nonce, bool) {
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for pendingNonce, pendingFp := range g.pending {
+		if pendingNonce != nonce && pendingFp == fp {
+			return pendingNonce, true
+		}
+	}
+
+	return ZeroNonce, false
+}
+
+// recent returns the nonces of all orders that were submitted within the
+// dedup window before the given time. All older submissions are forgotten.
+func (g *submitGuard) recent(now time.Time) map[Nonce]struct{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	recent := make(map[Nonce]struct{}, len(g.submitted))
+	for nonce, ts := range g.submitted {
+		if now.Sub(ts) > g.dedupWindow {
+			delete(g.submitted, nonce)
+			continue
+		}
+
+		recent[nonce] = struct{}{}
+	}
+
+	return recent
+}
+
+// CheckSubmission makes sure a new order can be submitted. Unless force is
+// set, an order that has the same economic parameters as another active order
+// that was submitted within the dedup window is refused with
+// ErrDuplicateOrder. If the order submission rate limit is exceeded,
+// ErrSubmitRateLimited is returned.
+//
+// If the check passes, the order's fingerprint is reserved until the order is
+// stored or ReleaseReservation is called for it, so a concurrent submission of
+// an identical order is refused as well.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) CheckSubmission(o Order, force bool) error {
+	m.submitGuard.checkMtx.Lock()
+	defer m.submitGuard.checkMtx.Unlock()
+
+	if !force && m.submitGuard.dedupWindow > 0 {
+		if err := m.checkDuplicate(o); err != nil {
+			return err
+		}
+	}
+
+	if !m.submitGuard.allow() {
+		return ErrSubmitRateLimited
+	}
+
+	m.submitGuard.reserve(o.Nonce(), orderFingerprint(o))
+
+	return nil
+}
+
+// checkDuplicate returns ErrDuplicateOrder if another active order with the
+// same economic fingerprint as the given order was submitted within the dedup
+// window or is currently being submitted.
+//
+// NOTE: The submit guard's checkMtx must be held when calling this method.
+func (m *manager) checkDuplicate(o Order) error {
+	newFingerprint := orderFingerprint(o)
+	nonce, ok := m.submitGuard.pendingDuplicate(o.Nonce(), newFingerprint)
+	if ok {
+		return fmt.Errorf("%w: order %v with the same parameters is "+
+			"being submitted", ErrDuplicateOrder, nonce)
+	}
+
+	recent := m.submitGuard.recent(time.Now())
+	if len(recent) == 0 {
+		return nil
+	}
+
+	dbOrders, err := m.cfg.Store.GetOrdersByState(
+		StateSubmitted, StateCleared, StatePartiallyFilled,
+		StateStaged,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, dbOrder := range dbOrders {
+		if _, ok := recent[dbOrder.Nonce()]; !ok {
+			continue
+		}
+
+		if orderFingerprint(dbOrder) == newFingerprint {
+			return fmt.Errorf("%w: active order %v has the same "+
+				"parameters", ErrDuplicateOrder,
+				dbOrder.Nonce())
+		}
+	}
+
+	return nil
+}
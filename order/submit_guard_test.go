@@ -0,0 +1,148 @@
+package order
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckSubmission makes sure duplicates of recently submitted active
+// orders are refused unless forced and that the submission rate is limited.
+func TestCheckSubmission(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	mgr := NewManager(&ManagerConfig{
+		Store:           store,
+		SubmitRateLimit: 1,
+		SubmitBurst:     3,
+		DedupWindow:     time.Minute,
+	})
+
+	newBid := func(nonce byte, amt uint64) *Bid {
+		bid := &Bid{
+			Kit: newKitFromTemplate(Nonce{nonce}, &Kit{
+				State:         StateSubmitted,
+				FixedRate:     21,
+				LeaseDuration: 2016,
+				AcctKey:       [33]byte{0x02},
+			}),
+		}
+		bid.Amt = btcutil.Amount(amt)
+
+		return bid
+	}
+
+	// An order that was submitted recently and is still active is a
+	// duplicate of a new order with the same parameters.
+	existing := newBid(1, 100_000)
+	require.NoError(t, store.SubmitOrder(existing))
+	mgr.submitGuard.add(existing.Nonce(), time.Now())
+
+	err := mgr.CheckSubmission(newBid(2, 100_000), false)
+	require.ErrorIs(t, err, ErrDuplicateOrder)
+
+	// A different amount or the force flag make the new order acceptable.
+	require.NoError(t, mgr.CheckSubmission(newBid(3, 200_000), false))
+	require.NoError(t, mgr.CheckSubmission(newBid(4, 100_000), true))
+
+	// Both orders are reserved now until their submission either succeeds
+	// or fails.
+	err = mgr.CheckSubmission(newBid(7, 200_000), false)
+	require.ErrorIs(t, err, ErrDuplicateOrder)
+	mgr.ReleaseReservation(Nonce{3})
+	mgr.ReleaseReservation(Nonce{4})
+
+	// Orders that are no longer active or that were submitted outside of
+	// the dedup window are not considered.
+	existing.State = StateCanceled
+	require.NoError(t, mgr.CheckSubmission(newBid(5, 100_000), false))
+	mgr.ReleaseReservation(Nonce{5})
+
+	existing.State = StateSubmitted
+	mgr.submitGuard.add(existing.Nonce(), time.Now().Add(-time.Hour))
+	require.Empty(t, mgr.submitGuard.recent(time.Now()))
+
+	// The burst of three submissions is used up now, so the next one is
+	// rate limited.
+	err = mgr.CheckSubmission(newBid(6, 100_000), false)
+	require.ErrorIs(t, err, ErrSubmitRateLimited)
+
+	// Without a rate limit and dedup window, everything is accepted.
+	mgr = NewManager(&ManagerConfig{
+		Store: store,
+	})
+	mgr.submitGuard.add(existing.Nonce(), time.Now())
+	for i := 0; i < 10; i++ {
+		require.NoError(t, mgr.CheckSubmission(existing, false))
+	}
+}
+
+// TestCheckSubmissionConcurrent makes sure only one of two identical orders
+// that are submitted concurrently passes the duplicate check and that the
+// reservation of its fingerprint is dropped again once it's released.
+func TestCheckSubmissionConcurrent(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	mgr := NewManager(&ManagerConfig{
+		Store:       store,
+		DedupWindow: time.Minute,
+	})
+
+	newBid := func(nonce byte) *Bid {
+		bid := &Bid{
+			Kit: newKitFromTemplate(Nonce{nonce}, &Kit{
+				State:         StateSubmitted,
+				FixedRate:     21,
+				LeaseDuration: 2016,
+				AcctKey:       [33]byte{0x02},
+			}),
+		}
+		bid.Amt = 100_000
+
+		return bid
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 2)
+	)
+	for i := range errs {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs[i] = mgr.CheckSubmission(newBid(byte(i+1)), false)
+		}()
+	}
+	wg.Wait()
+
+	var numDuplicates int
+	for _, err := range errs {
+		if err != nil {
+			require.ErrorIs(t, err, ErrDuplicateOrder)
+			numDuplicates++
+		}
+	}
+	require.Equal(t, 1, numDuplicates)
+
+	// Once the submission of the accepted order fails, an identical order
+	// can be submitted again.
+	mgr.ReleaseReservation(Nonce{1})
+	mgr.ReleaseReservation(Nonce{2})
+	require.NoError(t, mgr.CheckSubmission(newBid(3), false))
+
+	// A stored order drops its reservation but is still considered through
+	// the list of recent submissions.
+	accepted := newBid(3)
+	require.NoError(t, mgr.storeOrder(accepted))
+	require.Empty(t, mgr.submitGuard.pending)
+
+	err := mgr.CheckSubmission(newBid(4), false)
+	require.ErrorIs(t, err, ErrDuplicateOrder)
+}
@@ -0,0 +1,283 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// MaxTemplateNameLength is the maximum length of an order template name in
+// bytes.
+const MaxTemplateNameLength = 64
+
+var (
+	// ErrNoOrderTemplate is the error that is returned if no order
+	// template with a given name exists.
+	ErrNoOrderTemplate = errors.New("no order template found")
+
+	// ErrInvalidTemplateName is the error that is returned if an order
+	// template name is empty or too long.
+	ErrInvalidTemplateName = fmt.Errorf("order template name must be "+
+		"between 1 and %d bytes", MaxTemplateNameLength)
+)
+
+// Template is a named set of order parameters that can be used to submit new
+// orders of the same kind repeatedly. A template contains everything needed
+// to create an order except the amount and the account it is submitted from.
+type Template struct {
+	// Name is the unique name of the template.
+	Name string
+
+	// Order is the order the template was created from. Its amount,
+	// account key, nonce and state are not set.
+	Order Order
+}
+
+// TemplateOverrides are the order parameters that are added to or replace the
+// parameters of a template when creating a new order from it.
+type TemplateOverrides struct {
+	// Amt is the amount of the new order. This is always required.
+	Amt btcutil.Amount
+
+	// AcctKey is the trader key of the account the new order is submitted
+	// from. If this is empty, an account is selected automatically.
+	AcctKey [33]byte
+
+	// MaxBatchFeeRate replaces the max batch fee rate of the template if
+	// it is set.
+	MaxBatchFeeRate chainfee.SatPerKWeight
+
+	// Label replaces the label of the template if it is set.
+	Label string
+}
+
+// NewTemplate creates a new order template with the given name from the given
+// order. The amount, account, nonce and state of the order are not part of
+// the template.
+func NewTemplate(name string, o Order) (*Template, error) {
+	if len(name) == 0 || len(name) > MaxTemplateNameLength {
+		return nil, ErrInvalidTemplateName
+	}
+
+	// The self channel balance depends on the order amount, so it can't
+	// be part of a template.
+	if bid, ok := o.(*Bid); ok && bid.SelfChanBalance != 0 {
+		return nil, errors.New("bids with a self channel balance " +
+			"cannot be used as a template")
+	}
+
+	tpl, err := copyOrderParams(o, NewKit(ZeroNonce))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{
+		Name:  name,
+		Order: tpl,
+	}, nil
+}
+
+// NewOrder creates a new order with a fresh nonce from the template and the
+// given overrides.
+func (t *Template) NewOrder(overrides *TemplateOverrides) (Order, error) {
+	preimageBytes, err := randomPreimage()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %v", err)
+	}
+	var preimage lntypes.Preimage
+	copy(preimage[:], preimageBytes)
+
+	o, err := copyOrderParams(t.Order, NewKitWithPreimage(preimage))
+	if err != nil {
+		return nil, err
+	}
+
+	kit := o.Details()
+	kit.AcctKey = overrides.AcctKey
+	kit.Amt = overrides.Amt
+	kit.Units = NewSupplyFromSats(kit.Amt)
+	kit.UnitsUnfulfilled = kit.Units
+	if overrides.MaxBatchFeeRate != 0 {
+		kit.MaxBatchFeeRate = overrides.MaxBatchFeeRate
+	}
+	if overrides.Label != "" {
+		if err := ValidateLabel(overrides.Label); err != nil {
+			return nil, err
+		}
+		kit.Label = overrides.Label
+	}
+
+	if kit.MinUnitsMatch > kit.Units {
+		return nil, fmt.Errorf("order amount of %v is smaller than the "+
+			"min units match of %d units of template %q", kit.Amt,
+			kit.MinUnitsMatch, t.Name)
+	}
+
+	return o, nil
+}
+
+// copyOrderParams copies all parameters of the given order except its amount,
+// account, nonce, state and recurrence into a new order of the same type that
+// uses the given kit.
+func copyOrderParams(o Order, kit *Kit) (Order, error) {
+	details := o.Details()
+	kit.Version = details.Version
+	kit.FixedRate = details.FixedRate
+	kit.MaxBatchFeeRate = details.MaxBatchFeeRate
+	kit.LeaseDuration = details.LeaseDuration
+	kit.MinUnitsMatch = details.MinUnitsMatch
+	kit.ChannelType = details.ChannelType
+	kit.UnannouncedChannel = details.UnannouncedChannel
+	kit.ZeroConfChannel = details.ZeroConfChannel
+	kit.AllowedNodeIDs = append([][33]byte(nil), details.AllowedNodeIDs...)
+	kit.NotAllowedNodeIDs = append(
+		[][33]byte(nil), details.NotAllowedNodeIDs...,
+	)
+	kit.Label = details.Label
+	kit.MaxChainFee = details.MaxChainFee
+	kit.AutoUpdateFeeRate = details.AutoUpdateFeeRate
+
+	switch castOrder := o.(type) {
+	case *Ask:
+		return &Ask{
+			Kit:              *kit,
+			MinDistinctPeers: castOrder.MinDistinctPeers,
+		}, nil
+
+	case *Bid:
+		// A sidecar ticket can only be used for a single order.
+		if castOrder.SidecarTicket != nil {
+			return nil, errors.New("parameters of bids for " +
+				"sidecar tickets cannot be reused")
+		}
+
+		return &Bid{
+			Kit:             *kit,
+			MinNodeTier:     castOrder.MinNodeTier,
+			SelfChanBalance: castOrder.SelfChanBalance,
+			NewNodesOnly:    castOrder.NewNodesOnly,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown order type: %v", o.Type())
+	}
+}
+
+// validateTemplate makes sure the parameters of a template are valid under the
+// given auctioneer terms. Everything that depends on the order amount or the
+// account can only be validated once an order is created from the template.
+func validateTemplate(t *Template, terms *terms.AuctioneerTerms) error {
+	details := t.Order.Details()
+
+	_, ok := terms.LeaseDurationBuckets[details.LeaseDuration]
+	if !ok {
+		return fmt.Errorf("invalid lease duration %d, must be one of %v",
+			details.LeaseDuration, terms.LeaseDurationBuckets)
+	}
+
+	if details.MaxBatchFeeRate < chainfee.FeePerKwFloor {
+		return fmt.Errorf("invalid max batch fee rate %v, must be "+
+			"greater than %v", details.MaxBatchFeeRate,
+			chainfee.FeePerKwFloor)
+	}
+
+	if details.MinUnitsMatch == 0 {
+		return errors.New("min units match must be greater than 0")
+	}
+
+	if err := details.ValidateNodeIDs(); err != nil {
+		return err
+	}
+
+	if err := details.ValidateChannelType(terms); err != nil {
+		return err
+	}
+
+	ask, isAsk := t.Order.(*Ask)
+	if isAsk && ask.MinDistinctPeers > 0 &&
+		ask.Version < VersionMinDistinctPeers {
+
+		return errors.New("cannot use min distinct peers with old " +
+			"order version")
+	}
+
+	if details.UnannouncedChannel {
+		if err := details.ValidateUnannouncedChannel(); err != nil {
+			return err
+		}
+	}
+
+	if details.ZeroConfChannel {
+		if err := details.ValidateZeroConfChannel(terms); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SaveOrderTemplate validates the given order parameters against the current
+// auctioneer terms and stores them as a template with the given name,
+// replacing any existing template of the same name.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) SaveOrderTemplate(ctx context.Context, name string,
+	params Order) error {
+
+	tpl, err := NewTemplate(name, params)
+	if err != nil {
+		return err
+	}
+
+	auctionTerms, err := m.Terms(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validateTemplate(tpl, auctionTerms); err != nil {
+		return fmt.Errorf("invalid order template: %w", err)
+	}
+
+	return m.cfg.Store.StoreOrderTemplate(tpl)
+}
+
+// SubmitOrderFromTemplate creates a new order from the template with the given
+// name and the given overrides and hands it to the submit function together
+// with the current auctioneer terms. The template is validated against the
+// current terms first, so a template that became stale since it was saved is
+// rejected instead of being submitted with outdated parameters.
+//
+// NOTE: This is part of the Manager interface.
+func (m *manager) SubmitOrderFromTemplate(ctx context.Context, name string,
+	overrides *TemplateOverrides,
+	submit func(Order, *terms.AuctioneerTerms) error) (Order, error) {
+
+	tpl, err := m.cfg.Store.OrderTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionTerms, err := m.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTemplate(tpl, auctionTerms); err != nil {
+		return nil, fmt.Errorf("order template %q is no longer valid "+
+			"under the current auctioneer terms: %w", name, err)
+	}
+
+	o, err := tpl.NewOrder(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := submit(o, auctionTerms); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
@@ -0,0 +1,130 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrderTemplates makes sure order templates are validated against the
+// current auctioneer terms when they are saved and used, and that orders
+// created from a template contain the template's parameters.
+func TestOrderTemplates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := newMockStore()
+	liveTerms := &terms.AuctioneerTerms{
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			2016: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+		},
+	}
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			return liveTerms, nil
+		},
+		TermsStore:    store,
+		TermsCacheTTL: time.Hour,
+	})
+
+	ask := &Ask{
+		Kit: newKitFromTemplate(Nonce{0x01}, &Kit{
+			Version:         VersionMinDistinctPeers,
+			State:           StatePartiallyFilled,
+			FixedRate:       21,
+			Amt:             500_000,
+			Units:           5,
+			MaxBatchFeeRate: chainfee.FeePerKwFloor,
+			AcctKey:         [33]byte{2, 1},
+			LeaseDuration:   4032,
+			MinUnitsMatch:   2,
+		}),
+		MinDistinctPeers: 2,
+	}
+	ask.Label = "weekly"
+
+	// The lease duration of the order isn't offered by the auctioneer, so
+	// the template is rejected.
+	err := mgr.SaveOrderTemplate(ctx, "weekly", ask)
+	require.ErrorContains(t, err, "invalid lease duration 4032")
+
+	// Names must not be empty.
+	ask.LeaseDuration = 2016
+	err = mgr.SaveOrderTemplate(ctx, "", ask)
+	require.ErrorIs(t, err, ErrInvalidTemplateName)
+
+	// Bids with a self channel balance depend on the order amount.
+	bid := &Bid{
+		Kit:             *NewKit(Nonce{0x02}),
+		SelfChanBalance: 100_000,
+	}
+	err = mgr.SaveOrderTemplate(ctx, "bid", bid)
+	require.ErrorContains(t, err, "self channel balance")
+
+	// A valid template doesn't contain the order's amount, account, nonce
+	// or state.
+	require.NoError(t, mgr.SaveOrderTemplate(ctx, "weekly", ask))
+	tpl := store.templates["weekly"]
+	require.Equal(t, ZeroNonce, tpl.Order.Nonce())
+	require.Zero(t, tpl.Order.Details().Amt)
+	require.Zero(t, tpl.Order.Details().AcctKey)
+	require.Equal(t, StateSubmitted, tpl.Order.Details().State)
+	require.EqualValues(t, 2, tpl.Order.(*Ask).MinDistinctPeers)
+
+	// An order created from the template gets a fresh nonce and the
+	// amount, account and overridden parameters.
+	var submitted Order
+	submit := func(o Order, _ *terms.AuctioneerTerms) error {
+		submitted = o
+		return nil
+	}
+	overrides := &TemplateOverrides{
+		Amt:             1_000_000,
+		AcctKey:         [33]byte{2, 2},
+		MaxBatchFeeRate: chainfee.FeePerKwFloor * 2,
+	}
+	o, err := mgr.SubmitOrderFromTemplate(ctx, "weekly", overrides, submit)
+	require.NoError(t, err)
+	require.Equal(t, submitted, o)
+	require.NotEqual(t, ZeroNonce, o.Nonce())
+	require.Equal(t, o.Nonce(), Nonce(o.Details().Preimage.Hash()))
+	require.Equal(t, btcutil.Amount(1_000_000), o.Details().Amt)
+	require.Equal(t, SupplyUnit(10), o.Details().Units)
+	require.Equal(t, SupplyUnit(10), o.Details().UnitsUnfulfilled)
+	require.Equal(t, overrides.AcctKey, o.Details().AcctKey)
+	require.Equal(t, overrides.MaxBatchFeeRate, o.Details().MaxBatchFeeRate)
+	require.Equal(t, "weekly", o.Details().Label)
+	require.EqualValues(t, 21, o.Details().FixedRate)
+	require.EqualValues(t, 2, o.Details().MinUnitsMatch)
+	require.EqualValues(t, 2, o.(*Ask).MinDistinctPeers)
+
+	// The amount must be large enough for the template's min units match.
+	overrides.Amt = 100_000
+	_, err = mgr.SubmitOrderFromTemplate(ctx, "weekly", overrides, submit)
+	require.ErrorContains(t, err, "smaller than the min units match")
+
+	_, err = mgr.SubmitOrderFromTemplate(ctx, "unknown", overrides, submit)
+	require.ErrorIs(t, err, ErrNoOrderTemplate)
+
+	// Once the auctioneer stops offering the template's lease duration,
+	// the template fails loudly instead of being submitted.
+	liveTerms = &terms.AuctioneerTerms{
+		LeaseDurationBuckets: map[uint32]auctioneerrpc.DurationBucketState{
+			4032: auctioneerrpc.DurationBucketState_MARKET_OPEN,
+		},
+	}
+	submitted = nil
+	overrides.Amt = 1_000_000
+	_, err = mgr.SubmitOrderFromTemplate(ctx, "weekly", overrides, submit)
+	require.ErrorContains(t, err, "is no longer valid")
+	require.Nil(t, submitted)
+}
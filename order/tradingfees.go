@@ -3,6 +3,7 @@ package order
 import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/pool/account"
 	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/input"
@@ -63,16 +64,20 @@ func PerBlockPremium(amt btcutil.Amount, fixedRate uint32) float64 {
 }
 
 // EstimateTraderFee calculates the chain fees a trader has to pay for their
-// part of a batch transaction. The more outputs a trader creates (channels),
-// the higher fee they will pay.
-func EstimateTraderFee(numTraderChans uint32,
-	feeRate chainfee.SatPerKWeight) btcutil.Amount {
+// part of a batch transaction for an account of the given version. The more
+// outputs a trader creates (channels), the higher fee they will pay.
+func EstimateTraderFee(numTraderChans uint32, feeRate chainfee.SatPerKWeight,
+	accountVersion account.Version) btcutil.Amount {
 
 	var weightEstimate int64
 
 	// First we'll tack on the size of their account output that will be
 	// threaded through in this batch.
-	weightEstimate += input.P2WSHOutputSize
+	if accountVersion == account.VersionTaprootEnabled {
+		weightEstimate += input.P2TROutputSize
+	} else {
+		weightEstimate += input.P2WSHOutputSize
+	}
 
 	// Next we'll add the size of a typical input to account for the input
 	// spending their account outpoint.
@@ -90,11 +95,30 @@ func EstimateTraderFee(numTraderChans uint32,
 
 	// Finally, we tack on the size of the witness spending the account
 	// outpoint.
-	weightEstimate += poolscript.MultiSigWitnessSize
+	weightEstimate += int64(
+		accountVersion.ScriptVersion().MultiSigWitnessSize(),
+	)
 
 	return feeRate.FeeForWeight(weightEstimate)
 }
 
+// OrderChainFee returns the share of the chain fees an account pays for its
+// part of a batch transaction that is attributed to one of its orders. The
+// account's chain fee is split evenly between all channels it creates in the
+// batch, so an order pays for as many of them as it was matched with.
+func OrderChainFee(numOrderChans, numAccountChans uint32,
+	feeRate chainfee.SatPerKWeight,
+	accountVersion account.Version) btcutil.Amount {
+
+	if numAccountChans == 0 {
+		return 0
+	}
+
+	accountFee := EstimateTraderFee(numAccountChans, feeRate, accountVersion)
+	return accountFee * btcutil.Amount(numOrderChans) /
+		btcutil.Amount(numAccountChans)
+}
+
 // Quote is a struct holding the result of an order quote calculation.
 type Quote struct {
 	// TotalPremium is the total order premium in satoshis for filling the
@@ -123,8 +147,12 @@ func NewQuote(amt, minChanAmt btcutil.Amount, rate FixedRatePremium,
 
 	exeFee := schedule.BaseFee() + schedule.ExecutionFee(amt)
 
+	// We don't know the account the order will be submitted with yet, so
+	// we assume the larger legacy account.
 	maxNumMatches := amt / minChanAmt
-	chainFee := maxNumMatches * EstimateTraderFee(1, maxBatchFeeRate)
+	chainFee := maxNumMatches * EstimateTraderFee(
+		1, maxBatchFeeRate, account.VersionInitialNoVersion,
+	)
 
 	return &Quote{
 		TotalPremium:      rate.LumpSumPremium(amt, leaseDuration),
@@ -249,10 +277,14 @@ func (t *AccountTally) CalcTakerDelta(feeSchedule terms.FeeSchedule,
 }
 
 // ChainFees estimates the chain fees that need to be paid for the number of
-// channels created for this account and subtracts that value from the ending
-// balance.
-func (t *AccountTally) ChainFees(feeRate chainfee.SatPerKWeight) {
-	chainFeesDue := EstimateTraderFee(t.NumChansCreated, feeRate)
+// channels created for this account of the given version and subtracts that
+// value from the ending balance.
+func (t *AccountTally) ChainFees(feeRate chainfee.SatPerKWeight,
+	accountVersion account.Version) {
+
+	chainFeesDue := EstimateTraderFee(
+		t.NumChansCreated, feeRate, accountVersion,
+	)
 	t.EndingBalance -= chainFeesDue
 }
 
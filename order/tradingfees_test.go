@@ -1,6 +1,7 @@
 package order
 
 import (
+	"context"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcutil"
@@ -83,3 +84,48 @@ func TestNewQuote(t *testing.T) {
 		require.Equal(t, tc.result, q)
 	}
 }
+
+// TestQuoteOrder makes sure the order manager quotes an order with the fee
+// schedule of the auctioneer terms without storing the order.
+func TestQuoteOrder(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	mgr := NewManager(&ManagerConfig{
+		Store: store,
+		FetchTerms: func(context.Context) (*terms.AuctioneerTerms,
+			error) {
+
+			return &terms.AuctioneerTerms{
+				OrderExecBaseFee: 1,
+				OrderExecFeeRate: 1000,
+			}, nil
+		},
+		TermsStore: store,
+	})
+
+	maxBatchFeeRate := chainfee.SatPerKVByte(100_000).FeePerKWeight()
+	bid := &Bid{
+		Kit: newKitFromTemplate(ZeroNonce, &Kit{
+			Amt:             5_000_000,
+			FixedRate:       14880,
+			LeaseDuration:   2016,
+			MinUnitsMatch:   2,
+			MaxBatchFeeRate: maxBatchFeeRate,
+		}),
+	}
+	q, err := mgr.QuoteOrder(context.Background(), bid)
+	require.NoError(t, err)
+	require.Equal(t, &Quote{
+		TotalPremium:      149990,
+		RatePerBlock:      0.00001488,
+		TotalExecutionFee: 5001,
+		WorstCaseChainFee: 408125,
+	}, q)
+	require.Empty(t, store.orders)
+
+	// Without a min units match, the worst case chain fee is unknown.
+	bid.MinUnitsMatch = 0
+	_, err = mgr.QuoteOrder(context.Background(), bid)
+	require.Error(t, err)
+}
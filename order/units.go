@@ -0,0 +1,171 @@
+package order
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+const (
+	// BlocksPerYear is the number of blocks that are expected to be mined
+	// within one year at the target rate of one block every ten minutes.
+	// It is used to convert between per-block and annualized rates.
+	BlocksPerYear = 6 * 24 * 365
+)
+
+// amountUnit is a unit suffix that is accepted for amounts.
+type amountUnit struct {
+	// suffix is the suffix of the amount that selects the unit.
+	suffix string
+
+	// msat is the number of millisatoshis one unit is worth.
+	msat float64
+}
+
+// amountUnits are all units that are accepted for amounts. Units with a longer
+// suffix must come first as they are matched in order.
+var amountUnits = []amountUnit{
+	{suffix: "msat", msat: 1},
+	{suffix: "sats", msat: 1000},
+	{suffix: "sat", msat: 1000},
+	{suffix: "btc", msat: btcutil.SatoshiPerBitcoin * 1000},
+}
+
+// ParseAmount parses a human readable amount. Amounts without a unit are
+// interpreted as satoshis, otherwise the unit must be one of the suffixes
+// "btc", "sat" or "msat", for example "0.5btc" or "100000 sat". Amounts that
+// aren't a whole number of satoshis are rejected.
+func ParseAmount(amount string) (btcutil.Amount, error) {
+	str := strings.ToLower(strings.TrimSpace(amount))
+
+	var unit *amountUnit
+	for i := range amountUnits {
+		if strings.HasSuffix(str, amountUnits[i].suffix) {
+			unit = &amountUnits[i]
+			str = strings.TrimSpace(
+				strings.TrimSuffix(str, unit.suffix),
+			)
+			break
+		}
+	}
+
+	// Plain satoshi amounts are parsed as integers so they don't lose
+	// precision.
+	if unit == nil {
+		sats, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q: %v", amount,
+				err)
+		}
+		if sats < 0 {
+			return 0, fmt.Errorf("invalid amount %q: must not be "+
+				"negative", amount)
+		}
+
+		return btcutil.Amount(sats), nil
+	}
+
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %v", amount, err)
+	}
+	if value < 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("invalid amount %q: must not be "+
+			"negative", amount)
+	}
+
+	msat := math.Round(value * unit.msat)
+	if msat > float64(btcutil.MaxSatoshi)*1000 {
+		return 0, fmt.Errorf("invalid amount %q: exceeds the maximum "+
+			"of %v", amount, btcutil.Amount(btcutil.MaxSatoshi))
+	}
+	if math.Mod(msat, 1000) != 0 {
+		return 0, fmt.Errorf("invalid amount %q: must be a whole "+
+			"number of satoshis", amount)
+	}
+
+	return btcutil.Amount(msat / 1000), nil
+}
+
+// ParsePercent parses a percentage with an optional "%" suffix, for example
+// "3.5%" or "3.5".
+func ParsePercent(percent string) (float64, error) {
+	str := strings.TrimSpace(percent)
+	str = strings.TrimSpace(strings.TrimSuffix(str, "%"))
+
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid percentage %q: %v", percent, err)
+	}
+	if value <= 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("invalid percentage %q: must be "+
+			"positive", percent)
+	}
+
+	return value, nil
+}
+
+// NewFixedRateFromPercent converts the interest rate percentage that is paid
+// over the whole lease duration to the per-block fixed rate of an order.
+func NewFixedRateFromPercent(interestPercent float64,
+	durationBlocks uint32) (FixedRatePremium, error) {
+
+	if durationBlocks == 0 {
+		return 0, fmt.Errorf("lease duration must be set to convert " +
+			"an interest rate")
+	}
+
+	// rate = % / 100
+	// rate = rateFixed / totalParts
+	// rateFixed = rate * totalParts
+	//
+	// We then divide the rate by the number of blocks as the interest is
+	// the final lump sum paid over the whole lease duration.
+	rateFixed := interestPercent / 100 * FeeRateTotalParts /
+		float64(durationBlocks)
+
+	return newFixedRate(
+		rateFixed, fmt.Sprintf("%v%% over %v blocks", interestPercent,
+			durationBlocks),
+	)
+}
+
+// NewFixedRateFromAPR converts the annualized interest rate percentage to the
+// per-block fixed rate of an order.
+func NewFixedRateFromAPR(aprPercent float64) (FixedRatePremium, error) {
+	rateFixed := aprPercent / 100 * FeeRateTotalParts / BlocksPerYear
+
+	return newFixedRate(rateFixed, fmt.Sprintf("%v%% APR", aprPercent))
+}
+
+// newFixedRate truncates the given fractional fixed rate to the precision of
+// our fixed point and makes sure it can be expressed.
+func newFixedRate(rateFixed float64, desc string) (FixedRatePremium, error) {
+	// If the value is less than 1, then we aren't able to express it given
+	// the current precision allowed by our fixed point.
+	switch {
+	case math.IsNaN(rateFixed) || rateFixed < 1:
+		return 0, fmt.Errorf("fixed rate of %v is too small (%s), "+
+			"min is 1 (%v%% per block)", uint32(rateFixed), desc,
+			100/FeeRateTotalParts)
+
+	case rateFixed > math.MaxUint32:
+		return 0, fmt.Errorf("fixed rate of %s is too large", desc)
+	}
+
+	return FixedRatePremium(rateFixed), nil
+}
+
+// InterestPercent returns the interest rate in percent that is paid over the
+// given lease duration at the fixed rate.
+func (f FixedRatePremium) InterestPercent(durationBlocks uint32) float64 {
+	return float64(f) / FeeRateTotalParts * float64(durationBlocks) * 100
+}
+
+// APR returns the annualized interest rate in percent of the fixed rate.
+func (f FixedRatePremium) APR() float64 {
+	return f.InterestPercent(BlocksPerYear)
+}
@@ -0,0 +1,102 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseAmount makes sure amounts are parsed correctly with and without a
+// unit suffix.
+func TestParseAmount(t *testing.T) {
+	testCases := []struct {
+		amount string
+		result btcutil.Amount
+		err    string
+	}{{
+		amount: "100000",
+		result: 100_000,
+	}, {
+		amount: "100000sat",
+		result: 100_000,
+	}, {
+		amount: " 100000 sats ",
+		result: 100_000,
+	}, {
+		amount: "0.5btc",
+		result: 50_000_000,
+	}, {
+		amount: "1.23456789 BTC",
+		result: 123_456_789,
+	}, {
+		amount: "5000msat",
+		result: 5,
+	}, {
+		amount: "5500msat",
+		err:    "must be a whole number of satoshis",
+	}, {
+		amount: "0.000000001btc",
+		err:    "must be a whole number of satoshis",
+	}, {
+		amount: "1.5",
+		err:    "invalid amount",
+	}, {
+		amount: "-1btc",
+		err:    "must not be negative",
+	}, {
+		amount: "22000000btc",
+		err:    "exceeds the maximum",
+	}, {
+		amount: "1 eur",
+		err:    "invalid amount",
+	}}
+
+	for _, tc := range testCases {
+		amt, err := ParseAmount(tc.amount)
+		if tc.err != "" {
+			require.ErrorContains(t, err, tc.err, tc.amount)
+			continue
+		}
+
+		require.NoError(t, err, tc.amount)
+		require.Equal(t, tc.result, amt, tc.amount)
+	}
+}
+
+// TestFixedRateConversion makes sure interest rates are converted to the fixed
+// rate of an order and back correctly.
+func TestFixedRateConversion(t *testing.T) {
+	percent, err := ParsePercent("3.5%")
+	require.NoError(t, err)
+	require.Equal(t, 3.5, percent)
+
+	_, err = ParsePercent("-1%")
+	require.ErrorContains(t, err, "must be positive")
+
+	// 3.5% per year are 665.9 parts per billion per block, which is
+	// truncated to the precision of the fixed rate.
+	rate, err := NewFixedRateFromAPR(percent)
+	require.NoError(t, err)
+	require.Equal(t, FixedRatePremium(665), rate)
+	require.InDelta(t, 3.4952, rate.APR(), 0.0001)
+
+	// 1% over 2016 blocks are 4960.3 parts per billion per block.
+	rate, err = NewFixedRateFromPercent(1, 2016)
+	require.NoError(t, err)
+	require.Equal(t, FixedRatePremium(4960), rate)
+	require.InDelta(t, 0.99993, rate.InterestPercent(2016), 0.00001)
+	require.Equal(
+		t, btcutil.Amount(99_993),
+		rate.LumpSumPremium(10_000_000, 2016),
+	)
+
+	_, err = NewFixedRateFromAPR(0.0001)
+	require.ErrorContains(t, err, "too small")
+
+	_, err = NewFixedRateFromPercent(1e9, 1)
+	require.ErrorContains(t, err, "too large")
+
+	_, err = NewFixedRateFromPercent(1, 0)
+	require.ErrorContains(t, err, "lease duration must be set")
+}
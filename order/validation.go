@@ -0,0 +1,147 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+)
+
+// ValidationCode is the machine readable reason an order failed validation.
+// The numeric value of every code is the same as the value of the
+// auctioneerrpc.InvalidOrder_FailReason it maps to.
+type ValidationCode uint8
+
+const (
+	// ValidationInvalidAmt means the order amount is invalid.
+	ValidationInvalidAmt ValidationCode = 0
+
+	// ValidationInvalidRate means the fixed rate of the order is invalid.
+	ValidationInvalidRate ValidationCode = 1
+
+	// ValidationInvalidLeaseDuration means the lease duration is not one
+	// of the active durations.
+	ValidationInvalidLeaseDuration ValidationCode = 2
+
+	// ValidationInvalidMaxBatchFeeRate means the max batch fee rate is
+	// below the fee rate floor.
+	ValidationInvalidMaxBatchFeeRate ValidationCode = 3
+
+	// ValidationInsufficientBalance means the account doesn't have enough
+	// balance for the order.
+	ValidationInsufficientBalance ValidationCode = 4
+
+	// ValidationInvalidNodeTier means the minimum node tier of the bid is
+	// invalid.
+	ValidationInvalidNodeTier ValidationCode = 5
+
+	// ValidationInvalidMinUnitsMatch means the min units match is zero or
+	// exceeds the order units.
+	ValidationInvalidMinUnitsMatch ValidationCode = 6
+
+	// ValidationInvalidNodeIDs means the allowed or not allowed node IDs
+	// are invalid.
+	ValidationInvalidNodeIDs ValidationCode = 7
+
+	// ValidationInvalidChannelType means the channel type is unknown or
+	// not supported by the auctioneer.
+	ValidationInvalidChannelType ValidationCode = 8
+
+	// ValidationInvalidSelfChanBalance means the self channel balance of
+	// the bid is invalid.
+	ValidationInvalidSelfChanBalance ValidationCode = 9
+
+	// ValidationInvalidSidecar means the bid can't be used with its
+	// sidecar ticket.
+	ValidationInvalidSidecar ValidationCode = 10
+
+	// ValidationInvalidMinDistinctPeers means the min distinct peers of
+	// the ask can't be satisfied.
+	ValidationInvalidMinDistinctPeers ValidationCode = 11
+
+	// ValidationInvalidChannelFlags means the unannounced or zero conf
+	// channel flag can't be used.
+	ValidationInvalidChannelFlags ValidationCode = 12
+
+	// ValidationInvalidExpiry means the order would expire right away.
+	ValidationInvalidExpiry ValidationCode = 13
+
+	// ValidationAccountNotReady means the account is not in a state that
+	// allows submitting orders.
+	ValidationAccountNotReady ValidationCode = 14
+)
+
+// FailReason returns the RPC fail reason the validation code maps to.
+func (c ValidationCode) FailReason() auctioneerrpc.InvalidOrder_FailReason {
+	return auctioneerrpc.InvalidOrder_FailReason(c)
+}
+
+// String returns the name of the RPC fail reason of the validation code.
+func (c ValidationCode) String() string {
+	return c.FailReason().String()
+}
+
+// ValidationError is the error that is returned if an order fails validation.
+// It carries a machine readable code and, if the failure can be attributed to
+// a single field, the name and the offending value of that field.
+type ValidationError struct {
+	// Code is the reason the order failed validation.
+	Code ValidationCode
+
+	// Field is the RPC name of the order field that failed validation. It
+	// is empty if the failure can't be attributed to a single field.
+	Field string
+
+	// Value is the offending value of the field, formatted as a string.
+	Value string
+
+	// Err is the underlying human readable error.
+	Err error
+}
+
+// NewValidationError creates a new validation error with the given code for
+// the given field and its offending value.
+func NewValidationError(code ValidationCode, field string, value interface{},
+	err error) *ValidationError {
+
+	var valueStr string
+	if value != nil {
+		valueStr = fmt.Sprintf("%v", value)
+	}
+
+	return &ValidationError{
+		Code:  code,
+		Field: field,
+		Value: valueStr,
+		Err:   err,
+	}
+}
+
+// Error returns the human readable message of the underlying error.
+//
+// NOTE: This is part of the error interface.
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidOrder returns the RPC representation of the validation error for the
+// order with the given nonce.
+func (e *ValidationError) InvalidOrder(
+	nonce Nonce) *auctioneerrpc.InvalidOrder {
+
+	return &auctioneerrpc.InvalidOrder{
+		OrderNonce: nonce[:],
+		FailReason: e.Code.FailReason(),
+		FailString: e.Error(),
+		Field:      e.Field,
+		Value:      e.Value,
+	}
+}
+
+// A compile-time constraint to ensure ValidationError implements the error
+// interface.
+var _ error = (*ValidationError)(nil)
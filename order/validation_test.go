@@ -0,0 +1,67 @@
+package order
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidationCodes makes sure every validation code maps to the RPC fail
+// reason with the same meaning.
+func TestValidationCodes(t *testing.T) {
+	t.Parallel()
+
+	codes := map[ValidationCode]auctioneerrpc.InvalidOrder_FailReason{
+		ValidationInvalidAmt:              auctioneerrpc.InvalidOrder_INVALID_AMT,
+		ValidationInvalidRate:             auctioneerrpc.InvalidOrder_INVALID_RATE,
+		ValidationInvalidLeaseDuration:    auctioneerrpc.InvalidOrder_INVALID_LEASE_DURATION,
+		ValidationInvalidMaxBatchFeeRate:  auctioneerrpc.InvalidOrder_INVALID_MAX_BATCH_FEE_RATE,
+		ValidationInsufficientBalance:     auctioneerrpc.InvalidOrder_INSUFFICIENT_BALANCE,
+		ValidationInvalidNodeTier:         auctioneerrpc.InvalidOrder_INVALID_NODE_TIER,
+		ValidationInvalidMinUnitsMatch:    auctioneerrpc.InvalidOrder_INVALID_MIN_UNITS_MATCH,
+		ValidationInvalidNodeIDs:          auctioneerrpc.InvalidOrder_INVALID_NODE_IDS,
+		ValidationInvalidChannelType:      auctioneerrpc.InvalidOrder_INVALID_CHANNEL_TYPE,
+		ValidationInvalidSelfChanBalance:  auctioneerrpc.InvalidOrder_INVALID_SELF_CHAN_BALANCE,
+		ValidationInvalidSidecar:          auctioneerrpc.InvalidOrder_INVALID_SIDECAR,
+		ValidationInvalidMinDistinctPeers: auctioneerrpc.InvalidOrder_INVALID_MIN_DISTINCT_PEERS,
+		ValidationInvalidChannelFlags:     auctioneerrpc.InvalidOrder_INVALID_CHANNEL_FLAGS,
+		ValidationInvalidExpiry:           auctioneerrpc.InvalidOrder_INVALID_EXPIRY,
+		ValidationAccountNotReady:         auctioneerrpc.InvalidOrder_ACCOUNT_NOT_READY,
+	}
+	require.Len(t, codes, len(auctioneerrpc.InvalidOrder_FailReason_name))
+
+	for code, reason := range codes {
+		require.Equal(t, reason, code.FailReason())
+	}
+}
+
+// TestValidationErrorInvalidOrder makes sure a validation error is turned into
+// the RPC representation of an invalid order.
+func TestValidationErrorInvalidOrder(t *testing.T) {
+	t.Parallel()
+
+	baseErr := errors.New("invalid lease duration")
+	err := NewValidationError(
+		ValidationInvalidLeaseDuration, "lease_duration_blocks",
+		uint32(123), baseErr,
+	)
+	require.ErrorIs(t, err, baseErr)
+	require.Equal(t, baseErr.Error(), err.Error())
+
+	nonce := Nonce{1, 2, 3}
+	require.Equal(t, &auctioneerrpc.InvalidOrder{
+		OrderNonce: nonce[:],
+		FailReason: auctioneerrpc.InvalidOrder_INVALID_LEASE_DURATION,
+		FailString: "invalid lease duration",
+		Field:      "lease_duration_blocks",
+		Value:      "123",
+	}, err.InvalidOrder(nonce))
+
+	// A validation error without a value has an empty value string.
+	err = NewValidationError(
+		ValidationInvalidExpiry, "expiry", nil, baseErr,
+	)
+	require.Empty(t, err.InvalidOrder(nonce).Value)
+}
@@ -0,0 +1,427 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	// sidecarPeerMsgType is the custom peer message type used to send
+	// sidecar tickets directly to the other node. It needs to be in the
+	// custom message range which starts at 32768.
+	sidecarPeerMsgType uint32 = 32860
+
+	// peerMailBoxQueueSize is the number of tickets that are buffered per
+	// stream before new tickets are dropped. Both sides of the negotiation
+	// re-send their tickets until the negotiation is finished, so dropping
+	// a ticket only delays the negotiation.
+	peerMailBoxQueueSize = 10
+)
+
+var (
+	// ErrUnknownPeer is returned if a ticket should be sent to the other
+	// node but its identity isn't known yet.
+	ErrUnknownPeer = errors.New("node of the other party is unknown")
+)
+
+// PeerMessenger is the subset of the lnd client that is needed to exchange
+// custom messages with connected peers.
+type PeerMessenger interface {
+	// SendCustomMessage sends a custom peer message.
+	SendCustomMessage(ctx context.Context,
+		in *lnrpc.SendCustomMessageRequest,
+		opts ...grpc.CallOption) (*lnrpc.SendCustomMessageResponse,
+		error)
+
+	// SubscribeCustomMessages subscribes to a stream of incoming custom
+	// peer messages.
+	SubscribeCustomMessages(ctx context.Context,
+		in *lnrpc.SubscribeCustomMessagesRequest,
+		opts ...grpc.CallOption) (
+		lnrpc.Lightning_SubscribeCustomMessagesClient, error)
+}
+
+// PeerMailBox is a sidecar.MailBox that sends the sidecar tickets directly to
+// the other node as custom peer messages. Each message carries the stream ID of
+// the receiving side followed by the serialized ticket. This allows two nodes
+// that have a direct connection to negotiate a sidecar ticket without using the
+// auctioneer's hash mail server.
+type PeerMailBox struct {
+	client PeerMessenger
+
+	// streams maps a stream ID to the queue of tickets received for it.
+	streams map[[64]byte]chan *sidecar.Ticket
+
+	// providers maps the recipient stream ID of a ticket to the node of
+	// its provider. The provider's node isn't part of the ticket, so it is
+	// either added explicitly or learned from the first ticket received.
+	providers map[[64]byte][33]byte
+
+	sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile-time check to make sure PeerMailBox implements the MailBox
+// interface.
+var _ sidecar.MailBox = (*PeerMailBox)(nil)
+
+// NewPeerMailBox creates a new mailbox that uses custom peer messages.
+func NewPeerMailBox(client PeerMessenger) *PeerMailBox {
+	return &PeerMailBox{
+		client:    client,
+		streams:   make(map[[64]byte]chan *sidecar.Ticket),
+		providers: make(map[[64]byte][33]byte),
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start subscribes to the custom peer messages of lnd.
+func (m *PeerMailBox) Start() {
+	m.wg.Add(1)
+	go m.readMessages()
+}
+
+// Stop stops reading custom peer messages.
+func (m *PeerMailBox) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// AddProvider registers the node of the provider of a ticket. This is needed
+// by the recipient to send its registered ticket to the provider.
+func (m *PeerMailBox) AddProvider(ticket *sidecar.Ticket,
+	node *btcec.PublicKey) error {
+
+	streamID, err := deriveRecipientStreamID(ticket)
+	if err != nil {
+		return err
+	}
+
+	var nodeKey [33]byte
+	copy(nodeKey[:], node.SerializeCompressed())
+
+	m.Lock()
+	m.providers[streamID] = nodeKey
+	m.Unlock()
+
+	return nil
+}
+
+// readMessages reads all custom peer messages from lnd and delivers the ones
+// that contain sidecar tickets. If the subscription fails, it is retried with
+// an increasing back off.
+//
+// NOTE: This method must be run as a goroutine.
+func (m *PeerMailBox) readMessages() {
+	defer m.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var retryTimer backOffer
+	for {
+		err := m.subscribe(ctx)
+		if err != nil {
+			sdcrLog.Errorf("Unable to read custom peer "+
+				"messages: %v", err)
+		}
+
+		select {
+		case <-retryTimer.backOff("peer mailbox"):
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// subscribe subscribes to the custom peer messages and delivers them until
+// the subscription fails or the mailbox is stopped.
+func (m *PeerMailBox) subscribe(pCtx context.Context) error {
+	ctx, cancel := context.WithCancel(pCtx)
+	defer cancel()
+
+	stream, err := m.client.SubscribeCustomMessages(
+		ctx, &lnrpc.SubscribeCustomMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	// The stream can only be interrupted by canceling its context, so we
+	// do that once we're asked to quit.
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		select {
+		case <-m.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		if msg.Type != sidecarPeerMsgType {
+			continue
+		}
+
+		if err := m.deliver(msg.Peer, msg.Data); err != nil {
+			sdcrLog.Warnf("Dropping sidecar message from peer "+
+				"%x: %v", msg.Peer, err)
+		}
+	}
+}
+
+// deliver parses a sidecar message received from the given peer and adds the
+// contained ticket to the queue of the stream it is addressed to.
+func (m *PeerMailBox) deliver(peer, data []byte) error {
+	if len(data) <= 64 {
+		return fmt.Errorf("message too short")
+	}
+
+	var (
+		streamID [64]byte
+		nodeKey  [33]byte
+	)
+	copy(streamID[:], data[:64])
+	copy(nodeKey[:], peer)
+
+	ticket, err := sidecar.DeserializeTicket(bytes.NewReader(data[64:]))
+	if err != nil {
+		return fmt.Errorf("unable to decode ticket: %v", err)
+	}
+
+	providerStreamID, err := deriveProviderStreamID(ticket)
+	if err != nil {
+		return err
+	}
+	recipientStreamID, err := deriveRecipientStreamID(ticket)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	switch streamID {
+	// Tickets sent to the provider must come from the recipient's node
+	// that is part of the registered ticket.
+	case providerStreamID:
+		if ticket.Recipient == nil ||
+			ticket.Recipient.NodePubKey == nil {
+
+			return fmt.Errorf("ticket has no recipient")
+		}
+
+		recipientNode := ticket.Recipient.NodePubKey
+		if !bytes.Equal(recipientNode.SerializeCompressed(), peer) {
+			return fmt.Errorf("ticket not sent by recipient")
+		}
+
+	// Tickets sent to the recipient must come from the provider's node. If
+	// we don't know the provider yet, we'll remember the node for replies.
+	case recipientStreamID:
+		provider, ok := m.providers[recipientStreamID]
+		if ok && provider != nodeKey {
+			return fmt.Errorf("ticket not sent by provider")
+		}
+		m.providers[recipientStreamID] = nodeKey
+
+	default:
+		return fmt.Errorf("stream ID doesn't match ticket")
+	}
+
+	select {
+	case m.queue(streamID) <- ticket:
+	default:
+		return fmt.Errorf("queue of stream %x full", streamID[:])
+	}
+
+	return nil
+}
+
+// queue returns the ticket queue of a stream, creating it if it doesn't exist
+// yet.
+//
+// NOTE: The mutex must be held when calling this method.
+func (m *PeerMailBox) queue(streamID [64]byte) chan *sidecar.Ticket {
+	queue, ok := m.streams[streamID]
+	if !ok {
+		queue = make(chan *sidecar.Ticket, peerMailBoxQueueSize)
+		m.streams[streamID] = queue
+	}
+
+	return queue
+}
+
+// RecvSidecarPkt attempts to receive a new sidecar packet from the other node
+// on the stream defined by the ticket and sidecar ticket role.
+//
+// NOTE: This is part of the sidecar.MailBox interface.
+func (m *PeerMailBox) RecvSidecarPkt(ctx context.Context, pkt *sidecar.Ticket,
+	provider bool) (*sidecar.Ticket, error) {
+
+	streamID, err := deriveStreamID(pkt, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	queue := m.queue(streamID)
+	m.Unlock()
+
+	select {
+	case ticket := <-queue:
+		return ticket, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-m.quit:
+		return nil, fmt.Errorf("peer mailbox shutting down")
+	}
+}
+
+// SendSidecarPkt attempts to send the specified sidecar ticket to the node of
+// the party designated by the provider bool.
+//
+// NOTE: This is part of the sidecar.MailBox interface.
+func (m *PeerMailBox) SendSidecarPkt(ctx context.Context, pkt *sidecar.Ticket,
+	provider bool) error {
+
+	streamID, err := deriveStreamID(pkt, provider)
+	if err != nil {
+		return err
+	}
+
+	peer, err := m.peer(pkt, provider)
+	if err != nil {
+		return err
+	}
+
+	var msg bytes.Buffer
+	_, _ = msg.Write(streamID[:])
+	if err := sidecar.SerializeTicket(&msg, pkt); err != nil {
+		return err
+	}
+
+	sdcrLog.Infof("Sending ticket(state=%v, id=%x) to peer %x", pkt.State,
+		pkt.ID[:], peer)
+
+	_, err = m.client.SendCustomMessage(
+		ctx, &lnrpc.SendCustomMessageRequest{
+			Peer: peer,
+			Type: sidecarPeerMsgType,
+			Data: msg.Bytes(),
+		},
+	)
+	return err
+}
+
+// peer returns the node a ticket should be sent to.
+func (m *PeerMailBox) peer(ticket *sidecar.Ticket, provider bool) ([]byte,
+	error) {
+
+	// The recipient's node is part of every ticket after registration.
+	if !provider {
+		if ticket.Recipient == nil ||
+			ticket.Recipient.NodePubKey == nil {
+
+			return nil, ErrUnknownPeer
+		}
+
+		return ticket.Recipient.NodePubKey.SerializeCompressed(), nil
+	}
+
+	streamID, err := deriveRecipientStreamID(ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	node, ok := m.providers[streamID]
+	if !ok {
+		return nil, ErrUnknownPeer
+	}
+
+	return node[:], nil
+}
+
+// InitSidecarMailbox creates the queue for the given stream ID if it doesn't
+// exist yet.
+//
+// NOTE: This is part of the sidecar.MailBox interface.
+func (m *PeerMailBox) InitSidecarMailbox(streamID [64]byte,
+	_ *sidecar.Ticket) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	_ = m.queue(streamID)
+
+	return nil
+}
+
+// DelSidecarMailbox removes the queue the sidecar ticket recipient used to
+// receive tickets from the provider.
+//
+// NOTE: This is part of the sidecar.MailBox interface.
+func (m *PeerMailBox) DelSidecarMailbox(streamID [64]byte,
+	_ *sidecar.Ticket) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.streams, streamID)
+	delete(m.providers, streamID)
+
+	return nil
+}
+
+// InitAcctMailbox creates the queue for the given stream ID if it doesn't
+// exist yet.
+//
+// NOTE: This is part of the sidecar.MailBox interface.
+func (m *PeerMailBox) InitAcctMailbox(streamID [64]byte,
+	_ *keychain.KeyDescriptor) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	_ = m.queue(streamID)
+
+	return nil
+}
+
+// DelAcctMailbox removes the queue the sidecar ticket provider used to receive
+// tickets from the recipient.
+//
+// NOTE: This is part of the sidecar.MailBox interface.
+func (m *PeerMailBox) DelAcctMailbox(streamID [64]byte,
+	_ *keychain.KeyDescriptor) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.streams, streamID)
+
+	return nil
+}
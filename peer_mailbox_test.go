@@ -0,0 +1,160 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// mockPeerNode is a node that can exchange custom messages with the other
+// mock nodes it is connected to.
+type mockPeerNode struct {
+	pubKey *btcec.PublicKey
+	peers  map[[33]byte]*mockPeerNode
+	msgs   chan *lnrpc.CustomMessage
+}
+
+func newMockPeerNode(key byte) *mockPeerNode {
+	_, pubKey := btcec.PrivKeyFromBytes([]byte{key})
+	return &mockPeerNode{
+		pubKey: pubKey,
+		peers:  make(map[[33]byte]*mockPeerNode),
+		msgs:   make(chan *lnrpc.CustomMessage, 10),
+	}
+}
+
+func (n *mockPeerNode) connect(other *mockPeerNode) {
+	var nodeKey, otherKey [33]byte
+	copy(nodeKey[:], n.pubKey.SerializeCompressed())
+	copy(otherKey[:], other.pubKey.SerializeCompressed())
+
+	n.peers[otherKey] = other
+	other.peers[nodeKey] = n
+}
+
+func (n *mockPeerNode) SendCustomMessage(_ context.Context,
+	in *lnrpc.SendCustomMessageRequest,
+	_ ...grpc.CallOption) (*lnrpc.SendCustomMessageResponse, error) {
+
+	var peerKey [33]byte
+	copy(peerKey[:], in.Peer)
+
+	peer, ok := n.peers[peerKey]
+	if !ok {
+		return nil, ErrUnknownPeer
+	}
+
+	peer.msgs <- &lnrpc.CustomMessage{
+		Peer: n.pubKey.SerializeCompressed(),
+		Type: in.Type,
+		Data: in.Data,
+	}
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+func (n *mockPeerNode) SubscribeCustomMessages(ctx context.Context,
+	_ *lnrpc.SubscribeCustomMessagesRequest, _ ...grpc.CallOption) (
+	lnrpc.Lightning_SubscribeCustomMessagesClient, error) {
+
+	return &mockCustomMsgStream{ctx: ctx, msgs: n.msgs}, nil
+}
+
+type mockCustomMsgStream struct {
+	grpc.ClientStream
+
+	ctx  context.Context
+	msgs chan *lnrpc.CustomMessage
+}
+
+func (s *mockCustomMsgStream) Recv() (*lnrpc.CustomMessage, error) {
+	select {
+	case msg := <-s.msgs:
+		return msg, nil
+
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// TestPeerMailBox makes sure tickets are exchanged between the provider and
+// recipient over custom peer messages and that tickets sent by other nodes are
+// dropped.
+func TestPeerMailBox(t *testing.T) {
+	t.Parallel()
+
+	providerNode := newMockPeerNode(0x01)
+	recipientNode := newMockPeerNode(0x02)
+	otherNode := newMockPeerNode(0x04)
+	providerNode.connect(recipientNode)
+	otherNode.connect(recipientNode)
+	otherNode.connect(providerNode)
+
+	provider := NewPeerMailBox(providerNode)
+	recipient := NewPeerMailBox(recipientNode)
+	other := NewPeerMailBox(otherNode)
+	for _, mailBox := range []*PeerMailBox{provider, recipient, other} {
+		mailBox.Start()
+		defer mailBox.Stop()
+	}
+
+	ticket := &sidecar.Ticket{
+		ID:    [8]byte{1},
+		State: sidecar.StateRegistered,
+		Offer: sidecar.Offer{
+			SignPubKey:     providerNode.pubKey,
+			SigOfferDigest: testOfferSig,
+		},
+		Recipient: &sidecar.Recipient{
+			NodePubKey:     recipientNode.pubKey,
+			MultiSigPubKey: recipientNode.pubKey,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The recipient doesn't know the provider's node before it's added or
+	// learned.
+	err := recipient.SendSidecarPkt(ctx, ticket, true)
+	require.ErrorIs(t, err, ErrUnknownPeer)
+	require.NoError(t, recipient.AddProvider(ticket, providerNode.pubKey))
+
+	// The registered ticket is delivered to the provider.
+	require.NoError(t, recipient.SendSidecarPkt(ctx, ticket, true))
+	received, err := provider.RecvSidecarPkt(ctx, ticket, true)
+	require.NoError(t, err)
+	require.Equal(t, ticket.ID, received.ID)
+	require.Equal(t, sidecar.StateRegistered, received.State)
+
+	// A ticket for the provider that isn't sent by the recipient's node is
+	// dropped.
+	require.NoError(t, other.AddProvider(ticket, providerNode.pubKey))
+	require.NoError(t, other.SendSidecarPkt(ctx, ticket, true))
+
+	// The provider replies to the recipient's node that is part of the
+	// ticket.
+	ordered := *ticket
+	ordered.State = sidecar.StateOrdered
+	require.NoError(t, provider.SendSidecarPkt(ctx, &ordered, false))
+	received, err = recipient.RecvSidecarPkt(ctx, ticket, false)
+	require.NoError(t, err)
+	require.Equal(t, sidecar.StateOrdered, received.State)
+
+	// A ticket for the recipient that isn't sent by the provider's node is
+	// dropped as well.
+	require.NoError(t, other.SendSidecarPkt(ctx, &ordered, false))
+
+	shortCtx, shortCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer shortCancel()
+	_, err = provider.RecvSidecarPkt(shortCtx, ticket, true)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	_, err = recipient.RecvSidecarPkt(shortCtx, ticket, false)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
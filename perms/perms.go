@@ -1,6 +1,10 @@
 package perms
 
-import "gopkg.in/macaroon-bakery.v2/bakery"
+import (
+	"sort"
+
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
 
 // RequiredPermissions is a map of all pool RPC methods and their required
 // macaroon permissions to access poold.
@@ -22,6 +26,10 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/SubscribeServerState": {{
+		Entity: "auction",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/QuoteAccount": {{
 		Entity: "account",
 		Action: "read",
@@ -38,6 +46,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/WithdrawAndCloseAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/SweepExpiredAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/WithdrawAccount": {{
 		Entity: "account",
 		Action: "write",
@@ -46,10 +62,38 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/DepositAccountPsbt": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/FinalizeDeposit": {{
+		Entity: "account",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/RenewAccount": {{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/ScheduleWithdrawAccount": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ListScheduledWithdrawals": {{
+		Entity: "account",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/CancelScheduledWithdraw": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/UpdateAccountAutoRenew": {{
+		Entity: "account",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/UpdateAccountReserve": {{
+		Entity: "account",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/BumpAccountFee": {{
 		Entity: "account",
 		Action: "write",
@@ -58,10 +102,30 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "account",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/AccountEvents": {{
+		Entity: "account",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/SubscribeAccountUpdates": {{
+		Entity: "account",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/ExportAccountWatchOnly": {{
+		Entity: "account",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/SubmitOrder": {{
 		Entity: "order",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/PrepareOrder": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/SubmitSignedOrder": {{
+		Entity: "order",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/ListOrders": {{
 		Entity: "order",
 		Action: "read",
@@ -70,10 +134,61 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "order",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/CancelAllOrders": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ReplaceOrder": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/SaveOrderTemplate": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ListOrderTemplates": {{
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/DeleteOrderTemplate": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/SubmitOrderFromTemplate": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/PruneArchivedOrders": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ActivateOrder": {{
+		Entity: "order",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/OrderStats": {{
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/SubscribeOrderBook": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/OrderBookSnapshot": {{
+		Entity: "auction",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/QuoteOrder": {{
 		Entity: "order",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/QuoteMatch": {{
+		Entity: "order",
+		Action: "read",
+	}, {
+		Entity: "auction",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/AuctionFee": {{
 		Entity: "auction",
 		Action: "read",
@@ -82,6 +197,14 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "auction",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/SubscribeLeaseEvents": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/LeaseEvidence": {{
+		Entity: "auction",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/BatchSnapshot": {{
 		Entity: "auction",
 		Action: "read",
@@ -90,6 +213,18 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "auth",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/ListLsatTokens": {{
+		Entity: "auth",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/RevokeLsatToken": {{
+		Entity: "auth",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/ImportLsatToken": {{
+		Entity: "auth",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/LeaseDurations": {{
 		Entity: "auction",
 		Action: "read",
@@ -106,10 +241,38 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "auction",
 		Action: "read",
 	}},
+	"/poolrpc.Trader/ListLocalBatchSnapshots": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/AcceptedBatchProposal": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/SetBatchPolicy": {{
+		Entity: "auction",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/GetBatchPolicy": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/ListFundingFailures": {{
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/LeaseAudit": {{
+		Entity: "auction",
+		Action: "read",
+	}},
 	"/poolrpc.Trader/OfferSidecar": {{
 		Entity: "order",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/OfferSidecarBatch": {{
+		Entity: "order",
+		Action: "write",
+	}},
 	"/poolrpc.Trader/RegisterSidecar": {{
 		Entity: "order",
 		Action: "write",
@@ -130,4 +293,91 @@ var RequiredPermissions = map[string][]bakery.Op{
 		Entity: "order",
 		Action: "write",
 	}},
+	"/poolrpc.Trader/SubscribeSidecar": {{
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/VerifyDB": {{
+		Entity: "account",
+		Action: "read",
+	}, {
+		Entity: "order",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/SetAuctioneerEndpoint": {{
+		Entity: "auction",
+		Action: "write",
+	}},
+	"/poolrpc.Trader/DumpConfig": {{
+		Entity: "account",
+		Action: "read",
+	}, {
+		Entity: "order",
+		Action: "read",
+	}, {
+		Entity: "auction",
+		Action: "read",
+	}},
+	"/poolrpc.Trader/BakeMacaroon": {{
+		Entity: "macaroon",
+		Action: "generate",
+	}},
+}
+
+// AllPermissions returns all distinct permissions that are required by at
+// least one RPC method, sorted by entity and action.
+func AllPermissions() []bakery.Op {
+	return filterPermissions(func(bakery.Op) bool {
+		return true
+	})
+}
+
+// ReadOnlyPermissions returns all distinct permissions that only grant read
+// access, sorted by entity and action.
+func ReadOnlyPermissions() []bakery.Op {
+	return filterPermissions(func(op bakery.Op) bool {
+		return op.Action == "read"
+	})
+}
+
+// IsKnownPermission returns true if the given permission is required by at
+// least one RPC method.
+func IsKnownPermission(op bakery.Op) bool {
+	for _, ops := range RequiredPermissions {
+		for _, required := range ops {
+			if required == op {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// filterPermissions returns all distinct permissions that are required by at
+// least one RPC method and match the given filter, sorted by entity and
+// action.
+func filterPermissions(filter func(bakery.Op) bool) []bakery.Op {
+	seen := make(map[bakery.Op]struct{})
+	var result []bakery.Op
+	for _, ops := range RequiredPermissions {
+		for _, op := range ops {
+			if _, ok := seen[op]; ok || !filter(op) {
+				continue
+			}
+
+			seen[op] = struct{}{}
+			result = append(result, op)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Entity != result[j].Entity {
+			return result[i].Entity < result[j].Entity
+		}
+
+		return result[i].Action < result[j].Action
+	})
+
+	return result
 }
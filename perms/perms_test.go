@@ -0,0 +1,43 @@
+package perms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// TestPermissionSets makes sure the read-only permissions are a subset of all
+// permissions that doesn't grant any write access.
+func TestPermissionSets(t *testing.T) {
+	t.Parallel()
+
+	all := AllPermissions()
+	readOnly := ReadOnlyPermissions()
+
+	require.Contains(t, all, bakery.Op{Entity: "account", Action: "write"})
+	require.Contains(
+		t, all, bakery.Op{Entity: "macaroon", Action: "generate"},
+	)
+	require.Contains(t, readOnly, bakery.Op{
+		Entity: "account", Action: "read",
+	})
+
+	for _, op := range readOnly {
+		require.Equal(t, "read", op.Action)
+		require.Contains(t, all, op)
+	}
+
+	// Every permission is only listed once.
+	seen := make(map[bakery.Op]struct{})
+	for _, op := range all {
+		require.NotContains(t, seen, op)
+		seen[op] = struct{}{}
+
+		require.True(t, IsKnownPermission(op))
+	}
+
+	require.False(t, IsKnownPermission(bakery.Op{
+		Entity: "account", Action: "generate",
+	}))
+}
@@ -0,0 +1,94 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Store is the interface the batch policy is persisted with.
+type Store interface {
+	// BatchPolicy returns the stored batch policy. If no policy was
+	// stored yet, ErrNoBatchPolicy is returned.
+	BatchPolicy() (*Policy, error)
+
+	// StoreBatchPolicy stores the given batch policy, replacing any
+	// previously stored one.
+	StoreBatchPolicy(p *Policy) error
+}
+
+// Manager holds the active batch policy. The policy can be replaced at any
+// time and is persisted so it survives restarts.
+type Manager struct {
+	store Store
+
+	mtx    sync.RWMutex
+	policy Policy
+}
+
+// NewManager creates a new policy manager that persists the policy in the
+// given store.
+func NewManager(store Store) *Manager {
+	return &Manager{
+		store: store,
+	}
+}
+
+// Start loads the stored batch policy. If no policy was stored yet, all
+// batches are accepted.
+func (m *Manager) Start() error {
+	p, err := m.store.BatchPolicy()
+	switch {
+	case errors.Is(err, ErrNoBatchPolicy):
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to load batch policy: %v", err)
+	}
+
+	m.mtx.Lock()
+	m.policy = *p
+	m.mtx.Unlock()
+
+	if !p.IsEmpty() {
+		log.Infof("Loaded batch policy: %v", p)
+	}
+
+	return nil
+}
+
+// Policy returns a copy of the active batch policy.
+func (m *Manager) Policy() *Policy {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	p := m.policy
+	return &p
+}
+
+// SetPolicy validates and persists the given policy and replaces the active
+// one with it. The new policy applies to the next batch we verify.
+func (m *Manager) SetPolicy(p *Policy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	// We hold the lock while storing the policy so concurrent updates are
+	// persisted in the same order they're applied in.
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if err := m.store.StoreBatchPolicy(p); err != nil {
+		return fmt.Errorf("unable to store batch policy: %v", err)
+	}
+	m.policy = *p
+
+	log.Infof("Batch policy updated: %v", p)
+
+	return nil
+}
+
+// Evaluate checks the batch against the active policy.
+func (m *Manager) Evaluate(b *Batch) error {
+	return m.Policy().Evaluate(b)
+}
@@ -0,0 +1,270 @@
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+const (
+	// bpsPerUnit is the number of basis points in a whole.
+	bpsPerUnit = 10_000
+)
+
+var (
+	// ErrPolicyViolation is the wrapped error that is returned if a batch
+	// violates one or more rules of the batch policy.
+	ErrPolicyViolation = errors.New("batch violates batch policy")
+
+	// ErrNoBatchPolicy is returned if no batch policy was stored yet.
+	ErrNoBatchPolicy = errors.New("no batch policy found")
+)
+
+// RuleType identifies one of the rules of a batch policy.
+type RuleType uint8
+
+const (
+	// RuleMaxChainFee limits the total chain fee all our accounts pay
+	// for a batch.
+	RuleMaxChainFee RuleType = 1
+
+	// RuleMaxPriceDeviation limits how far the clearing price may deviate
+	// from the rate of each of our matched orders.
+	RuleMaxPriceDeviation RuleType = 2
+
+	// RuleMaxMatchedOrders limits the number of our orders that may be
+	// matched in a single batch.
+	RuleMaxMatchedOrders RuleType = 3
+)
+
+// String returns a human readable name of the rule type.
+func (t RuleType) String() string {
+	switch t {
+	case RuleMaxChainFee:
+		return "max_chain_fee"
+
+	case RuleMaxPriceDeviation:
+		return "max_price_deviation"
+
+	case RuleMaxMatchedOrders:
+		return "max_matched_orders"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", t)
+	}
+}
+
+// Policy is a set of declarative rules a batch must satisfy before we accept
+// it. A rule with a zero value is disabled, so the zero value of a policy
+// accepts every batch.
+type Policy struct {
+	// MaxChainFee is the maximum total chain fee our accounts may pay for
+	// a single batch.
+	MaxChainFee btcutil.Amount `json:"max_chain_fee_sat,omitempty"`
+
+	// MaxPriceDeviationBps is the maximum deviation of the clearing price
+	// from the rate of any of our matched orders, in basis points of the
+	// order's rate.
+	MaxPriceDeviationBps uint32 `json:"max_price_deviation_bps,omitempty"`
+
+	// MaxMatchedOrders is the maximum number of our orders that may be
+	// matched in a single batch.
+	MaxMatchedOrders uint32 `json:"max_matched_orders,omitempty"`
+}
+
+// Validate makes sure the policy only contains valid rules.
+func (p *Policy) Validate() error {
+	if p.MaxChainFee < 0 {
+		return fmt.Errorf("max chain fee cannot be negative")
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if none of the rules of the policy are enabled.
+func (p *Policy) IsEmpty() bool {
+	return *p == Policy{}
+}
+
+// String returns a human readable description of the enabled rules.
+func (p *Policy) String() string {
+	if p.IsEmpty() {
+		return "no rules"
+	}
+
+	return fmt.Sprintf("max_chain_fee=%v, max_price_deviation_bps=%d, "+
+		"max_matched_orders=%d", p.MaxChainFee, p.MaxPriceDeviationBps,
+		p.MaxMatchedOrders)
+}
+
+// MatchedOrder is the part of one of our matched orders a policy is evaluated
+// against.
+type MatchedOrder struct {
+	// Nonce is the nonce of our order, only used to describe violations.
+	Nonce string
+
+	// Rate is the fixed rate of our order in parts per billion.
+	Rate uint32
+
+	// ClearingPrice is the clearing price of the batch for the lease
+	// duration of our order, in parts per billion.
+	ClearingPrice uint32
+}
+
+// Batch is the summary of a batch from our point of view that a policy is
+// evaluated against.
+type Batch struct {
+	// ChainFee is the total chain fee all our accounts pay for the batch.
+	ChainFee btcutil.Amount
+
+	// Orders are all our orders that were matched in the batch.
+	Orders []MatchedOrder
+}
+
+// Violation describes why a batch doesn't satisfy one rule of a policy.
+type Violation struct {
+	// Rule is the rule that was violated.
+	Rule RuleType
+
+	// Limit is the limit configured for the rule.
+	Limit uint64
+
+	// Actual is the value of the batch that exceeds the limit.
+	Actual uint64
+
+	// Reason is a human readable description of the violation.
+	Reason string
+}
+
+// ViolationErr is the error returned if a batch violates one or more rules of
+// a policy.
+type ViolationErr struct {
+	// Violations are all rules the batch violates.
+	Violations []*Violation
+}
+
+// Unwrap returns the underlying error cause. This is always
+// ErrPolicyViolation so we can compare any error returned by the policy with
+// errors.Is() but still retain the context which rules were violated.
+func (e *ViolationErr) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+// Error returns a description of all violations.
+//
+// NOTE: This method is part of the error interface.
+func (e *ViolationErr) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for idx, v := range e.Violations {
+		reasons[idx] = fmt.Sprintf("%v: %s", v.Rule, v.Reason)
+	}
+
+	return fmt.Sprintf("%v: %s", ErrPolicyViolation,
+		strings.Join(reasons, ", "))
+}
+
+// Evaluate checks the batch against all enabled rules of the policy. If the
+// batch violates any of them, a ViolationErr describing all violations is
+// returned.
+func (p *Policy) Evaluate(b *Batch) error {
+	var violations []*Violation
+
+	if p.MaxChainFee > 0 && b.ChainFee > p.MaxChainFee {
+		violations = append(violations, &Violation{
+			Rule:   RuleMaxChainFee,
+			Limit:  uint64(p.MaxChainFee),
+			Actual: uint64(b.ChainFee),
+			Reason: fmt.Sprintf("total chain fee %v exceeds %v",
+				b.ChainFee, p.MaxChainFee),
+		})
+	}
+
+	if p.MaxPriceDeviationBps > 0 {
+		for _, o := range b.Orders {
+			deviation := priceDeviationBps(o.Rate, o.ClearingPrice)
+			if deviation <= uint64(p.MaxPriceDeviationBps) {
+				continue
+			}
+
+			violations = append(violations, &Violation{
+				Rule:   RuleMaxPriceDeviation,
+				Limit:  uint64(p.MaxPriceDeviationBps),
+				Actual: deviation,
+				Reason: fmt.Sprintf("clearing price %d of "+
+					"order %s deviates %d bps from its "+
+					"rate %d", o.ClearingPrice, o.Nonce,
+					deviation, o.Rate),
+			})
+		}
+	}
+
+	numOrders := uint64(len(b.Orders))
+	if p.MaxMatchedOrders > 0 && numOrders > uint64(p.MaxMatchedOrders) {
+		violations = append(violations, &Violation{
+			Rule:   RuleMaxMatchedOrders,
+			Limit:  uint64(p.MaxMatchedOrders),
+			Actual: numOrders,
+			Reason: fmt.Sprintf("%d orders matched, maximum is %d",
+				numOrders, p.MaxMatchedOrders),
+		})
+	}
+
+	if len(violations) > 0 {
+		return &ViolationErr{
+			Violations: violations,
+		}
+	}
+
+	return nil
+}
+
+// priceDeviationBps returns the deviation of the clearing price from the given
+// order rate in basis points of the rate, rounded up.
+func priceDeviationBps(rate, clearingPrice uint32) uint64 {
+	diff := uint64(clearingPrice) - uint64(rate)
+	if clearingPrice < rate {
+		diff = uint64(rate) - uint64(clearingPrice)
+	}
+
+	// Orders can't have a zero rate, but let's not divide by zero if
+	// there's a bug somewhere. Any difference is infinitely large then.
+	if rate == 0 {
+		if diff == 0 {
+			return 0
+		}
+		return ^uint64(0)
+	}
+
+	return (diff*bpsPerUnit + uint64(rate) - 1) / uint64(rate)
+}
+
+// LoadFile reads a policy from the JSON file at the given path. Unknown fields
+// are rejected so a typo doesn't silently disable a rule.
+func LoadFile(path string) (*Policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open batch policy file: %v",
+			err)
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+
+	var p Policy
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("unable to parse batch policy file %s: "+
+			"%v", path, err)
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid batch policy in %s: %v", path,
+			err)
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,185 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestEvaluate makes sure each rule rejects only the batches it's meant to
+// and that all violations are reported at once.
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	batch := &Batch{
+		ChainFee: 5_000,
+		Orders: []MatchedOrder{{
+			Nonce:         "aa",
+			Rate:          1_000,
+			ClearingPrice: 1_000,
+		}, {
+			Nonce:         "bb",
+			Rate:          1_000,
+			ClearingPrice: 900,
+		}},
+	}
+
+	testCases := []struct {
+		name          string
+		policy        Policy
+		expectedRules []RuleType
+	}{{
+		name: "empty policy",
+	}, {
+		name: "all rules satisfied",
+		policy: Policy{
+			MaxChainFee:          5_000,
+			MaxPriceDeviationBps: 1_000,
+			MaxMatchedOrders:     2,
+		},
+	}, {
+		name:          "chain fee exceeded",
+		policy:        Policy{MaxChainFee: 4_999},
+		expectedRules: []RuleType{RuleMaxChainFee},
+	}, {
+		name:          "price deviation exceeded",
+		policy:        Policy{MaxPriceDeviationBps: 999},
+		expectedRules: []RuleType{RuleMaxPriceDeviation},
+	}, {
+		name:          "too many orders",
+		policy:        Policy{MaxMatchedOrders: 1},
+		expectedRules: []RuleType{RuleMaxMatchedOrders},
+	}, {
+		name: "all rules violated",
+		policy: Policy{
+			MaxChainFee:          1,
+			MaxPriceDeviationBps: 1,
+			MaxMatchedOrders:     1,
+		},
+		expectedRules: []RuleType{
+			RuleMaxChainFee, RuleMaxPriceDeviation,
+			RuleMaxMatchedOrders,
+		},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Evaluate(batch)
+			if len(tc.expectedRules) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrPolicyViolation)
+
+			var violationErr *ViolationErr
+			require.ErrorAs(t, err, &violationErr)
+
+			rules := make([]RuleType, len(violationErr.Violations))
+			for idx, v := range violationErr.Violations {
+				rules[idx] = v.Rule
+			}
+			require.Equal(t, tc.expectedRules, rules)
+		})
+	}
+}
+
+// TestPriceDeviationBps makes sure the deviation is calculated in both
+// directions and rounded up.
+func TestPriceDeviationBps(t *testing.T) {
+	t.Parallel()
+
+	require.EqualValues(t, 0, priceDeviationBps(1_000, 1_000))
+	require.EqualValues(t, 1_000, priceDeviationBps(1_000, 900))
+	require.EqualValues(t, 1_000, priceDeviationBps(1_000, 1_100))
+	require.EqualValues(t, 20_000, priceDeviationBps(1_000, 3_000))
+	require.EqualValues(t, 1, priceDeviationBps(1_000_000, 1_000_001))
+}
+
+// TestLoadFile makes sure a policy can be read from a JSON file and that
+// unknown rules are rejected.
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	p, err := LoadFile(writeFile("valid.json", `{
+		"max_chain_fee_sat": 20000,
+		"max_price_deviation_bps": 500
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, &Policy{
+		MaxChainFee:          20_000,
+		MaxPriceDeviationBps: 500,
+	}, p)
+
+	_, err = LoadFile(writeFile("typo.json", `{"max_chain_fees": 1}`))
+	require.ErrorContains(t, err, "unknown field")
+
+	_, err = LoadFile(writeFile("negative.json", `{
+		"max_chain_fee_sat": -1
+	}`))
+	require.ErrorContains(t, err, "cannot be negative")
+
+	_, err = LoadFile(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
+
+// mockStore is an in-memory policy store.
+type mockStore struct {
+	policy *Policy
+}
+
+// BatchPolicy returns the stored policy.
+func (s *mockStore) BatchPolicy() (*Policy, error) {
+	if s.policy == nil {
+		return nil, ErrNoBatchPolicy
+	}
+
+	p := *s.policy
+	return &p, nil
+}
+
+// StoreBatchPolicy stores the given policy.
+func (s *mockStore) StoreBatchPolicy(p *Policy) error {
+	stored := *p
+	s.policy = &stored
+	return nil
+}
+
+// TestManager makes sure a policy set on the manager is applied right away
+// and loaded again after a restart.
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	store := &mockStore{}
+	m := NewManager(store)
+	require.NoError(t, m.Start())
+	require.True(t, m.Policy().IsEmpty())
+
+	batch := &Batch{ChainFee: 10_000}
+	require.NoError(t, m.Evaluate(batch))
+
+	require.Error(t, m.SetPolicy(&Policy{MaxChainFee: -1}))
+	require.Nil(t, store.policy)
+
+	require.NoError(t, m.SetPolicy(&Policy{MaxChainFee: 5_000}))
+	require.ErrorIs(t, m.Evaluate(batch), ErrPolicyViolation)
+
+	// Changing the returned copy doesn't change the active policy.
+	m.Policy().MaxChainFee = 0
+	require.ErrorIs(t, m.Evaluate(batch), ErrPolicyViolation)
+
+	restarted := NewManager(store)
+	require.NoError(t, restarted.Start())
+	require.Equal(t, &Policy{MaxChainFee: 5_000}, restarted.Policy())
+}
@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ChangeAddressType int32
+
+const (
+	// Let lnd decide on the type of the change address.
+	ChangeAddressType_CHANGE_ADDRESS_TYPE_DEFAULT ChangeAddressType = 0
+	// Use a native SegWit v0 (p2wkh) change address.
+	ChangeAddressType_CHANGE_ADDRESS_TYPE_P2WKH ChangeAddressType = 1
+	// Use a Taproot (p2tr) change address.
+	ChangeAddressType_CHANGE_ADDRESS_TYPE_P2TR ChangeAddressType = 2
+)
+
+// Enum value maps for ChangeAddressType.
+var (
+	ChangeAddressType_name = map[int32]string{
+		0: "CHANGE_ADDRESS_TYPE_DEFAULT",
+		1: "CHANGE_ADDRESS_TYPE_P2WKH",
+		2: "CHANGE_ADDRESS_TYPE_P2TR",
+	}
+	ChangeAddressType_value = map[string]int32{
+		"CHANGE_ADDRESS_TYPE_DEFAULT": 0,
+		"CHANGE_ADDRESS_TYPE_P2WKH":   1,
+		"CHANGE_ADDRESS_TYPE_P2TR":    2,
+	}
+)
+
+func (x ChangeAddressType) Enum() *ChangeAddressType {
+	p := new(ChangeAddressType)
+	*p = x
+	return p
+}
+
+func (x ChangeAddressType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeAddressType) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeAddressType) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[0]
+}
+
+func (x ChangeAddressType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeAddressType.Descriptor instead.
+func (ChangeAddressType) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{0}
+}
+
 type AccountState int32
 
 const (
@@ -90,11 +142,11 @@ func (x AccountState) String() string {
 }
 
 func (AccountState) Descriptor() protoreflect.EnumDescriptor {
-	return file_trader_proto_enumTypes[0].Descriptor()
+	return file_trader_proto_enumTypes[1].Descriptor()
 }
 
 func (AccountState) Type() protoreflect.EnumType {
-	return &file_trader_proto_enumTypes[0]
+	return &file_trader_proto_enumTypes[1]
 }
 
 func (x AccountState) Number() protoreflect.EnumNumber {
@@ -103,7 +155,59 @@ func (x AccountState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccountState.Descriptor instead.
 func (AccountState) EnumDescriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{0}
+	return file_trader_proto_rawDescGZIP(), []int{1}
+}
+
+type OrderTypeFilter int32
+
+const (
+	// Orders of both types are matched.
+	OrderTypeFilter_ORDER_TYPE_ANY OrderTypeFilter = 0
+	// Only asks are matched.
+	OrderTypeFilter_ORDER_TYPE_ASK OrderTypeFilter = 1
+	// Only bids are matched.
+	OrderTypeFilter_ORDER_TYPE_BID OrderTypeFilter = 2
+)
+
+// Enum value maps for OrderTypeFilter.
+var (
+	OrderTypeFilter_name = map[int32]string{
+		0: "ORDER_TYPE_ANY",
+		1: "ORDER_TYPE_ASK",
+		2: "ORDER_TYPE_BID",
+	}
+	OrderTypeFilter_value = map[string]int32{
+		"ORDER_TYPE_ANY": 0,
+		"ORDER_TYPE_ASK": 1,
+		"ORDER_TYPE_BID": 2,
+	}
+)
+
+func (x OrderTypeFilter) Enum() *OrderTypeFilter {
+	p := new(OrderTypeFilter)
+	*p = x
+	return p
+}
+
+func (x OrderTypeFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderTypeFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[2].Descriptor()
+}
+
+func (OrderTypeFilter) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[2]
+}
+
+func (x OrderTypeFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderTypeFilter.Descriptor instead.
+func (OrderTypeFilter) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{2}
 }
 
 type MatchState int32
@@ -159,11 +263,11 @@ func (x MatchState) String() string {
 }
 
 func (MatchState) Descriptor() protoreflect.EnumDescriptor {
-	return file_trader_proto_enumTypes[1].Descriptor()
+	return file_trader_proto_enumTypes[3].Descriptor()
 }
 
 func (MatchState) Type() protoreflect.EnumType {
-	return &file_trader_proto_enumTypes[1]
+	return &file_trader_proto_enumTypes[3]
 }
 
 func (x MatchState) Number() protoreflect.EnumNumber {
@@ -172,7 +276,7 @@ func (x MatchState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MatchState.Descriptor instead.
 func (MatchState) EnumDescriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{1}
+	return file_trader_proto_rawDescGZIP(), []int{3}
 }
 
 type MatchRejectReason int32
@@ -206,25 +310,107 @@ const (
 	//the reputation score of both nodes, depending on the number of errors
 	//recorded.
 	MatchRejectReason_PARTIAL_REJECT_CHANNEL_FUNDING_FAILED MatchRejectReason = 5
+	//
+	//The client doesn't accept the new expiry the auctioneer proposed for one of
+	//its accounts as part of the batch and rejected the whole batch because of
+	//that.
+	MatchRejectReason_ACCOUNT_EXPIRY_EXTENSION MatchRejectReason = 6
+	//
+	//The client's lnd node has been unhealthy for longer than the configured
+	//grace period and the client rejected the whole batch because of that.
+	MatchRejectReason_TRADER_UNHEALTHY MatchRejectReason = 7
+	//
+	//The share of the batch transaction's chain fee one of the client's orders
+	//would have to pay exceeds the maximum chain fee set on the order and the
+	//client rejected the whole batch because of that.
+	MatchRejectReason_CHAIN_FEE_EXCEEDED MatchRejectReason = 8
+	//
+	//The client rejected the match because it was with a node the order is not
+	//allowed to be matched with.
+	MatchRejectReason_PARTIAL_REJECT_NODE_NOT_ALLOWED MatchRejectReason = 9
+	//
+	//The client rejected the match because the number of units matched is below
+	//the minimum units match of the order.
+	MatchRejectReason_PARTIAL_REJECT_MIN_UNITS_MATCH MatchRejectReason = 10
+	//
+	//The client rejected the match because the channel type of the matched order
+	//is different from the channel type of the order.
+	MatchRejectReason_PARTIAL_REJECT_CHANNEL_TYPE_MISMATCH MatchRejectReason = 11
+	//
+	//The client rejected the match because the matched order requests an
+	//unannounced channel while the order requests an announced one or vice
+	//versa.
+	MatchRejectReason_PARTIAL_REJECT_ANNOUNCEMENT_MISMATCH MatchRejectReason = 12
+	//
+	//The client rejected the match because a bid for a zero conf channel was
+	//matched with an ask that didn't opt in to open zero conf channels.
+	MatchRejectReason_PARTIAL_REJECT_ZERO_CONF_MISMATCH MatchRejectReason = 13
+	//
+	//The client rejected the whole batch because the fee rate of the batch
+	//transaction exceeds the maximum batch fee rate of one of its orders.
+	MatchRejectReason_BATCH_FEE_RATE_EXCEEDED MatchRejectReason = 14
+	//
+	//The client rejected the match because the node of the matched ask is rated
+	//below the minimum node tier of the bid.
+	MatchRejectReason_PARTIAL_REJECT_NODE_TIER_TOO_LOW MatchRejectReason = 15
+	//
+	//The client rejected the match because the initial balances of the sidecar
+	//channel it would create don't match the capacity and push amount of the
+	//sidecar ticket.
+	MatchRejectReason_PARTIAL_REJECT_SIDECAR_BALANCE_MISMATCH MatchRejectReason = 16
+	//
+	//The client rejected the whole batch because it violates one or more rules
+	//of the trader's batch policy.
+	MatchRejectReason_POLICY_VIOLATION MatchRejectReason = 17
+	//
+	//The client rejected the whole batch because the external batch approval
+	//hook rejected it or didn't approve it in time.
+	MatchRejectReason_APPROVAL_REJECTED MatchRejectReason = 18
 )
 
 // Enum value maps for MatchRejectReason.
 var (
 	MatchRejectReason_name = map[int32]string{
-		0: "NONE",
-		1: "SERVER_MISBEHAVIOR",
-		2: "BATCH_VERSION_MISMATCH",
-		3: "PARTIAL_REJECT_COLLATERAL",
-		4: "PARTIAL_REJECT_DUPLICATE_PEER",
-		5: "PARTIAL_REJECT_CHANNEL_FUNDING_FAILED",
+		0:  "NONE",
+		1:  "SERVER_MISBEHAVIOR",
+		2:  "BATCH_VERSION_MISMATCH",
+		3:  "PARTIAL_REJECT_COLLATERAL",
+		4:  "PARTIAL_REJECT_DUPLICATE_PEER",
+		5:  "PARTIAL_REJECT_CHANNEL_FUNDING_FAILED",
+		6:  "ACCOUNT_EXPIRY_EXTENSION",
+		7:  "TRADER_UNHEALTHY",
+		8:  "CHAIN_FEE_EXCEEDED",
+		9:  "PARTIAL_REJECT_NODE_NOT_ALLOWED",
+		10: "PARTIAL_REJECT_MIN_UNITS_MATCH",
+		11: "PARTIAL_REJECT_CHANNEL_TYPE_MISMATCH",
+		12: "PARTIAL_REJECT_ANNOUNCEMENT_MISMATCH",
+		13: "PARTIAL_REJECT_ZERO_CONF_MISMATCH",
+		14: "BATCH_FEE_RATE_EXCEEDED",
+		15: "PARTIAL_REJECT_NODE_TIER_TOO_LOW",
+		16: "PARTIAL_REJECT_SIDECAR_BALANCE_MISMATCH",
+		17: "POLICY_VIOLATION",
+		18: "APPROVAL_REJECTED",
 	}
 	MatchRejectReason_value = map[string]int32{
-		"NONE":                                  0,
-		"SERVER_MISBEHAVIOR":                    1,
-		"BATCH_VERSION_MISMATCH":                2,
-		"PARTIAL_REJECT_COLLATERAL":             3,
-		"PARTIAL_REJECT_DUPLICATE_PEER":         4,
-		"PARTIAL_REJECT_CHANNEL_FUNDING_FAILED": 5,
+		"NONE":                                    0,
+		"SERVER_MISBEHAVIOR":                      1,
+		"BATCH_VERSION_MISMATCH":                  2,
+		"PARTIAL_REJECT_COLLATERAL":               3,
+		"PARTIAL_REJECT_DUPLICATE_PEER":           4,
+		"PARTIAL_REJECT_CHANNEL_FUNDING_FAILED":   5,
+		"ACCOUNT_EXPIRY_EXTENSION":                6,
+		"TRADER_UNHEALTHY":                        7,
+		"CHAIN_FEE_EXCEEDED":                      8,
+		"PARTIAL_REJECT_NODE_NOT_ALLOWED":         9,
+		"PARTIAL_REJECT_MIN_UNITS_MATCH":          10,
+		"PARTIAL_REJECT_CHANNEL_TYPE_MISMATCH":    11,
+		"PARTIAL_REJECT_ANNOUNCEMENT_MISMATCH":    12,
+		"PARTIAL_REJECT_ZERO_CONF_MISMATCH":       13,
+		"BATCH_FEE_RATE_EXCEEDED":                 14,
+		"PARTIAL_REJECT_NODE_TIER_TOO_LOW":        15,
+		"PARTIAL_REJECT_SIDECAR_BALANCE_MISMATCH": 16,
+		"POLICY_VIOLATION":                        17,
+		"APPROVAL_REJECTED":                       18,
 	}
 )
 
@@ -239,11 +425,11 @@ func (x MatchRejectReason) String() string {
 }
 
 func (MatchRejectReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_trader_proto_enumTypes[2].Descriptor()
+	return file_trader_proto_enumTypes[4].Descriptor()
 }
 
 func (MatchRejectReason) Type() protoreflect.EnumType {
-	return &file_trader_proto_enumTypes[2]
+	return &file_trader_proto_enumTypes[4]
 }
 
 func (x MatchRejectReason) Number() protoreflect.EnumNumber {
@@ -252,7 +438,394 @@ func (x MatchRejectReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MatchRejectReason.Descriptor instead.
 func (MatchRejectReason) EnumDescriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{2}
+	return file_trader_proto_rawDescGZIP(), []int{4}
+}
+
+type AccountEventAction int32
+
+const (
+	// The action that triggered the change is not known.
+	AccountEventAction_ACCOUNT_ACTION_UNKNOWN AccountEventAction = 0
+	// The account was created and funded.
+	AccountEventAction_ACCOUNT_ACTION_OPEN AccountEventAction = 1
+	// Funds were deposited into the account.
+	AccountEventAction_ACCOUNT_ACTION_DEPOSIT AccountEventAction = 2
+	// Funds were withdrawn from the account.
+	AccountEventAction_ACCOUNT_ACTION_WITHDRAWAL AccountEventAction = 3
+	// The expiry of the account was changed.
+	AccountEventAction_ACCOUNT_ACTION_RENEWAL AccountEventAction = 4
+	// The account was closed.
+	AccountEventAction_ACCOUNT_ACTION_CLOSE AccountEventAction = 5
+	// The account participated in a batch.
+	AccountEventAction_ACCOUNT_ACTION_BATCH AccountEventAction = 6
+	//
+	//The state of the account changed because of an on-chain event, for example
+	//a confirmation or the account expiring.
+	AccountEventAction_ACCOUNT_ACTION_STATE_CHANGE AccountEventAction = 7
+	//
+	//The fee of the account's latest transaction was bumped through CPFP. The
+	//account itself is not changed by this action.
+	AccountEventAction_ACCOUNT_ACTION_FEE_BUMP AccountEventAction = 8
+)
+
+// Enum value maps for AccountEventAction.
+var (
+	AccountEventAction_name = map[int32]string{
+		0: "ACCOUNT_ACTION_UNKNOWN",
+		1: "ACCOUNT_ACTION_OPEN",
+		2: "ACCOUNT_ACTION_DEPOSIT",
+		3: "ACCOUNT_ACTION_WITHDRAWAL",
+		4: "ACCOUNT_ACTION_RENEWAL",
+		5: "ACCOUNT_ACTION_CLOSE",
+		6: "ACCOUNT_ACTION_BATCH",
+		7: "ACCOUNT_ACTION_STATE_CHANGE",
+		8: "ACCOUNT_ACTION_FEE_BUMP",
+	}
+	AccountEventAction_value = map[string]int32{
+		"ACCOUNT_ACTION_UNKNOWN":      0,
+		"ACCOUNT_ACTION_OPEN":         1,
+		"ACCOUNT_ACTION_DEPOSIT":      2,
+		"ACCOUNT_ACTION_WITHDRAWAL":   3,
+		"ACCOUNT_ACTION_RENEWAL":      4,
+		"ACCOUNT_ACTION_CLOSE":        5,
+		"ACCOUNT_ACTION_BATCH":        6,
+		"ACCOUNT_ACTION_STATE_CHANGE": 7,
+		"ACCOUNT_ACTION_FEE_BUMP":     8,
+	}
+)
+
+func (x AccountEventAction) Enum() *AccountEventAction {
+	p := new(AccountEventAction)
+	*p = x
+	return p
+}
+
+func (x AccountEventAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountEventAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[5].Descriptor()
+}
+
+func (AccountEventAction) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[5]
+}
+
+func (x AccountEventAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountEventAction.Descriptor instead.
+func (AccountEventAction) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{5}
+}
+
+type LeaseChannelState int32
+
+const (
+	//
+	//The channel didn't complete its funding flow yet or was created before the
+	//channels of leases were tracked.
+	LeaseChannelState_LEASE_CHANNEL_STATE_UNKNOWN LeaseChannelState = 0
+	// The channel is open.
+	LeaseChannelState_LEASE_CHANNEL_STATE_OPEN LeaseChannelState = 1
+	// The channel was closed cooperatively or after its lease expired.
+	LeaseChannelState_LEASE_CHANNEL_STATE_CLOSED LeaseChannelState = 2
+	//
+	//The channel was force closed before its lease expired, so it provided less
+	//than the liquidity the premium was paid for.
+	LeaseChannelState_LEASE_CHANNEL_STATE_CLOSED_EARLY LeaseChannelState = 3
+)
+
+// Enum value maps for LeaseChannelState.
+var (
+	LeaseChannelState_name = map[int32]string{
+		0: "LEASE_CHANNEL_STATE_UNKNOWN",
+		1: "LEASE_CHANNEL_STATE_OPEN",
+		2: "LEASE_CHANNEL_STATE_CLOSED",
+		3: "LEASE_CHANNEL_STATE_CLOSED_EARLY",
+	}
+	LeaseChannelState_value = map[string]int32{
+		"LEASE_CHANNEL_STATE_UNKNOWN":      0,
+		"LEASE_CHANNEL_STATE_OPEN":         1,
+		"LEASE_CHANNEL_STATE_CLOSED":       2,
+		"LEASE_CHANNEL_STATE_CLOSED_EARLY": 3,
+	}
+)
+
+func (x LeaseChannelState) Enum() *LeaseChannelState {
+	p := new(LeaseChannelState)
+	*p = x
+	return p
+}
+
+func (x LeaseChannelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseChannelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[6].Descriptor()
+}
+
+func (LeaseChannelState) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[6]
+}
+
+func (x LeaseChannelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseChannelState.Descriptor instead.
+func (LeaseChannelState) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{6}
+}
+
+type LeaseCloseType int32
+
+const (
+	// The channel was closed cooperatively.
+	LeaseCloseType_LEASE_CLOSE_TYPE_COOPERATIVE LeaseCloseType = 0
+	// We force closed the channel.
+	LeaseCloseType_LEASE_CLOSE_TYPE_LOCAL_FORCE LeaseCloseType = 1
+	// Our peer force closed the channel.
+	LeaseCloseType_LEASE_CLOSE_TYPE_REMOTE_FORCE LeaseCloseType = 2
+	// Our peer tried to close the channel with a revoked state.
+	LeaseCloseType_LEASE_CLOSE_TYPE_BREACH LeaseCloseType = 3
+	// The channel's funding transaction never confirmed.
+	LeaseCloseType_LEASE_CLOSE_TYPE_FUNDING_CANCELED LeaseCloseType = 4
+	// The channel was abandoned.
+	LeaseCloseType_LEASE_CLOSE_TYPE_ABANDONED LeaseCloseType = 5
+)
+
+// Enum value maps for LeaseCloseType.
+var (
+	LeaseCloseType_name = map[int32]string{
+		0: "LEASE_CLOSE_TYPE_COOPERATIVE",
+		1: "LEASE_CLOSE_TYPE_LOCAL_FORCE",
+		2: "LEASE_CLOSE_TYPE_REMOTE_FORCE",
+		3: "LEASE_CLOSE_TYPE_BREACH",
+		4: "LEASE_CLOSE_TYPE_FUNDING_CANCELED",
+		5: "LEASE_CLOSE_TYPE_ABANDONED",
+	}
+	LeaseCloseType_value = map[string]int32{
+		"LEASE_CLOSE_TYPE_COOPERATIVE":      0,
+		"LEASE_CLOSE_TYPE_LOCAL_FORCE":      1,
+		"LEASE_CLOSE_TYPE_REMOTE_FORCE":     2,
+		"LEASE_CLOSE_TYPE_BREACH":           3,
+		"LEASE_CLOSE_TYPE_FUNDING_CANCELED": 4,
+		"LEASE_CLOSE_TYPE_ABANDONED":        5,
+	}
+)
+
+func (x LeaseCloseType) Enum() *LeaseCloseType {
+	p := new(LeaseCloseType)
+	*p = x
+	return p
+}
+
+func (x LeaseCloseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaseCloseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[7].Descriptor()
+}
+
+func (LeaseCloseType) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[7]
+}
+
+func (x LeaseCloseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaseCloseType.Descriptor instead.
+func (LeaseCloseType) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{7}
+}
+
+type AuctioneerConnectionState int32
+
+const (
+	//
+	//The connection to the auction server wasn't opened yet because there is no
+	//active account, or the trader daemon is shutting down.
+	AuctioneerConnectionState_AUCTIONEER_DISCONNECTED AuctioneerConnectionState = 0
+	//
+	//The connection to the auction server is open and all accounts are
+	//subscribed to it.
+	AuctioneerConnectionState_AUCTIONEER_CONNECTED AuctioneerConnectionState = 1
+	//
+	//The connection to the auction server was re-established, but not all
+	//accounts could be subscribed again or the pending batch couldn't be
+	//checked. Another reconnect attempt is scheduled.
+	AuctioneerConnectionState_AUCTIONEER_DEGRADED AuctioneerConnectionState = 2
+	//
+	//The connection to the auction server was lost and the trader daemon is
+	//trying to re-establish it.
+	AuctioneerConnectionState_AUCTIONEER_RECONNECTING AuctioneerConnectionState = 3
+)
+
+// Enum value maps for AuctioneerConnectionState.
+var (
+	AuctioneerConnectionState_name = map[int32]string{
+		0: "AUCTIONEER_DISCONNECTED",
+		1: "AUCTIONEER_CONNECTED",
+		2: "AUCTIONEER_DEGRADED",
+		3: "AUCTIONEER_RECONNECTING",
+	}
+	AuctioneerConnectionState_value = map[string]int32{
+		"AUCTIONEER_DISCONNECTED": 0,
+		"AUCTIONEER_CONNECTED":    1,
+		"AUCTIONEER_DEGRADED":     2,
+		"AUCTIONEER_RECONNECTING": 3,
+	}
+)
+
+func (x AuctioneerConnectionState) Enum() *AuctioneerConnectionState {
+	p := new(AuctioneerConnectionState)
+	*p = x
+	return p
+}
+
+func (x AuctioneerConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuctioneerConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[8].Descriptor()
+}
+
+func (AuctioneerConnectionState) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[8]
+}
+
+func (x AuctioneerConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuctioneerConnectionState.Descriptor instead.
+func (AuctioneerConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{8}
+}
+
+type HealthGateState int32
+
+const (
+	//
+	//The health monitor is disabled and batches are never rejected because of
+	//the state of the lnd node.
+	HealthGateState_HEALTH_GATE_DISABLED HealthGateState = 0
+	// The lnd node is healthy and the trader takes part in batches.
+	HealthGateState_HEALTH_GATE_OPEN HealthGateState = 1
+	//
+	//The lnd node is unhealthy but the configured grace period hasn't passed
+	//yet, so the trader still takes part in batches.
+	HealthGateState_HEALTH_GATE_GRACE_PERIOD HealthGateState = 2
+	//
+	//The lnd node has been unhealthy for longer than the grace period and the
+	//trader rejects all batches until it is healthy again.
+	HealthGateState_HEALTH_GATE_CLOSED HealthGateState = 3
+)
+
+// Enum value maps for HealthGateState.
+var (
+	HealthGateState_name = map[int32]string{
+		0: "HEALTH_GATE_DISABLED",
+		1: "HEALTH_GATE_OPEN",
+		2: "HEALTH_GATE_GRACE_PERIOD",
+		3: "HEALTH_GATE_CLOSED",
+	}
+	HealthGateState_value = map[string]int32{
+		"HEALTH_GATE_DISABLED":     0,
+		"HEALTH_GATE_OPEN":         1,
+		"HEALTH_GATE_GRACE_PERIOD": 2,
+		"HEALTH_GATE_CLOSED":       3,
+	}
+)
+
+func (x HealthGateState) Enum() *HealthGateState {
+	p := new(HealthGateState)
+	*p = x
+	return p
+}
+
+func (x HealthGateState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthGateState) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[9].Descriptor()
+}
+
+func (HealthGateState) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[9]
+}
+
+func (x HealthGateState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthGateState.Descriptor instead.
+func (HealthGateState) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{9}
+}
+
+type SidecarTransport int32
+
+const (
+	//
+	//Use the default transport that is configured in the daemon.
+	SidecarTransport_SIDECAR_TRANSPORT_DEFAULT SidecarTransport = 0
+	//
+	//Exchange the tickets through the auctioneer's hash mail server.
+	SidecarTransport_SIDECAR_TRANSPORT_HASHMAIL SidecarTransport = 1
+	//
+	//Exchange the tickets directly with the other node using custom lnd peer
+	//messages. The two nodes must be connected as peers.
+	SidecarTransport_SIDECAR_TRANSPORT_PEER SidecarTransport = 2
+)
+
+// Enum value maps for SidecarTransport.
+var (
+	SidecarTransport_name = map[int32]string{
+		0: "SIDECAR_TRANSPORT_DEFAULT",
+		1: "SIDECAR_TRANSPORT_HASHMAIL",
+		2: "SIDECAR_TRANSPORT_PEER",
+	}
+	SidecarTransport_value = map[string]int32{
+		"SIDECAR_TRANSPORT_DEFAULT":  0,
+		"SIDECAR_TRANSPORT_HASHMAIL": 1,
+		"SIDECAR_TRANSPORT_PEER":     2,
+	}
+)
+
+func (x SidecarTransport) Enum() *SidecarTransport {
+	p := new(SidecarTransport)
+	*p = x
+	return p
+}
+
+func (x SidecarTransport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SidecarTransport) Descriptor() protoreflect.EnumDescriptor {
+	return file_trader_proto_enumTypes[10].Descriptor()
+}
+
+func (SidecarTransport) Type() protoreflect.EnumType {
+	return &file_trader_proto_enumTypes[10]
+}
+
+func (x SidecarTransport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SidecarTransport.Descriptor instead.
+func (SidecarTransport) EnumDescriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{10}
 }
 
 type InitAccountRequest struct {
@@ -276,6 +849,17 @@ type InitAccountRequest struct {
 	//full picture of the binary used (poold, LiT) and the method used for opening
 	//the account (pool CLI, LiT UI, other 3rd party UI).
 	Initiator string `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//An optional list of UTXOs of the backing lnd node's wallet to fund the
+	//account with. If set, only these inputs are used and they must cover the
+	//account value plus fees. Otherwise lnd selects the inputs.
+	Inputs []*auctioneerrpc.OutPoint `protobuf:"bytes,7,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The address type of the change output, if one is needed.
+	ChangeType ChangeAddressType `protobuf:"varint,8,opt,name=change_type,json=changeType,proto3,enum=poolrpc.ChangeAddressType" json:"change_type,omitempty"`
+	//
+	//An optional ceiling for the fee of the funding transaction. The account is
+	//not created if the transaction would exceed it.
+	FeeLimit *FeeLimit `protobuf:"bytes,9,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
 }
 
 func (x *InitAccountRequest) Reset() {
@@ -366,11 +950,32 @@ func (x *InitAccountRequest) GetInitiator() string {
 	return ""
 }
 
-type isInitAccountRequest_AccountExpiry interface {
-	isInitAccountRequest_AccountExpiry()
+func (x *InitAccountRequest) GetInputs() []*auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
 }
 
-type InitAccountRequest_AbsoluteHeight struct {
+func (x *InitAccountRequest) GetChangeType() ChangeAddressType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ChangeAddressType_CHANGE_ADDRESS_TYPE_DEFAULT
+}
+
+func (x *InitAccountRequest) GetFeeLimit() *FeeLimit {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
+}
+
+type isInitAccountRequest_AccountExpiry interface {
+	isInitAccountRequest_AccountExpiry()
+}
+
+type InitAccountRequest_AbsoluteHeight struct {
 	AbsoluteHeight uint32 `protobuf:"varint,2,opt,name=absolute_height,json=absoluteHeight,proto3,oneof"`
 }
 
@@ -403,6 +1008,185 @@ func (*InitAccountRequest_ConfTarget) isInitAccountRequest_Fees() {}
 
 func (*InitAccountRequest_FeeRateSatPerKw) isInitAccountRequest_Fees() {}
 
+type FeeLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The maximum absolute fee in satoshis the transaction may pay. Zero means no
+	//limit.
+	MaxFeeSat uint64 `protobuf:"varint,1,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+	//
+	//The maximum fee rate in satoshis per kw the transaction may pay. Zero means
+	//no limit.
+	MaxFeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=max_fee_rate_sat_per_kw,json=maxFeeRateSatPerKw,proto3" json:"max_fee_rate_sat_per_kw,omitempty"`
+}
+
+func (x *FeeLimit) Reset() {
+	*x = FeeLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeLimit) ProtoMessage() {}
+
+func (x *FeeLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeLimit.ProtoReflect.Descriptor instead.
+func (*FeeLimit) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeLimit) GetMaxFeeSat() uint64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+func (x *FeeLimit) GetMaxFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.MaxFeeRateSatPerKw
+	}
+	return 0
+}
+
+type AccountTxDryRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized unsigned transaction.
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	//
+	//The total value in satoshis of all inputs of the transaction, including
+	//the account input.
+	InputTotalSat uint64 `protobuf:"varint,2,opt,name=input_total_sat,json=inputTotalSat,proto3" json:"input_total_sat,omitempty"`
+	// The total value in satoshis of all outputs of the transaction.
+	OutputTotalSat uint64 `protobuf:"varint,3,opt,name=output_total_sat,json=outputTotalSat,proto3" json:"output_total_sat,omitempty"`
+	// The fee in satoshis the transaction pays.
+	FeeSat uint64 `protobuf:"varint,4,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	// The estimated virtual size of the transaction once it is signed.
+	Vsize uint64 `protobuf:"varint,5,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	// The fee rate, in satoshis per kw, the transaction pays.
+	FeeRateSatPerKw uint64 `protobuf:"varint,6,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	//
+	//The inputs of the backing lnd node's wallet that fund a deposit. They are
+	//leased until lease_expiry and used again by a deposit of the same amount
+	//at the same fee rate into the same account.
+	WalletInputs []*auctioneerrpc.OutPoint `protobuf:"bytes,7,rep,name=wallet_inputs,json=walletInputs,proto3" json:"wallet_inputs,omitempty"`
+	//
+	//The unix timestamp in seconds at which the lease of the wallet inputs
+	//expires.
+	LeaseExpiry int64 `protobuf:"varint,8,opt,name=lease_expiry,json=leaseExpiry,proto3" json:"lease_expiry,omitempty"`
+}
+
+func (x *AccountTxDryRun) Reset() {
+	*x = AccountTxDryRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountTxDryRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTxDryRun) ProtoMessage() {}
+
+func (x *AccountTxDryRun) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTxDryRun.ProtoReflect.Descriptor instead.
+func (*AccountTxDryRun) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountTxDryRun) GetRawTx() []byte {
+	if x != nil {
+		return x.RawTx
+	}
+	return nil
+}
+
+func (x *AccountTxDryRun) GetInputTotalSat() uint64 {
+	if x != nil {
+		return x.InputTotalSat
+	}
+	return 0
+}
+
+func (x *AccountTxDryRun) GetOutputTotalSat() uint64 {
+	if x != nil {
+		return x.OutputTotalSat
+	}
+	return 0
+}
+
+func (x *AccountTxDryRun) GetFeeSat() uint64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+func (x *AccountTxDryRun) GetVsize() uint64 {
+	if x != nil {
+		return x.Vsize
+	}
+	return 0
+}
+
+func (x *AccountTxDryRun) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *AccountTxDryRun) GetWalletInputs() []*auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.WalletInputs
+	}
+	return nil
+}
+
+func (x *AccountTxDryRun) GetLeaseExpiry() int64 {
+	if x != nil {
+		return x.LeaseExpiry
+	}
+	return 0
+}
+
 type QuoteAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -417,7 +1201,7 @@ type QuoteAccountRequest struct {
 func (x *QuoteAccountRequest) Reset() {
 	*x = QuoteAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[1]
+		mi := &file_trader_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -430,7 +1214,7 @@ func (x *QuoteAccountRequest) String() string {
 func (*QuoteAccountRequest) ProtoMessage() {}
 
 func (x *QuoteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[1]
+	mi := &file_trader_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +1227,7 @@ func (x *QuoteAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuoteAccountRequest.ProtoReflect.Descriptor instead.
 func (*QuoteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{1}
+	return file_trader_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *QuoteAccountRequest) GetAccountValue() uint64 {
@@ -491,7 +1275,7 @@ type QuoteAccountResponse struct {
 func (x *QuoteAccountResponse) Reset() {
 	*x = QuoteAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[2]
+		mi := &file_trader_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -504,7 +1288,7 @@ func (x *QuoteAccountResponse) String() string {
 func (*QuoteAccountResponse) ProtoMessage() {}
 
 func (x *QuoteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[2]
+	mi := &file_trader_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +1301,7 @@ func (x *QuoteAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuoteAccountResponse.ProtoReflect.Descriptor instead.
 func (*QuoteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{2}
+	return file_trader_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *QuoteAccountResponse) GetMinerFeeRateSatPerKw() uint64 {
@@ -547,7 +1331,7 @@ type ListAccountsRequest struct {
 func (x *ListAccountsRequest) Reset() {
 	*x = ListAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[3]
+		mi := &file_trader_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -560,7 +1344,7 @@ func (x *ListAccountsRequest) String() string {
 func (*ListAccountsRequest) ProtoMessage() {}
 
 func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[3]
+	mi := &file_trader_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +1357,7 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{3}
+	return file_trader_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListAccountsRequest) GetActiveOnly() bool {
@@ -594,7 +1378,7 @@ type ListAccountsResponse struct {
 func (x *ListAccountsResponse) Reset() {
 	*x = ListAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[4]
+		mi := &file_trader_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -607,7 +1391,7 @@ func (x *ListAccountsResponse) String() string {
 func (*ListAccountsResponse) ProtoMessage() {}
 
 func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[4]
+	mi := &file_trader_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +1404,7 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{4}
+	return file_trader_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListAccountsResponse) GetAccounts() []*Account {
@@ -644,7 +1428,7 @@ type Output struct {
 func (x *Output) Reset() {
 	*x = Output{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[5]
+		mi := &file_trader_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +1441,7 @@ func (x *Output) String() string {
 func (*Output) ProtoMessage() {}
 
 func (x *Output) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[5]
+	mi := &file_trader_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +1454,7 @@ func (x *Output) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Output.ProtoReflect.Descriptor instead.
 func (*Output) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{5}
+	return file_trader_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Output) GetValueSat() uint64 {
@@ -703,7 +1487,7 @@ type OutputWithFee struct {
 func (x *OutputWithFee) Reset() {
 	*x = OutputWithFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[6]
+		mi := &file_trader_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -716,7 +1500,7 @@ func (x *OutputWithFee) String() string {
 func (*OutputWithFee) ProtoMessage() {}
 
 func (x *OutputWithFee) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[6]
+	mi := &file_trader_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,7 +1513,7 @@ func (x *OutputWithFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputWithFee.ProtoReflect.Descriptor instead.
 func (*OutputWithFee) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{6}
+	return file_trader_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OutputWithFee) GetAddress() string {
@@ -791,7 +1575,7 @@ type OutputsWithImplicitFee struct {
 func (x *OutputsWithImplicitFee) Reset() {
 	*x = OutputsWithImplicitFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[7]
+		mi := &file_trader_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +1588,7 @@ func (x *OutputsWithImplicitFee) String() string {
 func (*OutputsWithImplicitFee) ProtoMessage() {}
 
 func (x *OutputsWithImplicitFee) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[7]
+	mi := &file_trader_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1601,7 @@ func (x *OutputsWithImplicitFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputsWithImplicitFee.ProtoReflect.Descriptor instead.
 func (*OutputsWithImplicitFee) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{7}
+	return file_trader_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OutputsWithImplicitFee) GetOutputs() []*Output {
@@ -838,12 +1622,20 @@ type CloseAccountRequest struct {
 	//	*CloseAccountRequest_OutputWithFee
 	//	*CloseAccountRequest_Outputs
 	FundsDestination isCloseAccountRequest_FundsDestination `protobuf_oneof:"funds_destination"`
+	//
+	//An optional ceiling for the fee of the closing transaction. The account is
+	//not closed if the transaction would exceed it.
+	FeeLimit *FeeLimit `protobuf:"bytes,4,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	//
+	//If set, the closing transaction is only created and returned unsigned,
+	//nothing is signed or broadcast.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *CloseAccountRequest) Reset() {
 	*x = CloseAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[8]
+		mi := &file_trader_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1648,7 @@ func (x *CloseAccountRequest) String() string {
 func (*CloseAccountRequest) ProtoMessage() {}
 
 func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[8]
+	mi := &file_trader_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +1661,7 @@ func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseAccountRequest.ProtoReflect.Descriptor instead.
 func (*CloseAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{8}
+	return file_trader_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CloseAccountRequest) GetTraderKey() []byte {
@@ -900,6 +1692,20 @@ func (x *CloseAccountRequest) GetOutputs() *OutputsWithImplicitFee {
 	return nil
 }
 
+func (x *CloseAccountRequest) GetFeeLimit() *FeeLimit {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
+}
+
+func (x *CloseAccountRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type isCloseAccountRequest_FundsDestination interface {
 	isCloseAccountRequest_FundsDestination()
 }
@@ -933,12 +1739,14 @@ type CloseAccountResponse struct {
 
 	// The hash of the closing transaction.
 	CloseTxid []byte `protobuf:"bytes,1,opt,name=close_txid,json=closeTxid,proto3" json:"close_txid,omitempty"`
+	// The unsigned closing transaction, only set for a dry run.
+	DryRun *AccountTxDryRun `protobuf:"bytes,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *CloseAccountResponse) Reset() {
 	*x = CloseAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[9]
+		mi := &file_trader_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -951,7 +1759,7 @@ func (x *CloseAccountResponse) String() string {
 func (*CloseAccountResponse) ProtoMessage() {}
 
 func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[9]
+	mi := &file_trader_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1772,7 @@ func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseAccountResponse.ProtoReflect.Descriptor instead.
 func (*CloseAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{9}
+	return file_trader_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CloseAccountResponse) GetCloseTxid() []byte {
@@ -974,43 +1782,44 @@ func (x *CloseAccountResponse) GetCloseTxid() []byte {
 	return nil
 }
 
-type WithdrawAccountRequest struct {
+func (x *CloseAccountResponse) GetDryRun() *AccountTxDryRun {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type WithdrawAndCloseAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The trader key associated with the account that funds will be withdrawed
-	//from.
+	// The trader key associated with the account that will be closed.
 	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	// The outputs we'll withdraw funds from the account into.
+	// The outputs the withdrawn part of the account balance is sent to.
 	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	//
-	//The fee rate, in satoshis per kw, to use for the withdrawal transaction.
+	//The fee rate, in satoshis per kw, to use for the closing transaction.
 	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
-	// Types that are assignable to AccountExpiry:
-	//	*WithdrawAccountRequest_AbsoluteExpiry
-	//	*WithdrawAccountRequest_RelativeExpiry
-	AccountExpiry isWithdrawAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
 }
 
-func (x *WithdrawAccountRequest) Reset() {
-	*x = WithdrawAccountRequest{}
+func (x *WithdrawAndCloseAccountRequest) Reset() {
+	*x = WithdrawAndCloseAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[10]
+		mi := &file_trader_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawAccountRequest) String() string {
+func (x *WithdrawAndCloseAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawAccountRequest) ProtoMessage() {}
+func (*WithdrawAndCloseAccountRequest) ProtoMessage() {}
 
-func (x *WithdrawAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[10]
+func (x *WithdrawAndCloseAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,99 +1830,112 @@ func (x *WithdrawAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawAccountRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use WithdrawAndCloseAccountRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAndCloseAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *WithdrawAccountRequest) GetTraderKey() []byte {
+func (x *WithdrawAndCloseAccountRequest) GetTraderKey() []byte {
 	if x != nil {
 		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *WithdrawAccountRequest) GetOutputs() []*Output {
+func (x *WithdrawAndCloseAccountRequest) GetOutputs() []*Output {
 	if x != nil {
 		return x.Outputs
 	}
 	return nil
 }
 
-func (x *WithdrawAccountRequest) GetFeeRateSatPerKw() uint64 {
+func (x *WithdrawAndCloseAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
 		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (m *WithdrawAccountRequest) GetAccountExpiry() isWithdrawAccountRequest_AccountExpiry {
-	if m != nil {
-		return m.AccountExpiry
-	}
-	return nil
+type WithdrawAndCloseAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the closing transaction.
+	CloseTxid []byte `protobuf:"bytes,1,opt,name=close_txid,json=closeTxid,proto3" json:"close_txid,omitempty"`
 }
 
-func (x *WithdrawAccountRequest) GetAbsoluteExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_AbsoluteExpiry); ok {
-		return x.AbsoluteExpiry
+func (x *WithdrawAndCloseAccountResponse) Reset() {
+	*x = WithdrawAndCloseAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WithdrawAccountRequest) GetRelativeExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_RelativeExpiry); ok {
-		return x.RelativeExpiry
-	}
-	return 0
+func (x *WithdrawAndCloseAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isWithdrawAccountRequest_AccountExpiry interface {
-	isWithdrawAccountRequest_AccountExpiry()
-}
+func (*WithdrawAndCloseAccountResponse) ProtoMessage() {}
 
-type WithdrawAccountRequest_AbsoluteExpiry struct {
-	// The new absolute expiration height of the account.
-	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
+func (x *WithdrawAndCloseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type WithdrawAccountRequest_RelativeExpiry struct {
-	// The new relative expiration height of the account.
-	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+// Deprecated: Use WithdrawAndCloseAccountResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawAndCloseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{13}
 }
 
-func (*WithdrawAccountRequest_AbsoluteExpiry) isWithdrawAccountRequest_AccountExpiry() {}
-
-func (*WithdrawAccountRequest_RelativeExpiry) isWithdrawAccountRequest_AccountExpiry() {}
+func (x *WithdrawAndCloseAccountResponse) GetCloseTxid() []byte {
+	if x != nil {
+		return x.CloseTxid
+	}
+	return nil
+}
 
-type WithdrawAccountResponse struct {
+type SweepExpiredAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the account after processing the withdrawal.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The transaction used to withdraw funds from the account.
-	WithdrawTxid []byte `protobuf:"bytes,2,opt,name=withdraw_txid,json=withdrawTxid,proto3" json:"withdraw_txid,omitempty"`
+	// The trader key associated with the expired account to sweep.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//An optional address the account funds are sent to. If not set, an address
+	//of the connected lnd node's wallet is used.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The fee rate, in satoshis per kw, to use for the sweep transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
 }
 
-func (x *WithdrawAccountResponse) Reset() {
-	*x = WithdrawAccountResponse{}
+func (x *SweepExpiredAccountRequest) Reset() {
+	*x = SweepExpiredAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[11]
+		mi := &file_trader_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WithdrawAccountResponse) String() string {
+func (x *SweepExpiredAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawAccountResponse) ProtoMessage() {}
+func (*SweepExpiredAccountRequest) ProtoMessage() {}
 
-func (x *WithdrawAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[11]
+func (x *SweepExpiredAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,62 +1946,125 @@ func (x *WithdrawAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawAccountResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SweepExpiredAccountRequest.ProtoReflect.Descriptor instead.
+func (*SweepExpiredAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WithdrawAccountResponse) GetAccount() *Account {
+func (x *SweepExpiredAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Account
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *WithdrawAccountResponse) GetWithdrawTxid() []byte {
+func (x *SweepExpiredAccountRequest) GetAddress() string {
 	if x != nil {
-		return x.WithdrawTxid
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SweepExpiredAccountRequest) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+type SweepExpiredAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the sweep transaction.
+	SweepTxid []byte `protobuf:"bytes,1,opt,name=sweep_txid,json=sweepTxid,proto3" json:"sweep_txid,omitempty"`
+}
+
+func (x *SweepExpiredAccountResponse) Reset() {
+	*x = SweepExpiredAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepExpiredAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepExpiredAccountResponse) ProtoMessage() {}
+
+func (x *SweepExpiredAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepExpiredAccountResponse.ProtoReflect.Descriptor instead.
+func (*SweepExpiredAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SweepExpiredAccountResponse) GetSweepTxid() []byte {
+	if x != nil {
+		return x.SweepTxid
 	}
 	return nil
 }
 
-type DepositAccountRequest struct {
+type WithdrawAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The trader key associated with the account that funds will be deposited
-	//into.
+	//The trader key associated with the account that funds will be withdrawed
+	//from.
 	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	// The amount in satoshis to deposit into the account.
-	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// The outputs we'll withdraw funds from the account into.
+	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	//
-	//The fee rate, in satoshis per kw, to use for the deposit transaction.
+	//The fee rate, in satoshis per kw, to use for the withdrawal transaction.
 	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
 	// Types that are assignable to AccountExpiry:
-	//	*DepositAccountRequest_AbsoluteExpiry
-	//	*DepositAccountRequest_RelativeExpiry
-	AccountExpiry isDepositAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	//	*WithdrawAccountRequest_AbsoluteExpiry
+	//	*WithdrawAccountRequest_RelativeExpiry
+	AccountExpiry isWithdrawAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	//
+	//An optional ceiling for the fee of the withdrawal transaction. The
+	//withdrawal is aborted if the transaction would exceed it.
+	FeeLimit *FeeLimit `protobuf:"bytes,6,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	//
+	//If set, the withdrawal transaction is only created and returned unsigned,
+	//nothing is signed or broadcast.
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *DepositAccountRequest) Reset() {
-	*x = DepositAccountRequest{}
+func (x *WithdrawAccountRequest) Reset() {
+	*x = WithdrawAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[12]
+		mi := &file_trader_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositAccountRequest) String() string {
+func (x *WithdrawAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAccountRequest) ProtoMessage() {}
+func (*WithdrawAccountRequest) ProtoMessage() {}
 
-func (x *DepositAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[12]
+func (x *WithdrawAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,99 +2075,117 @@ func (x *DepositAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAccountRequest.ProtoReflect.Descriptor instead.
-func (*DepositAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use WithdrawAccountRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DepositAccountRequest) GetTraderKey() []byte {
+func (x *WithdrawAccountRequest) GetTraderKey() []byte {
 	if x != nil {
 		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *DepositAccountRequest) GetAmountSat() uint64 {
+func (x *WithdrawAccountRequest) GetOutputs() []*Output {
 	if x != nil {
-		return x.AmountSat
+		return x.Outputs
 	}
-	return 0
+	return nil
 }
 
-func (x *DepositAccountRequest) GetFeeRateSatPerKw() uint64 {
+func (x *WithdrawAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
 		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (m *DepositAccountRequest) GetAccountExpiry() isDepositAccountRequest_AccountExpiry {
+func (m *WithdrawAccountRequest) GetAccountExpiry() isWithdrawAccountRequest_AccountExpiry {
 	if m != nil {
 		return m.AccountExpiry
 	}
 	return nil
 }
 
-func (x *DepositAccountRequest) GetAbsoluteExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_AbsoluteExpiry); ok {
+func (x *WithdrawAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_AbsoluteExpiry); ok {
 		return x.AbsoluteExpiry
 	}
 	return 0
 }
 
-func (x *DepositAccountRequest) GetRelativeExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_RelativeExpiry); ok {
+func (x *WithdrawAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*WithdrawAccountRequest_RelativeExpiry); ok {
 		return x.RelativeExpiry
 	}
 	return 0
 }
 
-type isDepositAccountRequest_AccountExpiry interface {
-	isDepositAccountRequest_AccountExpiry()
+func (x *WithdrawAccountRequest) GetFeeLimit() *FeeLimit {
+	if x != nil {
+		return x.FeeLimit
+	}
+	return nil
 }
 
-type DepositAccountRequest_AbsoluteExpiry struct {
+func (x *WithdrawAccountRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type isWithdrawAccountRequest_AccountExpiry interface {
+	isWithdrawAccountRequest_AccountExpiry()
+}
+
+type WithdrawAccountRequest_AbsoluteExpiry struct {
 	// The new absolute expiration height of the account.
 	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
 }
 
-type DepositAccountRequest_RelativeExpiry struct {
+type WithdrawAccountRequest_RelativeExpiry struct {
 	// The new relative expiration height of the account.
 	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
 }
 
-func (*DepositAccountRequest_AbsoluteExpiry) isDepositAccountRequest_AccountExpiry() {}
+func (*WithdrawAccountRequest_AbsoluteExpiry) isWithdrawAccountRequest_AccountExpiry() {}
 
-func (*DepositAccountRequest_RelativeExpiry) isDepositAccountRequest_AccountExpiry() {}
+func (*WithdrawAccountRequest_RelativeExpiry) isWithdrawAccountRequest_AccountExpiry() {}
 
-type DepositAccountResponse struct {
+type WithdrawAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the account after processing the deposit.
+	//
+	//The state of the account after processing the withdrawal. For a dry run,
+	//the state the account would have after the withdrawal.
 	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The transaction used to deposit funds into the account.
-	DepositTxid []byte `protobuf:"bytes,2,opt,name=deposit_txid,json=depositTxid,proto3" json:"deposit_txid,omitempty"`
+	// The transaction used to withdraw funds from the account.
+	WithdrawTxid []byte `protobuf:"bytes,2,opt,name=withdraw_txid,json=withdrawTxid,proto3" json:"withdraw_txid,omitempty"`
+	// The unsigned withdrawal transaction, only set for a dry run.
+	DryRun *AccountTxDryRun `protobuf:"bytes,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *DepositAccountResponse) Reset() {
-	*x = DepositAccountResponse{}
+func (x *WithdrawAccountResponse) Reset() {
+	*x = WithdrawAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[13]
+		mi := &file_trader_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositAccountResponse) String() string {
+func (x *WithdrawAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAccountResponse) ProtoMessage() {}
+func (*WithdrawAccountResponse) ProtoMessage() {}
 
-func (x *DepositAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[13]
+func (x *WithdrawAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,57 +2196,68 @@ func (x *DepositAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAccountResponse.ProtoReflect.Descriptor instead.
-func (*DepositAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use WithdrawAccountResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DepositAccountResponse) GetAccount() *Account {
+func (x *WithdrawAccountResponse) GetAccount() *Account {
 	if x != nil {
 		return x.Account
 	}
 	return nil
 }
 
-func (x *DepositAccountResponse) GetDepositTxid() []byte {
+func (x *WithdrawAccountResponse) GetWithdrawTxid() []byte {
 	if x != nil {
-		return x.DepositTxid
+		return x.WithdrawTxid
 	}
 	return nil
 }
 
-type RenewAccountRequest struct {
+func (x *WithdrawAccountResponse) GetDryRun() *AccountTxDryRun {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type ScheduledWithdrawal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The key associated with the account to renew.
-	AccountKey []byte `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
-	// Types that are assignable to AccountExpiry:
-	//	*RenewAccountRequest_AbsoluteExpiry
-	//	*RenewAccountRequest_RelativeExpiry
-	AccountExpiry isRenewAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
-	// The fee rate, in satoshis per kw, to use for the renewal transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// The trader key associated with the account to withdraw from.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The outputs the funds are withdrawn to.
+	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	//
+	//The highest fee rate, in satoshis per kw, at which the withdrawal is
+	//executed.
+	MaxFeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=max_fee_rate_sat_per_kw,json=maxFeeRateSatPerKw,proto3" json:"max_fee_rate_sat_per_kw,omitempty"`
+	//
+	//The unix timestamp in seconds after which the withdrawal is no longer
+	//attempted and removed.
+	ExpiryTimestamp uint64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
 }
 
-func (x *RenewAccountRequest) Reset() {
-	*x = RenewAccountRequest{}
+func (x *ScheduledWithdrawal) Reset() {
+	*x = ScheduledWithdrawal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[14]
+		mi := &file_trader_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenewAccountRequest) String() string {
+func (x *ScheduledWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenewAccountRequest) ProtoMessage() {}
+func (*ScheduledWithdrawal) ProtoMessage() {}
 
-func (x *RenewAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[14]
+func (x *ScheduledWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,92 +2268,75 @@ func (x *RenewAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenewAccountRequest.ProtoReflect.Descriptor instead.
-func (*RenewAccountRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ScheduledWithdrawal.ProtoReflect.Descriptor instead.
+func (*ScheduledWithdrawal) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RenewAccountRequest) GetAccountKey() []byte {
+func (x *ScheduledWithdrawal) GetTraderKey() []byte {
 	if x != nil {
-		return x.AccountKey
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (m *RenewAccountRequest) GetAccountExpiry() isRenewAccountRequest_AccountExpiry {
-	if m != nil {
-		return m.AccountExpiry
+func (x *ScheduledWithdrawal) GetOutputs() []*Output {
+	if x != nil {
+		return x.Outputs
 	}
 	return nil
 }
 
-func (x *RenewAccountRequest) GetAbsoluteExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_AbsoluteExpiry); ok {
-		return x.AbsoluteExpiry
+func (x *ScheduledWithdrawal) GetMaxFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.MaxFeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *RenewAccountRequest) GetRelativeExpiry() uint32 {
-	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_RelativeExpiry); ok {
-		return x.RelativeExpiry
-	}
-	return 0
-}
-
-func (x *RenewAccountRequest) GetFeeRateSatPerKw() uint64 {
+func (x *ScheduledWithdrawal) GetExpiryTimestamp() uint64 {
 	if x != nil {
-		return x.FeeRateSatPerKw
+		return x.ExpiryTimestamp
 	}
 	return 0
 }
 
-type isRenewAccountRequest_AccountExpiry interface {
-	isRenewAccountRequest_AccountExpiry()
-}
-
-type RenewAccountRequest_AbsoluteExpiry struct {
-	// The new absolute expiration height of the account.
-	AbsoluteExpiry uint32 `protobuf:"varint,2,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
-}
-
-type RenewAccountRequest_RelativeExpiry struct {
-	// The new relative expiration height of the account.
-	RelativeExpiry uint32 `protobuf:"varint,3,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
-}
-
-func (*RenewAccountRequest_AbsoluteExpiry) isRenewAccountRequest_AccountExpiry() {}
-
-func (*RenewAccountRequest_RelativeExpiry) isRenewAccountRequest_AccountExpiry() {}
-
-type RenewAccountResponse struct {
+type ScheduleWithdrawAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The state of the account after processing the renewal.
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The transaction used to renew the expiration of the account.
-	RenewalTxid []byte `protobuf:"bytes,2,opt,name=renewal_txid,json=renewalTxid,proto3" json:"renewal_txid,omitempty"`
+	// The trader key associated with the account to withdraw from.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The outputs the funds should be withdrawn to.
+	Outputs []*Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	//
+	//The highest fee rate, in satoshis per kw, at which the withdrawal should be
+	//executed.
+	MaxFeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=max_fee_rate_sat_per_kw,json=maxFeeRateSatPerKw,proto3" json:"max_fee_rate_sat_per_kw,omitempty"`
+	//
+	//The unix timestamp in seconds after which the withdrawal should no longer
+	//be attempted.
+	ExpiryTimestamp uint64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
 }
 
-func (x *RenewAccountResponse) Reset() {
-	*x = RenewAccountResponse{}
+func (x *ScheduleWithdrawAccountRequest) Reset() {
+	*x = ScheduleWithdrawAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[15]
+		mi := &file_trader_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenewAccountResponse) String() string {
+func (x *ScheduleWithdrawAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenewAccountResponse) ProtoMessage() {}
+func (*ScheduleWithdrawAccountRequest) ProtoMessage() {}
 
-func (x *RenewAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[15]
+func (x *ScheduleWithdrawAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,56 +2347,65 @@ func (x *RenewAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenewAccountResponse.ProtoReflect.Descriptor instead.
-func (*RenewAccountResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ScheduleWithdrawAccountRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleWithdrawAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RenewAccountResponse) GetAccount() *Account {
+func (x *ScheduleWithdrawAccountRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Account
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *RenewAccountResponse) GetRenewalTxid() []byte {
+func (x *ScheduleWithdrawAccountRequest) GetOutputs() []*Output {
 	if x != nil {
-		return x.RenewalTxid
+		return x.Outputs
 	}
 	return nil
 }
 
-type BumpAccountFeeRequest struct {
+func (x *ScheduleWithdrawAccountRequest) GetMaxFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.MaxFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *ScheduleWithdrawAccountRequest) GetExpiryTimestamp() uint64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+type ScheduleWithdrawAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The trader key associated with the account that will have its fee bumped.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	//
-	//The new fee rate, in satoshis per kw, to use for the child of the account
-	//transaction.
-	FeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// The withdrawal that was scheduled.
+	Withdrawal *ScheduledWithdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
 }
 
-func (x *BumpAccountFeeRequest) Reset() {
-	*x = BumpAccountFeeRequest{}
+func (x *ScheduleWithdrawAccountResponse) Reset() {
+	*x = ScheduleWithdrawAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[16]
+		mi := &file_trader_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BumpAccountFeeRequest) String() string {
+func (x *ScheduleWithdrawAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BumpAccountFeeRequest) ProtoMessage() {}
+func (*ScheduleWithdrawAccountResponse) ProtoMessage() {}
 
-func (x *BumpAccountFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[16]
+func (x *ScheduleWithdrawAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,48 +2416,41 @@ func (x *BumpAccountFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BumpAccountFeeRequest.ProtoReflect.Descriptor instead.
-func (*BumpAccountFeeRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ScheduleWithdrawAccountResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleWithdrawAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *BumpAccountFeeRequest) GetTraderKey() []byte {
+func (x *ScheduleWithdrawAccountResponse) GetWithdrawal() *ScheduledWithdrawal {
 	if x != nil {
-		return x.TraderKey
+		return x.Withdrawal
 	}
 	return nil
 }
 
-func (x *BumpAccountFeeRequest) GetFeeRateSatPerKw() uint64 {
-	if x != nil {
-		return x.FeeRateSatPerKw
-	}
-	return 0
-}
-
-type BumpAccountFeeResponse struct {
+type ListScheduledWithdrawalsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BumpAccountFeeResponse) Reset() {
-	*x = BumpAccountFeeResponse{}
+func (x *ListScheduledWithdrawalsRequest) Reset() {
+	*x = ListScheduledWithdrawalsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[17]
+		mi := &file_trader_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BumpAccountFeeResponse) String() string {
+func (x *ListScheduledWithdrawalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BumpAccountFeeResponse) ProtoMessage() {}
+func (*ListScheduledWithdrawalsRequest) ProtoMessage() {}
 
-func (x *BumpAccountFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[17]
+func (x *ListScheduledWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,55 +2461,37 @@ func (x *BumpAccountFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BumpAccountFeeResponse.ProtoReflect.Descriptor instead.
-func (*BumpAccountFeeResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListScheduledWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{21}
 }
 
-type Account struct {
+type ListScheduledWithdrawalsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The identifying component of an account. This is the key used for the trader
-	//in the 2-of-2 multi-sig construction of an account with an auctioneer.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	//
-	//The current outpoint associated with the account. This will change every
-	//time the account has been updated.
-	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// The current total amount of satoshis in the account.
-	Value uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	//
-	//The amount of satoshis in the account that is available, meaning not
-	//allocated to any oustanding orders.
-	AvailableBalance uint64 `protobuf:"varint,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
-	// The height at which the account will expire.
-	ExpirationHeight uint32 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
-	// The current state of the account.
-	State AccountState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.AccountState" json:"state,omitempty"`
-	// The hash of the account's latest transaction.
-	LatestTxid []byte `protobuf:"bytes,7,opt,name=latest_txid,json=latestTxid,proto3" json:"latest_txid,omitempty"`
+	// All withdrawals that are scheduled but weren't executed yet.
+	Withdrawals []*ScheduledWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *ListScheduledWithdrawalsResponse) Reset() {
+	*x = ListScheduledWithdrawalsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[18]
+		mi := &file_trader_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Account) String() string {
+func (x *ListScheduledWithdrawalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*ListScheduledWithdrawalsResponse) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[18]
+func (x *ListScheduledWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1621,95 +2502,91 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListScheduledWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Account) GetTraderKey() []byte {
+func (x *ListScheduledWithdrawalsResponse) GetWithdrawals() []*ScheduledWithdrawal {
 	if x != nil {
-		return x.TraderKey
+		return x.Withdrawals
 	}
 	return nil
 }
 
-func (x *Account) GetOutpoint() *auctioneerrpc.OutPoint {
-	if x != nil {
-		return x.Outpoint
-	}
-	return nil
+type CancelScheduledWithdrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The trader key associated with the account to cancel the scheduled
+	//withdrawal of.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 }
 
-func (x *Account) GetValue() uint64 {
-	if x != nil {
-		return x.Value
+func (x *CancelScheduledWithdrawRequest) Reset() {
+	*x = CancelScheduledWithdrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Account) GetAvailableBalance() uint64 {
-	if x != nil {
-		return x.AvailableBalance
-	}
-	return 0
+func (x *CancelScheduledWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Account) GetExpirationHeight() uint32 {
-	if x != nil {
-		return x.ExpirationHeight
+func (*CancelScheduledWithdrawRequest) ProtoMessage() {}
+
+func (x *CancelScheduledWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Account) GetState() AccountState {
-	if x != nil {
-		return x.State
-	}
-	return AccountState_PENDING_OPEN
+// Deprecated: Use CancelScheduledWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Account) GetLatestTxid() []byte {
+func (x *CancelScheduledWithdrawRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.LatestTxid
+		return x.TraderKey
 	}
 	return nil
 }
 
-type SubmitOrderRequest struct {
+type CancelScheduledWithdrawResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Details:
-	//	*SubmitOrderRequest_Ask
-	//	*SubmitOrderRequest_Bid
-	Details isSubmitOrderRequest_Details `protobuf_oneof:"details"`
-	//
-	//An optional identification string that will be appended to the user agent
-	//string sent to the server to give information about the usage of pool. This
-	//initiator part is meant for user interfaces to add their name to give the
-	//full picture of the binary used (poold, LiT) and the method used for
-	//submitting the order (pool CLI, LiT UI, other 3rd party UI).
-	Initiator string `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
 }
 
-func (x *SubmitOrderRequest) Reset() {
-	*x = SubmitOrderRequest{}
+func (x *CancelScheduledWithdrawResponse) Reset() {
+	*x = CancelScheduledWithdrawResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[19]
+		mi := &file_trader_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmitOrderRequest) String() string {
+func (x *CancelScheduledWithdrawResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitOrderRequest) ProtoMessage() {}
+func (*CancelScheduledWithdrawResponse) ProtoMessage() {}
 
-func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[19]
+func (x *CancelScheduledWithdrawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,181 +2597,199 @@ func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
-func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CancelScheduledWithdrawResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledWithdrawResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{24}
 }
 
-func (m *SubmitOrderRequest) GetDetails() isSubmitOrderRequest_Details {
-	if m != nil {
-		return m.Details
-	}
-	return nil
+type DepositAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The trader key associated with the account that funds will be deposited
+	//into.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The amount in satoshis to deposit into the account.
+	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	//
+	//The fee rate, in satoshis per kw, to use for the deposit transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*DepositAccountRequest_AbsoluteExpiry
+	//	*DepositAccountRequest_RelativeExpiry
+	AccountExpiry isDepositAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	//
+	//An optional list of UTXOs of the backing lnd node's wallet to fund the
+	//deposit with. If set, only these inputs are used and they must cover the
+	//deposit amount plus fees. Otherwise lnd selects the inputs.
+	Inputs []*auctioneerrpc.OutPoint `protobuf:"bytes,6,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The address type of the change output, if one is needed.
+	ChangeType ChangeAddressType `protobuf:"varint,7,opt,name=change_type,json=changeType,proto3,enum=poolrpc.ChangeAddressType" json:"change_type,omitempty"`
+	//
+	//An optional ceiling for the fee of the deposit transaction. The deposit is
+	//aborted if the transaction would exceed it.
+	FeeLimit *FeeLimit `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	//
+	//If set, the deposit transaction is only created and returned unsigned,
+	//nothing is signed or broadcast. Its wallet inputs are held for a short time
+	//so a following deposit with the same parameters uses them again.
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *SubmitOrderRequest) GetAsk() *Ask {
-	if x, ok := x.GetDetails().(*SubmitOrderRequest_Ask); ok {
-		return x.Ask
+func (x *DepositAccountRequest) Reset() {
+	*x = DepositAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SubmitOrderRequest) GetBid() *Bid {
-	if x, ok := x.GetDetails().(*SubmitOrderRequest_Bid); ok {
-		return x.Bid
-	}
-	return nil
+func (x *DepositAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SubmitOrderRequest) GetInitiator() string {
-	if x != nil {
-		return x.Initiator
-	}
-	return ""
-}
+func (*DepositAccountRequest) ProtoMessage() {}
 
-type isSubmitOrderRequest_Details interface {
-	isSubmitOrderRequest_Details()
+func (x *DepositAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type SubmitOrderRequest_Ask struct {
-	Ask *Ask `protobuf:"bytes,1,opt,name=ask,proto3,oneof"`
+// Deprecated: Use DepositAccountRequest.ProtoReflect.Descriptor instead.
+func (*DepositAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{25}
 }
 
-type SubmitOrderRequest_Bid struct {
-	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3,oneof"`
+func (x *DepositAccountRequest) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
 }
 
-func (*SubmitOrderRequest_Ask) isSubmitOrderRequest_Details() {}
-
-func (*SubmitOrderRequest_Bid) isSubmitOrderRequest_Details() {}
-
-type SubmitOrderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Details:
-	//	*SubmitOrderResponse_InvalidOrder
-	//	*SubmitOrderResponse_AcceptedOrderNonce
-	Details isSubmitOrderResponse_Details `protobuf_oneof:"details"`
-	//
-	//In case a bid order was submitted for a sidecar ticket, that ticket is
-	//updated with the new state and bid order nonce.
-	UpdatedSidecarTicket string `protobuf:"bytes,3,opt,name=updated_sidecar_ticket,json=updatedSidecarTicket,proto3" json:"updated_sidecar_ticket,omitempty"`
+func (x *DepositAccountRequest) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
 }
 
-func (x *SubmitOrderResponse) Reset() {
-	*x = SubmitOrderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DepositAccountRequest) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
 	}
+	return 0
 }
 
-func (x *SubmitOrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *DepositAccountRequest) GetAccountExpiry() isDepositAccountRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
+	}
+	return nil
 }
 
-func (*SubmitOrderResponse) ProtoMessage() {}
-
-func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DepositAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
-func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{20}
+func (x *DepositAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*DepositAccountRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
+	}
+	return 0
 }
 
-func (m *SubmitOrderResponse) GetDetails() isSubmitOrderResponse_Details {
-	if m != nil {
-		return m.Details
+func (x *DepositAccountRequest) GetInputs() []*auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.Inputs
 	}
 	return nil
 }
 
-func (x *SubmitOrderResponse) GetInvalidOrder() *auctioneerrpc.InvalidOrder {
-	if x, ok := x.GetDetails().(*SubmitOrderResponse_InvalidOrder); ok {
-		return x.InvalidOrder
+func (x *DepositAccountRequest) GetChangeType() ChangeAddressType {
+	if x != nil {
+		return x.ChangeType
 	}
-	return nil
+	return ChangeAddressType_CHANGE_ADDRESS_TYPE_DEFAULT
 }
 
-func (x *SubmitOrderResponse) GetAcceptedOrderNonce() []byte {
-	if x, ok := x.GetDetails().(*SubmitOrderResponse_AcceptedOrderNonce); ok {
-		return x.AcceptedOrderNonce
+func (x *DepositAccountRequest) GetFeeLimit() *FeeLimit {
+	if x != nil {
+		return x.FeeLimit
 	}
 	return nil
 }
 
-func (x *SubmitOrderResponse) GetUpdatedSidecarTicket() string {
+func (x *DepositAccountRequest) GetDryRun() bool {
 	if x != nil {
-		return x.UpdatedSidecarTicket
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type isSubmitOrderResponse_Details interface {
-	isSubmitOrderResponse_Details()
+type isDepositAccountRequest_AccountExpiry interface {
+	isDepositAccountRequest_AccountExpiry()
 }
 
-type SubmitOrderResponse_InvalidOrder struct {
-	//
-	//Order failed with the given reason.
-	InvalidOrder *auctioneerrpc.InvalidOrder `protobuf:"bytes,1,opt,name=invalid_order,json=invalidOrder,proto3,oneof"`
+type DepositAccountRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,4,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
 }
 
-type SubmitOrderResponse_AcceptedOrderNonce struct {
-	//
-	//The order nonce of the accepted order.
-	AcceptedOrderNonce []byte `protobuf:"bytes,2,opt,name=accepted_order_nonce,json=acceptedOrderNonce,proto3,oneof"`
+type DepositAccountRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,5,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
 }
 
-func (*SubmitOrderResponse_InvalidOrder) isSubmitOrderResponse_Details() {}
+func (*DepositAccountRequest_AbsoluteExpiry) isDepositAccountRequest_AccountExpiry() {}
 
-func (*SubmitOrderResponse_AcceptedOrderNonce) isSubmitOrderResponse_Details() {}
+func (*DepositAccountRequest_RelativeExpiry) isDepositAccountRequest_AccountExpiry() {}
 
-type ListOrdersRequest struct {
+type DepositAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//Can be set to true to list the orders including all events, which can be
-	//very verbose.
-	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	//
-	//Only list orders that are still active.
-	ActiveOnly bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	//The state of the account after processing the deposit. For a dry run, the
+	//state the account would have after the deposit.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to deposit funds into the account.
+	DepositTxid []byte `protobuf:"bytes,2,opt,name=deposit_txid,json=depositTxid,proto3" json:"deposit_txid,omitempty"`
+	// The unsigned deposit transaction, only set for a dry run.
+	DryRun *AccountTxDryRun `protobuf:"bytes,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
+func (x *DepositAccountResponse) Reset() {
+	*x = DepositAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[21]
+		mi := &file_trader_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *DepositAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*DepositAccountResponse) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[21]
+func (x *DepositAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,51 +2800,66 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DepositAccountResponse.ProtoReflect.Descriptor instead.
+func (*DepositAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListOrdersRequest) GetVerbose() bool {
+func (x *DepositAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Verbose
+		return x.Account
 	}
-	return false
+	return nil
 }
 
-func (x *ListOrdersRequest) GetActiveOnly() bool {
+func (x *DepositAccountResponse) GetDepositTxid() []byte {
 	if x != nil {
-		return x.ActiveOnly
+		return x.DepositTxid
 	}
-	return false
+	return nil
 }
 
-type ListOrdersResponse struct {
+func (x *DepositAccountResponse) GetDryRun() *AccountTxDryRun {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+type DepositAccountPsbtRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asks []*Ask `protobuf:"bytes,1,rep,name=asks,proto3" json:"asks,omitempty"`
-	Bids []*Bid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	//
+	//The trader key associated with the account that funds will be deposited
+	//into.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The amount in satoshis to deposit into the account.
+	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*DepositAccountPsbtRequest_AbsoluteExpiry
+	//	*DepositAccountPsbtRequest_RelativeExpiry
+	AccountExpiry isDepositAccountPsbtRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
+func (x *DepositAccountPsbtRequest) Reset() {
+	*x = DepositAccountPsbtRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[22]
+		mi := &file_trader_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *DepositAccountPsbtRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*DepositAccountPsbtRequest) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[22]
+func (x *DepositAccountPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,50 +2870,92 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use DepositAccountPsbtRequest.ProtoReflect.Descriptor instead.
+func (*DepositAccountPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListOrdersResponse) GetAsks() []*Ask {
+func (x *DepositAccountPsbtRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Asks
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *ListOrdersResponse) GetBids() []*Bid {
+func (x *DepositAccountPsbtRequest) GetAmountSat() uint64 {
 	if x != nil {
-		return x.Bids
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (m *DepositAccountPsbtRequest) GetAccountExpiry() isDepositAccountPsbtRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
 	}
 	return nil
 }
 
-type CancelOrderRequest struct {
+func (x *DepositAccountPsbtRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*DepositAccountPsbtRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
+	}
+	return 0
+}
+
+func (x *DepositAccountPsbtRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*DepositAccountPsbtRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
+	}
+	return 0
+}
+
+type isDepositAccountPsbtRequest_AccountExpiry interface {
+	isDepositAccountPsbtRequest_AccountExpiry()
+}
+
+type DepositAccountPsbtRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,3,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
+}
+
+type DepositAccountPsbtRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,4,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+}
+
+func (*DepositAccountPsbtRequest_AbsoluteExpiry) isDepositAccountPsbtRequest_AccountExpiry() {}
+
+func (*DepositAccountPsbtRequest_RelativeExpiry) isDepositAccountPsbtRequest_AccountExpiry() {}
+
+type DepositAccountPsbtResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//The serialized PSBT that spends the account into the new account output.
+	//The external wallet needs to add its inputs and change outputs to it.
+	Psbt []byte `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
+func (x *DepositAccountPsbtResponse) Reset() {
+	*x = DepositAccountPsbtResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[23]
+		mi := &file_trader_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *DepositAccountPsbtResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*DepositAccountPsbtResponse) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[23]
+func (x *DepositAccountPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,41 +2966,48 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DepositAccountPsbtResponse.ProtoReflect.Descriptor instead.
+func (*DepositAccountPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CancelOrderRequest) GetOrderNonce() []byte {
+func (x *DepositAccountPsbtResponse) GetPsbt() []byte {
 	if x != nil {
-		return x.OrderNonce
+		return x.Psbt
 	}
 	return nil
 }
 
-type CancelOrderResponse struct {
+type FinalizeDepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The trader key associated with the account the deposit is made into.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The serialized PSBT returned by DepositAccountPsbt after it was funded and
+	//signed by the external wallet.
+	SignedPsbt []byte `protobuf:"bytes,2,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
 }
 
-func (x *CancelOrderResponse) Reset() {
-	*x = CancelOrderResponse{}
+func (x *FinalizeDepositRequest) Reset() {
+	*x = FinalizeDepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[24]
+		mi := &file_trader_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelOrderResponse) String() string {
+func (x *FinalizeDepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderResponse) ProtoMessage() {}
+func (*FinalizeDepositRequest) ProtoMessage() {}
 
-func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[24]
+func (x *FinalizeDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2059,81 +3018,53 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
-func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use FinalizeDepositRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeDepositRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{29}
 }
 
-type Order struct {
+func (x *FinalizeDepositRequest) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *FinalizeDepositRequest) GetSignedPsbt() []byte {
+	if x != nil {
+		return x.SignedPsbt
+	}
+	return nil
+}
+
+type FinalizeDepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The trader's account key of the account that is used for the order.
-	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
-	//
-	//Fixed order rate in parts per billion.
-	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
-	//
-	//Order amount in satoshis.
-	Amt uint64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Maximum fee rate the trader is willing to pay for the batch transaction,
-	//expressed in satoshis per 1000 weight units (sat/KW).
-	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
-	//
-	//Order nonce, acts as unique order identifier.
-	OrderNonce []byte `protobuf:"bytes,5,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
-	//
-	//The state the order currently is in.
-	State auctioneerrpc.OrderState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.OrderState" json:"state,omitempty"`
-	//
-	//The number of order units the amount corresponds to.
-	Units uint32 `protobuf:"varint,7,opt,name=units,proto3" json:"units,omitempty"`
-	//
-	//The number of currently unfilled units of this order. This will be equal to
-	//the total amount of units until the order has reached the state PARTIAL_FILL
-	//or EXECUTED.
-	UnitsUnfulfilled uint32 `protobuf:"varint,8,opt,name=units_unfulfilled,json=unitsUnfulfilled,proto3" json:"units_unfulfilled,omitempty"`
-	// The value reserved from the account by this order to ensure the account
-	// can pay execution and chain fees in case it gets matched.
-	ReservedValueSat uint64 `protobuf:"varint,9,opt,name=reserved_value_sat,json=reservedValueSat,proto3" json:"reserved_value_sat,omitempty"`
-	// The unix timestamp in nanoseconds the order was first created/submitted.
-	CreationTimestampNs uint64 `protobuf:"varint,10,opt,name=creation_timestamp_ns,json=creationTimestampNs,proto3" json:"creation_timestamp_ns,omitempty"`
-	//
-	//A list of events that were emitted for this order. This field is only set
-	//when the verbose flag is set to true in the request.
-	Events []*OrderEvent `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
-	// The minimum number of order units that must be matched per order pair.
-	MinUnitsMatch uint32 `protobuf:"varint,12,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
-	// The channel type to use for the resulting matched channels.
-	ChannelType auctioneerrpc.OrderChannelType `protobuf:"varint,13,opt,name=channel_type,json=channelType,proto3,enum=poolrpc.OrderChannelType" json:"channel_type,omitempty"`
-	// List of nodes that will be allowed to match with our order. Incompatible
-	// with the `not_allowed_node_ids` field.
-	AllowedNodeIds [][]byte `protobuf:"bytes,14,rep,name=allowed_node_ids,json=allowedNodeIds,proto3" json:"allowed_node_ids,omitempty"`
-	// List of nodes that won't be allowed to match with our order. Incompatible
-	// with the `allowed_node_ids` field.
-	NotAllowedNodeIds [][]byte `protobuf:"bytes,15,rep,name=not_allowed_node_ids,json=notAllowedNodeIds,proto3" json:"not_allowed_node_ids,omitempty"`
+	// The state of the account after processing the deposit.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to deposit funds into the account.
+	DepositTxid []byte `protobuf:"bytes,2,opt,name=deposit_txid,json=depositTxid,proto3" json:"deposit_txid,omitempty"`
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *FinalizeDepositResponse) Reset() {
+	*x = FinalizeDepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[25]
+		mi := &file_trader_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Order) String() string {
+func (x *FinalizeDepositResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*FinalizeDepositResponse) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[25]
+func (x *FinalizeDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2144,169 +3075,236 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use FinalizeDepositResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeDepositResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Order) GetTraderKey() []byte {
+func (x *FinalizeDepositResponse) GetAccount() *Account {
 	if x != nil {
-		return x.TraderKey
+		return x.Account
 	}
 	return nil
 }
 
-func (x *Order) GetRateFixed() uint32 {
+func (x *FinalizeDepositResponse) GetDepositTxid() []byte {
 	if x != nil {
-		return x.RateFixed
+		return x.DepositTxid
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetAmt() uint64 {
-	if x != nil {
-		return x.Amt
+type RenewAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key associated with the account to renew.
+	AccountKey []byte `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	// Types that are assignable to AccountExpiry:
+	//	*RenewAccountRequest_AbsoluteExpiry
+	//	*RenewAccountRequest_RelativeExpiry
+	AccountExpiry isRenewAccountRequest_AccountExpiry `protobuf_oneof:"account_expiry"`
+	// The fee rate, in satoshis per kw, to use for the renewal transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	//
+	//If set, the renewal transaction is only created and returned unsigned,
+	//nothing is signed or broadcast.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RenewAccountRequest) Reset() {
+	*x = RenewAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Order) GetMaxBatchFeeRateSatPerKw() uint64 {
-	if x != nil {
-		return x.MaxBatchFeeRateSatPerKw
+func (x *RenewAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAccountRequest) ProtoMessage() {}
+
+func (x *RenewAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetOrderNonce() []byte {
+// Deprecated: Use RenewAccountRequest.ProtoReflect.Descriptor instead.
+func (*RenewAccountRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RenewAccountRequest) GetAccountKey() []byte {
 	if x != nil {
-		return x.OrderNonce
+		return x.AccountKey
 	}
 	return nil
 }
 
-func (x *Order) GetState() auctioneerrpc.OrderState {
-	if x != nil {
-		return x.State
+func (m *RenewAccountRequest) GetAccountExpiry() isRenewAccountRequest_AccountExpiry {
+	if m != nil {
+		return m.AccountExpiry
 	}
-	return auctioneerrpc.OrderState(0)
+	return nil
 }
 
-func (x *Order) GetUnits() uint32 {
-	if x != nil {
-		return x.Units
+func (x *RenewAccountRequest) GetAbsoluteExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_AbsoluteExpiry); ok {
+		return x.AbsoluteExpiry
 	}
 	return 0
 }
 
-func (x *Order) GetUnitsUnfulfilled() uint32 {
-	if x != nil {
-		return x.UnitsUnfulfilled
+func (x *RenewAccountRequest) GetRelativeExpiry() uint32 {
+	if x, ok := x.GetAccountExpiry().(*RenewAccountRequest_RelativeExpiry); ok {
+		return x.RelativeExpiry
 	}
 	return 0
 }
 
-func (x *Order) GetReservedValueSat() uint64 {
+func (x *RenewAccountRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.ReservedValueSat
+		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *Order) GetCreationTimestampNs() uint64 {
+func (x *RenewAccountRequest) GetDryRun() bool {
 	if x != nil {
-		return x.CreationTimestampNs
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *Order) GetEvents() []*OrderEvent {
-	if x != nil {
-		return x.Events
+type isRenewAccountRequest_AccountExpiry interface {
+	isRenewAccountRequest_AccountExpiry()
+}
+
+type RenewAccountRequest_AbsoluteExpiry struct {
+	// The new absolute expiration height of the account.
+	AbsoluteExpiry uint32 `protobuf:"varint,2,opt,name=absolute_expiry,json=absoluteExpiry,proto3,oneof"`
+}
+
+type RenewAccountRequest_RelativeExpiry struct {
+	// The new relative expiration height of the account.
+	RelativeExpiry uint32 `protobuf:"varint,3,opt,name=relative_expiry,json=relativeExpiry,proto3,oneof"`
+}
+
+func (*RenewAccountRequest_AbsoluteExpiry) isRenewAccountRequest_AccountExpiry() {}
+
+func (*RenewAccountRequest_RelativeExpiry) isRenewAccountRequest_AccountExpiry() {}
+
+type RenewAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state of the account after processing the renewal. For a dry run, the
+	//state the account would have after the renewal.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The transaction used to renew the expiration of the account.
+	RenewalTxid []byte `protobuf:"bytes,2,opt,name=renewal_txid,json=renewalTxid,proto3" json:"renewal_txid,omitempty"`
+	// The unsigned renewal transaction, only set for a dry run.
+	DryRun *AccountTxDryRun `protobuf:"bytes,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RenewAccountResponse) Reset() {
+	*x = RenewAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Order) GetMinUnitsMatch() uint32 {
-	if x != nil {
-		return x.MinUnitsMatch
+func (x *RenewAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAccountResponse) ProtoMessage() {}
+
+func (x *RenewAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetChannelType() auctioneerrpc.OrderChannelType {
+// Deprecated: Use RenewAccountResponse.ProtoReflect.Descriptor instead.
+func (*RenewAccountResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenewAccountResponse) GetAccount() *Account {
 	if x != nil {
-		return x.ChannelType
+		return x.Account
 	}
-	return auctioneerrpc.OrderChannelType(0)
+	return nil
 }
 
-func (x *Order) GetAllowedNodeIds() [][]byte {
+func (x *RenewAccountResponse) GetRenewalTxid() []byte {
 	if x != nil {
-		return x.AllowedNodeIds
+		return x.RenewalTxid
 	}
 	return nil
 }
 
-func (x *Order) GetNotAllowedNodeIds() [][]byte {
+func (x *RenewAccountResponse) GetDryRun() *AccountTxDryRun {
 	if x != nil {
-		return x.NotAllowedNodeIds
+		return x.DryRun
 	}
 	return nil
 }
 
-type Bid struct {
+type UpdateAccountAutoRenewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The trader key associated with the account to update.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// Whether the account should be renewed automatically.
+	AutoRenew bool `protobuf:"varint,2,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
 	//
-	//The common fields shared between both ask and bid order types.
-	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	//
-	//Required number of blocks that a channel opened as a result of this bid
-	//should be kept open.
-	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
-	//
-	//The version of the order format that is used. Will be increased once new
-	//features are added.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	//
-	//The minimum node tier this order should be matched with. Only asks backed by
-	//a node this tier or higher will be eligible for matching with this bid.
-	MinNodeTier auctioneerrpc.NodeTier `protobuf:"varint,4,opt,name=min_node_tier,json=minNodeTier,proto3,enum=poolrpc.NodeTier" json:"min_node_tier,omitempty"`
-	//
-	//Give the incoming channel that results from this bid being matched an
-	//initial outbound balance by adding additional funds from the taker's account
-	//into the channel. As a simplification for the execution protocol and the
-	//channel reserve calculations, the self_chan_balance can be at most the same
-	//as the order amount and the min_chan_amt must be set to the full order
-	//amount.
-	SelfChanBalance uint64 `protobuf:"varint,5,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
-	//
-	//If this bid order is meant to lease a channel for another node (which is
-	//dubbed a "sidecar channel") then this ticket contains all information
-	//required for setting up that sidecar channel. The ticket is expected to be
-	//the base58 encoded ticket, including the prefix and the checksum.
-	SidecarTicket string `protobuf:"bytes,6,opt,name=sidecar_ticket,json=sidecarTicket,proto3" json:"sidecar_ticket,omitempty"`
+	//The number of blocks before the account's expiry at which the automatic
+	//renewal is attempted. Must be set if auto_renew is true.
+	RenewalWindow uint32 `protobuf:"varint,3,opt,name=renewal_window,json=renewalWindow,proto3" json:"renewal_window,omitempty"`
 }
 
-func (x *Bid) Reset() {
-	*x = Bid{}
+func (x *UpdateAccountAutoRenewRequest) Reset() {
+	*x = UpdateAccountAutoRenewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[26]
+		mi := &file_trader_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Bid) String() string {
+func (x *UpdateAccountAutoRenewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bid) ProtoMessage() {}
+func (*UpdateAccountAutoRenewRequest) ProtoMessage() {}
 
-func (x *Bid) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[26]
+func (x *UpdateAccountAutoRenewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,88 +3315,58 @@ func (x *Bid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
-func (*Bid) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpdateAccountAutoRenewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountAutoRenewRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Bid) GetDetails() *Order {
+func (x *UpdateAccountAutoRenewRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Details
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *Bid) GetLeaseDurationBlocks() uint32 {
-	if x != nil {
-		return x.LeaseDurationBlocks
-	}
-	return 0
-}
-
-func (x *Bid) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *Bid) GetMinNodeTier() auctioneerrpc.NodeTier {
+func (x *UpdateAccountAutoRenewRequest) GetAutoRenew() bool {
 	if x != nil {
-		return x.MinNodeTier
+		return x.AutoRenew
 	}
-	return auctioneerrpc.NodeTier(0)
+	return false
 }
 
-func (x *Bid) GetSelfChanBalance() uint64 {
+func (x *UpdateAccountAutoRenewRequest) GetRenewalWindow() uint32 {
 	if x != nil {
-		return x.SelfChanBalance
+		return x.RenewalWindow
 	}
 	return 0
 }
 
-func (x *Bid) GetSidecarTicket() string {
-	if x != nil {
-		return x.SidecarTicket
-	}
-	return ""
-}
-
-type Ask struct {
+type UpdateAccountAutoRenewResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The common fields shared between both ask and bid order types.
-	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	//
-	//The number of blocks the liquidity provider is willing to provide the
-	//channel funds for.
-	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
-	//
-	//The version of the order format that is used. Will be increased once new
-	//features are added.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The state of the account after updating its automatic renewal settings.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *Ask) Reset() {
-	*x = Ask{}
+func (x *UpdateAccountAutoRenewResponse) Reset() {
+	*x = UpdateAccountAutoRenewResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[27]
+		mi := &file_trader_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Ask) String() string {
+func (x *UpdateAccountAutoRenewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ask) ProtoMessage() {}
+func (*UpdateAccountAutoRenewResponse) ProtoMessage() {}
 
-func (x *Ask) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[27]
+func (x *UpdateAccountAutoRenewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2409,72 +3377,48 @@ func (x *Ask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ask.ProtoReflect.Descriptor instead.
-func (*Ask) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpdateAccountAutoRenewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountAutoRenewResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Ask) GetDetails() *Order {
+func (x *UpdateAccountAutoRenewResponse) GetAccount() *Account {
 	if x != nil {
-		return x.Details
+		return x.Account
 	}
 	return nil
 }
 
-func (x *Ask) GetLeaseDurationBlocks() uint32 {
-	if x != nil {
-		return x.LeaseDurationBlocks
-	}
-	return 0
-}
-
-func (x *Ask) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-type QuoteOrderRequest struct {
+type UpdateAccountReserveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The trader key associated with the account to update.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 	//
-	//Order amount in satoshis.
-	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Fixed order rate in parts per billion.
-	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
-	//
-	//Required number of blocks that a channel opened as a result of this bid
-	//should be kept open.
-	LeaseDurationBlocks uint32 `protobuf:"varint,3,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
-	//
-	//Maximum fee rate the trader is willing to pay for the batch transaction,
-	//expressed in satoshis per 1000 weight units (sat/KW).
-	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
-	// The minimum number of order units that must be matched per order pair.
-	MinUnitsMatch uint32 `protobuf:"varint,5,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
+	//The new reserve of the account in satoshis. Must not exceed the account's
+	//value.
+	ReserveSat uint64 `protobuf:"varint,2,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
 }
 
-func (x *QuoteOrderRequest) Reset() {
-	*x = QuoteOrderRequest{}
+func (x *UpdateAccountReserveRequest) Reset() {
+	*x = UpdateAccountReserveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[28]
+		mi := &file_trader_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuoteOrderRequest) String() string {
+func (x *UpdateAccountReserveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteOrderRequest) ProtoMessage() {}
+func (*UpdateAccountReserveRequest) ProtoMessage() {}
 
-func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[28]
+func (x *UpdateAccountReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,92 +3429,104 @@ func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteOrderRequest.ProtoReflect.Descriptor instead.
-func (*QuoteOrderRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateAccountReserveRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountReserveRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *QuoteOrderRequest) GetAmt() uint64 {
+func (x *UpdateAccountReserveRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Amt
+		return x.TraderKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteOrderRequest) GetRateFixed() uint32 {
+func (x *UpdateAccountReserveRequest) GetReserveSat() uint64 {
 	if x != nil {
-		return x.RateFixed
+		return x.ReserveSat
 	}
 	return 0
 }
 
-func (x *QuoteOrderRequest) GetLeaseDurationBlocks() uint32 {
-	if x != nil {
-		return x.LeaseDurationBlocks
+type UpdateAccountReserveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the account after updating its reserve.
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *UpdateAccountReserveResponse) Reset() {
+	*x = UpdateAccountReserveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *QuoteOrderRequest) GetMaxBatchFeeRateSatPerKw() uint64 {
-	if x != nil {
-		return x.MaxBatchFeeRateSatPerKw
+func (x *UpdateAccountReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountReserveResponse) ProtoMessage() {}
+
+func (x *UpdateAccountReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *QuoteOrderRequest) GetMinUnitsMatch() uint32 {
+// Deprecated: Use UpdateAccountReserveResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountReserveResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateAccountReserveResponse) GetAccount() *Account {
 	if x != nil {
-		return x.MinUnitsMatch
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-type QuoteOrderResponse struct {
+type BumpAccountFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The total order premium in satoshis for filling the entire order. This
-	//represents the interest amount paid to the maker by the taker excluding any
-	//execution or chain fees.
-	TotalPremiumSat uint64 `protobuf:"varint,1,opt,name=total_premium_sat,json=totalPremiumSat,proto3" json:"total_premium_sat,omitempty"`
-	//
-	//The fixed order rate expressed as a fraction instead of parts per billion.
-	RatePerBlock float64 `protobuf:"fixed64,2,opt,name=rate_per_block,json=ratePerBlock,proto3" json:"rate_per_block,omitempty"`
-	//
-	//The fixed order rate expressed as a percentage instead of parts per billion.
-	RatePercent float64 `protobuf:"fixed64,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"`
-	//
-	//The total execution fee in satoshis that needs to be paid to the auctioneer
-	//for executing the entire order.
-	TotalExecutionFeeSat uint64 `protobuf:"varint,4,opt,name=total_execution_fee_sat,json=totalExecutionFeeSat,proto3" json:"total_execution_fee_sat,omitempty"`
+	//The trader key associated with the account that will have its fee bumped.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 	//
-	//The worst case chain fees that need to be paid if fee rates spike up to the
-	//max_batch_fee_rate_sat_per_kw value specified in the request. This value is
-	//highly dependent on the min_units_match parameter as well since the
-	//calculation assumes chain fees for the chain footprint of opening
-	//amt/min_units_match channels (hence worst case calculation).
-	WorstCaseChainFeeSat uint64 `protobuf:"varint,5,opt,name=worst_case_chain_fee_sat,json=worstCaseChainFeeSat,proto3" json:"worst_case_chain_fee_sat,omitempty"`
+	//The new fee rate, in satoshis per kw, to use for the child of the account
+	//transaction.
+	FeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
 }
 
-func (x *QuoteOrderResponse) Reset() {
-	*x = QuoteOrderResponse{}
+func (x *BumpAccountFeeRequest) Reset() {
+	*x = BumpAccountFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[29]
+		mi := &file_trader_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuoteOrderResponse) String() string {
+func (x *BumpAccountFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteOrderResponse) ProtoMessage() {}
+func (*BumpAccountFeeRequest) ProtoMessage() {}
 
-func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[29]
+func (x *BumpAccountFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2581,80 +3537,121 @@ func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteOrderResponse.ProtoReflect.Descriptor instead.
-func (*QuoteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *QuoteOrderResponse) GetTotalPremiumSat() uint64 {
-	if x != nil {
-		return x.TotalPremiumSat
-	}
-	return 0
+// Deprecated: Use BumpAccountFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpAccountFeeRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *QuoteOrderResponse) GetRatePerBlock() float64 {
+func (x *BumpAccountFeeRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.RatePerBlock
+		return x.TraderKey
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteOrderResponse) GetRatePercent() float64 {
+func (x *BumpAccountFeeRequest) GetFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.RatePercent
+		return x.FeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *QuoteOrderResponse) GetTotalExecutionFeeSat() uint64 {
-	if x != nil {
-		return x.TotalExecutionFeeSat
+type BumpAccountFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BumpAccountFeeResponse) Reset() {
+	*x = BumpAccountFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *QuoteOrderResponse) GetWorstCaseChainFeeSat() uint64 {
-	if x != nil {
-		return x.WorstCaseChainFeeSat
+func (x *BumpAccountFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpAccountFeeResponse) ProtoMessage() {}
+
+func (x *BumpAccountFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type OrderEvent struct {
+// Deprecated: Use BumpAccountFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpAccountFeeResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{38}
+}
+
+type Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The unix timestamp in nanoseconds the event was emitted at. This is the
-	//primary key of the event and is unique across the database.
-	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
-	// The human readable representation of the event.
-	EventStr string `protobuf:"bytes,2,opt,name=event_str,json=eventStr,proto3" json:"event_str,omitempty"`
-	// Types that are assignable to Event:
-	//	*OrderEvent_StateChange
-	//	*OrderEvent_Matched
-	Event isOrderEvent_Event `protobuf_oneof:"event"`
+	//The identifying component of an account. This is the key used for the trader
+	//in the 2-of-2 multi-sig construction of an account with an auctioneer.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The current outpoint associated with the account. This will change every
+	//time the account has been updated.
+	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The current total amount of satoshis in the account.
+	Value uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	//
+	//The amount of satoshis in the account that is available, meaning not
+	//allocated to any oustanding orders.
+	AvailableBalance uint64 `protobuf:"varint,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// The height at which the account will expire.
+	ExpirationHeight uint32 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// The current state of the account.
+	State AccountState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.AccountState" json:"state,omitempty"`
+	// The hash of the account's latest transaction.
+	LatestTxid []byte `protobuf:"bytes,7,opt,name=latest_txid,json=latestTxid,proto3" json:"latest_txid,omitempty"`
+	// Whether the account is renewed automatically before it expires.
+	AutoRenew bool `protobuf:"varint,8,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	//
+	//The number of blocks before the account's expiry at which the automatic
+	//renewal is attempted.
+	RenewalWindow uint32 `protobuf:"varint,9,opt,name=renewal_window,json=renewalWindow,proto3" json:"renewal_window,omitempty"`
+	//
+	//The amount of satoshis in the account that orders may not spend, to make
+	//sure the account can always be swept through its expiry path.
+	ReserveSat uint64 `protobuf:"varint,10,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
+	//
+	//The version of the account, which determines the type of its output
+	//script.
+	Version auctioneerrpc.AccountVersion `protobuf:"varint,11,opt,name=version,proto3,enum=poolrpc.AccountVersion" json:"version,omitempty"`
 }
 
-func (x *OrderEvent) Reset() {
-	*x = OrderEvent{}
+func (x *Account) Reset() {
+	*x = Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[30]
+		mi := &file_trader_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderEvent) String() string {
+func (x *Account) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderEvent) ProtoMessage() {}
+func (*Account) ProtoMessage() {}
 
-func (x *OrderEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[30]
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2665,98 +3662,139 @@ func (x *OrderEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
-func (*OrderEvent) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *OrderEvent) GetTimestampNs() int64 {
+func (x *Account) GetTraderKey() []byte {
 	if x != nil {
-		return x.TimestampNs
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *Account) GetOutpoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *Account) GetValue() uint64 {
+	if x != nil {
+		return x.Value
 	}
 	return 0
 }
 
-func (x *OrderEvent) GetEventStr() string {
+func (x *Account) GetAvailableBalance() uint64 {
 	if x != nil {
-		return x.EventStr
+		return x.AvailableBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *OrderEvent) GetEvent() isOrderEvent_Event {
-	if m != nil {
-		return m.Event
+func (x *Account) GetExpirationHeight() uint32 {
+	if x != nil {
+		return x.ExpirationHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *OrderEvent) GetStateChange() *UpdatedEvent {
-	if x, ok := x.GetEvent().(*OrderEvent_StateChange); ok {
-		return x.StateChange
+func (x *Account) GetState() AccountState {
+	if x != nil {
+		return x.State
 	}
-	return nil
+	return AccountState_PENDING_OPEN
 }
 
-func (x *OrderEvent) GetMatched() *MatchEvent {
-	if x, ok := x.GetEvent().(*OrderEvent_Matched); ok {
-		return x.Matched
+func (x *Account) GetLatestTxid() []byte {
+	if x != nil {
+		return x.LatestTxid
 	}
 	return nil
 }
 
-type isOrderEvent_Event interface {
-	isOrderEvent_Event()
+func (x *Account) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
 }
 
-type OrderEvent_StateChange struct {
-	// The order was updated in the database.
-	StateChange *UpdatedEvent `protobuf:"bytes,3,opt,name=state_change,json=stateChange,proto3,oneof"`
+func (x *Account) GetRenewalWindow() uint32 {
+	if x != nil {
+		return x.RenewalWindow
+	}
+	return 0
 }
 
-type OrderEvent_Matched struct {
-	// The order was involved in a match making attempt.
-	Matched *MatchEvent `protobuf:"bytes,4,opt,name=matched,proto3,oneof"`
+func (x *Account) GetReserveSat() uint64 {
+	if x != nil {
+		return x.ReserveSat
+	}
+	return 0
 }
 
-func (*OrderEvent_StateChange) isOrderEvent_Event() {}
-
-func (*OrderEvent_Matched) isOrderEvent_Event() {}
+func (x *Account) GetVersion() auctioneerrpc.AccountVersion {
+	if x != nil {
+		return x.Version
+	}
+	return auctioneerrpc.AccountVersion(0)
+}
 
-type UpdatedEvent struct {
+type SubmitOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Types that are assignable to Details:
+	//	*SubmitOrderRequest_Ask
+	//	*SubmitOrderRequest_Bid
+	Details isSubmitOrderRequest_Details `protobuf_oneof:"details"`
 	//
-	//The state of the order previous to the change. This is what the state
-	//changed from.
-	PreviousState auctioneerrpc.OrderState `protobuf:"varint,1,opt,name=previous_state,json=previousState,proto3,enum=poolrpc.OrderState" json:"previous_state,omitempty"`
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of pool. This
+	//initiator part is meant for user interfaces to add their name to give the
+	//full picture of the binary used (poold, LiT) and the method used for
+	//submitting the order (pool CLI, LiT UI, other 3rd party UI).
+	Initiator string `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
 	//
-	//The new state of the order after the change. This is what the state changed
-	//to.
-	NewState auctioneerrpc.OrderState `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=poolrpc.OrderState" json:"new_state,omitempty"`
-	// The units that were filled at the time of the event.
-	UnitsFilled uint32 `protobuf:"varint,3,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	//If set, the order is signed and stored locally but not sent to the auction
+	//server until it is activated with ActivateOrder. A staged order reserves
+	//account balance locally and can be canceled without contacting the auction
+	//server.
+	Stage bool `protobuf:"varint,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	//
+	//The chain fee rate in sat/kw at or below which a staged order is activated
+	//automatically. The fee rate is estimated by the connected lnd node. If this
+	//is 0, the order is only activated manually. Can only be set together with
+	//the stage flag.
+	ActivationFeeRateSatPerKw uint64 `protobuf:"varint,5,opt,name=activation_fee_rate_sat_per_kw,json=activationFeeRateSatPerKw,proto3" json:"activation_fee_rate_sat_per_kw,omitempty"`
+	//
+	//If set, the order is submitted even if another active order with the same
+	//type, amount, rate, lease duration and account was submitted recently.
+	Force bool `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *UpdatedEvent) Reset() {
-	*x = UpdatedEvent{}
+func (x *SubmitOrderRequest) Reset() {
+	*x = SubmitOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[31]
+		mi := &file_trader_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdatedEvent) String() string {
+func (x *SubmitOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatedEvent) ProtoMessage() {}
+func (*SubmitOrderRequest) ProtoMessage() {}
 
-func (x *UpdatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[31]
+func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2767,66 +3805,115 @@ func (x *UpdatedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatedEvent.ProtoReflect.Descriptor instead.
-func (*UpdatedEvent) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdatedEvent) GetPreviousState() auctioneerrpc.OrderState {
+func (m *SubmitOrderRequest) GetDetails() isSubmitOrderRequest_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetAsk() *Ask {
+	if x, ok := x.GetDetails().(*SubmitOrderRequest_Ask); ok {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetBid() *Bid {
+	if x, ok := x.GetDetails().(*SubmitOrderRequest_Bid); ok {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *SubmitOrderRequest) GetInitiator() string {
 	if x != nil {
-		return x.PreviousState
+		return x.Initiator
 	}
-	return auctioneerrpc.OrderState(0)
+	return ""
 }
 
-func (x *UpdatedEvent) GetNewState() auctioneerrpc.OrderState {
+func (x *SubmitOrderRequest) GetStage() bool {
 	if x != nil {
-		return x.NewState
+		return x.Stage
 	}
-	return auctioneerrpc.OrderState(0)
+	return false
 }
 
-func (x *UpdatedEvent) GetUnitsFilled() uint32 {
+func (x *SubmitOrderRequest) GetActivationFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.UnitsFilled
+		return x.ActivationFeeRateSatPerKw
 	}
 	return 0
 }
 
-type MatchEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The state of the match making process the order went through.
-	MatchState MatchState `protobuf:"varint,1,opt,name=match_state,json=matchState,proto3,enum=poolrpc.MatchState" json:"match_state,omitempty"`
-	// The number of units that would be (or were) filled with this match.
-	UnitsFilled uint32 `protobuf:"varint,2,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
-	// The nonce of the order we were matched to.
-	MatchedOrder []byte `protobuf:"bytes,3,opt,name=matched_order,json=matchedOrder,proto3" json:"matched_order,omitempty"`
-	//
-	//The reason why the trader daemon rejected the order. Is only set if
-	//match_state is set to REJECTED.
-	RejectReason MatchRejectReason `protobuf:"varint,4,opt,name=reject_reason,json=rejectReason,proto3,enum=poolrpc.MatchRejectReason" json:"reject_reason,omitempty"`
+func (x *SubmitOrderRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-func (x *MatchEvent) Reset() {
-	*x = MatchEvent{}
+type isSubmitOrderRequest_Details interface {
+	isSubmitOrderRequest_Details()
+}
+
+type SubmitOrderRequest_Ask struct {
+	Ask *Ask `protobuf:"bytes,1,opt,name=ask,proto3,oneof"`
+}
+
+type SubmitOrderRequest_Bid struct {
+	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3,oneof"`
+}
+
+func (*SubmitOrderRequest_Ask) isSubmitOrderRequest_Details() {}
+
+func (*SubmitOrderRequest_Bid) isSubmitOrderRequest_Details() {}
+
+type SubmitOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Details:
+	//	*SubmitOrderResponse_InvalidOrder
+	//	*SubmitOrderResponse_AcceptedOrderNonce
+	Details isSubmitOrderResponse_Details `protobuf_oneof:"details"`
+	//
+	//In case a bid order was submitted for a sidecar ticket, that ticket is
+	//updated with the new state and bid order nonce.
+	UpdatedSidecarTicket string `protobuf:"bytes,3,opt,name=updated_sidecar_ticket,json=updatedSidecarTicket,proto3" json:"updated_sidecar_ticket,omitempty"`
+	//
+	//The trader key of the account the order was submitted from.
+	TraderKey []byte `protobuf:"bytes,4,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//Whether the account was selected automatically because the order didn't
+	//specify one.
+	AccountSelected bool `protobuf:"varint,5,opt,name=account_selected,json=accountSelected,proto3" json:"account_selected,omitempty"`
+}
+
+func (x *SubmitOrderResponse) Reset() {
+	*x = SubmitOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[32]
+		mi := &file_trader_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MatchEvent) String() string {
+func (x *SubmitOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchEvent) ProtoMessage() {}
+func (*SubmitOrderResponse) ProtoMessage() {}
 
-func (x *MatchEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[32]
+func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,99 +3924,120 @@ func (x *MatchEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchEvent.ProtoReflect.Descriptor instead.
-func (*MatchEvent) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
+func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *MatchEvent) GetMatchState() MatchState {
-	if x != nil {
-		return x.MatchState
+func (m *SubmitOrderResponse) GetDetails() isSubmitOrderResponse_Details {
+	if m != nil {
+		return m.Details
 	}
-	return MatchState_PREPARE
+	return nil
 }
 
-func (x *MatchEvent) GetUnitsFilled() uint32 {
+func (x *SubmitOrderResponse) GetInvalidOrder() *auctioneerrpc.InvalidOrder {
+	if x, ok := x.GetDetails().(*SubmitOrderResponse_InvalidOrder); ok {
+		return x.InvalidOrder
+	}
+	return nil
+}
+
+func (x *SubmitOrderResponse) GetAcceptedOrderNonce() []byte {
+	if x, ok := x.GetDetails().(*SubmitOrderResponse_AcceptedOrderNonce); ok {
+		return x.AcceptedOrderNonce
+	}
+	return nil
+}
+
+func (x *SubmitOrderResponse) GetUpdatedSidecarTicket() string {
 	if x != nil {
-		return x.UnitsFilled
+		return x.UpdatedSidecarTicket
 	}
-	return 0
+	return ""
 }
 
-func (x *MatchEvent) GetMatchedOrder() []byte {
+func (x *SubmitOrderResponse) GetTraderKey() []byte {
 	if x != nil {
-		return x.MatchedOrder
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *MatchEvent) GetRejectReason() MatchRejectReason {
+func (x *SubmitOrderResponse) GetAccountSelected() bool {
 	if x != nil {
-		return x.RejectReason
+		return x.AccountSelected
 	}
-	return MatchRejectReason_NONE
+	return false
 }
 
-type RecoverAccountsRequest struct {
+type isSubmitOrderResponse_Details interface {
+	isSubmitOrderResponse_Details()
+}
+
+type SubmitOrderResponse_InvalidOrder struct {
+	//
+	//Order failed with the given reason.
+	InvalidOrder *auctioneerrpc.InvalidOrder `protobuf:"bytes,1,opt,name=invalid_order,json=invalidOrder,proto3,oneof"`
+}
+
+type SubmitOrderResponse_AcceptedOrderNonce struct {
+	//
+	//The order nonce of the accepted order.
+	AcceptedOrderNonce []byte `protobuf:"bytes,2,opt,name=accepted_order_nonce,json=acceptedOrderNonce,proto3,oneof"`
+}
+
+func (*SubmitOrderResponse_InvalidOrder) isSubmitOrderResponse_Details() {}
+
+func (*SubmitOrderResponse_AcceptedOrderNonce) isSubmitOrderResponse_Details() {}
+
+type PrepareOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//Recover the latest account states without interacting with the
-	//Lightning Labs server.
-	FullClient bool `protobuf:"varint,1,opt,name=full_client,json=fullClient,proto3" json:"full_client,omitempty"`
+	//Set if the order failed local validation, in which case all other fields
+	//are empty.
+	InvalidOrder *auctioneerrpc.InvalidOrder `protobuf:"bytes,1,opt,name=invalid_order,json=invalidOrder,proto3" json:"invalid_order,omitempty"`
 	//
-	//Number of accounts that we are trying to recover. Used during the
-	//full_client recovery process.
-	AccountTarget uint32 `protobuf:"varint,2,opt,name=account_target,json=accountTarget,proto3" json:"account_target,omitempty"`
+	//The nonce of the prepared order.
+	OrderNonce []byte `protobuf:"bytes,2,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
 	//
-	//Auctioneer's public key. Used during the full_client recovery process.
-	//This field should be left empty for testnet/mainnet, its value is already
-	//hardcoded in our codebase.
-	AuctioneerKey string `protobuf:"bytes,3,opt,name=auctioneer_key,json=auctioneerKey,proto3" json:"auctioneer_key,omitempty"`
+	//The digest of the order that must be signed with the trader key. It is
+	//computed from the serialized order, so it can be verified by recomputing
+	//it on the signing machine.
+	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
 	//
-	//Initial block height. We won't try to look for any account with an expiry
-	//height smaller than this value. Used during the full_client recovery
-	//process.
-	HeightHint uint32 `protobuf:"varint,4,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
-	// bitcoind/btcd instance address. Used during the full_client recovery
-	// process.
-	BitcoinHost string `protobuf:"bytes,5,opt,name=bitcoin_host,json=bitcoinHost,proto3" json:"bitcoin_host,omitempty"`
-	// bitcoind/btcd user name. Used during the full_client recovery
-	// process.
-	BitcoinUser string `protobuf:"bytes,6,opt,name=bitcoin_user,json=bitcoinUser,proto3" json:"bitcoin_user,omitempty"`
-	// bitcoind/btcd password. Used during the full_client recovery
-	// process.
-	BitcoinPassword string `protobuf:"bytes,7,opt,name=bitcoin_password,json=bitcoinPassword,proto3" json:"bitcoin_password,omitempty"`
-	// Use HTTP POST mode? bitcoind only supports this mode. Used during the
-	// full_client recovery process.
-	BitcoinHttppostmode bool `protobuf:"varint,8,opt,name=bitcoin_httppostmode,json=bitcoinHttppostmode,proto3" json:"bitcoin_httppostmode,omitempty"`
-	// Use TLS to connect? bitcoind only supports non-TLS connections. Used
-	// during the full_client recovery process.
-	BitcoinUsetls bool `protobuf:"varint,9,opt,name=bitcoin_usetls,json=bitcoinUsetls,proto3" json:"bitcoin_usetls,omitempty"`
-	// Path to btcd's TLS certificate, if TLS is enabled. Used  during the
-	// full_client recovery process.
-	BitcoinTlspath string `protobuf:"bytes,10,opt,name=bitcoin_tlspath,json=bitcoinTlspath,proto3" json:"bitcoin_tlspath,omitempty"`
+	//The order with all its details, serialized in the format of the local
+	//database. It must be passed to SubmitSignedOrder unchanged.
+	SerializedOrder []byte `protobuf:"bytes,4,opt,name=serialized_order,json=serializedOrder,proto3" json:"serialized_order,omitempty"`
+	//
+	//The trader key of the account the order must be signed with.
+	TraderKey []byte `protobuf:"bytes,5,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//Whether the account was selected automatically because the order didn't
+	//specify one.
+	AccountSelected bool `protobuf:"varint,6,opt,name=account_selected,json=accountSelected,proto3" json:"account_selected,omitempty"`
 }
 
-func (x *RecoverAccountsRequest) Reset() {
-	*x = RecoverAccountsRequest{}
+func (x *PrepareOrderResponse) Reset() {
+	*x = PrepareOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[33]
+		mi := &file_trader_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecoverAccountsRequest) String() string {
+func (x *PrepareOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverAccountsRequest) ProtoMessage() {}
+func (*PrepareOrderResponse) ProtoMessage() {}
 
-func (x *RecoverAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[33]
+func (x *PrepareOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2940,107 +4048,163 @@ func (x *RecoverAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverAccountsRequest.ProtoReflect.Descriptor instead.
-func (*RecoverAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use PrepareOrderResponse.ProtoReflect.Descriptor instead.
+func (*PrepareOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RecoverAccountsRequest) GetFullClient() bool {
+func (x *PrepareOrderResponse) GetInvalidOrder() *auctioneerrpc.InvalidOrder {
 	if x != nil {
-		return x.FullClient
+		return x.InvalidOrder
 	}
-	return false
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetAccountTarget() uint32 {
+func (x *PrepareOrderResponse) GetOrderNonce() []byte {
 	if x != nil {
-		return x.AccountTarget
+		return x.OrderNonce
 	}
-	return 0
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetAuctioneerKey() string {
+func (x *PrepareOrderResponse) GetDigest() []byte {
 	if x != nil {
-		return x.AuctioneerKey
+		return x.Digest
 	}
-	return ""
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetHeightHint() uint32 {
+func (x *PrepareOrderResponse) GetSerializedOrder() []byte {
 	if x != nil {
-		return x.HeightHint
+		return x.SerializedOrder
 	}
-	return 0
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinHost() string {
+func (x *PrepareOrderResponse) GetTraderKey() []byte {
 	if x != nil {
-		return x.BitcoinHost
+		return x.TraderKey
 	}
-	return ""
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinUser() string {
+func (x *PrepareOrderResponse) GetAccountSelected() bool {
 	if x != nil {
-		return x.BitcoinUser
+		return x.AccountSelected
 	}
-	return ""
+	return false
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinPassword() string {
-	if x != nil {
-		return x.BitcoinPassword
+type SubmitSignedOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The serialized order as returned by PrepareOrder.
+	SerializedOrder []byte `protobuf:"bytes,1,opt,name=serialized_order,json=serializedOrder,proto3" json:"serialized_order,omitempty"`
+	//
+	//The DER encoded signature of the order digest created with the trader key
+	//of the order's account, just like lnd's signer RPC creates it.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server, see SubmitOrderRequest.
+	Initiator string `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+}
+
+func (x *SubmitSignedOrderRequest) Reset() {
+	*x = SubmitSignedOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinHttppostmode() bool {
+func (x *SubmitSignedOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSignedOrderRequest) ProtoMessage() {}
+
+func (x *SubmitSignedOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSignedOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSignedOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SubmitSignedOrderRequest) GetSerializedOrder() []byte {
 	if x != nil {
-		return x.BitcoinHttppostmode
+		return x.SerializedOrder
 	}
-	return false
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinUsetls() bool {
+func (x *SubmitSignedOrderRequest) GetSignature() []byte {
 	if x != nil {
-		return x.BitcoinUsetls
+		return x.Signature
 	}
-	return false
+	return nil
 }
 
-func (x *RecoverAccountsRequest) GetBitcoinTlspath() string {
+func (x *SubmitSignedOrderRequest) GetInitiator() string {
 	if x != nil {
-		return x.BitcoinTlspath
+		return x.Initiator
 	}
 	return ""
 }
 
-type RecoverAccountsResponse struct {
+type ListOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The number of accounts that were recovered.
-	NumRecoveredAccounts uint32 `protobuf:"varint,1,opt,name=num_recovered_accounts,json=numRecoveredAccounts,proto3" json:"num_recovered_accounts,omitempty"`
+	//
+	//Can be set to true to list the orders including all events, which can be
+	//very verbose.
+	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	//
+	//Only list orders that are still active.
+	ActiveOnly bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	//
+	//The number of orders matching the filter that should be skipped before
+	//returning any results. Can be used for paginating through the orders.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	//
+	//The maximum number of orders to return. If zero, all orders after the index
+	//offset are returned.
+	MaxNumOrders uint32 `protobuf:"varint,4,opt,name=max_num_orders,json=maxNumOrders,proto3" json:"max_num_orders,omitempty"`
 }
 
-func (x *RecoverAccountsResponse) Reset() {
-	*x = RecoverAccountsResponse{}
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[34]
+		mi := &file_trader_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecoverAccountsResponse) String() string {
+func (x *ListOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverAccountsResponse) ProtoMessage() {}
+func (*ListOrdersRequest) ProtoMessage() {}
 
-func (x *RecoverAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[34]
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,41 +4215,65 @@ func (x *RecoverAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverAccountsResponse.ProtoReflect.Descriptor instead.
-func (*RecoverAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RecoverAccountsResponse) GetNumRecoveredAccounts() uint32 {
+func (x *ListOrdersRequest) GetVerbose() bool {
 	if x != nil {
-		return x.NumRecoveredAccounts
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListOrdersRequest) GetIndexOffset() uint32 {
+	if x != nil {
+		return x.IndexOffset
 	}
 	return 0
 }
 
-type AuctionFeeRequest struct {
+func (x *ListOrdersRequest) GetMaxNumOrders() uint32 {
+	if x != nil {
+		return x.MaxNumOrders
+	}
+	return 0
+}
+
+type ListOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Asks []*Ask `protobuf:"bytes,1,rep,name=asks,proto3" json:"asks,omitempty"`
+	Bids []*Bid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
 }
 
-func (x *AuctionFeeRequest) Reset() {
-	*x = AuctionFeeRequest{}
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[35]
+		mi := &file_trader_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuctionFeeRequest) String() string {
+func (x *ListOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuctionFeeRequest) ProtoMessage() {}
+func (*ListOrdersResponse) ProtoMessage() {}
 
-func (x *AuctionFeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[35]
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,38 +4284,50 @@ func (x *AuctionFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuctionFeeRequest.ProtoReflect.Descriptor instead.
-func (*AuctionFeeRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{45}
 }
 
-type AuctionFeeResponse struct {
+func (x *ListOrdersResponse) GetAsks() []*Ask {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetBids() []*Bid {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The execution fee charged per matched order.
-	ExecutionFee *auctioneerrpc.ExecutionFee `protobuf:"bytes,1,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
 }
 
-func (x *AuctionFeeResponse) Reset() {
-	*x = AuctionFeeResponse{}
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[36]
+		mi := &file_trader_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuctionFeeResponse) String() string {
+func (x *CancelOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuctionFeeResponse) ProtoMessage() {}
+func (*CancelOrderRequest) ProtoMessage() {}
 
-func (x *AuctionFeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[36]
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3138,85 +4338,41 @@ func (x *AuctionFeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuctionFeeResponse.ProtoReflect.Descriptor instead.
-func (*AuctionFeeResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AuctionFeeResponse) GetExecutionFee() *auctioneerrpc.ExecutionFee {
+func (x *CancelOrderRequest) GetOrderNonce() []byte {
 	if x != nil {
-		return x.ExecutionFee
+		return x.OrderNonce
 	}
 	return nil
 }
 
-type Lease struct {
+type CancelOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The outpoint of the channel created.
-	ChannelPoint *auctioneerrpc.OutPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	// The amount, in satoshis, of the channel created.
-	ChannelAmtSat uint64 `protobuf:"varint,2,opt,name=channel_amt_sat,json=channelAmtSat,proto3" json:"channel_amt_sat,omitempty"`
-	// The intended duration, in blocks, of the channel created.
-	ChannelDurationBlocks uint32 `protobuf:"varint,3,opt,name=channel_duration_blocks,json=channelDurationBlocks,proto3" json:"channel_duration_blocks,omitempty"`
-	// The absolute height that this channel lease expires.
-	ChannelLeaseExpiry uint32 `protobuf:"varint,4,opt,name=channel_lease_expiry,json=channelLeaseExpiry,proto3" json:"channel_lease_expiry,omitempty"`
-	//
-	//The premium, in satoshis, either paid or received for the offered liquidity.
-	PremiumSat uint64 `protobuf:"varint,5,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
-	//
-	//The execution fee, in satoshis, charged by the auctioneer for the channel
-	//created.
-	ExecutionFeeSat uint64 `protobuf:"varint,6,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
-	//
-	//The fee, in satoshis, charged by the auctioneer for the batch execution
-	//transaction that created this lease.
-	ChainFeeSat uint64 `protobuf:"varint,7,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
-	//
-	//The actual fixed rate expressed in parts per billionth this lease was
-	//bought/sold at.
-	ClearingRatePrice uint64 `protobuf:"varint,8,opt,name=clearing_rate_price,json=clearingRatePrice,proto3" json:"clearing_rate_price,omitempty"`
-	//
-	//The actual fixed rate of the bid/ask, this should always be 'better' than
-	//the clearing_rate_price.
-	OrderFixedRate uint64 `protobuf:"varint,9,opt,name=order_fixed_rate,json=orderFixedRate,proto3" json:"order_fixed_rate,omitempty"`
-	// The order executed that resulted in the channel created.
-	OrderNonce []byte `protobuf:"bytes,10,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
-	//
-	//The unique identifier for the order that was matched with that resulted
-	//in the channel created.
-	MatchedOrderNonce []byte `protobuf:"bytes,16,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
-	// Whether this channel was purchased from another trader or not.
-	Purchased bool `protobuf:"varint,11,opt,name=purchased,proto3" json:"purchased,omitempty"`
-	// The pubkey of the node that this channel was bought/sold from.
-	ChannelRemoteNodeKey []byte `protobuf:"bytes,12,opt,name=channel_remote_node_key,json=channelRemoteNodeKey,proto3" json:"channel_remote_node_key,omitempty"`
-	// The tier of the node that this channel was bought/sold from.
-	ChannelNodeTier auctioneerrpc.NodeTier `protobuf:"varint,13,opt,name=channel_node_tier,json=channelNodeTier,proto3,enum=poolrpc.NodeTier" json:"channel_node_tier,omitempty"`
-	// The self channel balance that was pushed to the recipient.
-	SelfChanBalance uint64 `protobuf:"varint,14,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
-	// Whether the channel was leased as a sidecar channel (bid orders only).
-	SidecarChannel bool `protobuf:"varint,15,opt,name=sidecar_channel,json=sidecarChannel,proto3" json:"sidecar_channel,omitempty"`
 }
 
-func (x *Lease) Reset() {
-	*x = Lease{}
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[37]
+		mi := &file_trader_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Lease) String() string {
+func (x *CancelOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lease) ProtoMessage() {}
+func (*CancelOrderResponse) ProtoMessage() {}
 
-func (x *Lease) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[37]
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3227,155 +4383,94 @@ func (x *Lease) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
-func (*Lease) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Lease) GetChannelPoint() *auctioneerrpc.OutPoint {
-	if x != nil {
-		return x.ChannelPoint
-	}
-	return nil
-}
+type ActivateOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Lease) GetChannelAmtSat() uint64 {
-	if x != nil {
-		return x.ChannelAmtSat
-	}
-	return 0
+	//
+	//The nonce of the staged order to activate.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server, see SubmitOrderRequest.
+	Initiator string `protobuf:"bytes,2,opt,name=initiator,proto3" json:"initiator,omitempty"`
 }
 
-func (x *Lease) GetChannelDurationBlocks() uint32 {
-	if x != nil {
-		return x.ChannelDurationBlocks
+func (x *ActivateOrderRequest) Reset() {
+	*x = ActivateOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Lease) GetChannelLeaseExpiry() uint32 {
-	if x != nil {
-		return x.ChannelLeaseExpiry
-	}
-	return 0
+func (x *ActivateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Lease) GetPremiumSat() uint64 {
-	if x != nil {
-		return x.PremiumSat
-	}
-	return 0
-}
+func (*ActivateOrderRequest) ProtoMessage() {}
 
-func (x *Lease) GetExecutionFeeSat() uint64 {
-	if x != nil {
-		return x.ExecutionFeeSat
+func (x *ActivateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Lease) GetChainFeeSat() uint64 {
-	if x != nil {
-		return x.ChainFeeSat
-	}
-	return 0
+// Deprecated: Use ActivateOrderRequest.ProtoReflect.Descriptor instead.
+func (*ActivateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Lease) GetClearingRatePrice() uint64 {
+func (x *ActivateOrderRequest) GetOrderNonce() []byte {
 	if x != nil {
-		return x.ClearingRatePrice
+		return x.OrderNonce
 	}
-	return 0
+	return nil
 }
 
-func (x *Lease) GetOrderFixedRate() uint64 {
+func (x *ActivateOrderRequest) GetInitiator() string {
 	if x != nil {
-		return x.OrderFixedRate
+		return x.Initiator
 	}
-	return 0
+	return ""
 }
 
-func (x *Lease) GetOrderNonce() []byte {
-	if x != nil {
-		return x.OrderNonce
-	}
-	return nil
+type ActivateOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Lease) GetMatchedOrderNonce() []byte {
-	if x != nil {
-		return x.MatchedOrderNonce
+func (x *ActivateOrderResponse) Reset() {
+	*x = ActivateOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Lease) GetPurchased() bool {
-	if x != nil {
-		return x.Purchased
-	}
-	return false
+func (x *ActivateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Lease) GetChannelRemoteNodeKey() []byte {
-	if x != nil {
-		return x.ChannelRemoteNodeKey
-	}
-	return nil
-}
+func (*ActivateOrderResponse) ProtoMessage() {}
 
-func (x *Lease) GetChannelNodeTier() auctioneerrpc.NodeTier {
-	if x != nil {
-		return x.ChannelNodeTier
-	}
-	return auctioneerrpc.NodeTier(0)
-}
-
-func (x *Lease) GetSelfChanBalance() uint64 {
-	if x != nil {
-		return x.SelfChanBalance
-	}
-	return 0
-}
-
-func (x *Lease) GetSidecarChannel() bool {
-	if x != nil {
-		return x.SidecarChannel
-	}
-	return false
-}
-
-type LeasesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//An optional list of batches to retrieve the leases of. If empty, leases
-	//throughout all batches are returned.
-	BatchIds [][]byte `protobuf:"bytes,1,rep,name=batch_ids,json=batchIds,proto3" json:"batch_ids,omitempty"`
-	//
-	//An optional list of accounts to retrieve the leases of. If empty, leases
-	//for all accounts are returned.
-	Accounts [][]byte `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
-}
-
-func (x *LeasesRequest) Reset() {
-	*x = LeasesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *LeasesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LeasesRequest) ProtoMessage() {}
-
-func (x *LeasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[38]
+func (x *ActivateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3386,55 +4481,44 @@ func (x *LeasesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeasesRequest.ProtoReflect.Descriptor instead.
-func (*LeasesRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *LeasesRequest) GetBatchIds() [][]byte {
-	if x != nil {
-		return x.BatchIds
-	}
-	return nil
-}
-
-func (x *LeasesRequest) GetAccounts() [][]byte {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+// Deprecated: Use ActivateOrderResponse.ProtoReflect.Descriptor instead.
+func (*ActivateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{49}
 }
 
-type LeasesResponse struct {
+type CancelAllOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The relevant list of leases purchased or sold within the auction.
-	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
-	// The total amount of satoshis earned from the leases returned.
-	TotalAmtEarnedSat uint64 `protobuf:"varint,2,opt,name=total_amt_earned_sat,json=totalAmtEarnedSat,proto3" json:"total_amt_earned_sat,omitempty"`
-	// The total amount of satoshis paid for the leases returned.
-	TotalAmtPaidSat uint64 `protobuf:"varint,3,opt,name=total_amt_paid_sat,json=totalAmtPaidSat,proto3" json:"total_amt_paid_sat,omitempty"`
+	//
+	//If set, only the orders of the account with this trader key are canceled.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The type of orders to cancel.
+	OrderType OrderTypeFilter `protobuf:"varint,2,opt,name=order_type,json=orderType,proto3,enum=poolrpc.OrderTypeFilter" json:"order_type,omitempty"`
+	//
+	//If set, only the orders for this lease duration bucket are canceled.
+	LeaseDurationBlocks uint32 `protobuf:"varint,3,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
 }
 
-func (x *LeasesResponse) Reset() {
-	*x = LeasesResponse{}
+func (x *CancelAllOrdersRequest) Reset() {
+	*x = CancelAllOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[39]
+		mi := &file_trader_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeasesResponse) String() string {
+func (x *CancelAllOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeasesResponse) ProtoMessage() {}
+func (*CancelAllOrdersRequest) ProtoMessage() {}
 
-func (x *LeasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[39]
+func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,55 +4529,59 @@ func (x *LeasesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeasesResponse.ProtoReflect.Descriptor instead.
-func (*LeasesResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CancelAllOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *LeasesResponse) GetLeases() []*Lease {
+func (x *CancelAllOrdersRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.Leases
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *LeasesResponse) GetTotalAmtEarnedSat() uint64 {
+func (x *CancelAllOrdersRequest) GetOrderType() OrderTypeFilter {
 	if x != nil {
-		return x.TotalAmtEarnedSat
+		return x.OrderType
 	}
-	return 0
+	return OrderTypeFilter_ORDER_TYPE_ANY
 }
 
-func (x *LeasesResponse) GetTotalAmtPaidSat() uint64 {
+func (x *CancelAllOrdersRequest) GetLeaseDurationBlocks() uint32 {
 	if x != nil {
-		return x.TotalAmtPaidSat
+		return x.LeaseDurationBlocks
 	}
 	return 0
 }
 
-type TokensRequest struct {
+type CancelAllOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The result of the cancellation of each order that matched the filters.
+	Results []*CancelOrderResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *TokensRequest) Reset() {
-	*x = TokensRequest{}
+func (x *CancelAllOrdersResponse) Reset() {
+	*x = CancelAllOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[40]
+		mi := &file_trader_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokensRequest) String() string {
+func (x *CancelAllOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokensRequest) ProtoMessage() {}
+func (*CancelAllOrdersResponse) ProtoMessage() {}
 
-func (x *TokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[40]
+func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3504,38 +4592,51 @@ func (x *TokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
-func (*TokensRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CancelAllOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{51}
 }
 
-type TokensResponse struct {
+func (x *CancelAllOrdersResponse) GetResults() []*CancelOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CancelOrderResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//*
-	//List of all tokens the daemon knows of, including old/expired tokens.
-	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	//
+	//The nonce of the order.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//Whether the order was canceled.
+	Canceled bool `protobuf:"varint,2,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	//
+	//The reason the order couldn't be canceled, if it wasn't.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TokensResponse) Reset() {
-	*x = TokensResponse{}
+func (x *CancelOrderResult) Reset() {
+	*x = CancelOrderResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[41]
+		mi := &file_trader_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokensResponse) String() string {
+func (x *CancelOrderResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokensResponse) ProtoMessage() {}
+func (*CancelOrderResult) ProtoMessage() {}
 
-func (x *TokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[41]
+func (x *CancelOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3546,69 +4647,73 @@ func (x *TokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
-func (*TokensResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CancelOrderResult.ProtoReflect.Descriptor instead.
+func (*CancelOrderResult) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *TokensResponse) GetTokens() []*LsatToken {
+func (x *CancelOrderResult) GetOrderNonce() []byte {
 	if x != nil {
-		return x.Tokens
+		return x.OrderNonce
 	}
 	return nil
 }
 
-type LsatToken struct {
+func (x *CancelOrderResult) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+func (x *CancelOrderResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReplaceOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//*
-	//The base macaroon that was baked by the auth server.
-	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
-	//*
-	//The payment hash of the payment that was paid to obtain the token.
-	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
-	//*
-	//The preimage of the payment hash, knowledge of this is proof that the
-	//payment has been paid. If the preimage is set to all zeros, this means the
-	//payment is still pending and the token is not yet fully valid.
-	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
-	//*
-	//The amount of millisatoshis that was paid to get the token.
-	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
-	//*
-	//The amount of millisatoshis paid in routing fee to pay for the token.
-	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
-	//*
-	//The creation time of the token as UNIX timestamp in seconds.
-	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
-	//*
-	//Indicates whether the token is expired or still valid.
-	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
-	//*
-	//Identifying attribute of this token in the store. Currently represents the
-	//file name of the token where it's stored on the file system.
-	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	//
+	//The nonce of the order to replace.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//The amount of the new order in satoshis. If this isn't set, the new order
+	//is for the amount that is still unfulfilled in the old order.
+	Amt uint64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//If set, this replaces the fixed order rate of the old order.
+	RateFixed uint32 `protobuf:"varint,3,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
+	//
+	//If set, this replaces the maximum fee rate of the old order.
+	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server, see SubmitOrderRequest.
+	Initiator string `protobuf:"bytes,5,opt,name=initiator,proto3" json:"initiator,omitempty"`
 }
 
-func (x *LsatToken) Reset() {
-	*x = LsatToken{}
+func (x *ReplaceOrderRequest) Reset() {
+	*x = ReplaceOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[42]
+		mi := &file_trader_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsatToken) String() string {
+func (x *ReplaceOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsatToken) ProtoMessage() {}
+func (*ReplaceOrderRequest) ProtoMessage() {}
 
-func (x *LsatToken) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[42]
+func (x *ReplaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3619,90 +4724,76 @@ func (x *LsatToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
-func (*LsatToken) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *LsatToken) GetBaseMacaroon() []byte {
-	if x != nil {
-		return x.BaseMacaroon
-	}
-	return nil
-}
-
-func (x *LsatToken) GetPaymentHash() []byte {
-	if x != nil {
-		return x.PaymentHash
-	}
-	return nil
+// Deprecated: Use ReplaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *LsatToken) GetPaymentPreimage() []byte {
+func (x *ReplaceOrderRequest) GetOrderNonce() []byte {
 	if x != nil {
-		return x.PaymentPreimage
+		return x.OrderNonce
 	}
 	return nil
 }
 
-func (x *LsatToken) GetAmountPaidMsat() int64 {
+func (x *ReplaceOrderRequest) GetAmt() uint64 {
 	if x != nil {
-		return x.AmountPaidMsat
+		return x.Amt
 	}
 	return 0
 }
 
-func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
+func (x *ReplaceOrderRequest) GetRateFixed() uint32 {
 	if x != nil {
-		return x.RoutingFeePaidMsat
+		return x.RateFixed
 	}
 	return 0
 }
 
-func (x *LsatToken) GetTimeCreated() int64 {
+func (x *ReplaceOrderRequest) GetMaxBatchFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.TimeCreated
+		return x.MaxBatchFeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *LsatToken) GetExpired() bool {
-	if x != nil {
-		return x.Expired
-	}
-	return false
-}
-
-func (x *LsatToken) GetStorageName() string {
+func (x *ReplaceOrderRequest) GetInitiator() string {
 	if x != nil {
-		return x.StorageName
+		return x.Initiator
 	}
 	return ""
 }
 
-type LeaseDurationRequest struct {
+type ReplaceOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The nonce of the canceled order.
+	OldOrderNonce []byte `protobuf:"bytes,1,opt,name=old_order_nonce,json=oldOrderNonce,proto3" json:"old_order_nonce,omitempty"`
+	//
+	//The nonce of the new order.
+	NewOrderNonce []byte `protobuf:"bytes,2,opt,name=new_order_nonce,json=newOrderNonce,proto3" json:"new_order_nonce,omitempty"`
 }
 
-func (x *LeaseDurationRequest) Reset() {
-	*x = LeaseDurationRequest{}
+func (x *ReplaceOrderResponse) Reset() {
+	*x = ReplaceOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[43]
+		mi := &file_trader_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaseDurationRequest) String() string {
+func (x *ReplaceOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseDurationRequest) ProtoMessage() {}
+func (*ReplaceOrderResponse) ProtoMessage() {}
 
-func (x *LeaseDurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[43]
+func (x *ReplaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3713,44 +4804,60 @@ func (x *LeaseDurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseDurationRequest.ProtoReflect.Descriptor instead.
-func (*LeaseDurationRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ReplaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{54}
 }
 
-type LeaseDurationResponse struct {
+func (x *ReplaceOrderResponse) GetOldOrderNonce() []byte {
+	if x != nil {
+		return x.OldOrderNonce
+	}
+	return nil
+}
+
+func (x *ReplaceOrderResponse) GetNewOrderNonce() []byte {
+	if x != nil {
+		return x.NewOrderNonce
+	}
+	return nil
+}
+
+type OrderTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//Deprecated, use lease_duration_buckets.
+	//The unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	//
-	// Deprecated: Do not use.
-	LeaseDurations map[uint32]bool `protobuf:"bytes,1,rep,name=lease_durations,json=leaseDurations,proto3" json:"lease_durations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//The order parameters of the template. The amount, trader key and nonce of
+	//the order are not set.
 	//
-	//The set of lease durations the market is currently accepting and the state
-	//the duration buckets currently are in.
-	LeaseDurationBuckets map[uint32]auctioneerrpc.DurationBucketState `protobuf:"bytes,2,rep,name=lease_duration_buckets,json=leaseDurationBuckets,proto3" json:"lease_duration_buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=poolrpc.DurationBucketState"`
+	// Types that are assignable to Details:
+	//	*OrderTemplate_Ask
+	//	*OrderTemplate_Bid
+	Details isOrderTemplate_Details `protobuf_oneof:"details"`
 }
 
-func (x *LeaseDurationResponse) Reset() {
-	*x = LeaseDurationResponse{}
+func (x *OrderTemplate) Reset() {
+	*x = OrderTemplate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[44]
+		mi := &file_trader_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaseDurationResponse) String() string {
+func (x *OrderTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaseDurationResponse) ProtoMessage() {}
+func (*OrderTemplate) ProtoMessage() {}
 
-func (x *LeaseDurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[44]
+func (x *OrderTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3761,49 +4868,90 @@ func (x *LeaseDurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaseDurationResponse.ProtoReflect.Descriptor instead.
-func (*LeaseDurationResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use OrderTemplate.ProtoReflect.Descriptor instead.
+func (*OrderTemplate) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{55}
 }
 
-// Deprecated: Do not use.
-func (x *LeaseDurationResponse) GetLeaseDurations() map[uint32]bool {
+func (x *OrderTemplate) GetName() string {
 	if x != nil {
-		return x.LeaseDurations
+		return x.Name
+	}
+	return ""
+}
+
+func (m *OrderTemplate) GetDetails() isOrderTemplate_Details {
+	if m != nil {
+		return m.Details
 	}
 	return nil
 }
 
-func (x *LeaseDurationResponse) GetLeaseDurationBuckets() map[uint32]auctioneerrpc.DurationBucketState {
-	if x != nil {
-		return x.LeaseDurationBuckets
+func (x *OrderTemplate) GetAsk() *Ask {
+	if x, ok := x.GetDetails().(*OrderTemplate_Ask); ok {
+		return x.Ask
 	}
 	return nil
 }
 
-type NextBatchInfoRequest struct {
+func (x *OrderTemplate) GetBid() *Bid {
+	if x, ok := x.GetDetails().(*OrderTemplate_Bid); ok {
+		return x.Bid
+	}
+	return nil
+}
+
+type isOrderTemplate_Details interface {
+	isOrderTemplate_Details()
+}
+
+type OrderTemplate_Ask struct {
+	Ask *Ask `protobuf:"bytes,2,opt,name=ask,proto3,oneof"`
+}
+
+type OrderTemplate_Bid struct {
+	Bid *Bid `protobuf:"bytes,3,opt,name=bid,proto3,oneof"`
+}
+
+func (*OrderTemplate_Ask) isOrderTemplate_Details() {}
+
+func (*OrderTemplate_Bid) isOrderTemplate_Details() {}
+
+type SaveOrderTemplateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The order parameters to save. The amount and trader key of the order are
+	//ignored.
+	//
+	// Types that are assignable to Details:
+	//	*SaveOrderTemplateRequest_Ask
+	//	*SaveOrderTemplateRequest_Bid
+	Details isSaveOrderTemplateRequest_Details `protobuf_oneof:"details"`
 }
 
-func (x *NextBatchInfoRequest) Reset() {
-	*x = NextBatchInfoRequest{}
+func (x *SaveOrderTemplateRequest) Reset() {
+	*x = SaveOrderTemplateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[45]
+		mi := &file_trader_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NextBatchInfoRequest) String() string {
+func (x *SaveOrderTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextBatchInfoRequest) ProtoMessage() {}
+func (*SaveOrderTemplateRequest) ProtoMessage() {}
 
-func (x *NextBatchInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[45]
+func (x *SaveOrderTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3814,51 +4962,78 @@ func (x *NextBatchInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextBatchInfoRequest.ProtoReflect.Descriptor instead.
-func (*NextBatchInfoRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use SaveOrderTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveOrderTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{56}
 }
 
-type NextBatchInfoResponse struct {
+func (x *SaveOrderTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *SaveOrderTemplateRequest) GetDetails() isSaveOrderTemplateRequest_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *SaveOrderTemplateRequest) GetAsk() *Ask {
+	if x, ok := x.GetDetails().(*SaveOrderTemplateRequest_Ask); ok {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *SaveOrderTemplateRequest) GetBid() *Bid {
+	if x, ok := x.GetDetails().(*SaveOrderTemplateRequest_Bid); ok {
+		return x.Bid
+	}
+	return nil
+}
+
+type isSaveOrderTemplateRequest_Details interface {
+	isSaveOrderTemplateRequest_Details()
+}
+
+type SaveOrderTemplateRequest_Ask struct {
+	Ask *Ask `protobuf:"bytes,2,opt,name=ask,proto3,oneof"`
+}
+
+type SaveOrderTemplateRequest_Bid struct {
+	Bid *Bid `protobuf:"bytes,3,opt,name=bid,proto3,oneof"`
+}
+
+func (*SaveOrderTemplateRequest_Ask) isSaveOrderTemplateRequest_Details() {}
+
+func (*SaveOrderTemplateRequest_Bid) isSaveOrderTemplateRequest_Details() {}
+
+type SaveOrderTemplateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//
-	//The confirmation target the auctioneer will use for fee estimation of the
-	//next batch.
-	ConfTarget uint32 `protobuf:"varint,5,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
-	//
-	//The fee rate, in satoshis per kiloweight, estimated by the auctioneer to use
-	//for the next batch.
-	FeeRateSatPerKw uint64 `protobuf:"varint,6,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
-	//
-	//The absolute unix timestamp in seconds at which the auctioneer will attempt
-	//to clear the next batch.
-	ClearTimestamp uint64 `protobuf:"varint,7,opt,name=clear_timestamp,json=clearTimestamp,proto3" json:"clear_timestamp,omitempty"`
-	//
-	//The value used by the auctioneer to determine if an account expiry height
-	//needs to be extended after participating in a batch and for how long.
-	AutoRenewExtensionBlocks uint32 `protobuf:"varint,8,opt,name=auto_renew_extension_blocks,json=autoRenewExtensionBlocks,proto3" json:"auto_renew_extension_blocks,omitempty"`
 }
 
-func (x *NextBatchInfoResponse) Reset() {
-	*x = NextBatchInfoResponse{}
+func (x *SaveOrderTemplateResponse) Reset() {
+	*x = SaveOrderTemplateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[46]
+		mi := &file_trader_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NextBatchInfoResponse) String() string {
+func (x *SaveOrderTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextBatchInfoResponse) ProtoMessage() {}
+func (*SaveOrderTemplateResponse) ProtoMessage() {}
 
-func (x *NextBatchInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[46]
+func (x *SaveOrderTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3869,65 +5044,74 @@ func (x *NextBatchInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextBatchInfoResponse.ProtoReflect.Descriptor instead.
-func (*NextBatchInfoResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use SaveOrderTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveOrderTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *NextBatchInfoResponse) GetConfTarget() uint32 {
-	if x != nil {
-		return x.ConfTarget
-	}
-	return 0
+type ListOrderTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *NextBatchInfoResponse) GetFeeRateSatPerKw() uint64 {
-	if x != nil {
-		return x.FeeRateSatPerKw
+func (x *ListOrderTemplatesRequest) Reset() {
+	*x = ListOrderTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *NextBatchInfoResponse) GetClearTimestamp() uint64 {
-	if x != nil {
-		return x.ClearTimestamp
-	}
-	return 0
+func (x *ListOrderTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NextBatchInfoResponse) GetAutoRenewExtensionBlocks() uint32 {
-	if x != nil {
-		return x.AutoRenewExtensionBlocks
+func (*ListOrderTemplatesRequest) ProtoMessage() {}
+
+func (x *ListOrderTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type NodeRatingRequest struct {
+// Deprecated: Use ListOrderTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{58}
+}
+
+type ListOrderTemplatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The target node to obtain ratings information for.
-	NodePubkeys [][]byte `protobuf:"bytes,1,rep,name=node_pubkeys,json=nodePubkeys,proto3" json:"node_pubkeys,omitempty"`
+	Templates []*OrderTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
 }
 
-func (x *NodeRatingRequest) Reset() {
-	*x = NodeRatingRequest{}
+func (x *ListOrderTemplatesResponse) Reset() {
+	*x = ListOrderTemplatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[47]
+		mi := &file_trader_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeRatingRequest) String() string {
+func (x *ListOrderTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeRatingRequest) ProtoMessage() {}
+func (*ListOrderTemplatesResponse) ProtoMessage() {}
 
-func (x *NodeRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[47]
+func (x *ListOrderTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,44 +5122,45 @@ func (x *NodeRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeRatingRequest.ProtoReflect.Descriptor instead.
-func (*NodeRatingRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListOrderTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *NodeRatingRequest) GetNodePubkeys() [][]byte {
+func (x *ListOrderTemplatesResponse) GetTemplates() []*OrderTemplate {
 	if x != nil {
-		return x.NodePubkeys
+		return x.Templates
 	}
 	return nil
 }
 
-type NodeRatingResponse struct {
+type DeleteOrderTemplateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A series of node ratings for each of the queried nodes.
-	NodeRatings []*auctioneerrpc.NodeRating `protobuf:"bytes,1,rep,name=node_ratings,json=nodeRatings,proto3" json:"node_ratings,omitempty"`
+	//
+	//The name of the template to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *NodeRatingResponse) Reset() {
-	*x = NodeRatingResponse{}
+func (x *DeleteOrderTemplateRequest) Reset() {
+	*x = DeleteOrderTemplateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[48]
+		mi := &file_trader_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeRatingResponse) String() string {
+func (x *DeleteOrderTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeRatingResponse) ProtoMessage() {}
+func (*DeleteOrderTemplateRequest) ProtoMessage() {}
 
-func (x *NodeRatingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[48]
+func (x *DeleteOrderTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3986,41 +5171,41 @@ func (x *NodeRatingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeRatingResponse.ProtoReflect.Descriptor instead.
-func (*NodeRatingResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DeleteOrderTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrderTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *NodeRatingResponse) GetNodeRatings() []*auctioneerrpc.NodeRating {
+func (x *DeleteOrderTemplateRequest) GetName() string {
 	if x != nil {
-		return x.NodeRatings
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetInfoRequest struct {
+type DeleteOrderTemplateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
+func (x *DeleteOrderTemplateResponse) Reset() {
+	*x = DeleteOrderTemplateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[49]
+		mi := &file_trader_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoRequest) String() string {
+func (x *DeleteOrderTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoRequest) ProtoMessage() {}
+func (*DeleteOrderTemplateResponse) ProtoMessage() {}
 
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[49]
+func (x *DeleteOrderTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4031,79 +5216,59 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DeleteOrderTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrderTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{61}
 }
 
-type GetInfoResponse struct {
+type SubmitOrderFromTemplateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The version of the Pool daemon that is running.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// The total number of accounts in the local database.
-	AccountsTotal uint32 `protobuf:"varint,2,opt,name=accounts_total,json=accountsTotal,proto3" json:"accounts_total,omitempty"`
 	//
-	//The total number of accounts that are in an active, non-archived state,
-	//including expired accounts.
-	AccountsActive uint32 `protobuf:"varint,3,opt,name=accounts_active,json=accountsActive,proto3" json:"accounts_active,omitempty"`
-	// The total number of accounts that are active but have expired.
-	AccountsActiveExpired uint32 `protobuf:"varint,4,opt,name=accounts_active_expired,json=accountsActiveExpired,proto3" json:"accounts_active_expired,omitempty"`
-	// The total number of accounts that are in an archived/closed state.
-	AccountsArchived uint32 `protobuf:"varint,5,opt,name=accounts_archived,json=accountsArchived,proto3" json:"accounts_archived,omitempty"`
-	// The total number of orders in the local database.
-	OrdersTotal uint32 `protobuf:"varint,6,opt,name=orders_total,json=ordersTotal,proto3" json:"orders_total,omitempty"`
+	//The name of the template to create the order from.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	//
-	//The total number of active/pending orders that are still waiting for
-	//execution.
-	OrdersActive uint32 `protobuf:"varint,7,opt,name=orders_active,json=ordersActive,proto3" json:"orders_active,omitempty"`
-	// The total number of orders that have been archived.
-	OrdersArchived uint32 `protobuf:"varint,8,opt,name=orders_archived,json=ordersArchived,proto3" json:"orders_archived,omitempty"`
-	// The current block height as seen by the connected lnd node.
-	CurrentBlockHeight uint32 `protobuf:"varint,9,opt,name=current_block_height,json=currentBlockHeight,proto3" json:"current_block_height,omitempty"`
-	// The number of batches an account of this node was ever involved in.
-	BatchesInvolved uint32 `protobuf:"varint,10,opt,name=batches_involved,json=batchesInvolved,proto3" json:"batches_involved,omitempty"`
-	// Our lnd node's rating as judged by the auctioneer server.
-	NodeRating *auctioneerrpc.NodeRating `protobuf:"bytes,11,opt,name=node_rating,json=nodeRating,proto3" json:"node_rating,omitempty"`
-	// The number of available LSAT tokens.
-	LsatTokens uint32 `protobuf:"varint,12,opt,name=lsat_tokens,json=lsatTokens,proto3" json:"lsat_tokens,omitempty"`
+	//The amount of the order in satoshis.
+	Amt uint64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
 	//
-	//Indicates whether there is an active subscription connection to the
-	//auctioneer. This will never be true if there is no active account. If there
-	//are active accounts, this value represents the network connection status to
-	//the auctioneer server.
-	SubscribedToAuctioneer bool `protobuf:"varint,13,opt,name=subscribed_to_auctioneer,json=subscribedToAuctioneer,proto3" json:"subscribed_to_auctioneer,omitempty"`
+	//The trader's account key of the account the order is submitted from. If
+	//this is empty, an account is selected automatically.
+	TraderKey []byte `protobuf:"bytes,3,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 	//
-	//Indicates whether the global `--newnodesonly` command line flag or
-	//`newnodesonly=true` configuration parameter was set on the Pool trader
-	//daemon.
-	NewNodesOnly bool `protobuf:"varint,14,opt,name=new_nodes_only,json=newNodesOnly,proto3" json:"new_nodes_only,omitempty"`
+	//If set, this replaces the maximum fee rate of the template.
+	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
 	//
-	//A map of all markets identified by their lease duration and the current
-	//set of statistics such as number of open orders and total units of open
-	//interest.
-	MarketInfo map[uint32]*auctioneerrpc.MarketInfo `protobuf:"bytes,15,rep,name=market_info,json=marketInfo,proto3" json:"market_info,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//If set, this replaces the label of the template.
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server, see SubmitOrderRequest.
+	Initiator string `protobuf:"bytes,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//If set, the order is submitted even if it is a duplicate of another
+	//recently submitted active order, see SubmitOrderRequest.
+	Force bool `protobuf:"varint,7,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
+func (x *SubmitOrderFromTemplateRequest) Reset() {
+	*x = SubmitOrderFromTemplateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[50]
+		mi := &file_trader_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoResponse) String() string {
+func (x *SubmitOrderFromTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
+func (*SubmitOrderFromTemplateRequest) ProtoMessage() {}
 
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[50]
+func (x *SubmitOrderFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4114,139 +5279,148 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SubmitOrderFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SubmitOrderFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetInfoResponse) GetVersion() string {
+func (x *SubmitOrderFromTemplateRequest) GetName() string {
 	if x != nil {
-		return x.Version
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetAccountsTotal() uint32 {
+func (x *SubmitOrderFromTemplateRequest) GetAmt() uint64 {
 	if x != nil {
-		return x.AccountsTotal
+		return x.Amt
 	}
 	return 0
 }
 
-func (x *GetInfoResponse) GetAccountsActive() uint32 {
+func (x *SubmitOrderFromTemplateRequest) GetTraderKey() []byte {
 	if x != nil {
-		return x.AccountsActive
+		return x.TraderKey
 	}
-	return 0
+	return nil
 }
 
-func (x *GetInfoResponse) GetAccountsActiveExpired() uint32 {
+func (x *SubmitOrderFromTemplateRequest) GetMaxBatchFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.AccountsActiveExpired
+		return x.MaxBatchFeeRateSatPerKw
 	}
 	return 0
 }
 
-func (x *GetInfoResponse) GetAccountsArchived() uint32 {
+func (x *SubmitOrderFromTemplateRequest) GetLabel() string {
 	if x != nil {
-		return x.AccountsArchived
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *GetInfoResponse) GetOrdersTotal() uint32 {
+func (x *SubmitOrderFromTemplateRequest) GetInitiator() string {
 	if x != nil {
-		return x.OrdersTotal
+		return x.Initiator
 	}
-	return 0
+	return ""
 }
 
-func (x *GetInfoResponse) GetOrdersActive() uint32 {
+func (x *SubmitOrderFromTemplateRequest) GetForce() bool {
 	if x != nil {
-		return x.OrdersActive
+		return x.Force
 	}
-	return 0
+	return false
 }
 
-func (x *GetInfoResponse) GetOrdersArchived() uint32 {
-	if x != nil {
-		return x.OrdersArchived
-	}
-	return 0
-}
+type PruneArchivedOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetInfoResponse) GetCurrentBlockHeight() uint32 {
-	if x != nil {
-		return x.CurrentBlockHeight
-	}
-	return 0
+	//
+	//Only orders whose most recent event happened before this unix timestamp in
+	//nanoseconds are removed.
+	OlderThanTimestampNs int64 `protobuf:"varint,1,opt,name=older_than_timestamp_ns,json=olderThanTimestampNs,proto3" json:"older_than_timestamp_ns,omitempty"`
+	//
+	//Only orders in one of the given archived states are removed. If no states
+	//are given, orders in any archived state are removed.
+	States []auctioneerrpc.OrderState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=poolrpc.OrderState" json:"states,omitempty"`
 }
 
-func (x *GetInfoResponse) GetBatchesInvolved() uint32 {
-	if x != nil {
-		return x.BatchesInvolved
+func (x *PruneArchivedOrdersRequest) Reset() {
+	*x = PruneArchivedOrdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetInfoResponse) GetNodeRating() *auctioneerrpc.NodeRating {
-	if x != nil {
-		return x.NodeRating
-	}
-	return nil
+func (x *PruneArchivedOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetInfoResponse) GetLsatTokens() uint32 {
-	if x != nil {
-		return x.LsatTokens
+func (*PruneArchivedOrdersRequest) ProtoMessage() {}
+
+func (x *PruneArchivedOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetInfoResponse) GetSubscribedToAuctioneer() bool {
-	if x != nil {
-		return x.SubscribedToAuctioneer
-	}
-	return false
+// Deprecated: Use PruneArchivedOrdersRequest.ProtoReflect.Descriptor instead.
+func (*PruneArchivedOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetInfoResponse) GetNewNodesOnly() bool {
+func (x *PruneArchivedOrdersRequest) GetOlderThanTimestampNs() int64 {
 	if x != nil {
-		return x.NewNodesOnly
+		return x.OlderThanTimestampNs
 	}
-	return false
+	return 0
 }
 
-func (x *GetInfoResponse) GetMarketInfo() map[uint32]*auctioneerrpc.MarketInfo {
+func (x *PruneArchivedOrdersRequest) GetStates() []auctioneerrpc.OrderState {
 	if x != nil {
-		return x.MarketInfo
+		return x.States
 	}
 	return nil
 }
 
-type StopDaemonRequest struct {
+type PruneArchivedOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The number of orders that were removed.
+	NumPruned uint32 `protobuf:"varint,1,opt,name=num_pruned,json=numPruned,proto3" json:"num_pruned,omitempty"`
 }
 
-func (x *StopDaemonRequest) Reset() {
-	*x = StopDaemonRequest{}
+func (x *PruneArchivedOrdersResponse) Reset() {
+	*x = PruneArchivedOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[51]
+		mi := &file_trader_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopDaemonRequest) String() string {
+func (x *PruneArchivedOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopDaemonRequest) ProtoMessage() {}
+func (*PruneArchivedOrdersResponse) ProtoMessage() {}
 
-func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[51]
+func (x *PruneArchivedOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4257,34 +5431,50 @@ func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopDaemonRequest.ProtoReflect.Descriptor instead.
-func (*StopDaemonRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use PruneArchivedOrdersResponse.ProtoReflect.Descriptor instead.
+func (*PruneArchivedOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{64}
 }
 
-type StopDaemonResponse struct {
+func (x *PruneArchivedOrdersResponse) GetNumPruned() uint32 {
+	if x != nil {
+		return x.NumPruned
+	}
+	return 0
+}
+
+type OrderStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//Only return the statistics of this lease duration market. If this is 0,
+	//the statistics of all markets are returned.
+	LeaseDurationBlocks uint32 `protobuf:"varint,1,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//Only include batches that were finalized at or after this unix timestamp
+	//in nanoseconds. If this is 0, all batches are included.
+	StartTimestampNs int64 `protobuf:"varint,2,opt,name=start_timestamp_ns,json=startTimestampNs,proto3" json:"start_timestamp_ns,omitempty"`
 }
 
-func (x *StopDaemonResponse) Reset() {
-	*x = StopDaemonResponse{}
+func (x *OrderStatsRequest) Reset() {
+	*x = OrderStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[52]
+		mi := &file_trader_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopDaemonResponse) String() string {
+func (x *OrderStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopDaemonResponse) ProtoMessage() {}
+func (*OrderStatsRequest) ProtoMessage() {}
 
-func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[52]
+func (x *OrderStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4295,45 +5485,72 @@ func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopDaemonResponse.ProtoReflect.Descriptor instead.
-func (*StopDaemonResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use OrderStatsRequest.ProtoReflect.Descriptor instead.
+func (*OrderStatsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{65}
 }
 
-type OfferSidecarRequest struct {
+func (x *OrderStatsRequest) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
+	}
+	return 0
+}
+
+func (x *OrderStatsRequest) GetStartTimestampNs() int64 {
+	if x != nil {
+		return x.StartTimestampNs
+	}
+	return 0
+}
+
+type LeaseDurationOrderStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//If false, then only the trader_key, unit, self_chan_balance, and
-	//lease_duration_blocks need to be set in the bid below. Otherwise, the
-	//fields as they're set when submitting a bid need to be filled in.
-	AutoNegotiate bool `protobuf:"varint,1,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+	//The lease duration market the statistics are for.
+	LeaseDurationBlocks uint32 `protobuf:"varint,1,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
 	//
-	//The bid template that will be used to populate the initial sidecar ticket
-	//as well as auto negotiate the remainig steps of the sidecar channel if
-	//needed.
-	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	//The number of batches our orders were filled in.
+	NumBatches uint32 `protobuf:"varint,2,opt,name=num_batches,json=numBatches,proto3" json:"num_batches,omitempty"`
+	//
+	//The total number of units that were filled.
+	UnitsFilled uint32 `protobuf:"varint,3,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	//
+	//The total amount of all leased channels in satoshis.
+	VolumeSat uint64 `protobuf:"varint,4,opt,name=volume_sat,json=volumeSat,proto3" json:"volume_sat,omitempty"`
+	//
+	//The average clearing price our orders were filled at, weighted by the
+	//number of units filled at each price. This is in the same unit as an
+	//order's rate_fixed.
+	WeightedAvgRateFixed uint32 `protobuf:"varint,5,opt,name=weighted_avg_rate_fixed,json=weightedAvgRateFixed,proto3" json:"weighted_avg_rate_fixed,omitempty"`
+	//
+	//The total premium our bids paid in satoshis.
+	PremiumsPaidSat uint64 `protobuf:"varint,6,opt,name=premiums_paid_sat,json=premiumsPaidSat,proto3" json:"premiums_paid_sat,omitempty"`
+	//
+	//The total premium our asks earned in satoshis.
+	PremiumsEarnedSat uint64 `protobuf:"varint,7,opt,name=premiums_earned_sat,json=premiumsEarnedSat,proto3" json:"premiums_earned_sat,omitempty"`
 }
 
-func (x *OfferSidecarRequest) Reset() {
-	*x = OfferSidecarRequest{}
+func (x *LeaseDurationOrderStats) Reset() {
+	*x = LeaseDurationOrderStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[53]
+		mi := &file_trader_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OfferSidecarRequest) String() string {
+func (x *LeaseDurationOrderStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OfferSidecarRequest) ProtoMessage() {}
+func (*LeaseDurationOrderStats) ProtoMessage() {}
 
-func (x *OfferSidecarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[53]
+func (x *LeaseDurationOrderStats) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4344,56 +5561,87 @@ func (x *OfferSidecarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OfferSidecarRequest.ProtoReflect.Descriptor instead.
-func (*OfferSidecarRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use LeaseDurationOrderStats.ProtoReflect.Descriptor instead.
+func (*LeaseDurationOrderStats) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *OfferSidecarRequest) GetAutoNegotiate() bool {
+func (x *LeaseDurationOrderStats) GetLeaseDurationBlocks() uint32 {
 	if x != nil {
-		return x.AutoNegotiate
+		return x.LeaseDurationBlocks
 	}
-	return false
+	return 0
 }
 
-func (x *OfferSidecarRequest) GetBid() *Bid {
+func (x *LeaseDurationOrderStats) GetNumBatches() uint32 {
 	if x != nil {
-		return x.Bid
+		return x.NumBatches
 	}
-	return nil
+	return 0
 }
 
-type SidecarTicket struct {
+func (x *LeaseDurationOrderStats) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+func (x *LeaseDurationOrderStats) GetVolumeSat() uint64 {
+	if x != nil {
+		return x.VolumeSat
+	}
+	return 0
+}
+
+func (x *LeaseDurationOrderStats) GetWeightedAvgRateFixed() uint32 {
+	if x != nil {
+		return x.WeightedAvgRateFixed
+	}
+	return 0
+}
+
+func (x *LeaseDurationOrderStats) GetPremiumsPaidSat() uint64 {
+	if x != nil {
+		return x.PremiumsPaidSat
+	}
+	return 0
+}
+
+func (x *LeaseDurationOrderStats) GetPremiumsEarnedSat() uint64 {
+	if x != nil {
+		return x.PremiumsEarnedSat
+	}
+	return 0
+}
+
+type OrderStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The complete sidecar ticket in its string encoded form which is base58
-	//encoded, has a human readable prefix ('sidecar...') and a checksum built in.
-	//The string encoded version will only be used on the trader side of the API.
-	//All requests to the auctioneer expect the ticket to be in its raw, tlv
-	//encoded byte form.
-	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	//The statistics of each lease duration market, sorted by lease duration.
+	Stats []*LeaseDurationOrderStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *SidecarTicket) Reset() {
-	*x = SidecarTicket{}
+func (x *OrderStatsResponse) Reset() {
+	*x = OrderStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[54]
+		mi := &file_trader_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SidecarTicket) String() string {
+func (x *OrderStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SidecarTicket) ProtoMessage() {}
+func (*OrderStatsResponse) ProtoMessage() {}
 
-func (x *SidecarTicket) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[54]
+func (x *OrderStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4404,78 +5652,165 @@ func (x *SidecarTicket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SidecarTicket.ProtoReflect.Descriptor instead.
-func (*SidecarTicket) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use OrderStatsResponse.ProtoReflect.Descriptor instead.
+func (*OrderStatsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *SidecarTicket) GetTicket() string {
+func (x *OrderStatsResponse) GetStats() []*LeaseDurationOrderStats {
 	if x != nil {
-		return x.Ticket
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type DecodedSidecarTicket struct {
+type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique, pseudorandom identifier of the ticket.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The version of the ticket encoding format.
-	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// The state of the ticket.
-	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	// The offered channel capacity in satoshis.
-	OfferCapacity uint64 `protobuf:"varint,4,opt,name=offer_capacity,json=offerCapacity,proto3" json:"offer_capacity,omitempty"`
-	// The offered push amount in satoshis.
-	OfferPushAmount uint64 `protobuf:"varint,5,opt,name=offer_push_amount,json=offerPushAmount,proto3" json:"offer_push_amount,omitempty"`
-	// The offered lease duration in blocks.
-	OfferLeaseDurationBlocks uint32 `protobuf:"varint,6,opt,name=offer_lease_duration_blocks,json=offerLeaseDurationBlocks,proto3" json:"offer_lease_duration_blocks,omitempty"`
-	// The public key the offer was signed with.
-	OfferSignPubkey []byte `protobuf:"bytes,7,opt,name=offer_sign_pubkey,json=offerSignPubkey,proto3" json:"offer_sign_pubkey,omitempty"`
-	// The signature over the offer's digest.
-	OfferSignature []byte `protobuf:"bytes,8,opt,name=offer_signature,json=offerSignature,proto3" json:"offer_signature,omitempty"`
-	// Whether the offer was created with the automatic order creation flag.
-	OfferAuto bool `protobuf:"varint,9,opt,name=offer_auto,json=offerAuto,proto3" json:"offer_auto,omitempty"`
-	// The recipient node's public identity key.
-	RecipientNodePubkey []byte `protobuf:"bytes,10,opt,name=recipient_node_pubkey,json=recipientNodePubkey,proto3" json:"recipient_node_pubkey,omitempty"`
 	//
-	//The recipient node's channel multisig public key to be used for the sidecar
-	//channel.
-	RecipientMultisigPubkey []byte `protobuf:"bytes,11,opt,name=recipient_multisig_pubkey,json=recipientMultisigPubkey,proto3" json:"recipient_multisig_pubkey,omitempty"`
-	// The index used when deriving the above multisig pubkey.
-	RecipientMultisigPubkeyIndex uint32 `protobuf:"varint,12,opt,name=recipient_multisig_pubkey_index,json=recipientMultisigPubkeyIndex,proto3" json:"recipient_multisig_pubkey_index,omitempty"`
-	// The nonce of the bid order created for this sidecar ticket.
-	OrderBidNonce []byte `protobuf:"bytes,13,opt,name=order_bid_nonce,json=orderBidNonce,proto3" json:"order_bid_nonce,omitempty"`
+	//The trader's account key of the account that is used for the order. When
+	//submitting an order, this can be left empty to select an account
+	//automatically.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
 	//
-	//The signature over the order's digest, signed with the private key that
-	//corresponds to the offer_sign_pubkey.
-	OrderSignature []byte `protobuf:"bytes,14,opt,name=order_signature,json=orderSignature,proto3" json:"order_signature,omitempty"`
-	// The pending channel ID of the sidecar channel during the execution phase.
-	ExecutionPendingChannelId []byte `protobuf:"bytes,15,opt,name=execution_pending_channel_id,json=executionPendingChannelId,proto3" json:"execution_pending_channel_id,omitempty"`
-	// The original, base58 encoded ticket.
-	EncodedTicket string `protobuf:"bytes,16,opt,name=encoded_ticket,json=encodedTicket,proto3" json:"encoded_ticket,omitempty"`
+	//Fixed order rate in parts per billion.
+	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
+	//
+	//Order amount in satoshis.
+	Amt uint64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Maximum fee rate the trader is willing to pay for the batch transaction,
+	//expressed in satoshis per 1000 weight units (sat/KW).
+	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
+	//
+	//Order nonce, acts as unique order identifier.
+	OrderNonce []byte `protobuf:"bytes,5,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//The state the order currently is in.
+	State auctioneerrpc.OrderState `protobuf:"varint,6,opt,name=state,proto3,enum=poolrpc.OrderState" json:"state,omitempty"`
+	//
+	//The number of order units the amount corresponds to.
+	Units uint32 `protobuf:"varint,7,opt,name=units,proto3" json:"units,omitempty"`
+	//
+	//The number of currently unfilled units of this order. This will be equal to
+	//the total amount of units until the order has reached the state PARTIAL_FILL
+	//or EXECUTED.
+	UnitsUnfulfilled uint32 `protobuf:"varint,8,opt,name=units_unfulfilled,json=unitsUnfulfilled,proto3" json:"units_unfulfilled,omitempty"`
+	// The value reserved from the account by this order to ensure the account
+	// can pay execution and chain fees in case it gets matched.
+	ReservedValueSat uint64 `protobuf:"varint,9,opt,name=reserved_value_sat,json=reservedValueSat,proto3" json:"reserved_value_sat,omitempty"`
+	// The unix timestamp in nanoseconds the order was first created/submitted.
+	CreationTimestampNs uint64 `protobuf:"varint,10,opt,name=creation_timestamp_ns,json=creationTimestampNs,proto3" json:"creation_timestamp_ns,omitempty"`
+	//
+	//A list of events that were emitted for this order. This field is only set
+	//when the verbose flag is set to true in the request.
+	Events []*OrderEvent `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	// The minimum number of order units that must be matched per order pair.
+	MinUnitsMatch uint32 `protobuf:"varint,12,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
+	// The channel type to use for the resulting matched channels.
+	ChannelType auctioneerrpc.OrderChannelType `protobuf:"varint,13,opt,name=channel_type,json=channelType,proto3,enum=poolrpc.OrderChannelType" json:"channel_type,omitempty"`
+	// List of nodes that will be allowed to match with our order. Incompatible
+	// with the `not_allowed_node_ids` field.
+	AllowedNodeIds [][]byte `protobuf:"bytes,14,rep,name=allowed_node_ids,json=allowedNodeIds,proto3" json:"allowed_node_ids,omitempty"`
+	// List of nodes that won't be allowed to match with our order. Incompatible
+	// with the `allowed_node_ids` field.
+	NotAllowedNodeIds [][]byte `protobuf:"bytes,15,rep,name=not_allowed_node_ids,json=notAllowedNodeIds,proto3" json:"not_allowed_node_ids,omitempty"`
+	//
+	//An optional label to identify the order. The label is only stored locally
+	//and never sent to the auctioneer. Must be valid UTF-8 and can be at most
+	//500 bytes long.
+	Label string `protobuf:"bytes,16,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//The maximum share of a batch transaction's chain fee in satoshis the order
+	//is willing to pay. The trader rejects any batch in which the order's share
+	//exceeds this value. Like the label, it is only enforced locally and never
+	//sent to the auctioneer. If zero, only the max batch fee rate applies.
+	MaxChainFeeSat uint64 `protobuf:"varint,17,opt,name=max_chain_fee_sat,json=maxChainFeeSat,proto3" json:"max_chain_fee_sat,omitempty"`
+	//
+	//The number of times the order is resubmitted with the same parameters from
+	//the same account once it is fully executed. Each resubmission is a new order
+	//with its own nonce. Resubmission stops early if the account's free balance
+	//falls below the minimum order size. Like the label, this is only known
+	//locally and never sent to the auctioneer.
+	Recurrences uint32 `protobuf:"varint,18,opt,name=recurrences,proto3" json:"recurrences,omitempty"`
+	//
+	//The nonces of all orders of a recurring order up to and including this
+	//one, starting with the original order. Can be used to group the fills of
+	//all resubmissions. Only set when listing orders.
+	RecurrenceNonces [][]byte `protobuf:"bytes,19,rep,name=recurrence_nonces,json=recurrenceNonces,proto3" json:"recurrence_nonces,omitempty"`
+	//
+	//The nonce of the order this order replaced, if it was created by
+	//ReplaceOrder. Only set when listing orders.
+	ReplacedOrderNonce []byte `protobuf:"bytes,20,opt,name=replaced_order_nonce,json=replacedOrderNonce,proto3" json:"replaced_order_nonce,omitempty"`
+	//
+	//The chain fee rate in sat/kw at or below which the order is activated
+	//automatically while it is staged. Only set when listing orders.
+	ActivationFeeRateSatPerKw uint64 `protobuf:"varint,21,opt,name=activation_fee_rate_sat_per_kw,json=activationFeeRateSatPerKw,proto3" json:"activation_fee_rate_sat_per_kw,omitempty"`
+	//
+	//The block height at which the order is canceled automatically. Zero means
+	//the order doesn't expire at a certain height. Like the label, this is only
+	//known locally and never sent to the auctioneer.
+	ExpiryHeight uint32 `protobuf:"varint,22,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	//
+	//The unix timestamp in seconds at which the order is canceled automatically.
+	//Zero means the order doesn't expire at a certain time. Like the label, this
+	//is only known locally and never sent to the auctioneer.
+	ExpiryTimestamp int64 `protobuf:"varint,23,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+	//
+	//The total premium in satoshis the order paid (bids) or earned (asks)
+	//across all batches it was matched in. Only set when listing orders.
+	PremiumTotalSat uint64 `protobuf:"varint,24,opt,name=premium_total_sat,json=premiumTotalSat,proto3" json:"premium_total_sat,omitempty"`
+	//
+	//The total execution fee in satoshis the order paid across all batches it
+	//was matched in. Only set when listing orders.
+	ExecutionFeeTotalSat uint64 `protobuf:"varint,25,opt,name=execution_fee_total_sat,json=executionFeeTotalSat,proto3" json:"execution_fee_total_sat,omitempty"`
+	//
+	//The total share of the batch transaction chain fees in satoshis the order
+	//paid across all batches it was matched in. Only set when listing orders.
+	ChainFeeTotalSat uint64 `protobuf:"varint,26,opt,name=chain_fee_total_sat,json=chainFeeTotalSat,proto3" json:"chain_fee_total_sat,omitempty"`
+	//
+	//Set if the order was already matched in batches before the totals were
+	//recorded. The totals then only include the batches since.
+	TotalsPartial bool `protobuf:"varint,27,opt,name=totals_partial,json=totalsPartial,proto3" json:"totals_partial,omitempty"`
+	//
+	//Whether the channels resulting from the order must not be announced to the
+	//network. Requires at least order version 7. Orders are only matched with
+	//orders that have the same value.
+	UnannouncedChannel bool `protobuf:"varint,28,opt,name=unannounced_channel,json=unannouncedChannel,proto3" json:"unannounced_channel,omitempty"`
+	//
+	//For bids, whether the channels resulting from the order should be usable
+	//without waiting for the batch transaction to confirm. For asks, whether the
+	//maker is willing to open such zero conf channels. Requires at least order
+	//version 8 and an auctioneer that supports zero conf channels.
+	ZeroConfChannel bool `protobuf:"varint,29,opt,name=zero_conf_channel,json=zeroConfChannel,proto3" json:"zero_conf_channel,omitempty"`
+	//
+	//Whether the order is replaced by one with a higher max batch fee rate if
+	//a batch is rejected because its fee rate exceeds the order's maximum. The
+	//new rate is the current fee estimate of the backing lnd node, capped at
+	//the maximum configured in the trader daemon. This is only known locally
+	//and never sent to the auctioneer.
+	AutoUpdateFeeRate bool `protobuf:"varint,30,opt,name=auto_update_fee_rate,json=autoUpdateFeeRate,proto3" json:"auto_update_fee_rate,omitempty"`
 }
 
-func (x *DecodedSidecarTicket) Reset() {
-	*x = DecodedSidecarTicket{}
+func (x *Order) Reset() {
+	*x = Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[55]
+		mi := &file_trader_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecodedSidecarTicket) String() string {
+func (x *Order) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodedSidecarTicket) ProtoMessage() {}
+func (*Order) ProtoMessage() {}
 
-func (x *DecodedSidecarTicket) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[55]
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4486,215 +5821,280 @@ func (x *DecodedSidecarTicket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodedSidecarTicket.ProtoReflect.Descriptor instead.
-func (*DecodedSidecarTicket) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *DecodedSidecarTicket) GetId() []byte {
+func (x *Order) GetTraderKey() []byte {
 	if x != nil {
-		return x.Id
+		return x.TraderKey
 	}
 	return nil
 }
 
-func (x *DecodedSidecarTicket) GetVersion() uint32 {
+func (x *Order) GetRateFixed() uint32 {
 	if x != nil {
-		return x.Version
+		return x.RateFixed
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetState() string {
+func (x *Order) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *Order) GetMaxBatchFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.MaxBatchFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *Order) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *Order) GetState() auctioneerrpc.OrderState {
 	if x != nil {
 		return x.State
 	}
-	return ""
+	return auctioneerrpc.OrderState(0)
 }
 
-func (x *DecodedSidecarTicket) GetOfferCapacity() uint64 {
+func (x *Order) GetUnits() uint32 {
 	if x != nil {
-		return x.OfferCapacity
+		return x.Units
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferPushAmount() uint64 {
+func (x *Order) GetUnitsUnfulfilled() uint32 {
 	if x != nil {
-		return x.OfferPushAmount
+		return x.UnitsUnfulfilled
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferLeaseDurationBlocks() uint32 {
+func (x *Order) GetReservedValueSat() uint64 {
 	if x != nil {
-		return x.OfferLeaseDurationBlocks
+		return x.ReservedValueSat
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferSignPubkey() []byte {
+func (x *Order) GetCreationTimestampNs() uint64 {
 	if x != nil {
-		return x.OfferSignPubkey
+		return x.CreationTimestampNs
 	}
-	return nil
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOfferSignature() []byte {
+func (x *Order) GetEvents() []*OrderEvent {
 	if x != nil {
-		return x.OfferSignature
+		return x.Events
 	}
 	return nil
 }
 
-func (x *DecodedSidecarTicket) GetOfferAuto() bool {
+func (x *Order) GetMinUnitsMatch() uint32 {
 	if x != nil {
-		return x.OfferAuto
+		return x.MinUnitsMatch
 	}
-	return false
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetRecipientNodePubkey() []byte {
+func (x *Order) GetChannelType() auctioneerrpc.OrderChannelType {
 	if x != nil {
-		return x.RecipientNodePubkey
+		return x.ChannelType
+	}
+	return auctioneerrpc.OrderChannelType(0)
+}
+
+func (x *Order) GetAllowedNodeIds() [][]byte {
+	if x != nil {
+		return x.AllowedNodeIds
 	}
 	return nil
 }
 
-func (x *DecodedSidecarTicket) GetRecipientMultisigPubkey() []byte {
+func (x *Order) GetNotAllowedNodeIds() [][]byte {
 	if x != nil {
-		return x.RecipientMultisigPubkey
+		return x.NotAllowedNodeIds
 	}
 	return nil
 }
 
-func (x *DecodedSidecarTicket) GetRecipientMultisigPubkeyIndex() uint32 {
+func (x *Order) GetLabel() string {
 	if x != nil {
-		return x.RecipientMultisigPubkeyIndex
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Order) GetMaxChainFeeSat() uint64 {
+	if x != nil {
+		return x.MaxChainFeeSat
 	}
 	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOrderBidNonce() []byte {
+func (x *Order) GetRecurrences() uint32 {
 	if x != nil {
-		return x.OrderBidNonce
+		return x.Recurrences
 	}
-	return nil
+	return 0
 }
 
-func (x *DecodedSidecarTicket) GetOrderSignature() []byte {
+func (x *Order) GetRecurrenceNonces() [][]byte {
 	if x != nil {
-		return x.OrderSignature
+		return x.RecurrenceNonces
 	}
 	return nil
 }
 
-func (x *DecodedSidecarTicket) GetExecutionPendingChannelId() []byte {
+func (x *Order) GetReplacedOrderNonce() []byte {
 	if x != nil {
-		return x.ExecutionPendingChannelId
+		return x.ReplacedOrderNonce
 	}
 	return nil
 }
 
-func (x *DecodedSidecarTicket) GetEncodedTicket() string {
+func (x *Order) GetActivationFeeRateSatPerKw() uint64 {
 	if x != nil {
-		return x.EncodedTicket
+		return x.ActivationFeeRateSatPerKw
 	}
-	return ""
+	return 0
 }
 
-type RegisterSidecarRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Order) GetExpiryHeight() uint32 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
 
-	//
-	//The sidecar ticket to register and add the node and channel funding
-	//information to. The ticket must be in the state "offered".
-	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
-	//
-	//If this value is True, then the daemon will attempt to finish negotiating
-	//the details of the sidecar channel automatically in the background. The
-	//progress of the ticket can be monitored using the SidecarState RPC. In
-	//addition, if this flag is set, then this method will _block_ until the
-	//sidecar negotiation either finishes or breaks down.
-	AutoNegotiate bool `protobuf:"varint,2,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+func (x *Order) GetExpiryTimestamp() int64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
 }
 
-func (x *RegisterSidecarRequest) Reset() {
-	*x = RegisterSidecarRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[56]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Order) GetPremiumTotalSat() uint64 {
+	if x != nil {
+		return x.PremiumTotalSat
 	}
+	return 0
 }
 
-func (x *RegisterSidecarRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetExecutionFeeTotalSat() uint64 {
+	if x != nil {
+		return x.ExecutionFeeTotalSat
+	}
+	return 0
 }
 
-func (*RegisterSidecarRequest) ProtoMessage() {}
+func (x *Order) GetChainFeeTotalSat() uint64 {
+	if x != nil {
+		return x.ChainFeeTotalSat
+	}
+	return 0
+}
 
-func (x *RegisterSidecarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[56]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Order) GetTotalsPartial() bool {
+	if x != nil {
+		return x.TotalsPartial
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use RegisterSidecarRequest.ProtoReflect.Descriptor instead.
-func (*RegisterSidecarRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{56}
+func (x *Order) GetUnannouncedChannel() bool {
+	if x != nil {
+		return x.UnannouncedChannel
+	}
+	return false
 }
 
-func (x *RegisterSidecarRequest) GetTicket() string {
+func (x *Order) GetZeroConfChannel() bool {
 	if x != nil {
-		return x.Ticket
+		return x.ZeroConfChannel
 	}
-	return ""
+	return false
 }
 
-func (x *RegisterSidecarRequest) GetAutoNegotiate() bool {
+func (x *Order) GetAutoUpdateFeeRate() bool {
 	if x != nil {
-		return x.AutoNegotiate
+		return x.AutoUpdateFeeRate
 	}
 	return false
 }
 
-type ExpectSidecarChannelRequest struct {
+type Bid struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The sidecar ticket to expect an incoming channel for. The ticket must be in
-	//the state "ordered".
-	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
-}
+	//The common fields shared between both ask and bid order types.
+	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	//
+	//Required number of blocks that a channel opened as a result of this bid
+	//should be kept open.
+	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//The version of the order format that is used. Will be increased once new
+	//features are added.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	//
+	//The minimum node tier this order should be matched with. Only asks backed by
+	//a node this tier or higher will be eligible for matching with this bid.
+	MinNodeTier auctioneerrpc.NodeTier `protobuf:"varint,4,opt,name=min_node_tier,json=minNodeTier,proto3,enum=poolrpc.NodeTier" json:"min_node_tier,omitempty"`
+	//
+	//Give the incoming channel that results from this bid being matched an
+	//initial outbound balance by adding additional funds from the taker's account
+	//into the channel. As a simplification for the execution protocol and the
+	//channel reserve calculations, the self_chan_balance can be at most the same
+	//as the order amount and the min_chan_amt must be set to the full order
+	//amount.
+	SelfChanBalance uint64 `protobuf:"varint,5,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
+	//
+	//If this bid order is meant to lease a channel for another node (which is
+	//dubbed a "sidecar channel") then this ticket contains all information
+	//required for setting up that sidecar channel. The ticket is expected to be
+	//the base58 encoded ticket, including the prefix and the checksum.
+	SidecarTicket string `protobuf:"bytes,6,opt,name=sidecar_ticket,json=sidecarTicket,proto3" json:"sidecar_ticket,omitempty"`
+	//
+	//If set, the bid is only matched with asks of nodes the connected lnd node
+	//doesn't have any open, pending or closing channel with yet. This is
+	//enforced by the trader daemon by rejecting such matches, the auction server
+	//doesn't know about this flag.
+	NewNodesOnly bool `protobuf:"varint,7,opt,name=new_nodes_only,json=newNodesOnly,proto3" json:"new_nodes_only,omitempty"`
+}
 
-func (x *ExpectSidecarChannelRequest) Reset() {
-	*x = ExpectSidecarChannelRequest{}
+func (x *Bid) Reset() {
+	*x = Bid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[57]
+		mi := &file_trader_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExpectSidecarChannelRequest) String() string {
+func (x *Bid) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpectSidecarChannelRequest) ProtoMessage() {}
+func (*Bid) ProtoMessage() {}
 
-func (x *ExpectSidecarChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[57]
+func (x *Bid) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4705,93 +6105,100 @@ func (x *ExpectSidecarChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpectSidecarChannelRequest.ProtoReflect.Descriptor instead.
-func (*ExpectSidecarChannelRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use Bid.ProtoReflect.Descriptor instead.
+func (*Bid) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ExpectSidecarChannelRequest) GetTicket() string {
+func (x *Bid) GetDetails() *Order {
 	if x != nil {
-		return x.Ticket
+		return x.Details
 	}
-	return ""
+	return nil
 }
 
-type ExpectSidecarChannelResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Bid) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
+	}
+	return 0
 }
 
-func (x *ExpectSidecarChannelResponse) Reset() {
-	*x = ExpectSidecarChannelResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[58]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Bid) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
 	}
+	return 0
 }
 
-func (x *ExpectSidecarChannelResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Bid) GetMinNodeTier() auctioneerrpc.NodeTier {
+	if x != nil {
+		return x.MinNodeTier
+	}
+	return auctioneerrpc.NodeTier(0)
 }
 
-func (*ExpectSidecarChannelResponse) ProtoMessage() {}
+func (x *Bid) GetSelfChanBalance() uint64 {
+	if x != nil {
+		return x.SelfChanBalance
+	}
+	return 0
+}
 
-func (x *ExpectSidecarChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[58]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Bid) GetSidecarTicket() string {
+	if x != nil {
+		return x.SidecarTicket
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ExpectSidecarChannelResponse.ProtoReflect.Descriptor instead.
-func (*ExpectSidecarChannelResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{58}
+func (x *Bid) GetNewNodesOnly() bool {
+	if x != nil {
+		return x.NewNodesOnly
+	}
+	return false
 }
 
-type ListSidecarsRequest struct {
+type Ask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The optional sidecar ID to filter for. If set, the result should either be
-	//a single ticket or no ticket in most cases. But because the ID is just 8
-	//bytes and is randomly generated, there could be collisions, especially since
-	//tickets can also be crafted by a malicious party and given to any node.
-	//That's why the offer's public key is also used as an identifying element
-	//since that cannot easily be forged without also producing a valid signature.
-	//So an attacker cannot overwrite a ticket a node offered by themselves
-	//offering a ticket with the same ID and tricking the victim into registering
-	//that. Long story sort, there could be multiple tickets with the same ID but
-	//different offer public keys, which is why those keys should be checked as
-	//well.
-	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	//The common fields shared between both ask and bid order types.
+	Details *Order `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	//
+	//The number of blocks the liquidity provider is willing to provide the
+	//channel funds for.
+	LeaseDurationBlocks uint32 `protobuf:"varint,2,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//The version of the order format that is used. Will be increased once new
+	//features are added.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	//
+	//The minimum number of distinct nodes the ask must be matched with in a
+	//single batch. Requires at least order version 6. If zero, the ask can be
+	//matched with any number of nodes.
+	MinDistinctPeers uint32 `protobuf:"varint,4,opt,name=min_distinct_peers,json=minDistinctPeers,proto3" json:"min_distinct_peers,omitempty"`
 }
 
-func (x *ListSidecarsRequest) Reset() {
-	*x = ListSidecarsRequest{}
+func (x *Ask) Reset() {
+	*x = Ask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[59]
+		mi := &file_trader_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSidecarsRequest) String() string {
+func (x *Ask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSidecarsRequest) ProtoMessage() {}
+func (*Ask) ProtoMessage() {}
 
-func (x *ListSidecarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[59]
+func (x *Ask) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4802,90 +6209,65 @@ func (x *ListSidecarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSidecarsRequest.ProtoReflect.Descriptor instead.
-func (*ListSidecarsRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use Ask.ProtoReflect.Descriptor instead.
+func (*Ask) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListSidecarsRequest) GetSidecarId() []byte {
+func (x *Ask) GetDetails() *Order {
 	if x != nil {
-		return x.SidecarId
+		return x.Details
 	}
 	return nil
 }
 
-type ListSidecarsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tickets []*DecodedSidecarTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
-}
-
-func (x *ListSidecarsResponse) Reset() {
-	*x = ListSidecarsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[60]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Ask) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
 	}
+	return 0
 }
 
-func (x *ListSidecarsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSidecarsResponse) ProtoMessage() {}
-
-func (x *ListSidecarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[60]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Ask) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSidecarsResponse.ProtoReflect.Descriptor instead.
-func (*ListSidecarsResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{60}
+	return 0
 }
 
-func (x *ListSidecarsResponse) GetTickets() []*DecodedSidecarTicket {
+func (x *Ask) GetMinDistinctPeers() uint32 {
 	if x != nil {
-		return x.Tickets
+		return x.MinDistinctPeers
 	}
-	return nil
+	return 0
 }
 
-type CancelSidecarRequest struct {
+type OrderBookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	// The lease duration of the market to return the order book for.
+	LeaseDurationBlocks uint32 `protobuf:"varint,1,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
 }
 
-func (x *CancelSidecarRequest) Reset() {
-	*x = CancelSidecarRequest{}
+func (x *OrderBookRequest) Reset() {
+	*x = OrderBookRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[61]
+		mi := &file_trader_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelSidecarRequest) String() string {
+func (x *OrderBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSidecarRequest) ProtoMessage() {}
+func (*OrderBookRequest) ProtoMessage() {}
 
-func (x *CancelSidecarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[61]
+func (x *OrderBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4896,41 +6278,67 @@ func (x *CancelSidecarRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSidecarRequest.ProtoReflect.Descriptor instead.
-func (*CancelSidecarRequest) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use OrderBookRequest.ProtoReflect.Descriptor instead.
+func (*OrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CancelSidecarRequest) GetSidecarId() []byte {
+func (x *OrderBookRequest) GetLeaseDurationBlocks() uint32 {
 	if x != nil {
-		return x.SidecarId
+		return x.LeaseDurationBlocks
 	}
-	return nil
+	return 0
 }
 
-type CancelSidecarResponse struct {
+type QuoteOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//Order amount in satoshis.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Fixed order rate in parts per billion.
+	RateFixed uint32 `protobuf:"varint,2,opt,name=rate_fixed,json=rateFixed,proto3" json:"rate_fixed,omitempty"`
+	//
+	//Required number of blocks that a channel opened as a result of this bid
+	//should be kept open.
+	LeaseDurationBlocks uint32 `protobuf:"varint,3,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//Maximum fee rate the trader is willing to pay for the batch transaction,
+	//expressed in satoshis per 1000 weight units (sat/KW).
+	MaxBatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=max_batch_fee_rate_sat_per_kw,json=maxBatchFeeRateSatPerKw,proto3" json:"max_batch_fee_rate_sat_per_kw,omitempty"`
+	// The minimum number of order units that must be matched per order pair.
+	MinUnitsMatch uint32 `protobuf:"varint,5,opt,name=min_units_match,json=minUnitsMatch,proto3" json:"min_units_match,omitempty"`
+	//
+	//Instead of the individual parameters above, the full order as it would be
+	//submitted can be quoted. If set, all other fields of the request are
+	//ignored. No nonce is created for the order and it isn't stored.
+	//
+	// Types that are assignable to Details:
+	//	*QuoteOrderRequest_Ask
+	//	*QuoteOrderRequest_Bid
+	Details isQuoteOrderRequest_Details `protobuf_oneof:"details"`
 }
 
-func (x *CancelSidecarResponse) Reset() {
-	*x = CancelSidecarResponse{}
+func (x *QuoteOrderRequest) Reset() {
+	*x = QuoteOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_trader_proto_msgTypes[62]
+		mi := &file_trader_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelSidecarResponse) String() string {
+func (x *QuoteOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSidecarResponse) ProtoMessage() {}
+func (*QuoteOrderRequest) ProtoMessage() {}
 
-func (x *CancelSidecarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_trader_proto_msgTypes[62]
+func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4941,1030 +6349,11661 @@ func (x *CancelSidecarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSidecarResponse.ProtoReflect.Descriptor instead.
-func (*CancelSidecarResponse) Descriptor() ([]byte, []int) {
-	return file_trader_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use QuoteOrderRequest.ProtoReflect.Descriptor instead.
+func (*QuoteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{72}
 }
 
-var File_trader_proto protoreflect.FileDescriptor
-
-var file_trader_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x1a, 0x1e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x65, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
-	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
-	0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
-	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1c, 0x0a, 0x09,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04,
-	0x66, 0x65, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x14, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x26, 0x0a, 0x0f,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x36, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x44, 0x0a, 0x14,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x22, 0x3f, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73,
-	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x00, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
-	0x4b, 0x77, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69,
-	0x74, 0x46, 0x65, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22,
-	0xc8, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49,
-	0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69,
-	0x64, 0x22, 0xf8, 0x01, 0x0a, 0x16, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50,
-	0x65, 0x72, 0x4b, 0x77, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
-	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
-	0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12,
-	0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x6a, 0x0a, 0x17,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
-	0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x69, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74,
-	0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66,
-	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x29,
-	0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c,
-	0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x69, 0x64, 0x22,
-	0xcc, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f,
-	0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2c,
-	0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x42, 0x10, 0x0a, 0x0e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x65,
-	0x0a, 0x14, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x74, 0x78,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61,
-	0x6c, 0x54, 0x78, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x15, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
-	0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52,
-	0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x18, 0x0a, 0x16, 0x42,
-	0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
-	0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x78, 0x69, 0x64, 0x22, 0x81, 0x01,
-	0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x48,
-	0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69,
-	0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x69, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
-	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x4e, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x58, 0x0a, 0x12,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x52, 0x04,
-	0x61, 0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64,
-	0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x15, 0x0a,
-	0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf6, 0x04, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x3e,
-	0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46,
-	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
-	0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e,
-	0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
-	0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x75, 0x6e, 0x66, 0x75, 0x6c, 0x66,
-	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75, 0x6e, 0x69,
-	0x74, 0x73, 0x55, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a,
-	0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
-	0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12,
-	0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14,
-	0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0x87, 0x02,
-	0x0a, 0x03, 0x42, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
-	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
-	0x54, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x73, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x7d, 0x0a, 0x03, 0x41, 0x73, 0x6b, 0x12, 0x28,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x32, 0x0a,
-	0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
-	0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55,
-	0x6e, 0x69, 0x74, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75,
-	0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x18,
-	0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
-	0x77, 0x6f, 0x72, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x53, 0x61, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
-	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08,
-	0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x0a,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x6d, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c,
-	0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9c, 0x03, 0x0a, 0x16, 0x52, 0x65,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x48, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x74, 0x63,
-	0x6f, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74, 0x63, 0x6f,
-	0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
-	0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x69,
-	0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e,
-	0x5f, 0x68, 0x74, 0x74, 0x70, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x48, 0x74, 0x74, 0x70,
-	0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x69, 0x74, 0x63,
-	0x6f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x74, 0x6c, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6c, 0x73, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69,
-	0x6e, 0x54, 0x6c, 0x73, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x14, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
-	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50,
-	0x0a, 0x12, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x65, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
-	0x22, 0xd6, 0x05, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x6d,
-	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f,
-	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69,
-	0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61,
-	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
-	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46,
-	0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x12, 0x35,
-	0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f,
-	0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
-	0x69, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x6f, 0x64, 0x65,
-	0x54, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x73, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x48, 0x0a, 0x0d, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2f,
-	0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x65, 0x61, 0x72, 0x6e,
-	0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x74, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12,
-	0x2b, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69,
-	0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x53, 0x61, 0x74, 0x22, 0x0f, 0x0a, 0x0d,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a,
-	0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x09,
-	0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61,
-	0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65,
-	0x65, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x92, 0x03, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x6c,
-	0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x16,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x65, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a, 0x14, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce,
-	0x01, 0x0a, 0x15, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63,
-	0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53,
-	0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
-	0x36, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
-	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x06, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a,
-	0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a,
-	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a,
-	0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
-	0x49, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6c, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
-	0x38, 0x0a, 0x18, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x6f,
-	0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
-	0x49, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x52, 0x0a, 0x0f, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13,
-	0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x4e, 0x65,
-	0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x69, 0x64, 0x52, 0x03, 0x62, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x22, 0xcc, 0x05, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b,
-	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x18, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f,
-	0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67,
-	0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x12,
-	0x32, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
-	0x45, 0x0a, 0x1f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x62, 0x69, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22,
-	0x57, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x4e,
-	0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22,
-	0x1e, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x34, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65,
-	0x63, 0x61, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
-	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x22, 0x17, 0x0a,
-	0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x93, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52,
-	0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f,
-	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53,
-	0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x07, 0x2a, 0x50, 0x0a, 0x0a,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
-	0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50,
-	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xbe,
-	0x01, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16,
-	0x0a, 0x12, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41,
-	0x56, 0x49, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48,
-	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45,
-	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x41, 0x54, 0x45, 0x52, 0x41, 0x4c, 0x10,
-	0x03, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a,
-	0x45, 0x43, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45,
-	0x45, 0x52, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f,
-	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x46,
-	0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32,
-	0xa5, 0x11, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f,
-	0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4b, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
-	0x49, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
-	0x0c, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x75,
-	0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
-	0x0f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
-	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
-	0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70,
-	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x0d, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1d,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x39, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
-	0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1f,
-	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
-	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x63, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
-	0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
-	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13,
-	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
-	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f,
-	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f,
-	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c,
-	0x61, 0x62, 0x73, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *QuoteOrderRequest) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
 }
 
-var (
-	file_trader_proto_rawDescOnce sync.Once
-	file_trader_proto_rawDescData = file_trader_proto_rawDesc
-)
+func (x *QuoteOrderRequest) GetRateFixed() uint32 {
+	if x != nil {
+		return x.RateFixed
+	}
+	return 0
+}
 
-func file_trader_proto_rawDescGZIP() []byte {
-	file_trader_proto_rawDescOnce.Do(func() {
-		file_trader_proto_rawDescData = protoimpl.X.CompressGZIP(file_trader_proto_rawDescData)
-	})
-	return file_trader_proto_rawDescData
+func (x *QuoteOrderRequest) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
+	}
+	return 0
 }
 
-var file_trader_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_trader_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
-var file_trader_proto_goTypes = []interface{}{
-	(AccountState)(0),                            // 0: poolrpc.AccountState
-	(MatchState)(0),                              // 1: poolrpc.MatchState
-	(MatchRejectReason)(0),                       // 2: poolrpc.MatchRejectReason
-	(*InitAccountRequest)(nil),                   // 3: poolrpc.InitAccountRequest
-	(*QuoteAccountRequest)(nil),                  // 4: poolrpc.QuoteAccountRequest
-	(*QuoteAccountResponse)(nil),                 // 5: poolrpc.QuoteAccountResponse
-	(*ListAccountsRequest)(nil),                  // 6: poolrpc.ListAccountsRequest
-	(*ListAccountsResponse)(nil),                 // 7: poolrpc.ListAccountsResponse
-	(*Output)(nil),                               // 8: poolrpc.Output
-	(*OutputWithFee)(nil),                        // 9: poolrpc.OutputWithFee
-	(*OutputsWithImplicitFee)(nil),               // 10: poolrpc.OutputsWithImplicitFee
-	(*CloseAccountRequest)(nil),                  // 11: poolrpc.CloseAccountRequest
-	(*CloseAccountResponse)(nil),                 // 12: poolrpc.CloseAccountResponse
-	(*WithdrawAccountRequest)(nil),               // 13: poolrpc.WithdrawAccountRequest
-	(*WithdrawAccountResponse)(nil),              // 14: poolrpc.WithdrawAccountResponse
-	(*DepositAccountRequest)(nil),                // 15: poolrpc.DepositAccountRequest
-	(*DepositAccountResponse)(nil),               // 16: poolrpc.DepositAccountResponse
-	(*RenewAccountRequest)(nil),                  // 17: poolrpc.RenewAccountRequest
-	(*RenewAccountResponse)(nil),                 // 18: poolrpc.RenewAccountResponse
-	(*BumpAccountFeeRequest)(nil),                // 19: poolrpc.BumpAccountFeeRequest
-	(*BumpAccountFeeResponse)(nil),               // 20: poolrpc.BumpAccountFeeResponse
-	(*Account)(nil),                              // 21: poolrpc.Account
-	(*SubmitOrderRequest)(nil),                   // 22: poolrpc.SubmitOrderRequest
-	(*SubmitOrderResponse)(nil),                  // 23: poolrpc.SubmitOrderResponse
-	(*ListOrdersRequest)(nil),                    // 24: poolrpc.ListOrdersRequest
-	(*ListOrdersResponse)(nil),                   // 25: poolrpc.ListOrdersResponse
-	(*CancelOrderRequest)(nil),                   // 26: poolrpc.CancelOrderRequest
-	(*CancelOrderResponse)(nil),                  // 27: poolrpc.CancelOrderResponse
-	(*Order)(nil),                                // 28: poolrpc.Order
-	(*Bid)(nil),                                  // 29: poolrpc.Bid
-	(*Ask)(nil),                                  // 30: poolrpc.Ask
-	(*QuoteOrderRequest)(nil),                    // 31: poolrpc.QuoteOrderRequest
-	(*QuoteOrderResponse)(nil),                   // 32: poolrpc.QuoteOrderResponse
-	(*OrderEvent)(nil),                           // 33: poolrpc.OrderEvent
-	(*UpdatedEvent)(nil),                         // 34: poolrpc.UpdatedEvent
-	(*MatchEvent)(nil),                           // 35: poolrpc.MatchEvent
-	(*RecoverAccountsRequest)(nil),               // 36: poolrpc.RecoverAccountsRequest
-	(*RecoverAccountsResponse)(nil),              // 37: poolrpc.RecoverAccountsResponse
-	(*AuctionFeeRequest)(nil),                    // 38: poolrpc.AuctionFeeRequest
-	(*AuctionFeeResponse)(nil),                   // 39: poolrpc.AuctionFeeResponse
-	(*Lease)(nil),                                // 40: poolrpc.Lease
-	(*LeasesRequest)(nil),                        // 41: poolrpc.LeasesRequest
-	(*LeasesResponse)(nil),                       // 42: poolrpc.LeasesResponse
-	(*TokensRequest)(nil),                        // 43: poolrpc.TokensRequest
-	(*TokensResponse)(nil),                       // 44: poolrpc.TokensResponse
-	(*LsatToken)(nil),                            // 45: poolrpc.LsatToken
-	(*LeaseDurationRequest)(nil),                 // 46: poolrpc.LeaseDurationRequest
-	(*LeaseDurationResponse)(nil),                // 47: poolrpc.LeaseDurationResponse
-	(*NextBatchInfoRequest)(nil),                 // 48: poolrpc.NextBatchInfoRequest
-	(*NextBatchInfoResponse)(nil),                // 49: poolrpc.NextBatchInfoResponse
-	(*NodeRatingRequest)(nil),                    // 50: poolrpc.NodeRatingRequest
-	(*NodeRatingResponse)(nil),                   // 51: poolrpc.NodeRatingResponse
-	(*GetInfoRequest)(nil),                       // 52: poolrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),                      // 53: poolrpc.GetInfoResponse
-	(*StopDaemonRequest)(nil),                    // 54: poolrpc.StopDaemonRequest
-	(*StopDaemonResponse)(nil),                   // 55: poolrpc.StopDaemonResponse
-	(*OfferSidecarRequest)(nil),                  // 56: poolrpc.OfferSidecarRequest
-	(*SidecarTicket)(nil),                        // 57: poolrpc.SidecarTicket
-	(*DecodedSidecarTicket)(nil),                 // 58: poolrpc.DecodedSidecarTicket
-	(*RegisterSidecarRequest)(nil),               // 59: poolrpc.RegisterSidecarRequest
-	(*ExpectSidecarChannelRequest)(nil),          // 60: poolrpc.ExpectSidecarChannelRequest
-	(*ExpectSidecarChannelResponse)(nil),         // 61: poolrpc.ExpectSidecarChannelResponse
-	(*ListSidecarsRequest)(nil),                  // 62: poolrpc.ListSidecarsRequest
-	(*ListSidecarsResponse)(nil),                 // 63: poolrpc.ListSidecarsResponse
-	(*CancelSidecarRequest)(nil),                 // 64: poolrpc.CancelSidecarRequest
-	(*CancelSidecarResponse)(nil),                // 65: poolrpc.CancelSidecarResponse
-	nil,                                          // 66: poolrpc.LeaseDurationResponse.LeaseDurationsEntry
-	nil,                                          // 67: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
-	nil,                                          // 68: poolrpc.GetInfoResponse.MarketInfoEntry
-	(*auctioneerrpc.OutPoint)(nil),               // 69: poolrpc.OutPoint
-	(*auctioneerrpc.InvalidOrder)(nil),           // 70: poolrpc.InvalidOrder
-	(auctioneerrpc.OrderState)(0),                // 71: poolrpc.OrderState
-	(auctioneerrpc.OrderChannelType)(0),          // 72: poolrpc.OrderChannelType
-	(auctioneerrpc.NodeTier)(0),                  // 73: poolrpc.NodeTier
-	(*auctioneerrpc.ExecutionFee)(nil),           // 74: poolrpc.ExecutionFee
-	(*auctioneerrpc.NodeRating)(nil),             // 75: poolrpc.NodeRating
-	(auctioneerrpc.DurationBucketState)(0),       // 76: poolrpc.DurationBucketState
-	(*auctioneerrpc.MarketInfo)(nil),             // 77: poolrpc.MarketInfo
-	(*auctioneerrpc.BatchSnapshotRequest)(nil),   // 78: poolrpc.BatchSnapshotRequest
-	(*auctioneerrpc.BatchSnapshotsRequest)(nil),  // 79: poolrpc.BatchSnapshotsRequest
-	(*auctioneerrpc.BatchSnapshotResponse)(nil),  // 80: poolrpc.BatchSnapshotResponse
-	(*auctioneerrpc.BatchSnapshotsResponse)(nil), // 81: poolrpc.BatchSnapshotsResponse
+func (x *QuoteOrderRequest) GetMaxBatchFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.MaxBatchFeeRateSatPerKw
+	}
+	return 0
 }
-var file_trader_proto_depIdxs = []int32{
-	21, // 0: poolrpc.ListAccountsResponse.accounts:type_name -> poolrpc.Account
-	8,  // 1: poolrpc.OutputsWithImplicitFee.outputs:type_name -> poolrpc.Output
-	9,  // 2: poolrpc.CloseAccountRequest.output_with_fee:type_name -> poolrpc.OutputWithFee
-	10, // 3: poolrpc.CloseAccountRequest.outputs:type_name -> poolrpc.OutputsWithImplicitFee
-	8,  // 4: poolrpc.WithdrawAccountRequest.outputs:type_name -> poolrpc.Output
-	21, // 5: poolrpc.WithdrawAccountResponse.account:type_name -> poolrpc.Account
-	21, // 6: poolrpc.DepositAccountResponse.account:type_name -> poolrpc.Account
-	21, // 7: poolrpc.RenewAccountResponse.account:type_name -> poolrpc.Account
-	69, // 8: poolrpc.Account.outpoint:type_name -> poolrpc.OutPoint
-	0,  // 9: poolrpc.Account.state:type_name -> poolrpc.AccountState
-	30, // 10: poolrpc.SubmitOrderRequest.ask:type_name -> poolrpc.Ask
-	29, // 11: poolrpc.SubmitOrderRequest.bid:type_name -> poolrpc.Bid
-	70, // 12: poolrpc.SubmitOrderResponse.invalid_order:type_name -> poolrpc.InvalidOrder
-	30, // 13: poolrpc.ListOrdersResponse.asks:type_name -> poolrpc.Ask
-	29, // 14: poolrpc.ListOrdersResponse.bids:type_name -> poolrpc.Bid
-	71, // 15: poolrpc.Order.state:type_name -> poolrpc.OrderState
-	33, // 16: poolrpc.Order.events:type_name -> poolrpc.OrderEvent
-	72, // 17: poolrpc.Order.channel_type:type_name -> poolrpc.OrderChannelType
-	28, // 18: poolrpc.Bid.details:type_name -> poolrpc.Order
-	73, // 19: poolrpc.Bid.min_node_tier:type_name -> poolrpc.NodeTier
-	28, // 20: poolrpc.Ask.details:type_name -> poolrpc.Order
-	34, // 21: poolrpc.OrderEvent.state_change:type_name -> poolrpc.UpdatedEvent
-	35, // 22: poolrpc.OrderEvent.matched:type_name -> poolrpc.MatchEvent
-	71, // 23: poolrpc.UpdatedEvent.previous_state:type_name -> poolrpc.OrderState
-	71, // 24: poolrpc.UpdatedEvent.new_state:type_name -> poolrpc.OrderState
-	1,  // 25: poolrpc.MatchEvent.match_state:type_name -> poolrpc.MatchState
-	2,  // 26: poolrpc.MatchEvent.reject_reason:type_name -> poolrpc.MatchRejectReason
-	74, // 27: poolrpc.AuctionFeeResponse.execution_fee:type_name -> poolrpc.ExecutionFee
-	69, // 28: poolrpc.Lease.channel_point:type_name -> poolrpc.OutPoint
-	73, // 29: poolrpc.Lease.channel_node_tier:type_name -> poolrpc.NodeTier
-	40, // 30: poolrpc.LeasesResponse.leases:type_name -> poolrpc.Lease
-	45, // 31: poolrpc.TokensResponse.tokens:type_name -> poolrpc.LsatToken
-	66, // 32: poolrpc.LeaseDurationResponse.lease_durations:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationsEntry
-	67, // 33: poolrpc.LeaseDurationResponse.lease_duration_buckets:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
-	75, // 34: poolrpc.NodeRatingResponse.node_ratings:type_name -> poolrpc.NodeRating
-	75, // 35: poolrpc.GetInfoResponse.node_rating:type_name -> poolrpc.NodeRating
-	68, // 36: poolrpc.GetInfoResponse.market_info:type_name -> poolrpc.GetInfoResponse.MarketInfoEntry
-	29, // 37: poolrpc.OfferSidecarRequest.bid:type_name -> poolrpc.Bid
-	58, // 38: poolrpc.ListSidecarsResponse.tickets:type_name -> poolrpc.DecodedSidecarTicket
-	76, // 39: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry.value:type_name -> poolrpc.DurationBucketState
-	77, // 40: poolrpc.GetInfoResponse.MarketInfoEntry.value:type_name -> poolrpc.MarketInfo
-	52, // 41: poolrpc.Trader.GetInfo:input_type -> poolrpc.GetInfoRequest
-	54, // 42: poolrpc.Trader.StopDaemon:input_type -> poolrpc.StopDaemonRequest
-	4,  // 43: poolrpc.Trader.QuoteAccount:input_type -> poolrpc.QuoteAccountRequest
-	3,  // 44: poolrpc.Trader.InitAccount:input_type -> poolrpc.InitAccountRequest
-	6,  // 45: poolrpc.Trader.ListAccounts:input_type -> poolrpc.ListAccountsRequest
-	11, // 46: poolrpc.Trader.CloseAccount:input_type -> poolrpc.CloseAccountRequest
-	13, // 47: poolrpc.Trader.WithdrawAccount:input_type -> poolrpc.WithdrawAccountRequest
-	15, // 48: poolrpc.Trader.DepositAccount:input_type -> poolrpc.DepositAccountRequest
-	17, // 49: poolrpc.Trader.RenewAccount:input_type -> poolrpc.RenewAccountRequest
-	19, // 50: poolrpc.Trader.BumpAccountFee:input_type -> poolrpc.BumpAccountFeeRequest
-	36, // 51: poolrpc.Trader.RecoverAccounts:input_type -> poolrpc.RecoverAccountsRequest
-	22, // 52: poolrpc.Trader.SubmitOrder:input_type -> poolrpc.SubmitOrderRequest
-	24, // 53: poolrpc.Trader.ListOrders:input_type -> poolrpc.ListOrdersRequest
-	26, // 54: poolrpc.Trader.CancelOrder:input_type -> poolrpc.CancelOrderRequest
-	31, // 55: poolrpc.Trader.QuoteOrder:input_type -> poolrpc.QuoteOrderRequest
-	38, // 56: poolrpc.Trader.AuctionFee:input_type -> poolrpc.AuctionFeeRequest
-	46, // 57: poolrpc.Trader.LeaseDurations:input_type -> poolrpc.LeaseDurationRequest
-	48, // 58: poolrpc.Trader.NextBatchInfo:input_type -> poolrpc.NextBatchInfoRequest
-	78, // 59: poolrpc.Trader.BatchSnapshot:input_type -> poolrpc.BatchSnapshotRequest
-	43, // 60: poolrpc.Trader.GetLsatTokens:input_type -> poolrpc.TokensRequest
-	41, // 61: poolrpc.Trader.Leases:input_type -> poolrpc.LeasesRequest
-	50, // 62: poolrpc.Trader.NodeRatings:input_type -> poolrpc.NodeRatingRequest
-	79, // 63: poolrpc.Trader.BatchSnapshots:input_type -> poolrpc.BatchSnapshotsRequest
-	56, // 64: poolrpc.Trader.OfferSidecar:input_type -> poolrpc.OfferSidecarRequest
-	59, // 65: poolrpc.Trader.RegisterSidecar:input_type -> poolrpc.RegisterSidecarRequest
-	60, // 66: poolrpc.Trader.ExpectSidecarChannel:input_type -> poolrpc.ExpectSidecarChannelRequest
-	57, // 67: poolrpc.Trader.DecodeSidecarTicket:input_type -> poolrpc.SidecarTicket
-	62, // 68: poolrpc.Trader.ListSidecars:input_type -> poolrpc.ListSidecarsRequest
-	64, // 69: poolrpc.Trader.CancelSidecar:input_type -> poolrpc.CancelSidecarRequest
-	53, // 70: poolrpc.Trader.GetInfo:output_type -> poolrpc.GetInfoResponse
-	55, // 71: poolrpc.Trader.StopDaemon:output_type -> poolrpc.StopDaemonResponse
-	5,  // 72: poolrpc.Trader.QuoteAccount:output_type -> poolrpc.QuoteAccountResponse
-	21, // 73: poolrpc.Trader.InitAccount:output_type -> poolrpc.Account
-	7,  // 74: poolrpc.Trader.ListAccounts:output_type -> poolrpc.ListAccountsResponse
-	12, // 75: poolrpc.Trader.CloseAccount:output_type -> poolrpc.CloseAccountResponse
-	14, // 76: poolrpc.Trader.WithdrawAccount:output_type -> poolrpc.WithdrawAccountResponse
-	16, // 77: poolrpc.Trader.DepositAccount:output_type -> poolrpc.DepositAccountResponse
-	18, // 78: poolrpc.Trader.RenewAccount:output_type -> poolrpc.RenewAccountResponse
-	20, // 79: poolrpc.Trader.BumpAccountFee:output_type -> poolrpc.BumpAccountFeeResponse
-	37, // 80: poolrpc.Trader.RecoverAccounts:output_type -> poolrpc.RecoverAccountsResponse
-	23, // 81: poolrpc.Trader.SubmitOrder:output_type -> poolrpc.SubmitOrderResponse
-	25, // 82: poolrpc.Trader.ListOrders:output_type -> poolrpc.ListOrdersResponse
-	27, // 83: poolrpc.Trader.CancelOrder:output_type -> poolrpc.CancelOrderResponse
-	32, // 84: poolrpc.Trader.QuoteOrder:output_type -> poolrpc.QuoteOrderResponse
-	39, // 85: poolrpc.Trader.AuctionFee:output_type -> poolrpc.AuctionFeeResponse
-	47, // 86: poolrpc.Trader.LeaseDurations:output_type -> poolrpc.LeaseDurationResponse
-	49, // 87: poolrpc.Trader.NextBatchInfo:output_type -> poolrpc.NextBatchInfoResponse
-	80, // 88: poolrpc.Trader.BatchSnapshot:output_type -> poolrpc.BatchSnapshotResponse
-	44, // 89: poolrpc.Trader.GetLsatTokens:output_type -> poolrpc.TokensResponse
-	42, // 90: poolrpc.Trader.Leases:output_type -> poolrpc.LeasesResponse
-	51, // 91: poolrpc.Trader.NodeRatings:output_type -> poolrpc.NodeRatingResponse
-	81, // 92: poolrpc.Trader.BatchSnapshots:output_type -> poolrpc.BatchSnapshotsResponse
-	57, // 93: poolrpc.Trader.OfferSidecar:output_type -> poolrpc.SidecarTicket
-	57, // 94: poolrpc.Trader.RegisterSidecar:output_type -> poolrpc.SidecarTicket
-	61, // 95: poolrpc.Trader.ExpectSidecarChannel:output_type -> poolrpc.ExpectSidecarChannelResponse
-	58, // 96: poolrpc.Trader.DecodeSidecarTicket:output_type -> poolrpc.DecodedSidecarTicket
-	63, // 97: poolrpc.Trader.ListSidecars:output_type -> poolrpc.ListSidecarsResponse
-	65, // 98: poolrpc.Trader.CancelSidecar:output_type -> poolrpc.CancelSidecarResponse
-	70, // [70:99] is the sub-list for method output_type
-	41, // [41:70] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+
+func (x *QuoteOrderRequest) GetMinUnitsMatch() uint32 {
+	if x != nil {
+		return x.MinUnitsMatch
+	}
+	return 0
 }
 
-func init() { file_trader_proto_init() }
-func file_trader_proto_init() {
-	if File_trader_proto != nil {
-		return
+func (m *QuoteOrderRequest) GetDetails() isQuoteOrderRequest_Details {
+	if m != nil {
+		return m.Details
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_trader_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitAccountRequest); i {
+	return nil
+}
+
+func (x *QuoteOrderRequest) GetAsk() *Ask {
+	if x, ok := x.GetDetails().(*QuoteOrderRequest_Ask); ok {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *QuoteOrderRequest) GetBid() *Bid {
+	if x, ok := x.GetDetails().(*QuoteOrderRequest_Bid); ok {
+		return x.Bid
+	}
+	return nil
+}
+
+type isQuoteOrderRequest_Details interface {
+	isQuoteOrderRequest_Details()
+}
+
+type QuoteOrderRequest_Ask struct {
+	Ask *Ask `protobuf:"bytes,6,opt,name=ask,proto3,oneof"`
+}
+
+type QuoteOrderRequest_Bid struct {
+	Bid *Bid `protobuf:"bytes,7,opt,name=bid,proto3,oneof"`
+}
+
+func (*QuoteOrderRequest_Ask) isQuoteOrderRequest_Details() {}
+
+func (*QuoteOrderRequest_Bid) isQuoteOrderRequest_Details() {}
+
+type QuoteOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The total order premium in satoshis for filling the entire order. This
+	//represents the interest amount paid to the maker by the taker excluding any
+	//execution or chain fees.
+	TotalPremiumSat uint64 `protobuf:"varint,1,opt,name=total_premium_sat,json=totalPremiumSat,proto3" json:"total_premium_sat,omitempty"`
+	//
+	//The fixed order rate expressed as a fraction instead of parts per billion.
+	RatePerBlock float64 `protobuf:"fixed64,2,opt,name=rate_per_block,json=ratePerBlock,proto3" json:"rate_per_block,omitempty"`
+	//
+	//The fixed order rate expressed as a percentage instead of parts per billion.
+	RatePercent float64 `protobuf:"fixed64,3,opt,name=rate_percent,json=ratePercent,proto3" json:"rate_percent,omitempty"`
+	//
+	//The total execution fee in satoshis that needs to be paid to the auctioneer
+	//for executing the entire order.
+	TotalExecutionFeeSat uint64 `protobuf:"varint,4,opt,name=total_execution_fee_sat,json=totalExecutionFeeSat,proto3" json:"total_execution_fee_sat,omitempty"`
+	//
+	//The worst case chain fees that need to be paid if fee rates spike up to the
+	//max_batch_fee_rate_sat_per_kw value specified in the request. This value is
+	//highly dependent on the min_units_match parameter as well since the
+	//calculation assumes chain fees for the chain footprint of opening
+	//amt/min_units_match channels (hence worst case calculation).
+	WorstCaseChainFeeSat uint64 `protobuf:"varint,5,opt,name=worst_case_chain_fee_sat,json=worstCaseChainFeeSat,proto3" json:"worst_case_chain_fee_sat,omitempty"`
+	//
+	//The fixed order rate expressed as an annualized percentage, assuming one
+	//block is mined every ten minutes.
+	RateAnnualizedPercent float64 `protobuf:"fixed64,6,opt,name=rate_annualized_percent,json=rateAnnualizedPercent,proto3" json:"rate_annualized_percent,omitempty"`
+}
+
+func (x *QuoteOrderResponse) Reset() {
+	*x = QuoteOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderResponse) ProtoMessage() {}
+
+func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderResponse.ProtoReflect.Descriptor instead.
+func (*QuoteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *QuoteOrderResponse) GetTotalPremiumSat() uint64 {
+	if x != nil {
+		return x.TotalPremiumSat
+	}
+	return 0
+}
+
+func (x *QuoteOrderResponse) GetRatePerBlock() float64 {
+	if x != nil {
+		return x.RatePerBlock
+	}
+	return 0
+}
+
+func (x *QuoteOrderResponse) GetRatePercent() float64 {
+	if x != nil {
+		return x.RatePercent
+	}
+	return 0
+}
+
+func (x *QuoteOrderResponse) GetTotalExecutionFeeSat() uint64 {
+	if x != nil {
+		return x.TotalExecutionFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteOrderResponse) GetWorstCaseChainFeeSat() uint64 {
+	if x != nil {
+		return x.WorstCaseChainFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteOrderResponse) GetRateAnnualizedPercent() float64 {
+	if x != nil {
+		return x.RateAnnualizedPercent
+	}
+	return 0
+}
+
+type QuoteMatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The order to simulate. No nonce is created for the order and it isn't
+	//stored.
+	//
+	// Types that are assignable to Details:
+	//	*QuoteMatchRequest_Ask
+	//	*QuoteMatchRequest_Bid
+	Details isQuoteMatchRequest_Details `protobuf_oneof:"details"`
+}
+
+func (x *QuoteMatchRequest) Reset() {
+	*x = QuoteMatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteMatchRequest) ProtoMessage() {}
+
+func (x *QuoteMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteMatchRequest.ProtoReflect.Descriptor instead.
+func (*QuoteMatchRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{74}
+}
+
+func (m *QuoteMatchRequest) GetDetails() isQuoteMatchRequest_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *QuoteMatchRequest) GetAsk() *Ask {
+	if x, ok := x.GetDetails().(*QuoteMatchRequest_Ask); ok {
+		return x.Ask
+	}
+	return nil
+}
+
+func (x *QuoteMatchRequest) GetBid() *Bid {
+	if x, ok := x.GetDetails().(*QuoteMatchRequest_Bid); ok {
+		return x.Bid
+	}
+	return nil
+}
+
+type isQuoteMatchRequest_Details interface {
+	isQuoteMatchRequest_Details()
+}
+
+type QuoteMatchRequest_Ask struct {
+	Ask *Ask `protobuf:"bytes,1,opt,name=ask,proto3,oneof"`
+}
+
+type QuoteMatchRequest_Bid struct {
+	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3,oneof"`
+}
+
+func (*QuoteMatchRequest_Ask) isQuoteMatchRequest_Details() {}
+
+func (*QuoteMatchRequest_Bid) isQuoteMatchRequest_Details() {}
+
+type QuoteMatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The estimated number of units of the order that would be filled. Zero if
+	//the order wouldn't be matched at all.
+	FilledUnits uint32 `protobuf:"varint,1,opt,name=filled_units,json=filledUnits,proto3" json:"filled_units,omitempty"`
+	// The estimated amount of the order in satoshis that would be filled.
+	FilledAmtSat uint64 `protobuf:"varint,2,opt,name=filled_amt_sat,json=filledAmtSat,proto3" json:"filled_amt_sat,omitempty"`
+	//
+	//The estimated uniform clearing rate of the batch in parts per billion.
+	//Zero if no orders would be matched at all.
+	ClearingRateFixed uint32 `protobuf:"varint,3,opt,name=clearing_rate_fixed,json=clearingRateFixed,proto3" json:"clearing_rate_fixed,omitempty"`
+	// The estimated clearing rate expressed as an annualized percentage.
+	ClearingRateAnnualizedPercent float64 `protobuf:"fixed64,4,opt,name=clearing_rate_annualized_percent,json=clearingRateAnnualizedPercent,proto3" json:"clearing_rate_annualized_percent,omitempty"`
+	//
+	//The estimated premium in satoshis for the filled amount at the clearing
+	//rate, which is paid by a bid and earned by an ask.
+	PremiumSat uint64 `protobuf:"varint,5,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	//
+	//The execution fee in satoshis that would be paid to the auctioneer for the
+	//filled amount.
+	ExecutionFeeSat uint64 `protobuf:"varint,6,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
+	//
+	//The chain fees in satoshis that would be paid for the filled amount in the
+	//worst case, at the max batch fee rate of the order and with a channel for
+	//every min_units_match units.
+	WorstCaseChainFeeSat uint64 `protobuf:"varint,7,opt,name=worst_case_chain_fee_sat,json=worstCaseChainFeeSat,proto3" json:"worst_case_chain_fee_sat,omitempty"`
+	//
+	//The estimated total number of units that would be matched in the batch,
+	//including the ones of the order.
+	MarketMatchedUnits uint64 `protobuf:"varint,8,opt,name=market_matched_units,json=marketMatchedUnits,proto3" json:"market_matched_units,omitempty"`
+	// The sequence number of the order book snapshot that was simulated.
+	BookSequence uint64 `protobuf:"varint,9,opt,name=book_sequence,json=bookSequence,proto3" json:"book_sequence,omitempty"`
+	//
+	//The unix timestamp in nanoseconds at which the order book snapshot that
+	//was simulated was taken.
+	BookTimestampNs int64 `protobuf:"varint,10,opt,name=book_timestamp_ns,json=bookTimestampNs,proto3" json:"book_timestamp_ns,omitempty"`
+	//
+	//A human readable note that all values of the response are estimates and
+	//what they are based on.
+	Disclaimer string `protobuf:"bytes,11,opt,name=disclaimer,proto3" json:"disclaimer,omitempty"`
+}
+
+func (x *QuoteMatchResponse) Reset() {
+	*x = QuoteMatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteMatchResponse) ProtoMessage() {}
+
+func (x *QuoteMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteMatchResponse.ProtoReflect.Descriptor instead.
+func (*QuoteMatchResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *QuoteMatchResponse) GetFilledUnits() uint32 {
+	if x != nil {
+		return x.FilledUnits
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetFilledAmtSat() uint64 {
+	if x != nil {
+		return x.FilledAmtSat
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetClearingRateFixed() uint32 {
+	if x != nil {
+		return x.ClearingRateFixed
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetClearingRateAnnualizedPercent() float64 {
+	if x != nil {
+		return x.ClearingRateAnnualizedPercent
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetExecutionFeeSat() uint64 {
+	if x != nil {
+		return x.ExecutionFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetWorstCaseChainFeeSat() uint64 {
+	if x != nil {
+		return x.WorstCaseChainFeeSat
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetMarketMatchedUnits() uint64 {
+	if x != nil {
+		return x.MarketMatchedUnits
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetBookSequence() uint64 {
+	if x != nil {
+		return x.BookSequence
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetBookTimestampNs() int64 {
+	if x != nil {
+		return x.BookTimestampNs
+	}
+	return 0
+}
+
+func (x *QuoteMatchResponse) GetDisclaimer() string {
+	if x != nil {
+		return x.Disclaimer
+	}
+	return ""
+}
+
+type OrderEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in nanoseconds the event was emitted at. This is the
+	//primary key of the event and is unique across the database.
+	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The human readable representation of the event.
+	EventStr string `protobuf:"bytes,2,opt,name=event_str,json=eventStr,proto3" json:"event_str,omitempty"`
+	// Types that are assignable to Event:
+	//	*OrderEvent_StateChange
+	//	*OrderEvent_Matched
+	//	*OrderEvent_FeeRateBump
+	Event isOrderEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *OrderEvent) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetEventStr() string {
+	if x != nil {
+		return x.EventStr
+	}
+	return ""
+}
+
+func (m *OrderEvent) GetEvent() isOrderEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetStateChange() *UpdatedEvent {
+	if x, ok := x.GetEvent().(*OrderEvent_StateChange); ok {
+		return x.StateChange
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetMatched() *MatchEvent {
+	if x, ok := x.GetEvent().(*OrderEvent_Matched); ok {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetFeeRateBump() *FeeRateBumpEvent {
+	if x, ok := x.GetEvent().(*OrderEvent_FeeRateBump); ok {
+		return x.FeeRateBump
+	}
+	return nil
+}
+
+type isOrderEvent_Event interface {
+	isOrderEvent_Event()
+}
+
+type OrderEvent_StateChange struct {
+	// The order was updated in the database.
+	StateChange *UpdatedEvent `protobuf:"bytes,3,opt,name=state_change,json=stateChange,proto3,oneof"`
+}
+
+type OrderEvent_Matched struct {
+	// The order was involved in a match making attempt.
+	Matched *MatchEvent `protobuf:"bytes,4,opt,name=matched,proto3,oneof"`
+}
+
+type OrderEvent_FeeRateBump struct {
+	//
+	//The order was created to replace an order with a max batch fee rate
+	//that was too low for a batch.
+	FeeRateBump *FeeRateBumpEvent `protobuf:"bytes,5,opt,name=fee_rate_bump,json=feeRateBump,proto3,oneof"`
+}
+
+func (*OrderEvent_StateChange) isOrderEvent_Event() {}
+
+func (*OrderEvent_Matched) isOrderEvent_Event() {}
+
+func (*OrderEvent_FeeRateBump) isOrderEvent_Event() {}
+
+type UpdatedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state of the order previous to the change. This is what the state
+	//changed from.
+	PreviousState auctioneerrpc.OrderState `protobuf:"varint,1,opt,name=previous_state,json=previousState,proto3,enum=poolrpc.OrderState" json:"previous_state,omitempty"`
+	//
+	//The new state of the order after the change. This is what the state changed
+	//to.
+	NewState auctioneerrpc.OrderState `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=poolrpc.OrderState" json:"new_state,omitempty"`
+	// The units that were filled at the time of the event.
+	UnitsFilled uint32 `protobuf:"varint,3,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	// The number of units that were filled by this change.
+	UnitsFilledDelta uint32 `protobuf:"varint,4,opt,name=units_filled_delta,json=unitsFilledDelta,proto3" json:"units_filled_delta,omitempty"`
+	//
+	//The ID of the batch that caused the change. This is empty if the change
+	//didn't happen as part of a batch.
+	BatchId []byte `protobuf:"bytes,5,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	//
+	//Set if the change canceled the order because it reached its expiry height
+	//or time.
+	Expired bool `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *UpdatedEvent) Reset() {
+	*x = UpdatedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatedEvent) ProtoMessage() {}
+
+func (x *UpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatedEvent.ProtoReflect.Descriptor instead.
+func (*UpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdatedEvent) GetPreviousState() auctioneerrpc.OrderState {
+	if x != nil {
+		return x.PreviousState
+	}
+	return auctioneerrpc.OrderState(0)
+}
+
+func (x *UpdatedEvent) GetNewState() auctioneerrpc.OrderState {
+	if x != nil {
+		return x.NewState
+	}
+	return auctioneerrpc.OrderState(0)
+}
+
+func (x *UpdatedEvent) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+func (x *UpdatedEvent) GetUnitsFilledDelta() uint32 {
+	if x != nil {
+		return x.UnitsFilledDelta
+	}
+	return 0
+}
+
+func (x *UpdatedEvent) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *UpdatedEvent) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type FeeRateBumpEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nonce of the order that was replaced.
+	ReplacedOrder []byte `protobuf:"bytes,1,opt,name=replaced_order,json=replacedOrder,proto3" json:"replaced_order,omitempty"`
+	// The max batch fee rate of the replaced order in sat/kw.
+	PreviousFeeRateSatPerKw uint64 `protobuf:"varint,2,opt,name=previous_fee_rate_sat_per_kw,json=previousFeeRateSatPerKw,proto3" json:"previous_fee_rate_sat_per_kw,omitempty"`
+	// The max batch fee rate of the new order in sat/kw.
+	NewFeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=new_fee_rate_sat_per_kw,json=newFeeRateSatPerKw,proto3" json:"new_fee_rate_sat_per_kw,omitempty"`
+	// The fee rate of the rejected batch in sat/kw.
+	BatchFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=batch_fee_rate_sat_per_kw,json=batchFeeRateSatPerKw,proto3" json:"batch_fee_rate_sat_per_kw,omitempty"`
+}
+
+func (x *FeeRateBumpEvent) Reset() {
+	*x = FeeRateBumpEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRateBumpEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRateBumpEvent) ProtoMessage() {}
+
+func (x *FeeRateBumpEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRateBumpEvent.ProtoReflect.Descriptor instead.
+func (*FeeRateBumpEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FeeRateBumpEvent) GetReplacedOrder() []byte {
+	if x != nil {
+		return x.ReplacedOrder
+	}
+	return nil
+}
+
+func (x *FeeRateBumpEvent) GetPreviousFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.PreviousFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *FeeRateBumpEvent) GetNewFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.NewFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *FeeRateBumpEvent) GetBatchFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.BatchFeeRateSatPerKw
+	}
+	return 0
+}
+
+type MatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the match making process the order went through.
+	MatchState MatchState `protobuf:"varint,1,opt,name=match_state,json=matchState,proto3,enum=poolrpc.MatchState" json:"match_state,omitempty"`
+	// The number of units that would be (or were) filled with this match.
+	UnitsFilled uint32 `protobuf:"varint,2,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	// The nonce of the order we were matched to.
+	MatchedOrder []byte `protobuf:"bytes,3,opt,name=matched_order,json=matchedOrder,proto3" json:"matched_order,omitempty"`
+	//
+	//The reason why the trader daemon rejected the order. Is only set if
+	//match_state is set to REJECTED.
+	RejectReason MatchRejectReason `protobuf:"varint,4,opt,name=reject_reason,json=rejectReason,proto3,enum=poolrpc.MatchRejectReason" json:"reject_reason,omitempty"`
+}
+
+func (x *MatchEvent) Reset() {
+	*x = MatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchEvent) ProtoMessage() {}
+
+func (x *MatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchEvent.ProtoReflect.Descriptor instead.
+func (*MatchEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MatchEvent) GetMatchState() MatchState {
+	if x != nil {
+		return x.MatchState
+	}
+	return MatchState_PREPARE
+}
+
+func (x *MatchEvent) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+func (x *MatchEvent) GetMatchedOrder() []byte {
+	if x != nil {
+		return x.MatchedOrder
+	}
+	return nil
+}
+
+func (x *MatchEvent) GetRejectReason() MatchRejectReason {
+	if x != nil {
+		return x.RejectReason
+	}
+	return MatchRejectReason_NONE
+}
+
+type RecoverAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Recover the latest account states without interacting with the
+	//Lightning Labs server.
+	FullClient bool `protobuf:"varint,1,opt,name=full_client,json=fullClient,proto3" json:"full_client,omitempty"`
+	//
+	//Number of accounts that we are trying to recover. The recovery stops once
+	//this many accounts were found. If no end_index is set, this is also the
+	//number of account keys that are tried.
+	AccountTarget uint32 `protobuf:"varint,2,opt,name=account_target,json=accountTarget,proto3" json:"account_target,omitempty"`
+	//
+	//Auctioneer's public key. Used during the full_client recovery process.
+	//This field should be left empty for testnet/mainnet, its value is already
+	//hardcoded in our codebase.
+	AuctioneerKey string `protobuf:"bytes,3,opt,name=auctioneer_key,json=auctioneerKey,proto3" json:"auctioneer_key,omitempty"`
+	//
+	//Initial block height. We won't try to look for any account with an expiry
+	//height smaller than this value. Used during the full_client recovery
+	//process.
+	HeightHint uint32 `protobuf:"varint,4,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
+	// bitcoind/btcd instance address. Used during the full_client recovery
+	// process.
+	BitcoinHost string `protobuf:"bytes,5,opt,name=bitcoin_host,json=bitcoinHost,proto3" json:"bitcoin_host,omitempty"`
+	// bitcoind/btcd user name. Used during the full_client recovery
+	// process.
+	BitcoinUser string `protobuf:"bytes,6,opt,name=bitcoin_user,json=bitcoinUser,proto3" json:"bitcoin_user,omitempty"`
+	// bitcoind/btcd password. Used during the full_client recovery
+	// process.
+	BitcoinPassword string `protobuf:"bytes,7,opt,name=bitcoin_password,json=bitcoinPassword,proto3" json:"bitcoin_password,omitempty"`
+	// Use HTTP POST mode? bitcoind only supports this mode. Used during the
+	// full_client recovery process.
+	BitcoinHttppostmode bool `protobuf:"varint,8,opt,name=bitcoin_httppostmode,json=bitcoinHttppostmode,proto3" json:"bitcoin_httppostmode,omitempty"`
+	// Use TLS to connect? bitcoind only supports non-TLS connections. Used
+	// during the full_client recovery process.
+	BitcoinUsetls bool `protobuf:"varint,9,opt,name=bitcoin_usetls,json=bitcoinUsetls,proto3" json:"bitcoin_usetls,omitempty"`
+	// Path to btcd's TLS certificate, if TLS is enabled. Used  during the
+	// full_client recovery process.
+	BitcoinTlspath string `protobuf:"bytes,10,opt,name=bitcoin_tlspath,json=bitcoinTlspath,proto3" json:"bitcoin_tlspath,omitempty"`
+	//
+	//The content of an account backup file. If set, the accounts are restored
+	//from the backup instead of asking the auctioneer or scanning the chain.
+	AccountBackup []byte `protobuf:"bytes,11,opt,name=account_backup,json=accountBackup,proto3" json:"account_backup,omitempty"`
+	//
+	//The first account key index to try.
+	StartIndex uint32 `protobuf:"varint,12,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	//
+	//The account key index at which to stop trying keys, the key with this
+	//index itself is not tried. If set, all keys in the range are tried instead
+	//of giving up after a number of successive unused keys.
+	EndIndex uint32 `protobuf:"varint,13,opt,name=end_index,json=endIndex,proto3" json:"end_index,omitempty"`
+	//
+	//Resume the last recovery that was interrupted from its checkpoint. The key
+	//range and account target of the interrupted recovery are used, the
+	//bitcoind/btcd options must be set again for full_client recoveries.
+	Resume bool `protobuf:"varint,14,opt,name=resume,proto3" json:"resume,omitempty"`
+}
+
+func (x *RecoverAccountsRequest) Reset() {
+	*x = RecoverAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverAccountsRequest) ProtoMessage() {}
+
+func (x *RecoverAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverAccountsRequest.ProtoReflect.Descriptor instead.
+func (*RecoverAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RecoverAccountsRequest) GetFullClient() bool {
+	if x != nil {
+		return x.FullClient
+	}
+	return false
+}
+
+func (x *RecoverAccountsRequest) GetAccountTarget() uint32 {
+	if x != nil {
+		return x.AccountTarget
+	}
+	return 0
+}
+
+func (x *RecoverAccountsRequest) GetAuctioneerKey() string {
+	if x != nil {
+		return x.AuctioneerKey
+	}
+	return ""
+}
+
+func (x *RecoverAccountsRequest) GetHeightHint() uint32 {
+	if x != nil {
+		return x.HeightHint
+	}
+	return 0
+}
+
+func (x *RecoverAccountsRequest) GetBitcoinHost() string {
+	if x != nil {
+		return x.BitcoinHost
+	}
+	return ""
+}
+
+func (x *RecoverAccountsRequest) GetBitcoinUser() string {
+	if x != nil {
+		return x.BitcoinUser
+	}
+	return ""
+}
+
+func (x *RecoverAccountsRequest) GetBitcoinPassword() string {
+	if x != nil {
+		return x.BitcoinPassword
+	}
+	return ""
+}
+
+func (x *RecoverAccountsRequest) GetBitcoinHttppostmode() bool {
+	if x != nil {
+		return x.BitcoinHttppostmode
+	}
+	return false
+}
+
+func (x *RecoverAccountsRequest) GetBitcoinUsetls() bool {
+	if x != nil {
+		return x.BitcoinUsetls
+	}
+	return false
+}
+
+func (x *RecoverAccountsRequest) GetBitcoinTlspath() string {
+	if x != nil {
+		return x.BitcoinTlspath
+	}
+	return ""
+}
+
+func (x *RecoverAccountsRequest) GetAccountBackup() []byte {
+	if x != nil {
+		return x.AccountBackup
+	}
+	return nil
+}
+
+func (x *RecoverAccountsRequest) GetStartIndex() uint32 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
+func (x *RecoverAccountsRequest) GetEndIndex() uint32 {
+	if x != nil {
+		return x.EndIndex
+	}
+	return 0
+}
+
+func (x *RecoverAccountsRequest) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type RecoverAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of accounts that were recovered so far.
+	NumRecoveredAccounts uint32 `protobuf:"varint,1,opt,name=num_recovered_accounts,json=numRecoveredAccounts,proto3" json:"num_recovered_accounts,omitempty"`
+	// The number of account keys that were tried so far.
+	KeysTried uint32 `protobuf:"varint,2,opt,name=keys_tried,json=keysTried,proto3" json:"keys_tried,omitempty"`
+	// The index of the next account key that is going to be tried.
+	NextKeyIndex uint32 `protobuf:"varint,3,opt,name=next_key_index,json=nextKeyIndex,proto3" json:"next_key_index,omitempty"`
+	//
+	//The block height up to which the chain was scanned for account updates.
+	//Only set for full_client recoveries.
+	HeightScanned uint32 `protobuf:"varint,4,opt,name=height_scanned,json=heightScanned,proto3" json:"height_scanned,omitempty"`
+	//
+	//The account that was found and stored with this update, if any. Its state
+	//is the one detected during the recovery.
+	Account *Account `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	// Set on the last update once the recovery has completed.
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *RecoverAccountsResponse) Reset() {
+	*x = RecoverAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverAccountsResponse) ProtoMessage() {}
+
+func (x *RecoverAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverAccountsResponse.ProtoReflect.Descriptor instead.
+func (*RecoverAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RecoverAccountsResponse) GetNumRecoveredAccounts() uint32 {
+	if x != nil {
+		return x.NumRecoveredAccounts
+	}
+	return 0
+}
+
+func (x *RecoverAccountsResponse) GetKeysTried() uint32 {
+	if x != nil {
+		return x.KeysTried
+	}
+	return 0
+}
+
+func (x *RecoverAccountsResponse) GetNextKeyIndex() uint32 {
+	if x != nil {
+		return x.NextKeyIndex
+	}
+	return 0
+}
+
+func (x *RecoverAccountsResponse) GetHeightScanned() uint32 {
+	if x != nil {
+		return x.HeightScanned
+	}
+	return 0
+}
+
+func (x *RecoverAccountsResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *RecoverAccountsResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type AccountEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The trader key of the account to return the events of.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+}
+
+func (x *AccountEventsRequest) Reset() {
+	*x = AccountEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEventsRequest) ProtoMessage() {}
+
+func (x *AccountEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEventsRequest.ProtoReflect.Descriptor instead.
+func (*AccountEventsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AccountEventsRequest) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
+}
+
+type AccountEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in nanoseconds the event was recorded at.
+	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The action that triggered the change of the account.
+	Action AccountEventAction `protobuf:"varint,2,opt,name=action,proto3,enum=poolrpc.AccountEventAction" json:"action,omitempty"`
+	// The state of the account previous to the change.
+	PreviousState AccountState `protobuf:"varint,3,opt,name=previous_state,json=previousState,proto3,enum=poolrpc.AccountState" json:"previous_state,omitempty"`
+	// The state of the account after the change.
+	NewState AccountState `protobuf:"varint,4,opt,name=new_state,json=newState,proto3,enum=poolrpc.AccountState" json:"new_state,omitempty"`
+	// The value of the account in satoshis previous to the change.
+	PreviousValue uint64 `protobuf:"varint,5,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	// The value of the account in satoshis after the change.
+	NewValue uint64 `protobuf:"varint,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// The expiration height of the account previous to the change.
+	PreviousExpirationHeight uint32 `protobuf:"varint,7,opt,name=previous_expiration_height,json=previousExpirationHeight,proto3" json:"previous_expiration_height,omitempty"`
+	// The expiration height of the account after the change.
+	NewExpirationHeight uint32 `protobuf:"varint,8,opt,name=new_expiration_height,json=newExpirationHeight,proto3" json:"new_expiration_height,omitempty"`
+	// The on-chain outpoint of the account after the change.
+	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,9,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The fee rate in satoshis per kw that was requested for a fee bump. Only set
+	//for events with the ACCOUNT_ACTION_FEE_BUMP action.
+	FeeRateSatPerKw uint64 `protobuf:"varint,10,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+}
+
+func (x *AccountEvent) Reset() {
+	*x = AccountEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEvent) ProtoMessage() {}
+
+func (x *AccountEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEvent.ProtoReflect.Descriptor instead.
+func (*AccountEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AccountEvent) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetAction() AccountEventAction {
+	if x != nil {
+		return x.Action
+	}
+	return AccountEventAction_ACCOUNT_ACTION_UNKNOWN
+}
+
+func (x *AccountEvent) GetPreviousState() AccountState {
+	if x != nil {
+		return x.PreviousState
+	}
+	return AccountState_PENDING_OPEN
+}
+
+func (x *AccountEvent) GetNewState() AccountState {
+	if x != nil {
+		return x.NewState
+	}
+	return AccountState_PENDING_OPEN
+}
+
+func (x *AccountEvent) GetPreviousValue() uint64 {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetNewValue() uint64 {
+	if x != nil {
+		return x.NewValue
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetPreviousExpirationHeight() uint32 {
+	if x != nil {
+		return x.PreviousExpirationHeight
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetNewExpirationHeight() uint32 {
+	if x != nil {
+		return x.NewExpirationHeight
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetOutpoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *AccountEvent) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+type AccountEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All events of the account in chronological order.
+	Events []*AccountEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *AccountEventsResponse) Reset() {
+	*x = AccountEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEventsResponse) ProtoMessage() {}
+
+func (x *AccountEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEventsResponse.ProtoReflect.Descriptor instead.
+func (*AccountEventsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AccountEventsResponse) GetEvents() []*AccountEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ExportAccountWatchOnlyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportAccountWatchOnlyRequest) Reset() {
+	*x = ExportAccountWatchOnlyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountWatchOnlyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountWatchOnlyRequest) ProtoMessage() {}
+
+func (x *ExportAccountWatchOnlyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountWatchOnlyRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountWatchOnlyRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{85}
+}
+
+type WatchOnlyAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The base trader key that identifies the account.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The version of the account.
+	Version auctioneerrpc.AccountVersion `protobuf:"varint,2,opt,name=version,proto3,enum=poolrpc.AccountVersion" json:"version,omitempty"`
+	// The value of the current account output in satoshis.
+	Value uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The height at which the account expires.
+	ExpirationHeight uint32 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// The base auctioneer key of the account.
+	AuctioneerKey []byte `protobuf:"bytes,5,opt,name=auctioneer_key,json=auctioneerKey,proto3" json:"auctioneer_key,omitempty"`
+	// The batch key the current account output was derived with.
+	BatchKey []byte `protobuf:"bytes,6,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The outpoint of the current account output.
+	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,7,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The output script of the current account output.
+	PkScript []byte `protobuf:"bytes,8,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	//
+	//The output script the account is recreated with when it takes part in a
+	//batch or is modified without changing its expiry. A spend that doesn't
+	//create this output is unexpected.
+	NextPkScript []byte `protobuf:"bytes,9,opt,name=next_pk_script,json=nextPkScript,proto3" json:"next_pk_script,omitempty"`
+}
+
+func (x *WatchOnlyAccount) Reset() {
+	*x = WatchOnlyAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOnlyAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOnlyAccount) ProtoMessage() {}
+
+func (x *WatchOnlyAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOnlyAccount.ProtoReflect.Descriptor instead.
+func (*WatchOnlyAccount) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *WatchOnlyAccount) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *WatchOnlyAccount) GetVersion() auctioneerrpc.AccountVersion {
+	if x != nil {
+		return x.Version
+	}
+	return auctioneerrpc.AccountVersion(0)
+}
+
+func (x *WatchOnlyAccount) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *WatchOnlyAccount) GetExpirationHeight() uint32 {
+	if x != nil {
+		return x.ExpirationHeight
+	}
+	return 0
+}
+
+func (x *WatchOnlyAccount) GetAuctioneerKey() []byte {
+	if x != nil {
+		return x.AuctioneerKey
+	}
+	return nil
+}
+
+func (x *WatchOnlyAccount) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *WatchOnlyAccount) GetOutpoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *WatchOnlyAccount) GetPkScript() []byte {
+	if x != nil {
+		return x.PkScript
+	}
+	return nil
+}
+
+func (x *WatchOnlyAccount) GetNextPkScript() []byte {
+	if x != nil {
+		return x.NextPkScript
+	}
+	return nil
+}
+
+type ExportAccountWatchOnlyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The watch-only view of all active accounts.
+	Accounts []*WatchOnlyAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *ExportAccountWatchOnlyResponse) Reset() {
+	*x = ExportAccountWatchOnlyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountWatchOnlyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountWatchOnlyResponse) ProtoMessage() {}
+
+func (x *ExportAccountWatchOnlyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountWatchOnlyResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountWatchOnlyResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ExportAccountWatchOnlyResponse) GetAccounts() []*WatchOnlyAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type SubscribeAccountUpdatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeAccountUpdatesRequest) Reset() {
+	*x = SubscribeAccountUpdatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAccountUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAccountUpdatesRequest) ProtoMessage() {}
+
+func (x *SubscribeAccountUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAccountUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAccountUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{88}
+}
+
+type AccountUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The trader key associated with the account.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	//
+	//The state of the account before the update. Equals new_state for new
+	//accounts and the initial state sent when subscribing.
+	PrevState AccountState `protobuf:"varint,2,opt,name=prev_state,json=prevState,proto3,enum=poolrpc.AccountState" json:"prev_state,omitempty"`
+	// The state of the account after the update.
+	NewState AccountState `protobuf:"varint,3,opt,name=new_state,json=newState,proto3,enum=poolrpc.AccountState" json:"new_state,omitempty"`
+	//
+	//The value of the account in satoshis before the update. Equals value for
+	//new accounts and the initial state sent when subscribing.
+	PrevValue uint64 `protobuf:"varint,4,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+	// The value of the account in satoshis after the update.
+	Value uint64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// The outpoint of the account after the update.
+	Outpoint *auctioneerrpc.OutPoint `protobuf:"bytes,6,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	//
+	//The hash of the account's latest transaction, which is the one that
+	//triggered the update if the account's on-chain state changed.
+	Txid []byte `protobuf:"bytes,7,opt,name=txid,proto3" json:"txid,omitempty"`
+	//
+	//Whether this is the current state of the account at the time of
+	//subscribing rather than an actual update.
+	Initial bool `protobuf:"varint,8,opt,name=initial,proto3" json:"initial,omitempty"`
+}
+
+func (x *AccountUpdate) Reset() {
+	*x = AccountUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUpdate) ProtoMessage() {}
+
+func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUpdate.ProtoReflect.Descriptor instead.
+func (*AccountUpdate) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AccountUpdate) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *AccountUpdate) GetPrevState() AccountState {
+	if x != nil {
+		return x.PrevState
+	}
+	return AccountState_PENDING_OPEN
+}
+
+func (x *AccountUpdate) GetNewState() AccountState {
+	if x != nil {
+		return x.NewState
+	}
+	return AccountState_PENDING_OPEN
+}
+
+func (x *AccountUpdate) GetPrevValue() uint64 {
+	if x != nil {
+		return x.PrevValue
+	}
+	return 0
+}
+
+func (x *AccountUpdate) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AccountUpdate) GetOutpoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *AccountUpdate) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *AccountUpdate) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+type AuctionFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AuctionFeeRequest) Reset() {
+	*x = AuctionFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionFeeRequest) ProtoMessage() {}
+
+func (x *AuctionFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionFeeRequest.ProtoReflect.Descriptor instead.
+func (*AuctionFeeRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{90}
+}
+
+type AuctionFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The execution fee charged per matched order.
+	ExecutionFee *auctioneerrpc.ExecutionFee `protobuf:"bytes,1,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+}
+
+func (x *AuctionFeeResponse) Reset() {
+	*x = AuctionFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionFeeResponse) ProtoMessage() {}
+
+func (x *AuctionFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionFeeResponse.ProtoReflect.Descriptor instead.
+func (*AuctionFeeResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AuctionFeeResponse) GetExecutionFee() *auctioneerrpc.ExecutionFee {
+	if x != nil {
+		return x.ExecutionFee
+	}
+	return nil
+}
+
+type Lease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The outpoint of the channel created.
+	ChannelPoint *auctioneerrpc.OutPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The amount, in satoshis, of the channel created.
+	ChannelAmtSat uint64 `protobuf:"varint,2,opt,name=channel_amt_sat,json=channelAmtSat,proto3" json:"channel_amt_sat,omitempty"`
+	// The intended duration, in blocks, of the channel created.
+	ChannelDurationBlocks uint32 `protobuf:"varint,3,opt,name=channel_duration_blocks,json=channelDurationBlocks,proto3" json:"channel_duration_blocks,omitempty"`
+	// The absolute height that this channel lease expires.
+	ChannelLeaseExpiry uint32 `protobuf:"varint,4,opt,name=channel_lease_expiry,json=channelLeaseExpiry,proto3" json:"channel_lease_expiry,omitempty"`
+	//
+	//The premium, in satoshis, either paid or received for the offered liquidity.
+	PremiumSat uint64 `protobuf:"varint,5,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	//
+	//The execution fee, in satoshis, charged by the auctioneer for the channel
+	//created.
+	ExecutionFeeSat uint64 `protobuf:"varint,6,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
+	//
+	//The fee, in satoshis, charged by the auctioneer for the batch execution
+	//transaction that created this lease.
+	ChainFeeSat uint64 `protobuf:"varint,7,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
+	//
+	//The actual fixed rate expressed in parts per billionth this lease was
+	//bought/sold at.
+	ClearingRatePrice uint64 `protobuf:"varint,8,opt,name=clearing_rate_price,json=clearingRatePrice,proto3" json:"clearing_rate_price,omitempty"`
+	//
+	//The actual fixed rate of the bid/ask, this should always be 'better' than
+	//the clearing_rate_price.
+	OrderFixedRate uint64 `protobuf:"varint,9,opt,name=order_fixed_rate,json=orderFixedRate,proto3" json:"order_fixed_rate,omitempty"`
+	// The order executed that resulted in the channel created.
+	OrderNonce []byte `protobuf:"bytes,10,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	//
+	//The unique identifier for the order that was matched with that resulted
+	//in the channel created.
+	MatchedOrderNonce []byte `protobuf:"bytes,16,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
+	// Whether this channel was purchased from another trader or not.
+	Purchased bool `protobuf:"varint,11,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	// The pubkey of the node that this channel was bought/sold from.
+	ChannelRemoteNodeKey []byte `protobuf:"bytes,12,opt,name=channel_remote_node_key,json=channelRemoteNodeKey,proto3" json:"channel_remote_node_key,omitempty"`
+	// The tier of the node that this channel was bought/sold from.
+	ChannelNodeTier auctioneerrpc.NodeTier `protobuf:"varint,13,opt,name=channel_node_tier,json=channelNodeTier,proto3,enum=poolrpc.NodeTier" json:"channel_node_tier,omitempty"`
+	// The self channel balance that was pushed to the recipient.
+	SelfChanBalance uint64 `protobuf:"varint,14,opt,name=self_chan_balance,json=selfChanBalance,proto3" json:"self_chan_balance,omitempty"`
+	// Whether the channel was leased as a sidecar channel (bid orders only).
+	SidecarChannel bool `protobuf:"varint,15,opt,name=sidecar_channel,json=sidecarChannel,proto3" json:"sidecar_channel,omitempty"`
+	// The label of our order that resulted in the lease, if one was set.
+	OrderLabel string `protobuf:"bytes,17,opt,name=order_label,json=orderLabel,proto3" json:"order_label,omitempty"`
+	// The number of distinct nodes the order of this lease was matched with
+	// across all leases returned.
+	OrderDistinctPeers uint32 `protobuf:"varint,18,opt,name=order_distinct_peers,json=orderDistinctPeers,proto3" json:"order_distinct_peers,omitempty"`
+	// The channel type that was negotiated for the leased channel.
+	ChannelType auctioneerrpc.OrderChannelType `protobuf:"varint,19,opt,name=channel_type,json=channelType,proto3,enum=poolrpc.OrderChannelType" json:"channel_type,omitempty"`
+	// Whether the leased channel is unannounced, i.e. not gossiped to the
+	// network.
+	UnannouncedChannel bool `protobuf:"varint,20,opt,name=unannounced_channel,json=unannouncedChannel,proto3" json:"unannounced_channel,omitempty"`
+	// Whether the leased channel was requested as a zero conf channel that
+	// is usable before the batch transaction confirms.
+	ZeroConfChannel bool `protobuf:"varint,21,opt,name=zero_conf_channel,json=zeroConfChannel,proto3" json:"zero_conf_channel,omitempty"`
+	// The amount pushed to the recipient of a sidecar channel, as specified by
+	// the sidecar ticket. This is only set for sidecar channels.
+	SidecarPushAmtSat uint64 `protobuf:"varint,22,opt,name=sidecar_push_amt_sat,json=sidecarPushAmtSat,proto3" json:"sidecar_push_amt_sat,omitempty"`
+	//
+	//The short channel ID of the channel once the batch transaction confirmed.
+	//This is zero as long as the confirmation wasn't seen.
+	ChannelId uint64 `protobuf:"varint,23,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// The state of the channel as last reported by lnd.
+	ChannelState LeaseChannelState `protobuf:"varint,24,opt,name=channel_state,json=channelState,proto3,enum=poolrpc.LeaseChannelState" json:"channel_state,omitempty"`
+	// The height the channel was closed at, zero while it is still open.
+	ChannelCloseHeight uint32 `protobuf:"varint,25,opt,name=channel_close_height,json=channelCloseHeight,proto3" json:"channel_close_height,omitempty"`
+	// The ID of the batch the lease was created in.
+	BatchId []byte `protobuf:"bytes,26,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	//
+	//The unix timestamp in nanoseconds the batch the lease was created in was
+	//finalized at. This is zero for batches that were finalized by versions
+	//that didn't record the batch in the order events yet.
+	BatchTimestampNs int64 `protobuf:"varint,27,opt,name=batch_timestamp_ns,json=batchTimestampNs,proto3" json:"batch_timestamp_ns,omitempty"`
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Lease) GetChannelPoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+func (x *Lease) GetChannelAmtSat() uint64 {
+	if x != nil {
+		return x.ChannelAmtSat
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelDurationBlocks() uint32 {
+	if x != nil {
+		return x.ChannelDurationBlocks
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelLeaseExpiry() uint32 {
+	if x != nil {
+		return x.ChannelLeaseExpiry
+	}
+	return 0
+}
+
+func (x *Lease) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *Lease) GetExecutionFeeSat() uint64 {
+	if x != nil {
+		return x.ExecutionFeeSat
+	}
+	return 0
+}
+
+func (x *Lease) GetChainFeeSat() uint64 {
+	if x != nil {
+		return x.ChainFeeSat
+	}
+	return 0
+}
+
+func (x *Lease) GetClearingRatePrice() uint64 {
+	if x != nil {
+		return x.ClearingRatePrice
+	}
+	return 0
+}
+
+func (x *Lease) GetOrderFixedRate() uint64 {
+	if x != nil {
+		return x.OrderFixedRate
+	}
+	return 0
+}
+
+func (x *Lease) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *Lease) GetMatchedOrderNonce() []byte {
+	if x != nil {
+		return x.MatchedOrderNonce
+	}
+	return nil
+}
+
+func (x *Lease) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+func (x *Lease) GetChannelRemoteNodeKey() []byte {
+	if x != nil {
+		return x.ChannelRemoteNodeKey
+	}
+	return nil
+}
+
+func (x *Lease) GetChannelNodeTier() auctioneerrpc.NodeTier {
+	if x != nil {
+		return x.ChannelNodeTier
+	}
+	return auctioneerrpc.NodeTier(0)
+}
+
+func (x *Lease) GetSelfChanBalance() uint64 {
+	if x != nil {
+		return x.SelfChanBalance
+	}
+	return 0
+}
+
+func (x *Lease) GetSidecarChannel() bool {
+	if x != nil {
+		return x.SidecarChannel
+	}
+	return false
+}
+
+func (x *Lease) GetOrderLabel() string {
+	if x != nil {
+		return x.OrderLabel
+	}
+	return ""
+}
+
+func (x *Lease) GetOrderDistinctPeers() uint32 {
+	if x != nil {
+		return x.OrderDistinctPeers
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelType() auctioneerrpc.OrderChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return auctioneerrpc.OrderChannelType(0)
+}
+
+func (x *Lease) GetUnannouncedChannel() bool {
+	if x != nil {
+		return x.UnannouncedChannel
+	}
+	return false
+}
+
+func (x *Lease) GetZeroConfChannel() bool {
+	if x != nil {
+		return x.ZeroConfChannel
+	}
+	return false
+}
+
+func (x *Lease) GetSidecarPushAmtSat() uint64 {
+	if x != nil {
+		return x.SidecarPushAmtSat
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Lease) GetChannelState() LeaseChannelState {
+	if x != nil {
+		return x.ChannelState
+	}
+	return LeaseChannelState_LEASE_CHANNEL_STATE_UNKNOWN
+}
+
+func (x *Lease) GetChannelCloseHeight() uint32 {
+	if x != nil {
+		return x.ChannelCloseHeight
+	}
+	return 0
+}
+
+func (x *Lease) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *Lease) GetBatchTimestampNs() int64 {
+	if x != nil {
+		return x.BatchTimestampNs
+	}
+	return 0
+}
+
+type LeasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//An optional list of batches to retrieve the leases of. If empty, leases
+	//throughout all batches are returned.
+	BatchIds [][]byte `protobuf:"bytes,1,rep,name=batch_ids,json=batchIds,proto3" json:"batch_ids,omitempty"`
+	//
+	//An optional list of accounts to retrieve the leases of. If empty, leases
+	//for all accounts are returned.
+	Accounts [][]byte `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	//
+	//Only return leases of batches that were finalized at or after this unix
+	//timestamp in nanoseconds. If this is 0, there is no lower bound.
+	StartTimestampNs int64 `protobuf:"varint,3,opt,name=start_timestamp_ns,json=startTimestampNs,proto3" json:"start_timestamp_ns,omitempty"`
+	//
+	//Only return leases of batches that were finalized before this unix
+	//timestamp in nanoseconds. If this is 0, there is no upper bound.
+	EndTimestampNs int64 `protobuf:"varint,4,opt,name=end_timestamp_ns,json=endTimestampNs,proto3" json:"end_timestamp_ns,omitempty"`
+	//
+	//Only return leases whose channels are currently open. Leases whose channels
+	//are pending or were closed are skipped.
+	OpenChannelsOnly bool `protobuf:"varint,5,opt,name=open_channels_only,json=openChannelsOnly,proto3" json:"open_channels_only,omitempty"`
+	//
+	//The number of leases matching the filters that should be skipped before
+	//returning any results. Leases are ordered by the time their batch was
+	//finalized, oldest first, and by their channel output index within a batch.
+	//Can be used for paginating through the leases.
+	IndexOffset uint32 `protobuf:"varint,6,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	//
+	//The maximum number of leases to return. If zero, all leases after the index
+	//offset are returned.
+	MaxNumLeases uint32 `protobuf:"varint,7,opt,name=max_num_leases,json=maxNumLeases,proto3" json:"max_num_leases,omitempty"`
+}
+
+func (x *LeasesRequest) Reset() {
+	*x = LeasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasesRequest) ProtoMessage() {}
+
+func (x *LeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasesRequest.ProtoReflect.Descriptor instead.
+func (*LeasesRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *LeasesRequest) GetBatchIds() [][]byte {
+	if x != nil {
+		return x.BatchIds
+	}
+	return nil
+}
+
+func (x *LeasesRequest) GetAccounts() [][]byte {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *LeasesRequest) GetStartTimestampNs() int64 {
+	if x != nil {
+		return x.StartTimestampNs
+	}
+	return 0
+}
+
+func (x *LeasesRequest) GetEndTimestampNs() int64 {
+	if x != nil {
+		return x.EndTimestampNs
+	}
+	return 0
+}
+
+func (x *LeasesRequest) GetOpenChannelsOnly() bool {
+	if x != nil {
+		return x.OpenChannelsOnly
+	}
+	return false
+}
+
+func (x *LeasesRequest) GetIndexOffset() uint32 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *LeasesRequest) GetMaxNumLeases() uint32 {
+	if x != nil {
+		return x.MaxNumLeases
+	}
+	return 0
+}
+
+type LeasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The relevant list of leases purchased or sold within the auction.
+	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+	// The total amount of satoshis earned from the leases returned.
+	TotalAmtEarnedSat uint64 `protobuf:"varint,2,opt,name=total_amt_earned_sat,json=totalAmtEarnedSat,proto3" json:"total_amt_earned_sat,omitempty"`
+	// The total amount of satoshis paid for the leases returned.
+	TotalAmtPaidSat uint64 `protobuf:"varint,3,opt,name=total_amt_paid_sat,json=totalAmtPaidSat,proto3" json:"total_amt_paid_sat,omitempty"`
+	//
+	//The total number of leases matching the filters, independent of the index
+	//offset and the maximum number of leases requested.
+	TotalNumLeases uint32 `protobuf:"varint,4,opt,name=total_num_leases,json=totalNumLeases,proto3" json:"total_num_leases,omitempty"`
+}
+
+func (x *LeasesResponse) Reset() {
+	*x = LeasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeasesResponse) ProtoMessage() {}
+
+func (x *LeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeasesResponse.ProtoReflect.Descriptor instead.
+func (*LeasesResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *LeasesResponse) GetLeases() []*Lease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+func (x *LeasesResponse) GetTotalAmtEarnedSat() uint64 {
+	if x != nil {
+		return x.TotalAmtEarnedSat
+	}
+	return 0
+}
+
+func (x *LeasesResponse) GetTotalAmtPaidSat() uint64 {
+	if x != nil {
+		return x.TotalAmtPaidSat
+	}
+	return 0
+}
+
+func (x *LeasesResponse) GetTotalNumLeases() uint32 {
+	if x != nil {
+		return x.TotalNumLeases
+	}
+	return 0
+}
+
+type SubscribeLeaseEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, all recorded lease events are sent before any new ones.
+	IncludeHistory bool `protobuf:"varint,1,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+}
+
+func (x *SubscribeLeaseEventsRequest) Reset() {
+	*x = SubscribeLeaseEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeLeaseEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLeaseEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeLeaseEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeLeaseEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeLeaseEventsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SubscribeLeaseEventsRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type LeaseEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in nanoseconds the event was recorded at.
+	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The outpoint of the channel that was closed early.
+	ChannelPoint *auctioneerrpc.OutPoint `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The ID of the transaction that closed the channel.
+	ClosingTxid []byte `protobuf:"bytes,3,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+	// The batch the channel was created in.
+	BatchId []byte `protobuf:"bytes,4,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The nonce of our order that resulted in the channel.
+	OrderNonce []byte `protobuf:"bytes,5,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// Whether we purchased the channel or sold it.
+	Purchased bool `protobuf:"varint,6,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	// The identity public key of our peer in the channel.
+	ChannelRemoteNodeKey []byte `protobuf:"bytes,7,opt,name=channel_remote_node_key,json=channelRemoteNodeKey,proto3" json:"channel_remote_node_key,omitempty"`
+	// The capacity of the channel in satoshis.
+	ChannelAmtSat uint64 `protobuf:"varint,8,opt,name=channel_amt_sat,json=channelAmtSat,proto3" json:"channel_amt_sat,omitempty"`
+	// How the channel was closed.
+	CloseType LeaseCloseType `protobuf:"varint,9,opt,name=close_type,json=closeType,proto3,enum=poolrpc.LeaseCloseType" json:"close_type,omitempty"`
+	// The number of blocks the channel was leased for.
+	ChannelDurationBlocks uint32 `protobuf:"varint,10,opt,name=channel_duration_blocks,json=channelDurationBlocks,proto3" json:"channel_duration_blocks,omitempty"`
+	// The absolute height the lease would have expired at.
+	ChannelLeaseExpiry uint32 `protobuf:"varint,11,opt,name=channel_lease_expiry,json=channelLeaseExpiry,proto3" json:"channel_lease_expiry,omitempty"`
+	// The height the channel was closed at.
+	CloseHeight uint32 `protobuf:"varint,12,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
+	// The number of blocks the lease had left when the channel was closed.
+	BlocksRemaining uint32 `protobuf:"varint,13,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+}
+
+func (x *LeaseEvent) Reset() {
+	*x = LeaseEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseEvent) ProtoMessage() {}
+
+func (x *LeaseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseEvent.ProtoReflect.Descriptor instead.
+func (*LeaseEvent) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *LeaseEvent) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetChannelPoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+func (x *LeaseEvent) GetClosingTxid() []byte {
+	if x != nil {
+		return x.ClosingTxid
+	}
+	return nil
+}
+
+func (x *LeaseEvent) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *LeaseEvent) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *LeaseEvent) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+func (x *LeaseEvent) GetChannelRemoteNodeKey() []byte {
+	if x != nil {
+		return x.ChannelRemoteNodeKey
+	}
+	return nil
+}
+
+func (x *LeaseEvent) GetChannelAmtSat() uint64 {
+	if x != nil {
+		return x.ChannelAmtSat
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetCloseType() LeaseCloseType {
+	if x != nil {
+		return x.CloseType
+	}
+	return LeaseCloseType_LEASE_CLOSE_TYPE_COOPERATIVE
+}
+
+func (x *LeaseEvent) GetChannelDurationBlocks() uint32 {
+	if x != nil {
+		return x.ChannelDurationBlocks
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetChannelLeaseExpiry() uint32 {
+	if x != nil {
+		return x.ChannelLeaseExpiry
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetCloseHeight() uint32 {
+	if x != nil {
+		return x.CloseHeight
+	}
+	return 0
+}
+
+func (x *LeaseEvent) GetBlocksRemaining() uint32 {
+	if x != nil {
+		return x.BlocksRemaining
+	}
+	return 0
+}
+
+type LeaseEvidenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The outpoint of the closed lease channel to collect the evidence of.
+	ChannelPoint *auctioneerrpc.OutPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+}
+
+func (x *LeaseEvidenceRequest) Reset() {
+	*x = LeaseEvidenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseEvidenceRequest) ProtoMessage() {}
+
+func (x *LeaseEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*LeaseEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *LeaseEvidenceRequest) GetChannelPoint() *auctioneerrpc.OutPoint {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+type LeaseEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the serialization format of the evidence.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The serialized evidence.
+	Evidence []byte `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *LeaseEvidenceResponse) Reset() {
+	*x = LeaseEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseEvidenceResponse) ProtoMessage() {}
+
+func (x *LeaseEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*LeaseEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *LeaseEvidenceResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeaseEvidenceResponse) GetEvidence() []byte {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type ListLocalBatchSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unique identifier of the first batch to return, encoded as a compressed
+	//pubkey. This represents the newest batch to fetch. If this is empty, the
+	//most recent batch the trader participated in is used as the starting point
+	//to go back from.
+	StartBatchId []byte `protobuf:"bytes,1,opt,name=start_batch_id,json=startBatchId,proto3" json:"start_batch_id,omitempty"`
+	//
+	//The number of batches to return at most, including the start batch. If
+	//zero, all batches up to and including the start batch are returned.
+	NumBatchesBack uint32 `protobuf:"varint,2,opt,name=num_batches_back,json=numBatchesBack,proto3" json:"num_batches_back,omitempty"`
+}
+
+func (x *ListLocalBatchSnapshotsRequest) Reset() {
+	*x = ListLocalBatchSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLocalBatchSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalBatchSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListLocalBatchSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalBatchSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLocalBatchSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListLocalBatchSnapshotsRequest) GetStartBatchId() []byte {
+	if x != nil {
+		return x.StartBatchId
+	}
+	return nil
+}
+
+func (x *ListLocalBatchSnapshotsRequest) GetNumBatchesBack() uint32 {
+	if x != nil {
+		return x.NumBatchesBack
+	}
+	return 0
+}
+
+type ListLocalBatchSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The list of local batch snapshots requested, newest first.
+	Batches []*LocalBatchSnapshot `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *ListLocalBatchSnapshotsResponse) Reset() {
+	*x = ListLocalBatchSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLocalBatchSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocalBatchSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListLocalBatchSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocalBatchSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLocalBatchSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListLocalBatchSnapshotsResponse) GetBatches() []*LocalBatchSnapshot {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type LocalBatchSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the batch.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The unique identifier of the batch.
+	BatchId []byte `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The txid of the batch transaction.
+	BatchTxId string `protobuf:"bytes,3,opt,name=batch_tx_id,json=batchTxId,proto3" json:"batch_tx_id,omitempty"`
+	// The fee rate, in satoshis per kiloweight, of the batch transaction.
+	BatchTxFeeRateSatPerKw uint64 `protobuf:"varint,4,opt,name=batch_tx_fee_rate_sat_per_kw,json=batchTxFeeRateSatPerKw,proto3" json:"batch_tx_fee_rate_sat_per_kw,omitempty"`
+	//
+	//Maps the distinct lease duration markets the trader's orders were matched
+	//in to the fixed rate clearing price of that market.
+	ClearingPrices map[uint32]uint32 `protobuf:"bytes,5,rep,name=clearing_prices,json=clearingPrices,proto3" json:"clearing_prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//
+	//The estimated amount of satoshis the trader paid in chain fees for the
+	//channels created for its orders in this batch.
+	ChainFeeSat uint64 `protobuf:"varint,6,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
+	// The list of all matches of the trader's orders within the batch.
+	MatchedOrders []*LocalMatchedOrder `protobuf:"bytes,7,rep,name=matched_orders,json=matchedOrders,proto3" json:"matched_orders,omitempty"`
+	//
+	//The decision of the external batch approval hook. Only set if a hook was
+	//configured when the batch was accepted.
+	Approval *BatchApprovalRecord `protobuf:"bytes,8,opt,name=approval,proto3" json:"approval,omitempty"`
+}
+
+func (x *LocalBatchSnapshot) Reset() {
+	*x = LocalBatchSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalBatchSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalBatchSnapshot) ProtoMessage() {}
+
+func (x *LocalBatchSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalBatchSnapshot.ProtoReflect.Descriptor instead.
+func (*LocalBatchSnapshot) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *LocalBatchSnapshot) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LocalBatchSnapshot) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *LocalBatchSnapshot) GetBatchTxId() string {
+	if x != nil {
+		return x.BatchTxId
+	}
+	return ""
+}
+
+func (x *LocalBatchSnapshot) GetBatchTxFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.BatchTxFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *LocalBatchSnapshot) GetClearingPrices() map[uint32]uint32 {
+	if x != nil {
+		return x.ClearingPrices
+	}
+	return nil
+}
+
+func (x *LocalBatchSnapshot) GetChainFeeSat() uint64 {
+	if x != nil {
+		return x.ChainFeeSat
+	}
+	return 0
+}
+
+func (x *LocalBatchSnapshot) GetMatchedOrders() []*LocalMatchedOrder {
+	if x != nil {
+		return x.MatchedOrders
+	}
+	return nil
+}
+
+func (x *LocalBatchSnapshot) GetApproval() *BatchApprovalRecord {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+type BatchApprovalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the batch was approved.
+	Approved bool `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	//
+	//Whether no decision was made within the timeout and the configured timeout
+	//action was applied instead.
+	TimedOut bool `protobuf:"varint,2,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// The reason given for the decision, if any.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The time it took to reach the decision, in milliseconds.
+	LatencyMs uint64 `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// The unix timestamp in seconds at which the decision was made.
+	DecidedAt int64 `protobuf:"varint,5,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+}
+
+func (x *BatchApprovalRecord) Reset() {
+	*x = BatchApprovalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchApprovalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApprovalRecord) ProtoMessage() {}
+
+func (x *BatchApprovalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApprovalRecord.ProtoReflect.Descriptor instead.
+func (*BatchApprovalRecord) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BatchApprovalRecord) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *BatchApprovalRecord) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *BatchApprovalRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchApprovalRecord) GetLatencyMs() uint64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *BatchApprovalRecord) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+type LocalMatchedOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nonce of the trader's own order that was matched.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// The nonce of the order the trader's order was matched with.
+	MatchedOrderNonce []byte `protobuf:"bytes,2,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
+	// The number of units that were filled by this match.
+	UnitsFilled uint32 `protobuf:"varint,3,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	// The lease duration of the matched bid, in blocks.
+	LeaseDurationBlocks uint32 `protobuf:"varint,4,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	// The node key of the counterparty of the match.
+	NodeKey []byte `protobuf:"bytes,5,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+}
+
+func (x *LocalMatchedOrder) Reset() {
+	*x = LocalMatchedOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalMatchedOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalMatchedOrder) ProtoMessage() {}
+
+func (x *LocalMatchedOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalMatchedOrder.ProtoReflect.Descriptor instead.
+func (*LocalMatchedOrder) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *LocalMatchedOrder) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *LocalMatchedOrder) GetMatchedOrderNonce() []byte {
+	if x != nil {
+		return x.MatchedOrderNonce
+	}
+	return nil
+}
+
+func (x *LocalMatchedOrder) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+func (x *LocalMatchedOrder) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
+	}
+	return 0
+}
+
+func (x *LocalMatchedOrder) GetNodeKey() []byte {
+	if x != nil {
+		return x.NodeKey
+	}
+	return nil
+}
+
+type AcceptedBatchProposalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unique identifier of the batch, encoded as a compressed pubkey. If this
+	//is empty, the current pending batch is used.
+	BatchId []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *AcceptedBatchProposalRequest) Reset() {
+	*x = AcceptedBatchProposalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptedBatchProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptedBatchProposalRequest) ProtoMessage() {}
+
+func (x *AcceptedBatchProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptedBatchProposalRequest.ProtoReflect.Descriptor instead.
+func (*AcceptedBatchProposalRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AcceptedBatchProposalRequest) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+type AcceptedBatchProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the batch.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The unique identifier of the batch.
+	BatchId []byte `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The total number of units filled for each of the trader's orders.
+	MatchedUnits []*MatchedOrderUnits `protobuf:"bytes,3,rep,name=matched_units,json=matchedUnits,proto3" json:"matched_units,omitempty"`
+	//
+	//Maps the distinct lease duration markets the trader's orders were matched
+	//in to the fixed rate clearing price of that market.
+	ClearingPrices map[uint32]uint32 `protobuf:"bytes,4,rep,name=clearing_prices,json=clearingPrices,proto3" json:"clearing_prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The ending states of the trader's accounts that participated.
+	AccountDiffs []*auctioneerrpc.AccountDiff `protobuf:"bytes,5,rep,name=account_diffs,json=accountDiffs,proto3" json:"account_diffs,omitempty"`
+	// The execution fee schedule the auctioneer charged fees with.
+	ExecutionFee *auctioneerrpc.ExecutionFee `protobuf:"bytes,6,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee,omitempty"`
+	// The txid of the batch transaction.
+	BatchTxId string `protobuf:"bytes,7,opt,name=batch_tx_id,json=batchTxId,proto3" json:"batch_tx_id,omitempty"`
+	// The serialized batch transaction the trader signed for.
+	BatchTx []byte `protobuf:"bytes,8,opt,name=batch_tx,json=batchTx,proto3" json:"batch_tx,omitempty"`
+	// The fee rate, in satoshis per kiloweight, of the batch transaction.
+	BatchTxFeeRateSatPerKw uint64 `protobuf:"varint,9,opt,name=batch_tx_fee_rate_sat_per_kw,json=batchTxFeeRateSatPerKw,proto3" json:"batch_tx_fee_rate_sat_per_kw,omitempty"`
+	// The rebate in satoshis that was offered to the trader.
+	FeeRebateSat uint64 `protobuf:"varint,10,opt,name=fee_rebate_sat,json=feeRebateSat,proto3" json:"fee_rebate_sat,omitempty"`
+}
+
+func (x *AcceptedBatchProposalResponse) Reset() {
+	*x = AcceptedBatchProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptedBatchProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptedBatchProposalResponse) ProtoMessage() {}
+
+func (x *AcceptedBatchProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptedBatchProposalResponse.ProtoReflect.Descriptor instead.
+func (*AcceptedBatchProposalResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AcceptedBatchProposalResponse) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AcceptedBatchProposalResponse) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *AcceptedBatchProposalResponse) GetMatchedUnits() []*MatchedOrderUnits {
+	if x != nil {
+		return x.MatchedUnits
+	}
+	return nil
+}
+
+func (x *AcceptedBatchProposalResponse) GetClearingPrices() map[uint32]uint32 {
+	if x != nil {
+		return x.ClearingPrices
+	}
+	return nil
+}
+
+func (x *AcceptedBatchProposalResponse) GetAccountDiffs() []*auctioneerrpc.AccountDiff {
+	if x != nil {
+		return x.AccountDiffs
+	}
+	return nil
+}
+
+func (x *AcceptedBatchProposalResponse) GetExecutionFee() *auctioneerrpc.ExecutionFee {
+	if x != nil {
+		return x.ExecutionFee
+	}
+	return nil
+}
+
+func (x *AcceptedBatchProposalResponse) GetBatchTxId() string {
+	if x != nil {
+		return x.BatchTxId
+	}
+	return ""
+}
+
+func (x *AcceptedBatchProposalResponse) GetBatchTx() []byte {
+	if x != nil {
+		return x.BatchTx
+	}
+	return nil
+}
+
+func (x *AcceptedBatchProposalResponse) GetBatchTxFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.BatchTxFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *AcceptedBatchProposalResponse) GetFeeRebateSat() uint64 {
+	if x != nil {
+		return x.FeeRebateSat
+	}
+	return 0
+}
+
+type MatchedOrderUnits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nonce of the trader's order that was matched.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// The total number of units filled for the order.
+	UnitsFilled uint32 `protobuf:"varint,2,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+}
+
+func (x *MatchedOrderUnits) Reset() {
+	*x = MatchedOrderUnits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchedOrderUnits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchedOrderUnits) ProtoMessage() {}
+
+func (x *MatchedOrderUnits) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchedOrderUnits.ProtoReflect.Descriptor instead.
+func (*MatchedOrderUnits) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MatchedOrderUnits) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *MatchedOrderUnits) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+type TokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TokensRequest) Reset() {
+	*x = TokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensRequest) ProtoMessage() {}
+
+func (x *TokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
+func (*TokensRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{107}
+}
+
+type TokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	//List of all tokens the daemon knows of, including old/expired tokens.
+	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *TokensResponse) Reset() {
+	*x = TokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensResponse) ProtoMessage() {}
+
+func (x *TokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
+func (*TokensResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *TokensResponse) GetTokens() []*LsatToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LsatToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	//The base macaroon that was baked by the auth server.
+	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
+	//*
+	//The payment hash of the payment that was paid to obtain the token.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//*
+	//The preimage of the payment hash, knowledge of this is proof that the
+	//payment has been paid. If the preimage is set to all zeros, this means the
+	//payment is still pending and the token is not yet fully valid.
+	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
+	//*
+	//The amount of millisatoshis that was paid to get the token.
+	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
+	//*
+	//The amount of millisatoshis paid in routing fee to pay for the token.
+	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
+	//*
+	//The creation time of the token as UNIX timestamp in seconds.
+	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+	//*
+	//Indicates whether the token is expired or still valid.
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	//*
+	//Identifying attribute of this token in the store. Currently represents the
+	//file name of the token where it's stored on the file system.
+	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+}
+
+func (x *LsatToken) Reset() {
+	*x = LsatToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsatToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsatToken) ProtoMessage() {}
+
+func (x *LsatToken) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
+func (*LsatToken) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *LsatToken) GetBaseMacaroon() []byte {
+	if x != nil {
+		return x.BaseMacaroon
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentPreimage() []byte {
+	if x != nil {
+		return x.PaymentPreimage
+	}
+	return nil
+}
+
+func (x *LsatToken) GetAmountPaidMsat() int64 {
+	if x != nil {
+		return x.AmountPaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
+	if x != nil {
+		return x.RoutingFeePaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetTimeCreated() int64 {
+	if x != nil {
+		return x.TimeCreated
+	}
+	return 0
+}
+
+func (x *LsatToken) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *LsatToken) GetStorageName() string {
+	if x != nil {
+		return x.StorageName
+	}
+	return ""
+}
+
+type ListLsatTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLsatTokensRequest) Reset() {
+	*x = ListLsatTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLsatTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLsatTokensRequest) ProtoMessage() {}
+
+func (x *ListLsatTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLsatTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListLsatTokensRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{110}
+}
+
+type ListLsatTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//All tokens of the token store, ordered by their creation time.
+	Tokens []*LsatTokenInfo `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *ListLsatTokensResponse) Reset() {
+	*x = ListLsatTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLsatTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLsatTokensResponse) ProtoMessage() {}
+
+func (x *ListLsatTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLsatTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListLsatTokensResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListLsatTokensResponse) GetTokens() []*LsatTokenInfo {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LsatTokenInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The ID of the token as encoded in the identifier of its base macaroon.
+	TokenId []byte `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	//
+	//The payment hash of the payment that was paid to obtain the token.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//
+	//The amount of millisatoshis that was paid to get the token.
+	AmountPaidMsat int64 `protobuf:"varint,3,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
+	//
+	//The amount of millisatoshis paid in routing fee to pay for the token.
+	RoutingFeePaidMsat int64 `protobuf:"varint,4,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
+	//
+	//The creation time of the token as UNIX timestamp in seconds.
+	TimeCreated int64 `protobuf:"varint,5,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+	//
+	//Whether the payment of the token is still in flight.
+	Pending bool `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
+	//
+	//Whether the token was revoked and is no longer used.
+	Revoked bool `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	//
+	//Identifying attribute of this token in the store. Currently represents the
+	//file name of the token where it's stored on the file system.
+	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+}
+
+func (x *LsatTokenInfo) Reset() {
+	*x = LsatTokenInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsatTokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsatTokenInfo) ProtoMessage() {}
+
+func (x *LsatTokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsatTokenInfo.ProtoReflect.Descriptor instead.
+func (*LsatTokenInfo) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *LsatTokenInfo) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+func (x *LsatTokenInfo) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LsatTokenInfo) GetAmountPaidMsat() int64 {
+	if x != nil {
+		return x.AmountPaidMsat
+	}
+	return 0
+}
+
+func (x *LsatTokenInfo) GetRoutingFeePaidMsat() int64 {
+	if x != nil {
+		return x.RoutingFeePaidMsat
+	}
+	return 0
+}
+
+func (x *LsatTokenInfo) GetTimeCreated() int64 {
+	if x != nil {
+		return x.TimeCreated
+	}
+	return 0
+}
+
+func (x *LsatTokenInfo) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *LsatTokenInfo) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *LsatTokenInfo) GetStorageName() string {
+	if x != nil {
+		return x.StorageName
+	}
+	return ""
+}
+
+type RevokeLsatTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The ID of the token to revoke. Only the current token can be revoked.
+	TokenId []byte `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *RevokeLsatTokenRequest) Reset() {
+	*x = RevokeLsatTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLsatTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLsatTokenRequest) ProtoMessage() {}
+
+func (x *RevokeLsatTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLsatTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLsatTokenRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RevokeLsatTokenRequest) GetTokenId() []byte {
+	if x != nil {
+		return x.TokenId
+	}
+	return nil
+}
+
+type RevokeLsatTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeLsatTokenResponse) Reset() {
+	*x = RevokeLsatTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLsatTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLsatTokenResponse) ProtoMessage() {}
+
+func (x *RevokeLsatTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLsatTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLsatTokenResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{114}
+}
+
+type ImportLsatTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The serialized token as stored in the lsat.token file in the base
+	//directory of the trader daemon.
+	Token []byte `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ImportLsatTokenRequest) Reset() {
+	*x = ImportLsatTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportLsatTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportLsatTokenRequest) ProtoMessage() {}
+
+func (x *ImportLsatTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportLsatTokenRequest.ProtoReflect.Descriptor instead.
+func (*ImportLsatTokenRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ImportLsatTokenRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ImportLsatTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The imported token.
+	Token *LsatTokenInfo `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ImportLsatTokenResponse) Reset() {
+	*x = ImportLsatTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportLsatTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportLsatTokenResponse) ProtoMessage() {}
+
+func (x *ImportLsatTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportLsatTokenResponse.ProtoReflect.Descriptor instead.
+func (*ImportLsatTokenResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ImportLsatTokenResponse) GetToken() *LsatTokenInfo {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type LeaseDurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaseDurationRequest) Reset() {
+	*x = LeaseDurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseDurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseDurationRequest) ProtoMessage() {}
+
+func (x *LeaseDurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseDurationRequest.ProtoReflect.Descriptor instead.
+func (*LeaseDurationRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{117}
+}
+
+type LeaseDurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Deprecated, use lease_duration_buckets.
+	//
+	// Deprecated: Do not use.
+	LeaseDurations map[uint32]bool `protobuf:"bytes,1,rep,name=lease_durations,json=leaseDurations,proto3" json:"lease_durations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//
+	//The set of lease durations the market is currently accepting and the state
+	//the duration buckets currently are in.
+	LeaseDurationBuckets map[uint32]auctioneerrpc.DurationBucketState `protobuf:"bytes,2,rep,name=lease_duration_buckets,json=leaseDurationBuckets,proto3" json:"lease_duration_buckets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=poolrpc.DurationBucketState"`
+}
+
+func (x *LeaseDurationResponse) Reset() {
+	*x = LeaseDurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseDurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseDurationResponse) ProtoMessage() {}
+
+func (x *LeaseDurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseDurationResponse.ProtoReflect.Descriptor instead.
+func (*LeaseDurationResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{118}
+}
+
+// Deprecated: Do not use.
+func (x *LeaseDurationResponse) GetLeaseDurations() map[uint32]bool {
+	if x != nil {
+		return x.LeaseDurations
+	}
+	return nil
+}
+
+func (x *LeaseDurationResponse) GetLeaseDurationBuckets() map[uint32]auctioneerrpc.DurationBucketState {
+	if x != nil {
+		return x.LeaseDurationBuckets
+	}
+	return nil
+}
+
+type NextBatchInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NextBatchInfoRequest) Reset() {
+	*x = NextBatchInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextBatchInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextBatchInfoRequest) ProtoMessage() {}
+
+func (x *NextBatchInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextBatchInfoRequest.ProtoReflect.Descriptor instead.
+func (*NextBatchInfoRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{119}
+}
+
+type NextBatchInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The confirmation target the auctioneer will use for fee estimation of the
+	//next batch.
+	ConfTarget uint32 `protobuf:"varint,5,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The fee rate, in satoshis per kiloweight, estimated by the auctioneer to use
+	//for the next batch.
+	FeeRateSatPerKw uint64 `protobuf:"varint,6,opt,name=fee_rate_sat_per_kw,json=feeRateSatPerKw,proto3" json:"fee_rate_sat_per_kw,omitempty"`
+	//
+	//The absolute unix timestamp in seconds at which the auctioneer will attempt
+	//to clear the next batch.
+	ClearTimestamp uint64 `protobuf:"varint,7,opt,name=clear_timestamp,json=clearTimestamp,proto3" json:"clear_timestamp,omitempty"`
+	//
+	//The value used by the auctioneer to determine if an account expiry height
+	//needs to be extended after participating in a batch and for how long.
+	AutoRenewExtensionBlocks uint32 `protobuf:"varint,8,opt,name=auto_renew_extension_blocks,json=autoRenewExtensionBlocks,proto3" json:"auto_renew_extension_blocks,omitempty"`
+}
+
+func (x *NextBatchInfoResponse) Reset() {
+	*x = NextBatchInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextBatchInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextBatchInfoResponse) ProtoMessage() {}
+
+func (x *NextBatchInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextBatchInfoResponse.ProtoReflect.Descriptor instead.
+func (*NextBatchInfoResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *NextBatchInfoResponse) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *NextBatchInfoResponse) GetFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.FeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *NextBatchInfoResponse) GetClearTimestamp() uint64 {
+	if x != nil {
+		return x.ClearTimestamp
+	}
+	return 0
+}
+
+func (x *NextBatchInfoResponse) GetAutoRenewExtensionBlocks() uint32 {
+	if x != nil {
+		return x.AutoRenewExtensionBlocks
+	}
+	return 0
+}
+
+type NodeRatingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target node to obtain ratings information for.
+	NodePubkeys [][]byte `protobuf:"bytes,1,rep,name=node_pubkeys,json=nodePubkeys,proto3" json:"node_pubkeys,omitempty"`
+}
+
+func (x *NodeRatingRequest) Reset() {
+	*x = NodeRatingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRatingRequest) ProtoMessage() {}
+
+func (x *NodeRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRatingRequest.ProtoReflect.Descriptor instead.
+func (*NodeRatingRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *NodeRatingRequest) GetNodePubkeys() [][]byte {
+	if x != nil {
+		return x.NodePubkeys
+	}
+	return nil
+}
+
+type NodeRatingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A series of node ratings for each of the queried nodes.
+	NodeRatings []*auctioneerrpc.NodeRating `protobuf:"bytes,1,rep,name=node_ratings,json=nodeRatings,proto3" json:"node_ratings,omitempty"`
+}
+
+func (x *NodeRatingResponse) Reset() {
+	*x = NodeRatingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRatingResponse) ProtoMessage() {}
+
+func (x *NodeRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRatingResponse.ProtoReflect.Descriptor instead.
+func (*NodeRatingResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *NodeRatingResponse) GetNodeRatings() []*auctioneerrpc.NodeRating {
+	if x != nil {
+		return x.NodeRatings
+	}
+	return nil
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{123}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the Pool daemon that is running.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The total number of accounts in the local database.
+	AccountsTotal uint32 `protobuf:"varint,2,opt,name=accounts_total,json=accountsTotal,proto3" json:"accounts_total,omitempty"`
+	//
+	//The total number of accounts that are in an active, non-archived state,
+	//including expired accounts.
+	AccountsActive uint32 `protobuf:"varint,3,opt,name=accounts_active,json=accountsActive,proto3" json:"accounts_active,omitempty"`
+	// The total number of accounts that are active but have expired.
+	AccountsActiveExpired uint32 `protobuf:"varint,4,opt,name=accounts_active_expired,json=accountsActiveExpired,proto3" json:"accounts_active_expired,omitempty"`
+	// The total number of accounts that are in an archived/closed state.
+	AccountsArchived uint32 `protobuf:"varint,5,opt,name=accounts_archived,json=accountsArchived,proto3" json:"accounts_archived,omitempty"`
+	// The total number of orders in the local database.
+	OrdersTotal uint32 `protobuf:"varint,6,opt,name=orders_total,json=ordersTotal,proto3" json:"orders_total,omitempty"`
+	//
+	//The total number of active/pending orders that are still waiting for
+	//execution.
+	OrdersActive uint32 `protobuf:"varint,7,opt,name=orders_active,json=ordersActive,proto3" json:"orders_active,omitempty"`
+	// The total number of orders that have been archived.
+	OrdersArchived uint32 `protobuf:"varint,8,opt,name=orders_archived,json=ordersArchived,proto3" json:"orders_archived,omitempty"`
+	// The current block height as seen by the connected lnd node.
+	CurrentBlockHeight uint32 `protobuf:"varint,9,opt,name=current_block_height,json=currentBlockHeight,proto3" json:"current_block_height,omitempty"`
+	// The number of batches an account of this node was ever involved in.
+	BatchesInvolved uint32 `protobuf:"varint,10,opt,name=batches_involved,json=batchesInvolved,proto3" json:"batches_involved,omitempty"`
+	// Our lnd node's rating as judged by the auctioneer server.
+	NodeRating *auctioneerrpc.NodeRating `protobuf:"bytes,11,opt,name=node_rating,json=nodeRating,proto3" json:"node_rating,omitempty"`
+	// The number of available LSAT tokens.
+	LsatTokens uint32 `protobuf:"varint,12,opt,name=lsat_tokens,json=lsatTokens,proto3" json:"lsat_tokens,omitempty"`
+	//
+	//Indicates whether there is an active subscription connection to the
+	//auctioneer. This will never be true if there is no active account. If there
+	//are active accounts, this value represents the network connection status to
+	//the auctioneer server.
+	SubscribedToAuctioneer bool `protobuf:"varint,13,opt,name=subscribed_to_auctioneer,json=subscribedToAuctioneer,proto3" json:"subscribed_to_auctioneer,omitempty"`
+	//
+	//Indicates whether the global `--newnodesonly` command line flag or
+	//`newnodesonly=true` configuration parameter was set on the Pool trader
+	//daemon.
+	NewNodesOnly bool `protobuf:"varint,14,opt,name=new_nodes_only,json=newNodesOnly,proto3" json:"new_nodes_only,omitempty"`
+	//
+	//A map of all markets identified by their lease duration and the current
+	//set of statistics such as number of open orders and total units of open
+	//interest.
+	MarketInfo map[uint32]*auctioneerrpc.MarketInfo `protobuf:"bytes,15,rep,name=market_info,json=marketInfo,proto3" json:"market_info,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The current state of the health gate that keeps the trader from taking
+	//part in batches while its lnd node is unhealthy.
+	HealthGate *HealthGate `protobuf:"bytes,16,opt,name=health_gate,json=healthGate,proto3" json:"health_gate,omitempty"`
+	//
+	//The state of the connection to the auction server. Unlike
+	//subscribed_to_auctioneer this also tells whether the trader daemon is
+	//currently trying to reconnect.
+	AuctioneerConnectionState AuctioneerConnectionState `protobuf:"varint,17,opt,name=auctioneer_connection_state,json=auctioneerConnectionState,proto3,enum=poolrpc.AuctioneerConnectionState" json:"auctioneer_connection_state,omitempty"`
+	//
+	//The address of the auction server endpoint that is currently used.
+	AuctioneerEndpoint string `protobuf:"bytes,18,opt,name=auctioneer_endpoint,json=auctioneerEndpoint,proto3" json:"auctioneer_endpoint,omitempty"`
+	//
+	//The LSAT token that is currently used to authenticate with the auction
+	//server. Not set if no token was acquired yet or the last one was revoked.
+	CurrentLsatToken *LsatTokenInfo `protobuf:"bytes,19,opt,name=current_lsat_token,json=currentLsatToken,proto3" json:"current_lsat_token,omitempty"`
+	//
+	//The number of accounts in the local database, keyed by the name of their
+	//state.
+	AccountsByState map[string]uint32 `protobuf:"bytes,20,rep,name=accounts_by_state,json=accountsByState,proto3" json:"accounts_by_state,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//
+	//The number of orders in the local database, keyed by the name of their
+	//state.
+	OrdersByState map[string]uint32 `protobuf:"bytes,21,rep,name=orders_by_state,json=ordersByState,proto3" json:"orders_by_state,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The ID of the batch that is currently pending, if there is one.
+	PendingBatchId []byte `protobuf:"bytes,22,opt,name=pending_batch_id,json=pendingBatchId,proto3" json:"pending_batch_id,omitempty"`
+	// The alias of the connected lnd node.
+	LndAlias string `protobuf:"bytes,23,opt,name=lnd_alias,json=lndAlias,proto3" json:"lnd_alias,omitempty"`
+	// The identity public key of the connected lnd node.
+	LndPubkey []byte `protobuf:"bytes,24,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
+	//
+	//The version of the auction server, as advertised in its terms. Empty if
+	//the auction server couldn't be reached or doesn't advertise its version.
+	AuctioneerVersion string `protobuf:"bytes,25,opt,name=auctioneer_version,json=auctioneerVersion,proto3" json:"auctioneer_version,omitempty"`
+	//
+	//The batch verification protocol version the trader uses when subscribing
+	//to the auction server. Traders that don't use the version of the auction
+	//server are declined.
+	BatchVersion uint32 `protobuf:"varint,26,opt,name=batch_version,json=batchVersion,proto3" json:"batch_version,omitempty"`
+	//
+	//The batch verification protocol version of the auction server, as
+	//advertised in its terms. Zero if the auction server couldn't be reached or
+	//doesn't advertise its version.
+	AuctioneerBatchVersion uint32 `protobuf:"varint,27,opt,name=auctioneer_batch_version,json=auctioneerBatchVersion,proto3" json:"auctioneer_batch_version,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetAccountsTotal() uint32 {
+	if x != nil {
+		return x.AccountsTotal
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAccountsActive() uint32 {
+	if x != nil {
+		return x.AccountsActive
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAccountsActiveExpired() uint32 {
+	if x != nil {
+		return x.AccountsActiveExpired
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAccountsArchived() uint32 {
+	if x != nil {
+		return x.AccountsArchived
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetOrdersTotal() uint32 {
+	if x != nil {
+		return x.OrdersTotal
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetOrdersActive() uint32 {
+	if x != nil {
+		return x.OrdersActive
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetOrdersArchived() uint32 {
+	if x != nil {
+		return x.OrdersArchived
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetCurrentBlockHeight() uint32 {
+	if x != nil {
+		return x.CurrentBlockHeight
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetBatchesInvolved() uint32 {
+	if x != nil {
+		return x.BatchesInvolved
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetNodeRating() *auctioneerrpc.NodeRating {
+	if x != nil {
+		return x.NodeRating
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetLsatTokens() uint32 {
+	if x != nil {
+		return x.LsatTokens
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetSubscribedToAuctioneer() bool {
+	if x != nil {
+		return x.SubscribedToAuctioneer
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetNewNodesOnly() bool {
+	if x != nil {
+		return x.NewNodesOnly
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetMarketInfo() map[uint32]*auctioneerrpc.MarketInfo {
+	if x != nil {
+		return x.MarketInfo
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetHealthGate() *HealthGate {
+	if x != nil {
+		return x.HealthGate
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetAuctioneerConnectionState() AuctioneerConnectionState {
+	if x != nil {
+		return x.AuctioneerConnectionState
+	}
+	return AuctioneerConnectionState_AUCTIONEER_DISCONNECTED
+}
+
+func (x *GetInfoResponse) GetAuctioneerEndpoint() string {
+	if x != nil {
+		return x.AuctioneerEndpoint
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetCurrentLsatToken() *LsatTokenInfo {
+	if x != nil {
+		return x.CurrentLsatToken
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetAccountsByState() map[string]uint32 {
+	if x != nil {
+		return x.AccountsByState
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetOrdersByState() map[string]uint32 {
+	if x != nil {
+		return x.OrdersByState
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetPendingBatchId() []byte {
+	if x != nil {
+		return x.PendingBatchId
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetLndAlias() string {
+	if x != nil {
+		return x.LndAlias
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetLndPubkey() []byte {
+	if x != nil {
+		return x.LndPubkey
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetAuctioneerVersion() string {
+	if x != nil {
+		return x.AuctioneerVersion
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetBatchVersion() uint32 {
+	if x != nil {
+		return x.BatchVersion
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAuctioneerBatchVersion() uint32 {
+	if x != nil {
+		return x.AuctioneerBatchVersion
+	}
+	return 0
+}
+
+type SetAuctioneerEndpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The address of the endpoint to use, as configured with
+	//--auctioneer.endpoint or --auctionserver. If empty, the endpoints are
+	//selected automatically again.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *SetAuctioneerEndpointRequest) Reset() {
+	*x = SetAuctioneerEndpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAuctioneerEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAuctioneerEndpointRequest) ProtoMessage() {}
+
+func (x *SetAuctioneerEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAuctioneerEndpointRequest.ProtoReflect.Descriptor instead.
+func (*SetAuctioneerEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SetAuctioneerEndpointRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type MacaroonPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entity the permission grants access to, for example account.
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// The action that is allowed on the entity, for example read.
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *MacaroonPermission) Reset() {
+	*x = MacaroonPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MacaroonPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MacaroonPermission) ProtoMessage() {}
+
+func (x *MacaroonPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MacaroonPermission.ProtoReflect.Descriptor instead.
+func (*MacaroonPermission) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *MacaroonPermission) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *MacaroonPermission) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type BakeMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The permissions the new macaroon grants. At least one is required.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	//
+	//The ID of the root key the macaroon is derived from. Macaroons of different
+	//root keys can be revoked independently.
+	RootKeyId uint64 `protobuf:"varint,2,opt,name=root_key_id,json=rootKeyId,proto3" json:"root_key_id,omitempty"`
+	//
+	//If set, the macaroon is only valid if it is used from the given IP
+	//address.
+	IpAddress string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// If set, the macaroon expires after the given number of seconds.
+	TimeoutSeconds uint64 `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *BakeMacaroonRequest) Reset() {
+	*x = BakeMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeMacaroonRequest) ProtoMessage() {}
+
+func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if x != nil {
+		return x.RootKeyId
+	}
+	return 0
+}
+
+func (x *BakeMacaroonRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *BakeMacaroonRequest) GetTimeoutSeconds() uint64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type BakeMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hex encoded serialized macaroon.
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+}
+
+func (x *BakeMacaroonResponse) Reset() {
+	*x = BakeMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BakeMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BakeMacaroonResponse) ProtoMessage() {}
+
+func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BakeMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *BakeMacaroonResponse) GetMacaroon() string {
+	if x != nil {
+		return x.Macaroon
+	}
+	return ""
+}
+
+type DumpConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DumpConfigRequest) Reset() {
+	*x = DumpConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpConfigRequest) ProtoMessage() {}
+
+func (x *DumpConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpConfigRequest.ProtoReflect.Descriptor instead.
+func (*DumpConfigRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{129}
+}
+
+type DumpConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The effective value of every option in the format of the config file, with
+	//one section per option group.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// The path of the config file that was read, if any.
+	ConfigFile string `protobuf:"bytes,2,opt,name=config_file,json=configFile,proto3" json:"config_file,omitempty"`
+	// The warnings about unknown sections and options in the config file.
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *DumpConfigResponse) Reset() {
+	*x = DumpConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpConfigResponse) ProtoMessage() {}
+
+func (x *DumpConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpConfigResponse.ProtoReflect.Descriptor instead.
+func (*DumpConfigResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *DumpConfigResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *DumpConfigResponse) GetConfigFile() string {
+	if x != nil {
+		return x.ConfigFile
+	}
+	return ""
+}
+
+func (x *DumpConfigResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type SetAuctioneerEndpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The address of the auction server endpoint that is currently used.
+	AuctioneerEndpoint string `protobuf:"bytes,1,opt,name=auctioneer_endpoint,json=auctioneerEndpoint,proto3" json:"auctioneer_endpoint,omitempty"`
+}
+
+func (x *SetAuctioneerEndpointResponse) Reset() {
+	*x = SetAuctioneerEndpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAuctioneerEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAuctioneerEndpointResponse) ProtoMessage() {}
+
+func (x *SetAuctioneerEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAuctioneerEndpointResponse.ProtoReflect.Descriptor instead.
+func (*SetAuctioneerEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SetAuctioneerEndpointResponse) GetAuctioneerEndpoint() string {
+	if x != nil {
+		return x.AuctioneerEndpoint
+	}
+	return ""
+}
+
+type SubscribeServerStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeServerStateRequest) Reset() {
+	*x = SubscribeServerStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeServerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeServerStateRequest) ProtoMessage() {}
+
+func (x *SubscribeServerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeServerStateRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeServerStateRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{132}
+}
+
+type ServerStateUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new state of the connection to the auction server.
+	State AuctioneerConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=poolrpc.AuctioneerConnectionState" json:"state,omitempty"`
+	// The unix timestamp in nanoseconds the state changed at.
+	TimestampNs int64 `protobuf:"varint,2,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	// The error that caused the state change, if any.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	//
+	//Whether this is the current state that is sent when subscribing, not an
+	//actual state change.
+	Initial bool `protobuf:"varint,4,opt,name=initial,proto3" json:"initial,omitempty"`
+}
+
+func (x *ServerStateUpdate) Reset() {
+	*x = ServerStateUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStateUpdate) ProtoMessage() {}
+
+func (x *ServerStateUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStateUpdate.ProtoReflect.Descriptor instead.
+func (*ServerStateUpdate) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ServerStateUpdate) GetState() AuctioneerConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return AuctioneerConnectionState_AUCTIONEER_DISCONNECTED
+}
+
+func (x *ServerStateUpdate) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *ServerStateUpdate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ServerStateUpdate) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+type HealthGate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current state of the health gate.
+	State HealthGateState `protobuf:"varint,1,opt,name=state,proto3,enum=poolrpc.HealthGateState" json:"state,omitempty"`
+	// The reason the lnd node is considered unhealthy, if it is.
+	UnhealthyReason string `protobuf:"bytes,2,opt,name=unhealthy_reason,json=unhealthyReason,proto3" json:"unhealthy_reason,omitempty"`
+	//
+	//The unix timestamp in seconds at which the lnd node was first detected to
+	//be unhealthy. Zero if the node is healthy.
+	UnhealthySince int64 `protobuf:"varint,3,opt,name=unhealthy_since,json=unhealthySince,proto3" json:"unhealthy_since,omitempty"`
+	//
+	//Indicates whether all open orders are canceled once the health gate
+	//closes.
+	CancelOrders bool `protobuf:"varint,4,opt,name=cancel_orders,json=cancelOrders,proto3" json:"cancel_orders,omitempty"`
+}
+
+func (x *HealthGate) Reset() {
+	*x = HealthGate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthGate) ProtoMessage() {}
+
+func (x *HealthGate) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthGate.ProtoReflect.Descriptor instead.
+func (*HealthGate) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *HealthGate) GetState() HealthGateState {
+	if x != nil {
+		return x.State
+	}
+	return HealthGateState_HEALTH_GATE_DISABLED
+}
+
+func (x *HealthGate) GetUnhealthyReason() string {
+	if x != nil {
+		return x.UnhealthyReason
+	}
+	return ""
+}
+
+func (x *HealthGate) GetUnhealthySince() int64 {
+	if x != nil {
+		return x.UnhealthySince
+	}
+	return 0
+}
+
+func (x *HealthGate) GetCancelOrders() bool {
+	if x != nil {
+		return x.CancelOrders
+	}
+	return false
+}
+
+type StopDaemonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopDaemonRequest) Reset() {
+	*x = StopDaemonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopDaemonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopDaemonRequest) ProtoMessage() {}
+
+func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopDaemonRequest.ProtoReflect.Descriptor instead.
+func (*StopDaemonRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{135}
+}
+
+type StopDaemonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopDaemonResponse) Reset() {
+	*x = StopDaemonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopDaemonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopDaemonResponse) ProtoMessage() {}
+
+func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopDaemonResponse.ProtoReflect.Descriptor instead.
+func (*StopDaemonResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{136}
+}
+
+type OfferSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If false, then only the trader_key, unit, self_chan_balance, and
+	//lease_duration_blocks need to be set in the bid below. Otherwise, the
+	//fields as they're set when submitting a bid need to be filled in.
+	AutoNegotiate bool `protobuf:"varint,1,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+	//
+	//The bid template that will be used to populate the initial sidecar ticket
+	//as well as auto negotiate the remainig steps of the sidecar channel if
+	//needed.
+	Bid *Bid `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid,omitempty"`
+	//
+	//The transport used to automatically negotiate the ticket with the
+	//recipient. If not set, the transport configured with --sidecartransport
+	//is used. Only used if auto_negotiate is true.
+	Transport SidecarTransport `protobuf:"varint,3,opt,name=transport,proto3,enum=poolrpc.SidecarTransport" json:"transport,omitempty"`
+}
+
+func (x *OfferSidecarRequest) Reset() {
+	*x = OfferSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferSidecarRequest) ProtoMessage() {}
+
+func (x *OfferSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferSidecarRequest.ProtoReflect.Descriptor instead.
+func (*OfferSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *OfferSidecarRequest) GetAutoNegotiate() bool {
+	if x != nil {
+		return x.AutoNegotiate
+	}
+	return false
+}
+
+func (x *OfferSidecarRequest) GetBid() *Bid {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *OfferSidecarRequest) GetTransport() SidecarTransport {
+	if x != nil {
+		return x.Transport
+	}
+	return SidecarTransport_SIDECAR_TRANSPORT_DEFAULT
+}
+
+type OfferSidecarBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of tickets to create.
+	NumTickets uint32 `protobuf:"varint,1,opt,name=num_tickets,json=numTickets,proto3" json:"num_tickets,omitempty"`
+	//
+	//The offer all tickets are created with. The bid must not contain an order
+	//nonce as each ticket's bid needs its own nonce.
+	Offer *OfferSidecarRequest `protobuf:"bytes,2,opt,name=offer,proto3" json:"offer,omitempty"`
+}
+
+func (x *OfferSidecarBatchRequest) Reset() {
+	*x = OfferSidecarBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferSidecarBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferSidecarBatchRequest) ProtoMessage() {}
+
+func (x *OfferSidecarBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferSidecarBatchRequest.ProtoReflect.Descriptor instead.
+func (*OfferSidecarBatchRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *OfferSidecarBatchRequest) GetNumTickets() uint32 {
+	if x != nil {
+		return x.NumTickets
+	}
+	return 0
+}
+
+func (x *OfferSidecarBatchRequest) GetOffer() *OfferSidecarRequest {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type OfferSidecarBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The created tickets, each in its string encoded form.
+	Tickets []*SidecarTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *OfferSidecarBatchResponse) Reset() {
+	*x = OfferSidecarBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferSidecarBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferSidecarBatchResponse) ProtoMessage() {}
+
+func (x *OfferSidecarBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferSidecarBatchResponse.ProtoReflect.Descriptor instead.
+func (*OfferSidecarBatchResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *OfferSidecarBatchResponse) GetTickets() []*SidecarTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type SidecarTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The complete sidecar ticket in its string encoded form which is base58
+	//encoded, has a human readable prefix ('sidecar...') and a checksum built in.
+	//The string encoded version will only be used on the trader side of the API.
+	//All requests to the auctioneer expect the ticket to be in its raw, tlv
+	//encoded byte form.
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *SidecarTicket) Reset() {
+	*x = SidecarTicket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidecarTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarTicket) ProtoMessage() {}
+
+func (x *SidecarTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarTicket.ProtoReflect.Descriptor instead.
+func (*SidecarTicket) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *SidecarTicket) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type DecodedSidecarTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique, pseudorandom identifier of the ticket.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The version of the ticket encoding format.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The state of the ticket.
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// The offered channel capacity in satoshis.
+	OfferCapacity uint64 `protobuf:"varint,4,opt,name=offer_capacity,json=offerCapacity,proto3" json:"offer_capacity,omitempty"`
+	// The offered push amount in satoshis.
+	OfferPushAmount uint64 `protobuf:"varint,5,opt,name=offer_push_amount,json=offerPushAmount,proto3" json:"offer_push_amount,omitempty"`
+	// The offered lease duration in blocks.
+	OfferLeaseDurationBlocks uint32 `protobuf:"varint,6,opt,name=offer_lease_duration_blocks,json=offerLeaseDurationBlocks,proto3" json:"offer_lease_duration_blocks,omitempty"`
+	// The public key the offer was signed with.
+	OfferSignPubkey []byte `protobuf:"bytes,7,opt,name=offer_sign_pubkey,json=offerSignPubkey,proto3" json:"offer_sign_pubkey,omitempty"`
+	// The signature over the offer's digest.
+	OfferSignature []byte `protobuf:"bytes,8,opt,name=offer_signature,json=offerSignature,proto3" json:"offer_signature,omitempty"`
+	// Whether the offer was created with the automatic order creation flag.
+	OfferAuto bool `protobuf:"varint,9,opt,name=offer_auto,json=offerAuto,proto3" json:"offer_auto,omitempty"`
+	// The recipient node's public identity key.
+	RecipientNodePubkey []byte `protobuf:"bytes,10,opt,name=recipient_node_pubkey,json=recipientNodePubkey,proto3" json:"recipient_node_pubkey,omitempty"`
+	//
+	//The recipient node's channel multisig public key to be used for the sidecar
+	//channel.
+	RecipientMultisigPubkey []byte `protobuf:"bytes,11,opt,name=recipient_multisig_pubkey,json=recipientMultisigPubkey,proto3" json:"recipient_multisig_pubkey,omitempty"`
+	// The index used when deriving the above multisig pubkey.
+	RecipientMultisigPubkeyIndex uint32 `protobuf:"varint,12,opt,name=recipient_multisig_pubkey_index,json=recipientMultisigPubkeyIndex,proto3" json:"recipient_multisig_pubkey_index,omitempty"`
+	// The nonce of the bid order created for this sidecar ticket.
+	OrderBidNonce []byte `protobuf:"bytes,13,opt,name=order_bid_nonce,json=orderBidNonce,proto3" json:"order_bid_nonce,omitempty"`
+	//
+	//The signature over the order's digest, signed with the private key that
+	//corresponds to the offer_sign_pubkey.
+	OrderSignature []byte `protobuf:"bytes,14,opt,name=order_signature,json=orderSignature,proto3" json:"order_signature,omitempty"`
+	// The pending channel ID of the sidecar channel during the execution phase.
+	ExecutionPendingChannelId []byte `protobuf:"bytes,15,opt,name=execution_pending_channel_id,json=executionPendingChannelId,proto3" json:"execution_pending_channel_id,omitempty"`
+	// The original, base58 encoded ticket.
+	EncodedTicket string `protobuf:"bytes,16,opt,name=encoded_ticket,json=encodedTicket,proto3" json:"encoded_ticket,omitempty"`
+	//
+	//The unix timestamp in seconds after which the ticket can no longer be
+	//registered and is canceled by the provider. Zero if the ticket doesn't
+	//expire.
+	ExpiryTimestamp uint64 `protobuf:"varint,17,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+	//
+	//The unix timestamp in nanoseconds the ticket was added to the local
+	//database. Zero for tickets that were added before creation times were
+	//recorded.
+	CreationTimestampNs uint64 `protobuf:"varint,18,opt,name=creation_timestamp_ns,json=creationTimestampNs,proto3" json:"creation_timestamp_ns,omitempty"`
+}
+
+func (x *DecodedSidecarTicket) Reset() {
+	*x = DecodedSidecarTicket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodedSidecarTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedSidecarTicket) ProtoMessage() {}
+
+func (x *DecodedSidecarTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedSidecarTicket.ProtoReflect.Descriptor instead.
+func (*DecodedSidecarTicket) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *DecodedSidecarTicket) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DecodedSidecarTicket) GetOfferCapacity() uint64 {
+	if x != nil {
+		return x.OfferCapacity
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOfferPushAmount() uint64 {
+	if x != nil {
+		return x.OfferPushAmount
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOfferLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.OfferLeaseDurationBlocks
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOfferSignPubkey() []byte {
+	if x != nil {
+		return x.OfferSignPubkey
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetOfferSignature() []byte {
+	if x != nil {
+		return x.OfferSignature
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetOfferAuto() bool {
+	if x != nil {
+		return x.OfferAuto
+	}
+	return false
+}
+
+func (x *DecodedSidecarTicket) GetRecipientNodePubkey() []byte {
+	if x != nil {
+		return x.RecipientNodePubkey
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetRecipientMultisigPubkey() []byte {
+	if x != nil {
+		return x.RecipientMultisigPubkey
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetRecipientMultisigPubkeyIndex() uint32 {
+	if x != nil {
+		return x.RecipientMultisigPubkeyIndex
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetOrderBidNonce() []byte {
+	if x != nil {
+		return x.OrderBidNonce
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetOrderSignature() []byte {
+	if x != nil {
+		return x.OrderSignature
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetExecutionPendingChannelId() []byte {
+	if x != nil {
+		return x.ExecutionPendingChannelId
+	}
+	return nil
+}
+
+func (x *DecodedSidecarTicket) GetEncodedTicket() string {
+	if x != nil {
+		return x.EncodedTicket
+	}
+	return ""
+}
+
+func (x *DecodedSidecarTicket) GetExpiryTimestamp() uint64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (x *DecodedSidecarTicket) GetCreationTimestampNs() uint64 {
+	if x != nil {
+		return x.CreationTimestampNs
+	}
+	return 0
+}
+
+type RegisterSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The sidecar ticket to register and add the node and channel funding
+	//information to. The ticket must be in the state "offered".
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	//
+	//If this value is True, then the daemon will attempt to finish negotiating
+	//the details of the sidecar channel automatically in the background. The
+	//progress of the ticket can be monitored using the SidecarState RPC. In
+	//addition, if this flag is set, then this method will _block_ until the
+	//sidecar negotiation either finishes or breaks down.
+	AutoNegotiate bool `protobuf:"varint,2,opt,name=auto_negotiate,json=autoNegotiate,proto3" json:"auto_negotiate,omitempty"`
+	//
+	//The transport used to automatically negotiate the ticket with the
+	//provider. If not set, the transport configured with --sidecartransport
+	//is used.
+	Transport SidecarTransport `protobuf:"varint,3,opt,name=transport,proto3,enum=poolrpc.SidecarTransport" json:"transport,omitempty"`
+	//
+	//The identity pubkey of the provider's node. This is required for the
+	//peer transport as the ticket itself doesn't identify the provider's node.
+	ProviderNode []byte `protobuf:"bytes,4,opt,name=provider_node,json=providerNode,proto3" json:"provider_node,omitempty"`
+}
+
+func (x *RegisterSidecarRequest) Reset() {
+	*x = RegisterSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSidecarRequest) ProtoMessage() {}
+
+func (x *RegisterSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSidecarRequest.ProtoReflect.Descriptor instead.
+func (*RegisterSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *RegisterSidecarRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *RegisterSidecarRequest) GetAutoNegotiate() bool {
+	if x != nil {
+		return x.AutoNegotiate
+	}
+	return false
+}
+
+func (x *RegisterSidecarRequest) GetTransport() SidecarTransport {
+	if x != nil {
+		return x.Transport
+	}
+	return SidecarTransport_SIDECAR_TRANSPORT_DEFAULT
+}
+
+func (x *RegisterSidecarRequest) GetProviderNode() []byte {
+	if x != nil {
+		return x.ProviderNode
+	}
+	return nil
+}
+
+type ExpectSidecarChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The sidecar ticket to expect an incoming channel for. The ticket must be in
+	//the state "ordered".
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *ExpectSidecarChannelRequest) Reset() {
+	*x = ExpectSidecarChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectSidecarChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectSidecarChannelRequest) ProtoMessage() {}
+
+func (x *ExpectSidecarChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectSidecarChannelRequest.ProtoReflect.Descriptor instead.
+func (*ExpectSidecarChannelRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ExpectSidecarChannelRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type ExpectSidecarChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExpectSidecarChannelResponse) Reset() {
+	*x = ExpectSidecarChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectSidecarChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectSidecarChannelResponse) ProtoMessage() {}
+
+func (x *ExpectSidecarChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectSidecarChannelResponse.ProtoReflect.Descriptor instead.
+func (*ExpectSidecarChannelResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{144}
+}
+
+type ListSidecarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The optional sidecar ID to filter for. If set, the result should either be
+	//a single ticket or no ticket in most cases. But because the ID is just 8
+	//bytes and is randomly generated, there could be collisions, especially since
+	//tickets can also be crafted by a malicious party and given to any node.
+	//That's why the offer's public key is also used as an identifying element
+	//since that cannot easily be forged without also producing a valid signature.
+	//So an attacker cannot overwrite a ticket a node offered by themselves
+	//offering a ticket with the same ID and tricking the victim into registering
+	//that. Long story sort, there could be multiple tickets with the same ID but
+	//different offer public keys, which is why those keys should be checked as
+	//well.
+	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	//
+	//The optional ticket state to filter for, using the same names as the state
+	//field of a decoded ticket (for example "offered" or "completed"). If empty,
+	//tickets in all states, including archived ones, are returned.
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	//
+	//If set, only tickets that were added to the local database at or after
+	//this unix timestamp in nanoseconds are returned.
+	CreatedAfterTimestampNs int64 `protobuf:"varint,3,opt,name=created_after_timestamp_ns,json=createdAfterTimestampNs,proto3" json:"created_after_timestamp_ns,omitempty"`
+	//
+	//If set, only tickets that were added to the local database before this
+	//unix timestamp in nanoseconds are returned.
+	CreatedBeforeTimestampNs int64 `protobuf:"varint,4,opt,name=created_before_timestamp_ns,json=createdBeforeTimestampNs,proto3" json:"created_before_timestamp_ns,omitempty"`
+}
+
+func (x *ListSidecarsRequest) Reset() {
+	*x = ListSidecarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSidecarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSidecarsRequest) ProtoMessage() {}
+
+func (x *ListSidecarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSidecarsRequest.ProtoReflect.Descriptor instead.
+func (*ListSidecarsRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListSidecarsRequest) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
+	}
+	return nil
+}
+
+func (x *ListSidecarsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListSidecarsRequest) GetCreatedAfterTimestampNs() int64 {
+	if x != nil {
+		return x.CreatedAfterTimestampNs
+	}
+	return 0
+}
+
+func (x *ListSidecarsRequest) GetCreatedBeforeTimestampNs() int64 {
+	if x != nil {
+		return x.CreatedBeforeTimestampNs
+	}
+	return 0
+}
+
+type ListSidecarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []*DecodedSidecarTicket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *ListSidecarsResponse) Reset() {
+	*x = ListSidecarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSidecarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSidecarsResponse) ProtoMessage() {}
+
+func (x *ListSidecarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSidecarsResponse.ProtoReflect.Descriptor instead.
+func (*ListSidecarsResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListSidecarsResponse) GetTickets() []*DecodedSidecarTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type CancelSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+}
+
+func (x *CancelSidecarRequest) Reset() {
+	*x = CancelSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSidecarRequest) ProtoMessage() {}
+
+func (x *CancelSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSidecarRequest.ProtoReflect.Descriptor instead.
+func (*CancelSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *CancelSidecarRequest) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
+	}
+	return nil
+}
+
+type CancelSidecarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelSidecarResponse) Reset() {
+	*x = CancelSidecarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSidecarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSidecarResponse) ProtoMessage() {}
+
+func (x *CancelSidecarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSidecarResponse.ProtoReflect.Descriptor instead.
+func (*CancelSidecarResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{148}
+}
+
+type SubscribeSidecarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The ID of the sidecar ticket to subscribe to. If multiple tickets with the
+	//same ID but different offer public keys exist, updates of all of them are
+	//streamed.
+	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	//
+	//If set, all recorded state changes of the ticket are sent before its
+	//current state.
+	IncludeHistory bool `protobuf:"varint,2,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+}
+
+func (x *SubscribeSidecarRequest) Reset() {
+	*x = SubscribeSidecarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSidecarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSidecarRequest) ProtoMessage() {}
+
+func (x *SubscribeSidecarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSidecarRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSidecarRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SubscribeSidecarRequest) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
+	}
+	return nil
+}
+
+func (x *SubscribeSidecarRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type SidecarUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the sidecar ticket that was updated.
+	SidecarId []byte `protobuf:"bytes,1,opt,name=sidecar_id,json=sidecarId,proto3" json:"sidecar_id,omitempty"`
+	// The public key the ticket's offer was signed with.
+	OfferSignPubkey []byte `protobuf:"bytes,2,opt,name=offer_sign_pubkey,json=offerSignPubkey,proto3" json:"offer_sign_pubkey,omitempty"`
+	//
+	//The state of the ticket before the update. For the initial update and the
+	//first recorded event of a ticket this equals the new state.
+	PrevState string `protobuf:"bytes,3,opt,name=prev_state,json=prevState,proto3" json:"prev_state,omitempty"`
+	// The state of the ticket after the update.
+	NewState string `protobuf:"bytes,4,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	//
+	//The reason of the state change, if one is known. This is mostly set for
+	//canceled tickets.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The unix timestamp in nanoseconds the state change was recorded at.
+	TimestampNs int64 `protobuf:"varint,6,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	//
+	//Whether the update describes the current state of the ticket at the time
+	//of subscribing rather than an actual state change.
+	Initial bool `protobuf:"varint,7,opt,name=initial,proto3" json:"initial,omitempty"`
+	// Whether the update is part of the recorded history of the ticket.
+	Historic bool `protobuf:"varint,8,opt,name=historic,proto3" json:"historic,omitempty"`
+}
+
+func (x *SidecarUpdate) Reset() {
+	*x = SidecarUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidecarUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarUpdate) ProtoMessage() {}
+
+func (x *SidecarUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarUpdate.ProtoReflect.Descriptor instead.
+func (*SidecarUpdate) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SidecarUpdate) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
+	}
+	return nil
+}
+
+func (x *SidecarUpdate) GetOfferSignPubkey() []byte {
+	if x != nil {
+		return x.OfferSignPubkey
+	}
+	return nil
+}
+
+func (x *SidecarUpdate) GetPrevState() string {
+	if x != nil {
+		return x.PrevState
+	}
+	return ""
+}
+
+func (x *SidecarUpdate) GetNewState() string {
+	if x != nil {
+		return x.NewState
+	}
+	return ""
+}
+
+func (x *SidecarUpdate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SidecarUpdate) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *SidecarUpdate) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+func (x *SidecarUpdate) GetHistoric() bool {
+	if x != nil {
+		return x.Historic
+	}
+	return false
+}
+
+type VerifyDBRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyDBRequest) Reset() {
+	*x = VerifyDBRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyDBRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDBRequest) ProtoMessage() {}
+
+func (x *VerifyDBRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDBRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDBRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{151}
+}
+
+type CorruptedRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The bucket the corrupted record was found in. Nested buckets are separated
+	//by slashes, keys that are not printable are hex encoded.
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// The key of the corrupted record within its bucket.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CorruptedRecord) Reset() {
+	*x = CorruptedRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorruptedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorruptedRecord) ProtoMessage() {}
+
+func (x *CorruptedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorruptedRecord.ProtoReflect.Descriptor instead.
+func (*CorruptedRecord) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CorruptedRecord) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CorruptedRecord) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type VerifyDBResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The list of all corrupted records that were found.
+	CorruptedRecords []*CorruptedRecord `protobuf:"bytes,1,rep,name=corrupted_records,json=corruptedRecords,proto3" json:"corrupted_records,omitempty"`
+}
+
+func (x *VerifyDBResponse) Reset() {
+	*x = VerifyDBResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyDBResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDBResponse) ProtoMessage() {}
+
+func (x *VerifyDBResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDBResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDBResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *VerifyDBResponse) GetCorruptedRecords() []*CorruptedRecord {
+	if x != nil {
+		return x.CorruptedRecords
+	}
+	return nil
+}
+
+type BatchPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The maximum total chain fee in satoshis all of the trader's accounts may
+	//pay for a single batch. A value of 0 disables the rule.
+	MaxChainFeeSat uint64 `protobuf:"varint,1,opt,name=max_chain_fee_sat,json=maxChainFeeSat,proto3" json:"max_chain_fee_sat,omitempty"`
+	//
+	//The maximum deviation of the clearing price from the rate of any of the
+	//trader's matched orders, in basis points of the order's rate. A value of 0
+	//disables the rule.
+	MaxPriceDeviationBps uint32 `protobuf:"varint,2,opt,name=max_price_deviation_bps,json=maxPriceDeviationBps,proto3" json:"max_price_deviation_bps,omitempty"`
+	//
+	//The maximum number of the trader's orders that may be matched in a single
+	//batch. A value of 0 disables the rule.
+	MaxMatchedOrders uint32 `protobuf:"varint,3,opt,name=max_matched_orders,json=maxMatchedOrders,proto3" json:"max_matched_orders,omitempty"`
+}
+
+func (x *BatchPolicy) Reset() {
+	*x = BatchPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPolicy) ProtoMessage() {}
+
+func (x *BatchPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPolicy.ProtoReflect.Descriptor instead.
+func (*BatchPolicy) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *BatchPolicy) GetMaxChainFeeSat() uint64 {
+	if x != nil {
+		return x.MaxChainFeeSat
+	}
+	return 0
+}
+
+func (x *BatchPolicy) GetMaxPriceDeviationBps() uint32 {
+	if x != nil {
+		return x.MaxPriceDeviationBps
+	}
+	return 0
+}
+
+func (x *BatchPolicy) GetMaxMatchedOrders() uint32 {
+	if x != nil {
+		return x.MaxMatchedOrders
+	}
+	return 0
+}
+
+type SetBatchPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The new batch policy. Rules that are not set are disabled, so an empty
+	//policy accepts all batches.
+	Policy *BatchPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetBatchPolicyRequest) Reset() {
+	*x = SetBatchPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBatchPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBatchPolicyRequest) ProtoMessage() {}
+
+func (x *SetBatchPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBatchPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetBatchPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SetBatchPolicyRequest) GetPolicy() *BatchPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetBatchPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch policy that is now enforced.
+	Policy *BatchPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetBatchPolicyResponse) Reset() {
+	*x = SetBatchPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBatchPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBatchPolicyResponse) ProtoMessage() {}
+
+func (x *SetBatchPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBatchPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetBatchPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SetBatchPolicyResponse) GetPolicy() *BatchPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type GetBatchPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBatchPolicyRequest) Reset() {
+	*x = GetBatchPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchPolicyRequest) ProtoMessage() {}
+
+func (x *GetBatchPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{157}
+}
+
+type ListFundingFailuresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFundingFailuresRequest) Reset() {
+	*x = ListFundingFailuresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFundingFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFundingFailuresRequest) ProtoMessage() {}
+
+func (x *ListFundingFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFundingFailuresRequest.ProtoReflect.Descriptor instead.
+func (*ListFundingFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{158}
+}
+
+type ListFundingFailuresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channels whose funding flow could not be resumed.
+	Failures []*FundingFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *ListFundingFailuresResponse) Reset() {
+	*x = ListFundingFailuresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFundingFailuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFundingFailuresResponse) ProtoMessage() {}
+
+func (x *ListFundingFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFundingFailuresResponse.ProtoReflect.Descriptor instead.
+func (*ListFundingFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ListFundingFailuresResponse) GetFailures() []*FundingFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type FundingFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending channel ID of the channel.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	// The unique identifier of the batch the channel was part of.
+	BatchId []byte `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The nonce of our order that was matched.
+	OrderNonce []byte `protobuf:"bytes,3,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// The nonce of the order ours was matched with.
+	MatchedOrderNonce []byte `protobuf:"bytes,4,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
+	// The node key of the channel counterparty.
+	NodeKey []byte `protobuf:"bytes,5,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The expected channel point of the channel, formatted as txid:index.
+	ChannelPoint string `protobuf:"bytes,6,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// Whether we opened the channel or expected the counterparty to open it.
+	Initiator bool `protobuf:"varint,7,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// The reason the funding flow could not be resumed.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The unix timestamp in seconds at which the failure was detected.
+	FailedAt int64 `protobuf:"varint,9,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+}
+
+func (x *FundingFailure) Reset() {
+	*x = FundingFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundingFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingFailure) ProtoMessage() {}
+
+func (x *FundingFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingFailure.ProtoReflect.Descriptor instead.
+func (*FundingFailure) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *FundingFailure) GetPendingChanId() []byte {
+	if x != nil {
+		return x.PendingChanId
+	}
+	return nil
+}
+
+func (x *FundingFailure) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *FundingFailure) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *FundingFailure) GetMatchedOrderNonce() []byte {
+	if x != nil {
+		return x.MatchedOrderNonce
+	}
+	return nil
+}
+
+func (x *FundingFailure) GetNodeKey() []byte {
+	if x != nil {
+		return x.NodeKey
+	}
+	return nil
+}
+
+func (x *FundingFailure) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *FundingFailure) GetInitiator() bool {
+	if x != nil {
+		return x.Initiator
+	}
+	return false
+}
+
+func (x *FundingFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FundingFailure) GetFailedAt() int64 {
+	if x != nil {
+		return x.FailedAt
+	}
+	return 0
+}
+
+type LeaseAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return the audits of channels whose lease doesn't match their
+	// batch.
+	MismatchesOnly bool `protobuf:"varint,1,opt,name=mismatches_only,json=mismatchesOnly,proto3" json:"mismatches_only,omitempty"`
+}
+
+func (x *LeaseAuditRequest) Reset() {
+	*x = LeaseAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseAuditRequest) ProtoMessage() {}
+
+func (x *LeaseAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseAuditRequest.ProtoReflect.Descriptor instead.
+func (*LeaseAuditRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *LeaseAuditRequest) GetMismatchesOnly() bool {
+	if x != nil {
+		return x.MismatchesOnly
+	}
+	return false
+}
+
+type LeaseAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lease audits in chronological order.
+	Audits []*LeaseAuditResult `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits,omitempty"`
+}
+
+func (x *LeaseAuditResponse) Reset() {
+	*x = LeaseAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseAuditResponse) ProtoMessage() {}
+
+func (x *LeaseAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseAuditResponse.ProtoReflect.Descriptor instead.
+func (*LeaseAuditResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *LeaseAuditResponse) GetAudits() []*LeaseAuditResult {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+type LeaseAuditResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channel point of the audited channel, formatted as txid:index.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	// The unique identifier of the batch the channel was created in.
+	BatchId []byte `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The nonce of our order that resulted in the channel.
+	OrderNonce []byte `protobuf:"bytes,3,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// Whether the lease is enforced by the channel script.
+	ScriptEnforced bool `protobuf:"varint,4,opt,name=script_enforced,json=scriptEnforced,proto3" json:"script_enforced,omitempty"`
+	// The lease expiry height promised in the batch.
+	ExpectedExpiryHeight uint32 `protobuf:"varint,5,opt,name=expected_expiry_height,json=expectedExpiryHeight,proto3" json:"expected_expiry_height,omitempty"`
+	// The lease expiry height of the channel as reported by lnd.
+	ActualExpiryHeight uint32 `protobuf:"varint,6,opt,name=actual_expiry_height,json=actualExpiryHeight,proto3" json:"actual_expiry_height,omitempty"`
+	// Whether the lease of the channel doesn't match its batch.
+	Mismatch bool `protobuf:"varint,7,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+	// The reason the lease doesn't match, empty if it does.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The unix timestamp in nanoseconds at which the audit was done.
+	TimestampNs int64 `protobuf:"varint,9,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+}
+
+func (x *LeaseAuditResult) Reset() {
+	*x = LeaseAuditResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseAuditResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseAuditResult) ProtoMessage() {}
+
+func (x *LeaseAuditResult) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseAuditResult.ProtoReflect.Descriptor instead.
+func (*LeaseAuditResult) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *LeaseAuditResult) GetChannelPoint() string {
+	if x != nil {
+		return x.ChannelPoint
+	}
+	return ""
+}
+
+func (x *LeaseAuditResult) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *LeaseAuditResult) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *LeaseAuditResult) GetScriptEnforced() bool {
+	if x != nil {
+		return x.ScriptEnforced
+	}
+	return false
+}
+
+func (x *LeaseAuditResult) GetExpectedExpiryHeight() uint32 {
+	if x != nil {
+		return x.ExpectedExpiryHeight
+	}
+	return 0
+}
+
+func (x *LeaseAuditResult) GetActualExpiryHeight() uint32 {
+	if x != nil {
+		return x.ActualExpiryHeight
+	}
+	return 0
+}
+
+func (x *LeaseAuditResult) GetMismatch() bool {
+	if x != nil {
+		return x.Mismatch
+	}
+	return false
+}
+
+func (x *LeaseAuditResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LeaseAuditResult) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+type BatchApprovalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the batch.
+	BatchId []byte `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The version of the batch.
+	BatchVersion uint32 `protobuf:"varint,2,opt,name=batch_version,json=batchVersion,proto3" json:"batch_version,omitempty"`
+	// The fee rate, in satoshis per kiloweight, of the batch transaction.
+	BatchTxFeeRateSatPerKw uint64 `protobuf:"varint,3,opt,name=batch_tx_fee_rate_sat_per_kw,json=batchTxFeeRateSatPerKw,proto3" json:"batch_tx_fee_rate_sat_per_kw,omitempty"`
+	//
+	//Maps the distinct lease duration markets the trader's orders were matched
+	//in to the fixed rate clearing price of that market.
+	ClearingPrices map[uint32]uint32 `protobuf:"bytes,4,rep,name=clearing_prices,json=clearingPrices,proto3" json:"clearing_prices,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// All matches of the trader's orders within the batch.
+	Matches []*BatchApprovalMatch `protobuf:"bytes,5,rep,name=matches,proto3" json:"matches,omitempty"`
+	// The changes the batch makes to the trader's accounts.
+	Accounts []*BatchApprovalAccount `protobuf:"bytes,6,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *BatchApprovalRequest) Reset() {
+	*x = BatchApprovalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApprovalRequest) ProtoMessage() {}
+
+func (x *BatchApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApprovalRequest.ProtoReflect.Descriptor instead.
+func (*BatchApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *BatchApprovalRequest) GetBatchId() []byte {
+	if x != nil {
+		return x.BatchId
+	}
+	return nil
+}
+
+func (x *BatchApprovalRequest) GetBatchVersion() uint32 {
+	if x != nil {
+		return x.BatchVersion
+	}
+	return 0
+}
+
+func (x *BatchApprovalRequest) GetBatchTxFeeRateSatPerKw() uint64 {
+	if x != nil {
+		return x.BatchTxFeeRateSatPerKw
+	}
+	return 0
+}
+
+func (x *BatchApprovalRequest) GetClearingPrices() map[uint32]uint32 {
+	if x != nil {
+		return x.ClearingPrices
+	}
+	return nil
+}
+
+func (x *BatchApprovalRequest) GetMatches() []*BatchApprovalMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *BatchApprovalRequest) GetAccounts() []*BatchApprovalAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type BatchApprovalMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nonce of the trader's own order that was matched.
+	OrderNonce []byte `protobuf:"bytes,1,opt,name=order_nonce,json=orderNonce,proto3" json:"order_nonce,omitempty"`
+	// Whether the trader's order is an ask.
+	IsAsk bool `protobuf:"varint,2,opt,name=is_ask,json=isAsk,proto3" json:"is_ask,omitempty"`
+	// The nonce of the order the trader's order was matched with.
+	MatchedOrderNonce []byte `protobuf:"bytes,3,opt,name=matched_order_nonce,json=matchedOrderNonce,proto3" json:"matched_order_nonce,omitempty"`
+	// The node key of the counterparty of the match.
+	NodeKey []byte `protobuf:"bytes,4,opt,name=node_key,json=nodeKey,proto3" json:"node_key,omitempty"`
+	// The number of units that were filled by this match.
+	UnitsFilled uint32 `protobuf:"varint,5,opt,name=units_filled,json=unitsFilled,proto3" json:"units_filled,omitempty"`
+	// The lease duration of the channel, in blocks.
+	LeaseDurationBlocks uint32 `protobuf:"varint,6,opt,name=lease_duration_blocks,json=leaseDurationBlocks,proto3" json:"lease_duration_blocks,omitempty"`
+	//
+	//The premium in satoshis the trader pays for the channel if its order is a
+	//bid or receives if it is an ask.
+	PremiumSat uint64 `protobuf:"varint,7,opt,name=premium_sat,json=premiumSat,proto3" json:"premium_sat,omitempty"`
+	// The execution fee in satoshis the trader pays for the match.
+	ExecutionFeeSat uint64 `protobuf:"varint,8,opt,name=execution_fee_sat,json=executionFeeSat,proto3" json:"execution_fee_sat,omitempty"`
+}
+
+func (x *BatchApprovalMatch) Reset() {
+	*x = BatchApprovalMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchApprovalMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApprovalMatch) ProtoMessage() {}
+
+func (x *BatchApprovalMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApprovalMatch.ProtoReflect.Descriptor instead.
+func (*BatchApprovalMatch) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *BatchApprovalMatch) GetOrderNonce() []byte {
+	if x != nil {
+		return x.OrderNonce
+	}
+	return nil
+}
+
+func (x *BatchApprovalMatch) GetIsAsk() bool {
+	if x != nil {
+		return x.IsAsk
+	}
+	return false
+}
+
+func (x *BatchApprovalMatch) GetMatchedOrderNonce() []byte {
+	if x != nil {
+		return x.MatchedOrderNonce
+	}
+	return nil
+}
+
+func (x *BatchApprovalMatch) GetNodeKey() []byte {
+	if x != nil {
+		return x.NodeKey
+	}
+	return nil
+}
+
+func (x *BatchApprovalMatch) GetUnitsFilled() uint32 {
+	if x != nil {
+		return x.UnitsFilled
+	}
+	return 0
+}
+
+func (x *BatchApprovalMatch) GetLeaseDurationBlocks() uint32 {
+	if x != nil {
+		return x.LeaseDurationBlocks
+	}
+	return 0
+}
+
+func (x *BatchApprovalMatch) GetPremiumSat() uint64 {
+	if x != nil {
+		return x.PremiumSat
+	}
+	return 0
+}
+
+func (x *BatchApprovalMatch) GetExecutionFeeSat() uint64 {
+	if x != nil {
+		return x.ExecutionFeeSat
+	}
+	return 0
+}
+
+type BatchApprovalAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The trader key of the account.
+	TraderKey []byte `protobuf:"bytes,1,opt,name=trader_key,json=traderKey,proto3" json:"trader_key,omitempty"`
+	// The balance of the account before the batch, in satoshis.
+	StartingBalanceSat uint64 `protobuf:"varint,2,opt,name=starting_balance_sat,json=startingBalanceSat,proto3" json:"starting_balance_sat,omitempty"`
+	// The balance of the account after the batch, in satoshis.
+	EndingBalanceSat uint64 `protobuf:"varint,3,opt,name=ending_balance_sat,json=endingBalanceSat,proto3" json:"ending_balance_sat,omitempty"`
+	//
+	//The estimated chain fee in satoshis the account pays for the channels
+	//created in the batch.
+	ChainFeeSat uint64 `protobuf:"varint,4,opt,name=chain_fee_sat,json=chainFeeSat,proto3" json:"chain_fee_sat,omitempty"`
+	// The new expiry height of the account, if the batch changes it.
+	NewExpiry uint32 `protobuf:"varint,5,opt,name=new_expiry,json=newExpiry,proto3" json:"new_expiry,omitempty"`
+}
+
+func (x *BatchApprovalAccount) Reset() {
+	*x = BatchApprovalAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchApprovalAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApprovalAccount) ProtoMessage() {}
+
+func (x *BatchApprovalAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApprovalAccount.ProtoReflect.Descriptor instead.
+func (*BatchApprovalAccount) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *BatchApprovalAccount) GetTraderKey() []byte {
+	if x != nil {
+		return x.TraderKey
+	}
+	return nil
+}
+
+func (x *BatchApprovalAccount) GetStartingBalanceSat() uint64 {
+	if x != nil {
+		return x.StartingBalanceSat
+	}
+	return 0
+}
+
+func (x *BatchApprovalAccount) GetEndingBalanceSat() uint64 {
+	if x != nil {
+		return x.EndingBalanceSat
+	}
+	return 0
+}
+
+func (x *BatchApprovalAccount) GetChainFeeSat() uint64 {
+	if x != nil {
+		return x.ChainFeeSat
+	}
+	return 0
+}
+
+func (x *BatchApprovalAccount) GetNewExpiry() uint32 {
+	if x != nil {
+		return x.NewExpiry
+	}
+	return 0
+}
+
+type BatchApprovalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the batch is approved.
+	Approved bool `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	// An optional human readable reason for the decision.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BatchApprovalResponse) Reset() {
+	*x = BatchApprovalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApprovalResponse) ProtoMessage() {}
+
+func (x *BatchApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApprovalResponse.ProtoReflect.Descriptor instead.
+func (*BatchApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *BatchApprovalResponse) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *BatchApprovalResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DownstreamAcceptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The node key of the peer that wants to open the channel.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// The hash of the genesis block of the chain the channel is opened on.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,json=chainHash,proto3" json:"chain_hash,omitempty"`
+	// The pending channel ID of the channel.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,json=pendingChanId,proto3" json:"pending_chan_id,omitempty"`
+	// The total capacity of the channel in satoshis.
+	FundingAmtSat uint64 `protobuf:"varint,4,opt,name=funding_amt_sat,json=fundingAmtSat,proto3" json:"funding_amt_sat,omitempty"`
+	// The amount in millisatoshis the peer pushes to us.
+	PushAmtMsat uint64 `protobuf:"varint,5,opt,name=push_amt_msat,json=pushAmtMsat,proto3" json:"push_amt_msat,omitempty"`
+	// The dust limit of the peer's commitment transaction in satoshis.
+	DustLimitSat uint64 `protobuf:"varint,6,opt,name=dust_limit_sat,json=dustLimitSat,proto3" json:"dust_limit_sat,omitempty"`
+	//
+	//The maximum amount of funds in millisatoshis the peer allows us to have
+	//in outstanding HTLCs.
+	MaxValueInFlightMsat uint64 `protobuf:"varint,7,opt,name=max_value_in_flight_msat,json=maxValueInFlightMsat,proto3" json:"max_value_in_flight_msat,omitempty"`
+	// The reserve in satoshis the peer requires us to keep in the channel.
+	ChannelReserveSat uint64 `protobuf:"varint,8,opt,name=channel_reserve_sat,json=channelReserveSat,proto3" json:"channel_reserve_sat,omitempty"`
+	// The minimum HTLC value in millisatoshis the peer accepts.
+	MinHtlcMsat uint64 `protobuf:"varint,9,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	// The initial fee rate of the commitment transaction in sat/kw.
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw,json=feePerKw,proto3" json:"fee_per_kw,omitempty"`
+	// The number of blocks our outputs are delayed by in a force close.
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
+	// The maximum number of HTLCs the peer accepts from us.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs,json=maxAcceptedHtlcs,proto3" json:"max_accepted_htlcs,omitempty"`
+	// The channel flags of the open_channel message.
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags,json=channelFlags,proto3" json:"channel_flags,omitempty"`
+}
+
+func (x *DownstreamAcceptRequest) Reset() {
+	*x = DownstreamAcceptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownstreamAcceptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownstreamAcceptRequest) ProtoMessage() {}
+
+func (x *DownstreamAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownstreamAcceptRequest.ProtoReflect.Descriptor instead.
+func (*DownstreamAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *DownstreamAcceptRequest) GetNodePubkey() []byte {
+	if x != nil {
+		return x.NodePubkey
+	}
+	return nil
+}
+
+func (x *DownstreamAcceptRequest) GetChainHash() []byte {
+	if x != nil {
+		return x.ChainHash
+	}
+	return nil
+}
+
+func (x *DownstreamAcceptRequest) GetPendingChanId() []byte {
+	if x != nil {
+		return x.PendingChanId
+	}
+	return nil
+}
+
+func (x *DownstreamAcceptRequest) GetFundingAmtSat() uint64 {
+	if x != nil {
+		return x.FundingAmtSat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetPushAmtMsat() uint64 {
+	if x != nil {
+		return x.PushAmtMsat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetDustLimitSat() uint64 {
+	if x != nil {
+		return x.DustLimitSat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetMaxValueInFlightMsat() uint64 {
+	if x != nil {
+		return x.MaxValueInFlightMsat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetChannelReserveSat() uint64 {
+	if x != nil {
+		return x.ChannelReserveSat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetMinHtlcMsat() uint64 {
+	if x != nil {
+		return x.MinHtlcMsat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetFeePerKw() uint64 {
+	if x != nil {
+		return x.FeePerKw
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetCsvDelay() uint32 {
+	if x != nil {
+		return x.CsvDelay
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
+	if x != nil {
+		return x.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptRequest) GetChannelFlags() uint32 {
+	if x != nil {
+		return x.ChannelFlags
+	}
+	return 0
+}
+
+type DownstreamAcceptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the channel should be accepted.
+	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+	//
+	//An optional error that is sent to the peer if the channel is rejected. It
+	//is limited to 500 characters.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The address to use if the peer supports upfront shutdown.
+	UpfrontShutdown string `protobuf:"bytes,3,opt,name=upfront_shutdown,json=upfrontShutdown,proto3" json:"upfront_shutdown,omitempty"`
+	// The number of blocks the peer's outputs are delayed by in a force close.
+	CsvDelay uint32 `protobuf:"varint,4,opt,name=csv_delay,json=csvDelay,proto3" json:"csv_delay,omitempty"`
+	// The reserve in satoshis the peer must keep in the channel.
+	ReserveSat uint64 `protobuf:"varint,5,opt,name=reserve_sat,json=reserveSat,proto3" json:"reserve_sat,omitempty"`
+	//
+	//The maximum amount of funds in millisatoshis we allow the peer to have in
+	//outstanding HTLCs.
+	InFlightMaxMsat uint64 `protobuf:"varint,6,opt,name=in_flight_max_msat,json=inFlightMaxMsat,proto3" json:"in_flight_max_msat,omitempty"`
+	// The maximum number of HTLCs the peer may offer us.
+	MaxHtlcCount uint32 `protobuf:"varint,7,opt,name=max_htlc_count,json=maxHtlcCount,proto3" json:"max_htlc_count,omitempty"`
+	// The minimum HTLC value in millisatoshis we accept.
+	MinHtlcIn uint64 `protobuf:"varint,8,opt,name=min_htlc_in,json=minHtlcIn,proto3" json:"min_htlc_in,omitempty"`
+	// The number of confirmations we require before the channel is usable.
+	MinAcceptDepth uint32 `protobuf:"varint,9,opt,name=min_accept_depth,json=minAcceptDepth,proto3" json:"min_accept_depth,omitempty"`
+}
+
+func (x *DownstreamAcceptResponse) Reset() {
+	*x = DownstreamAcceptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trader_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownstreamAcceptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownstreamAcceptResponse) ProtoMessage() {}
+
+func (x *DownstreamAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trader_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownstreamAcceptResponse.ProtoReflect.Descriptor instead.
+func (*DownstreamAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_trader_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DownstreamAcceptResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *DownstreamAcceptResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DownstreamAcceptResponse) GetUpfrontShutdown() string {
+	if x != nil {
+		return x.UpfrontShutdown
+	}
+	return ""
+}
+
+func (x *DownstreamAcceptResponse) GetCsvDelay() uint32 {
+	if x != nil {
+		return x.CsvDelay
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptResponse) GetReserveSat() uint64 {
+	if x != nil {
+		return x.ReserveSat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptResponse) GetInFlightMaxMsat() uint64 {
+	if x != nil {
+		return x.InFlightMaxMsat
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptResponse) GetMaxHtlcCount() uint32 {
+	if x != nil {
+		return x.MaxHtlcCount
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptResponse) GetMinHtlcIn() uint64 {
+	if x != nil {
+		return x.MinHtlcIn
+	}
+	return 0
+}
+
+func (x *DownstreamAcceptResponse) GetMinAcceptDepth() uint32 {
+	if x != nil {
+		return x.MinAcceptDepth
+	}
+	return 0
+}
+
+var File_trader_proto protoreflect.FileDescriptor
+
+var file_trader_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x1a, 0x1e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x03, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
+	0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x08,
+	0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x46, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0xb2, 0x02,
+	0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x78, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x76, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53,
+	0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x36, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x22, 0x65, 0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x14, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x36, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x22, 0x3f, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x2e, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77,
+	0x42, 0x06, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x46,
+	0x65, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x91, 0x02,
+	0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x46, 0x65, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x13, 0x0a, 0x11,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x78, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x1e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x40, 0x0a, 0x1f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x54, 0x78, 0x69, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x53, 0x77, 0x65,
+	0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x3c,
+	0x0a, 0x1b, 0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x77, 0x65, 0x65, 0x70, 0x54, 0x78, 0x69, 0x64, 0x22, 0xc1, 0x02, 0x0a,
+	0x16, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12,
+	0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x66, 0x65, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x10,
+	0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x22, 0x9d, 0x01, 0x0a, 0x17, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x69, 0x64, 0x12, 0x31, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x78, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0xbf, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x33, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53,
+	0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0xca, 0x01, 0x0a, 0x1e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50,
+	0x65, 0x72, 0x4b, 0x77, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x5f, 0x0a, 0x1f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x22, 0x21, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x62, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x22, 0x3f, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0x21, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x03, 0x0a, 0x15,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
+	0x77, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2e, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x78, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29,
+	0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x30, 0x0a, 0x1a, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x73, 0x62,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x62,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x73, 0x62, 0x74, 0x22, 0x58, 0x0a,
+	0x16, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x22, 0x68, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x78, 0x69,
+	0x64, 0x22, 0xe5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x61, 0x62,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x54, 0x78, 0x69,
+	0x64, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x78, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x4c, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x1b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x74, 0x22, 0x4a, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x15, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x13,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x75,
+	0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x78, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf0, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x03, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12,
+	0x20, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x1e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x13, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x80,
+	0x02, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22,
+	0x58, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
+	0x6b, 0x52, 0x04, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x62, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x69, 0x64, 0x52, 0x04, 0x62, 0x69, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x17,
+	0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x37, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x4f,
+	0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x66, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61,
+	0x6d, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
+	0x77, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x66, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x72, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x03,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x20,
+	0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x7d, 0x0a, 0x18, 0x53,
+	0x61, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x0a,
+	0x03, 0x62, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x42,
+	0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x61,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x1e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61,
+	0x6d, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
+	0x77, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1a,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e,
+	0x73, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x3c,
+	0x0a, 0x1b, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x11,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x17, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x53, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x41, 0x76, 0x67, 0x52, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x73, 0x50, 0x61, 0x69, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x73, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x73,
+	0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x61, 0x74, 0x22, 0x4c, 0x0a, 0x12, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x92, 0x0a, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d,
+	0x74, 0x12, 0x3e, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b,
+	0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x75, 0x6e, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x55, 0x6e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x32,
+	0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x6e,
+	0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x41, 0x0a, 0x1e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74,
+	0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61,
+	0x74, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2f,
+	0x0a, 0x13, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x6e, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x2a, 0x0a, 0x11, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x7a, 0x65, 0x72, 0x6f,
+	0x43, 0x6f, 0x6e, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0xad, 0x02, 0x0a,
+	0x03, 0x42, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0d,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x54,
+	0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x73, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xab, 0x01, 0x0a,
+	0x03, 0x41, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3e, 0x0a,
+	0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b,
+	0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0xb0, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x73, 0x74,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x36, 0x0a, 0x17, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x15, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x03,
+	0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x20,
+	0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xfe, 0x03, 0x0a, 0x12,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x20, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6e, 0x6e,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x36, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x83, 0x02, 0x0a,
+	0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x12, 0x3a, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x75, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x6d, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x10, 0x46, 0x65, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x6d, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x1c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x4b, 0x77, 0x12, 0x33, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53,
+	0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x37, 0x0a, 0x19, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77,
+	0x22, 0xcb, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x99,
+	0x04, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c,
+	0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x66, 0x75, 0x6c, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x74,
+	0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x62, 0x69,
+	0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69,
+	0x6e, 0x48, 0x74, 0x74, 0x70, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x74, 0x6c, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x74, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x5f,
+	0x74, 0x6c, 0x73, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62,
+	0x69, 0x74, 0x63, 0x6f, 0x69, 0x6e, 0x54, 0x6c, 0x73, 0x70, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x17, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x72, 0x69, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22,
+	0xeb, 0x03, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c,
+	0x0a, 0x1a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x18, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x65, 0x77,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x22, 0x46, 0x0a,
+	0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x6b,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x57, 0x0a, 0x1e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x20, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xaa, 0x02, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70,
+	0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x13,
+	0x0a, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x12, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0xd0, 0x09, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x36, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c,
+	0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x11, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6c,
+	0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x30, 0x0a, 0x14, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63,
+	0x74, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x6e,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x2a, 0x0a, 0x11, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x7a, 0x65, 0x72,
+	0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x14,
+	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x74,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x0d, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65,
+	0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x74, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2b,
+	0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xb3, 0x04,
+	0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12,
+	0x36, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63,
+	0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6d, 0x74,
+	0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x22, 0x4e, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x70, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75,
+	0x6d, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x42, 0x61, 0x63, 0x6b, 0x22, 0x58, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xe5,
+	0x03, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1c, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x58, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd6, 0x01,
+	0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x22, 0xd3, 0x04, 0x0a, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x0c, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x63, 0x0a, 0x0f, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x39,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78,
+	0x12, 0x3c, 0x0a, 0x1c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x46,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x24,
+	0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x52, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x53, 0x61, 0x74, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73,
+	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22,
+	0xbb, 0x02, 0x0a, 0x09, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x73,
+	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0xa4, 0x02, 0x0a, 0x0d, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x16, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x15, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x65, 0x0a, 0x19, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x16, 0x0a,
+	0x14, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x2c, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x61, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x36, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4c,
+	0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x10, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbf,
+	0x0c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x36, 0x0a, 0x17,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x73, 0x61, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65,
+	0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x34, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x67, 0x61, 0x74, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x1b, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x19, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x12,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x73, 0x61, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x59, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x62,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a,
+	0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x6e, 0x64, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6e, 0x64,
+	0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6c,
+	0x6e, 0x64, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x52, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40,
+	0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x38, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65,
+	0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x4d, 0x61,
+	0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xbc, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x6f,
+	0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x32, 0x0a, 0x14, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x75, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x12, 0x44, 0x75, 0x6d, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x50, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65,
+	0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x6e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a,
+	0x13, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67,
+	0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75,
+	0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x62,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x69, 0x64, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x6f, 0x0a, 0x18, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x32, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x19, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xab, 0x06,
+	0x0a, 0x14, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a,
+	0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x50,
+	0x75, 0x73, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6f, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x32, 0x0a, 0x15,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x1f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x69, 0x64,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x16,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x4e, 0x65, 0x67, 0x6f,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x45, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x4e, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4e, 0x73, 0x22, 0x4f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x87, 0x02, 0x0a, 0x0d, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x22, 0x11, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x42, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x0f, 0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x59, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x42, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x75,
+	0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x10, 0x63, 0x6f, 0x72, 0x72,
+	0x75, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x9d, 0x01, 0x0a,
+	0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x29, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x52, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x0e, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x3c, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x47,
+	0x0a, 0x12, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x22, 0xdb, 0x02, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x4e, 0x73, 0x22, 0xa5, 0x03, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c,
+	0x0a, 0x1c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x4b, 0x77, 0x12, 0x5a, 0x0a, 0x0f,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x02,
+	0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41, 0x73, 0x6b, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x53, 0x61, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x14,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x77,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x4b, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x8d, 0x04, 0x0a, 0x17, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x75, 0x73,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x18, 0x6d, 0x61, 0x78,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x48, 0x74, 0x6c,
+	0x63, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x4b, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x73, 0x76, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x68, 0x74, 0x6c, 0x63, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61,
+	0x78, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x48, 0x74, 0x6c, 0x63, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x75, 0x70, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x73, 0x76,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x73,
+	0x76, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x6e, 0x5f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x61, 0x78,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x48, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x69,
+	0x6e, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x48, 0x74, 0x6c, 0x63, 0x49, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69,
+	0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x2a, 0x71, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x32, 0x57, 0x4b, 0x48, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x50, 0x32, 0x54, 0x52, 0x10, 0x02, 0x2a, 0x93, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52,
+	0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x07, 0x2a, 0x4d, 0x0a,
+	0x0f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x4e, 0x59, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x10, 0x02, 0x2a, 0x50, 0x0a, 0x0a,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
+	0x45, 0x50, 0x41, 0x52, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xeb,
+	0x04, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x53, 0x42, 0x45, 0x48, 0x41,
+	0x56, 0x49, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x41, 0x54, 0x45, 0x52, 0x41, 0x4c, 0x10,
+	0x03, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45,
+	0x45, 0x52, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x46,
+	0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x59, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x52, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x59, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x46, 0x45, 0x45,
+	0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x08, 0x12, 0x23, 0x0a, 0x1f, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f,
+	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x09,
+	0x12, 0x22, 0x0a, 0x1e, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x53, 0x5f, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x10, 0x0a, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0b, 0x12, 0x28,
+	0x0a, 0x24, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x49,
+	0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0c, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x41, 0x52, 0x54,
+	0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0d, 0x12,
+	0x1b, 0x0a, 0x17, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x24, 0x0a, 0x20,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x54, 0x49, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x57,
+	0x10, 0x0f, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x5f, 0x42, 0x41, 0x4c,
+	0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x10, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x11, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x12, 0x2a, 0x92, 0x02, 0x0a,
+	0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53,
+	0x49, 0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41,
+	0x4c, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4e, 0x45, 0x57, 0x41, 0x4c, 0x10, 0x04, 0x12,
+	0x18, 0x0a, 0x14, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x42, 0x55, 0x4d, 0x50, 0x10,
+	0x08, 0x2a, 0x98, 0x01, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x45, 0x41, 0x53,
+	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c,
+	0x4f, 0x53, 0x45, 0x44, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0xdb, 0x01, 0x0a,
+	0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x46,
+	0x4f, 0x52, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x43,
+	0x48, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x45,
+	0x41, 0x53, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x88, 0x01, 0x0a, 0x19, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x45, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x45,
+	0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x45, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0x77, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x47,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x47, 0x41, 0x54,
+	0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x50, 0x45,
+	0x52, 0x49, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48,
+	0x5f, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x6d,
+	0x0a, 0x10, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x4d, 0x41, 0x49, 0x4c, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x49, 0x44, 0x45, 0x43, 0x41, 0x52, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x10, 0x02, 0x32, 0x80, 0x30,
+	0x0a, 0x06, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x44,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6e, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
+	0x0a, 0x17, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6e, 0x64, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13,
+	0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x28,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x27,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x73, 0x62, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x63, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x4e, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12,
+	0x69, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e,
+	0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x53, 0x61,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12,
+	0x48, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4e, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4e, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x73,
+	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x73,
+	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c,
+	0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x60, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x5a, 0x0a, 0x11, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x21, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x63, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x24, 0x2e, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x42, 0x12,
+	0x18, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x2e,
+	0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x65, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70,
+	0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x75, 0x6d,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x75,
+	0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x5e, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x6a, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_trader_proto_rawDescOnce sync.Once
+	file_trader_proto_rawDescData = file_trader_proto_rawDesc
+)
+
+func file_trader_proto_rawDescGZIP() []byte {
+	file_trader_proto_rawDescOnce.Do(func() {
+		file_trader_proto_rawDescData = protoimpl.X.CompressGZIP(file_trader_proto_rawDescData)
+	})
+	return file_trader_proto_rawDescData
+}
+
+var file_trader_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_trader_proto_msgTypes = make([]protoimpl.MessageInfo, 178)
+var file_trader_proto_goTypes = []interface{}{
+	(ChangeAddressType)(0),                       // 0: poolrpc.ChangeAddressType
+	(AccountState)(0),                            // 1: poolrpc.AccountState
+	(OrderTypeFilter)(0),                         // 2: poolrpc.OrderTypeFilter
+	(MatchState)(0),                              // 3: poolrpc.MatchState
+	(MatchRejectReason)(0),                       // 4: poolrpc.MatchRejectReason
+	(AccountEventAction)(0),                      // 5: poolrpc.AccountEventAction
+	(LeaseChannelState)(0),                       // 6: poolrpc.LeaseChannelState
+	(LeaseCloseType)(0),                          // 7: poolrpc.LeaseCloseType
+	(AuctioneerConnectionState)(0),               // 8: poolrpc.AuctioneerConnectionState
+	(HealthGateState)(0),                         // 9: poolrpc.HealthGateState
+	(SidecarTransport)(0),                        // 10: poolrpc.SidecarTransport
+	(*InitAccountRequest)(nil),                   // 11: poolrpc.InitAccountRequest
+	(*FeeLimit)(nil),                             // 12: poolrpc.FeeLimit
+	(*AccountTxDryRun)(nil),                      // 13: poolrpc.AccountTxDryRun
+	(*QuoteAccountRequest)(nil),                  // 14: poolrpc.QuoteAccountRequest
+	(*QuoteAccountResponse)(nil),                 // 15: poolrpc.QuoteAccountResponse
+	(*ListAccountsRequest)(nil),                  // 16: poolrpc.ListAccountsRequest
+	(*ListAccountsResponse)(nil),                 // 17: poolrpc.ListAccountsResponse
+	(*Output)(nil),                               // 18: poolrpc.Output
+	(*OutputWithFee)(nil),                        // 19: poolrpc.OutputWithFee
+	(*OutputsWithImplicitFee)(nil),               // 20: poolrpc.OutputsWithImplicitFee
+	(*CloseAccountRequest)(nil),                  // 21: poolrpc.CloseAccountRequest
+	(*CloseAccountResponse)(nil),                 // 22: poolrpc.CloseAccountResponse
+	(*WithdrawAndCloseAccountRequest)(nil),       // 23: poolrpc.WithdrawAndCloseAccountRequest
+	(*WithdrawAndCloseAccountResponse)(nil),      // 24: poolrpc.WithdrawAndCloseAccountResponse
+	(*SweepExpiredAccountRequest)(nil),           // 25: poolrpc.SweepExpiredAccountRequest
+	(*SweepExpiredAccountResponse)(nil),          // 26: poolrpc.SweepExpiredAccountResponse
+	(*WithdrawAccountRequest)(nil),               // 27: poolrpc.WithdrawAccountRequest
+	(*WithdrawAccountResponse)(nil),              // 28: poolrpc.WithdrawAccountResponse
+	(*ScheduledWithdrawal)(nil),                  // 29: poolrpc.ScheduledWithdrawal
+	(*ScheduleWithdrawAccountRequest)(nil),       // 30: poolrpc.ScheduleWithdrawAccountRequest
+	(*ScheduleWithdrawAccountResponse)(nil),      // 31: poolrpc.ScheduleWithdrawAccountResponse
+	(*ListScheduledWithdrawalsRequest)(nil),      // 32: poolrpc.ListScheduledWithdrawalsRequest
+	(*ListScheduledWithdrawalsResponse)(nil),     // 33: poolrpc.ListScheduledWithdrawalsResponse
+	(*CancelScheduledWithdrawRequest)(nil),       // 34: poolrpc.CancelScheduledWithdrawRequest
+	(*CancelScheduledWithdrawResponse)(nil),      // 35: poolrpc.CancelScheduledWithdrawResponse
+	(*DepositAccountRequest)(nil),                // 36: poolrpc.DepositAccountRequest
+	(*DepositAccountResponse)(nil),               // 37: poolrpc.DepositAccountResponse
+	(*DepositAccountPsbtRequest)(nil),            // 38: poolrpc.DepositAccountPsbtRequest
+	(*DepositAccountPsbtResponse)(nil),           // 39: poolrpc.DepositAccountPsbtResponse
+	(*FinalizeDepositRequest)(nil),               // 40: poolrpc.FinalizeDepositRequest
+	(*FinalizeDepositResponse)(nil),              // 41: poolrpc.FinalizeDepositResponse
+	(*RenewAccountRequest)(nil),                  // 42: poolrpc.RenewAccountRequest
+	(*RenewAccountResponse)(nil),                 // 43: poolrpc.RenewAccountResponse
+	(*UpdateAccountAutoRenewRequest)(nil),        // 44: poolrpc.UpdateAccountAutoRenewRequest
+	(*UpdateAccountAutoRenewResponse)(nil),       // 45: poolrpc.UpdateAccountAutoRenewResponse
+	(*UpdateAccountReserveRequest)(nil),          // 46: poolrpc.UpdateAccountReserveRequest
+	(*UpdateAccountReserveResponse)(nil),         // 47: poolrpc.UpdateAccountReserveResponse
+	(*BumpAccountFeeRequest)(nil),                // 48: poolrpc.BumpAccountFeeRequest
+	(*BumpAccountFeeResponse)(nil),               // 49: poolrpc.BumpAccountFeeResponse
+	(*Account)(nil),                              // 50: poolrpc.Account
+	(*SubmitOrderRequest)(nil),                   // 51: poolrpc.SubmitOrderRequest
+	(*SubmitOrderResponse)(nil),                  // 52: poolrpc.SubmitOrderResponse
+	(*PrepareOrderResponse)(nil),                 // 53: poolrpc.PrepareOrderResponse
+	(*SubmitSignedOrderRequest)(nil),             // 54: poolrpc.SubmitSignedOrderRequest
+	(*ListOrdersRequest)(nil),                    // 55: poolrpc.ListOrdersRequest
+	(*ListOrdersResponse)(nil),                   // 56: poolrpc.ListOrdersResponse
+	(*CancelOrderRequest)(nil),                   // 57: poolrpc.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                  // 58: poolrpc.CancelOrderResponse
+	(*ActivateOrderRequest)(nil),                 // 59: poolrpc.ActivateOrderRequest
+	(*ActivateOrderResponse)(nil),                // 60: poolrpc.ActivateOrderResponse
+	(*CancelAllOrdersRequest)(nil),               // 61: poolrpc.CancelAllOrdersRequest
+	(*CancelAllOrdersResponse)(nil),              // 62: poolrpc.CancelAllOrdersResponse
+	(*CancelOrderResult)(nil),                    // 63: poolrpc.CancelOrderResult
+	(*ReplaceOrderRequest)(nil),                  // 64: poolrpc.ReplaceOrderRequest
+	(*ReplaceOrderResponse)(nil),                 // 65: poolrpc.ReplaceOrderResponse
+	(*OrderTemplate)(nil),                        // 66: poolrpc.OrderTemplate
+	(*SaveOrderTemplateRequest)(nil),             // 67: poolrpc.SaveOrderTemplateRequest
+	(*SaveOrderTemplateResponse)(nil),            // 68: poolrpc.SaveOrderTemplateResponse
+	(*ListOrderTemplatesRequest)(nil),            // 69: poolrpc.ListOrderTemplatesRequest
+	(*ListOrderTemplatesResponse)(nil),           // 70: poolrpc.ListOrderTemplatesResponse
+	(*DeleteOrderTemplateRequest)(nil),           // 71: poolrpc.DeleteOrderTemplateRequest
+	(*DeleteOrderTemplateResponse)(nil),          // 72: poolrpc.DeleteOrderTemplateResponse
+	(*SubmitOrderFromTemplateRequest)(nil),       // 73: poolrpc.SubmitOrderFromTemplateRequest
+	(*PruneArchivedOrdersRequest)(nil),           // 74: poolrpc.PruneArchivedOrdersRequest
+	(*PruneArchivedOrdersResponse)(nil),          // 75: poolrpc.PruneArchivedOrdersResponse
+	(*OrderStatsRequest)(nil),                    // 76: poolrpc.OrderStatsRequest
+	(*LeaseDurationOrderStats)(nil),              // 77: poolrpc.LeaseDurationOrderStats
+	(*OrderStatsResponse)(nil),                   // 78: poolrpc.OrderStatsResponse
+	(*Order)(nil),                                // 79: poolrpc.Order
+	(*Bid)(nil),                                  // 80: poolrpc.Bid
+	(*Ask)(nil),                                  // 81: poolrpc.Ask
+	(*OrderBookRequest)(nil),                     // 82: poolrpc.OrderBookRequest
+	(*QuoteOrderRequest)(nil),                    // 83: poolrpc.QuoteOrderRequest
+	(*QuoteOrderResponse)(nil),                   // 84: poolrpc.QuoteOrderResponse
+	(*QuoteMatchRequest)(nil),                    // 85: poolrpc.QuoteMatchRequest
+	(*QuoteMatchResponse)(nil),                   // 86: poolrpc.QuoteMatchResponse
+	(*OrderEvent)(nil),                           // 87: poolrpc.OrderEvent
+	(*UpdatedEvent)(nil),                         // 88: poolrpc.UpdatedEvent
+	(*FeeRateBumpEvent)(nil),                     // 89: poolrpc.FeeRateBumpEvent
+	(*MatchEvent)(nil),                           // 90: poolrpc.MatchEvent
+	(*RecoverAccountsRequest)(nil),               // 91: poolrpc.RecoverAccountsRequest
+	(*RecoverAccountsResponse)(nil),              // 92: poolrpc.RecoverAccountsResponse
+	(*AccountEventsRequest)(nil),                 // 93: poolrpc.AccountEventsRequest
+	(*AccountEvent)(nil),                         // 94: poolrpc.AccountEvent
+	(*AccountEventsResponse)(nil),                // 95: poolrpc.AccountEventsResponse
+	(*ExportAccountWatchOnlyRequest)(nil),        // 96: poolrpc.ExportAccountWatchOnlyRequest
+	(*WatchOnlyAccount)(nil),                     // 97: poolrpc.WatchOnlyAccount
+	(*ExportAccountWatchOnlyResponse)(nil),       // 98: poolrpc.ExportAccountWatchOnlyResponse
+	(*SubscribeAccountUpdatesRequest)(nil),       // 99: poolrpc.SubscribeAccountUpdatesRequest
+	(*AccountUpdate)(nil),                        // 100: poolrpc.AccountUpdate
+	(*AuctionFeeRequest)(nil),                    // 101: poolrpc.AuctionFeeRequest
+	(*AuctionFeeResponse)(nil),                   // 102: poolrpc.AuctionFeeResponse
+	(*Lease)(nil),                                // 103: poolrpc.Lease
+	(*LeasesRequest)(nil),                        // 104: poolrpc.LeasesRequest
+	(*LeasesResponse)(nil),                       // 105: poolrpc.LeasesResponse
+	(*SubscribeLeaseEventsRequest)(nil),          // 106: poolrpc.SubscribeLeaseEventsRequest
+	(*LeaseEvent)(nil),                           // 107: poolrpc.LeaseEvent
+	(*LeaseEvidenceRequest)(nil),                 // 108: poolrpc.LeaseEvidenceRequest
+	(*LeaseEvidenceResponse)(nil),                // 109: poolrpc.LeaseEvidenceResponse
+	(*ListLocalBatchSnapshotsRequest)(nil),       // 110: poolrpc.ListLocalBatchSnapshotsRequest
+	(*ListLocalBatchSnapshotsResponse)(nil),      // 111: poolrpc.ListLocalBatchSnapshotsResponse
+	(*LocalBatchSnapshot)(nil),                   // 112: poolrpc.LocalBatchSnapshot
+	(*BatchApprovalRecord)(nil),                  // 113: poolrpc.BatchApprovalRecord
+	(*LocalMatchedOrder)(nil),                    // 114: poolrpc.LocalMatchedOrder
+	(*AcceptedBatchProposalRequest)(nil),         // 115: poolrpc.AcceptedBatchProposalRequest
+	(*AcceptedBatchProposalResponse)(nil),        // 116: poolrpc.AcceptedBatchProposalResponse
+	(*MatchedOrderUnits)(nil),                    // 117: poolrpc.MatchedOrderUnits
+	(*TokensRequest)(nil),                        // 118: poolrpc.TokensRequest
+	(*TokensResponse)(nil),                       // 119: poolrpc.TokensResponse
+	(*LsatToken)(nil),                            // 120: poolrpc.LsatToken
+	(*ListLsatTokensRequest)(nil),                // 121: poolrpc.ListLsatTokensRequest
+	(*ListLsatTokensResponse)(nil),               // 122: poolrpc.ListLsatTokensResponse
+	(*LsatTokenInfo)(nil),                        // 123: poolrpc.LsatTokenInfo
+	(*RevokeLsatTokenRequest)(nil),               // 124: poolrpc.RevokeLsatTokenRequest
+	(*RevokeLsatTokenResponse)(nil),              // 125: poolrpc.RevokeLsatTokenResponse
+	(*ImportLsatTokenRequest)(nil),               // 126: poolrpc.ImportLsatTokenRequest
+	(*ImportLsatTokenResponse)(nil),              // 127: poolrpc.ImportLsatTokenResponse
+	(*LeaseDurationRequest)(nil),                 // 128: poolrpc.LeaseDurationRequest
+	(*LeaseDurationResponse)(nil),                // 129: poolrpc.LeaseDurationResponse
+	(*NextBatchInfoRequest)(nil),                 // 130: poolrpc.NextBatchInfoRequest
+	(*NextBatchInfoResponse)(nil),                // 131: poolrpc.NextBatchInfoResponse
+	(*NodeRatingRequest)(nil),                    // 132: poolrpc.NodeRatingRequest
+	(*NodeRatingResponse)(nil),                   // 133: poolrpc.NodeRatingResponse
+	(*GetInfoRequest)(nil),                       // 134: poolrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                      // 135: poolrpc.GetInfoResponse
+	(*SetAuctioneerEndpointRequest)(nil),         // 136: poolrpc.SetAuctioneerEndpointRequest
+	(*MacaroonPermission)(nil),                   // 137: poolrpc.MacaroonPermission
+	(*BakeMacaroonRequest)(nil),                  // 138: poolrpc.BakeMacaroonRequest
+	(*BakeMacaroonResponse)(nil),                 // 139: poolrpc.BakeMacaroonResponse
+	(*DumpConfigRequest)(nil),                    // 140: poolrpc.DumpConfigRequest
+	(*DumpConfigResponse)(nil),                   // 141: poolrpc.DumpConfigResponse
+	(*SetAuctioneerEndpointResponse)(nil),        // 142: poolrpc.SetAuctioneerEndpointResponse
+	(*SubscribeServerStateRequest)(nil),          // 143: poolrpc.SubscribeServerStateRequest
+	(*ServerStateUpdate)(nil),                    // 144: poolrpc.ServerStateUpdate
+	(*HealthGate)(nil),                           // 145: poolrpc.HealthGate
+	(*StopDaemonRequest)(nil),                    // 146: poolrpc.StopDaemonRequest
+	(*StopDaemonResponse)(nil),                   // 147: poolrpc.StopDaemonResponse
+	(*OfferSidecarRequest)(nil),                  // 148: poolrpc.OfferSidecarRequest
+	(*OfferSidecarBatchRequest)(nil),             // 149: poolrpc.OfferSidecarBatchRequest
+	(*OfferSidecarBatchResponse)(nil),            // 150: poolrpc.OfferSidecarBatchResponse
+	(*SidecarTicket)(nil),                        // 151: poolrpc.SidecarTicket
+	(*DecodedSidecarTicket)(nil),                 // 152: poolrpc.DecodedSidecarTicket
+	(*RegisterSidecarRequest)(nil),               // 153: poolrpc.RegisterSidecarRequest
+	(*ExpectSidecarChannelRequest)(nil),          // 154: poolrpc.ExpectSidecarChannelRequest
+	(*ExpectSidecarChannelResponse)(nil),         // 155: poolrpc.ExpectSidecarChannelResponse
+	(*ListSidecarsRequest)(nil),                  // 156: poolrpc.ListSidecarsRequest
+	(*ListSidecarsResponse)(nil),                 // 157: poolrpc.ListSidecarsResponse
+	(*CancelSidecarRequest)(nil),                 // 158: poolrpc.CancelSidecarRequest
+	(*CancelSidecarResponse)(nil),                // 159: poolrpc.CancelSidecarResponse
+	(*SubscribeSidecarRequest)(nil),              // 160: poolrpc.SubscribeSidecarRequest
+	(*SidecarUpdate)(nil),                        // 161: poolrpc.SidecarUpdate
+	(*VerifyDBRequest)(nil),                      // 162: poolrpc.VerifyDBRequest
+	(*CorruptedRecord)(nil),                      // 163: poolrpc.CorruptedRecord
+	(*VerifyDBResponse)(nil),                     // 164: poolrpc.VerifyDBResponse
+	(*BatchPolicy)(nil),                          // 165: poolrpc.BatchPolicy
+	(*SetBatchPolicyRequest)(nil),                // 166: poolrpc.SetBatchPolicyRequest
+	(*SetBatchPolicyResponse)(nil),               // 167: poolrpc.SetBatchPolicyResponse
+	(*GetBatchPolicyRequest)(nil),                // 168: poolrpc.GetBatchPolicyRequest
+	(*ListFundingFailuresRequest)(nil),           // 169: poolrpc.ListFundingFailuresRequest
+	(*ListFundingFailuresResponse)(nil),          // 170: poolrpc.ListFundingFailuresResponse
+	(*FundingFailure)(nil),                       // 171: poolrpc.FundingFailure
+	(*LeaseAuditRequest)(nil),                    // 172: poolrpc.LeaseAuditRequest
+	(*LeaseAuditResponse)(nil),                   // 173: poolrpc.LeaseAuditResponse
+	(*LeaseAuditResult)(nil),                     // 174: poolrpc.LeaseAuditResult
+	(*BatchApprovalRequest)(nil),                 // 175: poolrpc.BatchApprovalRequest
+	(*BatchApprovalMatch)(nil),                   // 176: poolrpc.BatchApprovalMatch
+	(*BatchApprovalAccount)(nil),                 // 177: poolrpc.BatchApprovalAccount
+	(*BatchApprovalResponse)(nil),                // 178: poolrpc.BatchApprovalResponse
+	(*DownstreamAcceptRequest)(nil),              // 179: poolrpc.DownstreamAcceptRequest
+	(*DownstreamAcceptResponse)(nil),             // 180: poolrpc.DownstreamAcceptResponse
+	nil,                                          // 181: poolrpc.LocalBatchSnapshot.ClearingPricesEntry
+	nil,                                          // 182: poolrpc.AcceptedBatchProposalResponse.ClearingPricesEntry
+	nil,                                          // 183: poolrpc.LeaseDurationResponse.LeaseDurationsEntry
+	nil,                                          // 184: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
+	nil,                                          // 185: poolrpc.GetInfoResponse.MarketInfoEntry
+	nil,                                          // 186: poolrpc.GetInfoResponse.AccountsByStateEntry
+	nil,                                          // 187: poolrpc.GetInfoResponse.OrdersByStateEntry
+	nil,                                          // 188: poolrpc.BatchApprovalRequest.ClearingPricesEntry
+	(*auctioneerrpc.OutPoint)(nil),               // 189: poolrpc.OutPoint
+	(auctioneerrpc.AccountVersion)(0),            // 190: poolrpc.AccountVersion
+	(*auctioneerrpc.InvalidOrder)(nil),           // 191: poolrpc.InvalidOrder
+	(auctioneerrpc.OrderState)(0),                // 192: poolrpc.OrderState
+	(auctioneerrpc.OrderChannelType)(0),          // 193: poolrpc.OrderChannelType
+	(auctioneerrpc.NodeTier)(0),                  // 194: poolrpc.NodeTier
+	(*auctioneerrpc.ExecutionFee)(nil),           // 195: poolrpc.ExecutionFee
+	(*auctioneerrpc.AccountDiff)(nil),            // 196: poolrpc.AccountDiff
+	(*auctioneerrpc.NodeRating)(nil),             // 197: poolrpc.NodeRating
+	(auctioneerrpc.DurationBucketState)(0),       // 198: poolrpc.DurationBucketState
+	(*auctioneerrpc.MarketInfo)(nil),             // 199: poolrpc.MarketInfo
+	(*auctioneerrpc.BatchSnapshotRequest)(nil),   // 200: poolrpc.BatchSnapshotRequest
+	(*auctioneerrpc.BatchSnapshotsRequest)(nil),  // 201: poolrpc.BatchSnapshotsRequest
+	(*auctioneerrpc.OrderBookUpdate)(nil),        // 202: poolrpc.OrderBookUpdate
+	(*auctioneerrpc.BatchSnapshotResponse)(nil),  // 203: poolrpc.BatchSnapshotResponse
+	(*auctioneerrpc.BatchSnapshotsResponse)(nil), // 204: poolrpc.BatchSnapshotsResponse
+}
+var file_trader_proto_depIdxs = []int32{
+	189, // 0: poolrpc.InitAccountRequest.inputs:type_name -> poolrpc.OutPoint
+	0,   // 1: poolrpc.InitAccountRequest.change_type:type_name -> poolrpc.ChangeAddressType
+	12,  // 2: poolrpc.InitAccountRequest.fee_limit:type_name -> poolrpc.FeeLimit
+	189, // 3: poolrpc.AccountTxDryRun.wallet_inputs:type_name -> poolrpc.OutPoint
+	50,  // 4: poolrpc.ListAccountsResponse.accounts:type_name -> poolrpc.Account
+	18,  // 5: poolrpc.OutputsWithImplicitFee.outputs:type_name -> poolrpc.Output
+	19,  // 6: poolrpc.CloseAccountRequest.output_with_fee:type_name -> poolrpc.OutputWithFee
+	20,  // 7: poolrpc.CloseAccountRequest.outputs:type_name -> poolrpc.OutputsWithImplicitFee
+	12,  // 8: poolrpc.CloseAccountRequest.fee_limit:type_name -> poolrpc.FeeLimit
+	13,  // 9: poolrpc.CloseAccountResponse.dry_run:type_name -> poolrpc.AccountTxDryRun
+	18,  // 10: poolrpc.WithdrawAndCloseAccountRequest.outputs:type_name -> poolrpc.Output
+	18,  // 11: poolrpc.WithdrawAccountRequest.outputs:type_name -> poolrpc.Output
+	12,  // 12: poolrpc.WithdrawAccountRequest.fee_limit:type_name -> poolrpc.FeeLimit
+	50,  // 13: poolrpc.WithdrawAccountResponse.account:type_name -> poolrpc.Account
+	13,  // 14: poolrpc.WithdrawAccountResponse.dry_run:type_name -> poolrpc.AccountTxDryRun
+	18,  // 15: poolrpc.ScheduledWithdrawal.outputs:type_name -> poolrpc.Output
+	18,  // 16: poolrpc.ScheduleWithdrawAccountRequest.outputs:type_name -> poolrpc.Output
+	29,  // 17: poolrpc.ScheduleWithdrawAccountResponse.withdrawal:type_name -> poolrpc.ScheduledWithdrawal
+	29,  // 18: poolrpc.ListScheduledWithdrawalsResponse.withdrawals:type_name -> poolrpc.ScheduledWithdrawal
+	189, // 19: poolrpc.DepositAccountRequest.inputs:type_name -> poolrpc.OutPoint
+	0,   // 20: poolrpc.DepositAccountRequest.change_type:type_name -> poolrpc.ChangeAddressType
+	12,  // 21: poolrpc.DepositAccountRequest.fee_limit:type_name -> poolrpc.FeeLimit
+	50,  // 22: poolrpc.DepositAccountResponse.account:type_name -> poolrpc.Account
+	13,  // 23: poolrpc.DepositAccountResponse.dry_run:type_name -> poolrpc.AccountTxDryRun
+	50,  // 24: poolrpc.FinalizeDepositResponse.account:type_name -> poolrpc.Account
+	50,  // 25: poolrpc.RenewAccountResponse.account:type_name -> poolrpc.Account
+	13,  // 26: poolrpc.RenewAccountResponse.dry_run:type_name -> poolrpc.AccountTxDryRun
+	50,  // 27: poolrpc.UpdateAccountAutoRenewResponse.account:type_name -> poolrpc.Account
+	50,  // 28: poolrpc.UpdateAccountReserveResponse.account:type_name -> poolrpc.Account
+	189, // 29: poolrpc.Account.outpoint:type_name -> poolrpc.OutPoint
+	1,   // 30: poolrpc.Account.state:type_name -> poolrpc.AccountState
+	190, // 31: poolrpc.Account.version:type_name -> poolrpc.AccountVersion
+	81,  // 32: poolrpc.SubmitOrderRequest.ask:type_name -> poolrpc.Ask
+	80,  // 33: poolrpc.SubmitOrderRequest.bid:type_name -> poolrpc.Bid
+	191, // 34: poolrpc.SubmitOrderResponse.invalid_order:type_name -> poolrpc.InvalidOrder
+	191, // 35: poolrpc.PrepareOrderResponse.invalid_order:type_name -> poolrpc.InvalidOrder
+	81,  // 36: poolrpc.ListOrdersResponse.asks:type_name -> poolrpc.Ask
+	80,  // 37: poolrpc.ListOrdersResponse.bids:type_name -> poolrpc.Bid
+	2,   // 38: poolrpc.CancelAllOrdersRequest.order_type:type_name -> poolrpc.OrderTypeFilter
+	63,  // 39: poolrpc.CancelAllOrdersResponse.results:type_name -> poolrpc.CancelOrderResult
+	81,  // 40: poolrpc.OrderTemplate.ask:type_name -> poolrpc.Ask
+	80,  // 41: poolrpc.OrderTemplate.bid:type_name -> poolrpc.Bid
+	81,  // 42: poolrpc.SaveOrderTemplateRequest.ask:type_name -> poolrpc.Ask
+	80,  // 43: poolrpc.SaveOrderTemplateRequest.bid:type_name -> poolrpc.Bid
+	66,  // 44: poolrpc.ListOrderTemplatesResponse.templates:type_name -> poolrpc.OrderTemplate
+	192, // 45: poolrpc.PruneArchivedOrdersRequest.states:type_name -> poolrpc.OrderState
+	77,  // 46: poolrpc.OrderStatsResponse.stats:type_name -> poolrpc.LeaseDurationOrderStats
+	192, // 47: poolrpc.Order.state:type_name -> poolrpc.OrderState
+	87,  // 48: poolrpc.Order.events:type_name -> poolrpc.OrderEvent
+	193, // 49: poolrpc.Order.channel_type:type_name -> poolrpc.OrderChannelType
+	79,  // 50: poolrpc.Bid.details:type_name -> poolrpc.Order
+	194, // 51: poolrpc.Bid.min_node_tier:type_name -> poolrpc.NodeTier
+	79,  // 52: poolrpc.Ask.details:type_name -> poolrpc.Order
+	81,  // 53: poolrpc.QuoteOrderRequest.ask:type_name -> poolrpc.Ask
+	80,  // 54: poolrpc.QuoteOrderRequest.bid:type_name -> poolrpc.Bid
+	81,  // 55: poolrpc.QuoteMatchRequest.ask:type_name -> poolrpc.Ask
+	80,  // 56: poolrpc.QuoteMatchRequest.bid:type_name -> poolrpc.Bid
+	88,  // 57: poolrpc.OrderEvent.state_change:type_name -> poolrpc.UpdatedEvent
+	90,  // 58: poolrpc.OrderEvent.matched:type_name -> poolrpc.MatchEvent
+	89,  // 59: poolrpc.OrderEvent.fee_rate_bump:type_name -> poolrpc.FeeRateBumpEvent
+	192, // 60: poolrpc.UpdatedEvent.previous_state:type_name -> poolrpc.OrderState
+	192, // 61: poolrpc.UpdatedEvent.new_state:type_name -> poolrpc.OrderState
+	3,   // 62: poolrpc.MatchEvent.match_state:type_name -> poolrpc.MatchState
+	4,   // 63: poolrpc.MatchEvent.reject_reason:type_name -> poolrpc.MatchRejectReason
+	50,  // 64: poolrpc.RecoverAccountsResponse.account:type_name -> poolrpc.Account
+	5,   // 65: poolrpc.AccountEvent.action:type_name -> poolrpc.AccountEventAction
+	1,   // 66: poolrpc.AccountEvent.previous_state:type_name -> poolrpc.AccountState
+	1,   // 67: poolrpc.AccountEvent.new_state:type_name -> poolrpc.AccountState
+	189, // 68: poolrpc.AccountEvent.outpoint:type_name -> poolrpc.OutPoint
+	94,  // 69: poolrpc.AccountEventsResponse.events:type_name -> poolrpc.AccountEvent
+	190, // 70: poolrpc.WatchOnlyAccount.version:type_name -> poolrpc.AccountVersion
+	189, // 71: poolrpc.WatchOnlyAccount.outpoint:type_name -> poolrpc.OutPoint
+	97,  // 72: poolrpc.ExportAccountWatchOnlyResponse.accounts:type_name -> poolrpc.WatchOnlyAccount
+	1,   // 73: poolrpc.AccountUpdate.prev_state:type_name -> poolrpc.AccountState
+	1,   // 74: poolrpc.AccountUpdate.new_state:type_name -> poolrpc.AccountState
+	189, // 75: poolrpc.AccountUpdate.outpoint:type_name -> poolrpc.OutPoint
+	195, // 76: poolrpc.AuctionFeeResponse.execution_fee:type_name -> poolrpc.ExecutionFee
+	189, // 77: poolrpc.Lease.channel_point:type_name -> poolrpc.OutPoint
+	194, // 78: poolrpc.Lease.channel_node_tier:type_name -> poolrpc.NodeTier
+	193, // 79: poolrpc.Lease.channel_type:type_name -> poolrpc.OrderChannelType
+	6,   // 80: poolrpc.Lease.channel_state:type_name -> poolrpc.LeaseChannelState
+	103, // 81: poolrpc.LeasesResponse.leases:type_name -> poolrpc.Lease
+	189, // 82: poolrpc.LeaseEvent.channel_point:type_name -> poolrpc.OutPoint
+	7,   // 83: poolrpc.LeaseEvent.close_type:type_name -> poolrpc.LeaseCloseType
+	189, // 84: poolrpc.LeaseEvidenceRequest.channel_point:type_name -> poolrpc.OutPoint
+	112, // 85: poolrpc.ListLocalBatchSnapshotsResponse.batches:type_name -> poolrpc.LocalBatchSnapshot
+	181, // 86: poolrpc.LocalBatchSnapshot.clearing_prices:type_name -> poolrpc.LocalBatchSnapshot.ClearingPricesEntry
+	114, // 87: poolrpc.LocalBatchSnapshot.matched_orders:type_name -> poolrpc.LocalMatchedOrder
+	113, // 88: poolrpc.LocalBatchSnapshot.approval:type_name -> poolrpc.BatchApprovalRecord
+	117, // 89: poolrpc.AcceptedBatchProposalResponse.matched_units:type_name -> poolrpc.MatchedOrderUnits
+	182, // 90: poolrpc.AcceptedBatchProposalResponse.clearing_prices:type_name -> poolrpc.AcceptedBatchProposalResponse.ClearingPricesEntry
+	196, // 91: poolrpc.AcceptedBatchProposalResponse.account_diffs:type_name -> poolrpc.AccountDiff
+	195, // 92: poolrpc.AcceptedBatchProposalResponse.execution_fee:type_name -> poolrpc.ExecutionFee
+	120, // 93: poolrpc.TokensResponse.tokens:type_name -> poolrpc.LsatToken
+	123, // 94: poolrpc.ListLsatTokensResponse.tokens:type_name -> poolrpc.LsatTokenInfo
+	123, // 95: poolrpc.ImportLsatTokenResponse.token:type_name -> poolrpc.LsatTokenInfo
+	183, // 96: poolrpc.LeaseDurationResponse.lease_durations:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationsEntry
+	184, // 97: poolrpc.LeaseDurationResponse.lease_duration_buckets:type_name -> poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry
+	197, // 98: poolrpc.NodeRatingResponse.node_ratings:type_name -> poolrpc.NodeRating
+	197, // 99: poolrpc.GetInfoResponse.node_rating:type_name -> poolrpc.NodeRating
+	185, // 100: poolrpc.GetInfoResponse.market_info:type_name -> poolrpc.GetInfoResponse.MarketInfoEntry
+	145, // 101: poolrpc.GetInfoResponse.health_gate:type_name -> poolrpc.HealthGate
+	8,   // 102: poolrpc.GetInfoResponse.auctioneer_connection_state:type_name -> poolrpc.AuctioneerConnectionState
+	123, // 103: poolrpc.GetInfoResponse.current_lsat_token:type_name -> poolrpc.LsatTokenInfo
+	186, // 104: poolrpc.GetInfoResponse.accounts_by_state:type_name -> poolrpc.GetInfoResponse.AccountsByStateEntry
+	187, // 105: poolrpc.GetInfoResponse.orders_by_state:type_name -> poolrpc.GetInfoResponse.OrdersByStateEntry
+	137, // 106: poolrpc.BakeMacaroonRequest.permissions:type_name -> poolrpc.MacaroonPermission
+	8,   // 107: poolrpc.ServerStateUpdate.state:type_name -> poolrpc.AuctioneerConnectionState
+	9,   // 108: poolrpc.HealthGate.state:type_name -> poolrpc.HealthGateState
+	80,  // 109: poolrpc.OfferSidecarRequest.bid:type_name -> poolrpc.Bid
+	10,  // 110: poolrpc.OfferSidecarRequest.transport:type_name -> poolrpc.SidecarTransport
+	148, // 111: poolrpc.OfferSidecarBatchRequest.offer:type_name -> poolrpc.OfferSidecarRequest
+	151, // 112: poolrpc.OfferSidecarBatchResponse.tickets:type_name -> poolrpc.SidecarTicket
+	10,  // 113: poolrpc.RegisterSidecarRequest.transport:type_name -> poolrpc.SidecarTransport
+	152, // 114: poolrpc.ListSidecarsResponse.tickets:type_name -> poolrpc.DecodedSidecarTicket
+	163, // 115: poolrpc.VerifyDBResponse.corrupted_records:type_name -> poolrpc.CorruptedRecord
+	165, // 116: poolrpc.SetBatchPolicyRequest.policy:type_name -> poolrpc.BatchPolicy
+	165, // 117: poolrpc.SetBatchPolicyResponse.policy:type_name -> poolrpc.BatchPolicy
+	171, // 118: poolrpc.ListFundingFailuresResponse.failures:type_name -> poolrpc.FundingFailure
+	174, // 119: poolrpc.LeaseAuditResponse.audits:type_name -> poolrpc.LeaseAuditResult
+	188, // 120: poolrpc.BatchApprovalRequest.clearing_prices:type_name -> poolrpc.BatchApprovalRequest.ClearingPricesEntry
+	176, // 121: poolrpc.BatchApprovalRequest.matches:type_name -> poolrpc.BatchApprovalMatch
+	177, // 122: poolrpc.BatchApprovalRequest.accounts:type_name -> poolrpc.BatchApprovalAccount
+	198, // 123: poolrpc.LeaseDurationResponse.LeaseDurationBucketsEntry.value:type_name -> poolrpc.DurationBucketState
+	199, // 124: poolrpc.GetInfoResponse.MarketInfoEntry.value:type_name -> poolrpc.MarketInfo
+	134, // 125: poolrpc.Trader.GetInfo:input_type -> poolrpc.GetInfoRequest
+	146, // 126: poolrpc.Trader.StopDaemon:input_type -> poolrpc.StopDaemonRequest
+	143, // 127: poolrpc.Trader.SubscribeServerState:input_type -> poolrpc.SubscribeServerStateRequest
+	14,  // 128: poolrpc.Trader.QuoteAccount:input_type -> poolrpc.QuoteAccountRequest
+	11,  // 129: poolrpc.Trader.InitAccount:input_type -> poolrpc.InitAccountRequest
+	16,  // 130: poolrpc.Trader.ListAccounts:input_type -> poolrpc.ListAccountsRequest
+	21,  // 131: poolrpc.Trader.CloseAccount:input_type -> poolrpc.CloseAccountRequest
+	23,  // 132: poolrpc.Trader.WithdrawAndCloseAccount:input_type -> poolrpc.WithdrawAndCloseAccountRequest
+	25,  // 133: poolrpc.Trader.SweepExpiredAccount:input_type -> poolrpc.SweepExpiredAccountRequest
+	27,  // 134: poolrpc.Trader.WithdrawAccount:input_type -> poolrpc.WithdrawAccountRequest
+	30,  // 135: poolrpc.Trader.ScheduleWithdrawAccount:input_type -> poolrpc.ScheduleWithdrawAccountRequest
+	32,  // 136: poolrpc.Trader.ListScheduledWithdrawals:input_type -> poolrpc.ListScheduledWithdrawalsRequest
+	34,  // 137: poolrpc.Trader.CancelScheduledWithdraw:input_type -> poolrpc.CancelScheduledWithdrawRequest
+	36,  // 138: poolrpc.Trader.DepositAccount:input_type -> poolrpc.DepositAccountRequest
+	38,  // 139: poolrpc.Trader.DepositAccountPsbt:input_type -> poolrpc.DepositAccountPsbtRequest
+	40,  // 140: poolrpc.Trader.FinalizeDeposit:input_type -> poolrpc.FinalizeDepositRequest
+	42,  // 141: poolrpc.Trader.RenewAccount:input_type -> poolrpc.RenewAccountRequest
+	44,  // 142: poolrpc.Trader.UpdateAccountAutoRenew:input_type -> poolrpc.UpdateAccountAutoRenewRequest
+	46,  // 143: poolrpc.Trader.UpdateAccountReserve:input_type -> poolrpc.UpdateAccountReserveRequest
+	48,  // 144: poolrpc.Trader.BumpAccountFee:input_type -> poolrpc.BumpAccountFeeRequest
+	91,  // 145: poolrpc.Trader.RecoverAccounts:input_type -> poolrpc.RecoverAccountsRequest
+	93,  // 146: poolrpc.Trader.AccountEvents:input_type -> poolrpc.AccountEventsRequest
+	99,  // 147: poolrpc.Trader.SubscribeAccountUpdates:input_type -> poolrpc.SubscribeAccountUpdatesRequest
+	96,  // 148: poolrpc.Trader.ExportAccountWatchOnly:input_type -> poolrpc.ExportAccountWatchOnlyRequest
+	51,  // 149: poolrpc.Trader.SubmitOrder:input_type -> poolrpc.SubmitOrderRequest
+	51,  // 150: poolrpc.Trader.PrepareOrder:input_type -> poolrpc.SubmitOrderRequest
+	54,  // 151: poolrpc.Trader.SubmitSignedOrder:input_type -> poolrpc.SubmitSignedOrderRequest
+	55,  // 152: poolrpc.Trader.ListOrders:input_type -> poolrpc.ListOrdersRequest
+	57,  // 153: poolrpc.Trader.CancelOrder:input_type -> poolrpc.CancelOrderRequest
+	59,  // 154: poolrpc.Trader.ActivateOrder:input_type -> poolrpc.ActivateOrderRequest
+	61,  // 155: poolrpc.Trader.CancelAllOrders:input_type -> poolrpc.CancelAllOrdersRequest
+	64,  // 156: poolrpc.Trader.ReplaceOrder:input_type -> poolrpc.ReplaceOrderRequest
+	67,  // 157: poolrpc.Trader.SaveOrderTemplate:input_type -> poolrpc.SaveOrderTemplateRequest
+	69,  // 158: poolrpc.Trader.ListOrderTemplates:input_type -> poolrpc.ListOrderTemplatesRequest
+	71,  // 159: poolrpc.Trader.DeleteOrderTemplate:input_type -> poolrpc.DeleteOrderTemplateRequest
+	73,  // 160: poolrpc.Trader.SubmitOrderFromTemplate:input_type -> poolrpc.SubmitOrderFromTemplateRequest
+	74,  // 161: poolrpc.Trader.PruneArchivedOrders:input_type -> poolrpc.PruneArchivedOrdersRequest
+	76,  // 162: poolrpc.Trader.OrderStats:input_type -> poolrpc.OrderStatsRequest
+	82,  // 163: poolrpc.Trader.SubscribeOrderBook:input_type -> poolrpc.OrderBookRequest
+	82,  // 164: poolrpc.Trader.OrderBookSnapshot:input_type -> poolrpc.OrderBookRequest
+	83,  // 165: poolrpc.Trader.QuoteOrder:input_type -> poolrpc.QuoteOrderRequest
+	85,  // 166: poolrpc.Trader.QuoteMatch:input_type -> poolrpc.QuoteMatchRequest
+	101, // 167: poolrpc.Trader.AuctionFee:input_type -> poolrpc.AuctionFeeRequest
+	128, // 168: poolrpc.Trader.LeaseDurations:input_type -> poolrpc.LeaseDurationRequest
+	130, // 169: poolrpc.Trader.NextBatchInfo:input_type -> poolrpc.NextBatchInfoRequest
+	200, // 170: poolrpc.Trader.BatchSnapshot:input_type -> poolrpc.BatchSnapshotRequest
+	118, // 171: poolrpc.Trader.GetLsatTokens:input_type -> poolrpc.TokensRequest
+	121, // 172: poolrpc.Trader.ListLsatTokens:input_type -> poolrpc.ListLsatTokensRequest
+	124, // 173: poolrpc.Trader.RevokeLsatToken:input_type -> poolrpc.RevokeLsatTokenRequest
+	126, // 174: poolrpc.Trader.ImportLsatToken:input_type -> poolrpc.ImportLsatTokenRequest
+	104, // 175: poolrpc.Trader.Leases:input_type -> poolrpc.LeasesRequest
+	106, // 176: poolrpc.Trader.SubscribeLeaseEvents:input_type -> poolrpc.SubscribeLeaseEventsRequest
+	108, // 177: poolrpc.Trader.LeaseEvidence:input_type -> poolrpc.LeaseEvidenceRequest
+	132, // 178: poolrpc.Trader.NodeRatings:input_type -> poolrpc.NodeRatingRequest
+	201, // 179: poolrpc.Trader.BatchSnapshots:input_type -> poolrpc.BatchSnapshotsRequest
+	110, // 180: poolrpc.Trader.ListLocalBatchSnapshots:input_type -> poolrpc.ListLocalBatchSnapshotsRequest
+	115, // 181: poolrpc.Trader.AcceptedBatchProposal:input_type -> poolrpc.AcceptedBatchProposalRequest
+	166, // 182: poolrpc.Trader.SetBatchPolicy:input_type -> poolrpc.SetBatchPolicyRequest
+	168, // 183: poolrpc.Trader.GetBatchPolicy:input_type -> poolrpc.GetBatchPolicyRequest
+	169, // 184: poolrpc.Trader.ListFundingFailures:input_type -> poolrpc.ListFundingFailuresRequest
+	172, // 185: poolrpc.Trader.LeaseAudit:input_type -> poolrpc.LeaseAuditRequest
+	148, // 186: poolrpc.Trader.OfferSidecar:input_type -> poolrpc.OfferSidecarRequest
+	149, // 187: poolrpc.Trader.OfferSidecarBatch:input_type -> poolrpc.OfferSidecarBatchRequest
+	153, // 188: poolrpc.Trader.RegisterSidecar:input_type -> poolrpc.RegisterSidecarRequest
+	154, // 189: poolrpc.Trader.ExpectSidecarChannel:input_type -> poolrpc.ExpectSidecarChannelRequest
+	151, // 190: poolrpc.Trader.DecodeSidecarTicket:input_type -> poolrpc.SidecarTicket
+	156, // 191: poolrpc.Trader.ListSidecars:input_type -> poolrpc.ListSidecarsRequest
+	158, // 192: poolrpc.Trader.CancelSidecar:input_type -> poolrpc.CancelSidecarRequest
+	160, // 193: poolrpc.Trader.SubscribeSidecar:input_type -> poolrpc.SubscribeSidecarRequest
+	162, // 194: poolrpc.Trader.VerifyDB:input_type -> poolrpc.VerifyDBRequest
+	136, // 195: poolrpc.Trader.SetAuctioneerEndpoint:input_type -> poolrpc.SetAuctioneerEndpointRequest
+	138, // 196: poolrpc.Trader.BakeMacaroon:input_type -> poolrpc.BakeMacaroonRequest
+	140, // 197: poolrpc.Trader.DumpConfig:input_type -> poolrpc.DumpConfigRequest
+	175, // 198: poolrpc.BatchApprover.ApproveBatch:input_type -> poolrpc.BatchApprovalRequest
+	179, // 199: poolrpc.DownstreamAcceptor.AcceptChannel:input_type -> poolrpc.DownstreamAcceptRequest
+	135, // 200: poolrpc.Trader.GetInfo:output_type -> poolrpc.GetInfoResponse
+	147, // 201: poolrpc.Trader.StopDaemon:output_type -> poolrpc.StopDaemonResponse
+	144, // 202: poolrpc.Trader.SubscribeServerState:output_type -> poolrpc.ServerStateUpdate
+	15,  // 203: poolrpc.Trader.QuoteAccount:output_type -> poolrpc.QuoteAccountResponse
+	50,  // 204: poolrpc.Trader.InitAccount:output_type -> poolrpc.Account
+	17,  // 205: poolrpc.Trader.ListAccounts:output_type -> poolrpc.ListAccountsResponse
+	22,  // 206: poolrpc.Trader.CloseAccount:output_type -> poolrpc.CloseAccountResponse
+	24,  // 207: poolrpc.Trader.WithdrawAndCloseAccount:output_type -> poolrpc.WithdrawAndCloseAccountResponse
+	26,  // 208: poolrpc.Trader.SweepExpiredAccount:output_type -> poolrpc.SweepExpiredAccountResponse
+	28,  // 209: poolrpc.Trader.WithdrawAccount:output_type -> poolrpc.WithdrawAccountResponse
+	31,  // 210: poolrpc.Trader.ScheduleWithdrawAccount:output_type -> poolrpc.ScheduleWithdrawAccountResponse
+	33,  // 211: poolrpc.Trader.ListScheduledWithdrawals:output_type -> poolrpc.ListScheduledWithdrawalsResponse
+	35,  // 212: poolrpc.Trader.CancelScheduledWithdraw:output_type -> poolrpc.CancelScheduledWithdrawResponse
+	37,  // 213: poolrpc.Trader.DepositAccount:output_type -> poolrpc.DepositAccountResponse
+	39,  // 214: poolrpc.Trader.DepositAccountPsbt:output_type -> poolrpc.DepositAccountPsbtResponse
+	41,  // 215: poolrpc.Trader.FinalizeDeposit:output_type -> poolrpc.FinalizeDepositResponse
+	43,  // 216: poolrpc.Trader.RenewAccount:output_type -> poolrpc.RenewAccountResponse
+	45,  // 217: poolrpc.Trader.UpdateAccountAutoRenew:output_type -> poolrpc.UpdateAccountAutoRenewResponse
+	47,  // 218: poolrpc.Trader.UpdateAccountReserve:output_type -> poolrpc.UpdateAccountReserveResponse
+	49,  // 219: poolrpc.Trader.BumpAccountFee:output_type -> poolrpc.BumpAccountFeeResponse
+	92,  // 220: poolrpc.Trader.RecoverAccounts:output_type -> poolrpc.RecoverAccountsResponse
+	95,  // 221: poolrpc.Trader.AccountEvents:output_type -> poolrpc.AccountEventsResponse
+	100, // 222: poolrpc.Trader.SubscribeAccountUpdates:output_type -> poolrpc.AccountUpdate
+	98,  // 223: poolrpc.Trader.ExportAccountWatchOnly:output_type -> poolrpc.ExportAccountWatchOnlyResponse
+	52,  // 224: poolrpc.Trader.SubmitOrder:output_type -> poolrpc.SubmitOrderResponse
+	53,  // 225: poolrpc.Trader.PrepareOrder:output_type -> poolrpc.PrepareOrderResponse
+	52,  // 226: poolrpc.Trader.SubmitSignedOrder:output_type -> poolrpc.SubmitOrderResponse
+	56,  // 227: poolrpc.Trader.ListOrders:output_type -> poolrpc.ListOrdersResponse
+	58,  // 228: poolrpc.Trader.CancelOrder:output_type -> poolrpc.CancelOrderResponse
+	60,  // 229: poolrpc.Trader.ActivateOrder:output_type -> poolrpc.ActivateOrderResponse
+	62,  // 230: poolrpc.Trader.CancelAllOrders:output_type -> poolrpc.CancelAllOrdersResponse
+	65,  // 231: poolrpc.Trader.ReplaceOrder:output_type -> poolrpc.ReplaceOrderResponse
+	68,  // 232: poolrpc.Trader.SaveOrderTemplate:output_type -> poolrpc.SaveOrderTemplateResponse
+	70,  // 233: poolrpc.Trader.ListOrderTemplates:output_type -> poolrpc.ListOrderTemplatesResponse
+	72,  // 234: poolrpc.Trader.DeleteOrderTemplate:output_type -> poolrpc.DeleteOrderTemplateResponse
+	52,  // 235: poolrpc.Trader.SubmitOrderFromTemplate:output_type -> poolrpc.SubmitOrderResponse
+	75,  // 236: poolrpc.Trader.PruneArchivedOrders:output_type -> poolrpc.PruneArchivedOrdersResponse
+	78,  // 237: poolrpc.Trader.OrderStats:output_type -> poolrpc.OrderStatsResponse
+	202, // 238: poolrpc.Trader.SubscribeOrderBook:output_type -> poolrpc.OrderBookUpdate
+	202, // 239: poolrpc.Trader.OrderBookSnapshot:output_type -> poolrpc.OrderBookUpdate
+	84,  // 240: poolrpc.Trader.QuoteOrder:output_type -> poolrpc.QuoteOrderResponse
+	86,  // 241: poolrpc.Trader.QuoteMatch:output_type -> poolrpc.QuoteMatchResponse
+	102, // 242: poolrpc.Trader.AuctionFee:output_type -> poolrpc.AuctionFeeResponse
+	129, // 243: poolrpc.Trader.LeaseDurations:output_type -> poolrpc.LeaseDurationResponse
+	131, // 244: poolrpc.Trader.NextBatchInfo:output_type -> poolrpc.NextBatchInfoResponse
+	203, // 245: poolrpc.Trader.BatchSnapshot:output_type -> poolrpc.BatchSnapshotResponse
+	119, // 246: poolrpc.Trader.GetLsatTokens:output_type -> poolrpc.TokensResponse
+	122, // 247: poolrpc.Trader.ListLsatTokens:output_type -> poolrpc.ListLsatTokensResponse
+	125, // 248: poolrpc.Trader.RevokeLsatToken:output_type -> poolrpc.RevokeLsatTokenResponse
+	127, // 249: poolrpc.Trader.ImportLsatToken:output_type -> poolrpc.ImportLsatTokenResponse
+	105, // 250: poolrpc.Trader.Leases:output_type -> poolrpc.LeasesResponse
+	107, // 251: poolrpc.Trader.SubscribeLeaseEvents:output_type -> poolrpc.LeaseEvent
+	109, // 252: poolrpc.Trader.LeaseEvidence:output_type -> poolrpc.LeaseEvidenceResponse
+	133, // 253: poolrpc.Trader.NodeRatings:output_type -> poolrpc.NodeRatingResponse
+	204, // 254: poolrpc.Trader.BatchSnapshots:output_type -> poolrpc.BatchSnapshotsResponse
+	111, // 255: poolrpc.Trader.ListLocalBatchSnapshots:output_type -> poolrpc.ListLocalBatchSnapshotsResponse
+	116, // 256: poolrpc.Trader.AcceptedBatchProposal:output_type -> poolrpc.AcceptedBatchProposalResponse
+	167, // 257: poolrpc.Trader.SetBatchPolicy:output_type -> poolrpc.SetBatchPolicyResponse
+	165, // 258: poolrpc.Trader.GetBatchPolicy:output_type -> poolrpc.BatchPolicy
+	170, // 259: poolrpc.Trader.ListFundingFailures:output_type -> poolrpc.ListFundingFailuresResponse
+	173, // 260: poolrpc.Trader.LeaseAudit:output_type -> poolrpc.LeaseAuditResponse
+	151, // 261: poolrpc.Trader.OfferSidecar:output_type -> poolrpc.SidecarTicket
+	150, // 262: poolrpc.Trader.OfferSidecarBatch:output_type -> poolrpc.OfferSidecarBatchResponse
+	151, // 263: poolrpc.Trader.RegisterSidecar:output_type -> poolrpc.SidecarTicket
+	155, // 264: poolrpc.Trader.ExpectSidecarChannel:output_type -> poolrpc.ExpectSidecarChannelResponse
+	152, // 265: poolrpc.Trader.DecodeSidecarTicket:output_type -> poolrpc.DecodedSidecarTicket
+	157, // 266: poolrpc.Trader.ListSidecars:output_type -> poolrpc.ListSidecarsResponse
+	159, // 267: poolrpc.Trader.CancelSidecar:output_type -> poolrpc.CancelSidecarResponse
+	161, // 268: poolrpc.Trader.SubscribeSidecar:output_type -> poolrpc.SidecarUpdate
+	164, // 269: poolrpc.Trader.VerifyDB:output_type -> poolrpc.VerifyDBResponse
+	142, // 270: poolrpc.Trader.SetAuctioneerEndpoint:output_type -> poolrpc.SetAuctioneerEndpointResponse
+	139, // 271: poolrpc.Trader.BakeMacaroon:output_type -> poolrpc.BakeMacaroonResponse
+	141, // 272: poolrpc.Trader.DumpConfig:output_type -> poolrpc.DumpConfigResponse
+	178, // 273: poolrpc.BatchApprover.ApproveBatch:output_type -> poolrpc.BatchApprovalResponse
+	180, // 274: poolrpc.DownstreamAcceptor.AcceptChannel:output_type -> poolrpc.DownstreamAcceptResponse
+	200, // [200:275] is the sub-list for method output_type
+	125, // [125:200] is the sub-list for method input_type
+	125, // [125:125] is the sub-list for extension type_name
+	125, // [125:125] is the sub-list for extension extendee
+	0,   // [0:125] is the sub-list for field type_name
+}
+
+func init() { file_trader_proto_init() }
+func file_trader_proto_init() {
+	if File_trader_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_trader_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountTxDryRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputWithFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputsWithImplicitFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAndCloseAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAndCloseAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepExpiredAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepExpiredAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledWithdrawal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleWithdrawAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleWithdrawAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledWithdrawalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledWithdrawalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledWithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledWithdrawResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccountPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositAccountPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeDepositResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountAutoRenewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountAutoRenewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountReserveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountReserveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpAccountFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpAccountFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitSignedOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAllOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAllOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveOrderTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveOrderTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrderTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrderTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOrderTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOrderTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOrderFromTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneArchivedOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneArchivedOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseDurationOrderStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteMatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteMatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRateBumpEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountWatchOnlyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOnlyAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountWatchOnlyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeAccountUpdatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeLeaseEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseEvidenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5975,8 +18014,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteAccountRequest); i {
+		file_trader_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseEvidenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5987,8 +18026,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteAccountResponse); i {
+		file_trader_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLocalBatchSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5999,8 +18038,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsRequest); i {
+		file_trader_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLocalBatchSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6011,8 +18050,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsResponse); i {
+		file_trader_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalBatchSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6023,8 +18062,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Output); i {
+		file_trader_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchApprovalRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6035,8 +18074,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutputWithFee); i {
+		file_trader_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalMatchedOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6047,8 +18086,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutputsWithImplicitFee); i {
+		file_trader_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptedBatchProposalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6059,8 +18098,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseAccountRequest); i {
+		file_trader_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptedBatchProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6071,8 +18110,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseAccountResponse); i {
+		file_trader_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchedOrderUnits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6083,8 +18122,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawAccountRequest); i {
+		file_trader_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6095,8 +18134,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawAccountResponse); i {
+		file_trader_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6107,8 +18146,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositAccountRequest); i {
+		file_trader_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsatToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6119,8 +18158,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositAccountResponse); i {
+		file_trader_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLsatTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6131,8 +18170,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewAccountRequest); i {
+		file_trader_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLsatTokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6143,8 +18182,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewAccountResponse); i {
+		file_trader_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsatTokenInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6155,8 +18194,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BumpAccountFeeRequest); i {
+		file_trader_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeLsatTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6167,8 +18206,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BumpAccountFeeResponse); i {
+		file_trader_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeLsatTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6179,8 +18218,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_trader_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportLsatTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6191,8 +18230,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitOrderRequest); i {
+		file_trader_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportLsatTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6203,8 +18242,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitOrderResponse); i {
+		file_trader_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseDurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6215,8 +18254,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrdersRequest); i {
+		file_trader_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseDurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6227,8 +18266,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrdersResponse); i {
+		file_trader_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextBatchInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6239,8 +18278,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelOrderRequest); i {
+		file_trader_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextBatchInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6251,8 +18290,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelOrderResponse); i {
+		file_trader_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRatingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6263,8 +18302,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+		file_trader_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRatingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6275,8 +18314,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bid); i {
+		file_trader_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6287,8 +18326,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ask); i {
+		file_trader_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6299,8 +18338,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteOrderRequest); i {
+		file_trader_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAuctioneerEndpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6311,8 +18350,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteOrderResponse); i {
+		file_trader_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacaroonPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6323,8 +18362,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderEvent); i {
+		file_trader_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeMacaroonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6335,8 +18374,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatedEvent); i {
+		file_trader_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeMacaroonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6347,8 +18386,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchEvent); i {
+		file_trader_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6359,8 +18398,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverAccountsRequest); i {
+		file_trader_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6371,8 +18410,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverAccountsResponse); i {
+		file_trader_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAuctioneerEndpointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6383,8 +18422,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuctionFeeRequest); i {
+		file_trader_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeServerStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6395,8 +18434,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuctionFeeResponse); i {
+		file_trader_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStateUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6407,8 +18446,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lease); i {
+		file_trader_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthGate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6419,8 +18458,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeasesRequest); i {
+		file_trader_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopDaemonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6431,8 +18470,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeasesResponse); i {
+		file_trader_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopDaemonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6443,8 +18482,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensRequest); i {
+		file_trader_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6455,8 +18494,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensResponse); i {
+		file_trader_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferSidecarBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6467,8 +18506,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsatToken); i {
+		file_trader_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferSidecarBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6479,8 +18518,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseDurationRequest); i {
+		file_trader_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidecarTicket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6491,8 +18530,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaseDurationResponse); i {
+		file_trader_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedSidecarTicket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6503,8 +18542,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextBatchInfoRequest); i {
+		file_trader_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6515,8 +18554,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextBatchInfoResponse); i {
+		file_trader_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectSidecarChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6527,8 +18566,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRatingRequest); i {
+		file_trader_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectSidecarChannelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6539,8 +18578,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRatingResponse); i {
+		file_trader_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSidecarsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6551,8 +18590,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoRequest); i {
+		file_trader_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSidecarsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6563,8 +18602,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoResponse); i {
+		file_trader_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6575,8 +18614,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopDaemonRequest); i {
+		file_trader_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSidecarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6587,8 +18626,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopDaemonResponse); i {
+		file_trader_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSidecarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6599,8 +18638,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OfferSidecarRequest); i {
+		file_trader_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidecarUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6611,8 +18650,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidecarTicket); i {
+		file_trader_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDBRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6623,8 +18662,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodedSidecarTicket); i {
+		file_trader_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorruptedRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6635,8 +18674,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterSidecarRequest); i {
+		file_trader_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDBResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6647,8 +18686,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpectSidecarChannelRequest); i {
+		file_trader_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6659,8 +18698,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpectSidecarChannelResponse); i {
+		file_trader_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBatchPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6671,8 +18710,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSidecarsRequest); i {
+		file_trader_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBatchPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6683,8 +18722,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSidecarsResponse); i {
+		file_trader_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6695,8 +18734,8 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSidecarRequest); i {
+		file_trader_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFundingFailuresRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6707,8 +18746,128 @@ func file_trader_proto_init() {
 				return nil
 			}
 		}
-		file_trader_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSidecarResponse); i {
+		file_trader_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFundingFailuresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundingFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseAuditResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchApprovalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchApprovalMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchApprovalAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchApprovalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownstreamAcceptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trader_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownstreamAcceptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6726,50 +18885,71 @@ func file_trader_proto_init() {
 		(*InitAccountRequest_ConfTarget)(nil),
 		(*InitAccountRequest_FeeRateSatPerKw)(nil),
 	}
-	file_trader_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*QuoteAccountRequest_ConfTarget)(nil),
 	}
-	file_trader_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*OutputWithFee_ConfTarget)(nil),
 		(*OutputWithFee_FeeRateSatPerKw)(nil),
 	}
-	file_trader_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*CloseAccountRequest_OutputWithFee)(nil),
 		(*CloseAccountRequest_Outputs)(nil),
 	}
-	file_trader_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*WithdrawAccountRequest_AbsoluteExpiry)(nil),
 		(*WithdrawAccountRequest_RelativeExpiry)(nil),
 	}
-	file_trader_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*DepositAccountRequest_AbsoluteExpiry)(nil),
 		(*DepositAccountRequest_RelativeExpiry)(nil),
 	}
-	file_trader_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*DepositAccountPsbtRequest_AbsoluteExpiry)(nil),
+		(*DepositAccountPsbtRequest_RelativeExpiry)(nil),
+	}
+	file_trader_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*RenewAccountRequest_AbsoluteExpiry)(nil),
 		(*RenewAccountRequest_RelativeExpiry)(nil),
 	}
-	file_trader_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[40].OneofWrappers = []interface{}{
 		(*SubmitOrderRequest_Ask)(nil),
 		(*SubmitOrderRequest_Bid)(nil),
 	}
-	file_trader_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*SubmitOrderResponse_InvalidOrder)(nil),
 		(*SubmitOrderResponse_AcceptedOrderNonce)(nil),
 	}
-	file_trader_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_trader_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*OrderTemplate_Ask)(nil),
+		(*OrderTemplate_Bid)(nil),
+	}
+	file_trader_proto_msgTypes[56].OneofWrappers = []interface{}{
+		(*SaveOrderTemplateRequest_Ask)(nil),
+		(*SaveOrderTemplateRequest_Bid)(nil),
+	}
+	file_trader_proto_msgTypes[72].OneofWrappers = []interface{}{
+		(*QuoteOrderRequest_Ask)(nil),
+		(*QuoteOrderRequest_Bid)(nil),
+	}
+	file_trader_proto_msgTypes[74].OneofWrappers = []interface{}{
+		(*QuoteMatchRequest_Ask)(nil),
+		(*QuoteMatchRequest_Bid)(nil),
+	}
+	file_trader_proto_msgTypes[76].OneofWrappers = []interface{}{
 		(*OrderEvent_StateChange)(nil),
 		(*OrderEvent_Matched)(nil),
+		(*OrderEvent_FeeRateBump)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_trader_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   66,
+			NumEnums:      11,
+			NumMessages:   178,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_trader_proto_goTypes,
 		DependencyIndexes: file_trader_proto_depIdxs,
@@ -74,10 +74,917 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.SubscribeServerState"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubscribeServerStateRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		stream, err := client.SubscribeServerState(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
 	registry["poolrpc.Trader.QuoteAccount"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &QuoteAccountRequest{}
+		req := &QuoteAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.QuoteAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.InitAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &InitAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.InitAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListAccounts"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListAccountsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListAccounts(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.CloseAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &CloseAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.CloseAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.WithdrawAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &WithdrawAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.WithdrawAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ScheduleWithdrawAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ScheduleWithdrawAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ScheduleWithdrawAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListScheduledWithdrawals"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListScheduledWithdrawalsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListScheduledWithdrawals(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.CancelScheduledWithdraw"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &CancelScheduledWithdrawRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.CancelScheduledWithdraw(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.DepositAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &DepositAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.DepositAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.DepositAccountPsbt"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &DepositAccountPsbtRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.DepositAccountPsbt(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.FinalizeDeposit"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &FinalizeDepositRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.FinalizeDeposit(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.WithdrawAndCloseAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+	registry["poolrpc.Trader.SweepExpiredAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &WithdrawAndCloseAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.WithdrawAndCloseAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.RenewAccount"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &RenewAccountRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.RenewAccount(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.UpdateAccountAutoRenew"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+	registry["poolrpc.Trader.UpdateAccountReserve"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &UpdateAccountAutoRenewRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.UpdateAccountAutoRenew(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.BumpAccountFee"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &BumpAccountFeeRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.BumpAccountFee(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.RecoverAccounts"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &RecoverAccountsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		stream, err := client.RecoverAccounts(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
+	registry["poolrpc.Trader.AccountEvents"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &AccountEventsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.AccountEvents(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SubscribeAccountUpdates"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubscribeAccountUpdatesRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		stream, err := client.SubscribeAccountUpdates(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
+	registry["poolrpc.Trader.ExportAccountWatchOnly"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ExportAccountWatchOnlyRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ExportAccountWatchOnly(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SubmitOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubmitOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SubmitOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.PrepareOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubmitOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.PrepareOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SubmitSignedOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubmitSignedOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SubmitSignedOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListOrders"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListOrdersRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListOrders(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.CancelOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &CancelOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.CancelOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.CancelAllOrders"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &CancelAllOrdersRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.CancelAllOrders(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ReplaceOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ReplaceOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ReplaceOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.OrderStats"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &OrderStatsRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.OrderStats(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ActivateOrder"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ActivateOrderRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ActivateOrder(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SaveOrderTemplate"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SaveOrderTemplateRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SaveOrderTemplate(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.ListOrderTemplates"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &ListOrderTemplatesRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.ListOrderTemplates(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.DeleteOrderTemplate"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &DeleteOrderTemplateRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.DeleteOrderTemplate(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SubmitOrderFromTemplate"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubmitOrderFromTemplateRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SubmitOrderFromTemplate(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.PruneArchivedOrders"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &PruneArchivedOrdersRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.PruneArchivedOrders(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SubscribeOrderBook"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &OrderBookRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -85,7 +992,49 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.QuoteAccount(ctx, req)
+		stream, err := client.SubscribeOrderBook(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
+	registry["poolrpc.Trader.OrderBookSnapshot"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &OrderBookRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.OrderBookSnapshot(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -99,10 +1048,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.InitAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.QuoteOrder"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &InitAccountRequest{}
+		req := &QuoteOrderRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -110,7 +1059,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.InitAccount(ctx, req)
+		resp, err := client.QuoteOrder(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -124,10 +1073,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.ListAccounts"] = func(ctx context.Context,
+	registry["poolrpc.Trader.QuoteMatch"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &ListAccountsRequest{}
+		req := &QuoteMatchRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -135,7 +1084,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.ListAccounts(ctx, req)
+		resp, err := client.QuoteMatch(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -149,10 +1098,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.CloseAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.AuctionFee"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &CloseAccountRequest{}
+		req := &AuctionFeeRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -160,7 +1109,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.CloseAccount(ctx, req)
+		resp, err := client.AuctionFee(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -174,10 +1123,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.WithdrawAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.LeaseDurations"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &WithdrawAccountRequest{}
+		req := &LeaseDurationRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -185,7 +1134,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.WithdrawAccount(ctx, req)
+		resp, err := client.LeaseDurations(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -199,10 +1148,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.DepositAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.NextBatchInfo"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &DepositAccountRequest{}
+		req := &NextBatchInfoRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -210,7 +1159,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.DepositAccount(ctx, req)
+		resp, err := client.NextBatchInfo(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -224,10 +1173,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.RenewAccount"] = func(ctx context.Context,
+	registry["poolrpc.Trader.BatchSnapshot"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &RenewAccountRequest{}
+		req := &auctioneerrpc.BatchSnapshotRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -235,7 +1184,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.RenewAccount(ctx, req)
+		resp, err := client.BatchSnapshot(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -249,10 +1198,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.BumpAccountFee"] = func(ctx context.Context,
+	registry["poolrpc.Trader.GetLsatTokens"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &BumpAccountFeeRequest{}
+		req := &TokensRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -260,7 +1209,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.BumpAccountFee(ctx, req)
+		resp, err := client.GetLsatTokens(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -274,10 +1223,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.RecoverAccounts"] = func(ctx context.Context,
+	registry["poolrpc.Trader.ListLsatTokens"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &RecoverAccountsRequest{}
+		req := &ListLsatTokensRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -285,7 +1234,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.RecoverAccounts(ctx, req)
+		resp, err := client.ListLsatTokens(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -299,10 +1248,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.SubmitOrder"] = func(ctx context.Context,
+	registry["poolrpc.Trader.RevokeLsatToken"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &SubmitOrderRequest{}
+		req := &RevokeLsatTokenRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -310,7 +1259,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.SubmitOrder(ctx, req)
+		resp, err := client.RevokeLsatToken(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -324,10 +1273,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.ListOrders"] = func(ctx context.Context,
+	registry["poolrpc.Trader.ImportLsatToken"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &ListOrdersRequest{}
+		req := &ImportLsatTokenRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -335,7 +1284,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.ListOrders(ctx, req)
+		resp, err := client.ImportLsatToken(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -349,10 +1298,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.CancelOrder"] = func(ctx context.Context,
+	registry["poolrpc.Trader.Leases"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &CancelOrderRequest{}
+		req := &LeasesRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -360,7 +1309,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.CancelOrder(ctx, req)
+		resp, err := client.Leases(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -374,10 +1323,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.QuoteOrder"] = func(ctx context.Context,
+	registry["poolrpc.Trader.SubscribeLeaseEvents"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &QuoteOrderRequest{}
+		req := &SubscribeLeaseEventsRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -385,7 +1334,49 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.QuoteOrder(ctx, req)
+		stream, err := client.SubscribeLeaseEvents(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
+	registry["poolrpc.Trader.LeaseEvidence"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &LeaseEvidenceRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.LeaseEvidence(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -399,10 +1390,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.AuctionFee"] = func(ctx context.Context,
+	registry["poolrpc.Trader.NodeRatings"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &AuctionFeeRequest{}
+		req := &NodeRatingRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -410,7 +1401,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.AuctionFee(ctx, req)
+		resp, err := client.NodeRatings(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -424,10 +1415,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.LeaseDurations"] = func(ctx context.Context,
+	registry["poolrpc.Trader.BatchSnapshots"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &LeaseDurationRequest{}
+		req := &auctioneerrpc.BatchSnapshotsRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -435,7 +1426,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.LeaseDurations(ctx, req)
+		resp, err := client.BatchSnapshots(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -449,10 +1440,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.NextBatchInfo"] = func(ctx context.Context,
+	registry["poolrpc.Trader.ListLocalBatchSnapshots"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &NextBatchInfoRequest{}
+		req := &ListLocalauctioneerrpc.BatchSnapshotsRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -460,7 +1451,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.NextBatchInfo(ctx, req)
+		resp, err := client.ListLocalBatchSnapshots(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -474,10 +1465,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.BatchSnapshot"] = func(ctx context.Context,
+	registry["poolrpc.Trader.AcceptedBatchProposal"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &auctioneerrpc.BatchSnapshotRequest{}
+		req := &AcceptedBatchProposalRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -485,7 +1476,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.BatchSnapshot(ctx, req)
+		resp, err := client.AcceptedBatchProposal(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -499,10 +1490,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.GetLsatTokens"] = func(ctx context.Context,
+	registry["poolrpc.Trader.SetBatchPolicy"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &TokensRequest{}
+		req := &SetBatchPolicyRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -510,7 +1501,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.GetLsatTokens(ctx, req)
+		resp, err := client.SetBatchPolicy(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -524,10 +1515,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.Leases"] = func(ctx context.Context,
+	registry["poolrpc.Trader.GetBatchPolicy"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &LeasesRequest{}
+		req := &GetBatchPolicyRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -535,7 +1526,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.Leases(ctx, req)
+		resp, err := client.GetBatchPolicy(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -549,10 +1540,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.NodeRatings"] = func(ctx context.Context,
+	registry["poolrpc.Trader.ListFundingFailures"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &NodeRatingRequest{}
+		req := &ListFundingFailuresRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -560,7 +1551,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.NodeRatings(ctx, req)
+		resp, err := client.ListFundingFailures(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -574,10 +1565,10 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
-	registry["poolrpc.Trader.BatchSnapshots"] = func(ctx context.Context,
+	registry["poolrpc.Trader.LeaseAudit"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
-		req := &auctioneerrpc.BatchSnapshotsRequest{}
+		req := &LeaseAuditRequest{}
 		err := marshaler.Unmarshal([]byte(reqJSON), req)
 		if err != nil {
 			callback("", err)
@@ -585,7 +1576,7 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 
 		client := NewTraderClient(conn)
-		resp, err := client.BatchSnapshots(ctx, req)
+		resp, err := client.LeaseAudit(ctx, req)
 		if err != nil {
 			callback("", err)
 			return
@@ -624,6 +1615,31 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		callback(string(respBytes), nil)
 	}
 
+	registry["poolrpc.Trader.OfferSidecarBatch"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &OfferSidecarBatchRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.OfferSidecarBatch(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
 	registry["poolrpc.Trader.RegisterSidecar"] = func(ctx context.Context,
 		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
 
@@ -748,4 +1764,146 @@ func RegisterTraderJSONCallbacks(registry map[string]func(ctx context.Context,
 		}
 		callback(string(respBytes), nil)
 	}
+
+	registry["poolrpc.Trader.SubscribeSidecar"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SubscribeSidecarRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		stream, err := client.SubscribeSidecar(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		go func() {
+			for {
+				select {
+				case <-stream.Context().Done():
+					callback("", stream.Context().Err())
+					return
+				default:
+				}
+
+				resp, err := stream.Recv()
+				if err != nil {
+					callback("", err)
+					return
+				}
+
+				respBytes, err := marshaler.Marshal(resp)
+				if err != nil {
+					callback("", err)
+					return
+				}
+				callback(string(respBytes), nil)
+			}
+		}()
+	}
+
+	registry["poolrpc.Trader.VerifyDB"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &VerifyDBRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.VerifyDB(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.SetAuctioneerEndpoint"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &SetAuctioneerEndpointRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.SetAuctioneerEndpoint(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.BakeMacaroon"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &BakeMacaroonRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.BakeMacaroon(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
+
+	registry["poolrpc.Trader.DumpConfig"] = func(ctx context.Context,
+		conn *grpc.ClientConn, reqJSON string, callback func(string, error)) {
+
+		req := &DumpConfigRequest{}
+		err := marshaler.Unmarshal([]byte(reqJSON), req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		client := NewTraderClient(conn)
+		resp, err := client.DumpConfig(ctx, req)
+		if err != nil {
+			callback("", err)
+			return
+		}
+
+		respBytes, err := marshaler.Marshal(resp)
+		if err != nil {
+			callback("", err)
+			return
+		}
+		callback(string(respBytes), nil)
+	}
 }
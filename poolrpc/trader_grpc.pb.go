@@ -26,6 +26,11 @@ type TraderClient interface {
 	// pool: `stop`
 	//Stop gracefully shuts down the Pool trader daemon.
 	StopDaemon(ctx context.Context, in *StopDaemonRequest, opts ...grpc.CallOption) (*StopDaemonResponse, error)
+	// pool: `serverstate`
+	//SubscribeServerState streams an update every time the state of the
+	//connection to the auction server changes. The current state is sent first
+	//when subscribing.
+	SubscribeServerState(ctx context.Context, in *SubscribeServerStateRequest, opts ...grpc.CallOption) (Trader_SubscribeServerStateClient, error)
 	//
 	//QuoteAccount gets a fee quote to fund an account of the given size with the
 	//given confirmation target. If the connected lnd wallet doesn't have enough
@@ -43,33 +48,113 @@ type TraderClient interface {
 	//CloseAccount closes an account and returns the funds locked in that account
 	//to the connected lnd node's wallet.
 	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// pool: `accounts withdrawclose`
+	//WithdrawAndCloseAccount closes an account by sending part of its balance to
+	//the specified outputs and the remainder, minus fees, to an address of the
+	//connected lnd node's wallet in a single transaction. The account must not
+	//have any active orders and no batch must be pending.
+	WithdrawAndCloseAccount(ctx context.Context, in *WithdrawAndCloseAccountRequest, opts ...grpc.CallOption) (*WithdrawAndCloseAccountResponse, error)
+	// pool: `accounts sweep`
+	//SweepExpiredAccount sweeps the full balance of an expired account through
+	//the account's expiry path. This only requires the trader's signature and
+	//can be used to recover the funds if the auctioneer is unavailable.
+	SweepExpiredAccount(ctx context.Context, in *SweepExpiredAccountRequest, opts ...grpc.CallOption) (*SweepExpiredAccountResponse, error)
 	// pool: `accounts withdraw`
 	//WithdrawAccount splits off parts of the account balance into the specified
 	//outputs while recreating the account with a reduced balance.
 	WithdrawAccount(ctx context.Context, in *WithdrawAccountRequest, opts ...grpc.CallOption) (*WithdrawAccountResponse, error)
+	// pool: `accounts schedulewithdraw`
+	//ScheduleWithdrawAccount schedules a withdrawal from an account that is
+	//executed automatically once the estimated fee rate drops to or below the
+	//given maximum fee rate. Any previously scheduled withdrawal of the account
+	//is replaced.
+	ScheduleWithdrawAccount(ctx context.Context, in *ScheduleWithdrawAccountRequest, opts ...grpc.CallOption) (*ScheduleWithdrawAccountResponse, error)
+	// pool: `accounts listscheduled`
+	//ListScheduledWithdrawals returns all withdrawals that are scheduled but
+	//weren't executed yet.
+	ListScheduledWithdrawals(ctx context.Context, in *ListScheduledWithdrawalsRequest, opts ...grpc.CallOption) (*ListScheduledWithdrawalsResponse, error)
+	// pool: `accounts cancelscheduled`
+	//CancelScheduledWithdraw cancels the scheduled withdrawal of an account.
+	CancelScheduledWithdraw(ctx context.Context, in *CancelScheduledWithdrawRequest, opts ...grpc.CallOption) (*CancelScheduledWithdrawResponse, error)
 	// pool: `accounts deposit`
 	//DepositAccount adds more funds from the connected lnd node's wallet to an
 	//account.
 	DepositAccount(ctx context.Context, in *DepositAccountRequest, opts ...grpc.CallOption) (*DepositAccountResponse, error)
+	// pool: `accounts depositpsbt`
+	//DepositAccountPsbt initiates a deposit into an account that is funded by an
+	//external wallet. The returned PSBT spends the current account output into
+	//the new account output and must be funded, signed and then passed to
+	//FinalizeDeposit. Because the auctioneer reconstructs the transaction, the
+	//funded PSBT must keep version 2 and a lock time of 0, use a sequence of 0
+	//for all inputs and be sorted according to BIP-69.
+	DepositAccountPsbt(ctx context.Context, in *DepositAccountPsbtRequest, opts ...grpc.CallOption) (*DepositAccountPsbtResponse, error)
+	// pool: `accounts finalizedeposit`
+	//FinalizeDeposit completes a deposit that was initiated with
+	//DepositAccountPsbt using the PSBT funded and signed by the external wallet
+	//and broadcasts the deposit transaction.
+	FinalizeDeposit(ctx context.Context, in *FinalizeDepositRequest, opts ...grpc.CallOption) (*FinalizeDepositResponse, error)
 	// pool: `accounts renew`
 	//RenewAccount renews the expiration of an account.
 	RenewAccount(ctx context.Context, in *RenewAccountRequest, opts ...grpc.CallOption) (*RenewAccountResponse, error)
+	// pool: `accounts autorenew`
+	//UpdateAccountAutoRenew enables or disables the automatic renewal of an
+	//account. If enabled, the account is renewed once the best height comes
+	//within the given renewal window of its expiry.
+	UpdateAccountAutoRenew(ctx context.Context, in *UpdateAccountAutoRenewRequest, opts ...grpc.CallOption) (*UpdateAccountAutoRenewResponse, error)
+	// pool: `accounts reserve`
+	//UpdateAccountReserve updates the reserve of an account. Orders may not
+	//spend into the reserve, which ensures the account can always pay for a
+	//sweep through its expiry path.
+	UpdateAccountReserve(ctx context.Context, in *UpdateAccountReserveRequest, opts ...grpc.CallOption) (*UpdateAccountReserveResponse, error)
 	// pool: `accounts bumpfee`
 	//BumpAccountFee attempts to bump the fee of an account's transaction through
 	//child-pays-for-parent (CPFP). Since the CPFP is performed through the
 	//backing lnd node, the account transaction must contain an output under its
 	//control for a successful bump. If a CPFP has already been performed for an
 	//account, and this RPC is invoked again, then a replacing transaction (RBF)
-	//of the child will be broadcast.
+	//of the child will be broadcast. Accounts that are part of a pending batch
+	//can't be bumped. Every successful bump is recorded as an account event.
 	BumpAccountFee(ctx context.Context, in *BumpAccountFeeRequest, opts ...grpc.CallOption) (*BumpAccountFeeResponse, error)
 	// pool: `accounts recover`
 	//RecoverAccounts queries the auction server for this trader daemon's accounts
-	//in case we lost our local account database.
-	RecoverAccounts(ctx context.Context, in *RecoverAccountsRequest, opts ...grpc.CallOption) (*RecoverAccountsResponse, error)
+	//in case we lost our local account database. The progress of the recovery is
+	//streamed back, including every account that is found. Accounts are stored
+	//as soon as they are found, so an interrupted recovery can be resumed.
+	RecoverAccounts(ctx context.Context, in *RecoverAccountsRequest, opts ...grpc.CallOption) (Trader_RecoverAccountsClient, error)
+	// pool: `accounts events`
+	//AccountEvents returns the history of all changes of an account's value,
+	//expiry or state together with the action that triggered them.
+	AccountEvents(ctx context.Context, in *AccountEventsRequest, opts ...grpc.CallOption) (*AccountEventsResponse, error)
+	// pool: `accounts subscribe`
+	//SubscribeAccountUpdates streams an update every time the state, value or
+	//outpoint of an account changes. The current state of all accounts is sent
+	//first when subscribing, so clients don't miss any transitions that
+	//happened while they weren't connected.
+	SubscribeAccountUpdates(ctx context.Context, in *SubscribeAccountUpdatesRequest, opts ...grpc.CallOption) (Trader_SubscribeAccountUpdatesClient, error)
+	// pool: `accounts exportwatchonly`
+	//ExportAccountWatchOnly returns everything a third party needs to detect
+	//unexpected spends of the current outputs of all active accounts. The
+	//export deliberately contains no key material that allows signing for an
+	//account nor the shared secret that would reveal its other outputs.
+	ExportAccountWatchOnly(ctx context.Context, in *ExportAccountWatchOnlyRequest, opts ...grpc.CallOption) (*ExportAccountWatchOnlyResponse, error)
 	// pool: `orders submit`
 	//SubmitOrder creates a new ask or bid order and submits for the given account
-	//and submits it to the auction server for matching.
+	//and submits it to the auction server for matching. If no account is given,
+	//the account with the furthest expiry that has enough free balance to cover
+	//the order is selected automatically.
 	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	// pool: `orders submit --offline`
+	//PrepareOrder validates a new ask or bid order and derives all keys for it
+	//but neither signs, stores nor submits it. The returned digest of the order
+	//must be signed with the trader key of the account outside of the daemon,
+	//for example with the pool-sign tool, before the serialized order is
+	//submitted with SubmitSignedOrder.
+	PrepareOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*PrepareOrderResponse, error)
+	// pool: `orders submitsigned`
+	//SubmitSignedOrder verifies the signature of an order that was prepared with
+	//PrepareOrder against the trader key of its account, then stores the order
+	//locally and submits it to the auction server.
+	SubmitSignedOrder(ctx context.Context, in *SubmitSignedOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
 	// pool: `orders list`
 	//ListOrders returns a list of all active and archived orders that are
 	//currently known to the trader daemon.
@@ -78,10 +163,74 @@ type TraderClient interface {
 	//CancelOrder cancels an active order with the auction server to remove it
 	//from future matching.
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// pool: `orders activate`
+	//ActivateOrder sends an order that was submitted with the stage flag set to
+	//the auction server.
+	ActivateOrder(ctx context.Context, in *ActivateOrderRequest, opts ...grpc.CallOption) (*ActivateOrderResponse, error)
+	// pool: `orders cancel --all`
+	//CancelAllOrders cancels all active orders that match the given filters with
+	//the auction server. The result of each order's cancellation is returned so
+	//a partially failed cancellation is visible.
+	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
+	// pool: `orders update`
+	//ReplaceOrder replaces an active order with a new order that has the given
+	//parameters. The old order is canceled with the auction server and the new
+	//one is submitted right away. If the new order can't be submitted, the old
+	//order is not restored.
+	ReplaceOrder(ctx context.Context, in *ReplaceOrderRequest, opts ...grpc.CallOption) (*ReplaceOrderResponse, error)
+	// pool: `orders submit --save_template`
+	//SaveOrderTemplate validates the given order parameters against the current
+	//auctioneer terms and stores them as a named template, replacing any existing
+	//template of the same name. The amount and account of the order are not part
+	//of the template.
+	SaveOrderTemplate(ctx context.Context, in *SaveOrderTemplateRequest, opts ...grpc.CallOption) (*SaveOrderTemplateResponse, error)
+	// pool: `orders template list`
+	//ListOrderTemplates returns all stored order templates.
+	ListOrderTemplates(ctx context.Context, in *ListOrderTemplatesRequest, opts ...grpc.CallOption) (*ListOrderTemplatesResponse, error)
+	// pool: `orders template delete`
+	//DeleteOrderTemplate removes the order template with the given name.
+	DeleteOrderTemplate(ctx context.Context, in *DeleteOrderTemplateRequest, opts ...grpc.CallOption) (*DeleteOrderTemplateResponse, error)
+	// pool: `orders submit --template`
+	//SubmitOrderFromTemplate creates a new order from a stored template and the
+	//given amount and submits it to the auction server. The template is
+	//validated against the current auctioneer terms first, so a template that
+	//became stale since it was saved is rejected.
+	SubmitOrderFromTemplate(ctx context.Context, in *SubmitOrderFromTemplateRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	// pool: `orders prune`
+	//PruneArchivedOrders removes archived orders and their events from the local
+	//database if their most recent event happened before the given timestamp.
+	//Orders that were matched in a batch, pending or finalized, are never
+	//removed as the batch history and the leases still refer to them.
+	PruneArchivedOrders(ctx context.Context, in *PruneArchivedOrdersRequest, opts ...grpc.CallOption) (*PruneArchivedOrdersResponse, error)
+	// pool: `orders stats`
+	//OrderStats returns the fill statistics of all our orders, aggregated per
+	//lease duration market. Only units that were actually filled in a batch are
+	//counted, so partially filled orders that were canceled later on are
+	//included with their filled units only.
+	OrderStats(ctx context.Context, in *OrderStatsRequest, opts ...grpc.CallOption) (*OrderStatsResponse, error)
+	//
+	//SubscribeOrderBook streams the order book of the market with the given
+	//lease duration as it is relayed from the auctioneer. The current book is
+	//sent as a snapshot first, followed by incremental updates. A new snapshot
+	//is sent whenever the connection to the auctioneer had to be re-established.
+	//Subscribers that can't keep up with the updates are disconnected.
+	SubscribeOrderBook(ctx context.Context, in *OrderBookRequest, opts ...grpc.CallOption) (Trader_SubscribeOrderBookClient, error)
+	//
+	//OrderBookSnapshot returns the cached order book of the market with the
+	//given lease duration. Only the best levels of each side are cached.
+	OrderBookSnapshot(ctx context.Context, in *OrderBookRequest, opts ...grpc.CallOption) (*auctioneerrpc.OrderBookUpdate, error)
 	//
 	//QuoteOrder calculates the premium, execution fees and max batch fee rate for
 	//an order based on the given order parameters.
 	QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error)
+	// pool: `orders simulate`
+	//QuoteMatch estimates how the given order would be filled if the next
+	//batch was cleared right now. The order is added to a snapshot of the order
+	//book of its market, which is then cleared with the uniform-price matching
+	//of the auction. The result is only an estimate, as the actual batch depends
+	//on the orders submitted until then and on constraints that aren't part of
+	//the order book, like node tiers and the minimum match size of other orders.
+	QuoteMatch(ctx context.Context, in *QuoteMatchRequest, opts ...grpc.CallOption) (*QuoteMatchResponse, error)
 	// pool: `auction fee`
 	//AuctionFee returns the current auction order execution fee specified by the
 	//auction server.
@@ -102,10 +251,38 @@ type TraderClient interface {
 	// pool: `listauth`
 	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
 	GetLsatTokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (*TokensResponse, error)
+	// pool: `auth list`
+	//ListLsatTokens returns all LSAT tokens of the token store, including
+	//pending and revoked ones, in the order they were created.
+	ListLsatTokens(ctx context.Context, in *ListLsatTokensRequest, opts ...grpc.CallOption) (*ListLsatTokensResponse, error)
+	// pool: `auth revoke`
+	//RevokeLsatToken revokes the current LSAT token. The token is kept for
+	//accounting purposes but no longer used, a new token is acquired with the
+	//next call to the auction server.
+	RevokeLsatToken(ctx context.Context, in *RevokeLsatTokenRequest, opts ...grpc.CallOption) (*RevokeLsatTokenResponse, error)
+	// pool: `auth import`
+	//ImportLsatToken imports a paid LSAT token, for example one that was
+	//acquired by the trader daemon on another machine. The current token must be
+	//revoked first. The connection to the auction server is re-established with
+	//the imported token.
+	ImportLsatToken(ctx context.Context, in *ImportLsatTokenRequest, opts ...grpc.CallOption) (*ImportLsatTokenResponse, error)
 	// pool: `auction leases`
 	//Leases returns the list of channels that were either purchased or sold by
 	//the trader within the auction.
 	Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error)
+	// pool: `auction leaseevents`
+	//SubscribeLeaseEvents streams an event every time the channel of a lease
+	//is force closed before the lease expired. Optionally all recorded events
+	//are sent first, so the stream can be used to export the data needed to
+	//file a complaint about a misbehaving peer.
+	SubscribeLeaseEvents(ctx context.Context, in *SubscribeLeaseEventsRequest, opts ...grpc.CallOption) (Trader_SubscribeLeaseEventsClient, error)
+	// pool: `leases evidence`
+	//LeaseEvidence collects everything needed to prove that the channel of a
+	//lease was closed before the lease expired into a single serialized proof
+	//that can be handed to the auctioneer operator: the lease terms, the batch
+	//snapshot, our signed order and the channel's funding and closing
+	//transactions.
+	LeaseEvidence(ctx context.Context, in *LeaseEvidenceRequest, opts ...grpc.CallOption) (*LeaseEvidenceResponse, error)
 	// pool: `auction ratings`
 	//Returns the Node Tier information for this target Lightning node, and other
 	//related ranking information.
@@ -117,6 +294,37 @@ type TraderClient interface {
 	//one call. If no start batch ID is provided, the most recent finalized batch
 	//is used as the starting point to go back from.
 	BatchSnapshots(ctx context.Context, in *auctioneerrpc.BatchSnapshotsRequest, opts ...grpc.CallOption) (*auctioneerrpc.BatchSnapshotsResponse, error)
+	// pool: `auction localsnapshots`
+	//ListLocalBatchSnapshots returns the locally stored snapshots of the batches
+	//this trader participated in, starting at the start batch ID and going back
+	//through the history of batches, returning at most the number of specified
+	//batches. If no start batch ID is provided, the most recent batch the trader
+	//participated in is used as the starting point to go back from.
+	ListLocalBatchSnapshots(ctx context.Context, in *ListLocalBatchSnapshotsRequest, opts ...grpc.CallOption) (*ListLocalBatchSnapshotsResponse, error)
+	// pool: `auction proposal`
+	//AcceptedBatchProposal returns what the trader agreed to with the auctioneer
+	//when signing for a batch that wasn't finalized yet. If no batch ID is
+	//provided, the proposal of the current pending batch is returned.
+	AcceptedBatchProposal(ctx context.Context, in *AcceptedBatchProposalRequest, opts ...grpc.CallOption) (*AcceptedBatchProposalResponse, error)
+	// pool: `policy set`
+	//SetBatchPolicy replaces the batch policy of the trader. The policy is a set
+	//of rules every batch the trader takes part in must satisfy, otherwise the
+	//batch is rejected. The new policy is persisted and applies to the next
+	//batch that is verified.
+	SetBatchPolicy(ctx context.Context, in *SetBatchPolicyRequest, opts ...grpc.CallOption) (*SetBatchPolicyResponse, error)
+	// pool: `policy show`
+	//GetBatchPolicy returns the batch policy that is currently enforced.
+	GetBatchPolicy(ctx context.Context, in *GetBatchPolicyRequest, opts ...grpc.CallOption) (*BatchPolicy, error)
+	// pool: `auction fundingfailures`
+	//ListFundingFailures returns all channels of signed batches whose funding
+	//flow could not be resumed after a restart of the trader daemon. These
+	//channels need manual intervention.
+	ListFundingFailures(ctx context.Context, in *ListFundingFailuresRequest, opts ...grpc.CallOption) (*ListFundingFailuresResponse, error)
+	// pool: `auction leaseaudit`
+	//LeaseAudit returns the results of comparing the lease expiry of every
+	//channel that completed its funding flow with the lease expiry promised in
+	//its batch. Mismatches are flagged.
+	LeaseAudit(ctx context.Context, in *LeaseAuditRequest, opts ...grpc.CallOption) (*LeaseAuditResponse, error)
 	// pool: `sidecar offer`
 	//OfferSidecar is step 1/4 of the sidecar negotiation between the provider
 	//(the trader submitting the bid order) and the recipient (the trader
@@ -126,6 +334,14 @@ type TraderClient interface {
 	//signed with the provider's lnd node public key. The ticket returned by this
 	//call will have the state "offered".
 	OfferSidecar(ctx context.Context, in *OfferSidecarRequest, opts ...grpc.CallOption) (*SidecarTicket, error)
+	// pool: `sidecar offer`
+	//OfferSidecarBatch creates multiple sidecar tickets with the same offer in
+	//a single call. This is the bulk version of OfferSidecar, meant for
+	//providers that onboard many recipients. The call fails without creating
+	//any ticket if the account couldn't fund all of them (together with its
+	//active orders and previously offered tickets) if every ticket is
+	//registered and matched.
+	OfferSidecarBatch(ctx context.Context, in *OfferSidecarBatchRequest, opts ...grpc.CallOption) (*OfferSidecarBatchResponse, error)
 	// pool: `sidecar register`
 	//RegisterSidecarRequest is step 2/4 of the sidecar negotiation between the
 	//provider (the trader submitting the bid order) and the recipient (the trader
@@ -157,6 +373,35 @@ type TraderClient interface {
 	//on the state of the sidecar ticket its associated bid order might be
 	//canceled as well (if this ticket was offered by our node).
 	CancelSidecar(ctx context.Context, in *CancelSidecarRequest, opts ...grpc.CallOption) (*CancelSidecarResponse, error)
+	// pool: `sidecar subscribe`
+	//SubscribeSidecar streams an update every time a sidecar ticket with the
+	//given ID changes its state, including cancellations together with their
+	//reason. The current state of the ticket is sent first when subscribing.
+	//Optionally the recorded history of state changes is sent before that, so
+	//a reconnecting client can catch up on the transitions it missed.
+	SubscribeSidecar(ctx context.Context, in *SubscribeSidecarRequest, opts ...grpc.CallOption) (Trader_SubscribeSidecarClient, error)
+	// pool: `debug verifydb`
+	//VerifyDB scans the local database for records that don't match their
+	//checksum or cannot be decoded and reports them. The database is not
+	//modified.
+	VerifyDB(ctx context.Context, in *VerifyDBRequest, opts ...grpc.CallOption) (*VerifyDBResponse, error)
+	// pool: `debug setendpoint`
+	//SetAuctioneerEndpoint forces the trader daemon to use the given auction
+	//server endpoint, which must be one of the configured endpoints. The
+	//endpoint is tried first on every reconnect from now on, the other endpoints
+	//are only used if it can't be reached. If no endpoint is given, the
+	//endpoints are selected automatically again.
+	SetAuctioneerEndpoint(ctx context.Context, in *SetAuctioneerEndpointRequest, opts ...grpc.CallOption) (*SetAuctioneerEndpointResponse, error)
+	// pool: `bakemacaroon`
+	//BakeMacaroon bakes a new macaroon that only grants the given permissions.
+	//The macaroon can optionally be locked to an IP address and expire after a
+	//timeout.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// pool: `debug dumpconfig`
+	//DumpConfig returns the effective configuration of the trader daemon after
+	//the command line flags, environment variables and config file were
+	//combined. The values of secret options are redacted.
+	DumpConfig(ctx context.Context, in *DumpConfigRequest, opts ...grpc.CallOption) (*DumpConfigResponse, error)
 }
 
 type traderClient struct {
@@ -185,6 +430,38 @@ func (c *traderClient) StopDaemon(ctx context.Context, in *StopDaemonRequest, op
 	return out, nil
 }
 
+func (c *traderClient) SubscribeServerState(ctx context.Context, in *SubscribeServerStateRequest, opts ...grpc.CallOption) (Trader_SubscribeServerStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[0], "/poolrpc.Trader/SubscribeServerState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &traderSubscribeServerStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trader_SubscribeServerStateClient interface {
+	Recv() (*ServerStateUpdate, error)
+	grpc.ClientStream
+}
+
+type traderSubscribeServerStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderSubscribeServerStateClient) Recv() (*ServerStateUpdate, error) {
+	m := new(ServerStateUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *traderClient) QuoteAccount(ctx context.Context, in *QuoteAccountRequest, opts ...grpc.CallOption) (*QuoteAccountResponse, error) {
 	out := new(QuoteAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/QuoteAccount", in, out, opts...)
@@ -221,6 +498,24 @@ func (c *traderClient) CloseAccount(ctx context.Context, in *CloseAccountRequest
 	return out, nil
 }
 
+func (c *traderClient) WithdrawAndCloseAccount(ctx context.Context, in *WithdrawAndCloseAccountRequest, opts ...grpc.CallOption) (*WithdrawAndCloseAccountResponse, error) {
+	out := new(WithdrawAndCloseAccountResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/WithdrawAndCloseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SweepExpiredAccount(ctx context.Context, in *SweepExpiredAccountRequest, opts ...grpc.CallOption) (*SweepExpiredAccountResponse, error) {
+	out := new(SweepExpiredAccountResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SweepExpiredAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) WithdrawAccount(ctx context.Context, in *WithdrawAccountRequest, opts ...grpc.CallOption) (*WithdrawAccountResponse, error) {
 	out := new(WithdrawAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/WithdrawAccount", in, out, opts...)
@@ -230,6 +525,33 @@ func (c *traderClient) WithdrawAccount(ctx context.Context, in *WithdrawAccountR
 	return out, nil
 }
 
+func (c *traderClient) ScheduleWithdrawAccount(ctx context.Context, in *ScheduleWithdrawAccountRequest, opts ...grpc.CallOption) (*ScheduleWithdrawAccountResponse, error) {
+	out := new(ScheduleWithdrawAccountResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ScheduleWithdrawAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListScheduledWithdrawals(ctx context.Context, in *ListScheduledWithdrawalsRequest, opts ...grpc.CallOption) (*ListScheduledWithdrawalsResponse, error) {
+	out := new(ListScheduledWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListScheduledWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) CancelScheduledWithdraw(ctx context.Context, in *CancelScheduledWithdrawRequest, opts ...grpc.CallOption) (*CancelScheduledWithdrawResponse, error) {
+	out := new(CancelScheduledWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/CancelScheduledWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) DepositAccount(ctx context.Context, in *DepositAccountRequest, opts ...grpc.CallOption) (*DepositAccountResponse, error) {
 	out := new(DepositAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DepositAccount", in, out, opts...)
@@ -239,6 +561,24 @@ func (c *traderClient) DepositAccount(ctx context.Context, in *DepositAccountReq
 	return out, nil
 }
 
+func (c *traderClient) DepositAccountPsbt(ctx context.Context, in *DepositAccountPsbtRequest, opts ...grpc.CallOption) (*DepositAccountPsbtResponse, error) {
+	out := new(DepositAccountPsbtResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DepositAccountPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) FinalizeDeposit(ctx context.Context, in *FinalizeDepositRequest, opts ...grpc.CallOption) (*FinalizeDepositResponse, error) {
+	out := new(FinalizeDepositResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/FinalizeDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) RenewAccount(ctx context.Context, in *RenewAccountRequest, opts ...grpc.CallOption) (*RenewAccountResponse, error) {
 	out := new(RenewAccountResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/RenewAccount", in, out, opts...)
@@ -248,6 +588,24 @@ func (c *traderClient) RenewAccount(ctx context.Context, in *RenewAccountRequest
 	return out, nil
 }
 
+func (c *traderClient) UpdateAccountAutoRenew(ctx context.Context, in *UpdateAccountAutoRenewRequest, opts ...grpc.CallOption) (*UpdateAccountAutoRenewResponse, error) {
+	out := new(UpdateAccountAutoRenewResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/UpdateAccountAutoRenew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) UpdateAccountReserve(ctx context.Context, in *UpdateAccountReserveRequest, opts ...grpc.CallOption) (*UpdateAccountReserveResponse, error) {
+	out := new(UpdateAccountReserveResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/UpdateAccountReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) BumpAccountFee(ctx context.Context, in *BumpAccountFeeRequest, opts ...grpc.CallOption) (*BumpAccountFeeResponse, error) {
 	out := new(BumpAccountFeeResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/BumpAccountFee", in, out, opts...)
@@ -257,9 +615,82 @@ func (c *traderClient) BumpAccountFee(ctx context.Context, in *BumpAccountFeeReq
 	return out, nil
 }
 
-func (c *traderClient) RecoverAccounts(ctx context.Context, in *RecoverAccountsRequest, opts ...grpc.CallOption) (*RecoverAccountsResponse, error) {
-	out := new(RecoverAccountsResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/RecoverAccounts", in, out, opts...)
+func (c *traderClient) RecoverAccounts(ctx context.Context, in *RecoverAccountsRequest, opts ...grpc.CallOption) (Trader_RecoverAccountsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[1], "/poolrpc.Trader/RecoverAccounts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &traderRecoverAccountsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trader_RecoverAccountsClient interface {
+	Recv() (*RecoverAccountsResponse, error)
+	grpc.ClientStream
+}
+
+type traderRecoverAccountsClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderRecoverAccountsClient) Recv() (*RecoverAccountsResponse, error) {
+	m := new(RecoverAccountsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *traderClient) AccountEvents(ctx context.Context, in *AccountEventsRequest, opts ...grpc.CallOption) (*AccountEventsResponse, error) {
+	out := new(AccountEventsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/AccountEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SubscribeAccountUpdates(ctx context.Context, in *SubscribeAccountUpdatesRequest, opts ...grpc.CallOption) (Trader_SubscribeAccountUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[2], "/poolrpc.Trader/SubscribeAccountUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &traderSubscribeAccountUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trader_SubscribeAccountUpdatesClient interface {
+	Recv() (*AccountUpdate, error)
+	grpc.ClientStream
+}
+
+type traderSubscribeAccountUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderSubscribeAccountUpdatesClient) Recv() (*AccountUpdate, error) {
+	m := new(AccountUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *traderClient) ExportAccountWatchOnly(ctx context.Context, in *ExportAccountWatchOnlyRequest, opts ...grpc.CallOption) (*ExportAccountWatchOnlyResponse, error) {
+	out := new(ExportAccountWatchOnlyResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ExportAccountWatchOnly", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -275,6 +706,24 @@ func (c *traderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest,
 	return out, nil
 }
 
+func (c *traderClient) PrepareOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*PrepareOrderResponse, error) {
+	out := new(PrepareOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/PrepareOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SubmitSignedOrder(ctx context.Context, in *SubmitSignedOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SubmitSignedOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
 	out := new(ListOrdersResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListOrders", in, out, opts...)
@@ -293,6 +742,128 @@ func (c *traderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest,
 	return out, nil
 }
 
+func (c *traderClient) ActivateOrder(ctx context.Context, in *ActivateOrderRequest, opts ...grpc.CallOption) (*ActivateOrderResponse, error) {
+	out := new(ActivateOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ActivateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
+	out := new(CancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ReplaceOrder(ctx context.Context, in *ReplaceOrderRequest, opts ...grpc.CallOption) (*ReplaceOrderResponse, error) {
+	out := new(ReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SaveOrderTemplate(ctx context.Context, in *SaveOrderTemplateRequest, opts ...grpc.CallOption) (*SaveOrderTemplateResponse, error) {
+	out := new(SaveOrderTemplateResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SaveOrderTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListOrderTemplates(ctx context.Context, in *ListOrderTemplatesRequest, opts ...grpc.CallOption) (*ListOrderTemplatesResponse, error) {
+	out := new(ListOrderTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListOrderTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) DeleteOrderTemplate(ctx context.Context, in *DeleteOrderTemplateRequest, opts ...grpc.CallOption) (*DeleteOrderTemplateResponse, error) {
+	out := new(DeleteOrderTemplateResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DeleteOrderTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SubmitOrderFromTemplate(ctx context.Context, in *SubmitOrderFromTemplateRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SubmitOrderFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) PruneArchivedOrders(ctx context.Context, in *PruneArchivedOrdersRequest, opts ...grpc.CallOption) (*PruneArchivedOrdersResponse, error) {
+	out := new(PruneArchivedOrdersResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/PruneArchivedOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) OrderStats(ctx context.Context, in *OrderStatsRequest, opts ...grpc.CallOption) (*OrderStatsResponse, error) {
+	out := new(OrderStatsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/OrderStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SubscribeOrderBook(ctx context.Context, in *OrderBookRequest, opts ...grpc.CallOption) (Trader_SubscribeOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[3], "/poolrpc.Trader/SubscribeOrderBook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &traderSubscribeOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trader_SubscribeOrderBookClient interface {
+	Recv() (*auctioneerrpc.OrderBookUpdate, error)
+	grpc.ClientStream
+}
+
+type traderSubscribeOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderSubscribeOrderBookClient) Recv() (*auctioneerrpc.OrderBookUpdate, error) {
+	m := new(auctioneerrpc.OrderBookUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *traderClient) OrderBookSnapshot(ctx context.Context, in *OrderBookRequest, opts ...grpc.CallOption) (*auctioneerrpc.OrderBookUpdate, error) {
+	out := new(auctioneerrpc.OrderBookUpdate)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/OrderBookSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error) {
 	out := new(QuoteOrderResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/QuoteOrder", in, out, opts...)
@@ -302,6 +873,15 @@ func (c *traderClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, op
 	return out, nil
 }
 
+func (c *traderClient) QuoteMatch(ctx context.Context, in *QuoteMatchRequest, opts ...grpc.CallOption) (*QuoteMatchResponse, error) {
+	out := new(QuoteMatchResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/QuoteMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *traderClient) AuctionFee(ctx context.Context, in *AuctionFeeRequest, opts ...grpc.CallOption) (*AuctionFeeResponse, error) {
 	out := new(AuctionFeeResponse)
 	err := c.cc.Invoke(ctx, "/poolrpc.Trader/AuctionFee", in, out, opts...)
@@ -347,82 +927,281 @@ func (c *traderClient) GetLsatTokens(ctx context.Context, in *TokensRequest, opt
 	return out, nil
 }
 
-func (c *traderClient) Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error) {
-	out := new(LeasesResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/Leases", in, out, opts...)
+func (c *traderClient) ListLsatTokens(ctx context.Context, in *ListLsatTokensRequest, opts ...grpc.CallOption) (*ListLsatTokensResponse, error) {
+	out := new(ListLsatTokensResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListLsatTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *traderClient) NodeRatings(ctx context.Context, in *NodeRatingRequest, opts ...grpc.CallOption) (*NodeRatingResponse, error) {
-	out := new(NodeRatingResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/NodeRatings", in, out, opts...)
+func (c *traderClient) RevokeLsatToken(ctx context.Context, in *RevokeLsatTokenRequest, opts ...grpc.CallOption) (*RevokeLsatTokenResponse, error) {
+	out := new(RevokeLsatTokenResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/RevokeLsatToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *traderClient) BatchSnapshots(ctx context.Context, in *auctioneerrpc.BatchSnapshotsRequest, opts ...grpc.CallOption) (*auctioneerrpc.BatchSnapshotsResponse, error) {
-	out := new(auctioneerrpc.BatchSnapshotsResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/BatchSnapshots", in, out, opts...)
+func (c *traderClient) ImportLsatToken(ctx context.Context, in *ImportLsatTokenRequest, opts ...grpc.CallOption) (*ImportLsatTokenResponse, error) {
+	out := new(ImportLsatTokenResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ImportLsatToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *traderClient) OfferSidecar(ctx context.Context, in *OfferSidecarRequest, opts ...grpc.CallOption) (*SidecarTicket, error) {
-	out := new(SidecarTicket)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/OfferSidecar", in, out, opts...)
+func (c *traderClient) Leases(ctx context.Context, in *LeasesRequest, opts ...grpc.CallOption) (*LeasesResponse, error) {
+	out := new(LeasesResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/Leases", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *traderClient) RegisterSidecar(ctx context.Context, in *RegisterSidecarRequest, opts ...grpc.CallOption) (*SidecarTicket, error) {
-	out := new(SidecarTicket)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/RegisterSidecar", in, out, opts...)
+func (c *traderClient) SubscribeLeaseEvents(ctx context.Context, in *SubscribeLeaseEventsRequest, opts ...grpc.CallOption) (Trader_SubscribeLeaseEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[4], "/poolrpc.Trader/SubscribeLeaseEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &traderSubscribeLeaseEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *traderClient) ExpectSidecarChannel(ctx context.Context, in *ExpectSidecarChannelRequest, opts ...grpc.CallOption) (*ExpectSidecarChannelResponse, error) {
-	out := new(ExpectSidecarChannelResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ExpectSidecarChannel", in, out, opts...)
-	if err != nil {
+type Trader_SubscribeLeaseEventsClient interface {
+	Recv() (*LeaseEvent, error)
+	grpc.ClientStream
+}
+
+type traderSubscribeLeaseEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderSubscribeLeaseEventsClient) Recv() (*LeaseEvent, error) {
+	m := new(LeaseEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *traderClient) DecodeSidecarTicket(ctx context.Context, in *SidecarTicket, opts ...grpc.CallOption) (*DecodedSidecarTicket, error) {
-	out := new(DecodedSidecarTicket)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DecodeSidecarTicket", in, out, opts...)
+func (c *traderClient) LeaseEvidence(ctx context.Context, in *LeaseEvidenceRequest, opts ...grpc.CallOption) (*LeaseEvidenceResponse, error) {
+	out := new(LeaseEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/LeaseEvidence", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *traderClient) ListSidecars(ctx context.Context, in *ListSidecarsRequest, opts ...grpc.CallOption) (*ListSidecarsResponse, error) {
-	out := new(ListSidecarsResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListSidecars", in, out, opts...)
+func (c *traderClient) NodeRatings(ctx context.Context, in *NodeRatingRequest, opts ...grpc.CallOption) (*NodeRatingResponse, error) {
+	out := new(NodeRatingResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/NodeRatings", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *traderClient) CancelSidecar(ctx context.Context, in *CancelSidecarRequest, opts ...grpc.CallOption) (*CancelSidecarResponse, error) {
-	out := new(CancelSidecarResponse)
-	err := c.cc.Invoke(ctx, "/poolrpc.Trader/CancelSidecar", in, out, opts...)
-	if err != nil {
+func (c *traderClient) BatchSnapshots(ctx context.Context, in *auctioneerrpc.BatchSnapshotsRequest, opts ...grpc.CallOption) (*auctioneerrpc.BatchSnapshotsResponse, error) {
+	out := new(auctioneerrpc.BatchSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/BatchSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListLocalBatchSnapshots(ctx context.Context, in *ListLocalBatchSnapshotsRequest, opts ...grpc.CallOption) (*ListLocalBatchSnapshotsResponse, error) {
+	out := new(ListLocalBatchSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListLocalBatchSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) AcceptedBatchProposal(ctx context.Context, in *AcceptedBatchProposalRequest, opts ...grpc.CallOption) (*AcceptedBatchProposalResponse, error) {
+	out := new(AcceptedBatchProposalResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/AcceptedBatchProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SetBatchPolicy(ctx context.Context, in *SetBatchPolicyRequest, opts ...grpc.CallOption) (*SetBatchPolicyResponse, error) {
+	out := new(SetBatchPolicyResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SetBatchPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) GetBatchPolicy(ctx context.Context, in *GetBatchPolicyRequest, opts ...grpc.CallOption) (*BatchPolicy, error) {
+	out := new(BatchPolicy)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/GetBatchPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListFundingFailures(ctx context.Context, in *ListFundingFailuresRequest, opts ...grpc.CallOption) (*ListFundingFailuresResponse, error) {
+	out := new(ListFundingFailuresResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListFundingFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) LeaseAudit(ctx context.Context, in *LeaseAuditRequest, opts ...grpc.CallOption) (*LeaseAuditResponse, error) {
+	out := new(LeaseAuditResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/LeaseAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) OfferSidecar(ctx context.Context, in *OfferSidecarRequest, opts ...grpc.CallOption) (*SidecarTicket, error) {
+	out := new(SidecarTicket)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/OfferSidecar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) OfferSidecarBatch(ctx context.Context, in *OfferSidecarBatchRequest, opts ...grpc.CallOption) (*OfferSidecarBatchResponse, error) {
+	out := new(OfferSidecarBatchResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/OfferSidecarBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) RegisterSidecar(ctx context.Context, in *RegisterSidecarRequest, opts ...grpc.CallOption) (*SidecarTicket, error) {
+	out := new(SidecarTicket)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/RegisterSidecar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ExpectSidecarChannel(ctx context.Context, in *ExpectSidecarChannelRequest, opts ...grpc.CallOption) (*ExpectSidecarChannelResponse, error) {
+	out := new(ExpectSidecarChannelResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ExpectSidecarChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) DecodeSidecarTicket(ctx context.Context, in *SidecarTicket, opts ...grpc.CallOption) (*DecodedSidecarTicket, error) {
+	out := new(DecodedSidecarTicket)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DecodeSidecarTicket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) ListSidecars(ctx context.Context, in *ListSidecarsRequest, opts ...grpc.CallOption) (*ListSidecarsResponse, error) {
+	out := new(ListSidecarsResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/ListSidecars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) CancelSidecar(ctx context.Context, in *CancelSidecarRequest, opts ...grpc.CallOption) (*CancelSidecarResponse, error) {
+	out := new(CancelSidecarResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/CancelSidecar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SubscribeSidecar(ctx context.Context, in *SubscribeSidecarRequest, opts ...grpc.CallOption) (Trader_SubscribeSidecarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trader_ServiceDesc.Streams[5], "/poolrpc.Trader/SubscribeSidecar", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &traderSubscribeSidecarClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trader_SubscribeSidecarClient interface {
+	Recv() (*SidecarUpdate, error)
+	grpc.ClientStream
+}
+
+type traderSubscribeSidecarClient struct {
+	grpc.ClientStream
+}
+
+func (x *traderSubscribeSidecarClient) Recv() (*SidecarUpdate, error) {
+	m := new(SidecarUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *traderClient) VerifyDB(ctx context.Context, in *VerifyDBRequest, opts ...grpc.CallOption) (*VerifyDBResponse, error) {
+	out := new(VerifyDBResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/VerifyDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) SetAuctioneerEndpoint(ctx context.Context, in *SetAuctioneerEndpointRequest, opts ...grpc.CallOption) (*SetAuctioneerEndpointResponse, error) {
+	out := new(SetAuctioneerEndpointResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/SetAuctioneerEndpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/BakeMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *traderClient) DumpConfig(ctx context.Context, in *DumpConfigRequest, opts ...grpc.CallOption) (*DumpConfigResponse, error) {
+	out := new(DumpConfigResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.Trader/DumpConfig", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return out, nil
@@ -439,6 +1218,11 @@ type TraderServer interface {
 	// pool: `stop`
 	//Stop gracefully shuts down the Pool trader daemon.
 	StopDaemon(context.Context, *StopDaemonRequest) (*StopDaemonResponse, error)
+	// pool: `serverstate`
+	//SubscribeServerState streams an update every time the state of the
+	//connection to the auction server changes. The current state is sent first
+	//when subscribing.
+	SubscribeServerState(*SubscribeServerStateRequest, Trader_SubscribeServerStateServer) error
 	//
 	//QuoteAccount gets a fee quote to fund an account of the given size with the
 	//given confirmation target. If the connected lnd wallet doesn't have enough
@@ -456,33 +1240,113 @@ type TraderServer interface {
 	//CloseAccount closes an account and returns the funds locked in that account
 	//to the connected lnd node's wallet.
 	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// pool: `accounts withdrawclose`
+	//WithdrawAndCloseAccount closes an account by sending part of its balance to
+	//the specified outputs and the remainder, minus fees, to an address of the
+	//connected lnd node's wallet in a single transaction. The account must not
+	//have any active orders and no batch must be pending.
+	WithdrawAndCloseAccount(context.Context, *WithdrawAndCloseAccountRequest) (*WithdrawAndCloseAccountResponse, error)
+	// pool: `accounts sweep`
+	//SweepExpiredAccount sweeps the full balance of an expired account through
+	//the account's expiry path. This only requires the trader's signature and
+	//can be used to recover the funds if the auctioneer is unavailable.
+	SweepExpiredAccount(context.Context, *SweepExpiredAccountRequest) (*SweepExpiredAccountResponse, error)
 	// pool: `accounts withdraw`
 	//WithdrawAccount splits off parts of the account balance into the specified
 	//outputs while recreating the account with a reduced balance.
 	WithdrawAccount(context.Context, *WithdrawAccountRequest) (*WithdrawAccountResponse, error)
+	// pool: `accounts schedulewithdraw`
+	//ScheduleWithdrawAccount schedules a withdrawal from an account that is
+	//executed automatically once the estimated fee rate drops to or below the
+	//given maximum fee rate. Any previously scheduled withdrawal of the account
+	//is replaced.
+	ScheduleWithdrawAccount(context.Context, *ScheduleWithdrawAccountRequest) (*ScheduleWithdrawAccountResponse, error)
+	// pool: `accounts listscheduled`
+	//ListScheduledWithdrawals returns all withdrawals that are scheduled but
+	//weren't executed yet.
+	ListScheduledWithdrawals(context.Context, *ListScheduledWithdrawalsRequest) (*ListScheduledWithdrawalsResponse, error)
+	// pool: `accounts cancelscheduled`
+	//CancelScheduledWithdraw cancels the scheduled withdrawal of an account.
+	CancelScheduledWithdraw(context.Context, *CancelScheduledWithdrawRequest) (*CancelScheduledWithdrawResponse, error)
 	// pool: `accounts deposit`
 	//DepositAccount adds more funds from the connected lnd node's wallet to an
 	//account.
 	DepositAccount(context.Context, *DepositAccountRequest) (*DepositAccountResponse, error)
+	// pool: `accounts depositpsbt`
+	//DepositAccountPsbt initiates a deposit into an account that is funded by an
+	//external wallet. The returned PSBT spends the current account output into
+	//the new account output and must be funded, signed and then passed to
+	//FinalizeDeposit. Because the auctioneer reconstructs the transaction, the
+	//funded PSBT must keep version 2 and a lock time of 0, use a sequence of 0
+	//for all inputs and be sorted according to BIP-69.
+	DepositAccountPsbt(context.Context, *DepositAccountPsbtRequest) (*DepositAccountPsbtResponse, error)
+	// pool: `accounts finalizedeposit`
+	//FinalizeDeposit completes a deposit that was initiated with
+	//DepositAccountPsbt using the PSBT funded and signed by the external wallet
+	//and broadcasts the deposit transaction.
+	FinalizeDeposit(context.Context, *FinalizeDepositRequest) (*FinalizeDepositResponse, error)
 	// pool: `accounts renew`
 	//RenewAccount renews the expiration of an account.
 	RenewAccount(context.Context, *RenewAccountRequest) (*RenewAccountResponse, error)
+	// pool: `accounts autorenew`
+	//UpdateAccountAutoRenew enables or disables the automatic renewal of an
+	//account. If enabled, the account is renewed once the best height comes
+	//within the given renewal window of its expiry.
+	UpdateAccountAutoRenew(context.Context, *UpdateAccountAutoRenewRequest) (*UpdateAccountAutoRenewResponse, error)
+	// pool: `accounts reserve`
+	//UpdateAccountReserve updates the reserve of an account. Orders may not
+	//spend into the reserve, which ensures the account can always pay for a
+	//sweep through its expiry path.
+	UpdateAccountReserve(context.Context, *UpdateAccountReserveRequest) (*UpdateAccountReserveResponse, error)
 	// pool: `accounts bumpfee`
 	//BumpAccountFee attempts to bump the fee of an account's transaction through
 	//child-pays-for-parent (CPFP). Since the CPFP is performed through the
 	//backing lnd node, the account transaction must contain an output under its
 	//control for a successful bump. If a CPFP has already been performed for an
 	//account, and this RPC is invoked again, then a replacing transaction (RBF)
-	//of the child will be broadcast.
+	//of the child will be broadcast. Accounts that are part of a pending batch
+	//can't be bumped. Every successful bump is recorded as an account event.
 	BumpAccountFee(context.Context, *BumpAccountFeeRequest) (*BumpAccountFeeResponse, error)
 	// pool: `accounts recover`
 	//RecoverAccounts queries the auction server for this trader daemon's accounts
-	//in case we lost our local account database.
-	RecoverAccounts(context.Context, *RecoverAccountsRequest) (*RecoverAccountsResponse, error)
+	//in case we lost our local account database. The progress of the recovery is
+	//streamed back, including every account that is found. Accounts are stored
+	//as soon as they are found, so an interrupted recovery can be resumed.
+	RecoverAccounts(*RecoverAccountsRequest, Trader_RecoverAccountsServer) error
+	// pool: `accounts events`
+	//AccountEvents returns the history of all changes of an account's value,
+	//expiry or state together with the action that triggered them.
+	AccountEvents(context.Context, *AccountEventsRequest) (*AccountEventsResponse, error)
+	// pool: `accounts subscribe`
+	//SubscribeAccountUpdates streams an update every time the state, value or
+	//outpoint of an account changes. The current state of all accounts is sent
+	//first when subscribing, so clients don't miss any transitions that
+	//happened while they weren't connected.
+	SubscribeAccountUpdates(*SubscribeAccountUpdatesRequest, Trader_SubscribeAccountUpdatesServer) error
+	// pool: `accounts exportwatchonly`
+	//ExportAccountWatchOnly returns everything a third party needs to detect
+	//unexpected spends of the current outputs of all active accounts. The
+	//export deliberately contains no key material that allows signing for an
+	//account nor the shared secret that would reveal its other outputs.
+	ExportAccountWatchOnly(context.Context, *ExportAccountWatchOnlyRequest) (*ExportAccountWatchOnlyResponse, error)
 	// pool: `orders submit`
 	//SubmitOrder creates a new ask or bid order and submits for the given account
-	//and submits it to the auction server for matching.
+	//and submits it to the auction server for matching. If no account is given,
+	//the account with the furthest expiry that has enough free balance to cover
+	//the order is selected automatically.
 	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	// pool: `orders submit --offline`
+	//PrepareOrder validates a new ask or bid order and derives all keys for it
+	//but neither signs, stores nor submits it. The returned digest of the order
+	//must be signed with the trader key of the account outside of the daemon,
+	//for example with the pool-sign tool, before the serialized order is
+	//submitted with SubmitSignedOrder.
+	PrepareOrder(context.Context, *SubmitOrderRequest) (*PrepareOrderResponse, error)
+	// pool: `orders submitsigned`
+	//SubmitSignedOrder verifies the signature of an order that was prepared with
+	//PrepareOrder against the trader key of its account, then stores the order
+	//locally and submits it to the auction server.
+	SubmitSignedOrder(context.Context, *SubmitSignedOrderRequest) (*SubmitOrderResponse, error)
 	// pool: `orders list`
 	//ListOrders returns a list of all active and archived orders that are
 	//currently known to the trader daemon.
@@ -491,10 +1355,74 @@ type TraderServer interface {
 	//CancelOrder cancels an active order with the auction server to remove it
 	//from future matching.
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	// pool: `orders activate`
+	//ActivateOrder sends an order that was submitted with the stage flag set to
+	//the auction server.
+	ActivateOrder(context.Context, *ActivateOrderRequest) (*ActivateOrderResponse, error)
+	// pool: `orders cancel --all`
+	//CancelAllOrders cancels all active orders that match the given filters with
+	//the auction server. The result of each order's cancellation is returned so
+	//a partially failed cancellation is visible.
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	// pool: `orders update`
+	//ReplaceOrder replaces an active order with a new order that has the given
+	//parameters. The old order is canceled with the auction server and the new
+	//one is submitted right away. If the new order can't be submitted, the old
+	//order is not restored.
+	ReplaceOrder(context.Context, *ReplaceOrderRequest) (*ReplaceOrderResponse, error)
+	// pool: `orders submit --save_template`
+	//SaveOrderTemplate validates the given order parameters against the current
+	//auctioneer terms and stores them as a named template, replacing any existing
+	//template of the same name. The amount and account of the order are not part
+	//of the template.
+	SaveOrderTemplate(context.Context, *SaveOrderTemplateRequest) (*SaveOrderTemplateResponse, error)
+	// pool: `orders template list`
+	//ListOrderTemplates returns all stored order templates.
+	ListOrderTemplates(context.Context, *ListOrderTemplatesRequest) (*ListOrderTemplatesResponse, error)
+	// pool: `orders template delete`
+	//DeleteOrderTemplate removes the order template with the given name.
+	DeleteOrderTemplate(context.Context, *DeleteOrderTemplateRequest) (*DeleteOrderTemplateResponse, error)
+	// pool: `orders submit --template`
+	//SubmitOrderFromTemplate creates a new order from a stored template and the
+	//given amount and submits it to the auction server. The template is
+	//validated against the current auctioneer terms first, so a template that
+	//became stale since it was saved is rejected.
+	SubmitOrderFromTemplate(context.Context, *SubmitOrderFromTemplateRequest) (*SubmitOrderResponse, error)
+	// pool: `orders prune`
+	//PruneArchivedOrders removes archived orders and their events from the local
+	//database if their most recent event happened before the given timestamp.
+	//Orders that were matched in a batch, pending or finalized, are never
+	//removed as the batch history and the leases still refer to them.
+	PruneArchivedOrders(context.Context, *PruneArchivedOrdersRequest) (*PruneArchivedOrdersResponse, error)
+	// pool: `orders stats`
+	//OrderStats returns the fill statistics of all our orders, aggregated per
+	//lease duration market. Only units that were actually filled in a batch are
+	//counted, so partially filled orders that were canceled later on are
+	//included with their filled units only.
+	OrderStats(context.Context, *OrderStatsRequest) (*OrderStatsResponse, error)
+	//
+	//SubscribeOrderBook streams the order book of the market with the given
+	//lease duration as it is relayed from the auctioneer. The current book is
+	//sent as a snapshot first, followed by incremental updates. A new snapshot
+	//is sent whenever the connection to the auctioneer had to be re-established.
+	//Subscribers that can't keep up with the updates are disconnected.
+	SubscribeOrderBook(*OrderBookRequest, Trader_SubscribeOrderBookServer) error
+	//
+	//OrderBookSnapshot returns the cached order book of the market with the
+	//given lease duration. Only the best levels of each side are cached.
+	OrderBookSnapshot(context.Context, *OrderBookRequest) (*auctioneerrpc.OrderBookUpdate, error)
 	//
 	//QuoteOrder calculates the premium, execution fees and max batch fee rate for
 	//an order based on the given order parameters.
 	QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error)
+	// pool: `orders simulate`
+	//QuoteMatch estimates how the given order would be filled if the next
+	//batch was cleared right now. The order is added to a snapshot of the order
+	//book of its market, which is then cleared with the uniform-price matching
+	//of the auction. The result is only an estimate, as the actual batch depends
+	//on the orders submitted until then and on constraints that aren't part of
+	//the order book, like node tiers and the minimum match size of other orders.
+	QuoteMatch(context.Context, *QuoteMatchRequest) (*QuoteMatchResponse, error)
 	// pool: `auction fee`
 	//AuctionFee returns the current auction order execution fee specified by the
 	//auction server.
@@ -515,10 +1443,38 @@ type TraderServer interface {
 	// pool: `listauth`
 	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
 	GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error)
+	// pool: `auth list`
+	//ListLsatTokens returns all LSAT tokens of the token store, including
+	//pending and revoked ones, in the order they were created.
+	ListLsatTokens(context.Context, *ListLsatTokensRequest) (*ListLsatTokensResponse, error)
+	// pool: `auth revoke`
+	//RevokeLsatToken revokes the current LSAT token. The token is kept for
+	//accounting purposes but no longer used, a new token is acquired with the
+	//next call to the auction server.
+	RevokeLsatToken(context.Context, *RevokeLsatTokenRequest) (*RevokeLsatTokenResponse, error)
+	// pool: `auth import`
+	//ImportLsatToken imports a paid LSAT token, for example one that was
+	//acquired by the trader daemon on another machine. The current token must be
+	//revoked first. The connection to the auction server is re-established with
+	//the imported token.
+	ImportLsatToken(context.Context, *ImportLsatTokenRequest) (*ImportLsatTokenResponse, error)
 	// pool: `auction leases`
 	//Leases returns the list of channels that were either purchased or sold by
 	//the trader within the auction.
 	Leases(context.Context, *LeasesRequest) (*LeasesResponse, error)
+	// pool: `auction leaseevents`
+	//SubscribeLeaseEvents streams an event every time the channel of a lease
+	//is force closed before the lease expired. Optionally all recorded events
+	//are sent first, so the stream can be used to export the data needed to
+	//file a complaint about a misbehaving peer.
+	SubscribeLeaseEvents(*SubscribeLeaseEventsRequest, Trader_SubscribeLeaseEventsServer) error
+	// pool: `leases evidence`
+	//LeaseEvidence collects everything needed to prove that the channel of a
+	//lease was closed before the lease expired into a single serialized proof
+	//that can be handed to the auctioneer operator: the lease terms, the batch
+	//snapshot, our signed order and the channel's funding and closing
+	//transactions.
+	LeaseEvidence(context.Context, *LeaseEvidenceRequest) (*LeaseEvidenceResponse, error)
 	// pool: `auction ratings`
 	//Returns the Node Tier information for this target Lightning node, and other
 	//related ranking information.
@@ -530,6 +1486,37 @@ type TraderServer interface {
 	//one call. If no start batch ID is provided, the most recent finalized batch
 	//is used as the starting point to go back from.
 	BatchSnapshots(context.Context, *auctioneerrpc.BatchSnapshotsRequest) (*auctioneerrpc.BatchSnapshotsResponse, error)
+	// pool: `auction localsnapshots`
+	//ListLocalBatchSnapshots returns the locally stored snapshots of the batches
+	//this trader participated in, starting at the start batch ID and going back
+	//through the history of batches, returning at most the number of specified
+	//batches. If no start batch ID is provided, the most recent batch the trader
+	//participated in is used as the starting point to go back from.
+	ListLocalBatchSnapshots(context.Context, *ListLocalBatchSnapshotsRequest) (*ListLocalBatchSnapshotsResponse, error)
+	// pool: `auction proposal`
+	//AcceptedBatchProposal returns what the trader agreed to with the auctioneer
+	//when signing for a batch that wasn't finalized yet. If no batch ID is
+	//provided, the proposal of the current pending batch is returned.
+	AcceptedBatchProposal(context.Context, *AcceptedBatchProposalRequest) (*AcceptedBatchProposalResponse, error)
+	// pool: `policy set`
+	//SetBatchPolicy replaces the batch policy of the trader. The policy is a set
+	//of rules every batch the trader takes part in must satisfy, otherwise the
+	//batch is rejected. The new policy is persisted and applies to the next
+	//batch that is verified.
+	SetBatchPolicy(context.Context, *SetBatchPolicyRequest) (*SetBatchPolicyResponse, error)
+	// pool: `policy show`
+	//GetBatchPolicy returns the batch policy that is currently enforced.
+	GetBatchPolicy(context.Context, *GetBatchPolicyRequest) (*BatchPolicy, error)
+	// pool: `auction fundingfailures`
+	//ListFundingFailures returns all channels of signed batches whose funding
+	//flow could not be resumed after a restart of the trader daemon. These
+	//channels need manual intervention.
+	ListFundingFailures(context.Context, *ListFundingFailuresRequest) (*ListFundingFailuresResponse, error)
+	// pool: `auction leaseaudit`
+	//LeaseAudit returns the results of comparing the lease expiry of every
+	//channel that completed its funding flow with the lease expiry promised in
+	//its batch. Mismatches are flagged.
+	LeaseAudit(context.Context, *LeaseAuditRequest) (*LeaseAuditResponse, error)
 	// pool: `sidecar offer`
 	//OfferSidecar is step 1/4 of the sidecar negotiation between the provider
 	//(the trader submitting the bid order) and the recipient (the trader
@@ -539,6 +1526,14 @@ type TraderServer interface {
 	//signed with the provider's lnd node public key. The ticket returned by this
 	//call will have the state "offered".
 	OfferSidecar(context.Context, *OfferSidecarRequest) (*SidecarTicket, error)
+	// pool: `sidecar offer`
+	//OfferSidecarBatch creates multiple sidecar tickets with the same offer in
+	//a single call. This is the bulk version of OfferSidecar, meant for
+	//providers that onboard many recipients. The call fails without creating
+	//any ticket if the account couldn't fund all of them (together with its
+	//active orders and previously offered tickets) if every ticket is
+	//registered and matched.
+	OfferSidecarBatch(context.Context, *OfferSidecarBatchRequest) (*OfferSidecarBatchResponse, error)
 	// pool: `sidecar register`
 	//RegisterSidecarRequest is step 2/4 of the sidecar negotiation between the
 	//provider (the trader submitting the bid order) and the recipient (the trader
@@ -570,6 +1565,35 @@ type TraderServer interface {
 	//on the state of the sidecar ticket its associated bid order might be
 	//canceled as well (if this ticket was offered by our node).
 	CancelSidecar(context.Context, *CancelSidecarRequest) (*CancelSidecarResponse, error)
+	// pool: `sidecar subscribe`
+	//SubscribeSidecar streams an update every time a sidecar ticket with the
+	//given ID changes its state, including cancellations together with their
+	//reason. The current state of the ticket is sent first when subscribing.
+	//Optionally the recorded history of state changes is sent before that, so
+	//a reconnecting client can catch up on the transitions it missed.
+	SubscribeSidecar(*SubscribeSidecarRequest, Trader_SubscribeSidecarServer) error
+	// pool: `debug verifydb`
+	//VerifyDB scans the local database for records that don't match their
+	//checksum or cannot be decoded and reports them. The database is not
+	//modified.
+	VerifyDB(context.Context, *VerifyDBRequest) (*VerifyDBResponse, error)
+	// pool: `debug setendpoint`
+	//SetAuctioneerEndpoint forces the trader daemon to use the given auction
+	//server endpoint, which must be one of the configured endpoints. The
+	//endpoint is tried first on every reconnect from now on, the other endpoints
+	//are only used if it can't be reached. If no endpoint is given, the
+	//endpoints are selected automatically again.
+	SetAuctioneerEndpoint(context.Context, *SetAuctioneerEndpointRequest) (*SetAuctioneerEndpointResponse, error)
+	// pool: `bakemacaroon`
+	//BakeMacaroon bakes a new macaroon that only grants the given permissions.
+	//The macaroon can optionally be locked to an IP address and expire after a
+	//timeout.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// pool: `debug dumpconfig`
+	//DumpConfig returns the effective configuration of the trader daemon after
+	//the command line flags, environment variables and config file were
+	//combined. The values of secret options are redacted.
+	DumpConfig(context.Context, *DumpConfigRequest) (*DumpConfigResponse, error)
 	mustEmbedUnimplementedTraderServer()
 }
 
@@ -583,6 +1607,9 @@ func (UnimplementedTraderServer) GetInfo(context.Context, *GetInfoRequest) (*Get
 func (UnimplementedTraderServer) StopDaemon(context.Context, *StopDaemonRequest) (*StopDaemonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopDaemon not implemented")
 }
+func (UnimplementedTraderServer) SubscribeServerState(*SubscribeServerStateRequest, Trader_SubscribeServerStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeServerState not implemented")
+}
 func (UnimplementedTraderServer) QuoteAccount(context.Context, *QuoteAccountRequest) (*QuoteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteAccount not implemented")
 }
@@ -595,33 +1622,111 @@ func (UnimplementedTraderServer) ListAccounts(context.Context, *ListAccountsRequ
 func (UnimplementedTraderServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
 }
+func (UnimplementedTraderServer) WithdrawAndCloseAccount(context.Context, *WithdrawAndCloseAccountRequest) (*WithdrawAndCloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAndCloseAccount not implemented")
+}
+func (UnimplementedTraderServer) SweepExpiredAccount(context.Context, *SweepExpiredAccountRequest) (*SweepExpiredAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepExpiredAccount not implemented")
+}
 func (UnimplementedTraderServer) WithdrawAccount(context.Context, *WithdrawAccountRequest) (*WithdrawAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAccount not implemented")
 }
+func (UnimplementedTraderServer) ScheduleWithdrawAccount(context.Context, *ScheduleWithdrawAccountRequest) (*ScheduleWithdrawAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleWithdrawAccount not implemented")
+}
+func (UnimplementedTraderServer) ListScheduledWithdrawals(context.Context, *ListScheduledWithdrawalsRequest) (*ListScheduledWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledWithdrawals not implemented")
+}
+func (UnimplementedTraderServer) CancelScheduledWithdraw(context.Context, *CancelScheduledWithdrawRequest) (*CancelScheduledWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledWithdraw not implemented")
+}
 func (UnimplementedTraderServer) DepositAccount(context.Context, *DepositAccountRequest) (*DepositAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositAccount not implemented")
 }
+func (UnimplementedTraderServer) DepositAccountPsbt(context.Context, *DepositAccountPsbtRequest) (*DepositAccountPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAccountPsbt not implemented")
+}
+func (UnimplementedTraderServer) FinalizeDeposit(context.Context, *FinalizeDepositRequest) (*FinalizeDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeDeposit not implemented")
+}
 func (UnimplementedTraderServer) RenewAccount(context.Context, *RenewAccountRequest) (*RenewAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenewAccount not implemented")
 }
+func (UnimplementedTraderServer) UpdateAccountAutoRenew(context.Context, *UpdateAccountAutoRenewRequest) (*UpdateAccountAutoRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountAutoRenew not implemented")
+}
+func (UnimplementedTraderServer) UpdateAccountReserve(context.Context, *UpdateAccountReserveRequest) (*UpdateAccountReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountReserve not implemented")
+}
 func (UnimplementedTraderServer) BumpAccountFee(context.Context, *BumpAccountFeeRequest) (*BumpAccountFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BumpAccountFee not implemented")
 }
-func (UnimplementedTraderServer) RecoverAccounts(context.Context, *RecoverAccountsRequest) (*RecoverAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecoverAccounts not implemented")
+func (UnimplementedTraderServer) RecoverAccounts(*RecoverAccountsRequest, Trader_RecoverAccountsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RecoverAccounts not implemented")
+}
+func (UnimplementedTraderServer) AccountEvents(context.Context, *AccountEventsRequest) (*AccountEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountEvents not implemented")
+}
+func (UnimplementedTraderServer) SubscribeAccountUpdates(*SubscribeAccountUpdatesRequest, Trader_SubscribeAccountUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccountUpdates not implemented")
+}
+func (UnimplementedTraderServer) ExportAccountWatchOnly(context.Context, *ExportAccountWatchOnlyRequest) (*ExportAccountWatchOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountWatchOnly not implemented")
 }
 func (UnimplementedTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedTraderServer) PrepareOrder(context.Context, *SubmitOrderRequest) (*PrepareOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareOrder not implemented")
+}
+func (UnimplementedTraderServer) SubmitSignedOrder(context.Context, *SubmitSignedOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignedOrder not implemented")
+}
 func (UnimplementedTraderServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
 func (UnimplementedTraderServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedTraderServer) ActivateOrder(context.Context, *ActivateOrderRequest) (*ActivateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateOrder not implemented")
+}
+func (UnimplementedTraderServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (UnimplementedTraderServer) ReplaceOrder(context.Context, *ReplaceOrderRequest) (*ReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (UnimplementedTraderServer) SaveOrderTemplate(context.Context, *SaveOrderTemplateRequest) (*SaveOrderTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveOrderTemplate not implemented")
+}
+func (UnimplementedTraderServer) ListOrderTemplates(context.Context, *ListOrderTemplatesRequest) (*ListOrderTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderTemplates not implemented")
+}
+func (UnimplementedTraderServer) DeleteOrderTemplate(context.Context, *DeleteOrderTemplateRequest) (*DeleteOrderTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrderTemplate not implemented")
+}
+func (UnimplementedTraderServer) SubmitOrderFromTemplate(context.Context, *SubmitOrderFromTemplateRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrderFromTemplate not implemented")
+}
+func (UnimplementedTraderServer) PruneArchivedOrders(context.Context, *PruneArchivedOrdersRequest) (*PruneArchivedOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneArchivedOrders not implemented")
+}
+func (UnimplementedTraderServer) OrderStats(context.Context, *OrderStatsRequest) (*OrderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderStats not implemented")
+}
+func (UnimplementedTraderServer) SubscribeOrderBook(*OrderBookRequest, Trader_SubscribeOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOrderBook not implemented")
+}
+func (UnimplementedTraderServer) OrderBookSnapshot(context.Context, *OrderBookRequest) (*auctioneerrpc.OrderBookUpdate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookSnapshot not implemented")
+}
 func (UnimplementedTraderServer) QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
 }
+func (UnimplementedTraderServer) QuoteMatch(context.Context, *QuoteMatchRequest) (*QuoteMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteMatch not implemented")
+}
 func (UnimplementedTraderServer) AuctionFee(context.Context, *AuctionFeeRequest) (*AuctionFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuctionFee not implemented")
 }
@@ -637,18 +1742,54 @@ func (UnimplementedTraderServer) BatchSnapshot(context.Context, *auctioneerrpc.B
 func (UnimplementedTraderServer) GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLsatTokens not implemented")
 }
+func (UnimplementedTraderServer) ListLsatTokens(context.Context, *ListLsatTokensRequest) (*ListLsatTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLsatTokens not implemented")
+}
+func (UnimplementedTraderServer) RevokeLsatToken(context.Context, *RevokeLsatTokenRequest) (*RevokeLsatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLsatToken not implemented")
+}
+func (UnimplementedTraderServer) ImportLsatToken(context.Context, *ImportLsatTokenRequest) (*ImportLsatTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportLsatToken not implemented")
+}
 func (UnimplementedTraderServer) Leases(context.Context, *LeasesRequest) (*LeasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leases not implemented")
 }
+func (UnimplementedTraderServer) SubscribeLeaseEvents(*SubscribeLeaseEventsRequest, Trader_SubscribeLeaseEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeLeaseEvents not implemented")
+}
+func (UnimplementedTraderServer) LeaseEvidence(context.Context, *LeaseEvidenceRequest) (*LeaseEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseEvidence not implemented")
+}
 func (UnimplementedTraderServer) NodeRatings(context.Context, *NodeRatingRequest) (*NodeRatingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeRatings not implemented")
 }
 func (UnimplementedTraderServer) BatchSnapshots(context.Context, *auctioneerrpc.BatchSnapshotsRequest) (*auctioneerrpc.BatchSnapshotsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchSnapshots not implemented")
 }
+func (UnimplementedTraderServer) ListLocalBatchSnapshots(context.Context, *ListLocalBatchSnapshotsRequest) (*ListLocalBatchSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocalBatchSnapshots not implemented")
+}
+func (UnimplementedTraderServer) AcceptedBatchProposal(context.Context, *AcceptedBatchProposalRequest) (*AcceptedBatchProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptedBatchProposal not implemented")
+}
+func (UnimplementedTraderServer) SetBatchPolicy(context.Context, *SetBatchPolicyRequest) (*SetBatchPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBatchPolicy not implemented")
+}
+func (UnimplementedTraderServer) GetBatchPolicy(context.Context, *GetBatchPolicyRequest) (*BatchPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchPolicy not implemented")
+}
+func (UnimplementedTraderServer) ListFundingFailures(context.Context, *ListFundingFailuresRequest) (*ListFundingFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFundingFailures not implemented")
+}
+func (UnimplementedTraderServer) LeaseAudit(context.Context, *LeaseAuditRequest) (*LeaseAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseAudit not implemented")
+}
 func (UnimplementedTraderServer) OfferSidecar(context.Context, *OfferSidecarRequest) (*SidecarTicket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OfferSidecar not implemented")
 }
+func (UnimplementedTraderServer) OfferSidecarBatch(context.Context, *OfferSidecarBatchRequest) (*OfferSidecarBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferSidecarBatch not implemented")
+}
 func (UnimplementedTraderServer) RegisterSidecar(context.Context, *RegisterSidecarRequest) (*SidecarTicket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterSidecar not implemented")
 }
@@ -664,6 +1805,21 @@ func (UnimplementedTraderServer) ListSidecars(context.Context, *ListSidecarsRequ
 func (UnimplementedTraderServer) CancelSidecar(context.Context, *CancelSidecarRequest) (*CancelSidecarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSidecar not implemented")
 }
+func (UnimplementedTraderServer) SubscribeSidecar(*SubscribeSidecarRequest, Trader_SubscribeSidecarServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSidecar not implemented")
+}
+func (UnimplementedTraderServer) VerifyDB(context.Context, *VerifyDBRequest) (*VerifyDBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDB not implemented")
+}
+func (UnimplementedTraderServer) SetAuctioneerEndpoint(context.Context, *SetAuctioneerEndpointRequest) (*SetAuctioneerEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuctioneerEndpoint not implemented")
+}
+func (UnimplementedTraderServer) BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BakeMacaroon not implemented")
+}
+func (UnimplementedTraderServer) DumpConfig(context.Context, *DumpConfigRequest) (*DumpConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpConfig not implemented")
+}
 func (UnimplementedTraderServer) mustEmbedUnimplementedTraderServer() {}
 
 // UnsafeTraderServer may be embedded to opt out of forward compatibility for this service.
@@ -713,6 +1869,27 @@ func _Trader_StopDaemon_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_SubscribeServerState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeServerStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).SubscribeServerState(m, &traderSubscribeServerStateServer{stream})
+}
+
+type Trader_SubscribeServerStateServer interface {
+	Send(*ServerStateUpdate) error
+	grpc.ServerStream
+}
+
+type traderSubscribeServerStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderSubscribeServerStateServer) Send(m *ServerStateUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Trader_QuoteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuoteAccountRequest)
 	if err := dec(in); err != nil {
@@ -785,182 +1962,659 @@ func _Trader_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_WithdrawAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawAccountRequest)
+func _Trader_WithdrawAndCloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAndCloseAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).WithdrawAccount(ctx, in)
+		return srv.(TraderServer).WithdrawAndCloseAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/WithdrawAccount",
+		FullMethod: "/poolrpc.Trader/WithdrawAndCloseAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).WithdrawAccount(ctx, req.(*WithdrawAccountRequest))
+		return srv.(TraderServer).WithdrawAndCloseAccount(ctx, req.(*WithdrawAndCloseAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_DepositAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositAccountRequest)
+func _Trader_SweepExpiredAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepExpiredAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).DepositAccount(ctx, in)
+		return srv.(TraderServer).SweepExpiredAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/DepositAccount",
+		FullMethod: "/poolrpc.Trader/SweepExpiredAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).DepositAccount(ctx, req.(*DepositAccountRequest))
+		return srv.(TraderServer).SweepExpiredAccount(ctx, req.(*SweepExpiredAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_RenewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewAccountRequest)
+func _Trader_WithdrawAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).RenewAccount(ctx, in)
+		return srv.(TraderServer).WithdrawAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/RenewAccount",
+		FullMethod: "/poolrpc.Trader/WithdrawAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).RenewAccount(ctx, req.(*RenewAccountRequest))
+		return srv.(TraderServer).WithdrawAccount(ctx, req.(*WithdrawAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_BumpAccountFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BumpAccountFeeRequest)
+func _Trader_ScheduleWithdrawAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleWithdrawAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).BumpAccountFee(ctx, in)
+		return srv.(TraderServer).ScheduleWithdrawAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/BumpAccountFee",
+		FullMethod: "/poolrpc.Trader/ScheduleWithdrawAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).BumpAccountFee(ctx, req.(*BumpAccountFeeRequest))
+		return srv.(TraderServer).ScheduleWithdrawAccount(ctx, req.(*ScheduleWithdrawAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_RecoverAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecoverAccountsRequest)
+func _Trader_ListScheduledWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledWithdrawalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).RecoverAccounts(ctx, in)
+		return srv.(TraderServer).ListScheduledWithdrawals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/RecoverAccounts",
+		FullMethod: "/poolrpc.Trader/ListScheduledWithdrawals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).RecoverAccounts(ctx, req.(*RecoverAccountsRequest))
+		return srv.(TraderServer).ListScheduledWithdrawals(ctx, req.(*ListScheduledWithdrawalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _Trader_CancelScheduledWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledWithdrawRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).SubmitOrder(ctx, in)
+		return srv.(TraderServer).CancelScheduledWithdraw(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/SubmitOrder",
+		FullMethod: "/poolrpc.Trader/CancelScheduledWithdraw",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(TraderServer).CancelScheduledWithdraw(ctx, req.(*CancelScheduledWithdrawRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrdersRequest)
+func _Trader_DepositAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).ListOrders(ctx, in)
+		return srv.(TraderServer).DepositAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/ListOrders",
+		FullMethod: "/poolrpc.Trader/DepositAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).ListOrders(ctx, req.(*ListOrdersRequest))
+		return srv.(TraderServer).DepositAccount(ctx, req.(*DepositAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _Trader_DepositAccountPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositAccountPsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).CancelOrder(ctx, in)
+		return srv.(TraderServer).DepositAccountPsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/CancelOrder",
+		FullMethod: "/poolrpc.Trader/DepositAccountPsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(TraderServer).DepositAccountPsbt(ctx, req.(*DepositAccountPsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuoteOrderRequest)
+func _Trader_FinalizeDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeDepositRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).QuoteOrder(ctx, in)
+		return srv.(TraderServer).FinalizeDeposit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/QuoteOrder",
+		FullMethod: "/poolrpc.Trader/FinalizeDeposit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).QuoteOrder(ctx, req.(*QuoteOrderRequest))
+		return srv.(TraderServer).FinalizeDeposit(ctx, req.(*FinalizeDepositRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Trader_AuctionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuctionFeeRequest)
+func _Trader_RenewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).AuctionFee(ctx, in)
+		return srv.(TraderServer).RenewAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/AuctionFee",
+		FullMethod: "/poolrpc.Trader/RenewAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).AuctionFee(ctx, req.(*AuctionFeeRequest))
+		return srv.(TraderServer).RenewAccount(ctx, req.(*RenewAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_UpdateAccountAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountAutoRenewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).UpdateAccountAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/UpdateAccountAutoRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).UpdateAccountAutoRenew(ctx, req.(*UpdateAccountAutoRenewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_UpdateAccountReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).UpdateAccountReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/UpdateAccountReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).UpdateAccountReserve(ctx, req.(*UpdateAccountReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_BumpAccountFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpAccountFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).BumpAccountFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/BumpAccountFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).BumpAccountFee(ctx, req.(*BumpAccountFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_RecoverAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RecoverAccountsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).RecoverAccounts(m, &traderRecoverAccountsServer{stream})
+}
+
+type Trader_RecoverAccountsServer interface {
+	Send(*RecoverAccountsResponse) error
+	grpc.ServerStream
+}
+
+type traderRecoverAccountsServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderRecoverAccountsServer) Send(m *RecoverAccountsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trader_AccountEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).AccountEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/AccountEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).AccountEvents(ctx, req.(*AccountEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SubscribeAccountUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAccountUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).SubscribeAccountUpdates(m, &traderSubscribeAccountUpdatesServer{stream})
+}
+
+type Trader_SubscribeAccountUpdatesServer interface {
+	Send(*AccountUpdate) error
+	grpc.ServerStream
+}
+
+type traderSubscribeAccountUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderSubscribeAccountUpdatesServer) Send(m *AccountUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trader_ExportAccountWatchOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountWatchOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ExportAccountWatchOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ExportAccountWatchOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ExportAccountWatchOnly(ctx, req.(*ExportAccountWatchOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_PrepareOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).PrepareOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/PrepareOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).PrepareOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SubmitSignedOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSignedOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SubmitSignedOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SubmitSignedOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SubmitSignedOrder(ctx, req.(*SubmitSignedOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ActivateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ActivateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ActivateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ActivateOrder(ctx, req.(*ActivateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ReplaceOrder(ctx, req.(*ReplaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SaveOrderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveOrderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SaveOrderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SaveOrderTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SaveOrderTemplate(ctx, req.(*SaveOrderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ListOrderTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListOrderTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListOrderTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListOrderTemplates(ctx, req.(*ListOrderTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_DeleteOrderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).DeleteOrderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/DeleteOrderTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).DeleteOrderTemplate(ctx, req.(*DeleteOrderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SubmitOrderFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SubmitOrderFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SubmitOrderFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SubmitOrderFromTemplate(ctx, req.(*SubmitOrderFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_PruneArchivedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneArchivedOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).PruneArchivedOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/PruneArchivedOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).PruneArchivedOrders(ctx, req.(*PruneArchivedOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_OrderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).OrderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/OrderStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).OrderStats(ctx, req.(*OrderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SubscribeOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).SubscribeOrderBook(m, &traderSubscribeOrderBookServer{stream})
+}
+
+type Trader_SubscribeOrderBookServer interface {
+	Send(*auctioneerrpc.OrderBookUpdate) error
+	grpc.ServerStream
+}
+
+type traderSubscribeOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderSubscribeOrderBookServer) Send(m *auctioneerrpc.OrderBookUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trader_OrderBookSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).OrderBookSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/OrderBookSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).OrderBookSnapshot(ctx, req.(*OrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/QuoteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).QuoteOrder(ctx, req.(*QuoteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_QuoteMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).QuoteMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/QuoteMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).QuoteMatch(ctx, req.(*QuoteMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_AuctionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuctionFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).AuctionFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/AuctionFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).AuctionFee(ctx, req.(*AuctionFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1037,6 +2691,60 @@ func _Trader_GetLsatTokens_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_ListLsatTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLsatTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListLsatTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListLsatTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListLsatTokens(ctx, req.(*ListLsatTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_RevokeLsatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLsatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).RevokeLsatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/RevokeLsatToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).RevokeLsatToken(ctx, req.(*RevokeLsatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ImportLsatToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportLsatTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ImportLsatToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ImportLsatToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ImportLsatToken(ctx, req.(*ImportLsatTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_Leases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeasesRequest)
 	if err := dec(in); err != nil {
@@ -1055,6 +2763,45 @@ func _Trader_Leases_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_SubscribeLeaseEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeLeaseEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).SubscribeLeaseEvents(m, &traderSubscribeLeaseEventsServer{stream})
+}
+
+type Trader_SubscribeLeaseEventsServer interface {
+	Send(*LeaseEvent) error
+	grpc.ServerStream
+}
+
+type traderSubscribeLeaseEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderSubscribeLeaseEventsServer) Send(m *LeaseEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trader_LeaseEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).LeaseEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/LeaseEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).LeaseEvidence(ctx, req.(*LeaseEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_NodeRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NodeRatingRequest)
 	if err := dec(in); err != nil {
@@ -1091,20 +2838,146 @@ func _Trader_BatchSnapshots_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_ListLocalBatchSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocalBatchSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListLocalBatchSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListLocalBatchSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListLocalBatchSnapshots(ctx, req.(*ListLocalBatchSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_AcceptedBatchProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptedBatchProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).AcceptedBatchProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/AcceptedBatchProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).AcceptedBatchProposal(ctx, req.(*AcceptedBatchProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SetBatchPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBatchPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SetBatchPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SetBatchPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SetBatchPolicy(ctx, req.(*SetBatchPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_GetBatchPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).GetBatchPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/GetBatchPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).GetBatchPolicy(ctx, req.(*GetBatchPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_ListFundingFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFundingFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).ListFundingFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/ListFundingFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).ListFundingFailures(ctx, req.(*ListFundingFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_LeaseAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).LeaseAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/LeaseAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).LeaseAudit(ctx, req.(*LeaseAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trader_OfferSidecar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OfferSidecarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TraderServer).OfferSidecar(ctx, in)
+		return srv.(TraderServer).OfferSidecar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/OfferSidecar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).OfferSidecar(ctx, req.(*OfferSidecarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_OfferSidecarBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfferSidecarBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).OfferSidecarBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poolrpc.Trader/OfferSidecar",
+		FullMethod: "/poolrpc.Trader/OfferSidecarBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TraderServer).OfferSidecar(ctx, req.(*OfferSidecarRequest))
+		return srv.(TraderServer).OfferSidecarBatch(ctx, req.(*OfferSidecarBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1199,6 +3072,99 @@ func _Trader_CancelSidecar_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trader_SubscribeSidecar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSidecarRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TraderServer).SubscribeSidecar(m, &traderSubscribeSidecarServer{stream})
+}
+
+type Trader_SubscribeSidecarServer interface {
+	Send(*SidecarUpdate) error
+	grpc.ServerStream
+}
+
+type traderSubscribeSidecarServer struct {
+	grpc.ServerStream
+}
+
+func (x *traderSubscribeSidecarServer) Send(m *SidecarUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trader_VerifyDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).VerifyDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/VerifyDB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).VerifyDB(ctx, req.(*VerifyDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_SetAuctioneerEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAuctioneerEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).SetAuctioneerEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/SetAuctioneerEndpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).SetAuctioneerEndpoint(ctx, req.(*SetAuctioneerEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trader_DumpConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraderServer).DumpConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.Trader/DumpConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraderServer).DumpConfig(ctx, req.(*DumpConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Trader_ServiceDesc is the grpc.ServiceDesc for Trader service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1230,30 +3196,78 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseAccount",
 			Handler:    _Trader_CloseAccount_Handler,
 		},
+		{
+			MethodName: "WithdrawAndCloseAccount",
+			Handler:    _Trader_WithdrawAndCloseAccount_Handler,
+		},
+		{
+			MethodName: "SweepExpiredAccount",
+			Handler:    _Trader_SweepExpiredAccount_Handler,
+		},
 		{
 			MethodName: "WithdrawAccount",
 			Handler:    _Trader_WithdrawAccount_Handler,
 		},
+		{
+			MethodName: "ScheduleWithdrawAccount",
+			Handler:    _Trader_ScheduleWithdrawAccount_Handler,
+		},
+		{
+			MethodName: "ListScheduledWithdrawals",
+			Handler:    _Trader_ListScheduledWithdrawals_Handler,
+		},
+		{
+			MethodName: "CancelScheduledWithdraw",
+			Handler:    _Trader_CancelScheduledWithdraw_Handler,
+		},
 		{
 			MethodName: "DepositAccount",
 			Handler:    _Trader_DepositAccount_Handler,
 		},
+		{
+			MethodName: "DepositAccountPsbt",
+			Handler:    _Trader_DepositAccountPsbt_Handler,
+		},
+		{
+			MethodName: "FinalizeDeposit",
+			Handler:    _Trader_FinalizeDeposit_Handler,
+		},
 		{
 			MethodName: "RenewAccount",
 			Handler:    _Trader_RenewAccount_Handler,
 		},
+		{
+			MethodName: "UpdateAccountAutoRenew",
+			Handler:    _Trader_UpdateAccountAutoRenew_Handler,
+		},
+		{
+			MethodName: "UpdateAccountReserve",
+			Handler:    _Trader_UpdateAccountReserve_Handler,
+		},
 		{
 			MethodName: "BumpAccountFee",
 			Handler:    _Trader_BumpAccountFee_Handler,
 		},
 		{
-			MethodName: "RecoverAccounts",
-			Handler:    _Trader_RecoverAccounts_Handler,
+			MethodName: "AccountEvents",
+			Handler:    _Trader_AccountEvents_Handler,
+		},
+		{
+			MethodName: "ExportAccountWatchOnly",
+			Handler:    _Trader_ExportAccountWatchOnly_Handler,
 		},
 		{
 			MethodName: "SubmitOrder",
 			Handler:    _Trader_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "PrepareOrder",
+			Handler:    _Trader_PrepareOrder_Handler,
+		},
+		{
+			MethodName: "SubmitSignedOrder",
+			Handler:    _Trader_SubmitSignedOrder_Handler,
+		},
 		{
 			MethodName: "ListOrders",
 			Handler:    _Trader_ListOrders_Handler,
@@ -1262,10 +3276,54 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Trader_CancelOrder_Handler,
 		},
+		{
+			MethodName: "ActivateOrder",
+			Handler:    _Trader_ActivateOrder_Handler,
+		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _Trader_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Trader_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "SaveOrderTemplate",
+			Handler:    _Trader_SaveOrderTemplate_Handler,
+		},
+		{
+			MethodName: "ListOrderTemplates",
+			Handler:    _Trader_ListOrderTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteOrderTemplate",
+			Handler:    _Trader_DeleteOrderTemplate_Handler,
+		},
+		{
+			MethodName: "SubmitOrderFromTemplate",
+			Handler:    _Trader_SubmitOrderFromTemplate_Handler,
+		},
+		{
+			MethodName: "PruneArchivedOrders",
+			Handler:    _Trader_PruneArchivedOrders_Handler,
+		},
+		{
+			MethodName: "OrderStats",
+			Handler:    _Trader_OrderStats_Handler,
+		},
+		{
+			MethodName: "OrderBookSnapshot",
+			Handler:    _Trader_OrderBookSnapshot_Handler,
+		},
 		{
 			MethodName: "QuoteOrder",
 			Handler:    _Trader_QuoteOrder_Handler,
 		},
+		{
+			MethodName: "QuoteMatch",
+			Handler:    _Trader_QuoteMatch_Handler,
+		},
 		{
 			MethodName: "AuctionFee",
 			Handler:    _Trader_AuctionFee_Handler,
@@ -1286,10 +3344,26 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLsatTokens",
 			Handler:    _Trader_GetLsatTokens_Handler,
 		},
+		{
+			MethodName: "ListLsatTokens",
+			Handler:    _Trader_ListLsatTokens_Handler,
+		},
+		{
+			MethodName: "RevokeLsatToken",
+			Handler:    _Trader_RevokeLsatToken_Handler,
+		},
+		{
+			MethodName: "ImportLsatToken",
+			Handler:    _Trader_ImportLsatToken_Handler,
+		},
 		{
 			MethodName: "Leases",
 			Handler:    _Trader_Leases_Handler,
 		},
+		{
+			MethodName: "LeaseEvidence",
+			Handler:    _Trader_LeaseEvidence_Handler,
+		},
 		{
 			MethodName: "NodeRatings",
 			Handler:    _Trader_NodeRatings_Handler,
@@ -1298,10 +3372,38 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchSnapshots",
 			Handler:    _Trader_BatchSnapshots_Handler,
 		},
+		{
+			MethodName: "ListLocalBatchSnapshots",
+			Handler:    _Trader_ListLocalBatchSnapshots_Handler,
+		},
+		{
+			MethodName: "AcceptedBatchProposal",
+			Handler:    _Trader_AcceptedBatchProposal_Handler,
+		},
+		{
+			MethodName: "SetBatchPolicy",
+			Handler:    _Trader_SetBatchPolicy_Handler,
+		},
+		{
+			MethodName: "GetBatchPolicy",
+			Handler:    _Trader_GetBatchPolicy_Handler,
+		},
+		{
+			MethodName: "ListFundingFailures",
+			Handler:    _Trader_ListFundingFailures_Handler,
+		},
+		{
+			MethodName: "LeaseAudit",
+			Handler:    _Trader_LeaseAudit_Handler,
+		},
 		{
 			MethodName: "OfferSidecar",
 			Handler:    _Trader_OfferSidecar_Handler,
 		},
+		{
+			MethodName: "OfferSidecarBatch",
+			Handler:    _Trader_OfferSidecarBatch_Handler,
+		},
 		{
 			MethodName: "RegisterSidecar",
 			Handler:    _Trader_RegisterSidecar_Handler,
@@ -1322,6 +3424,241 @@ var Trader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSidecar",
 			Handler:    _Trader_CancelSidecar_Handler,
 		},
+		{
+			MethodName: "VerifyDB",
+			Handler:    _Trader_VerifyDB_Handler,
+		},
+		{
+			MethodName: "SetAuctioneerEndpoint",
+			Handler:    _Trader_SetAuctioneerEndpoint_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Trader_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "DumpConfig",
+			Handler:    _Trader_DumpConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeServerState",
+			Handler:       _Trader_SubscribeServerState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RecoverAccounts",
+			Handler:       _Trader_RecoverAccounts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAccountUpdates",
+			Handler:       _Trader_SubscribeAccountUpdates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeOrderBook",
+			Handler:       _Trader_SubscribeOrderBook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeLeaseEvents",
+			Handler:       _Trader_SubscribeLeaseEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeSidecar",
+			Handler:       _Trader_SubscribeSidecar_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "trader.proto",
+}
+
+// BatchApproverClient is the client API for BatchApprover service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BatchApproverClient interface {
+	//
+	//ApproveBatch is called with the details of a batch the trader is about to
+	//accept. The batch is only accepted if it is approved within the configured
+	//timeout.
+	ApproveBatch(ctx context.Context, in *BatchApprovalRequest, opts ...grpc.CallOption) (*BatchApprovalResponse, error)
+}
+
+type batchApproverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBatchApproverClient(cc grpc.ClientConnInterface) BatchApproverClient {
+	return &batchApproverClient{cc}
+}
+
+func (c *batchApproverClient) ApproveBatch(ctx context.Context, in *BatchApprovalRequest, opts ...grpc.CallOption) (*BatchApprovalResponse, error) {
+	out := new(BatchApprovalResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.BatchApprover/ApproveBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchApproverServer is the server API for BatchApprover service.
+// All implementations must embed UnimplementedBatchApproverServer
+// for forward compatibility
+type BatchApproverServer interface {
+	//
+	//ApproveBatch is called with the details of a batch the trader is about to
+	//accept. The batch is only accepted if it is approved within the configured
+	//timeout.
+	ApproveBatch(context.Context, *BatchApprovalRequest) (*BatchApprovalResponse, error)
+	mustEmbedUnimplementedBatchApproverServer()
+}
+
+// UnimplementedBatchApproverServer must be embedded to have forward compatible implementations.
+type UnimplementedBatchApproverServer struct {
+}
+
+func (UnimplementedBatchApproverServer) ApproveBatch(context.Context, *BatchApprovalRequest) (*BatchApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBatch not implemented")
+}
+func (UnimplementedBatchApproverServer) mustEmbedUnimplementedBatchApproverServer() {}
+
+// UnsafeBatchApproverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BatchApproverServer will
+// result in compilation errors.
+type UnsafeBatchApproverServer interface {
+	mustEmbedUnimplementedBatchApproverServer()
+}
+
+func RegisterBatchApproverServer(s grpc.ServiceRegistrar, srv BatchApproverServer) {
+	s.RegisterService(&BatchApprover_ServiceDesc, srv)
+}
+
+func _BatchApprover_ApproveBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchApproverServer).ApproveBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.BatchApprover/ApproveBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchApproverServer).ApproveBatch(ctx, req.(*BatchApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BatchApprover_ServiceDesc is the grpc.ServiceDesc for BatchApprover service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BatchApprover_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poolrpc.BatchApprover",
+	HandlerType: (*BatchApproverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApproveBatch",
+			Handler:    _BatchApprover_ApproveBatch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "trader.proto",
+}
+
+// DownstreamAcceptorClient is the client API for DownstreamAcceptor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DownstreamAcceptorClient interface {
+	//
+	//AcceptChannel is called for every incoming channel request that is not
+	//part of a batch. The channel is only opened if it is accepted within the
+	//configured timeout.
+	AcceptChannel(ctx context.Context, in *DownstreamAcceptRequest, opts ...grpc.CallOption) (*DownstreamAcceptResponse, error)
+}
+
+type downstreamAcceptorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDownstreamAcceptorClient(cc grpc.ClientConnInterface) DownstreamAcceptorClient {
+	return &downstreamAcceptorClient{cc}
+}
+
+func (c *downstreamAcceptorClient) AcceptChannel(ctx context.Context, in *DownstreamAcceptRequest, opts ...grpc.CallOption) (*DownstreamAcceptResponse, error) {
+	out := new(DownstreamAcceptResponse)
+	err := c.cc.Invoke(ctx, "/poolrpc.DownstreamAcceptor/AcceptChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DownstreamAcceptorServer is the server API for DownstreamAcceptor service.
+// All implementations must embed UnimplementedDownstreamAcceptorServer
+// for forward compatibility
+type DownstreamAcceptorServer interface {
+	//
+	//AcceptChannel is called for every incoming channel request that is not
+	//part of a batch. The channel is only opened if it is accepted within the
+	//configured timeout.
+	AcceptChannel(context.Context, *DownstreamAcceptRequest) (*DownstreamAcceptResponse, error)
+	mustEmbedUnimplementedDownstreamAcceptorServer()
+}
+
+// UnimplementedDownstreamAcceptorServer must be embedded to have forward compatible implementations.
+type UnimplementedDownstreamAcceptorServer struct {
+}
+
+func (UnimplementedDownstreamAcceptorServer) AcceptChannel(context.Context, *DownstreamAcceptRequest) (*DownstreamAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptChannel not implemented")
+}
+func (UnimplementedDownstreamAcceptorServer) mustEmbedUnimplementedDownstreamAcceptorServer() {}
+
+// UnsafeDownstreamAcceptorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DownstreamAcceptorServer will
+// result in compilation errors.
+type UnsafeDownstreamAcceptorServer interface {
+	mustEmbedUnimplementedDownstreamAcceptorServer()
+}
+
+func RegisterDownstreamAcceptorServer(s grpc.ServiceRegistrar, srv DownstreamAcceptorServer) {
+	s.RegisterService(&DownstreamAcceptor_ServiceDesc, srv)
+}
+
+func _DownstreamAcceptor_AcceptChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownstreamAcceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownstreamAcceptorServer).AcceptChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poolrpc.DownstreamAcceptor/AcceptChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownstreamAcceptorServer).AcceptChannel(ctx, req.(*DownstreamAcceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DownstreamAcceptor_ServiceDesc is the grpc.ServiceDesc for DownstreamAcceptor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DownstreamAcceptor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poolrpc.DownstreamAcceptor",
+	HandlerType: (*DownstreamAcceptorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptChannel",
+			Handler:    _DownstreamAcceptor_AcceptChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "trader.proto",
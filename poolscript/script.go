@@ -3,6 +3,7 @@ package poolscript
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/txscript"
@@ -147,6 +148,10 @@ type RecoveryHelper struct {
 
 	tweakedTraderKey     []byte
 	tweakedAuctioneerKey []byte
+
+	tweakedTraderPubKey *btcec.PublicKey
+	taprootInternalKey  *btcec.PublicKey
+	internalKeyTrader   *btcec.PublicKey
 }
 
 // NextBatchKey increments the currently used batch key and re-calculates the
@@ -180,70 +185,120 @@ func (r *RecoveryHelper) tweakKeys() {
 
 	r.tweakedTraderKey = tweakedTraderKey.SerializeCompressed()
 	r.tweakedAuctioneerKey = tweakedAuctioneerKey.SerializeCompressed()
+
+	r.tweakedTraderPubKey = tweakedTraderKey
+
+	// The internal key of a taproot account only depends on the base keys,
+	// so we only need to combine them once per account.
+	if r.internalKeyTrader == nil ||
+		!r.internalKeyTrader.IsEqual(r.TraderKey) {
+
+		// The keys are always valid points, so combining them can't
+		// fail.
+		r.taprootInternalKey, _ = TaprootInternalKey(
+			r.TraderKey, r.AuctioneerKey,
+		)
+		r.internalKeyTrader = r.TraderKey
+	}
 }
 
-// LocateOutput looks for an account output in the given transaction that
-// corresponds to a script derived with the current settings of the helper and
-// the given account expiry.
+// LocateOutput looks for an account output of any version in the given
+// transaction that corresponds to a script derived with the current settings
+// of the helper and the given account expiry. The version of the output found
+// is returned as well.
 func (r *RecoveryHelper) LocateOutput(expiry uint32, tx *wire.MsgTx) (uint32,
-	bool, error) {
+	Version, bool, error) {
 
 	witnessScript, err := accountWitnessScript(
 		expiry, r.tweakedTraderKey, r.tweakedAuctioneerKey,
 	)
 	if err != nil {
-		return 0, false, err
+		return 0, 0, false, err
 	}
 
 	scriptHash, err := input.WitnessScriptHash(witnessScript)
 	if err != nil {
-		return 0, false, err
+		return 0, 0, false, err
+	}
+
+	if idx, ok := LocateOutputScript(tx, scriptHash); ok {
+		return idx, VersionWitnessScript, true, nil
 	}
 
-	idx, ok := LocateOutputScript(tx, scriptHash)
-	return idx, ok, nil
+	taprootAccount, err := newTaprootAccount(
+		expiry, r.taprootInternalKey, r.tweakedTraderPubKey,
+	)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	taprootScript, err := taprootAccount.PkScript()
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	idx, ok := LocateOutputScript(tx, taprootScript)
+	return idx, VersionTaprootMuSig2, ok, nil
 }
 
 // LocateAnyOutput looks for an account output in and of the given transactions
 // that corresponds to a script derived with the current settings of the helper
 // and the given account expiry.
 func (r *RecoveryHelper) LocateAnyOutput(expiry uint32,
-	txns []*wire.MsgTx) (*wire.MsgTx, uint32, bool, error) {
+	txns []*wire.MsgTx) (*wire.MsgTx, uint32, Version, bool, error) {
 
 	for _, tx := range txns {
 		// We shouldn't return a pointer to a loop iterator value, so
 		// make a copy first.
 		tx := tx
 
-		idx, ok, err := r.LocateOutput(expiry, tx)
+		idx, version, ok, err := r.LocateOutput(expiry, tx)
 		if err != nil {
-			return nil, 0, false, err
+			return nil, 0, 0, false, err
 		}
 
 		if ok {
-			return tx, idx, true, nil
+			return tx, idx, version, true, nil
 		}
 	}
 
-	return nil, 0, false, nil
+	return nil, 0, 0, false, nil
 }
 
-// AccountScript returns the output script of an account on-chain.
+// AccountScript returns the output script of an account on-chain. For
+// VersionWitnessScript this is the P2WSH of the following witness script:
 //
 // <trader_key> OP_CHECKSIGVERIFY
 // <auctioneer_key> OP_CHECKSIG OP_IFDUP OP_NOTIF
 //	<account_expiry> OP_CHECKLOCKTIMEVERIFY
 // OP_ENDIF.
-func AccountScript(expiry uint32, traderKey, auctioneerKey,
+//
+// For VersionTaprootMuSig2 it is a P2TR output, see NewTaprootAccount.
+func AccountScript(version Version, expiry uint32, traderKey, auctioneerKey,
 	batchKey *btcec.PublicKey, secret [32]byte) ([]byte, error) {
 
-	witnessScript, err := AccountWitnessScript(
-		expiry, traderKey, auctioneerKey, batchKey, secret,
-	)
-	if err != nil {
-		return nil, err
+	switch version {
+	case VersionWitnessScript:
+		witnessScript, err := AccountWitnessScript(
+			expiry, traderKey, auctioneerKey, batchKey, secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return input.WitnessScriptHash(witnessScript)
+
+	case VersionTaprootMuSig2:
+		taprootAccount, err := NewTaprootAccount(
+			expiry, traderKey, auctioneerKey, batchKey, secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return taprootAccount.PkScript()
+
+	default:
+		return nil, fmt.Errorf("unknown account script version %v",
+			version)
 	}
-	return input.WitnessScriptHash(witnessScript)
 }
 
 // SpendExpiry returns the witness required to spend an account through the
@@ -267,8 +322,12 @@ func SpendMultiSig(witnessScript, traderSig, auctioneerSig []byte) wire.TxWitnes
 }
 
 // IsExpirySpend determines whether the provided witness corresponds to the
-// expiration script path of an account.
+// expiration script path of an account of any version.
 func IsExpirySpend(witness wire.TxWitness) bool {
+	if isTaprootExpirySpend(witness) {
+		return true
+	}
+
 	if len(witness) != 3 {
 		return false
 	}
@@ -279,9 +338,13 @@ func IsExpirySpend(witness wire.TxWitness) bool {
 }
 
 // IsMultiSigSpend determines whether the provided witness corresponds to the
-// multi-sig script path of an account.
+// multi-sig script path of an account of any version.
 func IsMultiSigSpend(witness wire.TxWitness) bool {
-	if len(witness) != 3 {
+	if isTaprootMultiSigSpend(witness) {
+		return true
+	}
+
+	if len(witness) != 3 || isTaprootExpirySpend(witness) {
 		return false
 	}
 	if len(witness[0]) == 0 {
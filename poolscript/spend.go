@@ -0,0 +1,195 @@
+package poolscript
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// SpendPath denotes the script path an account output was spent through.
+type SpendPath uint8
+
+const (
+	// SpendPathNone indicates that a transaction doesn't spend the account
+	// output at all.
+	SpendPathNone SpendPath = 0
+
+	// SpendPathMultiSig indicates that the account output was spent
+	// cooperatively by the trader and auctioneer, which is the case for
+	// batches, account modifications and closes.
+	SpendPathMultiSig SpendPath = 1
+
+	// SpendPathExpiry indicates that the account output was spent by the
+	// trader alone through the expiry path.
+	SpendPathExpiry SpendPath = 2
+)
+
+// String returns a human-readable description of a spend path.
+func (p SpendPath) String() string {
+	switch p {
+	case SpendPathNone:
+		return "none"
+
+	case SpendPathMultiSig:
+		return "multisig"
+
+	case SpendPathExpiry:
+		return "expiry"
+
+	default:
+		return fmt.Sprintf("unknown <%d>", p)
+	}
+}
+
+// AccountParams are the parameters of an account that are needed to detect
+// and classify a spend of one of its outputs.
+type AccountParams struct {
+	// OutPoint is the account output that is checked for a spend. A
+	// MuSig2 key spend of a taproot account doesn't reveal any of the
+	// account's keys, so a spending input can only be matched to the
+	// account by the output it spends.
+	OutPoint wire.OutPoint
+
+	// Expiry is the expiration block height of the account.
+	Expiry uint32
+
+	// TraderKey is the base trader key of the account.
+	TraderKey *btcec.PublicKey
+
+	// AuctioneerKey is the base auctioneer key of the account.
+	AuctioneerKey *btcec.PublicKey
+}
+
+// SpendClassification describes how a transaction spends an account output.
+type SpendClassification struct {
+	// Path is the script path the account output was spent through. If
+	// the transaction doesn't spend the account, this is SpendPathNone and
+	// all other fields are unset.
+	Path SpendPath
+
+	// InputIndex is the index of the input spending the account output.
+	InputIndex uint32
+
+	// Version is the script version of the spent account output as
+	// revealed by the spending witness.
+	Version Version
+
+	// TweakMismatch is true if the witness reveals a script that wasn't
+	// derived from the given batch key and secret, which means they are
+	// out of date. A MuSig2 key spend reveals no script, so it never
+	// results in a mismatch.
+	TweakMismatch bool
+
+	// Recreated is true if the transaction recreates the account with the
+	// same expiry and the next batch key. This is only checked for spends
+	// through the multi-sig path.
+	Recreated bool
+
+	// NextOutputIndex is the index of the recreated account output.
+	NextOutputIndex uint32
+
+	// NextVersion is the script version of the recreated account output,
+	// which differs from Version if the account was upgraded.
+	NextVersion Version
+}
+
+// ClassifySpend determines whether the given transaction spends the account
+// output described by the account parameters, which was derived from the
+// given batch key and secret. If it does, the spend path and script version
+// are deduced from the witness of the spending input and the transaction is
+// searched for a recreated account output of any version. Other accounts
+// spent by the same transaction don't influence the result, as their outputs
+// are derived from different keys.
+func ClassifySpend(tx *wire.MsgTx, params *AccountParams,
+	batchKey *btcec.PublicKey, secret [32]byte) (*SpendClassification,
+	error) {
+
+	spend := &SpendClassification{}
+
+	var spendingInput *wire.TxIn
+	for idx, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint == params.OutPoint {
+			spendingInput = txIn
+			spend.InputIndex = uint32(idx)
+			break
+		}
+	}
+	if spendingInput == nil {
+		return spend, nil
+	}
+
+	witness := spendingInput.Witness
+	switch {
+	case isTaprootMultiSigSpend(witness):
+		spend.Path = SpendPathMultiSig
+		spend.Version = VersionTaprootMuSig2
+
+	case isTaprootExpirySpend(witness):
+		spend.Path = SpendPathExpiry
+		spend.Version = VersionTaprootMuSig2
+
+		account, err := NewTaprootAccount(
+			params.Expiry, params.TraderKey, params.AuctioneerKey,
+			batchKey, secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		controlBlock, err := account.ControlBlock()
+		if err != nil {
+			return nil, err
+		}
+		spend.TweakMismatch = !bytes.Equal(
+			witness[1], account.ExpiryLeaf.Script,
+		) || !bytes.Equal(witness[2], controlBlock)
+
+	case IsExpirySpend(witness), IsMultiSigSpend(witness):
+		spend.Path = SpendPathMultiSig
+		if IsExpirySpend(witness) {
+			spend.Path = SpendPathExpiry
+		}
+		spend.Version = VersionWitnessScript
+
+		witnessScript, err := AccountWitnessScript(
+			params.Expiry, params.TraderKey, params.AuctioneerKey,
+			batchKey, secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		spend.TweakMismatch = !bytes.Equal(witness[2], witnessScript)
+
+	default:
+		return nil, fmt.Errorf("unknown spend witness %x", witness)
+	}
+
+	// The expiry path always fully spends the account, it's only recreated
+	// when spent cooperatively with the auctioneer.
+	if spend.Path != SpendPathMultiSig {
+		return spend, nil
+	}
+
+	nextBatchKey := IncrementKey(batchKey)
+	for _, version := range []Version{spend.Version, VersionTaprootMuSig2} {
+		nextScript, err := AccountScript(
+			version, params.Expiry, params.TraderKey,
+			params.AuctioneerKey, nextBatchKey, secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		idx, ok := LocateOutputScript(tx, nextScript)
+		if ok {
+			spend.Recreated = true
+			spend.NextOutputIndex = idx
+			spend.NextVersion = version
+
+			break
+		}
+	}
+
+	return spend, nil
+}
@@ -0,0 +1,292 @@
+package poolscript
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// classifySpendTestAccount is an account output used in the spend
+// classification tests.
+type classifySpendTestAccount struct {
+	params   *AccountParams
+	batchKey *btcec.PublicKey
+	secret   [32]byte
+}
+
+// newClassifySpendTestAccount creates an account with random keys whose
+// output is at the given outpoint.
+func newClassifySpendTestAccount(t *testing.T,
+	outPoint wire.OutPoint) *classifySpendTestAccount {
+
+	traderPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	auctioneerPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	batchKey, err := btcec.ParsePubKey(initialBatchKeyBytes)
+	require.NoError(t, err)
+
+	return &classifySpendTestAccount{
+		params: &AccountParams{
+			OutPoint:      outPoint,
+			Expiry:        1337,
+			TraderKey:     traderPrivKey.PubKey(),
+			AuctioneerKey: auctioneerPrivKey.PubKey(),
+		},
+		batchKey: batchKey,
+		secret:   [32]byte{byte(outPoint.Index), 2, 3},
+	}
+}
+
+// witness returns a witness with dummy signatures that spends the account
+// output of the given version through the given path, using the scripts
+// derived from the given batch key.
+func (a *classifySpendTestAccount) witness(t *testing.T, version Version,
+	path SpendPath, batchKey *btcec.PublicKey) wire.TxWitness {
+
+	var (
+		sig        = make([]byte, 71)
+		schnorrSig = make([]byte, schnorr.SignatureSize)
+	)
+	if version == VersionWitnessScript {
+		witnessScript, err := AccountWitnessScript(
+			a.params.Expiry, a.params.TraderKey,
+			a.params.AuctioneerKey, batchKey, a.secret,
+		)
+		require.NoError(t, err)
+
+		if path == SpendPathExpiry {
+			return SpendExpiry(witnessScript, sig)
+		}
+		return SpendMultiSig(witnessScript, sig, sig)
+	}
+
+	if path == SpendPathMultiSig {
+		return SpendMuSig2Taproot(schnorrSig)
+	}
+
+	account, err := NewTaprootAccount(
+		a.params.Expiry, a.params.TraderKey, a.params.AuctioneerKey,
+		batchKey, a.secret,
+	)
+	require.NoError(t, err)
+	controlBlock, err := account.ControlBlock()
+	require.NoError(t, err)
+
+	return SpendExpiryTaproot(
+		account.ExpiryLeaf.Script, schnorrSig, controlBlock,
+	)
+}
+
+// nextOutput returns the recreated account output of the given version.
+func (a *classifySpendTestAccount) nextOutput(t *testing.T,
+	version Version) *wire.TxOut {
+
+	pkScript, err := AccountScript(
+		version, a.params.Expiry, a.params.TraderKey,
+		a.params.AuctioneerKey, IncrementKey(a.batchKey), a.secret,
+	)
+	require.NoError(t, err)
+
+	return &wire.TxOut{Value: 100_000, PkScript: pkScript}
+}
+
+// TestClassifySpend makes sure spends of accounts of both versions are
+// detected and classified correctly.
+func TestClassifySpend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		otherOutPoint   = wire.OutPoint{Index: 1}
+		accountOutPoint = wire.OutPoint{Index: 2}
+		changeOutput    = &wire.TxOut{Value: 1000, PkScript: []byte{1}}
+	)
+
+	testCases := []struct {
+		name        string
+		version     Version
+		path        SpendPath
+		nextVersion Version
+		recreate    bool
+		staleKey    bool
+		expected    *SpendClassification
+	}{{
+		name:        "witness script batch",
+		version:     VersionWitnessScript,
+		path:        SpendPathMultiSig,
+		nextVersion: VersionWitnessScript,
+		recreate:    true,
+		expected: &SpendClassification{
+			Path:            SpendPathMultiSig,
+			InputIndex:      1,
+			Version:         VersionWitnessScript,
+			Recreated:       true,
+			NextOutputIndex: 2,
+			NextVersion:     VersionWitnessScript,
+		},
+	}, {
+		name:        "witness script upgraded to taproot",
+		version:     VersionWitnessScript,
+		path:        SpendPathMultiSig,
+		nextVersion: VersionTaprootMuSig2,
+		recreate:    true,
+		expected: &SpendClassification{
+			Path:            SpendPathMultiSig,
+			InputIndex:      1,
+			Version:         VersionWitnessScript,
+			Recreated:       true,
+			NextOutputIndex: 2,
+			NextVersion:     VersionTaprootMuSig2,
+		},
+	}, {
+		name:    "witness script close",
+		version: VersionWitnessScript,
+		path:    SpendPathMultiSig,
+		expected: &SpendClassification{
+			Path:       SpendPathMultiSig,
+			InputIndex: 1,
+			Version:    VersionWitnessScript,
+		},
+	}, {
+		name:    "witness script expiry",
+		version: VersionWitnessScript,
+		path:    SpendPathExpiry,
+		expected: &SpendClassification{
+			Path:       SpendPathExpiry,
+			InputIndex: 1,
+			Version:    VersionWitnessScript,
+		},
+	}, {
+		name:     "witness script stale batch key",
+		version:  VersionWitnessScript,
+		path:     SpendPathExpiry,
+		staleKey: true,
+		expected: &SpendClassification{
+			Path:          SpendPathExpiry,
+			InputIndex:    1,
+			Version:       VersionWitnessScript,
+			TweakMismatch: true,
+		},
+	}, {
+		name:        "taproot batch",
+		version:     VersionTaprootMuSig2,
+		path:        SpendPathMultiSig,
+		nextVersion: VersionTaprootMuSig2,
+		recreate:    true,
+		expected: &SpendClassification{
+			Path:            SpendPathMultiSig,
+			InputIndex:      1,
+			Version:         VersionTaprootMuSig2,
+			Recreated:       true,
+			NextOutputIndex: 2,
+			NextVersion:     VersionTaprootMuSig2,
+		},
+	}, {
+		name:    "taproot expiry",
+		version: VersionTaprootMuSig2,
+		path:    SpendPathExpiry,
+		expected: &SpendClassification{
+			Path:       SpendPathExpiry,
+			InputIndex: 1,
+			Version:    VersionTaprootMuSig2,
+		},
+	}, {
+		name:     "taproot stale batch key",
+		version:  VersionTaprootMuSig2,
+		path:     SpendPathExpiry,
+		staleKey: true,
+		expected: &SpendClassification{
+			Path:          SpendPathExpiry,
+			InputIndex:    1,
+			Version:       VersionTaprootMuSig2,
+			TweakMismatch: true,
+		},
+	}, {
+		name:     "not spent",
+		path:     SpendPathNone,
+		expected: &SpendClassification{},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Every transaction spends and recreates a second
+			// account in a batch to make sure it doesn't influence
+			// the result.
+			other := newClassifySpendTestAccount(t, otherOutPoint)
+			account := newClassifySpendTestAccount(
+				t, accountOutPoint,
+			)
+
+			tx := &wire.MsgTx{
+				TxIn: []*wire.TxIn{{
+					PreviousOutPoint: otherOutPoint,
+					Witness: other.witness(
+						t, VersionWitnessScript,
+						SpendPathMultiSig,
+						other.batchKey,
+					),
+				}},
+				TxOut: []*wire.TxOut{
+					other.nextOutput(
+						t, VersionWitnessScript,
+					),
+					changeOutput,
+				},
+			}
+
+			if tc.path != SpendPathNone {
+				witnessBatchKey := account.batchKey
+				if tc.staleKey {
+					witnessBatchKey = IncrementKey(
+						witnessBatchKey,
+					)
+				}
+				tx.AddTxIn(&wire.TxIn{
+					PreviousOutPoint: accountOutPoint,
+					Witness: account.witness(
+						t, tc.version, tc.path,
+						witnessBatchKey,
+					),
+				})
+			}
+			if tc.recreate {
+				tx.AddTxOut(account.nextOutput(
+					t, tc.nextVersion,
+				))
+			}
+
+			spend, err := ClassifySpend(
+				tx, account.params, account.batchKey,
+				account.secret,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, spend)
+		})
+	}
+}
+
+// TestClassifySpendUnknownWitness makes sure a spend with a witness that
+// doesn't belong to any account version is rejected.
+func TestClassifySpendUnknownWitness(t *testing.T) {
+	t.Parallel()
+
+	account := newClassifySpendTestAccount(t, wire.OutPoint{Index: 1})
+	tx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: account.params.OutPoint,
+			Witness:          wire.TxWitness{{1}, {2}},
+		}},
+	}
+
+	_, err := ClassifySpend(
+		tx, account.params, account.batchKey, account.secret,
+	)
+	require.ErrorContains(t, err, "unknown spend witness")
+}
@@ -0,0 +1,277 @@
+package poolscript
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// Version denotes the type of output script an account uses.
+type Version uint8
+
+// NOTE: We avoid the use of iota as these can be persisted to disk.
+const (
+	// VersionWitnessScript is the original account script version that
+	// uses a P2WSH output with a witness script containing both the 2-of-2
+	// multi-sig and the expiry path.
+	VersionWitnessScript Version = 0
+
+	// VersionTaprootMuSig2 is the account script version that uses a P2TR
+	// output. Its internal key is the MuSig2 combined key of the trader
+	// and auctioneer key, which is used for the cooperative key spend
+	// path. The expiry path is the only leaf of the script tree.
+	VersionTaprootMuSig2 Version = 1
+)
+
+const (
+	// TaprootExpiryScriptSize: 40 bytes
+	//	- OP_DATA: 1 byte (trader_key length)
+	//	- <trader_key>: 32 bytes
+	//	- OP_CHECKSIGVERIFY: 1 byte
+	//	- OP_DATA: 1 byte (account_expiry length)
+	//	- <account_expiry>: 4 bytes
+	//	- OP_CHECKLOCKTIMEVERIFY: 1 byte
+	TaprootExpiryScriptSize = 1 + 32 + 1 + 1 + 4 + 1
+
+	// TaprootControlBlockSize: 33 bytes
+	//	- leaf_version_and_parity: 1 byte
+	//	- <internal_key>: 32 bytes
+	TaprootControlBlockSize = 1 + 32
+
+	// TaprootMultiSigWitnessSize: 66 bytes
+	//	- num_witness_elements: 1 byte
+	//	- sig_varint_len: 1 byte
+	//	- <combined_sig>: 64 bytes
+	TaprootMultiSigWitnessSize = 1 + 1 + schnorr.SignatureSize
+
+	// TaprootExpiryWitnessSize: 141 bytes
+	//	- num_witness_elements: 1 byte
+	//	- trader_sig_varint_len: 1 byte
+	//	- <trader_sig>: 64 bytes
+	//	- leaf_script_varint_len: 1 byte
+	//	- <leaf_script>: 40 bytes
+	//	- control_block_varint_len: 1 byte
+	//	- <control_block>: 33 bytes
+	TaprootExpiryWitnessSize = 1 + 1 + schnorr.SignatureSize + 1 +
+		TaprootExpiryScriptSize + 1 + TaprootControlBlockSize
+)
+
+// String returns a human-readable description of a script version.
+func (v Version) String() string {
+	switch v {
+	case VersionWitnessScript:
+		return "witness_script"
+
+	case VersionTaprootMuSig2:
+		return "taproot_musig2"
+
+	default:
+		return fmt.Sprintf("unknown <%d>", v)
+	}
+}
+
+// MultiSigWitnessSize returns the size of the witness that spends an account
+// of this version through its cooperative path.
+func (v Version) MultiSigWitnessSize() int {
+	if v == VersionTaprootMuSig2 {
+		return TaprootMultiSigWitnessSize
+	}
+
+	return MultiSigWitnessSize
+}
+
+// ExpiryWitnessSize returns the size of the witness that spends an account of
+// this version through its expiry path.
+func (v Version) ExpiryWitnessSize() int {
+	if v == VersionTaprootMuSig2 {
+		return TaprootExpiryWitnessSize
+	}
+
+	return ExpiryWitnessSize
+}
+
+// TaprootAccount contains the keys and script of a taproot account output.
+type TaprootAccount struct {
+	// InternalKey is the MuSig2 combined key of the trader and auctioneer
+	// base keys before the taproot tweak is applied.
+	InternalKey *btcec.PublicKey
+
+	// OutputKey is the taproot output key that commits to the internal
+	// key and the expiry leaf.
+	OutputKey *btcec.PublicKey
+
+	// ExpiryLeaf is the only leaf of the script tree. It allows the trader
+	// to spend the account on their own once it expired.
+	ExpiryLeaf txscript.TapLeaf
+}
+
+// NewTaprootAccount returns the taproot output of an account. The internal
+// key doesn't change over the lifetime of an account, instead the per-batch
+// tweaked trader key is placed in the expiry leaf, so every output of the
+// account is unique.
+func NewTaprootAccount(expiry uint32, traderKey, auctioneerKey,
+	batchKey *btcec.PublicKey, secret [32]byte) (*TaprootAccount, error) {
+
+	traderKeyTweak := TraderKeyTweak(batchKey, secret, traderKey)
+	tweakedTraderKey := input.TweakPubKeyWithTweak(traderKey, traderKeyTweak)
+
+	internalKey, err := TaprootInternalKey(traderKey, auctioneerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTaprootAccount(expiry, internalKey, tweakedTraderKey)
+}
+
+// newTaprootAccount returns the taproot output of an account from its
+// internal key and the per-batch tweaked trader key.
+func newTaprootAccount(expiry uint32, internalKey,
+	tweakedTraderKey *btcec.PublicKey) (*TaprootAccount, error) {
+
+	expiryScript, err := TaprootExpiryScript(expiry, tweakedTraderKey)
+	if err != nil {
+		return nil, err
+	}
+	expiryLeaf := txscript.NewBaseTapLeaf(expiryScript)
+	rootHash := expiryLeaf.TapHash()
+
+	return &TaprootAccount{
+		InternalKey: internalKey,
+		OutputKey: txscript.ComputeTaprootOutputKey(
+			internalKey, rootHash[:],
+		),
+		ExpiryLeaf: expiryLeaf,
+	}, nil
+}
+
+// TaprootInternalKey returns the MuSig2 combined key of the trader and
+// auctioneer base keys that is used as the internal key of a taproot account.
+func TaprootInternalKey(traderKey,
+	auctioneerKey *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	aggregateKey, _, _, err := musig2.AggregateKeys(
+		MuSig2Signers(traderKey, auctioneerKey), true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error combining keys: %v", err)
+	}
+
+	return aggregateKey.FinalKey, nil
+}
+
+// PkScript returns the P2TR output script of the account.
+func (t *TaprootAccount) PkScript() ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+
+	builder.AddOp(txscript.OP_1)
+	builder.AddData(schnorr.SerializePubKey(t.OutputKey))
+
+	return builder.Script()
+}
+
+// RootHash returns the root hash of the script tree the output key commits
+// to.
+func (t *TaprootAccount) RootHash() []byte {
+	rootHash := t.ExpiryLeaf.TapHash()
+	return rootHash[:]
+}
+
+// ControlBlock returns the serialized control block that proves the inclusion
+// of the expiry leaf when spending the account through its expiry path.
+func (t *TaprootAccount) ControlBlock() ([]byte, error) {
+	outputKeyBytes := t.OutputKey.SerializeCompressed()
+	controlBlock := txscript.ControlBlock{
+		InternalKey: t.InternalKey,
+		OutputKeyYIsOdd: outputKeyBytes[0] ==
+			secp.PubKeyFormatCompressedOdd,
+		LeafVersion: txscript.BaseLeafVersion,
+	}
+
+	return controlBlock.ToBytes()
+}
+
+// TaprootExpiryScript returns the leaf script of the expiry path of a taproot
+// account.
+//
+// <tweaked_trader_key> OP_CHECKSIGVERIFY
+// <account_expiry> OP_CHECKLOCKTIMEVERIFY.
+func TaprootExpiryScript(expiry uint32,
+	tweakedTraderKey *btcec.PublicKey) ([]byte, error) {
+
+	builder := txscript.NewScriptBuilder()
+
+	builder.AddData(schnorr.SerializePubKey(tweakedTraderKey))
+	builder.AddOp(txscript.OP_CHECKSIGVERIFY)
+
+	builder.AddInt64(int64(expiry))
+	builder.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+
+	return builder.Script()
+}
+
+// MuSig2Signers returns the keys of all signers of the MuSig2 key spend path
+// of a taproot account. MuSig2 only operates on x-only keys, so the keys are
+// normalized to have an even y coordinate.
+func MuSig2Signers(traderKey,
+	auctioneerKey *btcec.PublicKey) []*btcec.PublicKey {
+
+	traderXOnly, _ := schnorr.ParsePubKey(schnorr.SerializePubKey(traderKey))
+	auctioneerXOnly, _ := schnorr.ParsePubKey(
+		schnorr.SerializePubKey(auctioneerKey),
+	)
+
+	return []*btcec.PublicKey{traderXOnly, auctioneerXOnly}
+}
+
+// MuSig2SignerKeys returns the serialized x-only keys of all signers of the
+// MuSig2 key spend path of a taproot account in the format lnd expects.
+func MuSig2SignerKeys(traderKey, auctioneerKey *btcec.PublicKey) [][32]byte {
+	signers := MuSig2Signers(traderKey, auctioneerKey)
+	keys := make([][32]byte, len(signers))
+	for idx, signer := range signers {
+		copy(keys[idx][:], schnorr.SerializePubKey(signer))
+	}
+
+	return keys
+}
+
+// MuSig2Nonces is the public nonce of a single MuSig2 signer.
+type MuSig2Nonces [musig2.PubNonceSize]byte
+
+// SpendExpiryTaproot returns the witness required to spend a taproot account
+// through the expiry script path.
+func SpendExpiryTaproot(leafScript, traderSig,
+	controlBlock []byte) wire.TxWitness {
+
+	witness := make(wire.TxWitness, 3)
+	witness[0] = traderSig
+	witness[1] = leafScript
+	witness[2] = controlBlock
+	return witness
+}
+
+// SpendMuSig2Taproot returns the witness required to spend a taproot account
+// through the MuSig2 key spend path.
+func SpendMuSig2Taproot(combinedSig []byte) wire.TxWitness {
+	return wire.TxWitness{combinedSig}
+}
+
+// isTaprootExpirySpend returns true if the witness spends a taproot account
+// through the expiry script path. The expiry leaf is the only leaf, so its
+// control block doesn't contain an inclusion proof.
+func isTaprootExpirySpend(witness wire.TxWitness) bool {
+	return len(witness) == 3 && len(witness[0]) > 0 &&
+		len(witness[2]) == TaprootControlBlockSize
+}
+
+// isTaprootMultiSigSpend returns true if the witness spends a taproot account
+// through the MuSig2 key spend path.
+func isTaprootMultiSigSpend(witness wire.TxWitness) bool {
+	return len(witness) == 1 && len(witness[0]) == schnorr.SignatureSize
+}
@@ -0,0 +1,332 @@
+package poolscript
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTaprootAccountSpend makes sure a taproot account output can be spent
+// through both its MuSig2 key spend path and its expiry script path, and that
+// the resulting witnesses are detected correctly.
+func TestTaprootAccountSpend(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry = 1337
+		value  = 100_000
+	)
+
+	traderPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	auctioneerPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	batchKey, err := btcec.ParsePubKey(initialBatchKeyBytes)
+	require.NoError(t, err)
+
+	traderKey := traderPrivKey.PubKey()
+	auctioneerKey := auctioneerPrivKey.PubKey()
+	secret := [32]byte{1, 2, 3}
+
+	pkScript, err := AccountScript(
+		VersionTaprootMuSig2, expiry, traderKey, auctioneerKey,
+		batchKey, secret,
+	)
+	require.NoError(t, err)
+	require.True(t, txscript.IsPayToTaproot(pkScript))
+
+	tapAccount, err := NewTaprootAccount(
+		expiry, traderKey, auctioneerKey, batchKey, secret,
+	)
+	require.NoError(t, err)
+
+	spendTx := func(lockTime uint32) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		tx.LockTime = lockTime
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Index: 1},
+		})
+		tx.AddTxOut(&wire.TxOut{
+			Value:    value / 2,
+			PkScript: pkScript,
+		})
+
+		return tx
+	}
+	prevOutFetcher := txscript.NewCannedPrevOutputFetcher(pkScript, value)
+	validate := func(tx *wire.MsgTx) error {
+		engine, err := txscript.NewEngine(
+			pkScript, tx, 0, txscript.StandardVerifyFlags, nil,
+			txscript.NewTxSigHashes(tx, prevOutFetcher), value,
+			prevOutFetcher,
+		)
+		require.NoError(t, err)
+
+		return engine.Execute()
+	}
+
+	// The expiry path is signed with the tweaked trader key and requires
+	// the lock time to be at least the expiry.
+	tweakedTraderPrivKey := input.TweakPrivKey(
+		traderPrivKey, TraderKeyTweak(batchKey, secret, traderKey),
+	)
+	controlBlock, err := tapAccount.ControlBlock()
+	require.NoError(t, err)
+
+	for _, lockTime := range []uint32{expiry - 1, expiry} {
+		tx := spendTx(lockTime)
+		sig, err := txscript.RawTxInTapscriptSignature(
+			tx, txscript.NewTxSigHashes(tx, prevOutFetcher), 0,
+			value, pkScript, tapAccount.ExpiryLeaf,
+			txscript.SigHashDefault, tweakedTraderPrivKey,
+		)
+		require.NoError(t, err)
+
+		tx.TxIn[0].Witness = SpendExpiryTaproot(
+			tapAccount.ExpiryLeaf.Script, sig, controlBlock,
+		)
+		require.LessOrEqual(
+			t, tx.TxIn[0].Witness.SerializeSize(),
+			TaprootExpiryWitnessSize,
+		)
+		require.True(t, IsExpirySpend(tx.TxIn[0].Witness))
+		require.False(t, IsMultiSigSpend(tx.TxIn[0].Witness))
+
+		if lockTime < expiry {
+			require.Error(t, validate(tx))
+		} else {
+			require.NoError(t, validate(tx))
+		}
+	}
+
+	// The key spend path requires a MuSig2 signature of both base keys
+	// that commits to the expiry leaf through the taproot tweak.
+	tx := spendTx(0)
+	sigHash, err := txscript.CalcTaprootSignatureHash(
+		txscript.NewTxSigHashes(tx, prevOutFetcher),
+		txscript.SigHashDefault, tx, 0, prevOutFetcher,
+	)
+	require.NoError(t, err)
+	var msg [32]byte
+	copy(msg[:], sigHash)
+
+	tx.TxIn[0].Witness = SpendMuSig2Taproot(muSig2Sign(
+		t, traderPrivKey, auctioneerPrivKey, tapAccount, msg,
+	))
+	require.Equal(
+		t, TaprootMultiSigWitnessSize,
+		tx.TxIn[0].Witness.SerializeSize(),
+	)
+	require.True(t, IsMultiSigSpend(tx.TxIn[0].Witness))
+	require.False(t, IsExpirySpend(tx.TxIn[0].Witness))
+	require.NoError(t, validate(tx))
+}
+
+// TestRecoveryHelperTaproot makes sure the recovery helper finds account
+// outputs of both versions and reports their version correctly.
+func TestRecoveryHelperTaproot(t *testing.T) {
+	t.Parallel()
+
+	const expiry = 1337
+
+	traderPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	auctioneerPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	batchKey, err := btcec.ParsePubKey(initialBatchKeyBytes)
+	require.NoError(t, err)
+
+	traderKey := traderPrivKey.PubKey()
+	auctioneerKey := auctioneerPrivKey.PubKey()
+	secret := [32]byte{1, 2, 3}
+
+	helper := &RecoveryHelper{
+		BatchKey:      batchKey,
+		AuctioneerKey: auctioneerKey,
+	}
+	helper.NextAccount(traderKey, secret)
+
+	for _, version := range []Version{
+		VersionWitnessScript, VersionTaprootMuSig2,
+	} {
+		pkScript, err := AccountScript(
+			version, expiry, traderKey, auctioneerKey, batchKey,
+			secret,
+		)
+		require.NoError(t, err)
+
+		tx := &wire.MsgTx{TxOut: []*wire.TxOut{
+			{PkScript: []byte{txscript.OP_RETURN}},
+			{PkScript: pkScript},
+		}}
+
+		_, idx, foundVersion, ok, err := helper.LocateAnyOutput(
+			expiry, []*wire.MsgTx{tx},
+		)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.EqualValues(t, 1, idx)
+		require.Equal(t, version, foundVersion)
+
+		// A different expiry must not match.
+		_, _, ok, err = helper.LocateOutput(expiry+1, tx)
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+}
+
+// TestMixedVersionBatchSpend makes sure a legacy P2WSH account and a taproot
+// account can both be spent through their cooperative path and re-created
+// with the next batch key in the same batch transaction.
+func TestMixedVersionBatchSpend(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry = 1337
+		value  = 100_000
+	)
+
+	traderPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	auctioneerPrivKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	batchKey, err := btcec.ParsePubKey(initialBatchKeyBytes)
+	require.NoError(t, err)
+
+	var (
+		traderKey     = traderPrivKey.PubKey()
+		auctioneerKey = auctioneerPrivKey.PubKey()
+		nextBatchKey  = IncrementKey(batchKey)
+		secrets       = [][32]byte{{1, 2, 3}, {4, 5, 6}}
+		versions      = []Version{
+			VersionWitnessScript, VersionTaprootMuSig2,
+		}
+		prevOutFetcher = txscript.NewMultiPrevOutFetcher(nil)
+		tx             = wire.NewMsgTx(2)
+	)
+	for idx, version := range versions {
+		pkScript, err := AccountScript(
+			version, expiry, traderKey, auctioneerKey, batchKey,
+			secrets[idx],
+		)
+		require.NoError(t, err)
+		nextPkScript, err := AccountScript(
+			version, expiry, traderKey, auctioneerKey,
+			nextBatchKey, secrets[idx],
+		)
+		require.NoError(t, err)
+
+		prevOut := wire.OutPoint{Index: uint32(idx)}
+		prevOutFetcher.AddPrevOut(prevOut, &wire.TxOut{
+			Value:    value,
+			PkScript: pkScript,
+		})
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: prevOut})
+		tx.AddTxOut(&wire.TxOut{
+			Value:    value / 2,
+			PkScript: nextPkScript,
+		})
+	}
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+
+	// The legacy account is signed with the tweaked trader and auctioneer
+	// keys of its witness script.
+	witnessScript, err := AccountWitnessScript(
+		expiry, traderKey, auctioneerKey, batchKey, secrets[0],
+	)
+	require.NoError(t, err)
+	tweakedTraderPrivKey := input.TweakPrivKey(
+		traderPrivKey, TraderKeyTweak(batchKey, secrets[0], traderKey),
+	)
+	tweakedAuctioneerPrivKey := input.TweakPrivKey(
+		auctioneerPrivKey, AuctioneerKeyTweak(
+			traderKey, auctioneerKey, batchKey, secrets[0],
+		),
+	)
+	var sigs [][]byte
+	for _, privKey := range []*btcec.PrivateKey{
+		tweakedTraderPrivKey, tweakedAuctioneerPrivKey,
+	} {
+		sig, err := txscript.RawTxInWitnessSignature(
+			tx, sigHashes, 0, value, witnessScript,
+			txscript.SigHashAll, privKey,
+		)
+		require.NoError(t, err)
+		sigs = append(sigs, sig)
+	}
+	tx.TxIn[0].Witness = SpendMultiSig(witnessScript, sigs[0], sigs[1])
+
+	// The taproot account is signed with a MuSig2 signature of the base
+	// keys.
+	tapAccount, err := NewTaprootAccount(
+		expiry, traderKey, auctioneerKey, batchKey, secrets[1],
+	)
+	require.NoError(t, err)
+	sigHash, err := txscript.CalcTaprootSignatureHash(
+		sigHashes, txscript.SigHashDefault, tx, 1, prevOutFetcher,
+	)
+	require.NoError(t, err)
+	var msg [32]byte
+	copy(msg[:], sigHash)
+	tx.TxIn[1].Witness = SpendMuSig2Taproot(muSig2Sign(
+		t, traderPrivKey, auctioneerPrivKey, tapAccount, msg,
+	))
+
+	for idx, txIn := range tx.TxIn {
+		require.True(t, IsMultiSigSpend(txIn.Witness))
+
+		prevOut := prevOutFetcher.FetchPrevOutput(txIn.PreviousOutPoint)
+		engine, err := txscript.NewEngine(
+			prevOut.PkScript, tx, idx, txscript.StandardVerifyFlags,
+			nil, sigHashes, value, prevOutFetcher,
+		)
+		require.NoError(t, err)
+		require.NoError(t, engine.Execute(), versions[idx])
+	}
+}
+
+// muSig2Sign creates the combined MuSig2 signature of the trader and
+// auctioneer for the key spend path of the given taproot account.
+func muSig2Sign(t *testing.T, traderPrivKey,
+	auctioneerPrivKey *btcec.PrivateKey, tapAccount *TaprootAccount,
+	msg [32]byte) []byte {
+
+	signers := MuSig2Signers(
+		traderPrivKey.PubKey(), auctioneerPrivKey.PubKey(),
+	)
+	newSession := func(privKey *btcec.PrivateKey) *musig2.Session {
+		ctx, err := musig2.NewContext(
+			privKey, true, musig2.WithKnownSigners(signers),
+			musig2.WithTaprootTweakCtx(tapAccount.RootHash()),
+		)
+		require.NoError(t, err)
+
+		session, err := ctx.NewSession()
+		require.NoError(t, err)
+
+		return session
+	}
+	traderSession := newSession(traderPrivKey)
+	auctioneerSession := newSession(auctioneerPrivKey)
+
+	_, err := traderSession.RegisterPubNonce(auctioneerSession.PublicNonce())
+	require.NoError(t, err)
+	_, err = auctioneerSession.RegisterPubNonce(traderSession.PublicNonce())
+	require.NoError(t, err)
+
+	auctioneerSig, err := auctioneerSession.Sign(msg)
+	require.NoError(t, err)
+	_, err = traderSession.Sign(msg)
+	require.NoError(t, err)
+
+	haveAllSigs, err := traderSession.CombineSig(auctioneerSig)
+	require.NoError(t, err)
+	require.True(t, haveAllSigs)
+
+	return traderSession.FinalSig().Serialize()
+}
@@ -0,0 +1,226 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/perms"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrReadOnly is returned for every operation that would modify
+	// accounts, orders or the lnd wallet while the daemon is running in
+	// read-only mode.
+	ErrReadOnly = errors.New("operation not allowed, pool daemon is " +
+		"running in read-only mode")
+
+	// readOnlyAllowedMethods is the set of RPC methods that require write
+	// permissions but don't modify any state and are therefore allowed in
+	// read-only mode.
+	readOnlyAllowedMethods = map[string]struct{}{
+		"/poolrpc.Trader/StopDaemon": {},
+	}
+)
+
+// isMutatingMethod returns true if the given RPC method would modify the
+// trader's state. All methods that require a write permission are considered
+// mutating, unless they're explicitly allowed.
+func isMutatingMethod(fullMethod string) bool {
+	if _, ok := readOnlyAllowedMethods[fullMethod]; ok {
+		return false
+	}
+
+	for _, op := range perms.RequiredPermissions[fullMethod] {
+		if op.Action == "write" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkReadOnly returns an error if the daemon is running in read-only mode
+// and the given RPC method would modify the trader's state.
+func checkReadOnly(readOnly bool, fullMethod string) error {
+	if !readOnly || !isMutatingMethod(fullMethod) {
+		return nil
+	}
+
+	return status.Errorf(
+		codes.FailedPrecondition, "%v: %v", fullMethod, ErrReadOnly,
+	)
+}
+
+// readOnlyUnaryServerInterceptor is a UnaryServerInterceptor that rejects all
+// unary RPCs that would modify the trader's state in read-only mode.
+func readOnlyUnaryServerInterceptor(
+	readOnly bool) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if err := checkReadOnly(readOnly, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// readOnlyStreamServerInterceptor is a StreamServerInterceptor that rejects
+// all streaming RPCs that would modify the trader's state in read-only mode.
+func readOnlyStreamServerInterceptor(
+	readOnly bool) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if err := checkReadOnly(readOnly, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// readOnlyWalletKit is a wallet kit client that passes through all queries to
+// lnd's wallet but refuses to derive new keys, lease outputs, sign or publish
+// anything.
+type readOnlyWalletKit struct {
+	lndclient.WalletKitClient
+}
+
+// A compile-time check to make sure readOnlyWalletKit implements the
+// lndclient.WalletKitClient interface.
+var _ lndclient.WalletKitClient = (*readOnlyWalletKit)(nil)
+
+// LeaseOutput always returns ErrReadOnly.
+func (w *readOnlyWalletKit) LeaseOutput(context.Context, wtxmgr.LockID,
+	wire.OutPoint, time.Duration) (time.Time, error) {
+
+	return time.Time{}, ErrReadOnly
+}
+
+// ReleaseOutput always returns ErrReadOnly.
+func (w *readOnlyWalletKit) ReleaseOutput(context.Context, wtxmgr.LockID,
+	wire.OutPoint) error {
+
+	return ErrReadOnly
+}
+
+// DeriveNextKey always returns ErrReadOnly.
+func (w *readOnlyWalletKit) DeriveNextKey(context.Context,
+	int32) (*keychain.KeyDescriptor, error) {
+
+	return nil, ErrReadOnly
+}
+
+// NextAddr always returns ErrReadOnly.
+func (w *readOnlyWalletKit) NextAddr(context.Context, string,
+	walletrpc.AddressType, bool) (btcutil.Address, error) {
+
+	return nil, ErrReadOnly
+}
+
+// PublishTransaction always returns ErrReadOnly.
+func (w *readOnlyWalletKit) PublishTransaction(context.Context, *wire.MsgTx,
+	string) error {
+
+	return ErrReadOnly
+}
+
+// SendOutputs always returns ErrReadOnly.
+func (w *readOnlyWalletKit) SendOutputs(context.Context, []*wire.TxOut,
+	chainfee.SatPerKWeight, string) (*wire.MsgTx, error) {
+
+	return nil, ErrReadOnly
+}
+
+// BumpFee always returns ErrReadOnly.
+func (w *readOnlyWalletKit) BumpFee(context.Context, wire.OutPoint,
+	chainfee.SatPerKWeight) error {
+
+	return ErrReadOnly
+}
+
+// FundPsbt always returns ErrReadOnly.
+func (w *readOnlyWalletKit) FundPsbt(context.Context,
+	*walletrpc.FundPsbtRequest) (*psbt.Packet, int32,
+	[]*walletrpc.UtxoLease, error) {
+
+	return nil, 0, nil, ErrReadOnly
+}
+
+// SignPsbt always returns ErrReadOnly.
+func (w *readOnlyWalletKit) SignPsbt(context.Context,
+	*psbt.Packet) (*psbt.Packet, error) {
+
+	return nil, ErrReadOnly
+}
+
+// FinalizePsbt always returns ErrReadOnly.
+func (w *readOnlyWalletKit) FinalizePsbt(context.Context, *psbt.Packet,
+	string) (*psbt.Packet, *wire.MsgTx, error) {
+
+	return nil, nil, ErrReadOnly
+}
+
+// readOnlySigner is a signer client that still verifies signatures but
+// refuses to create any.
+type readOnlySigner struct {
+	lndclient.SignerClient
+}
+
+// A compile-time check to make sure readOnlySigner implements the
+// lndclient.SignerClient interface.
+var _ lndclient.SignerClient = (*readOnlySigner)(nil)
+
+// SignOutputRaw always returns ErrReadOnly.
+func (s *readOnlySigner) SignOutputRaw(context.Context, *wire.MsgTx,
+	[]*lndclient.SignDescriptor, []*wire.TxOut) ([][]byte, error) {
+
+	return nil, ErrReadOnly
+}
+
+// ComputeInputScript always returns ErrReadOnly.
+func (s *readOnlySigner) ComputeInputScript(context.Context, *wire.MsgTx,
+	[]*lndclient.SignDescriptor) ([]*input.Script, error) {
+
+	return nil, ErrReadOnly
+}
+
+// SignMessage always returns ErrReadOnly.
+func (s *readOnlySigner) SignMessage(context.Context, []byte,
+	keychain.KeyLocator) ([]byte, error) {
+
+	return nil, ErrReadOnly
+}
+
+// MuSig2CreateSession always returns ErrReadOnly.
+func (s *readOnlySigner) MuSig2CreateSession(context.Context,
+	*keychain.KeyLocator, [][32]byte,
+	...lndclient.MuSig2SessionOpts) (*input.MuSig2SessionInfo, error) {
+
+	return nil, ErrReadOnly
+}
+
+// MuSig2Sign always returns ErrReadOnly.
+func (s *readOnlySigner) MuSig2Sign(context.Context, [32]byte, [32]byte,
+	bool) ([]byte, error) {
+
+	return nil, ErrReadOnly
+}
@@ -0,0 +1,61 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestReadOnlyInterceptor makes sure all RPCs that modify the trader's state
+// are rejected in read-only mode while all queries are still passed through.
+func TestReadOnlyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(readOnly bool, method string) error {
+		interceptor := readOnlyUnaryServerInterceptor(readOnly)
+		_, err := interceptor(
+			context.Background(), nil, &grpc.UnaryServerInfo{
+				FullMethod: method,
+			}, handler,
+		)
+		return err
+	}
+
+	mutating := []string{
+		"/poolrpc.Trader/SubmitOrder",
+		"/poolrpc.Trader/CancelOrder",
+		"/poolrpc.Trader/InitAccount",
+		"/poolrpc.Trader/WithdrawAccount",
+		"/poolrpc.Trader/DepositAccount",
+		"/poolrpc.Trader/CloseAccount",
+		"/poolrpc.Trader/RecoverAccounts",
+		"/poolrpc.Trader/OfferSidecar",
+	}
+	for _, method := range mutating {
+		require.NoError(t, call(false, method))
+
+		err := call(true, method)
+		require.Error(t, err, method)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Contains(t, err.Error(), ErrReadOnly.Error())
+	}
+
+	readOnly := []string{
+		"/poolrpc.Trader/GetInfo",
+		"/poolrpc.Trader/ListAccounts",
+		"/poolrpc.Trader/ListOrders",
+		"/poolrpc.Trader/BatchSnapshot",
+		"/poolrpc.Trader/BatchSnapshots",
+		"/poolrpc.Trader/StopDaemon",
+	}
+	for _, method := range readOnly {
+		require.NoError(t, call(true, method), method)
+	}
+}
@@ -0,0 +1,123 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/poolscript"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// The trader never holds any private keys itself. All keys live in the wallet
+// of the connected lnd node, or in the wallet of the remote signer if lnd runs
+// in watch-only mode, and are derived along the BIP43 style path
+//
+//	m/1017'/<coin type>'/<key family>'/0/<index>
+//
+// where the coin type is 0 for mainnet and 1 for all test networks. The
+// following key families are used:
+//
+//   - poolscript.AccountKeyFamily (220): The trader key of each account. It
+//     is part of the account output script, signs the account inputs of
+//     batches and account modifications, the digests of orders and sidecar
+//     offers and the authentication of the account subscriptions.
+//   - keychain.KeyFamilyMultiSig (0): The multisig key of each channel that
+//     is created in a batch, derived when submitting a bid or ask or when
+//     registering a sidecar ticket as the recipient. It also signs the
+//     recipient's part of a sidecar ticket.
+//
+// The watch-only lnd node only ever derives the public keys and tells us their
+// key locator. Everything that needs a private key goes through the signer
+// client which uses the key locator to derive the private key again.
+
+var (
+	// remoteSignerCheckKeyLoc is the key locator of the key that is derived
+	// on startup to make sure the remote signer holds the keys of our
+	// wallet.
+	remoteSignerCheckKeyLoc = keychain.KeyLocator{
+		Family: poolscript.AccountKeyFamily,
+		Index:  0,
+	}
+
+	// errRemoteSignerMismatch is returned if the remote signer derives a
+	// different trader key than the watch-only lnd node.
+	errRemoteSignerMismatch = errors.New("remote signer derived a " +
+		"different trader key than lnd, it's not the signer of the " +
+		"connected lnd wallet")
+)
+
+// RemoteSignerConfig holds the configuration of the connection to an lnd node
+// that runs as the remote signer of a watch-only lnd node.
+type RemoteSignerConfig struct {
+	Enable       bool   `long:"enable" description:"Create all signatures with a remote signer instead of the connected lnd node. Use this if lnd runs as a watch-only node with remote signing enabled. The connected lnd node is then only used to derive public keys and to watch the chain."`
+	Host         string `long:"host" description:"The rpc address of the lnd node that holds the private keys of the watch-only lnd wallet."`
+	MacaroonPath string `long:"macaroonpath" description:"The full path to the macaroon to use for the remote signer. The macaroon must at least grant the signer:generate and signer:read permissions."`
+	TLSPath      string `long:"tlspath" description:"Path to the tls certificate of the remote signer."`
+}
+
+// Validate makes sure the config is valid.
+func (c *RemoteSignerConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.Host == "" {
+		return fmt.Errorf("--remotesigner.host is required if the " +
+			"remote signer is enabled")
+	}
+	if c.MacaroonPath == "" {
+		return fmt.Errorf("--remotesigner.macaroonpath is required " +
+			"if the remote signer is enabled")
+	}
+
+	return nil
+}
+
+// getRemoteSigner connects to the remote signer. The signer doesn't need to be
+// synced to the chain as it never looks at it.
+func getRemoteSigner(network string,
+	cfg *RemoteSignerConfig) (*lndclient.GrpcLndServices, error) {
+
+	return lndclient.NewLndServices(&lndclient.LndServicesConfig{
+		LndAddress:         cfg.Host,
+		Network:            lndclient.Network(network),
+		CustomMacaroonPath: cfg.MacaroonPath,
+		TLSPath:            cfg.TLSPath,
+		CheckVersion:       minimalCompatibleVersion,
+		BlockUntilUnlocked: true,
+	})
+}
+
+// keyDeriver is the part of the wallet kit we need to check that the remote
+// signer holds the keys of our wallet.
+type keyDeriver interface {
+	// DeriveKey derives the key with the given locator.
+	DeriveKey(ctx context.Context,
+		locator *keychain.KeyLocator) (*keychain.KeyDescriptor, error)
+}
+
+// checkRemoteSigner makes sure the remote signer can derive keys of the trader
+// key family and that they match the public keys the watch-only lnd node
+// derives. Otherwise every account and order signature would be invalid.
+func checkRemoteSigner(ctx context.Context, remote,
+	watchOnly keyDeriver) error {
+
+	remoteKey, err := remote.DeriveKey(ctx, &remoteSignerCheckKeyLoc)
+	if err != nil {
+		return fmt.Errorf("remote signer unable to derive trader key: "+
+			"%v", err)
+	}
+
+	localKey, err := watchOnly.DeriveKey(ctx, &remoteSignerCheckKeyLoc)
+	if err != nil {
+		return fmt.Errorf("lnd unable to derive trader key: %v", err)
+	}
+
+	if !remoteKey.PubKey.IsEqual(localKey.PubKey) {
+		return errRemoteSignerMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKeyDeriver is a key deriver that derives the same key for every locator.
+type mockKeyDeriver struct {
+	key *btcec.PublicKey
+	err error
+}
+
+// DeriveKey returns the configured key with the given locator.
+func (d *mockKeyDeriver) DeriveKey(_ context.Context,
+	locator *keychain.KeyLocator) (*keychain.KeyDescriptor, error) {
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return &keychain.KeyDescriptor{
+		KeyLocator: *locator,
+		PubKey:     d.key,
+	}, nil
+}
+
+// TestCheckRemoteSigner makes sure the remote signer is only accepted if it
+// derives the same trader key as the watch-only lnd node.
+func TestCheckRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	privKey1, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	privKey2, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	var (
+		ctx       = context.Background()
+		key1      = &mockKeyDeriver{key: privKey1.PubKey()}
+		key2      = &mockKeyDeriver{key: privKey2.PubKey()}
+		errDerive = errors.New("permission denied")
+		failing   = &mockKeyDeriver{err: errDerive}
+	)
+
+	require.NoError(t, checkRemoteSigner(ctx, key1, key1))
+	require.ErrorIs(
+		t, checkRemoteSigner(ctx, key1, key2), errRemoteSignerMismatch,
+	)
+	require.ErrorContains(
+		t, checkRemoteSigner(ctx, failing, key1),
+		"remote signer unable to derive trader key",
+	)
+	require.ErrorContains(
+		t, checkRemoteSigner(ctx, key1, failing),
+		"lnd unable to derive trader key",
+	)
+}
+
+// TestRemoteSignerConfigValidate makes sure an enabled remote signer needs an
+// address and a macaroon.
+func TestRemoteSignerConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&RemoteSignerConfig{}).Validate())
+	require.Error(t, (&RemoteSignerConfig{Enable: true}).Validate())
+	require.Error(t, (&RemoteSignerConfig{
+		Enable: true,
+		Host:   "localhost:10019",
+	}).Validate())
+	require.NoError(t, (&RemoteSignerConfig{
+		Enable:       true,
+		Host:         "localhost:10019",
+		MacaroonPath: "signer.macaroon",
+	}).Validate())
+}
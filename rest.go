@@ -0,0 +1,69 @@
+package pool
+
+import (
+	"net/http"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// newRESTHandler wraps the given grpc-gateway handler so streaming RPCs can
+// be used over WebSockets and browsers from the configured origins are
+// allowed to make cross-origin requests. A request passes through the
+// following chain:
+//
+//	req --> CORS handler --> WebSocket proxy --> REST proxy --> gRPC server
+func newRESTHandler(mux http.Handler, cfg *Config) http.Handler {
+	// The trader RPC has no client-streaming methods, so there are no URIs
+	// that need their request body to be set up for client streaming.
+	wsProxy := lnrpc.NewWebSocketProxy(
+		mux, rpcLog, cfg.WSPingInterval, cfg.WSPongWait, nil,
+	)
+
+	return allowCORS(wsProxy, cfg.RESTCORS)
+}
+
+// allowCORS wraps the given handler with a function that adds the
+// Access-Control-Allow-Origin header to the response if the request
+// originates from one of the given origins. If no origins are given, CORS is
+// disabled and the handler is returned unchanged.
+func allowCORS(handler http.Handler, origins []string) http.Handler {
+	if len(origins) == 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+
+		// Requests that don't come from a browser don't need any of
+		// the CORS headers.
+		if origin == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(
+			"Access-Control-Allow-Headers",
+			"Content-Type, Accept, Grpc-Metadata-Macaroon",
+		)
+		w.Header().Set(
+			"Access-Control-Allow-Methods", "GET, POST, DELETE",
+		)
+
+		for _, allowedOrigin := range origins {
+			if allowedOrigin == "*" || origin == allowedOrigin {
+				w.Header().Set(
+					"Access-Control-Allow-Origin", origin,
+				)
+				break
+			}
+		}
+
+		// A pre-flight request only needs the headers, there's nothing
+		// to forward.
+		if r.Method == http.MethodOptions {
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,160 @@
+package pool
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// restTestServer is a trader server that answers the calls of the REST test
+// with the macaroon it received.
+type restTestServer struct {
+	poolrpc.UnimplementedTraderServer
+}
+
+// macaroonFromContext returns the macaroon the REST proxy forwarded.
+func macaroonFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if macaroons := md.Get("macaroon"); len(macaroons) > 0 {
+		return macaroons[0]
+	}
+
+	return ""
+}
+
+func (s *restTestServer) GetInfo(ctx context.Context,
+	_ *poolrpc.GetInfoRequest) (*poolrpc.GetInfoResponse, error) {
+
+	return &poolrpc.GetInfoResponse{
+		Version: macaroonFromContext(ctx),
+	}, nil
+}
+
+func (s *restTestServer) SubscribeServerState(
+	_ *poolrpc.SubscribeServerStateRequest,
+	stream poolrpc.Trader_SubscribeServerStateServer) error {
+
+	states := []poolrpc.AuctioneerConnectionState{
+		poolrpc.AuctioneerConnectionState_AUCTIONEER_CONNECTED,
+		poolrpc.AuctioneerConnectionState_AUCTIONEER_RECONNECTING,
+	}
+	for _, state := range states {
+		err := stream.Send(&poolrpc.ServerStateUpdate{
+			State:  state,
+			Reason: macaroonFromContext(stream.Context()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TestRESTHandler makes sure unary and streaming calls can be made through
+// the REST handler, including the macaroon header, and that cross-origin
+// requests are only allowed from the configured origins.
+func TestRESTHandler(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	poolrpc.RegisterTraderServer(grpcServer, &restTestServer{})
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := proxy.NewServeMux()
+	err = poolrpc.RegisterTraderHandlerFromEndpoint(
+		ctx, mux, lis.Addr().String(), []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	)
+	require.NoError(t, err)
+
+	const origin = "https://dashboard.example"
+	restServer := httptest.NewServer(newRESTHandler(mux, &Config{
+		RESTCORS: []string{origin},
+	}))
+	defer restServer.Close()
+
+	// Unary calls are forwarded together with the macaroon.
+	req, err := http.NewRequest(
+		http.MethodGet, restServer.URL+"/v1/pool/info", nil,
+	)
+	require.NoError(t, err)
+	req.Header.Set("Grpc-Metadata-Macaroon", "abcd")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(body), `"version":"abcd"`)
+
+	// A pre-flight request of an allowed origin is answered with the CORS
+	// headers only, other origins aren't allowed.
+	for _, reqOrigin := range []string{origin, "https://evil.example"} {
+		req, err := http.NewRequest(
+			http.MethodOptions, restServer.URL+"/v1/pool/info",
+			nil,
+		)
+		require.NoError(t, err)
+		req.Header.Set("Origin", reqOrigin)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		allowed := resp.Header.Get("Access-Control-Allow-Origin")
+		if reqOrigin == origin {
+			require.Equal(t, origin, allowed)
+		} else {
+			require.Empty(t, allowed)
+		}
+	}
+
+	// Streaming calls are available through WebSockets.
+	wsURL := "ws" + strings.TrimPrefix(restServer.URL, "http") +
+		"/v1/pool/serverstate"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, http.Header{
+		"Grpc-Metadata-Macaroon": []string{"abcd"},
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	for _, state := range []string{
+		"AUCTIONEER_CONNECTED", "AUCTIONEER_RECONNECTING",
+	} {
+		_, msg, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		var update struct {
+			Result struct {
+				State  string `json:"state"`
+				Reason string `json:"reason"`
+			} `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(msg, &update))
+		require.Equal(t, state, update.Result.State)
+		require.Equal(t, "abcd", update.Result.Reason)
+	}
+}
@@ -6,40 +6,78 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"net"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/approval"
 	"github.com/lightninglabs/pool/auctioneer"
 	"github.com/lightninglabs/pool/auctioneerrpc"
 	"github.com/lightninglabs/pool/chaninfo"
 	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/event"
 	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/lsatstore"
+	"github.com/lightninglabs/pool/matchsim"
+	"github.com/lightninglabs/pool/metrics"
 	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/perms"
+	"github.com/lightninglabs/pool/policy"
 	"github.com/lightninglabs/pool/poolrpc"
 	"github.com/lightninglabs/pool/poolscript"
 	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightninglabs/pool/sidecaracceptor"
 	"github.com/lightninglabs/pool/terms"
-	"github.com/lightningnetwork/lnd/chanbackup"
 	lndFunding "github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
 const (
 	// getInfoTimeout is the maximum time we allow for the initial getInfo
 	// call to the connected lnd node.
 	getInfoTimeout = 5 * time.Second
+
+	// fullClientRecoveryChunkSize is the number of account keys that are
+	// tried at once during a full client recovery before the progress is
+	// persisted.
+	fullClientRecoveryChunkSize = 50
+
+	// orderExpiryInterval is the interval at which we check whether any
+	// of our orders reached their expiry time. Orders are also checked on
+	// every new block.
+	orderExpiryInterval = time.Minute
+
+	// orderExpiryTimeout is the maximum time we allow for canceling all
+	// expired orders with the auctioneer.
+	orderExpiryTimeout = 30 * time.Second
+
+	// maxSidecarBatchSize is the maximum number of sidecar tickets that
+	// can be created with a single OfferSidecarBatch call.
+	maxSidecarBatchSize = 500
+
+	// quoteMatchDisclaimer is returned with every simulated match to make
+	// clear it is only an estimate. It is formatted with the time the
+	// order book snapshot was taken.
+	quoteMatchDisclaimer = "This is an estimate based on the order book " +
+		"snapshot taken at %s. The actual match depends on the orders " +
+		"present when the next batch is cleared and is not guaranteed."
 )
 
 // rpcServer implements the gRPC server on the client side and answers RPC calls
@@ -52,6 +90,15 @@ type rpcServer struct {
 	// used atomically.
 	bestHeight uint32
 
+	// shuttingDown is set to 1 once we started shutting down and don't
+	// accept any new RPCs or batches anymore. This MUST be used
+	// atomically.
+	shuttingDown uint32
+
+	// handlerStarted is set to 1 once the server handler goroutine is
+	// running. This MUST be used atomically.
+	handlerStarted uint32
+
 	// Required by the grpc-gateway/v2 library for forward compatibility.
 	// Must be after the atomically used variables to not break struct
 	// alignment.
@@ -74,6 +121,32 @@ type rpcServer struct {
 	// wumboSupported is true if the backing lnd node supports wumbo
 	// channels.
 	wumboSupported bool
+
+	// healthMonitor keeps us from taking part in batches while our lnd
+	// node is unhealthy. This is nil if the monitor is disabled.
+	healthMonitor *healthMonitor
+
+	// batchReorgWatcher reverts completed batches whose transaction was
+	// reorged out of the chain. This is nil if we're running as a
+	// subserver or in read-only mode.
+	batchReorgWatcher *batchReorgWatcher
+
+	// batchPolicy holds the rules every batch we take part in must
+	// satisfy.
+	batchPolicy *policy.Manager
+
+	// approvalGate asks the external approval hook about every batch
+	// before we accept it. This is nil if no hook is configured.
+	approvalGate *approval.Gate
+
+	// inflight is the batch we're currently part of. This must only be
+	// accessed by the server handler goroutine.
+	inflight inflightBatch
+
+	// drainRequests is used to ask the server handler to wait for the
+	// in-flight batch before shutting down. The given channel is closed
+	// once the batch finished or the grace period is over.
+	drainRequests chan chan struct{}
 }
 
 // accountStore is a clientdb.DB wrapper to implement the account.Store
@@ -89,45 +162,154 @@ func (s *accountStore) PendingBatch() error {
 	return err
 }
 
+// MarkBatchComplete marks the pending batch with the given batch transaction
+// hash as complete. If none of the pending batches has that transaction,
+// account.ErrNoPendingBatch is returned.
+func (s *accountStore) MarkBatchComplete(batchTxHash chainhash.Hash) error {
+	batchIDs, err := s.DB.PendingBatches()
+	if err != nil {
+		return err
+	}
+
+	for _, batchID := range batchIDs {
+		snapshot, err := s.DB.GetPendingBatchSnapshot(batchID)
+		if err != nil {
+			return err
+		}
+
+		if snapshot.BatchTX.TxHash() == batchTxHash {
+			return s.DB.MarkBatchComplete(batchID)
+		}
+	}
+
+	return account.ErrNoPendingBatch
+}
+
 // newRPCServer creates a new client-side RPC server that uses the given
 // connection to the trader's lnd node and the auction server. A client side
 // database is created in `serverDir` if it does not yet exist.
 func newRPCServer(server *Server) *rpcServer {
 	accountStore := &accountStore{server.db}
 	lndServices := &server.lndServices.LndServices
-	return &rpcServer{
-		server:      server,
-		lndServices: lndServices,
-		lndClient:   server.lndClient,
-		auctioneer:  server.AuctioneerClient,
-		accountManager: account.NewManager(&account.ManagerConfig{
-			Store:          accountStore,
-			Auctioneer:     server.AuctioneerClient,
-			Wallet:         lndServices.WalletKit,
-			Signer:         lndServices.Signer,
-			ChainNotifier:  lndServices.ChainNotifier,
-			TxSource:       lndServices.Client,
-			TxFeeEstimator: lndServices.Client,
-			TxLabelPrefix:  server.cfg.TxLabelPrefix,
-			ChainParams:    lndServices.ChainParams,
-			LndVersion:     lndServices.Version,
-		}),
-		orderManager: order.NewManager(&order.ManagerConfig{
-			Store:     server.db,
-			AcctStore: accountStore,
-			Lightning: lndServices.Client,
-			Wallet:    lndServices.WalletKit,
-			Signer:    lndServices.Signer,
-			BatchVersion: order.BatchVersion(
-				server.cfg.DebugConfig.BatchVersion,
-			),
-		}),
+
+	// In read-only mode the managers must never be able to sign or publish
+	// anything, so we hand them wrappers that reject those operations.
+	var (
+		wallet lndclient.WalletKitClient = lndServices.WalletKit
+		signer lndclient.SignerClient    = server.signer
+	)
+	if server.cfg.ReadOnly {
+		wallet = &readOnlyWalletKit{WalletKitClient: wallet}
+		signer = &readOnlySigner{SignerClient: signer}
+	}
+
+	accountManager := account.NewManager(&account.ManagerConfig{
+		Store:          accountStore,
+		Auctioneer:     server.AuctioneerClient,
+		Wallet:         wallet,
+		Signer:         signer,
+		ChainNotifier:  lndServices.ChainNotifier,
+		TxSource:       lndServices.Client,
+		TxFeeEstimator: lndServices.Client,
+		TxLabelPrefix:  server.cfg.TxLabelPrefix,
+		ChainParams:    lndServices.ChainParams,
+		LndVersion:     lndServices.Version,
+		AutoRenewMaxFeeRate: chainfee.SatPerKVByte(
+			server.cfg.AutoRenewMaxFeeRate * 1000,
+		).FeePerKWeight(),
+		AutoRenewExpiryBlocks:        server.cfg.AutoRenewExpiryBlocks,
+		MinAcceptableExpiryExtension: server.cfg.MinExpiryExtension,
+		HasActiveOrders: func(ctx context.Context,
+			traderKey *btcec.PublicKey) (bool, error) {
+
+			nonces, err := activeOrderNonces(
+				ctx, server.db, server.AuctioneerClient,
+				traderKey.SerializeCompressed(),
+			)
+			return len(nonces) > 0, err
+		},
+		Metrics:            server.metrics,
+		CompactFilterWatch: server.cfg.Lnd.CompactFilters,
+	})
+
+	batchPolicy := policy.NewManager(server.db)
+
+	orderManagerCfg := &order.ManagerConfig{
+		Store:     server.db,
+		AcctStore: accountStore,
+		Lightning: lndServices.Client,
+		Wallet:    wallet,
+		Signer:    signer,
+		BatchVersion: order.BatchVersion(
+			server.cfg.DebugConfig.BatchVersion,
+		),
+		FetchTerms:              server.AuctioneerClient.Terms,
+		SendOrder:               server.AuctioneerClient.SubmitOrder,
+		CancelOrder:             server.AuctioneerClient.CancelOrder,
+		TermsStore:              server.db,
+		TermsCacheTTL:           server.cfg.TermsCacheTTL,
+		ValidateExpiryExtension: accountManager.ValidateExpiryExtension,
+		SubmitRateLimit:         server.cfg.OrderSubmitRate,
+		SubmitBurst:             server.cfg.OrderSubmitBurst,
+		DedupWindow:             server.cfg.OrderDedupWindow,
+		MaxAutoFeeRate: chainfee.SatPerKVByte(
+			server.cfg.OrderMaxAutoFeeRate * 1000,
+		).FeePerKWeight(),
+		NodeTierCacheTTL:    server.cfg.NodeTierCacheTTL,
+		Metrics:             server.metrics,
+		EvaluateBatchPolicy: batchPolicy.Evaluate,
+	}
+	if !server.cfg.SkipNodeTierCheck {
+		client := server.AuctioneerClient
+		orderManagerCfg.FetchNodeTiers = client.NodeTiers
+	}
+	orderManager := order.NewManager(orderManagerCfg)
+
+	rpcServer := &rpcServer{
+		server:         server,
+		lndServices:    lndServices,
+		lndClient:      server.lndClient,
+		auctioneer:     server.AuctioneerClient,
+		accountManager: accountManager,
+		orderManager:   orderManager,
+		batchPolicy:    batchPolicy,
 		marshaler: NewMarshaler(&marshalerConfig{
 			GetOrders: server.db.GetOrders,
 			Terms:     server.AuctioneerClient.Terms,
 		}),
-		quit: make(chan struct{}),
+		quit:          make(chan struct{}),
+		drainRequests: make(chan chan struct{}),
+	}
+
+	if !server.cfg.Health.Disable && !server.cfg.ReadOnly {
+		healthCfg := server.cfg.Health
+		rpcServer.healthMonitor = newHealthMonitor(&healthMonitorConfig{
+			Interval:     healthCfg.Interval,
+			GracePeriod:  healthCfg.GracePeriod,
+			CancelOrders: healthCfg.CancelOrders,
+			CheckHealth: func(ctx context.Context) error {
+				return checkLndHealth(
+					ctx, lndServices.State, server.lndClient,
+				)
+			},
+			CancelAllOrders: rpcServer.cancelAllOrders,
+		})
+	}
+
+	if server.chainNotifier != nil && !server.cfg.ReadOnly {
+		rpcServer.batchReorgWatcher = newBatchReorgWatcher(
+			&batchReorgWatcherConfig{
+				Store: server.db,
+				Notifier: &chainBatchConfNotifier{
+					client: server.chainNotifier,
+				},
+				ConfDepth: batchReorgSafetyDepth,
+				OnRevert:  rpcServer.resumeRevertedBatch,
+			},
+		)
 	}
+
+	return rpcServer
 }
 
 // Start starts the rpcServer, making it ready to accept incoming requests.
@@ -177,26 +359,65 @@ func (s *rpcServer) Start() error {
 
 	s.updateHeight(height)
 
+	// Load the batch policy before we might be asked to take part in the
+	// first batch.
+	if err := s.startBatchPolicy(); err != nil {
+		return fmt.Errorf("unable to start batch policy: %v", err)
+	}
+
 	// Start the auctioneer client first to establish a connection.
 	if err := s.auctioneer.Start(); err != nil {
 		return fmt.Errorf("unable to start auctioneer client: %v", err)
 	}
 
-	// Start managers.
-	if err := s.accountManager.Start(); err != nil {
-		return fmt.Errorf("unable to start account manager: %v", err)
-	}
+	// The order manager only needs to be started to be able to answer
+	// queries about our orders.
 	if err := s.orderManager.Start(); err != nil {
 		return fmt.Errorf("unable to start order manager: %v", err)
 	}
-	if err := s.server.fundingManager.Start(); err != nil {
-		return fmt.Errorf("unable to start funding manager: %v", err)
-	}
-	if err := s.server.sidecarAcceptor.Start(blockErrChan); err != nil {
-		return fmt.Errorf("unable to start sidecar acceptor: %v", err)
+
+	// All other managers resume on-chain operations of our accounts and
+	// take part in batches, which we must not do in read-only mode.
+	if s.server.cfg.ReadOnly {
+		rpcLog.Infof("Running in read-only mode, not resuming " +
+			"accounts or participating in batches")
+	} else {
+		if err := s.accountManager.Start(); err != nil {
+			return fmt.Errorf("unable to start account manager: %v",
+				err)
+		}
+		if err := s.server.fundingManager.Start(); err != nil {
+			return fmt.Errorf("unable to start funding manager: %v",
+				err)
+		}
+		err := s.server.sidecarAcceptor.Start(blockErrChan)
+		if err != nil {
+			return fmt.Errorf("unable to start sidecar acceptor: %v",
+				err)
+		}
+		if s.healthMonitor != nil {
+			s.healthMonitor.Start()
+		}
+		if s.batchReorgWatcher != nil {
+			err := s.batchReorgWatcher.WatchBatches()
+			if err != nil {
+				return fmt.Errorf("unable to start batch reorg "+
+					"watcher: %v", err)
+			}
+		}
+		if s.server.cfg.BatchApproval.Enabled() {
+			s.approvalGate, err = approval.NewGate(
+				s.server.cfg.BatchApproval,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to start batch "+
+					"approval gate: %v", err)
+			}
+		}
 	}
 
 	s.wg.Add(1)
+	atomic.StoreUint32(&s.handlerStarted, 1)
 	go s.serverHandler(blockChan, blockErrChan)
 
 	rpcLog.Infof("Trader server is now active")
@@ -212,14 +433,22 @@ func (s *rpcServer) Stop() error {
 
 	var returnErr error
 	rpcLog.Info("Trader server stopping")
-	if err := s.server.sidecarAcceptor.Stop(); err != nil {
-		rpcLog.Errorf("Error stopping sidecar acceptor: %v", err)
-		returnErr = err
-	}
-	if err := s.server.fundingManager.Stop(); err != nil {
-		rpcLog.Errorf("Error stopping funding manager: %v", err)
+	if !s.server.cfg.ReadOnly {
+		if s.healthMonitor != nil {
+			s.healthMonitor.Stop()
+		}
+		if s.batchReorgWatcher != nil {
+			s.batchReorgWatcher.Stop()
+		}
+		if err := s.server.sidecarAcceptor.Stop(); err != nil {
+			rpcLog.Errorf("Error stopping sidecar acceptor: %v", err)
+			returnErr = err
+		}
+		if err := s.server.fundingManager.Stop(); err != nil {
+			rpcLog.Errorf("Error stopping funding manager: %v", err)
+		}
+		s.accountManager.Stop()
 	}
-	s.accountManager.Stop()
 	s.orderManager.Stop()
 	if err := s.auctioneer.Stop(); err != nil {
 		rpcLog.Errorf("Error closing server stream: %v", err)
@@ -230,6 +459,15 @@ func (s *rpcServer) Stop() error {
 	s.wg.Wait()
 	s.blockNtfnCancel()
 
+	// The server handler is the only user of the approval gate, so we can
+	// close it once the handler exited.
+	if s.approvalGate != nil {
+		if err := s.approvalGate.Stop(); err != nil {
+			rpcLog.Errorf("Error stopping batch approval gate: %v",
+				err)
+		}
+	}
+
 	rpcLog.Info("Stopped trader server")
 
 	return returnErr
@@ -239,6 +477,22 @@ func (s *rpcServer) Stop() error {
 func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan chan error) {
 	defer s.wg.Done()
 
+	expiryTicker := time.NewTicker(orderExpiryInterval)
+	defer expiryTicker.Stop()
+
+	// drain is set once we're shutting down and wait for an in-flight
+	// batch to finish.
+	var (
+		drain         *batchDrain
+		drainDeadline <-chan time.Time
+		drainLog      <-chan time.Time
+	)
+	defer func() {
+		if drain != nil {
+			s.finishDrain(drain)
+		}
+	}()
+
 	for {
 		select {
 		case msg := <-s.auctioneer.FromServerChan:
@@ -260,25 +514,44 @@ func (s *rpcServer) serverHandler(blockChan chan int32, blockErrChan chan error)
 				interceptor.RequestShutdown()
 			}
 
+			// If we're waiting for the in-flight batch to finish,
+			// this might have been the message we were waiting on.
+			if drain != nil && s.inflight.phase == batchPhaseNone {
+				s.finishDrain(drain)
+				drain, drainDeadline, drainLog = nil, nil, nil
+			}
+
+		case done := <-s.drainRequests:
+			drain = s.startDrain(done)
+			if drain != nil {
+				drainDeadline = drain.deadline.C
+				drainLog = drain.logTicker.C
+			}
+
+		case <-drainLog:
+			rpcLog.Infof("Shutdown still waiting for batch %x (%v)",
+				s.inflight.batch.ID[:], s.inflight.phase)
+
+		case <-drainDeadline:
+			s.finishDrain(drain)
+			drain, drainDeadline, drainLog = nil, nil, nil
+
 		case err := <-s.auctioneer.StreamErrChan:
-			// If the server is shutting down, then the client has
-			// already scheduled a restart. We only need to handle
-			// other errors here.
+			// The client schedules a reconnect by itself if the
+			// stream is lost, so we only log the error here.
 			if err != nil && err != auctioneer.ErrServerShutdown {
 				rpcLog.Errorf("Error in server stream: %v", err)
-				err := s.auctioneer.HandleServerShutdown(err)
-				if err != nil {
-					rpcLog.Errorf("Error closing stream: "+
-						"%v", err)
-				}
 			}
 
-			rpcLog.Error("Unknown server error: %v", err)
-
 		case height := <-blockChan:
 			rpcLog.Infof("Received new block notification: "+
 				"height=%v", height)
 			s.updateHeight(height)
+			s.expireOrders()
+			s.watchBatchReorgs()
+
+		case <-expiryTicker.C:
+			s.expireOrders()
 
 		case err := <-blockErrChan:
 			if err != nil {
@@ -300,6 +573,53 @@ func (s *rpcServer) updateHeight(height int32) {
 	atomic.StoreUint32(&s.bestHeight, uint32(height))
 }
 
+// expireOrders cancels all orders that reached their expiry height or time.
+// This is called from the main event loop so no order is canceled while the
+// batch it is part of is being processed.
+func (s *rpcServer) expireOrders() {
+	// We must not cancel any orders with the auctioneer in read-only mode.
+	if s.server.cfg.ReadOnly {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), orderExpiryTimeout,
+	)
+	defer cancel()
+
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	err := s.orderManager.ExpireOrders(ctx, bestHeight, time.Now())
+	if err != nil {
+		rpcLog.Errorf("Unable to expire orders: %v", err)
+	}
+}
+
+// watchBatchReorgs starts watching the transactions of all batches that were
+// completed since the last block for reorgs.
+func (s *rpcServer) watchBatchReorgs() {
+	if s.batchReorgWatcher == nil {
+		return
+	}
+
+	if err := s.batchReorgWatcher.WatchBatches(); err != nil {
+		rpcLog.Errorf("Unable to watch batches for reorgs: %v", err)
+	}
+}
+
+// resumeRevertedBatch resumes all accounts that took part in a batch that was
+// reverted because its transaction was reorged out of the chain. Their spend
+// watchers then complete the batch again once its transaction re-confirms.
+func (s *rpcServer) resumeRevertedBatch(ctx context.Context,
+	snapshot *clientdb.LocalBatchSnapshot) error {
+
+	accountKeys := make([]*btcec.PublicKey, 0, len(snapshot.Accounts))
+	for _, acct := range snapshot.Accounts {
+		accountKeys = append(accountKeys, acct.TraderKey.PubKey)
+	}
+
+	return s.accountManager.WatchMatchedAccounts(ctx, accountKeys)
+}
+
 // handleServerMessage reads a gRPC message received in the stream from the
 // auctioneer server and passes it to the correct manager.
 func (s *rpcServer) handleServerMessage(
@@ -322,6 +642,15 @@ func (s *rpcServer) handleServerMessage(
 
 		rpcLog.Infof("Received PrepareMsg for batch=%x, num_orders=%v",
 			batch.ID[:], len(batch.MatchedOrders))
+		s.server.metrics.AddBatch(metrics.BatchPrepared)
+
+		// We won't be around for the rest of the batch if we're
+		// already shutting down.
+		if s.isShuttingDown() {
+			rpcLog.Infof("Rejecting batch %x, shutting down",
+				batch.ID[:])
+			return s.sendRejectBatch(batch, ErrShuttingDown)
+		}
 
 		// Let's store an event for each order in the batch that we did
 		// receive a prepare message.
@@ -332,6 +661,16 @@ func (s *rpcServer) handleServerMessage(
 			rpcLog.Errorf("Unable to store order events: %v", err)
 		}
 
+		// If our lnd node has been unhealthy for too long, we most
+		// likely won't be able to complete the batch, so we reject it
+		// right away.
+		if s.healthMonitor != nil {
+			if err := s.healthMonitor.Allowed(); err != nil {
+				rpcLog.Warnf("Rejecting batch: %v", err)
+				return s.sendRejectBatch(batch, err)
+			}
+		}
+
 		// The prepare message can be sent over and over again if the
 		// batch needs adjustment. Clear all previous shims and channels
 		// that will never complete because the funding TX they refer to
@@ -369,6 +708,13 @@ func (s *rpcServer) handleServerMessage(
 			return s.sendRejectBatch(batch, err)
 		}
 
+		// If an external approval hook is configured, it has the final
+		// say about whether we take part in the batch.
+		if err := s.approveBatch(batch); err != nil {
+			rpcLog.Warnf("Batch not approved: %v", err)
+			return s.sendRejectBatch(batch, err)
+		}
+
 		// Before we accept the batch, we'll finish preparations on our
 		// end which include applying any order match predicates,
 		// connecting out to peers, and registering funding shim.
@@ -387,6 +733,8 @@ func (s *rpcServer) handleServerMessage(
 			rpcLog.Errorf("Error sending accept msg: %v", err)
 			return s.sendRejectBatch(batch, err)
 		}
+		s.server.metrics.AddBatch(metrics.BatchAccepted)
+		s.inflight.accepted(batch)
 
 	case *auctioneerrpc.ServerAuctionMessage_Sign:
 		// We were able to accept the batch. Inform the auctioneer,
@@ -405,16 +753,30 @@ func (s *rpcServer) handleServerMessage(
 			"num_orders=%v", batch.ID[:], len(batch.MatchedOrders))
 
 		// Sign for the accounts in the batch.
-		sigs, err := s.orderManager.BatchSign()
+		sigs, nonces, err := s.orderManager.BatchSign()
 		if err != nil {
 			rpcLog.Errorf("Error signing batch: %v", err)
 			return s.sendRejectBatch(batch, err)
 		}
-		err = s.sendSignBatch(batch, sigs, channelKeys)
+
+		// Keep a record of what we agreed to, so we can reconstruct it
+		// if we crash before the batch is finalized.
+		proposal, err := clientdb.NewAcceptedBatchProposal(batch)
+		if err != nil {
+			rpcLog.Errorf("Error creating batch proposal: %v", err)
+			return s.sendRejectBatch(batch, err)
+		}
+		err = s.server.db.StoreAcceptedBatchProposal(batch.ID, proposal)
+		if err != nil {
+			rpcLog.Errorf("Error storing batch proposal: %v", err)
+			return s.sendRejectBatch(batch, err)
+		}
+		err = s.sendSignBatch(batch, sigs, nonces, channelKeys)
 		if err != nil {
 			rpcLog.Errorf("Error sending sign msg: %v", err)
 			return s.sendRejectBatch(batch, err)
 		}
+		s.inflight.signed()
 
 	// The previously prepared batch has been executed and we can finalize
 	// it by opening the channel and persisting the account and order diffs.
@@ -437,6 +799,8 @@ func (s *rpcServer) handleServerMessage(
 		if err != nil {
 			return fmt.Errorf("error finalizing batch: %v", err)
 		}
+		s.server.metrics.AddBatch(metrics.BatchFinalized)
+		s.inflight.done()
 
 		// We've successfully processed the finalize message, let's
 		// store an event for this for all orders that were involved on
@@ -452,7 +816,7 @@ func (s *rpcServer) handleServerMessage(
 		// update our own state of the tickets to complete now.
 		for ourOrderNonce := range batch.MatchedOrders {
 			if err := s.setTicketStateForOrder(
-				sidecar.StateCompleted, ourOrderNonce,
+				sidecar.StateCompleted, ourOrderNonce, "",
 			); err != nil {
 				rpcLog.Errorf("Unable to update our sidecar "+
 					"ticket after completing batch: %v",
@@ -570,10 +934,16 @@ func (s *rpcServer) InitAccount(ctx context.Context,
 			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
 	}
 
+	coins, err := unmarshallCoinSelection(req.Inputs, req.ChangeType)
+	if err != nil {
+		return nil, err
+	}
+
 	acct, err := s.accountManager.InitAccount(
 		ContextWithInitiator(ctx, req.Initiator),
 		btcutil.Amount(req.AccountValue), feeRate,
-		expiryHeight, bestHeight,
+		expiryHeight, bestHeight, coins,
+		unmarshallFeeLimit(req.FeeLimit),
 	)
 	if err != nil {
 		return nil, err
@@ -582,10 +952,119 @@ func (s *rpcServer) InitAccount(ctx context.Context,
 	return MarshallAccount(acct)
 }
 
+// unmarshallCoinSelection parses the inputs and change address type selected
+// for funding an account or a deposit. If neither is set, nil is returned and
+// lnd is free to select the coins.
+func unmarshallCoinSelection(inputs []*auctioneerrpc.OutPoint,
+	changeType poolrpc.ChangeAddressType) (*account.CoinSelection, error) {
+
+	if len(inputs) == 0 &&
+		changeType == poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_DEFAULT {
+
+		return nil, nil
+	}
+
+	coins := &account.CoinSelection{
+		Inputs: make([]wire.OutPoint, 0, len(inputs)),
+	}
+	for _, input := range inputs {
+		hash, err := chainhash.NewHash(input.Txid)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input txid: %v", err)
+		}
+		coins.Inputs = append(coins.Inputs, wire.OutPoint{
+			Hash:  *hash,
+			Index: input.OutputIndex,
+		})
+	}
+
+	switch changeType {
+	case poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_DEFAULT:
+		coins.ChangeType = walletrpc.AddressType_UNKNOWN
+
+	case poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2WKH:
+		coins.ChangeType = walletrpc.AddressType_WITNESS_PUBKEY_HASH
+
+	case poolrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2TR:
+		coins.ChangeType = walletrpc.AddressType_TAPROOT_PUBKEY
+
+	default:
+		return nil, fmt.Errorf("unknown change address type %v",
+			changeType)
+	}
+
+	return coins, nil
+}
+
+// unmarshallFeeLimit parses the optional fee ceiling of an account
+// transaction. If it isn't set, nil is returned and the fee isn't limited.
+func unmarshallFeeLimit(limit *poolrpc.FeeLimit) *account.FeeLimit {
+	if limit == nil || (limit.MaxFeeSat == 0 &&
+		limit.MaxFeeRateSatPerKw == 0) {
+
+		return nil
+	}
+
+	return &account.FeeLimit{
+		MaxFee:     btcutil.Amount(limit.MaxFeeSat),
+		MaxFeeRate: chainfee.SatPerKWeight(limit.MaxFeeRateSatPerKw),
+	}
+}
+
+// marshallDryRun converts the unsigned transaction of an account modification
+// dry run and its fee breakdown into its RPC counterpart.
+func marshallDryRun(dryRun *account.DryRun) (*poolrpc.AccountTxDryRun,
+	error) {
+
+	var buf bytes.Buffer
+	if err := dryRun.Tx.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("error serializing transaction: %v", err)
+	}
+
+	rpcDryRun := &poolrpc.AccountTxDryRun{
+		RawTx:           buf.Bytes(),
+		InputTotalSat:   uint64(dryRun.InputTotal),
+		OutputTotalSat:  uint64(dryRun.OutputTotal),
+		FeeSat:          uint64(dryRun.Fee()),
+		Vsize:           uint64(dryRun.VSize()),
+		FeeRateSatPerKw: uint64(dryRun.FeeRate()),
+	}
+	for _, op := range dryRun.WalletInputs {
+		op := op
+		rpcDryRun.WalletInputs = append(
+			rpcDryRun.WalletInputs, &auctioneerrpc.OutPoint{
+				Txid:        op.Hash[:],
+				OutputIndex: op.Index,
+			},
+		)
+	}
+	if !dryRun.LeaseExpiry.IsZero() {
+		rpcDryRun.LeaseExpiry = dryRun.LeaseExpiry.Unix()
+	}
+
+	return rpcDryRun, nil
+}
+
 func (s *rpcServer) ListAccounts(ctx context.Context,
 	req *poolrpc.ListAccountsRequest) (*poolrpc.ListAccountsResponse, error) {
 
-	accounts, err := s.server.db.Accounts()
+	// The available balance of an account depends on its orders, so we
+	// read both within the same database transaction to not see the
+	// effects of a batch on only one of them.
+	var (
+		accounts []*account.Account
+		orders   []order.Order
+	)
+	err := s.server.db.ViewSnapshot(func(snapshot *clientdb.Snapshot) error {
+		var err error
+		accounts, err = snapshot.Accounts()
+		if err != nil {
+			return err
+		}
+
+		orders, err = snapshot.Orders()
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +1079,8 @@ func (s *rpcServer) ListAccounts(ctx context.Context,
 		validAccounts = append(validAccounts, acct)
 	}
 
-	rpcAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
-		ctx, validAccounts,
+	rpcAccounts, err := s.marshaler.MarshallAccountsWithOrders(
+		ctx, validAccounts, orders,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list marshalled accounts: "+
@@ -613,36 +1092,63 @@ func (s *rpcServer) ListAccounts(ctx context.Context,
 	}, nil
 }
 
-// MarshallAccount returns the RPC representation of an account.
-func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
-	var rpcState poolrpc.AccountState
-	switch a.State {
-	case account.StateInitiated, account.StatePendingOpen:
-		rpcState = poolrpc.AccountState_PENDING_OPEN
-
-	case account.StatePendingUpdate:
-		rpcState = poolrpc.AccountState_PENDING_UPDATE
+// ExportAccountWatchOnly returns the watch-only view of all active accounts
+// that have an output on chain.
+func (s *rpcServer) ExportAccountWatchOnly(_ context.Context,
+	_ *poolrpc.ExportAccountWatchOnlyRequest) (
+	*poolrpc.ExportAccountWatchOnlyResponse, error) {
 
-	case account.StateOpen:
-		rpcState = poolrpc.AccountState_OPEN
+	accounts, err := s.server.db.Accounts()
+	if err != nil {
+		return nil, err
+	}
 
-	case account.StateExpired:
-		rpcState = poolrpc.AccountState_EXPIRED
+	rpcAccounts := make([]*poolrpc.WatchOnlyAccount, 0, len(accounts))
+	for _, acct := range accounts {
+		// There's nothing to watch before the account was funded or
+		// after it was closed.
+		if acct.State == account.StateInitiated ||
+			!acct.State.IsActive() {
 
-	case account.StatePendingClosed:
-		rpcState = poolrpc.AccountState_PENDING_CLOSED
+			continue
+		}
 
-	case account.StateClosed:
-		rpcState = poolrpc.AccountState_CLOSED
+		watchOnly, err := account.NewWatchOnlyAccount(acct)
+		if err != nil {
+			return nil, err
+		}
 
-	case account.StateCanceledAfterRecovery:
-		rpcState = poolrpc.AccountState_RECOVERY_FAILED
+		var (
+			traderKey = watchOnly.TraderKey.SerializeCompressed()
+			auctKey   = watchOnly.AuctioneerKey.SerializeCompressed()
+			batchKey  = watchOnly.BatchKey.SerializeCompressed()
+		)
+		rpcAccounts = append(rpcAccounts, &poolrpc.WatchOnlyAccount{
+			TraderKey:        traderKey,
+			Version:          marshallAccountVersion(acct.Version),
+			Value:            uint64(watchOnly.Value),
+			ExpirationHeight: watchOnly.Expiry,
+			AuctioneerKey:    auctKey,
+			BatchKey:         batchKey,
+			Outpoint: &auctioneerrpc.OutPoint{
+				Txid:        watchOnly.OutPoint.Hash[:],
+				OutputIndex: watchOnly.OutPoint.Index,
+			},
+			PkScript:     watchOnly.PkScript,
+			NextPkScript: watchOnly.NextPkScript,
+		})
+	}
 
-	case account.StatePendingBatch:
-		rpcState = poolrpc.AccountState_PENDING_BATCH
+	return &poolrpc.ExportAccountWatchOnlyResponse{
+		Accounts: rpcAccounts,
+	}, nil
+}
 
-	default:
-		return nil, fmt.Errorf("unknown state %v", a.State)
+// MarshallAccount returns the RPC representation of an account.
+func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
+	rpcState, err := marshallAccountState(a.State)
+	if err != nil {
+		return nil, err
 	}
 
 	// The latest transaction is only known after the account has been
@@ -662,9 +1168,59 @@ func MarshallAccount(a *account.Account) (*poolrpc.Account, error) {
 		ExpirationHeight: a.Expiry,
 		State:            rpcState,
 		LatestTxid:       latestTxHash[:],
+		AutoRenew:        a.AutoRenew,
+		RenewalWindow:    a.RenewalWindow,
+		ReserveSat:       uint64(a.Reserve),
+		Version:          marshallAccountVersion(a.Version),
 	}, nil
 }
 
+// marshallAccountVersion translates an account version into its RPC
+// counterpart.
+func marshallAccountVersion(
+	version account.Version) auctioneerrpc.AccountVersion {
+
+	switch version {
+	case account.VersionTaprootEnabled:
+		return auctioneerrpc.AccountVersion_ACCOUNT_VERSION_TAPROOT
+
+	default:
+		return auctioneerrpc.AccountVersion_ACCOUNT_VERSION_LEGACY
+	}
+}
+
+// marshallAccountState translates an account state into its RPC counterpart.
+func marshallAccountState(state account.State) (poolrpc.AccountState, error) {
+	switch state {
+	case account.StateInitiated, account.StatePendingOpen:
+		return poolrpc.AccountState_PENDING_OPEN, nil
+
+	case account.StatePendingUpdate:
+		return poolrpc.AccountState_PENDING_UPDATE, nil
+
+	case account.StateOpen:
+		return poolrpc.AccountState_OPEN, nil
+
+	case account.StateExpired:
+		return poolrpc.AccountState_EXPIRED, nil
+
+	case account.StatePendingClosed:
+		return poolrpc.AccountState_PENDING_CLOSED, nil
+
+	case account.StateClosed:
+		return poolrpc.AccountState_CLOSED, nil
+
+	case account.StateCanceledAfterRecovery:
+		return poolrpc.AccountState_RECOVERY_FAILED, nil
+
+	case account.StatePendingBatch:
+		return poolrpc.AccountState_PENDING_BATCH, nil
+
+	default:
+		return 0, fmt.Errorf("unknown state %v", state)
+	}
+}
+
 // DepositAccount handles a trader's request to deposit funds into the specified
 // account by spending the specified inputs.
 func (s *rpcServer) DepositAccount(ctx context.Context,
@@ -700,11 +1256,17 @@ func (s *rpcServer) DepositAccount(ctx context.Context,
 		expiryHeight = req.GetRelativeExpiry() + bestHeight
 	}
 
+	coins, err := unmarshallCoinSelection(req.Inputs, req.ChangeType)
+	if err != nil {
+		return nil, err
+	}
+
 	// Proceed to process the deposit and map its response to the RPC's
 	// response.
-	modifiedAccount, tx, err := s.accountManager.DepositAccount(
+	modifiedAccount, tx, dryRun, err := s.accountManager.DepositAccount(
 		ctx, traderKey, btcutil.Amount(req.AmountSat), feeRate,
-		bestHeight, expiryHeight,
+		bestHeight, expiryHeight, coins,
+		unmarshallFeeLimit(req.FeeLimit), req.DryRun,
 	)
 	if err != nil {
 		return nil, err
@@ -716,6 +1278,18 @@ func (s *rpcServer) DepositAccount(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+
+	if dryRun != nil {
+		rpcDryRun, err := marshallDryRun(dryRun)
+		if err != nil {
+			return nil, err
+		}
+
+		return &poolrpc.DepositAccountResponse{
+			Account: rpcModAccounts[0],
+			DryRun:  rpcDryRun,
+		}, nil
+	}
 	txHash := tx.TxHash()
 
 	return &poolrpc.DepositAccountResponse{
@@ -724,13 +1298,14 @@ func (s *rpcServer) DepositAccount(ctx context.Context,
 	}, nil
 }
 
-// WithdrawAccount handles a trader's request to withdraw funds from the
-// specified account by spending the current account output to the specified
-// outputs.
-func (s *rpcServer) WithdrawAccount(ctx context.Context,
-	req *poolrpc.WithdrawAccountRequest) (*poolrpc.WithdrawAccountResponse, error) {
+// DepositAccountPsbt handles a trader's request to initiate a deposit into the
+// specified account that is funded by an external wallet through a PSBT.
+func (s *rpcServer) DepositAccountPsbt(ctx context.Context,
+	req *poolrpc.DepositAccountPsbtRequest) (
+	*poolrpc.DepositAccountPsbtResponse, error) {
 
-	rpcLog.Infof("Withdrawing from acct=%x", req.TraderKey)
+	rpcLog.Infof("Initiating PSBT deposit of %v into acct=%x",
+		btcutil.Amount(req.AmountSat), req.TraderKey)
 
 	// Ensure the trader key is well formed.
 	traderKey, err := btcec.ParsePubKey(req.TraderKey)
@@ -738,14 +1313,104 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 		return nil, err
 	}
 
-	// Ensure the outputs we'll withdraw to are well formed.
-	if len(req.Outputs) == 0 {
-		return nil, errors.New("missing outputs for withdrawal")
-	}
-	outputs, err := s.parseRPCOutputs(req.Outputs)
-	if err != nil {
-		return nil, err
-	}
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+
+	// If provided, determine new expiration value.
+	var expiryHeight uint32
+	switch {
+	case req.GetAbsoluteExpiry() != 0 && req.GetRelativeExpiry() != 0:
+		return nil, errors.New("relative and absolute height cannot " +
+			"be set in the same request")
+	case req.GetAbsoluteExpiry() != 0:
+		expiryHeight = req.GetAbsoluteExpiry()
+	case req.GetRelativeExpiry() != 0:
+		expiryHeight = req.GetRelativeExpiry() + bestHeight
+	}
+
+	packet, err := s.accountManager.DepositAccountPsbt(
+		ctx, traderKey, btcutil.Amount(req.AmountSat), bestHeight,
+		expiryHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("error serializing PSBT: %v", err)
+	}
+
+	return &poolrpc.DepositAccountPsbtResponse{
+		Psbt: buf.Bytes(),
+	}, nil
+}
+
+// FinalizeDeposit handles a trader's request to complete a deposit that was
+// initiated through DepositAccountPsbt using the PSBT that was funded and
+// signed by the external wallet.
+func (s *rpcServer) FinalizeDeposit(ctx context.Context,
+	req *poolrpc.FinalizeDepositRequest) (*poolrpc.FinalizeDepositResponse,
+	error) {
+
+	rpcLog.Infof("Finalizing PSBT deposit into acct=%x", req.TraderKey)
+
+	// Ensure the trader key is well formed.
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing PSBT: %v", err)
+	}
+
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	modifiedAccount, tx, err := s.accountManager.FinalizeDeposit(
+		ctx, traderKey, packet, bestHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcModAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
+		ctx, []*account.Account{modifiedAccount},
+	)
+	if err != nil {
+		return nil, err
+	}
+	txHash := tx.TxHash()
+
+	return &poolrpc.FinalizeDepositResponse{
+		Account:     rpcModAccounts[0],
+		DepositTxid: txHash[:],
+	}, nil
+}
+
+// WithdrawAccount handles a trader's request to withdraw funds from the
+// specified account by spending the current account output to the specified
+// outputs.
+func (s *rpcServer) WithdrawAccount(ctx context.Context,
+	req *poolrpc.WithdrawAccountRequest) (*poolrpc.WithdrawAccountResponse, error) {
+
+	rpcLog.Infof("Withdrawing from acct=%x", req.TraderKey)
+
+	// Ensure the trader key is well formed.
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the outputs we'll withdraw to are well formed.
+	if len(req.Outputs) == 0 {
+		return nil, errors.New("missing outputs for withdrawal")
+	}
+	outputs, err := s.parseRPCOutputs(req.Outputs)
+	if err != nil {
+		return nil, err
+	}
 
 	// Enforce a minimum fee rate of 253 sat/kw.
 	feeRate := chainfee.SatPerKWeight(req.FeeRateSatPerKw)
@@ -770,8 +1435,9 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 
 	// Proceed to process the withdrawal and map its response to the RPC's
 	// response.
-	modifiedAccount, tx, err := s.accountManager.WithdrawAccount(
+	modifiedAccount, tx, dryRun, err := s.accountManager.WithdrawAccount(
 		ctx, traderKey, outputs, feeRate, bestHeight, expiryHeight,
+		unmarshallFeeLimit(req.FeeLimit), req.DryRun,
 	)
 	if err != nil {
 		return nil, err
@@ -783,6 +1449,18 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+
+	if dryRun != nil {
+		rpcDryRun, err := marshallDryRun(dryRun)
+		if err != nil {
+			return nil, err
+		}
+
+		return &poolrpc.WithdrawAccountResponse{
+			Account: rpcModAccounts[0],
+			DryRun:  rpcDryRun,
+		}, nil
+	}
 	txHash := tx.TxHash()
 
 	return &poolrpc.WithdrawAccountResponse{
@@ -791,6 +1469,131 @@ func (s *rpcServer) WithdrawAccount(ctx context.Context,
 	}, nil
 }
 
+// ScheduleWithdrawAccount schedules a withdrawal from an account that is
+// executed automatically once the estimated fee rate drops to or below the
+// given maximum fee rate.
+func (s *rpcServer) ScheduleWithdrawAccount(_ context.Context,
+	req *poolrpc.ScheduleWithdrawAccountRequest) (
+	*poolrpc.ScheduleWithdrawAccountResponse, error) {
+
+	rpcLog.Infof("Scheduling withdrawal from acct=%x", req.TraderKey)
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Outputs) == 0 {
+		return nil, errors.New("missing outputs for withdrawal")
+	}
+	outputs, err := s.parseRPCOutputs(req.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExpiryTimestamp == 0 {
+		return nil, errors.New("expiry timestamp must be set")
+	}
+	expiry := time.Unix(int64(req.ExpiryTimestamp), 0)
+
+	withdrawal, err := s.accountManager.ScheduleWithdraw(
+		traderKey, outputs,
+		chainfee.SatPerKWeight(req.MaxFeeRateSatPerKw), expiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcWithdrawal, err := s.marshallScheduledWithdrawal(withdrawal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.ScheduleWithdrawAccountResponse{
+		Withdrawal: rpcWithdrawal,
+	}, nil
+}
+
+// ListScheduledWithdrawals returns all withdrawals that are scheduled but
+// weren't executed yet.
+func (s *rpcServer) ListScheduledWithdrawals(_ context.Context,
+	_ *poolrpc.ListScheduledWithdrawalsRequest) (
+	*poolrpc.ListScheduledWithdrawalsResponse, error) {
+
+	withdrawals, err := s.accountManager.ScheduledWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcWithdrawals := make(
+		[]*poolrpc.ScheduledWithdrawal, 0, len(withdrawals),
+	)
+	for _, withdrawal := range withdrawals {
+		rpcWithdrawal, err := s.marshallScheduledWithdrawal(withdrawal)
+		if err != nil {
+			return nil, err
+		}
+		rpcWithdrawals = append(rpcWithdrawals, rpcWithdrawal)
+	}
+
+	return &poolrpc.ListScheduledWithdrawalsResponse{
+		Withdrawals: rpcWithdrawals,
+	}, nil
+}
+
+// CancelScheduledWithdraw cancels the scheduled withdrawal of an account.
+func (s *rpcServer) CancelScheduledWithdraw(_ context.Context,
+	req *poolrpc.CancelScheduledWithdrawRequest) (
+	*poolrpc.CancelScheduledWithdrawResponse, error) {
+
+	rpcLog.Infof("Canceling scheduled withdrawal from acct=%x",
+		req.TraderKey)
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.accountManager.CancelScheduledWithdraw(traderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.CancelScheduledWithdrawResponse{}, nil
+}
+
+// marshallScheduledWithdrawal translates a scheduled withdrawal into its RPC
+// counterpart.
+func (s *rpcServer) marshallScheduledWithdrawal(
+	w *account.ScheduledWithdrawal) (*poolrpc.ScheduledWithdrawal, error) {
+
+	outputs := make([]*poolrpc.Output, 0, len(w.Outputs))
+	for _, output := range w.Outputs {
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			output.PkScript, s.lndServices.ChainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(addrs) != 1 {
+			return nil, fmt.Errorf("unable to determine address of "+
+				"output script %x", output.PkScript)
+		}
+
+		outputs = append(outputs, &poolrpc.Output{
+			ValueSat: uint64(output.Value),
+			Address:  addrs[0].String(),
+		})
+	}
+
+	return &poolrpc.ScheduledWithdrawal{
+		TraderKey:          w.TraderKey.SerializeCompressed(),
+		Outputs:            outputs,
+		MaxFeeRateSatPerKw: uint64(w.MaxFeeRate),
+		ExpiryTimestamp:    uint64(w.Expiry.Unix()),
+	}, nil
+}
+
 // RenewAccount updates the expiration of an open/expired account. This
 // will always require a signature from the auctioneer, even after the account
 // has expired, to ensure the auctioneer is aware the account is being renewed.
@@ -828,8 +1631,8 @@ func (s *rpcServer) RenewAccount(ctx context.Context,
 
 	// Proceed to process the expiration update and map its response to the
 	// RPC's response.
-	modifiedAccount, tx, err := s.accountManager.RenewAccount(
-		ctx, accountKey, expiryHeight, feeRate, bestHeight,
+	modifiedAccount, tx, dryRun, err := s.accountManager.RenewAccount(
+		ctx, accountKey, expiryHeight, feeRate, bestHeight, req.DryRun,
 	)
 	if err != nil {
 		return nil, err
@@ -841,6 +1644,18 @@ func (s *rpcServer) RenewAccount(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+
+	if dryRun != nil {
+		rpcDryRun, err := marshallDryRun(dryRun)
+		if err != nil {
+			return nil, err
+		}
+
+		return &poolrpc.RenewAccountResponse{
+			Account: rpcModAccounts[0],
+			DryRun:  rpcDryRun,
+		}, nil
+	}
 	txHash := tx.TxHash()
 
 	return &poolrpc.RenewAccountResponse{
@@ -849,6 +1664,74 @@ func (s *rpcServer) RenewAccount(ctx context.Context,
 	}, nil
 }
 
+// UpdateAccountAutoRenew enables or disables the automatic renewal of an
+// account. If enabled, the account is renewed once the best height comes
+// within the given renewal window of its expiry.
+func (s *rpcServer) UpdateAccountAutoRenew(ctx context.Context,
+	req *poolrpc.UpdateAccountAutoRenewRequest) (
+	*poolrpc.UpdateAccountAutoRenewResponse, error) {
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLog.Infof("Updating automatic renewal of account %x to %v "+
+		"(renewal_window=%d)", req.TraderKey, req.AutoRenew,
+		req.RenewalWindow)
+
+	modifiedAccount, err := s.accountManager.UpdateAutoRenew(
+		traderKey, req.AutoRenew, req.RenewalWindow,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcModAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
+		ctx, []*account.Account{modifiedAccount},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.UpdateAccountAutoRenewResponse{
+		Account: rpcModAccounts[0],
+	}, nil
+}
+
+// UpdateAccountReserve updates the reserve of an account. Orders may not spend
+// into the reserve.
+func (s *rpcServer) UpdateAccountReserve(ctx context.Context,
+	req *poolrpc.UpdateAccountReserveRequest) (
+	*poolrpc.UpdateAccountReserveResponse, error) {
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLog.Infof("Updating reserve of account %x to %d sats",
+		req.TraderKey, req.ReserveSat)
+
+	modifiedAccount, err := s.accountManager.UpdateReserve(
+		traderKey, btcutil.Amount(req.ReserveSat),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcModAccounts, err := s.marshaler.MarshallAccountsWithAvailableBalance(
+		ctx, []*account.Account{modifiedAccount},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.UpdateAccountReserveResponse{
+		Account: rpcModAccounts[0],
+	}, nil
+}
+
 // BumpAccountFee attempts to bump the fee of an account's transaction through
 // child-pays-for-parent (CPFP). Since the CPFP is performed through the backing
 // lnd node, the account transaction must contain an output under its control
@@ -947,44 +1830,13 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 		return nil, errors.New("a funds destination must be specified")
 	}
 
-	dbOrders, err := s.server.db.GetOrders()
+	openNonces, err := activeOrderNonces(
+		ctx, s.server.db, s.auctioneer, req.TraderKey,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	var openNonces []order.Nonce
-	for _, dbOrder := range dbOrders {
-		orderDetails := dbOrder.Details()
-		nonce := orderDetails.Nonce()
-
-		// There's no need to query for an order in a terminal state
-		// from our PoV as it can't transition back to active.
-		if dbOrder.Details().State.Archived() {
-			continue
-		}
-
-		// To ensure we have the latest order state, we'll consult with
-		// the auctioneer's state.
-		orderStateResp, err := s.auctioneer.OrderState(ctx, nonce)
-		if err != nil {
-			return nil, err
-		}
-		orderState, err := rpcOrderStateToDBState(orderStateResp.State)
-		if err != nil {
-			return nil, err
-		}
-
-		// If the order isn't in the base state, then we'll skip it as
-		// it isn't considered an "active" order.
-		if orderState.Archived() {
-			continue
-		}
-
-		if bytes.Equal(orderDetails.AcctKey[:], req.TraderKey) {
-			openNonces = append(openNonces, orderDetails.Nonce())
-		}
-	}
-
 	// We don't allow an account to be closed if it has open orders so they
 	// don't dangle in the order book on the server's side.
 	if len(openNonces) > 0 {
@@ -994,12 +1846,24 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 
 	// Proceed to close the requested account with the parsed fee
 	// expression.
-	closeTx, err := s.accountManager.CloseAccount(
+	closeTx, dryRun, err := s.accountManager.CloseAccount(
 		ctx, traderKey, feeExpr, atomic.LoadUint32(&s.bestHeight),
+		unmarshallFeeLimit(req.FeeLimit), req.DryRun,
 	)
 	if err != nil {
 		return nil, err
 	}
+
+	if dryRun != nil {
+		rpcDryRun, err := marshallDryRun(dryRun)
+		if err != nil {
+			return nil, err
+		}
+
+		return &poolrpc.CloseAccountResponse{
+			DryRun: rpcDryRun,
+		}, nil
+	}
 	closeTxHash := closeTx.TxHash()
 
 	return &poolrpc.CloseAccountResponse{
@@ -1007,22 +1871,172 @@ func (s *rpcServer) CloseAccount(ctx context.Context,
 	}, nil
 }
 
-// parseOutputScript parses the output script from the string representation of
-// an on-chain address and ensures it's valid for the current network.
-func (s *rpcServer) parseOutputScript(addrStr string) ([]byte, error) {
-	addr, err := btcutil.DecodeAddress(addrStr, s.lndServices.ChainParams)
+// WithdrawAndCloseAccount handles a trader's request to close an account by
+// withdrawing part of its balance to the specified outputs and sending the
+// remainder to the backing lnd node's wallet.
+func (s *rpcServer) WithdrawAndCloseAccount(ctx context.Context,
+	req *poolrpc.WithdrawAndCloseAccountRequest) (
+	*poolrpc.WithdrawAndCloseAccountResponse, error) {
+
+	rpcLog.Infof("Withdrawing from and closing acct=%x", req.TraderKey)
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
 	if err != nil {
 		return nil, err
 	}
-	if !addr.IsForNet(s.lndServices.ChainParams) {
-		return nil, fmt.Errorf("invalid address %v for %v "+
-			"network", addr.String(),
-			s.lndServices.ChainParams.Name)
-	}
-	return txscript.PayToAddrScript(addr)
-}
 
-// parseRPCOutputs maps []*poolrpc.Output -> []*wire.TxOut.
+	if len(req.Outputs) == 0 {
+		return nil, errors.New("missing outputs for withdrawal")
+	}
+	outputs, err := s.parseRPCOutputs(req.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw.
+	feeRate := chainfee.SatPerKWeight(req.FeeRateSatPerKw)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
+			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	closeTx, err := s.accountManager.WithdrawAndClose(
+		ctx, traderKey, outputs, feeRate,
+		atomic.LoadUint32(&s.bestHeight),
+	)
+	if err != nil {
+		return nil, err
+	}
+	closeTxHash := closeTx.TxHash()
+
+	return &poolrpc.WithdrawAndCloseAccountResponse{
+		CloseTxid: closeTxHash[:],
+	}, nil
+}
+
+// SweepExpiredAccount handles a trader's request to sweep an expired account
+// through its expiry path without the auctioneer's cooperation.
+func (s *rpcServer) SweepExpiredAccount(ctx context.Context,
+	req *poolrpc.SweepExpiredAccountRequest) (
+	*poolrpc.SweepExpiredAccountResponse, error) {
+
+	rpcLog.Infof("Sweeping expired acct=%x", req.TraderKey)
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Enforce a minimum fee rate of 253 sat/kw.
+	feeRate := chainfee.SatPerKWeight(req.FeeRateSatPerKw)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("fee rate of %d sat/kw is too low, "+
+			"minimum is %d sat/kw", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	// If no address was provided, the account manager sweeps the funds
+	// back into the backing lnd node's wallet.
+	var destAddr btcutil.Address
+	if req.Address != "" {
+		destAddr, err = btcutil.DecodeAddress(
+			req.Address, s.lndServices.ChainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !destAddr.IsForNet(s.lndServices.ChainParams) {
+			return nil, fmt.Errorf("invalid address %v for %v "+
+				"network", destAddr.String(),
+				s.lndServices.ChainParams.Name)
+		}
+	}
+
+	sweepTx, err := s.accountManager.SweepExpired(
+		ctx, traderKey, destAddr, feeRate,
+		atomic.LoadUint32(&s.bestHeight),
+	)
+	if err != nil {
+		return nil, err
+	}
+	sweepTxHash := sweepTx.TxHash()
+
+	return &poolrpc.SweepExpiredAccountResponse{
+		SweepTxid: sweepTxHash[:],
+	}, nil
+}
+
+// activeOrderNonces returns the nonces of all orders of the account with the
+// given raw trader key that are still active according to the auctioneer.
+func activeOrderNonces(ctx context.Context, db *clientdb.DB,
+	auctioneerClient *auctioneer.Client, traderKey []byte) ([]order.Nonce,
+	error) {
+
+	dbOrders, err := db.GetOrders()
+	if err != nil {
+		return nil, err
+	}
+
+	var openNonces []order.Nonce
+	for _, dbOrder := range dbOrders {
+		orderDetails := dbOrder.Details()
+		nonce := orderDetails.Nonce()
+
+		// There's no need to query for an order in a terminal state
+		// from our PoV as it can't transition back to active.
+		if dbOrder.Details().State.Archived() {
+			continue
+		}
+
+		// A staged order is unknown to the auctioneer but still needs
+		// to be canceled before the account can be closed.
+		if dbOrder.Details().State == order.StateStaged {
+			if bytes.Equal(orderDetails.AcctKey[:], traderKey) {
+				openNonces = append(openNonces, nonce)
+			}
+			continue
+		}
+
+		// To ensure we have the latest order state, we'll consult with
+		// the auctioneer's state.
+		orderStateResp, err := auctioneerClient.OrderState(ctx, nonce)
+		if err != nil {
+			return nil, err
+		}
+		orderState, err := rpcOrderStateToDBState(orderStateResp.State)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the order isn't in the base state, then we'll skip it as
+		// it isn't considered an "active" order.
+		if orderState.Archived() {
+			continue
+		}
+
+		if bytes.Equal(orderDetails.AcctKey[:], traderKey) {
+			openNonces = append(openNonces, orderDetails.Nonce())
+		}
+	}
+
+	return openNonces, nil
+}
+
+// parseOutputScript parses the output script from the string representation of
+// an on-chain address and ensures it's valid for the current network.
+func (s *rpcServer) parseOutputScript(addrStr string) ([]byte, error) {
+	addr, err := btcutil.DecodeAddress(addrStr, s.lndServices.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	if !addr.IsForNet(s.lndServices.ChainParams) {
+		return nil, fmt.Errorf("invalid address %v for %v "+
+			"network", addr.String(),
+			s.lndServices.ChainParams.Name)
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+// parseRPCOutputs maps []*poolrpc.Output -> []*wire.TxOut.
 func (s *rpcServer) parseRPCOutputs(outputs []*poolrpc.Output) ([]*wire.TxOut,
 	error) {
 
@@ -1041,9 +2055,88 @@ func (s *rpcServer) parseRPCOutputs(outputs []*poolrpc.Output) ([]*wire.TxOut,
 	return res, nil
 }
 
+// accountRecovery tracks the progress of an account recovery, persists it as
+// a checkpoint and streams it back to the caller.
+type accountRecovery struct {
+	server *rpcServer
+	stream poolrpc.Trader_RecoverAccountsServer
+
+	checkpoint    *account.RecoveryCheckpoint
+	keysTried     uint32
+	heightScanned uint32
+}
+
+// targetReached returns true if the number of accounts the recovery is looking
+// for have been found.
+func (r *accountRecovery) targetReached() bool {
+	return r.checkpoint.AccountsFound >= r.checkpoint.AccountTarget
+}
+
+// storeAccount stores a recovered account, starts watching it and reports it
+// to the caller.
+func (r *accountRecovery) storeAccount(ctx context.Context,
+	acct *account.Account) error {
+
+	err := r.server.accountManager.RecoverAccount(ctx, acct)
+	if err != nil {
+		// If something goes wrong for one account we still want to
+		// continue with the others.
+		rpcLog.Errorf("Error storing recovered account: %v", err)
+		return nil
+	}
+
+	r.checkpoint.AccountsFound++
+	return r.sendUpdate(acct, false)
+}
+
+// advance marks all account keys up to the given index as tried and persists
+// the progress so the recovery can be resumed from there.
+func (r *accountRecovery) advance(nextIndex uint32) error {
+	r.keysTried += nextIndex - r.checkpoint.NextIndex
+	r.checkpoint.NextIndex = nextIndex
+
+	err := r.server.server.db.StoreRecoveryCheckpoint(r.checkpoint)
+	if err != nil {
+		return fmt.Errorf("error storing recovery checkpoint: %v", err)
+	}
+
+	return r.sendUpdate(nil, false)
+}
+
+// sendUpdate sends the current progress of the recovery to the caller,
+// together with the account that was just found, if any.
+func (r *accountRecovery) sendUpdate(acct *account.Account, done bool) error {
+	update := &poolrpc.RecoverAccountsResponse{
+		NumRecoveredAccounts: r.checkpoint.AccountsFound,
+		KeysTried:            r.keysTried,
+		NextKeyIndex:         r.checkpoint.NextIndex,
+		HeightScanned:        r.heightScanned,
+		Done:                 done,
+	}
+
+	if acct != nil {
+		rpcAcct, err := MarshallAccount(acct)
+		if err != nil {
+			return err
+		}
+
+		// The account watcher only marks an account as expired once
+		// it processes the next block, so we report the state we can
+		// already detect from the current height.
+		bestHeight := atomic.LoadUint32(&r.server.bestHeight)
+		if acct.State == account.StateOpen && bestHeight >= acct.Expiry {
+			rpcAcct.State = poolrpc.AccountState_EXPIRED
+		}
+
+		update.Account = rpcAcct
+	}
+
+	return r.stream.Send(update)
+}
+
 // serverAssistedRecovery executes the server assisted account recovery process.
-func (s *rpcServer) serverAssistedRecovery(ctx context.Context, target uint32) (
-	[]*account.Account, error) {
+func (s *rpcServer) serverAssistedRecovery(ctx context.Context,
+	recovery *accountRecovery) error {
 
 	// The account recovery process uses a bi-directional streaming RPC on
 	// the server side. Unfortunately, because of the way streaming RPCs
@@ -1058,32 +2151,63 @@ func (s *rpcServer) serverAssistedRecovery(ctx context.Context, target uint32) (
 
 	// Prepare the keys we are going to try. Possibly not all of them will
 	// be used.
-	acctKeys, err := account.GenerateRecoveryKeys(
-		ctx, target, s.lndServices.WalletKit,
+	checkpoint := recovery.checkpoint
+	acctKeys, err := account.GenerateRecoveryKeyRange(
+		ctx, checkpoint.NextIndex, checkpoint.EndIndex,
+		s.lndServices.WalletKit,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error generating keys: %v", err)
+		return fmt.Errorf("error generating keys: %v", err)
 	}
 
-	// The auctioneer client will try to recover accounts for these keys as
-	// long as the auctioneer is able to find them in its database. If a
-	// certain number of keys result in an "account not found" error, the
-	// client will stop trying.
-	recoveredAccounts, err := s.auctioneer.RecoverAccounts(ctx, acctKeys)
-	if err != nil {
-		return nil, fmt.Errorf("error performing recovery: %v", err)
+	// We ask the auctioneer to recover the account of each key as long as
+	// it is able to find them in its database. Unless the user explicitly
+	// asked for a key range, we stop trying after a certain number of
+	// keys in a row result in an "account not found" error.
+	numNotFoundAccounts := 0
+	for _, keyDesc := range acctKeys {
+		acct, err := s.auctioneer.RecoverAccount(ctx, keyDesc)
+		if err != nil {
+			return fmt.Errorf("error performing recovery: %v", err)
+		}
+
+		if acct != nil {
+			numNotFoundAccounts = 0
+			if err := recovery.storeAccount(ctx, acct); err != nil {
+				return err
+			}
+		} else {
+			numNotFoundAccounts++
+		}
+
+		if err := recovery.advance(keyDesc.Index + 1); err != nil {
+			return err
+		}
+
+		if recovery.targetReached() {
+			return nil
+		}
+
+		maxUnused := auctioneer.MaxUnusedAccountKeyLookup
+		if !checkpoint.FullRange && numNotFoundAccounts > maxUnused {
+			rpcLog.Infof("Stopping recovery after %d unused "+
+				"account keys in a row at index %d",
+				numNotFoundAccounts, keyDesc.Index)
+
+			return nil
+		}
 	}
 
-	return recoveredAccounts, nil
+	return nil
 }
 
 // fullClientRecovery executes the account recovery process.
 func (s *rpcServer) fullClientRecovery(ctx context.Context,
-	req *poolrpc.RecoverAccountsRequest) ([]*account.Account, error) {
+	req *poolrpc.RecoverAccountsRequest, recovery *accountRecovery) error {
 
 	lndTxs, err := s.lndServices.Client.ListTransactions(ctx, 0, -1)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get txs from lnd: %v", err)
+		return fmt.Errorf("unable to get txs from lnd: %v", err)
 	}
 
 	// Reverse transaction order, so we have older ones at the beginning.
@@ -1105,54 +2229,158 @@ func (s *rpcServer) fullClientRecovery(ctx context.Context,
 	}
 
 	if req.AuctioneerKey == "" || req.HeightHint == 0 {
-		return nil, fmt.Errorf("unable to get auctioner data")
+		return fmt.Errorf("unable to get auctioner data")
 	}
 
 	auctioneerPubKey, err := account.DecodeAndParseKey(req.AuctioneerKey)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode an parse key: %v", err)
+		return fmt.Errorf("unable to decode an parse key: %v", err)
 	}
 	batchKey, err := account.DecodeAndParseKey(account.InitialBatchKey)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode an parse key: %v", err)
+		return fmt.Errorf("unable to decode an parse key: %v", err)
 	}
 
-	cfg := account.RecoveryConfig{
-		Network:       s.server.cfg.Network,
-		AccountTarget: req.AccountTarget,
-		FirstBlock:    req.HeightHint,
-		BitcoinConfig: &account.BitcoinConfig{
-			Host:         req.BitcoinHost,
-			User:         req.BitcoinUser,
-			Password:     req.BitcoinPassword,
-			HTTPPostMode: req.BitcoinHttppostmode,
-			UseTLS:       req.BitcoinUsetls,
-			TLSPath:      req.BitcoinTlspath,
-		},
-		Transactions:     txs,
-		Signer:           s.lndServices.Signer,
-		Wallet:           s.lndServices.WalletKit,
-		InitialBatchKey:  batchKey,
-		AuctioneerPubKey: auctioneerPubKey,
-		Quit:             s.quit,
+	// Scanning the chain takes a long time, so we try the keys in chunks
+	// and persist our progress after each of them.
+	checkpoint := recovery.checkpoint
+	for checkpoint.NextIndex < checkpoint.EndIndex {
+		endIndex := checkpoint.NextIndex + fullClientRecoveryChunkSize
+		if endIndex > checkpoint.EndIndex {
+			endIndex = checkpoint.EndIndex
+		}
+
+		cfg := account.RecoveryConfig{
+			Network: s.server.cfg.Network,
+			AccountTarget: checkpoint.AccountTarget -
+				checkpoint.AccountsFound,
+			StartIndex: checkpoint.NextIndex,
+			EndIndex:   endIndex,
+			FirstBlock: req.HeightHint,
+			BitcoinConfig: &account.BitcoinConfig{
+				Host:         req.BitcoinHost,
+				User:         req.BitcoinUser,
+				Password:     req.BitcoinPassword,
+				HTTPPostMode: req.BitcoinHttppostmode,
+				UseTLS:       req.BitcoinUsetls,
+				TLSPath:      req.BitcoinTlspath,
+			},
+			Transactions:     txs,
+			Signer:           s.server.signer,
+			Wallet:           s.lndServices.WalletKit,
+			InitialBatchKey:  batchKey,
+			AuctioneerPubKey: auctioneerPubKey,
+			Quit:             s.quit,
+			OnBlockScanned: func(height uint32) {
+				recovery.heightScanned = height
+				err := recovery.sendUpdate(nil, false)
+				if err != nil {
+					rpcLog.Debugf("Unable to send recovery "+
+						"progress: %v", err)
+				}
+			},
+		}
+
+		recoveredAccounts, err := account.RecoverAccounts(ctx, cfg)
+		if err != nil {
+			return fmt.Errorf("error performing recovery: %v", err)
+		}
+
+		for _, acct := range recoveredAccounts {
+			if err := recovery.storeAccount(ctx, acct); err != nil {
+				return err
+			}
+		}
+
+		if err := recovery.advance(endIndex); err != nil {
+			return err
+		}
+
+		if recovery.targetReached() {
+			return nil
+		}
 	}
 
-	recoveredAccounts, err := account.RecoverAccounts(ctx, cfg)
+	return nil
+}
+
+// recoverFromBackup restores all accounts from the given account backup.
+func (s *rpcServer) recoverFromBackup(ctx context.Context, backup []byte,
+	recovery *accountRecovery) error {
+
+	recoveredAccounts, err := clientdb.DeserializeAccountBackup(
+		bytes.NewReader(backup),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error performing recovery: %v", err)
+		return fmt.Errorf("error reading account backup: %v", err)
+	}
+
+	for _, acct := range recoveredAccounts {
+		if err := recovery.storeAccount(ctx, acct); err != nil {
+			return err
+		}
 	}
 
-	return recoveredAccounts, nil
+	return nil
 }
 
-func (s *rpcServer) RecoverAccounts(ctx context.Context,
-	req *poolrpc.RecoverAccountsRequest) (*poolrpc.RecoverAccountsResponse,
+// newRecoveryCheckpoint returns the checkpoint to start the requested account
+// recovery from. If the recovery should be resumed, the checkpoint of the
+// interrupted recovery is returned.
+func (s *rpcServer) newRecoveryCheckpoint(
+	req *poolrpc.RecoverAccountsRequest) (*account.RecoveryCheckpoint,
 	error) {
 
+	if req.Resume {
+		checkpoint, err := s.server.db.RecoveryCheckpoint()
+		if err != nil {
+			return nil, err
+		}
+
+		if checkpoint.FullClient != req.FullClient {
+			return nil, fmt.Errorf("interrupted recovery has "+
+				"full_client=%v, cannot resume with "+
+				"full_client=%v", checkpoint.FullClient,
+				req.FullClient)
+		}
+
+		return checkpoint, nil
+	}
+
+	target := req.AccountTarget
+	if target == 0 {
+		target = account.DefaultAccountKeyWindow
+	}
+
+	endIndex := req.EndIndex
+	if endIndex == 0 {
+		endIndex = req.StartIndex + target
+	}
+	if endIndex <= req.StartIndex {
+		return nil, fmt.Errorf("end index %d must be greater than "+
+			"start index %d", endIndex, req.StartIndex)
+	}
+
+	return &account.RecoveryCheckpoint{
+		StartIndex:    req.StartIndex,
+		EndIndex:      endIndex,
+		AccountTarget: target,
+		FullClient:    req.FullClient,
+		FullRange:     req.EndIndex != 0,
+		NextIndex:     req.StartIndex,
+	}, nil
+}
+
+// RecoverAccounts recovers the trader's accounts from a backup, with the help
+// of the auctioneer or by scanning the chain. The progress is streamed back
+// and persisted, so an interrupted recovery can be resumed.
+func (s *rpcServer) RecoverAccounts(req *poolrpc.RecoverAccountsRequest,
+	stream poolrpc.Trader_RecoverAccountsServer) error {
+
 	s.recoveryMutex.Lock()
 	if s.recoveryPending {
 		s.recoveryMutex.Unlock()
-		return nil, fmt.Errorf("recovery already in progress")
+		return fmt.Errorf("recovery already in progress")
 	}
 	s.recoveryPending = true
 	s.recoveryMutex.Unlock()
@@ -1166,44 +2394,60 @@ func (s *rpcServer) RecoverAccounts(ctx context.Context,
 
 	log.Infof("Attempting to recover accounts...")
 
-	var recoveredAccounts []*account.Account
+	ctx := stream.Context()
+	recovery := &accountRecovery{
+		server: s,
+		stream: stream,
+	}
+
 	var err error
+	switch {
+	// A backup contains all accounts, so there are no keys to try and no
+	// progress we'd need to persist.
+	case len(req.AccountBackup) > 0:
+		recovery.checkpoint = &account.RecoveryCheckpoint{}
+		err = s.recoverFromBackup(ctx, req.AccountBackup, recovery)
 
-	target := req.AccountTarget
-	if target == 0 {
-		target = account.DefaultAccountKeyWindow
-	}
+	default:
+		recovery.checkpoint, err = s.newRecoveryCheckpoint(req)
+		if err != nil {
+			return err
+		}
 
-	if !req.FullClient {
-		recoveredAccounts, err = s.serverAssistedRecovery(ctx, target)
-	} else {
-		recoveredAccounts, err = s.fullClientRecovery(ctx, req)
+		checkpoint := recovery.checkpoint
+		rpcLog.Infof("Recovering up to %d accounts from key index %d "+
+			"to %d (full_client=%v)", checkpoint.AccountTarget,
+			checkpoint.NextIndex, checkpoint.EndIndex,
+			checkpoint.FullClient)
+
+		if checkpoint.FullClient {
+			err = s.fullClientRecovery(ctx, req, recovery)
+		} else {
+			err = s.serverAssistedRecovery(ctx, recovery)
+		}
 	}
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Store the recovered accounts now and start watching them. If anything
-	// went wrong during recovery before, no account is stored yet. This is
-	// nice since it allows us to try recovery multiple times until it
-	// actually works.
-	numRecovered := len(recoveredAccounts)
+	// The recovery completed, so there's nothing to resume anymore.
+	if err := s.server.db.DeleteRecoveryCheckpoint(); err != nil {
+		return fmt.Errorf("error removing recovery checkpoint: %v", err)
+	}
+
+	// We need to know the highest index we ever used so we can make sure
+	// lnd's wallet is also at that index and the next account will be
+	// derived from the proper index. We look at all stored accounts as some
+	// might have been recovered before the recovery was resumed.
+	accounts, err := s.server.db.Accounts()
+	if err != nil {
+		return err
+	}
 	var maxIndex uint32
-	for _, acct := range recoveredAccounts {
-		// We need to know the highest index we ever used so we can make
-		// sure lnd's wallet is also at that index and the next account
-		// will be derived from the proper index.
+	for _, acct := range accounts {
 		if acct.TraderKey.Index > maxIndex {
 			maxIndex = acct.TraderKey.Index
 		}
-
-		err = s.accountManager.RecoverAccount(ctx, acct)
-		if err != nil {
-			// If something goes wrong for one account we still want
-			// to continue with the others.
-			numRecovered--
-			rpcLog.Errorf("Error storing recovered account: %v", err)
-		}
 	}
 
 	// Try to ratchet forward lnd's derivation index for accounts.
@@ -1216,72 +2460,243 @@ func (s *rpcServer) RecoverAccounts(ctx context.Context,
 			maxIndex, err)
 	}
 
-	return &poolrpc.RecoverAccountsResponse{
-		NumRecoveredAccounts: uint32(numRecovered),
-	}, nil
+	return recovery.sendUpdate(nil, true)
 }
 
-// assertAccountReady enrues that an account is in the "ready" state that
-// allows it to submit orders.We'll only allow orders for accounts that present
-// in an open state, or have a pending update or batch. On the server-side if
-// we have a pending update we won't be matched, but this lets us place our
-// orders early so we can join the earliest available batch.
-func assertAccountReady(acct *account.Account) error {
-	switch acct.State {
-	case account.StateOpen, account.StatePendingUpdate,
-		account.StatePendingBatch:
+// SubscribeAccountUpdates streams an update every time the state, value or
+// outpoint of an account changes, starting with the current state of all
+// accounts.
+func (s *rpcServer) SubscribeAccountUpdates(
+	_ *poolrpc.SubscribeAccountUpdatesRequest,
+	stream poolrpc.Trader_SubscribeAccountUpdatesServer) error {
 
-		return nil
+	// The account manager isn't started in read-only mode, so there are no
+	// updates we could deliver.
+	if s.server.cfg.ReadOnly {
+		return fmt.Errorf("account updates are not available: %w",
+			ErrReadOnly)
+	}
 
-	default:
-		return fmt.Errorf("acct=%x is in state %v, cannot "+
-			"make order",
-			acct.TraderKey.PubKey.SerializeCompressed(), acct.State)
+	client, initial, err := s.accountManager.SubscribeUpdates()
+	if err != nil {
+		return err
 	}
-}
+	defer client.Cancel()
 
-// validateOrder validates the order to ensure that all fields are consistent,
-// and the order is likely to be accepted by the auctioneer. If this method
+	for _, event := range initial {
+		if err := sendAccountUpdate(stream, event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case update := <-client.Updates():
+			event, ok := update.(*account.UpdateEvent)
+			if !ok {
+				continue
+			}
+
+			if err := sendAccountUpdate(stream, event); err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("account update subscription canceled")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return errors.New("server shutting down")
+		}
+	}
+}
+
+// sendAccountUpdate sends the RPC representation of an account update event to
+// the given stream.
+func sendAccountUpdate(stream poolrpc.Trader_SubscribeAccountUpdatesServer,
+	event *account.UpdateEvent) error {
+
+	prevState, err := marshallAccountState(event.PrevState)
+	if err != nil {
+		return err
+	}
+	newState, err := marshallAccountState(event.NewState)
+	if err != nil {
+		return err
+	}
+
+	// The latest transaction is only known after the account has been
+	// funded.
+	var txHash chainhash.Hash
+	if event.Tx != nil {
+		txHash = event.Tx.TxHash()
+	}
+
+	return stream.Send(&poolrpc.AccountUpdate{
+		TraderKey: event.TraderKey.SerializeCompressed(),
+		PrevState: prevState,
+		NewState:  newState,
+		PrevValue: uint64(event.PrevValue),
+		Value:     uint64(event.Value),
+		Outpoint: &auctioneerrpc.OutPoint{
+			Txid:        event.OutPoint.Hash[:],
+			OutputIndex: event.OutPoint.Index,
+		},
+		Txid:    txHash[:],
+		Initial: event.Initial,
+	})
+}
+
+// AccountEvents returns the history of all changes of an account's value,
+// expiry or state together with the action that triggered them.
+func (s *rpcServer) AccountEvents(_ context.Context,
+	req *poolrpc.AccountEventsRequest) (*poolrpc.AccountEventsResponse,
+	error) {
+
+	traderKey, err := btcec.ParsePubKey(req.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dbEvents, err := s.server.db.GetAccountEvents(traderKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch account events: %v",
+			err)
+	}
+
+	rpcEvents := make([]*poolrpc.AccountEvent, 0, len(dbEvents))
+	for _, evt := range dbEvents {
+		prevState, err := marshallAccountState(evt.PrevState)
+		if err != nil {
+			return nil, err
+		}
+		newState, err := marshallAccountState(evt.NewState)
+		if err != nil {
+			return nil, err
+		}
+
+		// The RPC enum values match the database actions.
+		action := poolrpc.AccountEventAction(evt.Action)
+
+		rpcEvents = append(rpcEvents, &poolrpc.AccountEvent{
+			TimestampNs:              evt.Timestamp().UnixNano(),
+			Action:                   action,
+			PreviousState:            prevState,
+			NewState:                 newState,
+			PreviousValue:            uint64(evt.PrevValue),
+			NewValue:                 uint64(evt.NewValue),
+			PreviousExpirationHeight: evt.PrevExpiry,
+			NewExpirationHeight:      evt.NewExpiry,
+			Outpoint: &auctioneerrpc.OutPoint{
+				Txid:        evt.OutPoint.Hash[:],
+				OutputIndex: evt.OutPoint.Index,
+			},
+			FeeRateSatPerKw: uint64(evt.FeeRate),
+		})
+	}
+
+	return &poolrpc.AccountEventsResponse{
+		Events: rpcEvents,
+	}, nil
+}
+
+// assertAccountReady enrues that an account is in the "ready" state that
+// allows it to submit orders.We'll only allow orders for accounts that present
+// in an open state, or have a pending update or batch. On the server-side if
+// we have a pending update we won't be matched, but this lets us place our
+// orders early so we can join the earliest available batch.
+func assertAccountReady(acct *account.Account) error {
+	switch acct.State {
+	case account.StateOpen, account.StatePendingUpdate,
+		account.StatePendingBatch:
+
+		return nil
+
+	default:
+		return fmt.Errorf("acct=%x is in state %v, cannot "+
+			"make order",
+			acct.TraderKey.PubKey.SerializeCompressed(), acct.State)
+	}
+}
+
+// validateOrder validates the order to ensure that all fields are consistent,
+// and the order is likely to be accepted by the auctioneer. If this method
 // returns nil, then the order is safe to submit to the auctioneer.
-func (s *rpcServer) validateOrder(order order.Order, acct *account.Account,
+func (s *rpcServer) validateOrder(o order.Order, acct *account.Account,
 	auctionTerms *terms.AuctioneerTerms) error {
 
 	// Now that we now how large the order is, ensure that if it's a
 	// wumbo-sized order, then the backing lnd node is advertising wumbo
 	// support.
-	if order.Details().Amt > lndFunding.MaxBtcFundingAmount && !s.wumboSupported {
-		return fmt.Errorf("%v is wumbo sized, but "+
-			"lnd node isn't signalling wumbo", order.Details().Amt)
+	amt := o.Details().Amt
+	if amt > lndFunding.MaxBtcFundingAmount && !s.wumboSupported {
+		return order.NewValidationError(
+			order.ValidationInvalidAmt, "amt", uint64(amt),
+			fmt.Errorf("%v is wumbo sized, but lnd node isn't "+
+				"signalling wumbo", amt),
+		)
 	}
 
 	// Ensure that the account can actually submit orders in its present
 	// state.
 	if err := assertAccountReady(acct); err != nil {
-		return err
+		return order.NewValidationError(
+			order.ValidationAccountNotReady, "account", acct.State,
+			err,
+		)
 	}
 
 	// If the market isn't currently accepting orders for this particular
 	// lease duration, then we'll exit here as the order will be rejected.
-	leaseDuration := order.Details().LeaseDuration
+	leaseDuration := o.Details().LeaseDuration
 	if _, ok := auctionTerms.LeaseDurationBuckets[leaseDuration]; !ok {
-		return fmt.Errorf("invalid channel lease duration %v "+
-			"blocks, active durations are: %v",
-			leaseDuration, auctionTerms.LeaseDurationBuckets)
+		return order.NewValidationError(
+			order.ValidationInvalidLeaseDuration,
+			"lease_duration_blocks", leaseDuration,
+			fmt.Errorf("invalid channel lease duration %v "+
+				"blocks, active durations are: %v",
+				leaseDuration,
+				auctionTerms.LeaseDurationBuckets),
+		)
+	}
+
+	// An order that already expired would be canceled right away.
+	bestHeight := atomic.LoadUint32(&s.bestHeight)
+	if o.Details().Expired(bestHeight, time.Now()) {
+		return order.NewValidationError(
+			order.ValidationInvalidExpiry, "expiry", nil,
+			fmt.Errorf("order expiry must be after the current "+
+				"block height %d and time", bestHeight),
+		)
 	}
 
 	// If the order does not specify any AllowedNodeIDs/NotAllowedNodeIDs
 	// it means that it can match with any other order. However, both
 	// fields cannot be set at the same time.
-	if len(order.Details().AllowedNodeIDs) > 0 &&
-		len(order.Details().NotAllowedNodeIDs) > 0 {
-
-		return fmt.Errorf("allowed and not allowed node ids set at " +
-			"the same time")
+	if err := o.Details().ValidateNodeIDs(); err != nil {
+		return order.NewValidationError(
+			order.ValidationInvalidNodeIDs, "allowed_node_ids", nil,
+			err,
+		)
 	}
 
 	return nil
 }
 
+// invalidOrderResponse returns the submit order response for an order that
+// failed validation.
+func invalidOrderResponse(nonce order.Nonce,
+	valErr *order.ValidationError) *poolrpc.SubmitOrderResponse {
+
+	return &poolrpc.SubmitOrderResponse{
+		Details: &poolrpc.SubmitOrderResponse_InvalidOrder{
+			InvalidOrder: valErr.InvalidOrder(nonce),
+		},
+	}
+}
+
 // orderPreparer represents a type of function that inserts the order into the
 // local database, and returns the params needed to submit it to the
 // auctioneer.
@@ -1303,6 +2718,11 @@ func prepareAndSubmitOrder(ctx context.Context, o order.Order,
 		return err
 	}
 
+	// A staged order is only sent to the server once it's activated.
+	if o.Details().State == order.StateStaged {
+		return nil
+	}
+
 	// Send the order to the server. If this fails, then the order is
 	// certain to never get into the order book. We don't need to keep it
 	// around in that case.
@@ -1326,92 +2746,108 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 			"still starting up")
 	}
 
-	// We'll use this channel type selector to pick a channel type based on
-	// the current connected lnd version. This lets us graceful update to
-	// new features as they're available, while still supporting older
-	// versions of lnd.
-	chanTypeSelector := order.WithDefaultChannelType(func() order.ChannelType {
-		// TODO(guggero): Use order.ChannelTypeScriptEnforced here as
-		// soon as a large percentage of ask orders support script
-		// enforced channel types to not cause a market segregation for
-		// lnd 0.14.x or later users without them being aware of why
-		// their bids aren't getting matched.
-		return order.ChannelTypePeerDependent
-	})
+	o, err := unmarshallOrderRequest(req)
 
-	var o order.Order
-	switch requestOrder := req.Details.(type) {
-	case *poolrpc.SubmitOrderRequest_Ask:
-		a := requestOrder.Ask
-		kit, err := order.ParseRPCOrder(
-			a.Version, a.LeaseDurationBlocks, a.Details,
-			chanTypeSelector,
-		)
-		if err != nil {
-			return nil, err
-		}
+	// The order doesn't have a nonce yet if it couldn't be parsed, so we
+	// report the zero nonce for it.
+	var valErr *order.ValidationError
+	if errors.As(err, &valErr) {
+		rpcLog.Warnf("Invalid order details: %v", valErr)
 
-		o = &order.Ask{
-			Kit: *kit,
-		}
+		return invalidOrderResponse(order.ZeroNonce, valErr), nil
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	case *poolrpc.SubmitOrderRequest_Bid:
-		b := requestOrder.Bid
-		kit, err := order.ParseRPCOrder(
-			b.Version, b.LeaseDurationBlocks, b.Details,
-			chanTypeSelector,
-		)
-		if err != nil {
-			return nil, err
-		}
-		nodeTier, err := unmarshallNodeTier(b.MinNodeTier)
-		if err != nil {
-			return nil, err
-		}
-		ticket, err := unmarshallSidecar(kit.Version, b.SidecarTicket)
-		if err != nil {
-			return nil, err
-		}
+	// A staged order is only stored locally until it is activated, either
+	// manually or once the chain fees are low enough.
+	activationFeeRate := chainfee.SatPerKWeight(
+		req.ActivationFeeRateSatPerKw,
+	)
+	switch {
+	case req.Stage:
+		if activationFeeRate != 0 &&
+			activationFeeRate < chainfee.FeePerKwFloor {
 
-		o = &order.Bid{
-			Kit:             *kit,
-			MinNodeTier:     nodeTier,
-			SelfChanBalance: btcutil.Amount(b.SelfChanBalance),
-			SidecarTicket:   ticket,
+			return nil, fmt.Errorf("activation fee rate must be "+
+				"at least %v", chainfee.FeePerKwFloor)
 		}
 
-	default:
-		return nil, fmt.Errorf("invalid order request")
-	}
+		o.Details().State = order.StateStaged
+		o.Details().ActivationFeeRate = activationFeeRate
 
-	// We also need to know the current maximum order duration.
-	auctionTerms, err := s.auctioneer.Terms(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not query auctioneer terms: %v",
-			err)
+	case activationFeeRate != 0:
+		return nil, fmt.Errorf("activation fee rate can only be set " +
+			"for staged orders")
 	}
 
-	// Verify that the account exists.
-	acctKey, err := btcec.ParsePubKey(o.Details().AcctKey[:])
+	// We also need to know the current maximum order duration. If the
+	// auctioneer can't be reached, the last known terms are used.
+	auctionTerms, err := s.orderManager.Terms(ctx)
 	if err != nil {
 		return nil, err
 	}
-	acct, err := s.server.db.Account(acctKey)
+
+	return s.submitOrder(
+		ctx, o, auctionTerms, req.Initiator, req.Force,
+		s.orderManager.PrepareOrder,
+	)
+}
+
+// unmarshallOrderRequest parses the ask or bid of an order submit request.
+func unmarshallOrderRequest(req *poolrpc.SubmitOrderRequest) (order.Order,
+	error) {
+
+	switch requestOrder := req.Details.(type) {
+	case *poolrpc.SubmitOrderRequest_Ask:
+		return unmarshallOrder(requestOrder.Ask, nil)
+
+	case *poolrpc.SubmitOrderRequest_Bid:
+		return unmarshallOrder(nil, requestOrder.Bid)
+
+	default:
+		return nil, fmt.Errorf("invalid order request")
+	}
+}
+
+// submitOrder selects an account for the given order if it doesn't specify
+// one, validates the order and then prepares it with the given function and
+// submits it to the auctioneer. Unless force is set, duplicates of recently
+// submitted active orders are refused.
+func (s *rpcServer) submitOrder(ctx context.Context, o order.Order,
+	auctionTerms *terms.AuctioneerTerms, initiator string, force bool,
+	prepare orderPreparer) (*poolrpc.SubmitOrderResponse, error) {
+
+	acct, acctSelected, err := s.orderAccount(o, auctionTerms)
 	if err != nil {
-		return nil, fmt.Errorf("cannot accept order: %v", err)
+		return nil, err
 	}
 
 	// Validate the order to ensure the account is in a live state, and the
 	// target lease duration period actually exists.
 	if err := s.validateOrder(o, acct, auctionTerms); err != nil {
+		var valErr *order.ValidationError
+		if errors.As(err, &valErr) {
+			rpcLog.Warnf("Invalid order details: %v", valErr)
+
+			return invalidOrderResponse(o.Nonce(), valErr), nil
+		}
+
 		return nil, fmt.Errorf("order valid validation: %w", err)
 	}
 
+	// Protect against runaway clients that submit the same order over and
+	// over again.
+	if err := s.orderManager.CheckSubmission(o, force); err != nil {
+		return nil, fmt.Errorf("cannot accept order: %w", err)
+	}
+
 	// Finally add the order to the local order database, and submit it to
 	// the auctioneer server.
 	err = prepareAndSubmitOrder(
-		ContextWithInitiator(ctx, req.Initiator), o, auctionTerms,
-		acct, s.auctioneer, s.orderManager.PrepareOrder,
+		ContextWithInitiator(ctx, initiator), o, auctionTerms,
+		acct, s.auctioneer, prepare,
 	)
 	if err != nil {
 		// The server rejected the order. We keep it around for now,
@@ -1423,6 +2859,7 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 		if err2 != nil {
 			rpcLog.Errorf("Could not update failed order: %v", err2)
 		}
+		s.orderManager.ReleaseReservation(o.Nonce())
 
 		// If there was something wrong with the information the user
 		// provided, then return this as a nice string instead of an
@@ -1437,13 +2874,28 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 			}, nil
 		}
 
+		// The same goes for orders that failed our local validation,
+		// which come with a machine readable reason.
+		var valErr *order.ValidationError
+		if errors.As(err, &valErr) {
+			rpcLog.Warnf("Invalid order details: %v", valErr)
+
+			return invalidOrderResponse(o.Nonce(), valErr), nil
+		}
+
 		// Any other error we return normally as a gRPC status level
 		// error.
 		return nil, fmt.Errorf("error submitting order to auctioneer: "+
 			"%v", err)
 	}
 
-	rpcLog.Infof("New order submitted: nonce=%v, type=%v", o.Nonce(), o.Type())
+	action := "submitted"
+	if o.Details().State == order.StateStaged {
+		action = "staged"
+	}
+	rpcLog.Infof("New order %s: nonce=%v, type=%v, account=%x, "+
+		"account_selected=%v", action, o.Nonce(), o.Type(),
+		o.Details().AcctKey[:], acctSelected)
 
 	// We should update the sidecar ticket if this was a bid created for it.
 	// The ticket in the order struct was already updated with the order
@@ -1473,107 +2925,398 @@ func (s *rpcServer) SubmitOrder(ctx context.Context,
 			AcceptedOrderNonce: orderNonce[:],
 		},
 		UpdatedSidecarTicket: encodedTicket,
+		TraderKey:            o.Details().AcctKey[:],
+		AccountSelected:      acctSelected,
 	}, nil
 }
 
-// ListOrders returns a list of all orders that is currently known to the trader
-// client's local store. The state of each order is queried on the auction
-// server and returned as well.
-func (s *rpcServer) ListOrders(ctx context.Context,
-	req *poolrpc.ListOrdersRequest) (*poolrpc.ListOrdersResponse, error) {
+// orderAccount returns the account the given order is submitted from. If the
+// order doesn't specify one, we pick one that can afford the order and report
+// that it was selected automatically. Bids for sidecar tickets must use the
+// account that signed the offer, so we don't need to search for those.
+func (s *rpcServer) orderAccount(o order.Order,
+	auctionTerms *terms.AuctioneerTerms) (*account.Account, bool, error) {
 
-	// Because we made sure every order has a creation timestamp in the
-	// events bucket, we can now access the orders in a sorted way if we
-	// first grab all creation events. This might be a bit less efficient
-	// because we first need to query the events bucket. But this can easily
-	// be solved by adding a write-through cache for the events, then that
-	// bucket serves both as an unique order sequence as well as the event
-	// source and is really fast to access.
-	creationEvents, err := s.server.db.AllEvents(event.TypeOrderCreated)
-	if err != nil {
-		return nil, fmt.Errorf("error querying order creation events: "+
-			"%v", err)
-	}
+	bid, isBid := o.(*order.Bid)
+	switch {
+	case o.Details().AcctKey != [33]byte{}:
 
-	// If we cannot query the auctioneer, we just use an empty fee
-	// schedule, as it is only used for calculating the reserved value.
-	var feeSchedule terms.FeeSchedule = terms.NewLinearFeeSchedule(0, 0)
-	auctioneerTerms, err := s.auctioneer.Terms(ctx)
-	if err != nil {
-		log.Warnf("unable to query auctioneer terms: %v", err)
-	} else {
-		feeSchedule = auctioneerTerms.FeeSchedule()
-	}
+	case isBid && bid.SidecarTicket != nil &&
+		bid.SidecarTicket.Offer.SignPubKey != nil:
 
-	// The RPC is split by order type so we have to separate them now.
-	asks := make([]*poolrpc.Ask, 0, len(creationEvents))
-	bids := make([]*poolrpc.Bid, 0, len(creationEvents))
-	for _, evt := range creationEvents {
-		orderCreateEvent, ok := evt.(*clientdb.CreatedEvent)
-		if !ok {
-			return nil, fmt.Errorf("invalid order create event: %v",
-				evt)
-		}
+		copy(
+			o.Details().AcctKey[:],
+			bid.SidecarTicket.Offer.SignPubKey.SerializeCompressed(),
+		)
 
-		dbOrder, err := s.server.db.GetOrder(orderCreateEvent.Nonce())
+	default:
+		acct, err := s.orderManager.SelectAccount(o, auctionTerms)
 		if err != nil {
-			return nil, err
+			return nil, false, fmt.Errorf("cannot accept order: %w",
+				err)
 		}
-		nonce := dbOrder.Nonce()
-		dbDetails := dbOrder.Details()
+		copy(
+			o.Details().AcctKey[:],
+			acct.TraderKey.PubKey.SerializeCompressed(),
+		)
 
-		// Skip order if it is archived and only active orders are
-		// requested.
-		if dbDetails.State.Archived() && req.ActiveOnly {
-			continue
-		}
+		return acct, true, nil
+	}
 
-		orderState, err := DBOrderStateToRPCState(dbDetails.State)
-		if err != nil {
-			return nil, err
-		}
+	// Verify that the account exists.
+	acctKey, err := btcec.ParsePubKey(o.Details().AcctKey[:])
+	if err != nil {
+		return nil, false, err
+	}
+	acct, err := s.server.db.Account(acctKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot accept order: %v", err)
+	}
 
-		var rpcEvents []*poolrpc.OrderEvent
-		if req.Verbose {
-			dbEvents, err := s.server.db.GetOrderEvents(nonce)
-			if err != nil {
-				return nil, err
-			}
+	return acct, false, nil
+}
 
-			rpcEvents, err = dbEventsToRPCEvents(dbEvents)
-			if err != nil {
-				return nil, err
-			}
-		}
+// PrepareOrder validates a new order and derives all keys for it, but doesn't
+// sign, store or submit it. The digest of the order needs to be signed with
+// the trader key of its account outside of the daemon before the order can be
+// submitted with SubmitSignedOrder.
+func (s *rpcServer) PrepareOrder(ctx context.Context,
+	req *poolrpc.SubmitOrderRequest) (*poolrpc.PrepareOrderResponse,
+	error) {
 
-		details := &poolrpc.Order{
-			TraderKey: dbDetails.AcctKey[:],
-			RateFixed: dbDetails.FixedRate,
-			Amt:       uint64(dbDetails.Amt),
-			MaxBatchFeeRateSatPerKw: uint64(
-				dbDetails.MaxBatchFeeRate,
-			),
-			OrderNonce:       nonce[:],
-			State:            orderState,
-			Units:            uint32(dbDetails.Units),
-			UnitsUnfulfilled: uint32(dbDetails.UnitsUnfulfilled),
-			ReservedValueSat: uint64(
-				dbOrder.ReservedValue(feeSchedule),
-			),
-			CreationTimestampNs: uint64(evt.Timestamp().UnixNano()),
-			Events:              rpcEvents,
-			MinUnitsMatch:       uint32(dbOrder.Details().MinUnitsMatch),
-			ChannelType: marshallChannelType(
-				dbOrder.Details().ChannelType,
-			),
-		}
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot prepare order, trader daemon " +
+			"still starting up")
+	}
 
-		switch o := dbOrder.(type) {
-		case *order.Ask:
-			rpcAsk := &poolrpc.Ask{
-				Details:             details,
-				LeaseDurationBlocks: dbDetails.LeaseDuration,
+	// A signed order is sent to the auction server right away, so there's
+	// nothing to stage.
+	if req.Stage || req.ActivationFeeRateSatPerKw != 0 {
+		return nil, fmt.Errorf("orders signed offline cannot be staged")
+	}
+
+	o, err := unmarshallOrderRequest(req)
+	var valErr *order.ValidationError
+	if errors.As(err, &valErr) {
+		rpcLog.Warnf("Invalid order details: %v", valErr)
+
+		return &poolrpc.PrepareOrderResponse{
+			InvalidOrder: valErr.InvalidOrder(order.ZeroNonce),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Recurring orders and fee rate updates submit new orders on their
+	// own, which requires the trader key.
+	if o.Details().Recurrence != nil || o.Details().AutoUpdateFeeRate {
+		return nil, fmt.Errorf("orders signed offline cannot be " +
+			"resubmitted automatically")
+	}
+
+	auctionTerms, err := s.orderManager.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, acctSelected, err := s.orderAccount(o, auctionTerms)
+	if err != nil {
+		return nil, err
+	}
+
+	// We run all checks of a regular submission now already, so an order
+	// isn't signed offline just to be refused later.
+	err = s.validateOrder(o, acct, auctionTerms)
+	if err == nil {
+		err = s.orderManager.CheckSubmission(o, req.Force)
+	}
+	if err == nil {
+		// The order isn't stored until it's submitted with its
+		// signature, so we don't hold on to its reservation.
+		defer s.orderManager.ReleaseReservation(o.Nonce())
+
+		_, err = s.orderManager.PrepareUnsignedOrder(
+			ctx, o, acct, auctionTerms,
+		)
+	}
+	if errors.As(err, &valErr) {
+		rpcLog.Warnf("Invalid order details: %v", valErr)
+
+		return &poolrpc.PrepareOrderResponse{
+			InvalidOrder: valErr.InvalidOrder(o.Nonce()),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot prepare order: %w", err)
+	}
+
+	digest, err := o.Digest()
+	if err != nil {
+		return nil, err
+	}
+	var serialized bytes.Buffer
+	if err := clientdb.SerializeUnsignedOrder(o, &serialized); err != nil {
+		return nil, err
+	}
+
+	nonce := o.Nonce()
+	return &poolrpc.PrepareOrderResponse{
+		OrderNonce:      nonce[:],
+		Digest:          digest[:],
+		SerializedOrder: serialized.Bytes(),
+		TraderKey:       o.Details().AcctKey[:],
+		AccountSelected: acctSelected,
+	}, nil
+}
+
+// SubmitSignedOrder verifies the signature of an order that was prepared with
+// PrepareOrder and signed outside of the daemon, stores the order locally and
+// then submits it to the auction server.
+func (s *rpcServer) SubmitSignedOrder(ctx context.Context,
+	req *poolrpc.SubmitSignedOrderRequest) (*poolrpc.SubmitOrderResponse,
+	error) {
+
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot submit order, trader daemon " +
+			"still starting up")
+	}
+
+	o, err := clientdb.DeserializeUnsignedOrder(
+		bytes.NewReader(req.SerializedOrder),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid serialized order: %v", err)
+	}
+	if o.Details().State != order.StateSubmitted {
+		return nil, fmt.Errorf("order was not prepared for submission, "+
+			"state is %v", o.Details().State)
+	}
+	if o.Details().AcctKey == [33]byte{} {
+		return nil, fmt.Errorf("order has no account")
+	}
+
+	auctionTerms, err := s.orderManager.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Duplicates were already checked when the order was prepared. The
+	// order itself isn't known to the daemon before it's submitted, so it
+	// would never count as its own duplicate anyway.
+	return s.submitOrder(
+		ctx, o, auctionTerms, req.Initiator, true,
+		func(ctx context.Context, o order.Order, acct *account.Account,
+			auctionTerms *terms.AuctioneerTerms) (
+			*order.ServerOrderParams, error) {
+
+			return s.orderManager.PrepareSignedOrder(
+				ctx, o, acct, auctionTerms, req.Signature,
+			)
+		},
+	)
+}
+
+// unmarshallOrder parses either the given RPC ask or bid into an order,
+// depending on which of the two is set.
+func unmarshallOrder(rpcAsk *poolrpc.Ask, rpcBid *poolrpc.Bid,
+	parseOpts ...order.ParseOption) (order.Order, error) {
+
+	// We'll use this channel type selector to pick a channel type based on
+	// the current connected lnd version. This lets us graceful update to
+	// new features as they're available, while still supporting older
+	// versions of lnd.
+	chanTypeSelector := order.WithDefaultChannelType(func() order.ChannelType {
+		// TODO(guggero): Use order.ChannelTypeScriptEnforced here as
+		// soon as a large percentage of ask orders support script
+		// enforced channel types to not cause a market segregation for
+		// lnd 0.14.x or later users without them being aware of why
+		// their bids aren't getting matched.
+		return order.ChannelTypePeerDependent
+	})
+	parseOpts = append(parseOpts, chanTypeSelector)
+
+	switch {
+	case rpcAsk != nil:
+		kit, err := order.ParseRPCOrder(
+			rpcAsk.Version, rpcAsk.LeaseDurationBlocks,
+			rpcAsk.Details, parseOpts...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &order.Ask{
+			Kit:              *kit,
+			MinDistinctPeers: rpcAsk.MinDistinctPeers,
+		}, nil
+
+	case rpcBid != nil:
+		kit, err := order.ParseRPCOrder(
+			rpcBid.Version, rpcBid.LeaseDurationBlocks,
+			rpcBid.Details, parseOpts...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		nodeTier, err := unmarshallNodeTier(rpcBid.MinNodeTier)
+		if err != nil {
+			return nil, err
+		}
+		ticket, err := unmarshallSidecar(
+			kit.Version, rpcBid.SidecarTicket,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &order.Bid{
+			Kit:             *kit,
+			MinNodeTier:     nodeTier,
+			SelfChanBalance: btcutil.Amount(rpcBid.SelfChanBalance),
+			SidecarTicket:   ticket,
+			NewNodesOnly:    rpcBid.NewNodesOnly,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid order request")
+	}
+}
+
+// ListOrders returns a list of all orders that is currently known to the trader
+// client's local store. The state of each order is queried on the auction
+// server and returned as well.
+func (s *rpcServer) ListOrders(ctx context.Context,
+	req *poolrpc.ListOrdersRequest) (*poolrpc.ListOrdersResponse, error) {
+
+	// We read the orders and their events within the same database
+	// transaction so a batch that completes in the meantime can't leave
+	// us with events that don't match the orders' state.
+	var (
+		dbOrders    []*clientdb.CreatedOrder
+		orderEvents = make(map[order.Nonce][]event.Event)
+	)
+	err := s.server.db.ViewSnapshot(func(snapshot *clientdb.Snapshot) error {
+		// We only load the requested page of orders from the database
+		// instead of all of them at once, as a long-lived trader can
+		// accumulate many thousands of archived orders. The orders are
+		// paged through in the order they were created in.
+		var err error
+		dbOrders, err = snapshot.OrdersPaginated(
+			req.IndexOffset, req.MaxNumOrders, clientdb.OrderFilter{
+				ActiveOnly: req.ActiveOnly,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error querying orders: %v", err)
+		}
+
+		if !req.Verbose {
+			return nil
+		}
+
+		for _, dbOrder := range dbOrders {
+			nonce := dbOrder.Nonce()
+			orderEvents[nonce], err = snapshot.OrderEvents(nonce)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// If we cannot query the auctioneer, we just use an empty fee
+	// schedule, as it is only used for calculating the reserved value.
+	var feeSchedule terms.FeeSchedule = terms.NewLinearFeeSchedule(0, 0)
+	auctioneerTerms, err := s.orderManager.Terms(ctx)
+	if err != nil {
+		log.Warnf("unable to query auctioneer terms: %v", err)
+	} else {
+		feeSchedule = auctioneerTerms.FeeSchedule()
+	}
+
+	// The RPC is split by order type so we have to separate them now.
+	asks := make([]*poolrpc.Ask, 0, len(dbOrders))
+	bids := make([]*poolrpc.Bid, 0, len(dbOrders))
+	for _, dbOrder := range dbOrders {
+		nonce := dbOrder.Nonce()
+		dbDetails := dbOrder.Details()
+
+		orderState, err := DBOrderStateToRPCState(dbDetails.State)
+		if err != nil {
+			return nil, err
+		}
+
+		var rpcEvents []*poolrpc.OrderEvent
+		if req.Verbose {
+			rpcEvents, err = dbEventsToRPCEvents(orderEvents[nonce])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		details := &poolrpc.Order{
+			TraderKey: dbDetails.AcctKey[:],
+			RateFixed: dbDetails.FixedRate,
+			Amt:       uint64(dbDetails.Amt),
+			MaxBatchFeeRateSatPerKw: uint64(
+				dbDetails.MaxBatchFeeRate,
+			),
+			OrderNonce:       nonce[:],
+			State:            orderState,
+			Units:            uint32(dbDetails.Units),
+			UnitsUnfulfilled: uint32(dbDetails.UnitsUnfulfilled),
+			ReservedValueSat: uint64(
+				dbOrder.ReservedValue(feeSchedule),
+			),
+			CreationTimestampNs: uint64(
+				dbOrder.CreatedAt.UnixNano(),
+			),
+			Events:        rpcEvents,
+			MinUnitsMatch: uint32(dbOrder.Details().MinUnitsMatch),
+			ChannelType: marshallChannelType(
+				dbOrder.Details().ChannelType,
+			),
+			Label:          dbDetails.Label,
+			MaxChainFeeSat: uint64(dbDetails.MaxChainFee),
+			ActivationFeeRateSatPerKw: uint64(
+				dbDetails.ActivationFeeRate,
+			),
+			ExpiryHeight:    dbDetails.ExpiryHeight,
+			ExpiryTimestamp: dbDetails.ExpiryUnix,
+			PremiumTotalSat: uint64(dbDetails.PremiumTotal),
+			ExecutionFeeTotalSat: uint64(
+				dbDetails.ExecutionFeeTotal,
+			),
+			ChainFeeTotalSat:   uint64(dbDetails.ChainFeeTotal),
+			TotalsPartial:      dbDetails.TotalsPartial,
+			UnannouncedChannel: dbDetails.UnannouncedChannel,
+			ZeroConfChannel:    dbDetails.ZeroConfChannel,
+			AutoUpdateFeeRate:  dbDetails.AutoUpdateFeeRate,
+		}
+		if dbDetails.Recurrence != nil {
+			details.Recurrences = dbDetails.Recurrence.Remaining
+			details.RecurrenceNonces = marshallNonces(
+				dbDetails.Recurrence.Nonces(nonce),
+			)
+		}
+		if dbDetails.Replaces != order.ZeroNonce {
+			details.ReplacedOrderNonce = dbDetails.Replaces[:]
+		}
+
+		switch o := dbOrder.Order.(type) {
+		case *order.Ask:
+			rpcAsk := &poolrpc.Ask{
+				Details:             details,
+				LeaseDurationBlocks: dbDetails.LeaseDuration,
 				Version:             uint32(o.Version),
+				MinDistinctPeers:    o.MinDistinctPeers,
 			}
 			asks = append(asks, rpcAsk)
 
@@ -1583,138 +3326,808 @@ func (s *rpcServer) ListOrders(ctx context.Context,
 				return nil, err
 			}
 
-			rpcBid := &poolrpc.Bid{
-				Details:             details,
-				LeaseDurationBlocks: dbDetails.LeaseDuration,
-				Version:             uint32(o.Version),
-				MinNodeTier:         nodeTier,
-				SelfChanBalance:     uint64(o.SelfChanBalance),
-			}
+			rpcBid := &poolrpc.Bid{
+				Details:             details,
+				LeaseDurationBlocks: dbDetails.LeaseDuration,
+				Version:             uint32(o.Version),
+				MinNodeTier:         nodeTier,
+				SelfChanBalance:     uint64(o.SelfChanBalance),
+				NewNodesOnly:        o.NewNodesOnly,
+			}
+
+			// The sidecar ticket was given to the auction server
+			// and stored as the raw tlv stream. We only want the
+			// user to see the more human readable base64 encoded
+			// version of the ticket though. That's why we string
+			// encode it here instead of sending the raw bytes back.
+			// If we did bytes it would be shown hex encoded and not
+			// base64 with the nice prefix.
+			if o.SidecarTicket != nil {
+				rpcBid.SidecarTicket, err = sidecar.EncodeToString(
+					o.SidecarTicket,
+				)
+				if err != nil {
+					return nil, fmt.Errorf("error "+
+						"encoding sidecar ticket: %v",
+						err)
+				}
+			}
+
+			bids = append(bids, rpcBid)
+
+		default:
+			return nil, fmt.Errorf("unknown order type: %v",
+				o)
+		}
+	}
+	return &poolrpc.ListOrdersResponse{
+		Asks: asks,
+		Bids: bids,
+	}, nil
+}
+
+// CancelOrder cancels the order on the server and updates the state of the
+// local order accordingly.
+func (s *rpcServer) CancelOrder(ctx context.Context,
+	req *poolrpc.CancelOrderRequest) (*poolrpc.CancelOrderResponse, error) {
+
+	var nonce order.Nonce
+	copy(nonce[:], req.OrderNonce)
+
+	o, err := s.server.db.GetOrder(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	switch o.Details().State {
+	// The order has already been canceled, just return now.
+	case order.StateCanceled:
+		return &poolrpc.CancelOrderResponse{}, nil
+
+	// The order has reached a terminal state so there's no point in
+	// canceling it.
+	case order.StateExecuted, order.StateExpired, order.StateFailed:
+		return nil, fmt.Errorf("unable to cancel order in terminal "+
+			"state %v", o.Details().State)
+	default:
+		break
+	}
+
+	rpcLog.Infof("Cancelling order_nonce=%v", nonce)
+
+	// We cancel an order in two phases. First, we'll cancel the order on
+	// the server-side. A staged order was never sent to the server, so we
+	// only need to cancel it locally.
+	if o.Details().State != order.StateStaged {
+		err = s.auctioneer.CancelOrder(ctx, o.Details().Preimage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Now that we've cancelled things on the server-side, we'll update our
+	// local state to reflect this change in the order. If we crash here,
+	// then we'll sync up the order state once we restart again.
+	err = s.server.db.UpdateOrder(
+		nonce, order.StateModifier(order.StateCanceled),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.orderManager.ReleaseReservation(nonce)
+
+	// If this order was for a sidecar ticket, we also want to cancel the
+	// ticket itself since it will never be completed anyway.
+	err = s.setTicketStateForOrder(
+		sidecar.StateCanceled, nonce, "bid order canceled",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error updating our sidecar ticket "+
+			"after canceling order: %v", err)
+	}
+
+	return &poolrpc.CancelOrderResponse{}, nil
+}
+
+// ActivateOrder sends a staged order to the auction server.
+func (s *rpcServer) ActivateOrder(ctx context.Context,
+	req *poolrpc.ActivateOrderRequest) (*poolrpc.ActivateOrderResponse,
+	error) {
+
+	var nonce order.Nonce
+	copy(nonce[:], req.OrderNonce)
+
+	err := s.orderManager.ActivateOrder(
+		ContextWithInitiator(ctx, req.Initiator), nonce,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLog.Infof("Activated staged order_nonce=%v", nonce)
+
+	return &poolrpc.ActivateOrderResponse{}, nil
+}
+
+// CancelAllOrders cancels all active orders that match the given filters with
+// the auction server and returns the result of each order's cancellation.
+func (s *rpcServer) CancelAllOrders(ctx context.Context,
+	req *poolrpc.CancelAllOrdersRequest) (*poolrpc.CancelAllOrdersResponse,
+	error) {
+
+	filter := &order.CancelFilter{
+		LeaseDuration: req.LeaseDurationBlocks,
+	}
+	if len(req.TraderKey) > 0 {
+		traderKey, err := btcec.ParsePubKey(req.TraderKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trader key: %v", err)
+		}
+		copy(filter.AcctKey[:], traderKey.SerializeCompressed())
+	}
+
+	switch req.OrderType {
+	case poolrpc.OrderTypeFilter_ORDER_TYPE_ANY:
+
+	case poolrpc.OrderTypeFilter_ORDER_TYPE_ASK:
+		orderType := order.TypeAsk
+		filter.Type = &orderType
+
+	case poolrpc.OrderTypeFilter_ORDER_TYPE_BID:
+		orderType := order.TypeBid
+		filter.Type = &orderType
+
+	default:
+		return nil, fmt.Errorf("unknown order type filter: %v",
+			req.OrderType)
+	}
+
+	results, err := s.cancelOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResults := make([]*poolrpc.CancelOrderResult, 0, len(results))
+	for nonce, cancelErr := range results {
+		nonce := nonce
+		rpcResult := &poolrpc.CancelOrderResult{
+			OrderNonce: nonce[:],
+			Canceled:   cancelErr == nil,
+		}
+		if cancelErr != nil {
+			rpcResult.Error = cancelErr.Error()
+		}
+		rpcResults = append(rpcResults, rpcResult)
+	}
+	sort.Slice(rpcResults, func(i, j int) bool {
+		return bytes.Compare(
+			rpcResults[i].OrderNonce, rpcResults[j].OrderNonce,
+		) < 0
+	})
+
+	return &poolrpc.CancelAllOrdersResponse{
+		Results: rpcResults,
+	}, nil
+}
+
+// ReplaceOrder replaces an active order with a new order that has the given
+// parameters. The old order is canceled with the auction server and the new one
+// is submitted right away.
+func (s *rpcServer) ReplaceOrder(ctx context.Context,
+	req *poolrpc.ReplaceOrderRequest) (*poolrpc.ReplaceOrderResponse,
+	error) {
+
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot replace order, trader daemon " +
+			"still starting up")
+	}
+
+	var oldNonce order.Nonce
+	copy(oldNonce[:], req.OrderNonce)
+
+	params := &order.ReplaceParams{
+		Amt:       btcutil.Amount(req.Amt),
+		FixedRate: req.RateFixed,
+		MaxBatchFeeRate: chainfee.SatPerKWeight(
+			req.MaxBatchFeeRateSatPerKw,
+		),
+	}
+
+	rpcLog.Infof("Replacing order_nonce=%v", oldNonce)
+
+	newOrder, err := s.orderManager.ReplaceOrder(
+		ctx, oldNonce, params, func(o order.Order,
+			auctionTerms *terms.AuctioneerTerms) error {
+
+			// The old order is canceled as part of the
+			// replacement, so the new order must never be refused
+			// as its duplicate.
+			resp, err := s.submitOrder(
+				ctx, o, auctionTerms, req.Initiator, true,
+				s.orderManager.PrepareOrder,
+			)
+			if err != nil {
+				return err
+			}
+
+			// The old order is gone, so an order the auctioneer
+			// rejected must be reported as an error as well.
+			if invalid := resp.GetInvalidOrder(); invalid != nil {
+				return fmt.Errorf("order rejected: %v",
+					invalid.FailString)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	newNonce := newOrder.Nonce()
+
+	return &poolrpc.ReplaceOrderResponse{
+		OldOrderNonce: oldNonce[:],
+		NewOrderNonce: newNonce[:],
+	}, nil
+}
+
+// cancelOrders cancels all active orders that match the given filter and also
+// cancels the sidecar tickets of all canceled orders. The result of each
+// order's cancellation is returned, keyed by its nonce.
+func (s *rpcServer) cancelOrders(ctx context.Context,
+	filter *order.CancelFilter) (map[order.Nonce]error, error) {
+
+	results, err := s.orderManager.CancelAllOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for nonce, cancelErr := range results {
+		if cancelErr != nil {
+			continue
+		}
+
+		rpcLog.Infof("Canceled order_nonce=%v", nonce)
+
+		// If this order was for a sidecar ticket, we also want to
+		// cancel the ticket itself since it will never be completed
+		// anyway.
+		err = s.setTicketStateForOrder(
+			sidecar.StateCanceled, nonce, "bid order canceled",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error updating our sidecar "+
+				"ticket after canceling order: %v", err)
+		}
+	}
+
+	return results, nil
+}
+
+// cancelAllOrders cancels all orders that are not yet in a terminal state.
+func (s *rpcServer) cancelAllOrders(ctx context.Context) error {
+	results, err := s.cancelOrders(ctx, &order.CancelFilter{})
+	if err != nil {
+		return err
+	}
+
+	for nonce, cancelErr := range results {
+		if cancelErr != nil {
+			return fmt.Errorf("unable to cancel order %v: %v",
+				nonce, cancelErr)
+		}
+	}
+
+	return nil
+}
+
+// PruneArchivedOrders removes archived orders and their events from the local
+// database if their most recent event happened before the given timestamp.
+func (s *rpcServer) PruneArchivedOrders(_ context.Context,
+	req *poolrpc.PruneArchivedOrdersRequest) (
+	*poolrpc.PruneArchivedOrdersResponse, error) {
+
+	states := make([]order.State, 0, len(req.States))
+	for _, rpcState := range req.States {
+		state, err := rpcOrderStateToDBState(rpcState)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+
+	olderThan := time.Unix(0, req.OlderThanTimestampNs)
+	numPruned, err := s.server.db.PruneArchivedOrders(olderThan, states)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prune orders: %v", err)
+	}
+
+	rpcLog.Infof("Pruned %d archived orders older than %v", numPruned,
+		olderThan)
+
+	return &poolrpc.PruneArchivedOrdersResponse{
+		NumPruned: uint32(numPruned),
+	}, nil
+}
+
+// OrderStats returns the fill statistics of all our orders, aggregated per
+// lease duration market.
+func (s *rpcServer) OrderStats(_ context.Context,
+	req *poolrpc.OrderStatsRequest) (*poolrpc.OrderStatsResponse, error) {
+
+	var since time.Time
+	if req.StartTimestampNs != 0 {
+		since = time.Unix(0, req.StartTimestampNs)
+	}
+
+	stats, err := s.server.db.OrderStats(req.LeaseDurationBlocks, since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to calculate order stats: %v",
+			err)
+	}
+
+	rpcStats := make([]*poolrpc.LeaseDurationOrderStats, 0, len(stats))
+	for _, durationStats := range stats {
+		rpcStats = append(rpcStats, &poolrpc.LeaseDurationOrderStats{
+			LeaseDurationBlocks: durationStats.LeaseDuration,
+			NumBatches:          durationStats.NumBatches,
+			UnitsFilled:         uint32(durationStats.UnitsFilled),
+			VolumeSat:           uint64(durationStats.Volume),
+			WeightedAvgRateFixed: uint32(
+				durationStats.WeightedAvgRate,
+			),
+			PremiumsPaidSat:   uint64(durationStats.PremiumsPaid),
+			PremiumsEarnedSat: uint64(durationStats.PremiumsEarned),
+		})
+	}
+	sort.Slice(rpcStats, func(i, j int) bool {
+		return rpcStats[i].LeaseDurationBlocks <
+			rpcStats[j].LeaseDurationBlocks
+	})
+
+	return &poolrpc.OrderStatsResponse{
+		Stats: rpcStats,
+	}, nil
+}
+
+// SaveOrderTemplate validates the given order parameters against the current
+// auctioneer terms and stores them as a named template.
+func (s *rpcServer) SaveOrderTemplate(ctx context.Context,
+	req *poolrpc.SaveOrderTemplateRequest) (
+	*poolrpc.SaveOrderTemplateResponse, error) {
+
+	var (
+		o   order.Order
+		err error
+	)
+	switch requestOrder := req.Details.(type) {
+	case *poolrpc.SaveOrderTemplateRequest_Ask:
+		o, err = unmarshallOrder(
+			requestOrder.Ask, nil, order.AsTemplate(),
+		)
+
+	case *poolrpc.SaveOrderTemplateRequest_Bid:
+		o, err = unmarshallOrder(
+			nil, requestOrder.Bid, order.AsTemplate(),
+		)
+
+	default:
+		return nil, fmt.Errorf("invalid order template request")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.orderManager.SaveOrderTemplate(ctx, req.Name, o)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLog.Infof("Saved order template %q of type %v", req.Name, o.Type())
+
+	return &poolrpc.SaveOrderTemplateResponse{}, nil
+}
+
+// ListOrderTemplates returns all stored order templates.
+func (s *rpcServer) ListOrderTemplates(_ context.Context,
+	_ *poolrpc.ListOrderTemplatesRequest) (
+	*poolrpc.ListOrderTemplatesResponse, error) {
+
+	templates, err := s.server.db.ListOrderTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list order templates: %v",
+			err)
+	}
+
+	rpcTemplates := make([]*poolrpc.OrderTemplate, 0, len(templates))
+	for _, t := range templates {
+		rpcTemplate, err := marshallOrderTemplate(t)
+		if err != nil {
+			return nil, err
+		}
+		rpcTemplates = append(rpcTemplates, rpcTemplate)
+	}
+
+	return &poolrpc.ListOrderTemplatesResponse{
+		Templates: rpcTemplates,
+	}, nil
+}
+
+// DeleteOrderTemplate removes the order template with the given name.
+func (s *rpcServer) DeleteOrderTemplate(_ context.Context,
+	req *poolrpc.DeleteOrderTemplateRequest) (
+	*poolrpc.DeleteOrderTemplateResponse, error) {
+
+	if err := s.server.db.DeleteOrderTemplate(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.DeleteOrderTemplateResponse{}, nil
+}
+
+// SubmitOrderFromTemplate creates a new order from a stored template and the
+// given amount and submits it to the auction server.
+func (s *rpcServer) SubmitOrderFromTemplate(ctx context.Context,
+	req *poolrpc.SubmitOrderFromTemplateRequest) (
+	*poolrpc.SubmitOrderResponse, error) {
+
+	// Guard this RPC from being used before we've started up fully.
+	if s.server.lndServices == nil {
+		return nil, fmt.Errorf("cannot submit order, trader daemon " +
+			"still starting up")
+	}
+
+	overrides := &order.TemplateOverrides{
+		Amt: btcutil.Amount(req.Amt),
+		MaxBatchFeeRate: chainfee.SatPerKWeight(
+			req.MaxBatchFeeRateSatPerKw,
+		),
+		Label: req.Label,
+	}
+	copy(overrides.AcctKey[:], req.TraderKey)
+
+	var resp *poolrpc.SubmitOrderResponse
+	_, err := s.orderManager.SubmitOrderFromTemplate(
+		ctx, req.Name, overrides, func(o order.Order,
+			auctionTerms *terms.AuctioneerTerms) error {
+
+			var err error
+			resp, err = s.submitOrder(
+				ctx, o, auctionTerms, req.Initiator, req.Force,
+				s.orderManager.PrepareOrder,
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// marshallOrderTemplate translates an order template into its RPC
+// counterpart.
+func marshallOrderTemplate(t *order.Template) (*poolrpc.OrderTemplate,
+	error) {
+
+	tplDetails := t.Order.Details()
+	details := &poolrpc.Order{
+		RateFixed: tplDetails.FixedRate,
+		MaxBatchFeeRateSatPerKw: uint64(
+			tplDetails.MaxBatchFeeRate,
+		),
+		MinUnitsMatch:     uint32(tplDetails.MinUnitsMatch),
+		ChannelType:       marshallChannelType(tplDetails.ChannelType),
+		AllowedNodeIds:    marshallNodeIDs(tplDetails.AllowedNodeIDs),
+		NotAllowedNodeIds: marshallNodeIDs(tplDetails.NotAllowedNodeIDs),
+		Label:             tplDetails.Label,
+		MaxChainFeeSat:    uint64(tplDetails.MaxChainFee),
+	}
+	details.UnannouncedChannel = tplDetails.UnannouncedChannel
+	details.ZeroConfChannel = tplDetails.ZeroConfChannel
+	details.AutoUpdateFeeRate = tplDetails.AutoUpdateFeeRate
+
+	rpcTemplate := &poolrpc.OrderTemplate{
+		Name: t.Name,
+	}
+	switch o := t.Order.(type) {
+	case *order.Ask:
+		rpcTemplate.Details = &poolrpc.OrderTemplate_Ask{
+			Ask: &poolrpc.Ask{
+				Details:             details,
+				LeaseDurationBlocks: tplDetails.LeaseDuration,
+				Version:             uint32(o.Version),
+				MinDistinctPeers:    o.MinDistinctPeers,
+			},
+		}
+
+	case *order.Bid:
+		nodeTier, err := auctioneer.MarshallNodeTier(o.MinNodeTier)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcTemplate.Details = &poolrpc.OrderTemplate_Bid{
+			Bid: &poolrpc.Bid{
+				Details:             details,
+				LeaseDurationBlocks: tplDetails.LeaseDuration,
+				Version:             uint32(o.Version),
+				MinNodeTier:         nodeTier,
+				NewNodesOnly:        o.NewNodesOnly,
+			},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown order type: %v", o)
+	}
+
+	return rpcTemplate, nil
+}
+
+// marshallNonces converts a list of order nonces into a list of byte slices.
+func marshallNonces(nonces []order.Nonce) [][]byte {
+	rpcNonces := make([][]byte, len(nonces))
+	for idx := range nonces {
+		rpcNonces[idx] = nonces[idx][:]
+	}
+
+	return rpcNonces
+}
+
+// marshallNodeIDs converts a list of node IDs into a list of byte slices.
+func marshallNodeIDs(nodeIDs [][33]byte) [][]byte {
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	rpcNodeIDs := make([][]byte, len(nodeIDs))
+	for idx := range nodeIDs {
+		rpcNodeIDs[idx] = nodeIDs[idx][:]
+	}
+
+	return rpcNodeIDs
+}
+
+// QuoteOrder calculates the premium, execution fees and max batch fee rate for
+// an order based on the given order parameters.
+func (s *rpcServer) QuoteOrder(ctx context.Context,
+	req *poolrpc.QuoteOrderRequest) (*poolrpc.QuoteOrderResponse, error) {
+
+	// If the full order is given, we parse it the same way as when it's
+	// submitted. Otherwise we only need the parameters that are relevant
+	// for the quote.
+	var (
+		o   order.Order
+		err error
+	)
+	switch requestOrder := req.Details.(type) {
+	case *poolrpc.QuoteOrderRequest_Ask:
+		o, err = unmarshallOrder(
+			requestOrder.Ask, nil, order.WithoutNonce(),
+		)
 
-			// The sidecar ticket was given to the auction server
-			// and stored as the raw tlv stream. We only want the
-			// user to see the more human readable base64 encoded
-			// version of the ticket though. That's why we string
-			// encode it here instead of sending the raw bytes back.
-			// If we did bytes it would be shown hex encoded and not
-			// base64 with the nice prefix.
-			if o.SidecarTicket != nil {
-				rpcBid.SidecarTicket, err = sidecar.EncodeToString(
-					o.SidecarTicket,
-				)
-				if err != nil {
-					return nil, fmt.Errorf("error "+
-						"encoding sidecar ticket: %v",
-						err)
-				}
-			}
+	case *poolrpc.QuoteOrderRequest_Bid:
+		o, err = unmarshallOrder(
+			nil, requestOrder.Bid, order.WithoutNonce(),
+		)
 
-			bids = append(bids, rpcBid)
+	default:
+		kit := order.NewKit(order.ZeroNonce)
+		kit.Amt = btcutil.Amount(req.Amt)
+		kit.FixedRate = req.RateFixed
+		kit.LeaseDuration = req.LeaseDurationBlocks
+		kit.MaxBatchFeeRate = chainfee.SatPerKWeight(
+			req.MaxBatchFeeRateSatPerKw,
+		)
+		kit.MinUnitsMatch = order.SupplyUnit(req.MinUnitsMatch)
+		o = &order.Bid{Kit: *kit}
+	}
+	if err != nil {
+		return nil, err
+	}
 
-		default:
-			return nil, fmt.Errorf("unknown order type: %v",
-				o)
-		}
+	q, err := s.orderManager.QuoteOrder(ctx, o)
+	if err != nil {
+		return nil, err
 	}
-	return &poolrpc.ListOrdersResponse{
-		Asks: asks,
-		Bids: bids,
+
+	rate := order.FixedRatePremium(o.Details().FixedRate)
+	return &poolrpc.QuoteOrderResponse{
+		TotalPremiumSat:       uint64(q.TotalPremium),
+		RatePerBlock:          q.RatePerBlock,
+		RatePercent:           q.RatePerBlock * 100,
+		TotalExecutionFeeSat:  uint64(q.TotalExecutionFee),
+		WorstCaseChainFeeSat:  uint64(q.WorstCaseChainFee),
+		RateAnnualizedPercent: rate.APR(),
 	}, nil
 }
 
-// CancelOrder cancels the order on the server and updates the state of the
-// local order accordingly.
-func (s *rpcServer) CancelOrder(ctx context.Context,
-	req *poolrpc.CancelOrderRequest) (*poolrpc.CancelOrderResponse, error) {
+// QuoteMatch estimates how the given order would be filled if the next batch
+// was cleared right now, by simulating the batch with the cached order book of
+// the order's market.
+func (s *rpcServer) QuoteMatch(ctx context.Context,
+	req *poolrpc.QuoteMatchRequest) (*poolrpc.QuoteMatchResponse, error) {
 
-	var nonce order.Nonce
-	copy(nonce[:], req.OrderNonce)
+	var (
+		o   order.Order
+		err error
+	)
+	switch requestOrder := req.Details.(type) {
+	case *poolrpc.QuoteMatchRequest_Ask:
+		o, err = unmarshallOrder(
+			requestOrder.Ask, nil, order.WithoutNonce(),
+		)
 
-	o, err := s.server.db.GetOrder(nonce)
+	case *poolrpc.QuoteMatchRequest_Bid:
+		o, err = unmarshallOrder(
+			nil, requestOrder.Bid, order.WithoutNonce(),
+		)
+
+	default:
+		return nil, fmt.Errorf("order must be set")
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	switch o.Details().State {
-	// The order has already been canceled, just return now.
-	case order.StateCanceled:
-		return &poolrpc.CancelOrderResponse{}, nil
-
-	// The order has reached a terminal state so there's no point in
-	// canceling it.
-	case order.StateExecuted, order.StateExpired, order.StateFailed:
-		return nil, fmt.Errorf("unable to cancel order in terminal "+
-			"state %v", o.Details().State)
-	default:
-		break
+	details := o.Details()
+	if err := s.validateMarket(ctx, details.LeaseDuration); err != nil {
+		return nil, err
 	}
 
-	rpcLog.Infof("Cancelling order_nonce=%v", nonce)
+	book, err := s.auctioneer.OrderBookSnapshot(ctx, details.LeaseDuration)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch order book: %v", err)
+	}
+	bookTime := time.Now()
 
-	// We cancel an order in two phases. First, we'll cancel the order on
-	// the server-side.
-	err = s.auctioneer.CancelOrder(ctx, o.Details().Preimage)
+	result, err := matchsim.Simulate(simulationBook(book), &matchsim.Order{
+		Type:          o.Type(),
+		RateFixed:     order.FixedRatePremium(details.FixedRate),
+		Units:         details.Units,
+		MinUnitsMatch: details.MinUnitsMatch,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Now that we've cancelled things on the server-side, we'll update our
-	// local state to reflect this change in the order. If we crash here,
-	// then we'll sync up the order state once we restart again.
-	err = s.server.db.UpdateOrder(
-		nonce, order.StateModifier(order.StateCanceled),
-	)
+	filledAmt := result.FilledUnits.ToSatoshis()
+	resp := &poolrpc.QuoteMatchResponse{
+		FilledUnits:                   uint32(result.FilledUnits),
+		FilledAmtSat:                  uint64(filledAmt),
+		ClearingRateFixed:             uint32(result.ClearingRate),
+		ClearingRateAnnualizedPercent: result.ClearingRate.APR(),
+		PremiumSat: uint64(
+			result.Premium(details.LeaseDuration),
+		),
+		MarketMatchedUnits: uint64(result.MarketUnits),
+		BookSequence:       book.Sequence,
+		BookTimestampNs:    bookTime.UnixNano(),
+		Disclaimer: fmt.Sprintf(quoteMatchDisclaimer,
+			bookTime.UTC().Format(time.RFC3339)),
+	}
+	if result.FilledUnits == 0 {
+		return resp, nil
+	}
+
+	// The fees only depend on the filled amount, so we quote an order for
+	// just that amount.
+	kit := order.NewKit(order.ZeroNonce)
+	kit.Amt = filledAmt
+	kit.FixedRate = uint32(result.ClearingRate)
+	kit.LeaseDuration = details.LeaseDuration
+	kit.MaxBatchFeeRate = details.MaxBatchFeeRate
+	kit.MinUnitsMatch = details.MinUnitsMatch
+	q, err := s.orderManager.QuoteOrder(ctx, &order.Bid{Kit: *kit})
 	if err != nil {
 		return nil, err
 	}
+	resp.ExecutionFeeSat = uint64(q.TotalExecutionFee)
+	resp.WorstCaseChainFeeSat = uint64(q.WorstCaseChainFee)
 
-	// If this order was for a sidecar ticket, we also want to cancel the
-	// ticket itself since it will never be completed anyway.
-	err = s.setTicketStateForOrder(sidecar.StateCanceled, nonce)
+	return resp, nil
+}
+
+// simulationBook converts an order book snapshot of the auctioneer to the book
+// the match simulation runs on.
+func simulationBook(book *auctioneerrpc.OrderBookUpdate) *matchsim.Book {
+	toLevels := func(
+		levels []*auctioneerrpc.OrderBookLevel) []matchsim.Level {
+
+		simLevels := make([]matchsim.Level, 0, len(levels))
+		for _, level := range levels {
+			simLevels = append(simLevels, matchsim.Level{
+				RateFixed: order.FixedRatePremium(
+					level.RateFixed,
+				),
+				Units: order.SupplyUnit(level.Units),
+			})
+		}
+
+		return simLevels
+	}
+
+	return &matchsim.Book{
+		Asks: toLevels(book.Asks),
+		Bids: toLevels(book.Bids),
+	}
+}
+
+// validateMarket makes sure the auctioneer has a market for the given lease
+// duration.
+func (s *rpcServer) validateMarket(ctx context.Context,
+	leaseDuration uint32) error {
+
+	auctionTerms, err := s.orderManager.Terms(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error updating our sidecar ticket "+
-			"after canceling order: %v", err)
+		return err
 	}
 
-	return &poolrpc.CancelOrderResponse{}, nil
+	if _, ok := auctionTerms.LeaseDurationBuckets[leaseDuration]; !ok {
+		return fmt.Errorf("invalid lease duration %d, must be one of "+
+			"%v", leaseDuration, auctionTerms.LeaseDurationBuckets)
+	}
+
+	return nil
 }
 
-// QuoteOrder calculates the premium, execution fees and max batch fee rate for
-// an order based on the given order parameters.
-func (s *rpcServer) QuoteOrder(ctx context.Context,
-	req *poolrpc.QuoteOrderRequest) (*poolrpc.QuoteOrderResponse, error) {
+// SubscribeOrderBook streams the order book of the market with the given lease
+// duration as it is relayed from the auctioneer, starting with a snapshot of
+// the current book.
+func (s *rpcServer) SubscribeOrderBook(req *poolrpc.OrderBookRequest,
+	stream poolrpc.Trader_SubscribeOrderBookServer) error {
 
-	auctionTerms, err := s.auctioneer.Terms(ctx)
+	err := s.validateMarket(stream.Context(), req.LeaseDurationBlocks)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query auctioneer terms: %v",
-			err)
+		return err
 	}
 
-	feeSchedule := terms.NewLinearFeeSchedule(
-		auctionTerms.OrderExecBaseFee,
-		auctionTerms.OrderExecFeeRate,
-	)
+	sub, err := s.auctioneer.SubscribeOrderBook(req.LeaseDurationBlocks)
+	if err != nil {
+		return err
+	}
+	defer sub.Cancel()
 
-	q := order.NewQuote(
-		btcutil.Amount(req.Amt),
-		order.SupplyUnit(req.MinUnitsMatch).ToSatoshis(),
-		order.FixedRatePremium(req.RateFixed), req.LeaseDurationBlocks,
-		chainfee.SatPerKWeight(req.MaxBatchFeeRateSatPerKw),
-		feeSchedule,
-	)
+	for {
+		select {
+		case update := <-sub.Updates():
+			if err := stream.Send(update); err != nil {
+				return err
+			}
 
-	return &poolrpc.QuoteOrderResponse{
-		TotalPremiumSat:      uint64(q.TotalPremium),
-		RatePerBlock:         q.RatePerBlock,
-		RatePercent:          q.RatePerBlock * 100,
-		TotalExecutionFeeSat: uint64(q.TotalExecutionFee),
-		WorstCaseChainFeeSat: uint64(q.WorstCaseChainFee),
-	}, nil
+		case err := <-sub.Err():
+			return err
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return errors.New("server shutting down")
+		}
+	}
+}
+
+// OrderBookSnapshot returns the cached order book of the market with the given
+// lease duration.
+func (s *rpcServer) OrderBookSnapshot(ctx context.Context,
+	req *poolrpc.OrderBookRequest) (*auctioneerrpc.OrderBookUpdate,
+	error) {
+
+	if err := s.validateMarket(ctx, req.LeaseDurationBlocks); err != nil {
+		return nil, err
+	}
+
+	return s.auctioneer.OrderBookSnapshot(ctx, req.LeaseDurationBlocks)
 }
 
 // sendRejectBatch sends a reject message to the server with the properly
 // decoded reason code and the full reason message as a string.
 func (s *rpcServer) sendRejectBatch(batch *order.Batch, failure error) error {
+	s.server.metrics.AddBatch(metrics.BatchRejected)
+	s.inflight.done()
+
 	// As we're rejecting this batch, we'll now cancel all funding shims
 	// that we may have registered since we may be matched with a distinct
 	// set of channels if this batch is repeated.
@@ -1737,10 +4150,82 @@ func (s *rpcServer) sendRejectBatch(batch *order.Batch, failure error) error {
 
 	// Attach the status code to the message to give a bit more context.
 	var (
-		partialReject   *funding.MatchRejectErr
+		partialReject   *order.MatchRejectErr
 		versionMismatch *order.ErrVersionMismatch
 	)
 	switch {
+	case errors.Is(failure, account.ErrInvalidExpiryExtension):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_ACCOUNT_EXPIRY_EXTENSION
+
+		// Track this reject by adding an event to our orders.
+		err := s.server.db.StoreBatchEvents(
+			batch, order.MatchStateRejected,
+			poolrpc.MatchRejectReason_ACCOUNT_EXPIRY_EXTENSION,
+		)
+		if err != nil {
+			rpcLog.Errorf("Could not store match event: %v", err)
+		}
+
+	case errors.Is(failure, ErrTraderUnhealthy):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_TRADER_UNHEALTHY
+
+		// Track this reject by adding an event to our orders.
+		err := s.server.db.StoreBatchEvents(
+			batch, order.MatchStateRejected,
+			poolrpc.MatchRejectReason_TRADER_UNHEALTHY,
+		)
+		if err != nil {
+			rpcLog.Errorf("Could not store match event: %v", err)
+		}
+
+	case errors.Is(failure, order.ErrChainFeeExceeded):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_CHAIN_FEE_EXCEEDED
+
+		// Track this reject by adding an event to our orders.
+		err := s.server.db.StoreBatchEvents(
+			batch, order.MatchStateRejected,
+			poolrpc.MatchRejectReason_CHAIN_FEE_EXCEEDED,
+		)
+		if err != nil {
+			rpcLog.Errorf("Could not store match event: %v", err)
+		}
+
+	case errors.Is(failure, policy.ErrPolicyViolation):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_POLICY_VIOLATION
+
+		// Track this reject by adding an event to our orders.
+		err := s.server.db.StoreBatchEvents(
+			batch, order.MatchStateRejected,
+			poolrpc.MatchRejectReason_POLICY_VIOLATION,
+		)
+		if err != nil {
+			rpcLog.Errorf("Could not store match event: %v", err)
+		}
+
+	case errors.Is(failure, approval.ErrBatchNotApproved):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_APPROVAL_REJECTED
+
+		// Track this reject by adding an event to our orders.
+		err := s.server.db.StoreBatchEvents(
+			batch, order.MatchStateRejected,
+			poolrpc.MatchRejectReason_APPROVAL_REJECTED,
+		)
+		if err != nil {
+			rpcLog.Errorf("Could not store match event: %v", err)
+		}
+
+	case errors.Is(failure, order.ErrBatchFeeRateExceeded):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_BATCH_FEE_RATE_EXCEEDED
+
+		// Track this reject by adding an event to our orders.
+		err := s.server.db.StoreBatchEvents(
+			batch, order.MatchStateRejected,
+			poolrpc.MatchRejectReason_BATCH_FEE_RATE_EXCEEDED,
+		)
+		if err != nil {
+			rpcLog.Errorf("Could not store match event: %v", err)
+		}
+
 	case errors.As(failure, &versionMismatch):
 		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_BATCH_VERSION_MISMATCH
 
@@ -1861,9 +4346,86 @@ func (s *rpcServer) GetLsatTokens(_ context.Context,
 	return &poolrpc.TokensResponse{Tokens: rpcTokens}, nil
 }
 
+// ListLsatTokens returns all tokens of the LSAT token store, including pending
+// and revoked ones.
+func (s *rpcServer) ListLsatTokens(_ context.Context,
+	_ *poolrpc.ListLsatTokensRequest) (*poolrpc.ListLsatTokensResponse,
+	error) {
+
+	tokens, err := s.server.lsatStore.ListTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcTokens := make([]*poolrpc.LsatTokenInfo, len(tokens))
+	for idx, token := range tokens {
+		rpcTokens[idx] = marshallLsatTokenInfo(token)
+	}
+
+	return &poolrpc.ListLsatTokensResponse{Tokens: rpcTokens}, nil
+}
+
+// RevokeLsatToken revokes the current LSAT token so a new one is acquired with
+// the next call to the auction server.
+func (s *rpcServer) RevokeLsatToken(_ context.Context,
+	req *poolrpc.RevokeLsatTokenRequest) (*poolrpc.RevokeLsatTokenResponse,
+	error) {
+
+	if len(req.TokenId) != lsat.TokenIDSize {
+		return nil, fmt.Errorf("invalid token ID length, expected %d "+
+			"bytes", lsat.TokenIDSize)
+	}
+
+	var tokenID lsat.TokenID
+	copy(tokenID[:], req.TokenId)
+
+	rpcLog.Infof("Revoking LSAT token %v", tokenID.String())
+
+	if err := s.server.lsatStore.RevokeToken(tokenID); err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.RevokeLsatTokenResponse{}, nil
+}
+
+// ImportLsatToken imports a paid LSAT token that replaces the revoked current
+// token of the store.
+func (s *rpcServer) ImportLsatToken(_ context.Context,
+	req *poolrpc.ImportLsatTokenRequest) (*poolrpc.ImportLsatTokenResponse,
+	error) {
+
+	token, err := s.server.lsatStore.ImportToken(req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLog.Infof("Imported LSAT token %v", token.ID.String())
+
+	return &poolrpc.ImportLsatTokenResponse{
+		Token: marshallLsatTokenInfo(token),
+	}, nil
+}
+
+// marshallLsatTokenInfo translates the information about an LSAT token into
+// its RPC counterpart.
+func marshallLsatTokenInfo(token *lsatstore.TokenInfo) *poolrpc.LsatTokenInfo {
+	return &poolrpc.LsatTokenInfo{
+		TokenId:            token.ID[:],
+		PaymentHash:        token.PaymentHash[:],
+		AmountPaidMsat:     int64(token.AmountPaid),
+		RoutingFeePaidMsat: int64(token.RoutingFeePaid),
+		TimeCreated:        token.TimeCreated.Unix(),
+		Pending:            token.Pending,
+		Revoked:            token.Revoked,
+		StorageName:        token.StorageName,
+	}
+}
+
 // sendSignBatch sends a sign message to the server with the witness stacks of
-// all accounts that are involved in the batch.
+// all accounts that are involved in the batch and our MuSig2 nonces for all
+// taproot accounts.
 func (s *rpcServer) sendSignBatch(batch *order.Batch, sigs order.BatchSignature,
+	nonces order.AccountNonces,
 	chanInfos map[wire.OutPoint]*chaninfo.ChannelInfo) error {
 
 	// Prepare the list of witness stacks and channel infos and send them to
@@ -1871,10 +4433,15 @@ func (s *rpcServer) sendSignBatch(batch *order.Batch, sigs order.BatchSignature,
 	rpcSigs := make(map[string][]byte, len(sigs))
 	for acctKey, sig := range sigs {
 		key := hex.EncodeToString(acctKey[:])
-		rpcSigs[key] = sig.Serialize()
+		rpcSigs[key] = sig
+	}
+	rpcNonces := make(map[string][]byte, len(nonces))
+	for acctKey, nonce := range nonces {
+		key := hex.EncodeToString(acctKey[:])
+		rpcNonces[key] = nonce[:]
 	}
 
-	rpcChannelInfos, err := marshallChannelInfo(chanInfos)
+	rpcChannelInfos, err := funding.MarshallChannelInfo(chanInfos)
 	if err != nil {
 		return fmt.Errorf("error marshalling channel info: %v", err)
 	}
@@ -1895,6 +4462,7 @@ func (s *rpcServer) sendSignBatch(batch *order.Batch, sigs order.BatchSignature,
 				BatchId:      batch.ID[:],
 				AccountSigs:  rpcSigs,
 				ChannelInfos: rpcChannelInfos,
+				TraderNonces: rpcNonces,
 			},
 		},
 	})
@@ -1940,28 +4508,43 @@ func (s *rpcServer) Leases(ctx context.Context,
 
 	// We'll then retrieve the list of specified batches. If none are
 	// specified, we'll retrieve all of them.
-	var batches []*clientdb.LocalBatchSnapshot
-	if len(req.BatchIds) == 0 {
-		var err error
-		batches, err = s.server.db.GetLocalBatchSnapshots()
+	batchIDs := make([]order.BatchID, 0, len(req.BatchIds))
+	for _, rawBatchID := range req.BatchIds {
+		batchKey, err := btcec.ParsePubKey(rawBatchID)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid batch id: %v", err)
 		}
-	} else {
-		for _, rawBatchID := range req.BatchIds {
-			batchKey, err := btcec.ParsePubKey(rawBatchID)
-			if err != nil {
-				return nil, fmt.Errorf("invalid batch id: %v",
-					err)
-			}
 
-			batchID := order.NewBatchID(batchKey)
-			batch, err := s.server.db.GetLocalBatchSnapshot(batchID)
-			if err != nil {
-				return nil, err
-			}
-			batches = append(batches, batch)
-		}
+		batchIDs = append(batchIDs, order.NewBatchID(batchKey))
+	}
+
+	filter := clientdb.BatchSnapshotFilter{
+		BatchIDs: batchIDs,
+		Accounts: accounts,
+	}
+	if req.StartTimestampNs != 0 {
+		filter.StartTime = time.Unix(0, req.StartTimestampNs)
+	}
+	if req.EndTimestampNs != 0 {
+		filter.EndTime = time.Unix(0, req.EndTimestampNs)
+	}
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() &&
+		!filter.EndTime.After(filter.StartTime) {
+
+		return nil, fmt.Errorf("end timestamp must be after start " +
+			"timestamp")
+	}
+
+	// All batches are read within the same database transaction, so we
+	// return a consistent view even if a batch completes in the meantime.
+	var batches []*clientdb.TimedBatchSnapshot
+	err := s.server.db.ViewSnapshot(func(snapshot *clientdb.Snapshot) error {
+		var err error
+		batches, err = snapshot.FilteredLocalBatchSnapshots(filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// As the true lease expiry of a channel is only known after tit has
@@ -1975,11 +4558,42 @@ func (s *rpcServer) Leases(ctx context.Context,
 			"channels: %v", err)
 	}
 
+	// The channels of our leases are tracked once they complete their
+	// funding flow, so we also know about the ones that were closed since.
+	storedLeaseChans, err := s.server.db.LeaseChannels()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch lease channels: %v",
+			err)
+	}
+	leaseChans := make(map[string]*clientdb.LeaseChannel)
+	for _, leaseChan := range storedLeaseChans {
+		leaseChans[leaseChan.ChanPoint.String()] = leaseChan
+	}
+
 	// We'll map the outpoint string representation of a channel to the
 	// actual lease expiry height which we'll use to partially populate our
 	// response.
 	chanLeaseExpiries := make(map[string]uint32)
+	for chanPoint, leaseChan := range leaseChans {
+		// If lnd doesn't know the channel anymore, we still know when
+		// its lease expires.
+		if leaseChan.LeaseExpiry != 0 {
+			chanLeaseExpiries[chanPoint] = leaseChan.LeaseExpiry
+		}
+	}
 	for _, channel := range openChans.Channels {
+		// Channels of leases that completed their funding flow before
+		// we started tracking them are only known to lnd.
+		chanPoint := channel.ChannelPoint
+		if _, ok := leaseChans[chanPoint]; !ok {
+			leaseChans[chanPoint] = &clientdb.LeaseChannel{
+				ShortChanID: lnwire.NewShortChanIDFromInt(
+					channel.ChanId,
+				),
+				State: clientdb.LeaseChannelOpen,
+			}
+		}
+
 		switch height := channel.ThawHeight; {
 		case height == 0:
 			continue
@@ -2001,13 +4615,13 @@ func (s *rpcServer) Leases(ctx context.Context,
 	}
 
 	return s.prepareLeasesResponse(
-		ctx, accounts, batches, chanLeaseExpiries,
+		ctx, req, accounts, batches, chanLeaseExpiries, leaseChans,
 	)
 }
 
 // fetchNodeRatings returns an up to date set of ratings for each of the nodes
-// we matched with in the passed batch.
-func fetchNodeRatings(ctx context.Context, batches []*clientdb.LocalBatchSnapshot,
+// the passed leases were created with.
+func fetchNodeRatings(ctx context.Context, leases []*poolrpc.Lease,
 	auctioneer *auctioneer.Client) (map[[33]byte]auctioneerrpc.NodeTier,
 	error) {
 
@@ -2016,12 +4630,10 @@ func fetchNodeRatings(ctx context.Context, batches []*clientdb.LocalBatchSnapsho
 	//
 	// TODO(roasbeef): capture rating at time of lease? also cache
 	nodeRatings := make(map[[33]byte]auctioneerrpc.NodeTier)
-	for _, batch := range batches {
-		for _, matches := range batch.MatchedOrders {
-			for _, match := range matches {
-				nodeRatings[match.NodeKey] = 0
-			}
-		}
+	for _, lease := range leases {
+		var nodeKey [33]byte
+		copy(nodeKey[:], lease.ChannelRemoteNodeKey)
+		nodeRatings[nodeKey] = 0
 	}
 
 	nodeKeys := make([]*btcec.PublicKey, 0, len(nodeRatings))
@@ -2053,25 +4665,22 @@ func fetchNodeRatings(ctx context.Context, batches []*clientdb.LocalBatchSnapsho
 }
 
 // prepareLeasesResponse prepares a poolrpc.LeasesResponse for the given
-// accounts in the given batches.
+// accounts in the given batches, applying the channel filter and pagination of
+// the request.
 func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
-	accounts map[[33]byte]struct{}, batches []*clientdb.LocalBatchSnapshot,
-	chanLeaseExpiries map[string]uint32) (*poolrpc.LeasesResponse, error) {
-
-	// First, we'll try to grab our set of node ratings as we want them to
-	// fully populate the lease response.
-	nodeRatings, err := fetchNodeRatings(ctx, batches, s.auctioneer)
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch node ratings: %v", err)
-	}
+	req *poolrpc.LeasesRequest, accounts map[[33]byte]struct{},
+	batches []*clientdb.TimedBatchSnapshot,
+	chanLeaseExpiries map[string]uint32,
+	leaseChans map[string]*clientdb.LeaseChannel) (*poolrpc.LeasesResponse,
+	error) {
 
-	// Now we're ready to prepare our response.
-	var (
-		rpcLeases      []*poolrpc.Lease
-		totalAmtEarned btcutil.Amount
-		totalAmtPaid   btcutil.Amount
-	)
+	var rpcLeases []*poolrpc.Lease
 	for _, batch := range batches {
+		var batchTimestamp int64
+		if !batch.FinalizedAt.IsZero() {
+			batchTimestamp = batch.FinalizedAt.UnixNano()
+		}
+
 		// Keep a tally of how many channels were created within each
 		// batch in order to estimate the chain fees paid.
 		numChans := 0
@@ -2110,7 +4719,10 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 
 				// For sidecar orders the multisig key used
 				// isn't our own.
-				isSidecarChannel := false
+				var (
+					isSidecarChannel bool
+					sidecarPushAmt   btcutil.Amount
+				)
 				ourOrderBid, ourOrderIsBid := ourOrder.(*order.Bid)
 				if ourOrderIsBid && ourOrderBid.SidecarTicket != nil {
 					t := ourOrderBid.SidecarTicket
@@ -2119,13 +4731,24 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 					}
 
 					isSidecarChannel = true
+					sidecarPushAmt = t.Offer.PushAmt
 					ourMultiSigPubKey = t.Recipient.MultiSigPubKey
 				}
 
+				// The channel output is funded with the self
+				// channel balance of the bid on top of the
+				// units filled.
 				chanAmt := match.UnitsFilled.ToSatoshis()
+				outputAmt := chanAmt
+				if ourOrderIsBid {
+					outputAmt += ourOrderBid.SelfChanBalance
+				} else {
+					matchedBid := match.Order.(*order.Bid)
+					outputAmt += matchedBid.SelfChanBalance
+				}
 				_, chanOutput, err := input.GenFundingPkScript(
 					ourMultiSigPubKey.SerializeCompressed(),
-					match.MultiSigKey[:], int64(chanAmt),
+					match.MultiSigKey[:], int64(outputAmt),
 				)
 				if err != nil {
 					return nil, err
@@ -2148,13 +4771,16 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 					bidDuration     uint32
 					selfChanBalance uint64
 				)
+				var zeroConf bool
 				if ourOrder.Type() == order.TypeBid {
 					bidDuration = ourOrder.(*order.Bid).LeaseDuration
 					selfChanBalance = uint64(
 						ourOrder.(*order.Bid).SelfChanBalance,
 					)
+					zeroConf = ourOrder.Details().ZeroConfChannel
 				} else {
 					bidDuration = match.Order.(*order.Bid).LeaseDuration
+					zeroConf = match.Order.Details().ZeroConfChannel
 				}
 
 				// The clearing price is dependent on the lease
@@ -2179,21 +4805,34 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 				exeFee := batch.ExecutionFee.BaseFee() +
 					batch.ExecutionFee.ExecutionFee(chanAmt)
 
-				if ourOrder.Type() == order.TypeBid {
-					totalAmtPaid += premium
-				} else {
-					totalAmtEarned += premium
-				}
-				totalAmtPaid += exeFee
-
 				chanPointStr := fmt.Sprintf(
 					"%v:%v", batchTxHash, chanOutputIdx,
 				)
 				leaseExpiryHeight := chanLeaseExpiries[chanPointStr]
 
+				// Leases whose channels we don't know at all
+				// didn't complete their funding flow yet.
+				var (
+					chanID          uint64
+					chanState       poolrpc.LeaseChannelState
+					chanCloseHeight uint32
+				)
+				leaseChan, ok := leaseChans[chanPointStr]
+				if ok {
+					chanID = leaseChan.ShortChanID.ToUint64()
+					chanState = marshallLeaseChannelState(
+						leaseChan.State,
+					)
+					chanCloseHeight = leaseChan.CloseHeight
+				}
+
 				purchased := ourOrder.Type() == order.TypeBid
-				nodeTier := nodeRatings[match.NodeKey]
 				matchedNonce := match.Order.Nonce()
+				orderLabel := ourOrder.Details().Label
+				chanType := order.NegotiatedChannelType(
+					ourOrder.Details().ChannelType,
+					match.Order.Details().ChannelType,
+				)
 
 				rpcLeases = append(rpcLeases, &poolrpc.Lease{
 					ChannelPoint: &auctioneerrpc.OutPoint{
@@ -2203,6 +4842,9 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 					ChannelAmtSat:         uint64(chanAmt),
 					ChannelDurationBlocks: bidDuration,
 					ChannelLeaseExpiry:    leaseExpiryHeight,
+					ChannelId:             chanID,
+					ChannelState:          chanState,
+					ChannelCloseHeight:    chanCloseHeight,
 					PremiumSat:            uint64(premium),
 					ClearingRatePrice:     uint64(clearingPrice),
 					OrderFixedRate: uint64(
@@ -2213,74 +4855,643 @@ func (s *rpcServer) prepareLeasesResponse(ctx context.Context,
 					MatchedOrderNonce:    matchedNonce[:],
 					Purchased:            purchased,
 					ChannelRemoteNodeKey: match.NodeKey[:],
-					ChannelNodeTier:      nodeTier,
 					SelfChanBalance:      selfChanBalance,
 					SidecarChannel:       isSidecarChannel,
+					OrderLabel:           orderLabel,
+					ChannelType: marshallChannelType(
+						chanType,
+					),
+					UnannouncedChannel: ourOrder.Details().
+						UnannouncedChannel,
+					ZeroConfChannel:   zeroConf,
+					SidecarPushAmtSat: uint64(sidecarPushAmt),
+					BatchId:           batch.BatchID[:],
+					BatchTimestampNs:  batchTimestamp,
 				})
 
-				numChans++
-			}
+				numChans++
+			}
+		}
+
+		if numChans == 0 {
+			continue
+		}
+
+		// The matched orders are kept in a map, so we sort the leases
+		// of the batch by their channel output to return them in a
+		// stable order.
+		batchLeases := rpcLeases[firstIdxForBatch:]
+		sort.Slice(batchLeases, func(i, j int) bool {
+			return batchLeases[i].ChannelPoint.OutputIndex <
+				batchLeases[j].ChannelPoint.OutputIndex
+		})
+
+		// Estimate the chain fees paid for the number of channels
+		// created in this batch.
+		chainFee := order.EstimateTraderFee(
+			uint32(numChans), batch.BatchTxFeeRate,
+			account.VersionInitialNoVersion,
+		)
+
+		// We'll need to compute the chain fee paid for each lease.
+		// Since multiple leases can be created within a batch, we'll
+		// need to evenly distribute. We'll always apply the remainder
+		// to the first lease in the batch.
+		chainFeePerChan := chainFee / btcutil.Amount(numChans)
+		chainFeePerChanRem := chainFee % btcutil.Amount(numChans)
+		for i, lease := range batchLeases {
+			chainFee := chainFeePerChan
+			if i == 0 {
+				chainFee += chainFeePerChanRem
+			}
+			lease.ChainFeeSat = uint64(chainFee)
+		}
+	}
+
+	// The channel filter is applied after the chain fees were distributed,
+	// so the fee of a lease doesn't depend on the state of the other
+	// channels of its batch.
+	if req.OpenChannelsOnly {
+		openLeases := make([]*poolrpc.Lease, 0, len(rpcLeases))
+		openState := poolrpc.LeaseChannelState_LEASE_CHANNEL_STATE_OPEN
+		for _, lease := range rpcLeases {
+			if lease.ChannelState == openState {
+				openLeases = append(openLeases, lease)
+			}
+		}
+		rpcLeases = openLeases
+	}
+
+	// To let makers confirm their orders were spread over enough nodes, we
+	// count the distinct peers of each order across all matching leases.
+	orderPeers := make(map[order.Nonce]map[[33]byte]struct{})
+	for _, lease := range rpcLeases {
+		var (
+			nonce   order.Nonce
+			nodeKey [33]byte
+		)
+		copy(nonce[:], lease.OrderNonce)
+		copy(nodeKey[:], lease.ChannelRemoteNodeKey)
+
+		if _, ok := orderPeers[nonce]; !ok {
+			orderPeers[nonce] = make(map[[33]byte]struct{})
+		}
+		orderPeers[nonce][nodeKey] = struct{}{}
+	}
+	for _, lease := range rpcLeases {
+		var nonce order.Nonce
+		copy(nonce[:], lease.OrderNonce)
+
+		lease.OrderDistinctPeers = uint32(len(orderPeers[nonce]))
+	}
+
+	// Only the requested page of leases is returned.
+	totalNumLeases := len(rpcLeases)
+	offset := int(req.IndexOffset)
+	if offset > len(rpcLeases) {
+		offset = len(rpcLeases)
+	}
+	rpcLeases = rpcLeases[offset:]
+	if req.MaxNumLeases != 0 && int(req.MaxNumLeases) < len(rpcLeases) {
+		rpcLeases = rpcLeases[:req.MaxNumLeases]
+	}
+
+	// Now that we know which leases we return, we'll grab the node ratings
+	// of their peers to fully populate the response.
+	nodeRatings, err := fetchNodeRatings(ctx, rpcLeases, s.auctioneer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch node ratings: %v", err)
+	}
+
+	var totalAmtEarned, totalAmtPaid uint64
+	for _, lease := range rpcLeases {
+		var nodeKey [33]byte
+		copy(nodeKey[:], lease.ChannelRemoteNodeKey)
+		lease.ChannelNodeTier = nodeRatings[nodeKey]
+
+		if lease.Purchased {
+			totalAmtPaid += lease.PremiumSat
+		} else {
+			totalAmtEarned += lease.PremiumSat
+		}
+		totalAmtPaid += lease.ExecutionFeeSat + lease.ChainFeeSat
+	}
+
+	return &poolrpc.LeasesResponse{
+		Leases:            rpcLeases,
+		TotalAmtEarnedSat: totalAmtEarned,
+		TotalAmtPaidSat:   totalAmtPaid,
+		TotalNumLeases:    uint32(totalNumLeases),
+	}, nil
+}
+
+// BatchSnapshot returns information about a target batch including the
+// clearing price of the batch, and the set of orders matched within the batch.
+func (s *rpcServer) BatchSnapshot(ctx context.Context,
+	req *auctioneerrpc.BatchSnapshotRequest) (
+	*auctioneerrpc.BatchSnapshotResponse, error) {
+
+	// THe current version of this call just proxies the request to the
+	// auctioneer, as we may only have information about the batch if we
+	// were matched with it on disk.
+	var batchID order.BatchID
+	copy(batchID[:], req.BatchId)
+	return s.auctioneer.BatchSnapshot(ctx, batchID)
+}
+
+// BatchSnapshots returns a list of batch snapshots starting at the start batch
+// ID and going back through the history of batches, returning at most the
+// number of specified batches. A maximum of 100 snapshots can be queried in
+// one call. If no start batch ID is provided, the most recent finalized batch
+// is used as the starting point to go back from.
+func (s *rpcServer) BatchSnapshots(ctx context.Context,
+	req *auctioneerrpc.BatchSnapshotsRequest) (
+	*auctioneerrpc.BatchSnapshotsResponse, error) {
+
+	// To allow for easy querying through REST, we use the fallback value of
+	// showing one batch if no number is specified.
+	if req.NumBatchesBack == 0 {
+		req.NumBatchesBack = 1
+	}
+
+	return s.auctioneer.BatchSnapshots(ctx, req)
+}
+
+// ListLocalBatchSnapshots returns the locally stored snapshots of the batches
+// this trader participated in, going back in history from the given start
+// batch.
+func (s *rpcServer) ListLocalBatchSnapshots(_ context.Context,
+	req *poolrpc.ListLocalBatchSnapshotsRequest) (
+	*poolrpc.ListLocalBatchSnapshotsResponse, error) {
+
+	var startID order.BatchID
+	if len(req.StartBatchId) > 0 {
+		batchKey, err := btcec.ParsePubKey(req.StartBatchId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch id: %v", err)
+		}
+		startID = order.NewBatchID(batchKey)
+	}
+
+	batches, err := s.server.db.GetLocalBatchSnapshotsFrom(
+		startID, req.NumBatchesBack,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcBatches := make([]*poolrpc.LocalBatchSnapshot, 0, len(batches))
+	for _, batch := range batches {
+		rpcBatch, err := marshallLocalBatchSnapshot(batch)
+		if err != nil {
+			return nil, err
+		}
+		rpcBatch.Approval, err = s.fetchBatchApproval(batch.BatchID)
+		if err != nil {
+			return nil, err
+		}
+		rpcBatches = append(rpcBatches, rpcBatch)
+	}
+
+	return &poolrpc.ListLocalBatchSnapshotsResponse{
+		Batches: rpcBatches,
+	}, nil
+}
+
+// AcceptedBatchProposal returns what we agreed to with the auctioneer when
+// signing for a batch that wasn't finalized yet. If no batch ID is given, the
+// proposal of the current pending batch is returned.
+func (s *rpcServer) AcceptedBatchProposal(_ context.Context,
+	req *poolrpc.AcceptedBatchProposalRequest) (
+	*poolrpc.AcceptedBatchProposalResponse, error) {
+
+	var batchID order.BatchID
+	switch {
+	case len(req.BatchId) > 0:
+		batchKey, err := btcec.ParsePubKey(req.BatchId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch id: %v", err)
+		}
+		batchID = order.NewBatchID(batchKey)
+
+	default:
+		pendingBatch, err := s.server.db.PendingBatchSnapshot()
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch pending "+
+				"batch: %w", err)
+		}
+		batchID = pendingBatch.BatchID
+	}
+
+	proposal, err := s.server.db.GetAcceptedBatchProposal(batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallAcceptedBatchProposal(proposal)
+}
+
+// ListFundingFailures returns all channels of signed batches whose funding
+// flow couldn't be resumed after a restart.
+func (s *rpcServer) ListFundingFailures(_ context.Context,
+	_ *poolrpc.ListFundingFailuresRequest) (
+	*poolrpc.ListFundingFailuresResponse, error) {
+
+	failures, err := s.server.fundingManager.FundingFailures()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch funding failures: %v",
+			err)
+	}
+
+	rpcFailures := make([]*poolrpc.FundingFailure, len(failures))
+	for idx, failure := range failures {
+		rpcFailures[idx] = &poolrpc.FundingFailure{
+			PendingChanId:     failure.PendingChanID[:],
+			BatchId:           failure.BatchID[:],
+			OrderNonce:        failure.OrderNonce[:],
+			MatchedOrderNonce: failure.MatchedOrderNonce[:],
+			NodeKey:           failure.NodeKey[:],
+			ChannelPoint:      failure.ChanPoint.String(),
+			Initiator:         failure.Initiator,
+			Reason:            failure.FailureReason,
+			FailedAt:          failure.FailedAt.Unix(),
+		}
+	}
+
+	return &poolrpc.ListFundingFailuresResponse{
+		Failures: rpcFailures,
+	}, nil
+}
+
+// LeaseAudit returns the results of comparing the lease expiry of every channel
+// that completed its funding flow with the one promised in its batch.
+func (s *rpcServer) LeaseAudit(_ context.Context,
+	req *poolrpc.LeaseAuditRequest) (*poolrpc.LeaseAuditResponse, error) {
+
+	audits, err := s.server.fundingManager.LeaseAudits(req.MismatchesOnly)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch lease audits: %v", err)
+	}
+
+	rpcAudits := make([]*poolrpc.LeaseAuditResult, len(audits))
+	for idx, audit := range audits {
+		rpcAudits[idx] = &poolrpc.LeaseAuditResult{
+			ChannelPoint:         audit.ChanPoint.String(),
+			BatchId:              audit.BatchID[:],
+			OrderNonce:           audit.OrderNonce[:],
+			ScriptEnforced:       audit.ScriptEnforced,
+			ExpectedExpiryHeight: audit.ExpectedExpiry,
+			ActualExpiryHeight:   audit.ActualExpiry,
+			Mismatch:             audit.Mismatch(),
+			Reason:               audit.Reason,
+			TimestampNs:          audit.Timestamp().UnixNano(),
+		}
+	}
+
+	return &poolrpc.LeaseAuditResponse{
+		Audits: rpcAudits,
+	}, nil
+}
+
+// SubscribeLeaseEvents streams an event every time the channel of a lease is
+// force closed before the lease expired.
+func (s *rpcServer) SubscribeLeaseEvents(
+	req *poolrpc.SubscribeLeaseEventsRequest,
+	stream poolrpc.Trader_SubscribeLeaseEventsServer) error {
+
+	// The funding manager doesn't watch any channels in read-only mode, so
+	// there are no events we could deliver.
+	if s.server.cfg.ReadOnly {
+		return fmt.Errorf("lease events are not available: %w",
+			ErrReadOnly)
+	}
+
+	// We subscribe before reading the history so we don't miss any event
+	// that happens in between.
+	client, err := s.server.fundingManager.SubscribeLeaseEvents()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	var lastEventTS time.Time
+	if req.IncludeHistory {
+		events, err := s.server.fundingManager.LeasesClosedEarly()
+		if err != nil {
+			return fmt.Errorf("unable to fetch lease events: %v",
+				err)
+		}
+
+		for _, evt := range events {
+			err := stream.Send(marshallLeaseEvent(evt))
+			if err != nil {
+				return err
+			}
+			lastEventTS = evt.Timestamp()
+		}
+	}
+
+	for {
+		select {
+		case update := <-client.Updates():
+			evt, ok := update.(*clientdb.LeaseClosedEarlyEvent)
+			if !ok {
+				continue
+			}
+
+			// Skip any event we already sent as part of the
+			// history.
+			if !evt.Timestamp().After(lastEventTS) {
+				continue
+			}
+
+			err := stream.Send(marshallLeaseEvent(evt))
+			if err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("lease event subscription canceled")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return errors.New("server shutting down")
+		}
+	}
+}
+
+// marshallLeaseEvent translates an early lease close into its RPC counterpart.
+func marshallLeaseEvent(
+	evt *clientdb.LeaseClosedEarlyEvent) *poolrpc.LeaseEvent {
+
+	return &poolrpc.LeaseEvent{
+		TimestampNs: evt.Timestamp().UnixNano(),
+		ChannelPoint: &auctioneerrpc.OutPoint{
+			Txid:        evt.ChanPoint.Hash[:],
+			OutputIndex: evt.ChanPoint.Index,
+		},
+		ClosingTxid:           evt.ClosingTxid[:],
+		BatchId:               evt.BatchID[:],
+		OrderNonce:            evt.OrderNonce[:],
+		Purchased:             evt.Purchased,
+		ChannelRemoteNodeKey:  evt.RemoteNodeKey[:],
+		ChannelAmtSat:         uint64(evt.Capacity),
+		CloseType:             marshallLeaseCloseType(evt.CloseType),
+		ChannelDurationBlocks: evt.LeaseDuration,
+		ChannelLeaseExpiry:    evt.LeaseExpiry,
+		CloseHeight:           evt.CloseHeight,
+		BlocksRemaining:       evt.BlocksRemaining(),
+	}
+}
+
+// LeaseEvidence collects everything needed to prove that the channel of a
+// lease was closed before the lease expired into a single serialized proof.
+func (s *rpcServer) LeaseEvidence(ctx context.Context,
+	req *poolrpc.LeaseEvidenceRequest) (*poolrpc.LeaseEvidenceResponse,
+	error) {
+
+	if req.ChannelPoint == nil {
+		return nil, errors.New("channel point missing")
+	}
+	hash, err := chainhash.NewHash(req.ChannelPoint.Txid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel point txid: %v", err)
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *hash,
+		Index: req.ChannelPoint.OutputIndex,
+	}
+
+	evidence, err := s.server.fundingManager.LeaseEvidence(ctx, chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to collect lease evidence: %v",
+			err)
+	}
+
+	var buf bytes.Buffer
+	if err := clientdb.SerializeLeaseEvidence(&buf, evidence); err != nil {
+		return nil, fmt.Errorf("unable to serialize lease evidence: %v",
+			err)
+	}
+
+	return &poolrpc.LeaseEvidenceResponse{
+		Version:  uint32(evidence.Version),
+		Evidence: buf.Bytes(),
+	}, nil
+}
+
+// SetBatchPolicy replaces the batch policy every batch we take part in must
+// satisfy. The new policy is persisted and applies to the next batch.
+func (s *rpcServer) SetBatchPolicy(_ context.Context,
+	req *poolrpc.SetBatchPolicyRequest) (*poolrpc.SetBatchPolicyResponse,
+	error) {
+
+	p := unmarshallBatchPolicy(req.Policy)
+	if err := s.batchPolicy.SetPolicy(p); err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.SetBatchPolicyResponse{
+		Policy: marshallBatchPolicy(s.batchPolicy.Policy()),
+	}, nil
+}
+
+// GetBatchPolicy returns the batch policy that is currently enforced.
+func (s *rpcServer) GetBatchPolicy(_ context.Context,
+	_ *poolrpc.GetBatchPolicyRequest) (*poolrpc.BatchPolicy, error) {
+
+	return marshallBatchPolicy(s.batchPolicy.Policy()), nil
+}
+
+// startBatchPolicy loads the stored batch policy. If a policy file is
+// configured, its policy replaces the stored one.
+func (s *rpcServer) startBatchPolicy() error {
+	if err := s.batchPolicy.Start(); err != nil {
+		return err
+	}
+
+	// We can't persist a new policy in read-only mode, but we also don't
+	// take part in any batches there.
+	policyFile := s.server.cfg.BatchPolicyFile
+	if policyFile == "" || s.server.cfg.ReadOnly {
+		return nil
+	}
+
+	p, err := policy.LoadFile(policyFile)
+	if err != nil {
+		return err
+	}
+
+	rpcLog.Infof("Applying batch policy from %s", policyFile)
+
+	return s.batchPolicy.SetPolicy(p)
+}
+
+// unmarshallBatchPolicy translates an RPC batch policy into the policy it
+// describes. A nil policy disables all rules.
+func unmarshallBatchPolicy(p *poolrpc.BatchPolicy) *policy.Policy {
+	if p == nil {
+		return &policy.Policy{}
+	}
+
+	return &policy.Policy{
+		MaxChainFee:          btcutil.Amount(p.MaxChainFeeSat),
+		MaxPriceDeviationBps: p.MaxPriceDeviationBps,
+		MaxMatchedOrders:     p.MaxMatchedOrders,
+	}
+}
+
+// marshallBatchPolicy translates a batch policy into its RPC counterpart.
+func marshallBatchPolicy(p *policy.Policy) *poolrpc.BatchPolicy {
+	return &poolrpc.BatchPolicy{
+		MaxChainFeeSat:       uint64(p.MaxChainFee),
+		MaxPriceDeviationBps: p.MaxPriceDeviationBps,
+		MaxMatchedOrders:     p.MaxMatchedOrders,
+	}
+}
+
+// marshallLocalBatchSnapshot translates a local batch snapshot into its RPC
+// counterpart.
+// marshallAcceptedBatchProposal translates a stored batch proposal into its
+// RPC counterpart.
+func marshallAcceptedBatchProposal(p *clientdb.AcceptedBatchProposal) (
+	*poolrpc.AcceptedBatchProposalResponse, error) {
+
+	var txBuf bytes.Buffer
+	if err := p.BatchTX.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
 
-			// Estimate the chain fees paid for the number of
-			// channels created in this batch and tally them.
-			chainFee := order.EstimateTraderFee(
-				uint32(numChans), batch.BatchTxFeeRate,
-			)
+	matchedUnits := make(
+		[]*poolrpc.MatchedOrderUnits, 0, len(p.MatchedUnits),
+	)
+	for nonce, units := range p.MatchedUnits {
+		nonce := nonce
+		matchedUnits = append(matchedUnits, &poolrpc.MatchedOrderUnits{
+			OrderNonce:  nonce[:],
+			UnitsFilled: uint32(units),
+		})
+	}
 
-			// We'll need to compute the chain fee paid for each
-			// lease. Since multiple leases can be created within a
-			// batch, we'll need to evenly distribute. We'll always
-			// apply the remainder to the first lease in the batch.
-			chainFeePerChan := chainFee / btcutil.Amount(numChans)
-			chainFeePerChanRem := chainFee % btcutil.Amount(numChans)
-			for i := firstIdxForBatch; i < len(rpcLeases); i++ {
-				chainFee := chainFeePerChan
-				if i == firstIdxForBatch {
-					chainFee += chainFeePerChanRem
-				}
-				rpcLeases[i].ChainFeeSat = uint64(chainFee)
-			}
-			totalAmtPaid += chainFee
+	// Sort the units to get a stable output, the proposal stores them in a
+	// map.
+	sort.Slice(matchedUnits, func(i, j int) bool {
+		return bytes.Compare(
+			matchedUnits[i].OrderNonce, matchedUnits[j].OrderNonce,
+		) < 0
+	})
+
+	clearingPrices := make(map[uint32]uint32, len(p.ClearingPrices))
+	for duration, price := range p.ClearingPrices {
+		clearingPrices[duration] = uint32(price)
+	}
+
+	accountDiffs := make([]*auctioneerrpc.AccountDiff, len(p.AccountDiffs))
+	for idx, diff := range p.AccountDiffs {
+		accountDiffs[idx] = &auctioneerrpc.AccountDiff{
+			EndingBalance: uint64(diff.EndingBalance),
+			EndingState:   diff.EndingState,
+			OutpointIndex: diff.OutpointIndex,
+			TraderKey:     diff.AccountKeyRaw[:],
+			NewExpiry:     diff.NewExpiry,
 		}
 	}
 
-	return &poolrpc.LeasesResponse{
-		Leases:            rpcLeases,
-		TotalAmtEarnedSat: uint64(totalAmtEarned),
-		TotalAmtPaidSat:   uint64(totalAmtPaid),
+	return &poolrpc.AcceptedBatchProposalResponse{
+		Version:        uint32(p.Version),
+		BatchId:        p.BatchID[:],
+		MatchedUnits:   matchedUnits,
+		ClearingPrices: clearingPrices,
+		AccountDiffs:   accountDiffs,
+		ExecutionFee: &auctioneerrpc.ExecutionFee{
+			BaseFee: uint64(p.ExecutionFee.BaseFee()),
+			FeeRate: uint64(p.ExecutionFee.FeeRate()),
+		},
+		BatchTxId:              p.BatchTX.TxHash().String(),
+		BatchTx:                txBuf.Bytes(),
+		BatchTxFeeRateSatPerKw: uint64(p.BatchTxFeeRate),
+		FeeRebateSat:           uint64(p.FeeRebate),
 	}, nil
 }
 
-// BatchSnapshot returns information about a target batch including the
-// clearing price of the batch, and the set of orders matched within the batch.
-func (s *rpcServer) BatchSnapshot(ctx context.Context,
-	req *auctioneerrpc.BatchSnapshotRequest) (
-	*auctioneerrpc.BatchSnapshotResponse, error) {
+func marshallLocalBatchSnapshot(batch *clientdb.LocalBatchSnapshot) (
+	*poolrpc.LocalBatchSnapshot, error) {
 
-	// THe current version of this call just proxies the request to the
-	// auctioneer, as we may only have information about the batch if we
-	// were matched with it on disk.
-	var batchID order.BatchID
-	copy(batchID[:], req.BatchId)
-	return s.auctioneer.BatchSnapshot(ctx, batchID)
-}
+	var (
+		matches  []*poolrpc.LocalMatchedOrder
+		numChans uint32
+	)
+	for nonce, matchedOrders := range batch.MatchedOrders {
+		nonce := nonce
 
-// BatchSnapshots returns a list of batch snapshots starting at the start batch
-// ID and going back through the history of batches, returning at most the
-// number of specified batches. A maximum of 100 snapshots can be queried in
-// one call. If no start batch ID is provided, the most recent finalized batch
-// is used as the starting point to go back from.
-func (s *rpcServer) BatchSnapshots(ctx context.Context,
-	req *auctioneerrpc.BatchSnapshotsRequest) (
-	*auctioneerrpc.BatchSnapshotsResponse, error) {
+		ourOrder, ok := batch.Orders[nonce]
+		if !ok {
+			return nil, fmt.Errorf("order %v not found in batch "+
+				"snapshot", nonce)
+		}
 
-	// To allow for easy querying through REST, we use the fallback value of
-	// showing one batch if no number is specified.
-	if req.NumBatchesBack == 0 {
-		req.NumBatchesBack = 1
+		for _, match := range matchedOrders {
+			// The duration of the channel is always that specified
+			// by the bid order.
+			duration := ourOrder.Details().LeaseDuration
+			if bid, ok := match.Order.(*order.Bid); ok {
+				duration = bid.LeaseDuration
+			}
+
+			matchedNonce := match.Order.Nonce()
+			nodeKey := match.NodeKey
+			matches = append(matches, &poolrpc.LocalMatchedOrder{
+				OrderNonce:          nonce[:],
+				MatchedOrderNonce:   matchedNonce[:],
+				UnitsFilled:         uint32(match.UnitsFilled),
+				LeaseDurationBlocks: duration,
+				NodeKey:             nodeKey[:],
+			})
+
+			numChans++
+		}
 	}
 
-	return s.auctioneer.BatchSnapshots(ctx, req)
+	// Sort the matches to get a stable output, the snapshot stores them in
+	// a map.
+	sort.Slice(matches, func(i, j int) bool {
+		cmp := bytes.Compare(
+			matches[i].OrderNonce, matches[j].OrderNonce,
+		)
+		if cmp != 0 {
+			return cmp < 0
+		}
+		return bytes.Compare(
+			matches[i].MatchedOrderNonce,
+			matches[j].MatchedOrderNonce,
+		) < 0
+	})
+
+	clearingPrices := make(map[uint32]uint32, len(batch.ClearingPrices))
+	for duration, price := range batch.ClearingPrices {
+		clearingPrices[duration] = uint32(price)
+	}
+
+	var batchTxID string
+	if batch.BatchTX != nil {
+		batchTxID = batch.BatchTX.TxHash().String()
+	}
+
+	chainFee := order.EstimateTraderFee(
+		numChans, batch.BatchTxFeeRate, account.VersionInitialNoVersion,
+	)
+
+	return &poolrpc.LocalBatchSnapshot{
+		Version:                uint32(batch.Version),
+		BatchId:                batch.BatchID[:],
+		BatchTxId:              batchTxID,
+		BatchTxFeeRateSatPerKw: uint64(batch.BatchTxFeeRate),
+		ClearingPrices:         clearingPrices,
+		ChainFeeSat:            uint64(chainFee),
+		MatchedOrders:          matches,
+	}, nil
 }
 
 // LeaseDurations returns the current set of valid lease duration in the
@@ -2360,7 +5571,22 @@ func (s *rpcServer) GetInfo(ctx context.Context,
 		Version:                Version(),
 		CurrentBlockHeight:     atomic.LoadUint32(&s.bestHeight),
 		SubscribedToAuctioneer: s.auctioneer.IsSubscribed(),
-		NewNodesOnly:           s.server.cfg.NewNodesOnly,
+		AuctioneerConnectionState: marshallConnectionState(
+			s.auctioneer.ConnectionState(),
+		),
+		AuctioneerEndpoint: s.auctioneer.Endpoint(),
+		NewNodesOnly:       s.server.cfg.NewNodesOnly,
+		HealthGate: &poolrpc.HealthGate{
+			State: poolrpc.HealthGateState_HEALTH_GATE_DISABLED,
+		},
+		AccountsByState: make(map[string]uint32),
+		OrdersByState:   make(map[string]uint32),
+		LndAlias:        s.lndServices.NodeAlias,
+		LndPubkey:       s.lndServices.NodePubkey[:],
+		BatchVersion:    s.server.cfg.DebugConfig.BatchVersion,
+	}
+	if s.healthMonitor != nil {
+		info.HealthGate = s.healthMonitor.RPCState()
 	}
 
 	// Query our own node's rating. We want the GetInfo call to be available
@@ -2398,14 +5624,73 @@ func (s *rpcServer) GetInfo(ctx context.Context,
 		info.MarketInfo = marketInfo.Markets
 	}
 
-	// Tally up account statistics.
-	accounts, err := s.server.db.Accounts()
+	// The auctioneer advertises its version in its terms. If we can't get
+	// them, the version is left empty so a missing connection stands out.
+	auctioneerTerms, err := s.auctioneer.Terms(ctx)
+	if err != nil {
+		rpcLog.Warnf("Error querying terms from auctioneer: %v", err)
+	} else {
+		info.AuctioneerVersion = auctioneerTerms.ServerVersion
+		info.AuctioneerBatchVersion = auctioneerTerms.BatchVersion
+	}
+
+	// Load our accounts, orders and batches within the same database
+	// transaction, so the statistics are consistent with each other.
+	var (
+		accounts       []*account.Account
+		orders         []order.Order
+		batches        []*clientdb.LocalBatchSnapshot
+		pendingBatchID *order.BatchID
+	)
+	err = s.server.db.ViewSnapshot(func(snapshot *clientdb.Snapshot) error {
+		var err error
+		accounts, err = snapshot.Accounts()
+		if err != nil {
+			return fmt.Errorf("error loading accounts: %v", err)
+		}
+
+		orders, err = snapshot.Orders()
+		if err != nil {
+			return fmt.Errorf("error loading orders: %v", err)
+		}
+
+		batches, err = snapshot.LocalBatchSnapshots()
+		if err != nil {
+			return fmt.Errorf("error loading batches: %v", err)
+		}
+
+		batchID, err := snapshot.PendingBatchID()
+		switch {
+		case err == account.ErrNoPendingBatch:
+
+		case err != nil:
+			return fmt.Errorf("error loading pending batch: %v",
+				err)
+
+		default:
+			pendingBatchID = &batchID
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error loading accounts: %v", err)
+		return nil, err
+	}
+
+	if pendingBatchID != nil {
+		info.PendingBatchId = pendingBatchID[:]
 	}
+
+	// Tally up account statistics.
 	for _, acct := range accounts {
 		info.AccountsTotal++
 
+		state, err := marshallAccountState(acct.State)
+		if err != nil {
+			return nil, err
+		}
+		info.AccountsByState[state.String()]++
+
 		if acct.State.IsActive() {
 			info.AccountsActive++
 
@@ -2418,13 +5703,15 @@ func (s *rpcServer) GetInfo(ctx context.Context,
 	}
 
 	// Tally up order statistics.
-	orders, err := s.server.db.GetOrders()
-	if err != nil {
-		return nil, fmt.Errorf("error loading orders: %v", err)
-	}
 	for _, o := range orders {
 		info.OrdersTotal++
 
+		state, err := DBOrderStateToRPCState(o.Details().State)
+		if err != nil {
+			return nil, err
+		}
+		info.OrdersByState[state.String()]++
+
 		if o.Details().State.Archived() {
 			info.OrdersArchived++
 		} else {
@@ -2434,10 +5721,6 @@ func (s *rpcServer) GetInfo(ctx context.Context,
 
 	// Count the number of local batch snapshots which should be equivalent
 	// to the number of batches a local account was involved in.
-	batches, err := s.server.db.GetLocalBatchSnapshots()
-	if err != nil {
-		return nil, fmt.Errorf("error loading batches: %v", err)
-	}
 	info.BatchesInvolved = uint32(len(batches))
 
 	// Finally count the number of LSAT tokens in our store.
@@ -2447,9 +5730,96 @@ func (s *rpcServer) GetInfo(ctx context.Context,
 	}
 	info.LsatTokens = uint32(len(tokens))
 
+	currentToken, err := s.server.lsatStore.CurrentTokenInfo()
+	switch {
+	case err == lsat.ErrNoToken:
+
+	case err != nil:
+		return nil, fmt.Errorf("error loading current token: %v", err)
+
+	default:
+		info.CurrentLsatToken = marshallLsatTokenInfo(currentToken)
+	}
+
 	return info, nil
 }
 
+// SubscribeServerState streams an update every time the state of the
+// connection to the auction server changes, starting with the current state.
+func (s *rpcServer) SubscribeServerState(
+	_ *poolrpc.SubscribeServerStateRequest,
+	stream poolrpc.Trader_SubscribeServerStateServer) error {
+
+	// Subscribe before reading the current state so we can't miss an
+	// update in between. At worst we send the same state twice.
+	client, err := s.auctioneer.SubscribeConnectionState()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	err = stream.Send(&poolrpc.ServerStateUpdate{
+		State: marshallConnectionState(
+			s.auctioneer.ConnectionState(),
+		),
+		TimestampNs: time.Now().UnixNano(),
+		Initial:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case update := <-client.Updates():
+			u, ok := update.(*auctioneer.ConnectionStateUpdate)
+			if !ok {
+				continue
+			}
+
+			rpcUpdate := &poolrpc.ServerStateUpdate{
+				State:       marshallConnectionState(u.State),
+				TimestampNs: u.Timestamp.UnixNano(),
+			}
+			if u.Err != nil {
+				rpcUpdate.Reason = u.Err.Error()
+			}
+			if err := stream.Send(rpcUpdate); err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("server state subscription canceled")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return errors.New("server shutting down")
+		}
+	}
+}
+
+// marshallConnectionState translates the state of the connection to the
+// auction server into its RPC counterpart.
+func marshallConnectionState(
+	state auctioneer.ConnectionState) poolrpc.AuctioneerConnectionState {
+
+	switch state {
+	case auctioneer.ConnectionStateConnected:
+		return poolrpc.AuctioneerConnectionState_AUCTIONEER_CONNECTED
+
+	case auctioneer.ConnectionStateDegraded:
+		return poolrpc.AuctioneerConnectionState_AUCTIONEER_DEGRADED
+
+	case auctioneer.ConnectionStateReconnecting:
+		return poolrpc.AuctioneerConnectionState_AUCTIONEER_RECONNECTING
+
+	default:
+		return poolrpc.AuctioneerConnectionState_AUCTIONEER_DISCONNECTED
+	}
+}
+
 // StopDaemon gracefully shuts down the Pool trader daemon.
 func (s *rpcServer) StopDaemon(_ context.Context,
 	_ *poolrpc.StopDaemonRequest) (*poolrpc.StopDaemonResponse, error) {
@@ -2496,81 +5866,249 @@ func (s *rpcServer) OfferSidecar(ctx context.Context,
 	// eventually need to submit it.
 	var bid *order.Bid
 	if req.AutoNegotiate {
-		kit, err := order.ParseRPCOrder(
-			req.Bid.Version, req.Bid.LeaseDurationBlocks,
-			req.Bid.Details,
-		)
+		bid, err = parseSidecarBid(req.Bid)
+		if err != nil {
+			return nil, err
+		}
+
+		// Perform some initial validation on the order to ensure that
+		// we'll be able to eventually submit it.
+		auctionTerms, err := s.orderManager.Terms(ctx)
 		if err != nil {
 			return nil, err
 		}
-		nodeTier, err := unmarshallNodeTier(req.Bid.MinNodeTier)
+		err = s.validateOrder(bid, acct, auctionTerms)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		// We don't add the ticket here yet as we'll only add it at the
-		// very end once the ticket has advanced to the final stage.
-		bid = &order.Bid{
-			Kit:             *kit,
-			MinNodeTier:     nodeTier,
-			SelfChanBalance: btcutil.Amount(req.Bid.SelfChanBalance),
+	// The funding manager does all the work, including signing and storing
+	// the new ticket.
+	ticket, err := s.server.fundingManager.OfferSidecar(
+		ctx, btcutil.Amount(req.Bid.Details.Amt),
+		btcutil.Amount(req.Bid.SelfChanBalance),
+		req.Bid.LeaseDurationBlocks, acct.TraderKey, bid,
+		req.AutoNegotiate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce order.Nonce
+	if bid != nil {
+		nonce = bid.Nonce()
+	}
+
+	// If the bid has already been specified, then we can go ahead and set
+	// it within the ticket.
+	ticket.Order = &sidecar.Order{
+		BidNonce: nonce,
+	}
+
+	// If the ticket has requested automated negotiation, then we'll hand
+	// it off to the coordinate tor now.
+	if ticket.Offer.Auto {
+		transport, err := unmarshallSidecarTransport(req.Transport)
+		if err != nil {
+			return nil, err
 		}
 
-		// Perform some initial validation on the order to ensure that
-		// we'll be able to eventually submit it.
-		auctionTerms, err := s.auctioneer.Terms(ctx)
+		err = s.server.sidecarAcceptor.CoordinateSidecar(
+			ticket, bid, acct, transport,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to query auctioneer "+
-				"terms: %v", err)
+			return nil, err
 		}
-		err = s.validateOrder(bid, acct, auctionTerms)
+	}
+
+	// We'll return a nice string encoded version of the ticket to the user.
+	ticketStr, err := sidecar.EncodeToString(ticket)
+	if err != nil {
+		return nil, err
+	}
+	return &poolrpc.SidecarTicket{
+		Ticket: ticketStr,
+	}, nil
+}
+
+// parseSidecarBid parses the bid template of a sidecar offer that is
+// negotiated automatically.
+func parseSidecarBid(rpcBid *poolrpc.Bid) (*order.Bid, error) {
+	kit, err := order.ParseRPCOrder(
+		rpcBid.Version, rpcBid.LeaseDurationBlocks, rpcBid.Details,
+	)
+	if err != nil {
+		return nil, err
+	}
+	nodeTier, err := unmarshallNodeTier(rpcBid.MinNodeTier)
+	if err != nil {
+		return nil, err
+	}
+
+	// We don't add the ticket here yet as we'll only add it at the very
+	// end once the ticket has advanced to the final stage.
+	return &order.Bid{
+		Kit:             *kit,
+		MinNodeTier:     nodeTier,
+		SelfChanBalance: btcutil.Amount(rpcBid.SelfChanBalance),
+	}, nil
+}
+
+// OfferSidecarBatch creates multiple sidecar tickets with the same offer in a
+// single call. No ticket is created if the account couldn't fund all of them
+// in the worst case, together with its active orders and the tickets it
+// offered before.
+func (s *rpcServer) OfferSidecarBatch(ctx context.Context,
+	req *poolrpc.OfferSidecarBatchRequest) (
+	*poolrpc.OfferSidecarBatchResponse, error) {
+
+	// Do some basic sanity checks first.
+	switch {
+	case req.NumTickets == 0:
+		return nil, fmt.Errorf("number of tickets must be positive")
+
+	case req.NumTickets > maxSidecarBatchSize:
+		return nil, fmt.Errorf("cannot create more than %d tickets "+
+			"at once", maxSidecarBatchSize)
+
+	case req.Offer == nil || req.Offer.Bid == nil ||
+		req.Offer.Bid.Details == nil:
+
+		return nil, fmt.Errorf("offer bid must be set")
+
+	// Each ticket's bid needs its own nonce, so we can't use the same
+	// nonce for all of them.
+	case len(req.Offer.Bid.Details.OrderNonce) != 0:
+		return nil, fmt.Errorf("order nonce cannot be set when " +
+			"creating multiple tickets")
+	}
+
+	acctKey, err := btcec.ParsePubKey(req.Offer.Bid.Details.TraderKey)
+	if err != nil {
+		return nil, err
+	}
+	acct, err := s.server.db.Account(acctKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// For tickets that are negotiated manually we only know the push
+	// amount the provider pays in any case. For automatically negotiated
+	// tickets we know the full bid and can reserve its worst case value.
+	auctionTerms, err := s.orderManager.Terms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feeSchedule := auctionTerms.FeeSchedule()
+	perTicket := btcutil.Amount(req.Offer.Bid.SelfChanBalance)
+	if req.Offer.AutoNegotiate {
+		bid, err := parseSidecarBid(req.Offer.Bid)
 		if err != nil {
 			return nil, err
 		}
+		perTicket = bid.ReservedValue(feeSchedule)
+	}
+
+	err = s.checkSidecarFunding(
+		acct, feeSchedule, perTicket*btcutil.Amount(req.NumTickets),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &poolrpc.OfferSidecarBatchResponse{
+		Tickets: make([]*poolrpc.SidecarTicket, 0, req.NumTickets),
+	}
+	for i := uint32(0); i < req.NumTickets; i++ {
+		ticket, err := s.OfferSidecar(ctx, req.Offer)
+		if err != nil {
+			return nil, fmt.Errorf("error creating sidecar ticket "+
+				"%d of %d: %w", i+1, req.NumTickets, err)
+		}
+
+		resp.Tickets = append(resp.Tickets, ticket)
+	}
+
+	return resp, nil
+}
+
+// checkSidecarFunding makes sure the given account can fund the given
+// additional value in the worst case, together with all of its active orders
+// and all tickets it offered that don't have an order yet. The account's
+// reserve may never be spent.
+func (s *rpcServer) checkSidecarFunding(acct *account.Account,
+	feeSchedule terms.FeeSchedule, additional btcutil.Amount) error {
+
+	var acctKey [33]byte
+	copy(acctKey[:], acct.TraderKey.PubKey.SerializeCompressed())
+
+	// Archived orders don't reserve any balance, so there's no need to
+	// read them.
+	dbOrders, err := s.server.db.GetOrdersByState(
+		order.StateSubmitted, order.StateCleared,
+		order.StatePartiallyFilled, order.StateStaged,
+	)
+	if err != nil {
+		return err
+	}
+
+	reserved := additional
+	for _, o := range dbOrders {
+		if o.Details().AcctKey != acctKey {
+			continue
+		}
+
+		reserved += o.ReservedValue(feeSchedule)
 	}
 
-	// The funding manager does all the work, including signing and storing
-	// the new ticket.
-	ticket, err := s.server.fundingManager.OfferSidecar(
-		ctx, btcutil.Amount(req.Bid.Details.Amt),
-		btcutil.Amount(req.Bid.SelfChanBalance),
-		req.Bid.LeaseDurationBlocks, acct.TraderKey, bid,
-		req.AutoNegotiate,
+	// Tickets that were ordered already are covered by their orders
+	// above, so we only need to look at the ones that could still be
+	// registered.
+	tickets, err := s.server.db.Sidecars(
+		sidecar.StateCreated, sidecar.StateOffered,
+		sidecar.StateRegistered,
 	)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("error reading sidecar tickets: %v", err)
 	}
+	for _, ticket := range tickets {
+		if !ticket.Offer.SignPubKey.IsEqual(acct.TraderKey.PubKey) {
+			continue
+		}
 
-	var nonce order.Nonce
-	if bid != nil {
-		nonce = bid.Nonce()
+		reserved += s.sidecarReservedValue(ticket, feeSchedule)
 	}
 
-	// If the bid has already been specified, then we can go ahead and set
-	// it within the ticket.
-	ticket.Order = &sidecar.Order{
-		BidNonce: nonce,
+	if acct.Value < reserved+acct.Reserve {
+		return fmt.Errorf("%w: account value %v cannot fund %v "+
+			"reserved for orders and sidecar tickets plus its "+
+			"reserve of %v", order.ErrInsufficientBalance,
+			acct.Value, reserved, acct.Reserve)
 	}
 
-	// If the ticket has requested automated negotiation, then we'll hand
-	// it off to the coordinate tor now.
-	if ticket.Offer.Auto {
-		err := s.server.sidecarAcceptor.CoordinateSidecar(
-			ticket, bid, acct,
-		)
-		if err != nil {
-			return nil, err
-		}
+	return nil
+}
+
+// sidecarReservedValue returns the value the provider's account needs to fund
+// in the worst case for a ticket that doesn't have an order yet. If the ticket
+// has a bid template, that bid's reserved value is used. Otherwise only the
+// push amount is known.
+func (s *rpcServer) sidecarReservedValue(ticket *sidecar.Ticket,
+	feeSchedule terms.FeeSchedule) btcutil.Amount {
+
+	if ticket.Order == nil || ticket.Order.BidNonce == order.ZeroNonce {
+		return ticket.Offer.PushAmt
 	}
 
-	// We'll return a nice string encoded version of the ticket to the user.
-	ticketStr, err := sidecar.EncodeToString(ticket)
+	bid, err := s.server.db.SidecarBidTemplate(ticket)
 	if err != nil {
-		return nil, err
+		rpcLog.Debugf("Unable to read bid template of sidecar ticket "+
+			"%x: %v", ticket.ID[:], err)
+		return ticket.Offer.PushAmt
 	}
-	return &poolrpc.SidecarTicket{
-		Ticket: ticketStr,
-	}, nil
+
+	return bid.ReservedValue(feeSchedule)
 }
 
 // RegisterSidecar is step 2/4 of the sidecar negotiation between the provider
@@ -2601,7 +6139,23 @@ func (s *rpcServer) RegisterSidecar(ctx context.Context,
 	// automated negotiation is to be sued, if so then we'll hand things
 	// off to the sidecar acceptor to finish the process.
 	if registeredTicket.Offer.Auto {
-		err := s.server.sidecarAcceptor.AutoAcceptSidecar(registeredTicket)
+		transport, err := unmarshallSidecarTransport(req.Transport)
+		if err != nil {
+			return nil, err
+		}
+
+		var providerNode *btcec.PublicKey
+		if len(req.ProviderNode) > 0 {
+			providerNode, err = btcec.ParsePubKey(req.ProviderNode)
+			if err != nil {
+				return nil, fmt.Errorf("invalid provider node: "+
+					"%v", err)
+			}
+		}
+
+		err = s.server.sidecarAcceptor.AutoAcceptSidecar(
+			registeredTicket, transport, providerNode,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to start ticket auto "+
 				"negotiation: %v", err)
@@ -2620,7 +6174,9 @@ func (s *rpcServer) RegisterSidecar(ctx context.Context,
 func (s *rpcServer) expectSidecarChannel(ctx context.Context,
 	t *sidecar.Ticket) error {
 
-	err := validateOrderedTicket(ctx, t, s.lndServices.Signer, s.server.db)
+	err := sidecaracceptor.ValidateOrderedTicket(
+		ctx, t, s.server.signer, s.server.db,
+	)
 	if err != nil {
 		return err
 	}
@@ -2678,30 +6234,128 @@ func (s *rpcServer) ListSidecars(_ context.Context,
 
 	var (
 		tickets []*sidecar.Ticket
+		states  []sidecar.State
 		err     error
 	)
 
+	if req.State != "" {
+		state, err := parseSidecarState(req.State)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+
 	if len(req.SidecarId) > 0 {
 		var id [8]byte
 		copy(id[:], req.SidecarId)
 		tickets, err = s.server.db.SidecarsByID(id)
 	} else {
-		tickets, err = s.server.db.Sidecars()
+		tickets, err = s.server.db.Sidecars(states...)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error reading sidecar tickets: %v", err)
 	}
 
+	creationTimes, err := s.sidecarCreationTimes()
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &poolrpc.ListSidecarsResponse{
-		Tickets: make([]*poolrpc.DecodedSidecarTicket, len(tickets)),
+		Tickets: make([]*poolrpc.DecodedSidecarTicket, 0, len(tickets)),
 	}
-	for idx, ticket := range tickets {
-		resp.Tickets[idx] = marshallTicket(ticket)
+	for _, ticket := range tickets {
+		// Tickets looked up by their ID still need to be filtered by
+		// their state.
+		if len(states) > 0 && ticket.State != states[0] {
+			continue
+		}
+
+		// Tickets without a creation time were added before creation
+		// times were recorded, so they were created before any time
+		// that can be given as a filter.
+		var signKey [33]byte
+		copy(signKey[:], ticket.Offer.SignPubKey.SerializeCompressed())
+		created, ok := creationTimes[sidecarTicketKey(
+			ticket.ID, signKey,
+		)]
+		createdNs := created.UnixNano()
+		if req.CreatedAfterTimestampNs != 0 &&
+			(!ok || createdNs < req.CreatedAfterTimestampNs) {
+
+			continue
+		}
+		if req.CreatedBeforeTimestampNs != 0 && ok &&
+			createdNs >= req.CreatedBeforeTimestampNs {
+
+			continue
+		}
+
+		rpcTicket := marshallTicket(ticket)
+		if ok {
+			rpcTicket.CreationTimestampNs = uint64(createdNs)
+		}
+		resp.Tickets = append(resp.Tickets, rpcTicket)
 	}
 
 	return resp, nil
 }
 
+// sidecarTicketKey returns the key that identifies a sidecar ticket, which is
+// its ID followed by its offer signing key.
+func sidecarTicketKey(id [8]byte, signKey [33]byte) [41]byte {
+	var key [41]byte
+	copy(key[:], id[:])
+	copy(key[8:], signKey[:])
+
+	return key
+}
+
+// sidecarCreationTimes returns the time each sidecar ticket was added to the
+// local database, which is the time the first event of the ticket was
+// recorded. Tickets that were added before the events were introduced aren't
+// contained in the result.
+func (s *rpcServer) sidecarCreationTimes() (map[[41]byte]time.Time, error) {
+	events, err := s.server.db.AllEvents(event.TypeSidecarStateChange)
+	if err != nil {
+		return nil, fmt.Errorf("error reading sidecar events: %v", err)
+	}
+
+	// The events are sorted by their timestamps, so the first event we
+	// see for a ticket is the oldest one.
+	creationTimes := make(map[[41]byte]time.Time)
+	for _, evt := range events {
+		sidecarEvent, ok := evt.(*clientdb.SidecarEvent)
+		if !ok {
+			return nil, fmt.Errorf("invalid sidecar event: %v", evt)
+		}
+
+		key := sidecarTicketKey(
+			sidecarEvent.ID, sidecarEvent.OfferSignPubKey,
+		)
+		if _, ok := creationTimes[key]; ok {
+			continue
+		}
+		creationTimes[key] = evt.Timestamp()
+	}
+
+	return creationTimes, nil
+}
+
+// parseSidecarState parses the string representation of a sidecar ticket state
+// as it is returned over RPC.
+func parseSidecarState(stateStr string) (sidecar.State, error) {
+	state := sidecar.StateCreated
+	for ; state <= sidecar.StateCanceled; state++ {
+		if state.String() == stateStr {
+			return state, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown sidecar ticket state %q", stateStr)
+}
+
 // CancelSidecar cancels the execution of a specific sidecar ticket. Depending
 // on the state of the sidecar ticket its associated bid order might be
 // canceled as well (if this ticket was offered by our node).
@@ -2770,7 +6424,8 @@ func (s *rpcServer) CancelSidecar(ctx context.Context,
 	// Set the state to canceled and update our local database. This will
 	// also delete any bid template in the sidecar bucket if it existed.
 	ticket.State = sidecar.StateCanceled
-	if err := s.server.db.UpdateSidecar(ticket); err != nil {
+	err = s.server.db.UpdateSidecarWithReason(ticket, "canceled by user")
+	if err != nil {
 		return nil, fmt.Errorf("error updating sidecar ticket with ID "+
 			"%x to state %d: %v", ticket.ID[:], ticket.State, err)
 	}
@@ -2778,10 +6433,282 @@ func (s *rpcServer) CancelSidecar(ctx context.Context,
 	return &poolrpc.CancelSidecarResponse{}, nil
 }
 
+// SubscribeSidecar streams an update every time a sidecar ticket with the
+// given ID changes its state. The current state of the ticket is sent first,
+// optionally preceded by its recorded history of state changes.
+func (s *rpcServer) SubscribeSidecar(req *poolrpc.SubscribeSidecarRequest,
+	stream poolrpc.Trader_SubscribeSidecarServer) error {
+
+	// The sidecar acceptor isn't started in read-only mode, so there are
+	// no updates we could deliver.
+	if s.server.cfg.ReadOnly {
+		return fmt.Errorf("sidecar updates are not available: %w",
+			ErrReadOnly)
+	}
+
+	var id [8]byte
+	if len(req.SidecarId) != len(id) {
+		return fmt.Errorf("invalid sidecar ID length %d",
+			len(req.SidecarId))
+	}
+	copy(id[:], req.SidecarId)
+
+	// We subscribe before reading the current state so we don't miss any
+	// update that happens in between.
+	client, err := s.server.sidecarAcceptor.SubscribeUpdates()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	// The history is read before the tickets themselves. An update that
+	// happens in between is then contained in the current state and
+	// streamed again below, which is better than missing it. Updates
+	// that are already part of the history are skipped though.
+	var (
+		histories   = make(map[[33]byte][]*clientdb.SidecarEvent)
+		lastEventTS = make(map[[33]byte]time.Time)
+	)
+	tickets, err := s.server.db.SidecarsByID(id)
+	if err != nil {
+		return err
+	}
+	if len(tickets) == 0 {
+		return fmt.Errorf("no sidecar ticket with ID %x found", id[:])
+	}
+	for _, ticket := range tickets {
+		events, err := s.server.db.GetSidecarEvents(
+			ticket.ID, ticket.Offer.SignPubKey,
+		)
+		if err != nil {
+			return fmt.Errorf("error reading events of sidecar "+
+				"ticket %x: %v", ticket.ID[:], err)
+		}
+
+		var key [33]byte
+		copy(key[:], ticket.Offer.SignPubKey.SerializeCompressed())
+		histories[key] = events
+		if len(events) > 0 {
+			lastEventTS[key] = events[len(events)-1].Timestamp()
+		}
+	}
+
+	// Now that we know about all events, we re-read the tickets to get
+	// their current state.
+	tickets, err = s.server.db.SidecarsByID(id)
+	if err != nil {
+		return err
+	}
+	for _, ticket := range tickets {
+		var key [33]byte
+		copy(key[:], ticket.Offer.SignPubKey.SerializeCompressed())
+
+		if req.IncludeHistory {
+			for _, evt := range histories[key] {
+				update := marshallSidecarEvent(evt)
+				update.Historic = true
+				if err := stream.Send(update); err != nil {
+					return err
+				}
+			}
+		}
+
+		state := ticket.State.String()
+		initial := &poolrpc.SidecarUpdate{
+			SidecarId:       ticket.ID[:],
+			OfferSignPubkey: key[:],
+			PrevState:       state,
+			NewState:        state,
+			Initial:         true,
+		}
+		if ts, ok := lastEventTS[key]; ok {
+			initial.TimestampNs = ts.UnixNano()
+		}
+		if err := stream.Send(initial); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case update := <-client.Updates():
+			evt, ok := update.(*clientdb.SidecarEvent)
+			if !ok || evt.ID != id {
+				continue
+			}
+
+			// Skip any update we already sent as part of the
+			// history.
+			lastTS, ok := lastEventTS[evt.OfferSignPubKey]
+			if ok && !evt.Timestamp().After(lastTS) {
+				continue
+			}
+
+			err := stream.Send(marshallSidecarEvent(evt))
+			if err != nil {
+				return err
+			}
+
+		case <-client.Quit():
+			return errors.New("sidecar update subscription canceled")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return errors.New("server shutting down")
+		}
+	}
+}
+
+// marshallSidecarEvent translates a sidecar event into its RPC counterpart.
+func marshallSidecarEvent(evt *clientdb.SidecarEvent) *poolrpc.SidecarUpdate {
+	return &poolrpc.SidecarUpdate{
+		SidecarId:       evt.ID[:],
+		OfferSignPubkey: evt.OfferSignPubKey[:],
+		PrevState:       evt.PrevState.String(),
+		NewState:        evt.NewState.String(),
+		Reason:          evt.Reason,
+		TimestampNs:     evt.Timestamp().UnixNano(),
+	}
+}
+
+// SetAuctioneerEndpoint forces both connections to the auction server to use
+// the given endpoint, or to select the endpoint automatically again if none is
+// given.
+func (s *rpcServer) SetAuctioneerEndpoint(_ context.Context,
+	req *poolrpc.SetAuctioneerEndpointRequest) (
+	*poolrpc.SetAuctioneerEndpointResponse, error) {
+
+	rpcLog.Infof("Forcing auction server endpoint %q", req.Address)
+
+	if err := s.auctioneer.SetEndpoint(req.Address); err != nil {
+		return nil, err
+	}
+
+	// The sidecar acceptor uses its own connection to the auction server
+	// that should use the same endpoint.
+	if s.server.sidecarAcceptor != nil {
+		err := s.server.sidecarAcceptor.cfg.Client.SetEndpoint(
+			req.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &poolrpc.SetAuctioneerEndpointResponse{
+		AuctioneerEndpoint: s.auctioneer.Endpoint(),
+	}, nil
+}
+
+// BakeMacaroon bakes a new macaroon that only grants the given permissions.
+// The macaroon can optionally be locked to an IP address and expire after a
+// timeout.
+func (s *rpcServer) BakeMacaroon(ctx context.Context,
+	req *poolrpc.BakeMacaroonRequest) (*poolrpc.BakeMacaroonResponse,
+	error) {
+
+	if len(req.Permissions) == 0 {
+		return nil, fmt.Errorf("at least one permission is required")
+	}
+
+	ops := make([]bakery.Op, len(req.Permissions))
+	for idx, permission := range req.Permissions {
+		ops[idx] = bakery.Op{
+			Entity: permission.Entity,
+			Action: permission.Action,
+		}
+
+		if !perms.IsKnownPermission(ops[idx]) {
+			return nil, fmt.Errorf("unknown permission %s:%s",
+				permission.Entity, permission.Action)
+		}
+	}
+
+	var constraints []macaroons.Constraint
+	if req.IpAddress != "" {
+		if net.ParseIP(req.IpAddress) == nil {
+			return nil, fmt.Errorf("invalid IP address %q",
+				req.IpAddress)
+		}
+
+		constraints = append(
+			constraints, macaroons.IPLockConstraint(req.IpAddress),
+		)
+	}
+	if req.TimeoutSeconds > 0 {
+		constraints = append(constraints, macaroons.TimeoutConstraint(
+			int64(req.TimeoutSeconds),
+		))
+	}
+
+	rpcLog.Infof("Baking macaroon with permissions %v", ops)
+
+	mac, err := s.server.bakeMacaroon(
+		ctx, req.RootKeyId, ops, constraints...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &poolrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(macBytes),
+	}, nil
+}
+
+// DumpConfig returns the effective configuration of the trader daemon after
+// the command line flags, environment variables and config file were
+// combined. The values of secret options are redacted.
+func (s *rpcServer) DumpConfig(_ context.Context,
+	_ *poolrpc.DumpConfigRequest) (*poolrpc.DumpConfigResponse, error) {
+
+	cfg := s.server.cfg
+	return &poolrpc.DumpConfigResponse{
+		Config:     dumpConfig(cfg),
+		ConfigFile: cfg.loadedConfigFile,
+		Warnings:   cfg.configWarnings,
+	}, nil
+}
+
+// VerifyDB scans the local database for records that don't match their
+// checksum or cannot be decoded and reports them. The database is not
+// modified.
+func (s *rpcServer) VerifyDB(_ context.Context,
+	_ *poolrpc.VerifyDBRequest) (*poolrpc.VerifyDBResponse, error) {
+
+	corrupted, err := s.server.db.VerifyRecords()
+	if err != nil {
+		return nil, fmt.Errorf("error verifying database: %v", err)
+	}
+
+	resp := &poolrpc.VerifyDBResponse{
+		CorruptedRecords: make(
+			[]*poolrpc.CorruptedRecord, len(corrupted),
+		),
+	}
+	for idx, record := range corrupted {
+		rpcLog.Warnf("Found corrupted database record: %v", record)
+
+		resp.CorruptedRecords[idx] = &poolrpc.CorruptedRecord{
+			Bucket: record.Bucket,
+			Key:    record.Key,
+		}
+	}
+
+	return resp, nil
+}
+
 // setTicketStateForOrder updates the sidecar ticket state we have for a given
-// order in our local database to the new state.
+// order in our local database to the new state, recording the optional reason
+// in the ticket's event log.
 func (s *rpcServer) setTicketStateForOrder(newState sidecar.State,
-	nonce order.Nonce) error {
+	nonce order.Nonce, reason string) error {
 
 	tickets, err := s.server.db.Sidecars()
 	if err != nil {
@@ -2794,7 +6721,8 @@ func (s *rpcServer) setTicketStateForOrder(newState sidecar.State,
 		}
 
 		ticket.State = newState
-		if err := s.server.db.UpdateSidecar(ticket); err != nil {
+		err := s.server.db.UpdateSidecarWithReason(ticket, reason)
+		if err != nil {
 			return fmt.Errorf("error updating sidecar ticket "+
 				"with ID %x to state %d: %v", ticket.ID[:],
 				newState, err)
@@ -2836,6 +6764,9 @@ func rpcOrderStateToDBState(state auctioneerrpc.OrderState) (order.State,
 	case auctioneerrpc.OrderState_ORDER_FAILED:
 		return order.StateFailed, nil
 
+	case auctioneerrpc.OrderState_ORDER_STAGED:
+		return order.StateStaged, nil
+
 	default:
 		return 0, fmt.Errorf("unknown state: %v", state)
 	}
@@ -2868,6 +6799,9 @@ func DBOrderStateToRPCState(state order.State) (auctioneerrpc.OrderState,
 	case order.StateFailed:
 		return auctioneerrpc.OrderState_ORDER_FAILED, nil
 
+	case order.StateStaged:
+		return auctioneerrpc.OrderState_ORDER_STAGED, nil
+
 	default:
 		return 0, fmt.Errorf("unknown state: %v", state)
 	}
@@ -2926,12 +6860,20 @@ func dbEventsToRPCEvents(dbEvents []event.Event) ([]*poolrpc.OrderEvent,
 			if err != nil {
 				return nil, err
 			}
+			stateChange := &poolrpc.UpdatedEvent{
+				PreviousState: prevState,
+				NewState:      newState,
+				UnitsFilled:   uint32(e.UnitsFilled),
+				UnitsFilledDelta: uint32(
+					e.UnitsFilledDelta,
+				),
+				Expired: e.Expired,
+			}
+			if e.BatchID != nil {
+				stateChange.BatchId = e.BatchID[:]
+			}
 			rpcEvent.Event = &poolrpc.OrderEvent_StateChange{
-				StateChange: &poolrpc.UpdatedEvent{
-					PreviousState: prevState,
-					NewState:      newState,
-					UnitsFilled:   uint32(e.UnitsFilled),
-				},
+				StateChange: stateChange,
 			}
 
 		case *clientdb.MatchEvent:
@@ -2952,6 +6894,22 @@ func dbEventsToRPCEvents(dbEvents []event.Event) ([]*poolrpc.OrderEvent,
 				},
 			}
 
+		case *clientdb.FeeRateBumpEvent:
+			rpcEvent.Event = &poolrpc.OrderEvent_FeeRateBump{
+				FeeRateBump: &poolrpc.FeeRateBumpEvent{
+					ReplacedOrder: e.ReplacedOrder[:],
+					PreviousFeeRateSatPerKw: uint64(
+						e.PrevFeeRate,
+					),
+					NewFeeRateSatPerKw: uint64(
+						e.NewFeeRate,
+					),
+					BatchFeeRateSatPerKw: uint64(
+						e.BatchFeeRate,
+					),
+				},
+			}
+
 		default:
 			return nil, fmt.Errorf("unknown event type: %v", e)
 		}
@@ -2978,7 +6936,70 @@ func unmarshallNodeTier(nodeTier auctioneerrpc.NodeTier) (order.NodeTier,
 		return order.NodeTier1, nil
 
 	default:
-		return 0, fmt.Errorf("unknown node tier: %v", nodeTier)
+		return 0, order.NewValidationError(
+			order.ValidationInvalidNodeTier, "min_node_tier",
+			nodeTier, fmt.Errorf("unknown node tier: %v", nodeTier),
+		)
+	}
+}
+
+// unmarshallSidecarTransport maps the RPC sidecar transport enum to its
+// internal counterpart. The default transport is mapped to an empty transport
+// which lets the sidecar acceptor use the configured one.
+func unmarshallSidecarTransport(
+	transport poolrpc.SidecarTransport) (sidecar.Transport, error) {
+
+	switch transport {
+	case poolrpc.SidecarTransport_SIDECAR_TRANSPORT_DEFAULT:
+		return "", nil
+
+	case poolrpc.SidecarTransport_SIDECAR_TRANSPORT_HASHMAIL:
+		return sidecar.TransportHashMail, nil
+
+	case poolrpc.SidecarTransport_SIDECAR_TRANSPORT_PEER:
+		return sidecar.TransportPeer, nil
+
+	default:
+		return "", fmt.Errorf("unknown sidecar transport: %v",
+			transport)
+	}
+}
+
+// marshallLeaseChannelState maps the state of a lease channel into the RPC
+// counterpart.
+func marshallLeaseChannelState(
+	state clientdb.LeaseChannelState) poolrpc.LeaseChannelState {
+
+	switch state {
+	case clientdb.LeaseChannelOpen:
+		return poolrpc.LeaseChannelState_LEASE_CHANNEL_STATE_OPEN
+	case clientdb.LeaseChannelClosed:
+		return poolrpc.LeaseChannelState_LEASE_CHANNEL_STATE_CLOSED
+	case clientdb.LeaseChannelClosedEarly:
+		return poolrpc.LeaseChannelState_LEASE_CHANNEL_STATE_CLOSED_EARLY
+	default:
+		return poolrpc.LeaseChannelState_LEASE_CHANNEL_STATE_UNKNOWN
+	}
+}
+
+// marshallLeaseCloseType maps the close type of a lease channel into its RPC
+// counterpart.
+func marshallLeaseCloseType(
+	closeType lndclient.CloseType) poolrpc.LeaseCloseType {
+
+	switch closeType {
+	case lndclient.CloseTypeLocalForce:
+		return poolrpc.LeaseCloseType_LEASE_CLOSE_TYPE_LOCAL_FORCE
+	case lndclient.CloseTypeRemoteForce:
+		return poolrpc.LeaseCloseType_LEASE_CLOSE_TYPE_REMOTE_FORCE
+	case lndclient.CloseTypeBreach:
+		return poolrpc.LeaseCloseType_LEASE_CLOSE_TYPE_BREACH
+	case lndclient.CloseTypeFundingCancelled:
+		return poolrpc.LeaseCloseType_LEASE_CLOSE_TYPE_FUNDING_CANCELED
+	case lndclient.CloseTypeAbandoned:
+		return poolrpc.LeaseCloseType_LEASE_CLOSE_TYPE_ABANDONED
+	default:
+		return poolrpc.LeaseCloseType_LEASE_CLOSE_TYPE_COOPERATIVE
 	}
 }
 
@@ -2996,53 +7017,6 @@ func marshallChannelType(
 	}
 }
 
-// marshallChannelInfo turns the given channel information map into its RPC
-// counterpart.
-func marshallChannelInfo(chanInfos map[wire.OutPoint]*chaninfo.ChannelInfo) (
-	map[string]*auctioneerrpc.ChannelInfo, error) {
-
-	rpcChannelInfos := make(
-		map[string]*auctioneerrpc.ChannelInfo, len(chanInfos),
-	)
-	for chanPoint, chanInfo := range chanInfos {
-		var channelType auctioneerrpc.ChannelType
-		switch chanInfo.Version {
-		case chanbackup.TweaklessCommitVersion:
-			channelType = auctioneerrpc.ChannelType_TWEAKLESS
-
-		// The AnchorsCommitVersion was never widely deployed (at least
-		// in mainnet) because the lnd version that included it guarded
-		// the anchor channels behind a config flag. Also, the two
-		// anchor versions only differ in the fee negotiation and not
-		// the commitment TX format, so we don't need to distinguish
-		// between them for our purpose.
-		case chanbackup.AnchorsCommitVersion,
-			chanbackup.AnchorsZeroFeeHtlcTxCommitVersion:
-			channelType = auctioneerrpc.ChannelType_ANCHORS
-
-		case chanbackup.ScriptEnforcedLeaseVersion:
-			channelType = auctioneerrpc.ChannelType_SCRIPT_ENFORCED_LEASE
-
-		default:
-			return nil, fmt.Errorf("unknown channel type: %v",
-				chanInfo.Version)
-		}
-		rpcChannelInfos[chanPoint.String()] = &auctioneerrpc.ChannelInfo{
-			Type: channelType,
-			LocalNodeKey: chanInfo.LocalNodeKey.
-				SerializeCompressed(),
-			RemoteNodeKey: chanInfo.RemoteNodeKey.
-				SerializeCompressed(),
-			LocalPaymentBasePoint: chanInfo.LocalPaymentBasePoint.
-				SerializeCompressed(),
-			RemotePaymentBasePoint: chanInfo.RemotePaymentBasePoint.
-				SerializeCompressed(),
-		}
-	}
-
-	return rpcChannelInfos, nil
-}
-
 func unmarshallSidecar(version order.Version,
 	encodedTicket string) (*sidecar.Ticket, error) {
 
@@ -3085,6 +7059,10 @@ func marshallTicket(t *sidecar.Ticket) *poolrpc.DecodedSidecarTicket {
 		EncodedTicket:            encoded,
 	}
 
+	if !t.Expiry.IsZero() {
+		resp.ExpiryTimestamp = uint64(t.Expiry.Unix())
+	}
+
 	if t.Offer.SigOfferDigest != nil {
 		resp.OfferSignature = t.Offer.SigOfferDigest.Serialize()
 	}
@@ -1,17 +1,25 @@
 package pool
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/order"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightninglabs/pool/terms"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -75,9 +83,9 @@ var renewAccountTestCases = []struct {
 		accMgr.EXPECT().
 			RenewAccount(
 				gomock.Any(), getAccountKey(req.AccountKey),
-				expiryHeight, feeRate, bestHeight,
+				expiryHeight, feeRate, bestHeight, false,
 			).
-			Return(acc, tx, nil)
+			Return(acc, tx, nil, nil)
 
 		rpcAccount := poolrpc.Account{}
 		marshalerMock.EXPECT().
@@ -170,3 +178,260 @@ func TestRenewAccount(t *testing.T) {
 		})
 	}
 }
+
+// TestCheckSidecarFunding makes sure the tickets an account offered but that
+// don't have an order yet are taken into account when checking whether the
+// account can fund more sidecar tickets.
+func TestCheckSidecarFunding(t *testing.T) {
+	t.Parallel()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &rpcServer{server: &Server{db: db}}
+
+	_, acctPubKey := btcec.PrivKeyFromBytes([]byte{0x01})
+	_, otherPubKey := btcec.PrivKeyFromBytes([]byte{0x02})
+	acct := &account.Account{
+		Value:   100_000,
+		Reserve: 10_000,
+		TraderKey: &keychain.KeyDescriptor{
+			PubKey: acctPubKey,
+		},
+	}
+
+	addTicket := func(id byte, state sidecar.State,
+		signKey *btcec.PublicKey, pushAmt btcutil.Amount) {
+
+		require.NoError(t, db.AddSidecar(&sidecar.Ticket{
+			ID:    [8]byte{id},
+			State: state,
+			Offer: sidecar.Offer{
+				Capacity:   1_000_000,
+				PushAmt:    pushAmt,
+				SignPubKey: signKey,
+			},
+		}))
+	}
+
+	// Only the offered ticket of our account needs to be funded. The
+	// ordered one is covered by its order and the other one is offered by
+	// a different account.
+	addTicket(1, sidecar.StateOffered, acctPubKey, 20_000)
+	addTicket(2, sidecar.StateOrdered, acctPubKey, 50_000)
+	addTicket(3, sidecar.StateOffered, otherPubKey, 50_000)
+
+	feeSchedule := terms.NewLinearFeeSchedule(0, 0)
+	require.NoError(t, s.checkSidecarFunding(acct, feeSchedule, 70_000))
+
+	err = s.checkSidecarFunding(acct, feeSchedule, 70_001)
+	require.ErrorIs(t, err, order.ErrInsufficientBalance)
+}
+
+// TestListOrdersPagination makes sure orders are paged through in the order
+// they were created in, even if that differs from the order of their nonces.
+func TestListOrdersPagination(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	orderMgr := order.NewMockManager(mockCtrl)
+	orderMgr.EXPECT().Terms(gomock.Any()).Return(
+		nil, errors.New("auctioneer offline"),
+	).AnyTimes()
+
+	s := &rpcServer{
+		server:       &Server{db: db},
+		orderManager: orderMgr,
+	}
+
+	// We create the orders with descending nonces, so every page would
+	// contain the wrong orders if they were paged through by nonce. The
+	// first order is canceled, so it's skipped when asking for active
+	// orders only.
+	const numOrders = 5
+	createdNonces := make([][]byte, numOrders)
+	for i := 0; i < numOrders; i++ {
+		kit := order.NewKit(order.Nonce{byte(numOrders - i)})
+		kit.State = order.StateSubmitted
+		if i == 0 {
+			kit.State = order.StateCanceled
+		}
+		kit.Amt = 100_000
+		kit.Units = 1
+		kit.UnitsUnfulfilled = 1
+		kit.MinUnitsMatch = 1
+		kit.LeaseDuration = 2016
+		kit.MaxBatchFeeRate = chainfee.FeePerKwFloor
+		copy(kit.AcctKey[:], traderKeyRaw)
+
+		require.NoError(t, db.SubmitOrder(&order.Ask{Kit: *kit}))
+
+		nonce := kit.Nonce()
+		createdNonces[i] = nonce[:]
+	}
+
+	listPages := func(pageSize uint32, activeOnly bool) [][]byte {
+		var (
+			nonces      [][]byte
+			lastCreated uint64
+		)
+		for offset := uint32(0); ; offset += pageSize {
+			resp, err := s.ListOrders(
+				context.Background(), &poolrpc.ListOrdersRequest{
+					IndexOffset:  offset,
+					MaxNumOrders: pageSize,
+					ActiveOnly:   activeOnly,
+				},
+			)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Asks), int(pageSize))
+
+			if len(resp.Asks) == 0 {
+				return nonces
+			}
+			for _, ask := range resp.Asks {
+				created := ask.Details.CreationTimestampNs
+				require.Greater(t, created, lastCreated)
+				lastCreated = created
+
+				nonces = append(nonces, ask.Details.OrderNonce)
+			}
+		}
+	}
+
+	require.Equal(t, createdNonces, listPages(2, false))
+	require.Equal(t, createdNonces, listPages(3, false))
+	require.Equal(t, createdNonces[1:], listPages(2, true))
+}
+
+// TestAcceptedBatchProposal makes sure the proposal of a batch we signed for
+// can be queried both explicitly and through the current pending batch.
+func TestAcceptedBatchProposal(t *testing.T) {
+	t.Parallel()
+
+	db, err := clientdb.New(t.TempDir(), clientdb.DBFilename, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &rpcServer{server: &Server{db: db}}
+
+	// Without a pending batch there's nothing to return.
+	_, err = s.AcceptedBatchProposal(
+		context.Background(), &poolrpc.AcceptedBatchProposalRequest{},
+	)
+	require.ErrorIs(t, err, account.ErrNoPendingBatch)
+
+	traderKey := getAccountKey(traderKeyRaw)
+	batchID := order.NewBatchID(traderKey)
+	batchTx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 1},
+		}},
+		TxOut: []*wire.TxOut{{
+			Value:    123_456,
+			PkScript: []byte{0x00, 0x20},
+		}},
+	}
+	var rawTraderKey [33]byte
+	copy(rawTraderKey[:], traderKeyRaw)
+	nonce1, nonce2 := order.Nonce{0x01}, order.Nonce{0x02}
+	recreated := auctioneerrpc.AccountDiff_OUTPUT_RECREATED
+	batch := &order.Batch{
+		ID:      batchID,
+		Version: order.DefaultBatchVersion,
+		MatchedOrders: map[order.Nonce][]*order.MatchedOrder{
+			nonce2: {{UnitsFilled: 7}},
+			nonce1: {{UnitsFilled: 2}, {UnitsFilled: 3}},
+		},
+		ClearingPrices: map[uint32]order.FixedRatePremium{
+			2016: 123,
+		},
+		AccountDiffs: []*order.AccountDiff{{
+			AccountKeyRaw: rawTraderKey,
+			AccountKey:    traderKey,
+			EndingState:   recreated,
+			EndingBalance: 123_456,
+			NewExpiry:     1337,
+		}},
+		ExecutionFee:   terms.NewLinearFeeSchedule(10, 100),
+		BatchTX:        batchTx,
+		BatchTxFeeRate: chainfee.FeePerKwFloor,
+		FeeRebate:      99,
+	}
+
+	proposal, err := clientdb.NewAcceptedBatchProposal(batch)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreAcceptedBatchProposal(batchID, proposal))
+
+	// The pending batch itself doesn't need any of our orders, we only want
+	// the proposal to be found through its ID.
+	pendingBatch := &order.Batch{
+		ID:           batchID,
+		ExecutionFee: batch.ExecutionFee,
+		BatchTX:      batchTx,
+	}
+	err = db.StorePendingBatch(pendingBatch, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	expected := &poolrpc.AcceptedBatchProposalResponse{
+		Version: uint32(order.DefaultBatchVersion),
+		BatchId: batchID[:],
+		MatchedUnits: []*poolrpc.MatchedOrderUnits{{
+			OrderNonce:  nonce1[:],
+			UnitsFilled: 5,
+		}, {
+			OrderNonce:  nonce2[:],
+			UnitsFilled: 7,
+		}},
+		ClearingPrices: map[uint32]uint32{2016: 123},
+		AccountDiffs: []*auctioneerrpc.AccountDiff{{
+			EndingBalance: 123_456,
+			EndingState:   recreated,
+			TraderKey:     traderKeyRaw,
+			NewExpiry:     1337,
+		}},
+		ExecutionFee: &auctioneerrpc.ExecutionFee{
+			BaseFee: 10,
+			FeeRate: 100,
+		},
+		BatchTxId:              batchTx.TxHash().String(),
+		BatchTxFeeRateSatPerKw: uint64(chainfee.FeePerKwFloor),
+		FeeRebateSat:           99,
+	}
+	var txBuf bytes.Buffer
+	require.NoError(t, batchTx.Serialize(&txBuf))
+	expected.BatchTx = txBuf.Bytes()
+
+	// Both the pending batch and the explicit batch ID lead to the same
+	// proposal.
+	resp, err := s.AcceptedBatchProposal(
+		context.Background(), &poolrpc.AcceptedBatchProposalRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected, resp)
+
+	resp, err = s.AcceptedBatchProposal(
+		context.Background(), &poolrpc.AcceptedBatchProposalRequest{
+			BatchId: batchID[:],
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected, resp)
+
+	// Once the batch is finalized, the proposal is gone.
+	require.NoError(t, db.MarkBatchComplete(batchID))
+	_, err = s.AcceptedBatchProposal(
+		context.Background(), &poolrpc.AcceptedBatchProposalRequest{
+			BatchId: batchID[:],
+		},
+	)
+	require.ErrorIs(t, err, clientdb.ErrNoAcceptedBatchProposal)
+}
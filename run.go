@@ -40,6 +40,15 @@ func Run(cfg *Config) error {
 		return err
 	}
 
+	// Now that logging is set up, we can report what we found while
+	// reading the config file.
+	if cfg.loadedConfigFile != "" {
+		log.Infof("Loaded config file %v", cfg.loadedConfigFile)
+	}
+	for _, warning := range cfg.configWarnings {
+		log.Warn(warning)
+	}
+
 	trader := NewServer(cfg)
 	err = trader.Start()
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -22,14 +23,18 @@ import (
 	"github.com/lightninglabs/pool/auctioneer"
 	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/lsatstore"
+	"github.com/lightninglabs/pool/metrics"
 	"github.com/lightninglabs/pool/order"
-	"github.com/lightninglabs/pool/perms"
 	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/sidecar"
 	"github.com/lightninglabs/pool/terms"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
-	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -75,15 +80,20 @@ type Server struct {
 	db              *clientdb.DB
 	fundingManager  *funding.Manager
 	sidecarAcceptor *SidecarAcceptor
-	lsatStore       *lsat.FileStore
+	lsatStore       *lsatstore.Store
 	lndServices     *lndclient.GrpcLndServices
 	lndClient       lnrpc.LightningClient
+	chainNotifier   chainrpc.ChainNotifierClient
+	remoteSigner    *lndclient.GrpcLndServices
+	signer          lndclient.SignerClient
 	grpcServer      *grpc.Server
 	restProxy       *http.Server
 	grpcListener    net.Listener
 	restListener    net.Listener
 	restCancel      func()
 	macaroonService *lndclient.MacaroonService
+	metrics         metrics.Recorder
+	metricsExporter *metrics.Exporter
 	wg              sync.WaitGroup
 }
 
@@ -132,7 +142,7 @@ func (s *Server) Start() error {
 	//
 	// TODO(roasbeef): more granular macaroons, can ask user to make just
 	// what we need
-	s.lndClient, err = lndclient.NewBasicClient(
+	basicConn, err := lndclient.NewBasicConn(
 		s.cfg.Lnd.Host, s.cfg.Lnd.TLSPath,
 		path.Dir(s.cfg.Lnd.MacaroonPath), s.cfg.Network,
 		lndclient.MacFilename(path.Base(s.cfg.Lnd.MacaroonPath)),
@@ -140,33 +150,32 @@ func (s *Server) Start() error {
 	if err != nil {
 		return err
 	}
+	s.lndClient = lnrpc.NewLightningClient(basicConn)
+
+	// The chain notifier of lndclient doesn't tell us about reorgs, so we
+	// talk to lnd's chain notifier RPC directly to find out if a batch
+	// transaction was reorged out of the chain.
+	s.chainNotifier = chainrpc.NewChainNotifierClient(basicConn)
 
 	// Create and start the macaroon service and let it create its default
-	// macaroon in case it doesn't exist yet.
-	s.macaroonService, err = lndclient.NewMacaroonService(
-		&lndclient.MacaroonServiceConfig{
-			DBPath:           s.cfg.BaseDir,
-			DBTimeout:        clientdb.DefaultPoolDBTimeout,
-			MacaroonLocation: poolMacaroonLocation,
-			MacaroonPath:     s.cfg.MacaroonPath,
-			Checkers: []macaroons.Checker{
-				macaroons.IPLockChecker,
-			},
-			RequiredPerms: perms.RequiredPermissions,
-			DBPassword:    macDbDefaultPw,
-			LndClient:     &s.lndServices.LndServices,
-			EphemeralKey:  lndclient.SharedKeyNUMS,
-			KeyLocator:    lndclient.SharedKeyLocator,
-		},
-	)
-	if err != nil {
-		return err
+	// macaroons in case they don't exist yet. Without macaroons, the RPC
+	// interface is only protected by TLS.
+	if s.cfg.NoMacaroons {
+		log.Warnf("Macaroon authentication is disabled, anyone who " +
+			"can reach the RPC interface has full access")
+	} else {
+		if err := s.startMacaroonService(); err != nil {
+			return err
+		}
+		shutdownFuncs["macaroon"] = s.macaroonService.Stop
 	}
 
-	if err := s.macaroonService.Start(); err != nil {
+	// Start the metrics exporter first so the managers can report their
+	// state right from the start.
+	if err := s.setupMetrics(); err != nil {
 		return err
 	}
-	shutdownFuncs["macaroon"] = s.macaroonService.Stop
+	shutdownFuncs["metrics"] = s.stopMetrics
 
 	// Setup the auctioneer client and interceptor.
 	err = s.setupClient()
@@ -184,22 +193,42 @@ func (s *Server) Start() error {
 	}
 	shutdownFuncs["rpcServer"] = s.rpcServer.Stop
 
-	// Let's create our interceptor chain, starting with the security
-	// interceptors that will check macaroons for their validity.
-	unaryMacIntercept, streamMacIntercept, err := s.macaroonService.Interceptors()
-	if err != nil {
-		return fmt.Errorf("error with macaroon interceptor: %v", err)
+	// Let's create our interceptor chain. The security interceptors that
+	// check macaroons for their validity are only added if macaroons
+	// aren't disabled.
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		errorLogStreamServerInterceptor(rpcLog),
+		shutdownStreamServerInterceptor(s.rpcServer.isShuttingDown),
 	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		errorLogUnaryServerInterceptor(rpcLog),
+		shutdownUnaryServerInterceptor(s.rpcServer.isShuttingDown),
+	}
+	if s.macaroonService != nil {
+		unaryMacIntercept, streamMacIntercept, err :=
+			s.macaroonService.Interceptors()
+		if err != nil {
+			return fmt.Errorf("error with macaroon interceptor: %v",
+				err)
+		}
+
+		streamInterceptors = append(
+			streamInterceptors, streamMacIntercept,
+		)
+		unaryInterceptors = append(unaryInterceptors, unaryMacIntercept)
+	}
+	streamInterceptors = append(
+		streamInterceptors,
+		readOnlyStreamServerInterceptor(s.cfg.ReadOnly),
+	)
+	unaryInterceptors = append(
+		unaryInterceptors,
+		readOnlyUnaryServerInterceptor(s.cfg.ReadOnly),
+	)
 
 	serverOpts := []grpc.ServerOption{
-		grpc.ChainStreamInterceptor(
-			errorLogStreamServerInterceptor(rpcLog),
-			streamMacIntercept,
-		),
-		grpc.ChainUnaryInterceptor(
-			errorLogUnaryServerInterceptor(rpcLog),
-			unaryMacIntercept,
-		),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	}
 	s.grpcServer = grpc.NewServer(serverOpts...)
 	poolrpc.RegisterTraderServer(s.grpcServer, s.rpcServer)
@@ -226,71 +255,15 @@ func (s *Server) Start() error {
 				s.cfg.RPCListen)
 		}
 
-		// The default JSON marshaler of the REST proxy only sets
-		// OrigName to true, which instructs it to use the same field
-		// names as specified in the proto file and not switch to camel
-		// case. What we also want is that the marshaler prints all
-		// values, even if they are falsey.
-		customMarshalerOption := proxy.WithMarshalerOption(
-			proxy.MIMEWildcard, &proxy.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{
-					UseProtoNames:   true,
-					EmitUnpopulated: true,
-				},
-			},
-		)
-
-		// We'll also create and start an accompanying proxy to serve
-		// clients through REST.
-		var ctx context.Context
-		ctx, s.restCancel = context.WithCancel(context.Background())
-		mux := proxy.NewServeMux(customMarshalerOption)
-		proxyOpts := []grpc.DialOption{
-			grpc.WithTransportCredentials(*restClientCreds),
-		}
-
-		// With TLS enabled by default, we cannot call 0.0.0.0
-		// internally from the REST proxy as that IP address isn't in
-		// the cert. We need to rewrite it to the loopback address.
-		restProxyDest := s.cfg.RPCListen
-		switch {
-		case strings.Contains(restProxyDest, "0.0.0.0"):
-			restProxyDest = strings.Replace(
-				restProxyDest, "0.0.0.0", "127.0.0.1", 1,
-			)
-
-		case strings.Contains(restProxyDest, "[::]"):
-			restProxyDest = strings.Replace(
-				restProxyDest, "[::]", "[::1]", 1,
-			)
-		}
-		err = poolrpc.RegisterTraderHandlerFromEndpoint(
-			ctx, mux, restProxyDest, proxyOpts,
-		)
-		if err != nil {
-			return err
-		}
-
-		log.Infof("Starting REST proxy listener")
-		s.restListener, err = net.Listen("tcp", s.cfg.RESTListen)
-		if err != nil {
-			return fmt.Errorf("REST proxy unable to listen on %s",
-				s.cfg.RESTListen)
-		}
-		s.restListener = tls.NewListener(s.restListener, serverTLSCfg)
-		shutdownFuncs["restListener"] = s.restListener.Close
-
-		s.restProxy = &http.Server{Handler: mux}
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-
-			err := s.restProxy.Serve(s.restListener)
-			if err != nil && err != http.ErrServerClosed {
-				log.Errorf("Could not start rest listener: %v",
-					err)
+		// The REST proxy is optional, it can be turned off by setting
+		// an empty listen address.
+		if s.cfg.RESTListen != "" {
+			err := s.startRESTProxy(serverTLSCfg, restClientCreds)
+			if err != nil {
+				return err
 			}
-		}()
+			shutdownFuncs["restListener"] = s.restListener.Close
+		}
 	}
 	s.grpcListener = tls.NewListener(s.grpcListener, serverTLSCfg)
 	shutdownFuncs["rpcListener"] = s.grpcListener.Close
@@ -313,10 +286,13 @@ func (s *Server) Start() error {
 	}()
 
 	// The final thing we'll do on start up is sync the order state of the
-	// auctioneer with what we have on disk.
-	err = s.syncLocalOrderState()
-	if err != nil {
-		return err
+	// auctioneer with what we have on disk. We can't update our orders in
+	// read-only mode, so we skip it there.
+	if !s.cfg.ReadOnly {
+		err = s.syncLocalOrderState()
+		if err != nil {
+			return err
+		}
 	}
 
 	// If we got here successfully, there's no need to shutdown anything
@@ -326,6 +302,78 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// startRESTProxy starts the REST proxy that forwards all REST and WebSocket
+// requests to the gRPC server.
+func (s *Server) startRESTProxy(serverTLSCfg *tls.Config,
+	restClientCreds *credentials.TransportCredentials) error {
+
+	// The default JSON marshaler of the REST proxy only sets OrigName to
+	// true, which instructs it to use the same field names as specified in
+	// the proto file and not switch to camel case. What we also want is
+	// that the marshaler prints all values, even if they are falsey.
+	customMarshalerOption := proxy.WithMarshalerOption(
+		proxy.MIMEWildcard, &proxy.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+		},
+	)
+
+	// We'll create and start an accompanying proxy to serve clients
+	// through REST.
+	var ctx context.Context
+	ctx, s.restCancel = context.WithCancel(context.Background())
+	mux := proxy.NewServeMux(customMarshalerOption)
+	proxyOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(*restClientCreds),
+	}
+
+	// With TLS enabled by default, we cannot call 0.0.0.0 internally from
+	// the REST proxy as that IP address isn't in the cert. We need to
+	// rewrite it to the loopback address.
+	restProxyDest := s.cfg.RPCListen
+	switch {
+	case strings.Contains(restProxyDest, "0.0.0.0"):
+		restProxyDest = strings.Replace(
+			restProxyDest, "0.0.0.0", "127.0.0.1", 1,
+		)
+
+	case strings.Contains(restProxyDest, "[::]"):
+		restProxyDest = strings.Replace(
+			restProxyDest, "[::]", "[::1]", 1,
+		)
+	}
+	err := poolrpc.RegisterTraderHandlerFromEndpoint(
+		ctx, mux, restProxyDest, proxyOpts,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Starting REST proxy listener")
+	s.restListener, err = net.Listen("tcp", s.cfg.RESTListen)
+	if err != nil {
+		return fmt.Errorf("REST proxy unable to listen on %s",
+			s.cfg.RESTListen)
+	}
+	s.restListener = tls.NewListener(s.restListener, serverTLSCfg)
+
+	// Streaming RPCs are served over WebSockets on the same listener.
+	s.restProxy = &http.Server{Handler: newRESTHandler(mux, s.cfg)}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		err := s.restProxy.Serve(s.restListener)
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("Could not start rest listener: %v", err)
+		}
+	}()
+
+	return nil
+}
+
 // StartAsSubserver is an alternative start method where the RPC server does not
 // create its own gRPC server but registers on an existing one.
 func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
@@ -355,35 +403,21 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 	}()
 
 	if withMacaroonService {
-		// Create and start the macaroon service and let it create its default
-		// macaroon in case it doesn't exist yet.
-		var err error
-		s.macaroonService, err = lndclient.NewMacaroonService(
-			&lndclient.MacaroonServiceConfig{
-				DBPath:           s.cfg.BaseDir,
-				DBTimeout:        clientdb.DefaultPoolDBTimeout,
-				MacaroonLocation: poolMacaroonLocation,
-				MacaroonPath:     s.cfg.MacaroonPath,
-				Checkers: []macaroons.Checker{
-					macaroons.IPLockChecker,
-				},
-				RequiredPerms: perms.RequiredPermissions,
-				DBPassword:    macDbDefaultPw,
-				LndClient:     &s.lndServices.LndServices,
-				EphemeralKey:  lndclient.SharedKeyNUMS,
-				KeyLocator:    lndclient.SharedKeyLocator,
-			},
-		)
-		if err != nil {
-			return err
-		}
-
-		if err := s.macaroonService.Start(); err != nil {
+		// Create and start the macaroon service and let it create its
+		// default macaroons in case they don't exist yet.
+		if err := s.startMacaroonService(); err != nil {
 			return err
 		}
 		shutdownFuncs["macaroon"] = s.macaroonService.Stop
 	}
 
+	// Start the metrics exporter first so the managers can report their
+	// state right from the start.
+	if err := s.setupMetrics(); err != nil {
+		return err
+	}
+	shutdownFuncs["metrics"] = s.stopMetrics
+
 	// Setup the auctioneer client and interceptor.
 	err := s.setupClient()
 	if err != nil {
@@ -401,10 +435,13 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 	shutdownFuncs["rpcServer"] = s.rpcServer.Stop
 
 	// The final thing we'll do on start up is sync the order state of the
-	// auctioneer with what we have on disk.
-	err = s.syncLocalOrderState()
-	if err != nil {
-		return err
+	// auctioneer with what we have on disk. We can't update our orders in
+	// read-only mode, so we skip it there.
+	if !s.cfg.ReadOnly {
+		err = s.syncLocalOrderState()
+		if err != nil {
+			return err
+		}
 	}
 
 	// If we got here successfully, there's no need to shutdown anything
@@ -423,6 +460,18 @@ func (s *Server) StartAsSubserver(lndClient lnrpc.LightningClient,
 func (s *Server) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op, fullMethod string) error {
 
+	// When running as a subserver, our own gRPC interceptors aren't used,
+	// so we also need to reject mutating calls in read-only mode here.
+	if err := checkReadOnly(s.cfg.ReadOnly, fullMethod); err != nil {
+		return err
+	}
+	if s.rpcServer != nil {
+		err := checkShuttingDown(s.rpcServer.isShuttingDown())
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.macaroonService == nil {
 		return fmt.Errorf("macaroon service has not been initialised")
 	}
@@ -433,11 +482,69 @@ func (s *Server) ValidateMacaroon(ctx context.Context,
 	)
 }
 
+// setupMetrics creates the recorder the managers report their state to. If a
+// listen address is configured, the metrics are collected in a Prometheus
+// registry that is served on that address. Otherwise nothing is recorded.
+func (s *Server) setupMetrics() error {
+	s.metrics = metrics.Disabled
+	if s.cfg.Metrics == nil || s.cfg.Metrics.Listen == "" {
+		return nil
+	}
+
+	registry := prometheus.NewRegistry()
+	recorder, err := metrics.NewPrometheusRecorder(registry)
+	if err != nil {
+		return fmt.Errorf("unable to create metrics recorder: %v", err)
+	}
+
+	exporter := metrics.NewExporter(s.cfg.Metrics.Listen, registry)
+	if err := exporter.Start(); err != nil {
+		return err
+	}
+
+	s.metrics = recorder
+	s.metricsExporter = exporter
+
+	return nil
+}
+
+// stopMetrics stops the metrics exporter, if it was started.
+func (s *Server) stopMetrics() error {
+	if s.metricsExporter == nil {
+		return nil
+	}
+
+	return s.metricsExporter.Stop()
+}
+
 // setupClient initializes the auctioneer client and its interceptors.
 func (s *Server) setupClient() error {
+	// Parse the auction server endpoints we can fail over between. The
+	// LSAT and our account keys aren't bound to a specific endpoint, so
+	// all of them need to be operated by the same auctioneer.
+	endpoints := make(
+		[]*auctioneer.Endpoint, 0, len(s.cfg.Auctioneer.Endpoints),
+	)
+	for _, endpointStr := range s.cfg.Auctioneer.Endpoints {
+		endpoint, err := auctioneer.ParseEndpoint(endpointStr)
+		if err != nil {
+			return err
+		}
+		endpoints = append(endpoints, endpoint)
+		log.Infof("Auction server endpoint: %v", endpoint.Address)
+	}
+	endpointSelection, err := auctioneer.ParseEndpointSelection(
+		s.cfg.Auctioneer.EndpointSelection,
+	)
+	if err != nil {
+		return err
+	}
+
 	// If no auction server is specified, use the default addresses for
 	// mainnet and testnet.
-	if s.cfg.AuctionServer == "" && len(s.cfg.AuctioneerDialOpts) == 0 {
+	if s.cfg.AuctionServer == "" && len(endpoints) == 0 &&
+		len(s.cfg.AuctioneerDialOpts) == 0 {
+
 		switch s.cfg.Network {
 		case "mainnet":
 			s.cfg.AuctionServer = MainnetServer
@@ -448,15 +555,34 @@ func (s *Server) setupClient() error {
 		}
 	}
 
-	log.Infof("Auction server address: %v", s.cfg.AuctionServer)
+	if len(endpoints) == 0 {
+		log.Infof("Auction server address: %v", s.cfg.AuctionServer)
+	}
 
 	// Open the main database.
-	var err error
-	s.db, err = clientdb.New(s.cfg.BaseDir, clientdb.DBFilename)
+	s.cfg.DB.Metrics = s.metrics
+	s.db, err = clientdb.New(
+		s.cfg.BaseDir, clientdb.DBFilename, s.cfg.DB,
+	)
 	if err != nil {
 		return err
 	}
 
+	// Keep the account backup file up to date with every change to any of
+	// our accounts, starting with the current state. Our accounts can't
+	// change in read-only mode, so there's nothing to back up.
+	if !s.cfg.ReadOnly {
+		if err := s.setupAccountBackup(); err != nil {
+			return err
+		}
+	}
+
+	// All signatures are created by the connected lnd node, unless it runs
+	// in watch-only mode and we're told to use its remote signer.
+	if err := s.setupSigner(); err != nil {
+		return err
+	}
+
 	// Parse our lnd node's public key.
 	nodePubKey, err := btcec.ParsePubKey(s.lndServices.NodePubkey[:])
 	if err != nil {
@@ -464,7 +590,7 @@ func (s *Server) setupClient() error {
 	}
 
 	// Setup the LSAT interceptor for the client.
-	s.lsatStore, err = lsat.NewFileStore(s.cfg.BaseDir)
+	s.lsatStore, err = lsatstore.New(s.cfg.BaseDir)
 	if err != nil {
 		return err
 	}
@@ -523,52 +649,82 @@ func (s *Server) setupClient() error {
 	// Create the funding manager. The RPC server is responsible for
 	// starting/stopping it though as all that logic is currently there for
 	// the other managers as well.
-	channelAcceptor := NewChannelAcceptor(s.lndServices.Client)
+	channelAcceptor := funding.NewChannelAcceptor(
+		s.lndServices.Client, s.cfg.ChannelAcceptor,
+	)
+	connectStrategy, err := funding.ParseConnectStrategy(
+		s.cfg.PeerConnectStrategy,
+	)
+	if err != nil {
+		return err
+	}
 	s.fundingManager = funding.NewManager(&funding.ManagerConfig{
 		DB:                s.db,
 		WalletKit:         s.lndServices.WalletKit,
 		LightningClient:   s.lndServices.Client,
-		SignerClient:      s.lndServices.Signer,
+		SignerClient:      s.signer,
 		BaseClient:        s.lndClient,
 		NodePubKey:        nodePubKey,
 		BatchStepTimeout:  order.DefaultBatchStepTimeout,
 		NewNodesOnly:      s.cfg.NewNodesOnly,
 		NotifyShimCreated: channelAcceptor.ShimRegistered,
+
+		SidecarTicketExpiry: s.cfg.SidecarTicketExpiry,
+		PeerConnectStrategy: connectStrategy,
+		PeerConnectTimeout:  s.cfg.PeerConnectTimeout,
 	})
 
 	// Create an instance of the auctioneer client library.
+	auctCfg := s.cfg.Auctioneer
 	clientCfg := &auctioneer.Config{
-		ServerAddress: s.cfg.AuctionServer,
-		ProxyAddress:  s.cfg.Proxy,
-		Insecure:      s.cfg.Insecure,
-		TLSPathServer: s.cfg.TLSPathAuctSrv,
-		DialOpts:      s.cfg.AuctioneerDialOpts,
-		Signer:        s.lndServices.Signer,
-		MinBackoff:    s.cfg.MinBackoff,
-		MaxBackoff:    s.cfg.MaxBackoff,
-		BatchSource:   s.db,
-		BatchCleaner:  s.fundingManager,
+		ServerAddress:     s.cfg.AuctionServer,
+		Endpoints:         endpoints,
+		EndpointSelection: endpointSelection,
+		ProxyAddress:      auctCfg.Proxy,
+		Insecure:          s.cfg.Insecure,
+		TLSPathServer:     s.cfg.TLSPathAuctSrv,
+		DialOpts:          s.cfg.AuctioneerDialOpts,
+		Signer:            s.signer,
+		MinBackoff:        s.cfg.MinBackoff,
+		MaxBackoff:        s.cfg.MaxBackoff,
+		BatchSource:       s.db,
+		BatchCleaner:      s.fundingManager,
 		BatchVersion: order.BatchVersion(
 			s.cfg.DebugConfig.BatchVersion,
 		),
 		GenUserAgent: func(ctx context.Context) string {
 			return UserAgent(InitiatorFromContext(ctx))
 		},
+		ProxyStreamIsolation:         auctCfg.StreamIsolation,
+		TorControlAddress:            auctCfg.TorControl,
+		TorControlPassword:           auctCfg.TorPassword,
+		KeepaliveTime:                auctCfg.KeepaliveTime,
+		KeepaliveTimeout:             auctCfg.KeepaliveTimeout,
+		KeepalivePermitWithoutStream: auctCfg.KeepalivePermitWithoutStream,
+		CallTimeout:                  auctCfg.CallTimeout,
+		BatchStreamTimeout:           auctCfg.BatchStreamTimeout,
+		Metrics:                      s.metrics,
 	}
 
-	// Create the acceptors for receiving sidecar channels. We need to
-	// create a copy of the auctioneer client configuration because the
-	// acceptor is going to overwrite some of its values.
-	clientCfgCopy := *clientCfg
+	// Create the acceptors for receiving sidecar channels. They use their
+	// own auctioneer client that connects to the sidecar stream, so we
+	// need to create a copy of the auctioneer client configuration.
+	sidecarClientCfg := *clientCfg
+	sidecarClientCfg.ConnectSidecar = true
+	sidecarClientCfg.Metrics = nil
+	sidecarClient, err := auctioneer.NewClient(&sidecarClientCfg)
+	if err != nil {
+		return err
+	}
 	s.sidecarAcceptor = NewSidecarAcceptor(&SidecarAcceptorConfig{
 		SidecarDB:      s.db,
 		AcctDB:         &accountStore{DB: s.db},
-		Signer:         s.lndServices.Signer,
+		Signer:         s.signer,
 		Wallet:         s.lndServices.WalletKit,
 		BaseClient:     s.lndClient,
 		Acceptor:       channelAcceptor,
 		NodePubKey:     nodePubKey,
-		ClientCfg:      clientCfgCopy,
+		Client:         sidecarClient,
 		FundingManager: s.fundingManager,
 		PrepareOrder: func(ctx context.Context,
 			order order.Order,
@@ -584,6 +740,22 @@ func (s *Server) setupClient() error {
 			)
 		},
 		FetchSidecarBid: s.db.SidecarBidTemplate,
+		CancelOrder: func(ctx context.Context,
+			nonce order.Nonce) error {
+
+			// Like above, the rpc server doesn't exist yet when we
+			// initialize this config. Canceling through the rpc
+			// server also updates the local order and ticket.
+			_, err := s.rpcServer.CancelOrder(
+				ctx, &poolrpc.CancelOrderRequest{
+					OrderNonce: nonce[:],
+				},
+			)
+			return err
+		},
+		PeerMailBox:     NewPeerMailBox(s.lndClient),
+		Transport:       sidecar.Transport(s.cfg.SidecarTransport),
+		SubscribeEvents: s.db.SubscribeSidecarEvents,
 	})
 
 	// Create an instance of the auctioneer client library.
@@ -592,6 +764,12 @@ func (s *Server) setupClient() error {
 		return err
 	}
 
+	// Both streams to the auction server are authenticated with the LSAT
+	// token, so they need to reconnect once the token is revoked or
+	// replaced with an imported one.
+	s.lsatStore.AddListener(s.AuctioneerClient.TokenChanged)
+	s.lsatStore.AddListener(sidecarClient.TokenChanged)
+
 	return s.AuctioneerClient.Start()
 }
 
@@ -602,8 +780,14 @@ func (s *Server) Stop() error {
 
 	var shutdownErr error
 
+	// Stop accepting new RPCs and batches and give a batch we're currently
+	// part of the chance to complete before we close the auction server
+	// connection and the database.
+	s.rpcServer.drainBatch()
+
 	// Don't return any errors yet, give everything else a chance to shut
 	// down first.
+	log.Info("Closing auction server connection")
 	err := s.AuctioneerClient.Stop()
 	if err != nil {
 		shutdownErr = err
@@ -635,6 +819,12 @@ func (s *Server) Stop() error {
 			log.Errorf("Error stopping macaroon service: %v", err)
 		}
 	}
+	if err := s.stopMetrics(); err != nil {
+		log.Errorf("Error stopping metrics exporter: %v", err)
+	}
+	if s.remoteSigner != nil {
+		s.remoteSigner.Close()
+	}
 	s.lndServices.Close()
 	s.wg.Wait()
 
@@ -644,6 +834,40 @@ func (s *Server) Stop() error {
 	return nil
 }
 
+// setupSigner sets the signer client that creates all account, order and
+// batch signatures. If a remote signer is configured, we connect to it and
+// make sure it holds the keys of the connected watch-only lnd node.
+func (s *Server) setupSigner() error {
+	s.signer = s.lndServices.Signer
+	if !s.cfg.RemoteSigner.Enable {
+		return nil
+	}
+
+	remoteSigner, err := getRemoteSigner(s.cfg.Network, s.cfg.RemoteSigner)
+	if err != nil {
+		return fmt.Errorf("unable to connect to remote signer: %v", err)
+	}
+
+	ctxt, cancel := context.WithTimeout(
+		context.Background(), defaultRPCTimeout,
+	)
+	defer cancel()
+	err = checkRemoteSigner(
+		ctxt, remoteSigner.WalletKit, s.lndServices.WalletKit,
+	)
+	if err != nil {
+		remoteSigner.Close()
+		return err
+	}
+
+	log.Infof("Using remote signer at %s", s.cfg.RemoteSigner.Host)
+
+	s.remoteSigner = remoteSigner
+	s.signer = remoteSigner.Signer
+
+	return nil
+}
+
 // getLnd returns an instance of the lnd services proxy.
 func getLnd(network string, cfg *LndConfig) (*lndclient.GrpcLndServices, error) {
 	// We'll want to wait for lnd to be fully synced to its chain backend.
@@ -726,6 +950,33 @@ func (i *regtestInterceptor) StreamInterceptor(ctx context.Context,
 	return streamer(idCtx, desc, cc, method, opts...)
 }
 
+// setupAccountBackup writes the account backup file with the current state of
+// all accounts and subscribes to account updates to rewrite it whenever any
+// account changes.
+func (s *Server) setupAccountBackup() error {
+	backupPath := filepath.Join(
+		s.cfg.BaseDir, clientdb.AccountBackupFilename,
+	)
+	writeBackup := func(accounts []*account.Account) {
+		err := clientdb.WriteAccountBackup(backupPath, accounts)
+		if err != nil {
+			log.Errorf("Unable to write account backup: %v", err)
+		}
+	}
+
+	accounts, err := s.db.Accounts()
+	if err != nil {
+		return fmt.Errorf("unable to read accounts for backup: %v", err)
+	}
+	if err := clientdb.WriteAccountBackup(backupPath, accounts); err != nil {
+		return err
+	}
+
+	s.db.SubscribeAccountUpdates(writeBackup)
+
+	return nil
+}
+
 // syncLocalOrderState synchronizes the order state of the local database with
 // that of the remote auctioneer. Whenever order state diverges, we take the
 // order state of the server but only if the new state is a cancel. Any other
@@ -774,6 +1025,11 @@ func (s *Server) syncLocalOrderState() error {
 			// as failed and allow the client start up with the
 			// rest of valid orders.
 			//
+			// Staged orders are only known to the auctioneer
+			// once they're activated.
+			case auctioneer.IsOrderNotFoundErr(err) &&
+				localOrderState == order.StateStaged:
+
 			// NOTE: This should never happen under normal
 			// conditions. This was added to handle a specific
 			// failure that's being investigated.
@@ -803,12 +1059,21 @@ func (s *Server) syncLocalOrderState() error {
 		// Only if the remote state is a cancelled order will we update
 		// our local state. Otherwise, this should be handled after we
 		// complete the handshake and poll to see if there're any
-		// pending batches we need to process.
-		if remoteOrderState == order.StateCanceled {
+		// pending batches we need to process. A staged order the
+		// auctioneer knows about was activated but we didn't get to
+		// update its state, so we take the remote state.
+		switch {
+		case localOrderState == order.StateStaged:
+			addUpdate(orderNonce, remoteOrderState)
+			log.Debugf("Order(%v) was activated, updating local "+
+				"state", orderNonce)
+
+		case remoteOrderState == order.StateCanceled:
 			addUpdate(orderNonce, order.StateCanceled)
 			log.Debugf("Order(%v) is cancelled, updating local "+
 				"state", orderNonce)
-		} else {
+
+		default:
 			log.Warnf("Order(%v) has de-synced state, "+
 				"local_state=%v, remote_state=%v", localOrderState,
 				remoteOrderState)
@@ -817,5 +1082,16 @@ func (s *Server) syncLocalOrderState() error {
 
 	// Now that we know the set of orders we need to update, we'll update
 	// them all in an atomic batch.
-	return s.db.UpdateOrders(noncesToUpdate, ordersToUpdate)
+	err = s.db.UpdateOrders(noncesToUpdate, ordersToUpdate)
+	if err != nil {
+		return err
+	}
+
+	// None of the updated orders can be matched anymore, so they no longer
+	// reserve any account balance.
+	for _, nonce := range noncesToUpdate {
+		s.rpcServer.orderManager.ReleaseReservation(nonce)
+	}
+
+	return nil
 }
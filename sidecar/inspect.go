@@ -0,0 +1,154 @@
+package sidecar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// Summary is a structured summary of a decoded and verified sidecar ticket.
+type Summary struct {
+	// ID is the pseudorandom identifier of the ticket.
+	ID [8]byte
+
+	// Version is the version of the ticket format.
+	Version Version
+
+	// State is the state the ticket is in.
+	State State
+
+	// Capacity is the offered channel capacity.
+	Capacity btcutil.Amount
+
+	// PushAmt is the offered self channel balance that is pushed to the
+	// recipient.
+	PushAmt btcutil.Amount
+
+	// LeaseDurationBlocks is the offered lease duration of the channel.
+	LeaseDurationBlocks uint32
+
+	// Auto is true if the ticket is negotiated automatically.
+	Auto bool
+
+	// Expiry is the time the ticket expires at or the zero time if it
+	// doesn't expire.
+	Expiry time.Time
+
+	// SignPubKey is the key the offer and order parts are signed with.
+	SignPubKey *btcec.PublicKey
+
+	// HasRecipient is true if the recipient added its information to the
+	// ticket.
+	HasRecipient bool
+
+	// HasOrder is true if the ticket contains the order part.
+	HasOrder bool
+
+	// OrderSigned is true if the order part contains a valid signature of
+	// the provider.
+	OrderSigned bool
+
+	// HasExecution is true if the ticket contains the execution part.
+	HasExecution bool
+
+	// Ticket is the full decoded ticket.
+	Ticket *Ticket
+}
+
+// DecodeAndVerify decodes the given string encoded sidecar ticket, checks its
+// version and verifies the signatures of the provider. If a signer public key
+// is given, the ticket must be signed by it. All verification happens locally,
+// so neither a connection to lnd nor a database is required.
+func DecodeAndVerify(ticketStr string,
+	signerPub *btcec.PublicKey) (*Summary, error) {
+
+	ticket, err := DecodeString(ticketStr)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ticket: %v", err)
+	}
+
+	switch ticket.Version {
+	case VersionDefault, VersionExpiry:
+	default:
+		return nil, fmt.Errorf("unknown ticket version %d",
+			ticket.Version)
+	}
+
+	offer := ticket.Offer
+	if offer.SignPubKey == nil || offer.SigOfferDigest == nil {
+		return nil, fmt.Errorf("offer in ticket is not signed")
+	}
+	if signerPub != nil && !signerPub.IsEqual(offer.SignPubKey) {
+		return nil, fmt.Errorf("offer signed by %x instead of %x",
+			offer.SignPubKey.SerializeCompressed(),
+			signerPub.SerializeCompressed())
+	}
+
+	offerDigest, err := ticket.OfferDigest()
+	if err != nil {
+		return nil, fmt.Errorf("error calculating offer digest: %v",
+			err)
+	}
+	err = verifyDigestSig(
+		offerDigest, offer.SigOfferDigest, offer.SignPubKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer signature: %v", err)
+	}
+
+	summary := &Summary{
+		ID:                  ticket.ID,
+		Version:             ticket.Version,
+		State:               ticket.State,
+		Capacity:            offer.Capacity,
+		PushAmt:             offer.PushAmt,
+		LeaseDurationBlocks: offer.LeaseDurationBlocks,
+		Auto:                offer.Auto,
+		Expiry:              ticket.Expiry,
+		SignPubKey:          offer.SignPubKey,
+		HasRecipient:        ticket.Recipient != nil,
+		HasOrder:            ticket.Order != nil,
+		HasExecution:        ticket.Execution != nil,
+		Ticket:              ticket,
+	}
+
+	// The order part is only signed once the ticket reached the ordered
+	// state, before that only the nonce might be set.
+	if ticket.Order != nil && ticket.Order.SigOrderDigest != nil {
+		orderDigest, err := ticket.OrderDigest()
+		if err != nil {
+			return nil, fmt.Errorf("error calculating order "+
+				"digest: %v", err)
+		}
+		err = verifyDigestSig(
+			orderDigest, ticket.Order.SigOrderDigest,
+			offer.SignPubKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid order signature: %v",
+				err)
+		}
+
+		summary.OrderSigned = true
+	}
+
+	return summary, nil
+}
+
+// verifyDigestSig verifies a signature over a ticket digest. The digests are
+// signed with lnd's signer which hashes the message with sha256 before signing
+// it.
+func verifyDigestSig(digest [32]byte, sig *ecdsa.Signature,
+	pubKey *btcec.PublicKey) error {
+
+	if !sig.Verify(chainhash.HashB(digest[:]), pubKey) {
+		return fmt.Errorf("signature not valid for public key %x",
+			pubKey.SerializeCompressed())
+	}
+
+	return nil
+}
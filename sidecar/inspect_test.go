@@ -0,0 +1,92 @@
+package sidecar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+// signDigest signs a ticket digest the same way lnd's signer does.
+func signDigest(privKey *btcec.PrivateKey, digest [32]byte) *ecdsa.Signature {
+	return ecdsa.Sign(privKey, chainhash.HashB(digest[:]))
+}
+
+// TestDecodeAndVerify makes sure tickets can be decoded and their signatures
+// verified without a signer.
+func TestDecodeAndVerify(t *testing.T) {
+	t.Parallel()
+
+	privKey, pubKey := btcec.PrivKeyFromBytes([]byte{0x01})
+	_, otherPubKey := btcec.PrivKeyFromBytes([]byte{0x02})
+
+	ticket, err := NewTicket(
+		VersionExpiry, 100_000, 40_000, 2016, pubKey, true,
+		time.Unix(1650000000, 0),
+	)
+	require.NoError(t, err)
+
+	offerDigest, err := ticket.OfferDigest()
+	require.NoError(t, err)
+	ticket.Offer.SigOfferDigest = signDigest(privKey, offerDigest)
+
+	encode := func(ticket *Ticket) string {
+		ticketStr, err := EncodeToString(ticket)
+		require.NoError(t, err)
+		return ticketStr
+	}
+
+	summary, err := DecodeAndVerify(encode(ticket), nil)
+	require.NoError(t, err)
+	require.Equal(t, StateOffered, summary.State)
+	require.EqualValues(t, 100_000, summary.Capacity)
+	require.EqualValues(t, 40_000, summary.PushAmt)
+	require.EqualValues(t, 2016, summary.LeaseDurationBlocks)
+	require.True(t, summary.Auto)
+	require.Equal(t, ticket.Expiry, summary.Expiry)
+	require.False(t, summary.HasRecipient)
+	require.False(t, summary.HasOrder)
+	require.False(t, summary.OrderSigned)
+	require.False(t, summary.HasExecution)
+
+	// The ticket must be signed by the expected key if one is given.
+	_, err = DecodeAndVerify(encode(ticket), pubKey)
+	require.NoError(t, err)
+	_, err = DecodeAndVerify(encode(ticket), otherPubKey)
+	require.ErrorContains(t, err, "instead of")
+
+	// Changing any signed field invalidates the offer signature.
+	tampered := *ticket
+	tampered.Offer.Capacity = 200_000
+	_, err = DecodeAndVerify(encode(&tampered), nil)
+	require.ErrorContains(t, err, "invalid offer signature")
+
+	// The order signature is verified as well once the order is signed.
+	ticket.State = StateOrdered
+	ticket.Recipient = &Recipient{
+		NodePubKey:     otherPubKey,
+		MultiSigPubKey: otherPubKey,
+	}
+	ticket.Order = &Order{BidNonce: [32]byte{1, 2, 3}}
+	orderDigest, err := ticket.OrderDigest()
+	require.NoError(t, err)
+	ticket.Order.SigOrderDigest = signDigest(privKey, orderDigest)
+
+	summary, err = DecodeAndVerify(encode(ticket), pubKey)
+	require.NoError(t, err)
+	require.True(t, summary.HasRecipient)
+	require.True(t, summary.HasOrder)
+	require.True(t, summary.OrderSigned)
+
+	ticket.Order.BidNonce = [32]byte{3, 2, 1}
+	_, err = DecodeAndVerify(encode(ticket), pubKey)
+	require.ErrorContains(t, err, "invalid order signature")
+
+	// Unknown versions are refused.
+	ticket.Version = Version(99)
+	_, err = DecodeAndVerify(encode(ticket), nil)
+	require.ErrorContains(t, err, "unknown ticket version")
+}
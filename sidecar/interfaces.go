@@ -2,13 +2,16 @@ package sidecar
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -18,6 +21,10 @@ type Version uint8
 const (
 	// VersionDefault is the initial version of the ticket format.
 	VersionDefault Version = 0
+
+	// VersionExpiry is the version of the ticket format that adds an
+	// expiry to the ticket which is covered by the offer signature.
+	VersionExpiry Version = 1
 )
 
 // State is the state a sidecar ticket currently is in. Each updater of the
@@ -202,6 +209,13 @@ type Ticket struct {
 	// must be set for all states greater or equal to StateOrdered.
 	Order *Order
 
+	// Expiry is the time after which the ticket can no longer be
+	// registered by the recipient and is canceled by the provider. It is
+	// set by the provider when offering the ticket and is covered by the
+	// offer signature. Tickets with the default version don't expire and
+	// have a zero expiry.
+	Expiry time.Time
+
 	// Execution contains the information about the execution part of the
 	// sidecar channel. This information is not meant to be exchanged
 	// between the participating parties but is included in the ticket to
@@ -211,14 +225,24 @@ type Ticket struct {
 }
 
 // NewTicket creates a new sidecar ticket with the given version and offer
-// information.
+// information. The expiry is only used for tickets of the VersionExpiry
+// version or later.
 func NewTicket(version Version, capacity, pushAmt btcutil.Amount,
-	duration uint32, offerPubKey *btcec.PublicKey,
-	auto bool) (*Ticket, error) {
+	duration uint32, offerPubKey *btcec.PublicKey, auto bool,
+	expiry time.Time) (*Ticket, error) {
+
+	if version >= VersionExpiry && expiry.IsZero() {
+		return nil, fmt.Errorf("ticket version %d requires an expiry",
+			version)
+	}
+	if version < VersionExpiry {
+		expiry = time.Time{}
+	}
 
 	t := &Ticket{
 		Version: version,
 		State:   StateOffered,
+		Expiry:  expiry,
 		Offer: Offer{
 			Capacity:            capacity,
 			PushAmt:             pushAmt,
@@ -252,6 +276,15 @@ func (t *Ticket) OfferDigest() ([32]byte, error) {
 			return result, err
 		}
 
+	case VersionExpiry:
+		err := lnwire.WriteElements(
+			&msg, t.ID[:], uint8(t.Version), t.Offer.Capacity,
+			t.Offer.PushAmt, t.Offer.Auto, uint64(t.Expiry.Unix()),
+		)
+		if err != nil {
+			return result, err
+		}
+
 	default:
 		return result, fmt.Errorf("unknown version %d", t.Version)
 	}
@@ -270,7 +303,7 @@ func (t *Ticket) OrderDigest() ([32]byte, error) {
 	}
 
 	switch t.Version {
-	case VersionDefault:
+	case VersionDefault, VersionExpiry:
 		err := lnwire.WriteElements(
 			&msg, t.ID[:], uint8(t.Version), t.Offer.Capacity,
 			t.Offer.PushAmt, t.Order.BidNonce[:],
@@ -285,6 +318,12 @@ func (t *Ticket) OrderDigest() ([32]byte, error) {
 	return sha256.Sum256(msg.Bytes()), nil
 }
 
+// IsExpired returns true if the ticket has an expiry that lies at or before the
+// given time.
+func (t *Ticket) IsExpired(now time.Time) bool {
+	return !t.Expiry.IsZero() && !now.Before(t.Expiry)
+}
+
 // Store is the interface a persistent storage must implement for storing and
 // retrieving sidecar tickets.
 type Store interface {
@@ -294,11 +333,78 @@ type Store interface {
 	// UpdateSidecar updates a sidecar order in the database.
 	UpdateSidecar(sidecar *Ticket) error
 
+	// UpdateSidecarWithReason updates a sidecar order in the database and
+	// records the given reason for its state change.
+	UpdateSidecarWithReason(sidecar *Ticket, reason string) error
+
 	// Sidecar retrieves a specific sidecar by its ID and provider signing
 	// key (offer signature pubkey) or returns ErrNoSidecar if it's not
 	// found.
 	Sidecar(id [8]byte, offerSignPubKey *btcec.PublicKey) (*Ticket, error)
 
-	// Sidecars retrieves all known sidecar orders from the database.
-	Sidecars() ([]*Ticket, error)
+	// Sidecars retrieves all known sidecar orders from the database. If
+	// any states are given, only tickets in one of those states are
+	// returned.
+	Sidecars(states ...State) ([]*Ticket, error)
+}
+
+// Transport is the kind of mailbox two nodes use to exchange sidecar tickets
+// while automatically negotiating a sidecar channel.
+type Transport string
+
+const (
+	// TransportHashMail is the transport that uses the auctioneer's hash
+	// mail server to relay the tickets between the two nodes.
+	TransportHashMail Transport = "hashmail"
+
+	// TransportPeer is the transport that sends the tickets directly to
+	// the other node as custom lnd peer messages. This requires the two
+	// nodes to be connected as peers.
+	TransportPeer Transport = "peer"
+)
+
+// ParseTransport parses the string representation of a sidecar transport.
+func ParseTransport(transport string) (Transport, error) {
+	switch Transport(transport) {
+	case TransportHashMail, TransportPeer:
+		return Transport(transport), nil
+
+	default:
+		return "", fmt.Errorf("unknown sidecar transport %q, must be "+
+			"either %q or %q", transport, TransportHashMail,
+			TransportPeer)
+	}
+}
+
+// MailBox is an interface that abstracts over the transport used by the
+// provider and recipient of a sidecar ticket to communicate with each other.
+// Each side reads from its own stream which is identified by a stream ID that
+// is derived from the ticket.
+type MailBox interface {
+	// RecvSidecarPkt attempts to receive a new sidecar packet from the
+	// relevant mailbox defined by the ticket and sidecar ticket role.
+	RecvSidecarPkt(ctx context.Context, pkt *Ticket,
+		provider bool) (*Ticket, error)
+
+	// SendSidecarPkt attempts to send the specified sidecar ticket to the
+	// party designated by the provider bool.
+	SendSidecarPkt(ctx context.Context, pkt *Ticket, provider bool) error
+
+	// InitSidecarMailbox attempts to create the mailbox with the given
+	// stream ID using the sidecar ticket authentication mechanism. If the
+	// mailbox already exists, then a nil error is to be returned.
+	InitSidecarMailbox(streamID [64]byte, ticket *Ticket) error
+
+	// DelSidecarMailbox tears down the mailbox the sidecar ticket
+	// recipient used to communicate with the provider.
+	DelSidecarMailbox(streamID [64]byte, ticket *Ticket) error
+
+	// InitAcctMailbox attempts to create the mailbox with the given stream
+	// ID using account signature authentication mechanism. If the mailbox
+	// already exists, then a nil error is to be returned.
+	InitAcctMailbox(streamID [64]byte, pubKey *keychain.KeyDescriptor) error
+
+	// DelAcctMailbox tears down the mailbox that the sidecar ticket
+	// provider used to communicate with the recipient.
+	DelAcctMailbox(streamID [64]byte, pubKey *keychain.KeyDescriptor) error
 }
@@ -5,10 +5,12 @@
 package sidecar
 
 import (
+	context "context"
 	reflect "reflect"
 
 	v2 "github.com/btcsuite/btcd/btcec/v2"
 	gomock "github.com/golang/mock/gomock"
+	keychain "github.com/lightningnetwork/lnd/keychain"
 )
 
 // MockStore is a mock of Store interface.
@@ -64,18 +66,22 @@ func (mr *MockStoreMockRecorder) Sidecar(id, offerSignPubKey interface{}) *gomoc
 }
 
 // Sidecars mocks base method.
-func (m *MockStore) Sidecars() ([]*Ticket, error) {
+func (m *MockStore) Sidecars(states ...State) ([]*Ticket, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Sidecars")
+	varargs := []interface{}{}
+	for _, a := range states {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Sidecars", varargs...)
 	ret0, _ := ret[0].([]*Ticket)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Sidecars indicates an expected call of Sidecars.
-func (mr *MockStoreMockRecorder) Sidecars() *gomock.Call {
+func (mr *MockStoreMockRecorder) Sidecars(states ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sidecars", reflect.TypeOf((*MockStore)(nil).Sidecars))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sidecars", reflect.TypeOf((*MockStore)(nil).Sidecars), states...)
 }
 
 // UpdateSidecar mocks base method.
@@ -91,3 +97,125 @@ func (mr *MockStoreMockRecorder) UpdateSidecar(sidecar interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSidecar", reflect.TypeOf((*MockStore)(nil).UpdateSidecar), sidecar)
 }
+
+// UpdateSidecarWithReason mocks base method.
+func (m *MockStore) UpdateSidecarWithReason(sidecar *Ticket, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSidecarWithReason", sidecar, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSidecarWithReason indicates an expected call of UpdateSidecarWithReason.
+func (mr *MockStoreMockRecorder) UpdateSidecarWithReason(sidecar, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSidecarWithReason", reflect.TypeOf((*MockStore)(nil).UpdateSidecarWithReason), sidecar, reason)
+}
+
+// MockMailBox is a mock of MailBox interface.
+type MockMailBox struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailBoxMockRecorder
+}
+
+// MockMailBoxMockRecorder is the mock recorder for MockMailBox.
+type MockMailBoxMockRecorder struct {
+	mock *MockMailBox
+}
+
+// NewMockMailBox creates a new mock instance.
+func NewMockMailBox(ctrl *gomock.Controller) *MockMailBox {
+	mock := &MockMailBox{ctrl: ctrl}
+	mock.recorder = &MockMailBoxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailBox) EXPECT() *MockMailBoxMockRecorder {
+	return m.recorder
+}
+
+// DelAcctMailbox mocks base method.
+func (m *MockMailBox) DelAcctMailbox(streamID [64]byte, pubKey *keychain.KeyDescriptor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelAcctMailbox", streamID, pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelAcctMailbox indicates an expected call of DelAcctMailbox.
+func (mr *MockMailBoxMockRecorder) DelAcctMailbox(streamID, pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelAcctMailbox", reflect.TypeOf((*MockMailBox)(nil).DelAcctMailbox), streamID, pubKey)
+}
+
+// DelSidecarMailbox mocks base method.
+func (m *MockMailBox) DelSidecarMailbox(streamID [64]byte, ticket *Ticket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelSidecarMailbox", streamID, ticket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelSidecarMailbox indicates an expected call of DelSidecarMailbox.
+func (mr *MockMailBoxMockRecorder) DelSidecarMailbox(streamID, ticket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelSidecarMailbox", reflect.TypeOf((*MockMailBox)(nil).DelSidecarMailbox), streamID, ticket)
+}
+
+// InitAcctMailbox mocks base method.
+func (m *MockMailBox) InitAcctMailbox(streamID [64]byte, pubKey *keychain.KeyDescriptor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitAcctMailbox", streamID, pubKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitAcctMailbox indicates an expected call of InitAcctMailbox.
+func (mr *MockMailBoxMockRecorder) InitAcctMailbox(streamID, pubKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitAcctMailbox", reflect.TypeOf((*MockMailBox)(nil).InitAcctMailbox), streamID, pubKey)
+}
+
+// InitSidecarMailbox mocks base method.
+func (m *MockMailBox) InitSidecarMailbox(streamID [64]byte, ticket *Ticket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitSidecarMailbox", streamID, ticket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitSidecarMailbox indicates an expected call of InitSidecarMailbox.
+func (mr *MockMailBoxMockRecorder) InitSidecarMailbox(streamID, ticket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitSidecarMailbox", reflect.TypeOf((*MockMailBox)(nil).InitSidecarMailbox), streamID, ticket)
+}
+
+// RecvSidecarPkt mocks base method.
+func (m *MockMailBox) RecvSidecarPkt(ctx context.Context, pkt *Ticket, provider bool) (*Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvSidecarPkt", ctx, pkt, provider)
+	ret0, _ := ret[0].(*Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecvSidecarPkt indicates an expected call of RecvSidecarPkt.
+func (mr *MockMailBoxMockRecorder) RecvSidecarPkt(ctx, pkt, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvSidecarPkt", reflect.TypeOf((*MockMailBox)(nil).RecvSidecarPkt), ctx, pkt, provider)
+}
+
+// SendSidecarPkt mocks base method.
+func (m *MockMailBox) SendSidecarPkt(ctx context.Context, pkt *Ticket, provider bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSidecarPkt", ctx, pkt, provider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendSidecarPkt indicates an expected call of SendSidecarPkt.
+func (mr *MockMailBoxMockRecorder) SendSidecarPkt(ctx, pkt, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSidecarPkt", reflect.TypeOf((*MockMailBox)(nil).SendSidecarPkt), ctx, pkt, provider)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/btcutil"
@@ -15,6 +16,7 @@ const (
 	idType      tlv.Type = 1
 	versionType tlv.Type = 2
 	stateType   tlv.Type = 3
+	expiryType  tlv.Type = 4
 
 	offerType          tlv.Type = 10
 	capacityType       tlv.Type = 11
@@ -65,9 +67,21 @@ func SerializeTicket(w io.Writer, ticket *Ticket) error {
 		tlv.MakeStaticRecord(idType, &ticket.ID, 8, EBytes8, DBytes8),
 		tlv.MakePrimitiveRecord(versionType, &version),
 		tlv.MakePrimitiveRecord(stateType, &state),
-		tlv.MakePrimitiveRecord(offerType, &offerBytes),
 	}
 
+	// The expiry is stored as a unix timestamp with second precision,
+	// which is the same precision that is covered by the offer signature.
+	if !ticket.Expiry.IsZero() {
+		expiry := uint64(ticket.Expiry.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			expiryType, &expiry,
+		))
+	}
+
+	tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+		offerType, &offerBytes,
+	))
+
 	if ticket.Recipient != nil {
 		recipientBytes, err := serializeRecipient(*ticket.Recipient)
 		if err != nil {
@@ -112,6 +126,7 @@ func DeserializeTicket(r io.Reader) (*Ticket, error) {
 	var (
 		ticket                     = &Ticket{}
 		version, state             uint8
+		expiry                     uint64
 		offerBytes, recipientBytes []byte
 		orderBytes, executionBytes []byte
 	)
@@ -120,6 +135,7 @@ func DeserializeTicket(r io.Reader) (*Ticket, error) {
 		tlv.MakeStaticRecord(idType, &ticket.ID, 8, EBytes8, DBytes8),
 		tlv.MakePrimitiveRecord(versionType, &version),
 		tlv.MakePrimitiveRecord(stateType, &state),
+		tlv.MakePrimitiveRecord(expiryType, &expiry),
 		tlv.MakePrimitiveRecord(offerType, &offerBytes),
 		tlv.MakePrimitiveRecord(recipientType, &recipientBytes),
 		tlv.MakePrimitiveRecord(orderType, &orderBytes),
@@ -137,6 +153,10 @@ func DeserializeTicket(r io.Reader) (*Ticket, error) {
 	ticket.Version = Version(version)
 	ticket.State = State(state)
 
+	if t, ok := parsedTypes[expiryType]; ok && t == nil {
+		ticket.Expiry = time.Unix(int64(expiry), 0)
+	}
+
 	if t, ok := parsedTypes[offerType]; ok && t == nil {
 		ticket.Offer, err = deserializeOffer(offerBytes)
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/pool/internal/test"
@@ -69,6 +70,7 @@ func TestSerializeTicket(t *testing.T) {
 		ID:      [8]byte{7, 6, 5, 4, 3, 2, 1, 0},
 		Version: Version(99),
 		State:   StateRegistered,
+		Expiry:  time.Unix(1650000000, 0),
 		Offer: Offer{
 			Capacity:       777,
 			PushAmt:        888,
@@ -10,6 +10,15 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
+// Verifier is the part of lnd's signer that is needed to verify the
+// signatures of a ticket.
+type Verifier interface {
+	// VerifyMessage verifies a signature over a message using the public
+	// key provided.
+	VerifyMessage(ctx context.Context, msg, sig []byte,
+		pubkey [33]byte) (bool, error)
+}
+
 // SignOffer adds a signature over the offer digest to the given ticket.
 func SignOffer(ctx context.Context, ticket *Ticket,
 	signingKeyLoc keychain.KeyLocator, signer lndclient.SignerClient) error {
@@ -51,8 +60,7 @@ func SignOffer(ctx context.Context, ticket *Ticket,
 
 // VerifyOffer verifies the state of a ticket to be in the offered state and
 // also makes sure the offer signature is valid.
-func VerifyOffer(ctx context.Context, ticket *Ticket,
-	signer lndclient.SignerClient) error {
+func VerifyOffer(ctx context.Context, ticket *Ticket, signer Verifier) error {
 
 	// The ticket needs to be in the correct state for us to verify it.
 	if ticket == nil || ticket.State < StateOffered {
@@ -136,8 +144,7 @@ func SignOrder(ctx context.Context, ticket *Ticket, bidNonce [32]byte,
 
 // VerifyOrder verifies the state of a ticket to be in the ordered state and
 // also makes sure the order signature is valid.
-func VerifyOrder(ctx context.Context, ticket *Ticket,
-	signer lndclient.SignerClient) error {
+func VerifyOrder(ctx context.Context, ticket *Ticket, signer Verifier) error {
 
 	// The ticket needs to be in the correct state for us to verify it.
 	if ticket == nil || ticket.State < StateOrdered {
@@ -3,6 +3,7 @@ package sidecar
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/pool/internal/test"
@@ -341,3 +342,36 @@ func TestVerifyOrder(t *testing.T) {
 		}
 	}
 }
+
+// TestOfferDigestExpiry makes sure the expiry of a ticket is covered by the
+// offer digest of tickets that support it.
+func TestOfferDigestExpiry(t *testing.T) {
+	_, err := NewTicket(
+		VersionExpiry, 100_000, 0, 2016, providerPubKey, false,
+		time.Time{},
+	)
+	require.Error(t, err)
+
+	expiry := time.Unix(1650000000, 0)
+	ticket, err := NewTicket(
+		VersionExpiry, 100_000, 0, 2016, providerPubKey, false, expiry,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expiry, ticket.Expiry)
+
+	digest, err := ticket.OfferDigest()
+	require.NoError(t, err)
+
+	ticket.Expiry = expiry.Add(time.Hour)
+	extendedDigest, err := ticket.OfferDigest()
+	require.NoError(t, err)
+	require.NotEqual(t, digest, extendedDigest)
+
+	// Tickets of the default version never expire.
+	ticket, err = NewTicket(
+		VersionDefault, 100_000, 0, 2016, providerPubKey, false, expiry,
+	)
+	require.NoError(t, err)
+	require.True(t, ticket.Expiry.IsZero())
+	require.False(t, ticket.IsExpired(expiry.Add(time.Hour)))
+}
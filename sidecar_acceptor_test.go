@@ -10,7 +10,6 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	gomock "github.com/golang/mock/gomock"
 	"github.com/lightninglabs/pool/account"
-	"github.com/lightninglabs/pool/auctioneer"
 	"github.com/lightninglabs/pool/clientdb"
 	"github.com/lightninglabs/pool/internal/test"
 	"github.com/lightninglabs/pool/order"
@@ -112,6 +111,33 @@ var registerSidecarTestCases = []struct {
 			).
 			Return(nil, nil)
 	},
+}, {
+	name: "unable to register sidecar if ticket expired",
+	ticket: &sidecar.Ticket{
+		ID:      [8]byte{1, 2, 3, 4},
+		Version: sidecar.VersionExpiry,
+		State:   sidecar.StateOffered,
+		Expiry:  time.Unix(1600000000, 0),
+		Offer: sidecar.Offer{
+			SignPubKey:     providerPubKey,
+			SigOfferDigest: testOfferSig,
+		},
+	},
+	expectedErr: "ticket expired",
+	mockSetter: func(ticket *sidecar.Ticket, sc *test.MockSignerClient,
+		wc *test.MockWalletKitClient, store *sidecar.MockStore,
+	) {
+		p1, p2, p3 := getVerifyParameters(ticket)
+
+		sc.EXPECT().
+			VerifyMessage(
+				gomock.Any(),
+				p1,
+				p2,
+				p3,
+			).
+			Return(true, nil)
+	},
 }, {
 	name: "register sidecar happy path",
 	ticket: &sidecar.Ticket{
@@ -196,7 +222,6 @@ func TestRegisterSidecar(t *testing.T) {
 				Signer:     signer,
 				Wallet:     wallet,
 				NodePubKey: ourNodePubKey,
-				ClientCfg:  auctioneer.Config{},
 			})
 
 			ticket, err := acceptor.RegisterSidecar(
@@ -221,6 +246,94 @@ func TestRegisterSidecar(t *testing.T) {
 	}
 }
 
+// TestCancelExpiredTickets makes sure expired sidecar tickets are canceled and
+// that the bids of tickets we provided are canceled first.
+func TestCancelExpiredTickets(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	store := sidecar.NewMockStore(mockCtrl)
+	acctStore := account.NewMockStore(mockCtrl)
+
+	now := time.Unix(1650000000, 0)
+	newTicket := func(id byte, state sidecar.State,
+		expiry time.Time) *sidecar.Ticket {
+
+		return &sidecar.Ticket{
+			ID:      [8]byte{id},
+			Version: sidecar.VersionExpiry,
+			State:   state,
+			Expiry:  expiry,
+			Offer: sidecar.Offer{
+				SignPubKey:     providerPubKey,
+				SigOfferDigest: testOfferSig,
+			},
+			Order: &sidecar.Order{
+				BidNonce: [32]byte{id},
+			},
+		}
+	}
+
+	var (
+		expired       = now.Add(-time.Minute)
+		providerOrder = newTicket(1, sidecar.StateOrdered, expired)
+		failedCancel  = newTicket(2, sidecar.StateOrdered, expired)
+		registered    = newTicket(3, sidecar.StateRegistered, expired)
+		notExpired    = newTicket(
+			4, sidecar.StateOffered, now.Add(time.Minute),
+		)
+		noExpiry = newTicket(5, sidecar.StateOffered, time.Time{})
+	)
+
+	store.EXPECT().Sidecars(
+		sidecar.StateCreated, sidecar.StateOffered,
+		sidecar.StateRegistered, sidecar.StateOrdered,
+	).Return([]*sidecar.Ticket{
+		providerOrder, failedCancel, registered, notExpired, noExpiry,
+	}, nil)
+	acctStore.EXPECT().Account(providerPubKey).
+		Return(&account.Account{}, nil).Times(2)
+
+	// Only the tickets that were canceled successfully are archived.
+	var updated []*sidecar.Ticket
+	store.EXPECT().UpdateSidecarWithReason(gomock.Any(), "ticket expired").
+		DoAndReturn(func(ticket *sidecar.Ticket, _ string) error {
+			updated = append(updated, ticket)
+			return nil
+		}).Times(2)
+
+	var canceled []order.Nonce
+	acceptor := NewSidecarAcceptor(&SidecarAcceptorConfig{
+		SidecarDB: store,
+		AcctDB:    acctStore,
+		CancelOrder: func(_ context.Context, nonce order.Nonce) error {
+			if nonce == failedCancel.Order.BidNonce {
+				return fmt.Errorf("auctioneer busy")
+			}
+
+			canceled = append(canceled, nonce)
+			return nil
+		},
+	})
+
+	err := acceptor.cancelExpiredTickets(context.Background(), now)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, []order.Nonce{providerOrder.Order.BidNonce}, canceled,
+	)
+	require.Equal(
+		t, []*sidecar.Ticket{providerOrder, registered}, updated,
+	)
+	require.Equal(t, sidecar.StateCanceled, providerOrder.State)
+	require.Equal(t, sidecar.StateCanceled, registered.State)
+	require.Equal(t, sidecar.StateOrdered, failedCancel.State)
+	require.Equal(t, sidecar.StateOffered, notExpired.State)
+	require.Equal(t, sidecar.StateOffered, noExpiry.State)
+}
+
 type mockMailBox struct {
 	providerChan     chan *sidecar.Ticket
 	providerMsgAck   chan struct{}
@@ -362,6 +475,12 @@ func (m *mockDriver) UpdateSidecar(tkt *sidecar.Ticket) error {
 	return nil
 }
 
+func (m *mockDriver) UpdateSidecarWithReason(tkt *sidecar.Ticket,
+	_ string) error {
+
+	return m.UpdateSidecar(tkt)
+}
+
 func (m *mockDriver) SubmitSidecarOrder(tkt *sidecar.Ticket, bid *order.Bid,
 	acct *account.Account) (*sidecar.Ticket, error) {
 
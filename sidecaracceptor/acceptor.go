@@ -0,0 +1,695 @@
+package sidecaracceptor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/poolrpc"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// StateChangeCallback is called each time the acceptor moved a ticket to a new
+// state and stored it. The callback is invoked synchronously, so it must not
+// modify the ticket or call back into the acceptor.
+type StateChangeCallback func(ticket *sidecar.Ticket)
+
+// Config holds all the configuration information that the acceptor needs in
+// order to carry out its duties.
+type Config struct {
+	// SidecarDB is the store the tickets are persisted in.
+	SidecarDB sidecar.Store
+
+	// Signer is used to verify the provider's signatures of a ticket.
+	Signer Signer
+
+	// Wallet is used to derive the multisig keys of the channels.
+	Wallet WalletKit
+
+	// BaseClient is a raw lnrpc client that is used to cancel funding
+	// shims of rejected batches.
+	BaseClient funding.BaseClient
+
+	// Acceptor is the channel acceptor that validates the incoming
+	// channels of the tickets.
+	Acceptor *funding.ChannelAcceptor
+
+	// NodePubKey is the identity public key of the recipient's node.
+	NodePubKey *btcec.PublicKey
+
+	// Auctioneer is the connection to the auction server. It must be set
+	// up to use the sidecar stream of the auction server.
+	Auctioneer Auctioneer
+
+	// FundingManager is used to receive the channels of a batch.
+	FundingManager ChannelFunder
+
+	// OnStateChange is an optional callback that is invoked each time a
+	// ticket moved to a new state.
+	OnStateChange StateChangeCallback
+}
+
+// Acceptor implements the state machine of the recipient of a sidecar channel.
+// The two tasks of the recipient are:
+//  1. Verify a sidecar ticket and the offer contained within then add the
+//     recipient node information to the ticket so it can be returned to the
+//     sidecar provider. This is step 2/4 of the entire sidecar execution
+//     protocol.
+//  2. Interact with the auction server and connect out to an asker's node in
+//     the right moment then accept the incoming channel. This is step 4/4 of
+//     the entire sidecar execution protocol.
+//
+// The acceptor doesn't need a Pool account, so it can be used by nodes that
+// only receive sidecar channels.
+type Acceptor struct {
+	cfg *Config
+
+	pendingOpenChanClient *subscribe.Client
+
+	pendingSidecarOrders    map[order.Nonce]*sidecar.Ticket
+	pendingSidecarOrdersMtx sync.Mutex
+	pendingBatch            *order.Batch
+
+	sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewAcceptor creates a new sidecar recipient acceptor.
+func NewAcceptor(cfg *Config) *Acceptor {
+	return &Acceptor{
+		cfg:                  cfg,
+		pendingSidecarOrders: make(map[order.Nonce]*sidecar.Ticket),
+		quit:                 make(chan struct{}),
+	}
+}
+
+// Start starts the acceptor and resumes expecting the channels of all tickets
+// that weren't completed yet.
+func (a *Acceptor) Start(errChan chan error) error {
+	if err := a.cfg.Auctioneer.Start(); err != nil {
+		return fmt.Errorf("error starting auctioneer client: %v", err)
+	}
+	if err := a.cfg.Acceptor.Start(errChan); err != nil {
+		return fmt.Errorf("error starting channel acceptor: %v", err)
+	}
+
+	// We want to make sure we don't miss any channel updates as long as we
+	// are running.
+	pendingOpenChanClient, err := a.cfg.FundingManager.SubscribePendingOpenChan()
+	if err != nil {
+		return fmt.Errorf("error subscribing to pending open channel "+
+			"events: %v", err)
+	}
+	a.pendingOpenChanClient = pendingOpenChanClient
+
+	// If we weren't able to complete all expected sidecar channels, we want
+	// to resume them now.
+	tickets, err := a.cfg.SidecarDB.Sidecars(sidecar.StateExpectingChannel)
+	if err != nil {
+		return fmt.Errorf("error reading sidecar tickets: %v", err)
+	}
+	for _, ticket := range tickets {
+		// Tickets that are negotiated automatically are resumed by
+		// their negotiator which expects the channel itself.
+		if ticket.Offer.Auto {
+			continue
+		}
+
+		r := ticket.Recipient
+		if r == nil || !r.NodePubKey.IsEqual(a.cfg.NodePubKey) {
+			continue
+		}
+
+		// This is a ticket for our node that is still being expected,
+		// add it to our map of expected channels.
+		ctxb := context.Background()
+		if err := a.ExpectChannel(ctxb, ticket); err != nil {
+			return fmt.Errorf("error subscribing to batch "+
+				"updates for sidecar ticket: %v", err)
+		}
+	}
+
+	a.wg.Add(1)
+	go a.subscribe()
+
+	return nil
+}
+
+// Stop stops the acceptor.
+func (a *Acceptor) Stop() error {
+	var returnErr error
+	if err := a.cfg.Auctioneer.Stop(); err != nil {
+		log.Errorf("Error stopping auctioneer client: %v", err)
+		returnErr = err
+	}
+
+	if a.pendingOpenChanClient != nil {
+		a.pendingOpenChanClient.Cancel()
+	}
+	a.cfg.Acceptor.Stop()
+	close(a.quit)
+
+	a.wg.Wait()
+
+	return returnErr
+}
+
+// subscribe listens for messages coming from the auctioneer. The acceptor only
+// subscribes with the multisig keys of its tickets, so the only messages it
+// receives are about batches that contain one of its channels. The important
+// part is that we connect out to the asker in the prepare step and accept the
+// incoming channel in the sign step. The rest is just cleanup of pending
+// states.
+//
+// NOTE: This method must be run as a goroutine.
+func (a *Acceptor) subscribe() {
+	defer a.wg.Done()
+
+	serverMsgs := a.cfg.Auctioneer.ServerMessages()
+	for {
+		select {
+		case serverMsg, ok := <-serverMsgs:
+			// The client is shutting down.
+			if !ok {
+				return
+			}
+
+			if err := a.handleServerMessage(serverMsg); err != nil {
+				log.Errorf("Error while handling server "+
+					"message: %v", err)
+			}
+
+		case <-a.quit:
+			return
+		}
+	}
+}
+
+// RegisterSidecar derives a new multisig key for a potential future channel
+// bought over a sidecar order and adds that to the offered ticket. If
+// successful, the updated ticket is added to the store.
+func (a *Acceptor) RegisterSidecar(ctx context.Context,
+	ticket sidecar.Ticket) (*sidecar.Ticket, error) {
+
+	// The ticket needs to be in the correct state for us to register it.
+	if err := sidecar.VerifyOffer(ctx, &ticket, a.cfg.Signer); err != nil {
+		return nil, fmt.Errorf("error verifying sidecar offer: %v", err)
+	}
+
+	// The expiry is covered by the offer signature, so we can trust it
+	// now. The provider cancels the ticket once it expires, so there's no
+	// point in registering it anymore.
+	if ticket.IsExpired(time.Now()) {
+		return nil, fmt.Errorf("ticket expired at %v",
+			ticket.Expiry.Format(time.RFC3339))
+	}
+
+	// Do we already have a ticket with that ID?
+	_, err := a.cfg.SidecarDB.Sidecar(ticket.ID, ticket.Offer.SignPubKey)
+	if err != clientdb.ErrNoSidecar {
+		return nil, fmt.Errorf("ticket with ID %x already exists",
+			ticket.ID[:])
+	}
+
+	// First we'll need a new multisig key for the channel that will be
+	// opened through this sidecar order.
+	keyDesc, err := a.cfg.Wallet.DeriveNextKey(
+		ctx, int32(keychain.KeyFamilyMultiSig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving multisig key: %v", err)
+	}
+
+	ticket.State = sidecar.StateRegistered
+	ticket.Recipient = &sidecar.Recipient{
+		NodePubKey:       a.cfg.NodePubKey,
+		MultiSigPubKey:   keyDesc.PubKey,
+		MultiSigKeyIndex: keyDesc.Index,
+	}
+	if err := a.cfg.SidecarDB.AddSidecar(&ticket); err != nil {
+		return nil, fmt.Errorf("error storing sidecar: %v", err)
+	}
+	a.notifyStateChange(&ticket)
+
+	return &ticket, nil
+}
+
+// ValidateOrderedTicket validates that a ticket has properly transitioned to
+// the ordered state and was registered by us before.
+func (a *Acceptor) ValidateOrderedTicket(ctx context.Context,
+	t *sidecar.Ticket) error {
+
+	return ValidateOrderedTicket(ctx, t, a.cfg.Signer, a.cfg.SidecarDB)
+}
+
+// ExpectChannel informs the acceptor that a new bid order was submitted for the
+// given sidecar ticket. We subscribe to auction events using the multisig key
+// we gave out when we registered the ticket. The ticket should be validated
+// with ValidateOrderedTicket first.
+func (a *Acceptor) ExpectChannel(ctx context.Context, t *sidecar.Ticket) error {
+	if t.Order == nil {
+		return fmt.Errorf("order in sidecar ticket is missing")
+	}
+
+	// Multiple channels should be registered serially, we'll hold the mutex
+	// for the whole duration.
+	a.pendingSidecarOrdersMtx.Lock()
+	defer a.pendingSidecarOrdersMtx.Unlock()
+
+	nonce := t.Order.BidNonce
+	_, ok := a.pendingSidecarOrders[nonce]
+	if ok {
+		return fmt.Errorf("sidecar with order nonce %x is already "+
+			"registered", nonce[:])
+	}
+
+	// We didn't know about this ticket for this nonce before so let's now
+	// update its state in the database and start expecting a channel for it
+	// now.
+	t.State = sidecar.StateExpectingChannel
+	if err := a.cfg.SidecarDB.UpdateSidecar(t); err != nil {
+		return fmt.Errorf("error updating sidecar: %v", err)
+	}
+	a.notifyStateChange(t)
+
+	a.pendingSidecarOrders[nonce] = t
+
+	// Authenticate our fake account with the server now to receive updates
+	// about possible matches. This method will return as soon as the
+	// authentication itself is completed, after which we can read the
+	// server messages on the auctioneer's message channel.
+	return a.cfg.Auctioneer.StartAccountSubscription(
+		ctx, &keychain.KeyDescriptor{
+			KeyLocator: keychain.KeyLocator{
+				Family: keychain.KeyFamilyMultiSig,
+				Index:  t.Recipient.MultiSigKeyIndex,
+			},
+			PubKey: t.Recipient.MultiSigPubKey,
+		},
+	)
+}
+
+// ValidateOrderedTicket validates a ticket in the ordered state to ensure all
+// the details are in place, and signed properly.
+func ValidateOrderedTicket(ctx context.Context, t *sidecar.Ticket,
+	signer Signer, db sidecar.Store) error {
+
+	// The ticket should be in the ordered state at this point (has the bid
+	// information).
+	if t.State != sidecar.StateOrdered {
+		return fmt.Errorf("sidecar ticket in state %v, expected %v",
+			t.State, sidecar.StateOrdered)
+	}
+
+	// Let's make sure the ticket itself and the offer is valid.
+	if err := sidecar.VerifyOffer(ctx, t, signer); err != nil {
+		return fmt.Errorf("error validating order in sidecar "+
+			"ticket: %v", err)
+	}
+
+	// Make sure the order signature is valid and the ticket actually exists
+	// in our database. We need to have it stored already since must've done
+	// the register part before.
+	if err := sidecar.VerifyOrder(ctx, t, signer); err != nil {
+		return fmt.Errorf("error validating order in sidecar "+
+			"ticket: %v", err)
+	}
+	if _, err := db.Sidecar(t.ID, t.Offer.SignPubKey); err != nil {
+		return fmt.Errorf("error looking up sidecar order for "+
+			"ticket with ID %x: %v", t.ID[:], err)
+	}
+
+	return nil
+}
+
+// notifyStateChange invokes the state change callback if one is configured.
+func (a *Acceptor) notifyStateChange(t *sidecar.Ticket) {
+	if a.cfg.OnStateChange != nil {
+		a.cfg.OnStateChange(t)
+	}
+}
+
+// handleServerMessage reacts to a message sent by the server and sends back the
+// appropriate response message (if needed). The main lock will be held during
+// the full execution of this method.
+func (a *Acceptor) handleServerMessage(
+	serverMsg *auctioneerrpc.ServerAuctionMessage) error {
+
+	// We hold the lock during the whole process of reacting to a server
+	// message to make sure no user RPC calls interfere with the execution.
+	a.Lock()
+	defer a.Unlock()
+
+	switch msg := serverMsg.Msg.(type) {
+	case *auctioneerrpc.ServerAuctionMessage_Prepare:
+		log.Tracef("Received prepare msg from server, "+
+			"batch_id=%x: %v", msg.Prepare.BatchId,
+			poolrpc.PrintMsg(msg.Prepare))
+
+		if err := a.matchPrepare(msg.Prepare); err != nil {
+			log.Errorf("unable to handle prepare message: %v", err)
+			return a.sendRejectBatch(msg.Prepare.BatchId, nil, err)
+		}
+
+	case *auctioneerrpc.ServerAuctionMessage_Sign:
+		log.Tracef("Received sign msg from server, batch_id=%x: %v",
+			msg.Sign.BatchId, poolrpc.PrintMsg(msg.Sign))
+
+		if err := a.matchSign(msg.Sign); err != nil {
+			log.Errorf("unable to handle sign message: %v", err)
+			return a.sendRejectBatch(
+				a.pendingBatch.ID[:], a.pendingBatch, err,
+			)
+		}
+
+	case *auctioneerrpc.ServerAuctionMessage_Finalize:
+		batchID := msg.Finalize.BatchId
+
+		log.Tracef("Received finalize msg from server, "+
+			"batch_id=%x: %v", batchID,
+			poolrpc.PrintMsg(msg.Finalize))
+
+		// This operation cannot fail.
+		a.matchFinalize()
+
+	default:
+		log.Debugf("Received msg %v from auctioneer on sidecar "+
+			"client: %v", poolrpc.PrintMsg(serverMsg))
+	}
+
+	return nil
+}
+
+// matchPrepare handles an incoming OrderMatchPrepare message from the server.
+// Since we're only on the receiving end of a sidecar channel (which is always
+// a bid order) the tasks are simplified compared to normal bid order execution.
+//
+// NOTE: The lock must be held when calling this method.
+func (a *Acceptor) matchPrepare(msg *auctioneerrpc.OrderMatchPrepare) error {
+	// Parse and formally validate what we got from the server.
+	batch, err := order.ParseRPCBatch(msg)
+	if err != nil {
+		return fmt.Errorf("unable to parse batch: %v", err)
+	}
+
+	log.Infof("Received PrepareMsg for batch=%x, num_orders=%v",
+		batch.ID[:], len(batch.MatchedOrders))
+
+	// Ensure that we do not have any registered shims for the orders
+	// in this batch. This is not supposed to happen but we have a bug.
+	if err = a.removeShims(batch); err != nil {
+		return fmt.Errorf("unable to cleanup shims before start "+
+			"preparing the current batch: %v", err)
+	}
+
+	// If there is still a pending batch around from a previous iteration,
+	// we need to clean up the pending channels first.
+	if a.pendingBatch != nil {
+		if err := a.removeShims(a.pendingBatch); err != nil {
+			return fmt.Errorf("unable to cleanup previous batch: "+
+				"%v", err)
+		}
+		a.pendingBatch = nil
+	}
+
+	// The funding shims we're about to register derive the channel's
+	// balances from our tickets, so we reject any match that would result
+	// in different balances than the ticket specifies.
+	if err := a.verifySidecarBalances(batch); err != nil {
+		return err
+	}
+
+	// Before we accept the batch, we'll finish preparations on our end
+	// which include applying any order match predicates, connecting out to
+	// peers, and registering funding shim. We don't do a full batch
+	// validation since we don't have any information about the account
+	// that's being used to pay for the sidecar channel.
+	err = a.cfg.FundingManager.PrepChannelFunding(batch, a.getSidecarAsOrder)
+	if err != nil {
+		return fmt.Errorf("error preparing channel funding: %w", err)
+	}
+
+	// Accept the match now.
+	log.Infof("Accepting batch=%x", batch.ID[:])
+
+	// Send the message to the server.
+	err = a.cfg.Auctioneer.SendAuctionMessage(
+		&auctioneerrpc.ClientAuctionMessage{
+			Msg: &auctioneerrpc.ClientAuctionMessage_Accept{
+				Accept: &auctioneerrpc.OrderMatchAccept{
+					BatchId: batch.ID[:],
+				},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error sending accept msg: %v", err)
+	}
+
+	// We know we're involved in a batch, so let's store it for the
+	// next step.
+	a.pendingBatch = batch
+
+	return nil
+}
+
+// verifySidecarBalances makes sure all channels we'd receive in the given batch
+// are funded with the capacity and push amount of the corresponding sidecar
+// ticket. All matches that don't are rejected at once with an
+// order.MatchRejectErr.
+func (a *Acceptor) verifySidecarBalances(batch *order.Batch) error {
+	rejects := make(map[order.Nonce]*auctioneerrpc.OrderReject)
+	for nonce, theirOrders := range batch.MatchedOrders {
+		// Orders that aren't one of our sidecar tickets are ignored by
+		// the funding manager as well.
+		ourOrder, err := a.getSidecarAsOrder(nonce)
+		if err != nil {
+			continue
+		}
+		bid := ourOrder.(*order.Bid)
+
+		for _, theirOrder := range theirOrders {
+			reject := order.SidecarBalanceReject(bid, theirOrder)
+			if reject != nil {
+				rejects[theirOrder.Order.Nonce()] = reject
+			}
+		}
+	}
+
+	if len(rejects) > 0 {
+		return &order.MatchRejectErr{
+			RejectedOrders: rejects,
+		}
+	}
+
+	return nil
+}
+
+// isPending returns true if the provided batchID matches the current pending
+// one.
+func (a *Acceptor) isPending(batchID []byte) bool {
+	if a.pendingBatch == nil || !bytes.Equal(batchID, a.pendingBatch.ID[:]) {
+		log.Errorf("error processing batch sign message, unknown "+
+			"batch with ID %x", batchID)
+
+		return false
+	}
+
+	return true
+}
+
+// matchSign handles an incoming OrderMatchSignBegin message from the server.
+// Since we're only on the receiving end of a sidecar channel (which is always
+// a bid order) the tasks are simplified compared to normal bid order execution.
+//
+// NOTE: The lock must be held when calling this method.
+func (a *Acceptor) matchSign(msg *auctioneerrpc.OrderMatchSignBegin) error {
+	// Assert we're in the correct state to receive a sign message.
+	if !a.isPending(msg.BatchId) {
+		return fmt.Errorf("pending batchID was: %x got: %x",
+			a.pendingBatch.ID[:], msg.BatchId)
+	}
+
+	batch := a.pendingBatch
+	batchID := a.pendingBatch.ID[:]
+
+	channelInfos, err := a.cfg.FundingManager.SidecarBatchChannelSetup(
+		batch, a.pendingOpenChanClient, a.getSidecarAsOrder,
+	)
+	if err != nil {
+		return fmt.Errorf("error setting up channels: %w", err)
+	}
+
+	rpcChannelInfos, err := funding.MarshallChannelInfo(channelInfos)
+	if err != nil {
+		return fmt.Errorf("error setting up channels: %v", err)
+	}
+
+	log.Infof("Received OrderMatchSignBegin for batch=%x, "+
+		"num_orders=%v", batchID, len(batch.MatchedOrders))
+
+	log.Infof("Sending OrderMatchSign for batch %x", batchID)
+	return a.cfg.Auctioneer.SendAuctionMessage(
+		&auctioneerrpc.ClientAuctionMessage{
+			Msg: &auctioneerrpc.ClientAuctionMessage_Sign{
+				Sign: &auctioneerrpc.OrderMatchSign{
+					BatchId:      batchID,
+					ChannelInfos: rpcChannelInfos,
+				},
+			},
+		},
+	)
+}
+
+// matchFinalize handles an incoming OrderMatchFinalize message from the server.
+// Since we're only on the receiving end of a sidecar channel (which is always
+// a bid order) the tasks are simplified compared to normal bid order execution.
+//
+// NOTE: The lock must be held when calling this method.
+func (a *Acceptor) matchFinalize() {
+	log.Infof("Received FinalizeMsg for batch=%x", a.pendingBatch.ID[:])
+
+	// All we need to do now is some cleanup. Even if the cleanup
+	// fails, we want to clear the pending batch as we won't receive
+	// any more messages for it.
+	batch := a.pendingBatch
+	a.pendingBatch = nil
+
+	// Remove pending shim and update sidecar ticket.
+	for ourOrder := range batch.MatchedOrders {
+		dummyBid, err := a.getSidecarAsOrder(ourOrder)
+		if err != nil {
+			// Skip over matched orders that aren't sidecar ones.
+			continue
+		}
+
+		// Make sure we don't expect this sidecar channel again.
+		a.pendingSidecarOrdersMtx.Lock()
+		ticket := a.pendingSidecarOrders[dummyBid.Nonce()]
+		ticket.State = sidecar.StateCompleted
+		if err := a.cfg.SidecarDB.UpdateSidecar(ticket); err != nil {
+			log.Errorf("Error updating sidecar ticket to "+
+				"state complete: %v", err)
+		}
+
+		delete(a.pendingSidecarOrders, ourOrder)
+		a.pendingSidecarOrdersMtx.Unlock()
+
+		a.cfg.Acceptor.ShimRemoved(dummyBid.(*order.Bid))
+
+		a.notifyStateChange(ticket)
+	}
+}
+
+// getSidecarAsOrder tries to find a sidecar ticket for the order with the given
+// nonce and returns a dummy order that contains all the necessary information
+// needed for channel receiving.
+func (a *Acceptor) getSidecarAsOrder(o order.Nonce) (order.Order, error) {
+	a.pendingSidecarOrdersMtx.Lock()
+	defer a.pendingSidecarOrdersMtx.Unlock()
+
+	for _, ticket := range a.pendingSidecarOrders {
+		if ticket.Order.BidNonce == o {
+			kit := order.NewKit(ticket.Order.BidNonce)
+			kit.LeaseDuration = ticket.Offer.LeaseDurationBlocks
+			return &order.Bid{
+				Kit:             *kit,
+				SidecarTicket:   ticket,
+				SelfChanBalance: ticket.Offer.PushAmt,
+			}, nil
+		}
+	}
+
+	return nil, clientdb.ErrNoOrder
+}
+
+// sendRejectBatch sends a reject message to the server with the properly
+// decoded reason code and the full reason message as a string.
+func (a *Acceptor) sendRejectBatch(batchID []byte, batch *order.Batch,
+	failure error) error {
+
+	if batch != nil {
+		// As we're rejecting this batch, we'll cancel all funding shims
+		// that we may have registered.
+		if err := a.removeShims(batch); err != nil {
+			return err
+		}
+		a.pendingBatch = nil
+	}
+
+	msg := &auctioneerrpc.ClientAuctionMessage_Reject{
+		Reject: &auctioneerrpc.OrderMatchReject{
+			BatchId: batchID,
+			Reason:  failure.Error(),
+		},
+	}
+
+	// Attach the status code to the message to give a bit more context.
+	var (
+		partialReject   *order.MatchRejectErr
+		versionMismatch *order.ErrVersionMismatch
+	)
+	switch {
+	case errors.As(failure, &versionMismatch):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_BATCH_VERSION_MISMATCH
+
+	case errors.Is(failure, order.ErrMismatchErr):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_SERVER_MISBEHAVIOR
+
+	case errors.As(failure, &partialReject):
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_PARTIAL_REJECT
+		msg.Reject.RejectedOrders = make(map[string]*auctioneerrpc.OrderReject)
+		for nonce, reject := range partialReject.RejectedOrders {
+			msg.Reject.RejectedOrders[nonce.String()] = reject
+		}
+
+	default:
+		msg.Reject.ReasonCode = auctioneerrpc.OrderMatchReject_UNKNOWN
+	}
+
+	log.Infof("Sending sidecar batch rejection message for batch %x "+
+		"with code %v and message: %v", batchID, msg.Reject.ReasonCode,
+		failure)
+
+	return a.cfg.Auctioneer.SendAuctionMessage(
+		&auctioneerrpc.ClientAuctionMessage{
+			Msg: msg,
+		},
+	)
+}
+
+// removeShims removes any previously created channel shims for the given batch
+// from lnd and the channel acceptor.
+func (a *Acceptor) removeShims(batch *order.Batch) error {
+	// As we're rejecting this batch, we'll now cancel all funding shims
+	// that we may have registered since we may be matched with a distinct
+	// set of channels if this batch is repeated.
+	if err := funding.CancelPendingFundingShims(
+		batch.MatchedOrders, a.cfg.BaseClient, a.getSidecarAsOrder,
+	); err != nil {
+		return err
+	}
+
+	for ourOrder := range batch.MatchedOrders {
+		dummyBid, err := a.getSidecarAsOrder(ourOrder)
+		if err != nil {
+			continue
+		}
+
+		a.cfg.Acceptor.ShimRemoved(dummyBid.(*order.Bid))
+	}
+
+	return nil
+}
@@ -0,0 +1,355 @@
+package sidecaracceptor
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/chaninfo"
+	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/chanbackup"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+var (
+	providerPrivKey, providerPubKey = btcec.PrivKeyFromBytes([]byte{0x01})
+	_, ourNodePubKey                = btcec.PrivKeyFromBytes([]byte{0x02})
+	_, multiSigPubKey               = btcec.PrivKeyFromBytes([]byte{0x03})
+	_, askerNodePubKey              = btcec.PrivKeyFromBytes([]byte{0x04})
+	_, batchKey                     = btcec.PrivKeyFromBytes([]byte{0x05})
+)
+
+// mockSigner verifies signatures the same way lnd's signer does.
+type mockSigner struct{}
+
+func (m *mockSigner) VerifyMessage(_ context.Context, msg, sig []byte,
+	pubkey [33]byte) (bool, error) {
+
+	pubKey, err := btcec.ParsePubKey(pubkey[:])
+	if err != nil {
+		return false, err
+	}
+	signature, err := ecdsa.ParseDERSignature(sig)
+	if err != nil {
+		return false, err
+	}
+
+	return signature.Verify(chainhash.HashB(msg), pubKey), nil
+}
+
+type mockWallet struct{}
+
+func (m *mockWallet) DeriveNextKey(_ context.Context,
+	family int32) (*keychain.KeyDescriptor, error) {
+
+	return &keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(family),
+			Index:  7,
+		},
+		PubKey: multiSigPubKey,
+	}, nil
+}
+
+// mockAuctioneer is an auction server that the test drives manually.
+type mockAuctioneer struct {
+	subscriptions chan *keychain.KeyDescriptor
+	serverMsgs    chan *auctioneerrpc.ServerAuctionMessage
+	clientMsgs    chan *auctioneerrpc.ClientAuctionMessage
+}
+
+func newMockAuctioneer() *mockAuctioneer {
+	return &mockAuctioneer{
+		subscriptions: make(chan *keychain.KeyDescriptor, 1),
+		serverMsgs:    make(chan *auctioneerrpc.ServerAuctionMessage),
+		clientMsgs:    make(chan *auctioneerrpc.ClientAuctionMessage, 1),
+	}
+}
+
+func (m *mockAuctioneer) Start() error {
+	return nil
+}
+
+func (m *mockAuctioneer) Stop() error {
+	return nil
+}
+
+func (m *mockAuctioneer) StartAccountSubscription(_ context.Context,
+	acctKey *keychain.KeyDescriptor) error {
+
+	m.subscriptions <- acctKey
+	return nil
+}
+
+func (m *mockAuctioneer) SendAuctionMessage(
+	msg *auctioneerrpc.ClientAuctionMessage) error {
+
+	m.clientMsgs <- msg
+	return nil
+}
+
+func (m *mockAuctioneer) ServerMessages() <-chan *auctioneerrpc.ServerAuctionMessage {
+	return m.serverMsgs
+}
+
+// mockFunder pretends the channels of a batch are opened successfully.
+type mockFunder struct {
+	t *testing.T
+
+	preparedBids chan *order.Bid
+}
+
+func (m *mockFunder) PrepChannelFunding(batch *order.Batch,
+	getOrder order.Fetcher) error {
+
+	for nonce := range batch.MatchedOrders {
+		ourOrder, err := getOrder(nonce)
+		require.NoError(m.t, err)
+
+		m.preparedBids <- ourOrder.(*order.Bid)
+	}
+
+	return nil
+}
+
+func (m *mockFunder) SidecarBatchChannelSetup(batch *order.Batch,
+	_ *subscribe.Client, _ order.Fetcher) (
+	map[wire.OutPoint]*chaninfo.ChannelInfo, error) {
+
+	chanPoint := wire.OutPoint{Hash: batch.BatchTX.TxHash()}
+	version := chanbackup.SingleBackupVersion(
+		chanbackup.ScriptEnforcedLeaseVersion,
+	)
+	return map[wire.OutPoint]*chaninfo.ChannelInfo{
+		chanPoint: {
+			Version:                version,
+			LocalNodeKey:           ourNodePubKey,
+			RemoteNodeKey:          askerNodePubKey,
+			LocalPaymentBasePoint:  multiSigPubKey,
+			RemotePaymentBasePoint: askerNodePubKey,
+		},
+	}, nil
+}
+
+func (m *mockFunder) SubscribePendingOpenChan() (*subscribe.Client, error) {
+	return nil, nil
+}
+
+type mockChannelAcceptorClient struct{}
+
+func (m *mockChannelAcceptorClient) ChannelAcceptor(context.Context,
+	time.Duration, lndclient.AcceptorFunction) (chan error, error) {
+
+	return make(chan error), nil
+}
+
+// signDigest signs a ticket digest with the provider's key the same way lnd's
+// signer does.
+func signDigest(digest [32]byte) *ecdsa.Signature {
+	return ecdsa.Sign(providerPrivKey, chainhash.HashB(digest[:]))
+}
+
+// TestAcceptorTicketLifecycle drives a sidecar ticket from the offered to the
+// completed state against a mocked auctioneer.
+func TestAcceptorTicketLifecycle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		states    []sidecar.State
+		statesMtx sync.Mutex
+	)
+	onStateChange := func(ticket *sidecar.Ticket) {
+		statesMtx.Lock()
+		defer statesMtx.Unlock()
+
+		states = append(states, ticket.State)
+	}
+	assertStates := func(expected ...sidecar.State) {
+		t.Helper()
+
+		statesMtx.Lock()
+		defer statesMtx.Unlock()
+
+		require.Equal(t, expected, states)
+	}
+
+	store := newMemStore()
+	auctioneer := newMockAuctioneer()
+	funder := &mockFunder{t: t, preparedBids: make(chan *order.Bid, 1)}
+	acceptor := NewAcceptor(&Config{
+		SidecarDB: store,
+		Signer:    &mockSigner{},
+		Wallet:    &mockWallet{},
+		Acceptor: funding.NewChannelAcceptor(
+			&mockChannelAcceptorClient{},
+			funding.DefaultAcceptorConfig(),
+		),
+		NodePubKey:     ourNodePubKey,
+		Auctioneer:     auctioneer,
+		FundingManager: funder,
+		OnStateChange:  onStateChange,
+	})
+	require.NoError(t, acceptor.Start(make(chan error, 1)))
+	defer func() {
+		require.NoError(t, acceptor.Stop())
+	}()
+
+	ctx := context.Background()
+
+	// The provider offers a ticket.
+	ticket, err := sidecar.NewTicket(
+		sidecar.VersionExpiry, 1_000_000, 200_000, 2016,
+		providerPubKey, false, time.Unix(time.Now().Unix()+3600, 0),
+	)
+	require.NoError(t, err)
+	offerDigest, err := ticket.OfferDigest()
+	require.NoError(t, err)
+	ticket.Offer.SigOfferDigest = signDigest(offerDigest)
+
+	// We register it, which adds our node and multisig key.
+	registered, err := acceptor.RegisterSidecar(ctx, *ticket)
+	require.NoError(t, err)
+	require.Equal(t, sidecar.StateRegistered, registered.State)
+	require.True(t, registered.Recipient.NodePubKey.IsEqual(ourNodePubKey))
+	require.True(
+		t, registered.Recipient.MultiSigPubKey.IsEqual(multiSigPubKey),
+	)
+	assertStates(sidecar.StateRegistered)
+
+	// Registering the same ticket twice isn't possible.
+	_, err = acceptor.RegisterSidecar(ctx, *ticket)
+	require.ErrorContains(t, err, "already exists")
+
+	// The provider submits the bid and signs the order part of the ticket.
+	bidNonce := order.Nonce{1, 2, 3}
+	ordered := *registered
+	ordered.State = sidecar.StateOrdered
+	ordered.Order = &sidecar.Order{BidNonce: bidNonce}
+	orderDigest, err := ordered.OrderDigest()
+	require.NoError(t, err)
+	ordered.Order.SigOrderDigest = signDigest(orderDigest)
+
+	require.NoError(t, acceptor.ValidateOrderedTicket(ctx, &ordered))
+	require.NoError(t, acceptor.ExpectChannel(ctx, &ordered))
+	assertStates(
+		sidecar.StateRegistered, sidecar.StateExpectingChannel,
+	)
+
+	// We subscribe to batch updates with the multisig key of the ticket.
+	select {
+	case acctKey := <-auctioneer.subscriptions:
+		require.True(t, acctKey.PubKey.IsEqual(multiSigPubKey))
+		require.EqualValues(t, 7, acctKey.Index)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("no account subscription")
+	}
+
+	// The auctioneer matches the bid of the ticket in a batch.
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{Value: 1_000_000})
+	var batchTx bytes.Buffer
+	require.NoError(t, tx.Serialize(&batchTx))
+	batchID := batchKey.SerializeCompressed()
+	sendServerMsg := func(msg *auctioneerrpc.ServerAuctionMessage) {
+		select {
+		case auctioneer.serverMsgs <- msg:
+		case <-time.After(testTimeout):
+			t.Fatalf("server message not read")
+		}
+	}
+	receiveClientMsg := func() *auctioneerrpc.ClientAuctionMessage {
+		select {
+		case msg := <-auctioneer.clientMsgs:
+			return msg
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no client message received")
+			return nil
+		}
+	}
+
+	matchedOrders := map[string]*auctioneerrpc.MatchedOrder{
+		hex.EncodeToString(bidNonce[:]): {},
+	}
+	sendServerMsg(&auctioneerrpc.ServerAuctionMessage{
+		Msg: &auctioneerrpc.ServerAuctionMessage_Prepare{
+			Prepare: &auctioneerrpc.OrderMatchPrepare{
+				MatchedMarkets: map[uint32]*auctioneerrpc.MatchedMarket{
+					2016: {
+						MatchedOrders:     matchedOrders,
+						ClearingPriceRate: 100,
+					},
+				},
+				ExecutionFee: &auctioneerrpc.ExecutionFee{
+					BaseFee: 1,
+					FeeRate: 1,
+				},
+				BatchTransaction: batchTx.Bytes(),
+				BatchId:          batchID,
+			},
+		},
+	})
+
+	// The funding manager is prepared with a bid derived from the ticket
+	// and we accept the batch.
+	select {
+	case bid := <-funder.preparedBids:
+		require.Equal(t, bidNonce, bid.Nonce())
+		require.EqualValues(t, 200_000, bid.SelfChanBalance)
+		require.EqualValues(t, 2016, bid.LeaseDuration)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("channel funding not prepared")
+	}
+	accept := receiveClientMsg().GetAccept()
+	require.NotNil(t, accept)
+	require.Equal(t, batchID, accept.BatchId)
+
+	// Once the channel is set up, we send its information to the
+	// auctioneer.
+	sendServerMsg(&auctioneerrpc.ServerAuctionMessage{
+		Msg: &auctioneerrpc.ServerAuctionMessage_Sign{
+			Sign: &auctioneerrpc.OrderMatchSignBegin{
+				BatchId: batchID,
+			},
+		},
+	})
+	sign := receiveClientMsg().GetSign()
+	require.NotNil(t, sign)
+	require.Equal(t, batchID, sign.BatchId)
+	require.Len(t, sign.ChannelInfos, 1)
+
+	// Finally, the batch is finalized and the ticket is completed.
+	sendServerMsg(&auctioneerrpc.ServerAuctionMessage{
+		Msg: &auctioneerrpc.ServerAuctionMessage_Finalize{
+			Finalize: &auctioneerrpc.OrderMatchFinalize{
+				BatchId: batchID,
+			},
+		},
+	})
+	require.Eventually(t, func() bool {
+		stored, err := store.Sidecar(ticket.ID, providerPubKey)
+		return err == nil && stored.State == sidecar.StateCompleted
+	}, testTimeout, 10*time.Millisecond)
+	assertStates(
+		sidecar.StateRegistered, sidecar.StateExpectingChannel,
+		sidecar.StateCompleted,
+	)
+}
@@ -0,0 +1,69 @@
+package sidecaracceptor
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/pool/auctioneerrpc"
+	"github.com/lightninglabs/pool/chaninfo"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightninglabs/pool/sidecar"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// Signer is the part of lnd's signer RPC that the acceptor needs to verify the
+// provider's signatures of a ticket.
+type Signer interface {
+	sidecar.Verifier
+}
+
+// WalletKit is the part of lnd's wallet kit RPC that the acceptor needs to
+// derive the multisig keys of the channels it receives.
+type WalletKit interface {
+	// DeriveNextKey derives the next key of the given key family.
+	DeriveNextKey(ctx context.Context, family int32) (
+		*keychain.KeyDescriptor, error)
+}
+
+// Auctioneer is the connection to the auction server the acceptor uses to
+// learn about batches that contain a channel for one of its tickets.
+type Auctioneer interface {
+	// Start starts the connection to the auction server.
+	Start() error
+
+	// Stop shuts down the connection to the auction server.
+	Stop() error
+
+	// StartAccountSubscription subscribes to batch updates for the given
+	// key. For sidecar tickets, this is the multisig key of the expected
+	// channel.
+	StartAccountSubscription(ctx context.Context,
+		acctKey *keychain.KeyDescriptor) error
+
+	// SendAuctionMessage sends a message to the auction server as a
+	// response to a message it sent.
+	SendAuctionMessage(msg *auctioneerrpc.ClientAuctionMessage) error
+
+	// ServerMessages returns the channel on which all messages sent by
+	// the auction server are delivered.
+	ServerMessages() <-chan *auctioneerrpc.ServerAuctionMessage
+}
+
+// ChannelFunder is the part of the funding manager that is needed to receive
+// sidecar channels.
+type ChannelFunder interface {
+	// PrepChannelFunding preps the backing node to receive the channels
+	// of a batch.
+	PrepChannelFunding(batch *order.Batch, getOrder order.Fetcher) error
+
+	// SidecarBatchChannelSetup waits for the channels of our tickets in
+	// the batch to be opened and returns their information.
+	SidecarBatchChannelSetup(batch *order.Batch,
+		chanUpdates *subscribe.Client, getOrder order.Fetcher) (
+		map[wire.OutPoint]*chaninfo.ChannelInfo, error)
+
+	// SubscribePendingOpenChan returns a new subscription client for
+	// pending open channel events.
+	SubscribePendingOpenChan() (*subscribe.Client, error)
+}
@@ -0,0 +1,185 @@
+package sidecaracceptor
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/pool/account"
+	"github.com/lightninglabs/pool/auctioneer"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/funding"
+	"github.com/lightninglabs/pool/order"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	// defaultMinBackoff is the shortest backoff when reconnecting to the
+	// auction server.
+	defaultMinBackoff = 5 * time.Second
+
+	// defaultMaxBackoff is the longest backoff when reconnecting to the
+	// auction server.
+	defaultMaxBackoff = 1 * time.Minute
+)
+
+// noPendingBatch is an auctioneer.BatchSource for traders that never have a
+// pending batch of their own, like the standalone acceptor that doesn't have
+// an account.
+type noPendingBatch struct{}
+
+// PendingBatchSnapshot always returns account.ErrNoPendingBatch.
+//
+// NOTE: This is part of the auctioneer.BatchSource interface.
+func (noPendingBatch) PendingBatchSnapshot() (*clientdb.LocalBatchSnapshot,
+	error) {
+
+	return nil, account.ErrNoPendingBatch
+}
+
+// Standalone is a sidecar acceptor that runs without the Pool trader daemon.
+// It only needs a connection to lnd and keeps the tickets in memory, every
+// change of a ticket's state is reported to the state change callback so the
+// caller can persist the tickets and hand the registered ticket back to the
+// provider.
+type Standalone struct {
+	*Acceptor
+
+	lndServices    *lndclient.GrpcLndServices
+	lndConn        *grpc.ClientConn
+	fundingManager *funding.Manager
+}
+
+// NewStandalone connects to lnd with the given configuration and creates a
+// sidecar acceptor that uses the default auction server of lnd's network. The
+// callback is invoked each time a ticket moves to a new state.
+func NewStandalone(lndCfg *lndclient.LndServicesConfig,
+	onStateChange StateChangeCallback) (*Standalone, error) {
+
+	var serverAddress string
+	switch lndCfg.Network {
+	case lndclient.NetworkMainnet:
+		serverAddress = auctioneer.MainnetServer
+
+	case lndclient.NetworkTestnet:
+		serverAddress = auctioneer.TestnetServer
+
+	default:
+		return nil, fmt.Errorf("no auction server known for network "+
+			"%v", lndCfg.Network)
+	}
+
+	lndServices, err := lndclient.NewLndServices(lndCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to lnd: %v", err)
+	}
+
+	// The funding manager needs some lower-level calls that aren't part of
+	// lndclient, so we need a "basic client" as well.
+	macDir := lndCfg.MacaroonDir
+	var basicOpts []lndclient.BasicClientOption
+	if lndCfg.CustomMacaroonPath != "" {
+		macDir = path.Dir(lndCfg.CustomMacaroonPath)
+		basicOpts = append(basicOpts, lndclient.MacFilename(
+			path.Base(lndCfg.CustomMacaroonPath),
+		))
+	}
+	lndConn, err := lndclient.NewBasicConn(
+		lndCfg.LndAddress, lndCfg.TLSPath, macDir,
+		string(lndCfg.Network), basicOpts...,
+	)
+	if err != nil {
+		lndServices.Close()
+		return nil, fmt.Errorf("error connecting to lnd: %v", err)
+	}
+	baseClient := lnrpc.NewLightningClient(lndConn)
+
+	nodePubKey, err := btcec.ParsePubKey(lndServices.NodePubkey[:])
+	if err != nil {
+		_ = lndConn.Close()
+		lndServices.Close()
+		return nil, fmt.Errorf("unable to parse node pubkey: %v", err)
+	}
+
+	channelAcceptor := funding.NewChannelAcceptor(
+		lndServices.Client, funding.DefaultAcceptorConfig(),
+	)
+	fundingManager := funding.NewManager(&funding.ManagerConfig{
+		WalletKit:         lndServices.WalletKit,
+		LightningClient:   lndServices.Client,
+		SignerClient:      lndServices.Signer,
+		BaseClient:        baseClient,
+		NodePubKey:        nodePubKey,
+		BatchStepTimeout:  order.DefaultBatchStepTimeout,
+		NotifyShimCreated: channelAcceptor.ShimRegistered,
+	})
+
+	client, err := auctioneer.NewClient(&auctioneer.Config{
+		ServerAddress:  serverAddress,
+		Signer:         lndServices.Signer,
+		MinBackoff:     defaultMinBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		BatchSource:    noPendingBatch{},
+		BatchVersion:   order.LatestBatchVersion,
+		ConnectSidecar: true,
+
+		KeepaliveTime:      auctioneer.DefaultKeepaliveTime,
+		KeepaliveTimeout:   auctioneer.DefaultKeepaliveTimeout,
+		CallTimeout:        auctioneer.DefaultCallTimeout,
+		BatchStreamTimeout: auctioneer.DefaultBatchStreamTimeout,
+	})
+	if err != nil {
+		_ = lndConn.Close()
+		lndServices.Close()
+		return nil, fmt.Errorf("error creating auctioneer client: %v",
+			err)
+	}
+
+	return &Standalone{
+		Acceptor: NewAcceptor(&Config{
+			SidecarDB:      newMemStore(),
+			Signer:         lndServices.Signer,
+			Wallet:         lndServices.WalletKit,
+			BaseClient:     baseClient,
+			Acceptor:       channelAcceptor,
+			NodePubKey:     nodePubKey,
+			Auctioneer:     client,
+			FundingManager: fundingManager,
+			OnStateChange:  onStateChange,
+		}),
+		lndServices:    lndServices,
+		lndConn:        lndConn,
+		fundingManager: fundingManager,
+	}, nil
+}
+
+// Start starts the funding manager and the acceptor. Errors of the channel
+// acceptor stream are sent to the given channel.
+func (s *Standalone) Start(errChan chan error) error {
+	if err := s.fundingManager.Start(); err != nil {
+		return fmt.Errorf("error starting funding manager: %v", err)
+	}
+
+	return s.Acceptor.Start(errChan)
+}
+
+// Stop stops the acceptor and closes all connections to lnd.
+func (s *Standalone) Stop() error {
+	returnErr := s.Acceptor.Stop()
+
+	if err := s.fundingManager.Stop(); err != nil {
+		log.Errorf("Error stopping funding manager: %v", err)
+		returnErr = err
+	}
+
+	if err := s.lndConn.Close(); err != nil {
+		log.Errorf("Error closing lnd connection: %v", err)
+		returnErr = err
+	}
+	s.lndServices.Close()
+
+	return returnErr
+}
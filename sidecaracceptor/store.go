@@ -0,0 +1,144 @@
+package sidecaracceptor
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/pool/clientdb"
+	"github.com/lightninglabs/pool/sidecar"
+)
+
+// ticketKey is the key a ticket is stored under, the ID and the provider's
+// signing key.
+type ticketKey struct {
+	id        [8]byte
+	signerKey [33]byte
+}
+
+// newTicketKey returns the key of a ticket with the given ID and signing key.
+func newTicketKey(id [8]byte, offerSignPubKey *btcec.PublicKey) ticketKey {
+	key := ticketKey{id: id}
+	copy(key.signerKey[:], offerSignPubKey.SerializeCompressed())
+
+	return key
+}
+
+// memStore is a sidecar.Store that keeps all tickets in memory. It's used by
+// the standalone acceptor which leaves persisting the tickets to the caller.
+type memStore struct {
+	tickets map[ticketKey]*sidecar.Ticket
+
+	sync.Mutex
+}
+
+// A compile-time check to make sure memStore implements the sidecar.Store
+// interface.
+var _ sidecar.Store = (*memStore)(nil)
+
+// newMemStore creates a new empty in-memory ticket store.
+func newMemStore() *memStore {
+	return &memStore{
+		tickets: make(map[ticketKey]*sidecar.Ticket),
+	}
+}
+
+// AddSidecar adds a record for the sidecar order to the store.
+//
+// NOTE: This is part of the sidecar.Store interface.
+func (s *memStore) AddSidecar(ticket *sidecar.Ticket) error {
+	s.Lock()
+	defer s.Unlock()
+
+	key := newTicketKey(ticket.ID, ticket.Offer.SignPubKey)
+	if _, ok := s.tickets[key]; ok {
+		return fmt.Errorf("sidecar with ID %x already exists",
+			ticket.ID[:])
+	}
+
+	ticketCopy := *ticket
+	s.tickets[key] = &ticketCopy
+
+	return nil
+}
+
+// UpdateSidecar updates a sidecar order in the store.
+//
+// NOTE: This is part of the sidecar.Store interface.
+func (s *memStore) UpdateSidecar(ticket *sidecar.Ticket) error {
+	s.Lock()
+	defer s.Unlock()
+
+	key := newTicketKey(ticket.ID, ticket.Offer.SignPubKey)
+	if _, ok := s.tickets[key]; !ok {
+		return clientdb.ErrNoSidecar
+	}
+
+	ticketCopy := *ticket
+	s.tickets[key] = &ticketCopy
+
+	return nil
+}
+
+// UpdateSidecarWithReason updates a sidecar order in the store. The reason is
+// ignored as the store doesn't keep an event log.
+//
+// NOTE: This is part of the sidecar.Store interface.
+func (s *memStore) UpdateSidecarWithReason(ticket *sidecar.Ticket,
+	_ string) error {
+
+	return s.UpdateSidecar(ticket)
+}
+
+// Sidecar retrieves a specific sidecar by its ID and provider signing key
+// (offer signature pubkey) or returns clientdb.ErrNoSidecar if it's not found.
+//
+// NOTE: This is part of the sidecar.Store interface.
+func (s *memStore) Sidecar(id [8]byte,
+	offerSignPubKey *btcec.PublicKey) (*sidecar.Ticket, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	ticket, ok := s.tickets[newTicketKey(id, offerSignPubKey)]
+	if !ok {
+		return nil, clientdb.ErrNoSidecar
+	}
+
+	ticketCopy := *ticket
+	return &ticketCopy, nil
+}
+
+// Sidecars retrieves all known sidecar orders from the store. If any states
+// are given, only tickets in one of those states are returned.
+//
+// NOTE: This is part of the sidecar.Store interface.
+func (s *memStore) Sidecars(states ...sidecar.State) ([]*sidecar.Ticket,
+	error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	tickets := make([]*sidecar.Ticket, 0, len(s.tickets))
+	for _, ticket := range s.tickets {
+		if len(states) > 0 && !hasState(ticket.State, states) {
+			continue
+		}
+
+		ticketCopy := *ticket
+		tickets = append(tickets, &ticketCopy)
+	}
+
+	return tickets, nil
+}
+
+// hasState returns true if the state is one of the given states.
+func hasState(state sidecar.State, states []sidecar.State) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
@@ -8,6 +8,29 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+// DefaultMaxSelfChanBalanceRatio is the maximum self channel balance of a bid
+// in parts per million of the bid amount that is used if the auctioneer
+// doesn't announce a ratio. It limits the self channel balance to the bid
+// amount.
+const DefaultMaxSelfChanBalanceRatio = 1_000_000
+
+// DefaultChannelTypes are the channel types that are accepted if the
+// auctioneer doesn't announce the channel types it supports.
+var DefaultChannelTypes = []auctioneerrpc.OrderChannelType{
+	auctioneerrpc.OrderChannelType_ORDER_CHANNEL_TYPE_PEER_DEPENDENT,
+	auctioneerrpc.OrderChannelType_ORDER_CHANNEL_TYPE_SCRIPT_ENFORCED,
+}
+
+// Capability is a bit in the capabilities bit field of the auctioneer terms
+// that signals support for an optional feature.
+type Capability uint64
+
+const (
+	// CapabilityZeroConfChannels signals that the auctioneer accepts bids
+	// for zero conf channels and asks that opt in to serve them.
+	CapabilityZeroConfChannels Capability = 1 << 0
+)
+
 // AuctioneerTerms is a struct that holds all dynamic terms the auctioneer defines.
 type AuctioneerTerms struct {
 	// MaxAccountValue is the current maximum allowed account value.
@@ -39,6 +62,42 @@ type AuctioneerTerms struct {
 	// AutoRenewExtensionBlocks is the threshold used to extend the expiry height
 	// of the accounts that are close to expire after participating in a batch.
 	AutoRenewExtensionBlocks uint32
+
+	// TaprootAccounts indicates whether the auctioneer supports taproot
+	// accounts that use a MuSig2 combined key.
+	TaprootAccounts bool
+
+	// MaxSelfChanBalanceRatio is the maximum self channel balance of a bid
+	// in parts per million of the bid amount. If this is zero, the
+	// DefaultMaxSelfChanBalanceRatio applies.
+	MaxSelfChanBalanceRatio uint64
+
+	// ChannelTypes are the channel types the auctioneer currently accepts
+	// orders for. If this is empty, the DefaultChannelTypes apply.
+	ChannelTypes []auctioneerrpc.OrderChannelType
+
+	// Capabilities is the bit field of optional features the auctioneer
+	// supports.
+	Capabilities Capability
+
+	// ServerVersion is the version of the auction server software. It is
+	// empty if the auctioneer doesn't announce its version.
+	ServerVersion string
+
+	// BatchVersion is the batch verification protocol version the
+	// auctioneer currently uses.
+	BatchVersion uint32
+
+	// CachedAt is the time the terms were stored in the local cache. It is
+	// only set if the auctioneer couldn't be reached and the last known
+	// terms were used instead, otherwise it is the zero time.
+	CachedAt time.Time
+}
+
+// IsCached returns true if the terms weren't fetched from the auctioneer
+// directly but were read from the local cache.
+func (t *AuctioneerTerms) IsCached() bool {
+	return !t.CachedAt.IsZero()
 }
 
 // FeeSchedule returns the execution fee as a FeeSchedule.
@@ -47,3 +106,46 @@ func (t *AuctioneerTerms) FeeSchedule() FeeSchedule {
 		t.OrderExecBaseFee, t.OrderExecFeeRate,
 	)
 }
+
+// MaxSelfChanBalance returns the maximum self channel balance of a bid for the
+// given amount.
+func (t *AuctioneerTerms) MaxSelfChanBalance(
+	amt btcutil.Amount) btcutil.Amount {
+
+	ratio := t.MaxSelfChanBalanceRatio
+	if ratio == 0 {
+		ratio = DefaultMaxSelfChanBalanceRatio
+	}
+
+	return amt * btcutil.Amount(ratio) / 1_000_000
+}
+
+// SupportedChannelTypes returns the channel types the auctioneer currently
+// accepts orders for.
+func (t *AuctioneerTerms) SupportedChannelTypes() []auctioneerrpc.OrderChannelType {
+	if len(t.ChannelTypes) == 0 {
+		return DefaultChannelTypes
+	}
+
+	return t.ChannelTypes
+}
+
+// SupportsChannelType returns true if the auctioneer currently accepts orders
+// for the given channel type.
+func (t *AuctioneerTerms) SupportsChannelType(
+	channelType auctioneerrpc.OrderChannelType) bool {
+
+	for _, supported := range t.SupportedChannelTypes() {
+		if supported == channelType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasCapability returns true if the auctioneer supports the optional feature
+// of the given capability bit.
+func (t *AuctioneerTerms) HasCapability(capability Capability) bool {
+	return t.Capabilities&capability == capability
+}